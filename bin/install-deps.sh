@@ -9,7 +9,7 @@ linuxArm64="https://gethstore.blob.core.windows.net/builds/geth-alltools-linux-a
 
 if [[ "$OS" == "linux" ]]; then
     sudo apt-get update
-    sudo apt-get install -y make curl git software-properties-common jq
+    sudo apt-get install -y make curl git software-properties-common jq golang-go
 
     if [[ $ARCH == *"x86_64"* ]]; then
         curl -L $linuxAmd64 | tar -xz
@@ -21,7 +21,7 @@ if [[ "$OS" == "linux" ]]; then
     fi
 elif [[ "$OS" == "darwin" ]]; then
     brew tap ethereum/ethereum
-    brew install libusb ethereum@1.14.5
+    brew install libusb ethereum@1.14.5 go
 else
     echo "Unsupported OS: $OS"
     exit 1
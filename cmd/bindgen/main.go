@@ -0,0 +1,45 @@
+// Command bindgen generates the Go bindings under pkg/bindings from the forge
+// build artifacts in out/.
+//
+// One binding package is generated per .sol file under src/contracts. Structs
+// shared between contracts are collapsed onto pkg/types, typed custom errors
+// are generated for pkg/errors, the event decoders of pkg/events are listed,
+// Reader/Writer/Events interfaces and an in-memory fake are generated per
+// binding, and pkg/bindings/manifest.json records the ABI hash of every
+// binding. Binding packages without a matching source are reported as errors,
+// or deleted with -prune.
+//
+// With -check nothing is written: the committed bindings are compared against
+// the sources (and, when out/ exists, against freshly generated bindings) and
+// any drift is reported with a non-zero exit status.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/internal/bindgen"
+)
+
+func main() {
+	var cfg bindgen.Config
+	flag.StringVar(&cfg.ContractsDir, "contracts", "./src/contracts", "directory holding the Solidity sources")
+	flag.StringVar(&cfg.OutDir, "out", "./out", "forge build output directory")
+	flag.StringVar(&cfg.BindingsDir, "bindings", "./pkg/bindings", "directory holding the generated binding packages")
+	flag.StringVar(&cfg.TypesDir, "types", "./pkg/types", "directory of the shared types package")
+	flag.StringVar(&cfg.ErrorsDir, "errors", "./pkg/errors", "directory of the custom error registry package")
+	flag.StringVar(&cfg.EventsDir, "events", "./pkg/events", "directory of the event registry package")
+	flag.BoolVar(&cfg.Prune, "prune", false, "delete binding packages that have no matching source")
+	check := flag.Bool("check", false, "report drift between sources, artifacts and bindings without writing")
+	flag.Parse()
+
+	run := bindgen.Generate
+	if *check {
+		run = bindgen.Check
+	}
+	if err := run(cfg); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,111 @@
+// Command rewardsclaim builds the RewardsMerkleClaim of an earner from a
+// distribution file and prints it as JSON.
+//
+// The distribution file is a JSON array of {"earner", "token",
+// "cumulativeAmount"} rows, the table the distribution root was built from.
+// The root is looked up on the RewardsCoordinator given with
+// -rewards-coordinator, or taken from the -deployment config, and tokens the
+// earner has already claimed in full are left out. The claim is printed in
+// the shape of RewardsMerkleClaim, with what each token leaf pays in a
+// separate "amounts" array:
+//
+//	rewardsclaim -rpc $RPC_URL -deployment script/configs/mainnet.json \
+//		-distribution distribution.json -earner 0x... -tokens 0x...,0x...
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deployment"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewardsclaim"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewardstree"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+func main() {
+	rpc := flag.String("rpc", "", "JSON-RPC endpoint")
+	deploymentPath := flag.String("deployment", "", "deployment config to take the RewardsCoordinator from")
+	coordinator := flag.String("rewards-coordinator", "", "RewardsCoordinator address, overriding -deployment")
+	distribution := flag.String("distribution", "", "distribution file")
+	earner := flag.String("earner", "", "earner to claim for")
+	tokens := flag.String("tokens", "", "comma-separated tokens to claim; all of the earner's tokens if empty")
+	flag.Parse()
+
+	if err := run(*rpc, *deploymentPath, *coordinator, *distribution, *earner, *tokens); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(rpc, deploymentPath, coordinator, distribution, earner, tokens string) error {
+	if rpc == "" || distribution == "" || !common.IsHexAddress(earner) {
+		return errors.New("-rpc, -distribution and a valid -earner are required")
+	}
+	address, err := rewardsCoordinatorAddress(deploymentPath, coordinator)
+	if err != nil {
+		return err
+	}
+	var claimed []common.Address
+	if tokens != "" {
+		for _, token := range strings.Split(tokens, ",") {
+			if !common.IsHexAddress(token) {
+				return fmt.Errorf("invalid token %q", token)
+			}
+			claimed = append(claimed, common.HexToAddress(token))
+		}
+	}
+
+	d, err := rewardsclaim.LoadDistribution(distribution)
+	if err != nil {
+		return err
+	}
+	tree, err := rewardstree.Build(d)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	client, err := ethclient.DialContext(ctx, rpc)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	rc, err := rewardscoordinator.NewRewardsCoordinatorCaller(address, client)
+	if err != nil {
+		return err
+	}
+	claim, err := rewardsclaim.Build(ctx, rc, tree, common.HexToAddress(earner), claimed)
+	if err != nil {
+		return err
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(claim)
+}
+
+func rewardsCoordinatorAddress(deploymentPath, coordinator string) (common.Address, error) {
+	if coordinator != "" {
+		if !common.IsHexAddress(coordinator) {
+			return common.Address{}, fmt.Errorf("invalid -rewards-coordinator %q", coordinator)
+		}
+		return common.HexToAddress(coordinator), nil
+	}
+	if deploymentPath == "" {
+		return common.Address{}, errors.New("one of -deployment and -rewards-coordinator is required")
+	}
+	d, err := deployment.Load(deploymentPath)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if d.Core.RewardsCoordinator.Proxy == (common.Address{}) {
+		return common.Address{}, fmt.Errorf("%s has no RewardsCoordinator", deploymentPath)
+	}
+	return d.Core.RewardsCoordinator.Proxy, nil
+}
@@ -0,0 +1,87 @@
+package bindgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Source is a Solidity file under the contracts directory that gets a binding.
+type Source struct {
+	// Name is the contract name, taken from the file name.
+	Name string
+	// Path is the path of the .sol file.
+	Path string
+}
+
+// Artifact is the subset of a forge build artifact (out/<File>.sol/<Name>.json)
+// needed to generate a binding.
+type Artifact struct {
+	ABI      json.RawMessage `json:"abi"`
+	Bytecode struct {
+		Object string `json:"object"`
+	} `json:"bytecode"`
+}
+
+// Sources lists the .sol files under contractsDir. Bindings are named after the
+// file, so two files with the same name are an error.
+func Sources(contractsDir string) ([]Source, error) {
+	seen := map[string]string{}
+	var sources []Source
+	err := filepath.WalkDir(contractsDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || filepath.Ext(path) != ".sol" {
+			return nil
+		}
+		name := strings.TrimSuffix(filepath.Base(path), ".sol")
+		if prev, ok := seen[name]; ok {
+			return fmt.Errorf("contract name %s is used by both %s and %s", name, prev, path)
+		}
+		seen[name] = path
+		sources = append(sources, Source{Name: name, Path: path})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sources, func(i, j int) bool { return sources[i].Name < sources[j].Name })
+	return sources, nil
+}
+
+// LoadArtifact reads the forge artifact of a contract from outDir.
+func LoadArtifact(outDir, name string) (*Artifact, error) {
+	path := filepath.Join(outDir, name+".sol", name+".json")
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read artifact: %w", err)
+	}
+	var a Artifact
+	if err := json.Unmarshal(raw, &a); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if len(a.ABI) == 0 {
+		return nil, fmt.Errorf("%s has no ABI", path)
+	}
+	if strings.Contains(a.Bytecode.Object, "__$") {
+		return nil, fmt.Errorf("%s has unlinked library references", path)
+	}
+	return &a, nil
+}
+
+// stripABI removes all whitespace from an ABI, which is the form abigen embeds
+// in <Contract>MetaData.ABI.
+func stripABI(abi string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) {
+			return -1
+		}
+		return r
+	}, abi)
+}
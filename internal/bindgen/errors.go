@@ -0,0 +1,357 @@
+package bindgen
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	bindingsImport     = "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings"
+	errorsFile         = "errors.go"
+	errorRegistryFile  = "contracts.go"
+	generatedHeader    = "// Code generated by bindgen - DO NOT EDIT.\n"
+	errorTypePrefix    = "Err"
+	errorMapNameSuffix = "Errors"
+)
+
+// Errors generates a typed Go error for every custom error declared in the ABI
+// of each binding under bindingsDir, and writes the list of contracts with
+// custom errors to the registry package in registryDir.
+func Errors(bindingsDir, registryDir string) error {
+	pkgs, err := bindingPackages(bindingsDir)
+	if err != nil {
+		return err
+	}
+
+	var withErrors []string
+	for _, pkg := range pkgs {
+		src, err := os.ReadFile(filepath.Join(bindingsDir, pkg, bindingFile))
+		if err != nil {
+			return err
+		}
+		name, raw, parsed, err := bindingRawABI(src)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pkg, err)
+		}
+		internalTypes, err := errorInternalTypes(raw)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pkg, err)
+		}
+
+		path := filepath.Join(bindingsDir, pkg, errorsFile)
+		if len(parsed.Errors) == 0 {
+			if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+			continue
+		}
+		out, err := errorsSource(name, parsed, internalTypes)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pkg, err)
+		}
+		if err := writeIfChanged(path, out); err != nil {
+			return err
+		}
+		withErrors = append(withErrors, name)
+	}
+
+	out, err := errorRegistrySource(withErrors)
+	if err != nil {
+		return err
+	}
+	return writeIfChanged(filepath.Join(registryDir, errorRegistryFile), out)
+}
+
+// bindingABI extracts the package name and the parsed ABI from the
+// <Contract>MetaData variable of a binding.
+func bindingABI(src []byte) (string, abi.ABI, error) {
+	name, _, parsed, err := bindingRawABI(src)
+	return name, parsed, err
+}
+
+// bindingRawABI is like bindingABI but also returns the ABI JSON.
+func bindingRawABI(src []byte) (string, string, abi.ABI, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return "", "", abi.ABI{}, err
+	}
+	name := file.Name.Name
+	meta := typePrefix(name) + "MetaData"
+
+	var raw string
+	ast.Inspect(file, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != meta || len(spec.Values) != 1 {
+			return true
+		}
+		unary, ok := spec.Values[0].(*ast.UnaryExpr)
+		if !ok {
+			return false
+		}
+		lit, ok := unary.X.(*ast.CompositeLit)
+		if !ok {
+			return false
+		}
+		for _, elt := range lit.Elts {
+			kv, ok := elt.(*ast.KeyValueExpr)
+			if !ok {
+				continue
+			}
+			if key, ok := kv.Key.(*ast.Ident); ok && key.Name == "ABI" {
+				if v, ok := kv.Value.(*ast.BasicLit); ok {
+					raw, _ = strconv.Unquote(v.Value)
+				}
+			}
+		}
+		return false
+	})
+	if raw == "" {
+		return "", "", abi.ABI{}, fmt.Errorf("no ABI found in %s", meta)
+	}
+	parsed, err := abi.JSON(strings.NewReader(raw))
+	if err != nil {
+		return "", "", abi.ABI{}, fmt.Errorf("parse %s.ABI: %w", meta, err)
+	}
+	return name, raw, parsed, nil
+}
+
+// errorInternalTypes returns the Solidity internalType of every custom error
+// parameter, keyed by error name. The embedded ABIs are minified, which strips
+// the space from "struct Foo" and leaves abi.Type.TupleRawName empty, so the
+// struct names have to be recovered from the JSON.
+func errorInternalTypes(raw string) (map[string][]string, error) {
+	var entries []struct {
+		Type   string `json:"type"`
+		Name   string `json:"name"`
+		Inputs []struct {
+			InternalType string `json:"internalType"`
+		} `json:"inputs"`
+	}
+	if err := json.Unmarshal([]byte(raw), &entries); err != nil {
+		return nil, err
+	}
+	out := map[string][]string{}
+	for _, e := range entries {
+		if e.Type != "error" {
+			continue
+		}
+		types := make([]string, len(e.Inputs))
+		for i, input := range e.Inputs {
+			types[i] = input.InternalType
+		}
+		out[e.Name] = types
+	}
+	return out, nil
+}
+
+// errorsSource renders the typed errors of a single contract.
+func errorsSource(contract string, parsed abi.ABI, internalTypes map[string][]string) ([]byte, error) {
+	names := make([]string, 0, len(parsed.Errors))
+	for name := range parsed.Errors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	imports := map[string]bool{}
+	var body bytes.Buffer
+	for _, name := range names {
+		e := parsed.Errors[name]
+		typeName := errorTypePrefix + abi.ToCamelCase(name)
+		fields := errorFieldNames(e)
+
+		fmt.Fprintf(&body, "\n// %s is the typed form of the %s custom error %s.\n", typeName, contract, e.Sig)
+		if len(e.Inputs) == 0 {
+			fmt.Fprintf(&body, "type %s struct{}\n\n", typeName)
+		} else {
+			fmt.Fprintf(&body, "type %s struct {\n", typeName)
+			for i, input := range e.Inputs {
+				var internalType string
+				if i < len(internalTypes[e.Name]) {
+					internalType = internalTypes[e.Name][i]
+				}
+				goType, err := goTypeOf(input.Type, internalType, imports)
+				if err != nil {
+					return nil, fmt.Errorf("error %s: %w", name, err)
+				}
+				fmt.Fprintf(&body, "\t%s %s\n", fields[i], goType)
+			}
+			body.WriteString("}\n\n")
+		}
+
+		fmt.Fprintf(&body, "// Error implements the error interface.\n")
+		if len(e.Inputs) == 0 {
+			fmt.Fprintf(&body, "func (%s) Error() string {\n\treturn %q\n}\n", typeName, contract+": "+e.Name+"()")
+			continue
+		}
+		imports["fmt"] = true
+		var (
+			verbs []string
+			args  []string
+		)
+		for i, input := range e.Inputs {
+			label := input.Name
+			if label == "" {
+				label = fields[i]
+			}
+			verbs = append(verbs, label+": %v")
+			args = append(args, "e."+fields[i])
+		}
+		fmt.Fprintf(&body, "func (e %s) Error() string {\n\treturn fmt.Sprintf(%q, %s)\n}\n",
+			typeName, contract+": "+e.Name+"("+strings.Join(verbs, ", ")+")", strings.Join(args, ", "))
+	}
+
+	mapName := typePrefix(contract) + errorMapNameSuffix
+	fmt.Fprintf(&body, "\n// %s maps the name of every custom error in the %s ABI to its typed Go error.\n", mapName, contract)
+	fmt.Fprintf(&body, "var %s = map[string]error{\n", mapName)
+	for _, name := range names {
+		fmt.Fprintf(&body, "\t%q: %s%s{},\n", name, errorTypePrefix, abi.ToCamelCase(name))
+	}
+	body.WriteString("}\n")
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "\npackage %s\n", contract)
+	writeImports(&buf, imports)
+	buf.Write(body.Bytes())
+	return format.Source(buf.Bytes())
+}
+
+// errorRegistrySource renders the list of contracts the registry is built from.
+func errorRegistrySource(contracts []string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	buf.WriteString("\npackage errors\n\nimport (\n")
+	for _, c := range contracts {
+		fmt.Fprintf(&buf, "\t%s %q\n", importAlias(c), bindingsImport+"/"+c)
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("// contracts lists every binding whose ABI declares custom errors.\n")
+	buf.WriteString("var contracts = []Contract{\n")
+	for _, c := range contracts {
+		alias, prefix := importAlias(c), typePrefix(c)
+		fmt.Fprintf(&buf, "\t{Name: %q, MetaData: %s.%sMetaData, Errors: %s.%s%s},\n", c, alias, prefix, alias, prefix, errorMapNameSuffix)
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
+
+// typePrefix is the prefix abigen gives the identifiers of a binding package,
+// which drops any underscores from the contract name.
+func typePrefix(pkg string) string {
+	return abi.ToCamelCase(pkg)
+}
+
+// importAlias is the lower-case name binding packages are imported under.
+func importAlias(pkg string) string {
+	return strings.ToLower(typePrefix(pkg))
+}
+
+// errorFieldNames returns the Go field names of a custom error's typed struct,
+// in ABI order. Unnamed parameters are called Arg0, Arg1, ...
+func errorFieldNames(e abi.Error) []string {
+	names := make([]string, len(e.Inputs))
+	for i, input := range e.Inputs {
+		if input.Name == "" {
+			names[i] = fmt.Sprintf("Arg%d", i)
+		} else {
+			names[i] = abi.ToCamelCase(input.Name)
+		}
+	}
+	return names
+}
+
+// goTypeOf returns the Go type abigen uses for an ABI type, recording the
+// imports it needs. internalType is the Solidity type from the ABI JSON and is
+// used to name struct parameters.
+func goTypeOf(t abi.Type, internalType string, imports map[string]bool) (string, error) {
+	switch t.T {
+	case abi.AddressTy:
+		imports["github.com/ethereum/go-ethereum/common"] = true
+		return "common.Address", nil
+	case abi.BoolTy:
+		return "bool", nil
+	case abi.StringTy:
+		return "string", nil
+	case abi.BytesTy:
+		return "[]byte", nil
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", t.Size), nil
+	case abi.HashTy:
+		return "[32]byte", nil
+	case abi.IntTy, abi.UintTy:
+		switch t.Size {
+		case 8, 16, 32, 64:
+			if t.T == abi.IntTy {
+				return fmt.Sprintf("int%d", t.Size), nil
+			}
+			return fmt.Sprintf("uint%d", t.Size), nil
+		}
+		imports["math/big"] = true
+		return "*big.Int", nil
+	case abi.SliceTy:
+		elem, err := goTypeOf(*t.Elem, elemInternalType(internalType), imports)
+		return "[]" + elem, err
+	case abi.ArrayTy:
+		elem, err := goTypeOf(*t.Elem, elemInternalType(internalType), imports)
+		return fmt.Sprintf("[%d]%s", t.Size, elem), err
+	case abi.TupleTy:
+		name := t.TupleRawName
+		if name == "" {
+			name = strings.TrimSpace(strings.TrimPrefix(internalType, "struct"))
+			name = strings.ReplaceAll(name, ".", "")
+		}
+		if name == "" {
+			return "", fmt.Errorf("anonymous tuple %s", t.String())
+		}
+		// Struct bindings are declared (or aliased) in the same package.
+		return name, nil
+	}
+	return "", fmt.Errorf("unsupported type %s", t.String())
+}
+
+// elemInternalType strips the outermost array suffix from a Solidity type.
+func elemInternalType(internalType string) string {
+	if i := strings.LastIndex(internalType, "["); i >= 0 {
+		return internalType[:i]
+	}
+	return internalType
+}
+
+func writeImports(buf *bytes.Buffer, imports map[string]bool) {
+	if len(imports) == 0 {
+		return
+	}
+	paths := make([]string, 0, len(imports))
+	for p := range imports {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	buf.WriteString("\nimport (\n")
+	for _, p := range paths {
+		fmt.Fprintf(buf, "\t%q\n", p)
+	}
+	buf.WriteString(")\n")
+}
+
+func writeIfChanged(path string, out []byte) error {
+	if old, err := os.ReadFile(path); err == nil && bytes.Equal(old, out) {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, out, 0o644)
+}
@@ -0,0 +1,61 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+)
+
+const eventRegistryFile = "contracts.go"
+
+// Events writes the list of bindings declaring events to the event registry
+// package in registryDir.
+func Events(bindingsDir, registryDir string) error {
+	pkgs, err := bindingPackages(bindingsDir)
+	if err != nil {
+		return err
+	}
+
+	var withEvents []string
+	for _, pkg := range pkgs {
+		src, err := os.ReadFile(filepath.Join(bindingsDir, pkg, bindingFile))
+		if err != nil {
+			return err
+		}
+		name, parsed, err := bindingABI(src)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pkg, err)
+		}
+		if len(parsed.Events) > 0 {
+			withEvents = append(withEvents, name)
+		}
+	}
+
+	out, err := eventRegistrySource(withEvents)
+	if err != nil {
+		return err
+	}
+	return writeIfChanged(filepath.Join(registryDir, eventRegistryFile), out)
+}
+
+// eventRegistrySource renders the list of contracts the event registry is
+// built from.
+func eventRegistrySource(contracts []string) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	buf.WriteString("\npackage events\n\nimport (\n")
+	for _, c := range contracts {
+		fmt.Fprintf(&buf, "\t%s %q\n", importAlias(c), bindingsImport+"/"+c)
+	}
+	buf.WriteString(")\n\n")
+	buf.WriteString("// contracts lists every binding whose ABI declares events.\n")
+	buf.WriteString("var contracts = []Contract{\n")
+	for _, c := range contracts {
+		alias, prefix := importAlias(c), typePrefix(c)
+		fmt.Fprintf(&buf, "\t{Name: %q, MetaData: %s.%sMetaData, Filterer: filterer(%s.New%sFilterer)},\n", c, alias, prefix, alias, prefix)
+	}
+	buf.WriteString("}\n")
+	return format.Source(buf.Bytes())
+}
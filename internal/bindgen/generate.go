@@ -0,0 +1,236 @@
+package bindgen
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Config locates the inputs and outputs of the binding generator.
+type Config struct {
+	// ContractsDir holds the Solidity sources; one binding is generated per file.
+	ContractsDir string
+	// OutDir is the forge build output directory.
+	OutDir string
+	// BindingsDir receives one package per contract.
+	BindingsDir string
+	// TypesDir is the shared struct package.
+	TypesDir string
+	// ErrorsDir is the custom error registry package.
+	ErrorsDir string
+	// EventsDir is the event registry package.
+	EventsDir string
+	// Prune deletes binding packages that have no matching source. When false,
+	// orphaned packages are reported as errors.
+	Prune bool
+}
+
+// Generate regenerates every binding from the forge artifacts, applies the
+// shared struct, typed error, event registry and interface post-processing,
+// and writes the manifest.
+// Every failure is reported; nothing is post-processed if any binding fails.
+func Generate(cfg Config) error {
+	sources, err := Sources(cfg.ContractsDir)
+	if err != nil {
+		return err
+	}
+
+	var (
+		errs     []error
+		manifest = Manifest{}
+	)
+	for _, s := range sources {
+		code, hash, err := generateBinding(cfg.OutDir, s.Name)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", s.Name, err))
+			continue
+		}
+		if err := writeIfChanged(filepath.Join(cfg.BindingsDir, s.Name, bindingFile), []byte(code)); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		manifest[s.Name] = ManifestEntry{Source: filepath.ToSlash(s.Path), ABIHash: hash}
+	}
+	if err := pruneOrphans(cfg.BindingsDir, sources, cfg.Prune); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	if err := SharedTypes(cfg.BindingsDir, cfg.TypesDir); err != nil {
+		return err
+	}
+	if err := Errors(cfg.BindingsDir, cfg.ErrorsDir); err != nil {
+		return err
+	}
+	if err := Events(cfg.BindingsDir, cfg.EventsDir); err != nil {
+		return err
+	}
+	if err := Interfaces(cfg.BindingsDir); err != nil {
+		return err
+	}
+	return manifest.Write(cfg.BindingsDir)
+}
+
+// Check reports drift between the sources, the build artifacts and the
+// committed bindings without modifying the tree. If OutDir exists the bindings
+// are regenerated into a scratch copy and compared file by file; otherwise only
+// the manifest and the source/binding correspondence are verified.
+func Check(cfg Config) error {
+	sources, err := Sources(cfg.ContractsDir)
+	if err != nil {
+		return err
+	}
+	manifest, err := ReadManifest(cfg.BindingsDir)
+	if err != nil {
+		return err
+	}
+	if errs := manifest.Verify(cfg.BindingsDir, sources); len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+	if _, err := os.Stat(cfg.OutDir); os.IsNotExist(err) {
+		return nil
+	}
+
+	scratch, err := os.MkdirTemp("", "bindgen")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(scratch)
+
+	dirs := map[string]string{}
+	for _, dir := range []string{cfg.BindingsDir, cfg.TypesDir, cfg.ErrorsDir, cfg.EventsDir} {
+		dst := filepath.Join(scratch, fmt.Sprint(len(dirs)))
+		if err := copyDir(dir, dst); err != nil {
+			return err
+		}
+		dirs[dir] = dst
+	}
+	regen := cfg
+	regen.BindingsDir, regen.TypesDir = dirs[cfg.BindingsDir], dirs[cfg.TypesDir]
+	regen.ErrorsDir, regen.EventsDir = dirs[cfg.ErrorsDir], dirs[cfg.EventsDir]
+	regen.Prune = false
+	if err := Generate(regen); err != nil {
+		return err
+	}
+
+	var errs []error
+	for dir, dst := range dirs {
+		diffs, err := diffDirs(dir, dst)
+		if err != nil {
+			return err
+		}
+		for _, d := range diffs {
+			errs = append(errs, fmt.Errorf("%s is stale, regenerate the bindings", filepath.Join(dir, d)))
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errors.Join(errs...)
+}
+
+// generateBinding runs abigen on a contract's artifact and returns the Go
+// source together with the ABI hash.
+func generateBinding(outDir, name string) (string, string, error) {
+	artifact, err := LoadArtifact(outDir, name)
+	if err != nil {
+		return "", "", err
+	}
+	abi := string(artifact.ABI)
+	code, err := bind.Bind(
+		[]string{name},
+		[]string{abi},
+		[]string{artifact.Bytecode.Object},
+		nil,
+		name,
+		bind.LangGo,
+		map[string]string{},
+		map[string]string{},
+	)
+	if err != nil {
+		return "", "", fmt.Errorf("abigen: %w", err)
+	}
+	return code, ABIHash(abi), nil
+}
+
+// pruneOrphans removes (or, without prune, reports) binding packages that no
+// longer have a source.
+func pruneOrphans(bindingsDir string, sources []Source, prune bool) error {
+	pkgs, err := bindingPackages(bindingsDir)
+	if err != nil {
+		return err
+	}
+	want := map[string]bool{}
+	for _, s := range sources {
+		want[s.Name] = true
+	}
+	var errs []error
+	for _, pkg := range pkgs {
+		if want[pkg] {
+			continue
+		}
+		if !prune {
+			errs = append(errs, fmt.Errorf("%s: orphaned binding, no matching source (rerun with -prune to delete it)", pkg))
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(bindingsDir, pkg)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func copyDir(src, dst string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(dst, rel)
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, data, 0o644)
+	})
+}
+
+// diffDirs returns the relative paths that differ between two directory trees.
+func diffDirs(a, b string) ([]string, error) {
+	files := map[string]bool{}
+	for _, root := range []string{a, b} {
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(root, path)
+			files[rel] = true
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	var diffs []string
+	for rel := range files {
+		x, errA := os.ReadFile(filepath.Join(a, rel))
+		y, errB := os.ReadFile(filepath.Join(b, rel))
+		if errA != nil || errB != nil || !bytes.Equal(x, y) {
+			diffs = append(diffs, rel)
+		}
+	}
+	sort.Strings(diffs)
+	return diffs, nil
+}
@@ -0,0 +1,133 @@
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testArtifactABI = `[
+  {
+    "type": "function",
+    "name": "isMember",
+    "inputs": [
+      {
+        "name": "operatorSet",
+        "type": "tuple",
+        "internalType": "struct OperatorSet",
+        "components": [
+          { "name": "avs", "type": "address", "internalType": "address" },
+          { "name": "id", "type": "uint32", "internalType": "uint32" }
+        ]
+      }
+    ],
+    "outputs": [{ "name": "", "type": "bool", "internalType": "bool" }],
+    "stateMutability": "view"
+  },
+  { "type": "error", "name": "NotMember", "inputs": [] }
+]`
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func testConfig(t *testing.T) Config {
+	t.Helper()
+	root := t.TempDir()
+	cfg := Config{
+		ContractsDir: filepath.Join(root, "src"),
+		OutDir:       filepath.Join(root, "out"),
+		BindingsDir:  filepath.Join(root, "bindings"),
+		TypesDir:     filepath.Join(root, "types"),
+		ErrorsDir:    filepath.Join(root, "errors"),
+		EventsDir:    filepath.Join(root, "events"),
+	}
+	writeFile(t, filepath.Join(cfg.ContractsDir, "core", "Foo.sol"), "contract Foo {}")
+	writeFile(t, filepath.Join(cfg.OutDir, "Foo.sol", "Foo.json"),
+		`{"abi": `+testArtifactABI+`, "bytecode": {"object": "0x6080"}}`)
+	return cfg
+}
+
+func TestGenerateReportsOrphans(t *testing.T) {
+	cfg := testConfig(t)
+	writeFile(t, filepath.Join(cfg.BindingsDir, "Stale", bindingFile), "package Stale\n")
+
+	err := Generate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "Stale: orphaned binding") {
+		t.Fatalf("Expected orphaned binding error, got %v", err)
+	}
+
+	cfg.Prune = true
+	if err := Generate(cfg); err != nil {
+		t.Fatalf("Generate with prune failed: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(cfg.BindingsDir, "Stale")); !os.IsNotExist(err) {
+		t.Errorf("Expected orphaned package to be deleted")
+	}
+}
+
+func TestGenerateAndCheck(t *testing.T) {
+	cfg := testConfig(t)
+	if err := Generate(cfg); err != nil {
+		t.Fatalf("Generate failed: %v", err)
+	}
+
+	binding, err := os.ReadFile(filepath.Join(cfg.BindingsDir, "Foo", bindingFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(binding), "type OperatorSet = eltypes.OperatorSet") {
+		t.Errorf("Expected OperatorSet to be aliased to the shared type")
+	}
+	if _, err := os.Stat(filepath.Join(cfg.BindingsDir, "Foo", errorsFile)); err != nil {
+		t.Errorf("Expected typed errors to be generated: %v", err)
+	}
+	interfaces, err := os.ReadFile(filepath.Join(cfg.BindingsDir, "Foo", interfacesFile))
+	if err != nil || !strings.Contains(string(interfaces), "IsMember(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)") {
+		t.Errorf("Expected FooReader to declare IsMember, got %v", err)
+	}
+	fake, err := os.ReadFile(filepath.Join(cfg.BindingsDir, "Foo", fakeFile))
+	if err != nil || !strings.Contains(string(fake), `Contract.Stub("isMember", []interface{}{operatorSet}, out0)`) {
+		t.Errorf("Expected FakeFoo to stub isMember, got %v", err)
+	}
+
+	manifest, err := ReadManifest(cfg.BindingsDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := manifest["Foo"]; got.ABIHash != ABIHash(testArtifactABI) || !strings.HasSuffix(got.Source, "core/Foo.sol") {
+		t.Errorf("Unexpected manifest entry %+v", got)
+	}
+
+	if err := Check(cfg); err != nil {
+		t.Fatalf("Expected freshly generated bindings to pass the check, got %v", err)
+	}
+
+	// Changing the contract's ABI without regenerating must fail the check.
+	writeFile(t, filepath.Join(cfg.OutDir, "Foo.sol", "Foo.json"),
+		`{"abi": `+strings.Replace(testArtifactABI, "NotMember", "NotAMember", 1)+`, "bytecode": {"object": "0x6080"}}`)
+	err = Check(cfg)
+	if err == nil || !strings.Contains(err.Error(), "stale") {
+		t.Fatalf("Expected stale binding error, got %v", err)
+	}
+}
+
+func TestGenerateMissingArtifact(t *testing.T) {
+	cfg := testConfig(t)
+	writeFile(t, filepath.Join(cfg.ContractsDir, "Bar.sol"), "contract Bar {}")
+
+	err := Generate(cfg)
+	if err == nil || !strings.Contains(err.Error(), "Bar: read artifact") {
+		t.Fatalf("Expected missing artifact error, got %v", err)
+	}
+	if _, statErr := os.Stat(filepath.Join(cfg.TypesDir, sharedTypesFile)); !os.IsNotExist(statErr) {
+		t.Errorf("Expected no post-processing after a failed binding")
+	}
+}
@@ -0,0 +1,387 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	interfacesFile = "interfaces.go"
+	fakeFile       = "fake.go"
+	fakeImport     = "github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+)
+
+// surface is one of the method sets abigen generates for a contract.
+type surface int
+
+const (
+	reader surface = iota
+	writer
+	events
+)
+
+// bindingMethod is an exported method of a binding's Caller, Transactor or
+// Filterer.
+type bindingMethod struct {
+	surface surface
+	name    string
+	// abiName is the ABI method or event the binding calls, e.g.
+	// "getMaxMagnitudes0", "fallback", "receive" or "AllocationUpdated".
+	abiName string
+	params  []param // excluding opts
+	results []string
+	// signature is the method's parameters and results as declared.
+	signature string
+}
+
+type param struct {
+	name, typ string
+}
+
+// Interfaces generates, for every binding under bindingsDir, the Reader,
+// Writer and Events interfaces satisfied by its Caller, Transactor and
+// Filterer, and an in-memory Fake<Contract> implementing all of them on top
+// of pkg/fake.
+func Interfaces(bindingsDir string) error {
+	pkgs, err := bindingPackages(bindingsDir)
+	if err != nil {
+		return err
+	}
+	for _, pkg := range pkgs {
+		src, err := os.ReadFile(filepath.Join(bindingsDir, pkg, bindingFile))
+		if err != nil {
+			return err
+		}
+		methods, imports, err := bindingMethods(src)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pkg, err)
+		}
+
+		files := map[string]func(string, []bindingMethod, map[string]string) ([]byte, error){
+			interfacesFile: interfacesSource,
+			fakeFile:       fakeSource,
+		}
+		for file, render := range files {
+			path := filepath.Join(bindingsDir, pkg, file)
+			if len(methods) == 0 {
+				if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+					return err
+				}
+				continue
+			}
+			out, err := render(pkg, methods, imports)
+			if err != nil {
+				return fmt.Errorf("%s: %s: %w", pkg, file, err)
+			}
+			if err := writeIfChanged(path, out); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bindingMethods extracts the Caller, Transactor and Filterer methods of a
+// binding, together with the binding's imports keyed by package name.
+func bindingMethods(src []byte) ([]bindingMethod, map[string]string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	prefix := typePrefix(file.Name.Name)
+	receivers := map[string]surface{
+		prefix + "Caller":     reader,
+		prefix + "Transactor": writer,
+		prefix + "Filterer":   events,
+	}
+
+	imports := map[string]string{}
+	for _, imp := range file.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		name := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			name = imp.Name.Name
+		}
+		imports[name] = path
+	}
+
+	var methods []bindingMethod
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || !fn.Name.IsExported() {
+			continue
+		}
+		star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+		recv, ok := star.X.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		s, ok := receivers[recv.Name]
+		if !ok {
+			continue
+		}
+
+		m := bindingMethod{surface: s, name: fn.Name.Name, abiName: abiName(fn)}
+		if m.signature, err = render(fset, fn.Type); err != nil {
+			return nil, nil, err
+		}
+		m.signature = strings.TrimPrefix(m.signature, "func")
+		for i, field := range fn.Type.Params.List {
+			typ, err := render(fset, field.Type)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, name := range field.Names {
+				if i == 0 && s != events {
+					continue // opts
+				}
+				m.params = append(m.params, param{name: name.Name, typ: typ})
+			}
+		}
+		for _, field := range fn.Type.Results.List {
+			typ, err := render(fset, field.Type)
+			if err != nil {
+				return nil, nil, err
+			}
+			if typ != "error" {
+				m.results = append(m.results, typ)
+			}
+		}
+		if s != events && m.abiName == "" {
+			return nil, nil, fmt.Errorf("cannot tell which ABI method %s.%s calls", recv.Name, m.name)
+		}
+		methods = append(methods, m)
+	}
+	return methods, imports, nil
+}
+
+// abiName returns the ABI method or event name a binding method passes to its
+// bound contract.
+func abiName(fn *ast.FuncDecl) string {
+	var name string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || name != "" {
+			return name == ""
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		if x, ok := sel.X.(*ast.SelectorExpr); !ok || x.Sel.Name != "contract" {
+			return true
+		}
+		arg := -1
+		switch sel.Sel.Name {
+		case "Call":
+			arg = 2
+		case "Transact", "UnpackLog":
+			arg = 1
+		case "RawTransact":
+			if id, ok := call.Args[1].(*ast.Ident); ok && id.Name == "nil" {
+				name = "receive"
+			} else {
+				name = "fallback"
+			}
+			return false
+		}
+		if arg < 0 || arg >= len(call.Args) {
+			return true
+		}
+		if lit, ok := call.Args[arg].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+			name, _ = strconv.Unquote(lit.Value)
+		}
+		return false
+	})
+	return name
+}
+
+// interfacesSource renders the Reader, Writer and Events interfaces of a
+// binding.
+func interfacesSource(pkg string, methods []bindingMethod, imports map[string]string) ([]byte, error) {
+	prefix := typePrefix(pkg)
+	used := map[string]bool{}
+	var body bytes.Buffer
+
+	surfaces := []struct {
+		s        surface
+		name     string
+		concrete string
+		doc      string
+	}{
+		{reader, prefix + "Reader", prefix + "Caller", "read-only"},
+		{writer, prefix + "Writer", prefix + "Transactor", "transacting"},
+		{events, prefix + "Events", prefix + "Filterer", "event filtering"},
+	}
+	var asserts []string
+	for _, sf := range surfaces {
+		var decls []string
+		for _, m := range methods {
+			if m.surface == sf.s {
+				decls = append(decls, m.name+m.signature)
+				usedPackages(m.signature, imports, used)
+			}
+		}
+		if len(decls) == 0 {
+			continue
+		}
+		fmt.Fprintf(&body, "\n// %s is the %s surface of the %s\n// binding, implemented by %s, %s and Fake%s.\n", sf.name, sf.doc, pkg, sf.concrete, prefix, prefix)
+		fmt.Fprintf(&body, "type %s interface {\n", sf.name)
+		for _, d := range decls {
+			fmt.Fprintf(&body, "\t%s\n", d)
+		}
+		body.WriteString("}\n")
+		for _, impl := range []string{sf.concrete, prefix, "Fake" + prefix} {
+			asserts = append(asserts, fmt.Sprintf("_ %s = (*%s)(nil)", sf.name, impl))
+		}
+	}
+	body.WriteString("\nvar (\n")
+	for _, a := range asserts {
+		fmt.Fprintf(&body, "\t%s\n", a)
+	}
+	body.WriteString(")\n")
+
+	return goSource(pkg, used, body.Bytes())
+}
+
+// fakeSource renders the Fake<Contract> of a binding.
+func fakeSource(pkg string, methods []bindingMethod, imports map[string]string) ([]byte, error) {
+	prefix := typePrefix(pkg)
+	fake := "Fake" + prefix
+	recv := "_" + fake
+	used := map[string]bool{fakeImport: true, imports["common"]: true}
+	var body bytes.Buffer
+
+	fmt.Fprintf(&body, `
+// %[1]s is an in-memory %[2]s for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded %[2]sFilterer.
+type %[1]s struct {
+	*fake.Contract
+	*%[2]sFilterer
+}
+
+// New%[1]s returns an empty fake of the contract at address.
+func New%[1]s(address common.Address) *%[1]s {
+	contract := fake.NewContract(%[2]sMetaData, address)
+	filterer, err := New%[2]sFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &%[1]s{Contract: contract, %[2]sFilterer: filterer}
+}
+`, fake, prefix)
+
+	for _, m := range methods {
+		switch m.surface {
+		case reader:
+			usedPackages(m.signature, imports, used)
+			writeFakeRead(&body, recv, fake, m)
+		case writer:
+			usedPackages(m.signature, imports, used)
+			writeFakeWrite(&body, recv, fake, m)
+		case events:
+			if strings.HasPrefix(m.name, "Parse") && len(m.results) == 1 {
+				fmt.Fprintf(&body, "\n// Emit%[1]s appends the event to the fake's logs.\nfunc (%[2]s *%[3]s) Emit%[1]s(event %[4]s) {\n\t%[2]s.Contract.Emit(%[5]q, event)\n}\n",
+					strings.TrimPrefix(m.name, "Parse"), recv, fake, m.results[0], m.abiName)
+			}
+		}
+	}
+	return goSource(pkg, used, body.Bytes())
+}
+
+func writeFakeRead(body *bytes.Buffer, recv, fake string, m bindingMethod) {
+	var outs, vars, ptrs, returns []string
+	for i, typ := range m.results {
+		out := fmt.Sprintf("out%d", i)
+		outs = append(outs, out+" "+typ)
+		vars = append(vars, fmt.Sprintf("var %s %s", out, typ))
+		ptrs = append(ptrs, "&"+out)
+		returns = append(returns, out)
+	}
+	args := argList(m.params)
+
+	fmt.Fprintf(body, "\n// %s returns the values stubbed with Stub%s.\n", m.name, m.name)
+	fmt.Fprintf(body, "func (%s *%s) %s%s {\n", recv, fake, m.name, m.signature)
+	for _, v := range vars {
+		fmt.Fprintf(body, "\t%s\n", v)
+	}
+	fmt.Fprintf(body, "\terr := %s.Contract.Read(%q, %s, %s)\n", recv, m.abiName, args, strings.Join(ptrs, ", "))
+	fmt.Fprintf(body, "\treturn %s\n}\n", strings.Join(append(returns, "err"), ", "))
+
+	fmt.Fprintf(body, "\n// Stub%[1]s sets what %[1]s returns for the given arguments.\n", m.name)
+	fmt.Fprintf(body, "func (%s *%s) Stub%s(%s) {\n", recv, fake, m.name, strings.Join(append(paramDecls(m.params), outs...), ", "))
+	fmt.Fprintf(body, "\t%s.Contract.Stub(%q, %s, %s)\n}\n", recv, m.abiName, args, strings.Join(returns, ", "))
+}
+
+func writeFakeWrite(body *bytes.Buffer, recv, fake string, m bindingMethod) {
+	hook := "func(" + strings.Join(append([]string{"opts *bind.TransactOpts"}, paramDecls(m.params)...), ", ") + ") error"
+	names := []string{"opts"}
+	for _, p := range m.params {
+		names = append(names, p.name)
+	}
+
+	fmt.Fprintf(body, "\n// %[1]s records a %[2]s call after running the hook set with On%[1]s.\n", m.name, m.abiName)
+	fmt.Fprintf(body, "func (%s *%s) %s%s {\n", recv, fake, m.name, m.signature)
+	fmt.Fprintf(body, "\treturn %s.Contract.Transact(opts, %q, func() error {\n", recv, m.abiName)
+	fmt.Fprintf(body, "\t\tif hook, ok := %s.Contract.Hook(%q).(%s); ok {\n", recv, m.abiName, hook)
+	fmt.Fprintf(body, "\t\t\treturn hook(%s)\n\t\t}\n\t\treturn nil\n", strings.Join(names, ", "))
+	if len(m.params) == 0 {
+		body.WriteString("\t})\n}\n")
+	} else {
+		fmt.Fprintf(body, "\t}, %s)\n}\n", strings.Join(names[1:], ", "))
+	}
+
+	fmt.Fprintf(body, "\n// On%[1]s sets a hook run by every %[1]s call, e.g. to update stubbed\n// reads. A non-nil error fails the call.\n", m.name)
+	fmt.Fprintf(body, "func (%s *%s) On%s(hook %s) {\n\t%s.Contract.SetHook(%q, hook)\n}\n", recv, fake, m.name, hook, recv, m.abiName)
+}
+
+func paramDecls(params []param) []string {
+	decls := make([]string, len(params))
+	for i, p := range params {
+		decls[i] = p.name + " " + p.typ
+	}
+	return decls
+}
+
+func argList(params []param) string {
+	names := make([]string, len(params))
+	for i, p := range params {
+		names[i] = p.name
+	}
+	return "[]interface{}{" + strings.Join(names, ", ") + "}"
+}
+
+// usedPackages records the imports referenced by qualified identifiers in a
+// type expression.
+func usedPackages(expr string, imports map[string]string, used map[string]bool) {
+	for name, path := range imports {
+		if regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\.`).MatchString(expr) {
+			used[path] = true
+		}
+	}
+}
+
+// goSource assembles and formats a generated file of a binding package.
+func goSource(pkg string, imports map[string]bool, body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	fmt.Fprintf(&buf, "\npackage %s\n", pkg)
+	writeImports(&buf, imports)
+	buf.Write(body)
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,112 @@
+package bindgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ManifestFile is the name of the manifest written to the bindings directory.
+const ManifestFile = "manifest.json"
+
+// ManifestEntry records where a binding was generated from.
+type ManifestEntry struct {
+	// Source is the Solidity file the binding was generated from.
+	Source string `json:"source"`
+	// ABIHash is the keccak256 hash of the whitespace-stripped ABI.
+	ABIHash string `json:"abiHash"`
+}
+
+// Manifest maps each binding package to its ManifestEntry.
+type Manifest map[string]ManifestEntry
+
+// ABIHash hashes an ABI the same way regardless of its formatting, so the
+// artifact ABI and the one embedded in a binding hash identically.
+func ABIHash(abi string) string {
+	return crypto.Keccak256Hash([]byte(stripABI(abi))).Hex()
+}
+
+// ReadManifest loads the manifest from bindingsDir. A missing manifest yields
+// an empty one.
+func ReadManifest(bindingsDir string) (Manifest, error) {
+	raw, err := os.ReadFile(filepath.Join(bindingsDir, ManifestFile))
+	if os.IsNotExist(err) {
+		return Manifest{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var m Manifest
+	if err := json.Unmarshal(raw, &m); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", ManifestFile, err)
+	}
+	return m, nil
+}
+
+// Write stores the manifest in bindingsDir.
+func (m Manifest) Write(bindingsDir string) error {
+	out, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeIfChanged(filepath.Join(bindingsDir, ManifestFile), append(out, '\n'))
+}
+
+// Verify checks the bindings in bindingsDir against the manifest and the given
+// sources. It reports every binding whose embedded ABI no longer matches its
+// recorded hash, every source without a binding and every binding without a
+// source.
+func (m Manifest) Verify(bindingsDir string, sources []Source) []error {
+	var errs []error
+
+	pkgs, err := bindingPackages(bindingsDir)
+	if err != nil {
+		return []error{err}
+	}
+	have := map[string]bool{}
+	for _, pkg := range pkgs {
+		have[pkg] = true
+	}
+	want := map[string]bool{}
+	for _, s := range sources {
+		want[s.Name] = true
+		if !have[s.Name] {
+			errs = append(errs, fmt.Errorf("%s: no binding for %s", s.Name, s.Path))
+		}
+		if e, ok := m[s.Name]; !ok {
+			errs = append(errs, fmt.Errorf("%s: missing from %s", s.Name, ManifestFile))
+		} else if e.Source != filepath.ToSlash(s.Path) {
+			errs = append(errs, fmt.Errorf("%s: %s records source %s, found %s", s.Name, ManifestFile, e.Source, s.Path))
+		}
+	}
+	for _, pkg := range pkgs {
+		if !want[pkg] {
+			errs = append(errs, fmt.Errorf("%s: orphaned binding, no matching source", pkg))
+			continue
+		}
+		src, err := os.ReadFile(filepath.Join(bindingsDir, pkg, bindingFile))
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		_, raw, _, err := bindingRawABI(src)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", pkg, err))
+			continue
+		}
+		if e, ok := m[pkg]; ok && e.ABIHash != ABIHash(raw) {
+			errs = append(errs, fmt.Errorf("%s: binding ABI hash %s does not match %s entry %s", pkg, ABIHash(raw), ManifestFile, e.ABIHash))
+		}
+	}
+	for name := range m {
+		if !want[name] {
+			errs = append(errs, fmt.Errorf("%s: stale %s entry, no matching source", name, ManifestFile))
+		}
+	}
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Error() < errs[j].Error() })
+	return errs
+}
@@ -0,0 +1,366 @@
+// Package bindgen contains the post-processing steps applied to the abigen
+// output under pkg/bindings.
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	// SharedTypesImport is the import path of the canonical struct package.
+	SharedTypesImport = "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+
+	// SharedTypesAlias is the name bindings import SharedTypesImport under, since
+	// every binding already imports go-ethereum's core/types as "types".
+	SharedTypesAlias = "eltypes"
+
+	bindingFile     = "binding.go"
+	sharedTypesFile = "types.go"
+
+	// userStructDoc is the doc comment suffix abigen attaches to structs that
+	// mirror a Solidity struct, as opposed to the contract wrapper types.
+	userStructDoc = "binding around an user-defined struct."
+)
+
+// structDecl is a Solidity struct binding found in a Go file.
+type structDecl struct {
+	name string
+	body string // normalized struct type expression
+}
+
+// SharedTypes collects the struct bindings duplicated across the generated
+// packages in bindingsDir, writes one canonical definition of each to
+// typesDir and replaces the per-package copies with type aliases.
+//
+// Running it on already-processed bindings is a no-op. It fails if two
+// packages disagree on the layout of a struct with the same name, or if a
+// freshly generated struct no longer matches its canonical definition.
+func SharedTypes(bindingsDir, typesDir string) error {
+	canonical, err := readStructs(filepath.Join(typesDir, sharedTypesFile))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if canonical == nil {
+		canonical = map[string]string{}
+	}
+
+	pkgs, err := bindingPackages(bindingsDir)
+	if err != nil {
+		return err
+	}
+
+	// First pass: gather every struct and every alias still in use.
+	used := map[string]bool{}
+	origin := map[string]string{}
+	for _, pkg := range pkgs {
+		src, err := os.ReadFile(filepath.Join(bindingsDir, pkg, bindingFile))
+		if err != nil {
+			return err
+		}
+		decls, aliases, err := parseBinding(src)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pkg, err)
+		}
+		for _, name := range aliases {
+			if _, ok := canonical[name]; !ok {
+				return fmt.Errorf("%s: alias %s.%s has no canonical definition", pkg, SharedTypesAlias, name)
+			}
+			used[name] = true
+		}
+		for _, d := range decls {
+			if body, ok := canonical[d.name]; ok && body != d.body {
+				where := origin[d.name]
+				if where == "" {
+					where = "pkg/types"
+				}
+				return fmt.Errorf("%s: struct %s does not match the definition in %s", pkg, d.name, where)
+			}
+			if _, ok := origin[d.name]; !ok {
+				origin[d.name] = pkg
+			}
+			canonical[d.name] = d.body
+			used[d.name] = true
+		}
+	}
+	for name := range canonical {
+		if !used[name] {
+			delete(canonical, name)
+		}
+	}
+
+	if err := writeSharedTypes(filepath.Join(typesDir, sharedTypesFile), canonical); err != nil {
+		return err
+	}
+
+	// Second pass: swap each struct definition for an alias.
+	for _, pkg := range pkgs {
+		path := filepath.Join(bindingsDir, pkg, bindingFile)
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		out, err := aliasStructs(src)
+		if err != nil {
+			return fmt.Errorf("%s: %w", pkg, err)
+		}
+		if !bytes.Equal(src, out) {
+			if err := os.WriteFile(path, out, 0o644); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// bindingPackages lists the package directories under bindingsDir that hold a
+// generated binding.
+func bindingPackages(bindingsDir string) ([]string, error) {
+	entries, err := os.ReadDir(bindingsDir)
+	if err != nil {
+		return nil, err
+	}
+	var pkgs []string
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(bindingsDir, e.Name(), bindingFile)); err == nil {
+			pkgs = append(pkgs, e.Name())
+		}
+	}
+	sort.Strings(pkgs)
+	return pkgs, nil
+}
+
+// parseBinding returns the struct declarations and the shared type aliases
+// present in a binding source file.
+func parseBinding(src []byte) ([]structDecl, []string, error) {
+	return parseStructs(src, userStructs)
+}
+
+// parseStructs returns the struct declarations and shared type aliases among
+// the type specs selected by specs.
+func parseStructs(src []byte, specs func(*ast.File) []*ast.TypeSpec) ([]structDecl, []string, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err
+	}
+	var (
+		decls   []structDecl
+		aliases []string
+	)
+	for _, spec := range specs(file) {
+		if spec.Assign.IsValid() {
+			if sel, ok := spec.Type.(*ast.SelectorExpr); ok {
+				if x, ok := sel.X.(*ast.Ident); ok && x.Name == SharedTypesAlias {
+					aliases = append(aliases, sel.Sel.Name)
+				}
+			}
+			continue
+		}
+		if st, ok := spec.Type.(*ast.StructType); ok {
+			body, err := render(fset, st)
+			if err != nil {
+				return nil, nil, err
+			}
+			decls = append(decls, structDecl{name: spec.Name.Name, body: body})
+		}
+	}
+	return decls, aliases, nil
+}
+
+// readStructs parses a previously written shared types file.
+func readStructs(path string) (map[string]string, error) {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	decls, _, err := parseStructs(src, allTypes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	out := make(map[string]string, len(decls))
+	for _, d := range decls {
+		out[d.name] = d.body
+	}
+	return out, nil
+}
+
+// aliasStructs rewrites every struct declaration in a binding into an alias of
+// the canonical type.
+func aliasStructs(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	type edit struct {
+		start, end int
+		text       string
+	}
+	var edits []edit
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || !isUserStruct(gen) {
+			continue
+		}
+		spec := gen.Specs[0].(*ast.TypeSpec)
+		if _, ok := spec.Type.(*ast.StructType); !ok || spec.Assign.IsValid() {
+			continue
+		}
+		start := gen.Pos()
+		if gen.Doc != nil {
+			start = gen.Doc.Pos()
+		}
+		name := spec.Name.Name
+		edits = append(edits, edit{
+			start: fset.Position(start).Offset,
+			end:   fset.Position(gen.End()).Offset,
+			text: fmt.Sprintf("// %s is an alias of the canonical %s.%s shared by all bindings.\ntype %s = %s.%s",
+				name, SharedTypesAlias, name, name, SharedTypesAlias, name),
+		})
+	}
+	if len(edits) == 0 {
+		return src, nil
+	}
+
+	if !importsShared(file) {
+		imp := importDecl(file)
+		if imp == nil || !imp.Lparen.IsValid() {
+			return nil, fmt.Errorf("binding has no import block")
+		}
+		off := fset.Position(imp.Rparen).Offset
+		edits = append(edits, edit{
+			start: off,
+			end:   off,
+			text:  fmt.Sprintf("\t%s %q\n", SharedTypesAlias, SharedTypesImport),
+		})
+	}
+
+	sort.Slice(edits, func(i, j int) bool { return edits[i].start > edits[j].start })
+	out := append([]byte(nil), src...)
+	for _, e := range edits {
+		out = append(out[:e.start], append([]byte(e.text), out[e.end:]...)...)
+	}
+	return format.Source(out)
+}
+
+// writeSharedTypes renders the canonical struct definitions.
+func writeSharedTypes(path string, structs map[string]string) error {
+	names := make([]string, 0, len(structs))
+	for name := range structs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString(generatedHeader)
+	buf.WriteString("// This file holds the canonical definition of every Solidity struct used by\n")
+	buf.WriteString("// the contract bindings under pkg/bindings.\n\n")
+	buf.WriteString("package types\n\n")
+	buf.WriteString("import (\n\t\"math/big\"\n\n\t\"github.com/ethereum/go-ethereum/common\"\n)\n\n")
+	buf.WriteString("// Reference imports to suppress errors if they are not otherwise used.\n")
+	buf.WriteString("var (\n\t_ = big.NewInt\n\t_ = common.Big1\n)\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\n// %s is a Go binding around the user-defined Solidity struct %s.\n", name, solidityName(name))
+		fmt.Fprintf(&buf, "type %s %s\n", name, structs[name])
+	}
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return writeIfChanged(path, out)
+}
+
+// solidityName turns the flattened abigen name back into the qualified
+// Solidity name, e.g. IAllocationManagerTypesAllocateParams becomes
+// IAllocationManagerTypes.AllocateParams.
+func solidityName(name string) string {
+	for _, scope := range []string{"BeaconChainProofs", "ERC20VotesUpgradeable", "BN254"} {
+		if strings.HasPrefix(name, scope) && len(name) > len(scope) {
+			return scope + "." + name[len(scope):]
+		}
+	}
+	if strings.HasPrefix(name, "I") {
+		if i := strings.Index(name, "Types"); i > 0 && i+len("Types") < len(name) {
+			return name[:i+len("Types")] + "." + name[i+len("Types"):]
+		}
+	}
+	return name
+}
+
+// userStructs returns the type declarations that bind a Solidity struct,
+// whether still defined in place or already aliased to the shared package.
+func userStructs(file *ast.File) []*ast.TypeSpec {
+	var specs []*ast.TypeSpec
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.TYPE || len(gen.Specs) != 1 {
+			continue
+		}
+		spec := gen.Specs[0].(*ast.TypeSpec)
+		if isUserStruct(gen) || spec.Assign.IsValid() {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
+
+// allTypes returns every single-spec type declaration in file.
+func allTypes(file *ast.File) []*ast.TypeSpec {
+	var specs []*ast.TypeSpec
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.TYPE && len(gen.Specs) == 1 {
+			specs = append(specs, gen.Specs[0].(*ast.TypeSpec))
+		}
+	}
+	return specs
+}
+
+func isUserStruct(gen *ast.GenDecl) bool {
+	return gen.Tok == token.TYPE && len(gen.Specs) == 1 && gen.Doc != nil &&
+		strings.HasSuffix(strings.TrimSpace(gen.Doc.Text()), userStructDoc)
+}
+
+func importDecl(file *ast.File) *ast.GenDecl {
+	for _, decl := range file.Decls {
+		if gen, ok := decl.(*ast.GenDecl); ok && gen.Tok == token.IMPORT {
+			return gen
+		}
+	}
+	return nil
+}
+
+func importsShared(file *ast.File) bool {
+	for _, imp := range file.Imports {
+		if imp.Path.Value == fmt.Sprintf("%q", SharedTypesImport) {
+			return true
+		}
+	}
+	return false
+}
+
+func render(fset *token.FileSet, node ast.Node) (string, error) {
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, fset, node); err != nil {
+		return "", err
+	}
+	out, err := format.Source([]byte("package p\ntype _ " + buf.String()))
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(strings.TrimPrefix(string(out), "package p\n\ntype _ ")), nil
+}
@@ -0,0 +1,109 @@
+package bindgen
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const testBinding = `// Code generated - DO NOT EDIT.
+
+package Foo
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
+type OperatorSet struct {
+	Avs common.Address
+	Id  uint32
+}
+
+// FooCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FooCaller struct {
+	amount *big.Int
+}
+`
+
+func writeBinding(t *testing.T, dir, pkg, src string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Join(dir, pkg), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, pkg, bindingFile), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSharedTypes(t *testing.T) {
+	root := t.TempDir()
+	bindings := filepath.Join(root, "bindings")
+	types := filepath.Join(root, "types")
+	writeBinding(t, bindings, "Foo", testBinding)
+	writeBinding(t, bindings, "Bar", strings.ReplaceAll(testBinding, "Foo", "Bar"))
+
+	if err := SharedTypes(bindings, types); err != nil {
+		t.Fatalf("SharedTypes failed: %v", err)
+	}
+
+	shared, err := os.ReadFile(filepath.Join(types, sharedTypesFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(shared), "type OperatorSet struct") {
+		t.Errorf("Expected canonical OperatorSet in shared types, got:\n%s", shared)
+	}
+	if strings.Contains(string(shared), "FooCaller") {
+		t.Errorf("Expected contract wrapper types to stay in the binding, got:\n%s", shared)
+	}
+
+	foo, err := os.ReadFile(filepath.Join(bindings, "Foo", bindingFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(foo), "type OperatorSet = eltypes.OperatorSet") {
+		t.Errorf("Expected OperatorSet alias in binding, got:\n%s", foo)
+	}
+	if !strings.Contains(string(foo), "type FooCaller struct") {
+		t.Errorf("Expected FooCaller to be left untouched, got:\n%s", foo)
+	}
+
+	// A second run over processed bindings must not change anything.
+	if err := SharedTypes(bindings, types); err != nil {
+		t.Fatalf("SharedTypes rerun failed: %v", err)
+	}
+	again, _ := os.ReadFile(filepath.Join(bindings, "Foo", bindingFile))
+	if string(again) != string(foo) {
+		t.Errorf("Expected rerun to be a no-op")
+	}
+}
+
+func TestSharedTypesMismatch(t *testing.T) {
+	root := t.TempDir()
+	bindings := filepath.Join(root, "bindings")
+	writeBinding(t, bindings, "Foo", testBinding)
+	writeBinding(t, bindings, "Bar", strings.ReplaceAll(strings.ReplaceAll(testBinding, "Foo", "Bar"), "Id  uint32", "Id  uint64"))
+
+	err := SharedTypes(bindings, filepath.Join(root, "types"))
+	if err == nil || !strings.Contains(err.Error(), "OperatorSet") {
+		t.Fatalf("Expected layout mismatch error, got %v", err)
+	}
+}
+
+func TestSolidityName(t *testing.T) {
+	cases := map[string]string{
+		"IAllocationManagerTypesAllocateParams": "IAllocationManagerTypes.AllocateParams",
+		"BN254G1Point":                          "BN254.G1Point",
+		"BeaconChainProofsBalanceProof":         "BeaconChainProofs.BalanceProof",
+		"OperatorSet":                           "OperatorSet",
+	}
+	for in, want := range cases {
+		if got := solidityName(in); got != want {
+			t.Errorf("solidityName(%s) = %s, want %s", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,451 @@
+// Package allocplan turns an operator's target allocations into the
+// AllocationManager.modifyAllocations calls that reach them.
+//
+// A Target asks for a proportion of the operator's max magnitude in a
+// strategy, or for an amount of slashable stake, in one operator set. Build
+// checks the targets against a State, rejects plans that would allocate more
+// than the max magnitude and orders the changes into Steps: deallocations
+// first, then allocations as far as the allocatable magnitude allows, and
+// the remaining allocations in later steps once the slashable deallocations
+// they wait for complete after DEALLOCATION_DELAY.
+//
+//	src := chainview.NewSource(contracts, client)
+//	state, err := allocplan.Load(ctx, src, nil, operator, targets)
+//	if err != nil {
+//		return err
+//	}
+//	plan, err := allocplan.Build(state, targets)
+//	for _, step := range plan.Steps {
+//		// Wait for step.Block, then call ModifyAllocations(opts, operator, step.Params).
+//	}
+package allocplan
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/slashing"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrInvalidTarget is returned for a Target without a proportion or
+	// stake, or with one out of range.
+	ErrInvalidTarget = errors.New("allocplan: invalid target")
+	// ErrDuplicateTarget is returned when two targets name the same operator
+	// set and strategy.
+	ErrDuplicateTarget = errors.New("allocplan: duplicate target")
+	// ErrStakeUnreachable is returned when a Target asks for more stake than
+	// the operator has delegated in the strategy.
+	ErrStakeUnreachable = errors.New("allocplan: stake exceeds operator shares")
+	// ErrMissingState is returned when a target's operator set or strategy
+	// has no state.
+	ErrMissingState = errors.New("allocplan: no state for target")
+)
+
+// Target is the allocation wanted in one strategy of one operator set.
+type Target struct {
+	OperatorSet eltypes.OperatorSet
+	Strategy    common.Address
+	// Proportion is the share of the max magnitude to allocate, in WAD.
+	Proportion *big.Int
+	// Stake is the slashable stake to allocate, in shares of Strategy. It is
+	// used when Proportion is nil, valued at the operator's current shares
+	// and rounded up to the smallest magnitude that reaches it.
+	Stake *big.Int
+}
+
+// ParsePercent parses a percentage such as "30", "12.5" or "12.5%" into a
+// WAD proportion, rounding down.
+func ParsePercent(s string) (*big.Int, error) {
+	r, ok := new(big.Rat).SetString(strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(s), "%")))
+	if !ok || r.Sign() < 0 || r.Cmp(big.NewRat(100, 1)) > 0 {
+		return nil, fmt.Errorf("%w: percentage %q", ErrInvalidTarget, s)
+	}
+	r.Mul(r, big.NewRat(slashing.WAD, 100))
+	return new(big.Int).Quo(r.Num(), r.Denom()), nil
+}
+
+// State is the operator's allocation state the plan starts from.
+type State struct {
+	Operator common.Address
+	// Block is the block.number the first modifyAllocations call executes in.
+	Block uint32
+	// AllocationDelaySet and AllocationDelay are
+	// AllocationManager.getAllocationDelay.
+	AllocationDelaySet bool
+	AllocationDelay    uint32
+	DeallocationDelay  uint32
+
+	Strategies   map[common.Address]*StrategyState
+	OperatorSets map[eltypes.OperatorSet]*OperatorSetState
+}
+
+// StrategyState is the operator's state in one strategy.
+type StrategyState struct {
+	MaxMagnitude   uint64
+	OperatorShares *big.Int
+	// Allocations holds the operator's allocation in every operator set it is
+	// allocated to, as AllocationManager.getStrategyAllocations reports them.
+	// The allocatable magnitude is derived from them.
+	Allocations map[eltypes.OperatorSet]eltypes.IAllocationManagerTypesAllocation
+}
+
+// OperatorSetState is an operator set a target allocates to.
+type OperatorSetState struct {
+	Exists bool
+	// Slashable is AllocationManager.isOperatorSlashable for the operator.
+	Slashable bool
+	// Strategies are the strategies of the operator set. Deallocations from
+	// other strategies, like those while the operator is not slashable, take
+	// effect immediately.
+	Strategies []common.Address
+}
+
+// Plan is an ordered list of modifyAllocations calls.
+type Plan struct {
+	Operator common.Address
+	Steps    []Step
+}
+
+// Step is one modifyAllocations call.
+type Step struct {
+	// Block is the earliest block the call can execute in, after the
+	// deallocations it relies on complete. The EffectBlocks of its Changes
+	// assume it executes in Block and move with it. Gas estimation runs
+	// against the latest block, so a later step either waits for Block to be
+	// mined or is sent with a gas limit.
+	Block   uint32
+	Params  []eltypes.IAllocationManagerTypesAllocateParams
+	Changes []Change
+}
+
+// Change is the change of one allocation.
+type Change struct {
+	OperatorSet eltypes.OperatorSet
+	Strategy    common.Address
+	// From is the magnitude allocated when the call executes and To the
+	// magnitude it sets.
+	From, To uint64
+	// Stake is the slashable stake at To, valued at the current operator
+	// shares.
+	Stake *big.Int
+	// EffectBlock is when To takes effect: after the allocation delay for an
+	// allocation and DEALLOCATION_DELAY for a slashable deallocation, which
+	// stays slashable until then. Other deallocations take effect at once.
+	EffectBlock uint32
+}
+
+// Deallocation reports whether the change lowers the allocation.
+func (c Change) Deallocation() bool {
+	return c.To < c.From
+}
+
+// Changes returns the changes of all steps in order.
+func (p *Plan) Changes() []Change {
+	var changes []Change
+	for _, step := range p.Steps {
+		changes = append(changes, step.Changes...)
+	}
+	return changes
+}
+
+type key struct {
+	set      eltypes.OperatorSet
+	strategy common.Address
+}
+
+// pending is a change waiting to be scheduled.
+type pending struct {
+	Change
+	// ready is the first block the allocation has no pending modification.
+	ready     uint32
+	slashable bool
+}
+
+// release is magnitude a deallocation frees at a block.
+type release struct {
+	strategy  common.Address
+	block     uint32
+	magnitude uint64
+	applied   bool
+}
+
+// Build plans the modifyAllocations calls that reach targets from state. It
+// returns the error modifyAllocations would revert with for the plan as a
+// whole, if any; targets already met are left out.
+func Build(state *State, targets []Target) (*Plan, error) {
+	if !state.AllocationDelaySet {
+		return nil, allocationmanager.ErrUninitializedAllocationDelay{}
+	}
+
+	wanted := make(map[key]uint64, len(targets))
+	var changes []*pending
+	for _, t := range targets {
+		k := key{t.OperatorSet, t.Strategy}
+		if _, ok := wanted[k]; ok {
+			return nil, fmt.Errorf("%w: %s in operator set %d of %s", ErrDuplicateTarget, t.Strategy.Hex(), t.OperatorSet.Id, t.OperatorSet.Avs.Hex())
+		}
+		set, ok := state.OperatorSets[t.OperatorSet]
+		if !ok {
+			return nil, fmt.Errorf("%w: operator set %d of %s", ErrMissingState, t.OperatorSet.Id, t.OperatorSet.Avs.Hex())
+		}
+		if !set.Exists {
+			return nil, allocationmanager.ErrInvalidOperatorSet{}
+		}
+		s, ok := state.Strategies[t.Strategy]
+		if !ok {
+			return nil, fmt.Errorf("%w: strategy %s", ErrMissingState, t.Strategy.Hex())
+		}
+
+		to, err := targetMagnitude(s, t)
+		if err != nil {
+			return nil, fmt.Errorf("%w (%s in operator set %d of %s)", err, t.Strategy.Hex(), t.OperatorSet.Id, t.OperatorSet.Avs.Hex())
+		}
+		wanted[k] = to
+
+		// A pending change has to take effect before the allocation can be
+		// modified again. A slash can shrink its diff to zero but leaves its
+		// effect block, which modifyAllocations checks.
+		a := s.Allocations[t.OperatorSet]
+		from, err := slashing.AddInt128(a.CurrentMagnitude, slashing.OrZero(a.PendingDiff))
+		if err != nil {
+			return nil, err
+		}
+		ready := max(state.Block, a.EffectBlock)
+		if from == to {
+			continue
+		}
+		stake, err := stakeAt(s, to)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, &pending{
+			Change:    Change{OperatorSet: t.OperatorSet, Strategy: t.Strategy, From: from, To: to, Stake: stake},
+			ready:     ready,
+			slashable: from != 0 && set.Slashable && contains(set.Strategies, t.Strategy),
+		})
+	}
+
+	available := make(map[common.Address]uint64, len(state.Strategies))
+	var releases []*release
+	for addr, s := range state.Strategies {
+		// Every operator set's allocation once all changes are done must fit
+		// in the max magnitude.
+		var total uint64
+		for set, a := range s.Allocations {
+			final, err := slashing.AddInt128(a.CurrentMagnitude, slashing.OrZero(a.PendingDiff))
+			if err != nil {
+				return nil, err
+			}
+			if to, ok := wanted[key{set, addr}]; ok {
+				final = to
+			}
+			total += final
+		}
+		for k, to := range wanted {
+			if _, ok := s.Allocations[k.set]; !ok && k.strategy == addr {
+				total += to
+			}
+		}
+		if total > s.MaxMagnitude {
+			return nil, fmt.Errorf("%w: plan allocates %d in %s, max magnitude is %d", allocationmanager.ErrInsufficientMagnitude{}, total, addr.Hex(), s.MaxMagnitude)
+		}
+
+		encumbered, rs, err := encumbrance(state.Block, addr, s)
+		if err != nil {
+			return nil, err
+		}
+		if encumbered > s.MaxMagnitude {
+			return nil, fmt.Errorf("allocplan: %s encumbers %d of max magnitude %d", addr.Hex(), encumbered, s.MaxMagnitude)
+		}
+		available[addr] = s.MaxMagnitude - encumbered
+		releases = append(releases, rs...)
+	}
+
+	plan := &Plan{Operator: state.Operator}
+	for block := state.Block; len(changes) > 0; {
+		for _, r := range releases {
+			if !r.applied && r.block <= block {
+				available[r.strategy] += r.magnitude
+				r.applied = true
+			}
+		}
+
+		var deallocations, allocations []Change
+		var rest []*pending
+		for _, c := range changes {
+			if c.ready > block {
+				rest = append(rest, c)
+				continue
+			}
+			if c.Deallocation() {
+				c.EffectBlock = block
+				if c.slashable {
+					c.EffectBlock = block + state.DeallocationDelay + 1
+					releases = append(releases, &release{strategy: c.Strategy, block: c.EffectBlock, magnitude: c.From - c.To})
+				} else {
+					available[c.Strategy] += c.From - c.To
+				}
+				deallocations = append(deallocations, c.Change)
+			}
+		}
+		// Deallocations come first in the call, so allocations can use the
+		// magnitude they free at once.
+		for _, c := range changes {
+			if c.ready > block || c.Deallocation() {
+				continue
+			}
+			if c.To-c.From > available[c.Strategy] {
+				rest = append(rest, c)
+				continue
+			}
+			available[c.Strategy] -= c.To - c.From
+			c.EffectBlock = block + state.AllocationDelay
+			allocations = append(allocations, c.Change)
+		}
+		if len(deallocations)+len(allocations) > 0 {
+			step := Step{Block: block, Changes: append(deallocations, allocations...)}
+			step.Params = append(allocateParams(deallocations), allocateParams(allocations)...)
+			plan.Steps = append(plan.Steps, step)
+		}
+		changes = rest
+		if len(changes) == 0 {
+			break
+		}
+
+		// Move on to the next block magnitude is freed or an allocation
+		// becomes modifiable.
+		next := uint32(0)
+		for _, r := range releases {
+			if !r.applied && r.block > block && (next == 0 || r.block < next) {
+				next = r.block
+			}
+		}
+		for _, c := range changes {
+			if c.ready > block && (next == 0 || c.ready < next) {
+				next = c.ready
+			}
+		}
+		if next == 0 {
+			c := changes[0]
+			return nil, fmt.Errorf("%w: no magnitude to allocate %d in %s", allocationmanager.ErrInsufficientMagnitude{}, c.To-c.From, c.Strategy.Hex())
+		}
+		block = next
+	}
+	return plan, nil
+}
+
+// encumbrance returns the magnitude encumbered in strategy at block and the
+// releases of the deallocations still pending then.
+func encumbrance(block uint32, strategy common.Address, s *StrategyState) (uint64, []*release, error) {
+	var encumbered uint64
+	var releases []*release
+	for _, a := range s.Allocations {
+		pending := slashing.OrZero(a.PendingDiff)
+		magnitude := a.CurrentMagnitude
+		switch {
+		case pending.Sign() < 0 && block < a.EffectBlock:
+			released, err := slashing.AddInt128(0, new(big.Int).Neg(pending))
+			if err != nil {
+				return 0, nil, err
+			}
+			releases = append(releases, &release{strategy: strategy, block: a.EffectBlock, magnitude: released})
+		case pending.Sign() != 0:
+			// Allocations encumber magnitude as soon as they are made.
+			var err error
+			if magnitude, err = slashing.AddInt128(magnitude, pending); err != nil {
+				return 0, nil, err
+			}
+		}
+		encumbered += magnitude
+	}
+	sort.Slice(releases, func(i, j int) bool { return releases[i].block < releases[j].block })
+	return encumbered, releases, nil
+}
+
+// targetMagnitude converts a target into a magnitude.
+func targetMagnitude(s *StrategyState, t Target) (uint64, error) {
+	max := new(big.Int).SetUint64(s.MaxMagnitude)
+	switch {
+	case t.Proportion != nil:
+		if t.Proportion.Sign() < 0 || t.Proportion.Cmp(big.NewInt(slashing.WAD)) > 0 {
+			return 0, fmt.Errorf("%w: proportion %s", ErrInvalidTarget, t.Proportion)
+		}
+		m, err := slashing.MulWad(max, t.Proportion)
+		if err != nil {
+			return 0, err
+		}
+		return m.Uint64(), nil
+	case t.Stake != nil:
+		if t.Stake.Sign() < 0 {
+			return 0, fmt.Errorf("%w: stake %s", ErrInvalidTarget, t.Stake)
+		}
+		full, err := stakeAt(s, s.MaxMagnitude)
+		if err != nil {
+			return 0, err
+		}
+		if full.Cmp(t.Stake) < 0 {
+			return 0, fmt.Errorf("%w: %s of %s", ErrStakeUnreachable, t.Stake, full)
+		}
+		// The stake only grows with the magnitude, so search for the
+		// smallest magnitude that reaches it.
+		var searchErr error
+		m := sort.Search(int(s.MaxMagnitude), func(m int) bool {
+			stake, err := stakeAt(s, uint64(m))
+			if err != nil {
+				searchErr = err
+				return true
+			}
+			return stake.Cmp(t.Stake) >= 0
+		})
+		return uint64(m), searchErr
+	default:
+		return 0, fmt.Errorf("%w: no proportion or stake", ErrInvalidTarget)
+	}
+}
+
+// stakeAt mirrors the slashable stake AllocationManager.getAllocatedStake
+// reports for magnitude.
+func stakeAt(s *StrategyState, magnitude uint64) (*big.Int, error) {
+	if s.MaxMagnitude == 0 {
+		return new(big.Int), nil
+	}
+	proportion, err := slashing.DivWad(new(big.Int).SetUint64(magnitude), new(big.Int).SetUint64(s.MaxMagnitude))
+	if err != nil {
+		return nil, err
+	}
+	return slashing.MulWad(slashing.OrZero(s.OperatorShares), proportion)
+}
+
+// allocateParams groups changes into AllocateParams by operator set, keeping their
+// order.
+func allocateParams(changes []Change) []eltypes.IAllocationManagerTypesAllocateParams {
+	var out []eltypes.IAllocationManagerTypesAllocateParams
+	index := make(map[eltypes.OperatorSet]int)
+	for _, c := range changes {
+		i, ok := index[c.OperatorSet]
+		if !ok {
+			i = len(out)
+			index[c.OperatorSet] = i
+			out = append(out, eltypes.IAllocationManagerTypesAllocateParams{OperatorSet: c.OperatorSet})
+		}
+		out[i].Strategies = append(out[i].Strategies, c.Strategy)
+		out[i].NewMagnitudes = append(out[i].NewMagnitudes, c.To)
+	}
+	return out
+}
+
+func contains(addrs []common.Address, addr common.Address) bool {
+	for _, a := range addrs {
+		if a == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+package allocplan
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	avs  = common.HexToAddress("0xa5")
+	s1   = common.HexToAddress("0x1")
+	s2   = common.HexToAddress("0x2")
+	setA = eltypes.OperatorSet{Avs: avs, Id: 1}
+	setB = eltypes.OperatorSet{Avs: avs, Id: 2}
+	// The operator is no longer slashable by setC.
+	setC = eltypes.OperatorSet{Avs: avs, Id: 3}
+)
+
+func e18(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func allocated(current uint64) eltypes.IAllocationManagerTypesAllocation {
+	return eltypes.IAllocationManagerTypesAllocation{CurrentMagnitude: current, PendingDiff: new(big.Int)}
+}
+
+func testState() *State {
+	both := []common.Address{s1, s2}
+	return &State{
+		Operator:           common.HexToAddress("0x09"),
+		Block:              100,
+		AllocationDelaySet: true,
+		AllocationDelay:    10,
+		DeallocationDelay:  50,
+		Strategies: map[common.Address]*StrategyState{
+			s1: {MaxMagnitude: 1e18, OperatorShares: e18(1000), Allocations: map[eltypes.OperatorSet]eltypes.IAllocationManagerTypesAllocation{
+				setA: allocated(6e17), setB: allocated(0), setC: allocated(1e17),
+			}},
+			s2: {MaxMagnitude: 5e17, OperatorShares: e18(10), Allocations: map[eltypes.OperatorSet]eltypes.IAllocationManagerTypesAllocation{
+				setA: allocated(5e17),
+			}},
+		},
+		OperatorSets: map[eltypes.OperatorSet]*OperatorSetState{
+			setA: {Exists: true, Slashable: true, Strategies: both},
+			setB: {Exists: true, Slashable: true, Strategies: both},
+			setC: {Exists: true, Strategies: both},
+		},
+	}
+}
+
+func percent(t *testing.T, s string) *big.Int {
+	t.Helper()
+	p, err := ParsePercent(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return p
+}
+
+func TestBuild(t *testing.T) {
+	plan, err := Build(testState(), []Target{
+		{OperatorSet: setA, Strategy: s1, Proportion: percent(t, "30%")},
+		{OperatorSet: setB, Strategy: s1, Proportion: percent(t, "40")},
+		{OperatorSet: setC, Strategy: s1, Proportion: new(big.Int)},
+		{OperatorSet: setA, Strategy: s2, Stake: e18(4)},
+		{OperatorSet: setB, Strategy: s2, Stake: e18(6)},
+	})
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if len(plan.Steps) != 2 {
+		t.Fatalf("Expected 2 steps, got %d", len(plan.Steps))
+	}
+
+	// The deallocation from setC frees magnitude at once, the others only
+	// after the deallocation delay.
+	first := plan.Steps[0]
+	if first.Block != 100 {
+		t.Errorf("Expected the first step at block 100, got %d", first.Block)
+	}
+	want := []Change{
+		{OperatorSet: setA, Strategy: s1, From: 6e17, To: 3e17, Stake: e18(300), EffectBlock: 151},
+		{OperatorSet: setC, Strategy: s1, From: 1e17, To: 0, Stake: new(big.Int), EffectBlock: 100},
+		{OperatorSet: setA, Strategy: s2, From: 5e17, To: 2e17, Stake: e18(4), EffectBlock: 151},
+		{OperatorSet: setB, Strategy: s1, From: 0, To: 4e17, Stake: e18(400), EffectBlock: 110},
+	}
+	if !reflect.DeepEqual(first.Changes, want) {
+		t.Errorf("Expected changes %+v, got %+v", want, first.Changes)
+	}
+	wantParams := []eltypes.IAllocationManagerTypesAllocateParams{
+		{OperatorSet: setA, Strategies: []common.Address{s1, s2}, NewMagnitudes: []uint64{3e17, 2e17}},
+		{OperatorSet: setC, Strategies: []common.Address{s1}, NewMagnitudes: []uint64{0}},
+		{OperatorSet: setB, Strategies: []common.Address{s1}, NewMagnitudes: []uint64{4e17}},
+	}
+	if !reflect.DeepEqual(first.Params, wantParams) {
+		t.Errorf("Expected params %+v, got %+v", wantParams, first.Params)
+	}
+
+	second := plan.Steps[1]
+	want = []Change{{OperatorSet: setB, Strategy: s2, From: 0, To: 3e17, Stake: e18(6), EffectBlock: 161}}
+	if second.Block != 151 || !reflect.DeepEqual(second.Changes, want) {
+		t.Errorf("Expected %+v at block 151, got %+v at %d", want, second.Changes, second.Block)
+	}
+	if n := len(plan.Changes()); n != 5 {
+		t.Errorf("Expected 5 changes, got %d", n)
+	}
+}
+
+func TestBuildWaitsForPendingChange(t *testing.T) {
+	state := testState()
+	state.Strategies[s1].Allocations[setB] = eltypes.IAllocationManagerTypesAllocation{CurrentMagnitude: 1e17, PendingDiff: big.NewInt(1e17), EffectBlock: 120}
+	plan, err := Build(state, []Target{
+		{OperatorSet: setB, Strategy: s1, Proportion: percent(t, "30")},
+		// Already met: left out.
+		{OperatorSet: setA, Strategy: s1, Proportion: percent(t, "60")},
+	})
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	want := []Change{{OperatorSet: setB, Strategy: s1, From: 2e17, To: 3e17, Stake: e18(300), EffectBlock: 130}}
+	if len(plan.Steps) != 1 || plan.Steps[0].Block != 120 || !reflect.DeepEqual(plan.Steps[0].Changes, want) {
+		t.Errorf("Expected %+v at block 120, got %+v", want, plan.Steps)
+	}
+}
+
+func TestBuildWaitsForPendingDeallocation(t *testing.T) {
+	state := testState()
+	state.Strategies[s2].Allocations[setA] = eltypes.IAllocationManagerTypesAllocation{CurrentMagnitude: 5e17, PendingDiff: big.NewInt(-1e17), EffectBlock: 130}
+	plan, err := Build(state, []Target{{OperatorSet: setB, Strategy: s2, Proportion: percent(t, "10")}})
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if len(plan.Steps) != 1 || plan.Steps[0].Block != 130 {
+		t.Errorf("Expected one step once the deallocation completes at block 130, got %+v", plan.Steps)
+	}
+}
+
+func TestBuildWaitsForSlashedDeallocation(t *testing.T) {
+	// A slash took the pending diff to zero; the effect block still holds.
+	state := testState()
+	state.Strategies[s1].Allocations[setB] = eltypes.IAllocationManagerTypesAllocation{CurrentMagnitude: 0, PendingDiff: new(big.Int), EffectBlock: 140}
+	plan, err := Build(state, []Target{{OperatorSet: setB, Strategy: s1, Proportion: percent(t, "10")}})
+	if err != nil {
+		t.Fatalf("Build failed: %v", err)
+	}
+	if len(plan.Steps) != 1 || plan.Steps[0].Block != 140 {
+		t.Errorf("Expected one step once the effect block passes at 140, got %+v", plan.Steps)
+	}
+}
+
+func TestBuildErrors(t *testing.T) {
+	for _, v := range []struct {
+		name    string
+		modify  func(*State)
+		targets []Target
+		err     error
+	}{
+		{"over-allocation", nil, []Target{{OperatorSet: setB, Strategy: s1, Proportion: percent(t, "40")}}, allocationmanager.ErrInsufficientMagnitude{}},
+		{"no allocation delay", func(s *State) { s.AllocationDelaySet = false }, []Target{{OperatorSet: setB, Strategy: s1, Stake: e18(1)}}, allocationmanager.ErrUninitializedAllocationDelay{}},
+		{"no operator set", func(s *State) { s.OperatorSets[setB].Exists = false }, []Target{{OperatorSet: setB, Strategy: s1, Stake: e18(1)}}, allocationmanager.ErrInvalidOperatorSet{}},
+		{"duplicate", nil, []Target{{OperatorSet: setB, Strategy: s1, Stake: e18(1)}, {OperatorSet: setB, Strategy: s1, Stake: e18(2)}}, ErrDuplicateTarget},
+		{"too much stake", nil, []Target{{OperatorSet: setB, Strategy: s2, Stake: e18(11)}}, ErrStakeUnreachable},
+		{"no amount", nil, []Target{{OperatorSet: setB, Strategy: s2}}, ErrInvalidTarget},
+		{"proportion above one", nil, []Target{{OperatorSet: setB, Strategy: s2, Proportion: e18(2)}}, ErrInvalidTarget},
+		{"unknown strategy", nil, []Target{{OperatorSet: setB, Strategy: common.HexToAddress("0x3"), Stake: e18(1)}}, ErrMissingState},
+	} {
+		state := testState()
+		if v.modify != nil {
+			v.modify(state)
+		}
+		if _, err := Build(state, v.targets); !errors.Is(err, v.err) {
+			t.Errorf("%s: expected %v, got %v", v.name, v.err, err)
+		}
+	}
+}
+
+func TestParsePercent(t *testing.T) {
+	for _, v := range []struct {
+		in   string
+		want int64
+	}{
+		{"30", 3e17},
+		{"12.5%", 125e15},
+		{" 100 % ", 1e18},
+		{"0", 0},
+	} {
+		if got, err := ParsePercent(v.in); err != nil || got.Int64() != v.want {
+			t.Errorf("%q: expected %d, got %v (%v)", v.in, v.want, got, err)
+		}
+	}
+	for _, in := range []string{"", "abc", "-1", "100.1"} {
+		if _, err := ParsePercent(in); !errors.Is(err, ErrInvalidTarget) {
+			t.Errorf("%q: expected ErrInvalidTarget, got %v", in, err)
+		}
+	}
+}
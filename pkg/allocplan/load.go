@@ -0,0 +1,93 @@
+package allocplan
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Load reads the state of operator that planning targets depends on, as of
+// opts.BlockNumber or the latest block, for a first call mined in the next
+// block.
+func Load(ctx context.Context, src chainview.Source, opts *bind.CallOpts, operator common.Address, targets []Target) (*State, error) {
+	o, err := src.CallOpts(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	am := src.AllocationManager
+
+	state := &State{
+		Operator:     operator,
+		Block:        uint32(o.BlockNumber.Uint64() + 1),
+		Strategies:   make(map[common.Address]*StrategyState),
+		OperatorSets: make(map[eltypes.OperatorSet]*OperatorSetState),
+	}
+	if state.AllocationDelaySet, state.AllocationDelay, err = am.GetAllocationDelay(&o, operator); err != nil {
+		return nil, fmt.Errorf("allocplan: failed to read allocation delay of %s: %w", operator.Hex(), err)
+	}
+	if state.DeallocationDelay, err = am.DEALLOCATIONDELAY(&o); err != nil {
+		return nil, fmt.Errorf("allocplan: failed to read deallocation delay: %w", err)
+	}
+
+	var strategies []common.Address
+	for _, t := range targets {
+		if _, ok := state.Strategies[t.Strategy]; !ok {
+			state.Strategies[t.Strategy] = &StrategyState{}
+			strategies = append(strategies, t.Strategy)
+		}
+		if _, ok := state.OperatorSets[t.OperatorSet]; ok {
+			continue
+		}
+		set := &OperatorSetState{}
+		if set.Exists, err = am.IsOperatorSet(&o, t.OperatorSet); err != nil {
+			return nil, fmt.Errorf("allocplan: failed to read operator set %d of %s: %w", t.OperatorSet.Id, t.OperatorSet.Avs.Hex(), err)
+		}
+		if set.Slashable, err = am.IsOperatorSlashable(&o, operator, t.OperatorSet); err != nil {
+			return nil, fmt.Errorf("allocplan: failed to read slashability of %s: %w", operator.Hex(), err)
+		}
+		if set.Strategies, err = am.GetStrategiesInOperatorSet(&o, t.OperatorSet); err != nil {
+			return nil, fmt.Errorf("allocplan: failed to read strategies of operator set %d of %s: %w", t.OperatorSet.Id, t.OperatorSet.Avs.Hex(), err)
+		}
+		state.OperatorSets[t.OperatorSet] = set
+	}
+
+	shares, err := src.DelegationManager.GetOperatorShares(&o, operator, strategies)
+	if err != nil {
+		return nil, fmt.Errorf("allocplan: failed to read operator shares of %s: %w", operator.Hex(), err)
+	}
+	if len(shares) != len(strategies) {
+		return nil, fmt.Errorf("allocplan: expected %d operator shares, got %d", len(strategies), len(shares))
+	}
+	for i, strategy := range strategies {
+		s := state.Strategies[strategy]
+		s.OperatorShares = shares[i]
+		if s.MaxMagnitude, err = am.GetMaxMagnitude(&o, operator, strategy); err != nil {
+			return nil, fmt.Errorf("allocplan: failed to read max magnitude in %s: %w", strategy.Hex(), err)
+		}
+		allocations, err := am.GetStrategyAllocations(&o, operator, strategy)
+		if err != nil {
+			return nil, fmt.Errorf("allocplan: failed to read allocations in %s: %w", strategy.Hex(), err)
+		}
+		if len(allocations.OperatorSets) != len(allocations.Allocations) {
+			return nil, fmt.Errorf("allocplan: got %d allocations for %d operator sets", len(allocations.Allocations), len(allocations.OperatorSets))
+		}
+		s.Allocations = make(map[eltypes.OperatorSet]eltypes.IAllocationManagerTypesAllocation, len(allocations.OperatorSets))
+		for j, set := range allocations.OperatorSets {
+			s.Allocations[set] = allocations.Allocations[j]
+		}
+	}
+	// getStrategyAllocations leaves out a set whose magnitude a slash took
+	// to zero, though a deallocation in it may still be pending.
+	for _, t := range targets {
+		a, err := am.GetAllocation(&o, operator, t.OperatorSet, t.Strategy)
+		if err != nil {
+			return nil, fmt.Errorf("allocplan: failed to read allocation in %s of operator set %d of %s: %w", t.Strategy.Hex(), t.OperatorSet.Id, t.OperatorSet.Avs.Hex(), err)
+		}
+		state.Strategies[t.Strategy].Allocations[t.OperatorSet] = a
+	}
+	return state, nil
+}
@@ -0,0 +1,138 @@
+//go:build !go1.23 || simulated
+
+package allocplan
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// TestPlanMatchesContract moves magnitude between two operator sets, one
+// strategy at once and the other after the deallocation delay, executes the
+// plan and checks every step's effect blocks and the final allocations.
+func TestPlanMatchesContract(t *testing.T) {
+	h, err := harness.New(harness.Config{DeallocationDelay: 5})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+
+	account := func() *bind.TransactOpts {
+		auth, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	modify := func(operator *bind.TransactOpts, p []eltypes.IAllocationManagerTypesAllocateParams) *types.Receipt {
+		t.Helper()
+		receipt, err := h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			// Estimation runs against the latest block, before the deallocations
+			// the call relies on complete.
+			opts.GasLimit = 1_000_000
+			return h.AllocationManager.ModifyAllocations(opts, operator.From, p)
+		})
+		check(err)
+		return receipt
+	}
+
+	var deployed []harness.Strategy
+	for i := 0; i < 2; i++ {
+		s, err := h.DeployStrategy(ctx)
+		check(err)
+		deployed = append(deployed, s)
+	}
+	sort.Slice(deployed, func(i, j int) bool { return deployed[i].Address.Cmp(deployed[j].Address) < 0 })
+	strategies := []common.Address{deployed[0].Address, deployed[1].Address}
+
+	operator, avs, staker := account(), account(), account()
+	check(h.RegisterOperator(ctx, operator))
+	var sets []eltypes.OperatorSet
+	for id := uint32(1); id <= 2; id++ {
+		set, err := h.CreateOperatorSet(ctx, avs, id, strategies)
+		check(err)
+		check(h.RegisterForOperatorSet(ctx, operator, set))
+		sets = append(sets, set)
+	}
+	for _, s := range deployed {
+		check(h.Deposit(ctx, staker, s, big.NewInt(4e18)))
+	}
+	check(h.Delegate(ctx, staker, operator.From))
+	modify(operator, []eltypes.IAllocationManagerTypesAllocateParams{
+		{OperatorSet: sets[0], Strategies: strategies, NewMagnitudes: []uint64{6e17, 1e18}},
+	})
+
+	targets := []Target{
+		{OperatorSet: sets[0], Strategy: strategies[0], Proportion: big.NewInt(3e17)},
+		{OperatorSet: sets[1], Strategy: strategies[0], Proportion: big.NewInt(4e17)},
+		{OperatorSet: sets[0], Strategy: strategies[1], Proportion: big.NewInt(5e17)},
+		{OperatorSet: sets[1], Strategy: strategies[1], Stake: big.NewInt(2e18)},
+	}
+	state, err := Load(ctx, chainview.NewSource(h.Contracts, h.Client), nil, operator.From, targets)
+	check(err)
+	plan, err := Build(state, targets)
+	check(err)
+	if len(plan.Steps) != 2 {
+		t.Fatalf("Expected 2 steps, got %+v", plan.Steps)
+	}
+
+	for _, step := range plan.Steps {
+		for {
+			block, err := h.Client.BlockNumber(ctx)
+			check(err)
+			if block+1 >= uint64(step.Block) {
+				break
+			}
+			h.Backend.Commit()
+		}
+		receipt := modify(operator, step.Params)
+		if receipt.BlockNumber.Uint64() != uint64(step.Block) {
+			t.Fatalf("Expected the step in block %d, got %d", step.Block, receipt.BlockNumber)
+		}
+		var updates []Change
+		for _, log := range receipt.Logs {
+			if e, err := h.AllocationManager.ParseAllocationUpdated(*log); err == nil {
+				updates = append(updates, Change{OperatorSet: e.OperatorSet, Strategy: e.Strategy, To: e.Magnitude, EffectBlock: e.EffectBlock})
+			}
+		}
+		if len(updates) != len(step.Changes) {
+			t.Fatalf("Expected %d allocation updates, got %d", len(step.Changes), len(updates))
+		}
+		for i, c := range step.Changes {
+			u := updates[i]
+			if u.OperatorSet != c.OperatorSet || u.Strategy != c.Strategy || u.To != c.To || u.EffectBlock != c.EffectBlock {
+				t.Errorf("Expected update to %d effective at %d, got %d at %d", c.To, c.EffectBlock, u.To, u.EffectBlock)
+			}
+		}
+	}
+
+	for _, c := range plan.Changes() {
+		allocation, err := h.AllocationManager.GetAllocation(&bind.CallOpts{Context: ctx}, operator.From, c.OperatorSet, c.Strategy)
+		check(err)
+		if got := allocation.CurrentMagnitude + allocation.PendingDiff.Uint64(); got != c.To {
+			t.Errorf("%s in operator set %d: expected magnitude %d, got %d", c.Strategy.Hex(), c.OperatorSet.Id, c.To, got)
+		}
+	}
+	stake, err := h.AllocationManager.GetAllocatedStake(&bind.CallOpts{Context: ctx}, sets[1], []common.Address{operator.From}, strategies[1:])
+	check(err)
+	if stake[0][0].Cmp(big.NewInt(2e18)) < 0 {
+		t.Errorf("Expected at least 2e18 allocated stake, got %s", stake[0][0])
+	}
+}
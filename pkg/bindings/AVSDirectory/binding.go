@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,12 +30,8 @@ var (
 	_ = abi.ConvertType
 )
 
-// ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry is an auto generated low-level Go binding around an user-defined struct.
-type ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry struct {
-	Signature []byte
-	Salt      [32]byte
-	Expiry    *big.Int
-}
+// ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry is an alias of the canonical eltypes.ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry shared by all bindings.
+type ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry = eltypes.ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry
 
 // AVSDirectoryMetaData contains all meta data concerning the AVSDirectory contract.
 var AVSDirectoryMetaData = &bind.MetaData{
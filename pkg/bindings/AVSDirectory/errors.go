@@ -0,0 +1,130 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectory
+
+import (
+	"fmt"
+)
+
+// ErrCurrentlyPaused is the typed form of the AVSDirectory custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "AVSDirectory: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the AVSDirectory custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "AVSDirectory: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the AVSDirectory custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "AVSDirectory: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidShortString is the typed form of the AVSDirectory custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "AVSDirectory: InvalidShortString()"
+}
+
+// ErrInvalidSignature is the typed form of the AVSDirectory custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "AVSDirectory: InvalidSignature()"
+}
+
+// ErrOnlyPauser is the typed form of the AVSDirectory custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "AVSDirectory: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the AVSDirectory custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "AVSDirectory: OnlyUnpauser()"
+}
+
+// ErrOperatorAlreadyRegisteredToAVS is the typed form of the AVSDirectory custom error OperatorAlreadyRegisteredToAVS().
+type ErrOperatorAlreadyRegisteredToAVS struct{}
+
+// Error implements the error interface.
+func (ErrOperatorAlreadyRegisteredToAVS) Error() string {
+	return "AVSDirectory: OperatorAlreadyRegisteredToAVS()"
+}
+
+// ErrOperatorNotRegisteredToAVS is the typed form of the AVSDirectory custom error OperatorNotRegisteredToAVS().
+type ErrOperatorNotRegisteredToAVS struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegisteredToAVS) Error() string {
+	return "AVSDirectory: OperatorNotRegisteredToAVS()"
+}
+
+// ErrOperatorNotRegisteredToEigenLayer is the typed form of the AVSDirectory custom error OperatorNotRegisteredToEigenLayer().
+type ErrOperatorNotRegisteredToEigenLayer struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegisteredToEigenLayer) Error() string {
+	return "AVSDirectory: OperatorNotRegisteredToEigenLayer()"
+}
+
+// ErrSaltSpent is the typed form of the AVSDirectory custom error SaltSpent().
+type ErrSaltSpent struct{}
+
+// Error implements the error interface.
+func (ErrSaltSpent) Error() string {
+	return "AVSDirectory: SaltSpent()"
+}
+
+// ErrSignatureExpired is the typed form of the AVSDirectory custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "AVSDirectory: SignatureExpired()"
+}
+
+// ErrStringTooLong is the typed form of the AVSDirectory custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("AVSDirectory: StringTooLong(str: %v)", e.Str)
+}
+
+// AVSDirectoryErrors maps the name of every custom error in the AVSDirectory ABI to its typed Go error.
+var AVSDirectoryErrors = map[string]error{
+	"CurrentlyPaused":                   ErrCurrentlyPaused{},
+	"InputAddressZero":                  ErrInputAddressZero{},
+	"InvalidNewPausedStatus":            ErrInvalidNewPausedStatus{},
+	"InvalidShortString":                ErrInvalidShortString{},
+	"InvalidSignature":                  ErrInvalidSignature{},
+	"OnlyPauser":                        ErrOnlyPauser{},
+	"OnlyUnpauser":                      ErrOnlyUnpauser{},
+	"OperatorAlreadyRegisteredToAVS":    ErrOperatorAlreadyRegisteredToAVS{},
+	"OperatorNotRegisteredToAVS":        ErrOperatorNotRegisteredToAVS{},
+	"OperatorNotRegisteredToEigenLayer": ErrOperatorNotRegisteredToEigenLayer{},
+	"SaltSpent":                         ErrSaltSpent{},
+	"SignatureExpired":                  ErrSignatureExpired{},
+	"StringTooLong":                     ErrStringTooLong{},
+}
@@ -0,0 +1,376 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectory
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeAVSDirectory is an in-memory AVSDirectory for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded AVSDirectoryFilterer.
+type FakeAVSDirectory struct {
+	*fake.Contract
+	*AVSDirectoryFilterer
+}
+
+// NewFakeAVSDirectory returns an empty fake of the contract at address.
+func NewFakeAVSDirectory(address common.Address) *FakeAVSDirectory {
+	contract := fake.NewContract(AVSDirectoryMetaData, address)
+	filterer, err := NewAVSDirectoryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeAVSDirectory{Contract: contract, AVSDirectoryFilterer: filterer}
+}
+
+// OPERATORAVSREGISTRATIONTYPEHASH returns the values stubbed with StubOPERATORAVSREGISTRATIONTYPEHASH.
+func (_FakeAVSDirectory *FakeAVSDirectory) OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectory.Contract.Read("OPERATOR_AVS_REGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORAVSREGISTRATIONTYPEHASH sets what OPERATORAVSREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubOPERATORAVSREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeAVSDirectory.Contract.Stub("OPERATOR_AVS_REGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// OPERATORSETFORCEDEREGISTRATIONTYPEHASH returns the values stubbed with StubOPERATORSETFORCEDEREGISTRATIONTYPEHASH.
+func (_FakeAVSDirectory *FakeAVSDirectory) OPERATORSETFORCEDEREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectory.Contract.Read("OPERATOR_SET_FORCE_DEREGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORSETFORCEDEREGISTRATIONTYPEHASH sets what OPERATORSETFORCEDEREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubOPERATORSETFORCEDEREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeAVSDirectory.Contract.Stub("OPERATOR_SET_FORCE_DEREGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// OPERATORSETREGISTRATIONTYPEHASH returns the values stubbed with StubOPERATORSETREGISTRATIONTYPEHASH.
+func (_FakeAVSDirectory *FakeAVSDirectory) OPERATORSETREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectory.Contract.Read("OPERATOR_SET_REGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORSETREGISTRATIONTYPEHASH sets what OPERATORSETREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubOPERATORSETREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeAVSDirectory.Contract.Stub("OPERATOR_SET_REGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// AvsOperatorStatus returns the values stubbed with StubAvsOperatorStatus.
+func (_FakeAVSDirectory *FakeAVSDirectory) AvsOperatorStatus(opts *bind.CallOpts, avs common.Address, operator common.Address) (uint8, error) {
+	var out0 uint8
+	err := _FakeAVSDirectory.Contract.Read("avsOperatorStatus", []interface{}{avs, operator}, &out0)
+	return out0, err
+}
+
+// StubAvsOperatorStatus sets what AvsOperatorStatus returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubAvsOperatorStatus(avs common.Address, operator common.Address, out0 uint8) {
+	_FakeAVSDirectory.Contract.Stub("avsOperatorStatus", []interface{}{avs, operator}, out0)
+}
+
+// CalculateOperatorAVSRegistrationDigestHash returns the values stubbed with StubCalculateOperatorAVSRegistrationDigestHash.
+func (_FakeAVSDirectory *FakeAVSDirectory) CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectory.Contract.Read("calculateOperatorAVSRegistrationDigestHash", []interface{}{operator, avs, salt, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorAVSRegistrationDigestHash sets what CalculateOperatorAVSRegistrationDigestHash returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubCalculateOperatorAVSRegistrationDigestHash(operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int, out0 [32]byte) {
+	_FakeAVSDirectory.Contract.Stub("calculateOperatorAVSRegistrationDigestHash", []interface{}{operator, avs, salt, expiry}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeAVSDirectory *FakeAVSDirectory) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAVSDirectory.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubDelegation(out0 common.Address) {
+	_FakeAVSDirectory.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeAVSDirectory *FakeAVSDirectory) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectory.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubDomainSeparator(out0 [32]byte) {
+	_FakeAVSDirectory.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// OperatorSaltIsSpent returns the values stubbed with StubOperatorSaltIsSpent.
+func (_FakeAVSDirectory *FakeAVSDirectory) OperatorSaltIsSpent(opts *bind.CallOpts, operator common.Address, salt [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeAVSDirectory.Contract.Read("operatorSaltIsSpent", []interface{}{operator, salt}, &out0)
+	return out0, err
+}
+
+// StubOperatorSaltIsSpent sets what OperatorSaltIsSpent returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubOperatorSaltIsSpent(operator common.Address, salt [32]byte, out0 bool) {
+	_FakeAVSDirectory.Contract.Stub("operatorSaltIsSpent", []interface{}{operator, salt}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeAVSDirectory *FakeAVSDirectory) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAVSDirectory.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubOwner(out0 common.Address) {
+	_FakeAVSDirectory.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeAVSDirectory *FakeAVSDirectory) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeAVSDirectory.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubPaused(index uint8, out0 bool) {
+	_FakeAVSDirectory.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeAVSDirectory *FakeAVSDirectory) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeAVSDirectory.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubPaused0(out0 *big.Int) {
+	_FakeAVSDirectory.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeAVSDirectory *FakeAVSDirectory) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAVSDirectory.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubPauserRegistry(out0 common.Address) {
+	_FakeAVSDirectory.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeAVSDirectory *FakeAVSDirectory) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeAVSDirectory.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeAVSDirectory *FakeAVSDirectory) StubVersion(out0 string) {
+	_FakeAVSDirectory.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// CancelSalt records a cancelSalt call after running the hook set with OnCancelSalt.
+func (_FakeAVSDirectory *FakeAVSDirectory) CancelSalt(opts *bind.TransactOpts, salt [32]byte) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "cancelSalt", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("cancelSalt").(func(opts *bind.TransactOpts, salt [32]byte) error); ok {
+			return hook(opts, salt)
+		}
+		return nil
+	}, salt)
+}
+
+// OnCancelSalt sets a hook run by every CancelSalt call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnCancelSalt(hook func(opts *bind.TransactOpts, salt [32]byte) error) {
+	_FakeAVSDirectory.Contract.SetHook("cancelSalt", hook)
+}
+
+// DeregisterOperatorFromAVS records a deregisterOperatorFromAVS call after running the hook set with OnDeregisterOperatorFromAVS.
+func (_FakeAVSDirectory *FakeAVSDirectory) DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "deregisterOperatorFromAVS", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("deregisterOperatorFromAVS").(func(opts *bind.TransactOpts, operator common.Address) error); ok {
+			return hook(opts, operator)
+		}
+		return nil
+	}, operator)
+}
+
+// OnDeregisterOperatorFromAVS sets a hook run by every DeregisterOperatorFromAVS call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnDeregisterOperatorFromAVS(hook func(opts *bind.TransactOpts, operator common.Address) error) {
+	_FakeAVSDirectory.Contract.SetHook("deregisterOperatorFromAVS", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeAVSDirectory *FakeAVSDirectory) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialOwner, initialPausedStatus)
+		}
+		return nil
+	}, initialOwner, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) error) {
+	_FakeAVSDirectory.Contract.SetHook("initialize", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeAVSDirectory *FakeAVSDirectory) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeAVSDirectory.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeAVSDirectory *FakeAVSDirectory) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeAVSDirectory.Contract.SetHook("pauseAll", hook)
+}
+
+// RegisterOperatorToAVS records a registerOperatorToAVS call after running the hook set with OnRegisterOperatorToAVS.
+func (_FakeAVSDirectory *FakeAVSDirectory) RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "registerOperatorToAVS", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("registerOperatorToAVS").(func(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) error); ok {
+			return hook(opts, operator, operatorSignature)
+		}
+		return nil
+	}, operator, operatorSignature)
+}
+
+// OnRegisterOperatorToAVS sets a hook run by every RegisterOperatorToAVS call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnRegisterOperatorToAVS(hook func(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) error) {
+	_FakeAVSDirectory.Contract.SetHook("registerOperatorToAVS", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeAVSDirectory *FakeAVSDirectory) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeAVSDirectory.Contract.SetHook("renounceOwnership", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeAVSDirectory *FakeAVSDirectory) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeAVSDirectory.Contract.SetHook("transferOwnership", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeAVSDirectory *FakeAVSDirectory) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeAVSDirectory.Contract.SetHook("unpause", hook)
+}
+
+// UpdateAVSMetadataURI records a updateAVSMetadataURI call after running the hook set with OnUpdateAVSMetadataURI.
+func (_FakeAVSDirectory *FakeAVSDirectory) UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error) {
+	return _FakeAVSDirectory.Contract.Transact(opts, "updateAVSMetadataURI", func() error {
+		if hook, ok := _FakeAVSDirectory.Contract.Hook("updateAVSMetadataURI").(func(opts *bind.TransactOpts, metadataURI string) error); ok {
+			return hook(opts, metadataURI)
+		}
+		return nil
+	}, metadataURI)
+}
+
+// OnUpdateAVSMetadataURI sets a hook run by every UpdateAVSMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectory *FakeAVSDirectory) OnUpdateAVSMetadataURI(hook func(opts *bind.TransactOpts, metadataURI string) error) {
+	_FakeAVSDirectory.Contract.SetHook("updateAVSMetadataURI", hook)
+}
+
+// EmitAVSMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeAVSDirectory *FakeAVSDirectory) EmitAVSMetadataURIUpdated(event *AVSDirectoryAVSMetadataURIUpdated) {
+	_FakeAVSDirectory.Contract.Emit("AVSMetadataURIUpdated", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeAVSDirectory *FakeAVSDirectory) EmitInitialized(event *AVSDirectoryInitialized) {
+	_FakeAVSDirectory.Contract.Emit("Initialized", event)
+}
+
+// EmitOperatorAVSRegistrationStatusUpdated appends the event to the fake's logs.
+func (_FakeAVSDirectory *FakeAVSDirectory) EmitOperatorAVSRegistrationStatusUpdated(event *AVSDirectoryOperatorAVSRegistrationStatusUpdated) {
+	_FakeAVSDirectory.Contract.Emit("OperatorAVSRegistrationStatusUpdated", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeAVSDirectory *FakeAVSDirectory) EmitOwnershipTransferred(event *AVSDirectoryOwnershipTransferred) {
+	_FakeAVSDirectory.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeAVSDirectory *FakeAVSDirectory) EmitPaused(event *AVSDirectoryPaused) {
+	_FakeAVSDirectory.Contract.Emit("Paused", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeAVSDirectory *FakeAVSDirectory) EmitUnpaused(event *AVSDirectoryUnpaused) {
+	_FakeAVSDirectory.Contract.Emit("Unpaused", event)
+}
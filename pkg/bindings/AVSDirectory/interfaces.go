@@ -0,0 +1,79 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectory
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// AVSDirectoryReader is the read-only surface of the AVSDirectory
+// binding, implemented by AVSDirectoryCaller, AVSDirectory and FakeAVSDirectory.
+type AVSDirectoryReader interface {
+	OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	OPERATORSETFORCEDEREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	OPERATORSETREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	AvsOperatorStatus(opts *bind.CallOpts, avs common.Address, operator common.Address) (uint8, error)
+	CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	OperatorSaltIsSpent(opts *bind.CallOpts, operator common.Address, salt [32]byte) (bool, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+// AVSDirectoryWriter is the transacting surface of the AVSDirectory
+// binding, implemented by AVSDirectoryTransactor, AVSDirectory and FakeAVSDirectory.
+type AVSDirectoryWriter interface {
+	CancelSalt(opts *bind.TransactOpts, salt [32]byte) (*types.Transaction, error)
+	DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// AVSDirectoryEvents is the event filtering surface of the AVSDirectory
+// binding, implemented by AVSDirectoryFilterer, AVSDirectory and FakeAVSDirectory.
+type AVSDirectoryEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*AVSDirectoryAVSMetadataURIUpdatedIterator, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *AVSDirectoryAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*AVSDirectoryAVSMetadataURIUpdated, error)
+	FilterInitialized(opts *bind.FilterOpts) (*AVSDirectoryInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *AVSDirectoryInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*AVSDirectoryInitialized, error)
+	FilterOperatorAVSRegistrationStatusUpdated(opts *bind.FilterOpts, operator []common.Address, avs []common.Address) (*AVSDirectoryOperatorAVSRegistrationStatusUpdatedIterator, error)
+	WatchOperatorAVSRegistrationStatusUpdated(opts *bind.WatchOpts, sink chan<- *AVSDirectoryOperatorAVSRegistrationStatusUpdated, operator []common.Address, avs []common.Address) (event.Subscription, error)
+	ParseOperatorAVSRegistrationStatusUpdated(log types.Log) (*AVSDirectoryOperatorAVSRegistrationStatusUpdated, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*AVSDirectoryOwnershipTransferredIterator, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *AVSDirectoryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	ParseOwnershipTransferred(log types.Log) (*AVSDirectoryOwnershipTransferred, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*AVSDirectoryPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *AVSDirectoryPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*AVSDirectoryPaused, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*AVSDirectoryUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *AVSDirectoryUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*AVSDirectoryUnpaused, error)
+}
+
+var (
+	_ AVSDirectoryReader = (*AVSDirectoryCaller)(nil)
+	_ AVSDirectoryReader = (*AVSDirectory)(nil)
+	_ AVSDirectoryReader = (*FakeAVSDirectory)(nil)
+	_ AVSDirectoryWriter = (*AVSDirectoryTransactor)(nil)
+	_ AVSDirectoryWriter = (*AVSDirectory)(nil)
+	_ AVSDirectoryWriter = (*FakeAVSDirectory)(nil)
+	_ AVSDirectoryEvents = (*AVSDirectoryFilterer)(nil)
+	_ AVSDirectoryEvents = (*AVSDirectory)(nil)
+	_ AVSDirectoryEvents = (*FakeAVSDirectory)(nil)
+)
@@ -0,0 +1,61 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectoryStorage
+
+// ErrInvalidSignature is the typed form of the AVSDirectoryStorage custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "AVSDirectoryStorage: InvalidSignature()"
+}
+
+// ErrOperatorAlreadyRegisteredToAVS is the typed form of the AVSDirectoryStorage custom error OperatorAlreadyRegisteredToAVS().
+type ErrOperatorAlreadyRegisteredToAVS struct{}
+
+// Error implements the error interface.
+func (ErrOperatorAlreadyRegisteredToAVS) Error() string {
+	return "AVSDirectoryStorage: OperatorAlreadyRegisteredToAVS()"
+}
+
+// ErrOperatorNotRegisteredToAVS is the typed form of the AVSDirectoryStorage custom error OperatorNotRegisteredToAVS().
+type ErrOperatorNotRegisteredToAVS struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegisteredToAVS) Error() string {
+	return "AVSDirectoryStorage: OperatorNotRegisteredToAVS()"
+}
+
+// ErrOperatorNotRegisteredToEigenLayer is the typed form of the AVSDirectoryStorage custom error OperatorNotRegisteredToEigenLayer().
+type ErrOperatorNotRegisteredToEigenLayer struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegisteredToEigenLayer) Error() string {
+	return "AVSDirectoryStorage: OperatorNotRegisteredToEigenLayer()"
+}
+
+// ErrSaltSpent is the typed form of the AVSDirectoryStorage custom error SaltSpent().
+type ErrSaltSpent struct{}
+
+// Error implements the error interface.
+func (ErrSaltSpent) Error() string {
+	return "AVSDirectoryStorage: SaltSpent()"
+}
+
+// ErrSignatureExpired is the typed form of the AVSDirectoryStorage custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "AVSDirectoryStorage: SignatureExpired()"
+}
+
+// AVSDirectoryStorageErrors maps the name of every custom error in the AVSDirectoryStorage ABI to its typed Go error.
+var AVSDirectoryStorageErrors = map[string]error{
+	"InvalidSignature":                  ErrInvalidSignature{},
+	"OperatorAlreadyRegisteredToAVS":    ErrOperatorAlreadyRegisteredToAVS{},
+	"OperatorNotRegisteredToAVS":        ErrOperatorNotRegisteredToAVS{},
+	"OperatorNotRegisteredToEigenLayer": ErrOperatorNotRegisteredToEigenLayer{},
+	"SaltSpent":                         ErrSaltSpent{},
+	"SignatureExpired":                  ErrSignatureExpired{},
+}
@@ -0,0 +1,216 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectoryStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeAVSDirectoryStorage is an in-memory AVSDirectoryStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded AVSDirectoryStorageFilterer.
+type FakeAVSDirectoryStorage struct {
+	*fake.Contract
+	*AVSDirectoryStorageFilterer
+}
+
+// NewFakeAVSDirectoryStorage returns an empty fake of the contract at address.
+func NewFakeAVSDirectoryStorage(address common.Address) *FakeAVSDirectoryStorage {
+	contract := fake.NewContract(AVSDirectoryStorageMetaData, address)
+	filterer, err := NewAVSDirectoryStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeAVSDirectoryStorage{Contract: contract, AVSDirectoryStorageFilterer: filterer}
+}
+
+// OPERATORAVSREGISTRATIONTYPEHASH returns the values stubbed with StubOPERATORAVSREGISTRATIONTYPEHASH.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectoryStorage.Contract.Read("OPERATOR_AVS_REGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORAVSREGISTRATIONTYPEHASH sets what OPERATORAVSREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) StubOPERATORAVSREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeAVSDirectoryStorage.Contract.Stub("OPERATOR_AVS_REGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// OPERATORSETFORCEDEREGISTRATIONTYPEHASH returns the values stubbed with StubOPERATORSETFORCEDEREGISTRATIONTYPEHASH.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OPERATORSETFORCEDEREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectoryStorage.Contract.Read("OPERATOR_SET_FORCE_DEREGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORSETFORCEDEREGISTRATIONTYPEHASH sets what OPERATORSETFORCEDEREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) StubOPERATORSETFORCEDEREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeAVSDirectoryStorage.Contract.Stub("OPERATOR_SET_FORCE_DEREGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// OPERATORSETREGISTRATIONTYPEHASH returns the values stubbed with StubOPERATORSETREGISTRATIONTYPEHASH.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OPERATORSETREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectoryStorage.Contract.Read("OPERATOR_SET_REGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORSETREGISTRATIONTYPEHASH sets what OPERATORSETREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) StubOPERATORSETREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeAVSDirectoryStorage.Contract.Stub("OPERATOR_SET_REGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// AvsOperatorStatus returns the values stubbed with StubAvsOperatorStatus.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) AvsOperatorStatus(opts *bind.CallOpts, avs common.Address, operator common.Address) (uint8, error) {
+	var out0 uint8
+	err := _FakeAVSDirectoryStorage.Contract.Read("avsOperatorStatus", []interface{}{avs, operator}, &out0)
+	return out0, err
+}
+
+// StubAvsOperatorStatus sets what AvsOperatorStatus returns for the given arguments.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) StubAvsOperatorStatus(avs common.Address, operator common.Address, out0 uint8) {
+	_FakeAVSDirectoryStorage.Contract.Stub("avsOperatorStatus", []interface{}{avs, operator}, out0)
+}
+
+// CalculateOperatorAVSRegistrationDigestHash returns the values stubbed with StubCalculateOperatorAVSRegistrationDigestHash.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectoryStorage.Contract.Read("calculateOperatorAVSRegistrationDigestHash", []interface{}{operator, avs, salt, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorAVSRegistrationDigestHash sets what CalculateOperatorAVSRegistrationDigestHash returns for the given arguments.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) StubCalculateOperatorAVSRegistrationDigestHash(operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int, out0 [32]byte) {
+	_FakeAVSDirectoryStorage.Contract.Stub("calculateOperatorAVSRegistrationDigestHash", []interface{}{operator, avs, salt, expiry}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAVSDirectoryStorage.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) StubDelegation(out0 common.Address) {
+	_FakeAVSDirectoryStorage.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeAVSDirectoryStorage.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) StubDomainSeparator(out0 [32]byte) {
+	_FakeAVSDirectoryStorage.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// OperatorSaltIsSpent returns the values stubbed with StubOperatorSaltIsSpent.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OperatorSaltIsSpent(opts *bind.CallOpts, operator common.Address, salt [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeAVSDirectoryStorage.Contract.Read("operatorSaltIsSpent", []interface{}{operator, salt}, &out0)
+	return out0, err
+}
+
+// StubOperatorSaltIsSpent sets what OperatorSaltIsSpent returns for the given arguments.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) StubOperatorSaltIsSpent(operator common.Address, salt [32]byte, out0 bool) {
+	_FakeAVSDirectoryStorage.Contract.Stub("operatorSaltIsSpent", []interface{}{operator, salt}, out0)
+}
+
+// CancelSalt records a cancelSalt call after running the hook set with OnCancelSalt.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) CancelSalt(opts *bind.TransactOpts, salt [32]byte) (*types.Transaction, error) {
+	return _FakeAVSDirectoryStorage.Contract.Transact(opts, "cancelSalt", func() error {
+		if hook, ok := _FakeAVSDirectoryStorage.Contract.Hook("cancelSalt").(func(opts *bind.TransactOpts, salt [32]byte) error); ok {
+			return hook(opts, salt)
+		}
+		return nil
+	}, salt)
+}
+
+// OnCancelSalt sets a hook run by every CancelSalt call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OnCancelSalt(hook func(opts *bind.TransactOpts, salt [32]byte) error) {
+	_FakeAVSDirectoryStorage.Contract.SetHook("cancelSalt", hook)
+}
+
+// DeregisterOperatorFromAVS records a deregisterOperatorFromAVS call after running the hook set with OnDeregisterOperatorFromAVS.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _FakeAVSDirectoryStorage.Contract.Transact(opts, "deregisterOperatorFromAVS", func() error {
+		if hook, ok := _FakeAVSDirectoryStorage.Contract.Hook("deregisterOperatorFromAVS").(func(opts *bind.TransactOpts, operator common.Address) error); ok {
+			return hook(opts, operator)
+		}
+		return nil
+	}, operator)
+}
+
+// OnDeregisterOperatorFromAVS sets a hook run by every DeregisterOperatorFromAVS call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OnDeregisterOperatorFromAVS(hook func(opts *bind.TransactOpts, operator common.Address) error) {
+	_FakeAVSDirectoryStorage.Contract.SetHook("deregisterOperatorFromAVS", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeAVSDirectoryStorage.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeAVSDirectoryStorage.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialOwner, initialPausedStatus)
+		}
+		return nil
+	}, initialOwner, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) error) {
+	_FakeAVSDirectoryStorage.Contract.SetHook("initialize", hook)
+}
+
+// RegisterOperatorToAVS records a registerOperatorToAVS call after running the hook set with OnRegisterOperatorToAVS.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) (*types.Transaction, error) {
+	return _FakeAVSDirectoryStorage.Contract.Transact(opts, "registerOperatorToAVS", func() error {
+		if hook, ok := _FakeAVSDirectoryStorage.Contract.Hook("registerOperatorToAVS").(func(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) error); ok {
+			return hook(opts, operator, operatorSignature)
+		}
+		return nil
+	}, operator, operatorSignature)
+}
+
+// OnRegisterOperatorToAVS sets a hook run by every RegisterOperatorToAVS call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OnRegisterOperatorToAVS(hook func(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) error) {
+	_FakeAVSDirectoryStorage.Contract.SetHook("registerOperatorToAVS", hook)
+}
+
+// UpdateAVSMetadataURI records a updateAVSMetadataURI call after running the hook set with OnUpdateAVSMetadataURI.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error) {
+	return _FakeAVSDirectoryStorage.Contract.Transact(opts, "updateAVSMetadataURI", func() error {
+		if hook, ok := _FakeAVSDirectoryStorage.Contract.Hook("updateAVSMetadataURI").(func(opts *bind.TransactOpts, metadataURI string) error); ok {
+			return hook(opts, metadataURI)
+		}
+		return nil
+	}, metadataURI)
+}
+
+// OnUpdateAVSMetadataURI sets a hook run by every UpdateAVSMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) OnUpdateAVSMetadataURI(hook func(opts *bind.TransactOpts, metadataURI string) error) {
+	_FakeAVSDirectoryStorage.Contract.SetHook("updateAVSMetadataURI", hook)
+}
+
+// EmitAVSMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) EmitAVSMetadataURIUpdated(event *AVSDirectoryStorageAVSMetadataURIUpdated) {
+	_FakeAVSDirectoryStorage.Contract.Emit("AVSMetadataURIUpdated", event)
+}
+
+// EmitOperatorAVSRegistrationStatusUpdated appends the event to the fake's logs.
+func (_FakeAVSDirectoryStorage *FakeAVSDirectoryStorage) EmitOperatorAVSRegistrationStatusUpdated(event *AVSDirectoryStorageOperatorAVSRegistrationStatusUpdated) {
+	_FakeAVSDirectoryStorage.Contract.Emit("OperatorAVSRegistrationStatusUpdated", event)
+}
@@ -0,0 +1,57 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AVSDirectoryStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// AVSDirectoryStorageReader is the read-only surface of the AVSDirectoryStorage
+// binding, implemented by AVSDirectoryStorageCaller, AVSDirectoryStorage and FakeAVSDirectoryStorage.
+type AVSDirectoryStorageReader interface {
+	OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	OPERATORSETFORCEDEREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	OPERATORSETREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	AvsOperatorStatus(opts *bind.CallOpts, avs common.Address, operator common.Address) (uint8, error)
+	CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	OperatorSaltIsSpent(opts *bind.CallOpts, operator common.Address, salt [32]byte) (bool, error)
+}
+
+// AVSDirectoryStorageWriter is the transacting surface of the AVSDirectoryStorage
+// binding, implemented by AVSDirectoryStorageTransactor, AVSDirectoryStorage and FakeAVSDirectoryStorage.
+type AVSDirectoryStorageWriter interface {
+	CancelSalt(opts *bind.TransactOpts, salt [32]byte) (*types.Transaction, error)
+	DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) (*types.Transaction, error)
+	RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) (*types.Transaction, error)
+	UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// AVSDirectoryStorageEvents is the event filtering surface of the AVSDirectoryStorage
+// binding, implemented by AVSDirectoryStorageFilterer, AVSDirectoryStorage and FakeAVSDirectoryStorage.
+type AVSDirectoryStorageEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*AVSDirectoryStorageAVSMetadataURIUpdatedIterator, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *AVSDirectoryStorageAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*AVSDirectoryStorageAVSMetadataURIUpdated, error)
+	FilterOperatorAVSRegistrationStatusUpdated(opts *bind.FilterOpts, operator []common.Address, avs []common.Address) (*AVSDirectoryStorageOperatorAVSRegistrationStatusUpdatedIterator, error)
+	WatchOperatorAVSRegistrationStatusUpdated(opts *bind.WatchOpts, sink chan<- *AVSDirectoryStorageOperatorAVSRegistrationStatusUpdated, operator []common.Address, avs []common.Address) (event.Subscription, error)
+	ParseOperatorAVSRegistrationStatusUpdated(log types.Log) (*AVSDirectoryStorageOperatorAVSRegistrationStatusUpdated, error)
+}
+
+var (
+	_ AVSDirectoryStorageReader = (*AVSDirectoryStorageCaller)(nil)
+	_ AVSDirectoryStorageReader = (*AVSDirectoryStorage)(nil)
+	_ AVSDirectoryStorageReader = (*FakeAVSDirectoryStorage)(nil)
+	_ AVSDirectoryStorageWriter = (*AVSDirectoryStorageTransactor)(nil)
+	_ AVSDirectoryStorageWriter = (*AVSDirectoryStorage)(nil)
+	_ AVSDirectoryStorageWriter = (*FakeAVSDirectoryStorage)(nil)
+	_ AVSDirectoryStorageEvents = (*AVSDirectoryStorageFilterer)(nil)
+	_ AVSDirectoryStorageEvents = (*AVSDirectoryStorage)(nil)
+	_ AVSDirectoryStorageEvents = (*FakeAVSDirectoryStorage)(nil)
+)
@@ -0,0 +1,268 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AllocationManager
+
+// ErrAlreadyMemberOfSet is the typed form of the AllocationManager custom error AlreadyMemberOfSet().
+type ErrAlreadyMemberOfSet struct{}
+
+// Error implements the error interface.
+func (ErrAlreadyMemberOfSet) Error() string {
+	return "AllocationManager: AlreadyMemberOfSet()"
+}
+
+// ErrCurrentlyPaused is the typed form of the AllocationManager custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "AllocationManager: CurrentlyPaused()"
+}
+
+// ErrEmpty is the typed form of the AllocationManager custom error Empty().
+type ErrEmpty struct{}
+
+// Error implements the error interface.
+func (ErrEmpty) Error() string {
+	return "AllocationManager: Empty()"
+}
+
+// ErrInputAddressZero is the typed form of the AllocationManager custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "AllocationManager: InputAddressZero()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the AllocationManager custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "AllocationManager: InputArrayLengthMismatch()"
+}
+
+// ErrInsufficientMagnitude is the typed form of the AllocationManager custom error InsufficientMagnitude().
+type ErrInsufficientMagnitude struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientMagnitude) Error() string {
+	return "AllocationManager: InsufficientMagnitude()"
+}
+
+// ErrInvalidAVSRegistrar is the typed form of the AllocationManager custom error InvalidAVSRegistrar().
+type ErrInvalidAVSRegistrar struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAVSRegistrar) Error() string {
+	return "AllocationManager: InvalidAVSRegistrar()"
+}
+
+// ErrInvalidCaller is the typed form of the AllocationManager custom error InvalidCaller().
+type ErrInvalidCaller struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCaller) Error() string {
+	return "AllocationManager: InvalidCaller()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the AllocationManager custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "AllocationManager: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidOperator is the typed form of the AllocationManager custom error InvalidOperator().
+type ErrInvalidOperator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperator) Error() string {
+	return "AllocationManager: InvalidOperator()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the AllocationManager custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "AllocationManager: InvalidOperatorSet()"
+}
+
+// ErrInvalidPermissions is the typed form of the AllocationManager custom error InvalidPermissions().
+type ErrInvalidPermissions struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPermissions) Error() string {
+	return "AllocationManager: InvalidPermissions()"
+}
+
+// ErrInvalidRedistributionRecipient is the typed form of the AllocationManager custom error InvalidRedistributionRecipient().
+type ErrInvalidRedistributionRecipient struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRedistributionRecipient) Error() string {
+	return "AllocationManager: InvalidRedistributionRecipient()"
+}
+
+// ErrInvalidSnapshotOrdering is the typed form of the AllocationManager custom error InvalidSnapshotOrdering().
+type ErrInvalidSnapshotOrdering struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSnapshotOrdering) Error() string {
+	return "AllocationManager: InvalidSnapshotOrdering()"
+}
+
+// ErrInvalidStrategy is the typed form of the AllocationManager custom error InvalidStrategy().
+type ErrInvalidStrategy struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStrategy) Error() string {
+	return "AllocationManager: InvalidStrategy()"
+}
+
+// ErrInvalidWadToSlash is the typed form of the AllocationManager custom error InvalidWadToSlash().
+type ErrInvalidWadToSlash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidWadToSlash) Error() string {
+	return "AllocationManager: InvalidWadToSlash()"
+}
+
+// ErrModificationAlreadyPending is the typed form of the AllocationManager custom error ModificationAlreadyPending().
+type ErrModificationAlreadyPending struct{}
+
+// Error implements the error interface.
+func (ErrModificationAlreadyPending) Error() string {
+	return "AllocationManager: ModificationAlreadyPending()"
+}
+
+// ErrNonexistentAVSMetadata is the typed form of the AllocationManager custom error NonexistentAVSMetadata().
+type ErrNonexistentAVSMetadata struct{}
+
+// Error implements the error interface.
+func (ErrNonexistentAVSMetadata) Error() string {
+	return "AllocationManager: NonexistentAVSMetadata()"
+}
+
+// ErrNotMemberOfSet is the typed form of the AllocationManager custom error NotMemberOfSet().
+type ErrNotMemberOfSet struct{}
+
+// Error implements the error interface.
+func (ErrNotMemberOfSet) Error() string {
+	return "AllocationManager: NotMemberOfSet()"
+}
+
+// ErrOnlyPauser is the typed form of the AllocationManager custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "AllocationManager: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the AllocationManager custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "AllocationManager: OnlyUnpauser()"
+}
+
+// ErrOperatorNotSlashable is the typed form of the AllocationManager custom error OperatorNotSlashable().
+type ErrOperatorNotSlashable struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotSlashable) Error() string {
+	return "AllocationManager: OperatorNotSlashable()"
+}
+
+// ErrOperatorSetAlreadyMigrated is the typed form of the AllocationManager custom error OperatorSetAlreadyMigrated().
+type ErrOperatorSetAlreadyMigrated struct{}
+
+// Error implements the error interface.
+func (ErrOperatorSetAlreadyMigrated) Error() string {
+	return "AllocationManager: OperatorSetAlreadyMigrated()"
+}
+
+// ErrSameMagnitude is the typed form of the AllocationManager custom error SameMagnitude().
+type ErrSameMagnitude struct{}
+
+// Error implements the error interface.
+func (ErrSameMagnitude) Error() string {
+	return "AllocationManager: SameMagnitude()"
+}
+
+// ErrSlasherNotSet is the typed form of the AllocationManager custom error SlasherNotSet().
+type ErrSlasherNotSet struct{}
+
+// Error implements the error interface.
+func (ErrSlasherNotSet) Error() string {
+	return "AllocationManager: SlasherNotSet()"
+}
+
+// ErrStrategiesMustBeInAscendingOrder is the typed form of the AllocationManager custom error StrategiesMustBeInAscendingOrder().
+type ErrStrategiesMustBeInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrStrategiesMustBeInAscendingOrder) Error() string {
+	return "AllocationManager: StrategiesMustBeInAscendingOrder()"
+}
+
+// ErrStrategyAlreadyInOperatorSet is the typed form of the AllocationManager custom error StrategyAlreadyInOperatorSet().
+type ErrStrategyAlreadyInOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyInOperatorSet) Error() string {
+	return "AllocationManager: StrategyAlreadyInOperatorSet()"
+}
+
+// ErrStrategyNotInOperatorSet is the typed form of the AllocationManager custom error StrategyNotInOperatorSet().
+type ErrStrategyNotInOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotInOperatorSet) Error() string {
+	return "AllocationManager: StrategyNotInOperatorSet()"
+}
+
+// ErrUninitializedAllocationDelay is the typed form of the AllocationManager custom error UninitializedAllocationDelay().
+type ErrUninitializedAllocationDelay struct{}
+
+// Error implements the error interface.
+func (ErrUninitializedAllocationDelay) Error() string {
+	return "AllocationManager: UninitializedAllocationDelay()"
+}
+
+// AllocationManagerErrors maps the name of every custom error in the AllocationManager ABI to its typed Go error.
+var AllocationManagerErrors = map[string]error{
+	"AlreadyMemberOfSet":               ErrAlreadyMemberOfSet{},
+	"CurrentlyPaused":                  ErrCurrentlyPaused{},
+	"Empty":                            ErrEmpty{},
+	"InputAddressZero":                 ErrInputAddressZero{},
+	"InputArrayLengthMismatch":         ErrInputArrayLengthMismatch{},
+	"InsufficientMagnitude":            ErrInsufficientMagnitude{},
+	"InvalidAVSRegistrar":              ErrInvalidAVSRegistrar{},
+	"InvalidCaller":                    ErrInvalidCaller{},
+	"InvalidNewPausedStatus":           ErrInvalidNewPausedStatus{},
+	"InvalidOperator":                  ErrInvalidOperator{},
+	"InvalidOperatorSet":               ErrInvalidOperatorSet{},
+	"InvalidPermissions":               ErrInvalidPermissions{},
+	"InvalidRedistributionRecipient":   ErrInvalidRedistributionRecipient{},
+	"InvalidSnapshotOrdering":          ErrInvalidSnapshotOrdering{},
+	"InvalidStrategy":                  ErrInvalidStrategy{},
+	"InvalidWadToSlash":                ErrInvalidWadToSlash{},
+	"ModificationAlreadyPending":       ErrModificationAlreadyPending{},
+	"NonexistentAVSMetadata":           ErrNonexistentAVSMetadata{},
+	"NotMemberOfSet":                   ErrNotMemberOfSet{},
+	"OnlyPauser":                       ErrOnlyPauser{},
+	"OnlyUnpauser":                     ErrOnlyUnpauser{},
+	"OperatorNotSlashable":             ErrOperatorNotSlashable{},
+	"OperatorSetAlreadyMigrated":       ErrOperatorSetAlreadyMigrated{},
+	"SameMagnitude":                    ErrSameMagnitude{},
+	"SlasherNotSet":                    ErrSlasherNotSet{},
+	"StrategiesMustBeInAscendingOrder": ErrStrategiesMustBeInAscendingOrder{},
+	"StrategyAlreadyInOperatorSet":     ErrStrategyAlreadyInOperatorSet{},
+	"StrategyNotInOperatorSet":         ErrStrategyNotInOperatorSet{},
+	"UninitializedAllocationDelay":     ErrUninitializedAllocationDelay{},
+}
@@ -0,0 +1,915 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AllocationManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeAllocationManager is an in-memory AllocationManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded AllocationManagerFilterer.
+type FakeAllocationManager struct {
+	*fake.Contract
+	*AllocationManagerFilterer
+}
+
+// NewFakeAllocationManager returns an empty fake of the contract at address.
+func NewFakeAllocationManager(address common.Address) *FakeAllocationManager {
+	contract := fake.NewContract(AllocationManagerMetaData, address)
+	filterer, err := NewAllocationManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeAllocationManager{Contract: contract, AllocationManagerFilterer: filterer}
+}
+
+// ALLOCATIONCONFIGURATIONDELAY returns the values stubbed with StubALLOCATIONCONFIGURATIONDELAY.
+func (_FakeAllocationManager *FakeAllocationManager) ALLOCATIONCONFIGURATIONDELAY(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeAllocationManager.Contract.Read("ALLOCATION_CONFIGURATION_DELAY", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubALLOCATIONCONFIGURATIONDELAY sets what ALLOCATIONCONFIGURATIONDELAY returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubALLOCATIONCONFIGURATIONDELAY(out0 uint32) {
+	_FakeAllocationManager.Contract.Stub("ALLOCATION_CONFIGURATION_DELAY", []interface{}{}, out0)
+}
+
+// DEALLOCATIONDELAY returns the values stubbed with StubDEALLOCATIONDELAY.
+func (_FakeAllocationManager *FakeAllocationManager) DEALLOCATIONDELAY(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeAllocationManager.Contract.Read("DEALLOCATION_DELAY", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEALLOCATIONDELAY sets what DEALLOCATIONDELAY returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubDEALLOCATIONDELAY(out0 uint32) {
+	_FakeAllocationManager.Contract.Stub("DEALLOCATION_DELAY", []interface{}{}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeAllocationManager *FakeAllocationManager) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManager.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubDelegation(out0 common.Address) {
+	_FakeAllocationManager.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// EigenStrategy returns the values stubbed with StubEigenStrategy.
+func (_FakeAllocationManager *FakeAllocationManager) EigenStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManager.Contract.Read("eigenStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenStrategy sets what EigenStrategy returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubEigenStrategy(out0 common.Address) {
+	_FakeAllocationManager.Contract.Stub("eigenStrategy", []interface{}{}, out0)
+}
+
+// GetAVSRegistrar returns the values stubbed with StubGetAVSRegistrar.
+func (_FakeAllocationManager *FakeAllocationManager) GetAVSRegistrar(opts *bind.CallOpts, avs common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManager.Contract.Read("getAVSRegistrar", []interface{}{avs}, &out0)
+	return out0, err
+}
+
+// StubGetAVSRegistrar sets what GetAVSRegistrar returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetAVSRegistrar(avs common.Address, out0 common.Address) {
+	_FakeAllocationManager.Contract.Stub("getAVSRegistrar", []interface{}{avs}, out0)
+}
+
+// GetAllocatableMagnitude returns the values stubbed with StubGetAllocatableMagnitude.
+func (_FakeAllocationManager *FakeAllocationManager) GetAllocatableMagnitude(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeAllocationManager.Contract.Read("getAllocatableMagnitude", []interface{}{arg0, arg1}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatableMagnitude sets what GetAllocatableMagnitude returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetAllocatableMagnitude(arg0 common.Address, arg1 common.Address, out0 uint64) {
+	_FakeAllocationManager.Contract.Stub("getAllocatableMagnitude", []interface{}{arg0, arg1}, out0)
+}
+
+// GetAllocatedSets returns the values stubbed with StubGetAllocatedSets.
+func (_FakeAllocationManager *FakeAllocationManager) GetAllocatedSets(opts *bind.CallOpts, arg0 common.Address) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeAllocationManager.Contract.Read("getAllocatedSets", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedSets sets what GetAllocatedSets returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetAllocatedSets(arg0 common.Address, out0 []OperatorSet) {
+	_FakeAllocationManager.Contract.Stub("getAllocatedSets", []interface{}{arg0}, out0)
+}
+
+// GetAllocatedStake returns the values stubbed with StubGetAllocatedStake.
+func (_FakeAllocationManager *FakeAllocationManager) GetAllocatedStake(opts *bind.CallOpts, arg0 OperatorSet, arg1 []common.Address, arg2 []common.Address) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeAllocationManager.Contract.Read("getAllocatedStake", []interface{}{arg0, arg1, arg2}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedStake sets what GetAllocatedStake returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetAllocatedStake(arg0 OperatorSet, arg1 []common.Address, arg2 []common.Address, out0 [][]*big.Int) {
+	_FakeAllocationManager.Contract.Stub("getAllocatedStake", []interface{}{arg0, arg1, arg2}, out0)
+}
+
+// GetAllocatedStrategies returns the values stubbed with StubGetAllocatedStrategies.
+func (_FakeAllocationManager *FakeAllocationManager) GetAllocatedStrategies(opts *bind.CallOpts, arg0 common.Address, arg1 OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeAllocationManager.Contract.Read("getAllocatedStrategies", []interface{}{arg0, arg1}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedStrategies sets what GetAllocatedStrategies returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetAllocatedStrategies(arg0 common.Address, arg1 OperatorSet, out0 []common.Address) {
+	_FakeAllocationManager.Contract.Stub("getAllocatedStrategies", []interface{}{arg0, arg1}, out0)
+}
+
+// GetAllocation returns the values stubbed with StubGetAllocation.
+func (_FakeAllocationManager *FakeAllocationManager) GetAllocation(opts *bind.CallOpts, arg0 common.Address, arg1 OperatorSet, arg2 common.Address) (IAllocationManagerTypesAllocation, error) {
+	var out0 IAllocationManagerTypesAllocation
+	err := _FakeAllocationManager.Contract.Read("getAllocation", []interface{}{arg0, arg1, arg2}, &out0)
+	return out0, err
+}
+
+// StubGetAllocation sets what GetAllocation returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetAllocation(arg0 common.Address, arg1 OperatorSet, arg2 common.Address, out0 IAllocationManagerTypesAllocation) {
+	_FakeAllocationManager.Contract.Stub("getAllocation", []interface{}{arg0, arg1, arg2}, out0)
+}
+
+// GetAllocationDelay returns the values stubbed with StubGetAllocationDelay.
+func (_FakeAllocationManager *FakeAllocationManager) GetAllocationDelay(opts *bind.CallOpts, operator common.Address) (bool, uint32, error) {
+	var out0 bool
+	var out1 uint32
+	err := _FakeAllocationManager.Contract.Read("getAllocationDelay", []interface{}{operator}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetAllocationDelay sets what GetAllocationDelay returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetAllocationDelay(operator common.Address, out0 bool, out1 uint32) {
+	_FakeAllocationManager.Contract.Stub("getAllocationDelay", []interface{}{operator}, out0, out1)
+}
+
+// GetAllocations returns the values stubbed with StubGetAllocations.
+func (_FakeAllocationManager *FakeAllocationManager) GetAllocations(opts *bind.CallOpts, arg0 []common.Address, arg1 OperatorSet, arg2 common.Address) ([]IAllocationManagerTypesAllocation, error) {
+	var out0 []IAllocationManagerTypesAllocation
+	err := _FakeAllocationManager.Contract.Read("getAllocations", []interface{}{arg0, arg1, arg2}, &out0)
+	return out0, err
+}
+
+// StubGetAllocations sets what GetAllocations returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetAllocations(arg0 []common.Address, arg1 OperatorSet, arg2 common.Address, out0 []IAllocationManagerTypesAllocation) {
+	_FakeAllocationManager.Contract.Stub("getAllocations", []interface{}{arg0, arg1, arg2}, out0)
+}
+
+// GetEncumberedMagnitude returns the values stubbed with StubGetEncumberedMagnitude.
+func (_FakeAllocationManager *FakeAllocationManager) GetEncumberedMagnitude(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeAllocationManager.Contract.Read("getEncumberedMagnitude", []interface{}{arg0, arg1}, &out0)
+	return out0, err
+}
+
+// StubGetEncumberedMagnitude sets what GetEncumberedMagnitude returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetEncumberedMagnitude(arg0 common.Address, arg1 common.Address, out0 uint64) {
+	_FakeAllocationManager.Contract.Stub("getEncumberedMagnitude", []interface{}{arg0, arg1}, out0)
+}
+
+// GetMaxMagnitude returns the values stubbed with StubGetMaxMagnitude.
+func (_FakeAllocationManager *FakeAllocationManager) GetMaxMagnitude(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeAllocationManager.Contract.Read("getMaxMagnitude", []interface{}{arg0, arg1}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitude sets what GetMaxMagnitude returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetMaxMagnitude(arg0 common.Address, arg1 common.Address, out0 uint64) {
+	_FakeAllocationManager.Contract.Stub("getMaxMagnitude", []interface{}{arg0, arg1}, out0)
+}
+
+// GetMaxMagnitudes returns the values stubbed with StubGetMaxMagnitudes.
+func (_FakeAllocationManager *FakeAllocationManager) GetMaxMagnitudes(opts *bind.CallOpts, arg0 []common.Address, arg1 common.Address) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeAllocationManager.Contract.Read("getMaxMagnitudes", []interface{}{arg0, arg1}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudes sets what GetMaxMagnitudes returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetMaxMagnitudes(arg0 []common.Address, arg1 common.Address, out0 []uint64) {
+	_FakeAllocationManager.Contract.Stub("getMaxMagnitudes", []interface{}{arg0, arg1}, out0)
+}
+
+// GetMaxMagnitudes0 returns the values stubbed with StubGetMaxMagnitudes0.
+func (_FakeAllocationManager *FakeAllocationManager) GetMaxMagnitudes0(opts *bind.CallOpts, arg0 common.Address, arg1 []common.Address) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeAllocationManager.Contract.Read("getMaxMagnitudes0", []interface{}{arg0, arg1}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudes0 sets what GetMaxMagnitudes0 returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetMaxMagnitudes0(arg0 common.Address, arg1 []common.Address, out0 []uint64) {
+	_FakeAllocationManager.Contract.Stub("getMaxMagnitudes0", []interface{}{arg0, arg1}, out0)
+}
+
+// GetMaxMagnitudesAtBlock returns the values stubbed with StubGetMaxMagnitudesAtBlock.
+func (_FakeAllocationManager *FakeAllocationManager) GetMaxMagnitudesAtBlock(opts *bind.CallOpts, arg0 common.Address, arg1 []common.Address, arg2 uint32) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeAllocationManager.Contract.Read("getMaxMagnitudesAtBlock", []interface{}{arg0, arg1, arg2}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudesAtBlock sets what GetMaxMagnitudesAtBlock returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetMaxMagnitudesAtBlock(arg0 common.Address, arg1 []common.Address, arg2 uint32, out0 []uint64) {
+	_FakeAllocationManager.Contract.Stub("getMaxMagnitudesAtBlock", []interface{}{arg0, arg1, arg2}, out0)
+}
+
+// GetMemberCount returns the values stubbed with StubGetMemberCount.
+func (_FakeAllocationManager *FakeAllocationManager) GetMemberCount(opts *bind.CallOpts, arg0 OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeAllocationManager.Contract.Read("getMemberCount", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubGetMemberCount sets what GetMemberCount returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetMemberCount(arg0 OperatorSet, out0 *big.Int) {
+	_FakeAllocationManager.Contract.Stub("getMemberCount", []interface{}{arg0}, out0)
+}
+
+// GetMembers returns the values stubbed with StubGetMembers.
+func (_FakeAllocationManager *FakeAllocationManager) GetMembers(opts *bind.CallOpts, arg0 OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeAllocationManager.Contract.Read("getMembers", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubGetMembers sets what GetMembers returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetMembers(arg0 OperatorSet, out0 []common.Address) {
+	_FakeAllocationManager.Contract.Stub("getMembers", []interface{}{arg0}, out0)
+}
+
+// GetMinimumSlashableStake returns the values stubbed with StubGetMinimumSlashableStake.
+func (_FakeAllocationManager *FakeAllocationManager) GetMinimumSlashableStake(opts *bind.CallOpts, arg0 OperatorSet, arg1 []common.Address, arg2 []common.Address, arg3 uint32) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeAllocationManager.Contract.Read("getMinimumSlashableStake", []interface{}{arg0, arg1, arg2, arg3}, &out0)
+	return out0, err
+}
+
+// StubGetMinimumSlashableStake sets what GetMinimumSlashableStake returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetMinimumSlashableStake(arg0 OperatorSet, arg1 []common.Address, arg2 []common.Address, arg3 uint32, out0 [][]*big.Int) {
+	_FakeAllocationManager.Contract.Stub("getMinimumSlashableStake", []interface{}{arg0, arg1, arg2, arg3}, out0)
+}
+
+// GetOperatorSetCount returns the values stubbed with StubGetOperatorSetCount.
+func (_FakeAllocationManager *FakeAllocationManager) GetOperatorSetCount(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeAllocationManager.Contract.Read("getOperatorSetCount", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetCount sets what GetOperatorSetCount returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetOperatorSetCount(arg0 common.Address, out0 *big.Int) {
+	_FakeAllocationManager.Contract.Stub("getOperatorSetCount", []interface{}{arg0}, out0)
+}
+
+// GetPendingSlasher returns the values stubbed with StubGetPendingSlasher.
+func (_FakeAllocationManager *FakeAllocationManager) GetPendingSlasher(opts *bind.CallOpts, arg0 OperatorSet) (struct {
+	PendingSlasher common.Address
+	EffectBlock    uint32
+}, error) {
+	var out0 struct {
+		PendingSlasher common.Address
+		EffectBlock    uint32
+	}
+	err := _FakeAllocationManager.Contract.Read("getPendingSlasher", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubGetPendingSlasher sets what GetPendingSlasher returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetPendingSlasher(arg0 OperatorSet, out0 struct {
+	PendingSlasher common.Address
+	EffectBlock    uint32
+}) {
+	_FakeAllocationManager.Contract.Stub("getPendingSlasher", []interface{}{arg0}, out0)
+}
+
+// GetRedistributionRecipient returns the values stubbed with StubGetRedistributionRecipient.
+func (_FakeAllocationManager *FakeAllocationManager) GetRedistributionRecipient(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManager.Contract.Read("getRedistributionRecipient", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetRedistributionRecipient sets what GetRedistributionRecipient returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetRedistributionRecipient(operatorSet OperatorSet, out0 common.Address) {
+	_FakeAllocationManager.Contract.Stub("getRedistributionRecipient", []interface{}{operatorSet}, out0)
+}
+
+// GetRegisteredSets returns the values stubbed with StubGetRegisteredSets.
+func (_FakeAllocationManager *FakeAllocationManager) GetRegisteredSets(opts *bind.CallOpts, arg0 common.Address) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeAllocationManager.Contract.Read("getRegisteredSets", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubGetRegisteredSets sets what GetRegisteredSets returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetRegisteredSets(arg0 common.Address, out0 []OperatorSet) {
+	_FakeAllocationManager.Contract.Stub("getRegisteredSets", []interface{}{arg0}, out0)
+}
+
+// GetSlashCount returns the values stubbed with StubGetSlashCount.
+func (_FakeAllocationManager *FakeAllocationManager) GetSlashCount(opts *bind.CallOpts, arg0 OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeAllocationManager.Contract.Read("getSlashCount", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubGetSlashCount sets what GetSlashCount returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetSlashCount(arg0 OperatorSet, out0 *big.Int) {
+	_FakeAllocationManager.Contract.Stub("getSlashCount", []interface{}{arg0}, out0)
+}
+
+// GetSlasher returns the values stubbed with StubGetSlasher.
+func (_FakeAllocationManager *FakeAllocationManager) GetSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManager.Contract.Read("getSlasher", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetSlasher sets what GetSlasher returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetSlasher(operatorSet OperatorSet, out0 common.Address) {
+	_FakeAllocationManager.Contract.Stub("getSlasher", []interface{}{operatorSet}, out0)
+}
+
+// GetStrategiesInOperatorSet returns the values stubbed with StubGetStrategiesInOperatorSet.
+func (_FakeAllocationManager *FakeAllocationManager) GetStrategiesInOperatorSet(opts *bind.CallOpts, arg0 OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeAllocationManager.Contract.Read("getStrategiesInOperatorSet", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubGetStrategiesInOperatorSet sets what GetStrategiesInOperatorSet returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetStrategiesInOperatorSet(arg0 OperatorSet, out0 []common.Address) {
+	_FakeAllocationManager.Contract.Stub("getStrategiesInOperatorSet", []interface{}{arg0}, out0)
+}
+
+// GetStrategyAllocations returns the values stubbed with StubGetStrategyAllocations.
+func (_FakeAllocationManager *FakeAllocationManager) GetStrategyAllocations(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (struct {
+	OperatorSets []OperatorSet
+	Allocations  []IAllocationManagerTypesAllocation
+}, error) {
+	var out0 struct {
+		OperatorSets []OperatorSet
+		Allocations  []IAllocationManagerTypesAllocation
+	}
+	err := _FakeAllocationManager.Contract.Read("getStrategyAllocations", []interface{}{arg0, arg1}, &out0)
+	return out0, err
+}
+
+// StubGetStrategyAllocations sets what GetStrategyAllocations returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubGetStrategyAllocations(arg0 common.Address, arg1 common.Address, out0 struct {
+	OperatorSets []OperatorSet
+	Allocations  []IAllocationManagerTypesAllocation
+}) {
+	_FakeAllocationManager.Contract.Stub("getStrategyAllocations", []interface{}{arg0, arg1}, out0)
+}
+
+// IsMemberOfOperatorSet returns the values stubbed with StubIsMemberOfOperatorSet.
+func (_FakeAllocationManager *FakeAllocationManager) IsMemberOfOperatorSet(opts *bind.CallOpts, arg0 common.Address, arg1 OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManager.Contract.Read("isMemberOfOperatorSet", []interface{}{arg0, arg1}, &out0)
+	return out0, err
+}
+
+// StubIsMemberOfOperatorSet sets what IsMemberOfOperatorSet returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubIsMemberOfOperatorSet(arg0 common.Address, arg1 OperatorSet, out0 bool) {
+	_FakeAllocationManager.Contract.Stub("isMemberOfOperatorSet", []interface{}{arg0, arg1}, out0)
+}
+
+// IsOperatorRedistributable returns the values stubbed with StubIsOperatorRedistributable.
+func (_FakeAllocationManager *FakeAllocationManager) IsOperatorRedistributable(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManager.Contract.Read("isOperatorRedistributable", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorRedistributable sets what IsOperatorRedistributable returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubIsOperatorRedistributable(arg0 common.Address, out0 bool) {
+	_FakeAllocationManager.Contract.Stub("isOperatorRedistributable", []interface{}{arg0}, out0)
+}
+
+// IsOperatorSet returns the values stubbed with StubIsOperatorSet.
+func (_FakeAllocationManager *FakeAllocationManager) IsOperatorSet(opts *bind.CallOpts, arg0 OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManager.Contract.Read("isOperatorSet", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorSet sets what IsOperatorSet returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubIsOperatorSet(arg0 OperatorSet, out0 bool) {
+	_FakeAllocationManager.Contract.Stub("isOperatorSet", []interface{}{arg0}, out0)
+}
+
+// IsOperatorSlashable returns the values stubbed with StubIsOperatorSlashable.
+func (_FakeAllocationManager *FakeAllocationManager) IsOperatorSlashable(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManager.Contract.Read("isOperatorSlashable", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorSlashable sets what IsOperatorSlashable returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubIsOperatorSlashable(operator common.Address, operatorSet OperatorSet, out0 bool) {
+	_FakeAllocationManager.Contract.Stub("isOperatorSlashable", []interface{}{operator, operatorSet}, out0)
+}
+
+// IsRedistributingOperatorSet returns the values stubbed with StubIsRedistributingOperatorSet.
+func (_FakeAllocationManager *FakeAllocationManager) IsRedistributingOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManager.Contract.Read("isRedistributingOperatorSet", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsRedistributingOperatorSet sets what IsRedistributingOperatorSet returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubIsRedistributingOperatorSet(operatorSet OperatorSet, out0 bool) {
+	_FakeAllocationManager.Contract.Stub("isRedistributingOperatorSet", []interface{}{operatorSet}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeAllocationManager *FakeAllocationManager) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManager.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubPaused(index uint8, out0 bool) {
+	_FakeAllocationManager.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeAllocationManager *FakeAllocationManager) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeAllocationManager.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubPaused0(out0 *big.Int) {
+	_FakeAllocationManager.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeAllocationManager *FakeAllocationManager) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManager.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubPauserRegistry(out0 common.Address) {
+	_FakeAllocationManager.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// PermissionController returns the values stubbed with StubPermissionController.
+func (_FakeAllocationManager *FakeAllocationManager) PermissionController(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManager.Contract.Read("permissionController", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPermissionController sets what PermissionController returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubPermissionController(out0 common.Address) {
+	_FakeAllocationManager.Contract.Stub("permissionController", []interface{}{}, out0)
+}
+
+// ViewImplementation returns the values stubbed with StubViewImplementation.
+func (_FakeAllocationManager *FakeAllocationManager) ViewImplementation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManager.Contract.Read("viewImplementation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubViewImplementation sets what ViewImplementation returns for the given arguments.
+func (_FakeAllocationManager *FakeAllocationManager) StubViewImplementation(out0 common.Address) {
+	_FakeAllocationManager.Contract.Stub("viewImplementation", []interface{}{}, out0)
+}
+
+// AddStrategiesToOperatorSet records a addStrategiesToOperatorSet call after running the hook set with OnAddStrategiesToOperatorSet.
+func (_FakeAllocationManager *FakeAllocationManager) AddStrategiesToOperatorSet(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "addStrategiesToOperatorSet", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("addStrategiesToOperatorSet").(func(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) error); ok {
+			return hook(opts, avs, operatorSetId, strategies)
+		}
+		return nil
+	}, avs, operatorSetId, strategies)
+}
+
+// OnAddStrategiesToOperatorSet sets a hook run by every AddStrategiesToOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnAddStrategiesToOperatorSet(hook func(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) error) {
+	_FakeAllocationManager.Contract.SetHook("addStrategiesToOperatorSet", hook)
+}
+
+// ClearDeallocationQueue records a clearDeallocationQueue call after running the hook set with OnClearDeallocationQueue.
+func (_FakeAllocationManager *FakeAllocationManager) ClearDeallocationQueue(opts *bind.TransactOpts, operator common.Address, strategies []common.Address, numToClear []uint16) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "clearDeallocationQueue", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("clearDeallocationQueue").(func(opts *bind.TransactOpts, operator common.Address, strategies []common.Address, numToClear []uint16) error); ok {
+			return hook(opts, operator, strategies, numToClear)
+		}
+		return nil
+	}, operator, strategies, numToClear)
+}
+
+// OnClearDeallocationQueue sets a hook run by every ClearDeallocationQueue call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnClearDeallocationQueue(hook func(opts *bind.TransactOpts, operator common.Address, strategies []common.Address, numToClear []uint16) error) {
+	_FakeAllocationManager.Contract.SetHook("clearDeallocationQueue", hook)
+}
+
+// CreateOperatorSets records a createOperatorSets call after running the hook set with OnCreateOperatorSets.
+func (_FakeAllocationManager *FakeAllocationManager) CreateOperatorSets(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "createOperatorSets", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("createOperatorSets").(func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams) error); ok {
+			return hook(opts, avs, params)
+		}
+		return nil
+	}, avs, params)
+}
+
+// OnCreateOperatorSets sets a hook run by every CreateOperatorSets call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnCreateOperatorSets(hook func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams) error) {
+	_FakeAllocationManager.Contract.SetHook("createOperatorSets", hook)
+}
+
+// CreateOperatorSets0 records a createOperatorSets0 call after running the hook set with OnCreateOperatorSets0.
+func (_FakeAllocationManager *FakeAllocationManager) CreateOperatorSets0(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "createOperatorSets0", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("createOperatorSets0").(func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2) error); ok {
+			return hook(opts, avs, params)
+		}
+		return nil
+	}, avs, params)
+}
+
+// OnCreateOperatorSets0 sets a hook run by every CreateOperatorSets0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnCreateOperatorSets0(hook func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2) error) {
+	_FakeAllocationManager.Contract.SetHook("createOperatorSets0", hook)
+}
+
+// CreateRedistributingOperatorSets records a createRedistributingOperatorSets call after running the hook set with OnCreateRedistributingOperatorSets.
+func (_FakeAllocationManager *FakeAllocationManager) CreateRedistributingOperatorSets(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams, redistributionRecipients []common.Address) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "createRedistributingOperatorSets", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("createRedistributingOperatorSets").(func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams, redistributionRecipients []common.Address) error); ok {
+			return hook(opts, avs, params, redistributionRecipients)
+		}
+		return nil
+	}, avs, params, redistributionRecipients)
+}
+
+// OnCreateRedistributingOperatorSets sets a hook run by every CreateRedistributingOperatorSets call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnCreateRedistributingOperatorSets(hook func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams, redistributionRecipients []common.Address) error) {
+	_FakeAllocationManager.Contract.SetHook("createRedistributingOperatorSets", hook)
+}
+
+// CreateRedistributingOperatorSets0 records a createRedistributingOperatorSets0 call after running the hook set with OnCreateRedistributingOperatorSets0.
+func (_FakeAllocationManager *FakeAllocationManager) CreateRedistributingOperatorSets0(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2, redistributionRecipients []common.Address) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "createRedistributingOperatorSets0", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("createRedistributingOperatorSets0").(func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2, redistributionRecipients []common.Address) error); ok {
+			return hook(opts, avs, params, redistributionRecipients)
+		}
+		return nil
+	}, avs, params, redistributionRecipients)
+}
+
+// OnCreateRedistributingOperatorSets0 sets a hook run by every CreateRedistributingOperatorSets0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnCreateRedistributingOperatorSets0(hook func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2, redistributionRecipients []common.Address) error) {
+	_FakeAllocationManager.Contract.SetHook("createRedistributingOperatorSets0", hook)
+}
+
+// DeregisterFromOperatorSets records a deregisterFromOperatorSets call after running the hook set with OnDeregisterFromOperatorSets.
+func (_FakeAllocationManager *FakeAllocationManager) DeregisterFromOperatorSets(opts *bind.TransactOpts, params IAllocationManagerTypesDeregisterParams) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "deregisterFromOperatorSets", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("deregisterFromOperatorSets").(func(opts *bind.TransactOpts, params IAllocationManagerTypesDeregisterParams) error); ok {
+			return hook(opts, params)
+		}
+		return nil
+	}, params)
+}
+
+// OnDeregisterFromOperatorSets sets a hook run by every DeregisterFromOperatorSets call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnDeregisterFromOperatorSets(hook func(opts *bind.TransactOpts, params IAllocationManagerTypesDeregisterParams) error) {
+	_FakeAllocationManager.Contract.SetHook("deregisterFromOperatorSets", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeAllocationManager *FakeAllocationManager) Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialPausedStatus)
+		}
+		return nil
+	}, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnInitialize(hook func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error) {
+	_FakeAllocationManager.Contract.SetHook("initialize", hook)
+}
+
+// MigrateSlashers records a migrateSlashers call after running the hook set with OnMigrateSlashers.
+func (_FakeAllocationManager *FakeAllocationManager) MigrateSlashers(opts *bind.TransactOpts, operatorSets []OperatorSet) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "migrateSlashers", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("migrateSlashers").(func(opts *bind.TransactOpts, operatorSets []OperatorSet) error); ok {
+			return hook(opts, operatorSets)
+		}
+		return nil
+	}, operatorSets)
+}
+
+// OnMigrateSlashers sets a hook run by every MigrateSlashers call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnMigrateSlashers(hook func(opts *bind.TransactOpts, operatorSets []OperatorSet) error) {
+	_FakeAllocationManager.Contract.SetHook("migrateSlashers", hook)
+}
+
+// ModifyAllocations records a modifyAllocations call after running the hook set with OnModifyAllocations.
+func (_FakeAllocationManager *FakeAllocationManager) ModifyAllocations(opts *bind.TransactOpts, operator common.Address, params []IAllocationManagerTypesAllocateParams) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "modifyAllocations", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("modifyAllocations").(func(opts *bind.TransactOpts, operator common.Address, params []IAllocationManagerTypesAllocateParams) error); ok {
+			return hook(opts, operator, params)
+		}
+		return nil
+	}, operator, params)
+}
+
+// OnModifyAllocations sets a hook run by every ModifyAllocations call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnModifyAllocations(hook func(opts *bind.TransactOpts, operator common.Address, params []IAllocationManagerTypesAllocateParams) error) {
+	_FakeAllocationManager.Contract.SetHook("modifyAllocations", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeAllocationManager *FakeAllocationManager) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeAllocationManager.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeAllocationManager *FakeAllocationManager) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeAllocationManager.Contract.SetHook("pauseAll", hook)
+}
+
+// RegisterForOperatorSets records a registerForOperatorSets call after running the hook set with OnRegisterForOperatorSets.
+func (_FakeAllocationManager *FakeAllocationManager) RegisterForOperatorSets(opts *bind.TransactOpts, operator common.Address, params IAllocationManagerTypesRegisterParams) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "registerForOperatorSets", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("registerForOperatorSets").(func(opts *bind.TransactOpts, operator common.Address, params IAllocationManagerTypesRegisterParams) error); ok {
+			return hook(opts, operator, params)
+		}
+		return nil
+	}, operator, params)
+}
+
+// OnRegisterForOperatorSets sets a hook run by every RegisterForOperatorSets call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnRegisterForOperatorSets(hook func(opts *bind.TransactOpts, operator common.Address, params IAllocationManagerTypesRegisterParams) error) {
+	_FakeAllocationManager.Contract.SetHook("registerForOperatorSets", hook)
+}
+
+// RemoveStrategiesFromOperatorSet records a removeStrategiesFromOperatorSet call after running the hook set with OnRemoveStrategiesFromOperatorSet.
+func (_FakeAllocationManager *FakeAllocationManager) RemoveStrategiesFromOperatorSet(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "removeStrategiesFromOperatorSet", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("removeStrategiesFromOperatorSet").(func(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) error); ok {
+			return hook(opts, avs, operatorSetId, strategies)
+		}
+		return nil
+	}, avs, operatorSetId, strategies)
+}
+
+// OnRemoveStrategiesFromOperatorSet sets a hook run by every RemoveStrategiesFromOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnRemoveStrategiesFromOperatorSet(hook func(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) error) {
+	_FakeAllocationManager.Contract.SetHook("removeStrategiesFromOperatorSet", hook)
+}
+
+// SetAVSRegistrar records a setAVSRegistrar call after running the hook set with OnSetAVSRegistrar.
+func (_FakeAllocationManager *FakeAllocationManager) SetAVSRegistrar(opts *bind.TransactOpts, avs common.Address, registrar common.Address) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "setAVSRegistrar", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("setAVSRegistrar").(func(opts *bind.TransactOpts, avs common.Address, registrar common.Address) error); ok {
+			return hook(opts, avs, registrar)
+		}
+		return nil
+	}, avs, registrar)
+}
+
+// OnSetAVSRegistrar sets a hook run by every SetAVSRegistrar call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnSetAVSRegistrar(hook func(opts *bind.TransactOpts, avs common.Address, registrar common.Address) error) {
+	_FakeAllocationManager.Contract.SetHook("setAVSRegistrar", hook)
+}
+
+// SetAllocationDelay records a setAllocationDelay call after running the hook set with OnSetAllocationDelay.
+func (_FakeAllocationManager *FakeAllocationManager) SetAllocationDelay(opts *bind.TransactOpts, operator common.Address, delay uint32) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "setAllocationDelay", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("setAllocationDelay").(func(opts *bind.TransactOpts, operator common.Address, delay uint32) error); ok {
+			return hook(opts, operator, delay)
+		}
+		return nil
+	}, operator, delay)
+}
+
+// OnSetAllocationDelay sets a hook run by every SetAllocationDelay call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnSetAllocationDelay(hook func(opts *bind.TransactOpts, operator common.Address, delay uint32) error) {
+	_FakeAllocationManager.Contract.SetHook("setAllocationDelay", hook)
+}
+
+// SlashOperator records a slashOperator call after running the hook set with OnSlashOperator.
+func (_FakeAllocationManager *FakeAllocationManager) SlashOperator(opts *bind.TransactOpts, avs common.Address, params IAllocationManagerTypesSlashingParams) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "slashOperator", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("slashOperator").(func(opts *bind.TransactOpts, avs common.Address, params IAllocationManagerTypesSlashingParams) error); ok {
+			return hook(opts, avs, params)
+		}
+		return nil
+	}, avs, params)
+}
+
+// OnSlashOperator sets a hook run by every SlashOperator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnSlashOperator(hook func(opts *bind.TransactOpts, avs common.Address, params IAllocationManagerTypesSlashingParams) error) {
+	_FakeAllocationManager.Contract.SetHook("slashOperator", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeAllocationManager *FakeAllocationManager) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeAllocationManager.Contract.SetHook("unpause", hook)
+}
+
+// UpdateAVSMetadataURI records a updateAVSMetadataURI call after running the hook set with OnUpdateAVSMetadataURI.
+func (_FakeAllocationManager *FakeAllocationManager) UpdateAVSMetadataURI(opts *bind.TransactOpts, avs common.Address, metadataURI string) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "updateAVSMetadataURI", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("updateAVSMetadataURI").(func(opts *bind.TransactOpts, avs common.Address, metadataURI string) error); ok {
+			return hook(opts, avs, metadataURI)
+		}
+		return nil
+	}, avs, metadataURI)
+}
+
+// OnUpdateAVSMetadataURI sets a hook run by every UpdateAVSMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnUpdateAVSMetadataURI(hook func(opts *bind.TransactOpts, avs common.Address, metadataURI string) error) {
+	_FakeAllocationManager.Contract.SetHook("updateAVSMetadataURI", hook)
+}
+
+// UpdateSlasher records a updateSlasher call after running the hook set with OnUpdateSlasher.
+func (_FakeAllocationManager *FakeAllocationManager) UpdateSlasher(opts *bind.TransactOpts, operatorSet OperatorSet, slasher common.Address) (*types.Transaction, error) {
+	return _FakeAllocationManager.Contract.Transact(opts, "updateSlasher", func() error {
+		if hook, ok := _FakeAllocationManager.Contract.Hook("updateSlasher").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slasher common.Address) error); ok {
+			return hook(opts, operatorSet, slasher)
+		}
+		return nil
+	}, operatorSet, slasher)
+}
+
+// OnUpdateSlasher sets a hook run by every UpdateSlasher call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeAllocationManager *FakeAllocationManager) OnUpdateSlasher(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slasher common.Address) error) {
+	_FakeAllocationManager.Contract.SetHook("updateSlasher", hook)
+}
+
+// EmitAVSMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitAVSMetadataURIUpdated(event *AllocationManagerAVSMetadataURIUpdated) {
+	_FakeAllocationManager.Contract.Emit("AVSMetadataURIUpdated", event)
+}
+
+// EmitAVSRegistrarSet appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitAVSRegistrarSet(event *AllocationManagerAVSRegistrarSet) {
+	_FakeAllocationManager.Contract.Emit("AVSRegistrarSet", event)
+}
+
+// EmitAllocationDelaySet appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitAllocationDelaySet(event *AllocationManagerAllocationDelaySet) {
+	_FakeAllocationManager.Contract.Emit("AllocationDelaySet", event)
+}
+
+// EmitAllocationUpdated appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitAllocationUpdated(event *AllocationManagerAllocationUpdated) {
+	_FakeAllocationManager.Contract.Emit("AllocationUpdated", event)
+}
+
+// EmitEncumberedMagnitudeUpdated appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitEncumberedMagnitudeUpdated(event *AllocationManagerEncumberedMagnitudeUpdated) {
+	_FakeAllocationManager.Contract.Emit("EncumberedMagnitudeUpdated", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitInitialized(event *AllocationManagerInitialized) {
+	_FakeAllocationManager.Contract.Emit("Initialized", event)
+}
+
+// EmitMaxMagnitudeUpdated appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitMaxMagnitudeUpdated(event *AllocationManagerMaxMagnitudeUpdated) {
+	_FakeAllocationManager.Contract.Emit("MaxMagnitudeUpdated", event)
+}
+
+// EmitOperatorAddedToOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitOperatorAddedToOperatorSet(event *AllocationManagerOperatorAddedToOperatorSet) {
+	_FakeAllocationManager.Contract.Emit("OperatorAddedToOperatorSet", event)
+}
+
+// EmitOperatorRemovedFromOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitOperatorRemovedFromOperatorSet(event *AllocationManagerOperatorRemovedFromOperatorSet) {
+	_FakeAllocationManager.Contract.Emit("OperatorRemovedFromOperatorSet", event)
+}
+
+// EmitOperatorSetCreated appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitOperatorSetCreated(event *AllocationManagerOperatorSetCreated) {
+	_FakeAllocationManager.Contract.Emit("OperatorSetCreated", event)
+}
+
+// EmitOperatorSlashed appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitOperatorSlashed(event *AllocationManagerOperatorSlashed) {
+	_FakeAllocationManager.Contract.Emit("OperatorSlashed", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitPaused(event *AllocationManagerPaused) {
+	_FakeAllocationManager.Contract.Emit("Paused", event)
+}
+
+// EmitRedistributionAddressSet appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitRedistributionAddressSet(event *AllocationManagerRedistributionAddressSet) {
+	_FakeAllocationManager.Contract.Emit("RedistributionAddressSet", event)
+}
+
+// EmitSlasherMigrated appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitSlasherMigrated(event *AllocationManagerSlasherMigrated) {
+	_FakeAllocationManager.Contract.Emit("SlasherMigrated", event)
+}
+
+// EmitSlasherUpdated appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitSlasherUpdated(event *AllocationManagerSlasherUpdated) {
+	_FakeAllocationManager.Contract.Emit("SlasherUpdated", event)
+}
+
+// EmitStrategyAddedToOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitStrategyAddedToOperatorSet(event *AllocationManagerStrategyAddedToOperatorSet) {
+	_FakeAllocationManager.Contract.Emit("StrategyAddedToOperatorSet", event)
+}
+
+// EmitStrategyRemovedFromOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitStrategyRemovedFromOperatorSet(event *AllocationManagerStrategyRemovedFromOperatorSet) {
+	_FakeAllocationManager.Contract.Emit("StrategyRemovedFromOperatorSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeAllocationManager *FakeAllocationManager) EmitUnpaused(event *AllocationManagerUnpaused) {
+	_FakeAllocationManager.Contract.Emit("Unpaused", event)
+}
@@ -0,0 +1,156 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AllocationManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// AllocationManagerReader is the read-only surface of the AllocationManager
+// binding, implemented by AllocationManagerCaller, AllocationManager and FakeAllocationManager.
+type AllocationManagerReader interface {
+	ALLOCATIONCONFIGURATIONDELAY(opts *bind.CallOpts) (uint32, error)
+	DEALLOCATIONDELAY(opts *bind.CallOpts) (uint32, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	EigenStrategy(opts *bind.CallOpts) (common.Address, error)
+	GetAVSRegistrar(opts *bind.CallOpts, avs common.Address) (common.Address, error)
+	GetAllocatableMagnitude(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (uint64, error)
+	GetAllocatedSets(opts *bind.CallOpts, arg0 common.Address) ([]OperatorSet, error)
+	GetAllocatedStake(opts *bind.CallOpts, arg0 OperatorSet, arg1 []common.Address, arg2 []common.Address) ([][]*big.Int, error)
+	GetAllocatedStrategies(opts *bind.CallOpts, arg0 common.Address, arg1 OperatorSet) ([]common.Address, error)
+	GetAllocation(opts *bind.CallOpts, arg0 common.Address, arg1 OperatorSet, arg2 common.Address) (IAllocationManagerTypesAllocation, error)
+	GetAllocationDelay(opts *bind.CallOpts, operator common.Address) (bool, uint32, error)
+	GetAllocations(opts *bind.CallOpts, arg0 []common.Address, arg1 OperatorSet, arg2 common.Address) ([]IAllocationManagerTypesAllocation, error)
+	GetEncumberedMagnitude(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (uint64, error)
+	GetMaxMagnitude(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (uint64, error)
+	GetMaxMagnitudes(opts *bind.CallOpts, arg0 []common.Address, arg1 common.Address) ([]uint64, error)
+	GetMaxMagnitudes0(opts *bind.CallOpts, arg0 common.Address, arg1 []common.Address) ([]uint64, error)
+	GetMaxMagnitudesAtBlock(opts *bind.CallOpts, arg0 common.Address, arg1 []common.Address, arg2 uint32) ([]uint64, error)
+	GetMemberCount(opts *bind.CallOpts, arg0 OperatorSet) (*big.Int, error)
+	GetMembers(opts *bind.CallOpts, arg0 OperatorSet) ([]common.Address, error)
+	GetMinimumSlashableStake(opts *bind.CallOpts, arg0 OperatorSet, arg1 []common.Address, arg2 []common.Address, arg3 uint32) ([][]*big.Int, error)
+	GetOperatorSetCount(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	GetPendingSlasher(opts *bind.CallOpts, arg0 OperatorSet) (struct {
+		PendingSlasher common.Address
+		EffectBlock    uint32
+	}, error)
+	GetRedistributionRecipient(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetRegisteredSets(opts *bind.CallOpts, arg0 common.Address) ([]OperatorSet, error)
+	GetSlashCount(opts *bind.CallOpts, arg0 OperatorSet) (*big.Int, error)
+	GetSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetStrategiesInOperatorSet(opts *bind.CallOpts, arg0 OperatorSet) ([]common.Address, error)
+	GetStrategyAllocations(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address) (struct {
+		OperatorSets []OperatorSet
+		Allocations  []IAllocationManagerTypesAllocation
+	}, error)
+	IsMemberOfOperatorSet(opts *bind.CallOpts, arg0 common.Address, arg1 OperatorSet) (bool, error)
+	IsOperatorRedistributable(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	IsOperatorSet(opts *bind.CallOpts, arg0 OperatorSet) (bool, error)
+	IsOperatorSlashable(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error)
+	IsRedistributingOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PermissionController(opts *bind.CallOpts) (common.Address, error)
+	ViewImplementation(opts *bind.CallOpts) (common.Address, error)
+}
+
+// AllocationManagerWriter is the transacting surface of the AllocationManager
+// binding, implemented by AllocationManagerTransactor, AllocationManager and FakeAllocationManager.
+type AllocationManagerWriter interface {
+	AddStrategiesToOperatorSet(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) (*types.Transaction, error)
+	ClearDeallocationQueue(opts *bind.TransactOpts, operator common.Address, strategies []common.Address, numToClear []uint16) (*types.Transaction, error)
+	CreateOperatorSets(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams) (*types.Transaction, error)
+	CreateOperatorSets0(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2) (*types.Transaction, error)
+	CreateRedistributingOperatorSets(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams, redistributionRecipients []common.Address) (*types.Transaction, error)
+	CreateRedistributingOperatorSets0(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2, redistributionRecipients []common.Address) (*types.Transaction, error)
+	DeregisterFromOperatorSets(opts *bind.TransactOpts, params IAllocationManagerTypesDeregisterParams) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error)
+	MigrateSlashers(opts *bind.TransactOpts, operatorSets []OperatorSet) (*types.Transaction, error)
+	ModifyAllocations(opts *bind.TransactOpts, operator common.Address, params []IAllocationManagerTypesAllocateParams) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RegisterForOperatorSets(opts *bind.TransactOpts, operator common.Address, params IAllocationManagerTypesRegisterParams) (*types.Transaction, error)
+	RemoveStrategiesFromOperatorSet(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) (*types.Transaction, error)
+	SetAVSRegistrar(opts *bind.TransactOpts, avs common.Address, registrar common.Address) (*types.Transaction, error)
+	SetAllocationDelay(opts *bind.TransactOpts, operator common.Address, delay uint32) (*types.Transaction, error)
+	SlashOperator(opts *bind.TransactOpts, avs common.Address, params IAllocationManagerTypesSlashingParams) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateAVSMetadataURI(opts *bind.TransactOpts, avs common.Address, metadataURI string) (*types.Transaction, error)
+	UpdateSlasher(opts *bind.TransactOpts, operatorSet OperatorSet, slasher common.Address) (*types.Transaction, error)
+}
+
+// AllocationManagerEvents is the event filtering surface of the AllocationManager
+// binding, implemented by AllocationManagerFilterer, AllocationManager and FakeAllocationManager.
+type AllocationManagerEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*AllocationManagerAVSMetadataURIUpdatedIterator, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*AllocationManagerAVSMetadataURIUpdated, error)
+	FilterAVSRegistrarSet(opts *bind.FilterOpts) (*AllocationManagerAVSRegistrarSetIterator, error)
+	WatchAVSRegistrarSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerAVSRegistrarSet) (event.Subscription, error)
+	ParseAVSRegistrarSet(log types.Log) (*AllocationManagerAVSRegistrarSet, error)
+	FilterAllocationDelaySet(opts *bind.FilterOpts) (*AllocationManagerAllocationDelaySetIterator, error)
+	WatchAllocationDelaySet(opts *bind.WatchOpts, sink chan<- *AllocationManagerAllocationDelaySet) (event.Subscription, error)
+	ParseAllocationDelaySet(log types.Log) (*AllocationManagerAllocationDelaySet, error)
+	FilterAllocationUpdated(opts *bind.FilterOpts) (*AllocationManagerAllocationUpdatedIterator, error)
+	WatchAllocationUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerAllocationUpdated) (event.Subscription, error)
+	ParseAllocationUpdated(log types.Log) (*AllocationManagerAllocationUpdated, error)
+	FilterEncumberedMagnitudeUpdated(opts *bind.FilterOpts) (*AllocationManagerEncumberedMagnitudeUpdatedIterator, error)
+	WatchEncumberedMagnitudeUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerEncumberedMagnitudeUpdated) (event.Subscription, error)
+	ParseEncumberedMagnitudeUpdated(log types.Log) (*AllocationManagerEncumberedMagnitudeUpdated, error)
+	FilterInitialized(opts *bind.FilterOpts) (*AllocationManagerInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *AllocationManagerInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*AllocationManagerInitialized, error)
+	FilterMaxMagnitudeUpdated(opts *bind.FilterOpts) (*AllocationManagerMaxMagnitudeUpdatedIterator, error)
+	WatchMaxMagnitudeUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerMaxMagnitudeUpdated) (event.Subscription, error)
+	ParseMaxMagnitudeUpdated(log types.Log) (*AllocationManagerMaxMagnitudeUpdated, error)
+	FilterOperatorAddedToOperatorSet(opts *bind.FilterOpts, operator []common.Address) (*AllocationManagerOperatorAddedToOperatorSetIterator, error)
+	WatchOperatorAddedToOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerOperatorAddedToOperatorSet, operator []common.Address) (event.Subscription, error)
+	ParseOperatorAddedToOperatorSet(log types.Log) (*AllocationManagerOperatorAddedToOperatorSet, error)
+	FilterOperatorRemovedFromOperatorSet(opts *bind.FilterOpts, operator []common.Address) (*AllocationManagerOperatorRemovedFromOperatorSetIterator, error)
+	WatchOperatorRemovedFromOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerOperatorRemovedFromOperatorSet, operator []common.Address) (event.Subscription, error)
+	ParseOperatorRemovedFromOperatorSet(log types.Log) (*AllocationManagerOperatorRemovedFromOperatorSet, error)
+	FilterOperatorSetCreated(opts *bind.FilterOpts) (*AllocationManagerOperatorSetCreatedIterator, error)
+	WatchOperatorSetCreated(opts *bind.WatchOpts, sink chan<- *AllocationManagerOperatorSetCreated) (event.Subscription, error)
+	ParseOperatorSetCreated(log types.Log) (*AllocationManagerOperatorSetCreated, error)
+	FilterOperatorSlashed(opts *bind.FilterOpts) (*AllocationManagerOperatorSlashedIterator, error)
+	WatchOperatorSlashed(opts *bind.WatchOpts, sink chan<- *AllocationManagerOperatorSlashed) (event.Subscription, error)
+	ParseOperatorSlashed(log types.Log) (*AllocationManagerOperatorSlashed, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*AllocationManagerPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *AllocationManagerPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*AllocationManagerPaused, error)
+	FilterRedistributionAddressSet(opts *bind.FilterOpts) (*AllocationManagerRedistributionAddressSetIterator, error)
+	WatchRedistributionAddressSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerRedistributionAddressSet) (event.Subscription, error)
+	ParseRedistributionAddressSet(log types.Log) (*AllocationManagerRedistributionAddressSet, error)
+	FilterSlasherMigrated(opts *bind.FilterOpts) (*AllocationManagerSlasherMigratedIterator, error)
+	WatchSlasherMigrated(opts *bind.WatchOpts, sink chan<- *AllocationManagerSlasherMigrated) (event.Subscription, error)
+	ParseSlasherMigrated(log types.Log) (*AllocationManagerSlasherMigrated, error)
+	FilterSlasherUpdated(opts *bind.FilterOpts) (*AllocationManagerSlasherUpdatedIterator, error)
+	WatchSlasherUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerSlasherUpdated) (event.Subscription, error)
+	ParseSlasherUpdated(log types.Log) (*AllocationManagerSlasherUpdated, error)
+	FilterStrategyAddedToOperatorSet(opts *bind.FilterOpts) (*AllocationManagerStrategyAddedToOperatorSetIterator, error)
+	WatchStrategyAddedToOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStrategyAddedToOperatorSet) (event.Subscription, error)
+	ParseStrategyAddedToOperatorSet(log types.Log) (*AllocationManagerStrategyAddedToOperatorSet, error)
+	FilterStrategyRemovedFromOperatorSet(opts *bind.FilterOpts) (*AllocationManagerStrategyRemovedFromOperatorSetIterator, error)
+	WatchStrategyRemovedFromOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStrategyRemovedFromOperatorSet) (event.Subscription, error)
+	ParseStrategyRemovedFromOperatorSet(log types.Log) (*AllocationManagerStrategyRemovedFromOperatorSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*AllocationManagerUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *AllocationManagerUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*AllocationManagerUnpaused, error)
+}
+
+var (
+	_ AllocationManagerReader = (*AllocationManagerCaller)(nil)
+	_ AllocationManagerReader = (*AllocationManager)(nil)
+	_ AllocationManagerReader = (*FakeAllocationManager)(nil)
+	_ AllocationManagerWriter = (*AllocationManagerTransactor)(nil)
+	_ AllocationManagerWriter = (*AllocationManager)(nil)
+	_ AllocationManagerWriter = (*FakeAllocationManager)(nil)
+	_ AllocationManagerEvents = (*AllocationManagerFilterer)(nil)
+	_ AllocationManagerEvents = (*AllocationManager)(nil)
+	_ AllocationManagerEvents = (*FakeAllocationManager)(nil)
+)
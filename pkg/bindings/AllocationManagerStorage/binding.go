@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,11 +30,8 @@ var (
 	_ = abi.ConvertType
 )
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // AllocationManagerStorageMetaData contains all meta data concerning the AllocationManagerStorage contract.
 var AllocationManagerStorageMetaData = &bind.MetaData{
@@ -0,0 +1,151 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AllocationManagerStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeAllocationManagerStorage is an in-memory AllocationManagerStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded AllocationManagerStorageFilterer.
+type FakeAllocationManagerStorage struct {
+	*fake.Contract
+	*AllocationManagerStorageFilterer
+}
+
+// NewFakeAllocationManagerStorage returns an empty fake of the contract at address.
+func NewFakeAllocationManagerStorage(address common.Address) *FakeAllocationManagerStorage {
+	contract := fake.NewContract(AllocationManagerStorageMetaData, address)
+	filterer, err := NewAllocationManagerStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeAllocationManagerStorage{Contract: contract, AllocationManagerStorageFilterer: filterer}
+}
+
+// ALLOCATIONCONFIGURATIONDELAY returns the values stubbed with StubALLOCATIONCONFIGURATIONDELAY.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) ALLOCATIONCONFIGURATIONDELAY(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeAllocationManagerStorage.Contract.Read("ALLOCATION_CONFIGURATION_DELAY", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubALLOCATIONCONFIGURATIONDELAY sets what ALLOCATIONCONFIGURATIONDELAY returns for the given arguments.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) StubALLOCATIONCONFIGURATIONDELAY(out0 uint32) {
+	_FakeAllocationManagerStorage.Contract.Stub("ALLOCATION_CONFIGURATION_DELAY", []interface{}{}, out0)
+}
+
+// DEALLOCATIONDELAY returns the values stubbed with StubDEALLOCATIONDELAY.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) DEALLOCATIONDELAY(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeAllocationManagerStorage.Contract.Read("DEALLOCATION_DELAY", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEALLOCATIONDELAY sets what DEALLOCATIONDELAY returns for the given arguments.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) StubDEALLOCATIONDELAY(out0 uint32) {
+	_FakeAllocationManagerStorage.Contract.Stub("DEALLOCATION_DELAY", []interface{}{}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManagerStorage.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) StubDelegation(out0 common.Address) {
+	_FakeAllocationManagerStorage.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// EigenStrategy returns the values stubbed with StubEigenStrategy.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EigenStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManagerStorage.Contract.Read("eigenStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenStrategy sets what EigenStrategy returns for the given arguments.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) StubEigenStrategy(out0 common.Address) {
+	_FakeAllocationManagerStorage.Contract.Stub("eigenStrategy", []interface{}{}, out0)
+}
+
+// EmitAVSMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitAVSMetadataURIUpdated(event *AllocationManagerStorageAVSMetadataURIUpdated) {
+	_FakeAllocationManagerStorage.Contract.Emit("AVSMetadataURIUpdated", event)
+}
+
+// EmitAVSRegistrarSet appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitAVSRegistrarSet(event *AllocationManagerStorageAVSRegistrarSet) {
+	_FakeAllocationManagerStorage.Contract.Emit("AVSRegistrarSet", event)
+}
+
+// EmitAllocationDelaySet appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitAllocationDelaySet(event *AllocationManagerStorageAllocationDelaySet) {
+	_FakeAllocationManagerStorage.Contract.Emit("AllocationDelaySet", event)
+}
+
+// EmitAllocationUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitAllocationUpdated(event *AllocationManagerStorageAllocationUpdated) {
+	_FakeAllocationManagerStorage.Contract.Emit("AllocationUpdated", event)
+}
+
+// EmitEncumberedMagnitudeUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitEncumberedMagnitudeUpdated(event *AllocationManagerStorageEncumberedMagnitudeUpdated) {
+	_FakeAllocationManagerStorage.Contract.Emit("EncumberedMagnitudeUpdated", event)
+}
+
+// EmitMaxMagnitudeUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitMaxMagnitudeUpdated(event *AllocationManagerStorageMaxMagnitudeUpdated) {
+	_FakeAllocationManagerStorage.Contract.Emit("MaxMagnitudeUpdated", event)
+}
+
+// EmitOperatorAddedToOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitOperatorAddedToOperatorSet(event *AllocationManagerStorageOperatorAddedToOperatorSet) {
+	_FakeAllocationManagerStorage.Contract.Emit("OperatorAddedToOperatorSet", event)
+}
+
+// EmitOperatorRemovedFromOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitOperatorRemovedFromOperatorSet(event *AllocationManagerStorageOperatorRemovedFromOperatorSet) {
+	_FakeAllocationManagerStorage.Contract.Emit("OperatorRemovedFromOperatorSet", event)
+}
+
+// EmitOperatorSetCreated appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitOperatorSetCreated(event *AllocationManagerStorageOperatorSetCreated) {
+	_FakeAllocationManagerStorage.Contract.Emit("OperatorSetCreated", event)
+}
+
+// EmitOperatorSlashed appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitOperatorSlashed(event *AllocationManagerStorageOperatorSlashed) {
+	_FakeAllocationManagerStorage.Contract.Emit("OperatorSlashed", event)
+}
+
+// EmitRedistributionAddressSet appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitRedistributionAddressSet(event *AllocationManagerStorageRedistributionAddressSet) {
+	_FakeAllocationManagerStorage.Contract.Emit("RedistributionAddressSet", event)
+}
+
+// EmitSlasherMigrated appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitSlasherMigrated(event *AllocationManagerStorageSlasherMigrated) {
+	_FakeAllocationManagerStorage.Contract.Emit("SlasherMigrated", event)
+}
+
+// EmitSlasherUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitSlasherUpdated(event *AllocationManagerStorageSlasherUpdated) {
+	_FakeAllocationManagerStorage.Contract.Emit("SlasherUpdated", event)
+}
+
+// EmitStrategyAddedToOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitStrategyAddedToOperatorSet(event *AllocationManagerStorageStrategyAddedToOperatorSet) {
+	_FakeAllocationManagerStorage.Contract.Emit("StrategyAddedToOperatorSet", event)
+}
+
+// EmitStrategyRemovedFromOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManagerStorage *FakeAllocationManagerStorage) EmitStrategyRemovedFromOperatorSet(event *AllocationManagerStorageStrategyRemovedFromOperatorSet) {
+	_FakeAllocationManagerStorage.Contract.Emit("StrategyRemovedFromOperatorSet", event)
+}
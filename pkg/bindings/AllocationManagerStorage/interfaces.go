@@ -0,0 +1,78 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AllocationManagerStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// AllocationManagerStorageReader is the read-only surface of the AllocationManagerStorage
+// binding, implemented by AllocationManagerStorageCaller, AllocationManagerStorage and FakeAllocationManagerStorage.
+type AllocationManagerStorageReader interface {
+	ALLOCATIONCONFIGURATIONDELAY(opts *bind.CallOpts) (uint32, error)
+	DEALLOCATIONDELAY(opts *bind.CallOpts) (uint32, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	EigenStrategy(opts *bind.CallOpts) (common.Address, error)
+}
+
+// AllocationManagerStorageEvents is the event filtering surface of the AllocationManagerStorage
+// binding, implemented by AllocationManagerStorageFilterer, AllocationManagerStorage and FakeAllocationManagerStorage.
+type AllocationManagerStorageEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*AllocationManagerStorageAVSMetadataURIUpdatedIterator, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*AllocationManagerStorageAVSMetadataURIUpdated, error)
+	FilterAVSRegistrarSet(opts *bind.FilterOpts) (*AllocationManagerStorageAVSRegistrarSetIterator, error)
+	WatchAVSRegistrarSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageAVSRegistrarSet) (event.Subscription, error)
+	ParseAVSRegistrarSet(log types.Log) (*AllocationManagerStorageAVSRegistrarSet, error)
+	FilterAllocationDelaySet(opts *bind.FilterOpts) (*AllocationManagerStorageAllocationDelaySetIterator, error)
+	WatchAllocationDelaySet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageAllocationDelaySet) (event.Subscription, error)
+	ParseAllocationDelaySet(log types.Log) (*AllocationManagerStorageAllocationDelaySet, error)
+	FilterAllocationUpdated(opts *bind.FilterOpts) (*AllocationManagerStorageAllocationUpdatedIterator, error)
+	WatchAllocationUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageAllocationUpdated) (event.Subscription, error)
+	ParseAllocationUpdated(log types.Log) (*AllocationManagerStorageAllocationUpdated, error)
+	FilterEncumberedMagnitudeUpdated(opts *bind.FilterOpts) (*AllocationManagerStorageEncumberedMagnitudeUpdatedIterator, error)
+	WatchEncumberedMagnitudeUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageEncumberedMagnitudeUpdated) (event.Subscription, error)
+	ParseEncumberedMagnitudeUpdated(log types.Log) (*AllocationManagerStorageEncumberedMagnitudeUpdated, error)
+	FilterMaxMagnitudeUpdated(opts *bind.FilterOpts) (*AllocationManagerStorageMaxMagnitudeUpdatedIterator, error)
+	WatchMaxMagnitudeUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageMaxMagnitudeUpdated) (event.Subscription, error)
+	ParseMaxMagnitudeUpdated(log types.Log) (*AllocationManagerStorageMaxMagnitudeUpdated, error)
+	FilterOperatorAddedToOperatorSet(opts *bind.FilterOpts, operator []common.Address) (*AllocationManagerStorageOperatorAddedToOperatorSetIterator, error)
+	WatchOperatorAddedToOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageOperatorAddedToOperatorSet, operator []common.Address) (event.Subscription, error)
+	ParseOperatorAddedToOperatorSet(log types.Log) (*AllocationManagerStorageOperatorAddedToOperatorSet, error)
+	FilterOperatorRemovedFromOperatorSet(opts *bind.FilterOpts, operator []common.Address) (*AllocationManagerStorageOperatorRemovedFromOperatorSetIterator, error)
+	WatchOperatorRemovedFromOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageOperatorRemovedFromOperatorSet, operator []common.Address) (event.Subscription, error)
+	ParseOperatorRemovedFromOperatorSet(log types.Log) (*AllocationManagerStorageOperatorRemovedFromOperatorSet, error)
+	FilterOperatorSetCreated(opts *bind.FilterOpts) (*AllocationManagerStorageOperatorSetCreatedIterator, error)
+	WatchOperatorSetCreated(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageOperatorSetCreated) (event.Subscription, error)
+	ParseOperatorSetCreated(log types.Log) (*AllocationManagerStorageOperatorSetCreated, error)
+	FilterOperatorSlashed(opts *bind.FilterOpts) (*AllocationManagerStorageOperatorSlashedIterator, error)
+	WatchOperatorSlashed(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageOperatorSlashed) (event.Subscription, error)
+	ParseOperatorSlashed(log types.Log) (*AllocationManagerStorageOperatorSlashed, error)
+	FilterRedistributionAddressSet(opts *bind.FilterOpts) (*AllocationManagerStorageRedistributionAddressSetIterator, error)
+	WatchRedistributionAddressSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageRedistributionAddressSet) (event.Subscription, error)
+	ParseRedistributionAddressSet(log types.Log) (*AllocationManagerStorageRedistributionAddressSet, error)
+	FilterSlasherMigrated(opts *bind.FilterOpts) (*AllocationManagerStorageSlasherMigratedIterator, error)
+	WatchSlasherMigrated(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageSlasherMigrated) (event.Subscription, error)
+	ParseSlasherMigrated(log types.Log) (*AllocationManagerStorageSlasherMigrated, error)
+	FilterSlasherUpdated(opts *bind.FilterOpts) (*AllocationManagerStorageSlasherUpdatedIterator, error)
+	WatchSlasherUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageSlasherUpdated) (event.Subscription, error)
+	ParseSlasherUpdated(log types.Log) (*AllocationManagerStorageSlasherUpdated, error)
+	FilterStrategyAddedToOperatorSet(opts *bind.FilterOpts) (*AllocationManagerStorageStrategyAddedToOperatorSetIterator, error)
+	WatchStrategyAddedToOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageStrategyAddedToOperatorSet) (event.Subscription, error)
+	ParseStrategyAddedToOperatorSet(log types.Log) (*AllocationManagerStorageStrategyAddedToOperatorSet, error)
+	FilterStrategyRemovedFromOperatorSet(opts *bind.FilterOpts) (*AllocationManagerStorageStrategyRemovedFromOperatorSetIterator, error)
+	WatchStrategyRemovedFromOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerStorageStrategyRemovedFromOperatorSet) (event.Subscription, error)
+	ParseStrategyRemovedFromOperatorSet(log types.Log) (*AllocationManagerStorageStrategyRemovedFromOperatorSet, error)
+}
+
+var (
+	_ AllocationManagerStorageReader = (*AllocationManagerStorageCaller)(nil)
+	_ AllocationManagerStorageReader = (*AllocationManagerStorage)(nil)
+	_ AllocationManagerStorageReader = (*FakeAllocationManagerStorage)(nil)
+	_ AllocationManagerStorageEvents = (*AllocationManagerStorageFilterer)(nil)
+	_ AllocationManagerStorageEvents = (*AllocationManagerStorage)(nil)
+	_ AllocationManagerStorageEvents = (*FakeAllocationManagerStorage)(nil)
+)
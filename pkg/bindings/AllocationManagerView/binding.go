@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,18 +30,11 @@ var (
 	_ = abi.ConvertType
 )
 
-// IAllocationManagerTypesAllocation is an auto generated low-level Go binding around an user-defined struct.
-type IAllocationManagerTypesAllocation struct {
-	CurrentMagnitude uint64
-	PendingDiff      *big.Int
-	EffectBlock      uint32
-}
+// IAllocationManagerTypesAllocation is an alias of the canonical eltypes.IAllocationManagerTypesAllocation shared by all bindings.
+type IAllocationManagerTypesAllocation = eltypes.IAllocationManagerTypesAllocation
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // AllocationManagerViewMetaData contains all meta data concerning the AllocationManagerView contract.
 var AllocationManagerViewMetaData = &bind.MetaData{
@@ -0,0 +1,205 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AllocationManagerView
+
+// ErrAlreadyMemberOfSet is the typed form of the AllocationManagerView custom error AlreadyMemberOfSet().
+type ErrAlreadyMemberOfSet struct{}
+
+// Error implements the error interface.
+func (ErrAlreadyMemberOfSet) Error() string {
+	return "AllocationManagerView: AlreadyMemberOfSet()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the AllocationManagerView custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "AllocationManagerView: InputArrayLengthMismatch()"
+}
+
+// ErrInsufficientMagnitude is the typed form of the AllocationManagerView custom error InsufficientMagnitude().
+type ErrInsufficientMagnitude struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientMagnitude) Error() string {
+	return "AllocationManagerView: InsufficientMagnitude()"
+}
+
+// ErrInvalidAVSRegistrar is the typed form of the AllocationManagerView custom error InvalidAVSRegistrar().
+type ErrInvalidAVSRegistrar struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAVSRegistrar) Error() string {
+	return "AllocationManagerView: InvalidAVSRegistrar()"
+}
+
+// ErrInvalidCaller is the typed form of the AllocationManagerView custom error InvalidCaller().
+type ErrInvalidCaller struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCaller) Error() string {
+	return "AllocationManagerView: InvalidCaller()"
+}
+
+// ErrInvalidOperator is the typed form of the AllocationManagerView custom error InvalidOperator().
+type ErrInvalidOperator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperator) Error() string {
+	return "AllocationManagerView: InvalidOperator()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the AllocationManagerView custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "AllocationManagerView: InvalidOperatorSet()"
+}
+
+// ErrInvalidRedistributionRecipient is the typed form of the AllocationManagerView custom error InvalidRedistributionRecipient().
+type ErrInvalidRedistributionRecipient struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRedistributionRecipient) Error() string {
+	return "AllocationManagerView: InvalidRedistributionRecipient()"
+}
+
+// ErrInvalidStrategy is the typed form of the AllocationManagerView custom error InvalidStrategy().
+type ErrInvalidStrategy struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStrategy) Error() string {
+	return "AllocationManagerView: InvalidStrategy()"
+}
+
+// ErrInvalidWadToSlash is the typed form of the AllocationManagerView custom error InvalidWadToSlash().
+type ErrInvalidWadToSlash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidWadToSlash) Error() string {
+	return "AllocationManagerView: InvalidWadToSlash()"
+}
+
+// ErrModificationAlreadyPending is the typed form of the AllocationManagerView custom error ModificationAlreadyPending().
+type ErrModificationAlreadyPending struct{}
+
+// Error implements the error interface.
+func (ErrModificationAlreadyPending) Error() string {
+	return "AllocationManagerView: ModificationAlreadyPending()"
+}
+
+// ErrNonexistentAVSMetadata is the typed form of the AllocationManagerView custom error NonexistentAVSMetadata().
+type ErrNonexistentAVSMetadata struct{}
+
+// Error implements the error interface.
+func (ErrNonexistentAVSMetadata) Error() string {
+	return "AllocationManagerView: NonexistentAVSMetadata()"
+}
+
+// ErrNotMemberOfSet is the typed form of the AllocationManagerView custom error NotMemberOfSet().
+type ErrNotMemberOfSet struct{}
+
+// Error implements the error interface.
+func (ErrNotMemberOfSet) Error() string {
+	return "AllocationManagerView: NotMemberOfSet()"
+}
+
+// ErrOperatorNotSlashable is the typed form of the AllocationManagerView custom error OperatorNotSlashable().
+type ErrOperatorNotSlashable struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotSlashable) Error() string {
+	return "AllocationManagerView: OperatorNotSlashable()"
+}
+
+// ErrOperatorSetAlreadyMigrated is the typed form of the AllocationManagerView custom error OperatorSetAlreadyMigrated().
+type ErrOperatorSetAlreadyMigrated struct{}
+
+// Error implements the error interface.
+func (ErrOperatorSetAlreadyMigrated) Error() string {
+	return "AllocationManagerView: OperatorSetAlreadyMigrated()"
+}
+
+// ErrOutOfBounds is the typed form of the AllocationManagerView custom error OutOfBounds().
+type ErrOutOfBounds struct{}
+
+// Error implements the error interface.
+func (ErrOutOfBounds) Error() string {
+	return "AllocationManagerView: OutOfBounds()"
+}
+
+// ErrSameMagnitude is the typed form of the AllocationManagerView custom error SameMagnitude().
+type ErrSameMagnitude struct{}
+
+// Error implements the error interface.
+func (ErrSameMagnitude) Error() string {
+	return "AllocationManagerView: SameMagnitude()"
+}
+
+// ErrSlasherNotSet is the typed form of the AllocationManagerView custom error SlasherNotSet().
+type ErrSlasherNotSet struct{}
+
+// Error implements the error interface.
+func (ErrSlasherNotSet) Error() string {
+	return "AllocationManagerView: SlasherNotSet()"
+}
+
+// ErrStrategiesMustBeInAscendingOrder is the typed form of the AllocationManagerView custom error StrategiesMustBeInAscendingOrder().
+type ErrStrategiesMustBeInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrStrategiesMustBeInAscendingOrder) Error() string {
+	return "AllocationManagerView: StrategiesMustBeInAscendingOrder()"
+}
+
+// ErrStrategyAlreadyInOperatorSet is the typed form of the AllocationManagerView custom error StrategyAlreadyInOperatorSet().
+type ErrStrategyAlreadyInOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyInOperatorSet) Error() string {
+	return "AllocationManagerView: StrategyAlreadyInOperatorSet()"
+}
+
+// ErrStrategyNotInOperatorSet is the typed form of the AllocationManagerView custom error StrategyNotInOperatorSet().
+type ErrStrategyNotInOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotInOperatorSet) Error() string {
+	return "AllocationManagerView: StrategyNotInOperatorSet()"
+}
+
+// ErrUninitializedAllocationDelay is the typed form of the AllocationManagerView custom error UninitializedAllocationDelay().
+type ErrUninitializedAllocationDelay struct{}
+
+// Error implements the error interface.
+func (ErrUninitializedAllocationDelay) Error() string {
+	return "AllocationManagerView: UninitializedAllocationDelay()"
+}
+
+// AllocationManagerViewErrors maps the name of every custom error in the AllocationManagerView ABI to its typed Go error.
+var AllocationManagerViewErrors = map[string]error{
+	"AlreadyMemberOfSet":               ErrAlreadyMemberOfSet{},
+	"InputArrayLengthMismatch":         ErrInputArrayLengthMismatch{},
+	"InsufficientMagnitude":            ErrInsufficientMagnitude{},
+	"InvalidAVSRegistrar":              ErrInvalidAVSRegistrar{},
+	"InvalidCaller":                    ErrInvalidCaller{},
+	"InvalidOperator":                  ErrInvalidOperator{},
+	"InvalidOperatorSet":               ErrInvalidOperatorSet{},
+	"InvalidRedistributionRecipient":   ErrInvalidRedistributionRecipient{},
+	"InvalidStrategy":                  ErrInvalidStrategy{},
+	"InvalidWadToSlash":                ErrInvalidWadToSlash{},
+	"ModificationAlreadyPending":       ErrModificationAlreadyPending{},
+	"NonexistentAVSMetadata":           ErrNonexistentAVSMetadata{},
+	"NotMemberOfSet":                   ErrNotMemberOfSet{},
+	"OperatorNotSlashable":             ErrOperatorNotSlashable{},
+	"OperatorSetAlreadyMigrated":       ErrOperatorSetAlreadyMigrated{},
+	"OutOfBounds":                      ErrOutOfBounds{},
+	"SameMagnitude":                    ErrSameMagnitude{},
+	"SlasherNotSet":                    ErrSlasherNotSet{},
+	"StrategiesMustBeInAscendingOrder": ErrStrategiesMustBeInAscendingOrder{},
+	"StrategyAlreadyInOperatorSet":     ErrStrategyAlreadyInOperatorSet{},
+	"StrategyNotInOperatorSet":         ErrStrategyNotInOperatorSet{},
+	"UninitializedAllocationDelay":     ErrUninitializedAllocationDelay{},
+}
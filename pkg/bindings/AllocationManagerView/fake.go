@@ -0,0 +1,503 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AllocationManagerView
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+// FakeAllocationManagerView is an in-memory AllocationManagerView for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded AllocationManagerViewFilterer.
+type FakeAllocationManagerView struct {
+	*fake.Contract
+	*AllocationManagerViewFilterer
+}
+
+// NewFakeAllocationManagerView returns an empty fake of the contract at address.
+func NewFakeAllocationManagerView(address common.Address) *FakeAllocationManagerView {
+	contract := fake.NewContract(AllocationManagerViewMetaData, address)
+	filterer, err := NewAllocationManagerViewFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeAllocationManagerView{Contract: contract, AllocationManagerViewFilterer: filterer}
+}
+
+// ALLOCATIONCONFIGURATIONDELAY returns the values stubbed with StubALLOCATIONCONFIGURATIONDELAY.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) ALLOCATIONCONFIGURATIONDELAY(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeAllocationManagerView.Contract.Read("ALLOCATION_CONFIGURATION_DELAY", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubALLOCATIONCONFIGURATIONDELAY sets what ALLOCATIONCONFIGURATIONDELAY returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubALLOCATIONCONFIGURATIONDELAY(out0 uint32) {
+	_FakeAllocationManagerView.Contract.Stub("ALLOCATION_CONFIGURATION_DELAY", []interface{}{}, out0)
+}
+
+// DEALLOCATIONDELAY returns the values stubbed with StubDEALLOCATIONDELAY.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) DEALLOCATIONDELAY(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeAllocationManagerView.Contract.Read("DEALLOCATION_DELAY", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEALLOCATIONDELAY sets what DEALLOCATIONDELAY returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubDEALLOCATIONDELAY(out0 uint32) {
+	_FakeAllocationManagerView.Contract.Stub("DEALLOCATION_DELAY", []interface{}{}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManagerView.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubDelegation(out0 common.Address) {
+	_FakeAllocationManagerView.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// EigenStrategy returns the values stubbed with StubEigenStrategy.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EigenStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManagerView.Contract.Read("eigenStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenStrategy sets what EigenStrategy returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubEigenStrategy(out0 common.Address) {
+	_FakeAllocationManagerView.Contract.Stub("eigenStrategy", []interface{}{}, out0)
+}
+
+// GetAVSRegistrar returns the values stubbed with StubGetAVSRegistrar.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetAVSRegistrar(opts *bind.CallOpts, avs common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManagerView.Contract.Read("getAVSRegistrar", []interface{}{avs}, &out0)
+	return out0, err
+}
+
+// StubGetAVSRegistrar sets what GetAVSRegistrar returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetAVSRegistrar(avs common.Address, out0 common.Address) {
+	_FakeAllocationManagerView.Contract.Stub("getAVSRegistrar", []interface{}{avs}, out0)
+}
+
+// GetAllocatableMagnitude returns the values stubbed with StubGetAllocatableMagnitude.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetAllocatableMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeAllocationManagerView.Contract.Read("getAllocatableMagnitude", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatableMagnitude sets what GetAllocatableMagnitude returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetAllocatableMagnitude(operator common.Address, strategy common.Address, out0 uint64) {
+	_FakeAllocationManagerView.Contract.Stub("getAllocatableMagnitude", []interface{}{operator, strategy}, out0)
+}
+
+// GetAllocatedSets returns the values stubbed with StubGetAllocatedSets.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetAllocatedSets(opts *bind.CallOpts, operator common.Address) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeAllocationManagerView.Contract.Read("getAllocatedSets", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedSets sets what GetAllocatedSets returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetAllocatedSets(operator common.Address, out0 []OperatorSet) {
+	_FakeAllocationManagerView.Contract.Stub("getAllocatedSets", []interface{}{operator}, out0)
+}
+
+// GetAllocatedStake returns the values stubbed with StubGetAllocatedStake.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetAllocatedStake(opts *bind.CallOpts, operatorSet OperatorSet, operators []common.Address, strategies []common.Address) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeAllocationManagerView.Contract.Read("getAllocatedStake", []interface{}{operatorSet, operators, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedStake sets what GetAllocatedStake returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetAllocatedStake(operatorSet OperatorSet, operators []common.Address, strategies []common.Address, out0 [][]*big.Int) {
+	_FakeAllocationManagerView.Contract.Stub("getAllocatedStake", []interface{}{operatorSet, operators, strategies}, out0)
+}
+
+// GetAllocatedStrategies returns the values stubbed with StubGetAllocatedStrategies.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetAllocatedStrategies(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeAllocationManagerView.Contract.Read("getAllocatedStrategies", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedStrategies sets what GetAllocatedStrategies returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetAllocatedStrategies(operator common.Address, operatorSet OperatorSet, out0 []common.Address) {
+	_FakeAllocationManagerView.Contract.Stub("getAllocatedStrategies", []interface{}{operator, operatorSet}, out0)
+}
+
+// GetAllocation returns the values stubbed with StubGetAllocation.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetAllocation(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, strategy common.Address) (IAllocationManagerTypesAllocation, error) {
+	var out0 IAllocationManagerTypesAllocation
+	err := _FakeAllocationManagerView.Contract.Read("getAllocation", []interface{}{operator, operatorSet, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetAllocation sets what GetAllocation returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetAllocation(operator common.Address, operatorSet OperatorSet, strategy common.Address, out0 IAllocationManagerTypesAllocation) {
+	_FakeAllocationManagerView.Contract.Stub("getAllocation", []interface{}{operator, operatorSet, strategy}, out0)
+}
+
+// GetAllocationDelay returns the values stubbed with StubGetAllocationDelay.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetAllocationDelay(opts *bind.CallOpts, operator common.Address) (bool, uint32, error) {
+	var out0 bool
+	var out1 uint32
+	err := _FakeAllocationManagerView.Contract.Read("getAllocationDelay", []interface{}{operator}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetAllocationDelay sets what GetAllocationDelay returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetAllocationDelay(operator common.Address, out0 bool, out1 uint32) {
+	_FakeAllocationManagerView.Contract.Stub("getAllocationDelay", []interface{}{operator}, out0, out1)
+}
+
+// GetAllocations returns the values stubbed with StubGetAllocations.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetAllocations(opts *bind.CallOpts, operators []common.Address, operatorSet OperatorSet, strategy common.Address) ([]IAllocationManagerTypesAllocation, error) {
+	var out0 []IAllocationManagerTypesAllocation
+	err := _FakeAllocationManagerView.Contract.Read("getAllocations", []interface{}{operators, operatorSet, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetAllocations sets what GetAllocations returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetAllocations(operators []common.Address, operatorSet OperatorSet, strategy common.Address, out0 []IAllocationManagerTypesAllocation) {
+	_FakeAllocationManagerView.Contract.Stub("getAllocations", []interface{}{operators, operatorSet, strategy}, out0)
+}
+
+// GetEncumberedMagnitude returns the values stubbed with StubGetEncumberedMagnitude.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetEncumberedMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeAllocationManagerView.Contract.Read("getEncumberedMagnitude", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetEncumberedMagnitude sets what GetEncumberedMagnitude returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetEncumberedMagnitude(operator common.Address, strategy common.Address, out0 uint64) {
+	_FakeAllocationManagerView.Contract.Stub("getEncumberedMagnitude", []interface{}{operator, strategy}, out0)
+}
+
+// GetMaxMagnitude returns the values stubbed with StubGetMaxMagnitude.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetMaxMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeAllocationManagerView.Contract.Read("getMaxMagnitude", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitude sets what GetMaxMagnitude returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetMaxMagnitude(operator common.Address, strategy common.Address, out0 uint64) {
+	_FakeAllocationManagerView.Contract.Stub("getMaxMagnitude", []interface{}{operator, strategy}, out0)
+}
+
+// GetMaxMagnitudes returns the values stubbed with StubGetMaxMagnitudes.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetMaxMagnitudes(opts *bind.CallOpts, operators []common.Address, strategy common.Address) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeAllocationManagerView.Contract.Read("getMaxMagnitudes", []interface{}{operators, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudes sets what GetMaxMagnitudes returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetMaxMagnitudes(operators []common.Address, strategy common.Address, out0 []uint64) {
+	_FakeAllocationManagerView.Contract.Stub("getMaxMagnitudes", []interface{}{operators, strategy}, out0)
+}
+
+// GetMaxMagnitudes0 returns the values stubbed with StubGetMaxMagnitudes0.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetMaxMagnitudes0(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeAllocationManagerView.Contract.Read("getMaxMagnitudes0", []interface{}{operator, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudes0 sets what GetMaxMagnitudes0 returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetMaxMagnitudes0(operator common.Address, strategies []common.Address, out0 []uint64) {
+	_FakeAllocationManagerView.Contract.Stub("getMaxMagnitudes0", []interface{}{operator, strategies}, out0)
+}
+
+// GetMaxMagnitudesAtBlock returns the values stubbed with StubGetMaxMagnitudesAtBlock.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetMaxMagnitudesAtBlock(opts *bind.CallOpts, operator common.Address, strategies []common.Address, blockNumber uint32) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeAllocationManagerView.Contract.Read("getMaxMagnitudesAtBlock", []interface{}{operator, strategies, blockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudesAtBlock sets what GetMaxMagnitudesAtBlock returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetMaxMagnitudesAtBlock(operator common.Address, strategies []common.Address, blockNumber uint32, out0 []uint64) {
+	_FakeAllocationManagerView.Contract.Stub("getMaxMagnitudesAtBlock", []interface{}{operator, strategies, blockNumber}, out0)
+}
+
+// GetMemberCount returns the values stubbed with StubGetMemberCount.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetMemberCount(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeAllocationManagerView.Contract.Read("getMemberCount", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetMemberCount sets what GetMemberCount returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetMemberCount(operatorSet OperatorSet, out0 *big.Int) {
+	_FakeAllocationManagerView.Contract.Stub("getMemberCount", []interface{}{operatorSet}, out0)
+}
+
+// GetMembers returns the values stubbed with StubGetMembers.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetMembers(opts *bind.CallOpts, operatorSet OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeAllocationManagerView.Contract.Read("getMembers", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetMembers sets what GetMembers returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetMembers(operatorSet OperatorSet, out0 []common.Address) {
+	_FakeAllocationManagerView.Contract.Stub("getMembers", []interface{}{operatorSet}, out0)
+}
+
+// GetMinimumSlashableStake returns the values stubbed with StubGetMinimumSlashableStake.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetMinimumSlashableStake(opts *bind.CallOpts, operatorSet OperatorSet, operators []common.Address, strategies []common.Address, futureBlock uint32) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeAllocationManagerView.Contract.Read("getMinimumSlashableStake", []interface{}{operatorSet, operators, strategies, futureBlock}, &out0)
+	return out0, err
+}
+
+// StubGetMinimumSlashableStake sets what GetMinimumSlashableStake returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetMinimumSlashableStake(operatorSet OperatorSet, operators []common.Address, strategies []common.Address, futureBlock uint32, out0 [][]*big.Int) {
+	_FakeAllocationManagerView.Contract.Stub("getMinimumSlashableStake", []interface{}{operatorSet, operators, strategies, futureBlock}, out0)
+}
+
+// GetOperatorSetCount returns the values stubbed with StubGetOperatorSetCount.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetOperatorSetCount(opts *bind.CallOpts, avs common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeAllocationManagerView.Contract.Read("getOperatorSetCount", []interface{}{avs}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetCount sets what GetOperatorSetCount returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetOperatorSetCount(avs common.Address, out0 *big.Int) {
+	_FakeAllocationManagerView.Contract.Stub("getOperatorSetCount", []interface{}{avs}, out0)
+}
+
+// GetPendingSlasher returns the values stubbed with StubGetPendingSlasher.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetPendingSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, uint32, error) {
+	var out0 common.Address
+	var out1 uint32
+	err := _FakeAllocationManagerView.Contract.Read("getPendingSlasher", []interface{}{operatorSet}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetPendingSlasher sets what GetPendingSlasher returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetPendingSlasher(operatorSet OperatorSet, out0 common.Address, out1 uint32) {
+	_FakeAllocationManagerView.Contract.Stub("getPendingSlasher", []interface{}{operatorSet}, out0, out1)
+}
+
+// GetRedistributionRecipient returns the values stubbed with StubGetRedistributionRecipient.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetRedistributionRecipient(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManagerView.Contract.Read("getRedistributionRecipient", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetRedistributionRecipient sets what GetRedistributionRecipient returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetRedistributionRecipient(operatorSet OperatorSet, out0 common.Address) {
+	_FakeAllocationManagerView.Contract.Stub("getRedistributionRecipient", []interface{}{operatorSet}, out0)
+}
+
+// GetRegisteredSets returns the values stubbed with StubGetRegisteredSets.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetRegisteredSets(opts *bind.CallOpts, operator common.Address) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeAllocationManagerView.Contract.Read("getRegisteredSets", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubGetRegisteredSets sets what GetRegisteredSets returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetRegisteredSets(operator common.Address, out0 []OperatorSet) {
+	_FakeAllocationManagerView.Contract.Stub("getRegisteredSets", []interface{}{operator}, out0)
+}
+
+// GetSlashCount returns the values stubbed with StubGetSlashCount.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetSlashCount(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeAllocationManagerView.Contract.Read("getSlashCount", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetSlashCount sets what GetSlashCount returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetSlashCount(operatorSet OperatorSet, out0 *big.Int) {
+	_FakeAllocationManagerView.Contract.Stub("getSlashCount", []interface{}{operatorSet}, out0)
+}
+
+// GetSlasher returns the values stubbed with StubGetSlasher.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeAllocationManagerView.Contract.Read("getSlasher", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetSlasher sets what GetSlasher returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetSlasher(operatorSet OperatorSet, out0 common.Address) {
+	_FakeAllocationManagerView.Contract.Stub("getSlasher", []interface{}{operatorSet}, out0)
+}
+
+// GetStrategiesInOperatorSet returns the values stubbed with StubGetStrategiesInOperatorSet.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetStrategiesInOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeAllocationManagerView.Contract.Read("getStrategiesInOperatorSet", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetStrategiesInOperatorSet sets what GetStrategiesInOperatorSet returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetStrategiesInOperatorSet(operatorSet OperatorSet, out0 []common.Address) {
+	_FakeAllocationManagerView.Contract.Stub("getStrategiesInOperatorSet", []interface{}{operatorSet}, out0)
+}
+
+// GetStrategyAllocations returns the values stubbed with StubGetStrategyAllocations.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) GetStrategyAllocations(opts *bind.CallOpts, operator common.Address, strategy common.Address) ([]OperatorSet, []IAllocationManagerTypesAllocation, error) {
+	var out0 []OperatorSet
+	var out1 []IAllocationManagerTypesAllocation
+	err := _FakeAllocationManagerView.Contract.Read("getStrategyAllocations", []interface{}{operator, strategy}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetStrategyAllocations sets what GetStrategyAllocations returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubGetStrategyAllocations(operator common.Address, strategy common.Address, out0 []OperatorSet, out1 []IAllocationManagerTypesAllocation) {
+	_FakeAllocationManagerView.Contract.Stub("getStrategyAllocations", []interface{}{operator, strategy}, out0, out1)
+}
+
+// IsMemberOfOperatorSet returns the values stubbed with StubIsMemberOfOperatorSet.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) IsMemberOfOperatorSet(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManagerView.Contract.Read("isMemberOfOperatorSet", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsMemberOfOperatorSet sets what IsMemberOfOperatorSet returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubIsMemberOfOperatorSet(operator common.Address, operatorSet OperatorSet, out0 bool) {
+	_FakeAllocationManagerView.Contract.Stub("isMemberOfOperatorSet", []interface{}{operator, operatorSet}, out0)
+}
+
+// IsOperatorRedistributable returns the values stubbed with StubIsOperatorRedistributable.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) IsOperatorRedistributable(opts *bind.CallOpts, operator common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManagerView.Contract.Read("isOperatorRedistributable", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorRedistributable sets what IsOperatorRedistributable returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubIsOperatorRedistributable(operator common.Address, out0 bool) {
+	_FakeAllocationManagerView.Contract.Stub("isOperatorRedistributable", []interface{}{operator}, out0)
+}
+
+// IsOperatorSet returns the values stubbed with StubIsOperatorSet.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) IsOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManagerView.Contract.Read("isOperatorSet", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorSet sets what IsOperatorSet returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubIsOperatorSet(operatorSet OperatorSet, out0 bool) {
+	_FakeAllocationManagerView.Contract.Stub("isOperatorSet", []interface{}{operatorSet}, out0)
+}
+
+// IsOperatorSlashable returns the values stubbed with StubIsOperatorSlashable.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) IsOperatorSlashable(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManagerView.Contract.Read("isOperatorSlashable", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorSlashable sets what IsOperatorSlashable returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubIsOperatorSlashable(operator common.Address, operatorSet OperatorSet, out0 bool) {
+	_FakeAllocationManagerView.Contract.Stub("isOperatorSlashable", []interface{}{operator, operatorSet}, out0)
+}
+
+// IsRedistributingOperatorSet returns the values stubbed with StubIsRedistributingOperatorSet.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) IsRedistributingOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeAllocationManagerView.Contract.Read("isRedistributingOperatorSet", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsRedistributingOperatorSet sets what IsRedistributingOperatorSet returns for the given arguments.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) StubIsRedistributingOperatorSet(operatorSet OperatorSet, out0 bool) {
+	_FakeAllocationManagerView.Contract.Stub("isRedistributingOperatorSet", []interface{}{operatorSet}, out0)
+}
+
+// EmitAVSMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitAVSMetadataURIUpdated(event *AllocationManagerViewAVSMetadataURIUpdated) {
+	_FakeAllocationManagerView.Contract.Emit("AVSMetadataURIUpdated", event)
+}
+
+// EmitAVSRegistrarSet appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitAVSRegistrarSet(event *AllocationManagerViewAVSRegistrarSet) {
+	_FakeAllocationManagerView.Contract.Emit("AVSRegistrarSet", event)
+}
+
+// EmitAllocationDelaySet appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitAllocationDelaySet(event *AllocationManagerViewAllocationDelaySet) {
+	_FakeAllocationManagerView.Contract.Emit("AllocationDelaySet", event)
+}
+
+// EmitAllocationUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitAllocationUpdated(event *AllocationManagerViewAllocationUpdated) {
+	_FakeAllocationManagerView.Contract.Emit("AllocationUpdated", event)
+}
+
+// EmitEncumberedMagnitudeUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitEncumberedMagnitudeUpdated(event *AllocationManagerViewEncumberedMagnitudeUpdated) {
+	_FakeAllocationManagerView.Contract.Emit("EncumberedMagnitudeUpdated", event)
+}
+
+// EmitMaxMagnitudeUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitMaxMagnitudeUpdated(event *AllocationManagerViewMaxMagnitudeUpdated) {
+	_FakeAllocationManagerView.Contract.Emit("MaxMagnitudeUpdated", event)
+}
+
+// EmitOperatorAddedToOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitOperatorAddedToOperatorSet(event *AllocationManagerViewOperatorAddedToOperatorSet) {
+	_FakeAllocationManagerView.Contract.Emit("OperatorAddedToOperatorSet", event)
+}
+
+// EmitOperatorRemovedFromOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitOperatorRemovedFromOperatorSet(event *AllocationManagerViewOperatorRemovedFromOperatorSet) {
+	_FakeAllocationManagerView.Contract.Emit("OperatorRemovedFromOperatorSet", event)
+}
+
+// EmitOperatorSetCreated appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitOperatorSetCreated(event *AllocationManagerViewOperatorSetCreated) {
+	_FakeAllocationManagerView.Contract.Emit("OperatorSetCreated", event)
+}
+
+// EmitOperatorSlashed appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitOperatorSlashed(event *AllocationManagerViewOperatorSlashed) {
+	_FakeAllocationManagerView.Contract.Emit("OperatorSlashed", event)
+}
+
+// EmitRedistributionAddressSet appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitRedistributionAddressSet(event *AllocationManagerViewRedistributionAddressSet) {
+	_FakeAllocationManagerView.Contract.Emit("RedistributionAddressSet", event)
+}
+
+// EmitSlasherMigrated appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitSlasherMigrated(event *AllocationManagerViewSlasherMigrated) {
+	_FakeAllocationManagerView.Contract.Emit("SlasherMigrated", event)
+}
+
+// EmitSlasherUpdated appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitSlasherUpdated(event *AllocationManagerViewSlasherUpdated) {
+	_FakeAllocationManagerView.Contract.Emit("SlasherUpdated", event)
+}
+
+// EmitStrategyAddedToOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitStrategyAddedToOperatorSet(event *AllocationManagerViewStrategyAddedToOperatorSet) {
+	_FakeAllocationManagerView.Contract.Emit("StrategyAddedToOperatorSet", event)
+}
+
+// EmitStrategyRemovedFromOperatorSet appends the event to the fake's logs.
+func (_FakeAllocationManagerView *FakeAllocationManagerView) EmitStrategyRemovedFromOperatorSet(event *AllocationManagerViewStrategyRemovedFromOperatorSet) {
+	_FakeAllocationManagerView.Contract.Emit("StrategyRemovedFromOperatorSet", event)
+}
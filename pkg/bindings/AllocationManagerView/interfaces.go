@@ -0,0 +1,108 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package AllocationManagerView
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// AllocationManagerViewReader is the read-only surface of the AllocationManagerView
+// binding, implemented by AllocationManagerViewCaller, AllocationManagerView and FakeAllocationManagerView.
+type AllocationManagerViewReader interface {
+	ALLOCATIONCONFIGURATIONDELAY(opts *bind.CallOpts) (uint32, error)
+	DEALLOCATIONDELAY(opts *bind.CallOpts) (uint32, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	EigenStrategy(opts *bind.CallOpts) (common.Address, error)
+	GetAVSRegistrar(opts *bind.CallOpts, avs common.Address) (common.Address, error)
+	GetAllocatableMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error)
+	GetAllocatedSets(opts *bind.CallOpts, operator common.Address) ([]OperatorSet, error)
+	GetAllocatedStake(opts *bind.CallOpts, operatorSet OperatorSet, operators []common.Address, strategies []common.Address) ([][]*big.Int, error)
+	GetAllocatedStrategies(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) ([]common.Address, error)
+	GetAllocation(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, strategy common.Address) (IAllocationManagerTypesAllocation, error)
+	GetAllocationDelay(opts *bind.CallOpts, operator common.Address) (bool, uint32, error)
+	GetAllocations(opts *bind.CallOpts, operators []common.Address, operatorSet OperatorSet, strategy common.Address) ([]IAllocationManagerTypesAllocation, error)
+	GetEncumberedMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error)
+	GetMaxMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error)
+	GetMaxMagnitudes(opts *bind.CallOpts, operators []common.Address, strategy common.Address) ([]uint64, error)
+	GetMaxMagnitudes0(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]uint64, error)
+	GetMaxMagnitudesAtBlock(opts *bind.CallOpts, operator common.Address, strategies []common.Address, blockNumber uint32) ([]uint64, error)
+	GetMemberCount(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error)
+	GetMembers(opts *bind.CallOpts, operatorSet OperatorSet) ([]common.Address, error)
+	GetMinimumSlashableStake(opts *bind.CallOpts, operatorSet OperatorSet, operators []common.Address, strategies []common.Address, futureBlock uint32) ([][]*big.Int, error)
+	GetOperatorSetCount(opts *bind.CallOpts, avs common.Address) (*big.Int, error)
+	GetPendingSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, uint32, error)
+	GetRedistributionRecipient(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetRegisteredSets(opts *bind.CallOpts, operator common.Address) ([]OperatorSet, error)
+	GetSlashCount(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error)
+	GetSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetStrategiesInOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) ([]common.Address, error)
+	GetStrategyAllocations(opts *bind.CallOpts, operator common.Address, strategy common.Address) ([]OperatorSet, []IAllocationManagerTypesAllocation, error)
+	IsMemberOfOperatorSet(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error)
+	IsOperatorRedistributable(opts *bind.CallOpts, operator common.Address) (bool, error)
+	IsOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)
+	IsOperatorSlashable(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error)
+	IsRedistributingOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)
+}
+
+// AllocationManagerViewEvents is the event filtering surface of the AllocationManagerView
+// binding, implemented by AllocationManagerViewFilterer, AllocationManagerView and FakeAllocationManagerView.
+type AllocationManagerViewEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*AllocationManagerViewAVSMetadataURIUpdatedIterator, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*AllocationManagerViewAVSMetadataURIUpdated, error)
+	FilterAVSRegistrarSet(opts *bind.FilterOpts) (*AllocationManagerViewAVSRegistrarSetIterator, error)
+	WatchAVSRegistrarSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewAVSRegistrarSet) (event.Subscription, error)
+	ParseAVSRegistrarSet(log types.Log) (*AllocationManagerViewAVSRegistrarSet, error)
+	FilterAllocationDelaySet(opts *bind.FilterOpts) (*AllocationManagerViewAllocationDelaySetIterator, error)
+	WatchAllocationDelaySet(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewAllocationDelaySet) (event.Subscription, error)
+	ParseAllocationDelaySet(log types.Log) (*AllocationManagerViewAllocationDelaySet, error)
+	FilterAllocationUpdated(opts *bind.FilterOpts) (*AllocationManagerViewAllocationUpdatedIterator, error)
+	WatchAllocationUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewAllocationUpdated) (event.Subscription, error)
+	ParseAllocationUpdated(log types.Log) (*AllocationManagerViewAllocationUpdated, error)
+	FilterEncumberedMagnitudeUpdated(opts *bind.FilterOpts) (*AllocationManagerViewEncumberedMagnitudeUpdatedIterator, error)
+	WatchEncumberedMagnitudeUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewEncumberedMagnitudeUpdated) (event.Subscription, error)
+	ParseEncumberedMagnitudeUpdated(log types.Log) (*AllocationManagerViewEncumberedMagnitudeUpdated, error)
+	FilterMaxMagnitudeUpdated(opts *bind.FilterOpts) (*AllocationManagerViewMaxMagnitudeUpdatedIterator, error)
+	WatchMaxMagnitudeUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewMaxMagnitudeUpdated) (event.Subscription, error)
+	ParseMaxMagnitudeUpdated(log types.Log) (*AllocationManagerViewMaxMagnitudeUpdated, error)
+	FilterOperatorAddedToOperatorSet(opts *bind.FilterOpts, operator []common.Address) (*AllocationManagerViewOperatorAddedToOperatorSetIterator, error)
+	WatchOperatorAddedToOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewOperatorAddedToOperatorSet, operator []common.Address) (event.Subscription, error)
+	ParseOperatorAddedToOperatorSet(log types.Log) (*AllocationManagerViewOperatorAddedToOperatorSet, error)
+	FilterOperatorRemovedFromOperatorSet(opts *bind.FilterOpts, operator []common.Address) (*AllocationManagerViewOperatorRemovedFromOperatorSetIterator, error)
+	WatchOperatorRemovedFromOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewOperatorRemovedFromOperatorSet, operator []common.Address) (event.Subscription, error)
+	ParseOperatorRemovedFromOperatorSet(log types.Log) (*AllocationManagerViewOperatorRemovedFromOperatorSet, error)
+	FilterOperatorSetCreated(opts *bind.FilterOpts) (*AllocationManagerViewOperatorSetCreatedIterator, error)
+	WatchOperatorSetCreated(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewOperatorSetCreated) (event.Subscription, error)
+	ParseOperatorSetCreated(log types.Log) (*AllocationManagerViewOperatorSetCreated, error)
+	FilterOperatorSlashed(opts *bind.FilterOpts) (*AllocationManagerViewOperatorSlashedIterator, error)
+	WatchOperatorSlashed(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewOperatorSlashed) (event.Subscription, error)
+	ParseOperatorSlashed(log types.Log) (*AllocationManagerViewOperatorSlashed, error)
+	FilterRedistributionAddressSet(opts *bind.FilterOpts) (*AllocationManagerViewRedistributionAddressSetIterator, error)
+	WatchRedistributionAddressSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewRedistributionAddressSet) (event.Subscription, error)
+	ParseRedistributionAddressSet(log types.Log) (*AllocationManagerViewRedistributionAddressSet, error)
+	FilterSlasherMigrated(opts *bind.FilterOpts) (*AllocationManagerViewSlasherMigratedIterator, error)
+	WatchSlasherMigrated(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewSlasherMigrated) (event.Subscription, error)
+	ParseSlasherMigrated(log types.Log) (*AllocationManagerViewSlasherMigrated, error)
+	FilterSlasherUpdated(opts *bind.FilterOpts) (*AllocationManagerViewSlasherUpdatedIterator, error)
+	WatchSlasherUpdated(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewSlasherUpdated) (event.Subscription, error)
+	ParseSlasherUpdated(log types.Log) (*AllocationManagerViewSlasherUpdated, error)
+	FilterStrategyAddedToOperatorSet(opts *bind.FilterOpts) (*AllocationManagerViewStrategyAddedToOperatorSetIterator, error)
+	WatchStrategyAddedToOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewStrategyAddedToOperatorSet) (event.Subscription, error)
+	ParseStrategyAddedToOperatorSet(log types.Log) (*AllocationManagerViewStrategyAddedToOperatorSet, error)
+	FilterStrategyRemovedFromOperatorSet(opts *bind.FilterOpts) (*AllocationManagerViewStrategyRemovedFromOperatorSetIterator, error)
+	WatchStrategyRemovedFromOperatorSet(opts *bind.WatchOpts, sink chan<- *AllocationManagerViewStrategyRemovedFromOperatorSet) (event.Subscription, error)
+	ParseStrategyRemovedFromOperatorSet(log types.Log) (*AllocationManagerViewStrategyRemovedFromOperatorSet, error)
+}
+
+var (
+	_ AllocationManagerViewReader = (*AllocationManagerViewCaller)(nil)
+	_ AllocationManagerViewReader = (*AllocationManagerView)(nil)
+	_ AllocationManagerViewReader = (*FakeAllocationManagerView)(nil)
+	_ AllocationManagerViewEvents = (*AllocationManagerViewFilterer)(nil)
+	_ AllocationManagerViewEvents = (*AllocationManagerView)(nil)
+	_ AllocationManagerViewEvents = (*FakeAllocationManagerView)(nil)
+)
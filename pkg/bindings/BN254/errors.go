@@ -0,0 +1,52 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BN254
+
+// ErrECAddFailed is the typed form of the BN254 custom error ECAddFailed().
+type ErrECAddFailed struct{}
+
+// Error implements the error interface.
+func (ErrECAddFailed) Error() string {
+	return "BN254: ECAddFailed()"
+}
+
+// ErrECMulFailed is the typed form of the BN254 custom error ECMulFailed().
+type ErrECMulFailed struct{}
+
+// Error implements the error interface.
+func (ErrECMulFailed) Error() string {
+	return "BN254: ECMulFailed()"
+}
+
+// ErrECPairingFailed is the typed form of the BN254 custom error ECPairingFailed().
+type ErrECPairingFailed struct{}
+
+// Error implements the error interface.
+func (ErrECPairingFailed) Error() string {
+	return "BN254: ECPairingFailed()"
+}
+
+// ErrExpModFailed is the typed form of the BN254 custom error ExpModFailed().
+type ErrExpModFailed struct{}
+
+// Error implements the error interface.
+func (ErrExpModFailed) Error() string {
+	return "BN254: ExpModFailed()"
+}
+
+// ErrScalarTooLarge is the typed form of the BN254 custom error ScalarTooLarge().
+type ErrScalarTooLarge struct{}
+
+// Error implements the error interface.
+func (ErrScalarTooLarge) Error() string {
+	return "BN254: ScalarTooLarge()"
+}
+
+// BN254Errors maps the name of every custom error in the BN254 ABI to its typed Go error.
+var BN254Errors = map[string]error{
+	"ECAddFailed":     ErrECAddFailed{},
+	"ECMulFailed":     ErrECMulFailed{},
+	"ECPairingFailed": ErrECPairingFailed{},
+	"ExpModFailed":    ErrExpModFailed{},
+	"ScalarTooLarge":  ErrScalarTooLarge{},
+}
@@ -0,0 +1,151 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BN254CertificateVerifier
+
+// ErrArrayLengthMismatch is the typed form of the BN254CertificateVerifier custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "BN254CertificateVerifier: ArrayLengthMismatch()"
+}
+
+// ErrCertificateStale is the typed form of the BN254CertificateVerifier custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "BN254CertificateVerifier: CertificateStale()"
+}
+
+// ErrECAddFailed is the typed form of the BN254CertificateVerifier custom error ECAddFailed().
+type ErrECAddFailed struct{}
+
+// Error implements the error interface.
+func (ErrECAddFailed) Error() string {
+	return "BN254CertificateVerifier: ECAddFailed()"
+}
+
+// ErrECMulFailed is the typed form of the BN254CertificateVerifier custom error ECMulFailed().
+type ErrECMulFailed struct{}
+
+// Error implements the error interface.
+func (ErrECMulFailed) Error() string {
+	return "BN254CertificateVerifier: ECMulFailed()"
+}
+
+// ErrECPairingFailed is the typed form of the BN254CertificateVerifier custom error ECPairingFailed().
+type ErrECPairingFailed struct{}
+
+// Error implements the error interface.
+func (ErrECPairingFailed) Error() string {
+	return "BN254CertificateVerifier: ECPairingFailed()"
+}
+
+// ErrEmptyRoot is the typed form of the BN254CertificateVerifier custom error EmptyRoot().
+type ErrEmptyRoot struct{}
+
+// Error implements the error interface.
+func (ErrEmptyRoot) Error() string {
+	return "BN254CertificateVerifier: EmptyRoot()"
+}
+
+// ErrExpModFailed is the typed form of the BN254CertificateVerifier custom error ExpModFailed().
+type ErrExpModFailed struct{}
+
+// Error implements the error interface.
+func (ErrExpModFailed) Error() string {
+	return "BN254CertificateVerifier: ExpModFailed()"
+}
+
+// ErrInvalidIndex is the typed form of the BN254CertificateVerifier custom error InvalidIndex().
+type ErrInvalidIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidIndex) Error() string {
+	return "BN254CertificateVerifier: InvalidIndex()"
+}
+
+// ErrInvalidOperatorIndex is the typed form of the BN254CertificateVerifier custom error InvalidOperatorIndex().
+type ErrInvalidOperatorIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorIndex) Error() string {
+	return "BN254CertificateVerifier: InvalidOperatorIndex()"
+}
+
+// ErrInvalidProofLength is the typed form of the BN254CertificateVerifier custom error InvalidProofLength().
+type ErrInvalidProofLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidProofLength) Error() string {
+	return "BN254CertificateVerifier: InvalidProofLength()"
+}
+
+// ErrNonSignerIndicesNotSorted is the typed form of the BN254CertificateVerifier custom error NonSignerIndicesNotSorted().
+type ErrNonSignerIndicesNotSorted struct{}
+
+// Error implements the error interface.
+func (ErrNonSignerIndicesNotSorted) Error() string {
+	return "BN254CertificateVerifier: NonSignerIndicesNotSorted()"
+}
+
+// ErrOnlyTableUpdater is the typed form of the BN254CertificateVerifier custom error OnlyTableUpdater().
+type ErrOnlyTableUpdater struct{}
+
+// Error implements the error interface.
+func (ErrOnlyTableUpdater) Error() string {
+	return "BN254CertificateVerifier: OnlyTableUpdater()"
+}
+
+// ErrReferenceTimestampDoesNotExist is the typed form of the BN254CertificateVerifier custom error ReferenceTimestampDoesNotExist().
+type ErrReferenceTimestampDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrReferenceTimestampDoesNotExist) Error() string {
+	return "BN254CertificateVerifier: ReferenceTimestampDoesNotExist()"
+}
+
+// ErrRootDisabled is the typed form of the BN254CertificateVerifier custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "BN254CertificateVerifier: RootDisabled()"
+}
+
+// ErrTableUpdateStale is the typed form of the BN254CertificateVerifier custom error TableUpdateStale().
+type ErrTableUpdateStale struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateStale) Error() string {
+	return "BN254CertificateVerifier: TableUpdateStale()"
+}
+
+// ErrVerificationFailed is the typed form of the BN254CertificateVerifier custom error VerificationFailed().
+type ErrVerificationFailed struct{}
+
+// Error implements the error interface.
+func (ErrVerificationFailed) Error() string {
+	return "BN254CertificateVerifier: VerificationFailed()"
+}
+
+// BN254CertificateVerifierErrors maps the name of every custom error in the BN254CertificateVerifier ABI to its typed Go error.
+var BN254CertificateVerifierErrors = map[string]error{
+	"ArrayLengthMismatch":            ErrArrayLengthMismatch{},
+	"CertificateStale":               ErrCertificateStale{},
+	"ECAddFailed":                    ErrECAddFailed{},
+	"ECMulFailed":                    ErrECMulFailed{},
+	"ECPairingFailed":                ErrECPairingFailed{},
+	"EmptyRoot":                      ErrEmptyRoot{},
+	"ExpModFailed":                   ErrExpModFailed{},
+	"InvalidIndex":                   ErrInvalidIndex{},
+	"InvalidOperatorIndex":           ErrInvalidOperatorIndex{},
+	"InvalidProofLength":             ErrInvalidProofLength{},
+	"NonSignerIndicesNotSorted":      ErrNonSignerIndicesNotSorted{},
+	"OnlyTableUpdater":               ErrOnlyTableUpdater{},
+	"ReferenceTimestampDoesNotExist": ErrReferenceTimestampDoesNotExist{},
+	"RootDisabled":                   ErrRootDisabled{},
+	"TableUpdateStale":               ErrTableUpdateStale{},
+	"VerificationFailed":             ErrVerificationFailed{},
+}
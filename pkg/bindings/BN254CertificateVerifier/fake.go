@@ -0,0 +1,315 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BN254CertificateVerifier
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeBN254CertificateVerifier is an in-memory BN254CertificateVerifier for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded BN254CertificateVerifierFilterer.
+type FakeBN254CertificateVerifier struct {
+	*fake.Contract
+	*BN254CertificateVerifierFilterer
+}
+
+// NewFakeBN254CertificateVerifier returns an empty fake of the contract at address.
+func NewFakeBN254CertificateVerifier(address common.Address) *FakeBN254CertificateVerifier {
+	contract := fake.NewContract(BN254CertificateVerifierMetaData, address)
+	filterer, err := NewBN254CertificateVerifierFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeBN254CertificateVerifier{Contract: contract, BN254CertificateVerifierFilterer: filterer}
+}
+
+// OPERATORINFOLEAFSALT returns the values stubbed with StubOPERATORINFOLEAFSALT.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) OPERATORINFOLEAFSALT(opts *bind.CallOpts) (uint8, error) {
+	var out0 uint8
+	err := _FakeBN254CertificateVerifier.Contract.Read("OPERATOR_INFO_LEAF_SALT", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORINFOLEAFSALT sets what OPERATORINFOLEAFSALT returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubOPERATORINFOLEAFSALT(out0 uint8) {
+	_FakeBN254CertificateVerifier.Contract.Stub("OPERATOR_INFO_LEAF_SALT", []interface{}{}, out0)
+}
+
+// OPERATORTABLELEAFSALT returns the values stubbed with StubOPERATORTABLELEAFSALT.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) OPERATORTABLELEAFSALT(opts *bind.CallOpts) (uint8, error) {
+	var out0 uint8
+	err := _FakeBN254CertificateVerifier.Contract.Read("OPERATOR_TABLE_LEAF_SALT", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORTABLELEAFSALT sets what OPERATORTABLELEAFSALT returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubOPERATORTABLELEAFSALT(out0 uint8) {
+	_FakeBN254CertificateVerifier.Contract.Stub("OPERATOR_TABLE_LEAF_SALT", []interface{}{}, out0)
+}
+
+// CalculateCertificateDigest returns the values stubbed with StubCalculateCertificateDigest.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeBN254CertificateVerifier.Contract.Read("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigest sets what CalculateCertificateDigest returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubCalculateCertificateDigest(referenceTimestamp uint32, messageHash [32]byte, out0 [32]byte) {
+	_FakeBN254CertificateVerifier.Contract.Stub("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// CalculateOperatorInfoLeaf returns the values stubbed with StubCalculateOperatorInfoLeaf.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) CalculateOperatorInfoLeaf(opts *bind.CallOpts, operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeBN254CertificateVerifier.Contract.Read("calculateOperatorInfoLeaf", []interface{}{operatorInfo}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorInfoLeaf sets what CalculateOperatorInfoLeaf returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubCalculateOperatorInfoLeaf(operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo, out0 [32]byte) {
+	_FakeBN254CertificateVerifier.Contract.Stub("calculateOperatorInfoLeaf", []interface{}{operatorInfo}, out0)
+}
+
+// CalculateOperatorTableLeaf returns the values stubbed with StubCalculateOperatorTableLeaf.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) CalculateOperatorTableLeaf(opts *bind.CallOpts, operatorTableBytes []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeBN254CertificateVerifier.Contract.Read("calculateOperatorTableLeaf", []interface{}{operatorTableBytes}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorTableLeaf sets what CalculateOperatorTableLeaf returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubCalculateOperatorTableLeaf(operatorTableBytes []byte, out0 [32]byte) {
+	_FakeBN254CertificateVerifier.Contract.Stub("calculateOperatorTableLeaf", []interface{}{operatorTableBytes}, out0)
+}
+
+// GetNonsignerOperatorInfo returns the values stubbed with StubGetNonsignerOperatorInfo.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) GetNonsignerOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesBN254OperatorInfo, error) {
+	var out0 IOperatorTableCalculatorTypesBN254OperatorInfo
+	err := _FakeBN254CertificateVerifier.Contract.Read("getNonsignerOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubGetNonsignerOperatorInfo sets what GetNonsignerOperatorInfo returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubGetNonsignerOperatorInfo(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 IOperatorTableCalculatorTypesBN254OperatorInfo) {
+	_FakeBN254CertificateVerifier.Contract.Stub("getNonsignerOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// GetOperatorCount returns the values stubbed with StubGetOperatorCount.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBN254CertificateVerifier.Contract.Read("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorCount sets what GetOperatorCount returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubGetOperatorCount(operatorSet OperatorSet, referenceTimestamp uint32, out0 *big.Int) {
+	_FakeBN254CertificateVerifier.Contract.Stub("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetInfo returns the values stubbed with StubGetOperatorSetInfo.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) GetOperatorSetInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (IOperatorTableCalculatorTypesBN254OperatorSetInfo, error) {
+	var out0 IOperatorTableCalculatorTypesBN254OperatorSetInfo
+	err := _FakeBN254CertificateVerifier.Contract.Read("getOperatorSetInfo", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetInfo sets what GetOperatorSetInfo returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubGetOperatorSetInfo(operatorSet OperatorSet, referenceTimestamp uint32, out0 IOperatorTableCalculatorTypesBN254OperatorSetInfo) {
+	_FakeBN254CertificateVerifier.Contract.Stub("getOperatorSetInfo", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetOwner returns the values stubbed with StubGetOperatorSetOwner.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeBN254CertificateVerifier.Contract.Read("getOperatorSetOwner", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetOwner sets what GetOperatorSetOwner returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubGetOperatorSetOwner(operatorSet OperatorSet, out0 common.Address) {
+	_FakeBN254CertificateVerifier.Contract.Stub("getOperatorSetOwner", []interface{}{operatorSet}, out0)
+}
+
+// GetTotalStakeWeights returns the values stubbed with StubGetTotalStakeWeights.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeBN254CertificateVerifier.Contract.Read("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetTotalStakeWeights sets what GetTotalStakeWeights returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubGetTotalStakeWeights(operatorSet OperatorSet, referenceTimestamp uint32, out0 []*big.Int) {
+	_FakeBN254CertificateVerifier.Contract.Stub("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// IsNonsignerCached returns the values stubbed with StubIsNonsignerCached.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) IsNonsignerCached(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (bool, error) {
+	var out0 bool
+	err := _FakeBN254CertificateVerifier.Contract.Read("isNonsignerCached", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubIsNonsignerCached sets what IsNonsignerCached returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubIsNonsignerCached(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 bool) {
+	_FakeBN254CertificateVerifier.Contract.Stub("isNonsignerCached", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// IsReferenceTimestampSet returns the values stubbed with StubIsReferenceTimestampSet.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeBN254CertificateVerifier.Contract.Read("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsReferenceTimestampSet sets what IsReferenceTimestampSet returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubIsReferenceTimestampSet(operatorSet OperatorSet, referenceTimestamp uint32, out0 bool) {
+	_FakeBN254CertificateVerifier.Contract.Stub("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// LatestReferenceTimestamp returns the values stubbed with StubLatestReferenceTimestamp.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeBN254CertificateVerifier.Contract.Read("latestReferenceTimestamp", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubLatestReferenceTimestamp sets what LatestReferenceTimestamp returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubLatestReferenceTimestamp(operatorSet OperatorSet, out0 uint32) {
+	_FakeBN254CertificateVerifier.Contract.Stub("latestReferenceTimestamp", []interface{}{operatorSet}, out0)
+}
+
+// MaxOperatorTableStaleness returns the values stubbed with StubMaxOperatorTableStaleness.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeBN254CertificateVerifier.Contract.Read("maxOperatorTableStaleness", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubMaxOperatorTableStaleness sets what MaxOperatorTableStaleness returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubMaxOperatorTableStaleness(operatorSet OperatorSet, out0 uint32) {
+	_FakeBN254CertificateVerifier.Contract.Stub("maxOperatorTableStaleness", []interface{}{operatorSet}, out0)
+}
+
+// OperatorTableUpdater returns the values stubbed with StubOperatorTableUpdater.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) OperatorTableUpdater(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeBN254CertificateVerifier.Contract.Read("operatorTableUpdater", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOperatorTableUpdater sets what OperatorTableUpdater returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubOperatorTableUpdater(out0 common.Address) {
+	_FakeBN254CertificateVerifier.Contract.Stub("operatorTableUpdater", []interface{}{}, out0)
+}
+
+// TrySignatureVerification returns the values stubbed with StubTrySignatureVerification.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) TrySignatureVerification(opts *bind.CallOpts, msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point) (struct {
+	PairingSuccessful bool
+	SignatureValid    bool
+}, error) {
+	var out0 struct {
+		PairingSuccessful bool
+		SignatureValid    bool
+	}
+	err := _FakeBN254CertificateVerifier.Contract.Read("trySignatureVerification", []interface{}{msgHash, aggPubkey, apkG2, signature}, &out0)
+	return out0, err
+}
+
+// StubTrySignatureVerification sets what TrySignatureVerification returns for the given arguments.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) StubTrySignatureVerification(msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point, out0 struct {
+	PairingSuccessful bool
+	SignatureValid    bool
+}) {
+	_FakeBN254CertificateVerifier.Contract.Stub("trySignatureVerification", []interface{}{msgHash, aggPubkey, apkG2, signature}, out0)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeBN254CertificateVerifier.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeBN254CertificateVerifier.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, referenceTimestamp, operatorSetInfo, operatorSetConfig)
+		}
+		return nil
+	}, operatorSet, referenceTimestamp, operatorSetInfo, operatorSetConfig)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeBN254CertificateVerifier.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// VerifyCertificate records a verifyCertificate call after running the hook set with OnVerifyCertificate.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) VerifyCertificate(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) (*types.Transaction, error) {
+	return _FakeBN254CertificateVerifier.Contract.Transact(opts, "verifyCertificate", func() error {
+		if hook, ok := _FakeBN254CertificateVerifier.Contract.Hook("verifyCertificate").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) error); ok {
+			return hook(opts, operatorSet, cert)
+		}
+		return nil
+	}, operatorSet, cert)
+}
+
+// OnVerifyCertificate sets a hook run by every VerifyCertificate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) OnVerifyCertificate(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) error) {
+	_FakeBN254CertificateVerifier.Contract.SetHook("verifyCertificate", hook)
+}
+
+// VerifyCertificateNominal records a verifyCertificateNominal call after running the hook set with OnVerifyCertificateNominal.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) VerifyCertificateNominal(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) (*types.Transaction, error) {
+	return _FakeBN254CertificateVerifier.Contract.Transact(opts, "verifyCertificateNominal", func() error {
+		if hook, ok := _FakeBN254CertificateVerifier.Contract.Hook("verifyCertificateNominal").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) error); ok {
+			return hook(opts, operatorSet, cert, totalStakeNominalThresholds)
+		}
+		return nil
+	}, operatorSet, cert, totalStakeNominalThresholds)
+}
+
+// OnVerifyCertificateNominal sets a hook run by every VerifyCertificateNominal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) OnVerifyCertificateNominal(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) error) {
+	_FakeBN254CertificateVerifier.Contract.SetHook("verifyCertificateNominal", hook)
+}
+
+// VerifyCertificateProportion records a verifyCertificateProportion call after running the hook set with OnVerifyCertificateProportion.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) VerifyCertificateProportion(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) (*types.Transaction, error) {
+	return _FakeBN254CertificateVerifier.Contract.Transact(opts, "verifyCertificateProportion", func() error {
+		if hook, ok := _FakeBN254CertificateVerifier.Contract.Hook("verifyCertificateProportion").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) error); ok {
+			return hook(opts, operatorSet, cert, totalStakeProportionThresholds)
+		}
+		return nil
+	}, operatorSet, cert, totalStakeProportionThresholds)
+}
+
+// OnVerifyCertificateProportion sets a hook run by every VerifyCertificateProportion call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) OnVerifyCertificateProportion(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) error) {
+	_FakeBN254CertificateVerifier.Contract.SetHook("verifyCertificateProportion", hook)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) EmitInitialized(event *BN254CertificateVerifierInitialized) {
+	_FakeBN254CertificateVerifier.Contract.Emit("Initialized", event)
+}
+
+// EmitMaxStalenessPeriodUpdated appends the event to the fake's logs.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) EmitMaxStalenessPeriodUpdated(event *BN254CertificateVerifierMaxStalenessPeriodUpdated) {
+	_FakeBN254CertificateVerifier.Contract.Emit("MaxStalenessPeriodUpdated", event)
+}
+
+// EmitOperatorSetOwnerUpdated appends the event to the fake's logs.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) EmitOperatorSetOwnerUpdated(event *BN254CertificateVerifierOperatorSetOwnerUpdated) {
+	_FakeBN254CertificateVerifier.Contract.Emit("OperatorSetOwnerUpdated", event)
+}
+
+// EmitTableUpdated appends the event to the fake's logs.
+func (_FakeBN254CertificateVerifier *FakeBN254CertificateVerifier) EmitTableUpdated(event *BN254CertificateVerifierTableUpdated) {
+	_FakeBN254CertificateVerifier.Contract.Emit("TableUpdated", event)
+}
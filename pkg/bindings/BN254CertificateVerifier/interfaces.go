@@ -0,0 +1,73 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BN254CertificateVerifier
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// BN254CertificateVerifierReader is the read-only surface of the BN254CertificateVerifier
+// binding, implemented by BN254CertificateVerifierCaller, BN254CertificateVerifier and FakeBN254CertificateVerifier.
+type BN254CertificateVerifierReader interface {
+	OPERATORINFOLEAFSALT(opts *bind.CallOpts) (uint8, error)
+	OPERATORTABLELEAFSALT(opts *bind.CallOpts) (uint8, error)
+	CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error)
+	CalculateOperatorInfoLeaf(opts *bind.CallOpts, operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo) ([32]byte, error)
+	CalculateOperatorTableLeaf(opts *bind.CallOpts, operatorTableBytes []byte) ([32]byte, error)
+	GetNonsignerOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesBN254OperatorInfo, error)
+	GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error)
+	GetOperatorSetInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (IOperatorTableCalculatorTypesBN254OperatorSetInfo, error)
+	GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error)
+	IsNonsignerCached(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (bool, error)
+	IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error)
+	LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	OperatorTableUpdater(opts *bind.CallOpts) (common.Address, error)
+	TrySignatureVerification(opts *bind.CallOpts, msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point) (struct {
+		PairingSuccessful bool
+		SignatureValid    bool
+	}, error)
+}
+
+// BN254CertificateVerifierWriter is the transacting surface of the BN254CertificateVerifier
+// binding, implemented by BN254CertificateVerifierTransactor, BN254CertificateVerifier and FakeBN254CertificateVerifier.
+type BN254CertificateVerifierWriter interface {
+	UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	VerifyCertificate(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) (*types.Transaction, error)
+	VerifyCertificateNominal(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) (*types.Transaction, error)
+	VerifyCertificateProportion(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) (*types.Transaction, error)
+}
+
+// BN254CertificateVerifierEvents is the event filtering surface of the BN254CertificateVerifier
+// binding, implemented by BN254CertificateVerifierFilterer, BN254CertificateVerifier and FakeBN254CertificateVerifier.
+type BN254CertificateVerifierEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*BN254CertificateVerifierInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *BN254CertificateVerifierInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*BN254CertificateVerifierInitialized, error)
+	FilterMaxStalenessPeriodUpdated(opts *bind.FilterOpts) (*BN254CertificateVerifierMaxStalenessPeriodUpdatedIterator, error)
+	WatchMaxStalenessPeriodUpdated(opts *bind.WatchOpts, sink chan<- *BN254CertificateVerifierMaxStalenessPeriodUpdated) (event.Subscription, error)
+	ParseMaxStalenessPeriodUpdated(log types.Log) (*BN254CertificateVerifierMaxStalenessPeriodUpdated, error)
+	FilterOperatorSetOwnerUpdated(opts *bind.FilterOpts) (*BN254CertificateVerifierOperatorSetOwnerUpdatedIterator, error)
+	WatchOperatorSetOwnerUpdated(opts *bind.WatchOpts, sink chan<- *BN254CertificateVerifierOperatorSetOwnerUpdated) (event.Subscription, error)
+	ParseOperatorSetOwnerUpdated(log types.Log) (*BN254CertificateVerifierOperatorSetOwnerUpdated, error)
+	FilterTableUpdated(opts *bind.FilterOpts) (*BN254CertificateVerifierTableUpdatedIterator, error)
+	WatchTableUpdated(opts *bind.WatchOpts, sink chan<- *BN254CertificateVerifierTableUpdated) (event.Subscription, error)
+	ParseTableUpdated(log types.Log) (*BN254CertificateVerifierTableUpdated, error)
+}
+
+var (
+	_ BN254CertificateVerifierReader = (*BN254CertificateVerifierCaller)(nil)
+	_ BN254CertificateVerifierReader = (*BN254CertificateVerifier)(nil)
+	_ BN254CertificateVerifierReader = (*FakeBN254CertificateVerifier)(nil)
+	_ BN254CertificateVerifierWriter = (*BN254CertificateVerifierTransactor)(nil)
+	_ BN254CertificateVerifierWriter = (*BN254CertificateVerifier)(nil)
+	_ BN254CertificateVerifierWriter = (*FakeBN254CertificateVerifier)(nil)
+	_ BN254CertificateVerifierEvents = (*BN254CertificateVerifierFilterer)(nil)
+	_ BN254CertificateVerifierEvents = (*BN254CertificateVerifier)(nil)
+	_ BN254CertificateVerifierEvents = (*FakeBN254CertificateVerifier)(nil)
+)
@@ -0,0 +1,88 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BN254CertificateVerifierStorage
+
+// ErrArrayLengthMismatch is the typed form of the BN254CertificateVerifierStorage custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "BN254CertificateVerifierStorage: ArrayLengthMismatch()"
+}
+
+// ErrCertificateStale is the typed form of the BN254CertificateVerifierStorage custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "BN254CertificateVerifierStorage: CertificateStale()"
+}
+
+// ErrInvalidOperatorIndex is the typed form of the BN254CertificateVerifierStorage custom error InvalidOperatorIndex().
+type ErrInvalidOperatorIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorIndex) Error() string {
+	return "BN254CertificateVerifierStorage: InvalidOperatorIndex()"
+}
+
+// ErrNonSignerIndicesNotSorted is the typed form of the BN254CertificateVerifierStorage custom error NonSignerIndicesNotSorted().
+type ErrNonSignerIndicesNotSorted struct{}
+
+// Error implements the error interface.
+func (ErrNonSignerIndicesNotSorted) Error() string {
+	return "BN254CertificateVerifierStorage: NonSignerIndicesNotSorted()"
+}
+
+// ErrOnlyTableUpdater is the typed form of the BN254CertificateVerifierStorage custom error OnlyTableUpdater().
+type ErrOnlyTableUpdater struct{}
+
+// Error implements the error interface.
+func (ErrOnlyTableUpdater) Error() string {
+	return "BN254CertificateVerifierStorage: OnlyTableUpdater()"
+}
+
+// ErrReferenceTimestampDoesNotExist is the typed form of the BN254CertificateVerifierStorage custom error ReferenceTimestampDoesNotExist().
+type ErrReferenceTimestampDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrReferenceTimestampDoesNotExist) Error() string {
+	return "BN254CertificateVerifierStorage: ReferenceTimestampDoesNotExist()"
+}
+
+// ErrRootDisabled is the typed form of the BN254CertificateVerifierStorage custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "BN254CertificateVerifierStorage: RootDisabled()"
+}
+
+// ErrTableUpdateStale is the typed form of the BN254CertificateVerifierStorage custom error TableUpdateStale().
+type ErrTableUpdateStale struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateStale) Error() string {
+	return "BN254CertificateVerifierStorage: TableUpdateStale()"
+}
+
+// ErrVerificationFailed is the typed form of the BN254CertificateVerifierStorage custom error VerificationFailed().
+type ErrVerificationFailed struct{}
+
+// Error implements the error interface.
+func (ErrVerificationFailed) Error() string {
+	return "BN254CertificateVerifierStorage: VerificationFailed()"
+}
+
+// BN254CertificateVerifierStorageErrors maps the name of every custom error in the BN254CertificateVerifierStorage ABI to its typed Go error.
+var BN254CertificateVerifierStorageErrors = map[string]error{
+	"ArrayLengthMismatch":            ErrArrayLengthMismatch{},
+	"CertificateStale":               ErrCertificateStale{},
+	"InvalidOperatorIndex":           ErrInvalidOperatorIndex{},
+	"NonSignerIndicesNotSorted":      ErrNonSignerIndicesNotSorted{},
+	"OnlyTableUpdater":               ErrOnlyTableUpdater{},
+	"ReferenceTimestampDoesNotExist": ErrReferenceTimestampDoesNotExist{},
+	"RootDisabled":                   ErrRootDisabled{},
+	"TableUpdateStale":               ErrTableUpdateStale{},
+	"VerificationFailed":             ErrVerificationFailed{},
+}
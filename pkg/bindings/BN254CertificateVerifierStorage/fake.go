@@ -0,0 +1,262 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BN254CertificateVerifierStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeBN254CertificateVerifierStorage is an in-memory BN254CertificateVerifierStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded BN254CertificateVerifierStorageFilterer.
+type FakeBN254CertificateVerifierStorage struct {
+	*fake.Contract
+	*BN254CertificateVerifierStorageFilterer
+}
+
+// NewFakeBN254CertificateVerifierStorage returns an empty fake of the contract at address.
+func NewFakeBN254CertificateVerifierStorage(address common.Address) *FakeBN254CertificateVerifierStorage {
+	contract := fake.NewContract(BN254CertificateVerifierStorageMetaData, address)
+	filterer, err := NewBN254CertificateVerifierStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeBN254CertificateVerifierStorage{Contract: contract, BN254CertificateVerifierStorageFilterer: filterer}
+}
+
+// CalculateCertificateDigest returns the values stubbed with StubCalculateCertificateDigest.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigest sets what CalculateCertificateDigest returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubCalculateCertificateDigest(referenceTimestamp uint32, messageHash [32]byte, out0 [32]byte) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// GetNonsignerOperatorInfo returns the values stubbed with StubGetNonsignerOperatorInfo.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) GetNonsignerOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesBN254OperatorInfo, error) {
+	var out0 IOperatorTableCalculatorTypesBN254OperatorInfo
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("getNonsignerOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubGetNonsignerOperatorInfo sets what GetNonsignerOperatorInfo returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubGetNonsignerOperatorInfo(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 IOperatorTableCalculatorTypesBN254OperatorInfo) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("getNonsignerOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// GetOperatorCount returns the values stubbed with StubGetOperatorCount.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorCount sets what GetOperatorCount returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubGetOperatorCount(operatorSet OperatorSet, referenceTimestamp uint32, out0 *big.Int) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetInfo returns the values stubbed with StubGetOperatorSetInfo.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) GetOperatorSetInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (IOperatorTableCalculatorTypesBN254OperatorSetInfo, error) {
+	var out0 IOperatorTableCalculatorTypesBN254OperatorSetInfo
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("getOperatorSetInfo", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetInfo sets what GetOperatorSetInfo returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubGetOperatorSetInfo(operatorSet OperatorSet, referenceTimestamp uint32, out0 IOperatorTableCalculatorTypesBN254OperatorSetInfo) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("getOperatorSetInfo", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetOwner returns the values stubbed with StubGetOperatorSetOwner.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("getOperatorSetOwner", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetOwner sets what GetOperatorSetOwner returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubGetOperatorSetOwner(operatorSet OperatorSet, out0 common.Address) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("getOperatorSetOwner", []interface{}{operatorSet}, out0)
+}
+
+// GetTotalStakeWeights returns the values stubbed with StubGetTotalStakeWeights.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetTotalStakeWeights sets what GetTotalStakeWeights returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubGetTotalStakeWeights(operatorSet OperatorSet, referenceTimestamp uint32, out0 []*big.Int) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// IsNonsignerCached returns the values stubbed with StubIsNonsignerCached.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) IsNonsignerCached(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (bool, error) {
+	var out0 bool
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("isNonsignerCached", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubIsNonsignerCached sets what IsNonsignerCached returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubIsNonsignerCached(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 bool) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("isNonsignerCached", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// IsReferenceTimestampSet returns the values stubbed with StubIsReferenceTimestampSet.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsReferenceTimestampSet sets what IsReferenceTimestampSet returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubIsReferenceTimestampSet(operatorSet OperatorSet, referenceTimestamp uint32, out0 bool) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// LatestReferenceTimestamp returns the values stubbed with StubLatestReferenceTimestamp.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("latestReferenceTimestamp", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubLatestReferenceTimestamp sets what LatestReferenceTimestamp returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubLatestReferenceTimestamp(operatorSet OperatorSet, out0 uint32) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("latestReferenceTimestamp", []interface{}{operatorSet}, out0)
+}
+
+// MaxOperatorTableStaleness returns the values stubbed with StubMaxOperatorTableStaleness.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("maxOperatorTableStaleness", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubMaxOperatorTableStaleness sets what MaxOperatorTableStaleness returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubMaxOperatorTableStaleness(operatorSet OperatorSet, out0 uint32) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("maxOperatorTableStaleness", []interface{}{operatorSet}, out0)
+}
+
+// OperatorTableUpdater returns the values stubbed with StubOperatorTableUpdater.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) OperatorTableUpdater(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("operatorTableUpdater", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOperatorTableUpdater sets what OperatorTableUpdater returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubOperatorTableUpdater(out0 common.Address) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("operatorTableUpdater", []interface{}{}, out0)
+}
+
+// TrySignatureVerification returns the values stubbed with StubTrySignatureVerification.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) TrySignatureVerification(opts *bind.CallOpts, msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point) (struct {
+	PairingSuccessful bool
+	SignatureValid    bool
+}, error) {
+	var out0 struct {
+		PairingSuccessful bool
+		SignatureValid    bool
+	}
+	err := _FakeBN254CertificateVerifierStorage.Contract.Read("trySignatureVerification", []interface{}{msgHash, aggPubkey, apkG2, signature}, &out0)
+	return out0, err
+}
+
+// StubTrySignatureVerification sets what TrySignatureVerification returns for the given arguments.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) StubTrySignatureVerification(msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point, out0 struct {
+	PairingSuccessful bool
+	SignatureValid    bool
+}) {
+	_FakeBN254CertificateVerifierStorage.Contract.Stub("trySignatureVerification", []interface{}{msgHash, aggPubkey, apkG2, signature}, out0)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeBN254CertificateVerifierStorage.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeBN254CertificateVerifierStorage.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, referenceTimestamp, operatorSetInfo, operatorSetConfig)
+		}
+		return nil
+	}, operatorSet, referenceTimestamp, operatorSetInfo, operatorSetConfig)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeBN254CertificateVerifierStorage.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// VerifyCertificate records a verifyCertificate call after running the hook set with OnVerifyCertificate.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) VerifyCertificate(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) (*types.Transaction, error) {
+	return _FakeBN254CertificateVerifierStorage.Contract.Transact(opts, "verifyCertificate", func() error {
+		if hook, ok := _FakeBN254CertificateVerifierStorage.Contract.Hook("verifyCertificate").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) error); ok {
+			return hook(opts, operatorSet, cert)
+		}
+		return nil
+	}, operatorSet, cert)
+}
+
+// OnVerifyCertificate sets a hook run by every VerifyCertificate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) OnVerifyCertificate(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) error) {
+	_FakeBN254CertificateVerifierStorage.Contract.SetHook("verifyCertificate", hook)
+}
+
+// VerifyCertificateNominal records a verifyCertificateNominal call after running the hook set with OnVerifyCertificateNominal.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) VerifyCertificateNominal(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) (*types.Transaction, error) {
+	return _FakeBN254CertificateVerifierStorage.Contract.Transact(opts, "verifyCertificateNominal", func() error {
+		if hook, ok := _FakeBN254CertificateVerifierStorage.Contract.Hook("verifyCertificateNominal").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) error); ok {
+			return hook(opts, operatorSet, cert, totalStakeNominalThresholds)
+		}
+		return nil
+	}, operatorSet, cert, totalStakeNominalThresholds)
+}
+
+// OnVerifyCertificateNominal sets a hook run by every VerifyCertificateNominal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) OnVerifyCertificateNominal(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) error) {
+	_FakeBN254CertificateVerifierStorage.Contract.SetHook("verifyCertificateNominal", hook)
+}
+
+// VerifyCertificateProportion records a verifyCertificateProportion call after running the hook set with OnVerifyCertificateProportion.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) VerifyCertificateProportion(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) (*types.Transaction, error) {
+	return _FakeBN254CertificateVerifierStorage.Contract.Transact(opts, "verifyCertificateProportion", func() error {
+		if hook, ok := _FakeBN254CertificateVerifierStorage.Contract.Hook("verifyCertificateProportion").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) error); ok {
+			return hook(opts, operatorSet, cert, totalStakeProportionThresholds)
+		}
+		return nil
+	}, operatorSet, cert, totalStakeProportionThresholds)
+}
+
+// OnVerifyCertificateProportion sets a hook run by every VerifyCertificateProportion call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) OnVerifyCertificateProportion(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) error) {
+	_FakeBN254CertificateVerifierStorage.Contract.SetHook("verifyCertificateProportion", hook)
+}
+
+// EmitMaxStalenessPeriodUpdated appends the event to the fake's logs.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) EmitMaxStalenessPeriodUpdated(event *BN254CertificateVerifierStorageMaxStalenessPeriodUpdated) {
+	_FakeBN254CertificateVerifierStorage.Contract.Emit("MaxStalenessPeriodUpdated", event)
+}
+
+// EmitOperatorSetOwnerUpdated appends the event to the fake's logs.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) EmitOperatorSetOwnerUpdated(event *BN254CertificateVerifierStorageOperatorSetOwnerUpdated) {
+	_FakeBN254CertificateVerifierStorage.Contract.Emit("OperatorSetOwnerUpdated", event)
+}
+
+// EmitTableUpdated appends the event to the fake's logs.
+func (_FakeBN254CertificateVerifierStorage *FakeBN254CertificateVerifierStorage) EmitTableUpdated(event *BN254CertificateVerifierStorageTableUpdated) {
+	_FakeBN254CertificateVerifierStorage.Contract.Emit("TableUpdated", event)
+}
@@ -0,0 +1,66 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BN254CertificateVerifierStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// BN254CertificateVerifierStorageReader is the read-only surface of the BN254CertificateVerifierStorage
+// binding, implemented by BN254CertificateVerifierStorageCaller, BN254CertificateVerifierStorage and FakeBN254CertificateVerifierStorage.
+type BN254CertificateVerifierStorageReader interface {
+	CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error)
+	GetNonsignerOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesBN254OperatorInfo, error)
+	GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error)
+	GetOperatorSetInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (IOperatorTableCalculatorTypesBN254OperatorSetInfo, error)
+	GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error)
+	IsNonsignerCached(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (bool, error)
+	IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error)
+	LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	OperatorTableUpdater(opts *bind.CallOpts) (common.Address, error)
+	TrySignatureVerification(opts *bind.CallOpts, msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point) (struct {
+		PairingSuccessful bool
+		SignatureValid    bool
+	}, error)
+}
+
+// BN254CertificateVerifierStorageWriter is the transacting surface of the BN254CertificateVerifierStorage
+// binding, implemented by BN254CertificateVerifierStorageTransactor, BN254CertificateVerifierStorage and FakeBN254CertificateVerifierStorage.
+type BN254CertificateVerifierStorageWriter interface {
+	UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	VerifyCertificate(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) (*types.Transaction, error)
+	VerifyCertificateNominal(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) (*types.Transaction, error)
+	VerifyCertificateProportion(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) (*types.Transaction, error)
+}
+
+// BN254CertificateVerifierStorageEvents is the event filtering surface of the BN254CertificateVerifierStorage
+// binding, implemented by BN254CertificateVerifierStorageFilterer, BN254CertificateVerifierStorage and FakeBN254CertificateVerifierStorage.
+type BN254CertificateVerifierStorageEvents interface {
+	FilterMaxStalenessPeriodUpdated(opts *bind.FilterOpts) (*BN254CertificateVerifierStorageMaxStalenessPeriodUpdatedIterator, error)
+	WatchMaxStalenessPeriodUpdated(opts *bind.WatchOpts, sink chan<- *BN254CertificateVerifierStorageMaxStalenessPeriodUpdated) (event.Subscription, error)
+	ParseMaxStalenessPeriodUpdated(log types.Log) (*BN254CertificateVerifierStorageMaxStalenessPeriodUpdated, error)
+	FilterOperatorSetOwnerUpdated(opts *bind.FilterOpts) (*BN254CertificateVerifierStorageOperatorSetOwnerUpdatedIterator, error)
+	WatchOperatorSetOwnerUpdated(opts *bind.WatchOpts, sink chan<- *BN254CertificateVerifierStorageOperatorSetOwnerUpdated) (event.Subscription, error)
+	ParseOperatorSetOwnerUpdated(log types.Log) (*BN254CertificateVerifierStorageOperatorSetOwnerUpdated, error)
+	FilterTableUpdated(opts *bind.FilterOpts) (*BN254CertificateVerifierStorageTableUpdatedIterator, error)
+	WatchTableUpdated(opts *bind.WatchOpts, sink chan<- *BN254CertificateVerifierStorageTableUpdated) (event.Subscription, error)
+	ParseTableUpdated(log types.Log) (*BN254CertificateVerifierStorageTableUpdated, error)
+}
+
+var (
+	_ BN254CertificateVerifierStorageReader = (*BN254CertificateVerifierStorageCaller)(nil)
+	_ BN254CertificateVerifierStorageReader = (*BN254CertificateVerifierStorage)(nil)
+	_ BN254CertificateVerifierStorageReader = (*FakeBN254CertificateVerifierStorage)(nil)
+	_ BN254CertificateVerifierStorageWriter = (*BN254CertificateVerifierStorageTransactor)(nil)
+	_ BN254CertificateVerifierStorageWriter = (*BN254CertificateVerifierStorage)(nil)
+	_ BN254CertificateVerifierStorageWriter = (*FakeBN254CertificateVerifierStorage)(nil)
+	_ BN254CertificateVerifierStorageEvents = (*BN254CertificateVerifierStorageFilterer)(nil)
+	_ BN254CertificateVerifierStorageEvents = (*BN254CertificateVerifierStorage)(nil)
+	_ BN254CertificateVerifierStorageEvents = (*FakeBN254CertificateVerifierStorage)(nil)
+)
@@ -0,0 +1,662 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BackingEigen
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeBackingEigen is an in-memory BackingEigen for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded BackingEigenFilterer.
+type FakeBackingEigen struct {
+	*fake.Contract
+	*BackingEigenFilterer
+}
+
+// NewFakeBackingEigen returns an empty fake of the contract at address.
+func NewFakeBackingEigen(address common.Address) *FakeBackingEigen {
+	contract := fake.NewContract(BackingEigenMetaData, address)
+	filterer, err := NewBackingEigenFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeBackingEigen{Contract: contract, BackingEigenFilterer: filterer}
+}
+
+// CLOCKMODE returns the values stubbed with StubCLOCKMODE.
+func (_FakeBackingEigen *FakeBackingEigen) CLOCKMODE(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeBackingEigen.Contract.Read("CLOCK_MODE", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCLOCKMODE sets what CLOCKMODE returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubCLOCKMODE(out0 string) {
+	_FakeBackingEigen.Contract.Stub("CLOCK_MODE", []interface{}{}, out0)
+}
+
+// DOMAINSEPARATOR returns the values stubbed with StubDOMAINSEPARATOR.
+func (_FakeBackingEigen *FakeBackingEigen) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeBackingEigen.Contract.Read("DOMAIN_SEPARATOR", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDOMAINSEPARATOR sets what DOMAINSEPARATOR returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubDOMAINSEPARATOR(out0 [32]byte) {
+	_FakeBackingEigen.Contract.Stub("DOMAIN_SEPARATOR", []interface{}{}, out0)
+}
+
+// EIGEN returns the values stubbed with StubEIGEN.
+func (_FakeBackingEigen *FakeBackingEigen) EIGEN(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeBackingEigen.Contract.Read("EIGEN", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEIGEN sets what EIGEN returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubEIGEN(out0 common.Address) {
+	_FakeBackingEigen.Contract.Stub("EIGEN", []interface{}{}, out0)
+}
+
+// Allowance returns the values stubbed with StubAllowance.
+func (_FakeBackingEigen *FakeBackingEigen) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("allowance", []interface{}{owner, spender}, &out0)
+	return out0, err
+}
+
+// StubAllowance sets what Allowance returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubAllowance(owner common.Address, spender common.Address, out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("allowance", []interface{}{owner, spender}, out0)
+}
+
+// AllowedFrom returns the values stubbed with StubAllowedFrom.
+func (_FakeBackingEigen *FakeBackingEigen) AllowedFrom(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeBackingEigen.Contract.Read("allowedFrom", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubAllowedFrom sets what AllowedFrom returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubAllowedFrom(arg0 common.Address, out0 bool) {
+	_FakeBackingEigen.Contract.Stub("allowedFrom", []interface{}{arg0}, out0)
+}
+
+// AllowedTo returns the values stubbed with StubAllowedTo.
+func (_FakeBackingEigen *FakeBackingEigen) AllowedTo(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeBackingEigen.Contract.Read("allowedTo", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubAllowedTo sets what AllowedTo returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubAllowedTo(arg0 common.Address, out0 bool) {
+	_FakeBackingEigen.Contract.Stub("allowedTo", []interface{}{arg0}, out0)
+}
+
+// BalanceOf returns the values stubbed with StubBalanceOf.
+func (_FakeBackingEigen *FakeBackingEigen) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("balanceOf", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubBalanceOf sets what BalanceOf returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubBalanceOf(account common.Address, out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("balanceOf", []interface{}{account}, out0)
+}
+
+// Checkpoints returns the values stubbed with StubCheckpoints.
+func (_FakeBackingEigen *FakeBackingEigen) Checkpoints(opts *bind.CallOpts, account common.Address, pos uint32) (ERC20VotesUpgradeableCheckpoint, error) {
+	var out0 ERC20VotesUpgradeableCheckpoint
+	err := _FakeBackingEigen.Contract.Read("checkpoints", []interface{}{account, pos}, &out0)
+	return out0, err
+}
+
+// StubCheckpoints sets what Checkpoints returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubCheckpoints(account common.Address, pos uint32, out0 ERC20VotesUpgradeableCheckpoint) {
+	_FakeBackingEigen.Contract.Stub("checkpoints", []interface{}{account, pos}, out0)
+}
+
+// Clock returns the values stubbed with StubClock.
+func (_FakeBackingEigen *FakeBackingEigen) Clock(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("clock", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubClock sets what Clock returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubClock(out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("clock", []interface{}{}, out0)
+}
+
+// Decimals returns the values stubbed with StubDecimals.
+func (_FakeBackingEigen *FakeBackingEigen) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out0 uint8
+	err := _FakeBackingEigen.Contract.Read("decimals", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDecimals sets what Decimals returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubDecimals(out0 uint8) {
+	_FakeBackingEigen.Contract.Stub("decimals", []interface{}{}, out0)
+}
+
+// Delegates returns the values stubbed with StubDelegates.
+func (_FakeBackingEigen *FakeBackingEigen) Delegates(opts *bind.CallOpts, account common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeBackingEigen.Contract.Read("delegates", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubDelegates sets what Delegates returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubDelegates(account common.Address, out0 common.Address) {
+	_FakeBackingEigen.Contract.Stub("delegates", []interface{}{account}, out0)
+}
+
+// Eip712Domain returns the values stubbed with StubEip712Domain.
+func (_FakeBackingEigen *FakeBackingEigen) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out0 struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	}
+	err := _FakeBackingEigen.Contract.Read("eip712Domain", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEip712Domain sets what Eip712Domain returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubEip712Domain(out0 struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}) {
+	_FakeBackingEigen.Contract.Stub("eip712Domain", []interface{}{}, out0)
+}
+
+// GetPastTotalSupply returns the values stubbed with StubGetPastTotalSupply.
+func (_FakeBackingEigen *FakeBackingEigen) GetPastTotalSupply(opts *bind.CallOpts, timepoint *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("getPastTotalSupply", []interface{}{timepoint}, &out0)
+	return out0, err
+}
+
+// StubGetPastTotalSupply sets what GetPastTotalSupply returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubGetPastTotalSupply(timepoint *big.Int, out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("getPastTotalSupply", []interface{}{timepoint}, out0)
+}
+
+// GetPastVotes returns the values stubbed with StubGetPastVotes.
+func (_FakeBackingEigen *FakeBackingEigen) GetPastVotes(opts *bind.CallOpts, account common.Address, timepoint *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("getPastVotes", []interface{}{account, timepoint}, &out0)
+	return out0, err
+}
+
+// StubGetPastVotes sets what GetPastVotes returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubGetPastVotes(account common.Address, timepoint *big.Int, out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("getPastVotes", []interface{}{account, timepoint}, out0)
+}
+
+// GetVotes returns the values stubbed with StubGetVotes.
+func (_FakeBackingEigen *FakeBackingEigen) GetVotes(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("getVotes", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubGetVotes sets what GetVotes returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubGetVotes(account common.Address, out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("getVotes", []interface{}{account}, out0)
+}
+
+// IsMinter returns the values stubbed with StubIsMinter.
+func (_FakeBackingEigen *FakeBackingEigen) IsMinter(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeBackingEigen.Contract.Read("isMinter", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubIsMinter sets what IsMinter returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubIsMinter(arg0 common.Address, out0 bool) {
+	_FakeBackingEigen.Contract.Stub("isMinter", []interface{}{arg0}, out0)
+}
+
+// Name returns the values stubbed with StubName.
+func (_FakeBackingEigen *FakeBackingEigen) Name(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeBackingEigen.Contract.Read("name", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubName sets what Name returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubName(out0 string) {
+	_FakeBackingEigen.Contract.Stub("name", []interface{}{}, out0)
+}
+
+// Nonces returns the values stubbed with StubNonces.
+func (_FakeBackingEigen *FakeBackingEigen) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("nonces", []interface{}{owner}, &out0)
+	return out0, err
+}
+
+// StubNonces sets what Nonces returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubNonces(owner common.Address, out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("nonces", []interface{}{owner}, out0)
+}
+
+// NumCheckpoints returns the values stubbed with StubNumCheckpoints.
+func (_FakeBackingEigen *FakeBackingEigen) NumCheckpoints(opts *bind.CallOpts, account common.Address) (uint32, error) {
+	var out0 uint32
+	err := _FakeBackingEigen.Contract.Read("numCheckpoints", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubNumCheckpoints sets what NumCheckpoints returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubNumCheckpoints(account common.Address, out0 uint32) {
+	_FakeBackingEigen.Contract.Stub("numCheckpoints", []interface{}{account}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeBackingEigen *FakeBackingEigen) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeBackingEigen.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubOwner(out0 common.Address) {
+	_FakeBackingEigen.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// Symbol returns the values stubbed with StubSymbol.
+func (_FakeBackingEigen *FakeBackingEigen) Symbol(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeBackingEigen.Contract.Read("symbol", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubSymbol sets what Symbol returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubSymbol(out0 string) {
+	_FakeBackingEigen.Contract.Stub("symbol", []interface{}{}, out0)
+}
+
+// TotalSupply returns the values stubbed with StubTotalSupply.
+func (_FakeBackingEigen *FakeBackingEigen) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("totalSupply", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalSupply sets what TotalSupply returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubTotalSupply(out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("totalSupply", []interface{}{}, out0)
+}
+
+// TransferRestrictionsDisabledAfter returns the values stubbed with StubTransferRestrictionsDisabledAfter.
+func (_FakeBackingEigen *FakeBackingEigen) TransferRestrictionsDisabledAfter(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeBackingEigen.Contract.Read("transferRestrictionsDisabledAfter", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTransferRestrictionsDisabledAfter sets what TransferRestrictionsDisabledAfter returns for the given arguments.
+func (_FakeBackingEigen *FakeBackingEigen) StubTransferRestrictionsDisabledAfter(out0 *big.Int) {
+	_FakeBackingEigen.Contract.Stub("transferRestrictionsDisabledAfter", []interface{}{}, out0)
+}
+
+// Approve records a approve call after running the hook set with OnApprove.
+func (_FakeBackingEigen *FakeBackingEigen) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "approve", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("approve").(func(opts *bind.TransactOpts, spender common.Address, amount *big.Int) error); ok {
+			return hook(opts, spender, amount)
+		}
+		return nil
+	}, spender, amount)
+}
+
+// OnApprove sets a hook run by every Approve call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnApprove(hook func(opts *bind.TransactOpts, spender common.Address, amount *big.Int) error) {
+	_FakeBackingEigen.Contract.SetHook("approve", hook)
+}
+
+// Burn records a burn call after running the hook set with OnBurn.
+func (_FakeBackingEigen *FakeBackingEigen) Burn(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "burn", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("burn").(func(opts *bind.TransactOpts, amount *big.Int) error); ok {
+			return hook(opts, amount)
+		}
+		return nil
+	}, amount)
+}
+
+// OnBurn sets a hook run by every Burn call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnBurn(hook func(opts *bind.TransactOpts, amount *big.Int) error) {
+	_FakeBackingEigen.Contract.SetHook("burn", hook)
+}
+
+// DecreaseAllowance records a decreaseAllowance call after running the hook set with OnDecreaseAllowance.
+func (_FakeBackingEigen *FakeBackingEigen) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "decreaseAllowance", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("decreaseAllowance").(func(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) error); ok {
+			return hook(opts, spender, subtractedValue)
+		}
+		return nil
+	}, spender, subtractedValue)
+}
+
+// OnDecreaseAllowance sets a hook run by every DecreaseAllowance call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnDecreaseAllowance(hook func(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) error) {
+	_FakeBackingEigen.Contract.SetHook("decreaseAllowance", hook)
+}
+
+// Delegate records a delegate call after running the hook set with OnDelegate.
+func (_FakeBackingEigen *FakeBackingEigen) Delegate(opts *bind.TransactOpts, delegatee common.Address) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "delegate", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("delegate").(func(opts *bind.TransactOpts, delegatee common.Address) error); ok {
+			return hook(opts, delegatee)
+		}
+		return nil
+	}, delegatee)
+}
+
+// OnDelegate sets a hook run by every Delegate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnDelegate(hook func(opts *bind.TransactOpts, delegatee common.Address) error) {
+	_FakeBackingEigen.Contract.SetHook("delegate", hook)
+}
+
+// DelegateBySig records a delegateBySig call after running the hook set with OnDelegateBySig.
+func (_FakeBackingEigen *FakeBackingEigen) DelegateBySig(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "delegateBySig", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("delegateBySig").(func(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) error); ok {
+			return hook(opts, delegatee, nonce, expiry, v, r, s)
+		}
+		return nil
+	}, delegatee, nonce, expiry, v, r, s)
+}
+
+// OnDelegateBySig sets a hook run by every DelegateBySig call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnDelegateBySig(hook func(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) error) {
+	_FakeBackingEigen.Contract.SetHook("delegateBySig", hook)
+}
+
+// DisableTransferRestrictions records a disableTransferRestrictions call after running the hook set with OnDisableTransferRestrictions.
+func (_FakeBackingEigen *FakeBackingEigen) DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "disableTransferRestrictions", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("disableTransferRestrictions").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnDisableTransferRestrictions sets a hook run by every DisableTransferRestrictions call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnDisableTransferRestrictions(hook func(opts *bind.TransactOpts) error) {
+	_FakeBackingEigen.Contract.SetHook("disableTransferRestrictions", hook)
+}
+
+// IncreaseAllowance records a increaseAllowance call after running the hook set with OnIncreaseAllowance.
+func (_FakeBackingEigen *FakeBackingEigen) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "increaseAllowance", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("increaseAllowance").(func(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) error); ok {
+			return hook(opts, spender, addedValue)
+		}
+		return nil
+	}, spender, addedValue)
+}
+
+// OnIncreaseAllowance sets a hook run by every IncreaseAllowance call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnIncreaseAllowance(hook func(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) error) {
+	_FakeBackingEigen.Contract.SetHook("increaseAllowance", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeBackingEigen *FakeBackingEigen) Initialize(opts *bind.TransactOpts, initialOwner common.Address) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address) error); ok {
+			return hook(opts, initialOwner)
+		}
+		return nil
+	}, initialOwner)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address) error) {
+	_FakeBackingEigen.Contract.SetHook("initialize", hook)
+}
+
+// Mint records a mint call after running the hook set with OnMint.
+func (_FakeBackingEigen *FakeBackingEigen) Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "mint", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("mint").(func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, to, amount)
+		}
+		return nil
+	}, to, amount)
+}
+
+// OnMint sets a hook run by every Mint call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnMint(hook func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error) {
+	_FakeBackingEigen.Contract.SetHook("mint", hook)
+}
+
+// Permit records a permit call after running the hook set with OnPermit.
+func (_FakeBackingEigen *FakeBackingEigen) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "permit", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("permit").(func(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) error); ok {
+			return hook(opts, owner, spender, value, deadline, v, r, s)
+		}
+		return nil
+	}, owner, spender, value, deadline, v, r, s)
+}
+
+// OnPermit sets a hook run by every Permit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnPermit(hook func(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) error) {
+	_FakeBackingEigen.Contract.SetHook("permit", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeBackingEigen *FakeBackingEigen) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeBackingEigen.Contract.SetHook("renounceOwnership", hook)
+}
+
+// SetAllowedFrom records a setAllowedFrom call after running the hook set with OnSetAllowedFrom.
+func (_FakeBackingEigen *FakeBackingEigen) SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "setAllowedFrom", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("setAllowedFrom").(func(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) error); ok {
+			return hook(opts, from, isAllowedFrom)
+		}
+		return nil
+	}, from, isAllowedFrom)
+}
+
+// OnSetAllowedFrom sets a hook run by every SetAllowedFrom call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnSetAllowedFrom(hook func(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) error) {
+	_FakeBackingEigen.Contract.SetHook("setAllowedFrom", hook)
+}
+
+// SetAllowedTo records a setAllowedTo call after running the hook set with OnSetAllowedTo.
+func (_FakeBackingEigen *FakeBackingEigen) SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "setAllowedTo", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("setAllowedTo").(func(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) error); ok {
+			return hook(opts, to, isAllowedTo)
+		}
+		return nil
+	}, to, isAllowedTo)
+}
+
+// OnSetAllowedTo sets a hook run by every SetAllowedTo call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnSetAllowedTo(hook func(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) error) {
+	_FakeBackingEigen.Contract.SetHook("setAllowedTo", hook)
+}
+
+// SetIsMinter records a setIsMinter call after running the hook set with OnSetIsMinter.
+func (_FakeBackingEigen *FakeBackingEigen) SetIsMinter(opts *bind.TransactOpts, minterAddress common.Address, newStatus bool) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "setIsMinter", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("setIsMinter").(func(opts *bind.TransactOpts, minterAddress common.Address, newStatus bool) error); ok {
+			return hook(opts, minterAddress, newStatus)
+		}
+		return nil
+	}, minterAddress, newStatus)
+}
+
+// OnSetIsMinter sets a hook run by every SetIsMinter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnSetIsMinter(hook func(opts *bind.TransactOpts, minterAddress common.Address, newStatus bool) error) {
+	_FakeBackingEigen.Contract.SetHook("setIsMinter", hook)
+}
+
+// Transfer records a transfer call after running the hook set with OnTransfer.
+func (_FakeBackingEigen *FakeBackingEigen) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "transfer", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("transfer").(func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, to, amount)
+		}
+		return nil
+	}, to, amount)
+}
+
+// OnTransfer sets a hook run by every Transfer call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnTransfer(hook func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error) {
+	_FakeBackingEigen.Contract.SetHook("transfer", hook)
+}
+
+// TransferFrom records a transferFrom call after running the hook set with OnTransferFrom.
+func (_FakeBackingEigen *FakeBackingEigen) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "transferFrom", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("transferFrom").(func(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, from, to, amount)
+		}
+		return nil
+	}, from, to, amount)
+}
+
+// OnTransferFrom sets a hook run by every TransferFrom call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnTransferFrom(hook func(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) error) {
+	_FakeBackingEigen.Contract.SetHook("transferFrom", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeBackingEigen *FakeBackingEigen) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeBackingEigen.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeBackingEigen.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeBackingEigen *FakeBackingEigen) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeBackingEigen.Contract.SetHook("transferOwnership", hook)
+}
+
+// EmitApproval appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitApproval(event *BackingEigenApproval) {
+	_FakeBackingEigen.Contract.Emit("Approval", event)
+}
+
+// EmitBacked appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitBacked(event *BackingEigenBacked) {
+	_FakeBackingEigen.Contract.Emit("Backed", event)
+}
+
+// EmitDelegateChanged appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitDelegateChanged(event *BackingEigenDelegateChanged) {
+	_FakeBackingEigen.Contract.Emit("DelegateChanged", event)
+}
+
+// EmitDelegateVotesChanged appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitDelegateVotesChanged(event *BackingEigenDelegateVotesChanged) {
+	_FakeBackingEigen.Contract.Emit("DelegateVotesChanged", event)
+}
+
+// EmitEIP712DomainChanged appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitEIP712DomainChanged(event *BackingEigenEIP712DomainChanged) {
+	_FakeBackingEigen.Contract.Emit("EIP712DomainChanged", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitInitialized(event *BackingEigenInitialized) {
+	_FakeBackingEigen.Contract.Emit("Initialized", event)
+}
+
+// EmitIsMinterModified appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitIsMinterModified(event *BackingEigenIsMinterModified) {
+	_FakeBackingEigen.Contract.Emit("IsMinterModified", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitOwnershipTransferred(event *BackingEigenOwnershipTransferred) {
+	_FakeBackingEigen.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitSetAllowedFrom appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitSetAllowedFrom(event *BackingEigenSetAllowedFrom) {
+	_FakeBackingEigen.Contract.Emit("SetAllowedFrom", event)
+}
+
+// EmitSetAllowedTo appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitSetAllowedTo(event *BackingEigenSetAllowedTo) {
+	_FakeBackingEigen.Contract.Emit("SetAllowedTo", event)
+}
+
+// EmitTransfer appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitTransfer(event *BackingEigenTransfer) {
+	_FakeBackingEigen.Contract.Emit("Transfer", event)
+}
+
+// EmitTransferRestrictionsDisabled appends the event to the fake's logs.
+func (_FakeBackingEigen *FakeBackingEigen) EmitTransferRestrictionsDisabled(event *BackingEigenTransferRestrictionsDisabled) {
+	_FakeBackingEigen.Contract.Emit("TransferRestrictionsDisabled", event)
+}
@@ -0,0 +1,122 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BackingEigen
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// BackingEigenReader is the read-only surface of the BackingEigen
+// binding, implemented by BackingEigenCaller, BackingEigen and FakeBackingEigen.
+type BackingEigenReader interface {
+	CLOCKMODE(opts *bind.CallOpts) (string, error)
+	DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error)
+	EIGEN(opts *bind.CallOpts) (common.Address, error)
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+	AllowedFrom(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	AllowedTo(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	Checkpoints(opts *bind.CallOpts, account common.Address, pos uint32) (ERC20VotesUpgradeableCheckpoint, error)
+	Clock(opts *bind.CallOpts) (*big.Int, error)
+	Decimals(opts *bind.CallOpts) (uint8, error)
+	Delegates(opts *bind.CallOpts, account common.Address) (common.Address, error)
+	Eip712Domain(opts *bind.CallOpts) (struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	}, error)
+	GetPastTotalSupply(opts *bind.CallOpts, timepoint *big.Int) (*big.Int, error)
+	GetPastVotes(opts *bind.CallOpts, account common.Address, timepoint *big.Int) (*big.Int, error)
+	GetVotes(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	IsMinter(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	Name(opts *bind.CallOpts) (string, error)
+	Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error)
+	NumCheckpoints(opts *bind.CallOpts, account common.Address) (uint32, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Symbol(opts *bind.CallOpts) (string, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+	TransferRestrictionsDisabledAfter(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// BackingEigenWriter is the transacting surface of the BackingEigen
+// binding, implemented by BackingEigenTransactor, BackingEigen and FakeBackingEigen.
+type BackingEigenWriter interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+	Burn(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error)
+	DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error)
+	Delegate(opts *bind.TransactOpts, delegatee common.Address) (*types.Transaction, error)
+	DelegateBySig(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error)
+	DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error)
+	IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address) (*types.Transaction, error)
+	Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error)
+	SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error)
+	SetIsMinter(opts *bind.TransactOpts, minterAddress common.Address, newStatus bool) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+}
+
+// BackingEigenEvents is the event filtering surface of the BackingEigen
+// binding, implemented by BackingEigenFilterer, BackingEigen and FakeBackingEigen.
+type BackingEigenEvents interface {
+	FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*BackingEigenApprovalIterator, error)
+	WatchApproval(opts *bind.WatchOpts, sink chan<- *BackingEigenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error)
+	ParseApproval(log types.Log) (*BackingEigenApproval, error)
+	FilterBacked(opts *bind.FilterOpts) (*BackingEigenBackedIterator, error)
+	WatchBacked(opts *bind.WatchOpts, sink chan<- *BackingEigenBacked) (event.Subscription, error)
+	ParseBacked(log types.Log) (*BackingEigenBacked, error)
+	FilterDelegateChanged(opts *bind.FilterOpts, delegator []common.Address, fromDelegate []common.Address, toDelegate []common.Address) (*BackingEigenDelegateChangedIterator, error)
+	WatchDelegateChanged(opts *bind.WatchOpts, sink chan<- *BackingEigenDelegateChanged, delegator []common.Address, fromDelegate []common.Address, toDelegate []common.Address) (event.Subscription, error)
+	ParseDelegateChanged(log types.Log) (*BackingEigenDelegateChanged, error)
+	FilterDelegateVotesChanged(opts *bind.FilterOpts, delegate []common.Address) (*BackingEigenDelegateVotesChangedIterator, error)
+	WatchDelegateVotesChanged(opts *bind.WatchOpts, sink chan<- *BackingEigenDelegateVotesChanged, delegate []common.Address) (event.Subscription, error)
+	ParseDelegateVotesChanged(log types.Log) (*BackingEigenDelegateVotesChanged, error)
+	FilterEIP712DomainChanged(opts *bind.FilterOpts) (*BackingEigenEIP712DomainChangedIterator, error)
+	WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *BackingEigenEIP712DomainChanged) (event.Subscription, error)
+	ParseEIP712DomainChanged(log types.Log) (*BackingEigenEIP712DomainChanged, error)
+	FilterInitialized(opts *bind.FilterOpts) (*BackingEigenInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *BackingEigenInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*BackingEigenInitialized, error)
+	FilterIsMinterModified(opts *bind.FilterOpts, minterAddress []common.Address) (*BackingEigenIsMinterModifiedIterator, error)
+	WatchIsMinterModified(opts *bind.WatchOpts, sink chan<- *BackingEigenIsMinterModified, minterAddress []common.Address) (event.Subscription, error)
+	ParseIsMinterModified(log types.Log) (*BackingEigenIsMinterModified, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*BackingEigenOwnershipTransferredIterator, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *BackingEigenOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	ParseOwnershipTransferred(log types.Log) (*BackingEigenOwnershipTransferred, error)
+	FilterSetAllowedFrom(opts *bind.FilterOpts, from []common.Address) (*BackingEigenSetAllowedFromIterator, error)
+	WatchSetAllowedFrom(opts *bind.WatchOpts, sink chan<- *BackingEigenSetAllowedFrom, from []common.Address) (event.Subscription, error)
+	ParseSetAllowedFrom(log types.Log) (*BackingEigenSetAllowedFrom, error)
+	FilterSetAllowedTo(opts *bind.FilterOpts, to []common.Address) (*BackingEigenSetAllowedToIterator, error)
+	WatchSetAllowedTo(opts *bind.WatchOpts, sink chan<- *BackingEigenSetAllowedTo, to []common.Address) (event.Subscription, error)
+	ParseSetAllowedTo(log types.Log) (*BackingEigenSetAllowedTo, error)
+	FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*BackingEigenTransferIterator, error)
+	WatchTransfer(opts *bind.WatchOpts, sink chan<- *BackingEigenTransfer, from []common.Address, to []common.Address) (event.Subscription, error)
+	ParseTransfer(log types.Log) (*BackingEigenTransfer, error)
+	FilterTransferRestrictionsDisabled(opts *bind.FilterOpts) (*BackingEigenTransferRestrictionsDisabledIterator, error)
+	WatchTransferRestrictionsDisabled(opts *bind.WatchOpts, sink chan<- *BackingEigenTransferRestrictionsDisabled) (event.Subscription, error)
+	ParseTransferRestrictionsDisabled(log types.Log) (*BackingEigenTransferRestrictionsDisabled, error)
+}
+
+var (
+	_ BackingEigenReader = (*BackingEigenCaller)(nil)
+	_ BackingEigenReader = (*BackingEigen)(nil)
+	_ BackingEigenReader = (*FakeBackingEigen)(nil)
+	_ BackingEigenWriter = (*BackingEigenTransactor)(nil)
+	_ BackingEigenWriter = (*BackingEigen)(nil)
+	_ BackingEigenWriter = (*FakeBackingEigen)(nil)
+	_ BackingEigenEvents = (*BackingEigenFilterer)(nil)
+	_ BackingEigenEvents = (*BackingEigen)(nil)
+	_ BackingEigenEvents = (*FakeBackingEigen)(nil)
+)
@@ -0,0 +1,34 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package BeaconChainProofs
+
+// ErrInvalidProof is the typed form of the BeaconChainProofs custom error InvalidProof().
+type ErrInvalidProof struct{}
+
+// Error implements the error interface.
+func (ErrInvalidProof) Error() string {
+	return "BeaconChainProofs: InvalidProof()"
+}
+
+// ErrInvalidProofLength is the typed form of the BeaconChainProofs custom error InvalidProofLength().
+type ErrInvalidProofLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidProofLength) Error() string {
+	return "BeaconChainProofs: InvalidProofLength()"
+}
+
+// ErrInvalidValidatorFieldsLength is the typed form of the BeaconChainProofs custom error InvalidValidatorFieldsLength().
+type ErrInvalidValidatorFieldsLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidValidatorFieldsLength) Error() string {
+	return "BeaconChainProofs: InvalidValidatorFieldsLength()"
+}
+
+// BeaconChainProofsErrors maps the name of every custom error in the BeaconChainProofs ABI to its typed Go error.
+var BeaconChainProofsErrors = map[string]error{
+	"InvalidProof":                 ErrInvalidProof{},
+	"InvalidProofLength":           ErrInvalidProofLength{},
+	"InvalidValidatorFieldsLength": ErrInvalidValidatorFieldsLength{},
+}
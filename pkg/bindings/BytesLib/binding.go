@@ -1,203 +0,0 @@
-// Code generated - DO NOT EDIT.
-// This file is a generated binding and any manual changes will be lost.
-
-package BytesLib
-
-import (
-	"errors"
-	"math/big"
-	"strings"
-
-	ethereum "github.com/ethereum/go-ethereum"
-	"github.com/ethereum/go-ethereum/accounts/abi"
-	"github.com/ethereum/go-ethereum/accounts/abi/bind"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
-	"github.com/ethereum/go-ethereum/event"
-)
-
-// Reference imports to suppress errors if they are not otherwise used.
-var (
-	_ = errors.New
-	_ = big.NewInt
-	_ = strings.NewReader
-	_ = ethereum.NotFound
-	_ = bind.Bind
-	_ = common.Big1
-	_ = types.BloomLookup
-	_ = event.NewSubscription
-	_ = abi.ConvertType
-)
-
-// BytesLibMetaData contains all meta data concerning the BytesLib contract.
-var BytesLibMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"error\",\"name\":\"OutOfBounds\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"Overflow\",\"inputs\":[]}]",
-	Bin: "0x60556032600b8282823980515f1a607314602657634e487b7160e01b5f525f60045260245ffd5b305f52607381538281f3fe730000000000000000000000000000000000000000301460806040525f5ffdfea26469706673582212203ee1cf4e70ed42e70db49ca1d8f71ffd8fde66973bbd68b3a1083aa370f1158f64736f6c634300081b0033",
-}
-
-// BytesLibABI is the input ABI used to generate the binding from.
-// Deprecated: Use BytesLibMetaData.ABI instead.
-var BytesLibABI = BytesLibMetaData.ABI
-
-// BytesLibBin is the compiled bytecode used for deploying new contracts.
-// Deprecated: Use BytesLibMetaData.Bin instead.
-var BytesLibBin = BytesLibMetaData.Bin
-
-// DeployBytesLib deploys a new Ethereum contract, binding an instance of BytesLib to it.
-func DeployBytesLib(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *BytesLib, error) {
-	parsed, err := BytesLibMetaData.GetAbi()
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	if parsed == nil {
-		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
-	}
-
-	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(BytesLibBin), backend)
-	if err != nil {
-		return common.Address{}, nil, nil, err
-	}
-	return address, tx, &BytesLib{BytesLibCaller: BytesLibCaller{contract: contract}, BytesLibTransactor: BytesLibTransactor{contract: contract}, BytesLibFilterer: BytesLibFilterer{contract: contract}}, nil
-}
-
-// BytesLib is an auto generated Go binding around an Ethereum contract.
-type BytesLib struct {
-	BytesLibCaller     // Read-only binding to the contract
-	BytesLibTransactor // Write-only binding to the contract
-	BytesLibFilterer   // Log filterer for contract events
-}
-
-// BytesLibCaller is an auto generated read-only Go binding around an Ethereum contract.
-type BytesLibCaller struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// BytesLibTransactor is an auto generated write-only Go binding around an Ethereum contract.
-type BytesLibTransactor struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// BytesLibFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
-type BytesLibFilterer struct {
-	contract *bind.BoundContract // Generic contract wrapper for the low level calls
-}
-
-// BytesLibSession is an auto generated Go binding around an Ethereum contract,
-// with pre-set call and transact options.
-type BytesLibSession struct {
-	Contract     *BytesLib         // Generic contract binding to set the session for
-	CallOpts     bind.CallOpts     // Call options to use throughout this session
-	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
-}
-
-// BytesLibCallerSession is an auto generated read-only Go binding around an Ethereum contract,
-// with pre-set call options.
-type BytesLibCallerSession struct {
-	Contract *BytesLibCaller // Generic contract caller binding to set the session for
-	CallOpts bind.CallOpts   // Call options to use throughout this session
-}
-
-// BytesLibTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
-// with pre-set transact options.
-type BytesLibTransactorSession struct {
-	Contract     *BytesLibTransactor // Generic contract transactor binding to set the session for
-	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
-}
-
-// BytesLibRaw is an auto generated low-level Go binding around an Ethereum contract.
-type BytesLibRaw struct {
-	Contract *BytesLib // Generic contract binding to access the raw methods on
-}
-
-// BytesLibCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
-type BytesLibCallerRaw struct {
-	Contract *BytesLibCaller // Generic read-only contract binding to access the raw methods on
-}
-
-// BytesLibTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
-type BytesLibTransactorRaw struct {
-	Contract *BytesLibTransactor // Generic write-only contract binding to access the raw methods on
-}
-
-// NewBytesLib creates a new instance of BytesLib, bound to a specific deployed contract.
-func NewBytesLib(address common.Address, backend bind.ContractBackend) (*BytesLib, error) {
-	contract, err := bindBytesLib(address, backend, backend, backend)
-	if err != nil {
-		return nil, err
-	}
-	return &BytesLib{BytesLibCaller: BytesLibCaller{contract: contract}, BytesLibTransactor: BytesLibTransactor{contract: contract}, BytesLibFilterer: BytesLibFilterer{contract: contract}}, nil
-}
-
-// NewBytesLibCaller creates a new read-only instance of BytesLib, bound to a specific deployed contract.
-func NewBytesLibCaller(address common.Address, caller bind.ContractCaller) (*BytesLibCaller, error) {
-	contract, err := bindBytesLib(address, caller, nil, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &BytesLibCaller{contract: contract}, nil
-}
-
-// NewBytesLibTransactor creates a new write-only instance of BytesLib, bound to a specific deployed contract.
-func NewBytesLibTransactor(address common.Address, transactor bind.ContractTransactor) (*BytesLibTransactor, error) {
-	contract, err := bindBytesLib(address, nil, transactor, nil)
-	if err != nil {
-		return nil, err
-	}
-	return &BytesLibTransactor{contract: contract}, nil
-}
-
-// NewBytesLibFilterer creates a new log filterer instance of BytesLib, bound to a specific deployed contract.
-func NewBytesLibFilterer(address common.Address, filterer bind.ContractFilterer) (*BytesLibFilterer, error) {
-	contract, err := bindBytesLib(address, nil, nil, filterer)
-	if err != nil {
-		return nil, err
-	}
-	return &BytesLibFilterer{contract: contract}, nil
-}
-
-// bindBytesLib binds a generic wrapper to an already deployed contract.
-func bindBytesLib(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
-	parsed, err := BytesLibMetaData.GetAbi()
-	if err != nil {
-		return nil, err
-	}
-	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
-}
-
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_BytesLib *BytesLibRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _BytesLib.Contract.BytesLibCaller.contract.Call(opts, result, method, params...)
-}
-
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_BytesLib *BytesLibRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _BytesLib.Contract.BytesLibTransactor.contract.Transfer(opts)
-}
-
-// Transact invokes the (paid) contract method with params as input values.
-func (_BytesLib *BytesLibRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _BytesLib.Contract.BytesLibTransactor.contract.Transact(opts, method, params...)
-}
-
-// Call invokes the (constant) contract method with params as input values and
-// sets the output to result. The result type might be a single field for simple
-// returns, a slice of interfaces for anonymous returns and a struct for named
-// returns.
-func (_BytesLib *BytesLibCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
-	return _BytesLib.Contract.contract.Call(opts, result, method, params...)
-}
-
-// Transfer initiates a plain transaction to move funds to the contract, calling
-// its default method if one is available.
-func (_BytesLib *BytesLibTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
-	return _BytesLib.Contract.contract.Transfer(opts)
-}
-
-// Transact invokes the (paid) contract method with params as input values.
-func (_BytesLib *BytesLibTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
-	return _BytesLib.Contract.contract.Transact(opts, method, params...)
-}
@@ -0,0 +1,178 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package CrossChainRegistry
+
+// ErrArrayLengthMismatch is the typed form of the CrossChainRegistry custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "CrossChainRegistry: ArrayLengthMismatch()"
+}
+
+// ErrChainIDAlreadyWhitelisted is the typed form of the CrossChainRegistry custom error ChainIDAlreadyWhitelisted().
+type ErrChainIDAlreadyWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrChainIDAlreadyWhitelisted) Error() string {
+	return "CrossChainRegistry: ChainIDAlreadyWhitelisted()"
+}
+
+// ErrChainIDNotWhitelisted is the typed form of the CrossChainRegistry custom error ChainIDNotWhitelisted().
+type ErrChainIDNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrChainIDNotWhitelisted) Error() string {
+	return "CrossChainRegistry: ChainIDNotWhitelisted()"
+}
+
+// ErrCurrentlyPaused is the typed form of the CrossChainRegistry custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "CrossChainRegistry: CurrentlyPaused()"
+}
+
+// ErrEmptyChainIDsArray is the typed form of the CrossChainRegistry custom error EmptyChainIDsArray().
+type ErrEmptyChainIDsArray struct{}
+
+// Error implements the error interface.
+func (ErrEmptyChainIDsArray) Error() string {
+	return "CrossChainRegistry: EmptyChainIDsArray()"
+}
+
+// ErrGenerationReservationAlreadyExists is the typed form of the CrossChainRegistry custom error GenerationReservationAlreadyExists().
+type ErrGenerationReservationAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrGenerationReservationAlreadyExists) Error() string {
+	return "CrossChainRegistry: GenerationReservationAlreadyExists()"
+}
+
+// ErrGenerationReservationDoesNotExist is the typed form of the CrossChainRegistry custom error GenerationReservationDoesNotExist().
+type ErrGenerationReservationDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrGenerationReservationDoesNotExist) Error() string {
+	return "CrossChainRegistry: GenerationReservationDoesNotExist()"
+}
+
+// ErrInputAddressZero is the typed form of the CrossChainRegistry custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "CrossChainRegistry: InputAddressZero()"
+}
+
+// ErrInvalidChainId is the typed form of the CrossChainRegistry custom error InvalidChainId().
+type ErrInvalidChainId struct{}
+
+// Error implements the error interface.
+func (ErrInvalidChainId) Error() string {
+	return "CrossChainRegistry: InvalidChainId()"
+}
+
+// ErrInvalidEndIndex is the typed form of the CrossChainRegistry custom error InvalidEndIndex().
+type ErrInvalidEndIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEndIndex) Error() string {
+	return "CrossChainRegistry: InvalidEndIndex()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the CrossChainRegistry custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "CrossChainRegistry: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the CrossChainRegistry custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "CrossChainRegistry: InvalidOperatorSet()"
+}
+
+// ErrInvalidPermissions is the typed form of the CrossChainRegistry custom error InvalidPermissions().
+type ErrInvalidPermissions struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPermissions) Error() string {
+	return "CrossChainRegistry: InvalidPermissions()"
+}
+
+// ErrInvalidRange is the typed form of the CrossChainRegistry custom error InvalidRange().
+type ErrInvalidRange struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRange) Error() string {
+	return "CrossChainRegistry: InvalidRange()"
+}
+
+// ErrInvalidStalenessPeriod is the typed form of the CrossChainRegistry custom error InvalidStalenessPeriod().
+type ErrInvalidStalenessPeriod struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStalenessPeriod) Error() string {
+	return "CrossChainRegistry: InvalidStalenessPeriod()"
+}
+
+// ErrInvalidTableUpdateCadence is the typed form of the CrossChainRegistry custom error InvalidTableUpdateCadence().
+type ErrInvalidTableUpdateCadence struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTableUpdateCadence) Error() string {
+	return "CrossChainRegistry: InvalidTableUpdateCadence()"
+}
+
+// ErrKeyTypeNotSet is the typed form of the CrossChainRegistry custom error KeyTypeNotSet().
+type ErrKeyTypeNotSet struct{}
+
+// Error implements the error interface.
+func (ErrKeyTypeNotSet) Error() string {
+	return "CrossChainRegistry: KeyTypeNotSet()"
+}
+
+// ErrOnlyPauser is the typed form of the CrossChainRegistry custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "CrossChainRegistry: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the CrossChainRegistry custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "CrossChainRegistry: OnlyUnpauser()"
+}
+
+// CrossChainRegistryErrors maps the name of every custom error in the CrossChainRegistry ABI to its typed Go error.
+var CrossChainRegistryErrors = map[string]error{
+	"ArrayLengthMismatch":                ErrArrayLengthMismatch{},
+	"ChainIDAlreadyWhitelisted":          ErrChainIDAlreadyWhitelisted{},
+	"ChainIDNotWhitelisted":              ErrChainIDNotWhitelisted{},
+	"CurrentlyPaused":                    ErrCurrentlyPaused{},
+	"EmptyChainIDsArray":                 ErrEmptyChainIDsArray{},
+	"GenerationReservationAlreadyExists": ErrGenerationReservationAlreadyExists{},
+	"GenerationReservationDoesNotExist":  ErrGenerationReservationDoesNotExist{},
+	"InputAddressZero":                   ErrInputAddressZero{},
+	"InvalidChainId":                     ErrInvalidChainId{},
+	"InvalidEndIndex":                    ErrInvalidEndIndex{},
+	"InvalidNewPausedStatus":             ErrInvalidNewPausedStatus{},
+	"InvalidOperatorSet":                 ErrInvalidOperatorSet{},
+	"InvalidPermissions":                 ErrInvalidPermissions{},
+	"InvalidRange":                       ErrInvalidRange{},
+	"InvalidStalenessPeriod":             ErrInvalidStalenessPeriod{},
+	"InvalidTableUpdateCadence":          ErrInvalidTableUpdateCadence{},
+	"KeyTypeNotSet":                      ErrKeyTypeNotSet{},
+	"OnlyPauser":                         ErrOnlyPauser{},
+	"OnlyUnpauser":                       ErrOnlyUnpauser{},
+}
@@ -0,0 +1,496 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package CrossChainRegistry
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeCrossChainRegistry is an in-memory CrossChainRegistry for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded CrossChainRegistryFilterer.
+type FakeCrossChainRegistry struct {
+	*fake.Contract
+	*CrossChainRegistryFilterer
+}
+
+// NewFakeCrossChainRegistry returns an empty fake of the contract at address.
+func NewFakeCrossChainRegistry(address common.Address) *FakeCrossChainRegistry {
+	contract := fake.NewContract(CrossChainRegistryMetaData, address)
+	filterer, err := NewCrossChainRegistryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeCrossChainRegistry{Contract: contract, CrossChainRegistryFilterer: filterer}
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistry.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubAllocationManager(out0 common.Address) {
+	_FakeCrossChainRegistry.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// CalculateOperatorTableBytes returns the values stubbed with StubCalculateOperatorTableBytes.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) CalculateOperatorTableBytes(opts *bind.CallOpts, operatorSet OperatorSet) ([]byte, error) {
+	var out0 []byte
+	err := _FakeCrossChainRegistry.Contract.Read("calculateOperatorTableBytes", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorTableBytes sets what CalculateOperatorTableBytes returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubCalculateOperatorTableBytes(operatorSet OperatorSet, out0 []byte) {
+	_FakeCrossChainRegistry.Contract.Stub("calculateOperatorTableBytes", []interface{}{operatorSet}, out0)
+}
+
+// GetActiveGenerationReservationCount returns the values stubbed with StubGetActiveGenerationReservationCount.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) GetActiveGenerationReservationCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeCrossChainRegistry.Contract.Read("getActiveGenerationReservationCount", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservationCount sets what GetActiveGenerationReservationCount returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubGetActiveGenerationReservationCount(out0 *big.Int) {
+	_FakeCrossChainRegistry.Contract.Stub("getActiveGenerationReservationCount", []interface{}{}, out0)
+}
+
+// GetActiveGenerationReservations returns the values stubbed with StubGetActiveGenerationReservations.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) GetActiveGenerationReservations(opts *bind.CallOpts) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeCrossChainRegistry.Contract.Read("getActiveGenerationReservations", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservations sets what GetActiveGenerationReservations returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubGetActiveGenerationReservations(out0 []OperatorSet) {
+	_FakeCrossChainRegistry.Contract.Stub("getActiveGenerationReservations", []interface{}{}, out0)
+}
+
+// GetActiveGenerationReservationsByRange returns the values stubbed with StubGetActiveGenerationReservationsByRange.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) GetActiveGenerationReservationsByRange(opts *bind.CallOpts, startIndex *big.Int, endIndex *big.Int) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeCrossChainRegistry.Contract.Read("getActiveGenerationReservationsByRange", []interface{}{startIndex, endIndex}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservationsByRange sets what GetActiveGenerationReservationsByRange returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubGetActiveGenerationReservationsByRange(startIndex *big.Int, endIndex *big.Int, out0 []OperatorSet) {
+	_FakeCrossChainRegistry.Contract.Stub("getActiveGenerationReservationsByRange", []interface{}{startIndex, endIndex}, out0)
+}
+
+// GetOperatorSetConfig returns the values stubbed with StubGetOperatorSetConfig.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) GetOperatorSetConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ICrossChainRegistryTypesOperatorSetConfig, error) {
+	var out0 ICrossChainRegistryTypesOperatorSetConfig
+	err := _FakeCrossChainRegistry.Contract.Read("getOperatorSetConfig", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetConfig sets what GetOperatorSetConfig returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubGetOperatorSetConfig(operatorSet OperatorSet, out0 ICrossChainRegistryTypesOperatorSetConfig) {
+	_FakeCrossChainRegistry.Contract.Stub("getOperatorSetConfig", []interface{}{operatorSet}, out0)
+}
+
+// GetOperatorTableCalculator returns the values stubbed with StubGetOperatorTableCalculator.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) GetOperatorTableCalculator(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistry.Contract.Read("getOperatorTableCalculator", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorTableCalculator sets what GetOperatorTableCalculator returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubGetOperatorTableCalculator(operatorSet OperatorSet, out0 common.Address) {
+	_FakeCrossChainRegistry.Contract.Stub("getOperatorTableCalculator", []interface{}{operatorSet}, out0)
+}
+
+// GetSupportedChains returns the values stubbed with StubGetSupportedChains.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) GetSupportedChains(opts *bind.CallOpts) ([]*big.Int, []common.Address, error) {
+	var out0 []*big.Int
+	var out1 []common.Address
+	err := _FakeCrossChainRegistry.Contract.Read("getSupportedChains", []interface{}{}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetSupportedChains sets what GetSupportedChains returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubGetSupportedChains(out0 []*big.Int, out1 []common.Address) {
+	_FakeCrossChainRegistry.Contract.Stub("getSupportedChains", []interface{}{}, out0, out1)
+}
+
+// GetTableUpdateCadence returns the values stubbed with StubGetTableUpdateCadence.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) GetTableUpdateCadence(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeCrossChainRegistry.Contract.Read("getTableUpdateCadence", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetTableUpdateCadence sets what GetTableUpdateCadence returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubGetTableUpdateCadence(out0 uint32) {
+	_FakeCrossChainRegistry.Contract.Stub("getTableUpdateCadence", []interface{}{}, out0)
+}
+
+// HasActiveGenerationReservation returns the values stubbed with StubHasActiveGenerationReservation.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) HasActiveGenerationReservation(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeCrossChainRegistry.Contract.Read("hasActiveGenerationReservation", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubHasActiveGenerationReservation sets what HasActiveGenerationReservation returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubHasActiveGenerationReservation(operatorSet OperatorSet, out0 bool) {
+	_FakeCrossChainRegistry.Contract.Stub("hasActiveGenerationReservation", []interface{}{operatorSet}, out0)
+}
+
+// KeyRegistrar returns the values stubbed with StubKeyRegistrar.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) KeyRegistrar(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistry.Contract.Read("keyRegistrar", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubKeyRegistrar sets what KeyRegistrar returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubKeyRegistrar(out0 common.Address) {
+	_FakeCrossChainRegistry.Contract.Stub("keyRegistrar", []interface{}{}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistry.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubOwner(out0 common.Address) {
+	_FakeCrossChainRegistry.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeCrossChainRegistry.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubPaused(index uint8, out0 bool) {
+	_FakeCrossChainRegistry.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeCrossChainRegistry.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubPaused0(out0 *big.Int) {
+	_FakeCrossChainRegistry.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistry.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubPauserRegistry(out0 common.Address) {
+	_FakeCrossChainRegistry.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// PermissionController returns the values stubbed with StubPermissionController.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) PermissionController(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistry.Contract.Read("permissionController", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPermissionController sets what PermissionController returns for the given arguments.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) StubPermissionController(out0 common.Address) {
+	_FakeCrossChainRegistry.Contract.Stub("permissionController", []interface{}{}, out0)
+}
+
+// AddChainIDsToWhitelist records a addChainIDsToWhitelist call after running the hook set with OnAddChainIDsToWhitelist.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) AddChainIDsToWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "addChainIDsToWhitelist", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("addChainIDsToWhitelist").(func(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) error); ok {
+			return hook(opts, chainIDs, operatorTableUpdaters)
+		}
+		return nil
+	}, chainIDs, operatorTableUpdaters)
+}
+
+// OnAddChainIDsToWhitelist sets a hook run by every AddChainIDsToWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnAddChainIDsToWhitelist(hook func(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("addChainIDsToWhitelist", hook)
+}
+
+// CreateGenerationReservation records a createGenerationReservation call after running the hook set with OnCreateGenerationReservation.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) CreateGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "createGenerationReservation", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("createGenerationReservation").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, operatorTableCalculator, config)
+		}
+		return nil
+	}, operatorSet, operatorTableCalculator, config)
+}
+
+// OnCreateGenerationReservation sets a hook run by every CreateGenerationReservation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnCreateGenerationReservation(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("createGenerationReservation", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialTableUpdateCadence uint32, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialTableUpdateCadence uint32, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialOwner, initialTableUpdateCadence, initialPausedStatus)
+		}
+		return nil
+	}, initialOwner, initialTableUpdateCadence, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialTableUpdateCadence uint32, initialPausedStatus *big.Int) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("initialize", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("pauseAll", hook)
+}
+
+// RemoveChainIDsFromWhitelist records a removeChainIDsFromWhitelist call after running the hook set with OnRemoveChainIDsFromWhitelist.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) RemoveChainIDsFromWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "removeChainIDsFromWhitelist", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("removeChainIDsFromWhitelist").(func(opts *bind.TransactOpts, chainIDs []*big.Int) error); ok {
+			return hook(opts, chainIDs)
+		}
+		return nil
+	}, chainIDs)
+}
+
+// OnRemoveChainIDsFromWhitelist sets a hook run by every RemoveChainIDsFromWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnRemoveChainIDsFromWhitelist(hook func(opts *bind.TransactOpts, chainIDs []*big.Int) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("removeChainIDsFromWhitelist", hook)
+}
+
+// RemoveGenerationReservation records a removeGenerationReservation call after running the hook set with OnRemoveGenerationReservation.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) RemoveGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "removeGenerationReservation", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("removeGenerationReservation").(func(opts *bind.TransactOpts, operatorSet OperatorSet) error); ok {
+			return hook(opts, operatorSet)
+		}
+		return nil
+	}, operatorSet)
+}
+
+// OnRemoveGenerationReservation sets a hook run by every RemoveGenerationReservation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnRemoveGenerationReservation(hook func(opts *bind.TransactOpts, operatorSet OperatorSet) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("removeGenerationReservation", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("renounceOwnership", hook)
+}
+
+// SetOperatorSetConfig records a setOperatorSetConfig call after running the hook set with OnSetOperatorSetConfig.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) SetOperatorSetConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "setOperatorSetConfig", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("setOperatorSetConfig").(func(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, config)
+		}
+		return nil
+	}, operatorSet, config)
+}
+
+// OnSetOperatorSetConfig sets a hook run by every SetOperatorSetConfig call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnSetOperatorSetConfig(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("setOperatorSetConfig", hook)
+}
+
+// SetOperatorTableCalculator records a setOperatorTableCalculator call after running the hook set with OnSetOperatorTableCalculator.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) SetOperatorTableCalculator(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "setOperatorTableCalculator", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("setOperatorTableCalculator").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) error); ok {
+			return hook(opts, operatorSet, operatorTableCalculator)
+		}
+		return nil
+	}, operatorSet, operatorTableCalculator)
+}
+
+// OnSetOperatorTableCalculator sets a hook run by every SetOperatorTableCalculator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnSetOperatorTableCalculator(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("setOperatorTableCalculator", hook)
+}
+
+// SetTableUpdateCadence records a setTableUpdateCadence call after running the hook set with OnSetTableUpdateCadence.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) SetTableUpdateCadence(opts *bind.TransactOpts, tableUpdateCadence uint32) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "setTableUpdateCadence", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("setTableUpdateCadence").(func(opts *bind.TransactOpts, tableUpdateCadence uint32) error); ok {
+			return hook(opts, tableUpdateCadence)
+		}
+		return nil
+	}, tableUpdateCadence)
+}
+
+// OnSetTableUpdateCadence sets a hook run by every SetTableUpdateCadence call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnSetTableUpdateCadence(hook func(opts *bind.TransactOpts, tableUpdateCadence uint32) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("setTableUpdateCadence", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("transferOwnership", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeCrossChainRegistry.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeCrossChainRegistry.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeCrossChainRegistry.Contract.SetHook("unpause", hook)
+}
+
+// EmitChainIDAddedToWhitelist appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitChainIDAddedToWhitelist(event *CrossChainRegistryChainIDAddedToWhitelist) {
+	_FakeCrossChainRegistry.Contract.Emit("ChainIDAddedToWhitelist", event)
+}
+
+// EmitChainIDRemovedFromWhitelist appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitChainIDRemovedFromWhitelist(event *CrossChainRegistryChainIDRemovedFromWhitelist) {
+	_FakeCrossChainRegistry.Contract.Emit("ChainIDRemovedFromWhitelist", event)
+}
+
+// EmitGenerationReservationCreated appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitGenerationReservationCreated(event *CrossChainRegistryGenerationReservationCreated) {
+	_FakeCrossChainRegistry.Contract.Emit("GenerationReservationCreated", event)
+}
+
+// EmitGenerationReservationRemoved appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitGenerationReservationRemoved(event *CrossChainRegistryGenerationReservationRemoved) {
+	_FakeCrossChainRegistry.Contract.Emit("GenerationReservationRemoved", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitInitialized(event *CrossChainRegistryInitialized) {
+	_FakeCrossChainRegistry.Contract.Emit("Initialized", event)
+}
+
+// EmitOperatorSetConfigRemoved appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitOperatorSetConfigRemoved(event *CrossChainRegistryOperatorSetConfigRemoved) {
+	_FakeCrossChainRegistry.Contract.Emit("OperatorSetConfigRemoved", event)
+}
+
+// EmitOperatorSetConfigSet appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitOperatorSetConfigSet(event *CrossChainRegistryOperatorSetConfigSet) {
+	_FakeCrossChainRegistry.Contract.Emit("OperatorSetConfigSet", event)
+}
+
+// EmitOperatorTableCalculatorRemoved appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitOperatorTableCalculatorRemoved(event *CrossChainRegistryOperatorTableCalculatorRemoved) {
+	_FakeCrossChainRegistry.Contract.Emit("OperatorTableCalculatorRemoved", event)
+}
+
+// EmitOperatorTableCalculatorSet appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitOperatorTableCalculatorSet(event *CrossChainRegistryOperatorTableCalculatorSet) {
+	_FakeCrossChainRegistry.Contract.Emit("OperatorTableCalculatorSet", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitOwnershipTransferred(event *CrossChainRegistryOwnershipTransferred) {
+	_FakeCrossChainRegistry.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitPaused(event *CrossChainRegistryPaused) {
+	_FakeCrossChainRegistry.Contract.Emit("Paused", event)
+}
+
+// EmitTableUpdateCadenceSet appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitTableUpdateCadenceSet(event *CrossChainRegistryTableUpdateCadenceSet) {
+	_FakeCrossChainRegistry.Contract.Emit("TableUpdateCadenceSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeCrossChainRegistry *FakeCrossChainRegistry) EmitUnpaused(event *CrossChainRegistryUnpaused) {
+	_FakeCrossChainRegistry.Contract.Emit("Unpaused", event)
+}
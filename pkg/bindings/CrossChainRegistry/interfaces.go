@@ -0,0 +1,106 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package CrossChainRegistry
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// CrossChainRegistryReader is the read-only surface of the CrossChainRegistry
+// binding, implemented by CrossChainRegistryCaller, CrossChainRegistry and FakeCrossChainRegistry.
+type CrossChainRegistryReader interface {
+	AllocationManager(opts *bind.CallOpts) (common.Address, error)
+	CalculateOperatorTableBytes(opts *bind.CallOpts, operatorSet OperatorSet) ([]byte, error)
+	GetActiveGenerationReservationCount(opts *bind.CallOpts) (*big.Int, error)
+	GetActiveGenerationReservations(opts *bind.CallOpts) ([]OperatorSet, error)
+	GetActiveGenerationReservationsByRange(opts *bind.CallOpts, startIndex *big.Int, endIndex *big.Int) ([]OperatorSet, error)
+	GetOperatorSetConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ICrossChainRegistryTypesOperatorSetConfig, error)
+	GetOperatorTableCalculator(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetSupportedChains(opts *bind.CallOpts) ([]*big.Int, []common.Address, error)
+	GetTableUpdateCadence(opts *bind.CallOpts) (uint32, error)
+	HasActiveGenerationReservation(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)
+	KeyRegistrar(opts *bind.CallOpts) (common.Address, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PermissionController(opts *bind.CallOpts) (common.Address, error)
+}
+
+// CrossChainRegistryWriter is the transacting surface of the CrossChainRegistry
+// binding, implemented by CrossChainRegistryTransactor, CrossChainRegistry and FakeCrossChainRegistry.
+type CrossChainRegistryWriter interface {
+	AddChainIDsToWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) (*types.Transaction, error)
+	CreateGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialTableUpdateCadence uint32, initialPausedStatus *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RemoveChainIDsFromWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int) (*types.Transaction, error)
+	RemoveGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetOperatorSetConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	SetOperatorTableCalculator(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) (*types.Transaction, error)
+	SetTableUpdateCadence(opts *bind.TransactOpts, tableUpdateCadence uint32) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+}
+
+// CrossChainRegistryEvents is the event filtering surface of the CrossChainRegistry
+// binding, implemented by CrossChainRegistryFilterer, CrossChainRegistry and FakeCrossChainRegistry.
+type CrossChainRegistryEvents interface {
+	FilterChainIDAddedToWhitelist(opts *bind.FilterOpts) (*CrossChainRegistryChainIDAddedToWhitelistIterator, error)
+	WatchChainIDAddedToWhitelist(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryChainIDAddedToWhitelist) (event.Subscription, error)
+	ParseChainIDAddedToWhitelist(log types.Log) (*CrossChainRegistryChainIDAddedToWhitelist, error)
+	FilterChainIDRemovedFromWhitelist(opts *bind.FilterOpts) (*CrossChainRegistryChainIDRemovedFromWhitelistIterator, error)
+	WatchChainIDRemovedFromWhitelist(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryChainIDRemovedFromWhitelist) (event.Subscription, error)
+	ParseChainIDRemovedFromWhitelist(log types.Log) (*CrossChainRegistryChainIDRemovedFromWhitelist, error)
+	FilterGenerationReservationCreated(opts *bind.FilterOpts) (*CrossChainRegistryGenerationReservationCreatedIterator, error)
+	WatchGenerationReservationCreated(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryGenerationReservationCreated) (event.Subscription, error)
+	ParseGenerationReservationCreated(log types.Log) (*CrossChainRegistryGenerationReservationCreated, error)
+	FilterGenerationReservationRemoved(opts *bind.FilterOpts) (*CrossChainRegistryGenerationReservationRemovedIterator, error)
+	WatchGenerationReservationRemoved(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryGenerationReservationRemoved) (event.Subscription, error)
+	ParseGenerationReservationRemoved(log types.Log) (*CrossChainRegistryGenerationReservationRemoved, error)
+	FilterInitialized(opts *bind.FilterOpts) (*CrossChainRegistryInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*CrossChainRegistryInitialized, error)
+	FilterOperatorSetConfigRemoved(opts *bind.FilterOpts) (*CrossChainRegistryOperatorSetConfigRemovedIterator, error)
+	WatchOperatorSetConfigRemoved(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryOperatorSetConfigRemoved) (event.Subscription, error)
+	ParseOperatorSetConfigRemoved(log types.Log) (*CrossChainRegistryOperatorSetConfigRemoved, error)
+	FilterOperatorSetConfigSet(opts *bind.FilterOpts) (*CrossChainRegistryOperatorSetConfigSetIterator, error)
+	WatchOperatorSetConfigSet(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryOperatorSetConfigSet) (event.Subscription, error)
+	ParseOperatorSetConfigSet(log types.Log) (*CrossChainRegistryOperatorSetConfigSet, error)
+	FilterOperatorTableCalculatorRemoved(opts *bind.FilterOpts) (*CrossChainRegistryOperatorTableCalculatorRemovedIterator, error)
+	WatchOperatorTableCalculatorRemoved(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryOperatorTableCalculatorRemoved) (event.Subscription, error)
+	ParseOperatorTableCalculatorRemoved(log types.Log) (*CrossChainRegistryOperatorTableCalculatorRemoved, error)
+	FilterOperatorTableCalculatorSet(opts *bind.FilterOpts) (*CrossChainRegistryOperatorTableCalculatorSetIterator, error)
+	WatchOperatorTableCalculatorSet(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryOperatorTableCalculatorSet) (event.Subscription, error)
+	ParseOperatorTableCalculatorSet(log types.Log) (*CrossChainRegistryOperatorTableCalculatorSet, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*CrossChainRegistryOwnershipTransferredIterator, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	ParseOwnershipTransferred(log types.Log) (*CrossChainRegistryOwnershipTransferred, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*CrossChainRegistryPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*CrossChainRegistryPaused, error)
+	FilterTableUpdateCadenceSet(opts *bind.FilterOpts) (*CrossChainRegistryTableUpdateCadenceSetIterator, error)
+	WatchTableUpdateCadenceSet(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryTableUpdateCadenceSet) (event.Subscription, error)
+	ParseTableUpdateCadenceSet(log types.Log) (*CrossChainRegistryTableUpdateCadenceSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*CrossChainRegistryUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*CrossChainRegistryUnpaused, error)
+}
+
+var (
+	_ CrossChainRegistryReader = (*CrossChainRegistryCaller)(nil)
+	_ CrossChainRegistryReader = (*CrossChainRegistry)(nil)
+	_ CrossChainRegistryReader = (*FakeCrossChainRegistry)(nil)
+	_ CrossChainRegistryWriter = (*CrossChainRegistryTransactor)(nil)
+	_ CrossChainRegistryWriter = (*CrossChainRegistry)(nil)
+	_ CrossChainRegistryWriter = (*FakeCrossChainRegistry)(nil)
+	_ CrossChainRegistryEvents = (*CrossChainRegistryFilterer)(nil)
+	_ CrossChainRegistryEvents = (*CrossChainRegistry)(nil)
+	_ CrossChainRegistryEvents = (*FakeCrossChainRegistry)(nil)
+)
@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package CrossChainRegistryStorage
+
+// ErrArrayLengthMismatch is the typed form of the CrossChainRegistryStorage custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "CrossChainRegistryStorage: ArrayLengthMismatch()"
+}
+
+// ErrChainIDAlreadyWhitelisted is the typed form of the CrossChainRegistryStorage custom error ChainIDAlreadyWhitelisted().
+type ErrChainIDAlreadyWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrChainIDAlreadyWhitelisted) Error() string {
+	return "CrossChainRegistryStorage: ChainIDAlreadyWhitelisted()"
+}
+
+// ErrChainIDNotWhitelisted is the typed form of the CrossChainRegistryStorage custom error ChainIDNotWhitelisted().
+type ErrChainIDNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrChainIDNotWhitelisted) Error() string {
+	return "CrossChainRegistryStorage: ChainIDNotWhitelisted()"
+}
+
+// ErrEmptyChainIDsArray is the typed form of the CrossChainRegistryStorage custom error EmptyChainIDsArray().
+type ErrEmptyChainIDsArray struct{}
+
+// Error implements the error interface.
+func (ErrEmptyChainIDsArray) Error() string {
+	return "CrossChainRegistryStorage: EmptyChainIDsArray()"
+}
+
+// ErrGenerationReservationAlreadyExists is the typed form of the CrossChainRegistryStorage custom error GenerationReservationAlreadyExists().
+type ErrGenerationReservationAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrGenerationReservationAlreadyExists) Error() string {
+	return "CrossChainRegistryStorage: GenerationReservationAlreadyExists()"
+}
+
+// ErrGenerationReservationDoesNotExist is the typed form of the CrossChainRegistryStorage custom error GenerationReservationDoesNotExist().
+type ErrGenerationReservationDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrGenerationReservationDoesNotExist) Error() string {
+	return "CrossChainRegistryStorage: GenerationReservationDoesNotExist()"
+}
+
+// ErrInvalidChainId is the typed form of the CrossChainRegistryStorage custom error InvalidChainId().
+type ErrInvalidChainId struct{}
+
+// Error implements the error interface.
+func (ErrInvalidChainId) Error() string {
+	return "CrossChainRegistryStorage: InvalidChainId()"
+}
+
+// ErrInvalidEndIndex is the typed form of the CrossChainRegistryStorage custom error InvalidEndIndex().
+type ErrInvalidEndIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEndIndex) Error() string {
+	return "CrossChainRegistryStorage: InvalidEndIndex()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the CrossChainRegistryStorage custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "CrossChainRegistryStorage: InvalidOperatorSet()"
+}
+
+// ErrInvalidRange is the typed form of the CrossChainRegistryStorage custom error InvalidRange().
+type ErrInvalidRange struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRange) Error() string {
+	return "CrossChainRegistryStorage: InvalidRange()"
+}
+
+// ErrInvalidStalenessPeriod is the typed form of the CrossChainRegistryStorage custom error InvalidStalenessPeriod().
+type ErrInvalidStalenessPeriod struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStalenessPeriod) Error() string {
+	return "CrossChainRegistryStorage: InvalidStalenessPeriod()"
+}
+
+// ErrInvalidTableUpdateCadence is the typed form of the CrossChainRegistryStorage custom error InvalidTableUpdateCadence().
+type ErrInvalidTableUpdateCadence struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTableUpdateCadence) Error() string {
+	return "CrossChainRegistryStorage: InvalidTableUpdateCadence()"
+}
+
+// ErrKeyTypeNotSet is the typed form of the CrossChainRegistryStorage custom error KeyTypeNotSet().
+type ErrKeyTypeNotSet struct{}
+
+// Error implements the error interface.
+func (ErrKeyTypeNotSet) Error() string {
+	return "CrossChainRegistryStorage: KeyTypeNotSet()"
+}
+
+// CrossChainRegistryStorageErrors maps the name of every custom error in the CrossChainRegistryStorage ABI to its typed Go error.
+var CrossChainRegistryStorageErrors = map[string]error{
+	"ArrayLengthMismatch":                ErrArrayLengthMismatch{},
+	"ChainIDAlreadyWhitelisted":          ErrChainIDAlreadyWhitelisted{},
+	"ChainIDNotWhitelisted":              ErrChainIDNotWhitelisted{},
+	"EmptyChainIDsArray":                 ErrEmptyChainIDsArray{},
+	"GenerationReservationAlreadyExists": ErrGenerationReservationAlreadyExists{},
+	"GenerationReservationDoesNotExist":  ErrGenerationReservationDoesNotExist{},
+	"InvalidChainId":                     ErrInvalidChainId{},
+	"InvalidEndIndex":                    ErrInvalidEndIndex{},
+	"InvalidOperatorSet":                 ErrInvalidOperatorSet{},
+	"InvalidRange":                       ErrInvalidRange{},
+	"InvalidStalenessPeriod":             ErrInvalidStalenessPeriod{},
+	"InvalidTableUpdateCadence":          ErrInvalidTableUpdateCadence{},
+	"KeyTypeNotSet":                      ErrKeyTypeNotSet{},
+}
@@ -0,0 +1,320 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package CrossChainRegistryStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeCrossChainRegistryStorage is an in-memory CrossChainRegistryStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded CrossChainRegistryStorageFilterer.
+type FakeCrossChainRegistryStorage struct {
+	*fake.Contract
+	*CrossChainRegistryStorageFilterer
+}
+
+// NewFakeCrossChainRegistryStorage returns an empty fake of the contract at address.
+func NewFakeCrossChainRegistryStorage(address common.Address) *FakeCrossChainRegistryStorage {
+	contract := fake.NewContract(CrossChainRegistryStorageMetaData, address)
+	filterer, err := NewCrossChainRegistryStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeCrossChainRegistryStorage{Contract: contract, CrossChainRegistryStorageFilterer: filterer}
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistryStorage.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubAllocationManager(out0 common.Address) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// CalculateOperatorTableBytes returns the values stubbed with StubCalculateOperatorTableBytes.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) CalculateOperatorTableBytes(opts *bind.CallOpts, operatorSet OperatorSet) ([]byte, error) {
+	var out0 []byte
+	err := _FakeCrossChainRegistryStorage.Contract.Read("calculateOperatorTableBytes", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorTableBytes sets what CalculateOperatorTableBytes returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubCalculateOperatorTableBytes(operatorSet OperatorSet, out0 []byte) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("calculateOperatorTableBytes", []interface{}{operatorSet}, out0)
+}
+
+// GetActiveGenerationReservationCount returns the values stubbed with StubGetActiveGenerationReservationCount.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) GetActiveGenerationReservationCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeCrossChainRegistryStorage.Contract.Read("getActiveGenerationReservationCount", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservationCount sets what GetActiveGenerationReservationCount returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubGetActiveGenerationReservationCount(out0 *big.Int) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("getActiveGenerationReservationCount", []interface{}{}, out0)
+}
+
+// GetActiveGenerationReservations returns the values stubbed with StubGetActiveGenerationReservations.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) GetActiveGenerationReservations(opts *bind.CallOpts) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeCrossChainRegistryStorage.Contract.Read("getActiveGenerationReservations", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservations sets what GetActiveGenerationReservations returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubGetActiveGenerationReservations(out0 []OperatorSet) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("getActiveGenerationReservations", []interface{}{}, out0)
+}
+
+// GetActiveGenerationReservationsByRange returns the values stubbed with StubGetActiveGenerationReservationsByRange.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) GetActiveGenerationReservationsByRange(opts *bind.CallOpts, startIndex *big.Int, endIndex *big.Int) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeCrossChainRegistryStorage.Contract.Read("getActiveGenerationReservationsByRange", []interface{}{startIndex, endIndex}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservationsByRange sets what GetActiveGenerationReservationsByRange returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubGetActiveGenerationReservationsByRange(startIndex *big.Int, endIndex *big.Int, out0 []OperatorSet) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("getActiveGenerationReservationsByRange", []interface{}{startIndex, endIndex}, out0)
+}
+
+// GetOperatorSetConfig returns the values stubbed with StubGetOperatorSetConfig.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) GetOperatorSetConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ICrossChainRegistryTypesOperatorSetConfig, error) {
+	var out0 ICrossChainRegistryTypesOperatorSetConfig
+	err := _FakeCrossChainRegistryStorage.Contract.Read("getOperatorSetConfig", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetConfig sets what GetOperatorSetConfig returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubGetOperatorSetConfig(operatorSet OperatorSet, out0 ICrossChainRegistryTypesOperatorSetConfig) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("getOperatorSetConfig", []interface{}{operatorSet}, out0)
+}
+
+// GetOperatorTableCalculator returns the values stubbed with StubGetOperatorTableCalculator.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) GetOperatorTableCalculator(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistryStorage.Contract.Read("getOperatorTableCalculator", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorTableCalculator sets what GetOperatorTableCalculator returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubGetOperatorTableCalculator(operatorSet OperatorSet, out0 common.Address) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("getOperatorTableCalculator", []interface{}{operatorSet}, out0)
+}
+
+// GetSupportedChains returns the values stubbed with StubGetSupportedChains.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) GetSupportedChains(opts *bind.CallOpts) ([]*big.Int, []common.Address, error) {
+	var out0 []*big.Int
+	var out1 []common.Address
+	err := _FakeCrossChainRegistryStorage.Contract.Read("getSupportedChains", []interface{}{}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetSupportedChains sets what GetSupportedChains returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubGetSupportedChains(out0 []*big.Int, out1 []common.Address) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("getSupportedChains", []interface{}{}, out0, out1)
+}
+
+// GetTableUpdateCadence returns the values stubbed with StubGetTableUpdateCadence.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) GetTableUpdateCadence(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeCrossChainRegistryStorage.Contract.Read("getTableUpdateCadence", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetTableUpdateCadence sets what GetTableUpdateCadence returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubGetTableUpdateCadence(out0 uint32) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("getTableUpdateCadence", []interface{}{}, out0)
+}
+
+// HasActiveGenerationReservation returns the values stubbed with StubHasActiveGenerationReservation.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) HasActiveGenerationReservation(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeCrossChainRegistryStorage.Contract.Read("hasActiveGenerationReservation", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubHasActiveGenerationReservation sets what HasActiveGenerationReservation returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubHasActiveGenerationReservation(operatorSet OperatorSet, out0 bool) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("hasActiveGenerationReservation", []interface{}{operatorSet}, out0)
+}
+
+// KeyRegistrar returns the values stubbed with StubKeyRegistrar.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) KeyRegistrar(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeCrossChainRegistryStorage.Contract.Read("keyRegistrar", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubKeyRegistrar sets what KeyRegistrar returns for the given arguments.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) StubKeyRegistrar(out0 common.Address) {
+	_FakeCrossChainRegistryStorage.Contract.Stub("keyRegistrar", []interface{}{}, out0)
+}
+
+// AddChainIDsToWhitelist records a addChainIDsToWhitelist call after running the hook set with OnAddChainIDsToWhitelist.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) AddChainIDsToWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) (*types.Transaction, error) {
+	return _FakeCrossChainRegistryStorage.Contract.Transact(opts, "addChainIDsToWhitelist", func() error {
+		if hook, ok := _FakeCrossChainRegistryStorage.Contract.Hook("addChainIDsToWhitelist").(func(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) error); ok {
+			return hook(opts, chainIDs, operatorTableUpdaters)
+		}
+		return nil
+	}, chainIDs, operatorTableUpdaters)
+}
+
+// OnAddChainIDsToWhitelist sets a hook run by every AddChainIDsToWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) OnAddChainIDsToWhitelist(hook func(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) error) {
+	_FakeCrossChainRegistryStorage.Contract.SetHook("addChainIDsToWhitelist", hook)
+}
+
+// CreateGenerationReservation records a createGenerationReservation call after running the hook set with OnCreateGenerationReservation.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) CreateGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeCrossChainRegistryStorage.Contract.Transact(opts, "createGenerationReservation", func() error {
+		if hook, ok := _FakeCrossChainRegistryStorage.Contract.Hook("createGenerationReservation").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, operatorTableCalculator, config)
+		}
+		return nil
+	}, operatorSet, operatorTableCalculator, config)
+}
+
+// OnCreateGenerationReservation sets a hook run by every CreateGenerationReservation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) OnCreateGenerationReservation(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeCrossChainRegistryStorage.Contract.SetHook("createGenerationReservation", hook)
+}
+
+// RemoveChainIDsFromWhitelist records a removeChainIDsFromWhitelist call after running the hook set with OnRemoveChainIDsFromWhitelist.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) RemoveChainIDsFromWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int) (*types.Transaction, error) {
+	return _FakeCrossChainRegistryStorage.Contract.Transact(opts, "removeChainIDsFromWhitelist", func() error {
+		if hook, ok := _FakeCrossChainRegistryStorage.Contract.Hook("removeChainIDsFromWhitelist").(func(opts *bind.TransactOpts, chainIDs []*big.Int) error); ok {
+			return hook(opts, chainIDs)
+		}
+		return nil
+	}, chainIDs)
+}
+
+// OnRemoveChainIDsFromWhitelist sets a hook run by every RemoveChainIDsFromWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) OnRemoveChainIDsFromWhitelist(hook func(opts *bind.TransactOpts, chainIDs []*big.Int) error) {
+	_FakeCrossChainRegistryStorage.Contract.SetHook("removeChainIDsFromWhitelist", hook)
+}
+
+// RemoveGenerationReservation records a removeGenerationReservation call after running the hook set with OnRemoveGenerationReservation.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) RemoveGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet) (*types.Transaction, error) {
+	return _FakeCrossChainRegistryStorage.Contract.Transact(opts, "removeGenerationReservation", func() error {
+		if hook, ok := _FakeCrossChainRegistryStorage.Contract.Hook("removeGenerationReservation").(func(opts *bind.TransactOpts, operatorSet OperatorSet) error); ok {
+			return hook(opts, operatorSet)
+		}
+		return nil
+	}, operatorSet)
+}
+
+// OnRemoveGenerationReservation sets a hook run by every RemoveGenerationReservation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) OnRemoveGenerationReservation(hook func(opts *bind.TransactOpts, operatorSet OperatorSet) error) {
+	_FakeCrossChainRegistryStorage.Contract.SetHook("removeGenerationReservation", hook)
+}
+
+// SetOperatorSetConfig records a setOperatorSetConfig call after running the hook set with OnSetOperatorSetConfig.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) SetOperatorSetConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeCrossChainRegistryStorage.Contract.Transact(opts, "setOperatorSetConfig", func() error {
+		if hook, ok := _FakeCrossChainRegistryStorage.Contract.Hook("setOperatorSetConfig").(func(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, config)
+		}
+		return nil
+	}, operatorSet, config)
+}
+
+// OnSetOperatorSetConfig sets a hook run by every SetOperatorSetConfig call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) OnSetOperatorSetConfig(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeCrossChainRegistryStorage.Contract.SetHook("setOperatorSetConfig", hook)
+}
+
+// SetOperatorTableCalculator records a setOperatorTableCalculator call after running the hook set with OnSetOperatorTableCalculator.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) SetOperatorTableCalculator(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) (*types.Transaction, error) {
+	return _FakeCrossChainRegistryStorage.Contract.Transact(opts, "setOperatorTableCalculator", func() error {
+		if hook, ok := _FakeCrossChainRegistryStorage.Contract.Hook("setOperatorTableCalculator").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) error); ok {
+			return hook(opts, operatorSet, operatorTableCalculator)
+		}
+		return nil
+	}, operatorSet, operatorTableCalculator)
+}
+
+// OnSetOperatorTableCalculator sets a hook run by every SetOperatorTableCalculator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) OnSetOperatorTableCalculator(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) error) {
+	_FakeCrossChainRegistryStorage.Contract.SetHook("setOperatorTableCalculator", hook)
+}
+
+// SetTableUpdateCadence records a setTableUpdateCadence call after running the hook set with OnSetTableUpdateCadence.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) SetTableUpdateCadence(opts *bind.TransactOpts, tableUpdateCadence uint32) (*types.Transaction, error) {
+	return _FakeCrossChainRegistryStorage.Contract.Transact(opts, "setTableUpdateCadence", func() error {
+		if hook, ok := _FakeCrossChainRegistryStorage.Contract.Hook("setTableUpdateCadence").(func(opts *bind.TransactOpts, tableUpdateCadence uint32) error); ok {
+			return hook(opts, tableUpdateCadence)
+		}
+		return nil
+	}, tableUpdateCadence)
+}
+
+// OnSetTableUpdateCadence sets a hook run by every SetTableUpdateCadence call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) OnSetTableUpdateCadence(hook func(opts *bind.TransactOpts, tableUpdateCadence uint32) error) {
+	_FakeCrossChainRegistryStorage.Contract.SetHook("setTableUpdateCadence", hook)
+}
+
+// EmitChainIDAddedToWhitelist appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitChainIDAddedToWhitelist(event *CrossChainRegistryStorageChainIDAddedToWhitelist) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("ChainIDAddedToWhitelist", event)
+}
+
+// EmitChainIDRemovedFromWhitelist appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitChainIDRemovedFromWhitelist(event *CrossChainRegistryStorageChainIDRemovedFromWhitelist) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("ChainIDRemovedFromWhitelist", event)
+}
+
+// EmitGenerationReservationCreated appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitGenerationReservationCreated(event *CrossChainRegistryStorageGenerationReservationCreated) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("GenerationReservationCreated", event)
+}
+
+// EmitGenerationReservationRemoved appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitGenerationReservationRemoved(event *CrossChainRegistryStorageGenerationReservationRemoved) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("GenerationReservationRemoved", event)
+}
+
+// EmitOperatorSetConfigRemoved appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitOperatorSetConfigRemoved(event *CrossChainRegistryStorageOperatorSetConfigRemoved) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("OperatorSetConfigRemoved", event)
+}
+
+// EmitOperatorSetConfigSet appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitOperatorSetConfigSet(event *CrossChainRegistryStorageOperatorSetConfigSet) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("OperatorSetConfigSet", event)
+}
+
+// EmitOperatorTableCalculatorRemoved appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitOperatorTableCalculatorRemoved(event *CrossChainRegistryStorageOperatorTableCalculatorRemoved) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("OperatorTableCalculatorRemoved", event)
+}
+
+// EmitOperatorTableCalculatorSet appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitOperatorTableCalculatorSet(event *CrossChainRegistryStorageOperatorTableCalculatorSet) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("OperatorTableCalculatorSet", event)
+}
+
+// EmitTableUpdateCadenceSet appends the event to the fake's logs.
+func (_FakeCrossChainRegistryStorage *FakeCrossChainRegistryStorage) EmitTableUpdateCadenceSet(event *CrossChainRegistryStorageTableUpdateCadenceSet) {
+	_FakeCrossChainRegistryStorage.Contract.Emit("TableUpdateCadenceSet", event)
+}
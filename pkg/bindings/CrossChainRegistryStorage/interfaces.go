@@ -0,0 +1,83 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package CrossChainRegistryStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// CrossChainRegistryStorageReader is the read-only surface of the CrossChainRegistryStorage
+// binding, implemented by CrossChainRegistryStorageCaller, CrossChainRegistryStorage and FakeCrossChainRegistryStorage.
+type CrossChainRegistryStorageReader interface {
+	AllocationManager(opts *bind.CallOpts) (common.Address, error)
+	CalculateOperatorTableBytes(opts *bind.CallOpts, operatorSet OperatorSet) ([]byte, error)
+	GetActiveGenerationReservationCount(opts *bind.CallOpts) (*big.Int, error)
+	GetActiveGenerationReservations(opts *bind.CallOpts) ([]OperatorSet, error)
+	GetActiveGenerationReservationsByRange(opts *bind.CallOpts, startIndex *big.Int, endIndex *big.Int) ([]OperatorSet, error)
+	GetOperatorSetConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ICrossChainRegistryTypesOperatorSetConfig, error)
+	GetOperatorTableCalculator(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetSupportedChains(opts *bind.CallOpts) ([]*big.Int, []common.Address, error)
+	GetTableUpdateCadence(opts *bind.CallOpts) (uint32, error)
+	HasActiveGenerationReservation(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)
+	KeyRegistrar(opts *bind.CallOpts) (common.Address, error)
+}
+
+// CrossChainRegistryStorageWriter is the transacting surface of the CrossChainRegistryStorage
+// binding, implemented by CrossChainRegistryStorageTransactor, CrossChainRegistryStorage and FakeCrossChainRegistryStorage.
+type CrossChainRegistryStorageWriter interface {
+	AddChainIDsToWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) (*types.Transaction, error)
+	CreateGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	RemoveChainIDsFromWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int) (*types.Transaction, error)
+	RemoveGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet) (*types.Transaction, error)
+	SetOperatorSetConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	SetOperatorTableCalculator(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) (*types.Transaction, error)
+	SetTableUpdateCadence(opts *bind.TransactOpts, tableUpdateCadence uint32) (*types.Transaction, error)
+}
+
+// CrossChainRegistryStorageEvents is the event filtering surface of the CrossChainRegistryStorage
+// binding, implemented by CrossChainRegistryStorageFilterer, CrossChainRegistryStorage and FakeCrossChainRegistryStorage.
+type CrossChainRegistryStorageEvents interface {
+	FilterChainIDAddedToWhitelist(opts *bind.FilterOpts) (*CrossChainRegistryStorageChainIDAddedToWhitelistIterator, error)
+	WatchChainIDAddedToWhitelist(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageChainIDAddedToWhitelist) (event.Subscription, error)
+	ParseChainIDAddedToWhitelist(log types.Log) (*CrossChainRegistryStorageChainIDAddedToWhitelist, error)
+	FilterChainIDRemovedFromWhitelist(opts *bind.FilterOpts) (*CrossChainRegistryStorageChainIDRemovedFromWhitelistIterator, error)
+	WatchChainIDRemovedFromWhitelist(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageChainIDRemovedFromWhitelist) (event.Subscription, error)
+	ParseChainIDRemovedFromWhitelist(log types.Log) (*CrossChainRegistryStorageChainIDRemovedFromWhitelist, error)
+	FilterGenerationReservationCreated(opts *bind.FilterOpts) (*CrossChainRegistryStorageGenerationReservationCreatedIterator, error)
+	WatchGenerationReservationCreated(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageGenerationReservationCreated) (event.Subscription, error)
+	ParseGenerationReservationCreated(log types.Log) (*CrossChainRegistryStorageGenerationReservationCreated, error)
+	FilterGenerationReservationRemoved(opts *bind.FilterOpts) (*CrossChainRegistryStorageGenerationReservationRemovedIterator, error)
+	WatchGenerationReservationRemoved(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageGenerationReservationRemoved) (event.Subscription, error)
+	ParseGenerationReservationRemoved(log types.Log) (*CrossChainRegistryStorageGenerationReservationRemoved, error)
+	FilterOperatorSetConfigRemoved(opts *bind.FilterOpts) (*CrossChainRegistryStorageOperatorSetConfigRemovedIterator, error)
+	WatchOperatorSetConfigRemoved(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageOperatorSetConfigRemoved) (event.Subscription, error)
+	ParseOperatorSetConfigRemoved(log types.Log) (*CrossChainRegistryStorageOperatorSetConfigRemoved, error)
+	FilterOperatorSetConfigSet(opts *bind.FilterOpts) (*CrossChainRegistryStorageOperatorSetConfigSetIterator, error)
+	WatchOperatorSetConfigSet(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageOperatorSetConfigSet) (event.Subscription, error)
+	ParseOperatorSetConfigSet(log types.Log) (*CrossChainRegistryStorageOperatorSetConfigSet, error)
+	FilterOperatorTableCalculatorRemoved(opts *bind.FilterOpts) (*CrossChainRegistryStorageOperatorTableCalculatorRemovedIterator, error)
+	WatchOperatorTableCalculatorRemoved(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageOperatorTableCalculatorRemoved) (event.Subscription, error)
+	ParseOperatorTableCalculatorRemoved(log types.Log) (*CrossChainRegistryStorageOperatorTableCalculatorRemoved, error)
+	FilterOperatorTableCalculatorSet(opts *bind.FilterOpts) (*CrossChainRegistryStorageOperatorTableCalculatorSetIterator, error)
+	WatchOperatorTableCalculatorSet(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageOperatorTableCalculatorSet) (event.Subscription, error)
+	ParseOperatorTableCalculatorSet(log types.Log) (*CrossChainRegistryStorageOperatorTableCalculatorSet, error)
+	FilterTableUpdateCadenceSet(opts *bind.FilterOpts) (*CrossChainRegistryStorageTableUpdateCadenceSetIterator, error)
+	WatchTableUpdateCadenceSet(opts *bind.WatchOpts, sink chan<- *CrossChainRegistryStorageTableUpdateCadenceSet) (event.Subscription, error)
+	ParseTableUpdateCadenceSet(log types.Log) (*CrossChainRegistryStorageTableUpdateCadenceSet, error)
+}
+
+var (
+	_ CrossChainRegistryStorageReader = (*CrossChainRegistryStorageCaller)(nil)
+	_ CrossChainRegistryStorageReader = (*CrossChainRegistryStorage)(nil)
+	_ CrossChainRegistryStorageReader = (*FakeCrossChainRegistryStorage)(nil)
+	_ CrossChainRegistryStorageWriter = (*CrossChainRegistryStorageTransactor)(nil)
+	_ CrossChainRegistryStorageWriter = (*CrossChainRegistryStorage)(nil)
+	_ CrossChainRegistryStorageWriter = (*FakeCrossChainRegistryStorage)(nil)
+	_ CrossChainRegistryStorageEvents = (*CrossChainRegistryStorageFilterer)(nil)
+	_ CrossChainRegistryStorageEvents = (*CrossChainRegistryStorage)(nil)
+	_ CrossChainRegistryStorageEvents = (*FakeCrossChainRegistryStorage)(nil)
+)
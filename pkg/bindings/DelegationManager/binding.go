@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,35 +30,17 @@ var (
 	_ = abi.ConvertType
 )
 
-// IDelegationManagerTypesQueuedWithdrawalParams is an auto generated low-level Go binding around an user-defined struct.
-type IDelegationManagerTypesQueuedWithdrawalParams struct {
-	Strategies           []common.Address
-	DepositShares        []*big.Int
-	DeprecatedWithdrawer common.Address
-}
+// IDelegationManagerTypesQueuedWithdrawalParams is an alias of the canonical eltypes.IDelegationManagerTypesQueuedWithdrawalParams shared by all bindings.
+type IDelegationManagerTypesQueuedWithdrawalParams = eltypes.IDelegationManagerTypesQueuedWithdrawalParams
 
-// IDelegationManagerTypesWithdrawal is an auto generated low-level Go binding around an user-defined struct.
-type IDelegationManagerTypesWithdrawal struct {
-	Staker       common.Address
-	DelegatedTo  common.Address
-	Withdrawer   common.Address
-	Nonce        *big.Int
-	StartBlock   uint32
-	Strategies   []common.Address
-	ScaledShares []*big.Int
-}
+// IDelegationManagerTypesWithdrawal is an alias of the canonical eltypes.IDelegationManagerTypesWithdrawal shared by all bindings.
+type IDelegationManagerTypesWithdrawal = eltypes.IDelegationManagerTypesWithdrawal
 
-// ISignatureUtilsMixinTypesSignatureWithExpiry is an auto generated low-level Go binding around an user-defined struct.
-type ISignatureUtilsMixinTypesSignatureWithExpiry struct {
-	Signature []byte
-	Expiry    *big.Int
-}
+// ISignatureUtilsMixinTypesSignatureWithExpiry is an alias of the canonical eltypes.ISignatureUtilsMixinTypesSignatureWithExpiry shared by all bindings.
+type ISignatureUtilsMixinTypesSignatureWithExpiry = eltypes.ISignatureUtilsMixinTypesSignatureWithExpiry
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // DelegationManagerMetaData contains all meta data concerning the DelegationManager contract.
 var DelegationManagerMetaData = &bind.MetaData{
@@ -0,0 +1,256 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManager
+
+import (
+	"fmt"
+)
+
+// ErrActivelyDelegated is the typed form of the DelegationManager custom error ActivelyDelegated().
+type ErrActivelyDelegated struct{}
+
+// Error implements the error interface.
+func (ErrActivelyDelegated) Error() string {
+	return "DelegationManager: ActivelyDelegated()"
+}
+
+// ErrCallerCannotUndelegate is the typed form of the DelegationManager custom error CallerCannotUndelegate().
+type ErrCallerCannotUndelegate struct{}
+
+// Error implements the error interface.
+func (ErrCallerCannotUndelegate) Error() string {
+	return "DelegationManager: CallerCannotUndelegate()"
+}
+
+// ErrCurrentlyPaused is the typed form of the DelegationManager custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "DelegationManager: CurrentlyPaused()"
+}
+
+// ErrFullySlashed is the typed form of the DelegationManager custom error FullySlashed().
+type ErrFullySlashed struct{}
+
+// Error implements the error interface.
+func (ErrFullySlashed) Error() string {
+	return "DelegationManager: FullySlashed()"
+}
+
+// ErrInputAddressZero is the typed form of the DelegationManager custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "DelegationManager: InputAddressZero()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the DelegationManager custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "DelegationManager: InputArrayLengthMismatch()"
+}
+
+// ErrInputArrayLengthZero is the typed form of the DelegationManager custom error InputArrayLengthZero().
+type ErrInputArrayLengthZero struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthZero) Error() string {
+	return "DelegationManager: InputArrayLengthZero()"
+}
+
+// ErrInvalidDepositScalingFactor is the typed form of the DelegationManager custom error InvalidDepositScalingFactor().
+type ErrInvalidDepositScalingFactor struct{}
+
+// Error implements the error interface.
+func (ErrInvalidDepositScalingFactor) Error() string {
+	return "DelegationManager: InvalidDepositScalingFactor()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the DelegationManager custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "DelegationManager: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidPermissions is the typed form of the DelegationManager custom error InvalidPermissions().
+type ErrInvalidPermissions struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPermissions) Error() string {
+	return "DelegationManager: InvalidPermissions()"
+}
+
+// ErrInvalidShortString is the typed form of the DelegationManager custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "DelegationManager: InvalidShortString()"
+}
+
+// ErrInvalidSignature is the typed form of the DelegationManager custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "DelegationManager: InvalidSignature()"
+}
+
+// ErrInvalidSnapshotOrdering is the typed form of the DelegationManager custom error InvalidSnapshotOrdering().
+type ErrInvalidSnapshotOrdering struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSnapshotOrdering) Error() string {
+	return "DelegationManager: InvalidSnapshotOrdering()"
+}
+
+// ErrNotActivelyDelegated is the typed form of the DelegationManager custom error NotActivelyDelegated().
+type ErrNotActivelyDelegated struct{}
+
+// Error implements the error interface.
+func (ErrNotActivelyDelegated) Error() string {
+	return "DelegationManager: NotActivelyDelegated()"
+}
+
+// ErrOnlyAllocationManager is the typed form of the DelegationManager custom error OnlyAllocationManager().
+type ErrOnlyAllocationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyAllocationManager) Error() string {
+	return "DelegationManager: OnlyAllocationManager()"
+}
+
+// ErrOnlyEigenPodManager is the typed form of the DelegationManager custom error OnlyEigenPodManager().
+type ErrOnlyEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodManager) Error() string {
+	return "DelegationManager: OnlyEigenPodManager()"
+}
+
+// ErrOnlyPauser is the typed form of the DelegationManager custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "DelegationManager: OnlyPauser()"
+}
+
+// ErrOnlyStrategyManagerOrEigenPodManager is the typed form of the DelegationManager custom error OnlyStrategyManagerOrEigenPodManager().
+type ErrOnlyStrategyManagerOrEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyManagerOrEigenPodManager) Error() string {
+	return "DelegationManager: OnlyStrategyManagerOrEigenPodManager()"
+}
+
+// ErrOnlyUnpauser is the typed form of the DelegationManager custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "DelegationManager: OnlyUnpauser()"
+}
+
+// ErrOperatorNotRegistered is the typed form of the DelegationManager custom error OperatorNotRegistered().
+type ErrOperatorNotRegistered struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegistered) Error() string {
+	return "DelegationManager: OperatorNotRegistered()"
+}
+
+// ErrOperatorsCannotUndelegate is the typed form of the DelegationManager custom error OperatorsCannotUndelegate().
+type ErrOperatorsCannotUndelegate struct{}
+
+// Error implements the error interface.
+func (ErrOperatorsCannotUndelegate) Error() string {
+	return "DelegationManager: OperatorsCannotUndelegate()"
+}
+
+// ErrSaltSpent is the typed form of the DelegationManager custom error SaltSpent().
+type ErrSaltSpent struct{}
+
+// Error implements the error interface.
+func (ErrSaltSpent) Error() string {
+	return "DelegationManager: SaltSpent()"
+}
+
+// ErrSignatureExpired is the typed form of the DelegationManager custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "DelegationManager: SignatureExpired()"
+}
+
+// ErrStringTooLong is the typed form of the DelegationManager custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("DelegationManager: StringTooLong(str: %v)", e.Str)
+}
+
+// ErrWithdrawalDelayNotElapsed is the typed form of the DelegationManager custom error WithdrawalDelayNotElapsed().
+type ErrWithdrawalDelayNotElapsed struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalDelayNotElapsed) Error() string {
+	return "DelegationManager: WithdrawalDelayNotElapsed()"
+}
+
+// ErrWithdrawalNotQueued is the typed form of the DelegationManager custom error WithdrawalNotQueued().
+type ErrWithdrawalNotQueued struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalNotQueued) Error() string {
+	return "DelegationManager: WithdrawalNotQueued()"
+}
+
+// ErrWithdrawerNotCaller is the typed form of the DelegationManager custom error WithdrawerNotCaller().
+type ErrWithdrawerNotCaller struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawerNotCaller) Error() string {
+	return "DelegationManager: WithdrawerNotCaller()"
+}
+
+// DelegationManagerErrors maps the name of every custom error in the DelegationManager ABI to its typed Go error.
+var DelegationManagerErrors = map[string]error{
+	"ActivelyDelegated":                    ErrActivelyDelegated{},
+	"CallerCannotUndelegate":               ErrCallerCannotUndelegate{},
+	"CurrentlyPaused":                      ErrCurrentlyPaused{},
+	"FullySlashed":                         ErrFullySlashed{},
+	"InputAddressZero":                     ErrInputAddressZero{},
+	"InputArrayLengthMismatch":             ErrInputArrayLengthMismatch{},
+	"InputArrayLengthZero":                 ErrInputArrayLengthZero{},
+	"InvalidDepositScalingFactor":          ErrInvalidDepositScalingFactor{},
+	"InvalidNewPausedStatus":               ErrInvalidNewPausedStatus{},
+	"InvalidPermissions":                   ErrInvalidPermissions{},
+	"InvalidShortString":                   ErrInvalidShortString{},
+	"InvalidSignature":                     ErrInvalidSignature{},
+	"InvalidSnapshotOrdering":              ErrInvalidSnapshotOrdering{},
+	"NotActivelyDelegated":                 ErrNotActivelyDelegated{},
+	"OnlyAllocationManager":                ErrOnlyAllocationManager{},
+	"OnlyEigenPodManager":                  ErrOnlyEigenPodManager{},
+	"OnlyPauser":                           ErrOnlyPauser{},
+	"OnlyStrategyManagerOrEigenPodManager": ErrOnlyStrategyManagerOrEigenPodManager{},
+	"OnlyUnpauser":                         ErrOnlyUnpauser{},
+	"OperatorNotRegistered":                ErrOperatorNotRegistered{},
+	"OperatorsCannotUndelegate":            ErrOperatorsCannotUndelegate{},
+	"SaltSpent":                            ErrSaltSpent{},
+	"SignatureExpired":                     ErrSignatureExpired{},
+	"StringTooLong":                        ErrStringTooLong{},
+	"WithdrawalDelayNotElapsed":            ErrWithdrawalDelayNotElapsed{},
+	"WithdrawalNotQueued":                  ErrWithdrawalNotQueued{},
+	"WithdrawerNotCaller":                  ErrWithdrawerNotCaller{},
+}
@@ -0,0 +1,785 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeDelegationManager is an in-memory DelegationManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded DelegationManagerFilterer.
+type FakeDelegationManager struct {
+	*fake.Contract
+	*DelegationManagerFilterer
+}
+
+// NewFakeDelegationManager returns an empty fake of the contract at address.
+func NewFakeDelegationManager(address common.Address) *FakeDelegationManager {
+	contract := fake.NewContract(DelegationManagerMetaData, address)
+	filterer, err := NewDelegationManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeDelegationManager{Contract: contract, DelegationManagerFilterer: filterer}
+}
+
+// DELEGATIONAPPROVALTYPEHASH returns the values stubbed with StubDELEGATIONAPPROVALTYPEHASH.
+func (_FakeDelegationManager *FakeDelegationManager) DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeDelegationManager.Contract.Read("DELEGATION_APPROVAL_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDELEGATIONAPPROVALTYPEHASH sets what DELEGATIONAPPROVALTYPEHASH returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubDELEGATIONAPPROVALTYPEHASH(out0 [32]byte) {
+	_FakeDelegationManager.Contract.Stub("DELEGATION_APPROVAL_TYPEHASH", []interface{}{}, out0)
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeDelegationManager *FakeDelegationManager) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManager.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubAllocationManager(out0 common.Address) {
+	_FakeDelegationManager.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// BeaconChainETHStrategy returns the values stubbed with StubBeaconChainETHStrategy.
+func (_FakeDelegationManager *FakeDelegationManager) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManager.Contract.Read("beaconChainETHStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainETHStrategy sets what BeaconChainETHStrategy returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubBeaconChainETHStrategy(out0 common.Address) {
+	_FakeDelegationManager.Contract.Stub("beaconChainETHStrategy", []interface{}{}, out0)
+}
+
+// CalculateDelegationApprovalDigestHash returns the values stubbed with StubCalculateDelegationApprovalDigestHash.
+func (_FakeDelegationManager *FakeDelegationManager) CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, approver common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeDelegationManager.Contract.Read("calculateDelegationApprovalDigestHash", []interface{}{staker, operator, approver, approverSalt, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateDelegationApprovalDigestHash sets what CalculateDelegationApprovalDigestHash returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubCalculateDelegationApprovalDigestHash(staker common.Address, operator common.Address, approver common.Address, approverSalt [32]byte, expiry *big.Int, out0 [32]byte) {
+	_FakeDelegationManager.Contract.Stub("calculateDelegationApprovalDigestHash", []interface{}{staker, operator, approver, approverSalt, expiry}, out0)
+}
+
+// CalculateWithdrawalRoot returns the values stubbed with StubCalculateWithdrawalRoot.
+func (_FakeDelegationManager *FakeDelegationManager) CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerTypesWithdrawal) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeDelegationManager.Contract.Read("calculateWithdrawalRoot", []interface{}{withdrawal}, &out0)
+	return out0, err
+}
+
+// StubCalculateWithdrawalRoot sets what CalculateWithdrawalRoot returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubCalculateWithdrawalRoot(withdrawal IDelegationManagerTypesWithdrawal, out0 [32]byte) {
+	_FakeDelegationManager.Contract.Stub("calculateWithdrawalRoot", []interface{}{withdrawal}, out0)
+}
+
+// ConvertToDepositShares returns the values stubbed with StubConvertToDepositShares.
+func (_FakeDelegationManager *FakeDelegationManager) ConvertToDepositShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address, withdrawableShares []*big.Int) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeDelegationManager.Contract.Read("convertToDepositShares", []interface{}{staker, strategies, withdrawableShares}, &out0)
+	return out0, err
+}
+
+// StubConvertToDepositShares sets what ConvertToDepositShares returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubConvertToDepositShares(staker common.Address, strategies []common.Address, withdrawableShares []*big.Int, out0 []*big.Int) {
+	_FakeDelegationManager.Contract.Stub("convertToDepositShares", []interface{}{staker, strategies, withdrawableShares}, out0)
+}
+
+// CumulativeWithdrawalsQueued returns the values stubbed with StubCumulativeWithdrawalsQueued.
+func (_FakeDelegationManager *FakeDelegationManager) CumulativeWithdrawalsQueued(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManager.Contract.Read("cumulativeWithdrawalsQueued", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubCumulativeWithdrawalsQueued sets what CumulativeWithdrawalsQueued returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubCumulativeWithdrawalsQueued(staker common.Address, out0 *big.Int) {
+	_FakeDelegationManager.Contract.Stub("cumulativeWithdrawalsQueued", []interface{}{staker}, out0)
+}
+
+// DelegatedTo returns the values stubbed with StubDelegatedTo.
+func (_FakeDelegationManager *FakeDelegationManager) DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManager.Contract.Read("delegatedTo", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubDelegatedTo sets what DelegatedTo returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubDelegatedTo(staker common.Address, out0 common.Address) {
+	_FakeDelegationManager.Contract.Stub("delegatedTo", []interface{}{staker}, out0)
+}
+
+// DelegationApprover returns the values stubbed with StubDelegationApprover.
+func (_FakeDelegationManager *FakeDelegationManager) DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManager.Contract.Read("delegationApprover", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubDelegationApprover sets what DelegationApprover returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubDelegationApprover(operator common.Address, out0 common.Address) {
+	_FakeDelegationManager.Contract.Stub("delegationApprover", []interface{}{operator}, out0)
+}
+
+// DelegationApproverSaltIsSpent returns the values stubbed with StubDelegationApproverSaltIsSpent.
+func (_FakeDelegationManager *FakeDelegationManager) DelegationApproverSaltIsSpent(opts *bind.CallOpts, delegationApprover common.Address, salt [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManager.Contract.Read("delegationApproverSaltIsSpent", []interface{}{delegationApprover, salt}, &out0)
+	return out0, err
+}
+
+// StubDelegationApproverSaltIsSpent sets what DelegationApproverSaltIsSpent returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubDelegationApproverSaltIsSpent(delegationApprover common.Address, salt [32]byte, out0 bool) {
+	_FakeDelegationManager.Contract.Stub("delegationApproverSaltIsSpent", []interface{}{delegationApprover, salt}, out0)
+}
+
+// DepositScalingFactor returns the values stubbed with StubDepositScalingFactor.
+func (_FakeDelegationManager *FakeDelegationManager) DepositScalingFactor(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManager.Contract.Read("depositScalingFactor", []interface{}{staker, strategy}, &out0)
+	return out0, err
+}
+
+// StubDepositScalingFactor sets what DepositScalingFactor returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubDepositScalingFactor(staker common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeDelegationManager.Contract.Stub("depositScalingFactor", []interface{}{staker, strategy}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeDelegationManager *FakeDelegationManager) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeDelegationManager.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubDomainSeparator(out0 [32]byte) {
+	_FakeDelegationManager.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// EigenPodManager returns the values stubbed with StubEigenPodManager.
+func (_FakeDelegationManager *FakeDelegationManager) EigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManager.Contract.Read("eigenPodManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenPodManager sets what EigenPodManager returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubEigenPodManager(out0 common.Address) {
+	_FakeDelegationManager.Contract.Stub("eigenPodManager", []interface{}{}, out0)
+}
+
+// GetDepositedShares returns the values stubbed with StubGetDepositedShares.
+func (_FakeDelegationManager *FakeDelegationManager) GetDepositedShares(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeDelegationManager.Contract.Read("getDepositedShares", []interface{}{staker}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetDepositedShares sets what GetDepositedShares returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubGetDepositedShares(staker common.Address, out0 []common.Address, out1 []*big.Int) {
+	_FakeDelegationManager.Contract.Stub("getDepositedShares", []interface{}{staker}, out0, out1)
+}
+
+// GetOperatorShares returns the values stubbed with StubGetOperatorShares.
+func (_FakeDelegationManager *FakeDelegationManager) GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeDelegationManager.Contract.Read("getOperatorShares", []interface{}{operator, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorShares sets what GetOperatorShares returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubGetOperatorShares(operator common.Address, strategies []common.Address, out0 []*big.Int) {
+	_FakeDelegationManager.Contract.Stub("getOperatorShares", []interface{}{operator, strategies}, out0)
+}
+
+// GetOperatorsShares returns the values stubbed with StubGetOperatorsShares.
+func (_FakeDelegationManager *FakeDelegationManager) GetOperatorsShares(opts *bind.CallOpts, operators []common.Address, strategies []common.Address) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeDelegationManager.Contract.Read("getOperatorsShares", []interface{}{operators, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorsShares sets what GetOperatorsShares returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubGetOperatorsShares(operators []common.Address, strategies []common.Address, out0 [][]*big.Int) {
+	_FakeDelegationManager.Contract.Stub("getOperatorsShares", []interface{}{operators, strategies}, out0)
+}
+
+// GetQueuedWithdrawal returns the values stubbed with StubGetQueuedWithdrawal.
+func (_FakeDelegationManager *FakeDelegationManager) GetQueuedWithdrawal(opts *bind.CallOpts, withdrawalRoot [32]byte) (struct {
+	Withdrawal IDelegationManagerTypesWithdrawal
+	Shares     []*big.Int
+}, error) {
+	var out0 struct {
+		Withdrawal IDelegationManagerTypesWithdrawal
+		Shares     []*big.Int
+	}
+	err := _FakeDelegationManager.Contract.Read("getQueuedWithdrawal", []interface{}{withdrawalRoot}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawal sets what GetQueuedWithdrawal returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubGetQueuedWithdrawal(withdrawalRoot [32]byte, out0 struct {
+	Withdrawal IDelegationManagerTypesWithdrawal
+	Shares     []*big.Int
+}) {
+	_FakeDelegationManager.Contract.Stub("getQueuedWithdrawal", []interface{}{withdrawalRoot}, out0)
+}
+
+// GetQueuedWithdrawalRoots returns the values stubbed with StubGetQueuedWithdrawalRoots.
+func (_FakeDelegationManager *FakeDelegationManager) GetQueuedWithdrawalRoots(opts *bind.CallOpts, staker common.Address) ([][32]byte, error) {
+	var out0 [][32]byte
+	err := _FakeDelegationManager.Contract.Read("getQueuedWithdrawalRoots", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawalRoots sets what GetQueuedWithdrawalRoots returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubGetQueuedWithdrawalRoots(staker common.Address, out0 [][32]byte) {
+	_FakeDelegationManager.Contract.Stub("getQueuedWithdrawalRoots", []interface{}{staker}, out0)
+}
+
+// GetQueuedWithdrawals returns the values stubbed with StubGetQueuedWithdrawals.
+func (_FakeDelegationManager *FakeDelegationManager) GetQueuedWithdrawals(opts *bind.CallOpts, staker common.Address) (struct {
+	Withdrawals []IDelegationManagerTypesWithdrawal
+	Shares      [][]*big.Int
+}, error) {
+	var out0 struct {
+		Withdrawals []IDelegationManagerTypesWithdrawal
+		Shares      [][]*big.Int
+	}
+	err := _FakeDelegationManager.Contract.Read("getQueuedWithdrawals", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawals sets what GetQueuedWithdrawals returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubGetQueuedWithdrawals(staker common.Address, out0 struct {
+	Withdrawals []IDelegationManagerTypesWithdrawal
+	Shares      [][]*big.Int
+}) {
+	_FakeDelegationManager.Contract.Stub("getQueuedWithdrawals", []interface{}{staker}, out0)
+}
+
+// GetSlashableSharesInQueue returns the values stubbed with StubGetSlashableSharesInQueue.
+func (_FakeDelegationManager *FakeDelegationManager) GetSlashableSharesInQueue(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManager.Contract.Read("getSlashableSharesInQueue", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetSlashableSharesInQueue sets what GetSlashableSharesInQueue returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubGetSlashableSharesInQueue(operator common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeDelegationManager.Contract.Stub("getSlashableSharesInQueue", []interface{}{operator, strategy}, out0)
+}
+
+// GetWithdrawableShares returns the values stubbed with StubGetWithdrawableShares.
+func (_FakeDelegationManager *FakeDelegationManager) GetWithdrawableShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address) (struct {
+	WithdrawableShares []*big.Int
+	DepositShares      []*big.Int
+}, error) {
+	var out0 struct {
+		WithdrawableShares []*big.Int
+		DepositShares      []*big.Int
+	}
+	err := _FakeDelegationManager.Contract.Read("getWithdrawableShares", []interface{}{staker, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetWithdrawableShares sets what GetWithdrawableShares returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubGetWithdrawableShares(staker common.Address, strategies []common.Address, out0 struct {
+	WithdrawableShares []*big.Int
+	DepositShares      []*big.Int
+}) {
+	_FakeDelegationManager.Contract.Stub("getWithdrawableShares", []interface{}{staker, strategies}, out0)
+}
+
+// IsDelegated returns the values stubbed with StubIsDelegated.
+func (_FakeDelegationManager *FakeDelegationManager) IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManager.Contract.Read("isDelegated", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubIsDelegated sets what IsDelegated returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubIsDelegated(staker common.Address, out0 bool) {
+	_FakeDelegationManager.Contract.Stub("isDelegated", []interface{}{staker}, out0)
+}
+
+// IsOperator returns the values stubbed with StubIsOperator.
+func (_FakeDelegationManager *FakeDelegationManager) IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManager.Contract.Read("isOperator", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubIsOperator sets what IsOperator returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubIsOperator(operator common.Address, out0 bool) {
+	_FakeDelegationManager.Contract.Stub("isOperator", []interface{}{operator}, out0)
+}
+
+// MinWithdrawalDelayBlocks returns the values stubbed with StubMinWithdrawalDelayBlocks.
+func (_FakeDelegationManager *FakeDelegationManager) MinWithdrawalDelayBlocks(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeDelegationManager.Contract.Read("minWithdrawalDelayBlocks", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMinWithdrawalDelayBlocks sets what MinWithdrawalDelayBlocks returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubMinWithdrawalDelayBlocks(out0 uint32) {
+	_FakeDelegationManager.Contract.Stub("minWithdrawalDelayBlocks", []interface{}{}, out0)
+}
+
+// OperatorShares returns the values stubbed with StubOperatorShares.
+func (_FakeDelegationManager *FakeDelegationManager) OperatorShares(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManager.Contract.Read("operatorShares", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubOperatorShares sets what OperatorShares returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubOperatorShares(operator common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeDelegationManager.Contract.Stub("operatorShares", []interface{}{operator, strategy}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeDelegationManager *FakeDelegationManager) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManager.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubPaused(index uint8, out0 bool) {
+	_FakeDelegationManager.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeDelegationManager *FakeDelegationManager) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManager.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubPaused0(out0 *big.Int) {
+	_FakeDelegationManager.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeDelegationManager *FakeDelegationManager) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManager.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubPauserRegistry(out0 common.Address) {
+	_FakeDelegationManager.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// PendingWithdrawals returns the values stubbed with StubPendingWithdrawals.
+func (_FakeDelegationManager *FakeDelegationManager) PendingWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManager.Contract.Read("pendingWithdrawals", []interface{}{withdrawalRoot}, &out0)
+	return out0, err
+}
+
+// StubPendingWithdrawals sets what PendingWithdrawals returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubPendingWithdrawals(withdrawalRoot [32]byte, out0 bool) {
+	_FakeDelegationManager.Contract.Stub("pendingWithdrawals", []interface{}{withdrawalRoot}, out0)
+}
+
+// PermissionController returns the values stubbed with StubPermissionController.
+func (_FakeDelegationManager *FakeDelegationManager) PermissionController(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManager.Contract.Read("permissionController", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPermissionController sets what PermissionController returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubPermissionController(out0 common.Address) {
+	_FakeDelegationManager.Contract.Stub("permissionController", []interface{}{}, out0)
+}
+
+// QueuedWithdrawals returns the values stubbed with StubQueuedWithdrawals.
+func (_FakeDelegationManager *FakeDelegationManager) QueuedWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (IDelegationManagerTypesWithdrawal, error) {
+	var out0 IDelegationManagerTypesWithdrawal
+	err := _FakeDelegationManager.Contract.Read("queuedWithdrawals", []interface{}{withdrawalRoot}, &out0)
+	return out0, err
+}
+
+// StubQueuedWithdrawals sets what QueuedWithdrawals returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubQueuedWithdrawals(withdrawalRoot [32]byte, out0 IDelegationManagerTypesWithdrawal) {
+	_FakeDelegationManager.Contract.Stub("queuedWithdrawals", []interface{}{withdrawalRoot}, out0)
+}
+
+// StrategyManager returns the values stubbed with StubStrategyManager.
+func (_FakeDelegationManager *FakeDelegationManager) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManager.Contract.Read("strategyManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyManager sets what StrategyManager returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubStrategyManager(out0 common.Address) {
+	_FakeDelegationManager.Contract.Stub("strategyManager", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeDelegationManager *FakeDelegationManager) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeDelegationManager.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeDelegationManager *FakeDelegationManager) StubVersion(out0 string) {
+	_FakeDelegationManager.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// CompleteQueuedWithdrawal records a completeQueuedWithdrawal call after running the hook set with OnCompleteQueuedWithdrawal.
+func (_FakeDelegationManager *FakeDelegationManager) CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "completeQueuedWithdrawal", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("completeQueuedWithdrawal").(func(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) error); ok {
+			return hook(opts, withdrawal, tokens, receiveAsTokens)
+		}
+		return nil
+	}, withdrawal, tokens, receiveAsTokens)
+}
+
+// OnCompleteQueuedWithdrawal sets a hook run by every CompleteQueuedWithdrawal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnCompleteQueuedWithdrawal(hook func(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) error) {
+	_FakeDelegationManager.Contract.SetHook("completeQueuedWithdrawal", hook)
+}
+
+// CompleteQueuedWithdrawals records a completeQueuedWithdrawals call after running the hook set with OnCompleteQueuedWithdrawals.
+func (_FakeDelegationManager *FakeDelegationManager) CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "completeQueuedWithdrawals", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("completeQueuedWithdrawals").(func(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) error); ok {
+			return hook(opts, withdrawals, tokens, receiveAsTokens)
+		}
+		return nil
+	}, withdrawals, tokens, receiveAsTokens)
+}
+
+// OnCompleteQueuedWithdrawals sets a hook run by every CompleteQueuedWithdrawals call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnCompleteQueuedWithdrawals(hook func(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) error) {
+	_FakeDelegationManager.Contract.SetHook("completeQueuedWithdrawals", hook)
+}
+
+// DecreaseDelegatedShares records a decreaseDelegatedShares call after running the hook set with OnDecreaseDelegatedShares.
+func (_FakeDelegationManager *FakeDelegationManager) DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "decreaseDelegatedShares", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("decreaseDelegatedShares").(func(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) error); ok {
+			return hook(opts, staker, curDepositShares, beaconChainSlashingFactorDecrease)
+		}
+		return nil
+	}, staker, curDepositShares, beaconChainSlashingFactorDecrease)
+}
+
+// OnDecreaseDelegatedShares sets a hook run by every DecreaseDelegatedShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnDecreaseDelegatedShares(hook func(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) error) {
+	_FakeDelegationManager.Contract.SetHook("decreaseDelegatedShares", hook)
+}
+
+// DelegateTo records a delegateTo call after running the hook set with OnDelegateTo.
+func (_FakeDelegationManager *FakeDelegationManager) DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "delegateTo", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("delegateTo").(func(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error); ok {
+			return hook(opts, operator, approverSignatureAndExpiry, approverSalt)
+		}
+		return nil
+	}, operator, approverSignatureAndExpiry, approverSalt)
+}
+
+// OnDelegateTo sets a hook run by every DelegateTo call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnDelegateTo(hook func(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error) {
+	_FakeDelegationManager.Contract.SetHook("delegateTo", hook)
+}
+
+// IncreaseDelegatedShares records a increaseDelegatedShares call after running the hook set with OnIncreaseDelegatedShares.
+func (_FakeDelegationManager *FakeDelegationManager) IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "increaseDelegatedShares", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("increaseDelegatedShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) error); ok {
+			return hook(opts, staker, strategy, prevDepositShares, addedShares)
+		}
+		return nil
+	}, staker, strategy, prevDepositShares, addedShares)
+}
+
+// OnIncreaseDelegatedShares sets a hook run by every IncreaseDelegatedShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnIncreaseDelegatedShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) error) {
+	_FakeDelegationManager.Contract.SetHook("increaseDelegatedShares", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeDelegationManager *FakeDelegationManager) Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialPausedStatus)
+		}
+		return nil
+	}, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnInitialize(hook func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error) {
+	_FakeDelegationManager.Contract.SetHook("initialize", hook)
+}
+
+// ModifyOperatorDetails records a modifyOperatorDetails call after running the hook set with OnModifyOperatorDetails.
+func (_FakeDelegationManager *FakeDelegationManager) ModifyOperatorDetails(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "modifyOperatorDetails", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("modifyOperatorDetails").(func(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) error); ok {
+			return hook(opts, operator, newDelegationApprover)
+		}
+		return nil
+	}, operator, newDelegationApprover)
+}
+
+// OnModifyOperatorDetails sets a hook run by every ModifyOperatorDetails call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnModifyOperatorDetails(hook func(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) error) {
+	_FakeDelegationManager.Contract.SetHook("modifyOperatorDetails", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeDelegationManager *FakeDelegationManager) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeDelegationManager.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeDelegationManager *FakeDelegationManager) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeDelegationManager.Contract.SetHook("pauseAll", hook)
+}
+
+// QueueWithdrawals records a queueWithdrawals call after running the hook set with OnQueueWithdrawals.
+func (_FakeDelegationManager *FakeDelegationManager) QueueWithdrawals(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "queueWithdrawals", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("queueWithdrawals").(func(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) error); ok {
+			return hook(opts, params)
+		}
+		return nil
+	}, params)
+}
+
+// OnQueueWithdrawals sets a hook run by every QueueWithdrawals call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnQueueWithdrawals(hook func(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) error) {
+	_FakeDelegationManager.Contract.SetHook("queueWithdrawals", hook)
+}
+
+// Redelegate records a redelegate call after running the hook set with OnRedelegate.
+func (_FakeDelegationManager *FakeDelegationManager) Redelegate(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "redelegate", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("redelegate").(func(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error); ok {
+			return hook(opts, newOperator, newOperatorApproverSig, approverSalt)
+		}
+		return nil
+	}, newOperator, newOperatorApproverSig, approverSalt)
+}
+
+// OnRedelegate sets a hook run by every Redelegate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnRedelegate(hook func(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error) {
+	_FakeDelegationManager.Contract.SetHook("redelegate", hook)
+}
+
+// RegisterAsOperator records a registerAsOperator call after running the hook set with OnRegisterAsOperator.
+func (_FakeDelegationManager *FakeDelegationManager) RegisterAsOperator(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "registerAsOperator", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("registerAsOperator").(func(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) error); ok {
+			return hook(opts, initDelegationApprover, allocationDelay, metadataURI)
+		}
+		return nil
+	}, initDelegationApprover, allocationDelay, metadataURI)
+}
+
+// OnRegisterAsOperator sets a hook run by every RegisterAsOperator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnRegisterAsOperator(hook func(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) error) {
+	_FakeDelegationManager.Contract.SetHook("registerAsOperator", hook)
+}
+
+// SlashOperatorShares records a slashOperatorShares call after running the hook set with OnSlashOperatorShares.
+func (_FakeDelegationManager *FakeDelegationManager) SlashOperatorShares(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "slashOperatorShares", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("slashOperatorShares").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) error); ok {
+			return hook(opts, operator, operatorSet, slashId, strategy, prevMaxMagnitude, newMaxMagnitude)
+		}
+		return nil
+	}, operator, operatorSet, slashId, strategy, prevMaxMagnitude, newMaxMagnitude)
+}
+
+// OnSlashOperatorShares sets a hook run by every SlashOperatorShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnSlashOperatorShares(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) error) {
+	_FakeDelegationManager.Contract.SetHook("slashOperatorShares", hook)
+}
+
+// Undelegate records a undelegate call after running the hook set with OnUndelegate.
+func (_FakeDelegationManager *FakeDelegationManager) Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "undelegate", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("undelegate").(func(opts *bind.TransactOpts, staker common.Address) error); ok {
+			return hook(opts, staker)
+		}
+		return nil
+	}, staker)
+}
+
+// OnUndelegate sets a hook run by every Undelegate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnUndelegate(hook func(opts *bind.TransactOpts, staker common.Address) error) {
+	_FakeDelegationManager.Contract.SetHook("undelegate", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeDelegationManager *FakeDelegationManager) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeDelegationManager.Contract.SetHook("unpause", hook)
+}
+
+// UpdateOperatorMetadataURI records a updateOperatorMetadataURI call after running the hook set with OnUpdateOperatorMetadataURI.
+func (_FakeDelegationManager *FakeDelegationManager) UpdateOperatorMetadataURI(opts *bind.TransactOpts, operator common.Address, metadataURI string) (*types.Transaction, error) {
+	return _FakeDelegationManager.Contract.Transact(opts, "updateOperatorMetadataURI", func() error {
+		if hook, ok := _FakeDelegationManager.Contract.Hook("updateOperatorMetadataURI").(func(opts *bind.TransactOpts, operator common.Address, metadataURI string) error); ok {
+			return hook(opts, operator, metadataURI)
+		}
+		return nil
+	}, operator, metadataURI)
+}
+
+// OnUpdateOperatorMetadataURI sets a hook run by every UpdateOperatorMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManager *FakeDelegationManager) OnUpdateOperatorMetadataURI(hook func(opts *bind.TransactOpts, operator common.Address, metadataURI string) error) {
+	_FakeDelegationManager.Contract.SetHook("updateOperatorMetadataURI", hook)
+}
+
+// EmitDelegationApproverUpdated appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitDelegationApproverUpdated(event *DelegationManagerDelegationApproverUpdated) {
+	_FakeDelegationManager.Contract.Emit("DelegationApproverUpdated", event)
+}
+
+// EmitDepositScalingFactorUpdated appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitDepositScalingFactorUpdated(event *DelegationManagerDepositScalingFactorUpdated) {
+	_FakeDelegationManager.Contract.Emit("DepositScalingFactorUpdated", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitInitialized(event *DelegationManagerInitialized) {
+	_FakeDelegationManager.Contract.Emit("Initialized", event)
+}
+
+// EmitOperatorMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitOperatorMetadataURIUpdated(event *DelegationManagerOperatorMetadataURIUpdated) {
+	_FakeDelegationManager.Contract.Emit("OperatorMetadataURIUpdated", event)
+}
+
+// EmitOperatorRegistered appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitOperatorRegistered(event *DelegationManagerOperatorRegistered) {
+	_FakeDelegationManager.Contract.Emit("OperatorRegistered", event)
+}
+
+// EmitOperatorSharesDecreased appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitOperatorSharesDecreased(event *DelegationManagerOperatorSharesDecreased) {
+	_FakeDelegationManager.Contract.Emit("OperatorSharesDecreased", event)
+}
+
+// EmitOperatorSharesIncreased appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitOperatorSharesIncreased(event *DelegationManagerOperatorSharesIncreased) {
+	_FakeDelegationManager.Contract.Emit("OperatorSharesIncreased", event)
+}
+
+// EmitOperatorSharesSlashed appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitOperatorSharesSlashed(event *DelegationManagerOperatorSharesSlashed) {
+	_FakeDelegationManager.Contract.Emit("OperatorSharesSlashed", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitPaused(event *DelegationManagerPaused) {
+	_FakeDelegationManager.Contract.Emit("Paused", event)
+}
+
+// EmitSlashingWithdrawalCompleted appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitSlashingWithdrawalCompleted(event *DelegationManagerSlashingWithdrawalCompleted) {
+	_FakeDelegationManager.Contract.Emit("SlashingWithdrawalCompleted", event)
+}
+
+// EmitSlashingWithdrawalQueued appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitSlashingWithdrawalQueued(event *DelegationManagerSlashingWithdrawalQueued) {
+	_FakeDelegationManager.Contract.Emit("SlashingWithdrawalQueued", event)
+}
+
+// EmitStakerDelegated appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitStakerDelegated(event *DelegationManagerStakerDelegated) {
+	_FakeDelegationManager.Contract.Emit("StakerDelegated", event)
+}
+
+// EmitStakerForceUndelegated appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitStakerForceUndelegated(event *DelegationManagerStakerForceUndelegated) {
+	_FakeDelegationManager.Contract.Emit("StakerForceUndelegated", event)
+}
+
+// EmitStakerUndelegated appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitStakerUndelegated(event *DelegationManagerStakerUndelegated) {
+	_FakeDelegationManager.Contract.Emit("StakerUndelegated", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeDelegationManager *FakeDelegationManager) EmitUnpaused(event *DelegationManagerUnpaused) {
+	_FakeDelegationManager.Contract.Emit("Unpaused", event)
+}
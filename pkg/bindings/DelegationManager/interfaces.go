@@ -0,0 +1,141 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// DelegationManagerReader is the read-only surface of the DelegationManager
+// binding, implemented by DelegationManagerCaller, DelegationManager and FakeDelegationManager.
+type DelegationManagerReader interface {
+	DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	AllocationManager(opts *bind.CallOpts) (common.Address, error)
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, approver common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error)
+	CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerTypesWithdrawal) ([32]byte, error)
+	ConvertToDepositShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address, withdrawableShares []*big.Int) ([]*big.Int, error)
+	CumulativeWithdrawalsQueued(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error)
+	DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error)
+	DelegationApproverSaltIsSpent(opts *bind.CallOpts, delegationApprover common.Address, salt [32]byte) (bool, error)
+	DepositScalingFactor(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetDepositedShares(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error)
+	GetOperatorsShares(opts *bind.CallOpts, operators []common.Address, strategies []common.Address) ([][]*big.Int, error)
+	GetQueuedWithdrawal(opts *bind.CallOpts, withdrawalRoot [32]byte) (struct {
+		Withdrawal IDelegationManagerTypesWithdrawal
+		Shares     []*big.Int
+	}, error)
+	GetQueuedWithdrawalRoots(opts *bind.CallOpts, staker common.Address) ([][32]byte, error)
+	GetQueuedWithdrawals(opts *bind.CallOpts, staker common.Address) (struct {
+		Withdrawals []IDelegationManagerTypesWithdrawal
+		Shares      [][]*big.Int
+	}, error)
+	GetSlashableSharesInQueue(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error)
+	GetWithdrawableShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address) (struct {
+		WithdrawableShares []*big.Int
+		DepositShares      []*big.Int
+	}, error)
+	IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error)
+	IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error)
+	MinWithdrawalDelayBlocks(opts *bind.CallOpts) (uint32, error)
+	OperatorShares(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PendingWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (bool, error)
+	PermissionController(opts *bind.CallOpts) (common.Address, error)
+	QueuedWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (IDelegationManagerTypesWithdrawal, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+// DelegationManagerWriter is the transacting surface of the DelegationManager
+// binding, implemented by DelegationManagerTransactor, DelegationManager and FakeDelegationManager.
+type DelegationManagerWriter interface {
+	CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) (*types.Transaction, error)
+	CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) (*types.Transaction, error)
+	DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) (*types.Transaction, error)
+	DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error)
+	ModifyOperatorDetails(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	QueueWithdrawals(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) (*types.Transaction, error)
+	Redelegate(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	RegisterAsOperator(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) (*types.Transaction, error)
+	SlashOperatorShares(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) (*types.Transaction, error)
+	Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateOperatorMetadataURI(opts *bind.TransactOpts, operator common.Address, metadataURI string) (*types.Transaction, error)
+}
+
+// DelegationManagerEvents is the event filtering surface of the DelegationManager
+// binding, implemented by DelegationManagerFilterer, DelegationManager and FakeDelegationManager.
+type DelegationManagerEvents interface {
+	FilterDelegationApproverUpdated(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerDelegationApproverUpdatedIterator, error)
+	WatchDelegationApproverUpdated(opts *bind.WatchOpts, sink chan<- *DelegationManagerDelegationApproverUpdated, operator []common.Address) (event.Subscription, error)
+	ParseDelegationApproverUpdated(log types.Log) (*DelegationManagerDelegationApproverUpdated, error)
+	FilterDepositScalingFactorUpdated(opts *bind.FilterOpts) (*DelegationManagerDepositScalingFactorUpdatedIterator, error)
+	WatchDepositScalingFactorUpdated(opts *bind.WatchOpts, sink chan<- *DelegationManagerDepositScalingFactorUpdated) (event.Subscription, error)
+	ParseDepositScalingFactorUpdated(log types.Log) (*DelegationManagerDepositScalingFactorUpdated, error)
+	FilterInitialized(opts *bind.FilterOpts) (*DelegationManagerInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *DelegationManagerInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*DelegationManagerInitialized, error)
+	FilterOperatorMetadataURIUpdated(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorMetadataURIUpdatedIterator, error)
+	WatchOperatorMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorMetadataURIUpdated, operator []common.Address) (event.Subscription, error)
+	ParseOperatorMetadataURIUpdated(log types.Log) (*DelegationManagerOperatorMetadataURIUpdated, error)
+	FilterOperatorRegistered(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorRegisteredIterator, error)
+	WatchOperatorRegistered(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorRegistered, operator []common.Address) (event.Subscription, error)
+	ParseOperatorRegistered(log types.Log) (*DelegationManagerOperatorRegistered, error)
+	FilterOperatorSharesDecreased(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorSharesDecreasedIterator, error)
+	WatchOperatorSharesDecreased(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorSharesDecreased, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesDecreased(log types.Log) (*DelegationManagerOperatorSharesDecreased, error)
+	FilterOperatorSharesIncreased(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorSharesIncreasedIterator, error)
+	WatchOperatorSharesIncreased(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorSharesIncreased, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesIncreased(log types.Log) (*DelegationManagerOperatorSharesIncreased, error)
+	FilterOperatorSharesSlashed(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerOperatorSharesSlashedIterator, error)
+	WatchOperatorSharesSlashed(opts *bind.WatchOpts, sink chan<- *DelegationManagerOperatorSharesSlashed, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesSlashed(log types.Log) (*DelegationManagerOperatorSharesSlashed, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*DelegationManagerPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *DelegationManagerPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*DelegationManagerPaused, error)
+	FilterSlashingWithdrawalCompleted(opts *bind.FilterOpts) (*DelegationManagerSlashingWithdrawalCompletedIterator, error)
+	WatchSlashingWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *DelegationManagerSlashingWithdrawalCompleted) (event.Subscription, error)
+	ParseSlashingWithdrawalCompleted(log types.Log) (*DelegationManagerSlashingWithdrawalCompleted, error)
+	FilterSlashingWithdrawalQueued(opts *bind.FilterOpts) (*DelegationManagerSlashingWithdrawalQueuedIterator, error)
+	WatchSlashingWithdrawalQueued(opts *bind.WatchOpts, sink chan<- *DelegationManagerSlashingWithdrawalQueued) (event.Subscription, error)
+	ParseSlashingWithdrawalQueued(log types.Log) (*DelegationManagerSlashingWithdrawalQueued, error)
+	FilterStakerDelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStakerDelegatedIterator, error)
+	WatchStakerDelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStakerDelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerDelegated(log types.Log) (*DelegationManagerStakerDelegated, error)
+	FilterStakerForceUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStakerForceUndelegatedIterator, error)
+	WatchStakerForceUndelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStakerForceUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerForceUndelegated(log types.Log) (*DelegationManagerStakerForceUndelegated, error)
+	FilterStakerUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStakerUndelegatedIterator, error)
+	WatchStakerUndelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStakerUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerUndelegated(log types.Log) (*DelegationManagerStakerUndelegated, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*DelegationManagerUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *DelegationManagerUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*DelegationManagerUnpaused, error)
+}
+
+var (
+	_ DelegationManagerReader = (*DelegationManagerCaller)(nil)
+	_ DelegationManagerReader = (*DelegationManager)(nil)
+	_ DelegationManagerReader = (*FakeDelegationManager)(nil)
+	_ DelegationManagerWriter = (*DelegationManagerTransactor)(nil)
+	_ DelegationManagerWriter = (*DelegationManager)(nil)
+	_ DelegationManagerWriter = (*FakeDelegationManager)(nil)
+	_ DelegationManagerEvents = (*DelegationManagerFilterer)(nil)
+	_ DelegationManagerEvents = (*DelegationManager)(nil)
+	_ DelegationManagerEvents = (*FakeDelegationManager)(nil)
+)
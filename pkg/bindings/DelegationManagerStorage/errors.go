@@ -0,0 +1,160 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManagerStorage
+
+// ErrActivelyDelegated is the typed form of the DelegationManagerStorage custom error ActivelyDelegated().
+type ErrActivelyDelegated struct{}
+
+// Error implements the error interface.
+func (ErrActivelyDelegated) Error() string {
+	return "DelegationManagerStorage: ActivelyDelegated()"
+}
+
+// ErrCallerCannotUndelegate is the typed form of the DelegationManagerStorage custom error CallerCannotUndelegate().
+type ErrCallerCannotUndelegate struct{}
+
+// Error implements the error interface.
+func (ErrCallerCannotUndelegate) Error() string {
+	return "DelegationManagerStorage: CallerCannotUndelegate()"
+}
+
+// ErrFullySlashed is the typed form of the DelegationManagerStorage custom error FullySlashed().
+type ErrFullySlashed struct{}
+
+// Error implements the error interface.
+func (ErrFullySlashed) Error() string {
+	return "DelegationManagerStorage: FullySlashed()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the DelegationManagerStorage custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "DelegationManagerStorage: InputArrayLengthMismatch()"
+}
+
+// ErrInputArrayLengthZero is the typed form of the DelegationManagerStorage custom error InputArrayLengthZero().
+type ErrInputArrayLengthZero struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthZero) Error() string {
+	return "DelegationManagerStorage: InputArrayLengthZero()"
+}
+
+// ErrInvalidSignature is the typed form of the DelegationManagerStorage custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "DelegationManagerStorage: InvalidSignature()"
+}
+
+// ErrNotActivelyDelegated is the typed form of the DelegationManagerStorage custom error NotActivelyDelegated().
+type ErrNotActivelyDelegated struct{}
+
+// Error implements the error interface.
+func (ErrNotActivelyDelegated) Error() string {
+	return "DelegationManagerStorage: NotActivelyDelegated()"
+}
+
+// ErrOnlyAllocationManager is the typed form of the DelegationManagerStorage custom error OnlyAllocationManager().
+type ErrOnlyAllocationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyAllocationManager) Error() string {
+	return "DelegationManagerStorage: OnlyAllocationManager()"
+}
+
+// ErrOnlyEigenPodManager is the typed form of the DelegationManagerStorage custom error OnlyEigenPodManager().
+type ErrOnlyEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodManager) Error() string {
+	return "DelegationManagerStorage: OnlyEigenPodManager()"
+}
+
+// ErrOnlyStrategyManagerOrEigenPodManager is the typed form of the DelegationManagerStorage custom error OnlyStrategyManagerOrEigenPodManager().
+type ErrOnlyStrategyManagerOrEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyManagerOrEigenPodManager) Error() string {
+	return "DelegationManagerStorage: OnlyStrategyManagerOrEigenPodManager()"
+}
+
+// ErrOperatorNotRegistered is the typed form of the DelegationManagerStorage custom error OperatorNotRegistered().
+type ErrOperatorNotRegistered struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegistered) Error() string {
+	return "DelegationManagerStorage: OperatorNotRegistered()"
+}
+
+// ErrOperatorsCannotUndelegate is the typed form of the DelegationManagerStorage custom error OperatorsCannotUndelegate().
+type ErrOperatorsCannotUndelegate struct{}
+
+// Error implements the error interface.
+func (ErrOperatorsCannotUndelegate) Error() string {
+	return "DelegationManagerStorage: OperatorsCannotUndelegate()"
+}
+
+// ErrSaltSpent is the typed form of the DelegationManagerStorage custom error SaltSpent().
+type ErrSaltSpent struct{}
+
+// Error implements the error interface.
+func (ErrSaltSpent) Error() string {
+	return "DelegationManagerStorage: SaltSpent()"
+}
+
+// ErrSignatureExpired is the typed form of the DelegationManagerStorage custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "DelegationManagerStorage: SignatureExpired()"
+}
+
+// ErrWithdrawalDelayNotElapsed is the typed form of the DelegationManagerStorage custom error WithdrawalDelayNotElapsed().
+type ErrWithdrawalDelayNotElapsed struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalDelayNotElapsed) Error() string {
+	return "DelegationManagerStorage: WithdrawalDelayNotElapsed()"
+}
+
+// ErrWithdrawalNotQueued is the typed form of the DelegationManagerStorage custom error WithdrawalNotQueued().
+type ErrWithdrawalNotQueued struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalNotQueued) Error() string {
+	return "DelegationManagerStorage: WithdrawalNotQueued()"
+}
+
+// ErrWithdrawerNotCaller is the typed form of the DelegationManagerStorage custom error WithdrawerNotCaller().
+type ErrWithdrawerNotCaller struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawerNotCaller) Error() string {
+	return "DelegationManagerStorage: WithdrawerNotCaller()"
+}
+
+// DelegationManagerStorageErrors maps the name of every custom error in the DelegationManagerStorage ABI to its typed Go error.
+var DelegationManagerStorageErrors = map[string]error{
+	"ActivelyDelegated":                    ErrActivelyDelegated{},
+	"CallerCannotUndelegate":               ErrCallerCannotUndelegate{},
+	"FullySlashed":                         ErrFullySlashed{},
+	"InputArrayLengthMismatch":             ErrInputArrayLengthMismatch{},
+	"InputArrayLengthZero":                 ErrInputArrayLengthZero{},
+	"InvalidSignature":                     ErrInvalidSignature{},
+	"NotActivelyDelegated":                 ErrNotActivelyDelegated{},
+	"OnlyAllocationManager":                ErrOnlyAllocationManager{},
+	"OnlyEigenPodManager":                  ErrOnlyEigenPodManager{},
+	"OnlyStrategyManagerOrEigenPodManager": ErrOnlyStrategyManagerOrEigenPodManager{},
+	"OperatorNotRegistered":                ErrOperatorNotRegistered{},
+	"OperatorsCannotUndelegate":            ErrOperatorsCannotUndelegate{},
+	"SaltSpent":                            ErrSaltSpent{},
+	"SignatureExpired":                     ErrSignatureExpired{},
+	"WithdrawalDelayNotElapsed":            ErrWithdrawalDelayNotElapsed{},
+	"WithdrawalNotQueued":                  ErrWithdrawalNotQueued{},
+	"WithdrawerNotCaller":                  ErrWithdrawerNotCaller{},
+}
@@ -0,0 +1,662 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManagerStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeDelegationManagerStorage is an in-memory DelegationManagerStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded DelegationManagerStorageFilterer.
+type FakeDelegationManagerStorage struct {
+	*fake.Contract
+	*DelegationManagerStorageFilterer
+}
+
+// NewFakeDelegationManagerStorage returns an empty fake of the contract at address.
+func NewFakeDelegationManagerStorage(address common.Address) *FakeDelegationManagerStorage {
+	contract := fake.NewContract(DelegationManagerStorageMetaData, address)
+	filterer, err := NewDelegationManagerStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeDelegationManagerStorage{Contract: contract, DelegationManagerStorageFilterer: filterer}
+}
+
+// DELEGATIONAPPROVALTYPEHASH returns the values stubbed with StubDELEGATIONAPPROVALTYPEHASH.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeDelegationManagerStorage.Contract.Read("DELEGATION_APPROVAL_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDELEGATIONAPPROVALTYPEHASH sets what DELEGATIONAPPROVALTYPEHASH returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubDELEGATIONAPPROVALTYPEHASH(out0 [32]byte) {
+	_FakeDelegationManagerStorage.Contract.Stub("DELEGATION_APPROVAL_TYPEHASH", []interface{}{}, out0)
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManagerStorage.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubAllocationManager(out0 common.Address) {
+	_FakeDelegationManagerStorage.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// BeaconChainETHStrategy returns the values stubbed with StubBeaconChainETHStrategy.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManagerStorage.Contract.Read("beaconChainETHStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainETHStrategy sets what BeaconChainETHStrategy returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubBeaconChainETHStrategy(out0 common.Address) {
+	_FakeDelegationManagerStorage.Contract.Stub("beaconChainETHStrategy", []interface{}{}, out0)
+}
+
+// CalculateDelegationApprovalDigestHash returns the values stubbed with StubCalculateDelegationApprovalDigestHash.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeDelegationManagerStorage.Contract.Read("calculateDelegationApprovalDigestHash", []interface{}{staker, operator, _delegationApprover, approverSalt, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateDelegationApprovalDigestHash sets what CalculateDelegationApprovalDigestHash returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubCalculateDelegationApprovalDigestHash(staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int, out0 [32]byte) {
+	_FakeDelegationManagerStorage.Contract.Stub("calculateDelegationApprovalDigestHash", []interface{}{staker, operator, _delegationApprover, approverSalt, expiry}, out0)
+}
+
+// CalculateWithdrawalRoot returns the values stubbed with StubCalculateWithdrawalRoot.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerTypesWithdrawal) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeDelegationManagerStorage.Contract.Read("calculateWithdrawalRoot", []interface{}{withdrawal}, &out0)
+	return out0, err
+}
+
+// StubCalculateWithdrawalRoot sets what CalculateWithdrawalRoot returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubCalculateWithdrawalRoot(withdrawal IDelegationManagerTypesWithdrawal, out0 [32]byte) {
+	_FakeDelegationManagerStorage.Contract.Stub("calculateWithdrawalRoot", []interface{}{withdrawal}, out0)
+}
+
+// ConvertToDepositShares returns the values stubbed with StubConvertToDepositShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) ConvertToDepositShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address, withdrawableShares []*big.Int) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeDelegationManagerStorage.Contract.Read("convertToDepositShares", []interface{}{staker, strategies, withdrawableShares}, &out0)
+	return out0, err
+}
+
+// StubConvertToDepositShares sets what ConvertToDepositShares returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubConvertToDepositShares(staker common.Address, strategies []common.Address, withdrawableShares []*big.Int, out0 []*big.Int) {
+	_FakeDelegationManagerStorage.Contract.Stub("convertToDepositShares", []interface{}{staker, strategies, withdrawableShares}, out0)
+}
+
+// CumulativeWithdrawalsQueued returns the values stubbed with StubCumulativeWithdrawalsQueued.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) CumulativeWithdrawalsQueued(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManagerStorage.Contract.Read("cumulativeWithdrawalsQueued", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubCumulativeWithdrawalsQueued sets what CumulativeWithdrawalsQueued returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubCumulativeWithdrawalsQueued(staker common.Address, out0 *big.Int) {
+	_FakeDelegationManagerStorage.Contract.Stub("cumulativeWithdrawalsQueued", []interface{}{staker}, out0)
+}
+
+// DelegatedTo returns the values stubbed with StubDelegatedTo.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManagerStorage.Contract.Read("delegatedTo", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubDelegatedTo sets what DelegatedTo returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubDelegatedTo(staker common.Address, out0 common.Address) {
+	_FakeDelegationManagerStorage.Contract.Stub("delegatedTo", []interface{}{staker}, out0)
+}
+
+// DelegationApprover returns the values stubbed with StubDelegationApprover.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManagerStorage.Contract.Read("delegationApprover", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubDelegationApprover sets what DelegationApprover returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubDelegationApprover(operator common.Address, out0 common.Address) {
+	_FakeDelegationManagerStorage.Contract.Stub("delegationApprover", []interface{}{operator}, out0)
+}
+
+// DelegationApproverSaltIsSpent returns the values stubbed with StubDelegationApproverSaltIsSpent.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) DelegationApproverSaltIsSpent(opts *bind.CallOpts, delegationApprover common.Address, salt [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManagerStorage.Contract.Read("delegationApproverSaltIsSpent", []interface{}{delegationApprover, salt}, &out0)
+	return out0, err
+}
+
+// StubDelegationApproverSaltIsSpent sets what DelegationApproverSaltIsSpent returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubDelegationApproverSaltIsSpent(delegationApprover common.Address, salt [32]byte, out0 bool) {
+	_FakeDelegationManagerStorage.Contract.Stub("delegationApproverSaltIsSpent", []interface{}{delegationApprover, salt}, out0)
+}
+
+// DepositScalingFactor returns the values stubbed with StubDepositScalingFactor.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) DepositScalingFactor(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManagerStorage.Contract.Read("depositScalingFactor", []interface{}{staker, strategy}, &out0)
+	return out0, err
+}
+
+// StubDepositScalingFactor sets what DepositScalingFactor returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubDepositScalingFactor(staker common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeDelegationManagerStorage.Contract.Stub("depositScalingFactor", []interface{}{staker, strategy}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeDelegationManagerStorage.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubDomainSeparator(out0 [32]byte) {
+	_FakeDelegationManagerStorage.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// EigenPodManager returns the values stubbed with StubEigenPodManager.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManagerStorage.Contract.Read("eigenPodManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenPodManager sets what EigenPodManager returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubEigenPodManager(out0 common.Address) {
+	_FakeDelegationManagerStorage.Contract.Stub("eigenPodManager", []interface{}{}, out0)
+}
+
+// GetDepositedShares returns the values stubbed with StubGetDepositedShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) GetDepositedShares(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeDelegationManagerStorage.Contract.Read("getDepositedShares", []interface{}{staker}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetDepositedShares sets what GetDepositedShares returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubGetDepositedShares(staker common.Address, out0 []common.Address, out1 []*big.Int) {
+	_FakeDelegationManagerStorage.Contract.Stub("getDepositedShares", []interface{}{staker}, out0, out1)
+}
+
+// GetOperatorShares returns the values stubbed with StubGetOperatorShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeDelegationManagerStorage.Contract.Read("getOperatorShares", []interface{}{operator, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorShares sets what GetOperatorShares returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubGetOperatorShares(operator common.Address, strategies []common.Address, out0 []*big.Int) {
+	_FakeDelegationManagerStorage.Contract.Stub("getOperatorShares", []interface{}{operator, strategies}, out0)
+}
+
+// GetOperatorsShares returns the values stubbed with StubGetOperatorsShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) GetOperatorsShares(opts *bind.CallOpts, operators []common.Address, strategies []common.Address) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeDelegationManagerStorage.Contract.Read("getOperatorsShares", []interface{}{operators, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorsShares sets what GetOperatorsShares returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubGetOperatorsShares(operators []common.Address, strategies []common.Address, out0 [][]*big.Int) {
+	_FakeDelegationManagerStorage.Contract.Stub("getOperatorsShares", []interface{}{operators, strategies}, out0)
+}
+
+// GetQueuedWithdrawal returns the values stubbed with StubGetQueuedWithdrawal.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) GetQueuedWithdrawal(opts *bind.CallOpts, withdrawalRoot [32]byte) (struct {
+	Withdrawal IDelegationManagerTypesWithdrawal
+	Shares     []*big.Int
+}, error) {
+	var out0 struct {
+		Withdrawal IDelegationManagerTypesWithdrawal
+		Shares     []*big.Int
+	}
+	err := _FakeDelegationManagerStorage.Contract.Read("getQueuedWithdrawal", []interface{}{withdrawalRoot}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawal sets what GetQueuedWithdrawal returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubGetQueuedWithdrawal(withdrawalRoot [32]byte, out0 struct {
+	Withdrawal IDelegationManagerTypesWithdrawal
+	Shares     []*big.Int
+}) {
+	_FakeDelegationManagerStorage.Contract.Stub("getQueuedWithdrawal", []interface{}{withdrawalRoot}, out0)
+}
+
+// GetQueuedWithdrawalRoots returns the values stubbed with StubGetQueuedWithdrawalRoots.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) GetQueuedWithdrawalRoots(opts *bind.CallOpts, staker common.Address) ([][32]byte, error) {
+	var out0 [][32]byte
+	err := _FakeDelegationManagerStorage.Contract.Read("getQueuedWithdrawalRoots", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawalRoots sets what GetQueuedWithdrawalRoots returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubGetQueuedWithdrawalRoots(staker common.Address, out0 [][32]byte) {
+	_FakeDelegationManagerStorage.Contract.Stub("getQueuedWithdrawalRoots", []interface{}{staker}, out0)
+}
+
+// GetQueuedWithdrawals returns the values stubbed with StubGetQueuedWithdrawals.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) GetQueuedWithdrawals(opts *bind.CallOpts, staker common.Address) (struct {
+	Withdrawals []IDelegationManagerTypesWithdrawal
+	Shares      [][]*big.Int
+}, error) {
+	var out0 struct {
+		Withdrawals []IDelegationManagerTypesWithdrawal
+		Shares      [][]*big.Int
+	}
+	err := _FakeDelegationManagerStorage.Contract.Read("getQueuedWithdrawals", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawals sets what GetQueuedWithdrawals returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubGetQueuedWithdrawals(staker common.Address, out0 struct {
+	Withdrawals []IDelegationManagerTypesWithdrawal
+	Shares      [][]*big.Int
+}) {
+	_FakeDelegationManagerStorage.Contract.Stub("getQueuedWithdrawals", []interface{}{staker}, out0)
+}
+
+// GetSlashableSharesInQueue returns the values stubbed with StubGetSlashableSharesInQueue.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) GetSlashableSharesInQueue(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManagerStorage.Contract.Read("getSlashableSharesInQueue", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetSlashableSharesInQueue sets what GetSlashableSharesInQueue returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubGetSlashableSharesInQueue(operator common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeDelegationManagerStorage.Contract.Stub("getSlashableSharesInQueue", []interface{}{operator, strategy}, out0)
+}
+
+// GetWithdrawableShares returns the values stubbed with StubGetWithdrawableShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) GetWithdrawableShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address) (struct {
+	WithdrawableShares []*big.Int
+	DepositShares      []*big.Int
+}, error) {
+	var out0 struct {
+		WithdrawableShares []*big.Int
+		DepositShares      []*big.Int
+	}
+	err := _FakeDelegationManagerStorage.Contract.Read("getWithdrawableShares", []interface{}{staker, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetWithdrawableShares sets what GetWithdrawableShares returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubGetWithdrawableShares(staker common.Address, strategies []common.Address, out0 struct {
+	WithdrawableShares []*big.Int
+	DepositShares      []*big.Int
+}) {
+	_FakeDelegationManagerStorage.Contract.Stub("getWithdrawableShares", []interface{}{staker, strategies}, out0)
+}
+
+// IsDelegated returns the values stubbed with StubIsDelegated.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManagerStorage.Contract.Read("isDelegated", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubIsDelegated sets what IsDelegated returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubIsDelegated(staker common.Address, out0 bool) {
+	_FakeDelegationManagerStorage.Contract.Stub("isDelegated", []interface{}{staker}, out0)
+}
+
+// IsOperator returns the values stubbed with StubIsOperator.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManagerStorage.Contract.Read("isOperator", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubIsOperator sets what IsOperator returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubIsOperator(operator common.Address, out0 bool) {
+	_FakeDelegationManagerStorage.Contract.Stub("isOperator", []interface{}{operator}, out0)
+}
+
+// MinWithdrawalDelayBlocks returns the values stubbed with StubMinWithdrawalDelayBlocks.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) MinWithdrawalDelayBlocks(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeDelegationManagerStorage.Contract.Read("minWithdrawalDelayBlocks", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMinWithdrawalDelayBlocks sets what MinWithdrawalDelayBlocks returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubMinWithdrawalDelayBlocks(out0 uint32) {
+	_FakeDelegationManagerStorage.Contract.Stub("minWithdrawalDelayBlocks", []interface{}{}, out0)
+}
+
+// OperatorShares returns the values stubbed with StubOperatorShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OperatorShares(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeDelegationManagerStorage.Contract.Read("operatorShares", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubOperatorShares sets what OperatorShares returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubOperatorShares(operator common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeDelegationManagerStorage.Contract.Stub("operatorShares", []interface{}{operator, strategy}, out0)
+}
+
+// PendingWithdrawals returns the values stubbed with StubPendingWithdrawals.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) PendingWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeDelegationManagerStorage.Contract.Read("pendingWithdrawals", []interface{}{withdrawalRoot}, &out0)
+	return out0, err
+}
+
+// StubPendingWithdrawals sets what PendingWithdrawals returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubPendingWithdrawals(withdrawalRoot [32]byte, out0 bool) {
+	_FakeDelegationManagerStorage.Contract.Stub("pendingWithdrawals", []interface{}{withdrawalRoot}, out0)
+}
+
+// QueuedWithdrawals returns the values stubbed with StubQueuedWithdrawals.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) QueuedWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (IDelegationManagerTypesWithdrawal, error) {
+	var out0 IDelegationManagerTypesWithdrawal
+	err := _FakeDelegationManagerStorage.Contract.Read("queuedWithdrawals", []interface{}{withdrawalRoot}, &out0)
+	return out0, err
+}
+
+// StubQueuedWithdrawals sets what QueuedWithdrawals returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubQueuedWithdrawals(withdrawalRoot [32]byte, out0 IDelegationManagerTypesWithdrawal) {
+	_FakeDelegationManagerStorage.Contract.Stub("queuedWithdrawals", []interface{}{withdrawalRoot}, out0)
+}
+
+// StrategyManager returns the values stubbed with StubStrategyManager.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeDelegationManagerStorage.Contract.Read("strategyManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyManager sets what StrategyManager returns for the given arguments.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) StubStrategyManager(out0 common.Address) {
+	_FakeDelegationManagerStorage.Contract.Stub("strategyManager", []interface{}{}, out0)
+}
+
+// CompleteQueuedWithdrawal records a completeQueuedWithdrawal call after running the hook set with OnCompleteQueuedWithdrawal.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "completeQueuedWithdrawal", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("completeQueuedWithdrawal").(func(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) error); ok {
+			return hook(opts, withdrawal, tokens, receiveAsTokens)
+		}
+		return nil
+	}, withdrawal, tokens, receiveAsTokens)
+}
+
+// OnCompleteQueuedWithdrawal sets a hook run by every CompleteQueuedWithdrawal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnCompleteQueuedWithdrawal(hook func(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("completeQueuedWithdrawal", hook)
+}
+
+// CompleteQueuedWithdrawals records a completeQueuedWithdrawals call after running the hook set with OnCompleteQueuedWithdrawals.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "completeQueuedWithdrawals", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("completeQueuedWithdrawals").(func(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) error); ok {
+			return hook(opts, withdrawals, tokens, receiveAsTokens)
+		}
+		return nil
+	}, withdrawals, tokens, receiveAsTokens)
+}
+
+// OnCompleteQueuedWithdrawals sets a hook run by every CompleteQueuedWithdrawals call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnCompleteQueuedWithdrawals(hook func(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("completeQueuedWithdrawals", hook)
+}
+
+// DecreaseDelegatedShares records a decreaseDelegatedShares call after running the hook set with OnDecreaseDelegatedShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "decreaseDelegatedShares", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("decreaseDelegatedShares").(func(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) error); ok {
+			return hook(opts, staker, curDepositShares, beaconChainSlashingFactorDecrease)
+		}
+		return nil
+	}, staker, curDepositShares, beaconChainSlashingFactorDecrease)
+}
+
+// OnDecreaseDelegatedShares sets a hook run by every DecreaseDelegatedShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnDecreaseDelegatedShares(hook func(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("decreaseDelegatedShares", hook)
+}
+
+// DelegateTo records a delegateTo call after running the hook set with OnDelegateTo.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "delegateTo", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("delegateTo").(func(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error); ok {
+			return hook(opts, operator, approverSignatureAndExpiry, approverSalt)
+		}
+		return nil
+	}, operator, approverSignatureAndExpiry, approverSalt)
+}
+
+// OnDelegateTo sets a hook run by every DelegateTo call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnDelegateTo(hook func(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("delegateTo", hook)
+}
+
+// IncreaseDelegatedShares records a increaseDelegatedShares call after running the hook set with OnIncreaseDelegatedShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "increaseDelegatedShares", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("increaseDelegatedShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) error); ok {
+			return hook(opts, staker, strategy, prevDepositShares, addedShares)
+		}
+		return nil
+	}, staker, strategy, prevDepositShares, addedShares)
+}
+
+// OnIncreaseDelegatedShares sets a hook run by every IncreaseDelegatedShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnIncreaseDelegatedShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("increaseDelegatedShares", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialPausedStatus)
+		}
+		return nil
+	}, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnInitialize(hook func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("initialize", hook)
+}
+
+// ModifyOperatorDetails records a modifyOperatorDetails call after running the hook set with OnModifyOperatorDetails.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) ModifyOperatorDetails(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "modifyOperatorDetails", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("modifyOperatorDetails").(func(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) error); ok {
+			return hook(opts, operator, newDelegationApprover)
+		}
+		return nil
+	}, operator, newDelegationApprover)
+}
+
+// OnModifyOperatorDetails sets a hook run by every ModifyOperatorDetails call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnModifyOperatorDetails(hook func(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("modifyOperatorDetails", hook)
+}
+
+// QueueWithdrawals records a queueWithdrawals call after running the hook set with OnQueueWithdrawals.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) QueueWithdrawals(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "queueWithdrawals", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("queueWithdrawals").(func(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) error); ok {
+			return hook(opts, params)
+		}
+		return nil
+	}, params)
+}
+
+// OnQueueWithdrawals sets a hook run by every QueueWithdrawals call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnQueueWithdrawals(hook func(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("queueWithdrawals", hook)
+}
+
+// Redelegate records a redelegate call after running the hook set with OnRedelegate.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) Redelegate(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "redelegate", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("redelegate").(func(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error); ok {
+			return hook(opts, newOperator, newOperatorApproverSig, approverSalt)
+		}
+		return nil
+	}, newOperator, newOperatorApproverSig, approverSalt)
+}
+
+// OnRedelegate sets a hook run by every Redelegate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnRedelegate(hook func(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("redelegate", hook)
+}
+
+// RegisterAsOperator records a registerAsOperator call after running the hook set with OnRegisterAsOperator.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) RegisterAsOperator(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "registerAsOperator", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("registerAsOperator").(func(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) error); ok {
+			return hook(opts, initDelegationApprover, allocationDelay, metadataURI)
+		}
+		return nil
+	}, initDelegationApprover, allocationDelay, metadataURI)
+}
+
+// OnRegisterAsOperator sets a hook run by every RegisterAsOperator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnRegisterAsOperator(hook func(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("registerAsOperator", hook)
+}
+
+// SlashOperatorShares records a slashOperatorShares call after running the hook set with OnSlashOperatorShares.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) SlashOperatorShares(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "slashOperatorShares", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("slashOperatorShares").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) error); ok {
+			return hook(opts, operator, operatorSet, slashId, strategy, prevMaxMagnitude, newMaxMagnitude)
+		}
+		return nil
+	}, operator, operatorSet, slashId, strategy, prevMaxMagnitude, newMaxMagnitude)
+}
+
+// OnSlashOperatorShares sets a hook run by every SlashOperatorShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnSlashOperatorShares(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("slashOperatorShares", hook)
+}
+
+// Undelegate records a undelegate call after running the hook set with OnUndelegate.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "undelegate", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("undelegate").(func(opts *bind.TransactOpts, staker common.Address) error); ok {
+			return hook(opts, staker)
+		}
+		return nil
+	}, staker)
+}
+
+// OnUndelegate sets a hook run by every Undelegate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnUndelegate(hook func(opts *bind.TransactOpts, staker common.Address) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("undelegate", hook)
+}
+
+// UpdateOperatorMetadataURI records a updateOperatorMetadataURI call after running the hook set with OnUpdateOperatorMetadataURI.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) UpdateOperatorMetadataURI(opts *bind.TransactOpts, operator common.Address, metadataURI string) (*types.Transaction, error) {
+	return _FakeDelegationManagerStorage.Contract.Transact(opts, "updateOperatorMetadataURI", func() error {
+		if hook, ok := _FakeDelegationManagerStorage.Contract.Hook("updateOperatorMetadataURI").(func(opts *bind.TransactOpts, operator common.Address, metadataURI string) error); ok {
+			return hook(opts, operator, metadataURI)
+		}
+		return nil
+	}, operator, metadataURI)
+}
+
+// OnUpdateOperatorMetadataURI sets a hook run by every UpdateOperatorMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) OnUpdateOperatorMetadataURI(hook func(opts *bind.TransactOpts, operator common.Address, metadataURI string) error) {
+	_FakeDelegationManagerStorage.Contract.SetHook("updateOperatorMetadataURI", hook)
+}
+
+// EmitDelegationApproverUpdated appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitDelegationApproverUpdated(event *DelegationManagerStorageDelegationApproverUpdated) {
+	_FakeDelegationManagerStorage.Contract.Emit("DelegationApproverUpdated", event)
+}
+
+// EmitDepositScalingFactorUpdated appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitDepositScalingFactorUpdated(event *DelegationManagerStorageDepositScalingFactorUpdated) {
+	_FakeDelegationManagerStorage.Contract.Emit("DepositScalingFactorUpdated", event)
+}
+
+// EmitOperatorMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitOperatorMetadataURIUpdated(event *DelegationManagerStorageOperatorMetadataURIUpdated) {
+	_FakeDelegationManagerStorage.Contract.Emit("OperatorMetadataURIUpdated", event)
+}
+
+// EmitOperatorRegistered appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitOperatorRegistered(event *DelegationManagerStorageOperatorRegistered) {
+	_FakeDelegationManagerStorage.Contract.Emit("OperatorRegistered", event)
+}
+
+// EmitOperatorSharesDecreased appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitOperatorSharesDecreased(event *DelegationManagerStorageOperatorSharesDecreased) {
+	_FakeDelegationManagerStorage.Contract.Emit("OperatorSharesDecreased", event)
+}
+
+// EmitOperatorSharesIncreased appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitOperatorSharesIncreased(event *DelegationManagerStorageOperatorSharesIncreased) {
+	_FakeDelegationManagerStorage.Contract.Emit("OperatorSharesIncreased", event)
+}
+
+// EmitOperatorSharesSlashed appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitOperatorSharesSlashed(event *DelegationManagerStorageOperatorSharesSlashed) {
+	_FakeDelegationManagerStorage.Contract.Emit("OperatorSharesSlashed", event)
+}
+
+// EmitSlashingWithdrawalCompleted appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitSlashingWithdrawalCompleted(event *DelegationManagerStorageSlashingWithdrawalCompleted) {
+	_FakeDelegationManagerStorage.Contract.Emit("SlashingWithdrawalCompleted", event)
+}
+
+// EmitSlashingWithdrawalQueued appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitSlashingWithdrawalQueued(event *DelegationManagerStorageSlashingWithdrawalQueued) {
+	_FakeDelegationManagerStorage.Contract.Emit("SlashingWithdrawalQueued", event)
+}
+
+// EmitStakerDelegated appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitStakerDelegated(event *DelegationManagerStorageStakerDelegated) {
+	_FakeDelegationManagerStorage.Contract.Emit("StakerDelegated", event)
+}
+
+// EmitStakerForceUndelegated appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitStakerForceUndelegated(event *DelegationManagerStorageStakerForceUndelegated) {
+	_FakeDelegationManagerStorage.Contract.Emit("StakerForceUndelegated", event)
+}
+
+// EmitStakerUndelegated appends the event to the fake's logs.
+func (_FakeDelegationManagerStorage *FakeDelegationManagerStorage) EmitStakerUndelegated(event *DelegationManagerStorageStakerUndelegated) {
+	_FakeDelegationManagerStorage.Contract.Emit("StakerUndelegated", event)
+}
@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package DelegationManagerStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// DelegationManagerStorageReader is the read-only surface of the DelegationManagerStorage
+// binding, implemented by DelegationManagerStorageCaller, DelegationManagerStorage and FakeDelegationManagerStorage.
+type DelegationManagerStorageReader interface {
+	DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	AllocationManager(opts *bind.CallOpts) (common.Address, error)
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error)
+	CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerTypesWithdrawal) ([32]byte, error)
+	ConvertToDepositShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address, withdrawableShares []*big.Int) ([]*big.Int, error)
+	CumulativeWithdrawalsQueued(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error)
+	DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error)
+	DelegationApproverSaltIsSpent(opts *bind.CallOpts, delegationApprover common.Address, salt [32]byte) (bool, error)
+	DepositScalingFactor(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetDepositedShares(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error)
+	GetOperatorsShares(opts *bind.CallOpts, operators []common.Address, strategies []common.Address) ([][]*big.Int, error)
+	GetQueuedWithdrawal(opts *bind.CallOpts, withdrawalRoot [32]byte) (struct {
+		Withdrawal IDelegationManagerTypesWithdrawal
+		Shares     []*big.Int
+	}, error)
+	GetQueuedWithdrawalRoots(opts *bind.CallOpts, staker common.Address) ([][32]byte, error)
+	GetQueuedWithdrawals(opts *bind.CallOpts, staker common.Address) (struct {
+		Withdrawals []IDelegationManagerTypesWithdrawal
+		Shares      [][]*big.Int
+	}, error)
+	GetSlashableSharesInQueue(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error)
+	GetWithdrawableShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address) (struct {
+		WithdrawableShares []*big.Int
+		DepositShares      []*big.Int
+	}, error)
+	IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error)
+	IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error)
+	MinWithdrawalDelayBlocks(opts *bind.CallOpts) (uint32, error)
+	OperatorShares(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error)
+	PendingWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (bool, error)
+	QueuedWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (IDelegationManagerTypesWithdrawal, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+}
+
+// DelegationManagerStorageWriter is the transacting surface of the DelegationManagerStorage
+// binding, implemented by DelegationManagerStorageTransactor, DelegationManagerStorage and FakeDelegationManagerStorage.
+type DelegationManagerStorageWriter interface {
+	CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) (*types.Transaction, error)
+	CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) (*types.Transaction, error)
+	DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) (*types.Transaction, error)
+	DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error)
+	ModifyOperatorDetails(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) (*types.Transaction, error)
+	QueueWithdrawals(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) (*types.Transaction, error)
+	Redelegate(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	RegisterAsOperator(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) (*types.Transaction, error)
+	SlashOperatorShares(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) (*types.Transaction, error)
+	Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error)
+	UpdateOperatorMetadataURI(opts *bind.TransactOpts, operator common.Address, metadataURI string) (*types.Transaction, error)
+}
+
+// DelegationManagerStorageEvents is the event filtering surface of the DelegationManagerStorage
+// binding, implemented by DelegationManagerStorageFilterer, DelegationManagerStorage and FakeDelegationManagerStorage.
+type DelegationManagerStorageEvents interface {
+	FilterDelegationApproverUpdated(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageDelegationApproverUpdatedIterator, error)
+	WatchDelegationApproverUpdated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageDelegationApproverUpdated, operator []common.Address) (event.Subscription, error)
+	ParseDelegationApproverUpdated(log types.Log) (*DelegationManagerStorageDelegationApproverUpdated, error)
+	FilterDepositScalingFactorUpdated(opts *bind.FilterOpts) (*DelegationManagerStorageDepositScalingFactorUpdatedIterator, error)
+	WatchDepositScalingFactorUpdated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageDepositScalingFactorUpdated) (event.Subscription, error)
+	ParseDepositScalingFactorUpdated(log types.Log) (*DelegationManagerStorageDepositScalingFactorUpdated, error)
+	FilterOperatorMetadataURIUpdated(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorMetadataURIUpdatedIterator, error)
+	WatchOperatorMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorMetadataURIUpdated, operator []common.Address) (event.Subscription, error)
+	ParseOperatorMetadataURIUpdated(log types.Log) (*DelegationManagerStorageOperatorMetadataURIUpdated, error)
+	FilterOperatorRegistered(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorRegisteredIterator, error)
+	WatchOperatorRegistered(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorRegistered, operator []common.Address) (event.Subscription, error)
+	ParseOperatorRegistered(log types.Log) (*DelegationManagerStorageOperatorRegistered, error)
+	FilterOperatorSharesDecreased(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorSharesDecreasedIterator, error)
+	WatchOperatorSharesDecreased(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorSharesDecreased, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesDecreased(log types.Log) (*DelegationManagerStorageOperatorSharesDecreased, error)
+	FilterOperatorSharesIncreased(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorSharesIncreasedIterator, error)
+	WatchOperatorSharesIncreased(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorSharesIncreased, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesIncreased(log types.Log) (*DelegationManagerStorageOperatorSharesIncreased, error)
+	FilterOperatorSharesSlashed(opts *bind.FilterOpts, operator []common.Address) (*DelegationManagerStorageOperatorSharesSlashedIterator, error)
+	WatchOperatorSharesSlashed(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageOperatorSharesSlashed, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesSlashed(log types.Log) (*DelegationManagerStorageOperatorSharesSlashed, error)
+	FilterSlashingWithdrawalCompleted(opts *bind.FilterOpts) (*DelegationManagerStorageSlashingWithdrawalCompletedIterator, error)
+	WatchSlashingWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageSlashingWithdrawalCompleted) (event.Subscription, error)
+	ParseSlashingWithdrawalCompleted(log types.Log) (*DelegationManagerStorageSlashingWithdrawalCompleted, error)
+	FilterSlashingWithdrawalQueued(opts *bind.FilterOpts) (*DelegationManagerStorageSlashingWithdrawalQueuedIterator, error)
+	WatchSlashingWithdrawalQueued(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageSlashingWithdrawalQueued) (event.Subscription, error)
+	ParseSlashingWithdrawalQueued(log types.Log) (*DelegationManagerStorageSlashingWithdrawalQueued, error)
+	FilterStakerDelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStorageStakerDelegatedIterator, error)
+	WatchStakerDelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageStakerDelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerDelegated(log types.Log) (*DelegationManagerStorageStakerDelegated, error)
+	FilterStakerForceUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStorageStakerForceUndelegatedIterator, error)
+	WatchStakerForceUndelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageStakerForceUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerForceUndelegated(log types.Log) (*DelegationManagerStorageStakerForceUndelegated, error)
+	FilterStakerUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*DelegationManagerStorageStakerUndelegatedIterator, error)
+	WatchStakerUndelegated(opts *bind.WatchOpts, sink chan<- *DelegationManagerStorageStakerUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerUndelegated(log types.Log) (*DelegationManagerStorageStakerUndelegated, error)
+}
+
+var (
+	_ DelegationManagerStorageReader = (*DelegationManagerStorageCaller)(nil)
+	_ DelegationManagerStorageReader = (*DelegationManagerStorage)(nil)
+	_ DelegationManagerStorageReader = (*FakeDelegationManagerStorage)(nil)
+	_ DelegationManagerStorageWriter = (*DelegationManagerStorageTransactor)(nil)
+	_ DelegationManagerStorageWriter = (*DelegationManagerStorage)(nil)
+	_ DelegationManagerStorageWriter = (*FakeDelegationManagerStorage)(nil)
+	_ DelegationManagerStorageEvents = (*DelegationManagerStorageFilterer)(nil)
+	_ DelegationManagerStorageEvents = (*DelegationManagerStorage)(nil)
+	_ DelegationManagerStorageEvents = (*FakeDelegationManagerStorage)(nil)
+)
@@ -0,0 +1,32 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Deprecated_OwnableUpgradeable
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeDeprecatedOwnableUpgradeable is an in-memory DeprecatedOwnableUpgradeable for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded DeprecatedOwnableUpgradeableFilterer.
+type FakeDeprecatedOwnableUpgradeable struct {
+	*fake.Contract
+	*DeprecatedOwnableUpgradeableFilterer
+}
+
+// NewFakeDeprecatedOwnableUpgradeable returns an empty fake of the contract at address.
+func NewFakeDeprecatedOwnableUpgradeable(address common.Address) *FakeDeprecatedOwnableUpgradeable {
+	contract := fake.NewContract(DeprecatedOwnableUpgradeableMetaData, address)
+	filterer, err := NewDeprecatedOwnableUpgradeableFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeDeprecatedOwnableUpgradeable{Contract: contract, DeprecatedOwnableUpgradeableFilterer: filterer}
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeDeprecatedOwnableUpgradeable *FakeDeprecatedOwnableUpgradeable) EmitInitialized(event *DeprecatedOwnableUpgradeableInitialized) {
+	_FakeDeprecatedOwnableUpgradeable.Contract.Emit("Initialized", event)
+}
@@ -0,0 +1,23 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Deprecated_OwnableUpgradeable
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// DeprecatedOwnableUpgradeableEvents is the event filtering surface of the Deprecated_OwnableUpgradeable
+// binding, implemented by DeprecatedOwnableUpgradeableFilterer, DeprecatedOwnableUpgradeable and FakeDeprecatedOwnableUpgradeable.
+type DeprecatedOwnableUpgradeableEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*DeprecatedOwnableUpgradeableInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *DeprecatedOwnableUpgradeableInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*DeprecatedOwnableUpgradeableInitialized, error)
+}
+
+var (
+	_ DeprecatedOwnableUpgradeableEvents = (*DeprecatedOwnableUpgradeableFilterer)(nil)
+	_ DeprecatedOwnableUpgradeableEvents = (*DeprecatedOwnableUpgradeable)(nil)
+	_ DeprecatedOwnableUpgradeableEvents = (*FakeDeprecatedOwnableUpgradeable)(nil)
+)
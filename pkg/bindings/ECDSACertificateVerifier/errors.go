@@ -0,0 +1,148 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ECDSACertificateVerifier
+
+import (
+	"fmt"
+)
+
+// ErrArrayLengthMismatch is the typed form of the ECDSACertificateVerifier custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "ECDSACertificateVerifier: ArrayLengthMismatch()"
+}
+
+// ErrCertificateStale is the typed form of the ECDSACertificateVerifier custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "ECDSACertificateVerifier: CertificateStale()"
+}
+
+// ErrIndexOutOfBounds is the typed form of the ECDSACertificateVerifier custom error IndexOutOfBounds().
+type ErrIndexOutOfBounds struct{}
+
+// Error implements the error interface.
+func (ErrIndexOutOfBounds) Error() string {
+	return "ECDSACertificateVerifier: IndexOutOfBounds()"
+}
+
+// ErrInvalidShortString is the typed form of the ECDSACertificateVerifier custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "ECDSACertificateVerifier: InvalidShortString()"
+}
+
+// ErrInvalidSignature is the typed form of the ECDSACertificateVerifier custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "ECDSACertificateVerifier: InvalidSignature()"
+}
+
+// ErrInvalidSignatureLength is the typed form of the ECDSACertificateVerifier custom error InvalidSignatureLength().
+type ErrInvalidSignatureLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignatureLength) Error() string {
+	return "ECDSACertificateVerifier: InvalidSignatureLength()"
+}
+
+// ErrOnlyTableUpdater is the typed form of the ECDSACertificateVerifier custom error OnlyTableUpdater().
+type ErrOnlyTableUpdater struct{}
+
+// Error implements the error interface.
+func (ErrOnlyTableUpdater) Error() string {
+	return "ECDSACertificateVerifier: OnlyTableUpdater()"
+}
+
+// ErrOperatorCountZero is the typed form of the ECDSACertificateVerifier custom error OperatorCountZero().
+type ErrOperatorCountZero struct{}
+
+// Error implements the error interface.
+func (ErrOperatorCountZero) Error() string {
+	return "ECDSACertificateVerifier: OperatorCountZero()"
+}
+
+// ErrReferenceTimestampDoesNotExist is the typed form of the ECDSACertificateVerifier custom error ReferenceTimestampDoesNotExist().
+type ErrReferenceTimestampDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrReferenceTimestampDoesNotExist) Error() string {
+	return "ECDSACertificateVerifier: ReferenceTimestampDoesNotExist()"
+}
+
+// ErrRootDisabled is the typed form of the ECDSACertificateVerifier custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "ECDSACertificateVerifier: RootDisabled()"
+}
+
+// ErrSignatureExpired is the typed form of the ECDSACertificateVerifier custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "ECDSACertificateVerifier: SignatureExpired()"
+}
+
+// ErrSignersNotOrdered is the typed form of the ECDSACertificateVerifier custom error SignersNotOrdered().
+type ErrSignersNotOrdered struct{}
+
+// Error implements the error interface.
+func (ErrSignersNotOrdered) Error() string {
+	return "ECDSACertificateVerifier: SignersNotOrdered()"
+}
+
+// ErrStringTooLong is the typed form of the ECDSACertificateVerifier custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("ECDSACertificateVerifier: StringTooLong(str: %v)", e.Str)
+}
+
+// ErrTableUpdateStale is the typed form of the ECDSACertificateVerifier custom error TableUpdateStale().
+type ErrTableUpdateStale struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateStale) Error() string {
+	return "ECDSACertificateVerifier: TableUpdateStale()"
+}
+
+// ErrVerificationFailed is the typed form of the ECDSACertificateVerifier custom error VerificationFailed().
+type ErrVerificationFailed struct{}
+
+// Error implements the error interface.
+func (ErrVerificationFailed) Error() string {
+	return "ECDSACertificateVerifier: VerificationFailed()"
+}
+
+// ECDSACertificateVerifierErrors maps the name of every custom error in the ECDSACertificateVerifier ABI to its typed Go error.
+var ECDSACertificateVerifierErrors = map[string]error{
+	"ArrayLengthMismatch":            ErrArrayLengthMismatch{},
+	"CertificateStale":               ErrCertificateStale{},
+	"IndexOutOfBounds":               ErrIndexOutOfBounds{},
+	"InvalidShortString":             ErrInvalidShortString{},
+	"InvalidSignature":               ErrInvalidSignature{},
+	"InvalidSignatureLength":         ErrInvalidSignatureLength{},
+	"OnlyTableUpdater":               ErrOnlyTableUpdater{},
+	"OperatorCountZero":              ErrOperatorCountZero{},
+	"ReferenceTimestampDoesNotExist": ErrReferenceTimestampDoesNotExist{},
+	"RootDisabled":                   ErrRootDisabled{},
+	"SignatureExpired":               ErrSignatureExpired{},
+	"SignersNotOrdered":              ErrSignersNotOrdered{},
+	"StringTooLong":                  ErrStringTooLong{},
+	"TableUpdateStale":               ErrTableUpdateStale{},
+	"VerificationFailed":             ErrVerificationFailed{},
+}
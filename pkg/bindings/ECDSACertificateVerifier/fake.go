@@ -0,0 +1,261 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ECDSACertificateVerifier
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeECDSACertificateVerifier is an in-memory ECDSACertificateVerifier for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ECDSACertificateVerifierFilterer.
+type FakeECDSACertificateVerifier struct {
+	*fake.Contract
+	*ECDSACertificateVerifierFilterer
+}
+
+// NewFakeECDSACertificateVerifier returns an empty fake of the contract at address.
+func NewFakeECDSACertificateVerifier(address common.Address) *FakeECDSACertificateVerifier {
+	contract := fake.NewContract(ECDSACertificateVerifierMetaData, address)
+	filterer, err := NewECDSACertificateVerifierFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeECDSACertificateVerifier{Contract: contract, ECDSACertificateVerifierFilterer: filterer}
+}
+
+// CalculateCertificateDigest returns the values stubbed with StubCalculateCertificateDigest.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeECDSACertificateVerifier.Contract.Read("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigest sets what CalculateCertificateDigest returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubCalculateCertificateDigest(referenceTimestamp uint32, messageHash [32]byte, out0 [32]byte) {
+	_FakeECDSACertificateVerifier.Contract.Stub("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// CalculateCertificateDigestBytes returns the values stubbed with StubCalculateCertificateDigestBytes.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) CalculateCertificateDigestBytes(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([]byte, error) {
+	var out0 []byte
+	err := _FakeECDSACertificateVerifier.Contract.Read("calculateCertificateDigestBytes", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigestBytes sets what CalculateCertificateDigestBytes returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubCalculateCertificateDigestBytes(referenceTimestamp uint32, messageHash [32]byte, out0 []byte) {
+	_FakeECDSACertificateVerifier.Contract.Stub("calculateCertificateDigestBytes", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeECDSACertificateVerifier.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubDomainSeparator(out0 [32]byte) {
+	_FakeECDSACertificateVerifier.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// GetOperatorCount returns the values stubbed with StubGetOperatorCount.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeECDSACertificateVerifier.Contract.Read("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorCount sets what GetOperatorCount returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubGetOperatorCount(operatorSet OperatorSet, referenceTimestamp uint32, out0 *big.Int) {
+	_FakeECDSACertificateVerifier.Contract.Stub("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorInfo returns the values stubbed with StubGetOperatorInfo.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) GetOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesECDSAOperatorInfo, error) {
+	var out0 IOperatorTableCalculatorTypesECDSAOperatorInfo
+	err := _FakeECDSACertificateVerifier.Contract.Read("getOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorInfo sets what GetOperatorInfo returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubGetOperatorInfo(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 IOperatorTableCalculatorTypesECDSAOperatorInfo) {
+	_FakeECDSACertificateVerifier.Contract.Stub("getOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// GetOperatorInfos returns the values stubbed with StubGetOperatorInfos.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) GetOperatorInfos(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]IOperatorTableCalculatorTypesECDSAOperatorInfo, error) {
+	var out0 []IOperatorTableCalculatorTypesECDSAOperatorInfo
+	err := _FakeECDSACertificateVerifier.Contract.Read("getOperatorInfos", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorInfos sets what GetOperatorInfos returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubGetOperatorInfos(operatorSet OperatorSet, referenceTimestamp uint32, out0 []IOperatorTableCalculatorTypesECDSAOperatorInfo) {
+	_FakeECDSACertificateVerifier.Contract.Stub("getOperatorInfos", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetOwner returns the values stubbed with StubGetOperatorSetOwner.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeECDSACertificateVerifier.Contract.Read("getOperatorSetOwner", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetOwner sets what GetOperatorSetOwner returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubGetOperatorSetOwner(operatorSet OperatorSet, out0 common.Address) {
+	_FakeECDSACertificateVerifier.Contract.Stub("getOperatorSetOwner", []interface{}{operatorSet}, out0)
+}
+
+// GetTotalStakeWeights returns the values stubbed with StubGetTotalStakeWeights.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeECDSACertificateVerifier.Contract.Read("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetTotalStakeWeights sets what GetTotalStakeWeights returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubGetTotalStakeWeights(operatorSet OperatorSet, referenceTimestamp uint32, out0 []*big.Int) {
+	_FakeECDSACertificateVerifier.Contract.Stub("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// IsReferenceTimestampSet returns the values stubbed with StubIsReferenceTimestampSet.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeECDSACertificateVerifier.Contract.Read("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsReferenceTimestampSet sets what IsReferenceTimestampSet returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubIsReferenceTimestampSet(operatorSet OperatorSet, referenceTimestamp uint32, out0 bool) {
+	_FakeECDSACertificateVerifier.Contract.Stub("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// LatestReferenceTimestamp returns the values stubbed with StubLatestReferenceTimestamp.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeECDSACertificateVerifier.Contract.Read("latestReferenceTimestamp", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubLatestReferenceTimestamp sets what LatestReferenceTimestamp returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubLatestReferenceTimestamp(operatorSet OperatorSet, out0 uint32) {
+	_FakeECDSACertificateVerifier.Contract.Stub("latestReferenceTimestamp", []interface{}{operatorSet}, out0)
+}
+
+// MaxOperatorTableStaleness returns the values stubbed with StubMaxOperatorTableStaleness.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeECDSACertificateVerifier.Contract.Read("maxOperatorTableStaleness", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubMaxOperatorTableStaleness sets what MaxOperatorTableStaleness returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubMaxOperatorTableStaleness(operatorSet OperatorSet, out0 uint32) {
+	_FakeECDSACertificateVerifier.Contract.Stub("maxOperatorTableStaleness", []interface{}{operatorSet}, out0)
+}
+
+// OperatorTableUpdater returns the values stubbed with StubOperatorTableUpdater.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) OperatorTableUpdater(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeECDSACertificateVerifier.Contract.Read("operatorTableUpdater", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOperatorTableUpdater sets what OperatorTableUpdater returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubOperatorTableUpdater(out0 common.Address) {
+	_FakeECDSACertificateVerifier.Contract.Stub("operatorTableUpdater", []interface{}{}, out0)
+}
+
+// VerifyCertificate returns the values stubbed with StubVerifyCertificate.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) VerifyCertificate(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate) ([]*big.Int, []common.Address, error) {
+	var out0 []*big.Int
+	var out1 []common.Address
+	err := _FakeECDSACertificateVerifier.Contract.Read("verifyCertificate", []interface{}{operatorSet, cert}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubVerifyCertificate sets what VerifyCertificate returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubVerifyCertificate(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, out0 []*big.Int, out1 []common.Address) {
+	_FakeECDSACertificateVerifier.Contract.Stub("verifyCertificate", []interface{}{operatorSet, cert}, out0, out1)
+}
+
+// VerifyCertificateNominal returns the values stubbed with StubVerifyCertificateNominal.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) VerifyCertificateNominal(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int) (bool, []common.Address, error) {
+	var out0 bool
+	var out1 []common.Address
+	err := _FakeECDSACertificateVerifier.Contract.Read("verifyCertificateNominal", []interface{}{operatorSet, cert, totalStakeNominalThresholds}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubVerifyCertificateNominal sets what VerifyCertificateNominal returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubVerifyCertificateNominal(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int, out0 bool, out1 []common.Address) {
+	_FakeECDSACertificateVerifier.Contract.Stub("verifyCertificateNominal", []interface{}{operatorSet, cert, totalStakeNominalThresholds}, out0, out1)
+}
+
+// VerifyCertificateProportion returns the values stubbed with StubVerifyCertificateProportion.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) VerifyCertificateProportion(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16) (bool, []common.Address, error) {
+	var out0 bool
+	var out1 []common.Address
+	err := _FakeECDSACertificateVerifier.Contract.Read("verifyCertificateProportion", []interface{}{operatorSet, cert, totalStakeProportionThresholds}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubVerifyCertificateProportion sets what VerifyCertificateProportion returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubVerifyCertificateProportion(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16, out0 bool, out1 []common.Address) {
+	_FakeECDSACertificateVerifier.Contract.Stub("verifyCertificateProportion", []interface{}{operatorSet, cert, totalStakeProportionThresholds}, out0, out1)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeECDSACertificateVerifier.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) StubVersion(out0 string) {
+	_FakeECDSACertificateVerifier.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeECDSACertificateVerifier.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeECDSACertificateVerifier.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, referenceTimestamp, operatorInfos, operatorSetConfig)
+		}
+		return nil
+	}, operatorSet, referenceTimestamp, operatorInfos, operatorSetConfig)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeECDSACertificateVerifier.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) EmitInitialized(event *ECDSACertificateVerifierInitialized) {
+	_FakeECDSACertificateVerifier.Contract.Emit("Initialized", event)
+}
+
+// EmitMaxStalenessPeriodUpdated appends the event to the fake's logs.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) EmitMaxStalenessPeriodUpdated(event *ECDSACertificateVerifierMaxStalenessPeriodUpdated) {
+	_FakeECDSACertificateVerifier.Contract.Emit("MaxStalenessPeriodUpdated", event)
+}
+
+// EmitOperatorSetOwnerUpdated appends the event to the fake's logs.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) EmitOperatorSetOwnerUpdated(event *ECDSACertificateVerifierOperatorSetOwnerUpdated) {
+	_FakeECDSACertificateVerifier.Contract.Emit("OperatorSetOwnerUpdated", event)
+}
+
+// EmitTableUpdated appends the event to the fake's logs.
+func (_FakeECDSACertificateVerifier *FakeECDSACertificateVerifier) EmitTableUpdated(event *ECDSACertificateVerifierTableUpdated) {
+	_FakeECDSACertificateVerifier.Contract.Emit("TableUpdated", event)
+}
@@ -0,0 +1,67 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ECDSACertificateVerifier
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// ECDSACertificateVerifierReader is the read-only surface of the ECDSACertificateVerifier
+// binding, implemented by ECDSACertificateVerifierCaller, ECDSACertificateVerifier and FakeECDSACertificateVerifier.
+type ECDSACertificateVerifierReader interface {
+	CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error)
+	CalculateCertificateDigestBytes(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([]byte, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error)
+	GetOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesECDSAOperatorInfo, error)
+	GetOperatorInfos(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]IOperatorTableCalculatorTypesECDSAOperatorInfo, error)
+	GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error)
+	IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error)
+	LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	OperatorTableUpdater(opts *bind.CallOpts) (common.Address, error)
+	VerifyCertificate(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate) ([]*big.Int, []common.Address, error)
+	VerifyCertificateNominal(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int) (bool, []common.Address, error)
+	VerifyCertificateProportion(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16) (bool, []common.Address, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+// ECDSACertificateVerifierWriter is the transacting surface of the ECDSACertificateVerifier
+// binding, implemented by ECDSACertificateVerifierTransactor, ECDSACertificateVerifier and FakeECDSACertificateVerifier.
+type ECDSACertificateVerifierWriter interface {
+	UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+}
+
+// ECDSACertificateVerifierEvents is the event filtering surface of the ECDSACertificateVerifier
+// binding, implemented by ECDSACertificateVerifierFilterer, ECDSACertificateVerifier and FakeECDSACertificateVerifier.
+type ECDSACertificateVerifierEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*ECDSACertificateVerifierInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ECDSACertificateVerifierInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*ECDSACertificateVerifierInitialized, error)
+	FilterMaxStalenessPeriodUpdated(opts *bind.FilterOpts) (*ECDSACertificateVerifierMaxStalenessPeriodUpdatedIterator, error)
+	WatchMaxStalenessPeriodUpdated(opts *bind.WatchOpts, sink chan<- *ECDSACertificateVerifierMaxStalenessPeriodUpdated) (event.Subscription, error)
+	ParseMaxStalenessPeriodUpdated(log types.Log) (*ECDSACertificateVerifierMaxStalenessPeriodUpdated, error)
+	FilterOperatorSetOwnerUpdated(opts *bind.FilterOpts) (*ECDSACertificateVerifierOperatorSetOwnerUpdatedIterator, error)
+	WatchOperatorSetOwnerUpdated(opts *bind.WatchOpts, sink chan<- *ECDSACertificateVerifierOperatorSetOwnerUpdated) (event.Subscription, error)
+	ParseOperatorSetOwnerUpdated(log types.Log) (*ECDSACertificateVerifierOperatorSetOwnerUpdated, error)
+	FilterTableUpdated(opts *bind.FilterOpts) (*ECDSACertificateVerifierTableUpdatedIterator, error)
+	WatchTableUpdated(opts *bind.WatchOpts, sink chan<- *ECDSACertificateVerifierTableUpdated) (event.Subscription, error)
+	ParseTableUpdated(log types.Log) (*ECDSACertificateVerifierTableUpdated, error)
+}
+
+var (
+	_ ECDSACertificateVerifierReader = (*ECDSACertificateVerifierCaller)(nil)
+	_ ECDSACertificateVerifierReader = (*ECDSACertificateVerifier)(nil)
+	_ ECDSACertificateVerifierReader = (*FakeECDSACertificateVerifier)(nil)
+	_ ECDSACertificateVerifierWriter = (*ECDSACertificateVerifierTransactor)(nil)
+	_ ECDSACertificateVerifierWriter = (*ECDSACertificateVerifier)(nil)
+	_ ECDSACertificateVerifierWriter = (*FakeECDSACertificateVerifier)(nil)
+	_ ECDSACertificateVerifierEvents = (*ECDSACertificateVerifierFilterer)(nil)
+	_ ECDSACertificateVerifierEvents = (*ECDSACertificateVerifier)(nil)
+	_ ECDSACertificateVerifierEvents = (*FakeECDSACertificateVerifier)(nil)
+)
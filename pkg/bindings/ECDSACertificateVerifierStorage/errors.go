@@ -0,0 +1,106 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ECDSACertificateVerifierStorage
+
+// ErrArrayLengthMismatch is the typed form of the ECDSACertificateVerifierStorage custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "ECDSACertificateVerifierStorage: ArrayLengthMismatch()"
+}
+
+// ErrCertificateStale is the typed form of the ECDSACertificateVerifierStorage custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "ECDSACertificateVerifierStorage: CertificateStale()"
+}
+
+// ErrIndexOutOfBounds is the typed form of the ECDSACertificateVerifierStorage custom error IndexOutOfBounds().
+type ErrIndexOutOfBounds struct{}
+
+// Error implements the error interface.
+func (ErrIndexOutOfBounds) Error() string {
+	return "ECDSACertificateVerifierStorage: IndexOutOfBounds()"
+}
+
+// ErrInvalidSignatureLength is the typed form of the ECDSACertificateVerifierStorage custom error InvalidSignatureLength().
+type ErrInvalidSignatureLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignatureLength) Error() string {
+	return "ECDSACertificateVerifierStorage: InvalidSignatureLength()"
+}
+
+// ErrOnlyTableUpdater is the typed form of the ECDSACertificateVerifierStorage custom error OnlyTableUpdater().
+type ErrOnlyTableUpdater struct{}
+
+// Error implements the error interface.
+func (ErrOnlyTableUpdater) Error() string {
+	return "ECDSACertificateVerifierStorage: OnlyTableUpdater()"
+}
+
+// ErrOperatorCountZero is the typed form of the ECDSACertificateVerifierStorage custom error OperatorCountZero().
+type ErrOperatorCountZero struct{}
+
+// Error implements the error interface.
+func (ErrOperatorCountZero) Error() string {
+	return "ECDSACertificateVerifierStorage: OperatorCountZero()"
+}
+
+// ErrReferenceTimestampDoesNotExist is the typed form of the ECDSACertificateVerifierStorage custom error ReferenceTimestampDoesNotExist().
+type ErrReferenceTimestampDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrReferenceTimestampDoesNotExist) Error() string {
+	return "ECDSACertificateVerifierStorage: ReferenceTimestampDoesNotExist()"
+}
+
+// ErrRootDisabled is the typed form of the ECDSACertificateVerifierStorage custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "ECDSACertificateVerifierStorage: RootDisabled()"
+}
+
+// ErrSignersNotOrdered is the typed form of the ECDSACertificateVerifierStorage custom error SignersNotOrdered().
+type ErrSignersNotOrdered struct{}
+
+// Error implements the error interface.
+func (ErrSignersNotOrdered) Error() string {
+	return "ECDSACertificateVerifierStorage: SignersNotOrdered()"
+}
+
+// ErrTableUpdateStale is the typed form of the ECDSACertificateVerifierStorage custom error TableUpdateStale().
+type ErrTableUpdateStale struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateStale) Error() string {
+	return "ECDSACertificateVerifierStorage: TableUpdateStale()"
+}
+
+// ErrVerificationFailed is the typed form of the ECDSACertificateVerifierStorage custom error VerificationFailed().
+type ErrVerificationFailed struct{}
+
+// Error implements the error interface.
+func (ErrVerificationFailed) Error() string {
+	return "ECDSACertificateVerifierStorage: VerificationFailed()"
+}
+
+// ECDSACertificateVerifierStorageErrors maps the name of every custom error in the ECDSACertificateVerifierStorage ABI to its typed Go error.
+var ECDSACertificateVerifierStorageErrors = map[string]error{
+	"ArrayLengthMismatch":            ErrArrayLengthMismatch{},
+	"CertificateStale":               ErrCertificateStale{},
+	"IndexOutOfBounds":               ErrIndexOutOfBounds{},
+	"InvalidSignatureLength":         ErrInvalidSignatureLength{},
+	"OnlyTableUpdater":               ErrOnlyTableUpdater{},
+	"OperatorCountZero":              ErrOperatorCountZero{},
+	"ReferenceTimestampDoesNotExist": ErrReferenceTimestampDoesNotExist{},
+	"RootDisabled":                   ErrRootDisabled{},
+	"SignersNotOrdered":              ErrSignersNotOrdered{},
+	"TableUpdateStale":               ErrTableUpdateStale{},
+	"VerificationFailed":             ErrVerificationFailed{},
+}
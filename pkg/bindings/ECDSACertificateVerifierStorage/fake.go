@@ -0,0 +1,252 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ECDSACertificateVerifierStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeECDSACertificateVerifierStorage is an in-memory ECDSACertificateVerifierStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ECDSACertificateVerifierStorageFilterer.
+type FakeECDSACertificateVerifierStorage struct {
+	*fake.Contract
+	*ECDSACertificateVerifierStorageFilterer
+}
+
+// NewFakeECDSACertificateVerifierStorage returns an empty fake of the contract at address.
+func NewFakeECDSACertificateVerifierStorage(address common.Address) *FakeECDSACertificateVerifierStorage {
+	contract := fake.NewContract(ECDSACertificateVerifierStorageMetaData, address)
+	filterer, err := NewECDSACertificateVerifierStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeECDSACertificateVerifierStorage{Contract: contract, ECDSACertificateVerifierStorageFilterer: filterer}
+}
+
+// CalculateCertificateDigest returns the values stubbed with StubCalculateCertificateDigest.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigest sets what CalculateCertificateDigest returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubCalculateCertificateDigest(referenceTimestamp uint32, messageHash [32]byte, out0 [32]byte) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// CalculateCertificateDigestBytes returns the values stubbed with StubCalculateCertificateDigestBytes.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) CalculateCertificateDigestBytes(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([]byte, error) {
+	var out0 []byte
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("calculateCertificateDigestBytes", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigestBytes sets what CalculateCertificateDigestBytes returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubCalculateCertificateDigestBytes(referenceTimestamp uint32, messageHash [32]byte, out0 []byte) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("calculateCertificateDigestBytes", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubDomainSeparator(out0 [32]byte) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// GetOperatorCount returns the values stubbed with StubGetOperatorCount.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorCount sets what GetOperatorCount returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubGetOperatorCount(operatorSet OperatorSet, referenceTimestamp uint32, out0 *big.Int) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorInfo returns the values stubbed with StubGetOperatorInfo.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) GetOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesECDSAOperatorInfo, error) {
+	var out0 IOperatorTableCalculatorTypesECDSAOperatorInfo
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("getOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorInfo sets what GetOperatorInfo returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubGetOperatorInfo(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 IOperatorTableCalculatorTypesECDSAOperatorInfo) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("getOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// GetOperatorInfos returns the values stubbed with StubGetOperatorInfos.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) GetOperatorInfos(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]IOperatorTableCalculatorTypesECDSAOperatorInfo, error) {
+	var out0 []IOperatorTableCalculatorTypesECDSAOperatorInfo
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("getOperatorInfos", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorInfos sets what GetOperatorInfos returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubGetOperatorInfos(operatorSet OperatorSet, referenceTimestamp uint32, out0 []IOperatorTableCalculatorTypesECDSAOperatorInfo) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("getOperatorInfos", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetOwner returns the values stubbed with StubGetOperatorSetOwner.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("getOperatorSetOwner", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetOwner sets what GetOperatorSetOwner returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubGetOperatorSetOwner(operatorSet OperatorSet, out0 common.Address) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("getOperatorSetOwner", []interface{}{operatorSet}, out0)
+}
+
+// GetTotalStakeWeights returns the values stubbed with StubGetTotalStakeWeights.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetTotalStakeWeights sets what GetTotalStakeWeights returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubGetTotalStakeWeights(operatorSet OperatorSet, referenceTimestamp uint32, out0 []*big.Int) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// IsReferenceTimestampSet returns the values stubbed with StubIsReferenceTimestampSet.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsReferenceTimestampSet sets what IsReferenceTimestampSet returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubIsReferenceTimestampSet(operatorSet OperatorSet, referenceTimestamp uint32, out0 bool) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// LatestReferenceTimestamp returns the values stubbed with StubLatestReferenceTimestamp.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("latestReferenceTimestamp", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubLatestReferenceTimestamp sets what LatestReferenceTimestamp returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubLatestReferenceTimestamp(operatorSet OperatorSet, out0 uint32) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("latestReferenceTimestamp", []interface{}{operatorSet}, out0)
+}
+
+// MaxOperatorTableStaleness returns the values stubbed with StubMaxOperatorTableStaleness.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("maxOperatorTableStaleness", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubMaxOperatorTableStaleness sets what MaxOperatorTableStaleness returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubMaxOperatorTableStaleness(operatorSet OperatorSet, out0 uint32) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("maxOperatorTableStaleness", []interface{}{operatorSet}, out0)
+}
+
+// OperatorTableUpdater returns the values stubbed with StubOperatorTableUpdater.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) OperatorTableUpdater(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("operatorTableUpdater", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOperatorTableUpdater sets what OperatorTableUpdater returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubOperatorTableUpdater(out0 common.Address) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("operatorTableUpdater", []interface{}{}, out0)
+}
+
+// VerifyCertificate returns the values stubbed with StubVerifyCertificate.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) VerifyCertificate(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate) (struct {
+	TotalSignedStakeWeights []*big.Int
+	Signers                 []common.Address
+}, error) {
+	var out0 struct {
+		TotalSignedStakeWeights []*big.Int
+		Signers                 []common.Address
+	}
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("verifyCertificate", []interface{}{operatorSet, cert}, &out0)
+	return out0, err
+}
+
+// StubVerifyCertificate sets what VerifyCertificate returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubVerifyCertificate(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, out0 struct {
+	TotalSignedStakeWeights []*big.Int
+	Signers                 []common.Address
+}) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("verifyCertificate", []interface{}{operatorSet, cert}, out0)
+}
+
+// VerifyCertificateNominal returns the values stubbed with StubVerifyCertificateNominal.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) VerifyCertificateNominal(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int) (bool, []common.Address, error) {
+	var out0 bool
+	var out1 []common.Address
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("verifyCertificateNominal", []interface{}{operatorSet, cert, totalStakeNominalThresholds}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubVerifyCertificateNominal sets what VerifyCertificateNominal returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubVerifyCertificateNominal(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int, out0 bool, out1 []common.Address) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("verifyCertificateNominal", []interface{}{operatorSet, cert, totalStakeNominalThresholds}, out0, out1)
+}
+
+// VerifyCertificateProportion returns the values stubbed with StubVerifyCertificateProportion.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) VerifyCertificateProportion(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16) (bool, []common.Address, error) {
+	var out0 bool
+	var out1 []common.Address
+	err := _FakeECDSACertificateVerifierStorage.Contract.Read("verifyCertificateProportion", []interface{}{operatorSet, cert, totalStakeProportionThresholds}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubVerifyCertificateProportion sets what VerifyCertificateProportion returns for the given arguments.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) StubVerifyCertificateProportion(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16, out0 bool, out1 []common.Address) {
+	_FakeECDSACertificateVerifierStorage.Contract.Stub("verifyCertificateProportion", []interface{}{operatorSet, cert, totalStakeProportionThresholds}, out0, out1)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeECDSACertificateVerifierStorage.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeECDSACertificateVerifierStorage.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, referenceTimestamp, operatorInfos, operatorSetConfig)
+		}
+		return nil
+	}, operatorSet, referenceTimestamp, operatorInfos, operatorSetConfig)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeECDSACertificateVerifierStorage.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// EmitMaxStalenessPeriodUpdated appends the event to the fake's logs.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) EmitMaxStalenessPeriodUpdated(event *ECDSACertificateVerifierStorageMaxStalenessPeriodUpdated) {
+	_FakeECDSACertificateVerifierStorage.Contract.Emit("MaxStalenessPeriodUpdated", event)
+}
+
+// EmitOperatorSetOwnerUpdated appends the event to the fake's logs.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) EmitOperatorSetOwnerUpdated(event *ECDSACertificateVerifierStorageOperatorSetOwnerUpdated) {
+	_FakeECDSACertificateVerifierStorage.Contract.Emit("OperatorSetOwnerUpdated", event)
+}
+
+// EmitTableUpdated appends the event to the fake's logs.
+func (_FakeECDSACertificateVerifierStorage *FakeECDSACertificateVerifierStorage) EmitTableUpdated(event *ECDSACertificateVerifierStorageTableUpdated) {
+	_FakeECDSACertificateVerifierStorage.Contract.Emit("TableUpdated", event)
+}
@@ -0,0 +1,66 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ECDSACertificateVerifierStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// ECDSACertificateVerifierStorageReader is the read-only surface of the ECDSACertificateVerifierStorage
+// binding, implemented by ECDSACertificateVerifierStorageCaller, ECDSACertificateVerifierStorage and FakeECDSACertificateVerifierStorage.
+type ECDSACertificateVerifierStorageReader interface {
+	CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error)
+	CalculateCertificateDigestBytes(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([]byte, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error)
+	GetOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesECDSAOperatorInfo, error)
+	GetOperatorInfos(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]IOperatorTableCalculatorTypesECDSAOperatorInfo, error)
+	GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error)
+	IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error)
+	LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	OperatorTableUpdater(opts *bind.CallOpts) (common.Address, error)
+	VerifyCertificate(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate) (struct {
+		TotalSignedStakeWeights []*big.Int
+		Signers                 []common.Address
+	}, error)
+	VerifyCertificateNominal(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int) (bool, []common.Address, error)
+	VerifyCertificateProportion(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16) (bool, []common.Address, error)
+}
+
+// ECDSACertificateVerifierStorageWriter is the transacting surface of the ECDSACertificateVerifierStorage
+// binding, implemented by ECDSACertificateVerifierStorageTransactor, ECDSACertificateVerifierStorage and FakeECDSACertificateVerifierStorage.
+type ECDSACertificateVerifierStorageWriter interface {
+	UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+}
+
+// ECDSACertificateVerifierStorageEvents is the event filtering surface of the ECDSACertificateVerifierStorage
+// binding, implemented by ECDSACertificateVerifierStorageFilterer, ECDSACertificateVerifierStorage and FakeECDSACertificateVerifierStorage.
+type ECDSACertificateVerifierStorageEvents interface {
+	FilterMaxStalenessPeriodUpdated(opts *bind.FilterOpts) (*ECDSACertificateVerifierStorageMaxStalenessPeriodUpdatedIterator, error)
+	WatchMaxStalenessPeriodUpdated(opts *bind.WatchOpts, sink chan<- *ECDSACertificateVerifierStorageMaxStalenessPeriodUpdated) (event.Subscription, error)
+	ParseMaxStalenessPeriodUpdated(log types.Log) (*ECDSACertificateVerifierStorageMaxStalenessPeriodUpdated, error)
+	FilterOperatorSetOwnerUpdated(opts *bind.FilterOpts) (*ECDSACertificateVerifierStorageOperatorSetOwnerUpdatedIterator, error)
+	WatchOperatorSetOwnerUpdated(opts *bind.WatchOpts, sink chan<- *ECDSACertificateVerifierStorageOperatorSetOwnerUpdated) (event.Subscription, error)
+	ParseOperatorSetOwnerUpdated(log types.Log) (*ECDSACertificateVerifierStorageOperatorSetOwnerUpdated, error)
+	FilterTableUpdated(opts *bind.FilterOpts) (*ECDSACertificateVerifierStorageTableUpdatedIterator, error)
+	WatchTableUpdated(opts *bind.WatchOpts, sink chan<- *ECDSACertificateVerifierStorageTableUpdated) (event.Subscription, error)
+	ParseTableUpdated(log types.Log) (*ECDSACertificateVerifierStorageTableUpdated, error)
+}
+
+var (
+	_ ECDSACertificateVerifierStorageReader = (*ECDSACertificateVerifierStorageCaller)(nil)
+	_ ECDSACertificateVerifierStorageReader = (*ECDSACertificateVerifierStorage)(nil)
+	_ ECDSACertificateVerifierStorageReader = (*FakeECDSACertificateVerifierStorage)(nil)
+	_ ECDSACertificateVerifierStorageWriter = (*ECDSACertificateVerifierStorageTransactor)(nil)
+	_ ECDSACertificateVerifierStorageWriter = (*ECDSACertificateVerifierStorage)(nil)
+	_ ECDSACertificateVerifierStorageWriter = (*FakeECDSACertificateVerifierStorage)(nil)
+	_ ECDSACertificateVerifierStorageEvents = (*ECDSACertificateVerifierStorageFilterer)(nil)
+	_ ECDSACertificateVerifierStorageEvents = (*ECDSACertificateVerifierStorage)(nil)
+	_ ECDSACertificateVerifierStorageEvents = (*FakeECDSACertificateVerifierStorage)(nil)
+)
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,11 +30,8 @@ var (
 	_ = abi.ConvertType
 )
 
-// ERC20VotesUpgradeableCheckpoint is an auto generated low-level Go binding around an user-defined struct.
-type ERC20VotesUpgradeableCheckpoint struct {
-	FromBlock uint32
-	Votes     *big.Int
-}
+// ERC20VotesUpgradeableCheckpoint is an alias of the canonical eltypes.ERC20VotesUpgradeableCheckpoint shared by all bindings.
+type ERC20VotesUpgradeableCheckpoint = eltypes.ERC20VotesUpgradeableCheckpoint
 
 // EigenMetaData contains all meta data concerning the Eigen contract.
 var EigenMetaData = &bind.MetaData{
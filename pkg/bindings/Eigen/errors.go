@@ -0,0 +1,31 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Eigen
+
+import (
+	"fmt"
+)
+
+// ErrInvalidShortString is the typed form of the Eigen custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "Eigen: InvalidShortString()"
+}
+
+// ErrStringTooLong is the typed form of the Eigen custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("Eigen: StringTooLong(str: %v)", e.Str)
+}
+
+// EigenErrors maps the name of every custom error in the Eigen ABI to its typed Go error.
+var EigenErrors = map[string]error{
+	"InvalidShortString": ErrInvalidShortString{},
+	"StringTooLong":      ErrStringTooLong{},
+}
@@ -0,0 +1,707 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Eigen
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeEigen is an in-memory Eigen for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded EigenFilterer.
+type FakeEigen struct {
+	*fake.Contract
+	*EigenFilterer
+}
+
+// NewFakeEigen returns an empty fake of the contract at address.
+func NewFakeEigen(address common.Address) *FakeEigen {
+	contract := fake.NewContract(EigenMetaData, address)
+	filterer, err := NewEigenFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeEigen{Contract: contract, EigenFilterer: filterer}
+}
+
+// CLOCKMODE returns the values stubbed with StubCLOCKMODE.
+func (_FakeEigen *FakeEigen) CLOCKMODE(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeEigen.Contract.Read("CLOCK_MODE", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCLOCKMODE sets what CLOCKMODE returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubCLOCKMODE(out0 string) {
+	_FakeEigen.Contract.Stub("CLOCK_MODE", []interface{}{}, out0)
+}
+
+// DOMAINSEPARATOR returns the values stubbed with StubDOMAINSEPARATOR.
+func (_FakeEigen *FakeEigen) DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeEigen.Contract.Read("DOMAIN_SEPARATOR", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDOMAINSEPARATOR sets what DOMAINSEPARATOR returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubDOMAINSEPARATOR(out0 [32]byte) {
+	_FakeEigen.Contract.Stub("DOMAIN_SEPARATOR", []interface{}{}, out0)
+}
+
+// Allowance returns the values stubbed with StubAllowance.
+func (_FakeEigen *FakeEigen) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("allowance", []interface{}{owner, spender}, &out0)
+	return out0, err
+}
+
+// StubAllowance sets what Allowance returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubAllowance(owner common.Address, spender common.Address, out0 *big.Int) {
+	_FakeEigen.Contract.Stub("allowance", []interface{}{owner, spender}, out0)
+}
+
+// AllowedFrom returns the values stubbed with StubAllowedFrom.
+func (_FakeEigen *FakeEigen) AllowedFrom(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeEigen.Contract.Read("allowedFrom", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubAllowedFrom sets what AllowedFrom returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubAllowedFrom(arg0 common.Address, out0 bool) {
+	_FakeEigen.Contract.Stub("allowedFrom", []interface{}{arg0}, out0)
+}
+
+// AllowedTo returns the values stubbed with StubAllowedTo.
+func (_FakeEigen *FakeEigen) AllowedTo(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeEigen.Contract.Read("allowedTo", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubAllowedTo sets what AllowedTo returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubAllowedTo(arg0 common.Address, out0 bool) {
+	_FakeEigen.Contract.Stub("allowedTo", []interface{}{arg0}, out0)
+}
+
+// BEIGEN returns the values stubbed with StubBEIGEN.
+func (_FakeEigen *FakeEigen) BEIGEN(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigen.Contract.Read("bEIGEN", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBEIGEN sets what BEIGEN returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubBEIGEN(out0 common.Address) {
+	_FakeEigen.Contract.Stub("bEIGEN", []interface{}{}, out0)
+}
+
+// BalanceOf returns the values stubbed with StubBalanceOf.
+func (_FakeEigen *FakeEigen) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("balanceOf", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubBalanceOf sets what BalanceOf returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubBalanceOf(account common.Address, out0 *big.Int) {
+	_FakeEigen.Contract.Stub("balanceOf", []interface{}{account}, out0)
+}
+
+// Checkpoints returns the values stubbed with StubCheckpoints.
+func (_FakeEigen *FakeEigen) Checkpoints(opts *bind.CallOpts, account common.Address, pos uint32) (ERC20VotesUpgradeableCheckpoint, error) {
+	var out0 ERC20VotesUpgradeableCheckpoint
+	err := _FakeEigen.Contract.Read("checkpoints", []interface{}{account, pos}, &out0)
+	return out0, err
+}
+
+// StubCheckpoints sets what Checkpoints returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubCheckpoints(account common.Address, pos uint32, out0 ERC20VotesUpgradeableCheckpoint) {
+	_FakeEigen.Contract.Stub("checkpoints", []interface{}{account, pos}, out0)
+}
+
+// Clock returns the values stubbed with StubClock.
+func (_FakeEigen *FakeEigen) Clock(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("clock", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubClock sets what Clock returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubClock(out0 *big.Int) {
+	_FakeEigen.Contract.Stub("clock", []interface{}{}, out0)
+}
+
+// Decimals returns the values stubbed with StubDecimals.
+func (_FakeEigen *FakeEigen) Decimals(opts *bind.CallOpts) (uint8, error) {
+	var out0 uint8
+	err := _FakeEigen.Contract.Read("decimals", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDecimals sets what Decimals returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubDecimals(out0 uint8) {
+	_FakeEigen.Contract.Stub("decimals", []interface{}{}, out0)
+}
+
+// Delegates returns the values stubbed with StubDelegates.
+func (_FakeEigen *FakeEigen) Delegates(opts *bind.CallOpts, account common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigen.Contract.Read("delegates", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubDelegates sets what Delegates returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubDelegates(account common.Address, out0 common.Address) {
+	_FakeEigen.Contract.Stub("delegates", []interface{}{account}, out0)
+}
+
+// Eip712Domain returns the values stubbed with StubEip712Domain.
+func (_FakeEigen *FakeEigen) Eip712Domain(opts *bind.CallOpts) (struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}, error) {
+	var out0 struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	}
+	err := _FakeEigen.Contract.Read("eip712Domain", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEip712Domain sets what Eip712Domain returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubEip712Domain(out0 struct {
+	Fields            [1]byte
+	Name              string
+	Version           string
+	ChainId           *big.Int
+	VerifyingContract common.Address
+	Salt              [32]byte
+	Extensions        []*big.Int
+}) {
+	_FakeEigen.Contract.Stub("eip712Domain", []interface{}{}, out0)
+}
+
+// GetPastTotalSupply returns the values stubbed with StubGetPastTotalSupply.
+func (_FakeEigen *FakeEigen) GetPastTotalSupply(opts *bind.CallOpts, timepoint *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("getPastTotalSupply", []interface{}{timepoint}, &out0)
+	return out0, err
+}
+
+// StubGetPastTotalSupply sets what GetPastTotalSupply returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubGetPastTotalSupply(timepoint *big.Int, out0 *big.Int) {
+	_FakeEigen.Contract.Stub("getPastTotalSupply", []interface{}{timepoint}, out0)
+}
+
+// GetPastVotes returns the values stubbed with StubGetPastVotes.
+func (_FakeEigen *FakeEigen) GetPastVotes(opts *bind.CallOpts, account common.Address, timepoint *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("getPastVotes", []interface{}{account, timepoint}, &out0)
+	return out0, err
+}
+
+// StubGetPastVotes sets what GetPastVotes returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubGetPastVotes(account common.Address, timepoint *big.Int, out0 *big.Int) {
+	_FakeEigen.Contract.Stub("getPastVotes", []interface{}{account, timepoint}, out0)
+}
+
+// GetVotes returns the values stubbed with StubGetVotes.
+func (_FakeEigen *FakeEigen) GetVotes(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("getVotes", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubGetVotes sets what GetVotes returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubGetVotes(account common.Address, out0 *big.Int) {
+	_FakeEigen.Contract.Stub("getVotes", []interface{}{account}, out0)
+}
+
+// MintAllowedAfter returns the values stubbed with StubMintAllowedAfter.
+func (_FakeEigen *FakeEigen) MintAllowedAfter(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("mintAllowedAfter", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubMintAllowedAfter sets what MintAllowedAfter returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubMintAllowedAfter(arg0 common.Address, out0 *big.Int) {
+	_FakeEigen.Contract.Stub("mintAllowedAfter", []interface{}{arg0}, out0)
+}
+
+// MintingAllowance returns the values stubbed with StubMintingAllowance.
+func (_FakeEigen *FakeEigen) MintingAllowance(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("mintingAllowance", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubMintingAllowance sets what MintingAllowance returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubMintingAllowance(arg0 common.Address, out0 *big.Int) {
+	_FakeEigen.Contract.Stub("mintingAllowance", []interface{}{arg0}, out0)
+}
+
+// Name returns the values stubbed with StubName.
+func (_FakeEigen *FakeEigen) Name(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeEigen.Contract.Read("name", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubName sets what Name returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubName(out0 string) {
+	_FakeEigen.Contract.Stub("name", []interface{}{}, out0)
+}
+
+// Nonces returns the values stubbed with StubNonces.
+func (_FakeEigen *FakeEigen) Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("nonces", []interface{}{owner}, &out0)
+	return out0, err
+}
+
+// StubNonces sets what Nonces returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubNonces(owner common.Address, out0 *big.Int) {
+	_FakeEigen.Contract.Stub("nonces", []interface{}{owner}, out0)
+}
+
+// NumCheckpoints returns the values stubbed with StubNumCheckpoints.
+func (_FakeEigen *FakeEigen) NumCheckpoints(opts *bind.CallOpts, account common.Address) (uint32, error) {
+	var out0 uint32
+	err := _FakeEigen.Contract.Read("numCheckpoints", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubNumCheckpoints sets what NumCheckpoints returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubNumCheckpoints(account common.Address, out0 uint32) {
+	_FakeEigen.Contract.Stub("numCheckpoints", []interface{}{account}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeEigen *FakeEigen) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigen.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubOwner(out0 common.Address) {
+	_FakeEigen.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// Symbol returns the values stubbed with StubSymbol.
+func (_FakeEigen *FakeEigen) Symbol(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeEigen.Contract.Read("symbol", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubSymbol sets what Symbol returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubSymbol(out0 string) {
+	_FakeEigen.Contract.Stub("symbol", []interface{}{}, out0)
+}
+
+// TotalSupply returns the values stubbed with StubTotalSupply.
+func (_FakeEigen *FakeEigen) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("totalSupply", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalSupply sets what TotalSupply returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubTotalSupply(out0 *big.Int) {
+	_FakeEigen.Contract.Stub("totalSupply", []interface{}{}, out0)
+}
+
+// TransferRestrictionsDisabledAfter returns the values stubbed with StubTransferRestrictionsDisabledAfter.
+func (_FakeEigen *FakeEigen) TransferRestrictionsDisabledAfter(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigen.Contract.Read("transferRestrictionsDisabledAfter", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTransferRestrictionsDisabledAfter sets what TransferRestrictionsDisabledAfter returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubTransferRestrictionsDisabledAfter(out0 *big.Int) {
+	_FakeEigen.Contract.Stub("transferRestrictionsDisabledAfter", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeEigen *FakeEigen) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeEigen.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeEigen *FakeEigen) StubVersion(out0 string) {
+	_FakeEigen.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// Approve records a approve call after running the hook set with OnApprove.
+func (_FakeEigen *FakeEigen) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "approve", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("approve").(func(opts *bind.TransactOpts, spender common.Address, amount *big.Int) error); ok {
+			return hook(opts, spender, amount)
+		}
+		return nil
+	}, spender, amount)
+}
+
+// OnApprove sets a hook run by every Approve call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnApprove(hook func(opts *bind.TransactOpts, spender common.Address, amount *big.Int) error) {
+	_FakeEigen.Contract.SetHook("approve", hook)
+}
+
+// DecreaseAllowance records a decreaseAllowance call after running the hook set with OnDecreaseAllowance.
+func (_FakeEigen *FakeEigen) DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "decreaseAllowance", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("decreaseAllowance").(func(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) error); ok {
+			return hook(opts, spender, subtractedValue)
+		}
+		return nil
+	}, spender, subtractedValue)
+}
+
+// OnDecreaseAllowance sets a hook run by every DecreaseAllowance call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnDecreaseAllowance(hook func(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) error) {
+	_FakeEigen.Contract.SetHook("decreaseAllowance", hook)
+}
+
+// Delegate records a delegate call after running the hook set with OnDelegate.
+func (_FakeEigen *FakeEigen) Delegate(opts *bind.TransactOpts, delegatee common.Address) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "delegate", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("delegate").(func(opts *bind.TransactOpts, delegatee common.Address) error); ok {
+			return hook(opts, delegatee)
+		}
+		return nil
+	}, delegatee)
+}
+
+// OnDelegate sets a hook run by every Delegate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnDelegate(hook func(opts *bind.TransactOpts, delegatee common.Address) error) {
+	_FakeEigen.Contract.SetHook("delegate", hook)
+}
+
+// DelegateBySig records a delegateBySig call after running the hook set with OnDelegateBySig.
+func (_FakeEigen *FakeEigen) DelegateBySig(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "delegateBySig", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("delegateBySig").(func(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) error); ok {
+			return hook(opts, delegatee, nonce, expiry, v, r, s)
+		}
+		return nil
+	}, delegatee, nonce, expiry, v, r, s)
+}
+
+// OnDelegateBySig sets a hook run by every DelegateBySig call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnDelegateBySig(hook func(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) error) {
+	_FakeEigen.Contract.SetHook("delegateBySig", hook)
+}
+
+// DisableTransferRestrictions records a disableTransferRestrictions call after running the hook set with OnDisableTransferRestrictions.
+func (_FakeEigen *FakeEigen) DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "disableTransferRestrictions", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("disableTransferRestrictions").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnDisableTransferRestrictions sets a hook run by every DisableTransferRestrictions call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnDisableTransferRestrictions(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigen.Contract.SetHook("disableTransferRestrictions", hook)
+}
+
+// IncreaseAllowance records a increaseAllowance call after running the hook set with OnIncreaseAllowance.
+func (_FakeEigen *FakeEigen) IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "increaseAllowance", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("increaseAllowance").(func(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) error); ok {
+			return hook(opts, spender, addedValue)
+		}
+		return nil
+	}, spender, addedValue)
+}
+
+// OnIncreaseAllowance sets a hook run by every IncreaseAllowance call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnIncreaseAllowance(hook func(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) error) {
+	_FakeEigen.Contract.SetHook("increaseAllowance", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeEigen *FakeEigen) Initialize(opts *bind.TransactOpts, initialOwner common.Address, minters []common.Address, mintingAllowances []*big.Int, mintAllowedAfters []*big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, minters []common.Address, mintingAllowances []*big.Int, mintAllowedAfters []*big.Int) error); ok {
+			return hook(opts, initialOwner, minters, mintingAllowances, mintAllowedAfters)
+		}
+		return nil
+	}, initialOwner, minters, mintingAllowances, mintAllowedAfters)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, minters []common.Address, mintingAllowances []*big.Int, mintAllowedAfters []*big.Int) error) {
+	_FakeEigen.Contract.SetHook("initialize", hook)
+}
+
+// Mint records a mint call after running the hook set with OnMint.
+func (_FakeEigen *FakeEigen) Mint(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "mint", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("mint").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnMint sets a hook run by every Mint call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnMint(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigen.Contract.SetHook("mint", hook)
+}
+
+// Multisend records a multisend call after running the hook set with OnMultisend.
+func (_FakeEigen *FakeEigen) Multisend(opts *bind.TransactOpts, receivers []common.Address, amounts []*big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "multisend", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("multisend").(func(opts *bind.TransactOpts, receivers []common.Address, amounts []*big.Int) error); ok {
+			return hook(opts, receivers, amounts)
+		}
+		return nil
+	}, receivers, amounts)
+}
+
+// OnMultisend sets a hook run by every Multisend call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnMultisend(hook func(opts *bind.TransactOpts, receivers []common.Address, amounts []*big.Int) error) {
+	_FakeEigen.Contract.SetHook("multisend", hook)
+}
+
+// Permit records a permit call after running the hook set with OnPermit.
+func (_FakeEigen *FakeEigen) Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "permit", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("permit").(func(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) error); ok {
+			return hook(opts, owner, spender, value, deadline, v, r, s)
+		}
+		return nil
+	}, owner, spender, value, deadline, v, r, s)
+}
+
+// OnPermit sets a hook run by every Permit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnPermit(hook func(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) error) {
+	_FakeEigen.Contract.SetHook("permit", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeEigen *FakeEigen) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigen.Contract.SetHook("renounceOwnership", hook)
+}
+
+// SetAllowedFrom records a setAllowedFrom call after running the hook set with OnSetAllowedFrom.
+func (_FakeEigen *FakeEigen) SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "setAllowedFrom", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("setAllowedFrom").(func(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) error); ok {
+			return hook(opts, from, isAllowedFrom)
+		}
+		return nil
+	}, from, isAllowedFrom)
+}
+
+// OnSetAllowedFrom sets a hook run by every SetAllowedFrom call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnSetAllowedFrom(hook func(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) error) {
+	_FakeEigen.Contract.SetHook("setAllowedFrom", hook)
+}
+
+// SetAllowedTo records a setAllowedTo call after running the hook set with OnSetAllowedTo.
+func (_FakeEigen *FakeEigen) SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "setAllowedTo", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("setAllowedTo").(func(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) error); ok {
+			return hook(opts, to, isAllowedTo)
+		}
+		return nil
+	}, to, isAllowedTo)
+}
+
+// OnSetAllowedTo sets a hook run by every SetAllowedTo call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnSetAllowedTo(hook func(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) error) {
+	_FakeEigen.Contract.SetHook("setAllowedTo", hook)
+}
+
+// Transfer records a transfer call after running the hook set with OnTransfer.
+func (_FakeEigen *FakeEigen) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "transfer", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("transfer").(func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, to, amount)
+		}
+		return nil
+	}, to, amount)
+}
+
+// OnTransfer sets a hook run by every Transfer call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnTransfer(hook func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error) {
+	_FakeEigen.Contract.SetHook("transfer", hook)
+}
+
+// TransferFrom records a transferFrom call after running the hook set with OnTransferFrom.
+func (_FakeEigen *FakeEigen) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "transferFrom", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("transferFrom").(func(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, from, to, amount)
+		}
+		return nil
+	}, from, to, amount)
+}
+
+// OnTransferFrom sets a hook run by every TransferFrom call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnTransferFrom(hook func(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) error) {
+	_FakeEigen.Contract.SetHook("transferFrom", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeEigen *FakeEigen) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeEigen.Contract.SetHook("transferOwnership", hook)
+}
+
+// Unwrap records a unwrap call after running the hook set with OnUnwrap.
+func (_FakeEigen *FakeEigen) Unwrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "unwrap", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("unwrap").(func(opts *bind.TransactOpts, amount *big.Int) error); ok {
+			return hook(opts, amount)
+		}
+		return nil
+	}, amount)
+}
+
+// OnUnwrap sets a hook run by every Unwrap call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnUnwrap(hook func(opts *bind.TransactOpts, amount *big.Int) error) {
+	_FakeEigen.Contract.SetHook("unwrap", hook)
+}
+
+// Wrap records a wrap call after running the hook set with OnWrap.
+func (_FakeEigen *FakeEigen) Wrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _FakeEigen.Contract.Transact(opts, "wrap", func() error {
+		if hook, ok := _FakeEigen.Contract.Hook("wrap").(func(opts *bind.TransactOpts, amount *big.Int) error); ok {
+			return hook(opts, amount)
+		}
+		return nil
+	}, amount)
+}
+
+// OnWrap sets a hook run by every Wrap call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigen *FakeEigen) OnWrap(hook func(opts *bind.TransactOpts, amount *big.Int) error) {
+	_FakeEigen.Contract.SetHook("wrap", hook)
+}
+
+// EmitApproval appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitApproval(event *EigenApproval) {
+	_FakeEigen.Contract.Emit("Approval", event)
+}
+
+// EmitDelegateChanged appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitDelegateChanged(event *EigenDelegateChanged) {
+	_FakeEigen.Contract.Emit("DelegateChanged", event)
+}
+
+// EmitDelegateVotesChanged appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitDelegateVotesChanged(event *EigenDelegateVotesChanged) {
+	_FakeEigen.Contract.Emit("DelegateVotesChanged", event)
+}
+
+// EmitEIP712DomainChanged appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitEIP712DomainChanged(event *EigenEIP712DomainChanged) {
+	_FakeEigen.Contract.Emit("EIP712DomainChanged", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitInitialized(event *EigenInitialized) {
+	_FakeEigen.Contract.Emit("Initialized", event)
+}
+
+// EmitMint appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitMint(event *EigenMint) {
+	_FakeEigen.Contract.Emit("Mint", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitOwnershipTransferred(event *EigenOwnershipTransferred) {
+	_FakeEigen.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitSetAllowedFrom appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitSetAllowedFrom(event *EigenSetAllowedFrom) {
+	_FakeEigen.Contract.Emit("SetAllowedFrom", event)
+}
+
+// EmitSetAllowedTo appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitSetAllowedTo(event *EigenSetAllowedTo) {
+	_FakeEigen.Contract.Emit("SetAllowedTo", event)
+}
+
+// EmitTokenUnwrapped appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitTokenUnwrapped(event *EigenTokenUnwrapped) {
+	_FakeEigen.Contract.Emit("TokenUnwrapped", event)
+}
+
+// EmitTokenWrapped appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitTokenWrapped(event *EigenTokenWrapped) {
+	_FakeEigen.Contract.Emit("TokenWrapped", event)
+}
+
+// EmitTransfer appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitTransfer(event *EigenTransfer) {
+	_FakeEigen.Contract.Emit("Transfer", event)
+}
+
+// EmitTransferRestrictionsDisabled appends the event to the fake's logs.
+func (_FakeEigen *FakeEigen) EmitTransferRestrictionsDisabled(event *EigenTransferRestrictionsDisabled) {
+	_FakeEigen.Contract.Emit("TransferRestrictionsDisabled", event)
+}
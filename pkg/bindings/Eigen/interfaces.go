@@ -0,0 +1,128 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Eigen
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// EigenReader is the read-only surface of the Eigen
+// binding, implemented by EigenCaller, Eigen and FakeEigen.
+type EigenReader interface {
+	CLOCKMODE(opts *bind.CallOpts) (string, error)
+	DOMAINSEPARATOR(opts *bind.CallOpts) ([32]byte, error)
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+	AllowedFrom(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	AllowedTo(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	BEIGEN(opts *bind.CallOpts) (common.Address, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	Checkpoints(opts *bind.CallOpts, account common.Address, pos uint32) (ERC20VotesUpgradeableCheckpoint, error)
+	Clock(opts *bind.CallOpts) (*big.Int, error)
+	Decimals(opts *bind.CallOpts) (uint8, error)
+	Delegates(opts *bind.CallOpts, account common.Address) (common.Address, error)
+	Eip712Domain(opts *bind.CallOpts) (struct {
+		Fields            [1]byte
+		Name              string
+		Version           string
+		ChainId           *big.Int
+		VerifyingContract common.Address
+		Salt              [32]byte
+		Extensions        []*big.Int
+	}, error)
+	GetPastTotalSupply(opts *bind.CallOpts, timepoint *big.Int) (*big.Int, error)
+	GetPastVotes(opts *bind.CallOpts, account common.Address, timepoint *big.Int) (*big.Int, error)
+	GetVotes(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	MintAllowedAfter(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	MintingAllowance(opts *bind.CallOpts, arg0 common.Address) (*big.Int, error)
+	Name(opts *bind.CallOpts) (string, error)
+	Nonces(opts *bind.CallOpts, owner common.Address) (*big.Int, error)
+	NumCheckpoints(opts *bind.CallOpts, account common.Address) (uint32, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Symbol(opts *bind.CallOpts) (string, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+	TransferRestrictionsDisabledAfter(opts *bind.CallOpts) (*big.Int, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+// EigenWriter is the transacting surface of the Eigen
+// binding, implemented by EigenTransactor, Eigen and FakeEigen.
+type EigenWriter interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+	DecreaseAllowance(opts *bind.TransactOpts, spender common.Address, subtractedValue *big.Int) (*types.Transaction, error)
+	Delegate(opts *bind.TransactOpts, delegatee common.Address) (*types.Transaction, error)
+	DelegateBySig(opts *bind.TransactOpts, delegatee common.Address, nonce *big.Int, expiry *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error)
+	DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error)
+	IncreaseAllowance(opts *bind.TransactOpts, spender common.Address, addedValue *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, minters []common.Address, mintingAllowances []*big.Int, mintAllowedAfters []*big.Int) (*types.Transaction, error)
+	Mint(opts *bind.TransactOpts) (*types.Transaction, error)
+	Multisend(opts *bind.TransactOpts, receivers []common.Address, amounts []*big.Int) (*types.Transaction, error)
+	Permit(opts *bind.TransactOpts, owner common.Address, spender common.Address, value *big.Int, deadline *big.Int, v uint8, r [32]byte, s [32]byte) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error)
+	SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unwrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error)
+	Wrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error)
+}
+
+// EigenEvents is the event filtering surface of the Eigen
+// binding, implemented by EigenFilterer, Eigen and FakeEigen.
+type EigenEvents interface {
+	FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*EigenApprovalIterator, error)
+	WatchApproval(opts *bind.WatchOpts, sink chan<- *EigenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error)
+	ParseApproval(log types.Log) (*EigenApproval, error)
+	FilterDelegateChanged(opts *bind.FilterOpts, delegator []common.Address, fromDelegate []common.Address, toDelegate []common.Address) (*EigenDelegateChangedIterator, error)
+	WatchDelegateChanged(opts *bind.WatchOpts, sink chan<- *EigenDelegateChanged, delegator []common.Address, fromDelegate []common.Address, toDelegate []common.Address) (event.Subscription, error)
+	ParseDelegateChanged(log types.Log) (*EigenDelegateChanged, error)
+	FilterDelegateVotesChanged(opts *bind.FilterOpts, delegate []common.Address) (*EigenDelegateVotesChangedIterator, error)
+	WatchDelegateVotesChanged(opts *bind.WatchOpts, sink chan<- *EigenDelegateVotesChanged, delegate []common.Address) (event.Subscription, error)
+	ParseDelegateVotesChanged(log types.Log) (*EigenDelegateVotesChanged, error)
+	FilterEIP712DomainChanged(opts *bind.FilterOpts) (*EigenEIP712DomainChangedIterator, error)
+	WatchEIP712DomainChanged(opts *bind.WatchOpts, sink chan<- *EigenEIP712DomainChanged) (event.Subscription, error)
+	ParseEIP712DomainChanged(log types.Log) (*EigenEIP712DomainChanged, error)
+	FilterInitialized(opts *bind.FilterOpts) (*EigenInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *EigenInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*EigenInitialized, error)
+	FilterMint(opts *bind.FilterOpts, minter []common.Address) (*EigenMintIterator, error)
+	WatchMint(opts *bind.WatchOpts, sink chan<- *EigenMint, minter []common.Address) (event.Subscription, error)
+	ParseMint(log types.Log) (*EigenMint, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*EigenOwnershipTransferredIterator, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *EigenOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	ParseOwnershipTransferred(log types.Log) (*EigenOwnershipTransferred, error)
+	FilterSetAllowedFrom(opts *bind.FilterOpts, from []common.Address) (*EigenSetAllowedFromIterator, error)
+	WatchSetAllowedFrom(opts *bind.WatchOpts, sink chan<- *EigenSetAllowedFrom, from []common.Address) (event.Subscription, error)
+	ParseSetAllowedFrom(log types.Log) (*EigenSetAllowedFrom, error)
+	FilterSetAllowedTo(opts *bind.FilterOpts, to []common.Address) (*EigenSetAllowedToIterator, error)
+	WatchSetAllowedTo(opts *bind.WatchOpts, sink chan<- *EigenSetAllowedTo, to []common.Address) (event.Subscription, error)
+	ParseSetAllowedTo(log types.Log) (*EigenSetAllowedTo, error)
+	FilterTokenUnwrapped(opts *bind.FilterOpts, account []common.Address) (*EigenTokenUnwrappedIterator, error)
+	WatchTokenUnwrapped(opts *bind.WatchOpts, sink chan<- *EigenTokenUnwrapped, account []common.Address) (event.Subscription, error)
+	ParseTokenUnwrapped(log types.Log) (*EigenTokenUnwrapped, error)
+	FilterTokenWrapped(opts *bind.FilterOpts, account []common.Address) (*EigenTokenWrappedIterator, error)
+	WatchTokenWrapped(opts *bind.WatchOpts, sink chan<- *EigenTokenWrapped, account []common.Address) (event.Subscription, error)
+	ParseTokenWrapped(log types.Log) (*EigenTokenWrapped, error)
+	FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*EigenTransferIterator, error)
+	WatchTransfer(opts *bind.WatchOpts, sink chan<- *EigenTransfer, from []common.Address, to []common.Address) (event.Subscription, error)
+	ParseTransfer(log types.Log) (*EigenTransfer, error)
+	FilterTransferRestrictionsDisabled(opts *bind.FilterOpts) (*EigenTransferRestrictionsDisabledIterator, error)
+	WatchTransferRestrictionsDisabled(opts *bind.WatchOpts, sink chan<- *EigenTransferRestrictionsDisabled) (event.Subscription, error)
+	ParseTransferRestrictionsDisabled(log types.Log) (*EigenTransferRestrictionsDisabled, error)
+}
+
+var (
+	_ EigenReader = (*EigenCaller)(nil)
+	_ EigenReader = (*Eigen)(nil)
+	_ EigenReader = (*FakeEigen)(nil)
+	_ EigenWriter = (*EigenTransactor)(nil)
+	_ EigenWriter = (*Eigen)(nil)
+	_ EigenWriter = (*FakeEigen)(nil)
+	_ EigenEvents = (*EigenFilterer)(nil)
+	_ EigenEvents = (*Eigen)(nil)
+	_ EigenEvents = (*FakeEigen)(nil)
+)
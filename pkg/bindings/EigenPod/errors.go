@@ -0,0 +1,313 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPod
+
+// ErrBeaconTimestampBeforeLatestCheckpoint is the typed form of the EigenPod custom error BeaconTimestampBeforeLatestCheckpoint().
+type ErrBeaconTimestampBeforeLatestCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrBeaconTimestampBeforeLatestCheckpoint) Error() string {
+	return "EigenPod: BeaconTimestampBeforeLatestCheckpoint()"
+}
+
+// ErrBeaconTimestampTooFarInPast is the typed form of the EigenPod custom error BeaconTimestampTooFarInPast().
+type ErrBeaconTimestampTooFarInPast struct{}
+
+// Error implements the error interface.
+func (ErrBeaconTimestampTooFarInPast) Error() string {
+	return "EigenPod: BeaconTimestampTooFarInPast()"
+}
+
+// ErrCannotCheckpointTwiceInSingleBlock is the typed form of the EigenPod custom error CannotCheckpointTwiceInSingleBlock().
+type ErrCannotCheckpointTwiceInSingleBlock struct{}
+
+// Error implements the error interface.
+func (ErrCannotCheckpointTwiceInSingleBlock) Error() string {
+	return "EigenPod: CannotCheckpointTwiceInSingleBlock()"
+}
+
+// ErrCheckpointAlreadyActive is the typed form of the EigenPod custom error CheckpointAlreadyActive().
+type ErrCheckpointAlreadyActive struct{}
+
+// Error implements the error interface.
+func (ErrCheckpointAlreadyActive) Error() string {
+	return "EigenPod: CheckpointAlreadyActive()"
+}
+
+// ErrCredentialsAlreadyVerified is the typed form of the EigenPod custom error CredentialsAlreadyVerified().
+type ErrCredentialsAlreadyVerified struct{}
+
+// Error implements the error interface.
+func (ErrCredentialsAlreadyVerified) Error() string {
+	return "EigenPod: CredentialsAlreadyVerified()"
+}
+
+// ErrCurrentlyPaused is the typed form of the EigenPod custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "EigenPod: CurrentlyPaused()"
+}
+
+// ErrEmptyRoot is the typed form of the EigenPod custom error EmptyRoot().
+type ErrEmptyRoot struct{}
+
+// Error implements the error interface.
+func (ErrEmptyRoot) Error() string {
+	return "EigenPod: EmptyRoot()"
+}
+
+// ErrFeeQueryFailed is the typed form of the EigenPod custom error FeeQueryFailed().
+type ErrFeeQueryFailed struct{}
+
+// Error implements the error interface.
+func (ErrFeeQueryFailed) Error() string {
+	return "EigenPod: FeeQueryFailed()"
+}
+
+// ErrForkTimestampZero is the typed form of the EigenPod custom error ForkTimestampZero().
+type ErrForkTimestampZero struct{}
+
+// Error implements the error interface.
+func (ErrForkTimestampZero) Error() string {
+	return "EigenPod: ForkTimestampZero()"
+}
+
+// ErrInputAddressZero is the typed form of the EigenPod custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "EigenPod: InputAddressZero()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the EigenPod custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "EigenPod: InputArrayLengthMismatch()"
+}
+
+// ErrInsufficientFunds is the typed form of the EigenPod custom error InsufficientFunds().
+type ErrInsufficientFunds struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientFunds) Error() string {
+	return "EigenPod: InsufficientFunds()"
+}
+
+// ErrInsufficientWithdrawableBalance is the typed form of the EigenPod custom error InsufficientWithdrawableBalance().
+type ErrInsufficientWithdrawableBalance struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientWithdrawableBalance) Error() string {
+	return "EigenPod: InsufficientWithdrawableBalance()"
+}
+
+// ErrInvalidEIP4788Response is the typed form of the EigenPod custom error InvalidEIP4788Response().
+type ErrInvalidEIP4788Response struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEIP4788Response) Error() string {
+	return "EigenPod: InvalidEIP4788Response()"
+}
+
+// ErrInvalidIndex is the typed form of the EigenPod custom error InvalidIndex().
+type ErrInvalidIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidIndex) Error() string {
+	return "EigenPod: InvalidIndex()"
+}
+
+// ErrInvalidProof is the typed form of the EigenPod custom error InvalidProof().
+type ErrInvalidProof struct{}
+
+// Error implements the error interface.
+func (ErrInvalidProof) Error() string {
+	return "EigenPod: InvalidProof()"
+}
+
+// ErrInvalidProofLength is the typed form of the EigenPod custom error InvalidProofLength().
+type ErrInvalidProofLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidProofLength) Error() string {
+	return "EigenPod: InvalidProofLength()"
+}
+
+// ErrInvalidPubKeyLength is the typed form of the EigenPod custom error InvalidPubKeyLength().
+type ErrInvalidPubKeyLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPubKeyLength) Error() string {
+	return "EigenPod: InvalidPubKeyLength()"
+}
+
+// ErrInvalidValidatorFieldsLength is the typed form of the EigenPod custom error InvalidValidatorFieldsLength().
+type ErrInvalidValidatorFieldsLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidValidatorFieldsLength) Error() string {
+	return "EigenPod: InvalidValidatorFieldsLength()"
+}
+
+// ErrLeavesNotPowerOfTwo is the typed form of the EigenPod custom error LeavesNotPowerOfTwo().
+type ErrLeavesNotPowerOfTwo struct{}
+
+// Error implements the error interface.
+func (ErrLeavesNotPowerOfTwo) Error() string {
+	return "EigenPod: LeavesNotPowerOfTwo()"
+}
+
+// ErrMsgValueNot32ETH is the typed form of the EigenPod custom error MsgValueNot32ETH().
+type ErrMsgValueNot32ETH struct{}
+
+// Error implements the error interface.
+func (ErrMsgValueNot32ETH) Error() string {
+	return "EigenPod: MsgValueNot32ETH()"
+}
+
+// ErrNoActiveCheckpoint is the typed form of the EigenPod custom error NoActiveCheckpoint().
+type ErrNoActiveCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrNoActiveCheckpoint) Error() string {
+	return "EigenPod: NoActiveCheckpoint()"
+}
+
+// ErrNoBalanceToCheckpoint is the typed form of the EigenPod custom error NoBalanceToCheckpoint().
+type ErrNoBalanceToCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrNoBalanceToCheckpoint) Error() string {
+	return "EigenPod: NoBalanceToCheckpoint()"
+}
+
+// ErrNotEnoughLeaves is the typed form of the EigenPod custom error NotEnoughLeaves().
+type ErrNotEnoughLeaves struct{}
+
+// Error implements the error interface.
+func (ErrNotEnoughLeaves) Error() string {
+	return "EigenPod: NotEnoughLeaves()"
+}
+
+// ErrOnlyEigenPodManager is the typed form of the EigenPod custom error OnlyEigenPodManager().
+type ErrOnlyEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodManager) Error() string {
+	return "EigenPod: OnlyEigenPodManager()"
+}
+
+// ErrOnlyEigenPodOwner is the typed form of the EigenPod custom error OnlyEigenPodOwner().
+type ErrOnlyEigenPodOwner struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodOwner) Error() string {
+	return "EigenPod: OnlyEigenPodOwner()"
+}
+
+// ErrOnlyEigenPodOwnerOrProofSubmitter is the typed form of the EigenPod custom error OnlyEigenPodOwnerOrProofSubmitter().
+type ErrOnlyEigenPodOwnerOrProofSubmitter struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodOwnerOrProofSubmitter) Error() string {
+	return "EigenPod: OnlyEigenPodOwnerOrProofSubmitter()"
+}
+
+// ErrPredeployFailed is the typed form of the EigenPod custom error PredeployFailed().
+type ErrPredeployFailed struct{}
+
+// Error implements the error interface.
+func (ErrPredeployFailed) Error() string {
+	return "EigenPod: PredeployFailed()"
+}
+
+// ErrTimestampOutOfRange is the typed form of the EigenPod custom error TimestampOutOfRange().
+type ErrTimestampOutOfRange struct{}
+
+// Error implements the error interface.
+func (ErrTimestampOutOfRange) Error() string {
+	return "EigenPod: TimestampOutOfRange()"
+}
+
+// ErrValidatorInactiveOnBeaconChain is the typed form of the EigenPod custom error ValidatorInactiveOnBeaconChain().
+type ErrValidatorInactiveOnBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorInactiveOnBeaconChain) Error() string {
+	return "EigenPod: ValidatorInactiveOnBeaconChain()"
+}
+
+// ErrValidatorIsExitingBeaconChain is the typed form of the EigenPod custom error ValidatorIsExitingBeaconChain().
+type ErrValidatorIsExitingBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorIsExitingBeaconChain) Error() string {
+	return "EigenPod: ValidatorIsExitingBeaconChain()"
+}
+
+// ErrValidatorNotActiveInPod is the typed form of the EigenPod custom error ValidatorNotActiveInPod().
+type ErrValidatorNotActiveInPod struct{}
+
+// Error implements the error interface.
+func (ErrValidatorNotActiveInPod) Error() string {
+	return "EigenPod: ValidatorNotActiveInPod()"
+}
+
+// ErrValidatorNotSlashedOnBeaconChain is the typed form of the EigenPod custom error ValidatorNotSlashedOnBeaconChain().
+type ErrValidatorNotSlashedOnBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorNotSlashedOnBeaconChain) Error() string {
+	return "EigenPod: ValidatorNotSlashedOnBeaconChain()"
+}
+
+// ErrWithdrawalCredentialsNotForEigenPod is the typed form of the EigenPod custom error WithdrawalCredentialsNotForEigenPod().
+type ErrWithdrawalCredentialsNotForEigenPod struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalCredentialsNotForEigenPod) Error() string {
+	return "EigenPod: WithdrawalCredentialsNotForEigenPod()"
+}
+
+// EigenPodErrors maps the name of every custom error in the EigenPod ABI to its typed Go error.
+var EigenPodErrors = map[string]error{
+	"BeaconTimestampBeforeLatestCheckpoint": ErrBeaconTimestampBeforeLatestCheckpoint{},
+	"BeaconTimestampTooFarInPast":           ErrBeaconTimestampTooFarInPast{},
+	"CannotCheckpointTwiceInSingleBlock":    ErrCannotCheckpointTwiceInSingleBlock{},
+	"CheckpointAlreadyActive":               ErrCheckpointAlreadyActive{},
+	"CredentialsAlreadyVerified":            ErrCredentialsAlreadyVerified{},
+	"CurrentlyPaused":                       ErrCurrentlyPaused{},
+	"EmptyRoot":                             ErrEmptyRoot{},
+	"FeeQueryFailed":                        ErrFeeQueryFailed{},
+	"ForkTimestampZero":                     ErrForkTimestampZero{},
+	"InputAddressZero":                      ErrInputAddressZero{},
+	"InputArrayLengthMismatch":              ErrInputArrayLengthMismatch{},
+	"InsufficientFunds":                     ErrInsufficientFunds{},
+	"InsufficientWithdrawableBalance":       ErrInsufficientWithdrawableBalance{},
+	"InvalidEIP4788Response":                ErrInvalidEIP4788Response{},
+	"InvalidIndex":                          ErrInvalidIndex{},
+	"InvalidProof":                          ErrInvalidProof{},
+	"InvalidProofLength":                    ErrInvalidProofLength{},
+	"InvalidPubKeyLength":                   ErrInvalidPubKeyLength{},
+	"InvalidValidatorFieldsLength":          ErrInvalidValidatorFieldsLength{},
+	"LeavesNotPowerOfTwo":                   ErrLeavesNotPowerOfTwo{},
+	"MsgValueNot32ETH":                      ErrMsgValueNot32ETH{},
+	"NoActiveCheckpoint":                    ErrNoActiveCheckpoint{},
+	"NoBalanceToCheckpoint":                 ErrNoBalanceToCheckpoint{},
+	"NotEnoughLeaves":                       ErrNotEnoughLeaves{},
+	"OnlyEigenPodManager":                   ErrOnlyEigenPodManager{},
+	"OnlyEigenPodOwner":                     ErrOnlyEigenPodOwner{},
+	"OnlyEigenPodOwnerOrProofSubmitter":     ErrOnlyEigenPodOwnerOrProofSubmitter{},
+	"PredeployFailed":                       ErrPredeployFailed{},
+	"TimestampOutOfRange":                   ErrTimestampOutOfRange{},
+	"ValidatorInactiveOnBeaconChain":        ErrValidatorInactiveOnBeaconChain{},
+	"ValidatorIsExitingBeaconChain":         ErrValidatorIsExitingBeaconChain{},
+	"ValidatorNotActiveInPod":               ErrValidatorNotActiveInPod{},
+	"ValidatorNotSlashedOnBeaconChain":      ErrValidatorNotSlashedOnBeaconChain{},
+	"WithdrawalCredentialsNotForEigenPod":   ErrWithdrawalCredentialsNotForEigenPod{},
+}
@@ -0,0 +1,501 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPod
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeEigenPod is an in-memory EigenPod for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded EigenPodFilterer.
+type FakeEigenPod struct {
+	*fake.Contract
+	*EigenPodFilterer
+}
+
+// NewFakeEigenPod returns an empty fake of the contract at address.
+func NewFakeEigenPod(address common.Address) *FakeEigenPod {
+	contract := fake.NewContract(EigenPodMetaData, address)
+	filterer, err := NewEigenPodFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeEigenPod{Contract: contract, EigenPodFilterer: filterer}
+}
+
+// ActiveValidatorCount returns the values stubbed with StubActiveValidatorCount.
+func (_FakeEigenPod *FakeEigenPod) ActiveValidatorCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPod.Contract.Read("activeValidatorCount", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubActiveValidatorCount sets what ActiveValidatorCount returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubActiveValidatorCount(out0 *big.Int) {
+	_FakeEigenPod.Contract.Stub("activeValidatorCount", []interface{}{}, out0)
+}
+
+// CheckpointBalanceExitedGwei returns the values stubbed with StubCheckpointBalanceExitedGwei.
+func (_FakeEigenPod *FakeEigenPod) CheckpointBalanceExitedGwei(opts *bind.CallOpts, arg0 uint64) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPod.Contract.Read("checkpointBalanceExitedGwei", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubCheckpointBalanceExitedGwei sets what CheckpointBalanceExitedGwei returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubCheckpointBalanceExitedGwei(arg0 uint64, out0 uint64) {
+	_FakeEigenPod.Contract.Stub("checkpointBalanceExitedGwei", []interface{}{arg0}, out0)
+}
+
+// CurrentCheckpoint returns the values stubbed with StubCurrentCheckpoint.
+func (_FakeEigenPod *FakeEigenPod) CurrentCheckpoint(opts *bind.CallOpts) (IEigenPodTypesCheckpoint, error) {
+	var out0 IEigenPodTypesCheckpoint
+	err := _FakeEigenPod.Contract.Read("currentCheckpoint", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrentCheckpoint sets what CurrentCheckpoint returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubCurrentCheckpoint(out0 IEigenPodTypesCheckpoint) {
+	_FakeEigenPod.Contract.Stub("currentCheckpoint", []interface{}{}, out0)
+}
+
+// CurrentCheckpointTimestamp returns the values stubbed with StubCurrentCheckpointTimestamp.
+func (_FakeEigenPod *FakeEigenPod) CurrentCheckpointTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPod.Contract.Read("currentCheckpointTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrentCheckpointTimestamp sets what CurrentCheckpointTimestamp returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubCurrentCheckpointTimestamp(out0 uint64) {
+	_FakeEigenPod.Contract.Stub("currentCheckpointTimestamp", []interface{}{}, out0)
+}
+
+// EigenPodManager returns the values stubbed with StubEigenPodManager.
+func (_FakeEigenPod *FakeEigenPod) EigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPod.Contract.Read("eigenPodManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenPodManager sets what EigenPodManager returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubEigenPodManager(out0 common.Address) {
+	_FakeEigenPod.Contract.Stub("eigenPodManager", []interface{}{}, out0)
+}
+
+// EthPOS returns the values stubbed with StubEthPOS.
+func (_FakeEigenPod *FakeEigenPod) EthPOS(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPod.Contract.Read("ethPOS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEthPOS sets what EthPOS returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubEthPOS(out0 common.Address) {
+	_FakeEigenPod.Contract.Stub("ethPOS", []interface{}{}, out0)
+}
+
+// GetConsolidationRequestFee returns the values stubbed with StubGetConsolidationRequestFee.
+func (_FakeEigenPod *FakeEigenPod) GetConsolidationRequestFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPod.Contract.Read("getConsolidationRequestFee", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetConsolidationRequestFee sets what GetConsolidationRequestFee returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubGetConsolidationRequestFee(out0 *big.Int) {
+	_FakeEigenPod.Contract.Stub("getConsolidationRequestFee", []interface{}{}, out0)
+}
+
+// GetParentBlockRoot returns the values stubbed with StubGetParentBlockRoot.
+func (_FakeEigenPod *FakeEigenPod) GetParentBlockRoot(opts *bind.CallOpts, timestamp uint64) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeEigenPod.Contract.Read("getParentBlockRoot", []interface{}{timestamp}, &out0)
+	return out0, err
+}
+
+// StubGetParentBlockRoot sets what GetParentBlockRoot returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubGetParentBlockRoot(timestamp uint64, out0 [32]byte) {
+	_FakeEigenPod.Contract.Stub("getParentBlockRoot", []interface{}{timestamp}, out0)
+}
+
+// GetWithdrawalRequestFee returns the values stubbed with StubGetWithdrawalRequestFee.
+func (_FakeEigenPod *FakeEigenPod) GetWithdrawalRequestFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPod.Contract.Read("getWithdrawalRequestFee", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetWithdrawalRequestFee sets what GetWithdrawalRequestFee returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubGetWithdrawalRequestFee(out0 *big.Int) {
+	_FakeEigenPod.Contract.Stub("getWithdrawalRequestFee", []interface{}{}, out0)
+}
+
+// LastCheckpointTimestamp returns the values stubbed with StubLastCheckpointTimestamp.
+func (_FakeEigenPod *FakeEigenPod) LastCheckpointTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPod.Contract.Read("lastCheckpointTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubLastCheckpointTimestamp sets what LastCheckpointTimestamp returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubLastCheckpointTimestamp(out0 uint64) {
+	_FakeEigenPod.Contract.Stub("lastCheckpointTimestamp", []interface{}{}, out0)
+}
+
+// PodOwner returns the values stubbed with StubPodOwner.
+func (_FakeEigenPod *FakeEigenPod) PodOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPod.Contract.Read("podOwner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPodOwner sets what PodOwner returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubPodOwner(out0 common.Address) {
+	_FakeEigenPod.Contract.Stub("podOwner", []interface{}{}, out0)
+}
+
+// ProofSubmitter returns the values stubbed with StubProofSubmitter.
+func (_FakeEigenPod *FakeEigenPod) ProofSubmitter(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPod.Contract.Read("proofSubmitter", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubProofSubmitter sets what ProofSubmitter returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubProofSubmitter(out0 common.Address) {
+	_FakeEigenPod.Contract.Stub("proofSubmitter", []interface{}{}, out0)
+}
+
+// ValidatorPubkeyHashToInfo returns the values stubbed with StubValidatorPubkeyHashToInfo.
+func (_FakeEigenPod *FakeEigenPod) ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (IEigenPodTypesValidatorInfo, error) {
+	var out0 IEigenPodTypesValidatorInfo
+	err := _FakeEigenPod.Contract.Read("validatorPubkeyHashToInfo", []interface{}{validatorPubkeyHash}, &out0)
+	return out0, err
+}
+
+// StubValidatorPubkeyHashToInfo sets what ValidatorPubkeyHashToInfo returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubValidatorPubkeyHashToInfo(validatorPubkeyHash [32]byte, out0 IEigenPodTypesValidatorInfo) {
+	_FakeEigenPod.Contract.Stub("validatorPubkeyHashToInfo", []interface{}{validatorPubkeyHash}, out0)
+}
+
+// ValidatorPubkeyToInfo returns the values stubbed with StubValidatorPubkeyToInfo.
+func (_FakeEigenPod *FakeEigenPod) ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (IEigenPodTypesValidatorInfo, error) {
+	var out0 IEigenPodTypesValidatorInfo
+	err := _FakeEigenPod.Contract.Read("validatorPubkeyToInfo", []interface{}{validatorPubkey}, &out0)
+	return out0, err
+}
+
+// StubValidatorPubkeyToInfo sets what ValidatorPubkeyToInfo returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubValidatorPubkeyToInfo(validatorPubkey []byte, out0 IEigenPodTypesValidatorInfo) {
+	_FakeEigenPod.Contract.Stub("validatorPubkeyToInfo", []interface{}{validatorPubkey}, out0)
+}
+
+// ValidatorStatus returns the values stubbed with StubValidatorStatus.
+func (_FakeEigenPod *FakeEigenPod) ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeEigenPod.Contract.Read("validatorStatus", []interface{}{validatorPubkey}, &out0)
+	return out0, err
+}
+
+// StubValidatorStatus sets what ValidatorStatus returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubValidatorStatus(validatorPubkey []byte, out0 uint8) {
+	_FakeEigenPod.Contract.Stub("validatorStatus", []interface{}{validatorPubkey}, out0)
+}
+
+// ValidatorStatus0 returns the values stubbed with StubValidatorStatus0.
+func (_FakeEigenPod *FakeEigenPod) ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeEigenPod.Contract.Read("validatorStatus0", []interface{}{pubkeyHash}, &out0)
+	return out0, err
+}
+
+// StubValidatorStatus0 sets what ValidatorStatus0 returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubValidatorStatus0(pubkeyHash [32]byte, out0 uint8) {
+	_FakeEigenPod.Contract.Stub("validatorStatus0", []interface{}{pubkeyHash}, out0)
+}
+
+// WithdrawableRestakedExecutionLayerGwei returns the values stubbed with StubWithdrawableRestakedExecutionLayerGwei.
+func (_FakeEigenPod *FakeEigenPod) WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPod.Contract.Read("withdrawableRestakedExecutionLayerGwei", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubWithdrawableRestakedExecutionLayerGwei sets what WithdrawableRestakedExecutionLayerGwei returns for the given arguments.
+func (_FakeEigenPod *FakeEigenPod) StubWithdrawableRestakedExecutionLayerGwei(out0 uint64) {
+	_FakeEigenPod.Contract.Stub("withdrawableRestakedExecutionLayerGwei", []interface{}{}, out0)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeEigenPod *FakeEigenPod) Initialize(opts *bind.TransactOpts, _podOwner common.Address) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("initialize").(func(opts *bind.TransactOpts, _podOwner common.Address) error); ok {
+			return hook(opts, _podOwner)
+		}
+		return nil
+	}, _podOwner)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnInitialize(hook func(opts *bind.TransactOpts, _podOwner common.Address) error) {
+	_FakeEigenPod.Contract.SetHook("initialize", hook)
+}
+
+// RecoverTokens records a recoverTokens call after running the hook set with OnRecoverTokens.
+func (_FakeEigenPod *FakeEigenPod) RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "recoverTokens", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("recoverTokens").(func(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) error); ok {
+			return hook(opts, tokenList, amountsToWithdraw, recipient)
+		}
+		return nil
+	}, tokenList, amountsToWithdraw, recipient)
+}
+
+// OnRecoverTokens sets a hook run by every RecoverTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnRecoverTokens(hook func(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) error) {
+	_FakeEigenPod.Contract.SetHook("recoverTokens", hook)
+}
+
+// RequestConsolidation records a requestConsolidation call after running the hook set with OnRequestConsolidation.
+func (_FakeEigenPod *FakeEigenPod) RequestConsolidation(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "requestConsolidation", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("requestConsolidation").(func(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) error); ok {
+			return hook(opts, requests)
+		}
+		return nil
+	}, requests)
+}
+
+// OnRequestConsolidation sets a hook run by every RequestConsolidation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnRequestConsolidation(hook func(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) error) {
+	_FakeEigenPod.Contract.SetHook("requestConsolidation", hook)
+}
+
+// RequestWithdrawal records a requestWithdrawal call after running the hook set with OnRequestWithdrawal.
+func (_FakeEigenPod *FakeEigenPod) RequestWithdrawal(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "requestWithdrawal", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("requestWithdrawal").(func(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) error); ok {
+			return hook(opts, requests)
+		}
+		return nil
+	}, requests)
+}
+
+// OnRequestWithdrawal sets a hook run by every RequestWithdrawal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnRequestWithdrawal(hook func(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) error) {
+	_FakeEigenPod.Contract.SetHook("requestWithdrawal", hook)
+}
+
+// SetProofSubmitter records a setProofSubmitter call after running the hook set with OnSetProofSubmitter.
+func (_FakeEigenPod *FakeEigenPod) SetProofSubmitter(opts *bind.TransactOpts, newProofSubmitter common.Address) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "setProofSubmitter", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("setProofSubmitter").(func(opts *bind.TransactOpts, newProofSubmitter common.Address) error); ok {
+			return hook(opts, newProofSubmitter)
+		}
+		return nil
+	}, newProofSubmitter)
+}
+
+// OnSetProofSubmitter sets a hook run by every SetProofSubmitter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnSetProofSubmitter(hook func(opts *bind.TransactOpts, newProofSubmitter common.Address) error) {
+	_FakeEigenPod.Contract.SetHook("setProofSubmitter", hook)
+}
+
+// Stake records a stake call after running the hook set with OnStake.
+func (_FakeEigenPod *FakeEigenPod) Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "stake", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("stake").(func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error); ok {
+			return hook(opts, pubkey, signature, depositDataRoot)
+		}
+		return nil
+	}, pubkey, signature, depositDataRoot)
+}
+
+// OnStake sets a hook run by every Stake call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnStake(hook func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error) {
+	_FakeEigenPod.Contract.SetHook("stake", hook)
+}
+
+// StartCheckpoint records a startCheckpoint call after running the hook set with OnStartCheckpoint.
+func (_FakeEigenPod *FakeEigenPod) StartCheckpoint(opts *bind.TransactOpts, revertIfNoBalance bool) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "startCheckpoint", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("startCheckpoint").(func(opts *bind.TransactOpts, revertIfNoBalance bool) error); ok {
+			return hook(opts, revertIfNoBalance)
+		}
+		return nil
+	}, revertIfNoBalance)
+}
+
+// OnStartCheckpoint sets a hook run by every StartCheckpoint call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnStartCheckpoint(hook func(opts *bind.TransactOpts, revertIfNoBalance bool) error) {
+	_FakeEigenPod.Contract.SetHook("startCheckpoint", hook)
+}
+
+// VerifyCheckpointProofs records a verifyCheckpointProofs call after running the hook set with OnVerifyCheckpointProofs.
+func (_FakeEigenPod *FakeEigenPod) VerifyCheckpointProofs(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "verifyCheckpointProofs", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("verifyCheckpointProofs").(func(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) error); ok {
+			return hook(opts, balanceContainerProof, proofs)
+		}
+		return nil
+	}, balanceContainerProof, proofs)
+}
+
+// OnVerifyCheckpointProofs sets a hook run by every VerifyCheckpointProofs call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnVerifyCheckpointProofs(hook func(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) error) {
+	_FakeEigenPod.Contract.SetHook("verifyCheckpointProofs", hook)
+}
+
+// VerifyStaleBalance records a verifyStaleBalance call after running the hook set with OnVerifyStaleBalance.
+func (_FakeEigenPod *FakeEigenPod) VerifyStaleBalance(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "verifyStaleBalance", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("verifyStaleBalance").(func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) error); ok {
+			return hook(opts, beaconTimestamp, stateRootProof, proof)
+		}
+		return nil
+	}, beaconTimestamp, stateRootProof, proof)
+}
+
+// OnVerifyStaleBalance sets a hook run by every VerifyStaleBalance call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnVerifyStaleBalance(hook func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) error) {
+	_FakeEigenPod.Contract.SetHook("verifyStaleBalance", hook)
+}
+
+// VerifyWithdrawalCredentials records a verifyWithdrawalCredentials call after running the hook set with OnVerifyWithdrawalCredentials.
+func (_FakeEigenPod *FakeEigenPod) VerifyWithdrawalCredentials(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "verifyWithdrawalCredentials", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("verifyWithdrawalCredentials").(func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) error); ok {
+			return hook(opts, beaconTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+		}
+		return nil
+	}, beaconTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+}
+
+// OnVerifyWithdrawalCredentials sets a hook run by every VerifyWithdrawalCredentials call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnVerifyWithdrawalCredentials(hook func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) error) {
+	_FakeEigenPod.Contract.SetHook("verifyWithdrawalCredentials", hook)
+}
+
+// WithdrawRestakedBeaconChainETH records a withdrawRestakedBeaconChainETH call after running the hook set with OnWithdrawRestakedBeaconChainETH.
+func (_FakeEigenPod *FakeEigenPod) WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amountWei *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "withdrawRestakedBeaconChainETH", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("withdrawRestakedBeaconChainETH").(func(opts *bind.TransactOpts, recipient common.Address, amountWei *big.Int) error); ok {
+			return hook(opts, recipient, amountWei)
+		}
+		return nil
+	}, recipient, amountWei)
+}
+
+// OnWithdrawRestakedBeaconChainETH sets a hook run by every WithdrawRestakedBeaconChainETH call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnWithdrawRestakedBeaconChainETH(hook func(opts *bind.TransactOpts, recipient common.Address, amountWei *big.Int) error) {
+	_FakeEigenPod.Contract.SetHook("withdrawRestakedBeaconChainETH", hook)
+}
+
+// Receive records a receive call after running the hook set with OnReceive.
+func (_FakeEigenPod *FakeEigenPod) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigenPod.Contract.Transact(opts, "receive", func() error {
+		if hook, ok := _FakeEigenPod.Contract.Hook("receive").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnReceive sets a hook run by every Receive call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPod *FakeEigenPod) OnReceive(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigenPod.Contract.SetHook("receive", hook)
+}
+
+// EmitCheckpointCreated appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitCheckpointCreated(event *EigenPodCheckpointCreated) {
+	_FakeEigenPod.Contract.Emit("CheckpointCreated", event)
+}
+
+// EmitCheckpointFinalized appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitCheckpointFinalized(event *EigenPodCheckpointFinalized) {
+	_FakeEigenPod.Contract.Emit("CheckpointFinalized", event)
+}
+
+// EmitConsolidationRequested appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitConsolidationRequested(event *EigenPodConsolidationRequested) {
+	_FakeEigenPod.Contract.Emit("ConsolidationRequested", event)
+}
+
+// EmitEigenPodStaked appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitEigenPodStaked(event *EigenPodEigenPodStaked) {
+	_FakeEigenPod.Contract.Emit("EigenPodStaked", event)
+}
+
+// EmitExitRequested appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitExitRequested(event *EigenPodExitRequested) {
+	_FakeEigenPod.Contract.Emit("ExitRequested", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitInitialized(event *EigenPodInitialized) {
+	_FakeEigenPod.Contract.Emit("Initialized", event)
+}
+
+// EmitNonBeaconChainETHReceived appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitNonBeaconChainETHReceived(event *EigenPodNonBeaconChainETHReceived) {
+	_FakeEigenPod.Contract.Emit("NonBeaconChainETHReceived", event)
+}
+
+// EmitProofSubmitterUpdated appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitProofSubmitterUpdated(event *EigenPodProofSubmitterUpdated) {
+	_FakeEigenPod.Contract.Emit("ProofSubmitterUpdated", event)
+}
+
+// EmitRestakedBeaconChainETHWithdrawn appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitRestakedBeaconChainETHWithdrawn(event *EigenPodRestakedBeaconChainETHWithdrawn) {
+	_FakeEigenPod.Contract.Emit("RestakedBeaconChainETHWithdrawn", event)
+}
+
+// EmitSwitchToCompoundingRequested appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitSwitchToCompoundingRequested(event *EigenPodSwitchToCompoundingRequested) {
+	_FakeEigenPod.Contract.Emit("SwitchToCompoundingRequested", event)
+}
+
+// EmitValidatorBalanceUpdated appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitValidatorBalanceUpdated(event *EigenPodValidatorBalanceUpdated) {
+	_FakeEigenPod.Contract.Emit("ValidatorBalanceUpdated", event)
+}
+
+// EmitValidatorCheckpointed appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitValidatorCheckpointed(event *EigenPodValidatorCheckpointed) {
+	_FakeEigenPod.Contract.Emit("ValidatorCheckpointed", event)
+}
+
+// EmitValidatorRestaked appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitValidatorRestaked(event *EigenPodValidatorRestaked) {
+	_FakeEigenPod.Contract.Emit("ValidatorRestaked", event)
+}
+
+// EmitValidatorWithdrawn appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitValidatorWithdrawn(event *EigenPodValidatorWithdrawn) {
+	_FakeEigenPod.Contract.Emit("ValidatorWithdrawn", event)
+}
+
+// EmitWithdrawalRequested appends the event to the fake's logs.
+func (_FakeEigenPod *FakeEigenPod) EmitWithdrawalRequested(event *EigenPodWithdrawalRequested) {
+	_FakeEigenPod.Contract.Emit("WithdrawalRequested", event)
+}
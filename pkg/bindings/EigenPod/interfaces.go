@@ -0,0 +1,112 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPod
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// EigenPodReader is the read-only surface of the EigenPod
+// binding, implemented by EigenPodCaller, EigenPod and FakeEigenPod.
+type EigenPodReader interface {
+	ActiveValidatorCount(opts *bind.CallOpts) (*big.Int, error)
+	CheckpointBalanceExitedGwei(opts *bind.CallOpts, arg0 uint64) (uint64, error)
+	CurrentCheckpoint(opts *bind.CallOpts) (IEigenPodTypesCheckpoint, error)
+	CurrentCheckpointTimestamp(opts *bind.CallOpts) (uint64, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	EthPOS(opts *bind.CallOpts) (common.Address, error)
+	GetConsolidationRequestFee(opts *bind.CallOpts) (*big.Int, error)
+	GetParentBlockRoot(opts *bind.CallOpts, timestamp uint64) ([32]byte, error)
+	GetWithdrawalRequestFee(opts *bind.CallOpts) (*big.Int, error)
+	LastCheckpointTimestamp(opts *bind.CallOpts) (uint64, error)
+	PodOwner(opts *bind.CallOpts) (common.Address, error)
+	ProofSubmitter(opts *bind.CallOpts) (common.Address, error)
+	ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (IEigenPodTypesValidatorInfo, error)
+	ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (IEigenPodTypesValidatorInfo, error)
+	ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error)
+	ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error)
+	WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error)
+}
+
+// EigenPodWriter is the transacting surface of the EigenPod
+// binding, implemented by EigenPodTransactor, EigenPod and FakeEigenPod.
+type EigenPodWriter interface {
+	Initialize(opts *bind.TransactOpts, _podOwner common.Address) (*types.Transaction, error)
+	RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error)
+	RequestConsolidation(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) (*types.Transaction, error)
+	RequestWithdrawal(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) (*types.Transaction, error)
+	SetProofSubmitter(opts *bind.TransactOpts, newProofSubmitter common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	StartCheckpoint(opts *bind.TransactOpts, revertIfNoBalance bool) (*types.Transaction, error)
+	VerifyCheckpointProofs(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) (*types.Transaction, error)
+	VerifyStaleBalance(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) (*types.Transaction, error)
+	VerifyWithdrawalCredentials(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+	WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amountWei *big.Int) (*types.Transaction, error)
+	Receive(opts *bind.TransactOpts) (*types.Transaction, error)
+}
+
+// EigenPodEvents is the event filtering surface of the EigenPod
+// binding, implemented by EigenPodFilterer, EigenPod and FakeEigenPod.
+type EigenPodEvents interface {
+	FilterCheckpointCreated(opts *bind.FilterOpts, checkpointTimestamp []uint64, beaconBlockRoot [][32]byte) (*EigenPodCheckpointCreatedIterator, error)
+	WatchCheckpointCreated(opts *bind.WatchOpts, sink chan<- *EigenPodCheckpointCreated, checkpointTimestamp []uint64, beaconBlockRoot [][32]byte) (event.Subscription, error)
+	ParseCheckpointCreated(log types.Log) (*EigenPodCheckpointCreated, error)
+	FilterCheckpointFinalized(opts *bind.FilterOpts, checkpointTimestamp []uint64) (*EigenPodCheckpointFinalizedIterator, error)
+	WatchCheckpointFinalized(opts *bind.WatchOpts, sink chan<- *EigenPodCheckpointFinalized, checkpointTimestamp []uint64) (event.Subscription, error)
+	ParseCheckpointFinalized(log types.Log) (*EigenPodCheckpointFinalized, error)
+	FilterConsolidationRequested(opts *bind.FilterOpts, sourcePubkeyHash [][32]byte, targetPubkeyHash [][32]byte) (*EigenPodConsolidationRequestedIterator, error)
+	WatchConsolidationRequested(opts *bind.WatchOpts, sink chan<- *EigenPodConsolidationRequested, sourcePubkeyHash [][32]byte, targetPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseConsolidationRequested(log types.Log) (*EigenPodConsolidationRequested, error)
+	FilterEigenPodStaked(opts *bind.FilterOpts) (*EigenPodEigenPodStakedIterator, error)
+	WatchEigenPodStaked(opts *bind.WatchOpts, sink chan<- *EigenPodEigenPodStaked) (event.Subscription, error)
+	ParseEigenPodStaked(log types.Log) (*EigenPodEigenPodStaked, error)
+	FilterExitRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*EigenPodExitRequestedIterator, error)
+	WatchExitRequested(opts *bind.WatchOpts, sink chan<- *EigenPodExitRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseExitRequested(log types.Log) (*EigenPodExitRequested, error)
+	FilterInitialized(opts *bind.FilterOpts) (*EigenPodInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *EigenPodInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*EigenPodInitialized, error)
+	FilterNonBeaconChainETHReceived(opts *bind.FilterOpts) (*EigenPodNonBeaconChainETHReceivedIterator, error)
+	WatchNonBeaconChainETHReceived(opts *bind.WatchOpts, sink chan<- *EigenPodNonBeaconChainETHReceived) (event.Subscription, error)
+	ParseNonBeaconChainETHReceived(log types.Log) (*EigenPodNonBeaconChainETHReceived, error)
+	FilterProofSubmitterUpdated(opts *bind.FilterOpts) (*EigenPodProofSubmitterUpdatedIterator, error)
+	WatchProofSubmitterUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodProofSubmitterUpdated) (event.Subscription, error)
+	ParseProofSubmitterUpdated(log types.Log) (*EigenPodProofSubmitterUpdated, error)
+	FilterRestakedBeaconChainETHWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*EigenPodRestakedBeaconChainETHWithdrawnIterator, error)
+	WatchRestakedBeaconChainETHWithdrawn(opts *bind.WatchOpts, sink chan<- *EigenPodRestakedBeaconChainETHWithdrawn, recipient []common.Address) (event.Subscription, error)
+	ParseRestakedBeaconChainETHWithdrawn(log types.Log) (*EigenPodRestakedBeaconChainETHWithdrawn, error)
+	FilterSwitchToCompoundingRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*EigenPodSwitchToCompoundingRequestedIterator, error)
+	WatchSwitchToCompoundingRequested(opts *bind.WatchOpts, sink chan<- *EigenPodSwitchToCompoundingRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseSwitchToCompoundingRequested(log types.Log) (*EigenPodSwitchToCompoundingRequested, error)
+	FilterValidatorBalanceUpdated(opts *bind.FilterOpts) (*EigenPodValidatorBalanceUpdatedIterator, error)
+	WatchValidatorBalanceUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodValidatorBalanceUpdated) (event.Subscription, error)
+	ParseValidatorBalanceUpdated(log types.Log) (*EigenPodValidatorBalanceUpdated, error)
+	FilterValidatorCheckpointed(opts *bind.FilterOpts, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (*EigenPodValidatorCheckpointedIterator, error)
+	WatchValidatorCheckpointed(opts *bind.WatchOpts, sink chan<- *EigenPodValidatorCheckpointed, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (event.Subscription, error)
+	ParseValidatorCheckpointed(log types.Log) (*EigenPodValidatorCheckpointed, error)
+	FilterValidatorRestaked(opts *bind.FilterOpts) (*EigenPodValidatorRestakedIterator, error)
+	WatchValidatorRestaked(opts *bind.WatchOpts, sink chan<- *EigenPodValidatorRestaked) (event.Subscription, error)
+	ParseValidatorRestaked(log types.Log) (*EigenPodValidatorRestaked, error)
+	FilterValidatorWithdrawn(opts *bind.FilterOpts, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (*EigenPodValidatorWithdrawnIterator, error)
+	WatchValidatorWithdrawn(opts *bind.WatchOpts, sink chan<- *EigenPodValidatorWithdrawn, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (event.Subscription, error)
+	ParseValidatorWithdrawn(log types.Log) (*EigenPodValidatorWithdrawn, error)
+	FilterWithdrawalRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*EigenPodWithdrawalRequestedIterator, error)
+	WatchWithdrawalRequested(opts *bind.WatchOpts, sink chan<- *EigenPodWithdrawalRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseWithdrawalRequested(log types.Log) (*EigenPodWithdrawalRequested, error)
+}
+
+var (
+	_ EigenPodReader = (*EigenPodCaller)(nil)
+	_ EigenPodReader = (*EigenPod)(nil)
+	_ EigenPodReader = (*FakeEigenPod)(nil)
+	_ EigenPodWriter = (*EigenPodTransactor)(nil)
+	_ EigenPodWriter = (*EigenPod)(nil)
+	_ EigenPodWriter = (*FakeEigenPod)(nil)
+	_ EigenPodEvents = (*EigenPodFilterer)(nil)
+	_ EigenPodEvents = (*EigenPod)(nil)
+	_ EigenPodEvents = (*FakeEigenPod)(nil)
+)
@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodManager
+
+// ErrCurrentlyPaused is the typed form of the EigenPodManager custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "EigenPodManager: CurrentlyPaused()"
+}
+
+// ErrEigenPodAlreadyExists is the typed form of the EigenPodManager custom error EigenPodAlreadyExists().
+type ErrEigenPodAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrEigenPodAlreadyExists) Error() string {
+	return "EigenPodManager: EigenPodAlreadyExists()"
+}
+
+// ErrInputAddressZero is the typed form of the EigenPodManager custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "EigenPodManager: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the EigenPodManager custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "EigenPodManager: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidStrategy is the typed form of the EigenPodManager custom error InvalidStrategy().
+type ErrInvalidStrategy struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStrategy) Error() string {
+	return "EigenPodManager: InvalidStrategy()"
+}
+
+// ErrLegacyWithdrawalsNotCompleted is the typed form of the EigenPodManager custom error LegacyWithdrawalsNotCompleted().
+type ErrLegacyWithdrawalsNotCompleted struct{}
+
+// Error implements the error interface.
+func (ErrLegacyWithdrawalsNotCompleted) Error() string {
+	return "EigenPodManager: LegacyWithdrawalsNotCompleted()"
+}
+
+// ErrOnlyDelegationManager is the typed form of the EigenPodManager custom error OnlyDelegationManager().
+type ErrOnlyDelegationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyDelegationManager) Error() string {
+	return "EigenPodManager: OnlyDelegationManager()"
+}
+
+// ErrOnlyEigenPod is the typed form of the EigenPodManager custom error OnlyEigenPod().
+type ErrOnlyEigenPod struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPod) Error() string {
+	return "EigenPodManager: OnlyEigenPod()"
+}
+
+// ErrOnlyPauser is the typed form of the EigenPodManager custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "EigenPodManager: OnlyPauser()"
+}
+
+// ErrOnlyProofTimestampSetter is the typed form of the EigenPodManager custom error OnlyProofTimestampSetter().
+type ErrOnlyProofTimestampSetter struct{}
+
+// Error implements the error interface.
+func (ErrOnlyProofTimestampSetter) Error() string {
+	return "EigenPodManager: OnlyProofTimestampSetter()"
+}
+
+// ErrOnlyUnpauser is the typed form of the EigenPodManager custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "EigenPodManager: OnlyUnpauser()"
+}
+
+// ErrSharesNegative is the typed form of the EigenPodManager custom error SharesNegative().
+type ErrSharesNegative struct{}
+
+// Error implements the error interface.
+func (ErrSharesNegative) Error() string {
+	return "EigenPodManager: SharesNegative()"
+}
+
+// ErrSharesNotMultipleOfGwei is the typed form of the EigenPodManager custom error SharesNotMultipleOfGwei().
+type ErrSharesNotMultipleOfGwei struct{}
+
+// Error implements the error interface.
+func (ErrSharesNotMultipleOfGwei) Error() string {
+	return "EigenPodManager: SharesNotMultipleOfGwei()"
+}
+
+// EigenPodManagerErrors maps the name of every custom error in the EigenPodManager ABI to its typed Go error.
+var EigenPodManagerErrors = map[string]error{
+	"CurrentlyPaused":               ErrCurrentlyPaused{},
+	"EigenPodAlreadyExists":         ErrEigenPodAlreadyExists{},
+	"InputAddressZero":              ErrInputAddressZero{},
+	"InvalidNewPausedStatus":        ErrInvalidNewPausedStatus{},
+	"InvalidStrategy":               ErrInvalidStrategy{},
+	"LegacyWithdrawalsNotCompleted": ErrLegacyWithdrawalsNotCompleted{},
+	"OnlyDelegationManager":         ErrOnlyDelegationManager{},
+	"OnlyEigenPod":                  ErrOnlyEigenPod{},
+	"OnlyPauser":                    ErrOnlyPauser{},
+	"OnlyProofTimestampSetter":      ErrOnlyProofTimestampSetter{},
+	"OnlyUnpauser":                  ErrOnlyUnpauser{},
+	"SharesNegative":                ErrSharesNegative{},
+	"SharesNotMultipleOfGwei":       ErrSharesNotMultipleOfGwei{},
+}
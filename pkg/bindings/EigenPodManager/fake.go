@@ -0,0 +1,551 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeEigenPodManager is an in-memory EigenPodManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded EigenPodManagerFilterer.
+type FakeEigenPodManager struct {
+	*fake.Contract
+	*EigenPodManagerFilterer
+}
+
+// NewFakeEigenPodManager returns an empty fake of the contract at address.
+func NewFakeEigenPodManager(address common.Address) *FakeEigenPodManager {
+	contract := fake.NewContract(EigenPodManagerMetaData, address)
+	filterer, err := NewEigenPodManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeEigenPodManager{Contract: contract, EigenPodManagerFilterer: filterer}
+}
+
+// BeaconChainETHStrategy returns the values stubbed with StubBeaconChainETHStrategy.
+func (_FakeEigenPodManager *FakeEigenPodManager) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("beaconChainETHStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainETHStrategy sets what BeaconChainETHStrategy returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubBeaconChainETHStrategy(out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("beaconChainETHStrategy", []interface{}{}, out0)
+}
+
+// BeaconChainSlashingFactor returns the values stubbed with StubBeaconChainSlashingFactor.
+func (_FakeEigenPodManager *FakeEigenPodManager) BeaconChainSlashingFactor(opts *bind.CallOpts, podOwner common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPodManager.Contract.Read("beaconChainSlashingFactor", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainSlashingFactor sets what BeaconChainSlashingFactor returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubBeaconChainSlashingFactor(podOwner common.Address, out0 uint64) {
+	_FakeEigenPodManager.Contract.Stub("beaconChainSlashingFactor", []interface{}{podOwner}, out0)
+}
+
+// BurnableETHShares returns the values stubbed with StubBurnableETHShares.
+func (_FakeEigenPodManager *FakeEigenPodManager) BurnableETHShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManager.Contract.Read("burnableETHShares", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBurnableETHShares sets what BurnableETHShares returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubBurnableETHShares(out0 *big.Int) {
+	_FakeEigenPodManager.Contract.Stub("burnableETHShares", []interface{}{}, out0)
+}
+
+// DelegationManager returns the values stubbed with StubDelegationManager.
+func (_FakeEigenPodManager *FakeEigenPodManager) DelegationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("delegationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegationManager sets what DelegationManager returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubDelegationManager(out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("delegationManager", []interface{}{}, out0)
+}
+
+// EigenPodBeacon returns the values stubbed with StubEigenPodBeacon.
+func (_FakeEigenPodManager *FakeEigenPodManager) EigenPodBeacon(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("eigenPodBeacon", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenPodBeacon sets what EigenPodBeacon returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubEigenPodBeacon(out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("eigenPodBeacon", []interface{}{}, out0)
+}
+
+// EthPOS returns the values stubbed with StubEthPOS.
+func (_FakeEigenPodManager *FakeEigenPodManager) EthPOS(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("ethPOS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEthPOS sets what EthPOS returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubEthPOS(out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("ethPOS", []interface{}{}, out0)
+}
+
+// GetPod returns the values stubbed with StubGetPod.
+func (_FakeEigenPodManager *FakeEigenPodManager) GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("getPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubGetPod sets what GetPod returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubGetPod(podOwner common.Address, out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("getPod", []interface{}{podOwner}, out0)
+}
+
+// HasPod returns the values stubbed with StubHasPod.
+func (_FakeEigenPodManager *FakeEigenPodManager) HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeEigenPodManager.Contract.Read("hasPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubHasPod sets what HasPod returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubHasPod(podOwner common.Address, out0 bool) {
+	_FakeEigenPodManager.Contract.Stub("hasPod", []interface{}{podOwner}, out0)
+}
+
+// NumPods returns the values stubbed with StubNumPods.
+func (_FakeEigenPodManager *FakeEigenPodManager) NumPods(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManager.Contract.Read("numPods", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubNumPods sets what NumPods returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubNumPods(out0 *big.Int) {
+	_FakeEigenPodManager.Contract.Stub("numPods", []interface{}{}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeEigenPodManager *FakeEigenPodManager) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubOwner(out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// OwnerToPod returns the values stubbed with StubOwnerToPod.
+func (_FakeEigenPodManager *FakeEigenPodManager) OwnerToPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("ownerToPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubOwnerToPod sets what OwnerToPod returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubOwnerToPod(podOwner common.Address, out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("ownerToPod", []interface{}{podOwner}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeEigenPodManager *FakeEigenPodManager) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeEigenPodManager.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubPaused(index uint8, out0 bool) {
+	_FakeEigenPodManager.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeEigenPodManager *FakeEigenPodManager) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManager.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubPaused0(out0 *big.Int) {
+	_FakeEigenPodManager.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeEigenPodManager *FakeEigenPodManager) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubPauserRegistry(out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// PectraForkTimestamp returns the values stubbed with StubPectraForkTimestamp.
+func (_FakeEigenPodManager *FakeEigenPodManager) PectraForkTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPodManager.Contract.Read("pectraForkTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPectraForkTimestamp sets what PectraForkTimestamp returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubPectraForkTimestamp(out0 uint64) {
+	_FakeEigenPodManager.Contract.Stub("pectraForkTimestamp", []interface{}{}, out0)
+}
+
+// PodOwnerDepositShares returns the values stubbed with StubPodOwnerDepositShares.
+func (_FakeEigenPodManager *FakeEigenPodManager) PodOwnerDepositShares(opts *bind.CallOpts, podOwner common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManager.Contract.Read("podOwnerDepositShares", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubPodOwnerDepositShares sets what PodOwnerDepositShares returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubPodOwnerDepositShares(podOwner common.Address, out0 *big.Int) {
+	_FakeEigenPodManager.Contract.Stub("podOwnerDepositShares", []interface{}{podOwner}, out0)
+}
+
+// ProofTimestampSetter returns the values stubbed with StubProofTimestampSetter.
+func (_FakeEigenPodManager *FakeEigenPodManager) ProofTimestampSetter(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManager.Contract.Read("proofTimestampSetter", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubProofTimestampSetter sets what ProofTimestampSetter returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubProofTimestampSetter(out0 common.Address) {
+	_FakeEigenPodManager.Contract.Stub("proofTimestampSetter", []interface{}{}, out0)
+}
+
+// StakerDepositShares returns the values stubbed with StubStakerDepositShares.
+func (_FakeEigenPodManager *FakeEigenPodManager) StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManager.Contract.Read("stakerDepositShares", []interface{}{user, strategy}, &out0)
+	return out0, err
+}
+
+// StubStakerDepositShares sets what StakerDepositShares returns for the given arguments.
+func (_FakeEigenPodManager *FakeEigenPodManager) StubStakerDepositShares(user common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeEigenPodManager.Contract.Stub("stakerDepositShares", []interface{}{user, strategy}, out0)
+}
+
+// AddShares records a addShares call after running the hook set with OnAddShares.
+func (_FakeEigenPodManager *FakeEigenPodManager) AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "addShares", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("addShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, shares)
+		}
+		return nil
+	}, staker, strategy, shares)
+}
+
+// OnAddShares sets a hook run by every AddShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnAddShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error) {
+	_FakeEigenPodManager.Contract.SetHook("addShares", hook)
+}
+
+// CreatePod records a createPod call after running the hook set with OnCreatePod.
+func (_FakeEigenPodManager *FakeEigenPodManager) CreatePod(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "createPod", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("createPod").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnCreatePod sets a hook run by every CreatePod call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnCreatePod(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigenPodManager.Contract.SetHook("createPod", hook)
+}
+
+// IncreaseBurnOrRedistributableShares records a increaseBurnOrRedistributableShares call after running the hook set with OnIncreaseBurnOrRedistributableShares.
+func (_FakeEigenPodManager *FakeEigenPodManager) IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, arg0 OperatorSet, arg1 *big.Int, arg2 common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "increaseBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("increaseBurnOrRedistributableShares").(func(opts *bind.TransactOpts, arg0 OperatorSet, arg1 *big.Int, arg2 common.Address, addedSharesToBurn *big.Int) error); ok {
+			return hook(opts, arg0, arg1, arg2, addedSharesToBurn)
+		}
+		return nil
+	}, arg0, arg1, arg2, addedSharesToBurn)
+}
+
+// OnIncreaseBurnOrRedistributableShares sets a hook run by every IncreaseBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnIncreaseBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, arg0 OperatorSet, arg1 *big.Int, arg2 common.Address, addedSharesToBurn *big.Int) error) {
+	_FakeEigenPodManager.Contract.SetHook("increaseBurnOrRedistributableShares", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeEigenPodManager *FakeEigenPodManager) Initialize(opts *bind.TransactOpts, initialOwner common.Address, _initPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, _initPausedStatus *big.Int) error); ok {
+			return hook(opts, initialOwner, _initPausedStatus)
+		}
+		return nil
+	}, initialOwner, _initPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, _initPausedStatus *big.Int) error) {
+	_FakeEigenPodManager.Contract.SetHook("initialize", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeEigenPodManager *FakeEigenPodManager) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeEigenPodManager.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeEigenPodManager *FakeEigenPodManager) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigenPodManager.Contract.SetHook("pauseAll", hook)
+}
+
+// RecordBeaconChainETHBalanceUpdate records a recordBeaconChainETHBalanceUpdate call after running the hook set with OnRecordBeaconChainETHBalanceUpdate.
+func (_FakeEigenPodManager *FakeEigenPodManager) RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "recordBeaconChainETHBalanceUpdate", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("recordBeaconChainETHBalanceUpdate").(func(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) error); ok {
+			return hook(opts, podOwner, prevRestakedBalanceWei, balanceDeltaWei)
+		}
+		return nil
+	}, podOwner, prevRestakedBalanceWei, balanceDeltaWei)
+}
+
+// OnRecordBeaconChainETHBalanceUpdate sets a hook run by every RecordBeaconChainETHBalanceUpdate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnRecordBeaconChainETHBalanceUpdate(hook func(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) error) {
+	_FakeEigenPodManager.Contract.SetHook("recordBeaconChainETHBalanceUpdate", hook)
+}
+
+// RemoveDepositShares records a removeDepositShares call after running the hook set with OnRemoveDepositShares.
+func (_FakeEigenPodManager *FakeEigenPodManager) RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "removeDepositShares", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("removeDepositShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error); ok {
+			return hook(opts, staker, strategy, depositSharesToRemove)
+		}
+		return nil
+	}, staker, strategy, depositSharesToRemove)
+}
+
+// OnRemoveDepositShares sets a hook run by every RemoveDepositShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnRemoveDepositShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error) {
+	_FakeEigenPodManager.Contract.SetHook("removeDepositShares", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeEigenPodManager *FakeEigenPodManager) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigenPodManager.Contract.SetHook("renounceOwnership", hook)
+}
+
+// SetPectraForkTimestamp records a setPectraForkTimestamp call after running the hook set with OnSetPectraForkTimestamp.
+func (_FakeEigenPodManager *FakeEigenPodManager) SetPectraForkTimestamp(opts *bind.TransactOpts, timestamp uint64) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "setPectraForkTimestamp", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("setPectraForkTimestamp").(func(opts *bind.TransactOpts, timestamp uint64) error); ok {
+			return hook(opts, timestamp)
+		}
+		return nil
+	}, timestamp)
+}
+
+// OnSetPectraForkTimestamp sets a hook run by every SetPectraForkTimestamp call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnSetPectraForkTimestamp(hook func(opts *bind.TransactOpts, timestamp uint64) error) {
+	_FakeEigenPodManager.Contract.SetHook("setPectraForkTimestamp", hook)
+}
+
+// SetProofTimestampSetter records a setProofTimestampSetter call after running the hook set with OnSetProofTimestampSetter.
+func (_FakeEigenPodManager *FakeEigenPodManager) SetProofTimestampSetter(opts *bind.TransactOpts, newProofTimestampSetter common.Address) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "setProofTimestampSetter", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("setProofTimestampSetter").(func(opts *bind.TransactOpts, newProofTimestampSetter common.Address) error); ok {
+			return hook(opts, newProofTimestampSetter)
+		}
+		return nil
+	}, newProofTimestampSetter)
+}
+
+// OnSetProofTimestampSetter sets a hook run by every SetProofTimestampSetter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnSetProofTimestampSetter(hook func(opts *bind.TransactOpts, newProofTimestampSetter common.Address) error) {
+	_FakeEigenPodManager.Contract.SetHook("setProofTimestampSetter", hook)
+}
+
+// Stake records a stake call after running the hook set with OnStake.
+func (_FakeEigenPodManager *FakeEigenPodManager) Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "stake", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("stake").(func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error); ok {
+			return hook(opts, pubkey, signature, depositDataRoot)
+		}
+		return nil
+	}, pubkey, signature, depositDataRoot)
+}
+
+// OnStake sets a hook run by every Stake call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnStake(hook func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error) {
+	_FakeEigenPodManager.Contract.SetHook("stake", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeEigenPodManager *FakeEigenPodManager) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeEigenPodManager.Contract.SetHook("transferOwnership", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeEigenPodManager *FakeEigenPodManager) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeEigenPodManager.Contract.SetHook("unpause", hook)
+}
+
+// WithdrawSharesAsTokens records a withdrawSharesAsTokens call after running the hook set with OnWithdrawSharesAsTokens.
+func (_FakeEigenPodManager *FakeEigenPodManager) WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, arg2 common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManager.Contract.Transact(opts, "withdrawSharesAsTokens", func() error {
+		if hook, ok := _FakeEigenPodManager.Contract.Hook("withdrawSharesAsTokens").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, arg2 common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, arg2, shares)
+		}
+		return nil
+	}, staker, strategy, arg2, shares)
+}
+
+// OnWithdrawSharesAsTokens sets a hook run by every WithdrawSharesAsTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManager *FakeEigenPodManager) OnWithdrawSharesAsTokens(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, arg2 common.Address, shares *big.Int) error) {
+	_FakeEigenPodManager.Contract.SetHook("withdrawSharesAsTokens", hook)
+}
+
+// EmitBeaconChainETHDeposited appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitBeaconChainETHDeposited(event *EigenPodManagerBeaconChainETHDeposited) {
+	_FakeEigenPodManager.Contract.Emit("BeaconChainETHDeposited", event)
+}
+
+// EmitBeaconChainETHWithdrawalCompleted appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitBeaconChainETHWithdrawalCompleted(event *EigenPodManagerBeaconChainETHWithdrawalCompleted) {
+	_FakeEigenPodManager.Contract.Emit("BeaconChainETHWithdrawalCompleted", event)
+}
+
+// EmitBeaconChainSlashingFactorDecreased appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitBeaconChainSlashingFactorDecreased(event *EigenPodManagerBeaconChainSlashingFactorDecreased) {
+	_FakeEigenPodManager.Contract.Emit("BeaconChainSlashingFactorDecreased", event)
+}
+
+// EmitBurnableETHSharesIncreased appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitBurnableETHSharesIncreased(event *EigenPodManagerBurnableETHSharesIncreased) {
+	_FakeEigenPodManager.Contract.Emit("BurnableETHSharesIncreased", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitInitialized(event *EigenPodManagerInitialized) {
+	_FakeEigenPodManager.Contract.Emit("Initialized", event)
+}
+
+// EmitNewTotalShares appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitNewTotalShares(event *EigenPodManagerNewTotalShares) {
+	_FakeEigenPodManager.Contract.Emit("NewTotalShares", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitOwnershipTransferred(event *EigenPodManagerOwnershipTransferred) {
+	_FakeEigenPodManager.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitPaused(event *EigenPodManagerPaused) {
+	_FakeEigenPodManager.Contract.Emit("Paused", event)
+}
+
+// EmitPectraForkTimestampSet appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitPectraForkTimestampSet(event *EigenPodManagerPectraForkTimestampSet) {
+	_FakeEigenPodManager.Contract.Emit("PectraForkTimestampSet", event)
+}
+
+// EmitPodDeployed appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitPodDeployed(event *EigenPodManagerPodDeployed) {
+	_FakeEigenPodManager.Contract.Emit("PodDeployed", event)
+}
+
+// EmitPodSharesUpdated appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitPodSharesUpdated(event *EigenPodManagerPodSharesUpdated) {
+	_FakeEigenPodManager.Contract.Emit("PodSharesUpdated", event)
+}
+
+// EmitProofTimestampSetterSet appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitProofTimestampSetterSet(event *EigenPodManagerProofTimestampSetterSet) {
+	_FakeEigenPodManager.Contract.Emit("ProofTimestampSetterSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeEigenPodManager *FakeEigenPodManager) EmitUnpaused(event *EigenPodManagerUnpaused) {
+	_FakeEigenPodManager.Contract.Emit("Unpaused", event)
+}
@@ -0,0 +1,110 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// EigenPodManagerReader is the read-only surface of the EigenPodManager
+// binding, implemented by EigenPodManagerCaller, EigenPodManager and FakeEigenPodManager.
+type EigenPodManagerReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	BeaconChainSlashingFactor(opts *bind.CallOpts, podOwner common.Address) (uint64, error)
+	BurnableETHShares(opts *bind.CallOpts) (*big.Int, error)
+	DelegationManager(opts *bind.CallOpts) (common.Address, error)
+	EigenPodBeacon(opts *bind.CallOpts) (common.Address, error)
+	EthPOS(opts *bind.CallOpts) (common.Address, error)
+	GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error)
+	NumPods(opts *bind.CallOpts) (*big.Int, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	OwnerToPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PectraForkTimestamp(opts *bind.CallOpts) (uint64, error)
+	PodOwnerDepositShares(opts *bind.CallOpts, podOwner common.Address) (*big.Int, error)
+	ProofTimestampSetter(opts *bind.CallOpts) (common.Address, error)
+	StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error)
+}
+
+// EigenPodManagerWriter is the transacting surface of the EigenPodManager
+// binding, implemented by EigenPodManagerTransactor, EigenPodManager and FakeEigenPodManager.
+type EigenPodManagerWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	CreatePod(opts *bind.TransactOpts) (*types.Transaction, error)
+	IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, arg0 OperatorSet, arg1 *big.Int, arg2 common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, _initPausedStatus *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) (*types.Transaction, error)
+	RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetPectraForkTimestamp(opts *bind.TransactOpts, timestamp uint64) (*types.Transaction, error)
+	SetProofTimestampSetter(opts *bind.TransactOpts, newProofTimestampSetter common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, arg2 common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+// EigenPodManagerEvents is the event filtering surface of the EigenPodManager
+// binding, implemented by EigenPodManagerFilterer, EigenPodManager and FakeEigenPodManager.
+type EigenPodManagerEvents interface {
+	FilterBeaconChainETHDeposited(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerBeaconChainETHDepositedIterator, error)
+	WatchBeaconChainETHDeposited(opts *bind.WatchOpts, sink chan<- *EigenPodManagerBeaconChainETHDeposited, podOwner []common.Address) (event.Subscription, error)
+	ParseBeaconChainETHDeposited(log types.Log) (*EigenPodManagerBeaconChainETHDeposited, error)
+	FilterBeaconChainETHWithdrawalCompleted(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerBeaconChainETHWithdrawalCompletedIterator, error)
+	WatchBeaconChainETHWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *EigenPodManagerBeaconChainETHWithdrawalCompleted, podOwner []common.Address) (event.Subscription, error)
+	ParseBeaconChainETHWithdrawalCompleted(log types.Log) (*EigenPodManagerBeaconChainETHWithdrawalCompleted, error)
+	FilterBeaconChainSlashingFactorDecreased(opts *bind.FilterOpts) (*EigenPodManagerBeaconChainSlashingFactorDecreasedIterator, error)
+	WatchBeaconChainSlashingFactorDecreased(opts *bind.WatchOpts, sink chan<- *EigenPodManagerBeaconChainSlashingFactorDecreased) (event.Subscription, error)
+	ParseBeaconChainSlashingFactorDecreased(log types.Log) (*EigenPodManagerBeaconChainSlashingFactorDecreased, error)
+	FilterBurnableETHSharesIncreased(opts *bind.FilterOpts) (*EigenPodManagerBurnableETHSharesIncreasedIterator, error)
+	WatchBurnableETHSharesIncreased(opts *bind.WatchOpts, sink chan<- *EigenPodManagerBurnableETHSharesIncreased) (event.Subscription, error)
+	ParseBurnableETHSharesIncreased(log types.Log) (*EigenPodManagerBurnableETHSharesIncreased, error)
+	FilterInitialized(opts *bind.FilterOpts) (*EigenPodManagerInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *EigenPodManagerInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*EigenPodManagerInitialized, error)
+	FilterNewTotalShares(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerNewTotalSharesIterator, error)
+	WatchNewTotalShares(opts *bind.WatchOpts, sink chan<- *EigenPodManagerNewTotalShares, podOwner []common.Address) (event.Subscription, error)
+	ParseNewTotalShares(log types.Log) (*EigenPodManagerNewTotalShares, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*EigenPodManagerOwnershipTransferredIterator, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *EigenPodManagerOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	ParseOwnershipTransferred(log types.Log) (*EigenPodManagerOwnershipTransferred, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*EigenPodManagerPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *EigenPodManagerPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*EigenPodManagerPaused, error)
+	FilterPectraForkTimestampSet(opts *bind.FilterOpts) (*EigenPodManagerPectraForkTimestampSetIterator, error)
+	WatchPectraForkTimestampSet(opts *bind.WatchOpts, sink chan<- *EigenPodManagerPectraForkTimestampSet) (event.Subscription, error)
+	ParsePectraForkTimestampSet(log types.Log) (*EigenPodManagerPectraForkTimestampSet, error)
+	FilterPodDeployed(opts *bind.FilterOpts, eigenPod []common.Address, podOwner []common.Address) (*EigenPodManagerPodDeployedIterator, error)
+	WatchPodDeployed(opts *bind.WatchOpts, sink chan<- *EigenPodManagerPodDeployed, eigenPod []common.Address, podOwner []common.Address) (event.Subscription, error)
+	ParsePodDeployed(log types.Log) (*EigenPodManagerPodDeployed, error)
+	FilterPodSharesUpdated(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerPodSharesUpdatedIterator, error)
+	WatchPodSharesUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodManagerPodSharesUpdated, podOwner []common.Address) (event.Subscription, error)
+	ParsePodSharesUpdated(log types.Log) (*EigenPodManagerPodSharesUpdated, error)
+	FilterProofTimestampSetterSet(opts *bind.FilterOpts) (*EigenPodManagerProofTimestampSetterSetIterator, error)
+	WatchProofTimestampSetterSet(opts *bind.WatchOpts, sink chan<- *EigenPodManagerProofTimestampSetterSet) (event.Subscription, error)
+	ParseProofTimestampSetterSet(log types.Log) (*EigenPodManagerProofTimestampSetterSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*EigenPodManagerUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *EigenPodManagerUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*EigenPodManagerUnpaused, error)
+}
+
+var (
+	_ EigenPodManagerReader = (*EigenPodManagerCaller)(nil)
+	_ EigenPodManagerReader = (*EigenPodManager)(nil)
+	_ EigenPodManagerReader = (*FakeEigenPodManager)(nil)
+	_ EigenPodManagerWriter = (*EigenPodManagerTransactor)(nil)
+	_ EigenPodManagerWriter = (*EigenPodManager)(nil)
+	_ EigenPodManagerWriter = (*FakeEigenPodManager)(nil)
+	_ EigenPodManagerEvents = (*EigenPodManagerFilterer)(nil)
+	_ EigenPodManagerEvents = (*EigenPodManager)(nil)
+	_ EigenPodManagerEvents = (*FakeEigenPodManager)(nil)
+)
@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodManagerStorage
+
+// ErrCurrentlyPaused is the typed form of the EigenPodManagerStorage custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "EigenPodManagerStorage: CurrentlyPaused()"
+}
+
+// ErrEigenPodAlreadyExists is the typed form of the EigenPodManagerStorage custom error EigenPodAlreadyExists().
+type ErrEigenPodAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrEigenPodAlreadyExists) Error() string {
+	return "EigenPodManagerStorage: EigenPodAlreadyExists()"
+}
+
+// ErrInputAddressZero is the typed form of the EigenPodManagerStorage custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "EigenPodManagerStorage: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the EigenPodManagerStorage custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "EigenPodManagerStorage: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidStrategy is the typed form of the EigenPodManagerStorage custom error InvalidStrategy().
+type ErrInvalidStrategy struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStrategy) Error() string {
+	return "EigenPodManagerStorage: InvalidStrategy()"
+}
+
+// ErrLegacyWithdrawalsNotCompleted is the typed form of the EigenPodManagerStorage custom error LegacyWithdrawalsNotCompleted().
+type ErrLegacyWithdrawalsNotCompleted struct{}
+
+// Error implements the error interface.
+func (ErrLegacyWithdrawalsNotCompleted) Error() string {
+	return "EigenPodManagerStorage: LegacyWithdrawalsNotCompleted()"
+}
+
+// ErrOnlyDelegationManager is the typed form of the EigenPodManagerStorage custom error OnlyDelegationManager().
+type ErrOnlyDelegationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyDelegationManager) Error() string {
+	return "EigenPodManagerStorage: OnlyDelegationManager()"
+}
+
+// ErrOnlyEigenPod is the typed form of the EigenPodManagerStorage custom error OnlyEigenPod().
+type ErrOnlyEigenPod struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPod) Error() string {
+	return "EigenPodManagerStorage: OnlyEigenPod()"
+}
+
+// ErrOnlyPauser is the typed form of the EigenPodManagerStorage custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "EigenPodManagerStorage: OnlyPauser()"
+}
+
+// ErrOnlyProofTimestampSetter is the typed form of the EigenPodManagerStorage custom error OnlyProofTimestampSetter().
+type ErrOnlyProofTimestampSetter struct{}
+
+// Error implements the error interface.
+func (ErrOnlyProofTimestampSetter) Error() string {
+	return "EigenPodManagerStorage: OnlyProofTimestampSetter()"
+}
+
+// ErrOnlyUnpauser is the typed form of the EigenPodManagerStorage custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "EigenPodManagerStorage: OnlyUnpauser()"
+}
+
+// ErrSharesNegative is the typed form of the EigenPodManagerStorage custom error SharesNegative().
+type ErrSharesNegative struct{}
+
+// Error implements the error interface.
+func (ErrSharesNegative) Error() string {
+	return "EigenPodManagerStorage: SharesNegative()"
+}
+
+// ErrSharesNotMultipleOfGwei is the typed form of the EigenPodManagerStorage custom error SharesNotMultipleOfGwei().
+type ErrSharesNotMultipleOfGwei struct{}
+
+// Error implements the error interface.
+func (ErrSharesNotMultipleOfGwei) Error() string {
+	return "EigenPodManagerStorage: SharesNotMultipleOfGwei()"
+}
+
+// EigenPodManagerStorageErrors maps the name of every custom error in the EigenPodManagerStorage ABI to its typed Go error.
+var EigenPodManagerStorageErrors = map[string]error{
+	"CurrentlyPaused":               ErrCurrentlyPaused{},
+	"EigenPodAlreadyExists":         ErrEigenPodAlreadyExists{},
+	"InputAddressZero":              ErrInputAddressZero{},
+	"InvalidNewPausedStatus":        ErrInvalidNewPausedStatus{},
+	"InvalidStrategy":               ErrInvalidStrategy{},
+	"LegacyWithdrawalsNotCompleted": ErrLegacyWithdrawalsNotCompleted{},
+	"OnlyDelegationManager":         ErrOnlyDelegationManager{},
+	"OnlyEigenPod":                  ErrOnlyEigenPod{},
+	"OnlyPauser":                    ErrOnlyPauser{},
+	"OnlyProofTimestampSetter":      ErrOnlyProofTimestampSetter{},
+	"OnlyUnpauser":                  ErrOnlyUnpauser{},
+	"SharesNegative":                ErrSharesNegative{},
+	"SharesNotMultipleOfGwei":       ErrSharesNotMultipleOfGwei{},
+}
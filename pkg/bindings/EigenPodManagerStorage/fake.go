@@ -0,0 +1,481 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodManagerStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeEigenPodManagerStorage is an in-memory EigenPodManagerStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded EigenPodManagerStorageFilterer.
+type FakeEigenPodManagerStorage struct {
+	*fake.Contract
+	*EigenPodManagerStorageFilterer
+}
+
+// NewFakeEigenPodManagerStorage returns an empty fake of the contract at address.
+func NewFakeEigenPodManagerStorage(address common.Address) *FakeEigenPodManagerStorage {
+	contract := fake.NewContract(EigenPodManagerStorageMetaData, address)
+	filterer, err := NewEigenPodManagerStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeEigenPodManagerStorage{Contract: contract, EigenPodManagerStorageFilterer: filterer}
+}
+
+// BeaconChainETHStrategy returns the values stubbed with StubBeaconChainETHStrategy.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManagerStorage.Contract.Read("beaconChainETHStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainETHStrategy sets what BeaconChainETHStrategy returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubBeaconChainETHStrategy(out0 common.Address) {
+	_FakeEigenPodManagerStorage.Contract.Stub("beaconChainETHStrategy", []interface{}{}, out0)
+}
+
+// BeaconChainSlashingFactor returns the values stubbed with StubBeaconChainSlashingFactor.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) BeaconChainSlashingFactor(opts *bind.CallOpts, staker common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPodManagerStorage.Contract.Read("beaconChainSlashingFactor", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainSlashingFactor sets what BeaconChainSlashingFactor returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubBeaconChainSlashingFactor(staker common.Address, out0 uint64) {
+	_FakeEigenPodManagerStorage.Contract.Stub("beaconChainSlashingFactor", []interface{}{staker}, out0)
+}
+
+// BurnableETHShares returns the values stubbed with StubBurnableETHShares.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) BurnableETHShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManagerStorage.Contract.Read("burnableETHShares", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBurnableETHShares sets what BurnableETHShares returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubBurnableETHShares(out0 *big.Int) {
+	_FakeEigenPodManagerStorage.Contract.Stub("burnableETHShares", []interface{}{}, out0)
+}
+
+// DelegationManager returns the values stubbed with StubDelegationManager.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) DelegationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManagerStorage.Contract.Read("delegationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegationManager sets what DelegationManager returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubDelegationManager(out0 common.Address) {
+	_FakeEigenPodManagerStorage.Contract.Stub("delegationManager", []interface{}{}, out0)
+}
+
+// EigenPodBeacon returns the values stubbed with StubEigenPodBeacon.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EigenPodBeacon(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManagerStorage.Contract.Read("eigenPodBeacon", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenPodBeacon sets what EigenPodBeacon returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubEigenPodBeacon(out0 common.Address) {
+	_FakeEigenPodManagerStorage.Contract.Stub("eigenPodBeacon", []interface{}{}, out0)
+}
+
+// EthPOS returns the values stubbed with StubEthPOS.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EthPOS(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManagerStorage.Contract.Read("ethPOS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEthPOS sets what EthPOS returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubEthPOS(out0 common.Address) {
+	_FakeEigenPodManagerStorage.Contract.Stub("ethPOS", []interface{}{}, out0)
+}
+
+// GetPod returns the values stubbed with StubGetPod.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManagerStorage.Contract.Read("getPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubGetPod sets what GetPod returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubGetPod(podOwner common.Address, out0 common.Address) {
+	_FakeEigenPodManagerStorage.Contract.Stub("getPod", []interface{}{podOwner}, out0)
+}
+
+// HasPod returns the values stubbed with StubHasPod.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeEigenPodManagerStorage.Contract.Read("hasPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubHasPod sets what HasPod returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubHasPod(podOwner common.Address, out0 bool) {
+	_FakeEigenPodManagerStorage.Contract.Stub("hasPod", []interface{}{podOwner}, out0)
+}
+
+// NumPods returns the values stubbed with StubNumPods.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) NumPods(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManagerStorage.Contract.Read("numPods", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubNumPods sets what NumPods returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubNumPods(out0 *big.Int) {
+	_FakeEigenPodManagerStorage.Contract.Stub("numPods", []interface{}{}, out0)
+}
+
+// OwnerToPod returns the values stubbed with StubOwnerToPod.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OwnerToPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManagerStorage.Contract.Read("ownerToPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubOwnerToPod sets what OwnerToPod returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubOwnerToPod(podOwner common.Address, out0 common.Address) {
+	_FakeEigenPodManagerStorage.Contract.Stub("ownerToPod", []interface{}{podOwner}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeEigenPodManagerStorage.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubPaused(index uint8, out0 bool) {
+	_FakeEigenPodManagerStorage.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManagerStorage.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubPaused0(out0 *big.Int) {
+	_FakeEigenPodManagerStorage.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManagerStorage.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubPauserRegistry(out0 common.Address) {
+	_FakeEigenPodManagerStorage.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// PectraForkTimestamp returns the values stubbed with StubPectraForkTimestamp.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) PectraForkTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPodManagerStorage.Contract.Read("pectraForkTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPectraForkTimestamp sets what PectraForkTimestamp returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubPectraForkTimestamp(out0 uint64) {
+	_FakeEigenPodManagerStorage.Contract.Stub("pectraForkTimestamp", []interface{}{}, out0)
+}
+
+// PodOwnerDepositShares returns the values stubbed with StubPodOwnerDepositShares.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) PodOwnerDepositShares(opts *bind.CallOpts, podOwner common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManagerStorage.Contract.Read("podOwnerDepositShares", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubPodOwnerDepositShares sets what PodOwnerDepositShares returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubPodOwnerDepositShares(podOwner common.Address, out0 *big.Int) {
+	_FakeEigenPodManagerStorage.Contract.Stub("podOwnerDepositShares", []interface{}{podOwner}, out0)
+}
+
+// ProofTimestampSetter returns the values stubbed with StubProofTimestampSetter.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) ProofTimestampSetter(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodManagerStorage.Contract.Read("proofTimestampSetter", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubProofTimestampSetter sets what ProofTimestampSetter returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubProofTimestampSetter(out0 common.Address) {
+	_FakeEigenPodManagerStorage.Contract.Stub("proofTimestampSetter", []interface{}{}, out0)
+}
+
+// StakerDepositShares returns the values stubbed with StubStakerDepositShares.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodManagerStorage.Contract.Read("stakerDepositShares", []interface{}{user, strategy}, &out0)
+	return out0, err
+}
+
+// StubStakerDepositShares sets what StakerDepositShares returns for the given arguments.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) StubStakerDepositShares(user common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeEigenPodManagerStorage.Contract.Stub("stakerDepositShares", []interface{}{user, strategy}, out0)
+}
+
+// AddShares records a addShares call after running the hook set with OnAddShares.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "addShares", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("addShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, shares)
+		}
+		return nil
+	}, staker, strategy, shares)
+}
+
+// OnAddShares sets a hook run by every AddShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnAddShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("addShares", hook)
+}
+
+// CreatePod records a createPod call after running the hook set with OnCreatePod.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) CreatePod(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "createPod", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("createPod").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnCreatePod sets a hook run by every CreatePod call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnCreatePod(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("createPod", hook)
+}
+
+// IncreaseBurnOrRedistributableShares records a increaseBurnOrRedistributableShares call after running the hook set with OnIncreaseBurnOrRedistributableShares.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "increaseBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("increaseBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId, strategy, addedSharesToBurn)
+		}
+		return nil
+	}, operatorSet, slashId, strategy, addedSharesToBurn)
+}
+
+// OnIncreaseBurnOrRedistributableShares sets a hook run by every IncreaseBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnIncreaseBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("increaseBurnOrRedistributableShares", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("pauseAll", hook)
+}
+
+// RecordBeaconChainETHBalanceUpdate records a recordBeaconChainETHBalanceUpdate call after running the hook set with OnRecordBeaconChainETHBalanceUpdate.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "recordBeaconChainETHBalanceUpdate", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("recordBeaconChainETHBalanceUpdate").(func(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) error); ok {
+			return hook(opts, podOwner, prevRestakedBalanceWei, balanceDeltaWei)
+		}
+		return nil
+	}, podOwner, prevRestakedBalanceWei, balanceDeltaWei)
+}
+
+// OnRecordBeaconChainETHBalanceUpdate sets a hook run by every RecordBeaconChainETHBalanceUpdate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnRecordBeaconChainETHBalanceUpdate(hook func(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("recordBeaconChainETHBalanceUpdate", hook)
+}
+
+// RemoveDepositShares records a removeDepositShares call after running the hook set with OnRemoveDepositShares.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "removeDepositShares", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("removeDepositShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error); ok {
+			return hook(opts, staker, strategy, depositSharesToRemove)
+		}
+		return nil
+	}, staker, strategy, depositSharesToRemove)
+}
+
+// OnRemoveDepositShares sets a hook run by every RemoveDepositShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnRemoveDepositShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("removeDepositShares", hook)
+}
+
+// SetPectraForkTimestamp records a setPectraForkTimestamp call after running the hook set with OnSetPectraForkTimestamp.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) SetPectraForkTimestamp(opts *bind.TransactOpts, timestamp uint64) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "setPectraForkTimestamp", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("setPectraForkTimestamp").(func(opts *bind.TransactOpts, timestamp uint64) error); ok {
+			return hook(opts, timestamp)
+		}
+		return nil
+	}, timestamp)
+}
+
+// OnSetPectraForkTimestamp sets a hook run by every SetPectraForkTimestamp call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnSetPectraForkTimestamp(hook func(opts *bind.TransactOpts, timestamp uint64) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("setPectraForkTimestamp", hook)
+}
+
+// SetProofTimestampSetter records a setProofTimestampSetter call after running the hook set with OnSetProofTimestampSetter.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) SetProofTimestampSetter(opts *bind.TransactOpts, newProofTimestampSetter common.Address) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "setProofTimestampSetter", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("setProofTimestampSetter").(func(opts *bind.TransactOpts, newProofTimestampSetter common.Address) error); ok {
+			return hook(opts, newProofTimestampSetter)
+		}
+		return nil
+	}, newProofTimestampSetter)
+}
+
+// OnSetProofTimestampSetter sets a hook run by every SetProofTimestampSetter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnSetProofTimestampSetter(hook func(opts *bind.TransactOpts, newProofTimestampSetter common.Address) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("setProofTimestampSetter", hook)
+}
+
+// Stake records a stake call after running the hook set with OnStake.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "stake", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("stake").(func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error); ok {
+			return hook(opts, pubkey, signature, depositDataRoot)
+		}
+		return nil
+	}, pubkey, signature, depositDataRoot)
+}
+
+// OnStake sets a hook run by every Stake call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnStake(hook func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("stake", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("unpause", hook)
+}
+
+// WithdrawSharesAsTokens records a withdrawSharesAsTokens call after running the hook set with OnWithdrawSharesAsTokens.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodManagerStorage.Contract.Transact(opts, "withdrawSharesAsTokens", func() error {
+		if hook, ok := _FakeEigenPodManagerStorage.Contract.Hook("withdrawSharesAsTokens").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, token, shares)
+		}
+		return nil
+	}, staker, strategy, token, shares)
+}
+
+// OnWithdrawSharesAsTokens sets a hook run by every WithdrawSharesAsTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) OnWithdrawSharesAsTokens(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error) {
+	_FakeEigenPodManagerStorage.Contract.SetHook("withdrawSharesAsTokens", hook)
+}
+
+// EmitBeaconChainETHDeposited appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitBeaconChainETHDeposited(event *EigenPodManagerStorageBeaconChainETHDeposited) {
+	_FakeEigenPodManagerStorage.Contract.Emit("BeaconChainETHDeposited", event)
+}
+
+// EmitBeaconChainETHWithdrawalCompleted appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitBeaconChainETHWithdrawalCompleted(event *EigenPodManagerStorageBeaconChainETHWithdrawalCompleted) {
+	_FakeEigenPodManagerStorage.Contract.Emit("BeaconChainETHWithdrawalCompleted", event)
+}
+
+// EmitBeaconChainSlashingFactorDecreased appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitBeaconChainSlashingFactorDecreased(event *EigenPodManagerStorageBeaconChainSlashingFactorDecreased) {
+	_FakeEigenPodManagerStorage.Contract.Emit("BeaconChainSlashingFactorDecreased", event)
+}
+
+// EmitBurnableETHSharesIncreased appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitBurnableETHSharesIncreased(event *EigenPodManagerStorageBurnableETHSharesIncreased) {
+	_FakeEigenPodManagerStorage.Contract.Emit("BurnableETHSharesIncreased", event)
+}
+
+// EmitNewTotalShares appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitNewTotalShares(event *EigenPodManagerStorageNewTotalShares) {
+	_FakeEigenPodManagerStorage.Contract.Emit("NewTotalShares", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitPaused(event *EigenPodManagerStoragePaused) {
+	_FakeEigenPodManagerStorage.Contract.Emit("Paused", event)
+}
+
+// EmitPectraForkTimestampSet appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitPectraForkTimestampSet(event *EigenPodManagerStoragePectraForkTimestampSet) {
+	_FakeEigenPodManagerStorage.Contract.Emit("PectraForkTimestampSet", event)
+}
+
+// EmitPodDeployed appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitPodDeployed(event *EigenPodManagerStoragePodDeployed) {
+	_FakeEigenPodManagerStorage.Contract.Emit("PodDeployed", event)
+}
+
+// EmitPodSharesUpdated appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitPodSharesUpdated(event *EigenPodManagerStoragePodSharesUpdated) {
+	_FakeEigenPodManagerStorage.Contract.Emit("PodSharesUpdated", event)
+}
+
+// EmitProofTimestampSetterSet appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitProofTimestampSetterSet(event *EigenPodManagerStorageProofTimestampSetterSet) {
+	_FakeEigenPodManagerStorage.Contract.Emit("ProofTimestampSetterSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeEigenPodManagerStorage *FakeEigenPodManagerStorage) EmitUnpaused(event *EigenPodManagerStorageUnpaused) {
+	_FakeEigenPodManagerStorage.Contract.Emit("Unpaused", event)
+}
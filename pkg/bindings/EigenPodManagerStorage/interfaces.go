@@ -0,0 +1,100 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodManagerStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// EigenPodManagerStorageReader is the read-only surface of the EigenPodManagerStorage
+// binding, implemented by EigenPodManagerStorageCaller, EigenPodManagerStorage and FakeEigenPodManagerStorage.
+type EigenPodManagerStorageReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	BeaconChainSlashingFactor(opts *bind.CallOpts, staker common.Address) (uint64, error)
+	BurnableETHShares(opts *bind.CallOpts) (*big.Int, error)
+	DelegationManager(opts *bind.CallOpts) (common.Address, error)
+	EigenPodBeacon(opts *bind.CallOpts) (common.Address, error)
+	EthPOS(opts *bind.CallOpts) (common.Address, error)
+	GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error)
+	NumPods(opts *bind.CallOpts) (*big.Int, error)
+	OwnerToPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PectraForkTimestamp(opts *bind.CallOpts) (uint64, error)
+	PodOwnerDepositShares(opts *bind.CallOpts, podOwner common.Address) (*big.Int, error)
+	ProofTimestampSetter(opts *bind.CallOpts) (common.Address, error)
+	StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error)
+}
+
+// EigenPodManagerStorageWriter is the transacting surface of the EigenPodManagerStorage
+// binding, implemented by EigenPodManagerStorageTransactor, EigenPodManagerStorage and FakeEigenPodManagerStorage.
+type EigenPodManagerStorageWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	CreatePod(opts *bind.TransactOpts) (*types.Transaction, error)
+	IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) (*types.Transaction, error)
+	RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error)
+	SetPectraForkTimestamp(opts *bind.TransactOpts, timestamp uint64) (*types.Transaction, error)
+	SetProofTimestampSetter(opts *bind.TransactOpts, newProofTimestampSetter common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+// EigenPodManagerStorageEvents is the event filtering surface of the EigenPodManagerStorage
+// binding, implemented by EigenPodManagerStorageFilterer, EigenPodManagerStorage and FakeEigenPodManagerStorage.
+type EigenPodManagerStorageEvents interface {
+	FilterBeaconChainETHDeposited(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerStorageBeaconChainETHDepositedIterator, error)
+	WatchBeaconChainETHDeposited(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageBeaconChainETHDeposited, podOwner []common.Address) (event.Subscription, error)
+	ParseBeaconChainETHDeposited(log types.Log) (*EigenPodManagerStorageBeaconChainETHDeposited, error)
+	FilterBeaconChainETHWithdrawalCompleted(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerStorageBeaconChainETHWithdrawalCompletedIterator, error)
+	WatchBeaconChainETHWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageBeaconChainETHWithdrawalCompleted, podOwner []common.Address) (event.Subscription, error)
+	ParseBeaconChainETHWithdrawalCompleted(log types.Log) (*EigenPodManagerStorageBeaconChainETHWithdrawalCompleted, error)
+	FilterBeaconChainSlashingFactorDecreased(opts *bind.FilterOpts) (*EigenPodManagerStorageBeaconChainSlashingFactorDecreasedIterator, error)
+	WatchBeaconChainSlashingFactorDecreased(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageBeaconChainSlashingFactorDecreased) (event.Subscription, error)
+	ParseBeaconChainSlashingFactorDecreased(log types.Log) (*EigenPodManagerStorageBeaconChainSlashingFactorDecreased, error)
+	FilterBurnableETHSharesIncreased(opts *bind.FilterOpts) (*EigenPodManagerStorageBurnableETHSharesIncreasedIterator, error)
+	WatchBurnableETHSharesIncreased(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageBurnableETHSharesIncreased) (event.Subscription, error)
+	ParseBurnableETHSharesIncreased(log types.Log) (*EigenPodManagerStorageBurnableETHSharesIncreased, error)
+	FilterNewTotalShares(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerStorageNewTotalSharesIterator, error)
+	WatchNewTotalShares(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageNewTotalShares, podOwner []common.Address) (event.Subscription, error)
+	ParseNewTotalShares(log types.Log) (*EigenPodManagerStorageNewTotalShares, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*EigenPodManagerStoragePausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStoragePaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*EigenPodManagerStoragePaused, error)
+	FilterPectraForkTimestampSet(opts *bind.FilterOpts) (*EigenPodManagerStoragePectraForkTimestampSetIterator, error)
+	WatchPectraForkTimestampSet(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStoragePectraForkTimestampSet) (event.Subscription, error)
+	ParsePectraForkTimestampSet(log types.Log) (*EigenPodManagerStoragePectraForkTimestampSet, error)
+	FilterPodDeployed(opts *bind.FilterOpts, eigenPod []common.Address, podOwner []common.Address) (*EigenPodManagerStoragePodDeployedIterator, error)
+	WatchPodDeployed(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStoragePodDeployed, eigenPod []common.Address, podOwner []common.Address) (event.Subscription, error)
+	ParsePodDeployed(log types.Log) (*EigenPodManagerStoragePodDeployed, error)
+	FilterPodSharesUpdated(opts *bind.FilterOpts, podOwner []common.Address) (*EigenPodManagerStoragePodSharesUpdatedIterator, error)
+	WatchPodSharesUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStoragePodSharesUpdated, podOwner []common.Address) (event.Subscription, error)
+	ParsePodSharesUpdated(log types.Log) (*EigenPodManagerStoragePodSharesUpdated, error)
+	FilterProofTimestampSetterSet(opts *bind.FilterOpts) (*EigenPodManagerStorageProofTimestampSetterSetIterator, error)
+	WatchProofTimestampSetterSet(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageProofTimestampSetterSet) (event.Subscription, error)
+	ParseProofTimestampSetterSet(log types.Log) (*EigenPodManagerStorageProofTimestampSetterSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*EigenPodManagerStorageUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *EigenPodManagerStorageUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*EigenPodManagerStorageUnpaused, error)
+}
+
+var (
+	_ EigenPodManagerStorageReader = (*EigenPodManagerStorageCaller)(nil)
+	_ EigenPodManagerStorageReader = (*EigenPodManagerStorage)(nil)
+	_ EigenPodManagerStorageReader = (*FakeEigenPodManagerStorage)(nil)
+	_ EigenPodManagerStorageWriter = (*EigenPodManagerStorageTransactor)(nil)
+	_ EigenPodManagerStorageWriter = (*EigenPodManagerStorage)(nil)
+	_ EigenPodManagerStorageWriter = (*FakeEigenPodManagerStorage)(nil)
+	_ EigenPodManagerStorageEvents = (*EigenPodManagerStorageFilterer)(nil)
+	_ EigenPodManagerStorageEvents = (*EigenPodManagerStorage)(nil)
+	_ EigenPodManagerStorageEvents = (*FakeEigenPodManagerStorage)(nil)
+)
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,59 +30,29 @@ var (
 	_ = abi.ConvertType
 )
 
-// BeaconChainProofsBalanceContainerProof is an auto generated low-level Go binding around an user-defined struct.
-type BeaconChainProofsBalanceContainerProof struct {
-	BalanceContainerRoot [32]byte
-	Proof                []byte
-}
+// BeaconChainProofsBalanceContainerProof is an alias of the canonical eltypes.BeaconChainProofsBalanceContainerProof shared by all bindings.
+type BeaconChainProofsBalanceContainerProof = eltypes.BeaconChainProofsBalanceContainerProof
 
-// BeaconChainProofsBalanceProof is an auto generated low-level Go binding around an user-defined struct.
-type BeaconChainProofsBalanceProof struct {
-	PubkeyHash  [32]byte
-	BalanceRoot [32]byte
-	Proof       []byte
-}
+// BeaconChainProofsBalanceProof is an alias of the canonical eltypes.BeaconChainProofsBalanceProof shared by all bindings.
+type BeaconChainProofsBalanceProof = eltypes.BeaconChainProofsBalanceProof
 
-// BeaconChainProofsStateRootProof is an auto generated low-level Go binding around an user-defined struct.
-type BeaconChainProofsStateRootProof struct {
-	BeaconStateRoot [32]byte
-	Proof           []byte
-}
+// BeaconChainProofsStateRootProof is an alias of the canonical eltypes.BeaconChainProofsStateRootProof shared by all bindings.
+type BeaconChainProofsStateRootProof = eltypes.BeaconChainProofsStateRootProof
 
-// BeaconChainProofsValidatorProof is an auto generated low-level Go binding around an user-defined struct.
-type BeaconChainProofsValidatorProof struct {
-	ValidatorFields [][32]byte
-	Proof           []byte
-}
+// BeaconChainProofsValidatorProof is an alias of the canonical eltypes.BeaconChainProofsValidatorProof shared by all bindings.
+type BeaconChainProofsValidatorProof = eltypes.BeaconChainProofsValidatorProof
 
-// IEigenPodTypesCheckpoint is an auto generated low-level Go binding around an user-defined struct.
-type IEigenPodTypesCheckpoint struct {
-	BeaconBlockRoot       [32]byte
-	ProofsRemaining       *big.Int
-	PodBalanceGwei        uint64
-	BalanceDeltasGwei     int64
-	PrevBeaconBalanceGwei uint64
-}
+// IEigenPodTypesCheckpoint is an alias of the canonical eltypes.IEigenPodTypesCheckpoint shared by all bindings.
+type IEigenPodTypesCheckpoint = eltypes.IEigenPodTypesCheckpoint
 
-// IEigenPodTypesConsolidationRequest is an auto generated low-level Go binding around an user-defined struct.
-type IEigenPodTypesConsolidationRequest struct {
-	SrcPubkey    []byte
-	TargetPubkey []byte
-}
+// IEigenPodTypesConsolidationRequest is an alias of the canonical eltypes.IEigenPodTypesConsolidationRequest shared by all bindings.
+type IEigenPodTypesConsolidationRequest = eltypes.IEigenPodTypesConsolidationRequest
 
-// IEigenPodTypesValidatorInfo is an auto generated low-level Go binding around an user-defined struct.
-type IEigenPodTypesValidatorInfo struct {
-	ValidatorIndex      uint64
-	RestakedBalanceGwei uint64
-	LastCheckpointedAt  uint64
-	Status              uint8
-}
+// IEigenPodTypesValidatorInfo is an alias of the canonical eltypes.IEigenPodTypesValidatorInfo shared by all bindings.
+type IEigenPodTypesValidatorInfo = eltypes.IEigenPodTypesValidatorInfo
 
-// IEigenPodTypesWithdrawalRequest is an auto generated low-level Go binding around an user-defined struct.
-type IEigenPodTypesWithdrawalRequest struct {
-	Pubkey     []byte
-	AmountGwei uint64
-}
+// IEigenPodTypesWithdrawalRequest is an alias of the canonical eltypes.IEigenPodTypesWithdrawalRequest shared by all bindings.
+type IEigenPodTypesWithdrawalRequest = eltypes.IEigenPodTypesWithdrawalRequest
 
 // EigenPodStorageMetaData contains all meta data concerning the EigenPodStorage contract.
 var EigenPodStorageMetaData = &bind.MetaData{
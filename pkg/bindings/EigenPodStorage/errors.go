@@ -0,0 +1,250 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodStorage
+
+// ErrBeaconTimestampBeforeLatestCheckpoint is the typed form of the EigenPodStorage custom error BeaconTimestampBeforeLatestCheckpoint().
+type ErrBeaconTimestampBeforeLatestCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrBeaconTimestampBeforeLatestCheckpoint) Error() string {
+	return "EigenPodStorage: BeaconTimestampBeforeLatestCheckpoint()"
+}
+
+// ErrBeaconTimestampTooFarInPast is the typed form of the EigenPodStorage custom error BeaconTimestampTooFarInPast().
+type ErrBeaconTimestampTooFarInPast struct{}
+
+// Error implements the error interface.
+func (ErrBeaconTimestampTooFarInPast) Error() string {
+	return "EigenPodStorage: BeaconTimestampTooFarInPast()"
+}
+
+// ErrCannotCheckpointTwiceInSingleBlock is the typed form of the EigenPodStorage custom error CannotCheckpointTwiceInSingleBlock().
+type ErrCannotCheckpointTwiceInSingleBlock struct{}
+
+// Error implements the error interface.
+func (ErrCannotCheckpointTwiceInSingleBlock) Error() string {
+	return "EigenPodStorage: CannotCheckpointTwiceInSingleBlock()"
+}
+
+// ErrCheckpointAlreadyActive is the typed form of the EigenPodStorage custom error CheckpointAlreadyActive().
+type ErrCheckpointAlreadyActive struct{}
+
+// Error implements the error interface.
+func (ErrCheckpointAlreadyActive) Error() string {
+	return "EigenPodStorage: CheckpointAlreadyActive()"
+}
+
+// ErrCredentialsAlreadyVerified is the typed form of the EigenPodStorage custom error CredentialsAlreadyVerified().
+type ErrCredentialsAlreadyVerified struct{}
+
+// Error implements the error interface.
+func (ErrCredentialsAlreadyVerified) Error() string {
+	return "EigenPodStorage: CredentialsAlreadyVerified()"
+}
+
+// ErrCurrentlyPaused is the typed form of the EigenPodStorage custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "EigenPodStorage: CurrentlyPaused()"
+}
+
+// ErrFeeQueryFailed is the typed form of the EigenPodStorage custom error FeeQueryFailed().
+type ErrFeeQueryFailed struct{}
+
+// Error implements the error interface.
+func (ErrFeeQueryFailed) Error() string {
+	return "EigenPodStorage: FeeQueryFailed()"
+}
+
+// ErrForkTimestampZero is the typed form of the EigenPodStorage custom error ForkTimestampZero().
+type ErrForkTimestampZero struct{}
+
+// Error implements the error interface.
+func (ErrForkTimestampZero) Error() string {
+	return "EigenPodStorage: ForkTimestampZero()"
+}
+
+// ErrInputAddressZero is the typed form of the EigenPodStorage custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "EigenPodStorage: InputAddressZero()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the EigenPodStorage custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "EigenPodStorage: InputArrayLengthMismatch()"
+}
+
+// ErrInsufficientFunds is the typed form of the EigenPodStorage custom error InsufficientFunds().
+type ErrInsufficientFunds struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientFunds) Error() string {
+	return "EigenPodStorage: InsufficientFunds()"
+}
+
+// ErrInsufficientWithdrawableBalance is the typed form of the EigenPodStorage custom error InsufficientWithdrawableBalance().
+type ErrInsufficientWithdrawableBalance struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientWithdrawableBalance) Error() string {
+	return "EigenPodStorage: InsufficientWithdrawableBalance()"
+}
+
+// ErrInvalidEIP4788Response is the typed form of the EigenPodStorage custom error InvalidEIP4788Response().
+type ErrInvalidEIP4788Response struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEIP4788Response) Error() string {
+	return "EigenPodStorage: InvalidEIP4788Response()"
+}
+
+// ErrInvalidPubKeyLength is the typed form of the EigenPodStorage custom error InvalidPubKeyLength().
+type ErrInvalidPubKeyLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPubKeyLength) Error() string {
+	return "EigenPodStorage: InvalidPubKeyLength()"
+}
+
+// ErrMsgValueNot32ETH is the typed form of the EigenPodStorage custom error MsgValueNot32ETH().
+type ErrMsgValueNot32ETH struct{}
+
+// Error implements the error interface.
+func (ErrMsgValueNot32ETH) Error() string {
+	return "EigenPodStorage: MsgValueNot32ETH()"
+}
+
+// ErrNoActiveCheckpoint is the typed form of the EigenPodStorage custom error NoActiveCheckpoint().
+type ErrNoActiveCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrNoActiveCheckpoint) Error() string {
+	return "EigenPodStorage: NoActiveCheckpoint()"
+}
+
+// ErrNoBalanceToCheckpoint is the typed form of the EigenPodStorage custom error NoBalanceToCheckpoint().
+type ErrNoBalanceToCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrNoBalanceToCheckpoint) Error() string {
+	return "EigenPodStorage: NoBalanceToCheckpoint()"
+}
+
+// ErrOnlyEigenPodManager is the typed form of the EigenPodStorage custom error OnlyEigenPodManager().
+type ErrOnlyEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodManager) Error() string {
+	return "EigenPodStorage: OnlyEigenPodManager()"
+}
+
+// ErrOnlyEigenPodOwner is the typed form of the EigenPodStorage custom error OnlyEigenPodOwner().
+type ErrOnlyEigenPodOwner struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodOwner) Error() string {
+	return "EigenPodStorage: OnlyEigenPodOwner()"
+}
+
+// ErrOnlyEigenPodOwnerOrProofSubmitter is the typed form of the EigenPodStorage custom error OnlyEigenPodOwnerOrProofSubmitter().
+type ErrOnlyEigenPodOwnerOrProofSubmitter struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodOwnerOrProofSubmitter) Error() string {
+	return "EigenPodStorage: OnlyEigenPodOwnerOrProofSubmitter()"
+}
+
+// ErrPredeployFailed is the typed form of the EigenPodStorage custom error PredeployFailed().
+type ErrPredeployFailed struct{}
+
+// Error implements the error interface.
+func (ErrPredeployFailed) Error() string {
+	return "EigenPodStorage: PredeployFailed()"
+}
+
+// ErrTimestampOutOfRange is the typed form of the EigenPodStorage custom error TimestampOutOfRange().
+type ErrTimestampOutOfRange struct{}
+
+// Error implements the error interface.
+func (ErrTimestampOutOfRange) Error() string {
+	return "EigenPodStorage: TimestampOutOfRange()"
+}
+
+// ErrValidatorInactiveOnBeaconChain is the typed form of the EigenPodStorage custom error ValidatorInactiveOnBeaconChain().
+type ErrValidatorInactiveOnBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorInactiveOnBeaconChain) Error() string {
+	return "EigenPodStorage: ValidatorInactiveOnBeaconChain()"
+}
+
+// ErrValidatorIsExitingBeaconChain is the typed form of the EigenPodStorage custom error ValidatorIsExitingBeaconChain().
+type ErrValidatorIsExitingBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorIsExitingBeaconChain) Error() string {
+	return "EigenPodStorage: ValidatorIsExitingBeaconChain()"
+}
+
+// ErrValidatorNotActiveInPod is the typed form of the EigenPodStorage custom error ValidatorNotActiveInPod().
+type ErrValidatorNotActiveInPod struct{}
+
+// Error implements the error interface.
+func (ErrValidatorNotActiveInPod) Error() string {
+	return "EigenPodStorage: ValidatorNotActiveInPod()"
+}
+
+// ErrValidatorNotSlashedOnBeaconChain is the typed form of the EigenPodStorage custom error ValidatorNotSlashedOnBeaconChain().
+type ErrValidatorNotSlashedOnBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorNotSlashedOnBeaconChain) Error() string {
+	return "EigenPodStorage: ValidatorNotSlashedOnBeaconChain()"
+}
+
+// ErrWithdrawalCredentialsNotForEigenPod is the typed form of the EigenPodStorage custom error WithdrawalCredentialsNotForEigenPod().
+type ErrWithdrawalCredentialsNotForEigenPod struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalCredentialsNotForEigenPod) Error() string {
+	return "EigenPodStorage: WithdrawalCredentialsNotForEigenPod()"
+}
+
+// EigenPodStorageErrors maps the name of every custom error in the EigenPodStorage ABI to its typed Go error.
+var EigenPodStorageErrors = map[string]error{
+	"BeaconTimestampBeforeLatestCheckpoint": ErrBeaconTimestampBeforeLatestCheckpoint{},
+	"BeaconTimestampTooFarInPast":           ErrBeaconTimestampTooFarInPast{},
+	"CannotCheckpointTwiceInSingleBlock":    ErrCannotCheckpointTwiceInSingleBlock{},
+	"CheckpointAlreadyActive":               ErrCheckpointAlreadyActive{},
+	"CredentialsAlreadyVerified":            ErrCredentialsAlreadyVerified{},
+	"CurrentlyPaused":                       ErrCurrentlyPaused{},
+	"FeeQueryFailed":                        ErrFeeQueryFailed{},
+	"ForkTimestampZero":                     ErrForkTimestampZero{},
+	"InputAddressZero":                      ErrInputAddressZero{},
+	"InputArrayLengthMismatch":              ErrInputArrayLengthMismatch{},
+	"InsufficientFunds":                     ErrInsufficientFunds{},
+	"InsufficientWithdrawableBalance":       ErrInsufficientWithdrawableBalance{},
+	"InvalidEIP4788Response":                ErrInvalidEIP4788Response{},
+	"InvalidPubKeyLength":                   ErrInvalidPubKeyLength{},
+	"MsgValueNot32ETH":                      ErrMsgValueNot32ETH{},
+	"NoActiveCheckpoint":                    ErrNoActiveCheckpoint{},
+	"NoBalanceToCheckpoint":                 ErrNoBalanceToCheckpoint{},
+	"OnlyEigenPodManager":                   ErrOnlyEigenPodManager{},
+	"OnlyEigenPodOwner":                     ErrOnlyEigenPodOwner{},
+	"OnlyEigenPodOwnerOrProofSubmitter":     ErrOnlyEigenPodOwnerOrProofSubmitter{},
+	"PredeployFailed":                       ErrPredeployFailed{},
+	"TimestampOutOfRange":                   ErrTimestampOutOfRange{},
+	"ValidatorInactiveOnBeaconChain":        ErrValidatorInactiveOnBeaconChain{},
+	"ValidatorIsExitingBeaconChain":         ErrValidatorIsExitingBeaconChain{},
+	"ValidatorNotActiveInPod":               ErrValidatorNotActiveInPod{},
+	"ValidatorNotSlashedOnBeaconChain":      ErrValidatorNotSlashedOnBeaconChain{},
+	"WithdrawalCredentialsNotForEigenPod":   ErrWithdrawalCredentialsNotForEigenPod{},
+}
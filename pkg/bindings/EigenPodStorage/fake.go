@@ -0,0 +1,468 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeEigenPodStorage is an in-memory EigenPodStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded EigenPodStorageFilterer.
+type FakeEigenPodStorage struct {
+	*fake.Contract
+	*EigenPodStorageFilterer
+}
+
+// NewFakeEigenPodStorage returns an empty fake of the contract at address.
+func NewFakeEigenPodStorage(address common.Address) *FakeEigenPodStorage {
+	contract := fake.NewContract(EigenPodStorageMetaData, address)
+	filterer, err := NewEigenPodStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeEigenPodStorage{Contract: contract, EigenPodStorageFilterer: filterer}
+}
+
+// ActiveValidatorCount returns the values stubbed with StubActiveValidatorCount.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) ActiveValidatorCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodStorage.Contract.Read("activeValidatorCount", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubActiveValidatorCount sets what ActiveValidatorCount returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubActiveValidatorCount(out0 *big.Int) {
+	_FakeEigenPodStorage.Contract.Stub("activeValidatorCount", []interface{}{}, out0)
+}
+
+// CheckpointBalanceExitedGwei returns the values stubbed with StubCheckpointBalanceExitedGwei.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) CheckpointBalanceExitedGwei(opts *bind.CallOpts, arg0 uint64) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPodStorage.Contract.Read("checkpointBalanceExitedGwei", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubCheckpointBalanceExitedGwei sets what CheckpointBalanceExitedGwei returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubCheckpointBalanceExitedGwei(arg0 uint64, out0 uint64) {
+	_FakeEigenPodStorage.Contract.Stub("checkpointBalanceExitedGwei", []interface{}{arg0}, out0)
+}
+
+// CurrentCheckpoint returns the values stubbed with StubCurrentCheckpoint.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) CurrentCheckpoint(opts *bind.CallOpts) (IEigenPodTypesCheckpoint, error) {
+	var out0 IEigenPodTypesCheckpoint
+	err := _FakeEigenPodStorage.Contract.Read("currentCheckpoint", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrentCheckpoint sets what CurrentCheckpoint returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubCurrentCheckpoint(out0 IEigenPodTypesCheckpoint) {
+	_FakeEigenPodStorage.Contract.Stub("currentCheckpoint", []interface{}{}, out0)
+}
+
+// CurrentCheckpointTimestamp returns the values stubbed with StubCurrentCheckpointTimestamp.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) CurrentCheckpointTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPodStorage.Contract.Read("currentCheckpointTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrentCheckpointTimestamp sets what CurrentCheckpointTimestamp returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubCurrentCheckpointTimestamp(out0 uint64) {
+	_FakeEigenPodStorage.Contract.Stub("currentCheckpointTimestamp", []interface{}{}, out0)
+}
+
+// EigenPodManager returns the values stubbed with StubEigenPodManager.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodStorage.Contract.Read("eigenPodManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenPodManager sets what EigenPodManager returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubEigenPodManager(out0 common.Address) {
+	_FakeEigenPodStorage.Contract.Stub("eigenPodManager", []interface{}{}, out0)
+}
+
+// GetConsolidationRequestFee returns the values stubbed with StubGetConsolidationRequestFee.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) GetConsolidationRequestFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodStorage.Contract.Read("getConsolidationRequestFee", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetConsolidationRequestFee sets what GetConsolidationRequestFee returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubGetConsolidationRequestFee(out0 *big.Int) {
+	_FakeEigenPodStorage.Contract.Stub("getConsolidationRequestFee", []interface{}{}, out0)
+}
+
+// GetParentBlockRoot returns the values stubbed with StubGetParentBlockRoot.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) GetParentBlockRoot(opts *bind.CallOpts, timestamp uint64) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeEigenPodStorage.Contract.Read("getParentBlockRoot", []interface{}{timestamp}, &out0)
+	return out0, err
+}
+
+// StubGetParentBlockRoot sets what GetParentBlockRoot returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubGetParentBlockRoot(timestamp uint64, out0 [32]byte) {
+	_FakeEigenPodStorage.Contract.Stub("getParentBlockRoot", []interface{}{timestamp}, out0)
+}
+
+// GetWithdrawalRequestFee returns the values stubbed with StubGetWithdrawalRequestFee.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) GetWithdrawalRequestFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenPodStorage.Contract.Read("getWithdrawalRequestFee", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetWithdrawalRequestFee sets what GetWithdrawalRequestFee returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubGetWithdrawalRequestFee(out0 *big.Int) {
+	_FakeEigenPodStorage.Contract.Stub("getWithdrawalRequestFee", []interface{}{}, out0)
+}
+
+// LastCheckpointTimestamp returns the values stubbed with StubLastCheckpointTimestamp.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) LastCheckpointTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPodStorage.Contract.Read("lastCheckpointTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubLastCheckpointTimestamp sets what LastCheckpointTimestamp returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubLastCheckpointTimestamp(out0 uint64) {
+	_FakeEigenPodStorage.Contract.Stub("lastCheckpointTimestamp", []interface{}{}, out0)
+}
+
+// PodOwner returns the values stubbed with StubPodOwner.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) PodOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodStorage.Contract.Read("podOwner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPodOwner sets what PodOwner returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubPodOwner(out0 common.Address) {
+	_FakeEigenPodStorage.Contract.Stub("podOwner", []interface{}{}, out0)
+}
+
+// ProofSubmitter returns the values stubbed with StubProofSubmitter.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) ProofSubmitter(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenPodStorage.Contract.Read("proofSubmitter", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubProofSubmitter sets what ProofSubmitter returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubProofSubmitter(out0 common.Address) {
+	_FakeEigenPodStorage.Contract.Stub("proofSubmitter", []interface{}{}, out0)
+}
+
+// ValidatorPubkeyHashToInfo returns the values stubbed with StubValidatorPubkeyHashToInfo.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (IEigenPodTypesValidatorInfo, error) {
+	var out0 IEigenPodTypesValidatorInfo
+	err := _FakeEigenPodStorage.Contract.Read("validatorPubkeyHashToInfo", []interface{}{validatorPubkeyHash}, &out0)
+	return out0, err
+}
+
+// StubValidatorPubkeyHashToInfo sets what ValidatorPubkeyHashToInfo returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubValidatorPubkeyHashToInfo(validatorPubkeyHash [32]byte, out0 IEigenPodTypesValidatorInfo) {
+	_FakeEigenPodStorage.Contract.Stub("validatorPubkeyHashToInfo", []interface{}{validatorPubkeyHash}, out0)
+}
+
+// ValidatorPubkeyToInfo returns the values stubbed with StubValidatorPubkeyToInfo.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (IEigenPodTypesValidatorInfo, error) {
+	var out0 IEigenPodTypesValidatorInfo
+	err := _FakeEigenPodStorage.Contract.Read("validatorPubkeyToInfo", []interface{}{validatorPubkey}, &out0)
+	return out0, err
+}
+
+// StubValidatorPubkeyToInfo sets what ValidatorPubkeyToInfo returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubValidatorPubkeyToInfo(validatorPubkey []byte, out0 IEigenPodTypesValidatorInfo) {
+	_FakeEigenPodStorage.Contract.Stub("validatorPubkeyToInfo", []interface{}{validatorPubkey}, out0)
+}
+
+// ValidatorStatus returns the values stubbed with StubValidatorStatus.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeEigenPodStorage.Contract.Read("validatorStatus", []interface{}{validatorPubkey}, &out0)
+	return out0, err
+}
+
+// StubValidatorStatus sets what ValidatorStatus returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubValidatorStatus(validatorPubkey []byte, out0 uint8) {
+	_FakeEigenPodStorage.Contract.Stub("validatorStatus", []interface{}{validatorPubkey}, out0)
+}
+
+// ValidatorStatus0 returns the values stubbed with StubValidatorStatus0.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeEigenPodStorage.Contract.Read("validatorStatus0", []interface{}{pubkeyHash}, &out0)
+	return out0, err
+}
+
+// StubValidatorStatus0 sets what ValidatorStatus0 returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubValidatorStatus0(pubkeyHash [32]byte, out0 uint8) {
+	_FakeEigenPodStorage.Contract.Stub("validatorStatus0", []interface{}{pubkeyHash}, out0)
+}
+
+// WithdrawableRestakedExecutionLayerGwei returns the values stubbed with StubWithdrawableRestakedExecutionLayerGwei.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeEigenPodStorage.Contract.Read("withdrawableRestakedExecutionLayerGwei", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubWithdrawableRestakedExecutionLayerGwei sets what WithdrawableRestakedExecutionLayerGwei returns for the given arguments.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StubWithdrawableRestakedExecutionLayerGwei(out0 uint64) {
+	_FakeEigenPodStorage.Contract.Stub("withdrawableRestakedExecutionLayerGwei", []interface{}{}, out0)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) Initialize(opts *bind.TransactOpts, owner common.Address) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("initialize").(func(opts *bind.TransactOpts, owner common.Address) error); ok {
+			return hook(opts, owner)
+		}
+		return nil
+	}, owner)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnInitialize(hook func(opts *bind.TransactOpts, owner common.Address) error) {
+	_FakeEigenPodStorage.Contract.SetHook("initialize", hook)
+}
+
+// RecoverTokens records a recoverTokens call after running the hook set with OnRecoverTokens.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "recoverTokens", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("recoverTokens").(func(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) error); ok {
+			return hook(opts, tokenList, amountsToWithdraw, recipient)
+		}
+		return nil
+	}, tokenList, amountsToWithdraw, recipient)
+}
+
+// OnRecoverTokens sets a hook run by every RecoverTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnRecoverTokens(hook func(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) error) {
+	_FakeEigenPodStorage.Contract.SetHook("recoverTokens", hook)
+}
+
+// RequestConsolidation records a requestConsolidation call after running the hook set with OnRequestConsolidation.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) RequestConsolidation(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "requestConsolidation", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("requestConsolidation").(func(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) error); ok {
+			return hook(opts, requests)
+		}
+		return nil
+	}, requests)
+}
+
+// OnRequestConsolidation sets a hook run by every RequestConsolidation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnRequestConsolidation(hook func(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) error) {
+	_FakeEigenPodStorage.Contract.SetHook("requestConsolidation", hook)
+}
+
+// RequestWithdrawal records a requestWithdrawal call after running the hook set with OnRequestWithdrawal.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) RequestWithdrawal(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "requestWithdrawal", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("requestWithdrawal").(func(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) error); ok {
+			return hook(opts, requests)
+		}
+		return nil
+	}, requests)
+}
+
+// OnRequestWithdrawal sets a hook run by every RequestWithdrawal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnRequestWithdrawal(hook func(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) error) {
+	_FakeEigenPodStorage.Contract.SetHook("requestWithdrawal", hook)
+}
+
+// SetProofSubmitter records a setProofSubmitter call after running the hook set with OnSetProofSubmitter.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) SetProofSubmitter(opts *bind.TransactOpts, newProofSubmitter common.Address) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "setProofSubmitter", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("setProofSubmitter").(func(opts *bind.TransactOpts, newProofSubmitter common.Address) error); ok {
+			return hook(opts, newProofSubmitter)
+		}
+		return nil
+	}, newProofSubmitter)
+}
+
+// OnSetProofSubmitter sets a hook run by every SetProofSubmitter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnSetProofSubmitter(hook func(opts *bind.TransactOpts, newProofSubmitter common.Address) error) {
+	_FakeEigenPodStorage.Contract.SetHook("setProofSubmitter", hook)
+}
+
+// Stake records a stake call after running the hook set with OnStake.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "stake", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("stake").(func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error); ok {
+			return hook(opts, pubkey, signature, depositDataRoot)
+		}
+		return nil
+	}, pubkey, signature, depositDataRoot)
+}
+
+// OnStake sets a hook run by every Stake call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnStake(hook func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error) {
+	_FakeEigenPodStorage.Contract.SetHook("stake", hook)
+}
+
+// StartCheckpoint records a startCheckpoint call after running the hook set with OnStartCheckpoint.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) StartCheckpoint(opts *bind.TransactOpts, revertIfNoBalance bool) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "startCheckpoint", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("startCheckpoint").(func(opts *bind.TransactOpts, revertIfNoBalance bool) error); ok {
+			return hook(opts, revertIfNoBalance)
+		}
+		return nil
+	}, revertIfNoBalance)
+}
+
+// OnStartCheckpoint sets a hook run by every StartCheckpoint call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnStartCheckpoint(hook func(opts *bind.TransactOpts, revertIfNoBalance bool) error) {
+	_FakeEigenPodStorage.Contract.SetHook("startCheckpoint", hook)
+}
+
+// VerifyCheckpointProofs records a verifyCheckpointProofs call after running the hook set with OnVerifyCheckpointProofs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) VerifyCheckpointProofs(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "verifyCheckpointProofs", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("verifyCheckpointProofs").(func(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) error); ok {
+			return hook(opts, balanceContainerProof, proofs)
+		}
+		return nil
+	}, balanceContainerProof, proofs)
+}
+
+// OnVerifyCheckpointProofs sets a hook run by every VerifyCheckpointProofs call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnVerifyCheckpointProofs(hook func(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) error) {
+	_FakeEigenPodStorage.Contract.SetHook("verifyCheckpointProofs", hook)
+}
+
+// VerifyStaleBalance records a verifyStaleBalance call after running the hook set with OnVerifyStaleBalance.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) VerifyStaleBalance(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "verifyStaleBalance", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("verifyStaleBalance").(func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) error); ok {
+			return hook(opts, beaconTimestamp, stateRootProof, proof)
+		}
+		return nil
+	}, beaconTimestamp, stateRootProof, proof)
+}
+
+// OnVerifyStaleBalance sets a hook run by every VerifyStaleBalance call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnVerifyStaleBalance(hook func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) error) {
+	_FakeEigenPodStorage.Contract.SetHook("verifyStaleBalance", hook)
+}
+
+// VerifyWithdrawalCredentials records a verifyWithdrawalCredentials call after running the hook set with OnVerifyWithdrawalCredentials.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) VerifyWithdrawalCredentials(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "verifyWithdrawalCredentials", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("verifyWithdrawalCredentials").(func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) error); ok {
+			return hook(opts, beaconTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+		}
+		return nil
+	}, beaconTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+}
+
+// OnVerifyWithdrawalCredentials sets a hook run by every VerifyWithdrawalCredentials call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnVerifyWithdrawalCredentials(hook func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) error) {
+	_FakeEigenPodStorage.Contract.SetHook("verifyWithdrawalCredentials", hook)
+}
+
+// WithdrawRestakedBeaconChainETH records a withdrawRestakedBeaconChainETH call after running the hook set with OnWithdrawRestakedBeaconChainETH.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeEigenPodStorage.Contract.Transact(opts, "withdrawRestakedBeaconChainETH", func() error {
+		if hook, ok := _FakeEigenPodStorage.Contract.Hook("withdrawRestakedBeaconChainETH").(func(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) error); ok {
+			return hook(opts, recipient, amount)
+		}
+		return nil
+	}, recipient, amount)
+}
+
+// OnWithdrawRestakedBeaconChainETH sets a hook run by every WithdrawRestakedBeaconChainETH call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) OnWithdrawRestakedBeaconChainETH(hook func(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) error) {
+	_FakeEigenPodStorage.Contract.SetHook("withdrawRestakedBeaconChainETH", hook)
+}
+
+// EmitCheckpointCreated appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitCheckpointCreated(event *EigenPodStorageCheckpointCreated) {
+	_FakeEigenPodStorage.Contract.Emit("CheckpointCreated", event)
+}
+
+// EmitCheckpointFinalized appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitCheckpointFinalized(event *EigenPodStorageCheckpointFinalized) {
+	_FakeEigenPodStorage.Contract.Emit("CheckpointFinalized", event)
+}
+
+// EmitConsolidationRequested appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitConsolidationRequested(event *EigenPodStorageConsolidationRequested) {
+	_FakeEigenPodStorage.Contract.Emit("ConsolidationRequested", event)
+}
+
+// EmitEigenPodStaked appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitEigenPodStaked(event *EigenPodStorageEigenPodStaked) {
+	_FakeEigenPodStorage.Contract.Emit("EigenPodStaked", event)
+}
+
+// EmitExitRequested appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitExitRequested(event *EigenPodStorageExitRequested) {
+	_FakeEigenPodStorage.Contract.Emit("ExitRequested", event)
+}
+
+// EmitNonBeaconChainETHReceived appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitNonBeaconChainETHReceived(event *EigenPodStorageNonBeaconChainETHReceived) {
+	_FakeEigenPodStorage.Contract.Emit("NonBeaconChainETHReceived", event)
+}
+
+// EmitProofSubmitterUpdated appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitProofSubmitterUpdated(event *EigenPodStorageProofSubmitterUpdated) {
+	_FakeEigenPodStorage.Contract.Emit("ProofSubmitterUpdated", event)
+}
+
+// EmitRestakedBeaconChainETHWithdrawn appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitRestakedBeaconChainETHWithdrawn(event *EigenPodStorageRestakedBeaconChainETHWithdrawn) {
+	_FakeEigenPodStorage.Contract.Emit("RestakedBeaconChainETHWithdrawn", event)
+}
+
+// EmitSwitchToCompoundingRequested appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitSwitchToCompoundingRequested(event *EigenPodStorageSwitchToCompoundingRequested) {
+	_FakeEigenPodStorage.Contract.Emit("SwitchToCompoundingRequested", event)
+}
+
+// EmitValidatorBalanceUpdated appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitValidatorBalanceUpdated(event *EigenPodStorageValidatorBalanceUpdated) {
+	_FakeEigenPodStorage.Contract.Emit("ValidatorBalanceUpdated", event)
+}
+
+// EmitValidatorCheckpointed appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitValidatorCheckpointed(event *EigenPodStorageValidatorCheckpointed) {
+	_FakeEigenPodStorage.Contract.Emit("ValidatorCheckpointed", event)
+}
+
+// EmitValidatorRestaked appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitValidatorRestaked(event *EigenPodStorageValidatorRestaked) {
+	_FakeEigenPodStorage.Contract.Emit("ValidatorRestaked", event)
+}
+
+// EmitValidatorWithdrawn appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitValidatorWithdrawn(event *EigenPodStorageValidatorWithdrawn) {
+	_FakeEigenPodStorage.Contract.Emit("ValidatorWithdrawn", event)
+}
+
+// EmitWithdrawalRequested appends the event to the fake's logs.
+func (_FakeEigenPodStorage *FakeEigenPodStorage) EmitWithdrawalRequested(event *EigenPodStorageWithdrawalRequested) {
+	_FakeEigenPodStorage.Contract.Emit("WithdrawalRequested", event)
+}
@@ -0,0 +1,107 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenPodStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// EigenPodStorageReader is the read-only surface of the EigenPodStorage
+// binding, implemented by EigenPodStorageCaller, EigenPodStorage and FakeEigenPodStorage.
+type EigenPodStorageReader interface {
+	ActiveValidatorCount(opts *bind.CallOpts) (*big.Int, error)
+	CheckpointBalanceExitedGwei(opts *bind.CallOpts, arg0 uint64) (uint64, error)
+	CurrentCheckpoint(opts *bind.CallOpts) (IEigenPodTypesCheckpoint, error)
+	CurrentCheckpointTimestamp(opts *bind.CallOpts) (uint64, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetConsolidationRequestFee(opts *bind.CallOpts) (*big.Int, error)
+	GetParentBlockRoot(opts *bind.CallOpts, timestamp uint64) ([32]byte, error)
+	GetWithdrawalRequestFee(opts *bind.CallOpts) (*big.Int, error)
+	LastCheckpointTimestamp(opts *bind.CallOpts) (uint64, error)
+	PodOwner(opts *bind.CallOpts) (common.Address, error)
+	ProofSubmitter(opts *bind.CallOpts) (common.Address, error)
+	ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (IEigenPodTypesValidatorInfo, error)
+	ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (IEigenPodTypesValidatorInfo, error)
+	ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error)
+	ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error)
+	WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error)
+}
+
+// EigenPodStorageWriter is the transacting surface of the EigenPodStorage
+// binding, implemented by EigenPodStorageTransactor, EigenPodStorage and FakeEigenPodStorage.
+type EigenPodStorageWriter interface {
+	Initialize(opts *bind.TransactOpts, owner common.Address) (*types.Transaction, error)
+	RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error)
+	RequestConsolidation(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) (*types.Transaction, error)
+	RequestWithdrawal(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) (*types.Transaction, error)
+	SetProofSubmitter(opts *bind.TransactOpts, newProofSubmitter common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	StartCheckpoint(opts *bind.TransactOpts, revertIfNoBalance bool) (*types.Transaction, error)
+	VerifyCheckpointProofs(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) (*types.Transaction, error)
+	VerifyStaleBalance(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) (*types.Transaction, error)
+	VerifyWithdrawalCredentials(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+	WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// EigenPodStorageEvents is the event filtering surface of the EigenPodStorage
+// binding, implemented by EigenPodStorageFilterer, EigenPodStorage and FakeEigenPodStorage.
+type EigenPodStorageEvents interface {
+	FilterCheckpointCreated(opts *bind.FilterOpts, checkpointTimestamp []uint64, beaconBlockRoot [][32]byte) (*EigenPodStorageCheckpointCreatedIterator, error)
+	WatchCheckpointCreated(opts *bind.WatchOpts, sink chan<- *EigenPodStorageCheckpointCreated, checkpointTimestamp []uint64, beaconBlockRoot [][32]byte) (event.Subscription, error)
+	ParseCheckpointCreated(log types.Log) (*EigenPodStorageCheckpointCreated, error)
+	FilterCheckpointFinalized(opts *bind.FilterOpts, checkpointTimestamp []uint64) (*EigenPodStorageCheckpointFinalizedIterator, error)
+	WatchCheckpointFinalized(opts *bind.WatchOpts, sink chan<- *EigenPodStorageCheckpointFinalized, checkpointTimestamp []uint64) (event.Subscription, error)
+	ParseCheckpointFinalized(log types.Log) (*EigenPodStorageCheckpointFinalized, error)
+	FilterConsolidationRequested(opts *bind.FilterOpts, sourcePubkeyHash [][32]byte, targetPubkeyHash [][32]byte) (*EigenPodStorageConsolidationRequestedIterator, error)
+	WatchConsolidationRequested(opts *bind.WatchOpts, sink chan<- *EigenPodStorageConsolidationRequested, sourcePubkeyHash [][32]byte, targetPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseConsolidationRequested(log types.Log) (*EigenPodStorageConsolidationRequested, error)
+	FilterEigenPodStaked(opts *bind.FilterOpts) (*EigenPodStorageEigenPodStakedIterator, error)
+	WatchEigenPodStaked(opts *bind.WatchOpts, sink chan<- *EigenPodStorageEigenPodStaked) (event.Subscription, error)
+	ParseEigenPodStaked(log types.Log) (*EigenPodStorageEigenPodStaked, error)
+	FilterExitRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*EigenPodStorageExitRequestedIterator, error)
+	WatchExitRequested(opts *bind.WatchOpts, sink chan<- *EigenPodStorageExitRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseExitRequested(log types.Log) (*EigenPodStorageExitRequested, error)
+	FilterNonBeaconChainETHReceived(opts *bind.FilterOpts) (*EigenPodStorageNonBeaconChainETHReceivedIterator, error)
+	WatchNonBeaconChainETHReceived(opts *bind.WatchOpts, sink chan<- *EigenPodStorageNonBeaconChainETHReceived) (event.Subscription, error)
+	ParseNonBeaconChainETHReceived(log types.Log) (*EigenPodStorageNonBeaconChainETHReceived, error)
+	FilterProofSubmitterUpdated(opts *bind.FilterOpts) (*EigenPodStorageProofSubmitterUpdatedIterator, error)
+	WatchProofSubmitterUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodStorageProofSubmitterUpdated) (event.Subscription, error)
+	ParseProofSubmitterUpdated(log types.Log) (*EigenPodStorageProofSubmitterUpdated, error)
+	FilterRestakedBeaconChainETHWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*EigenPodStorageRestakedBeaconChainETHWithdrawnIterator, error)
+	WatchRestakedBeaconChainETHWithdrawn(opts *bind.WatchOpts, sink chan<- *EigenPodStorageRestakedBeaconChainETHWithdrawn, recipient []common.Address) (event.Subscription, error)
+	ParseRestakedBeaconChainETHWithdrawn(log types.Log) (*EigenPodStorageRestakedBeaconChainETHWithdrawn, error)
+	FilterSwitchToCompoundingRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*EigenPodStorageSwitchToCompoundingRequestedIterator, error)
+	WatchSwitchToCompoundingRequested(opts *bind.WatchOpts, sink chan<- *EigenPodStorageSwitchToCompoundingRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseSwitchToCompoundingRequested(log types.Log) (*EigenPodStorageSwitchToCompoundingRequested, error)
+	FilterValidatorBalanceUpdated(opts *bind.FilterOpts) (*EigenPodStorageValidatorBalanceUpdatedIterator, error)
+	WatchValidatorBalanceUpdated(opts *bind.WatchOpts, sink chan<- *EigenPodStorageValidatorBalanceUpdated) (event.Subscription, error)
+	ParseValidatorBalanceUpdated(log types.Log) (*EigenPodStorageValidatorBalanceUpdated, error)
+	FilterValidatorCheckpointed(opts *bind.FilterOpts, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (*EigenPodStorageValidatorCheckpointedIterator, error)
+	WatchValidatorCheckpointed(opts *bind.WatchOpts, sink chan<- *EigenPodStorageValidatorCheckpointed, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (event.Subscription, error)
+	ParseValidatorCheckpointed(log types.Log) (*EigenPodStorageValidatorCheckpointed, error)
+	FilterValidatorRestaked(opts *bind.FilterOpts) (*EigenPodStorageValidatorRestakedIterator, error)
+	WatchValidatorRestaked(opts *bind.WatchOpts, sink chan<- *EigenPodStorageValidatorRestaked) (event.Subscription, error)
+	ParseValidatorRestaked(log types.Log) (*EigenPodStorageValidatorRestaked, error)
+	FilterValidatorWithdrawn(opts *bind.FilterOpts, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (*EigenPodStorageValidatorWithdrawnIterator, error)
+	WatchValidatorWithdrawn(opts *bind.WatchOpts, sink chan<- *EigenPodStorageValidatorWithdrawn, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (event.Subscription, error)
+	ParseValidatorWithdrawn(log types.Log) (*EigenPodStorageValidatorWithdrawn, error)
+	FilterWithdrawalRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*EigenPodStorageWithdrawalRequestedIterator, error)
+	WatchWithdrawalRequested(opts *bind.WatchOpts, sink chan<- *EigenPodStorageWithdrawalRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseWithdrawalRequested(log types.Log) (*EigenPodStorageWithdrawalRequested, error)
+}
+
+var (
+	_ EigenPodStorageReader = (*EigenPodStorageCaller)(nil)
+	_ EigenPodStorageReader = (*EigenPodStorage)(nil)
+	_ EigenPodStorageReader = (*FakeEigenPodStorage)(nil)
+	_ EigenPodStorageWriter = (*EigenPodStorageTransactor)(nil)
+	_ EigenPodStorageWriter = (*EigenPodStorage)(nil)
+	_ EigenPodStorageWriter = (*FakeEigenPodStorage)(nil)
+	_ EigenPodStorageEvents = (*EigenPodStorageFilterer)(nil)
+	_ EigenPodStorageEvents = (*EigenPodStorage)(nil)
+	_ EigenPodStorageEvents = (*FakeEigenPodStorage)(nil)
+)
@@ -0,0 +1,115 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenStrategy
+
+// ErrBalanceExceedsMaxTotalDeposits is the typed form of the EigenStrategy custom error BalanceExceedsMaxTotalDeposits().
+type ErrBalanceExceedsMaxTotalDeposits struct{}
+
+// Error implements the error interface.
+func (ErrBalanceExceedsMaxTotalDeposits) Error() string {
+	return "EigenStrategy: BalanceExceedsMaxTotalDeposits()"
+}
+
+// ErrCurrentlyPaused is the typed form of the EigenStrategy custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "EigenStrategy: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the EigenStrategy custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "EigenStrategy: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the EigenStrategy custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "EigenStrategy: InvalidNewPausedStatus()"
+}
+
+// ErrMaxPerDepositExceedsMax is the typed form of the EigenStrategy custom error MaxPerDepositExceedsMax().
+type ErrMaxPerDepositExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrMaxPerDepositExceedsMax) Error() string {
+	return "EigenStrategy: MaxPerDepositExceedsMax()"
+}
+
+// ErrNewSharesZero is the typed form of the EigenStrategy custom error NewSharesZero().
+type ErrNewSharesZero struct{}
+
+// Error implements the error interface.
+func (ErrNewSharesZero) Error() string {
+	return "EigenStrategy: NewSharesZero()"
+}
+
+// ErrOnlyPauser is the typed form of the EigenStrategy custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "EigenStrategy: OnlyPauser()"
+}
+
+// ErrOnlyStrategyManager is the typed form of the EigenStrategy custom error OnlyStrategyManager().
+type ErrOnlyStrategyManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyManager) Error() string {
+	return "EigenStrategy: OnlyStrategyManager()"
+}
+
+// ErrOnlyUnderlyingToken is the typed form of the EigenStrategy custom error OnlyUnderlyingToken().
+type ErrOnlyUnderlyingToken struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnderlyingToken) Error() string {
+	return "EigenStrategy: OnlyUnderlyingToken()"
+}
+
+// ErrOnlyUnpauser is the typed form of the EigenStrategy custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "EigenStrategy: OnlyUnpauser()"
+}
+
+// ErrTotalSharesExceedsMax is the typed form of the EigenStrategy custom error TotalSharesExceedsMax().
+type ErrTotalSharesExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrTotalSharesExceedsMax) Error() string {
+	return "EigenStrategy: TotalSharesExceedsMax()"
+}
+
+// ErrWithdrawalAmountExceedsTotalDeposits is the typed form of the EigenStrategy custom error WithdrawalAmountExceedsTotalDeposits().
+type ErrWithdrawalAmountExceedsTotalDeposits struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalAmountExceedsTotalDeposits) Error() string {
+	return "EigenStrategy: WithdrawalAmountExceedsTotalDeposits()"
+}
+
+// EigenStrategyErrors maps the name of every custom error in the EigenStrategy ABI to its typed Go error.
+var EigenStrategyErrors = map[string]error{
+	"BalanceExceedsMaxTotalDeposits":       ErrBalanceExceedsMaxTotalDeposits{},
+	"CurrentlyPaused":                      ErrCurrentlyPaused{},
+	"InputAddressZero":                     ErrInputAddressZero{},
+	"InvalidNewPausedStatus":               ErrInvalidNewPausedStatus{},
+	"MaxPerDepositExceedsMax":              ErrMaxPerDepositExceedsMax{},
+	"NewSharesZero":                        ErrNewSharesZero{},
+	"OnlyPauser":                           ErrOnlyPauser{},
+	"OnlyStrategyManager":                  ErrOnlyStrategyManager{},
+	"OnlyUnderlyingToken":                  ErrOnlyUnderlyingToken{},
+	"OnlyUnpauser":                         ErrOnlyUnpauser{},
+	"TotalSharesExceedsMax":                ErrTotalSharesExceedsMax{},
+	"WithdrawalAmountExceedsTotalDeposits": ErrWithdrawalAmountExceedsTotalDeposits{},
+}
@@ -0,0 +1,351 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenStrategy
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeEigenStrategy is an in-memory EigenStrategy for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded EigenStrategyFilterer.
+type FakeEigenStrategy struct {
+	*fake.Contract
+	*EigenStrategyFilterer
+}
+
+// NewFakeEigenStrategy returns an empty fake of the contract at address.
+func NewFakeEigenStrategy(address common.Address) *FakeEigenStrategy {
+	contract := fake.NewContract(EigenStrategyMetaData, address)
+	filterer, err := NewEigenStrategyFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeEigenStrategy{Contract: contract, EigenStrategyFilterer: filterer}
+}
+
+// EIGEN returns the values stubbed with StubEIGEN.
+func (_FakeEigenStrategy *FakeEigenStrategy) EIGEN(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenStrategy.Contract.Read("EIGEN", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEIGEN sets what EIGEN returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubEIGEN(out0 common.Address) {
+	_FakeEigenStrategy.Contract.Stub("EIGEN", []interface{}{}, out0)
+}
+
+// Explanation returns the values stubbed with StubExplanation.
+func (_FakeEigenStrategy *FakeEigenStrategy) Explanation(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeEigenStrategy.Contract.Read("explanation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubExplanation sets what Explanation returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubExplanation(out0 string) {
+	_FakeEigenStrategy.Contract.Stub("explanation", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeEigenStrategy *FakeEigenStrategy) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeEigenStrategy.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubPaused(index uint8, out0 bool) {
+	_FakeEigenStrategy.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeEigenStrategy *FakeEigenStrategy) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenStrategy.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubPaused0(out0 *big.Int) {
+	_FakeEigenStrategy.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeEigenStrategy *FakeEigenStrategy) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenStrategy.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubPauserRegistry(out0 common.Address) {
+	_FakeEigenStrategy.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// Shares returns the values stubbed with StubShares.
+func (_FakeEigenStrategy *FakeEigenStrategy) Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenStrategy.Contract.Read("shares", []interface{}{user}, &out0)
+	return out0, err
+}
+
+// StubShares sets what Shares returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubShares(user common.Address, out0 *big.Int) {
+	_FakeEigenStrategy.Contract.Stub("shares", []interface{}{user}, out0)
+}
+
+// SharesToUnderlying returns the values stubbed with StubSharesToUnderlying.
+func (_FakeEigenStrategy *FakeEigenStrategy) SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenStrategy.Contract.Read("sharesToUnderlying", []interface{}{amountShares}, &out0)
+	return out0, err
+}
+
+// StubSharesToUnderlying sets what SharesToUnderlying returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubSharesToUnderlying(amountShares *big.Int, out0 *big.Int) {
+	_FakeEigenStrategy.Contract.Stub("sharesToUnderlying", []interface{}{amountShares}, out0)
+}
+
+// SharesToUnderlyingView returns the values stubbed with StubSharesToUnderlyingView.
+func (_FakeEigenStrategy *FakeEigenStrategy) SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenStrategy.Contract.Read("sharesToUnderlyingView", []interface{}{amountShares}, &out0)
+	return out0, err
+}
+
+// StubSharesToUnderlyingView sets what SharesToUnderlyingView returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubSharesToUnderlyingView(amountShares *big.Int, out0 *big.Int) {
+	_FakeEigenStrategy.Contract.Stub("sharesToUnderlyingView", []interface{}{amountShares}, out0)
+}
+
+// StrategyManager returns the values stubbed with StubStrategyManager.
+func (_FakeEigenStrategy *FakeEigenStrategy) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenStrategy.Contract.Read("strategyManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyManager sets what StrategyManager returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubStrategyManager(out0 common.Address) {
+	_FakeEigenStrategy.Contract.Stub("strategyManager", []interface{}{}, out0)
+}
+
+// TotalShares returns the values stubbed with StubTotalShares.
+func (_FakeEigenStrategy *FakeEigenStrategy) TotalShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenStrategy.Contract.Read("totalShares", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalShares sets what TotalShares returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubTotalShares(out0 *big.Int) {
+	_FakeEigenStrategy.Contract.Stub("totalShares", []interface{}{}, out0)
+}
+
+// UnderlyingToShares returns the values stubbed with StubUnderlyingToShares.
+func (_FakeEigenStrategy *FakeEigenStrategy) UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenStrategy.Contract.Read("underlyingToShares", []interface{}{amountUnderlying}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToShares sets what UnderlyingToShares returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubUnderlyingToShares(amountUnderlying *big.Int, out0 *big.Int) {
+	_FakeEigenStrategy.Contract.Stub("underlyingToShares", []interface{}{amountUnderlying}, out0)
+}
+
+// UnderlyingToSharesView returns the values stubbed with StubUnderlyingToSharesView.
+func (_FakeEigenStrategy *FakeEigenStrategy) UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenStrategy.Contract.Read("underlyingToSharesView", []interface{}{amountUnderlying}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToSharesView sets what UnderlyingToSharesView returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubUnderlyingToSharesView(amountUnderlying *big.Int, out0 *big.Int) {
+	_FakeEigenStrategy.Contract.Stub("underlyingToSharesView", []interface{}{amountUnderlying}, out0)
+}
+
+// UnderlyingToken returns the values stubbed with StubUnderlyingToken.
+func (_FakeEigenStrategy *FakeEigenStrategy) UnderlyingToken(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeEigenStrategy.Contract.Read("underlyingToken", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToken sets what UnderlyingToken returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubUnderlyingToken(out0 common.Address) {
+	_FakeEigenStrategy.Contract.Stub("underlyingToken", []interface{}{}, out0)
+}
+
+// UserUnderlyingView returns the values stubbed with StubUserUnderlyingView.
+func (_FakeEigenStrategy *FakeEigenStrategy) UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeEigenStrategy.Contract.Read("userUnderlyingView", []interface{}{user}, &out0)
+	return out0, err
+}
+
+// StubUserUnderlyingView sets what UserUnderlyingView returns for the given arguments.
+func (_FakeEigenStrategy *FakeEigenStrategy) StubUserUnderlyingView(user common.Address, out0 *big.Int) {
+	_FakeEigenStrategy.Contract.Stub("userUnderlyingView", []interface{}{user}, out0)
+}
+
+// Deposit records a deposit call after running the hook set with OnDeposit.
+func (_FakeEigenStrategy *FakeEigenStrategy) Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeEigenStrategy.Contract.Transact(opts, "deposit", func() error {
+		if hook, ok := _FakeEigenStrategy.Contract.Hook("deposit").(func(opts *bind.TransactOpts, token common.Address, amount *big.Int) error); ok {
+			return hook(opts, token, amount)
+		}
+		return nil
+	}, token, amount)
+}
+
+// OnDeposit sets a hook run by every Deposit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenStrategy *FakeEigenStrategy) OnDeposit(hook func(opts *bind.TransactOpts, token common.Address, amount *big.Int) error) {
+	_FakeEigenStrategy.Contract.SetHook("deposit", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeEigenStrategy *FakeEigenStrategy) Initialize(opts *bind.TransactOpts, _EIGEN common.Address, _bEIGEN common.Address) (*types.Transaction, error) {
+	return _FakeEigenStrategy.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeEigenStrategy.Contract.Hook("initialize").(func(opts *bind.TransactOpts, _EIGEN common.Address, _bEIGEN common.Address) error); ok {
+			return hook(opts, _EIGEN, _bEIGEN)
+		}
+		return nil
+	}, _EIGEN, _bEIGEN)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenStrategy *FakeEigenStrategy) OnInitialize(hook func(opts *bind.TransactOpts, _EIGEN common.Address, _bEIGEN common.Address) error) {
+	_FakeEigenStrategy.Contract.SetHook("initialize", hook)
+}
+
+// Initialize0 records a initialize0 call after running the hook set with OnInitialize0.
+func (_FakeEigenStrategy *FakeEigenStrategy) Initialize0(opts *bind.TransactOpts, _underlyingToken common.Address) (*types.Transaction, error) {
+	return _FakeEigenStrategy.Contract.Transact(opts, "initialize0", func() error {
+		if hook, ok := _FakeEigenStrategy.Contract.Hook("initialize0").(func(opts *bind.TransactOpts, _underlyingToken common.Address) error); ok {
+			return hook(opts, _underlyingToken)
+		}
+		return nil
+	}, _underlyingToken)
+}
+
+// OnInitialize0 sets a hook run by every Initialize0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenStrategy *FakeEigenStrategy) OnInitialize0(hook func(opts *bind.TransactOpts, _underlyingToken common.Address) error) {
+	_FakeEigenStrategy.Contract.SetHook("initialize0", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeEigenStrategy *FakeEigenStrategy) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeEigenStrategy.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeEigenStrategy.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenStrategy *FakeEigenStrategy) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeEigenStrategy.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeEigenStrategy *FakeEigenStrategy) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeEigenStrategy.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeEigenStrategy.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenStrategy *FakeEigenStrategy) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeEigenStrategy.Contract.SetHook("pauseAll", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeEigenStrategy *FakeEigenStrategy) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeEigenStrategy.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeEigenStrategy.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenStrategy *FakeEigenStrategy) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeEigenStrategy.Contract.SetHook("unpause", hook)
+}
+
+// UserUnderlying records a userUnderlying call after running the hook set with OnUserUnderlying.
+func (_FakeEigenStrategy *FakeEigenStrategy) UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error) {
+	return _FakeEigenStrategy.Contract.Transact(opts, "userUnderlying", func() error {
+		if hook, ok := _FakeEigenStrategy.Contract.Hook("userUnderlying").(func(opts *bind.TransactOpts, user common.Address) error); ok {
+			return hook(opts, user)
+		}
+		return nil
+	}, user)
+}
+
+// OnUserUnderlying sets a hook run by every UserUnderlying call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenStrategy *FakeEigenStrategy) OnUserUnderlying(hook func(opts *bind.TransactOpts, user common.Address) error) {
+	_FakeEigenStrategy.Contract.SetHook("userUnderlying", hook)
+}
+
+// Withdraw records a withdraw call after running the hook set with OnWithdraw.
+func (_FakeEigenStrategy *FakeEigenStrategy) Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error) {
+	return _FakeEigenStrategy.Contract.Transact(opts, "withdraw", func() error {
+		if hook, ok := _FakeEigenStrategy.Contract.Hook("withdraw").(func(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) error); ok {
+			return hook(opts, recipient, token, amountShares)
+		}
+		return nil
+	}, recipient, token, amountShares)
+}
+
+// OnWithdraw sets a hook run by every Withdraw call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeEigenStrategy *FakeEigenStrategy) OnWithdraw(hook func(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) error) {
+	_FakeEigenStrategy.Contract.SetHook("withdraw", hook)
+}
+
+// EmitExchangeRateEmitted appends the event to the fake's logs.
+func (_FakeEigenStrategy *FakeEigenStrategy) EmitExchangeRateEmitted(event *EigenStrategyExchangeRateEmitted) {
+	_FakeEigenStrategy.Contract.Emit("ExchangeRateEmitted", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeEigenStrategy *FakeEigenStrategy) EmitInitialized(event *EigenStrategyInitialized) {
+	_FakeEigenStrategy.Contract.Emit("Initialized", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeEigenStrategy *FakeEigenStrategy) EmitPaused(event *EigenStrategyPaused) {
+	_FakeEigenStrategy.Contract.Emit("Paused", event)
+}
+
+// EmitStrategyTokenSet appends the event to the fake's logs.
+func (_FakeEigenStrategy *FakeEigenStrategy) EmitStrategyTokenSet(event *EigenStrategyStrategyTokenSet) {
+	_FakeEigenStrategy.Contract.Emit("StrategyTokenSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeEigenStrategy *FakeEigenStrategy) EmitUnpaused(event *EigenStrategyUnpaused) {
+	_FakeEigenStrategy.Contract.Emit("Unpaused", event)
+}
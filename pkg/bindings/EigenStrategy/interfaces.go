@@ -0,0 +1,75 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package EigenStrategy
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// EigenStrategyReader is the read-only surface of the EigenStrategy
+// binding, implemented by EigenStrategyCaller, EigenStrategy and FakeEigenStrategy.
+type EigenStrategyReader interface {
+	EIGEN(opts *bind.CallOpts) (common.Address, error)
+	Explanation(opts *bind.CallOpts) (string, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	TotalShares(opts *bind.CallOpts) (*big.Int, error)
+	UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToken(opts *bind.CallOpts) (common.Address, error)
+	UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+}
+
+// EigenStrategyWriter is the transacting surface of the EigenStrategy
+// binding, implemented by EigenStrategyTransactor, EigenStrategy and FakeEigenStrategy.
+type EigenStrategyWriter interface {
+	Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _EIGEN common.Address, _bEIGEN common.Address) (*types.Transaction, error)
+	Initialize0(opts *bind.TransactOpts, _underlyingToken common.Address) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error)
+}
+
+// EigenStrategyEvents is the event filtering surface of the EigenStrategy
+// binding, implemented by EigenStrategyFilterer, EigenStrategy and FakeEigenStrategy.
+type EigenStrategyEvents interface {
+	FilterExchangeRateEmitted(opts *bind.FilterOpts) (*EigenStrategyExchangeRateEmittedIterator, error)
+	WatchExchangeRateEmitted(opts *bind.WatchOpts, sink chan<- *EigenStrategyExchangeRateEmitted) (event.Subscription, error)
+	ParseExchangeRateEmitted(log types.Log) (*EigenStrategyExchangeRateEmitted, error)
+	FilterInitialized(opts *bind.FilterOpts) (*EigenStrategyInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *EigenStrategyInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*EigenStrategyInitialized, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*EigenStrategyPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *EigenStrategyPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*EigenStrategyPaused, error)
+	FilterStrategyTokenSet(opts *bind.FilterOpts) (*EigenStrategyStrategyTokenSetIterator, error)
+	WatchStrategyTokenSet(opts *bind.WatchOpts, sink chan<- *EigenStrategyStrategyTokenSet) (event.Subscription, error)
+	ParseStrategyTokenSet(log types.Log) (*EigenStrategyStrategyTokenSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*EigenStrategyUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *EigenStrategyUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*EigenStrategyUnpaused, error)
+}
+
+var (
+	_ EigenStrategyReader = (*EigenStrategyCaller)(nil)
+	_ EigenStrategyReader = (*EigenStrategy)(nil)
+	_ EigenStrategyReader = (*FakeEigenStrategy)(nil)
+	_ EigenStrategyWriter = (*EigenStrategyTransactor)(nil)
+	_ EigenStrategyWriter = (*EigenStrategy)(nil)
+	_ EigenStrategyWriter = (*FakeEigenStrategy)(nil)
+	_ EigenStrategyEvents = (*EigenStrategyFilterer)(nil)
+	_ EigenStrategyEvents = (*EigenStrategy)(nil)
+	_ EigenStrategyEvents = (*FakeEigenStrategy)(nil)
+)
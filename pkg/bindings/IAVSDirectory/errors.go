@@ -0,0 +1,61 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAVSDirectory
+
+// ErrInvalidSignature is the typed form of the IAVSDirectory custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "IAVSDirectory: InvalidSignature()"
+}
+
+// ErrOperatorAlreadyRegisteredToAVS is the typed form of the IAVSDirectory custom error OperatorAlreadyRegisteredToAVS().
+type ErrOperatorAlreadyRegisteredToAVS struct{}
+
+// Error implements the error interface.
+func (ErrOperatorAlreadyRegisteredToAVS) Error() string {
+	return "IAVSDirectory: OperatorAlreadyRegisteredToAVS()"
+}
+
+// ErrOperatorNotRegisteredToAVS is the typed form of the IAVSDirectory custom error OperatorNotRegisteredToAVS().
+type ErrOperatorNotRegisteredToAVS struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegisteredToAVS) Error() string {
+	return "IAVSDirectory: OperatorNotRegisteredToAVS()"
+}
+
+// ErrOperatorNotRegisteredToEigenLayer is the typed form of the IAVSDirectory custom error OperatorNotRegisteredToEigenLayer().
+type ErrOperatorNotRegisteredToEigenLayer struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegisteredToEigenLayer) Error() string {
+	return "IAVSDirectory: OperatorNotRegisteredToEigenLayer()"
+}
+
+// ErrSaltSpent is the typed form of the IAVSDirectory custom error SaltSpent().
+type ErrSaltSpent struct{}
+
+// Error implements the error interface.
+func (ErrSaltSpent) Error() string {
+	return "IAVSDirectory: SaltSpent()"
+}
+
+// ErrSignatureExpired is the typed form of the IAVSDirectory custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "IAVSDirectory: SignatureExpired()"
+}
+
+// IAVSDirectoryErrors maps the name of every custom error in the IAVSDirectory ABI to its typed Go error.
+var IAVSDirectoryErrors = map[string]error{
+	"InvalidSignature":                  ErrInvalidSignature{},
+	"OperatorAlreadyRegisteredToAVS":    ErrOperatorAlreadyRegisteredToAVS{},
+	"OperatorNotRegisteredToAVS":        ErrOperatorNotRegisteredToAVS{},
+	"OperatorNotRegisteredToEigenLayer": ErrOperatorNotRegisteredToEigenLayer{},
+	"SaltSpent":                         ErrSaltSpent{},
+	"SignatureExpired":                  ErrSignatureExpired{},
+}
@@ -0,0 +1,180 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAVSDirectory
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIAVSDirectory is an in-memory IAVSDirectory for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IAVSDirectoryFilterer.
+type FakeIAVSDirectory struct {
+	*fake.Contract
+	*IAVSDirectoryFilterer
+}
+
+// NewFakeIAVSDirectory returns an empty fake of the contract at address.
+func NewFakeIAVSDirectory(address common.Address) *FakeIAVSDirectory {
+	contract := fake.NewContract(IAVSDirectoryMetaData, address)
+	filterer, err := NewIAVSDirectoryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIAVSDirectory{Contract: contract, IAVSDirectoryFilterer: filterer}
+}
+
+// OPERATORAVSREGISTRATIONTYPEHASH returns the values stubbed with StubOPERATORAVSREGISTRATIONTYPEHASH.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIAVSDirectory.Contract.Read("OPERATOR_AVS_REGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORAVSREGISTRATIONTYPEHASH sets what OPERATORAVSREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) StubOPERATORAVSREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeIAVSDirectory.Contract.Stub("OPERATOR_AVS_REGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// OPERATORSETREGISTRATIONTYPEHASH returns the values stubbed with StubOPERATORSETREGISTRATIONTYPEHASH.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) OPERATORSETREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIAVSDirectory.Contract.Read("OPERATOR_SET_REGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORSETREGISTRATIONTYPEHASH sets what OPERATORSETREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) StubOPERATORSETREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeIAVSDirectory.Contract.Stub("OPERATOR_SET_REGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// CalculateOperatorAVSRegistrationDigestHash returns the values stubbed with StubCalculateOperatorAVSRegistrationDigestHash.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIAVSDirectory.Contract.Read("calculateOperatorAVSRegistrationDigestHash", []interface{}{operator, avs, salt, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorAVSRegistrationDigestHash sets what CalculateOperatorAVSRegistrationDigestHash returns for the given arguments.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) StubCalculateOperatorAVSRegistrationDigestHash(operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int, out0 [32]byte) {
+	_FakeIAVSDirectory.Contract.Stub("calculateOperatorAVSRegistrationDigestHash", []interface{}{operator, avs, salt, expiry}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIAVSDirectory.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) StubDomainSeparator(out0 [32]byte) {
+	_FakeIAVSDirectory.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// OperatorSaltIsSpent returns the values stubbed with StubOperatorSaltIsSpent.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) OperatorSaltIsSpent(opts *bind.CallOpts, operator common.Address, salt [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeIAVSDirectory.Contract.Read("operatorSaltIsSpent", []interface{}{operator, salt}, &out0)
+	return out0, err
+}
+
+// StubOperatorSaltIsSpent sets what OperatorSaltIsSpent returns for the given arguments.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) StubOperatorSaltIsSpent(operator common.Address, salt [32]byte, out0 bool) {
+	_FakeIAVSDirectory.Contract.Stub("operatorSaltIsSpent", []interface{}{operator, salt}, out0)
+}
+
+// CancelSalt records a cancelSalt call after running the hook set with OnCancelSalt.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) CancelSalt(opts *bind.TransactOpts, salt [32]byte) (*types.Transaction, error) {
+	return _FakeIAVSDirectory.Contract.Transact(opts, "cancelSalt", func() error {
+		if hook, ok := _FakeIAVSDirectory.Contract.Hook("cancelSalt").(func(opts *bind.TransactOpts, salt [32]byte) error); ok {
+			return hook(opts, salt)
+		}
+		return nil
+	}, salt)
+}
+
+// OnCancelSalt sets a hook run by every CancelSalt call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) OnCancelSalt(hook func(opts *bind.TransactOpts, salt [32]byte) error) {
+	_FakeIAVSDirectory.Contract.SetHook("cancelSalt", hook)
+}
+
+// DeregisterOperatorFromAVS records a deregisterOperatorFromAVS call after running the hook set with OnDeregisterOperatorFromAVS.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error) {
+	return _FakeIAVSDirectory.Contract.Transact(opts, "deregisterOperatorFromAVS", func() error {
+		if hook, ok := _FakeIAVSDirectory.Contract.Hook("deregisterOperatorFromAVS").(func(opts *bind.TransactOpts, operator common.Address) error); ok {
+			return hook(opts, operator)
+		}
+		return nil
+	}, operator)
+}
+
+// OnDeregisterOperatorFromAVS sets a hook run by every DeregisterOperatorFromAVS call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) OnDeregisterOperatorFromAVS(hook func(opts *bind.TransactOpts, operator common.Address) error) {
+	_FakeIAVSDirectory.Contract.SetHook("deregisterOperatorFromAVS", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIAVSDirectory.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeIAVSDirectory.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialOwner, initialPausedStatus)
+		}
+		return nil
+	}, initialOwner, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) error) {
+	_FakeIAVSDirectory.Contract.SetHook("initialize", hook)
+}
+
+// RegisterOperatorToAVS records a registerOperatorToAVS call after running the hook set with OnRegisterOperatorToAVS.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) (*types.Transaction, error) {
+	return _FakeIAVSDirectory.Contract.Transact(opts, "registerOperatorToAVS", func() error {
+		if hook, ok := _FakeIAVSDirectory.Contract.Hook("registerOperatorToAVS").(func(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) error); ok {
+			return hook(opts, operator, operatorSignature)
+		}
+		return nil
+	}, operator, operatorSignature)
+}
+
+// OnRegisterOperatorToAVS sets a hook run by every RegisterOperatorToAVS call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) OnRegisterOperatorToAVS(hook func(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) error) {
+	_FakeIAVSDirectory.Contract.SetHook("registerOperatorToAVS", hook)
+}
+
+// UpdateAVSMetadataURI records a updateAVSMetadataURI call after running the hook set with OnUpdateAVSMetadataURI.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error) {
+	return _FakeIAVSDirectory.Contract.Transact(opts, "updateAVSMetadataURI", func() error {
+		if hook, ok := _FakeIAVSDirectory.Contract.Hook("updateAVSMetadataURI").(func(opts *bind.TransactOpts, metadataURI string) error); ok {
+			return hook(opts, metadataURI)
+		}
+		return nil
+	}, metadataURI)
+}
+
+// OnUpdateAVSMetadataURI sets a hook run by every UpdateAVSMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) OnUpdateAVSMetadataURI(hook func(opts *bind.TransactOpts, metadataURI string) error) {
+	_FakeIAVSDirectory.Contract.SetHook("updateAVSMetadataURI", hook)
+}
+
+// EmitAVSMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) EmitAVSMetadataURIUpdated(event *IAVSDirectoryAVSMetadataURIUpdated) {
+	_FakeIAVSDirectory.Contract.Emit("AVSMetadataURIUpdated", event)
+}
+
+// EmitOperatorAVSRegistrationStatusUpdated appends the event to the fake's logs.
+func (_FakeIAVSDirectory *FakeIAVSDirectory) EmitOperatorAVSRegistrationStatusUpdated(event *IAVSDirectoryOperatorAVSRegistrationStatusUpdated) {
+	_FakeIAVSDirectory.Contract.Emit("OperatorAVSRegistrationStatusUpdated", event)
+}
@@ -0,0 +1,54 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAVSDirectory
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IAVSDirectoryReader is the read-only surface of the IAVSDirectory
+// binding, implemented by IAVSDirectoryCaller, IAVSDirectory and FakeIAVSDirectory.
+type IAVSDirectoryReader interface {
+	OPERATORAVSREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	OPERATORSETREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	CalculateOperatorAVSRegistrationDigestHash(opts *bind.CallOpts, operator common.Address, avs common.Address, salt [32]byte, expiry *big.Int) ([32]byte, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	OperatorSaltIsSpent(opts *bind.CallOpts, operator common.Address, salt [32]byte) (bool, error)
+}
+
+// IAVSDirectoryWriter is the transacting surface of the IAVSDirectory
+// binding, implemented by IAVSDirectoryTransactor, IAVSDirectory and FakeIAVSDirectory.
+type IAVSDirectoryWriter interface {
+	CancelSalt(opts *bind.TransactOpts, salt [32]byte) (*types.Transaction, error)
+	DeregisterOperatorFromAVS(opts *bind.TransactOpts, operator common.Address) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int) (*types.Transaction, error)
+	RegisterOperatorToAVS(opts *bind.TransactOpts, operator common.Address, operatorSignature ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry) (*types.Transaction, error)
+	UpdateAVSMetadataURI(opts *bind.TransactOpts, metadataURI string) (*types.Transaction, error)
+}
+
+// IAVSDirectoryEvents is the event filtering surface of the IAVSDirectory
+// binding, implemented by IAVSDirectoryFilterer, IAVSDirectory and FakeIAVSDirectory.
+type IAVSDirectoryEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*IAVSDirectoryAVSMetadataURIUpdatedIterator, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *IAVSDirectoryAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*IAVSDirectoryAVSMetadataURIUpdated, error)
+	FilterOperatorAVSRegistrationStatusUpdated(opts *bind.FilterOpts, operator []common.Address, avs []common.Address) (*IAVSDirectoryOperatorAVSRegistrationStatusUpdatedIterator, error)
+	WatchOperatorAVSRegistrationStatusUpdated(opts *bind.WatchOpts, sink chan<- *IAVSDirectoryOperatorAVSRegistrationStatusUpdated, operator []common.Address, avs []common.Address) (event.Subscription, error)
+	ParseOperatorAVSRegistrationStatusUpdated(log types.Log) (*IAVSDirectoryOperatorAVSRegistrationStatusUpdated, error)
+}
+
+var (
+	_ IAVSDirectoryReader = (*IAVSDirectoryCaller)(nil)
+	_ IAVSDirectoryReader = (*IAVSDirectory)(nil)
+	_ IAVSDirectoryReader = (*FakeIAVSDirectory)(nil)
+	_ IAVSDirectoryWriter = (*IAVSDirectoryTransactor)(nil)
+	_ IAVSDirectoryWriter = (*IAVSDirectory)(nil)
+	_ IAVSDirectoryWriter = (*FakeIAVSDirectory)(nil)
+	_ IAVSDirectoryEvents = (*IAVSDirectoryFilterer)(nil)
+	_ IAVSDirectoryEvents = (*IAVSDirectory)(nil)
+	_ IAVSDirectoryEvents = (*FakeIAVSDirectory)(nil)
+)
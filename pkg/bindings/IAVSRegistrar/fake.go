@@ -0,0 +1,73 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAVSRegistrar
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeIAVSRegistrar is an in-memory IAVSRegistrar for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IAVSRegistrarFilterer.
+type FakeIAVSRegistrar struct {
+	*fake.Contract
+	*IAVSRegistrarFilterer
+}
+
+// NewFakeIAVSRegistrar returns an empty fake of the contract at address.
+func NewFakeIAVSRegistrar(address common.Address) *FakeIAVSRegistrar {
+	contract := fake.NewContract(IAVSRegistrarMetaData, address)
+	filterer, err := NewIAVSRegistrarFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIAVSRegistrar{Contract: contract, IAVSRegistrarFilterer: filterer}
+}
+
+// SupportsAVS returns the values stubbed with StubSupportsAVS.
+func (_FakeIAVSRegistrar *FakeIAVSRegistrar) SupportsAVS(opts *bind.CallOpts, avs common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIAVSRegistrar.Contract.Read("supportsAVS", []interface{}{avs}, &out0)
+	return out0, err
+}
+
+// StubSupportsAVS sets what SupportsAVS returns for the given arguments.
+func (_FakeIAVSRegistrar *FakeIAVSRegistrar) StubSupportsAVS(avs common.Address, out0 bool) {
+	_FakeIAVSRegistrar.Contract.Stub("supportsAVS", []interface{}{avs}, out0)
+}
+
+// DeregisterOperator records a deregisterOperator call after running the hook set with OnDeregisterOperator.
+func (_FakeIAVSRegistrar *FakeIAVSRegistrar) DeregisterOperator(opts *bind.TransactOpts, operator common.Address, avs common.Address, operatorSetIds []uint32) (*types.Transaction, error) {
+	return _FakeIAVSRegistrar.Contract.Transact(opts, "deregisterOperator", func() error {
+		if hook, ok := _FakeIAVSRegistrar.Contract.Hook("deregisterOperator").(func(opts *bind.TransactOpts, operator common.Address, avs common.Address, operatorSetIds []uint32) error); ok {
+			return hook(opts, operator, avs, operatorSetIds)
+		}
+		return nil
+	}, operator, avs, operatorSetIds)
+}
+
+// OnDeregisterOperator sets a hook run by every DeregisterOperator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSRegistrar *FakeIAVSRegistrar) OnDeregisterOperator(hook func(opts *bind.TransactOpts, operator common.Address, avs common.Address, operatorSetIds []uint32) error) {
+	_FakeIAVSRegistrar.Contract.SetHook("deregisterOperator", hook)
+}
+
+// RegisterOperator records a registerOperator call after running the hook set with OnRegisterOperator.
+func (_FakeIAVSRegistrar *FakeIAVSRegistrar) RegisterOperator(opts *bind.TransactOpts, operator common.Address, avs common.Address, operatorSetIds []uint32, data []byte) (*types.Transaction, error) {
+	return _FakeIAVSRegistrar.Contract.Transact(opts, "registerOperator", func() error {
+		if hook, ok := _FakeIAVSRegistrar.Contract.Hook("registerOperator").(func(opts *bind.TransactOpts, operator common.Address, avs common.Address, operatorSetIds []uint32, data []byte) error); ok {
+			return hook(opts, operator, avs, operatorSetIds, data)
+		}
+		return nil
+	}, operator, avs, operatorSetIds, data)
+}
+
+// OnRegisterOperator sets a hook run by every RegisterOperator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSRegistrar *FakeIAVSRegistrar) OnRegisterOperator(hook func(opts *bind.TransactOpts, operator common.Address, avs common.Address, operatorSetIds []uint32, data []byte) error) {
+	_FakeIAVSRegistrar.Contract.SetHook("registerOperator", hook)
+}
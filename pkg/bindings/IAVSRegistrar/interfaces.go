@@ -0,0 +1,31 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAVSRegistrar
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// IAVSRegistrarReader is the read-only surface of the IAVSRegistrar
+// binding, implemented by IAVSRegistrarCaller, IAVSRegistrar and FakeIAVSRegistrar.
+type IAVSRegistrarReader interface {
+	SupportsAVS(opts *bind.CallOpts, avs common.Address) (bool, error)
+}
+
+// IAVSRegistrarWriter is the transacting surface of the IAVSRegistrar
+// binding, implemented by IAVSRegistrarTransactor, IAVSRegistrar and FakeIAVSRegistrar.
+type IAVSRegistrarWriter interface {
+	DeregisterOperator(opts *bind.TransactOpts, operator common.Address, avs common.Address, operatorSetIds []uint32) (*types.Transaction, error)
+	RegisterOperator(opts *bind.TransactOpts, operator common.Address, avs common.Address, operatorSetIds []uint32, data []byte) (*types.Transaction, error)
+}
+
+var (
+	_ IAVSRegistrarReader = (*IAVSRegistrarCaller)(nil)
+	_ IAVSRegistrarReader = (*IAVSRegistrar)(nil)
+	_ IAVSRegistrarReader = (*FakeIAVSRegistrar)(nil)
+	_ IAVSRegistrarWriter = (*IAVSRegistrarTransactor)(nil)
+	_ IAVSRegistrarWriter = (*IAVSRegistrar)(nil)
+	_ IAVSRegistrarWriter = (*FakeIAVSRegistrar)(nil)
+)
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,18 +30,11 @@ var (
 	_ = abi.ConvertType
 )
 
-// ITaskMailboxTypesTaskParams is an auto generated low-level Go binding around an user-defined struct.
-type ITaskMailboxTypesTaskParams struct {
-	RefundCollector     common.Address
-	ExecutorOperatorSet OperatorSet
-	Payload             []byte
-}
+// ITaskMailboxTypesTaskParams is an alias of the canonical eltypes.ITaskMailboxTypesTaskParams shared by all bindings.
+type ITaskMailboxTypesTaskParams = eltypes.ITaskMailboxTypesTaskParams
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // IAVSTaskHookMetaData contains all meta data concerning the IAVSTaskHook contract.
 var IAVSTaskHookMetaData = &bind.MetaData{
@@ -0,0 +1,96 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAVSTaskHook
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIAVSTaskHook is an in-memory IAVSTaskHook for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IAVSTaskHookFilterer.
+type FakeIAVSTaskHook struct {
+	*fake.Contract
+	*IAVSTaskHookFilterer
+}
+
+// NewFakeIAVSTaskHook returns an empty fake of the contract at address.
+func NewFakeIAVSTaskHook(address common.Address) *FakeIAVSTaskHook {
+	contract := fake.NewContract(IAVSTaskHookMetaData, address)
+	filterer, err := NewIAVSTaskHookFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIAVSTaskHook{Contract: contract, IAVSTaskHookFilterer: filterer}
+}
+
+// CalculateTaskFee returns the values stubbed with StubCalculateTaskFee.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) CalculateTaskFee(opts *bind.CallOpts, taskParams ITaskMailboxTypesTaskParams) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIAVSTaskHook.Contract.Read("calculateTaskFee", []interface{}{taskParams}, &out0)
+	return out0, err
+}
+
+// StubCalculateTaskFee sets what CalculateTaskFee returns for the given arguments.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) StubCalculateTaskFee(taskParams ITaskMailboxTypesTaskParams, out0 *big.Int) {
+	_FakeIAVSTaskHook.Contract.Stub("calculateTaskFee", []interface{}{taskParams}, out0)
+}
+
+// ValidatePreTaskCreation returns the values stubbed with StubValidatePreTaskCreation.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) ValidatePreTaskCreation(opts *bind.CallOpts, caller common.Address, taskParams ITaskMailboxTypesTaskParams) error {
+	err := _FakeIAVSTaskHook.Contract.Read("validatePreTaskCreation", []interface{}{caller, taskParams})
+	return err
+}
+
+// StubValidatePreTaskCreation sets what ValidatePreTaskCreation returns for the given arguments.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) StubValidatePreTaskCreation(caller common.Address, taskParams ITaskMailboxTypesTaskParams) {
+	_FakeIAVSTaskHook.Contract.Stub("validatePreTaskCreation", []interface{}{caller, taskParams})
+}
+
+// ValidatePreTaskResultSubmission returns the values stubbed with StubValidatePreTaskResultSubmission.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) ValidatePreTaskResultSubmission(opts *bind.CallOpts, caller common.Address, taskHash [32]byte, cert []byte, result []byte) error {
+	err := _FakeIAVSTaskHook.Contract.Read("validatePreTaskResultSubmission", []interface{}{caller, taskHash, cert, result})
+	return err
+}
+
+// StubValidatePreTaskResultSubmission sets what ValidatePreTaskResultSubmission returns for the given arguments.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) StubValidatePreTaskResultSubmission(caller common.Address, taskHash [32]byte, cert []byte, result []byte) {
+	_FakeIAVSTaskHook.Contract.Stub("validatePreTaskResultSubmission", []interface{}{caller, taskHash, cert, result})
+}
+
+// HandlePostTaskCreation records a handlePostTaskCreation call after running the hook set with OnHandlePostTaskCreation.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) HandlePostTaskCreation(opts *bind.TransactOpts, taskHash [32]byte) (*types.Transaction, error) {
+	return _FakeIAVSTaskHook.Contract.Transact(opts, "handlePostTaskCreation", func() error {
+		if hook, ok := _FakeIAVSTaskHook.Contract.Hook("handlePostTaskCreation").(func(opts *bind.TransactOpts, taskHash [32]byte) error); ok {
+			return hook(opts, taskHash)
+		}
+		return nil
+	}, taskHash)
+}
+
+// OnHandlePostTaskCreation sets a hook run by every HandlePostTaskCreation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) OnHandlePostTaskCreation(hook func(opts *bind.TransactOpts, taskHash [32]byte) error) {
+	_FakeIAVSTaskHook.Contract.SetHook("handlePostTaskCreation", hook)
+}
+
+// HandlePostTaskResultSubmission records a handlePostTaskResultSubmission call after running the hook set with OnHandlePostTaskResultSubmission.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) HandlePostTaskResultSubmission(opts *bind.TransactOpts, caller common.Address, taskHash [32]byte) (*types.Transaction, error) {
+	return _FakeIAVSTaskHook.Contract.Transact(opts, "handlePostTaskResultSubmission", func() error {
+		if hook, ok := _FakeIAVSTaskHook.Contract.Hook("handlePostTaskResultSubmission").(func(opts *bind.TransactOpts, caller common.Address, taskHash [32]byte) error); ok {
+			return hook(opts, caller, taskHash)
+		}
+		return nil
+	}, caller, taskHash)
+}
+
+// OnHandlePostTaskResultSubmission sets a hook run by every HandlePostTaskResultSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAVSTaskHook *FakeIAVSTaskHook) OnHandlePostTaskResultSubmission(hook func(opts *bind.TransactOpts, caller common.Address, taskHash [32]byte) error) {
+	_FakeIAVSTaskHook.Contract.SetHook("handlePostTaskResultSubmission", hook)
+}
@@ -0,0 +1,34 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAVSTaskHook
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// IAVSTaskHookReader is the read-only surface of the IAVSTaskHook
+// binding, implemented by IAVSTaskHookCaller, IAVSTaskHook and FakeIAVSTaskHook.
+type IAVSTaskHookReader interface {
+	CalculateTaskFee(opts *bind.CallOpts, taskParams ITaskMailboxTypesTaskParams) (*big.Int, error)
+	ValidatePreTaskCreation(opts *bind.CallOpts, caller common.Address, taskParams ITaskMailboxTypesTaskParams) error
+	ValidatePreTaskResultSubmission(opts *bind.CallOpts, caller common.Address, taskHash [32]byte, cert []byte, result []byte) error
+}
+
+// IAVSTaskHookWriter is the transacting surface of the IAVSTaskHook
+// binding, implemented by IAVSTaskHookTransactor, IAVSTaskHook and FakeIAVSTaskHook.
+type IAVSTaskHookWriter interface {
+	HandlePostTaskCreation(opts *bind.TransactOpts, taskHash [32]byte) (*types.Transaction, error)
+	HandlePostTaskResultSubmission(opts *bind.TransactOpts, caller common.Address, taskHash [32]byte) (*types.Transaction, error)
+}
+
+var (
+	_ IAVSTaskHookReader = (*IAVSTaskHookCaller)(nil)
+	_ IAVSTaskHookReader = (*IAVSTaskHook)(nil)
+	_ IAVSTaskHookReader = (*FakeIAVSTaskHook)(nil)
+	_ IAVSTaskHookWriter = (*IAVSTaskHookTransactor)(nil)
+	_ IAVSTaskHookWriter = (*IAVSTaskHook)(nil)
+	_ IAVSTaskHookWriter = (*FakeIAVSTaskHook)(nil)
+)
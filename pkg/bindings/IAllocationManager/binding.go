@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,61 +30,29 @@ var (
 	_ = abi.ConvertType
 )
 
-// IAllocationManagerTypesAllocateParams is an auto generated low-level Go binding around an user-defined struct.
-type IAllocationManagerTypesAllocateParams struct {
-	OperatorSet   OperatorSet
-	Strategies    []common.Address
-	NewMagnitudes []uint64
-}
+// IAllocationManagerTypesAllocateParams is an alias of the canonical eltypes.IAllocationManagerTypesAllocateParams shared by all bindings.
+type IAllocationManagerTypesAllocateParams = eltypes.IAllocationManagerTypesAllocateParams
 
-// IAllocationManagerTypesAllocation is an auto generated low-level Go binding around an user-defined struct.
-type IAllocationManagerTypesAllocation struct {
-	CurrentMagnitude uint64
-	PendingDiff      *big.Int
-	EffectBlock      uint32
-}
+// IAllocationManagerTypesAllocation is an alias of the canonical eltypes.IAllocationManagerTypesAllocation shared by all bindings.
+type IAllocationManagerTypesAllocation = eltypes.IAllocationManagerTypesAllocation
 
-// IAllocationManagerTypesCreateSetParams is an auto generated low-level Go binding around an user-defined struct.
-type IAllocationManagerTypesCreateSetParams struct {
-	OperatorSetId uint32
-	Strategies    []common.Address
-}
+// IAllocationManagerTypesCreateSetParams is an alias of the canonical eltypes.IAllocationManagerTypesCreateSetParams shared by all bindings.
+type IAllocationManagerTypesCreateSetParams = eltypes.IAllocationManagerTypesCreateSetParams
 
-// IAllocationManagerTypesCreateSetParamsV2 is an auto generated low-level Go binding around an user-defined struct.
-type IAllocationManagerTypesCreateSetParamsV2 struct {
-	OperatorSetId uint32
-	Strategies    []common.Address
-	Slasher       common.Address
-}
+// IAllocationManagerTypesCreateSetParamsV2 is an alias of the canonical eltypes.IAllocationManagerTypesCreateSetParamsV2 shared by all bindings.
+type IAllocationManagerTypesCreateSetParamsV2 = eltypes.IAllocationManagerTypesCreateSetParamsV2
 
-// IAllocationManagerTypesDeregisterParams is an auto generated low-level Go binding around an user-defined struct.
-type IAllocationManagerTypesDeregisterParams struct {
-	Operator       common.Address
-	Avs            common.Address
-	OperatorSetIds []uint32
-}
+// IAllocationManagerTypesDeregisterParams is an alias of the canonical eltypes.IAllocationManagerTypesDeregisterParams shared by all bindings.
+type IAllocationManagerTypesDeregisterParams = eltypes.IAllocationManagerTypesDeregisterParams
 
-// IAllocationManagerTypesRegisterParams is an auto generated low-level Go binding around an user-defined struct.
-type IAllocationManagerTypesRegisterParams struct {
-	Avs            common.Address
-	OperatorSetIds []uint32
-	Data           []byte
-}
+// IAllocationManagerTypesRegisterParams is an alias of the canonical eltypes.IAllocationManagerTypesRegisterParams shared by all bindings.
+type IAllocationManagerTypesRegisterParams = eltypes.IAllocationManagerTypesRegisterParams
 
-// IAllocationManagerTypesSlashingParams is an auto generated low-level Go binding around an user-defined struct.
-type IAllocationManagerTypesSlashingParams struct {
-	Operator      common.Address
-	OperatorSetId uint32
-	Strategies    []common.Address
-	WadsToSlash   []*big.Int
-	Description   string
-}
+// IAllocationManagerTypesSlashingParams is an alias of the canonical eltypes.IAllocationManagerTypesSlashingParams shared by all bindings.
+type IAllocationManagerTypesSlashingParams = eltypes.IAllocationManagerTypesSlashingParams
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // IAllocationManagerMetaData contains all meta data concerning the IAllocationManager contract.
 var IAllocationManagerMetaData = &bind.MetaData{
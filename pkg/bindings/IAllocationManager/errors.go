@@ -0,0 +1,241 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAllocationManager
+
+// ErrAlreadyMemberOfSet is the typed form of the IAllocationManager custom error AlreadyMemberOfSet().
+type ErrAlreadyMemberOfSet struct{}
+
+// Error implements the error interface.
+func (ErrAlreadyMemberOfSet) Error() string {
+	return "IAllocationManager: AlreadyMemberOfSet()"
+}
+
+// ErrCurrentlyPaused is the typed form of the IAllocationManager custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "IAllocationManager: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the IAllocationManager custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "IAllocationManager: InputAddressZero()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the IAllocationManager custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "IAllocationManager: InputArrayLengthMismatch()"
+}
+
+// ErrInsufficientMagnitude is the typed form of the IAllocationManager custom error InsufficientMagnitude().
+type ErrInsufficientMagnitude struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientMagnitude) Error() string {
+	return "IAllocationManager: InsufficientMagnitude()"
+}
+
+// ErrInvalidAVSRegistrar is the typed form of the IAllocationManager custom error InvalidAVSRegistrar().
+type ErrInvalidAVSRegistrar struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAVSRegistrar) Error() string {
+	return "IAllocationManager: InvalidAVSRegistrar()"
+}
+
+// ErrInvalidCaller is the typed form of the IAllocationManager custom error InvalidCaller().
+type ErrInvalidCaller struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCaller) Error() string {
+	return "IAllocationManager: InvalidCaller()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the IAllocationManager custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "IAllocationManager: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidOperator is the typed form of the IAllocationManager custom error InvalidOperator().
+type ErrInvalidOperator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperator) Error() string {
+	return "IAllocationManager: InvalidOperator()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the IAllocationManager custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "IAllocationManager: InvalidOperatorSet()"
+}
+
+// ErrInvalidRedistributionRecipient is the typed form of the IAllocationManager custom error InvalidRedistributionRecipient().
+type ErrInvalidRedistributionRecipient struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRedistributionRecipient) Error() string {
+	return "IAllocationManager: InvalidRedistributionRecipient()"
+}
+
+// ErrInvalidStrategy is the typed form of the IAllocationManager custom error InvalidStrategy().
+type ErrInvalidStrategy struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStrategy) Error() string {
+	return "IAllocationManager: InvalidStrategy()"
+}
+
+// ErrInvalidWadToSlash is the typed form of the IAllocationManager custom error InvalidWadToSlash().
+type ErrInvalidWadToSlash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidWadToSlash) Error() string {
+	return "IAllocationManager: InvalidWadToSlash()"
+}
+
+// ErrModificationAlreadyPending is the typed form of the IAllocationManager custom error ModificationAlreadyPending().
+type ErrModificationAlreadyPending struct{}
+
+// Error implements the error interface.
+func (ErrModificationAlreadyPending) Error() string {
+	return "IAllocationManager: ModificationAlreadyPending()"
+}
+
+// ErrNonexistentAVSMetadata is the typed form of the IAllocationManager custom error NonexistentAVSMetadata().
+type ErrNonexistentAVSMetadata struct{}
+
+// Error implements the error interface.
+func (ErrNonexistentAVSMetadata) Error() string {
+	return "IAllocationManager: NonexistentAVSMetadata()"
+}
+
+// ErrNotMemberOfSet is the typed form of the IAllocationManager custom error NotMemberOfSet().
+type ErrNotMemberOfSet struct{}
+
+// Error implements the error interface.
+func (ErrNotMemberOfSet) Error() string {
+	return "IAllocationManager: NotMemberOfSet()"
+}
+
+// ErrOnlyPauser is the typed form of the IAllocationManager custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "IAllocationManager: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the IAllocationManager custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "IAllocationManager: OnlyUnpauser()"
+}
+
+// ErrOperatorNotSlashable is the typed form of the IAllocationManager custom error OperatorNotSlashable().
+type ErrOperatorNotSlashable struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotSlashable) Error() string {
+	return "IAllocationManager: OperatorNotSlashable()"
+}
+
+// ErrOperatorSetAlreadyMigrated is the typed form of the IAllocationManager custom error OperatorSetAlreadyMigrated().
+type ErrOperatorSetAlreadyMigrated struct{}
+
+// Error implements the error interface.
+func (ErrOperatorSetAlreadyMigrated) Error() string {
+	return "IAllocationManager: OperatorSetAlreadyMigrated()"
+}
+
+// ErrSameMagnitude is the typed form of the IAllocationManager custom error SameMagnitude().
+type ErrSameMagnitude struct{}
+
+// Error implements the error interface.
+func (ErrSameMagnitude) Error() string {
+	return "IAllocationManager: SameMagnitude()"
+}
+
+// ErrSlasherNotSet is the typed form of the IAllocationManager custom error SlasherNotSet().
+type ErrSlasherNotSet struct{}
+
+// Error implements the error interface.
+func (ErrSlasherNotSet) Error() string {
+	return "IAllocationManager: SlasherNotSet()"
+}
+
+// ErrStrategiesMustBeInAscendingOrder is the typed form of the IAllocationManager custom error StrategiesMustBeInAscendingOrder().
+type ErrStrategiesMustBeInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrStrategiesMustBeInAscendingOrder) Error() string {
+	return "IAllocationManager: StrategiesMustBeInAscendingOrder()"
+}
+
+// ErrStrategyAlreadyInOperatorSet is the typed form of the IAllocationManager custom error StrategyAlreadyInOperatorSet().
+type ErrStrategyAlreadyInOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyInOperatorSet) Error() string {
+	return "IAllocationManager: StrategyAlreadyInOperatorSet()"
+}
+
+// ErrStrategyNotInOperatorSet is the typed form of the IAllocationManager custom error StrategyNotInOperatorSet().
+type ErrStrategyNotInOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotInOperatorSet) Error() string {
+	return "IAllocationManager: StrategyNotInOperatorSet()"
+}
+
+// ErrUninitializedAllocationDelay is the typed form of the IAllocationManager custom error UninitializedAllocationDelay().
+type ErrUninitializedAllocationDelay struct{}
+
+// Error implements the error interface.
+func (ErrUninitializedAllocationDelay) Error() string {
+	return "IAllocationManager: UninitializedAllocationDelay()"
+}
+
+// IAllocationManagerErrors maps the name of every custom error in the IAllocationManager ABI to its typed Go error.
+var IAllocationManagerErrors = map[string]error{
+	"AlreadyMemberOfSet":               ErrAlreadyMemberOfSet{},
+	"CurrentlyPaused":                  ErrCurrentlyPaused{},
+	"InputAddressZero":                 ErrInputAddressZero{},
+	"InputArrayLengthMismatch":         ErrInputArrayLengthMismatch{},
+	"InsufficientMagnitude":            ErrInsufficientMagnitude{},
+	"InvalidAVSRegistrar":              ErrInvalidAVSRegistrar{},
+	"InvalidCaller":                    ErrInvalidCaller{},
+	"InvalidNewPausedStatus":           ErrInvalidNewPausedStatus{},
+	"InvalidOperator":                  ErrInvalidOperator{},
+	"InvalidOperatorSet":               ErrInvalidOperatorSet{},
+	"InvalidRedistributionRecipient":   ErrInvalidRedistributionRecipient{},
+	"InvalidStrategy":                  ErrInvalidStrategy{},
+	"InvalidWadToSlash":                ErrInvalidWadToSlash{},
+	"ModificationAlreadyPending":       ErrModificationAlreadyPending{},
+	"NonexistentAVSMetadata":           ErrNonexistentAVSMetadata{},
+	"NotMemberOfSet":                   ErrNotMemberOfSet{},
+	"OnlyPauser":                       ErrOnlyPauser{},
+	"OnlyUnpauser":                     ErrOnlyUnpauser{},
+	"OperatorNotSlashable":             ErrOperatorNotSlashable{},
+	"OperatorSetAlreadyMigrated":       ErrOperatorSetAlreadyMigrated{},
+	"SameMagnitude":                    ErrSameMagnitude{},
+	"SlasherNotSet":                    ErrSlasherNotSet{},
+	"StrategiesMustBeInAscendingOrder": ErrStrategiesMustBeInAscendingOrder{},
+	"StrategyAlreadyInOperatorSet":     ErrStrategyAlreadyInOperatorSet{},
+	"StrategyNotInOperatorSet":         ErrStrategyNotInOperatorSet{},
+	"UninitializedAllocationDelay":     ErrUninitializedAllocationDelay{},
+}
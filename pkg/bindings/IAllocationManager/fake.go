@@ -0,0 +1,886 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAllocationManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIAllocationManager is an in-memory IAllocationManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IAllocationManagerFilterer.
+type FakeIAllocationManager struct {
+	*fake.Contract
+	*IAllocationManagerFilterer
+}
+
+// NewFakeIAllocationManager returns an empty fake of the contract at address.
+func NewFakeIAllocationManager(address common.Address) *FakeIAllocationManager {
+	contract := fake.NewContract(IAllocationManagerMetaData, address)
+	filterer, err := NewIAllocationManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIAllocationManager{Contract: contract, IAllocationManagerFilterer: filterer}
+}
+
+// ALLOCATIONCONFIGURATIONDELAY returns the values stubbed with StubALLOCATIONCONFIGURATIONDELAY.
+func (_FakeIAllocationManager *FakeIAllocationManager) ALLOCATIONCONFIGURATIONDELAY(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIAllocationManager.Contract.Read("ALLOCATION_CONFIGURATION_DELAY", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubALLOCATIONCONFIGURATIONDELAY sets what ALLOCATIONCONFIGURATIONDELAY returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubALLOCATIONCONFIGURATIONDELAY(out0 uint32) {
+	_FakeIAllocationManager.Contract.Stub("ALLOCATION_CONFIGURATION_DELAY", []interface{}{}, out0)
+}
+
+// DEALLOCATIONDELAY returns the values stubbed with StubDEALLOCATIONDELAY.
+func (_FakeIAllocationManager *FakeIAllocationManager) DEALLOCATIONDELAY(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIAllocationManager.Contract.Read("DEALLOCATION_DELAY", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEALLOCATIONDELAY sets what DEALLOCATIONDELAY returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubDEALLOCATIONDELAY(out0 uint32) {
+	_FakeIAllocationManager.Contract.Stub("DEALLOCATION_DELAY", []interface{}{}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeIAllocationManager *FakeIAllocationManager) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIAllocationManager.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubDelegation(out0 common.Address) {
+	_FakeIAllocationManager.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// EigenStrategy returns the values stubbed with StubEigenStrategy.
+func (_FakeIAllocationManager *FakeIAllocationManager) EigenStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIAllocationManager.Contract.Read("eigenStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenStrategy sets what EigenStrategy returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubEigenStrategy(out0 common.Address) {
+	_FakeIAllocationManager.Contract.Stub("eigenStrategy", []interface{}{}, out0)
+}
+
+// GetAVSRegistrar returns the values stubbed with StubGetAVSRegistrar.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetAVSRegistrar(opts *bind.CallOpts, avs common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIAllocationManager.Contract.Read("getAVSRegistrar", []interface{}{avs}, &out0)
+	return out0, err
+}
+
+// StubGetAVSRegistrar sets what GetAVSRegistrar returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetAVSRegistrar(avs common.Address, out0 common.Address) {
+	_FakeIAllocationManager.Contract.Stub("getAVSRegistrar", []interface{}{avs}, out0)
+}
+
+// GetAllocatableMagnitude returns the values stubbed with StubGetAllocatableMagnitude.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetAllocatableMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeIAllocationManager.Contract.Read("getAllocatableMagnitude", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatableMagnitude sets what GetAllocatableMagnitude returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetAllocatableMagnitude(operator common.Address, strategy common.Address, out0 uint64) {
+	_FakeIAllocationManager.Contract.Stub("getAllocatableMagnitude", []interface{}{operator, strategy}, out0)
+}
+
+// GetAllocatedSets returns the values stubbed with StubGetAllocatedSets.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetAllocatedSets(opts *bind.CallOpts, operator common.Address) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeIAllocationManager.Contract.Read("getAllocatedSets", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedSets sets what GetAllocatedSets returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetAllocatedSets(operator common.Address, out0 []OperatorSet) {
+	_FakeIAllocationManager.Contract.Stub("getAllocatedSets", []interface{}{operator}, out0)
+}
+
+// GetAllocatedStake returns the values stubbed with StubGetAllocatedStake.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetAllocatedStake(opts *bind.CallOpts, operatorSet OperatorSet, operators []common.Address, strategies []common.Address) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeIAllocationManager.Contract.Read("getAllocatedStake", []interface{}{operatorSet, operators, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedStake sets what GetAllocatedStake returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetAllocatedStake(operatorSet OperatorSet, operators []common.Address, strategies []common.Address, out0 [][]*big.Int) {
+	_FakeIAllocationManager.Contract.Stub("getAllocatedStake", []interface{}{operatorSet, operators, strategies}, out0)
+}
+
+// GetAllocatedStrategies returns the values stubbed with StubGetAllocatedStrategies.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetAllocatedStrategies(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeIAllocationManager.Contract.Read("getAllocatedStrategies", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetAllocatedStrategies sets what GetAllocatedStrategies returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetAllocatedStrategies(operator common.Address, operatorSet OperatorSet, out0 []common.Address) {
+	_FakeIAllocationManager.Contract.Stub("getAllocatedStrategies", []interface{}{operator, operatorSet}, out0)
+}
+
+// GetAllocation returns the values stubbed with StubGetAllocation.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetAllocation(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, strategy common.Address) (IAllocationManagerTypesAllocation, error) {
+	var out0 IAllocationManagerTypesAllocation
+	err := _FakeIAllocationManager.Contract.Read("getAllocation", []interface{}{operator, operatorSet, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetAllocation sets what GetAllocation returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetAllocation(operator common.Address, operatorSet OperatorSet, strategy common.Address, out0 IAllocationManagerTypesAllocation) {
+	_FakeIAllocationManager.Contract.Stub("getAllocation", []interface{}{operator, operatorSet, strategy}, out0)
+}
+
+// GetAllocationDelay returns the values stubbed with StubGetAllocationDelay.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetAllocationDelay(opts *bind.CallOpts, operator common.Address) (struct {
+	IsSet bool
+	Delay uint32
+}, error) {
+	var out0 struct {
+		IsSet bool
+		Delay uint32
+	}
+	err := _FakeIAllocationManager.Contract.Read("getAllocationDelay", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubGetAllocationDelay sets what GetAllocationDelay returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetAllocationDelay(operator common.Address, out0 struct {
+	IsSet bool
+	Delay uint32
+}) {
+	_FakeIAllocationManager.Contract.Stub("getAllocationDelay", []interface{}{operator}, out0)
+}
+
+// GetAllocations returns the values stubbed with StubGetAllocations.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetAllocations(opts *bind.CallOpts, operators []common.Address, operatorSet OperatorSet, strategy common.Address) ([]IAllocationManagerTypesAllocation, error) {
+	var out0 []IAllocationManagerTypesAllocation
+	err := _FakeIAllocationManager.Contract.Read("getAllocations", []interface{}{operators, operatorSet, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetAllocations sets what GetAllocations returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetAllocations(operators []common.Address, operatorSet OperatorSet, strategy common.Address, out0 []IAllocationManagerTypesAllocation) {
+	_FakeIAllocationManager.Contract.Stub("getAllocations", []interface{}{operators, operatorSet, strategy}, out0)
+}
+
+// GetEncumberedMagnitude returns the values stubbed with StubGetEncumberedMagnitude.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetEncumberedMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeIAllocationManager.Contract.Read("getEncumberedMagnitude", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetEncumberedMagnitude sets what GetEncumberedMagnitude returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetEncumberedMagnitude(operator common.Address, strategy common.Address, out0 uint64) {
+	_FakeIAllocationManager.Contract.Stub("getEncumberedMagnitude", []interface{}{operator, strategy}, out0)
+}
+
+// GetMaxMagnitude returns the values stubbed with StubGetMaxMagnitude.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetMaxMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeIAllocationManager.Contract.Read("getMaxMagnitude", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitude sets what GetMaxMagnitude returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetMaxMagnitude(operator common.Address, strategy common.Address, out0 uint64) {
+	_FakeIAllocationManager.Contract.Stub("getMaxMagnitude", []interface{}{operator, strategy}, out0)
+}
+
+// GetMaxMagnitudes returns the values stubbed with StubGetMaxMagnitudes.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetMaxMagnitudes(opts *bind.CallOpts, operators []common.Address, strategy common.Address) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeIAllocationManager.Contract.Read("getMaxMagnitudes", []interface{}{operators, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudes sets what GetMaxMagnitudes returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetMaxMagnitudes(operators []common.Address, strategy common.Address, out0 []uint64) {
+	_FakeIAllocationManager.Contract.Stub("getMaxMagnitudes", []interface{}{operators, strategy}, out0)
+}
+
+// GetMaxMagnitudes0 returns the values stubbed with StubGetMaxMagnitudes0.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetMaxMagnitudes0(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeIAllocationManager.Contract.Read("getMaxMagnitudes0", []interface{}{operator, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudes0 sets what GetMaxMagnitudes0 returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetMaxMagnitudes0(operator common.Address, strategies []common.Address, out0 []uint64) {
+	_FakeIAllocationManager.Contract.Stub("getMaxMagnitudes0", []interface{}{operator, strategies}, out0)
+}
+
+// GetMaxMagnitudesAtBlock returns the values stubbed with StubGetMaxMagnitudesAtBlock.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetMaxMagnitudesAtBlock(opts *bind.CallOpts, operator common.Address, strategies []common.Address, blockNumber uint32) ([]uint64, error) {
+	var out0 []uint64
+	err := _FakeIAllocationManager.Contract.Read("getMaxMagnitudesAtBlock", []interface{}{operator, strategies, blockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetMaxMagnitudesAtBlock sets what GetMaxMagnitudesAtBlock returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetMaxMagnitudesAtBlock(operator common.Address, strategies []common.Address, blockNumber uint32, out0 []uint64) {
+	_FakeIAllocationManager.Contract.Stub("getMaxMagnitudesAtBlock", []interface{}{operator, strategies, blockNumber}, out0)
+}
+
+// GetMemberCount returns the values stubbed with StubGetMemberCount.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetMemberCount(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIAllocationManager.Contract.Read("getMemberCount", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetMemberCount sets what GetMemberCount returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetMemberCount(operatorSet OperatorSet, out0 *big.Int) {
+	_FakeIAllocationManager.Contract.Stub("getMemberCount", []interface{}{operatorSet}, out0)
+}
+
+// GetMembers returns the values stubbed with StubGetMembers.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetMembers(opts *bind.CallOpts, operatorSet OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeIAllocationManager.Contract.Read("getMembers", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetMembers sets what GetMembers returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetMembers(operatorSet OperatorSet, out0 []common.Address) {
+	_FakeIAllocationManager.Contract.Stub("getMembers", []interface{}{operatorSet}, out0)
+}
+
+// GetMinimumSlashableStake returns the values stubbed with StubGetMinimumSlashableStake.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetMinimumSlashableStake(opts *bind.CallOpts, operatorSet OperatorSet, operators []common.Address, strategies []common.Address, futureBlock uint32) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeIAllocationManager.Contract.Read("getMinimumSlashableStake", []interface{}{operatorSet, operators, strategies, futureBlock}, &out0)
+	return out0, err
+}
+
+// StubGetMinimumSlashableStake sets what GetMinimumSlashableStake returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetMinimumSlashableStake(operatorSet OperatorSet, operators []common.Address, strategies []common.Address, futureBlock uint32, out0 [][]*big.Int) {
+	_FakeIAllocationManager.Contract.Stub("getMinimumSlashableStake", []interface{}{operatorSet, operators, strategies, futureBlock}, out0)
+}
+
+// GetOperatorSetCount returns the values stubbed with StubGetOperatorSetCount.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetOperatorSetCount(opts *bind.CallOpts, avs common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIAllocationManager.Contract.Read("getOperatorSetCount", []interface{}{avs}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetCount sets what GetOperatorSetCount returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetOperatorSetCount(avs common.Address, out0 *big.Int) {
+	_FakeIAllocationManager.Contract.Stub("getOperatorSetCount", []interface{}{avs}, out0)
+}
+
+// GetPendingSlasher returns the values stubbed with StubGetPendingSlasher.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetPendingSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (struct {
+	PendingSlasher common.Address
+	EffectBlock    uint32
+}, error) {
+	var out0 struct {
+		PendingSlasher common.Address
+		EffectBlock    uint32
+	}
+	err := _FakeIAllocationManager.Contract.Read("getPendingSlasher", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetPendingSlasher sets what GetPendingSlasher returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetPendingSlasher(operatorSet OperatorSet, out0 struct {
+	PendingSlasher common.Address
+	EffectBlock    uint32
+}) {
+	_FakeIAllocationManager.Contract.Stub("getPendingSlasher", []interface{}{operatorSet}, out0)
+}
+
+// GetRedistributionRecipient returns the values stubbed with StubGetRedistributionRecipient.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetRedistributionRecipient(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIAllocationManager.Contract.Read("getRedistributionRecipient", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetRedistributionRecipient sets what GetRedistributionRecipient returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetRedistributionRecipient(operatorSet OperatorSet, out0 common.Address) {
+	_FakeIAllocationManager.Contract.Stub("getRedistributionRecipient", []interface{}{operatorSet}, out0)
+}
+
+// GetRegisteredSets returns the values stubbed with StubGetRegisteredSets.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetRegisteredSets(opts *bind.CallOpts, operator common.Address) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeIAllocationManager.Contract.Read("getRegisteredSets", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubGetRegisteredSets sets what GetRegisteredSets returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetRegisteredSets(operator common.Address, out0 []OperatorSet) {
+	_FakeIAllocationManager.Contract.Stub("getRegisteredSets", []interface{}{operator}, out0)
+}
+
+// GetSlashCount returns the values stubbed with StubGetSlashCount.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetSlashCount(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIAllocationManager.Contract.Read("getSlashCount", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetSlashCount sets what GetSlashCount returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetSlashCount(operatorSet OperatorSet, out0 *big.Int) {
+	_FakeIAllocationManager.Contract.Stub("getSlashCount", []interface{}{operatorSet}, out0)
+}
+
+// GetSlasher returns the values stubbed with StubGetSlasher.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIAllocationManager.Contract.Read("getSlasher", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetSlasher sets what GetSlasher returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetSlasher(operatorSet OperatorSet, out0 common.Address) {
+	_FakeIAllocationManager.Contract.Stub("getSlasher", []interface{}{operatorSet}, out0)
+}
+
+// GetStrategiesInOperatorSet returns the values stubbed with StubGetStrategiesInOperatorSet.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetStrategiesInOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeIAllocationManager.Contract.Read("getStrategiesInOperatorSet", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetStrategiesInOperatorSet sets what GetStrategiesInOperatorSet returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetStrategiesInOperatorSet(operatorSet OperatorSet, out0 []common.Address) {
+	_FakeIAllocationManager.Contract.Stub("getStrategiesInOperatorSet", []interface{}{operatorSet}, out0)
+}
+
+// GetStrategyAllocations returns the values stubbed with StubGetStrategyAllocations.
+func (_FakeIAllocationManager *FakeIAllocationManager) GetStrategyAllocations(opts *bind.CallOpts, operator common.Address, strategy common.Address) ([]OperatorSet, []IAllocationManagerTypesAllocation, error) {
+	var out0 []OperatorSet
+	var out1 []IAllocationManagerTypesAllocation
+	err := _FakeIAllocationManager.Contract.Read("getStrategyAllocations", []interface{}{operator, strategy}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetStrategyAllocations sets what GetStrategyAllocations returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubGetStrategyAllocations(operator common.Address, strategy common.Address, out0 []OperatorSet, out1 []IAllocationManagerTypesAllocation) {
+	_FakeIAllocationManager.Contract.Stub("getStrategyAllocations", []interface{}{operator, strategy}, out0, out1)
+}
+
+// IsMemberOfOperatorSet returns the values stubbed with StubIsMemberOfOperatorSet.
+func (_FakeIAllocationManager *FakeIAllocationManager) IsMemberOfOperatorSet(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeIAllocationManager.Contract.Read("isMemberOfOperatorSet", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsMemberOfOperatorSet sets what IsMemberOfOperatorSet returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubIsMemberOfOperatorSet(operator common.Address, operatorSet OperatorSet, out0 bool) {
+	_FakeIAllocationManager.Contract.Stub("isMemberOfOperatorSet", []interface{}{operator, operatorSet}, out0)
+}
+
+// IsOperatorRedistributable returns the values stubbed with StubIsOperatorRedistributable.
+func (_FakeIAllocationManager *FakeIAllocationManager) IsOperatorRedistributable(opts *bind.CallOpts, operator common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIAllocationManager.Contract.Read("isOperatorRedistributable", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorRedistributable sets what IsOperatorRedistributable returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubIsOperatorRedistributable(operator common.Address, out0 bool) {
+	_FakeIAllocationManager.Contract.Stub("isOperatorRedistributable", []interface{}{operator}, out0)
+}
+
+// IsOperatorSet returns the values stubbed with StubIsOperatorSet.
+func (_FakeIAllocationManager *FakeIAllocationManager) IsOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeIAllocationManager.Contract.Read("isOperatorSet", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorSet sets what IsOperatorSet returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubIsOperatorSet(operatorSet OperatorSet, out0 bool) {
+	_FakeIAllocationManager.Contract.Stub("isOperatorSet", []interface{}{operatorSet}, out0)
+}
+
+// IsOperatorSlashable returns the values stubbed with StubIsOperatorSlashable.
+func (_FakeIAllocationManager *FakeIAllocationManager) IsOperatorSlashable(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeIAllocationManager.Contract.Read("isOperatorSlashable", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorSlashable sets what IsOperatorSlashable returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubIsOperatorSlashable(operator common.Address, operatorSet OperatorSet, out0 bool) {
+	_FakeIAllocationManager.Contract.Stub("isOperatorSlashable", []interface{}{operator, operatorSet}, out0)
+}
+
+// IsRedistributingOperatorSet returns the values stubbed with StubIsRedistributingOperatorSet.
+func (_FakeIAllocationManager *FakeIAllocationManager) IsRedistributingOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeIAllocationManager.Contract.Read("isRedistributingOperatorSet", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubIsRedistributingOperatorSet sets what IsRedistributingOperatorSet returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubIsRedistributingOperatorSet(operatorSet OperatorSet, out0 bool) {
+	_FakeIAllocationManager.Contract.Stub("isRedistributingOperatorSet", []interface{}{operatorSet}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeIAllocationManager *FakeIAllocationManager) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeIAllocationManager.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubPaused(index uint8, out0 bool) {
+	_FakeIAllocationManager.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeIAllocationManager *FakeIAllocationManager) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIAllocationManager.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubPaused0(out0 *big.Int) {
+	_FakeIAllocationManager.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeIAllocationManager *FakeIAllocationManager) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIAllocationManager.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeIAllocationManager *FakeIAllocationManager) StubPauserRegistry(out0 common.Address) {
+	_FakeIAllocationManager.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// AddStrategiesToOperatorSet records a addStrategiesToOperatorSet call after running the hook set with OnAddStrategiesToOperatorSet.
+func (_FakeIAllocationManager *FakeIAllocationManager) AddStrategiesToOperatorSet(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "addStrategiesToOperatorSet", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("addStrategiesToOperatorSet").(func(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) error); ok {
+			return hook(opts, avs, operatorSetId, strategies)
+		}
+		return nil
+	}, avs, operatorSetId, strategies)
+}
+
+// OnAddStrategiesToOperatorSet sets a hook run by every AddStrategiesToOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnAddStrategiesToOperatorSet(hook func(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) error) {
+	_FakeIAllocationManager.Contract.SetHook("addStrategiesToOperatorSet", hook)
+}
+
+// ClearDeallocationQueue records a clearDeallocationQueue call after running the hook set with OnClearDeallocationQueue.
+func (_FakeIAllocationManager *FakeIAllocationManager) ClearDeallocationQueue(opts *bind.TransactOpts, operator common.Address, strategies []common.Address, numToClear []uint16) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "clearDeallocationQueue", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("clearDeallocationQueue").(func(opts *bind.TransactOpts, operator common.Address, strategies []common.Address, numToClear []uint16) error); ok {
+			return hook(opts, operator, strategies, numToClear)
+		}
+		return nil
+	}, operator, strategies, numToClear)
+}
+
+// OnClearDeallocationQueue sets a hook run by every ClearDeallocationQueue call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnClearDeallocationQueue(hook func(opts *bind.TransactOpts, operator common.Address, strategies []common.Address, numToClear []uint16) error) {
+	_FakeIAllocationManager.Contract.SetHook("clearDeallocationQueue", hook)
+}
+
+// CreateOperatorSets records a createOperatorSets call after running the hook set with OnCreateOperatorSets.
+func (_FakeIAllocationManager *FakeIAllocationManager) CreateOperatorSets(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "createOperatorSets", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("createOperatorSets").(func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams) error); ok {
+			return hook(opts, avs, params)
+		}
+		return nil
+	}, avs, params)
+}
+
+// OnCreateOperatorSets sets a hook run by every CreateOperatorSets call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnCreateOperatorSets(hook func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams) error) {
+	_FakeIAllocationManager.Contract.SetHook("createOperatorSets", hook)
+}
+
+// CreateOperatorSets0 records a createOperatorSets0 call after running the hook set with OnCreateOperatorSets0.
+func (_FakeIAllocationManager *FakeIAllocationManager) CreateOperatorSets0(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "createOperatorSets0", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("createOperatorSets0").(func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2) error); ok {
+			return hook(opts, avs, params)
+		}
+		return nil
+	}, avs, params)
+}
+
+// OnCreateOperatorSets0 sets a hook run by every CreateOperatorSets0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnCreateOperatorSets0(hook func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2) error) {
+	_FakeIAllocationManager.Contract.SetHook("createOperatorSets0", hook)
+}
+
+// CreateRedistributingOperatorSets records a createRedistributingOperatorSets call after running the hook set with OnCreateRedistributingOperatorSets.
+func (_FakeIAllocationManager *FakeIAllocationManager) CreateRedistributingOperatorSets(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams, redistributionRecipients []common.Address) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "createRedistributingOperatorSets", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("createRedistributingOperatorSets").(func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams, redistributionRecipients []common.Address) error); ok {
+			return hook(opts, avs, params, redistributionRecipients)
+		}
+		return nil
+	}, avs, params, redistributionRecipients)
+}
+
+// OnCreateRedistributingOperatorSets sets a hook run by every CreateRedistributingOperatorSets call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnCreateRedistributingOperatorSets(hook func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams, redistributionRecipients []common.Address) error) {
+	_FakeIAllocationManager.Contract.SetHook("createRedistributingOperatorSets", hook)
+}
+
+// CreateRedistributingOperatorSets0 records a createRedistributingOperatorSets0 call after running the hook set with OnCreateRedistributingOperatorSets0.
+func (_FakeIAllocationManager *FakeIAllocationManager) CreateRedistributingOperatorSets0(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2, redistributionRecipients []common.Address) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "createRedistributingOperatorSets0", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("createRedistributingOperatorSets0").(func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2, redistributionRecipients []common.Address) error); ok {
+			return hook(opts, avs, params, redistributionRecipients)
+		}
+		return nil
+	}, avs, params, redistributionRecipients)
+}
+
+// OnCreateRedistributingOperatorSets0 sets a hook run by every CreateRedistributingOperatorSets0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnCreateRedistributingOperatorSets0(hook func(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2, redistributionRecipients []common.Address) error) {
+	_FakeIAllocationManager.Contract.SetHook("createRedistributingOperatorSets0", hook)
+}
+
+// DeregisterFromOperatorSets records a deregisterFromOperatorSets call after running the hook set with OnDeregisterFromOperatorSets.
+func (_FakeIAllocationManager *FakeIAllocationManager) DeregisterFromOperatorSets(opts *bind.TransactOpts, params IAllocationManagerTypesDeregisterParams) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "deregisterFromOperatorSets", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("deregisterFromOperatorSets").(func(opts *bind.TransactOpts, params IAllocationManagerTypesDeregisterParams) error); ok {
+			return hook(opts, params)
+		}
+		return nil
+	}, params)
+}
+
+// OnDeregisterFromOperatorSets sets a hook run by every DeregisterFromOperatorSets call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnDeregisterFromOperatorSets(hook func(opts *bind.TransactOpts, params IAllocationManagerTypesDeregisterParams) error) {
+	_FakeIAllocationManager.Contract.SetHook("deregisterFromOperatorSets", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeIAllocationManager *FakeIAllocationManager) Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialPausedStatus)
+		}
+		return nil
+	}, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnInitialize(hook func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error) {
+	_FakeIAllocationManager.Contract.SetHook("initialize", hook)
+}
+
+// MigrateSlashers records a migrateSlashers call after running the hook set with OnMigrateSlashers.
+func (_FakeIAllocationManager *FakeIAllocationManager) MigrateSlashers(opts *bind.TransactOpts, operatorSets []OperatorSet) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "migrateSlashers", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("migrateSlashers").(func(opts *bind.TransactOpts, operatorSets []OperatorSet) error); ok {
+			return hook(opts, operatorSets)
+		}
+		return nil
+	}, operatorSets)
+}
+
+// OnMigrateSlashers sets a hook run by every MigrateSlashers call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnMigrateSlashers(hook func(opts *bind.TransactOpts, operatorSets []OperatorSet) error) {
+	_FakeIAllocationManager.Contract.SetHook("migrateSlashers", hook)
+}
+
+// ModifyAllocations records a modifyAllocations call after running the hook set with OnModifyAllocations.
+func (_FakeIAllocationManager *FakeIAllocationManager) ModifyAllocations(opts *bind.TransactOpts, operator common.Address, params []IAllocationManagerTypesAllocateParams) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "modifyAllocations", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("modifyAllocations").(func(opts *bind.TransactOpts, operator common.Address, params []IAllocationManagerTypesAllocateParams) error); ok {
+			return hook(opts, operator, params)
+		}
+		return nil
+	}, operator, params)
+}
+
+// OnModifyAllocations sets a hook run by every ModifyAllocations call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnModifyAllocations(hook func(opts *bind.TransactOpts, operator common.Address, params []IAllocationManagerTypesAllocateParams) error) {
+	_FakeIAllocationManager.Contract.SetHook("modifyAllocations", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeIAllocationManager *FakeIAllocationManager) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeIAllocationManager.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeIAllocationManager *FakeIAllocationManager) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeIAllocationManager.Contract.SetHook("pauseAll", hook)
+}
+
+// RegisterForOperatorSets records a registerForOperatorSets call after running the hook set with OnRegisterForOperatorSets.
+func (_FakeIAllocationManager *FakeIAllocationManager) RegisterForOperatorSets(opts *bind.TransactOpts, operator common.Address, params IAllocationManagerTypesRegisterParams) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "registerForOperatorSets", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("registerForOperatorSets").(func(opts *bind.TransactOpts, operator common.Address, params IAllocationManagerTypesRegisterParams) error); ok {
+			return hook(opts, operator, params)
+		}
+		return nil
+	}, operator, params)
+}
+
+// OnRegisterForOperatorSets sets a hook run by every RegisterForOperatorSets call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnRegisterForOperatorSets(hook func(opts *bind.TransactOpts, operator common.Address, params IAllocationManagerTypesRegisterParams) error) {
+	_FakeIAllocationManager.Contract.SetHook("registerForOperatorSets", hook)
+}
+
+// RemoveStrategiesFromOperatorSet records a removeStrategiesFromOperatorSet call after running the hook set with OnRemoveStrategiesFromOperatorSet.
+func (_FakeIAllocationManager *FakeIAllocationManager) RemoveStrategiesFromOperatorSet(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "removeStrategiesFromOperatorSet", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("removeStrategiesFromOperatorSet").(func(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) error); ok {
+			return hook(opts, avs, operatorSetId, strategies)
+		}
+		return nil
+	}, avs, operatorSetId, strategies)
+}
+
+// OnRemoveStrategiesFromOperatorSet sets a hook run by every RemoveStrategiesFromOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnRemoveStrategiesFromOperatorSet(hook func(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) error) {
+	_FakeIAllocationManager.Contract.SetHook("removeStrategiesFromOperatorSet", hook)
+}
+
+// SetAVSRegistrar records a setAVSRegistrar call after running the hook set with OnSetAVSRegistrar.
+func (_FakeIAllocationManager *FakeIAllocationManager) SetAVSRegistrar(opts *bind.TransactOpts, avs common.Address, registrar common.Address) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "setAVSRegistrar", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("setAVSRegistrar").(func(opts *bind.TransactOpts, avs common.Address, registrar common.Address) error); ok {
+			return hook(opts, avs, registrar)
+		}
+		return nil
+	}, avs, registrar)
+}
+
+// OnSetAVSRegistrar sets a hook run by every SetAVSRegistrar call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnSetAVSRegistrar(hook func(opts *bind.TransactOpts, avs common.Address, registrar common.Address) error) {
+	_FakeIAllocationManager.Contract.SetHook("setAVSRegistrar", hook)
+}
+
+// SetAllocationDelay records a setAllocationDelay call after running the hook set with OnSetAllocationDelay.
+func (_FakeIAllocationManager *FakeIAllocationManager) SetAllocationDelay(opts *bind.TransactOpts, operator common.Address, delay uint32) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "setAllocationDelay", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("setAllocationDelay").(func(opts *bind.TransactOpts, operator common.Address, delay uint32) error); ok {
+			return hook(opts, operator, delay)
+		}
+		return nil
+	}, operator, delay)
+}
+
+// OnSetAllocationDelay sets a hook run by every SetAllocationDelay call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnSetAllocationDelay(hook func(opts *bind.TransactOpts, operator common.Address, delay uint32) error) {
+	_FakeIAllocationManager.Contract.SetHook("setAllocationDelay", hook)
+}
+
+// SlashOperator records a slashOperator call after running the hook set with OnSlashOperator.
+func (_FakeIAllocationManager *FakeIAllocationManager) SlashOperator(opts *bind.TransactOpts, avs common.Address, params IAllocationManagerTypesSlashingParams) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "slashOperator", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("slashOperator").(func(opts *bind.TransactOpts, avs common.Address, params IAllocationManagerTypesSlashingParams) error); ok {
+			return hook(opts, avs, params)
+		}
+		return nil
+	}, avs, params)
+}
+
+// OnSlashOperator sets a hook run by every SlashOperator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnSlashOperator(hook func(opts *bind.TransactOpts, avs common.Address, params IAllocationManagerTypesSlashingParams) error) {
+	_FakeIAllocationManager.Contract.SetHook("slashOperator", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeIAllocationManager *FakeIAllocationManager) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeIAllocationManager.Contract.SetHook("unpause", hook)
+}
+
+// UpdateAVSMetadataURI records a updateAVSMetadataURI call after running the hook set with OnUpdateAVSMetadataURI.
+func (_FakeIAllocationManager *FakeIAllocationManager) UpdateAVSMetadataURI(opts *bind.TransactOpts, avs common.Address, metadataURI string) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "updateAVSMetadataURI", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("updateAVSMetadataURI").(func(opts *bind.TransactOpts, avs common.Address, metadataURI string) error); ok {
+			return hook(opts, avs, metadataURI)
+		}
+		return nil
+	}, avs, metadataURI)
+}
+
+// OnUpdateAVSMetadataURI sets a hook run by every UpdateAVSMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnUpdateAVSMetadataURI(hook func(opts *bind.TransactOpts, avs common.Address, metadataURI string) error) {
+	_FakeIAllocationManager.Contract.SetHook("updateAVSMetadataURI", hook)
+}
+
+// UpdateSlasher records a updateSlasher call after running the hook set with OnUpdateSlasher.
+func (_FakeIAllocationManager *FakeIAllocationManager) UpdateSlasher(opts *bind.TransactOpts, operatorSet OperatorSet, slasher common.Address) (*types.Transaction, error) {
+	return _FakeIAllocationManager.Contract.Transact(opts, "updateSlasher", func() error {
+		if hook, ok := _FakeIAllocationManager.Contract.Hook("updateSlasher").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slasher common.Address) error); ok {
+			return hook(opts, operatorSet, slasher)
+		}
+		return nil
+	}, operatorSet, slasher)
+}
+
+// OnUpdateSlasher sets a hook run by every UpdateSlasher call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIAllocationManager *FakeIAllocationManager) OnUpdateSlasher(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slasher common.Address) error) {
+	_FakeIAllocationManager.Contract.SetHook("updateSlasher", hook)
+}
+
+// EmitAVSMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitAVSMetadataURIUpdated(event *IAllocationManagerAVSMetadataURIUpdated) {
+	_FakeIAllocationManager.Contract.Emit("AVSMetadataURIUpdated", event)
+}
+
+// EmitAVSRegistrarSet appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitAVSRegistrarSet(event *IAllocationManagerAVSRegistrarSet) {
+	_FakeIAllocationManager.Contract.Emit("AVSRegistrarSet", event)
+}
+
+// EmitAllocationDelaySet appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitAllocationDelaySet(event *IAllocationManagerAllocationDelaySet) {
+	_FakeIAllocationManager.Contract.Emit("AllocationDelaySet", event)
+}
+
+// EmitAllocationUpdated appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitAllocationUpdated(event *IAllocationManagerAllocationUpdated) {
+	_FakeIAllocationManager.Contract.Emit("AllocationUpdated", event)
+}
+
+// EmitEncumberedMagnitudeUpdated appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitEncumberedMagnitudeUpdated(event *IAllocationManagerEncumberedMagnitudeUpdated) {
+	_FakeIAllocationManager.Contract.Emit("EncumberedMagnitudeUpdated", event)
+}
+
+// EmitMaxMagnitudeUpdated appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitMaxMagnitudeUpdated(event *IAllocationManagerMaxMagnitudeUpdated) {
+	_FakeIAllocationManager.Contract.Emit("MaxMagnitudeUpdated", event)
+}
+
+// EmitOperatorAddedToOperatorSet appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitOperatorAddedToOperatorSet(event *IAllocationManagerOperatorAddedToOperatorSet) {
+	_FakeIAllocationManager.Contract.Emit("OperatorAddedToOperatorSet", event)
+}
+
+// EmitOperatorRemovedFromOperatorSet appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitOperatorRemovedFromOperatorSet(event *IAllocationManagerOperatorRemovedFromOperatorSet) {
+	_FakeIAllocationManager.Contract.Emit("OperatorRemovedFromOperatorSet", event)
+}
+
+// EmitOperatorSetCreated appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitOperatorSetCreated(event *IAllocationManagerOperatorSetCreated) {
+	_FakeIAllocationManager.Contract.Emit("OperatorSetCreated", event)
+}
+
+// EmitOperatorSlashed appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitOperatorSlashed(event *IAllocationManagerOperatorSlashed) {
+	_FakeIAllocationManager.Contract.Emit("OperatorSlashed", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitPaused(event *IAllocationManagerPaused) {
+	_FakeIAllocationManager.Contract.Emit("Paused", event)
+}
+
+// EmitRedistributionAddressSet appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitRedistributionAddressSet(event *IAllocationManagerRedistributionAddressSet) {
+	_FakeIAllocationManager.Contract.Emit("RedistributionAddressSet", event)
+}
+
+// EmitSlasherMigrated appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitSlasherMigrated(event *IAllocationManagerSlasherMigrated) {
+	_FakeIAllocationManager.Contract.Emit("SlasherMigrated", event)
+}
+
+// EmitSlasherUpdated appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitSlasherUpdated(event *IAllocationManagerSlasherUpdated) {
+	_FakeIAllocationManager.Contract.Emit("SlasherUpdated", event)
+}
+
+// EmitStrategyAddedToOperatorSet appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitStrategyAddedToOperatorSet(event *IAllocationManagerStrategyAddedToOperatorSet) {
+	_FakeIAllocationManager.Contract.Emit("StrategyAddedToOperatorSet", event)
+}
+
+// EmitStrategyRemovedFromOperatorSet appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitStrategyRemovedFromOperatorSet(event *IAllocationManagerStrategyRemovedFromOperatorSet) {
+	_FakeIAllocationManager.Contract.Emit("StrategyRemovedFromOperatorSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeIAllocationManager *FakeIAllocationManager) EmitUnpaused(event *IAllocationManagerUnpaused) {
+	_FakeIAllocationManager.Contract.Emit("Unpaused", event)
+}
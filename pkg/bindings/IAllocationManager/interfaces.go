@@ -0,0 +1,151 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IAllocationManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IAllocationManagerReader is the read-only surface of the IAllocationManager
+// binding, implemented by IAllocationManagerCaller, IAllocationManager and FakeIAllocationManager.
+type IAllocationManagerReader interface {
+	ALLOCATIONCONFIGURATIONDELAY(opts *bind.CallOpts) (uint32, error)
+	DEALLOCATIONDELAY(opts *bind.CallOpts) (uint32, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	EigenStrategy(opts *bind.CallOpts) (common.Address, error)
+	GetAVSRegistrar(opts *bind.CallOpts, avs common.Address) (common.Address, error)
+	GetAllocatableMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error)
+	GetAllocatedSets(opts *bind.CallOpts, operator common.Address) ([]OperatorSet, error)
+	GetAllocatedStake(opts *bind.CallOpts, operatorSet OperatorSet, operators []common.Address, strategies []common.Address) ([][]*big.Int, error)
+	GetAllocatedStrategies(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) ([]common.Address, error)
+	GetAllocation(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, strategy common.Address) (IAllocationManagerTypesAllocation, error)
+	GetAllocationDelay(opts *bind.CallOpts, operator common.Address) (struct {
+		IsSet bool
+		Delay uint32
+	}, error)
+	GetAllocations(opts *bind.CallOpts, operators []common.Address, operatorSet OperatorSet, strategy common.Address) ([]IAllocationManagerTypesAllocation, error)
+	GetEncumberedMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error)
+	GetMaxMagnitude(opts *bind.CallOpts, operator common.Address, strategy common.Address) (uint64, error)
+	GetMaxMagnitudes(opts *bind.CallOpts, operators []common.Address, strategy common.Address) ([]uint64, error)
+	GetMaxMagnitudes0(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]uint64, error)
+	GetMaxMagnitudesAtBlock(opts *bind.CallOpts, operator common.Address, strategies []common.Address, blockNumber uint32) ([]uint64, error)
+	GetMemberCount(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error)
+	GetMembers(opts *bind.CallOpts, operatorSet OperatorSet) ([]common.Address, error)
+	GetMinimumSlashableStake(opts *bind.CallOpts, operatorSet OperatorSet, operators []common.Address, strategies []common.Address, futureBlock uint32) ([][]*big.Int, error)
+	GetOperatorSetCount(opts *bind.CallOpts, avs common.Address) (*big.Int, error)
+	GetPendingSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (struct {
+		PendingSlasher common.Address
+		EffectBlock    uint32
+	}, error)
+	GetRedistributionRecipient(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetRegisteredSets(opts *bind.CallOpts, operator common.Address) ([]OperatorSet, error)
+	GetSlashCount(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error)
+	GetSlasher(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetStrategiesInOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) ([]common.Address, error)
+	GetStrategyAllocations(opts *bind.CallOpts, operator common.Address, strategy common.Address) ([]OperatorSet, []IAllocationManagerTypesAllocation, error)
+	IsMemberOfOperatorSet(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error)
+	IsOperatorRedistributable(opts *bind.CallOpts, operator common.Address) (bool, error)
+	IsOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)
+	IsOperatorSlashable(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (bool, error)
+	IsRedistributingOperatorSet(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IAllocationManagerWriter is the transacting surface of the IAllocationManager
+// binding, implemented by IAllocationManagerTransactor, IAllocationManager and FakeIAllocationManager.
+type IAllocationManagerWriter interface {
+	AddStrategiesToOperatorSet(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) (*types.Transaction, error)
+	ClearDeallocationQueue(opts *bind.TransactOpts, operator common.Address, strategies []common.Address, numToClear []uint16) (*types.Transaction, error)
+	CreateOperatorSets(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams) (*types.Transaction, error)
+	CreateOperatorSets0(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2) (*types.Transaction, error)
+	CreateRedistributingOperatorSets(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParams, redistributionRecipients []common.Address) (*types.Transaction, error)
+	CreateRedistributingOperatorSets0(opts *bind.TransactOpts, avs common.Address, params []IAllocationManagerTypesCreateSetParamsV2, redistributionRecipients []common.Address) (*types.Transaction, error)
+	DeregisterFromOperatorSets(opts *bind.TransactOpts, params IAllocationManagerTypesDeregisterParams) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error)
+	MigrateSlashers(opts *bind.TransactOpts, operatorSets []OperatorSet) (*types.Transaction, error)
+	ModifyAllocations(opts *bind.TransactOpts, operator common.Address, params []IAllocationManagerTypesAllocateParams) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RegisterForOperatorSets(opts *bind.TransactOpts, operator common.Address, params IAllocationManagerTypesRegisterParams) (*types.Transaction, error)
+	RemoveStrategiesFromOperatorSet(opts *bind.TransactOpts, avs common.Address, operatorSetId uint32, strategies []common.Address) (*types.Transaction, error)
+	SetAVSRegistrar(opts *bind.TransactOpts, avs common.Address, registrar common.Address) (*types.Transaction, error)
+	SetAllocationDelay(opts *bind.TransactOpts, operator common.Address, delay uint32) (*types.Transaction, error)
+	SlashOperator(opts *bind.TransactOpts, avs common.Address, params IAllocationManagerTypesSlashingParams) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateAVSMetadataURI(opts *bind.TransactOpts, avs common.Address, metadataURI string) (*types.Transaction, error)
+	UpdateSlasher(opts *bind.TransactOpts, operatorSet OperatorSet, slasher common.Address) (*types.Transaction, error)
+}
+
+// IAllocationManagerEvents is the event filtering surface of the IAllocationManager
+// binding, implemented by IAllocationManagerFilterer, IAllocationManager and FakeIAllocationManager.
+type IAllocationManagerEvents interface {
+	FilterAVSMetadataURIUpdated(opts *bind.FilterOpts, avs []common.Address) (*IAllocationManagerAVSMetadataURIUpdatedIterator, error)
+	WatchAVSMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *IAllocationManagerAVSMetadataURIUpdated, avs []common.Address) (event.Subscription, error)
+	ParseAVSMetadataURIUpdated(log types.Log) (*IAllocationManagerAVSMetadataURIUpdated, error)
+	FilterAVSRegistrarSet(opts *bind.FilterOpts) (*IAllocationManagerAVSRegistrarSetIterator, error)
+	WatchAVSRegistrarSet(opts *bind.WatchOpts, sink chan<- *IAllocationManagerAVSRegistrarSet) (event.Subscription, error)
+	ParseAVSRegistrarSet(log types.Log) (*IAllocationManagerAVSRegistrarSet, error)
+	FilterAllocationDelaySet(opts *bind.FilterOpts) (*IAllocationManagerAllocationDelaySetIterator, error)
+	WatchAllocationDelaySet(opts *bind.WatchOpts, sink chan<- *IAllocationManagerAllocationDelaySet) (event.Subscription, error)
+	ParseAllocationDelaySet(log types.Log) (*IAllocationManagerAllocationDelaySet, error)
+	FilterAllocationUpdated(opts *bind.FilterOpts) (*IAllocationManagerAllocationUpdatedIterator, error)
+	WatchAllocationUpdated(opts *bind.WatchOpts, sink chan<- *IAllocationManagerAllocationUpdated) (event.Subscription, error)
+	ParseAllocationUpdated(log types.Log) (*IAllocationManagerAllocationUpdated, error)
+	FilterEncumberedMagnitudeUpdated(opts *bind.FilterOpts) (*IAllocationManagerEncumberedMagnitudeUpdatedIterator, error)
+	WatchEncumberedMagnitudeUpdated(opts *bind.WatchOpts, sink chan<- *IAllocationManagerEncumberedMagnitudeUpdated) (event.Subscription, error)
+	ParseEncumberedMagnitudeUpdated(log types.Log) (*IAllocationManagerEncumberedMagnitudeUpdated, error)
+	FilterMaxMagnitudeUpdated(opts *bind.FilterOpts) (*IAllocationManagerMaxMagnitudeUpdatedIterator, error)
+	WatchMaxMagnitudeUpdated(opts *bind.WatchOpts, sink chan<- *IAllocationManagerMaxMagnitudeUpdated) (event.Subscription, error)
+	ParseMaxMagnitudeUpdated(log types.Log) (*IAllocationManagerMaxMagnitudeUpdated, error)
+	FilterOperatorAddedToOperatorSet(opts *bind.FilterOpts, operator []common.Address) (*IAllocationManagerOperatorAddedToOperatorSetIterator, error)
+	WatchOperatorAddedToOperatorSet(opts *bind.WatchOpts, sink chan<- *IAllocationManagerOperatorAddedToOperatorSet, operator []common.Address) (event.Subscription, error)
+	ParseOperatorAddedToOperatorSet(log types.Log) (*IAllocationManagerOperatorAddedToOperatorSet, error)
+	FilterOperatorRemovedFromOperatorSet(opts *bind.FilterOpts, operator []common.Address) (*IAllocationManagerOperatorRemovedFromOperatorSetIterator, error)
+	WatchOperatorRemovedFromOperatorSet(opts *bind.WatchOpts, sink chan<- *IAllocationManagerOperatorRemovedFromOperatorSet, operator []common.Address) (event.Subscription, error)
+	ParseOperatorRemovedFromOperatorSet(log types.Log) (*IAllocationManagerOperatorRemovedFromOperatorSet, error)
+	FilterOperatorSetCreated(opts *bind.FilterOpts) (*IAllocationManagerOperatorSetCreatedIterator, error)
+	WatchOperatorSetCreated(opts *bind.WatchOpts, sink chan<- *IAllocationManagerOperatorSetCreated) (event.Subscription, error)
+	ParseOperatorSetCreated(log types.Log) (*IAllocationManagerOperatorSetCreated, error)
+	FilterOperatorSlashed(opts *bind.FilterOpts) (*IAllocationManagerOperatorSlashedIterator, error)
+	WatchOperatorSlashed(opts *bind.WatchOpts, sink chan<- *IAllocationManagerOperatorSlashed) (event.Subscription, error)
+	ParseOperatorSlashed(log types.Log) (*IAllocationManagerOperatorSlashed, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*IAllocationManagerPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *IAllocationManagerPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*IAllocationManagerPaused, error)
+	FilterRedistributionAddressSet(opts *bind.FilterOpts) (*IAllocationManagerRedistributionAddressSetIterator, error)
+	WatchRedistributionAddressSet(opts *bind.WatchOpts, sink chan<- *IAllocationManagerRedistributionAddressSet) (event.Subscription, error)
+	ParseRedistributionAddressSet(log types.Log) (*IAllocationManagerRedistributionAddressSet, error)
+	FilterSlasherMigrated(opts *bind.FilterOpts) (*IAllocationManagerSlasherMigratedIterator, error)
+	WatchSlasherMigrated(opts *bind.WatchOpts, sink chan<- *IAllocationManagerSlasherMigrated) (event.Subscription, error)
+	ParseSlasherMigrated(log types.Log) (*IAllocationManagerSlasherMigrated, error)
+	FilterSlasherUpdated(opts *bind.FilterOpts) (*IAllocationManagerSlasherUpdatedIterator, error)
+	WatchSlasherUpdated(opts *bind.WatchOpts, sink chan<- *IAllocationManagerSlasherUpdated) (event.Subscription, error)
+	ParseSlasherUpdated(log types.Log) (*IAllocationManagerSlasherUpdated, error)
+	FilterStrategyAddedToOperatorSet(opts *bind.FilterOpts) (*IAllocationManagerStrategyAddedToOperatorSetIterator, error)
+	WatchStrategyAddedToOperatorSet(opts *bind.WatchOpts, sink chan<- *IAllocationManagerStrategyAddedToOperatorSet) (event.Subscription, error)
+	ParseStrategyAddedToOperatorSet(log types.Log) (*IAllocationManagerStrategyAddedToOperatorSet, error)
+	FilterStrategyRemovedFromOperatorSet(opts *bind.FilterOpts) (*IAllocationManagerStrategyRemovedFromOperatorSetIterator, error)
+	WatchStrategyRemovedFromOperatorSet(opts *bind.WatchOpts, sink chan<- *IAllocationManagerStrategyRemovedFromOperatorSet) (event.Subscription, error)
+	ParseStrategyRemovedFromOperatorSet(log types.Log) (*IAllocationManagerStrategyRemovedFromOperatorSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*IAllocationManagerUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *IAllocationManagerUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*IAllocationManagerUnpaused, error)
+}
+
+var (
+	_ IAllocationManagerReader = (*IAllocationManagerCaller)(nil)
+	_ IAllocationManagerReader = (*IAllocationManager)(nil)
+	_ IAllocationManagerReader = (*FakeIAllocationManager)(nil)
+	_ IAllocationManagerWriter = (*IAllocationManagerTransactor)(nil)
+	_ IAllocationManagerWriter = (*IAllocationManager)(nil)
+	_ IAllocationManagerWriter = (*FakeIAllocationManager)(nil)
+	_ IAllocationManagerEvents = (*IAllocationManagerFilterer)(nil)
+	_ IAllocationManagerEvents = (*IAllocationManager)(nil)
+	_ IAllocationManagerEvents = (*FakeIAllocationManager)(nil)
+)
@@ -0,0 +1,88 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBN254CertificateVerifier
+
+// ErrArrayLengthMismatch is the typed form of the IBN254CertificateVerifier custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "IBN254CertificateVerifier: ArrayLengthMismatch()"
+}
+
+// ErrCertificateStale is the typed form of the IBN254CertificateVerifier custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "IBN254CertificateVerifier: CertificateStale()"
+}
+
+// ErrInvalidOperatorIndex is the typed form of the IBN254CertificateVerifier custom error InvalidOperatorIndex().
+type ErrInvalidOperatorIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorIndex) Error() string {
+	return "IBN254CertificateVerifier: InvalidOperatorIndex()"
+}
+
+// ErrNonSignerIndicesNotSorted is the typed form of the IBN254CertificateVerifier custom error NonSignerIndicesNotSorted().
+type ErrNonSignerIndicesNotSorted struct{}
+
+// Error implements the error interface.
+func (ErrNonSignerIndicesNotSorted) Error() string {
+	return "IBN254CertificateVerifier: NonSignerIndicesNotSorted()"
+}
+
+// ErrOnlyTableUpdater is the typed form of the IBN254CertificateVerifier custom error OnlyTableUpdater().
+type ErrOnlyTableUpdater struct{}
+
+// Error implements the error interface.
+func (ErrOnlyTableUpdater) Error() string {
+	return "IBN254CertificateVerifier: OnlyTableUpdater()"
+}
+
+// ErrReferenceTimestampDoesNotExist is the typed form of the IBN254CertificateVerifier custom error ReferenceTimestampDoesNotExist().
+type ErrReferenceTimestampDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrReferenceTimestampDoesNotExist) Error() string {
+	return "IBN254CertificateVerifier: ReferenceTimestampDoesNotExist()"
+}
+
+// ErrRootDisabled is the typed form of the IBN254CertificateVerifier custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "IBN254CertificateVerifier: RootDisabled()"
+}
+
+// ErrTableUpdateStale is the typed form of the IBN254CertificateVerifier custom error TableUpdateStale().
+type ErrTableUpdateStale struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateStale) Error() string {
+	return "IBN254CertificateVerifier: TableUpdateStale()"
+}
+
+// ErrVerificationFailed is the typed form of the IBN254CertificateVerifier custom error VerificationFailed().
+type ErrVerificationFailed struct{}
+
+// Error implements the error interface.
+func (ErrVerificationFailed) Error() string {
+	return "IBN254CertificateVerifier: VerificationFailed()"
+}
+
+// IBN254CertificateVerifierErrors maps the name of every custom error in the IBN254CertificateVerifier ABI to its typed Go error.
+var IBN254CertificateVerifierErrors = map[string]error{
+	"ArrayLengthMismatch":            ErrArrayLengthMismatch{},
+	"CertificateStale":               ErrCertificateStale{},
+	"InvalidOperatorIndex":           ErrInvalidOperatorIndex{},
+	"NonSignerIndicesNotSorted":      ErrNonSignerIndicesNotSorted{},
+	"OnlyTableUpdater":               ErrOnlyTableUpdater{},
+	"ReferenceTimestampDoesNotExist": ErrReferenceTimestampDoesNotExist{},
+	"RootDisabled":                   ErrRootDisabled{},
+	"TableUpdateStale":               ErrTableUpdateStale{},
+	"VerificationFailed":             ErrVerificationFailed{},
+}
@@ -0,0 +1,250 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBN254CertificateVerifier
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIBN254CertificateVerifier is an in-memory IBN254CertificateVerifier for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IBN254CertificateVerifierFilterer.
+type FakeIBN254CertificateVerifier struct {
+	*fake.Contract
+	*IBN254CertificateVerifierFilterer
+}
+
+// NewFakeIBN254CertificateVerifier returns an empty fake of the contract at address.
+func NewFakeIBN254CertificateVerifier(address common.Address) *FakeIBN254CertificateVerifier {
+	contract := fake.NewContract(IBN254CertificateVerifierMetaData, address)
+	filterer, err := NewIBN254CertificateVerifierFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIBN254CertificateVerifier{Contract: contract, IBN254CertificateVerifierFilterer: filterer}
+}
+
+// CalculateCertificateDigest returns the values stubbed with StubCalculateCertificateDigest.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIBN254CertificateVerifier.Contract.Read("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigest sets what CalculateCertificateDigest returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubCalculateCertificateDigest(referenceTimestamp uint32, messageHash [32]byte, out0 [32]byte) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// GetNonsignerOperatorInfo returns the values stubbed with StubGetNonsignerOperatorInfo.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) GetNonsignerOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesBN254OperatorInfo, error) {
+	var out0 IOperatorTableCalculatorTypesBN254OperatorInfo
+	err := _FakeIBN254CertificateVerifier.Contract.Read("getNonsignerOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubGetNonsignerOperatorInfo sets what GetNonsignerOperatorInfo returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubGetNonsignerOperatorInfo(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 IOperatorTableCalculatorTypesBN254OperatorInfo) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("getNonsignerOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// GetOperatorCount returns the values stubbed with StubGetOperatorCount.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIBN254CertificateVerifier.Contract.Read("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorCount sets what GetOperatorCount returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubGetOperatorCount(operatorSet OperatorSet, referenceTimestamp uint32, out0 *big.Int) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetInfo returns the values stubbed with StubGetOperatorSetInfo.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) GetOperatorSetInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (IOperatorTableCalculatorTypesBN254OperatorSetInfo, error) {
+	var out0 IOperatorTableCalculatorTypesBN254OperatorSetInfo
+	err := _FakeIBN254CertificateVerifier.Contract.Read("getOperatorSetInfo", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetInfo sets what GetOperatorSetInfo returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubGetOperatorSetInfo(operatorSet OperatorSet, referenceTimestamp uint32, out0 IOperatorTableCalculatorTypesBN254OperatorSetInfo) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("getOperatorSetInfo", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetOwner returns the values stubbed with StubGetOperatorSetOwner.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIBN254CertificateVerifier.Contract.Read("getOperatorSetOwner", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetOwner sets what GetOperatorSetOwner returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubGetOperatorSetOwner(operatorSet OperatorSet, out0 common.Address) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("getOperatorSetOwner", []interface{}{operatorSet}, out0)
+}
+
+// GetTotalStakeWeights returns the values stubbed with StubGetTotalStakeWeights.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeIBN254CertificateVerifier.Contract.Read("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetTotalStakeWeights sets what GetTotalStakeWeights returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubGetTotalStakeWeights(operatorSet OperatorSet, referenceTimestamp uint32, out0 []*big.Int) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// IsNonsignerCached returns the values stubbed with StubIsNonsignerCached.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) IsNonsignerCached(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (bool, error) {
+	var out0 bool
+	err := _FakeIBN254CertificateVerifier.Contract.Read("isNonsignerCached", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubIsNonsignerCached sets what IsNonsignerCached returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubIsNonsignerCached(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 bool) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("isNonsignerCached", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// IsReferenceTimestampSet returns the values stubbed with StubIsReferenceTimestampSet.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeIBN254CertificateVerifier.Contract.Read("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsReferenceTimestampSet sets what IsReferenceTimestampSet returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubIsReferenceTimestampSet(operatorSet OperatorSet, referenceTimestamp uint32, out0 bool) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// LatestReferenceTimestamp returns the values stubbed with StubLatestReferenceTimestamp.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeIBN254CertificateVerifier.Contract.Read("latestReferenceTimestamp", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubLatestReferenceTimestamp sets what LatestReferenceTimestamp returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubLatestReferenceTimestamp(operatorSet OperatorSet, out0 uint32) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("latestReferenceTimestamp", []interface{}{operatorSet}, out0)
+}
+
+// MaxOperatorTableStaleness returns the values stubbed with StubMaxOperatorTableStaleness.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeIBN254CertificateVerifier.Contract.Read("maxOperatorTableStaleness", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubMaxOperatorTableStaleness sets what MaxOperatorTableStaleness returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubMaxOperatorTableStaleness(operatorSet OperatorSet, out0 uint32) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("maxOperatorTableStaleness", []interface{}{operatorSet}, out0)
+}
+
+// TrySignatureVerification returns the values stubbed with StubTrySignatureVerification.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) TrySignatureVerification(opts *bind.CallOpts, msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point) (struct {
+	PairingSuccessful bool
+	SignatureValid    bool
+}, error) {
+	var out0 struct {
+		PairingSuccessful bool
+		SignatureValid    bool
+	}
+	err := _FakeIBN254CertificateVerifier.Contract.Read("trySignatureVerification", []interface{}{msgHash, aggPubkey, apkG2, signature}, &out0)
+	return out0, err
+}
+
+// StubTrySignatureVerification sets what TrySignatureVerification returns for the given arguments.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) StubTrySignatureVerification(msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point, out0 struct {
+	PairingSuccessful bool
+	SignatureValid    bool
+}) {
+	_FakeIBN254CertificateVerifier.Contract.Stub("trySignatureVerification", []interface{}{msgHash, aggPubkey, apkG2, signature}, out0)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeIBN254CertificateVerifier.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeIBN254CertificateVerifier.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, referenceTimestamp, operatorSetInfo, operatorSetConfig)
+		}
+		return nil
+	}, operatorSet, referenceTimestamp, operatorSetInfo, operatorSetConfig)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeIBN254CertificateVerifier.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// VerifyCertificate records a verifyCertificate call after running the hook set with OnVerifyCertificate.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) VerifyCertificate(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) (*types.Transaction, error) {
+	return _FakeIBN254CertificateVerifier.Contract.Transact(opts, "verifyCertificate", func() error {
+		if hook, ok := _FakeIBN254CertificateVerifier.Contract.Hook("verifyCertificate").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) error); ok {
+			return hook(opts, operatorSet, cert)
+		}
+		return nil
+	}, operatorSet, cert)
+}
+
+// OnVerifyCertificate sets a hook run by every VerifyCertificate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) OnVerifyCertificate(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) error) {
+	_FakeIBN254CertificateVerifier.Contract.SetHook("verifyCertificate", hook)
+}
+
+// VerifyCertificateNominal records a verifyCertificateNominal call after running the hook set with OnVerifyCertificateNominal.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) VerifyCertificateNominal(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) (*types.Transaction, error) {
+	return _FakeIBN254CertificateVerifier.Contract.Transact(opts, "verifyCertificateNominal", func() error {
+		if hook, ok := _FakeIBN254CertificateVerifier.Contract.Hook("verifyCertificateNominal").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) error); ok {
+			return hook(opts, operatorSet, cert, totalStakeNominalThresholds)
+		}
+		return nil
+	}, operatorSet, cert, totalStakeNominalThresholds)
+}
+
+// OnVerifyCertificateNominal sets a hook run by every VerifyCertificateNominal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) OnVerifyCertificateNominal(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) error) {
+	_FakeIBN254CertificateVerifier.Contract.SetHook("verifyCertificateNominal", hook)
+}
+
+// VerifyCertificateProportion records a verifyCertificateProportion call after running the hook set with OnVerifyCertificateProportion.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) VerifyCertificateProportion(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) (*types.Transaction, error) {
+	return _FakeIBN254CertificateVerifier.Contract.Transact(opts, "verifyCertificateProportion", func() error {
+		if hook, ok := _FakeIBN254CertificateVerifier.Contract.Hook("verifyCertificateProportion").(func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) error); ok {
+			return hook(opts, operatorSet, cert, totalStakeProportionThresholds)
+		}
+		return nil
+	}, operatorSet, cert, totalStakeProportionThresholds)
+}
+
+// OnVerifyCertificateProportion sets a hook run by every VerifyCertificateProportion call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) OnVerifyCertificateProportion(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) error) {
+	_FakeIBN254CertificateVerifier.Contract.SetHook("verifyCertificateProportion", hook)
+}
+
+// EmitMaxStalenessPeriodUpdated appends the event to the fake's logs.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) EmitMaxStalenessPeriodUpdated(event *IBN254CertificateVerifierMaxStalenessPeriodUpdated) {
+	_FakeIBN254CertificateVerifier.Contract.Emit("MaxStalenessPeriodUpdated", event)
+}
+
+// EmitOperatorSetOwnerUpdated appends the event to the fake's logs.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) EmitOperatorSetOwnerUpdated(event *IBN254CertificateVerifierOperatorSetOwnerUpdated) {
+	_FakeIBN254CertificateVerifier.Contract.Emit("OperatorSetOwnerUpdated", event)
+}
+
+// EmitTableUpdated appends the event to the fake's logs.
+func (_FakeIBN254CertificateVerifier *FakeIBN254CertificateVerifier) EmitTableUpdated(event *IBN254CertificateVerifierTableUpdated) {
+	_FakeIBN254CertificateVerifier.Contract.Emit("TableUpdated", event)
+}
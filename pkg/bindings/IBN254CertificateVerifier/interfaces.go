@@ -0,0 +1,65 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBN254CertificateVerifier
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IBN254CertificateVerifierReader is the read-only surface of the IBN254CertificateVerifier
+// binding, implemented by IBN254CertificateVerifierCaller, IBN254CertificateVerifier and FakeIBN254CertificateVerifier.
+type IBN254CertificateVerifierReader interface {
+	CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error)
+	GetNonsignerOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesBN254OperatorInfo, error)
+	GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error)
+	GetOperatorSetInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (IOperatorTableCalculatorTypesBN254OperatorSetInfo, error)
+	GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error)
+	IsNonsignerCached(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (bool, error)
+	IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error)
+	LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	TrySignatureVerification(opts *bind.CallOpts, msgHash [32]byte, aggPubkey BN254G1Point, apkG2 BN254G2Point, signature BN254G1Point) (struct {
+		PairingSuccessful bool
+		SignatureValid    bool
+	}, error)
+}
+
+// IBN254CertificateVerifierWriter is the transacting surface of the IBN254CertificateVerifier
+// binding, implemented by IBN254CertificateVerifierTransactor, IBN254CertificateVerifier and FakeIBN254CertificateVerifier.
+type IBN254CertificateVerifierWriter interface {
+	UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorSetInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	VerifyCertificate(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate) (*types.Transaction, error)
+	VerifyCertificateNominal(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeNominalThresholds []*big.Int) (*types.Transaction, error)
+	VerifyCertificateProportion(opts *bind.TransactOpts, operatorSet OperatorSet, cert IBN254CertificateVerifierTypesBN254Certificate, totalStakeProportionThresholds []uint16) (*types.Transaction, error)
+}
+
+// IBN254CertificateVerifierEvents is the event filtering surface of the IBN254CertificateVerifier
+// binding, implemented by IBN254CertificateVerifierFilterer, IBN254CertificateVerifier and FakeIBN254CertificateVerifier.
+type IBN254CertificateVerifierEvents interface {
+	FilterMaxStalenessPeriodUpdated(opts *bind.FilterOpts) (*IBN254CertificateVerifierMaxStalenessPeriodUpdatedIterator, error)
+	WatchMaxStalenessPeriodUpdated(opts *bind.WatchOpts, sink chan<- *IBN254CertificateVerifierMaxStalenessPeriodUpdated) (event.Subscription, error)
+	ParseMaxStalenessPeriodUpdated(log types.Log) (*IBN254CertificateVerifierMaxStalenessPeriodUpdated, error)
+	FilterOperatorSetOwnerUpdated(opts *bind.FilterOpts) (*IBN254CertificateVerifierOperatorSetOwnerUpdatedIterator, error)
+	WatchOperatorSetOwnerUpdated(opts *bind.WatchOpts, sink chan<- *IBN254CertificateVerifierOperatorSetOwnerUpdated) (event.Subscription, error)
+	ParseOperatorSetOwnerUpdated(log types.Log) (*IBN254CertificateVerifierOperatorSetOwnerUpdated, error)
+	FilterTableUpdated(opts *bind.FilterOpts) (*IBN254CertificateVerifierTableUpdatedIterator, error)
+	WatchTableUpdated(opts *bind.WatchOpts, sink chan<- *IBN254CertificateVerifierTableUpdated) (event.Subscription, error)
+	ParseTableUpdated(log types.Log) (*IBN254CertificateVerifierTableUpdated, error)
+}
+
+var (
+	_ IBN254CertificateVerifierReader = (*IBN254CertificateVerifierCaller)(nil)
+	_ IBN254CertificateVerifierReader = (*IBN254CertificateVerifier)(nil)
+	_ IBN254CertificateVerifierReader = (*FakeIBN254CertificateVerifier)(nil)
+	_ IBN254CertificateVerifierWriter = (*IBN254CertificateVerifierTransactor)(nil)
+	_ IBN254CertificateVerifierWriter = (*IBN254CertificateVerifier)(nil)
+	_ IBN254CertificateVerifierWriter = (*FakeIBN254CertificateVerifier)(nil)
+	_ IBN254CertificateVerifierEvents = (*IBN254CertificateVerifierFilterer)(nil)
+	_ IBN254CertificateVerifierEvents = (*IBN254CertificateVerifier)(nil)
+	_ IBN254CertificateVerifierEvents = (*FakeIBN254CertificateVerifier)(nil)
+)
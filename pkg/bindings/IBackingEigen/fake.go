@@ -0,0 +1,284 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBackingEigen
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIBackingEigen is an in-memory IBackingEigen for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IBackingEigenFilterer.
+type FakeIBackingEigen struct {
+	*fake.Contract
+	*IBackingEigenFilterer
+}
+
+// NewFakeIBackingEigen returns an empty fake of the contract at address.
+func NewFakeIBackingEigen(address common.Address) *FakeIBackingEigen {
+	contract := fake.NewContract(IBackingEigenMetaData, address)
+	filterer, err := NewIBackingEigenFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIBackingEigen{Contract: contract, IBackingEigenFilterer: filterer}
+}
+
+// CLOCKMODE returns the values stubbed with StubCLOCKMODE.
+func (_FakeIBackingEigen *FakeIBackingEigen) CLOCKMODE(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeIBackingEigen.Contract.Read("CLOCK_MODE", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCLOCKMODE sets what CLOCKMODE returns for the given arguments.
+func (_FakeIBackingEigen *FakeIBackingEigen) StubCLOCKMODE(out0 string) {
+	_FakeIBackingEigen.Contract.Stub("CLOCK_MODE", []interface{}{}, out0)
+}
+
+// EIGEN returns the values stubbed with StubEIGEN.
+func (_FakeIBackingEigen *FakeIBackingEigen) EIGEN(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIBackingEigen.Contract.Read("EIGEN", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEIGEN sets what EIGEN returns for the given arguments.
+func (_FakeIBackingEigen *FakeIBackingEigen) StubEIGEN(out0 common.Address) {
+	_FakeIBackingEigen.Contract.Stub("EIGEN", []interface{}{}, out0)
+}
+
+// Allowance returns the values stubbed with StubAllowance.
+func (_FakeIBackingEigen *FakeIBackingEigen) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIBackingEigen.Contract.Read("allowance", []interface{}{owner, spender}, &out0)
+	return out0, err
+}
+
+// StubAllowance sets what Allowance returns for the given arguments.
+func (_FakeIBackingEigen *FakeIBackingEigen) StubAllowance(owner common.Address, spender common.Address, out0 *big.Int) {
+	_FakeIBackingEigen.Contract.Stub("allowance", []interface{}{owner, spender}, out0)
+}
+
+// BalanceOf returns the values stubbed with StubBalanceOf.
+func (_FakeIBackingEigen *FakeIBackingEigen) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIBackingEigen.Contract.Read("balanceOf", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubBalanceOf sets what BalanceOf returns for the given arguments.
+func (_FakeIBackingEigen *FakeIBackingEigen) StubBalanceOf(account common.Address, out0 *big.Int) {
+	_FakeIBackingEigen.Contract.Stub("balanceOf", []interface{}{account}, out0)
+}
+
+// Clock returns the values stubbed with StubClock.
+func (_FakeIBackingEigen *FakeIBackingEigen) Clock(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIBackingEigen.Contract.Read("clock", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubClock sets what Clock returns for the given arguments.
+func (_FakeIBackingEigen *FakeIBackingEigen) StubClock(out0 *big.Int) {
+	_FakeIBackingEigen.Contract.Stub("clock", []interface{}{}, out0)
+}
+
+// TotalSupply returns the values stubbed with StubTotalSupply.
+func (_FakeIBackingEigen *FakeIBackingEigen) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIBackingEigen.Contract.Read("totalSupply", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalSupply sets what TotalSupply returns for the given arguments.
+func (_FakeIBackingEigen *FakeIBackingEigen) StubTotalSupply(out0 *big.Int) {
+	_FakeIBackingEigen.Contract.Stub("totalSupply", []interface{}{}, out0)
+}
+
+// TransferRestrictionsDisabledAfter returns the values stubbed with StubTransferRestrictionsDisabledAfter.
+func (_FakeIBackingEigen *FakeIBackingEigen) TransferRestrictionsDisabledAfter(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIBackingEigen.Contract.Read("transferRestrictionsDisabledAfter", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTransferRestrictionsDisabledAfter sets what TransferRestrictionsDisabledAfter returns for the given arguments.
+func (_FakeIBackingEigen *FakeIBackingEigen) StubTransferRestrictionsDisabledAfter(out0 *big.Int) {
+	_FakeIBackingEigen.Contract.Stub("transferRestrictionsDisabledAfter", []interface{}{}, out0)
+}
+
+// Approve records a approve call after running the hook set with OnApprove.
+func (_FakeIBackingEigen *FakeIBackingEigen) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "approve", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("approve").(func(opts *bind.TransactOpts, spender common.Address, amount *big.Int) error); ok {
+			return hook(opts, spender, amount)
+		}
+		return nil
+	}, spender, amount)
+}
+
+// OnApprove sets a hook run by every Approve call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnApprove(hook func(opts *bind.TransactOpts, spender common.Address, amount *big.Int) error) {
+	_FakeIBackingEigen.Contract.SetHook("approve", hook)
+}
+
+// Burn records a burn call after running the hook set with OnBurn.
+func (_FakeIBackingEigen *FakeIBackingEigen) Burn(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "burn", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("burn").(func(opts *bind.TransactOpts, amount *big.Int) error); ok {
+			return hook(opts, amount)
+		}
+		return nil
+	}, amount)
+}
+
+// OnBurn sets a hook run by every Burn call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnBurn(hook func(opts *bind.TransactOpts, amount *big.Int) error) {
+	_FakeIBackingEigen.Contract.SetHook("burn", hook)
+}
+
+// DisableTransferRestrictions records a disableTransferRestrictions call after running the hook set with OnDisableTransferRestrictions.
+func (_FakeIBackingEigen *FakeIBackingEigen) DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "disableTransferRestrictions", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("disableTransferRestrictions").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnDisableTransferRestrictions sets a hook run by every DisableTransferRestrictions call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnDisableTransferRestrictions(hook func(opts *bind.TransactOpts) error) {
+	_FakeIBackingEigen.Contract.SetHook("disableTransferRestrictions", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeIBackingEigen *FakeIBackingEigen) Initialize(opts *bind.TransactOpts, initialOwner common.Address) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address) error); ok {
+			return hook(opts, initialOwner)
+		}
+		return nil
+	}, initialOwner)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address) error) {
+	_FakeIBackingEigen.Contract.SetHook("initialize", hook)
+}
+
+// Mint records a mint call after running the hook set with OnMint.
+func (_FakeIBackingEigen *FakeIBackingEigen) Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "mint", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("mint").(func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, to, amount)
+		}
+		return nil
+	}, to, amount)
+}
+
+// OnMint sets a hook run by every Mint call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnMint(hook func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error) {
+	_FakeIBackingEigen.Contract.SetHook("mint", hook)
+}
+
+// SetAllowedFrom records a setAllowedFrom call after running the hook set with OnSetAllowedFrom.
+func (_FakeIBackingEigen *FakeIBackingEigen) SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "setAllowedFrom", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("setAllowedFrom").(func(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) error); ok {
+			return hook(opts, from, isAllowedFrom)
+		}
+		return nil
+	}, from, isAllowedFrom)
+}
+
+// OnSetAllowedFrom sets a hook run by every SetAllowedFrom call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnSetAllowedFrom(hook func(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) error) {
+	_FakeIBackingEigen.Contract.SetHook("setAllowedFrom", hook)
+}
+
+// SetAllowedTo records a setAllowedTo call after running the hook set with OnSetAllowedTo.
+func (_FakeIBackingEigen *FakeIBackingEigen) SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "setAllowedTo", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("setAllowedTo").(func(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) error); ok {
+			return hook(opts, to, isAllowedTo)
+		}
+		return nil
+	}, to, isAllowedTo)
+}
+
+// OnSetAllowedTo sets a hook run by every SetAllowedTo call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnSetAllowedTo(hook func(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) error) {
+	_FakeIBackingEigen.Contract.SetHook("setAllowedTo", hook)
+}
+
+// SetIsMinter records a setIsMinter call after running the hook set with OnSetIsMinter.
+func (_FakeIBackingEigen *FakeIBackingEigen) SetIsMinter(opts *bind.TransactOpts, minterAddress common.Address, newStatus bool) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "setIsMinter", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("setIsMinter").(func(opts *bind.TransactOpts, minterAddress common.Address, newStatus bool) error); ok {
+			return hook(opts, minterAddress, newStatus)
+		}
+		return nil
+	}, minterAddress, newStatus)
+}
+
+// OnSetIsMinter sets a hook run by every SetIsMinter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnSetIsMinter(hook func(opts *bind.TransactOpts, minterAddress common.Address, newStatus bool) error) {
+	_FakeIBackingEigen.Contract.SetHook("setIsMinter", hook)
+}
+
+// Transfer records a transfer call after running the hook set with OnTransfer.
+func (_FakeIBackingEigen *FakeIBackingEigen) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "transfer", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("transfer").(func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, to, amount)
+		}
+		return nil
+	}, to, amount)
+}
+
+// OnTransfer sets a hook run by every Transfer call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnTransfer(hook func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error) {
+	_FakeIBackingEigen.Contract.SetHook("transfer", hook)
+}
+
+// TransferFrom records a transferFrom call after running the hook set with OnTransferFrom.
+func (_FakeIBackingEigen *FakeIBackingEigen) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIBackingEigen.Contract.Transact(opts, "transferFrom", func() error {
+		if hook, ok := _FakeIBackingEigen.Contract.Hook("transferFrom").(func(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, from, to, amount)
+		}
+		return nil
+	}, from, to, amount)
+}
+
+// OnTransferFrom sets a hook run by every TransferFrom call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIBackingEigen *FakeIBackingEigen) OnTransferFrom(hook func(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) error) {
+	_FakeIBackingEigen.Contract.SetHook("transferFrom", hook)
+}
+
+// EmitApproval appends the event to the fake's logs.
+func (_FakeIBackingEigen *FakeIBackingEigen) EmitApproval(event *IBackingEigenApproval) {
+	_FakeIBackingEigen.Contract.Emit("Approval", event)
+}
+
+// EmitTransfer appends the event to the fake's logs.
+func (_FakeIBackingEigen *FakeIBackingEigen) EmitTransfer(event *IBackingEigenTransfer) {
+	_FakeIBackingEigen.Contract.Emit("Transfer", event)
+}
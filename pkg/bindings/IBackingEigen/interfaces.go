@@ -0,0 +1,61 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBackingEigen
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IBackingEigenReader is the read-only surface of the IBackingEigen
+// binding, implemented by IBackingEigenCaller, IBackingEigen and FakeIBackingEigen.
+type IBackingEigenReader interface {
+	CLOCKMODE(opts *bind.CallOpts) (string, error)
+	EIGEN(opts *bind.CallOpts) (common.Address, error)
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	Clock(opts *bind.CallOpts) (*big.Int, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+	TransferRestrictionsDisabledAfter(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// IBackingEigenWriter is the transacting surface of the IBackingEigen
+// binding, implemented by IBackingEigenTransactor, IBackingEigen and FakeIBackingEigen.
+type IBackingEigenWriter interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+	Burn(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error)
+	DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address) (*types.Transaction, error)
+	Mint(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error)
+	SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error)
+	SetIsMinter(opts *bind.TransactOpts, minterAddress common.Address, newStatus bool) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// IBackingEigenEvents is the event filtering surface of the IBackingEigen
+// binding, implemented by IBackingEigenFilterer, IBackingEigen and FakeIBackingEigen.
+type IBackingEigenEvents interface {
+	FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*IBackingEigenApprovalIterator, error)
+	WatchApproval(opts *bind.WatchOpts, sink chan<- *IBackingEigenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error)
+	ParseApproval(log types.Log) (*IBackingEigenApproval, error)
+	FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*IBackingEigenTransferIterator, error)
+	WatchTransfer(opts *bind.WatchOpts, sink chan<- *IBackingEigenTransfer, from []common.Address, to []common.Address) (event.Subscription, error)
+	ParseTransfer(log types.Log) (*IBackingEigenTransfer, error)
+}
+
+var (
+	_ IBackingEigenReader = (*IBackingEigenCaller)(nil)
+	_ IBackingEigenReader = (*IBackingEigen)(nil)
+	_ IBackingEigenReader = (*FakeIBackingEigen)(nil)
+	_ IBackingEigenWriter = (*IBackingEigenTransactor)(nil)
+	_ IBackingEigenWriter = (*IBackingEigen)(nil)
+	_ IBackingEigenWriter = (*FakeIBackingEigen)(nil)
+	_ IBackingEigenEvents = (*IBackingEigenFilterer)(nil)
+	_ IBackingEigenEvents = (*IBackingEigen)(nil)
+	_ IBackingEigenEvents = (*FakeIBackingEigen)(nil)
+)
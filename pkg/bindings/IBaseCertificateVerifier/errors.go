@@ -0,0 +1,70 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBaseCertificateVerifier
+
+// ErrArrayLengthMismatch is the typed form of the IBaseCertificateVerifier custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "IBaseCertificateVerifier: ArrayLengthMismatch()"
+}
+
+// ErrCertificateStale is the typed form of the IBaseCertificateVerifier custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "IBaseCertificateVerifier: CertificateStale()"
+}
+
+// ErrOnlyTableUpdater is the typed form of the IBaseCertificateVerifier custom error OnlyTableUpdater().
+type ErrOnlyTableUpdater struct{}
+
+// Error implements the error interface.
+func (ErrOnlyTableUpdater) Error() string {
+	return "IBaseCertificateVerifier: OnlyTableUpdater()"
+}
+
+// ErrReferenceTimestampDoesNotExist is the typed form of the IBaseCertificateVerifier custom error ReferenceTimestampDoesNotExist().
+type ErrReferenceTimestampDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrReferenceTimestampDoesNotExist) Error() string {
+	return "IBaseCertificateVerifier: ReferenceTimestampDoesNotExist()"
+}
+
+// ErrRootDisabled is the typed form of the IBaseCertificateVerifier custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "IBaseCertificateVerifier: RootDisabled()"
+}
+
+// ErrTableUpdateStale is the typed form of the IBaseCertificateVerifier custom error TableUpdateStale().
+type ErrTableUpdateStale struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateStale) Error() string {
+	return "IBaseCertificateVerifier: TableUpdateStale()"
+}
+
+// ErrVerificationFailed is the typed form of the IBaseCertificateVerifier custom error VerificationFailed().
+type ErrVerificationFailed struct{}
+
+// Error implements the error interface.
+func (ErrVerificationFailed) Error() string {
+	return "IBaseCertificateVerifier: VerificationFailed()"
+}
+
+// IBaseCertificateVerifierErrors maps the name of every custom error in the IBaseCertificateVerifier ABI to its typed Go error.
+var IBaseCertificateVerifierErrors = map[string]error{
+	"ArrayLengthMismatch":            ErrArrayLengthMismatch{},
+	"CertificateStale":               ErrCertificateStale{},
+	"OnlyTableUpdater":               ErrOnlyTableUpdater{},
+	"ReferenceTimestampDoesNotExist": ErrReferenceTimestampDoesNotExist{},
+	"RootDisabled":                   ErrRootDisabled{},
+	"TableUpdateStale":               ErrTableUpdateStale{},
+	"VerificationFailed":             ErrVerificationFailed{},
+}
@@ -0,0 +1,111 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBaseCertificateVerifier
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+// FakeIBaseCertificateVerifier is an in-memory IBaseCertificateVerifier for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IBaseCertificateVerifierFilterer.
+type FakeIBaseCertificateVerifier struct {
+	*fake.Contract
+	*IBaseCertificateVerifierFilterer
+}
+
+// NewFakeIBaseCertificateVerifier returns an empty fake of the contract at address.
+func NewFakeIBaseCertificateVerifier(address common.Address) *FakeIBaseCertificateVerifier {
+	contract := fake.NewContract(IBaseCertificateVerifierMetaData, address)
+	filterer, err := NewIBaseCertificateVerifierFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIBaseCertificateVerifier{Contract: contract, IBaseCertificateVerifierFilterer: filterer}
+}
+
+// GetOperatorCount returns the values stubbed with StubGetOperatorCount.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIBaseCertificateVerifier.Contract.Read("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorCount sets what GetOperatorCount returns for the given arguments.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) StubGetOperatorCount(operatorSet OperatorSet, referenceTimestamp uint32, out0 *big.Int) {
+	_FakeIBaseCertificateVerifier.Contract.Stub("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetOwner returns the values stubbed with StubGetOperatorSetOwner.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIBaseCertificateVerifier.Contract.Read("getOperatorSetOwner", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetOwner sets what GetOperatorSetOwner returns for the given arguments.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) StubGetOperatorSetOwner(operatorSet OperatorSet, out0 common.Address) {
+	_FakeIBaseCertificateVerifier.Contract.Stub("getOperatorSetOwner", []interface{}{operatorSet}, out0)
+}
+
+// GetTotalStakeWeights returns the values stubbed with StubGetTotalStakeWeights.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeIBaseCertificateVerifier.Contract.Read("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetTotalStakeWeights sets what GetTotalStakeWeights returns for the given arguments.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) StubGetTotalStakeWeights(operatorSet OperatorSet, referenceTimestamp uint32, out0 []*big.Int) {
+	_FakeIBaseCertificateVerifier.Contract.Stub("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// IsReferenceTimestampSet returns the values stubbed with StubIsReferenceTimestampSet.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeIBaseCertificateVerifier.Contract.Read("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsReferenceTimestampSet sets what IsReferenceTimestampSet returns for the given arguments.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) StubIsReferenceTimestampSet(operatorSet OperatorSet, referenceTimestamp uint32, out0 bool) {
+	_FakeIBaseCertificateVerifier.Contract.Stub("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// LatestReferenceTimestamp returns the values stubbed with StubLatestReferenceTimestamp.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeIBaseCertificateVerifier.Contract.Read("latestReferenceTimestamp", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubLatestReferenceTimestamp sets what LatestReferenceTimestamp returns for the given arguments.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) StubLatestReferenceTimestamp(operatorSet OperatorSet, out0 uint32) {
+	_FakeIBaseCertificateVerifier.Contract.Stub("latestReferenceTimestamp", []interface{}{operatorSet}, out0)
+}
+
+// MaxOperatorTableStaleness returns the values stubbed with StubMaxOperatorTableStaleness.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeIBaseCertificateVerifier.Contract.Read("maxOperatorTableStaleness", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubMaxOperatorTableStaleness sets what MaxOperatorTableStaleness returns for the given arguments.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) StubMaxOperatorTableStaleness(operatorSet OperatorSet, out0 uint32) {
+	_FakeIBaseCertificateVerifier.Contract.Stub("maxOperatorTableStaleness", []interface{}{operatorSet}, out0)
+}
+
+// EmitMaxStalenessPeriodUpdated appends the event to the fake's logs.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) EmitMaxStalenessPeriodUpdated(event *IBaseCertificateVerifierMaxStalenessPeriodUpdated) {
+	_FakeIBaseCertificateVerifier.Contract.Emit("MaxStalenessPeriodUpdated", event)
+}
+
+// EmitOperatorSetOwnerUpdated appends the event to the fake's logs.
+func (_FakeIBaseCertificateVerifier *FakeIBaseCertificateVerifier) EmitOperatorSetOwnerUpdated(event *IBaseCertificateVerifierOperatorSetOwnerUpdated) {
+	_FakeIBaseCertificateVerifier.Contract.Emit("OperatorSetOwnerUpdated", event)
+}
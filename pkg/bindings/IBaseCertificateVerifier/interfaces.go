@@ -0,0 +1,42 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IBaseCertificateVerifier
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IBaseCertificateVerifierReader is the read-only surface of the IBaseCertificateVerifier
+// binding, implemented by IBaseCertificateVerifierCaller, IBaseCertificateVerifier and FakeIBaseCertificateVerifier.
+type IBaseCertificateVerifierReader interface {
+	GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error)
+	GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error)
+	IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error)
+	LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+}
+
+// IBaseCertificateVerifierEvents is the event filtering surface of the IBaseCertificateVerifier
+// binding, implemented by IBaseCertificateVerifierFilterer, IBaseCertificateVerifier and FakeIBaseCertificateVerifier.
+type IBaseCertificateVerifierEvents interface {
+	FilterMaxStalenessPeriodUpdated(opts *bind.FilterOpts) (*IBaseCertificateVerifierMaxStalenessPeriodUpdatedIterator, error)
+	WatchMaxStalenessPeriodUpdated(opts *bind.WatchOpts, sink chan<- *IBaseCertificateVerifierMaxStalenessPeriodUpdated) (event.Subscription, error)
+	ParseMaxStalenessPeriodUpdated(log types.Log) (*IBaseCertificateVerifierMaxStalenessPeriodUpdated, error)
+	FilterOperatorSetOwnerUpdated(opts *bind.FilterOpts) (*IBaseCertificateVerifierOperatorSetOwnerUpdatedIterator, error)
+	WatchOperatorSetOwnerUpdated(opts *bind.WatchOpts, sink chan<- *IBaseCertificateVerifierOperatorSetOwnerUpdated) (event.Subscription, error)
+	ParseOperatorSetOwnerUpdated(log types.Log) (*IBaseCertificateVerifierOperatorSetOwnerUpdated, error)
+}
+
+var (
+	_ IBaseCertificateVerifierReader = (*IBaseCertificateVerifierCaller)(nil)
+	_ IBaseCertificateVerifierReader = (*IBaseCertificateVerifier)(nil)
+	_ IBaseCertificateVerifierReader = (*FakeIBaseCertificateVerifier)(nil)
+	_ IBaseCertificateVerifierEvents = (*IBaseCertificateVerifierFilterer)(nil)
+	_ IBaseCertificateVerifierEvents = (*IBaseCertificateVerifier)(nil)
+	_ IBaseCertificateVerifierEvents = (*FakeIBaseCertificateVerifier)(nil)
+)
@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ICrossChainRegistry
+
+// ErrArrayLengthMismatch is the typed form of the ICrossChainRegistry custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "ICrossChainRegistry: ArrayLengthMismatch()"
+}
+
+// ErrChainIDAlreadyWhitelisted is the typed form of the ICrossChainRegistry custom error ChainIDAlreadyWhitelisted().
+type ErrChainIDAlreadyWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrChainIDAlreadyWhitelisted) Error() string {
+	return "ICrossChainRegistry: ChainIDAlreadyWhitelisted()"
+}
+
+// ErrChainIDNotWhitelisted is the typed form of the ICrossChainRegistry custom error ChainIDNotWhitelisted().
+type ErrChainIDNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrChainIDNotWhitelisted) Error() string {
+	return "ICrossChainRegistry: ChainIDNotWhitelisted()"
+}
+
+// ErrEmptyChainIDsArray is the typed form of the ICrossChainRegistry custom error EmptyChainIDsArray().
+type ErrEmptyChainIDsArray struct{}
+
+// Error implements the error interface.
+func (ErrEmptyChainIDsArray) Error() string {
+	return "ICrossChainRegistry: EmptyChainIDsArray()"
+}
+
+// ErrGenerationReservationAlreadyExists is the typed form of the ICrossChainRegistry custom error GenerationReservationAlreadyExists().
+type ErrGenerationReservationAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrGenerationReservationAlreadyExists) Error() string {
+	return "ICrossChainRegistry: GenerationReservationAlreadyExists()"
+}
+
+// ErrGenerationReservationDoesNotExist is the typed form of the ICrossChainRegistry custom error GenerationReservationDoesNotExist().
+type ErrGenerationReservationDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrGenerationReservationDoesNotExist) Error() string {
+	return "ICrossChainRegistry: GenerationReservationDoesNotExist()"
+}
+
+// ErrInvalidChainId is the typed form of the ICrossChainRegistry custom error InvalidChainId().
+type ErrInvalidChainId struct{}
+
+// Error implements the error interface.
+func (ErrInvalidChainId) Error() string {
+	return "ICrossChainRegistry: InvalidChainId()"
+}
+
+// ErrInvalidEndIndex is the typed form of the ICrossChainRegistry custom error InvalidEndIndex().
+type ErrInvalidEndIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEndIndex) Error() string {
+	return "ICrossChainRegistry: InvalidEndIndex()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the ICrossChainRegistry custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "ICrossChainRegistry: InvalidOperatorSet()"
+}
+
+// ErrInvalidRange is the typed form of the ICrossChainRegistry custom error InvalidRange().
+type ErrInvalidRange struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRange) Error() string {
+	return "ICrossChainRegistry: InvalidRange()"
+}
+
+// ErrInvalidStalenessPeriod is the typed form of the ICrossChainRegistry custom error InvalidStalenessPeriod().
+type ErrInvalidStalenessPeriod struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStalenessPeriod) Error() string {
+	return "ICrossChainRegistry: InvalidStalenessPeriod()"
+}
+
+// ErrInvalidTableUpdateCadence is the typed form of the ICrossChainRegistry custom error InvalidTableUpdateCadence().
+type ErrInvalidTableUpdateCadence struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTableUpdateCadence) Error() string {
+	return "ICrossChainRegistry: InvalidTableUpdateCadence()"
+}
+
+// ErrKeyTypeNotSet is the typed form of the ICrossChainRegistry custom error KeyTypeNotSet().
+type ErrKeyTypeNotSet struct{}
+
+// Error implements the error interface.
+func (ErrKeyTypeNotSet) Error() string {
+	return "ICrossChainRegistry: KeyTypeNotSet()"
+}
+
+// ICrossChainRegistryErrors maps the name of every custom error in the ICrossChainRegistry ABI to its typed Go error.
+var ICrossChainRegistryErrors = map[string]error{
+	"ArrayLengthMismatch":                ErrArrayLengthMismatch{},
+	"ChainIDAlreadyWhitelisted":          ErrChainIDAlreadyWhitelisted{},
+	"ChainIDNotWhitelisted":              ErrChainIDNotWhitelisted{},
+	"EmptyChainIDsArray":                 ErrEmptyChainIDsArray{},
+	"GenerationReservationAlreadyExists": ErrGenerationReservationAlreadyExists{},
+	"GenerationReservationDoesNotExist":  ErrGenerationReservationDoesNotExist{},
+	"InvalidChainId":                     ErrInvalidChainId{},
+	"InvalidEndIndex":                    ErrInvalidEndIndex{},
+	"InvalidOperatorSet":                 ErrInvalidOperatorSet{},
+	"InvalidRange":                       ErrInvalidRange{},
+	"InvalidStalenessPeriod":             ErrInvalidStalenessPeriod{},
+	"InvalidTableUpdateCadence":          ErrInvalidTableUpdateCadence{},
+	"KeyTypeNotSet":                      ErrKeyTypeNotSet{},
+}
@@ -0,0 +1,296 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ICrossChainRegistry
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeICrossChainRegistry is an in-memory ICrossChainRegistry for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ICrossChainRegistryFilterer.
+type FakeICrossChainRegistry struct {
+	*fake.Contract
+	*ICrossChainRegistryFilterer
+}
+
+// NewFakeICrossChainRegistry returns an empty fake of the contract at address.
+func NewFakeICrossChainRegistry(address common.Address) *FakeICrossChainRegistry {
+	contract := fake.NewContract(ICrossChainRegistryMetaData, address)
+	filterer, err := NewICrossChainRegistryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeICrossChainRegistry{Contract: contract, ICrossChainRegistryFilterer: filterer}
+}
+
+// CalculateOperatorTableBytes returns the values stubbed with StubCalculateOperatorTableBytes.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) CalculateOperatorTableBytes(opts *bind.CallOpts, operatorSet OperatorSet) ([]byte, error) {
+	var out0 []byte
+	err := _FakeICrossChainRegistry.Contract.Read("calculateOperatorTableBytes", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorTableBytes sets what CalculateOperatorTableBytes returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubCalculateOperatorTableBytes(operatorSet OperatorSet, out0 []byte) {
+	_FakeICrossChainRegistry.Contract.Stub("calculateOperatorTableBytes", []interface{}{operatorSet}, out0)
+}
+
+// GetActiveGenerationReservationCount returns the values stubbed with StubGetActiveGenerationReservationCount.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) GetActiveGenerationReservationCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeICrossChainRegistry.Contract.Read("getActiveGenerationReservationCount", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservationCount sets what GetActiveGenerationReservationCount returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubGetActiveGenerationReservationCount(out0 *big.Int) {
+	_FakeICrossChainRegistry.Contract.Stub("getActiveGenerationReservationCount", []interface{}{}, out0)
+}
+
+// GetActiveGenerationReservations returns the values stubbed with StubGetActiveGenerationReservations.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) GetActiveGenerationReservations(opts *bind.CallOpts) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeICrossChainRegistry.Contract.Read("getActiveGenerationReservations", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservations sets what GetActiveGenerationReservations returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubGetActiveGenerationReservations(out0 []OperatorSet) {
+	_FakeICrossChainRegistry.Contract.Stub("getActiveGenerationReservations", []interface{}{}, out0)
+}
+
+// GetActiveGenerationReservationsByRange returns the values stubbed with StubGetActiveGenerationReservationsByRange.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) GetActiveGenerationReservationsByRange(opts *bind.CallOpts, startIndex *big.Int, endIndex *big.Int) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeICrossChainRegistry.Contract.Read("getActiveGenerationReservationsByRange", []interface{}{startIndex, endIndex}, &out0)
+	return out0, err
+}
+
+// StubGetActiveGenerationReservationsByRange sets what GetActiveGenerationReservationsByRange returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubGetActiveGenerationReservationsByRange(startIndex *big.Int, endIndex *big.Int, out0 []OperatorSet) {
+	_FakeICrossChainRegistry.Contract.Stub("getActiveGenerationReservationsByRange", []interface{}{startIndex, endIndex}, out0)
+}
+
+// GetOperatorSetConfig returns the values stubbed with StubGetOperatorSetConfig.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) GetOperatorSetConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ICrossChainRegistryTypesOperatorSetConfig, error) {
+	var out0 ICrossChainRegistryTypesOperatorSetConfig
+	err := _FakeICrossChainRegistry.Contract.Read("getOperatorSetConfig", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetConfig sets what GetOperatorSetConfig returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubGetOperatorSetConfig(operatorSet OperatorSet, out0 ICrossChainRegistryTypesOperatorSetConfig) {
+	_FakeICrossChainRegistry.Contract.Stub("getOperatorSetConfig", []interface{}{operatorSet}, out0)
+}
+
+// GetOperatorTableCalculator returns the values stubbed with StubGetOperatorTableCalculator.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) GetOperatorTableCalculator(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeICrossChainRegistry.Contract.Read("getOperatorTableCalculator", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorTableCalculator sets what GetOperatorTableCalculator returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubGetOperatorTableCalculator(operatorSet OperatorSet, out0 common.Address) {
+	_FakeICrossChainRegistry.Contract.Stub("getOperatorTableCalculator", []interface{}{operatorSet}, out0)
+}
+
+// GetSupportedChains returns the values stubbed with StubGetSupportedChains.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) GetSupportedChains(opts *bind.CallOpts) ([]*big.Int, []common.Address, error) {
+	var out0 []*big.Int
+	var out1 []common.Address
+	err := _FakeICrossChainRegistry.Contract.Read("getSupportedChains", []interface{}{}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetSupportedChains sets what GetSupportedChains returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubGetSupportedChains(out0 []*big.Int, out1 []common.Address) {
+	_FakeICrossChainRegistry.Contract.Stub("getSupportedChains", []interface{}{}, out0, out1)
+}
+
+// GetTableUpdateCadence returns the values stubbed with StubGetTableUpdateCadence.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) GetTableUpdateCadence(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeICrossChainRegistry.Contract.Read("getTableUpdateCadence", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetTableUpdateCadence sets what GetTableUpdateCadence returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubGetTableUpdateCadence(out0 uint32) {
+	_FakeICrossChainRegistry.Contract.Stub("getTableUpdateCadence", []interface{}{}, out0)
+}
+
+// HasActiveGenerationReservation returns the values stubbed with StubHasActiveGenerationReservation.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) HasActiveGenerationReservation(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error) {
+	var out0 bool
+	err := _FakeICrossChainRegistry.Contract.Read("hasActiveGenerationReservation", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubHasActiveGenerationReservation sets what HasActiveGenerationReservation returns for the given arguments.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) StubHasActiveGenerationReservation(operatorSet OperatorSet, out0 bool) {
+	_FakeICrossChainRegistry.Contract.Stub("hasActiveGenerationReservation", []interface{}{operatorSet}, out0)
+}
+
+// AddChainIDsToWhitelist records a addChainIDsToWhitelist call after running the hook set with OnAddChainIDsToWhitelist.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) AddChainIDsToWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) (*types.Transaction, error) {
+	return _FakeICrossChainRegistry.Contract.Transact(opts, "addChainIDsToWhitelist", func() error {
+		if hook, ok := _FakeICrossChainRegistry.Contract.Hook("addChainIDsToWhitelist").(func(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) error); ok {
+			return hook(opts, chainIDs, operatorTableUpdaters)
+		}
+		return nil
+	}, chainIDs, operatorTableUpdaters)
+}
+
+// OnAddChainIDsToWhitelist sets a hook run by every AddChainIDsToWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) OnAddChainIDsToWhitelist(hook func(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) error) {
+	_FakeICrossChainRegistry.Contract.SetHook("addChainIDsToWhitelist", hook)
+}
+
+// CreateGenerationReservation records a createGenerationReservation call after running the hook set with OnCreateGenerationReservation.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) CreateGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeICrossChainRegistry.Contract.Transact(opts, "createGenerationReservation", func() error {
+		if hook, ok := _FakeICrossChainRegistry.Contract.Hook("createGenerationReservation").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, operatorTableCalculator, config)
+		}
+		return nil
+	}, operatorSet, operatorTableCalculator, config)
+}
+
+// OnCreateGenerationReservation sets a hook run by every CreateGenerationReservation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) OnCreateGenerationReservation(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeICrossChainRegistry.Contract.SetHook("createGenerationReservation", hook)
+}
+
+// RemoveChainIDsFromWhitelist records a removeChainIDsFromWhitelist call after running the hook set with OnRemoveChainIDsFromWhitelist.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) RemoveChainIDsFromWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int) (*types.Transaction, error) {
+	return _FakeICrossChainRegistry.Contract.Transact(opts, "removeChainIDsFromWhitelist", func() error {
+		if hook, ok := _FakeICrossChainRegistry.Contract.Hook("removeChainIDsFromWhitelist").(func(opts *bind.TransactOpts, chainIDs []*big.Int) error); ok {
+			return hook(opts, chainIDs)
+		}
+		return nil
+	}, chainIDs)
+}
+
+// OnRemoveChainIDsFromWhitelist sets a hook run by every RemoveChainIDsFromWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) OnRemoveChainIDsFromWhitelist(hook func(opts *bind.TransactOpts, chainIDs []*big.Int) error) {
+	_FakeICrossChainRegistry.Contract.SetHook("removeChainIDsFromWhitelist", hook)
+}
+
+// RemoveGenerationReservation records a removeGenerationReservation call after running the hook set with OnRemoveGenerationReservation.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) RemoveGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet) (*types.Transaction, error) {
+	return _FakeICrossChainRegistry.Contract.Transact(opts, "removeGenerationReservation", func() error {
+		if hook, ok := _FakeICrossChainRegistry.Contract.Hook("removeGenerationReservation").(func(opts *bind.TransactOpts, operatorSet OperatorSet) error); ok {
+			return hook(opts, operatorSet)
+		}
+		return nil
+	}, operatorSet)
+}
+
+// OnRemoveGenerationReservation sets a hook run by every RemoveGenerationReservation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) OnRemoveGenerationReservation(hook func(opts *bind.TransactOpts, operatorSet OperatorSet) error) {
+	_FakeICrossChainRegistry.Contract.SetHook("removeGenerationReservation", hook)
+}
+
+// SetOperatorSetConfig records a setOperatorSetConfig call after running the hook set with OnSetOperatorSetConfig.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) SetOperatorSetConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeICrossChainRegistry.Contract.Transact(opts, "setOperatorSetConfig", func() error {
+		if hook, ok := _FakeICrossChainRegistry.Contract.Hook("setOperatorSetConfig").(func(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, config)
+		}
+		return nil
+	}, operatorSet, config)
+}
+
+// OnSetOperatorSetConfig sets a hook run by every SetOperatorSetConfig call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) OnSetOperatorSetConfig(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeICrossChainRegistry.Contract.SetHook("setOperatorSetConfig", hook)
+}
+
+// SetOperatorTableCalculator records a setOperatorTableCalculator call after running the hook set with OnSetOperatorTableCalculator.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) SetOperatorTableCalculator(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) (*types.Transaction, error) {
+	return _FakeICrossChainRegistry.Contract.Transact(opts, "setOperatorTableCalculator", func() error {
+		if hook, ok := _FakeICrossChainRegistry.Contract.Hook("setOperatorTableCalculator").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) error); ok {
+			return hook(opts, operatorSet, operatorTableCalculator)
+		}
+		return nil
+	}, operatorSet, operatorTableCalculator)
+}
+
+// OnSetOperatorTableCalculator sets a hook run by every SetOperatorTableCalculator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) OnSetOperatorTableCalculator(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) error) {
+	_FakeICrossChainRegistry.Contract.SetHook("setOperatorTableCalculator", hook)
+}
+
+// SetTableUpdateCadence records a setTableUpdateCadence call after running the hook set with OnSetTableUpdateCadence.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) SetTableUpdateCadence(opts *bind.TransactOpts, tableUpdateCadence uint32) (*types.Transaction, error) {
+	return _FakeICrossChainRegistry.Contract.Transact(opts, "setTableUpdateCadence", func() error {
+		if hook, ok := _FakeICrossChainRegistry.Contract.Hook("setTableUpdateCadence").(func(opts *bind.TransactOpts, tableUpdateCadence uint32) error); ok {
+			return hook(opts, tableUpdateCadence)
+		}
+		return nil
+	}, tableUpdateCadence)
+}
+
+// OnSetTableUpdateCadence sets a hook run by every SetTableUpdateCadence call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) OnSetTableUpdateCadence(hook func(opts *bind.TransactOpts, tableUpdateCadence uint32) error) {
+	_FakeICrossChainRegistry.Contract.SetHook("setTableUpdateCadence", hook)
+}
+
+// EmitChainIDAddedToWhitelist appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitChainIDAddedToWhitelist(event *ICrossChainRegistryChainIDAddedToWhitelist) {
+	_FakeICrossChainRegistry.Contract.Emit("ChainIDAddedToWhitelist", event)
+}
+
+// EmitChainIDRemovedFromWhitelist appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitChainIDRemovedFromWhitelist(event *ICrossChainRegistryChainIDRemovedFromWhitelist) {
+	_FakeICrossChainRegistry.Contract.Emit("ChainIDRemovedFromWhitelist", event)
+}
+
+// EmitGenerationReservationCreated appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitGenerationReservationCreated(event *ICrossChainRegistryGenerationReservationCreated) {
+	_FakeICrossChainRegistry.Contract.Emit("GenerationReservationCreated", event)
+}
+
+// EmitGenerationReservationRemoved appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitGenerationReservationRemoved(event *ICrossChainRegistryGenerationReservationRemoved) {
+	_FakeICrossChainRegistry.Contract.Emit("GenerationReservationRemoved", event)
+}
+
+// EmitOperatorSetConfigRemoved appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitOperatorSetConfigRemoved(event *ICrossChainRegistryOperatorSetConfigRemoved) {
+	_FakeICrossChainRegistry.Contract.Emit("OperatorSetConfigRemoved", event)
+}
+
+// EmitOperatorSetConfigSet appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitOperatorSetConfigSet(event *ICrossChainRegistryOperatorSetConfigSet) {
+	_FakeICrossChainRegistry.Contract.Emit("OperatorSetConfigSet", event)
+}
+
+// EmitOperatorTableCalculatorRemoved appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitOperatorTableCalculatorRemoved(event *ICrossChainRegistryOperatorTableCalculatorRemoved) {
+	_FakeICrossChainRegistry.Contract.Emit("OperatorTableCalculatorRemoved", event)
+}
+
+// EmitOperatorTableCalculatorSet appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitOperatorTableCalculatorSet(event *ICrossChainRegistryOperatorTableCalculatorSet) {
+	_FakeICrossChainRegistry.Contract.Emit("OperatorTableCalculatorSet", event)
+}
+
+// EmitTableUpdateCadenceSet appends the event to the fake's logs.
+func (_FakeICrossChainRegistry *FakeICrossChainRegistry) EmitTableUpdateCadenceSet(event *ICrossChainRegistryTableUpdateCadenceSet) {
+	_FakeICrossChainRegistry.Contract.Emit("TableUpdateCadenceSet", event)
+}
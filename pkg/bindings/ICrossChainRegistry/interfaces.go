@@ -0,0 +1,81 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ICrossChainRegistry
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// ICrossChainRegistryReader is the read-only surface of the ICrossChainRegistry
+// binding, implemented by ICrossChainRegistryCaller, ICrossChainRegistry and FakeICrossChainRegistry.
+type ICrossChainRegistryReader interface {
+	CalculateOperatorTableBytes(opts *bind.CallOpts, operatorSet OperatorSet) ([]byte, error)
+	GetActiveGenerationReservationCount(opts *bind.CallOpts) (*big.Int, error)
+	GetActiveGenerationReservations(opts *bind.CallOpts) ([]OperatorSet, error)
+	GetActiveGenerationReservationsByRange(opts *bind.CallOpts, startIndex *big.Int, endIndex *big.Int) ([]OperatorSet, error)
+	GetOperatorSetConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ICrossChainRegistryTypesOperatorSetConfig, error)
+	GetOperatorTableCalculator(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetSupportedChains(opts *bind.CallOpts) ([]*big.Int, []common.Address, error)
+	GetTableUpdateCadence(opts *bind.CallOpts) (uint32, error)
+	HasActiveGenerationReservation(opts *bind.CallOpts, operatorSet OperatorSet) (bool, error)
+}
+
+// ICrossChainRegistryWriter is the transacting surface of the ICrossChainRegistry
+// binding, implemented by ICrossChainRegistryTransactor, ICrossChainRegistry and FakeICrossChainRegistry.
+type ICrossChainRegistryWriter interface {
+	AddChainIDsToWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int, operatorTableUpdaters []common.Address) (*types.Transaction, error)
+	CreateGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	RemoveChainIDsFromWhitelist(opts *bind.TransactOpts, chainIDs []*big.Int) (*types.Transaction, error)
+	RemoveGenerationReservation(opts *bind.TransactOpts, operatorSet OperatorSet) (*types.Transaction, error)
+	SetOperatorSetConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+	SetOperatorTableCalculator(opts *bind.TransactOpts, operatorSet OperatorSet, operatorTableCalculator common.Address) (*types.Transaction, error)
+	SetTableUpdateCadence(opts *bind.TransactOpts, tableUpdateCadence uint32) (*types.Transaction, error)
+}
+
+// ICrossChainRegistryEvents is the event filtering surface of the ICrossChainRegistry
+// binding, implemented by ICrossChainRegistryFilterer, ICrossChainRegistry and FakeICrossChainRegistry.
+type ICrossChainRegistryEvents interface {
+	FilterChainIDAddedToWhitelist(opts *bind.FilterOpts) (*ICrossChainRegistryChainIDAddedToWhitelistIterator, error)
+	WatchChainIDAddedToWhitelist(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryChainIDAddedToWhitelist) (event.Subscription, error)
+	ParseChainIDAddedToWhitelist(log types.Log) (*ICrossChainRegistryChainIDAddedToWhitelist, error)
+	FilterChainIDRemovedFromWhitelist(opts *bind.FilterOpts) (*ICrossChainRegistryChainIDRemovedFromWhitelistIterator, error)
+	WatchChainIDRemovedFromWhitelist(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryChainIDRemovedFromWhitelist) (event.Subscription, error)
+	ParseChainIDRemovedFromWhitelist(log types.Log) (*ICrossChainRegistryChainIDRemovedFromWhitelist, error)
+	FilterGenerationReservationCreated(opts *bind.FilterOpts) (*ICrossChainRegistryGenerationReservationCreatedIterator, error)
+	WatchGenerationReservationCreated(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryGenerationReservationCreated) (event.Subscription, error)
+	ParseGenerationReservationCreated(log types.Log) (*ICrossChainRegistryGenerationReservationCreated, error)
+	FilterGenerationReservationRemoved(opts *bind.FilterOpts) (*ICrossChainRegistryGenerationReservationRemovedIterator, error)
+	WatchGenerationReservationRemoved(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryGenerationReservationRemoved) (event.Subscription, error)
+	ParseGenerationReservationRemoved(log types.Log) (*ICrossChainRegistryGenerationReservationRemoved, error)
+	FilterOperatorSetConfigRemoved(opts *bind.FilterOpts) (*ICrossChainRegistryOperatorSetConfigRemovedIterator, error)
+	WatchOperatorSetConfigRemoved(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryOperatorSetConfigRemoved) (event.Subscription, error)
+	ParseOperatorSetConfigRemoved(log types.Log) (*ICrossChainRegistryOperatorSetConfigRemoved, error)
+	FilterOperatorSetConfigSet(opts *bind.FilterOpts) (*ICrossChainRegistryOperatorSetConfigSetIterator, error)
+	WatchOperatorSetConfigSet(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryOperatorSetConfigSet) (event.Subscription, error)
+	ParseOperatorSetConfigSet(log types.Log) (*ICrossChainRegistryOperatorSetConfigSet, error)
+	FilterOperatorTableCalculatorRemoved(opts *bind.FilterOpts) (*ICrossChainRegistryOperatorTableCalculatorRemovedIterator, error)
+	WatchOperatorTableCalculatorRemoved(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryOperatorTableCalculatorRemoved) (event.Subscription, error)
+	ParseOperatorTableCalculatorRemoved(log types.Log) (*ICrossChainRegistryOperatorTableCalculatorRemoved, error)
+	FilterOperatorTableCalculatorSet(opts *bind.FilterOpts) (*ICrossChainRegistryOperatorTableCalculatorSetIterator, error)
+	WatchOperatorTableCalculatorSet(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryOperatorTableCalculatorSet) (event.Subscription, error)
+	ParseOperatorTableCalculatorSet(log types.Log) (*ICrossChainRegistryOperatorTableCalculatorSet, error)
+	FilterTableUpdateCadenceSet(opts *bind.FilterOpts) (*ICrossChainRegistryTableUpdateCadenceSetIterator, error)
+	WatchTableUpdateCadenceSet(opts *bind.WatchOpts, sink chan<- *ICrossChainRegistryTableUpdateCadenceSet) (event.Subscription, error)
+	ParseTableUpdateCadenceSet(log types.Log) (*ICrossChainRegistryTableUpdateCadenceSet, error)
+}
+
+var (
+	_ ICrossChainRegistryReader = (*ICrossChainRegistryCaller)(nil)
+	_ ICrossChainRegistryReader = (*ICrossChainRegistry)(nil)
+	_ ICrossChainRegistryReader = (*FakeICrossChainRegistry)(nil)
+	_ ICrossChainRegistryWriter = (*ICrossChainRegistryTransactor)(nil)
+	_ ICrossChainRegistryWriter = (*ICrossChainRegistry)(nil)
+	_ ICrossChainRegistryWriter = (*FakeICrossChainRegistry)(nil)
+	_ ICrossChainRegistryEvents = (*ICrossChainRegistryFilterer)(nil)
+	_ ICrossChainRegistryEvents = (*ICrossChainRegistry)(nil)
+	_ ICrossChainRegistryEvents = (*FakeICrossChainRegistry)(nil)
+)
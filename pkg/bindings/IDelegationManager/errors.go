@@ -0,0 +1,160 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IDelegationManager
+
+// ErrActivelyDelegated is the typed form of the IDelegationManager custom error ActivelyDelegated().
+type ErrActivelyDelegated struct{}
+
+// Error implements the error interface.
+func (ErrActivelyDelegated) Error() string {
+	return "IDelegationManager: ActivelyDelegated()"
+}
+
+// ErrCallerCannotUndelegate is the typed form of the IDelegationManager custom error CallerCannotUndelegate().
+type ErrCallerCannotUndelegate struct{}
+
+// Error implements the error interface.
+func (ErrCallerCannotUndelegate) Error() string {
+	return "IDelegationManager: CallerCannotUndelegate()"
+}
+
+// ErrFullySlashed is the typed form of the IDelegationManager custom error FullySlashed().
+type ErrFullySlashed struct{}
+
+// Error implements the error interface.
+func (ErrFullySlashed) Error() string {
+	return "IDelegationManager: FullySlashed()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the IDelegationManager custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "IDelegationManager: InputArrayLengthMismatch()"
+}
+
+// ErrInputArrayLengthZero is the typed form of the IDelegationManager custom error InputArrayLengthZero().
+type ErrInputArrayLengthZero struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthZero) Error() string {
+	return "IDelegationManager: InputArrayLengthZero()"
+}
+
+// ErrInvalidSignature is the typed form of the IDelegationManager custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "IDelegationManager: InvalidSignature()"
+}
+
+// ErrNotActivelyDelegated is the typed form of the IDelegationManager custom error NotActivelyDelegated().
+type ErrNotActivelyDelegated struct{}
+
+// Error implements the error interface.
+func (ErrNotActivelyDelegated) Error() string {
+	return "IDelegationManager: NotActivelyDelegated()"
+}
+
+// ErrOnlyAllocationManager is the typed form of the IDelegationManager custom error OnlyAllocationManager().
+type ErrOnlyAllocationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyAllocationManager) Error() string {
+	return "IDelegationManager: OnlyAllocationManager()"
+}
+
+// ErrOnlyEigenPodManager is the typed form of the IDelegationManager custom error OnlyEigenPodManager().
+type ErrOnlyEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodManager) Error() string {
+	return "IDelegationManager: OnlyEigenPodManager()"
+}
+
+// ErrOnlyStrategyManagerOrEigenPodManager is the typed form of the IDelegationManager custom error OnlyStrategyManagerOrEigenPodManager().
+type ErrOnlyStrategyManagerOrEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyManagerOrEigenPodManager) Error() string {
+	return "IDelegationManager: OnlyStrategyManagerOrEigenPodManager()"
+}
+
+// ErrOperatorNotRegistered is the typed form of the IDelegationManager custom error OperatorNotRegistered().
+type ErrOperatorNotRegistered struct{}
+
+// Error implements the error interface.
+func (ErrOperatorNotRegistered) Error() string {
+	return "IDelegationManager: OperatorNotRegistered()"
+}
+
+// ErrOperatorsCannotUndelegate is the typed form of the IDelegationManager custom error OperatorsCannotUndelegate().
+type ErrOperatorsCannotUndelegate struct{}
+
+// Error implements the error interface.
+func (ErrOperatorsCannotUndelegate) Error() string {
+	return "IDelegationManager: OperatorsCannotUndelegate()"
+}
+
+// ErrSaltSpent is the typed form of the IDelegationManager custom error SaltSpent().
+type ErrSaltSpent struct{}
+
+// Error implements the error interface.
+func (ErrSaltSpent) Error() string {
+	return "IDelegationManager: SaltSpent()"
+}
+
+// ErrSignatureExpired is the typed form of the IDelegationManager custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "IDelegationManager: SignatureExpired()"
+}
+
+// ErrWithdrawalDelayNotElapsed is the typed form of the IDelegationManager custom error WithdrawalDelayNotElapsed().
+type ErrWithdrawalDelayNotElapsed struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalDelayNotElapsed) Error() string {
+	return "IDelegationManager: WithdrawalDelayNotElapsed()"
+}
+
+// ErrWithdrawalNotQueued is the typed form of the IDelegationManager custom error WithdrawalNotQueued().
+type ErrWithdrawalNotQueued struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalNotQueued) Error() string {
+	return "IDelegationManager: WithdrawalNotQueued()"
+}
+
+// ErrWithdrawerNotCaller is the typed form of the IDelegationManager custom error WithdrawerNotCaller().
+type ErrWithdrawerNotCaller struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawerNotCaller) Error() string {
+	return "IDelegationManager: WithdrawerNotCaller()"
+}
+
+// IDelegationManagerErrors maps the name of every custom error in the IDelegationManager ABI to its typed Go error.
+var IDelegationManagerErrors = map[string]error{
+	"ActivelyDelegated":                    ErrActivelyDelegated{},
+	"CallerCannotUndelegate":               ErrCallerCannotUndelegate{},
+	"FullySlashed":                         ErrFullySlashed{},
+	"InputArrayLengthMismatch":             ErrInputArrayLengthMismatch{},
+	"InputArrayLengthZero":                 ErrInputArrayLengthZero{},
+	"InvalidSignature":                     ErrInvalidSignature{},
+	"NotActivelyDelegated":                 ErrNotActivelyDelegated{},
+	"OnlyAllocationManager":                ErrOnlyAllocationManager{},
+	"OnlyEigenPodManager":                  ErrOnlyEigenPodManager{},
+	"OnlyStrategyManagerOrEigenPodManager": ErrOnlyStrategyManagerOrEigenPodManager{},
+	"OperatorNotRegistered":                ErrOperatorNotRegistered{},
+	"OperatorsCannotUndelegate":            ErrOperatorsCannotUndelegate{},
+	"SaltSpent":                            ErrSaltSpent{},
+	"SignatureExpired":                     ErrSignatureExpired{},
+	"WithdrawalDelayNotElapsed":            ErrWithdrawalDelayNotElapsed{},
+	"WithdrawalNotQueued":                  ErrWithdrawalNotQueued{},
+	"WithdrawerNotCaller":                  ErrWithdrawerNotCaller{},
+}
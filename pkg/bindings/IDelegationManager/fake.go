@@ -0,0 +1,602 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IDelegationManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIDelegationManager is an in-memory IDelegationManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IDelegationManagerFilterer.
+type FakeIDelegationManager struct {
+	*fake.Contract
+	*IDelegationManagerFilterer
+}
+
+// NewFakeIDelegationManager returns an empty fake of the contract at address.
+func NewFakeIDelegationManager(address common.Address) *FakeIDelegationManager {
+	contract := fake.NewContract(IDelegationManagerMetaData, address)
+	filterer, err := NewIDelegationManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIDelegationManager{Contract: contract, IDelegationManagerFilterer: filterer}
+}
+
+// DELEGATIONAPPROVALTYPEHASH returns the values stubbed with StubDELEGATIONAPPROVALTYPEHASH.
+func (_FakeIDelegationManager *FakeIDelegationManager) DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIDelegationManager.Contract.Read("DELEGATION_APPROVAL_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDELEGATIONAPPROVALTYPEHASH sets what DELEGATIONAPPROVALTYPEHASH returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubDELEGATIONAPPROVALTYPEHASH(out0 [32]byte) {
+	_FakeIDelegationManager.Contract.Stub("DELEGATION_APPROVAL_TYPEHASH", []interface{}{}, out0)
+}
+
+// BeaconChainETHStrategy returns the values stubbed with StubBeaconChainETHStrategy.
+func (_FakeIDelegationManager *FakeIDelegationManager) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIDelegationManager.Contract.Read("beaconChainETHStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainETHStrategy sets what BeaconChainETHStrategy returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubBeaconChainETHStrategy(out0 common.Address) {
+	_FakeIDelegationManager.Contract.Stub("beaconChainETHStrategy", []interface{}{}, out0)
+}
+
+// CalculateDelegationApprovalDigestHash returns the values stubbed with StubCalculateDelegationApprovalDigestHash.
+func (_FakeIDelegationManager *FakeIDelegationManager) CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIDelegationManager.Contract.Read("calculateDelegationApprovalDigestHash", []interface{}{staker, operator, _delegationApprover, approverSalt, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateDelegationApprovalDigestHash sets what CalculateDelegationApprovalDigestHash returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubCalculateDelegationApprovalDigestHash(staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int, out0 [32]byte) {
+	_FakeIDelegationManager.Contract.Stub("calculateDelegationApprovalDigestHash", []interface{}{staker, operator, _delegationApprover, approverSalt, expiry}, out0)
+}
+
+// CalculateWithdrawalRoot returns the values stubbed with StubCalculateWithdrawalRoot.
+func (_FakeIDelegationManager *FakeIDelegationManager) CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerTypesWithdrawal) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIDelegationManager.Contract.Read("calculateWithdrawalRoot", []interface{}{withdrawal}, &out0)
+	return out0, err
+}
+
+// StubCalculateWithdrawalRoot sets what CalculateWithdrawalRoot returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubCalculateWithdrawalRoot(withdrawal IDelegationManagerTypesWithdrawal, out0 [32]byte) {
+	_FakeIDelegationManager.Contract.Stub("calculateWithdrawalRoot", []interface{}{withdrawal}, out0)
+}
+
+// ConvertToDepositShares returns the values stubbed with StubConvertToDepositShares.
+func (_FakeIDelegationManager *FakeIDelegationManager) ConvertToDepositShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address, withdrawableShares []*big.Int) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeIDelegationManager.Contract.Read("convertToDepositShares", []interface{}{staker, strategies, withdrawableShares}, &out0)
+	return out0, err
+}
+
+// StubConvertToDepositShares sets what ConvertToDepositShares returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubConvertToDepositShares(staker common.Address, strategies []common.Address, withdrawableShares []*big.Int, out0 []*big.Int) {
+	_FakeIDelegationManager.Contract.Stub("convertToDepositShares", []interface{}{staker, strategies, withdrawableShares}, out0)
+}
+
+// CumulativeWithdrawalsQueued returns the values stubbed with StubCumulativeWithdrawalsQueued.
+func (_FakeIDelegationManager *FakeIDelegationManager) CumulativeWithdrawalsQueued(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIDelegationManager.Contract.Read("cumulativeWithdrawalsQueued", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubCumulativeWithdrawalsQueued sets what CumulativeWithdrawalsQueued returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubCumulativeWithdrawalsQueued(staker common.Address, out0 *big.Int) {
+	_FakeIDelegationManager.Contract.Stub("cumulativeWithdrawalsQueued", []interface{}{staker}, out0)
+}
+
+// DelegatedTo returns the values stubbed with StubDelegatedTo.
+func (_FakeIDelegationManager *FakeIDelegationManager) DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIDelegationManager.Contract.Read("delegatedTo", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubDelegatedTo sets what DelegatedTo returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubDelegatedTo(staker common.Address, out0 common.Address) {
+	_FakeIDelegationManager.Contract.Stub("delegatedTo", []interface{}{staker}, out0)
+}
+
+// DelegationApprover returns the values stubbed with StubDelegationApprover.
+func (_FakeIDelegationManager *FakeIDelegationManager) DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIDelegationManager.Contract.Read("delegationApprover", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubDelegationApprover sets what DelegationApprover returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubDelegationApprover(operator common.Address, out0 common.Address) {
+	_FakeIDelegationManager.Contract.Stub("delegationApprover", []interface{}{operator}, out0)
+}
+
+// DelegationApproverSaltIsSpent returns the values stubbed with StubDelegationApproverSaltIsSpent.
+func (_FakeIDelegationManager *FakeIDelegationManager) DelegationApproverSaltIsSpent(opts *bind.CallOpts, _delegationApprover common.Address, salt [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeIDelegationManager.Contract.Read("delegationApproverSaltIsSpent", []interface{}{_delegationApprover, salt}, &out0)
+	return out0, err
+}
+
+// StubDelegationApproverSaltIsSpent sets what DelegationApproverSaltIsSpent returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubDelegationApproverSaltIsSpent(_delegationApprover common.Address, salt [32]byte, out0 bool) {
+	_FakeIDelegationManager.Contract.Stub("delegationApproverSaltIsSpent", []interface{}{_delegationApprover, salt}, out0)
+}
+
+// DepositScalingFactor returns the values stubbed with StubDepositScalingFactor.
+func (_FakeIDelegationManager *FakeIDelegationManager) DepositScalingFactor(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIDelegationManager.Contract.Read("depositScalingFactor", []interface{}{staker, strategy}, &out0)
+	return out0, err
+}
+
+// StubDepositScalingFactor sets what DepositScalingFactor returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubDepositScalingFactor(staker common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeIDelegationManager.Contract.Stub("depositScalingFactor", []interface{}{staker, strategy}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeIDelegationManager *FakeIDelegationManager) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIDelegationManager.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubDomainSeparator(out0 [32]byte) {
+	_FakeIDelegationManager.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// GetDepositedShares returns the values stubbed with StubGetDepositedShares.
+func (_FakeIDelegationManager *FakeIDelegationManager) GetDepositedShares(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeIDelegationManager.Contract.Read("getDepositedShares", []interface{}{staker}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetDepositedShares sets what GetDepositedShares returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubGetDepositedShares(staker common.Address, out0 []common.Address, out1 []*big.Int) {
+	_FakeIDelegationManager.Contract.Stub("getDepositedShares", []interface{}{staker}, out0, out1)
+}
+
+// GetOperatorShares returns the values stubbed with StubGetOperatorShares.
+func (_FakeIDelegationManager *FakeIDelegationManager) GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeIDelegationManager.Contract.Read("getOperatorShares", []interface{}{operator, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorShares sets what GetOperatorShares returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubGetOperatorShares(operator common.Address, strategies []common.Address, out0 []*big.Int) {
+	_FakeIDelegationManager.Contract.Stub("getOperatorShares", []interface{}{operator, strategies}, out0)
+}
+
+// GetOperatorsShares returns the values stubbed with StubGetOperatorsShares.
+func (_FakeIDelegationManager *FakeIDelegationManager) GetOperatorsShares(opts *bind.CallOpts, operators []common.Address, strategies []common.Address) ([][]*big.Int, error) {
+	var out0 [][]*big.Int
+	err := _FakeIDelegationManager.Contract.Read("getOperatorsShares", []interface{}{operators, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorsShares sets what GetOperatorsShares returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubGetOperatorsShares(operators []common.Address, strategies []common.Address, out0 [][]*big.Int) {
+	_FakeIDelegationManager.Contract.Stub("getOperatorsShares", []interface{}{operators, strategies}, out0)
+}
+
+// GetQueuedWithdrawal returns the values stubbed with StubGetQueuedWithdrawal.
+func (_FakeIDelegationManager *FakeIDelegationManager) GetQueuedWithdrawal(opts *bind.CallOpts, withdrawalRoot [32]byte) (struct {
+	Withdrawal IDelegationManagerTypesWithdrawal
+	Shares     []*big.Int
+}, error) {
+	var out0 struct {
+		Withdrawal IDelegationManagerTypesWithdrawal
+		Shares     []*big.Int
+	}
+	err := _FakeIDelegationManager.Contract.Read("getQueuedWithdrawal", []interface{}{withdrawalRoot}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawal sets what GetQueuedWithdrawal returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubGetQueuedWithdrawal(withdrawalRoot [32]byte, out0 struct {
+	Withdrawal IDelegationManagerTypesWithdrawal
+	Shares     []*big.Int
+}) {
+	_FakeIDelegationManager.Contract.Stub("getQueuedWithdrawal", []interface{}{withdrawalRoot}, out0)
+}
+
+// GetQueuedWithdrawalRoots returns the values stubbed with StubGetQueuedWithdrawalRoots.
+func (_FakeIDelegationManager *FakeIDelegationManager) GetQueuedWithdrawalRoots(opts *bind.CallOpts, staker common.Address) ([][32]byte, error) {
+	var out0 [][32]byte
+	err := _FakeIDelegationManager.Contract.Read("getQueuedWithdrawalRoots", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawalRoots sets what GetQueuedWithdrawalRoots returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubGetQueuedWithdrawalRoots(staker common.Address, out0 [][32]byte) {
+	_FakeIDelegationManager.Contract.Stub("getQueuedWithdrawalRoots", []interface{}{staker}, out0)
+}
+
+// GetQueuedWithdrawals returns the values stubbed with StubGetQueuedWithdrawals.
+func (_FakeIDelegationManager *FakeIDelegationManager) GetQueuedWithdrawals(opts *bind.CallOpts, staker common.Address) (struct {
+	Withdrawals []IDelegationManagerTypesWithdrawal
+	Shares      [][]*big.Int
+}, error) {
+	var out0 struct {
+		Withdrawals []IDelegationManagerTypesWithdrawal
+		Shares      [][]*big.Int
+	}
+	err := _FakeIDelegationManager.Contract.Read("getQueuedWithdrawals", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetQueuedWithdrawals sets what GetQueuedWithdrawals returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubGetQueuedWithdrawals(staker common.Address, out0 struct {
+	Withdrawals []IDelegationManagerTypesWithdrawal
+	Shares      [][]*big.Int
+}) {
+	_FakeIDelegationManager.Contract.Stub("getQueuedWithdrawals", []interface{}{staker}, out0)
+}
+
+// GetSlashableSharesInQueue returns the values stubbed with StubGetSlashableSharesInQueue.
+func (_FakeIDelegationManager *FakeIDelegationManager) GetSlashableSharesInQueue(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIDelegationManager.Contract.Read("getSlashableSharesInQueue", []interface{}{operator, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetSlashableSharesInQueue sets what GetSlashableSharesInQueue returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubGetSlashableSharesInQueue(operator common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeIDelegationManager.Contract.Stub("getSlashableSharesInQueue", []interface{}{operator, strategy}, out0)
+}
+
+// GetWithdrawableShares returns the values stubbed with StubGetWithdrawableShares.
+func (_FakeIDelegationManager *FakeIDelegationManager) GetWithdrawableShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address) (struct {
+	WithdrawableShares []*big.Int
+	DepositShares      []*big.Int
+}, error) {
+	var out0 struct {
+		WithdrawableShares []*big.Int
+		DepositShares      []*big.Int
+	}
+	err := _FakeIDelegationManager.Contract.Read("getWithdrawableShares", []interface{}{staker, strategies}, &out0)
+	return out0, err
+}
+
+// StubGetWithdrawableShares sets what GetWithdrawableShares returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubGetWithdrawableShares(staker common.Address, strategies []common.Address, out0 struct {
+	WithdrawableShares []*big.Int
+	DepositShares      []*big.Int
+}) {
+	_FakeIDelegationManager.Contract.Stub("getWithdrawableShares", []interface{}{staker, strategies}, out0)
+}
+
+// IsDelegated returns the values stubbed with StubIsDelegated.
+func (_FakeIDelegationManager *FakeIDelegationManager) IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIDelegationManager.Contract.Read("isDelegated", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubIsDelegated sets what IsDelegated returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubIsDelegated(staker common.Address, out0 bool) {
+	_FakeIDelegationManager.Contract.Stub("isDelegated", []interface{}{staker}, out0)
+}
+
+// IsOperator returns the values stubbed with StubIsOperator.
+func (_FakeIDelegationManager *FakeIDelegationManager) IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIDelegationManager.Contract.Read("isOperator", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubIsOperator sets what IsOperator returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubIsOperator(operator common.Address, out0 bool) {
+	_FakeIDelegationManager.Contract.Stub("isOperator", []interface{}{operator}, out0)
+}
+
+// MinWithdrawalDelayBlocks returns the values stubbed with StubMinWithdrawalDelayBlocks.
+func (_FakeIDelegationManager *FakeIDelegationManager) MinWithdrawalDelayBlocks(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIDelegationManager.Contract.Read("minWithdrawalDelayBlocks", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMinWithdrawalDelayBlocks sets what MinWithdrawalDelayBlocks returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubMinWithdrawalDelayBlocks(out0 uint32) {
+	_FakeIDelegationManager.Contract.Stub("minWithdrawalDelayBlocks", []interface{}{}, out0)
+}
+
+// QueuedWithdrawals returns the values stubbed with StubQueuedWithdrawals.
+func (_FakeIDelegationManager *FakeIDelegationManager) QueuedWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (IDelegationManagerTypesWithdrawal, error) {
+	var out0 IDelegationManagerTypesWithdrawal
+	err := _FakeIDelegationManager.Contract.Read("queuedWithdrawals", []interface{}{withdrawalRoot}, &out0)
+	return out0, err
+}
+
+// StubQueuedWithdrawals sets what QueuedWithdrawals returns for the given arguments.
+func (_FakeIDelegationManager *FakeIDelegationManager) StubQueuedWithdrawals(withdrawalRoot [32]byte, out0 IDelegationManagerTypesWithdrawal) {
+	_FakeIDelegationManager.Contract.Stub("queuedWithdrawals", []interface{}{withdrawalRoot}, out0)
+}
+
+// CompleteQueuedWithdrawal records a completeQueuedWithdrawal call after running the hook set with OnCompleteQueuedWithdrawal.
+func (_FakeIDelegationManager *FakeIDelegationManager) CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "completeQueuedWithdrawal", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("completeQueuedWithdrawal").(func(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) error); ok {
+			return hook(opts, withdrawal, tokens, receiveAsTokens)
+		}
+		return nil
+	}, withdrawal, tokens, receiveAsTokens)
+}
+
+// OnCompleteQueuedWithdrawal sets a hook run by every CompleteQueuedWithdrawal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnCompleteQueuedWithdrawal(hook func(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) error) {
+	_FakeIDelegationManager.Contract.SetHook("completeQueuedWithdrawal", hook)
+}
+
+// CompleteQueuedWithdrawals records a completeQueuedWithdrawals call after running the hook set with OnCompleteQueuedWithdrawals.
+func (_FakeIDelegationManager *FakeIDelegationManager) CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "completeQueuedWithdrawals", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("completeQueuedWithdrawals").(func(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) error); ok {
+			return hook(opts, withdrawals, tokens, receiveAsTokens)
+		}
+		return nil
+	}, withdrawals, tokens, receiveAsTokens)
+}
+
+// OnCompleteQueuedWithdrawals sets a hook run by every CompleteQueuedWithdrawals call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnCompleteQueuedWithdrawals(hook func(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) error) {
+	_FakeIDelegationManager.Contract.SetHook("completeQueuedWithdrawals", hook)
+}
+
+// DecreaseDelegatedShares records a decreaseDelegatedShares call after running the hook set with OnDecreaseDelegatedShares.
+func (_FakeIDelegationManager *FakeIDelegationManager) DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "decreaseDelegatedShares", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("decreaseDelegatedShares").(func(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) error); ok {
+			return hook(opts, staker, curDepositShares, beaconChainSlashingFactorDecrease)
+		}
+		return nil
+	}, staker, curDepositShares, beaconChainSlashingFactorDecrease)
+}
+
+// OnDecreaseDelegatedShares sets a hook run by every DecreaseDelegatedShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnDecreaseDelegatedShares(hook func(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) error) {
+	_FakeIDelegationManager.Contract.SetHook("decreaseDelegatedShares", hook)
+}
+
+// DelegateTo records a delegateTo call after running the hook set with OnDelegateTo.
+func (_FakeIDelegationManager *FakeIDelegationManager) DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "delegateTo", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("delegateTo").(func(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error); ok {
+			return hook(opts, operator, approverSignatureAndExpiry, approverSalt)
+		}
+		return nil
+	}, operator, approverSignatureAndExpiry, approverSalt)
+}
+
+// OnDelegateTo sets a hook run by every DelegateTo call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnDelegateTo(hook func(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error) {
+	_FakeIDelegationManager.Contract.SetHook("delegateTo", hook)
+}
+
+// IncreaseDelegatedShares records a increaseDelegatedShares call after running the hook set with OnIncreaseDelegatedShares.
+func (_FakeIDelegationManager *FakeIDelegationManager) IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "increaseDelegatedShares", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("increaseDelegatedShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) error); ok {
+			return hook(opts, staker, strategy, prevDepositShares, addedShares)
+		}
+		return nil
+	}, staker, strategy, prevDepositShares, addedShares)
+}
+
+// OnIncreaseDelegatedShares sets a hook run by every IncreaseDelegatedShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnIncreaseDelegatedShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) error) {
+	_FakeIDelegationManager.Contract.SetHook("increaseDelegatedShares", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeIDelegationManager *FakeIDelegationManager) Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialPausedStatus)
+		}
+		return nil
+	}, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnInitialize(hook func(opts *bind.TransactOpts, initialPausedStatus *big.Int) error) {
+	_FakeIDelegationManager.Contract.SetHook("initialize", hook)
+}
+
+// ModifyOperatorDetails records a modifyOperatorDetails call after running the hook set with OnModifyOperatorDetails.
+func (_FakeIDelegationManager *FakeIDelegationManager) ModifyOperatorDetails(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "modifyOperatorDetails", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("modifyOperatorDetails").(func(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) error); ok {
+			return hook(opts, operator, newDelegationApprover)
+		}
+		return nil
+	}, operator, newDelegationApprover)
+}
+
+// OnModifyOperatorDetails sets a hook run by every ModifyOperatorDetails call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnModifyOperatorDetails(hook func(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) error) {
+	_FakeIDelegationManager.Contract.SetHook("modifyOperatorDetails", hook)
+}
+
+// QueueWithdrawals records a queueWithdrawals call after running the hook set with OnQueueWithdrawals.
+func (_FakeIDelegationManager *FakeIDelegationManager) QueueWithdrawals(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "queueWithdrawals", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("queueWithdrawals").(func(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) error); ok {
+			return hook(opts, params)
+		}
+		return nil
+	}, params)
+}
+
+// OnQueueWithdrawals sets a hook run by every QueueWithdrawals call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnQueueWithdrawals(hook func(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) error) {
+	_FakeIDelegationManager.Contract.SetHook("queueWithdrawals", hook)
+}
+
+// Redelegate records a redelegate call after running the hook set with OnRedelegate.
+func (_FakeIDelegationManager *FakeIDelegationManager) Redelegate(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "redelegate", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("redelegate").(func(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error); ok {
+			return hook(opts, newOperator, newOperatorApproverSig, approverSalt)
+		}
+		return nil
+	}, newOperator, newOperatorApproverSig, approverSalt)
+}
+
+// OnRedelegate sets a hook run by every Redelegate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnRedelegate(hook func(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) error) {
+	_FakeIDelegationManager.Contract.SetHook("redelegate", hook)
+}
+
+// RegisterAsOperator records a registerAsOperator call after running the hook set with OnRegisterAsOperator.
+func (_FakeIDelegationManager *FakeIDelegationManager) RegisterAsOperator(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "registerAsOperator", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("registerAsOperator").(func(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) error); ok {
+			return hook(opts, initDelegationApprover, allocationDelay, metadataURI)
+		}
+		return nil
+	}, initDelegationApprover, allocationDelay, metadataURI)
+}
+
+// OnRegisterAsOperator sets a hook run by every RegisterAsOperator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnRegisterAsOperator(hook func(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) error) {
+	_FakeIDelegationManager.Contract.SetHook("registerAsOperator", hook)
+}
+
+// SlashOperatorShares records a slashOperatorShares call after running the hook set with OnSlashOperatorShares.
+func (_FakeIDelegationManager *FakeIDelegationManager) SlashOperatorShares(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "slashOperatorShares", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("slashOperatorShares").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) error); ok {
+			return hook(opts, operator, operatorSet, slashId, strategy, prevMaxMagnitude, newMaxMagnitude)
+		}
+		return nil
+	}, operator, operatorSet, slashId, strategy, prevMaxMagnitude, newMaxMagnitude)
+}
+
+// OnSlashOperatorShares sets a hook run by every SlashOperatorShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnSlashOperatorShares(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) error) {
+	_FakeIDelegationManager.Contract.SetHook("slashOperatorShares", hook)
+}
+
+// Undelegate records a undelegate call after running the hook set with OnUndelegate.
+func (_FakeIDelegationManager *FakeIDelegationManager) Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "undelegate", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("undelegate").(func(opts *bind.TransactOpts, staker common.Address) error); ok {
+			return hook(opts, staker)
+		}
+		return nil
+	}, staker)
+}
+
+// OnUndelegate sets a hook run by every Undelegate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnUndelegate(hook func(opts *bind.TransactOpts, staker common.Address) error) {
+	_FakeIDelegationManager.Contract.SetHook("undelegate", hook)
+}
+
+// UpdateOperatorMetadataURI records a updateOperatorMetadataURI call after running the hook set with OnUpdateOperatorMetadataURI.
+func (_FakeIDelegationManager *FakeIDelegationManager) UpdateOperatorMetadataURI(opts *bind.TransactOpts, operator common.Address, metadataURI string) (*types.Transaction, error) {
+	return _FakeIDelegationManager.Contract.Transact(opts, "updateOperatorMetadataURI", func() error {
+		if hook, ok := _FakeIDelegationManager.Contract.Hook("updateOperatorMetadataURI").(func(opts *bind.TransactOpts, operator common.Address, metadataURI string) error); ok {
+			return hook(opts, operator, metadataURI)
+		}
+		return nil
+	}, operator, metadataURI)
+}
+
+// OnUpdateOperatorMetadataURI sets a hook run by every UpdateOperatorMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIDelegationManager *FakeIDelegationManager) OnUpdateOperatorMetadataURI(hook func(opts *bind.TransactOpts, operator common.Address, metadataURI string) error) {
+	_FakeIDelegationManager.Contract.SetHook("updateOperatorMetadataURI", hook)
+}
+
+// EmitDelegationApproverUpdated appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitDelegationApproverUpdated(event *IDelegationManagerDelegationApproverUpdated) {
+	_FakeIDelegationManager.Contract.Emit("DelegationApproverUpdated", event)
+}
+
+// EmitDepositScalingFactorUpdated appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitDepositScalingFactorUpdated(event *IDelegationManagerDepositScalingFactorUpdated) {
+	_FakeIDelegationManager.Contract.Emit("DepositScalingFactorUpdated", event)
+}
+
+// EmitOperatorMetadataURIUpdated appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitOperatorMetadataURIUpdated(event *IDelegationManagerOperatorMetadataURIUpdated) {
+	_FakeIDelegationManager.Contract.Emit("OperatorMetadataURIUpdated", event)
+}
+
+// EmitOperatorRegistered appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitOperatorRegistered(event *IDelegationManagerOperatorRegistered) {
+	_FakeIDelegationManager.Contract.Emit("OperatorRegistered", event)
+}
+
+// EmitOperatorSharesDecreased appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitOperatorSharesDecreased(event *IDelegationManagerOperatorSharesDecreased) {
+	_FakeIDelegationManager.Contract.Emit("OperatorSharesDecreased", event)
+}
+
+// EmitOperatorSharesIncreased appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitOperatorSharesIncreased(event *IDelegationManagerOperatorSharesIncreased) {
+	_FakeIDelegationManager.Contract.Emit("OperatorSharesIncreased", event)
+}
+
+// EmitOperatorSharesSlashed appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitOperatorSharesSlashed(event *IDelegationManagerOperatorSharesSlashed) {
+	_FakeIDelegationManager.Contract.Emit("OperatorSharesSlashed", event)
+}
+
+// EmitSlashingWithdrawalCompleted appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitSlashingWithdrawalCompleted(event *IDelegationManagerSlashingWithdrawalCompleted) {
+	_FakeIDelegationManager.Contract.Emit("SlashingWithdrawalCompleted", event)
+}
+
+// EmitSlashingWithdrawalQueued appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitSlashingWithdrawalQueued(event *IDelegationManagerSlashingWithdrawalQueued) {
+	_FakeIDelegationManager.Contract.Emit("SlashingWithdrawalQueued", event)
+}
+
+// EmitStakerDelegated appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitStakerDelegated(event *IDelegationManagerStakerDelegated) {
+	_FakeIDelegationManager.Contract.Emit("StakerDelegated", event)
+}
+
+// EmitStakerForceUndelegated appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitStakerForceUndelegated(event *IDelegationManagerStakerForceUndelegated) {
+	_FakeIDelegationManager.Contract.Emit("StakerForceUndelegated", event)
+}
+
+// EmitStakerUndelegated appends the event to the fake's logs.
+func (_FakeIDelegationManager *FakeIDelegationManager) EmitStakerUndelegated(event *IDelegationManagerStakerUndelegated) {
+	_FakeIDelegationManager.Contract.Emit("StakerUndelegated", event)
+}
@@ -0,0 +1,119 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IDelegationManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IDelegationManagerReader is the read-only surface of the IDelegationManager
+// binding, implemented by IDelegationManagerCaller, IDelegationManager and FakeIDelegationManager.
+type IDelegationManagerReader interface {
+	DELEGATIONAPPROVALTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	CalculateDelegationApprovalDigestHash(opts *bind.CallOpts, staker common.Address, operator common.Address, _delegationApprover common.Address, approverSalt [32]byte, expiry *big.Int) ([32]byte, error)
+	CalculateWithdrawalRoot(opts *bind.CallOpts, withdrawal IDelegationManagerTypesWithdrawal) ([32]byte, error)
+	ConvertToDepositShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address, withdrawableShares []*big.Int) ([]*big.Int, error)
+	CumulativeWithdrawalsQueued(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	DelegatedTo(opts *bind.CallOpts, staker common.Address) (common.Address, error)
+	DelegationApprover(opts *bind.CallOpts, operator common.Address) (common.Address, error)
+	DelegationApproverSaltIsSpent(opts *bind.CallOpts, _delegationApprover common.Address, salt [32]byte) (bool, error)
+	DepositScalingFactor(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	GetDepositedShares(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	GetOperatorShares(opts *bind.CallOpts, operator common.Address, strategies []common.Address) ([]*big.Int, error)
+	GetOperatorsShares(opts *bind.CallOpts, operators []common.Address, strategies []common.Address) ([][]*big.Int, error)
+	GetQueuedWithdrawal(opts *bind.CallOpts, withdrawalRoot [32]byte) (struct {
+		Withdrawal IDelegationManagerTypesWithdrawal
+		Shares     []*big.Int
+	}, error)
+	GetQueuedWithdrawalRoots(opts *bind.CallOpts, staker common.Address) ([][32]byte, error)
+	GetQueuedWithdrawals(opts *bind.CallOpts, staker common.Address) (struct {
+		Withdrawals []IDelegationManagerTypesWithdrawal
+		Shares      [][]*big.Int
+	}, error)
+	GetSlashableSharesInQueue(opts *bind.CallOpts, operator common.Address, strategy common.Address) (*big.Int, error)
+	GetWithdrawableShares(opts *bind.CallOpts, staker common.Address, strategies []common.Address) (struct {
+		WithdrawableShares []*big.Int
+		DepositShares      []*big.Int
+	}, error)
+	IsDelegated(opts *bind.CallOpts, staker common.Address) (bool, error)
+	IsOperator(opts *bind.CallOpts, operator common.Address) (bool, error)
+	MinWithdrawalDelayBlocks(opts *bind.CallOpts) (uint32, error)
+	QueuedWithdrawals(opts *bind.CallOpts, withdrawalRoot [32]byte) (IDelegationManagerTypesWithdrawal, error)
+}
+
+// IDelegationManagerWriter is the transacting surface of the IDelegationManager
+// binding, implemented by IDelegationManagerTransactor, IDelegationManager and FakeIDelegationManager.
+type IDelegationManagerWriter interface {
+	CompleteQueuedWithdrawal(opts *bind.TransactOpts, withdrawal IDelegationManagerTypesWithdrawal, tokens []common.Address, receiveAsTokens bool) (*types.Transaction, error)
+	CompleteQueuedWithdrawals(opts *bind.TransactOpts, withdrawals []IDelegationManagerTypesWithdrawal, tokens [][]common.Address, receiveAsTokens []bool) (*types.Transaction, error)
+	DecreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, curDepositShares *big.Int, beaconChainSlashingFactorDecrease uint64) (*types.Transaction, error)
+	DelegateTo(opts *bind.TransactOpts, operator common.Address, approverSignatureAndExpiry ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	IncreaseDelegatedShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, prevDepositShares *big.Int, addedShares *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialPausedStatus *big.Int) (*types.Transaction, error)
+	ModifyOperatorDetails(opts *bind.TransactOpts, operator common.Address, newDelegationApprover common.Address) (*types.Transaction, error)
+	QueueWithdrawals(opts *bind.TransactOpts, params []IDelegationManagerTypesQueuedWithdrawalParams) (*types.Transaction, error)
+	Redelegate(opts *bind.TransactOpts, newOperator common.Address, newOperatorApproverSig ISignatureUtilsMixinTypesSignatureWithExpiry, approverSalt [32]byte) (*types.Transaction, error)
+	RegisterAsOperator(opts *bind.TransactOpts, initDelegationApprover common.Address, allocationDelay uint32, metadataURI string) (*types.Transaction, error)
+	SlashOperatorShares(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, prevMaxMagnitude uint64, newMaxMagnitude uint64) (*types.Transaction, error)
+	Undelegate(opts *bind.TransactOpts, staker common.Address) (*types.Transaction, error)
+	UpdateOperatorMetadataURI(opts *bind.TransactOpts, operator common.Address, metadataURI string) (*types.Transaction, error)
+}
+
+// IDelegationManagerEvents is the event filtering surface of the IDelegationManager
+// binding, implemented by IDelegationManagerFilterer, IDelegationManager and FakeIDelegationManager.
+type IDelegationManagerEvents interface {
+	FilterDelegationApproverUpdated(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerDelegationApproverUpdatedIterator, error)
+	WatchDelegationApproverUpdated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerDelegationApproverUpdated, operator []common.Address) (event.Subscription, error)
+	ParseDelegationApproverUpdated(log types.Log) (*IDelegationManagerDelegationApproverUpdated, error)
+	FilterDepositScalingFactorUpdated(opts *bind.FilterOpts) (*IDelegationManagerDepositScalingFactorUpdatedIterator, error)
+	WatchDepositScalingFactorUpdated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerDepositScalingFactorUpdated) (event.Subscription, error)
+	ParseDepositScalingFactorUpdated(log types.Log) (*IDelegationManagerDepositScalingFactorUpdated, error)
+	FilterOperatorMetadataURIUpdated(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorMetadataURIUpdatedIterator, error)
+	WatchOperatorMetadataURIUpdated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorMetadataURIUpdated, operator []common.Address) (event.Subscription, error)
+	ParseOperatorMetadataURIUpdated(log types.Log) (*IDelegationManagerOperatorMetadataURIUpdated, error)
+	FilterOperatorRegistered(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorRegisteredIterator, error)
+	WatchOperatorRegistered(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorRegistered, operator []common.Address) (event.Subscription, error)
+	ParseOperatorRegistered(log types.Log) (*IDelegationManagerOperatorRegistered, error)
+	FilterOperatorSharesDecreased(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorSharesDecreasedIterator, error)
+	WatchOperatorSharesDecreased(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorSharesDecreased, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesDecreased(log types.Log) (*IDelegationManagerOperatorSharesDecreased, error)
+	FilterOperatorSharesIncreased(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorSharesIncreasedIterator, error)
+	WatchOperatorSharesIncreased(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorSharesIncreased, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesIncreased(log types.Log) (*IDelegationManagerOperatorSharesIncreased, error)
+	FilterOperatorSharesSlashed(opts *bind.FilterOpts, operator []common.Address) (*IDelegationManagerOperatorSharesSlashedIterator, error)
+	WatchOperatorSharesSlashed(opts *bind.WatchOpts, sink chan<- *IDelegationManagerOperatorSharesSlashed, operator []common.Address) (event.Subscription, error)
+	ParseOperatorSharesSlashed(log types.Log) (*IDelegationManagerOperatorSharesSlashed, error)
+	FilterSlashingWithdrawalCompleted(opts *bind.FilterOpts) (*IDelegationManagerSlashingWithdrawalCompletedIterator, error)
+	WatchSlashingWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *IDelegationManagerSlashingWithdrawalCompleted) (event.Subscription, error)
+	ParseSlashingWithdrawalCompleted(log types.Log) (*IDelegationManagerSlashingWithdrawalCompleted, error)
+	FilterSlashingWithdrawalQueued(opts *bind.FilterOpts) (*IDelegationManagerSlashingWithdrawalQueuedIterator, error)
+	WatchSlashingWithdrawalQueued(opts *bind.WatchOpts, sink chan<- *IDelegationManagerSlashingWithdrawalQueued) (event.Subscription, error)
+	ParseSlashingWithdrawalQueued(log types.Log) (*IDelegationManagerSlashingWithdrawalQueued, error)
+	FilterStakerDelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*IDelegationManagerStakerDelegatedIterator, error)
+	WatchStakerDelegated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerStakerDelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerDelegated(log types.Log) (*IDelegationManagerStakerDelegated, error)
+	FilterStakerForceUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*IDelegationManagerStakerForceUndelegatedIterator, error)
+	WatchStakerForceUndelegated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerStakerForceUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerForceUndelegated(log types.Log) (*IDelegationManagerStakerForceUndelegated, error)
+	FilterStakerUndelegated(opts *bind.FilterOpts, staker []common.Address, operator []common.Address) (*IDelegationManagerStakerUndelegatedIterator, error)
+	WatchStakerUndelegated(opts *bind.WatchOpts, sink chan<- *IDelegationManagerStakerUndelegated, staker []common.Address, operator []common.Address) (event.Subscription, error)
+	ParseStakerUndelegated(log types.Log) (*IDelegationManagerStakerUndelegated, error)
+}
+
+var (
+	_ IDelegationManagerReader = (*IDelegationManagerCaller)(nil)
+	_ IDelegationManagerReader = (*IDelegationManager)(nil)
+	_ IDelegationManagerReader = (*FakeIDelegationManager)(nil)
+	_ IDelegationManagerWriter = (*IDelegationManagerTransactor)(nil)
+	_ IDelegationManagerWriter = (*IDelegationManager)(nil)
+	_ IDelegationManagerWriter = (*FakeIDelegationManager)(nil)
+	_ IDelegationManagerEvents = (*IDelegationManagerFilterer)(nil)
+	_ IDelegationManagerEvents = (*IDelegationManager)(nil)
+	_ IDelegationManagerEvents = (*FakeIDelegationManager)(nil)
+)
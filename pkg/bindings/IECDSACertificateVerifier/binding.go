@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,30 +30,17 @@ var (
 	_ = abi.ConvertType
 )
 
-// ICrossChainRegistryTypesOperatorSetConfig is an auto generated low-level Go binding around an user-defined struct.
-type ICrossChainRegistryTypesOperatorSetConfig struct {
-	Owner              common.Address
-	MaxStalenessPeriod uint32
-}
+// ICrossChainRegistryTypesOperatorSetConfig is an alias of the canonical eltypes.ICrossChainRegistryTypesOperatorSetConfig shared by all bindings.
+type ICrossChainRegistryTypesOperatorSetConfig = eltypes.ICrossChainRegistryTypesOperatorSetConfig
 
-// IECDSACertificateVerifierTypesECDSACertificate is an auto generated low-level Go binding around an user-defined struct.
-type IECDSACertificateVerifierTypesECDSACertificate struct {
-	ReferenceTimestamp uint32
-	MessageHash        [32]byte
-	Sig                []byte
-}
+// IECDSACertificateVerifierTypesECDSACertificate is an alias of the canonical eltypes.IECDSACertificateVerifierTypesECDSACertificate shared by all bindings.
+type IECDSACertificateVerifierTypesECDSACertificate = eltypes.IECDSACertificateVerifierTypesECDSACertificate
 
-// IOperatorTableCalculatorTypesECDSAOperatorInfo is an auto generated low-level Go binding around an user-defined struct.
-type IOperatorTableCalculatorTypesECDSAOperatorInfo struct {
-	Pubkey  common.Address
-	Weights []*big.Int
-}
+// IOperatorTableCalculatorTypesECDSAOperatorInfo is an alias of the canonical eltypes.IOperatorTableCalculatorTypesECDSAOperatorInfo shared by all bindings.
+type IOperatorTableCalculatorTypesECDSAOperatorInfo = eltypes.IOperatorTableCalculatorTypesECDSAOperatorInfo
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // IECDSACertificateVerifierMetaData contains all meta data concerning the IECDSACertificateVerifier contract.
 var IECDSACertificateVerifierMetaData = &bind.MetaData{
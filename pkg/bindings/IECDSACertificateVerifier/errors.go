@@ -0,0 +1,106 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IECDSACertificateVerifier
+
+// ErrArrayLengthMismatch is the typed form of the IECDSACertificateVerifier custom error ArrayLengthMismatch().
+type ErrArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrArrayLengthMismatch) Error() string {
+	return "IECDSACertificateVerifier: ArrayLengthMismatch()"
+}
+
+// ErrCertificateStale is the typed form of the IECDSACertificateVerifier custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "IECDSACertificateVerifier: CertificateStale()"
+}
+
+// ErrIndexOutOfBounds is the typed form of the IECDSACertificateVerifier custom error IndexOutOfBounds().
+type ErrIndexOutOfBounds struct{}
+
+// Error implements the error interface.
+func (ErrIndexOutOfBounds) Error() string {
+	return "IECDSACertificateVerifier: IndexOutOfBounds()"
+}
+
+// ErrInvalidSignatureLength is the typed form of the IECDSACertificateVerifier custom error InvalidSignatureLength().
+type ErrInvalidSignatureLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignatureLength) Error() string {
+	return "IECDSACertificateVerifier: InvalidSignatureLength()"
+}
+
+// ErrOnlyTableUpdater is the typed form of the IECDSACertificateVerifier custom error OnlyTableUpdater().
+type ErrOnlyTableUpdater struct{}
+
+// Error implements the error interface.
+func (ErrOnlyTableUpdater) Error() string {
+	return "IECDSACertificateVerifier: OnlyTableUpdater()"
+}
+
+// ErrOperatorCountZero is the typed form of the IECDSACertificateVerifier custom error OperatorCountZero().
+type ErrOperatorCountZero struct{}
+
+// Error implements the error interface.
+func (ErrOperatorCountZero) Error() string {
+	return "IECDSACertificateVerifier: OperatorCountZero()"
+}
+
+// ErrReferenceTimestampDoesNotExist is the typed form of the IECDSACertificateVerifier custom error ReferenceTimestampDoesNotExist().
+type ErrReferenceTimestampDoesNotExist struct{}
+
+// Error implements the error interface.
+func (ErrReferenceTimestampDoesNotExist) Error() string {
+	return "IECDSACertificateVerifier: ReferenceTimestampDoesNotExist()"
+}
+
+// ErrRootDisabled is the typed form of the IECDSACertificateVerifier custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "IECDSACertificateVerifier: RootDisabled()"
+}
+
+// ErrSignersNotOrdered is the typed form of the IECDSACertificateVerifier custom error SignersNotOrdered().
+type ErrSignersNotOrdered struct{}
+
+// Error implements the error interface.
+func (ErrSignersNotOrdered) Error() string {
+	return "IECDSACertificateVerifier: SignersNotOrdered()"
+}
+
+// ErrTableUpdateStale is the typed form of the IECDSACertificateVerifier custom error TableUpdateStale().
+type ErrTableUpdateStale struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateStale) Error() string {
+	return "IECDSACertificateVerifier: TableUpdateStale()"
+}
+
+// ErrVerificationFailed is the typed form of the IECDSACertificateVerifier custom error VerificationFailed().
+type ErrVerificationFailed struct{}
+
+// Error implements the error interface.
+func (ErrVerificationFailed) Error() string {
+	return "IECDSACertificateVerifier: VerificationFailed()"
+}
+
+// IECDSACertificateVerifierErrors maps the name of every custom error in the IECDSACertificateVerifier ABI to its typed Go error.
+var IECDSACertificateVerifierErrors = map[string]error{
+	"ArrayLengthMismatch":            ErrArrayLengthMismatch{},
+	"CertificateStale":               ErrCertificateStale{},
+	"IndexOutOfBounds":               ErrIndexOutOfBounds{},
+	"InvalidSignatureLength":         ErrInvalidSignatureLength{},
+	"OnlyTableUpdater":               ErrOnlyTableUpdater{},
+	"OperatorCountZero":              ErrOperatorCountZero{},
+	"ReferenceTimestampDoesNotExist": ErrReferenceTimestampDoesNotExist{},
+	"RootDisabled":                   ErrRootDisabled{},
+	"SignersNotOrdered":              ErrSignersNotOrdered{},
+	"TableUpdateStale":               ErrTableUpdateStale{},
+	"VerificationFailed":             ErrVerificationFailed{},
+}
@@ -0,0 +1,240 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IECDSACertificateVerifier
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIECDSACertificateVerifier is an in-memory IECDSACertificateVerifier for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IECDSACertificateVerifierFilterer.
+type FakeIECDSACertificateVerifier struct {
+	*fake.Contract
+	*IECDSACertificateVerifierFilterer
+}
+
+// NewFakeIECDSACertificateVerifier returns an empty fake of the contract at address.
+func NewFakeIECDSACertificateVerifier(address common.Address) *FakeIECDSACertificateVerifier {
+	contract := fake.NewContract(IECDSACertificateVerifierMetaData, address)
+	filterer, err := NewIECDSACertificateVerifierFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIECDSACertificateVerifier{Contract: contract, IECDSACertificateVerifierFilterer: filterer}
+}
+
+// CalculateCertificateDigest returns the values stubbed with StubCalculateCertificateDigest.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIECDSACertificateVerifier.Contract.Read("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigest sets what CalculateCertificateDigest returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubCalculateCertificateDigest(referenceTimestamp uint32, messageHash [32]byte, out0 [32]byte) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("calculateCertificateDigest", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// CalculateCertificateDigestBytes returns the values stubbed with StubCalculateCertificateDigestBytes.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) CalculateCertificateDigestBytes(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([]byte, error) {
+	var out0 []byte
+	err := _FakeIECDSACertificateVerifier.Contract.Read("calculateCertificateDigestBytes", []interface{}{referenceTimestamp, messageHash}, &out0)
+	return out0, err
+}
+
+// StubCalculateCertificateDigestBytes sets what CalculateCertificateDigestBytes returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubCalculateCertificateDigestBytes(referenceTimestamp uint32, messageHash [32]byte, out0 []byte) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("calculateCertificateDigestBytes", []interface{}{referenceTimestamp, messageHash}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIECDSACertificateVerifier.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubDomainSeparator(out0 [32]byte) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// GetOperatorCount returns the values stubbed with StubGetOperatorCount.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIECDSACertificateVerifier.Contract.Read("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorCount sets what GetOperatorCount returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubGetOperatorCount(operatorSet OperatorSet, referenceTimestamp uint32, out0 *big.Int) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("getOperatorCount", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorInfo returns the values stubbed with StubGetOperatorInfo.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) GetOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesECDSAOperatorInfo, error) {
+	var out0 IOperatorTableCalculatorTypesECDSAOperatorInfo
+	err := _FakeIECDSACertificateVerifier.Contract.Read("getOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorInfo sets what GetOperatorInfo returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubGetOperatorInfo(operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int, out0 IOperatorTableCalculatorTypesECDSAOperatorInfo) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("getOperatorInfo", []interface{}{operatorSet, referenceTimestamp, operatorIndex}, out0)
+}
+
+// GetOperatorInfos returns the values stubbed with StubGetOperatorInfos.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) GetOperatorInfos(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]IOperatorTableCalculatorTypesECDSAOperatorInfo, error) {
+	var out0 []IOperatorTableCalculatorTypesECDSAOperatorInfo
+	err := _FakeIECDSACertificateVerifier.Contract.Read("getOperatorInfos", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorInfos sets what GetOperatorInfos returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubGetOperatorInfos(operatorSet OperatorSet, referenceTimestamp uint32, out0 []IOperatorTableCalculatorTypesECDSAOperatorInfo) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("getOperatorInfos", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// GetOperatorSetOwner returns the values stubbed with StubGetOperatorSetOwner.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIECDSACertificateVerifier.Contract.Read("getOperatorSetOwner", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetOwner sets what GetOperatorSetOwner returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubGetOperatorSetOwner(operatorSet OperatorSet, out0 common.Address) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("getOperatorSetOwner", []interface{}{operatorSet}, out0)
+}
+
+// GetTotalStakeWeights returns the values stubbed with StubGetTotalStakeWeights.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeIECDSACertificateVerifier.Contract.Read("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetTotalStakeWeights sets what GetTotalStakeWeights returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubGetTotalStakeWeights(operatorSet OperatorSet, referenceTimestamp uint32, out0 []*big.Int) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("getTotalStakeWeights", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// IsReferenceTimestampSet returns the values stubbed with StubIsReferenceTimestampSet.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeIECDSACertificateVerifier.Contract.Read("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsReferenceTimestampSet sets what IsReferenceTimestampSet returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubIsReferenceTimestampSet(operatorSet OperatorSet, referenceTimestamp uint32, out0 bool) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("isReferenceTimestampSet", []interface{}{operatorSet, referenceTimestamp}, out0)
+}
+
+// LatestReferenceTimestamp returns the values stubbed with StubLatestReferenceTimestamp.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeIECDSACertificateVerifier.Contract.Read("latestReferenceTimestamp", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubLatestReferenceTimestamp sets what LatestReferenceTimestamp returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubLatestReferenceTimestamp(operatorSet OperatorSet, out0 uint32) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("latestReferenceTimestamp", []interface{}{operatorSet}, out0)
+}
+
+// MaxOperatorTableStaleness returns the values stubbed with StubMaxOperatorTableStaleness.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeIECDSACertificateVerifier.Contract.Read("maxOperatorTableStaleness", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubMaxOperatorTableStaleness sets what MaxOperatorTableStaleness returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubMaxOperatorTableStaleness(operatorSet OperatorSet, out0 uint32) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("maxOperatorTableStaleness", []interface{}{operatorSet}, out0)
+}
+
+// VerifyCertificate returns the values stubbed with StubVerifyCertificate.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) VerifyCertificate(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate) (struct {
+	TotalSignedStakeWeights []*big.Int
+	Signers                 []common.Address
+}, error) {
+	var out0 struct {
+		TotalSignedStakeWeights []*big.Int
+		Signers                 []common.Address
+	}
+	err := _FakeIECDSACertificateVerifier.Contract.Read("verifyCertificate", []interface{}{operatorSet, cert}, &out0)
+	return out0, err
+}
+
+// StubVerifyCertificate sets what VerifyCertificate returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubVerifyCertificate(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, out0 struct {
+	TotalSignedStakeWeights []*big.Int
+	Signers                 []common.Address
+}) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("verifyCertificate", []interface{}{operatorSet, cert}, out0)
+}
+
+// VerifyCertificateNominal returns the values stubbed with StubVerifyCertificateNominal.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) VerifyCertificateNominal(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int) (bool, []common.Address, error) {
+	var out0 bool
+	var out1 []common.Address
+	err := _FakeIECDSACertificateVerifier.Contract.Read("verifyCertificateNominal", []interface{}{operatorSet, cert, totalStakeNominalThresholds}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubVerifyCertificateNominal sets what VerifyCertificateNominal returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubVerifyCertificateNominal(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int, out0 bool, out1 []common.Address) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("verifyCertificateNominal", []interface{}{operatorSet, cert, totalStakeNominalThresholds}, out0, out1)
+}
+
+// VerifyCertificateProportion returns the values stubbed with StubVerifyCertificateProportion.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) VerifyCertificateProportion(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16) (bool, []common.Address, error) {
+	var out0 bool
+	var out1 []common.Address
+	err := _FakeIECDSACertificateVerifier.Contract.Read("verifyCertificateProportion", []interface{}{operatorSet, cert, totalStakeProportionThresholds}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubVerifyCertificateProportion sets what VerifyCertificateProportion returns for the given arguments.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) StubVerifyCertificateProportion(operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16, out0 bool, out1 []common.Address) {
+	_FakeIECDSACertificateVerifier.Contract.Stub("verifyCertificateProportion", []interface{}{operatorSet, cert, totalStakeProportionThresholds}, out0, out1)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error) {
+	return _FakeIECDSACertificateVerifier.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeIECDSACertificateVerifier.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error); ok {
+			return hook(opts, operatorSet, referenceTimestamp, operatorInfos, operatorSetConfig)
+		}
+		return nil
+	}, operatorSet, referenceTimestamp, operatorInfos, operatorSetConfig)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) error) {
+	_FakeIECDSACertificateVerifier.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// EmitMaxStalenessPeriodUpdated appends the event to the fake's logs.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) EmitMaxStalenessPeriodUpdated(event *IECDSACertificateVerifierMaxStalenessPeriodUpdated) {
+	_FakeIECDSACertificateVerifier.Contract.Emit("MaxStalenessPeriodUpdated", event)
+}
+
+// EmitOperatorSetOwnerUpdated appends the event to the fake's logs.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) EmitOperatorSetOwnerUpdated(event *IECDSACertificateVerifierOperatorSetOwnerUpdated) {
+	_FakeIECDSACertificateVerifier.Contract.Emit("OperatorSetOwnerUpdated", event)
+}
+
+// EmitTableUpdated appends the event to the fake's logs.
+func (_FakeIECDSACertificateVerifier *FakeIECDSACertificateVerifier) EmitTableUpdated(event *IECDSACertificateVerifierTableUpdated) {
+	_FakeIECDSACertificateVerifier.Contract.Emit("TableUpdated", event)
+}
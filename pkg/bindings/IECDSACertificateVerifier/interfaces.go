@@ -0,0 +1,65 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IECDSACertificateVerifier
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IECDSACertificateVerifierReader is the read-only surface of the IECDSACertificateVerifier
+// binding, implemented by IECDSACertificateVerifierCaller, IECDSACertificateVerifier and FakeIECDSACertificateVerifier.
+type IECDSACertificateVerifierReader interface {
+	CalculateCertificateDigest(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([32]byte, error)
+	CalculateCertificateDigestBytes(opts *bind.CallOpts, referenceTimestamp uint32, messageHash [32]byte) ([]byte, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	GetOperatorCount(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (*big.Int, error)
+	GetOperatorInfo(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorIndex *big.Int) (IOperatorTableCalculatorTypesECDSAOperatorInfo, error)
+	GetOperatorInfos(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]IOperatorTableCalculatorTypesECDSAOperatorInfo, error)
+	GetOperatorSetOwner(opts *bind.CallOpts, operatorSet OperatorSet) (common.Address, error)
+	GetTotalStakeWeights(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) ([]*big.Int, error)
+	IsReferenceTimestampSet(opts *bind.CallOpts, operatorSet OperatorSet, referenceTimestamp uint32) (bool, error)
+	LatestReferenceTimestamp(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	MaxOperatorTableStaleness(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	VerifyCertificate(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate) (struct {
+		TotalSignedStakeWeights []*big.Int
+		Signers                 []common.Address
+	}, error)
+	VerifyCertificateNominal(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeNominalThresholds []*big.Int) (bool, []common.Address, error)
+	VerifyCertificateProportion(opts *bind.CallOpts, operatorSet OperatorSet, cert IECDSACertificateVerifierTypesECDSACertificate, totalStakeProportionThresholds []uint16) (bool, []common.Address, error)
+}
+
+// IECDSACertificateVerifierWriter is the transacting surface of the IECDSACertificateVerifier
+// binding, implemented by IECDSACertificateVerifierTransactor, IECDSACertificateVerifier and FakeIECDSACertificateVerifier.
+type IECDSACertificateVerifierWriter interface {
+	UpdateOperatorTable(opts *bind.TransactOpts, operatorSet OperatorSet, referenceTimestamp uint32, operatorInfos []IOperatorTableCalculatorTypesECDSAOperatorInfo, operatorSetConfig ICrossChainRegistryTypesOperatorSetConfig) (*types.Transaction, error)
+}
+
+// IECDSACertificateVerifierEvents is the event filtering surface of the IECDSACertificateVerifier
+// binding, implemented by IECDSACertificateVerifierFilterer, IECDSACertificateVerifier and FakeIECDSACertificateVerifier.
+type IECDSACertificateVerifierEvents interface {
+	FilterMaxStalenessPeriodUpdated(opts *bind.FilterOpts) (*IECDSACertificateVerifierMaxStalenessPeriodUpdatedIterator, error)
+	WatchMaxStalenessPeriodUpdated(opts *bind.WatchOpts, sink chan<- *IECDSACertificateVerifierMaxStalenessPeriodUpdated) (event.Subscription, error)
+	ParseMaxStalenessPeriodUpdated(log types.Log) (*IECDSACertificateVerifierMaxStalenessPeriodUpdated, error)
+	FilterOperatorSetOwnerUpdated(opts *bind.FilterOpts) (*IECDSACertificateVerifierOperatorSetOwnerUpdatedIterator, error)
+	WatchOperatorSetOwnerUpdated(opts *bind.WatchOpts, sink chan<- *IECDSACertificateVerifierOperatorSetOwnerUpdated) (event.Subscription, error)
+	ParseOperatorSetOwnerUpdated(log types.Log) (*IECDSACertificateVerifierOperatorSetOwnerUpdated, error)
+	FilterTableUpdated(opts *bind.FilterOpts) (*IECDSACertificateVerifierTableUpdatedIterator, error)
+	WatchTableUpdated(opts *bind.WatchOpts, sink chan<- *IECDSACertificateVerifierTableUpdated) (event.Subscription, error)
+	ParseTableUpdated(log types.Log) (*IECDSACertificateVerifierTableUpdated, error)
+}
+
+var (
+	_ IECDSACertificateVerifierReader = (*IECDSACertificateVerifierCaller)(nil)
+	_ IECDSACertificateVerifierReader = (*IECDSACertificateVerifier)(nil)
+	_ IECDSACertificateVerifierReader = (*FakeIECDSACertificateVerifier)(nil)
+	_ IECDSACertificateVerifierWriter = (*IECDSACertificateVerifierTransactor)(nil)
+	_ IECDSACertificateVerifierWriter = (*IECDSACertificateVerifier)(nil)
+	_ IECDSACertificateVerifierWriter = (*FakeIECDSACertificateVerifier)(nil)
+	_ IECDSACertificateVerifierEvents = (*IECDSACertificateVerifierFilterer)(nil)
+	_ IECDSACertificateVerifierEvents = (*IECDSACertificateVerifier)(nil)
+	_ IECDSACertificateVerifierEvents = (*FakeIECDSACertificateVerifier)(nil)
+)
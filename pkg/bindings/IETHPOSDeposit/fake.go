@@ -0,0 +1,74 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IETHPOSDeposit
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeIETHPOSDeposit is an in-memory IETHPOSDeposit for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IETHPOSDepositFilterer.
+type FakeIETHPOSDeposit struct {
+	*fake.Contract
+	*IETHPOSDepositFilterer
+}
+
+// NewFakeIETHPOSDeposit returns an empty fake of the contract at address.
+func NewFakeIETHPOSDeposit(address common.Address) *FakeIETHPOSDeposit {
+	contract := fake.NewContract(IETHPOSDepositMetaData, address)
+	filterer, err := NewIETHPOSDepositFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIETHPOSDeposit{Contract: contract, IETHPOSDepositFilterer: filterer}
+}
+
+// GetDepositCount returns the values stubbed with StubGetDepositCount.
+func (_FakeIETHPOSDeposit *FakeIETHPOSDeposit) GetDepositCount(opts *bind.CallOpts) ([]byte, error) {
+	var out0 []byte
+	err := _FakeIETHPOSDeposit.Contract.Read("get_deposit_count", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetDepositCount sets what GetDepositCount returns for the given arguments.
+func (_FakeIETHPOSDeposit *FakeIETHPOSDeposit) StubGetDepositCount(out0 []byte) {
+	_FakeIETHPOSDeposit.Contract.Stub("get_deposit_count", []interface{}{}, out0)
+}
+
+// GetDepositRoot returns the values stubbed with StubGetDepositRoot.
+func (_FakeIETHPOSDeposit *FakeIETHPOSDeposit) GetDepositRoot(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIETHPOSDeposit.Contract.Read("get_deposit_root", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetDepositRoot sets what GetDepositRoot returns for the given arguments.
+func (_FakeIETHPOSDeposit *FakeIETHPOSDeposit) StubGetDepositRoot(out0 [32]byte) {
+	_FakeIETHPOSDeposit.Contract.Stub("get_deposit_root", []interface{}{}, out0)
+}
+
+// Deposit records a deposit call after running the hook set with OnDeposit.
+func (_FakeIETHPOSDeposit *FakeIETHPOSDeposit) Deposit(opts *bind.TransactOpts, pubkey []byte, withdrawal_credentials []byte, signature []byte, deposit_data_root [32]byte) (*types.Transaction, error) {
+	return _FakeIETHPOSDeposit.Contract.Transact(opts, "deposit", func() error {
+		if hook, ok := _FakeIETHPOSDeposit.Contract.Hook("deposit").(func(opts *bind.TransactOpts, pubkey []byte, withdrawal_credentials []byte, signature []byte, deposit_data_root [32]byte) error); ok {
+			return hook(opts, pubkey, withdrawal_credentials, signature, deposit_data_root)
+		}
+		return nil
+	}, pubkey, withdrawal_credentials, signature, deposit_data_root)
+}
+
+// OnDeposit sets a hook run by every Deposit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIETHPOSDeposit *FakeIETHPOSDeposit) OnDeposit(hook func(opts *bind.TransactOpts, pubkey []byte, withdrawal_credentials []byte, signature []byte, deposit_data_root [32]byte) error) {
+	_FakeIETHPOSDeposit.Contract.SetHook("deposit", hook)
+}
+
+// EmitDepositEvent appends the event to the fake's logs.
+func (_FakeIETHPOSDeposit *FakeIETHPOSDeposit) EmitDepositEvent(event *IETHPOSDepositDepositEvent) {
+	_FakeIETHPOSDeposit.Contract.Emit("DepositEvent", event)
+}
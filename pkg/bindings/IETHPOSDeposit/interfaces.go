@@ -0,0 +1,42 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IETHPOSDeposit
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IETHPOSDepositReader is the read-only surface of the IETHPOSDeposit
+// binding, implemented by IETHPOSDepositCaller, IETHPOSDeposit and FakeIETHPOSDeposit.
+type IETHPOSDepositReader interface {
+	GetDepositCount(opts *bind.CallOpts) ([]byte, error)
+	GetDepositRoot(opts *bind.CallOpts) ([32]byte, error)
+}
+
+// IETHPOSDepositWriter is the transacting surface of the IETHPOSDeposit
+// binding, implemented by IETHPOSDepositTransactor, IETHPOSDeposit and FakeIETHPOSDeposit.
+type IETHPOSDepositWriter interface {
+	Deposit(opts *bind.TransactOpts, pubkey []byte, withdrawal_credentials []byte, signature []byte, deposit_data_root [32]byte) (*types.Transaction, error)
+}
+
+// IETHPOSDepositEvents is the event filtering surface of the IETHPOSDeposit
+// binding, implemented by IETHPOSDepositFilterer, IETHPOSDeposit and FakeIETHPOSDeposit.
+type IETHPOSDepositEvents interface {
+	FilterDepositEvent(opts *bind.FilterOpts) (*IETHPOSDepositDepositEventIterator, error)
+	WatchDepositEvent(opts *bind.WatchOpts, sink chan<- *IETHPOSDepositDepositEvent) (event.Subscription, error)
+	ParseDepositEvent(log types.Log) (*IETHPOSDepositDepositEvent, error)
+}
+
+var (
+	_ IETHPOSDepositReader = (*IETHPOSDepositCaller)(nil)
+	_ IETHPOSDepositReader = (*IETHPOSDeposit)(nil)
+	_ IETHPOSDepositReader = (*FakeIETHPOSDeposit)(nil)
+	_ IETHPOSDepositWriter = (*IETHPOSDepositTransactor)(nil)
+	_ IETHPOSDepositWriter = (*IETHPOSDeposit)(nil)
+	_ IETHPOSDepositWriter = (*FakeIETHPOSDeposit)(nil)
+	_ IETHPOSDepositEvents = (*IETHPOSDepositFilterer)(nil)
+	_ IETHPOSDepositEvents = (*IETHPOSDeposit)(nil)
+	_ IETHPOSDepositEvents = (*FakeIETHPOSDeposit)(nil)
+)
@@ -0,0 +1,244 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigen
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIEigen is an in-memory IEigen for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IEigenFilterer.
+type FakeIEigen struct {
+	*fake.Contract
+	*IEigenFilterer
+}
+
+// NewFakeIEigen returns an empty fake of the contract at address.
+func NewFakeIEigen(address common.Address) *FakeIEigen {
+	contract := fake.NewContract(IEigenMetaData, address)
+	filterer, err := NewIEigenFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIEigen{Contract: contract, IEigenFilterer: filterer}
+}
+
+// CLOCKMODE returns the values stubbed with StubCLOCKMODE.
+func (_FakeIEigen *FakeIEigen) CLOCKMODE(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeIEigen.Contract.Read("CLOCK_MODE", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCLOCKMODE sets what CLOCKMODE returns for the given arguments.
+func (_FakeIEigen *FakeIEigen) StubCLOCKMODE(out0 string) {
+	_FakeIEigen.Contract.Stub("CLOCK_MODE", []interface{}{}, out0)
+}
+
+// Allowance returns the values stubbed with StubAllowance.
+func (_FakeIEigen *FakeIEigen) Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigen.Contract.Read("allowance", []interface{}{owner, spender}, &out0)
+	return out0, err
+}
+
+// StubAllowance sets what Allowance returns for the given arguments.
+func (_FakeIEigen *FakeIEigen) StubAllowance(owner common.Address, spender common.Address, out0 *big.Int) {
+	_FakeIEigen.Contract.Stub("allowance", []interface{}{owner, spender}, out0)
+}
+
+// BalanceOf returns the values stubbed with StubBalanceOf.
+func (_FakeIEigen *FakeIEigen) BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigen.Contract.Read("balanceOf", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubBalanceOf sets what BalanceOf returns for the given arguments.
+func (_FakeIEigen *FakeIEigen) StubBalanceOf(account common.Address, out0 *big.Int) {
+	_FakeIEigen.Contract.Stub("balanceOf", []interface{}{account}, out0)
+}
+
+// Clock returns the values stubbed with StubClock.
+func (_FakeIEigen *FakeIEigen) Clock(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigen.Contract.Read("clock", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubClock sets what Clock returns for the given arguments.
+func (_FakeIEigen *FakeIEigen) StubClock(out0 *big.Int) {
+	_FakeIEigen.Contract.Stub("clock", []interface{}{}, out0)
+}
+
+// TotalSupply returns the values stubbed with StubTotalSupply.
+func (_FakeIEigen *FakeIEigen) TotalSupply(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigen.Contract.Read("totalSupply", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalSupply sets what TotalSupply returns for the given arguments.
+func (_FakeIEigen *FakeIEigen) StubTotalSupply(out0 *big.Int) {
+	_FakeIEigen.Contract.Stub("totalSupply", []interface{}{}, out0)
+}
+
+// Approve records a approve call after running the hook set with OnApprove.
+func (_FakeIEigen *FakeIEigen) Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "approve", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("approve").(func(opts *bind.TransactOpts, spender common.Address, amount *big.Int) error); ok {
+			return hook(opts, spender, amount)
+		}
+		return nil
+	}, spender, amount)
+}
+
+// OnApprove sets a hook run by every Approve call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnApprove(hook func(opts *bind.TransactOpts, spender common.Address, amount *big.Int) error) {
+	_FakeIEigen.Contract.SetHook("approve", hook)
+}
+
+// DisableTransferRestrictions records a disableTransferRestrictions call after running the hook set with OnDisableTransferRestrictions.
+func (_FakeIEigen *FakeIEigen) DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "disableTransferRestrictions", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("disableTransferRestrictions").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnDisableTransferRestrictions sets a hook run by every DisableTransferRestrictions call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnDisableTransferRestrictions(hook func(opts *bind.TransactOpts) error) {
+	_FakeIEigen.Contract.SetHook("disableTransferRestrictions", hook)
+}
+
+// Mint records a mint call after running the hook set with OnMint.
+func (_FakeIEigen *FakeIEigen) Mint(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "mint", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("mint").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnMint sets a hook run by every Mint call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnMint(hook func(opts *bind.TransactOpts) error) {
+	_FakeIEigen.Contract.SetHook("mint", hook)
+}
+
+// SetAllowedFrom records a setAllowedFrom call after running the hook set with OnSetAllowedFrom.
+func (_FakeIEigen *FakeIEigen) SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "setAllowedFrom", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("setAllowedFrom").(func(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) error); ok {
+			return hook(opts, from, isAllowedFrom)
+		}
+		return nil
+	}, from, isAllowedFrom)
+}
+
+// OnSetAllowedFrom sets a hook run by every SetAllowedFrom call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnSetAllowedFrom(hook func(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) error) {
+	_FakeIEigen.Contract.SetHook("setAllowedFrom", hook)
+}
+
+// SetAllowedTo records a setAllowedTo call after running the hook set with OnSetAllowedTo.
+func (_FakeIEigen *FakeIEigen) SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "setAllowedTo", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("setAllowedTo").(func(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) error); ok {
+			return hook(opts, to, isAllowedTo)
+		}
+		return nil
+	}, to, isAllowedTo)
+}
+
+// OnSetAllowedTo sets a hook run by every SetAllowedTo call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnSetAllowedTo(hook func(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) error) {
+	_FakeIEigen.Contract.SetHook("setAllowedTo", hook)
+}
+
+// Transfer records a transfer call after running the hook set with OnTransfer.
+func (_FakeIEigen *FakeIEigen) Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "transfer", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("transfer").(func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, to, amount)
+		}
+		return nil
+	}, to, amount)
+}
+
+// OnTransfer sets a hook run by every Transfer call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnTransfer(hook func(opts *bind.TransactOpts, to common.Address, amount *big.Int) error) {
+	_FakeIEigen.Contract.SetHook("transfer", hook)
+}
+
+// TransferFrom records a transferFrom call after running the hook set with OnTransferFrom.
+func (_FakeIEigen *FakeIEigen) TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "transferFrom", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("transferFrom").(func(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) error); ok {
+			return hook(opts, from, to, amount)
+		}
+		return nil
+	}, from, to, amount)
+}
+
+// OnTransferFrom sets a hook run by every TransferFrom call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnTransferFrom(hook func(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) error) {
+	_FakeIEigen.Contract.SetHook("transferFrom", hook)
+}
+
+// Unwrap records a unwrap call after running the hook set with OnUnwrap.
+func (_FakeIEigen *FakeIEigen) Unwrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "unwrap", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("unwrap").(func(opts *bind.TransactOpts, amount *big.Int) error); ok {
+			return hook(opts, amount)
+		}
+		return nil
+	}, amount)
+}
+
+// OnUnwrap sets a hook run by every Unwrap call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnUnwrap(hook func(opts *bind.TransactOpts, amount *big.Int) error) {
+	_FakeIEigen.Contract.SetHook("unwrap", hook)
+}
+
+// Wrap records a wrap call after running the hook set with OnWrap.
+func (_FakeIEigen *FakeIEigen) Wrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIEigen.Contract.Transact(opts, "wrap", func() error {
+		if hook, ok := _FakeIEigen.Contract.Hook("wrap").(func(opts *bind.TransactOpts, amount *big.Int) error); ok {
+			return hook(opts, amount)
+		}
+		return nil
+	}, amount)
+}
+
+// OnWrap sets a hook run by every Wrap call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigen *FakeIEigen) OnWrap(hook func(opts *bind.TransactOpts, amount *big.Int) error) {
+	_FakeIEigen.Contract.SetHook("wrap", hook)
+}
+
+// EmitApproval appends the event to the fake's logs.
+func (_FakeIEigen *FakeIEigen) EmitApproval(event *IEigenApproval) {
+	_FakeIEigen.Contract.Emit("Approval", event)
+}
+
+// EmitTransfer appends the event to the fake's logs.
+func (_FakeIEigen *FakeIEigen) EmitTransfer(event *IEigenTransfer) {
+	_FakeIEigen.Contract.Emit("Transfer", event)
+}
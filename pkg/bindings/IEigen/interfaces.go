@@ -0,0 +1,58 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigen
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IEigenReader is the read-only surface of the IEigen
+// binding, implemented by IEigenCaller, IEigen and FakeIEigen.
+type IEigenReader interface {
+	CLOCKMODE(opts *bind.CallOpts) (string, error)
+	Allowance(opts *bind.CallOpts, owner common.Address, spender common.Address) (*big.Int, error)
+	BalanceOf(opts *bind.CallOpts, account common.Address) (*big.Int, error)
+	Clock(opts *bind.CallOpts) (*big.Int, error)
+	TotalSupply(opts *bind.CallOpts) (*big.Int, error)
+}
+
+// IEigenWriter is the transacting surface of the IEigen
+// binding, implemented by IEigenTransactor, IEigen and FakeIEigen.
+type IEigenWriter interface {
+	Approve(opts *bind.TransactOpts, spender common.Address, amount *big.Int) (*types.Transaction, error)
+	DisableTransferRestrictions(opts *bind.TransactOpts) (*types.Transaction, error)
+	Mint(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetAllowedFrom(opts *bind.TransactOpts, from common.Address, isAllowedFrom bool) (*types.Transaction, error)
+	SetAllowedTo(opts *bind.TransactOpts, to common.Address, isAllowedTo bool) (*types.Transaction, error)
+	Transfer(opts *bind.TransactOpts, to common.Address, amount *big.Int) (*types.Transaction, error)
+	TransferFrom(opts *bind.TransactOpts, from common.Address, to common.Address, amount *big.Int) (*types.Transaction, error)
+	Unwrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error)
+	Wrap(opts *bind.TransactOpts, amount *big.Int) (*types.Transaction, error)
+}
+
+// IEigenEvents is the event filtering surface of the IEigen
+// binding, implemented by IEigenFilterer, IEigen and FakeIEigen.
+type IEigenEvents interface {
+	FilterApproval(opts *bind.FilterOpts, owner []common.Address, spender []common.Address) (*IEigenApprovalIterator, error)
+	WatchApproval(opts *bind.WatchOpts, sink chan<- *IEigenApproval, owner []common.Address, spender []common.Address) (event.Subscription, error)
+	ParseApproval(log types.Log) (*IEigenApproval, error)
+	FilterTransfer(opts *bind.FilterOpts, from []common.Address, to []common.Address) (*IEigenTransferIterator, error)
+	WatchTransfer(opts *bind.WatchOpts, sink chan<- *IEigenTransfer, from []common.Address, to []common.Address) (event.Subscription, error)
+	ParseTransfer(log types.Log) (*IEigenTransfer, error)
+}
+
+var (
+	_ IEigenReader = (*IEigenCaller)(nil)
+	_ IEigenReader = (*IEigen)(nil)
+	_ IEigenReader = (*FakeIEigen)(nil)
+	_ IEigenWriter = (*IEigenTransactor)(nil)
+	_ IEigenWriter = (*IEigen)(nil)
+	_ IEigenWriter = (*FakeIEigen)(nil)
+	_ IEigenEvents = (*IEigenFilterer)(nil)
+	_ IEigenEvents = (*IEigen)(nil)
+	_ IEigenEvents = (*FakeIEigen)(nil)
+)
@@ -0,0 +1,250 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigenPod
+
+// ErrBeaconTimestampBeforeLatestCheckpoint is the typed form of the IEigenPod custom error BeaconTimestampBeforeLatestCheckpoint().
+type ErrBeaconTimestampBeforeLatestCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrBeaconTimestampBeforeLatestCheckpoint) Error() string {
+	return "IEigenPod: BeaconTimestampBeforeLatestCheckpoint()"
+}
+
+// ErrBeaconTimestampTooFarInPast is the typed form of the IEigenPod custom error BeaconTimestampTooFarInPast().
+type ErrBeaconTimestampTooFarInPast struct{}
+
+// Error implements the error interface.
+func (ErrBeaconTimestampTooFarInPast) Error() string {
+	return "IEigenPod: BeaconTimestampTooFarInPast()"
+}
+
+// ErrCannotCheckpointTwiceInSingleBlock is the typed form of the IEigenPod custom error CannotCheckpointTwiceInSingleBlock().
+type ErrCannotCheckpointTwiceInSingleBlock struct{}
+
+// Error implements the error interface.
+func (ErrCannotCheckpointTwiceInSingleBlock) Error() string {
+	return "IEigenPod: CannotCheckpointTwiceInSingleBlock()"
+}
+
+// ErrCheckpointAlreadyActive is the typed form of the IEigenPod custom error CheckpointAlreadyActive().
+type ErrCheckpointAlreadyActive struct{}
+
+// Error implements the error interface.
+func (ErrCheckpointAlreadyActive) Error() string {
+	return "IEigenPod: CheckpointAlreadyActive()"
+}
+
+// ErrCredentialsAlreadyVerified is the typed form of the IEigenPod custom error CredentialsAlreadyVerified().
+type ErrCredentialsAlreadyVerified struct{}
+
+// Error implements the error interface.
+func (ErrCredentialsAlreadyVerified) Error() string {
+	return "IEigenPod: CredentialsAlreadyVerified()"
+}
+
+// ErrCurrentlyPaused is the typed form of the IEigenPod custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "IEigenPod: CurrentlyPaused()"
+}
+
+// ErrFeeQueryFailed is the typed form of the IEigenPod custom error FeeQueryFailed().
+type ErrFeeQueryFailed struct{}
+
+// Error implements the error interface.
+func (ErrFeeQueryFailed) Error() string {
+	return "IEigenPod: FeeQueryFailed()"
+}
+
+// ErrForkTimestampZero is the typed form of the IEigenPod custom error ForkTimestampZero().
+type ErrForkTimestampZero struct{}
+
+// Error implements the error interface.
+func (ErrForkTimestampZero) Error() string {
+	return "IEigenPod: ForkTimestampZero()"
+}
+
+// ErrInputAddressZero is the typed form of the IEigenPod custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "IEigenPod: InputAddressZero()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the IEigenPod custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "IEigenPod: InputArrayLengthMismatch()"
+}
+
+// ErrInsufficientFunds is the typed form of the IEigenPod custom error InsufficientFunds().
+type ErrInsufficientFunds struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientFunds) Error() string {
+	return "IEigenPod: InsufficientFunds()"
+}
+
+// ErrInsufficientWithdrawableBalance is the typed form of the IEigenPod custom error InsufficientWithdrawableBalance().
+type ErrInsufficientWithdrawableBalance struct{}
+
+// Error implements the error interface.
+func (ErrInsufficientWithdrawableBalance) Error() string {
+	return "IEigenPod: InsufficientWithdrawableBalance()"
+}
+
+// ErrInvalidEIP4788Response is the typed form of the IEigenPod custom error InvalidEIP4788Response().
+type ErrInvalidEIP4788Response struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEIP4788Response) Error() string {
+	return "IEigenPod: InvalidEIP4788Response()"
+}
+
+// ErrInvalidPubKeyLength is the typed form of the IEigenPod custom error InvalidPubKeyLength().
+type ErrInvalidPubKeyLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPubKeyLength) Error() string {
+	return "IEigenPod: InvalidPubKeyLength()"
+}
+
+// ErrMsgValueNot32ETH is the typed form of the IEigenPod custom error MsgValueNot32ETH().
+type ErrMsgValueNot32ETH struct{}
+
+// Error implements the error interface.
+func (ErrMsgValueNot32ETH) Error() string {
+	return "IEigenPod: MsgValueNot32ETH()"
+}
+
+// ErrNoActiveCheckpoint is the typed form of the IEigenPod custom error NoActiveCheckpoint().
+type ErrNoActiveCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrNoActiveCheckpoint) Error() string {
+	return "IEigenPod: NoActiveCheckpoint()"
+}
+
+// ErrNoBalanceToCheckpoint is the typed form of the IEigenPod custom error NoBalanceToCheckpoint().
+type ErrNoBalanceToCheckpoint struct{}
+
+// Error implements the error interface.
+func (ErrNoBalanceToCheckpoint) Error() string {
+	return "IEigenPod: NoBalanceToCheckpoint()"
+}
+
+// ErrOnlyEigenPodManager is the typed form of the IEigenPod custom error OnlyEigenPodManager().
+type ErrOnlyEigenPodManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodManager) Error() string {
+	return "IEigenPod: OnlyEigenPodManager()"
+}
+
+// ErrOnlyEigenPodOwner is the typed form of the IEigenPod custom error OnlyEigenPodOwner().
+type ErrOnlyEigenPodOwner struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodOwner) Error() string {
+	return "IEigenPod: OnlyEigenPodOwner()"
+}
+
+// ErrOnlyEigenPodOwnerOrProofSubmitter is the typed form of the IEigenPod custom error OnlyEigenPodOwnerOrProofSubmitter().
+type ErrOnlyEigenPodOwnerOrProofSubmitter struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPodOwnerOrProofSubmitter) Error() string {
+	return "IEigenPod: OnlyEigenPodOwnerOrProofSubmitter()"
+}
+
+// ErrPredeployFailed is the typed form of the IEigenPod custom error PredeployFailed().
+type ErrPredeployFailed struct{}
+
+// Error implements the error interface.
+func (ErrPredeployFailed) Error() string {
+	return "IEigenPod: PredeployFailed()"
+}
+
+// ErrTimestampOutOfRange is the typed form of the IEigenPod custom error TimestampOutOfRange().
+type ErrTimestampOutOfRange struct{}
+
+// Error implements the error interface.
+func (ErrTimestampOutOfRange) Error() string {
+	return "IEigenPod: TimestampOutOfRange()"
+}
+
+// ErrValidatorInactiveOnBeaconChain is the typed form of the IEigenPod custom error ValidatorInactiveOnBeaconChain().
+type ErrValidatorInactiveOnBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorInactiveOnBeaconChain) Error() string {
+	return "IEigenPod: ValidatorInactiveOnBeaconChain()"
+}
+
+// ErrValidatorIsExitingBeaconChain is the typed form of the IEigenPod custom error ValidatorIsExitingBeaconChain().
+type ErrValidatorIsExitingBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorIsExitingBeaconChain) Error() string {
+	return "IEigenPod: ValidatorIsExitingBeaconChain()"
+}
+
+// ErrValidatorNotActiveInPod is the typed form of the IEigenPod custom error ValidatorNotActiveInPod().
+type ErrValidatorNotActiveInPod struct{}
+
+// Error implements the error interface.
+func (ErrValidatorNotActiveInPod) Error() string {
+	return "IEigenPod: ValidatorNotActiveInPod()"
+}
+
+// ErrValidatorNotSlashedOnBeaconChain is the typed form of the IEigenPod custom error ValidatorNotSlashedOnBeaconChain().
+type ErrValidatorNotSlashedOnBeaconChain struct{}
+
+// Error implements the error interface.
+func (ErrValidatorNotSlashedOnBeaconChain) Error() string {
+	return "IEigenPod: ValidatorNotSlashedOnBeaconChain()"
+}
+
+// ErrWithdrawalCredentialsNotForEigenPod is the typed form of the IEigenPod custom error WithdrawalCredentialsNotForEigenPod().
+type ErrWithdrawalCredentialsNotForEigenPod struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalCredentialsNotForEigenPod) Error() string {
+	return "IEigenPod: WithdrawalCredentialsNotForEigenPod()"
+}
+
+// IEigenPodErrors maps the name of every custom error in the IEigenPod ABI to its typed Go error.
+var IEigenPodErrors = map[string]error{
+	"BeaconTimestampBeforeLatestCheckpoint": ErrBeaconTimestampBeforeLatestCheckpoint{},
+	"BeaconTimestampTooFarInPast":           ErrBeaconTimestampTooFarInPast{},
+	"CannotCheckpointTwiceInSingleBlock":    ErrCannotCheckpointTwiceInSingleBlock{},
+	"CheckpointAlreadyActive":               ErrCheckpointAlreadyActive{},
+	"CredentialsAlreadyVerified":            ErrCredentialsAlreadyVerified{},
+	"CurrentlyPaused":                       ErrCurrentlyPaused{},
+	"FeeQueryFailed":                        ErrFeeQueryFailed{},
+	"ForkTimestampZero":                     ErrForkTimestampZero{},
+	"InputAddressZero":                      ErrInputAddressZero{},
+	"InputArrayLengthMismatch":              ErrInputArrayLengthMismatch{},
+	"InsufficientFunds":                     ErrInsufficientFunds{},
+	"InsufficientWithdrawableBalance":       ErrInsufficientWithdrawableBalance{},
+	"InvalidEIP4788Response":                ErrInvalidEIP4788Response{},
+	"InvalidPubKeyLength":                   ErrInvalidPubKeyLength{},
+	"MsgValueNot32ETH":                      ErrMsgValueNot32ETH{},
+	"NoActiveCheckpoint":                    ErrNoActiveCheckpoint{},
+	"NoBalanceToCheckpoint":                 ErrNoBalanceToCheckpoint{},
+	"OnlyEigenPodManager":                   ErrOnlyEigenPodManager{},
+	"OnlyEigenPodOwner":                     ErrOnlyEigenPodOwner{},
+	"OnlyEigenPodOwnerOrProofSubmitter":     ErrOnlyEigenPodOwnerOrProofSubmitter{},
+	"PredeployFailed":                       ErrPredeployFailed{},
+	"TimestampOutOfRange":                   ErrTimestampOutOfRange{},
+	"ValidatorInactiveOnBeaconChain":        ErrValidatorInactiveOnBeaconChain{},
+	"ValidatorIsExitingBeaconChain":         ErrValidatorIsExitingBeaconChain{},
+	"ValidatorNotActiveInPod":               ErrValidatorNotActiveInPod{},
+	"ValidatorNotSlashedOnBeaconChain":      ErrValidatorNotSlashedOnBeaconChain{},
+	"WithdrawalCredentialsNotForEigenPod":   ErrWithdrawalCredentialsNotForEigenPod{},
+}
@@ -0,0 +1,468 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigenPod
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIEigenPod is an in-memory IEigenPod for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IEigenPodFilterer.
+type FakeIEigenPod struct {
+	*fake.Contract
+	*IEigenPodFilterer
+}
+
+// NewFakeIEigenPod returns an empty fake of the contract at address.
+func NewFakeIEigenPod(address common.Address) *FakeIEigenPod {
+	contract := fake.NewContract(IEigenPodMetaData, address)
+	filterer, err := NewIEigenPodFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIEigenPod{Contract: contract, IEigenPodFilterer: filterer}
+}
+
+// ActiveValidatorCount returns the values stubbed with StubActiveValidatorCount.
+func (_FakeIEigenPod *FakeIEigenPod) ActiveValidatorCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigenPod.Contract.Read("activeValidatorCount", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubActiveValidatorCount sets what ActiveValidatorCount returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubActiveValidatorCount(out0 *big.Int) {
+	_FakeIEigenPod.Contract.Stub("activeValidatorCount", []interface{}{}, out0)
+}
+
+// CheckpointBalanceExitedGwei returns the values stubbed with StubCheckpointBalanceExitedGwei.
+func (_FakeIEigenPod *FakeIEigenPod) CheckpointBalanceExitedGwei(opts *bind.CallOpts, arg0 uint64) (uint64, error) {
+	var out0 uint64
+	err := _FakeIEigenPod.Contract.Read("checkpointBalanceExitedGwei", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubCheckpointBalanceExitedGwei sets what CheckpointBalanceExitedGwei returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubCheckpointBalanceExitedGwei(arg0 uint64, out0 uint64) {
+	_FakeIEigenPod.Contract.Stub("checkpointBalanceExitedGwei", []interface{}{arg0}, out0)
+}
+
+// CurrentCheckpoint returns the values stubbed with StubCurrentCheckpoint.
+func (_FakeIEigenPod *FakeIEigenPod) CurrentCheckpoint(opts *bind.CallOpts) (IEigenPodTypesCheckpoint, error) {
+	var out0 IEigenPodTypesCheckpoint
+	err := _FakeIEigenPod.Contract.Read("currentCheckpoint", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrentCheckpoint sets what CurrentCheckpoint returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubCurrentCheckpoint(out0 IEigenPodTypesCheckpoint) {
+	_FakeIEigenPod.Contract.Stub("currentCheckpoint", []interface{}{}, out0)
+}
+
+// CurrentCheckpointTimestamp returns the values stubbed with StubCurrentCheckpointTimestamp.
+func (_FakeIEigenPod *FakeIEigenPod) CurrentCheckpointTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeIEigenPod.Contract.Read("currentCheckpointTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrentCheckpointTimestamp sets what CurrentCheckpointTimestamp returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubCurrentCheckpointTimestamp(out0 uint64) {
+	_FakeIEigenPod.Contract.Stub("currentCheckpointTimestamp", []interface{}{}, out0)
+}
+
+// EigenPodManager returns the values stubbed with StubEigenPodManager.
+func (_FakeIEigenPod *FakeIEigenPod) EigenPodManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPod.Contract.Read("eigenPodManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenPodManager sets what EigenPodManager returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubEigenPodManager(out0 common.Address) {
+	_FakeIEigenPod.Contract.Stub("eigenPodManager", []interface{}{}, out0)
+}
+
+// GetConsolidationRequestFee returns the values stubbed with StubGetConsolidationRequestFee.
+func (_FakeIEigenPod *FakeIEigenPod) GetConsolidationRequestFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigenPod.Contract.Read("getConsolidationRequestFee", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetConsolidationRequestFee sets what GetConsolidationRequestFee returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubGetConsolidationRequestFee(out0 *big.Int) {
+	_FakeIEigenPod.Contract.Stub("getConsolidationRequestFee", []interface{}{}, out0)
+}
+
+// GetParentBlockRoot returns the values stubbed with StubGetParentBlockRoot.
+func (_FakeIEigenPod *FakeIEigenPod) GetParentBlockRoot(opts *bind.CallOpts, timestamp uint64) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIEigenPod.Contract.Read("getParentBlockRoot", []interface{}{timestamp}, &out0)
+	return out0, err
+}
+
+// StubGetParentBlockRoot sets what GetParentBlockRoot returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubGetParentBlockRoot(timestamp uint64, out0 [32]byte) {
+	_FakeIEigenPod.Contract.Stub("getParentBlockRoot", []interface{}{timestamp}, out0)
+}
+
+// GetWithdrawalRequestFee returns the values stubbed with StubGetWithdrawalRequestFee.
+func (_FakeIEigenPod *FakeIEigenPod) GetWithdrawalRequestFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigenPod.Contract.Read("getWithdrawalRequestFee", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetWithdrawalRequestFee sets what GetWithdrawalRequestFee returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubGetWithdrawalRequestFee(out0 *big.Int) {
+	_FakeIEigenPod.Contract.Stub("getWithdrawalRequestFee", []interface{}{}, out0)
+}
+
+// LastCheckpointTimestamp returns the values stubbed with StubLastCheckpointTimestamp.
+func (_FakeIEigenPod *FakeIEigenPod) LastCheckpointTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeIEigenPod.Contract.Read("lastCheckpointTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubLastCheckpointTimestamp sets what LastCheckpointTimestamp returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubLastCheckpointTimestamp(out0 uint64) {
+	_FakeIEigenPod.Contract.Stub("lastCheckpointTimestamp", []interface{}{}, out0)
+}
+
+// PodOwner returns the values stubbed with StubPodOwner.
+func (_FakeIEigenPod *FakeIEigenPod) PodOwner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPod.Contract.Read("podOwner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPodOwner sets what PodOwner returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubPodOwner(out0 common.Address) {
+	_FakeIEigenPod.Contract.Stub("podOwner", []interface{}{}, out0)
+}
+
+// ProofSubmitter returns the values stubbed with StubProofSubmitter.
+func (_FakeIEigenPod *FakeIEigenPod) ProofSubmitter(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPod.Contract.Read("proofSubmitter", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubProofSubmitter sets what ProofSubmitter returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubProofSubmitter(out0 common.Address) {
+	_FakeIEigenPod.Contract.Stub("proofSubmitter", []interface{}{}, out0)
+}
+
+// ValidatorPubkeyHashToInfo returns the values stubbed with StubValidatorPubkeyHashToInfo.
+func (_FakeIEigenPod *FakeIEigenPod) ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (IEigenPodTypesValidatorInfo, error) {
+	var out0 IEigenPodTypesValidatorInfo
+	err := _FakeIEigenPod.Contract.Read("validatorPubkeyHashToInfo", []interface{}{validatorPubkeyHash}, &out0)
+	return out0, err
+}
+
+// StubValidatorPubkeyHashToInfo sets what ValidatorPubkeyHashToInfo returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubValidatorPubkeyHashToInfo(validatorPubkeyHash [32]byte, out0 IEigenPodTypesValidatorInfo) {
+	_FakeIEigenPod.Contract.Stub("validatorPubkeyHashToInfo", []interface{}{validatorPubkeyHash}, out0)
+}
+
+// ValidatorPubkeyToInfo returns the values stubbed with StubValidatorPubkeyToInfo.
+func (_FakeIEigenPod *FakeIEigenPod) ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (IEigenPodTypesValidatorInfo, error) {
+	var out0 IEigenPodTypesValidatorInfo
+	err := _FakeIEigenPod.Contract.Read("validatorPubkeyToInfo", []interface{}{validatorPubkey}, &out0)
+	return out0, err
+}
+
+// StubValidatorPubkeyToInfo sets what ValidatorPubkeyToInfo returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubValidatorPubkeyToInfo(validatorPubkey []byte, out0 IEigenPodTypesValidatorInfo) {
+	_FakeIEigenPod.Contract.Stub("validatorPubkeyToInfo", []interface{}{validatorPubkey}, out0)
+}
+
+// ValidatorStatus returns the values stubbed with StubValidatorStatus.
+func (_FakeIEigenPod *FakeIEigenPod) ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeIEigenPod.Contract.Read("validatorStatus", []interface{}{validatorPubkey}, &out0)
+	return out0, err
+}
+
+// StubValidatorStatus sets what ValidatorStatus returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubValidatorStatus(validatorPubkey []byte, out0 uint8) {
+	_FakeIEigenPod.Contract.Stub("validatorStatus", []interface{}{validatorPubkey}, out0)
+}
+
+// ValidatorStatus0 returns the values stubbed with StubValidatorStatus0.
+func (_FakeIEigenPod *FakeIEigenPod) ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeIEigenPod.Contract.Read("validatorStatus0", []interface{}{pubkeyHash}, &out0)
+	return out0, err
+}
+
+// StubValidatorStatus0 sets what ValidatorStatus0 returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubValidatorStatus0(pubkeyHash [32]byte, out0 uint8) {
+	_FakeIEigenPod.Contract.Stub("validatorStatus0", []interface{}{pubkeyHash}, out0)
+}
+
+// WithdrawableRestakedExecutionLayerGwei returns the values stubbed with StubWithdrawableRestakedExecutionLayerGwei.
+func (_FakeIEigenPod *FakeIEigenPod) WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeIEigenPod.Contract.Read("withdrawableRestakedExecutionLayerGwei", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubWithdrawableRestakedExecutionLayerGwei sets what WithdrawableRestakedExecutionLayerGwei returns for the given arguments.
+func (_FakeIEigenPod *FakeIEigenPod) StubWithdrawableRestakedExecutionLayerGwei(out0 uint64) {
+	_FakeIEigenPod.Contract.Stub("withdrawableRestakedExecutionLayerGwei", []interface{}{}, out0)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeIEigenPod *FakeIEigenPod) Initialize(opts *bind.TransactOpts, owner common.Address) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("initialize").(func(opts *bind.TransactOpts, owner common.Address) error); ok {
+			return hook(opts, owner)
+		}
+		return nil
+	}, owner)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnInitialize(hook func(opts *bind.TransactOpts, owner common.Address) error) {
+	_FakeIEigenPod.Contract.SetHook("initialize", hook)
+}
+
+// RecoverTokens records a recoverTokens call after running the hook set with OnRecoverTokens.
+func (_FakeIEigenPod *FakeIEigenPod) RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "recoverTokens", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("recoverTokens").(func(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) error); ok {
+			return hook(opts, tokenList, amountsToWithdraw, recipient)
+		}
+		return nil
+	}, tokenList, amountsToWithdraw, recipient)
+}
+
+// OnRecoverTokens sets a hook run by every RecoverTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnRecoverTokens(hook func(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) error) {
+	_FakeIEigenPod.Contract.SetHook("recoverTokens", hook)
+}
+
+// RequestConsolidation records a requestConsolidation call after running the hook set with OnRequestConsolidation.
+func (_FakeIEigenPod *FakeIEigenPod) RequestConsolidation(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "requestConsolidation", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("requestConsolidation").(func(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) error); ok {
+			return hook(opts, requests)
+		}
+		return nil
+	}, requests)
+}
+
+// OnRequestConsolidation sets a hook run by every RequestConsolidation call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnRequestConsolidation(hook func(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) error) {
+	_FakeIEigenPod.Contract.SetHook("requestConsolidation", hook)
+}
+
+// RequestWithdrawal records a requestWithdrawal call after running the hook set with OnRequestWithdrawal.
+func (_FakeIEigenPod *FakeIEigenPod) RequestWithdrawal(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "requestWithdrawal", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("requestWithdrawal").(func(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) error); ok {
+			return hook(opts, requests)
+		}
+		return nil
+	}, requests)
+}
+
+// OnRequestWithdrawal sets a hook run by every RequestWithdrawal call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnRequestWithdrawal(hook func(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) error) {
+	_FakeIEigenPod.Contract.SetHook("requestWithdrawal", hook)
+}
+
+// SetProofSubmitter records a setProofSubmitter call after running the hook set with OnSetProofSubmitter.
+func (_FakeIEigenPod *FakeIEigenPod) SetProofSubmitter(opts *bind.TransactOpts, newProofSubmitter common.Address) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "setProofSubmitter", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("setProofSubmitter").(func(opts *bind.TransactOpts, newProofSubmitter common.Address) error); ok {
+			return hook(opts, newProofSubmitter)
+		}
+		return nil
+	}, newProofSubmitter)
+}
+
+// OnSetProofSubmitter sets a hook run by every SetProofSubmitter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnSetProofSubmitter(hook func(opts *bind.TransactOpts, newProofSubmitter common.Address) error) {
+	_FakeIEigenPod.Contract.SetHook("setProofSubmitter", hook)
+}
+
+// Stake records a stake call after running the hook set with OnStake.
+func (_FakeIEigenPod *FakeIEigenPod) Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "stake", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("stake").(func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error); ok {
+			return hook(opts, pubkey, signature, depositDataRoot)
+		}
+		return nil
+	}, pubkey, signature, depositDataRoot)
+}
+
+// OnStake sets a hook run by every Stake call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnStake(hook func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error) {
+	_FakeIEigenPod.Contract.SetHook("stake", hook)
+}
+
+// StartCheckpoint records a startCheckpoint call after running the hook set with OnStartCheckpoint.
+func (_FakeIEigenPod *FakeIEigenPod) StartCheckpoint(opts *bind.TransactOpts, revertIfNoBalance bool) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "startCheckpoint", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("startCheckpoint").(func(opts *bind.TransactOpts, revertIfNoBalance bool) error); ok {
+			return hook(opts, revertIfNoBalance)
+		}
+		return nil
+	}, revertIfNoBalance)
+}
+
+// OnStartCheckpoint sets a hook run by every StartCheckpoint call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnStartCheckpoint(hook func(opts *bind.TransactOpts, revertIfNoBalance bool) error) {
+	_FakeIEigenPod.Contract.SetHook("startCheckpoint", hook)
+}
+
+// VerifyCheckpointProofs records a verifyCheckpointProofs call after running the hook set with OnVerifyCheckpointProofs.
+func (_FakeIEigenPod *FakeIEigenPod) VerifyCheckpointProofs(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "verifyCheckpointProofs", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("verifyCheckpointProofs").(func(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) error); ok {
+			return hook(opts, balanceContainerProof, proofs)
+		}
+		return nil
+	}, balanceContainerProof, proofs)
+}
+
+// OnVerifyCheckpointProofs sets a hook run by every VerifyCheckpointProofs call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnVerifyCheckpointProofs(hook func(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) error) {
+	_FakeIEigenPod.Contract.SetHook("verifyCheckpointProofs", hook)
+}
+
+// VerifyStaleBalance records a verifyStaleBalance call after running the hook set with OnVerifyStaleBalance.
+func (_FakeIEigenPod *FakeIEigenPod) VerifyStaleBalance(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "verifyStaleBalance", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("verifyStaleBalance").(func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) error); ok {
+			return hook(opts, beaconTimestamp, stateRootProof, proof)
+		}
+		return nil
+	}, beaconTimestamp, stateRootProof, proof)
+}
+
+// OnVerifyStaleBalance sets a hook run by every VerifyStaleBalance call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnVerifyStaleBalance(hook func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) error) {
+	_FakeIEigenPod.Contract.SetHook("verifyStaleBalance", hook)
+}
+
+// VerifyWithdrawalCredentials records a verifyWithdrawalCredentials call after running the hook set with OnVerifyWithdrawalCredentials.
+func (_FakeIEigenPod *FakeIEigenPod) VerifyWithdrawalCredentials(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "verifyWithdrawalCredentials", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("verifyWithdrawalCredentials").(func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) error); ok {
+			return hook(opts, beaconTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+		}
+		return nil
+	}, beaconTimestamp, stateRootProof, validatorIndices, validatorFieldsProofs, validatorFields)
+}
+
+// OnVerifyWithdrawalCredentials sets a hook run by every VerifyWithdrawalCredentials call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnVerifyWithdrawalCredentials(hook func(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) error) {
+	_FakeIEigenPod.Contract.SetHook("verifyWithdrawalCredentials", hook)
+}
+
+// WithdrawRestakedBeaconChainETH records a withdrawRestakedBeaconChainETH call after running the hook set with OnWithdrawRestakedBeaconChainETH.
+func (_FakeIEigenPod *FakeIEigenPod) WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIEigenPod.Contract.Transact(opts, "withdrawRestakedBeaconChainETH", func() error {
+		if hook, ok := _FakeIEigenPod.Contract.Hook("withdrawRestakedBeaconChainETH").(func(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) error); ok {
+			return hook(opts, recipient, amount)
+		}
+		return nil
+	}, recipient, amount)
+}
+
+// OnWithdrawRestakedBeaconChainETH sets a hook run by every WithdrawRestakedBeaconChainETH call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPod *FakeIEigenPod) OnWithdrawRestakedBeaconChainETH(hook func(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) error) {
+	_FakeIEigenPod.Contract.SetHook("withdrawRestakedBeaconChainETH", hook)
+}
+
+// EmitCheckpointCreated appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitCheckpointCreated(event *IEigenPodCheckpointCreated) {
+	_FakeIEigenPod.Contract.Emit("CheckpointCreated", event)
+}
+
+// EmitCheckpointFinalized appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitCheckpointFinalized(event *IEigenPodCheckpointFinalized) {
+	_FakeIEigenPod.Contract.Emit("CheckpointFinalized", event)
+}
+
+// EmitConsolidationRequested appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitConsolidationRequested(event *IEigenPodConsolidationRequested) {
+	_FakeIEigenPod.Contract.Emit("ConsolidationRequested", event)
+}
+
+// EmitEigenPodStaked appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitEigenPodStaked(event *IEigenPodEigenPodStaked) {
+	_FakeIEigenPod.Contract.Emit("EigenPodStaked", event)
+}
+
+// EmitExitRequested appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitExitRequested(event *IEigenPodExitRequested) {
+	_FakeIEigenPod.Contract.Emit("ExitRequested", event)
+}
+
+// EmitNonBeaconChainETHReceived appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitNonBeaconChainETHReceived(event *IEigenPodNonBeaconChainETHReceived) {
+	_FakeIEigenPod.Contract.Emit("NonBeaconChainETHReceived", event)
+}
+
+// EmitProofSubmitterUpdated appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitProofSubmitterUpdated(event *IEigenPodProofSubmitterUpdated) {
+	_FakeIEigenPod.Contract.Emit("ProofSubmitterUpdated", event)
+}
+
+// EmitRestakedBeaconChainETHWithdrawn appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitRestakedBeaconChainETHWithdrawn(event *IEigenPodRestakedBeaconChainETHWithdrawn) {
+	_FakeIEigenPod.Contract.Emit("RestakedBeaconChainETHWithdrawn", event)
+}
+
+// EmitSwitchToCompoundingRequested appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitSwitchToCompoundingRequested(event *IEigenPodSwitchToCompoundingRequested) {
+	_FakeIEigenPod.Contract.Emit("SwitchToCompoundingRequested", event)
+}
+
+// EmitValidatorBalanceUpdated appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitValidatorBalanceUpdated(event *IEigenPodValidatorBalanceUpdated) {
+	_FakeIEigenPod.Contract.Emit("ValidatorBalanceUpdated", event)
+}
+
+// EmitValidatorCheckpointed appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitValidatorCheckpointed(event *IEigenPodValidatorCheckpointed) {
+	_FakeIEigenPod.Contract.Emit("ValidatorCheckpointed", event)
+}
+
+// EmitValidatorRestaked appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitValidatorRestaked(event *IEigenPodValidatorRestaked) {
+	_FakeIEigenPod.Contract.Emit("ValidatorRestaked", event)
+}
+
+// EmitValidatorWithdrawn appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitValidatorWithdrawn(event *IEigenPodValidatorWithdrawn) {
+	_FakeIEigenPod.Contract.Emit("ValidatorWithdrawn", event)
+}
+
+// EmitWithdrawalRequested appends the event to the fake's logs.
+func (_FakeIEigenPod *FakeIEigenPod) EmitWithdrawalRequested(event *IEigenPodWithdrawalRequested) {
+	_FakeIEigenPod.Contract.Emit("WithdrawalRequested", event)
+}
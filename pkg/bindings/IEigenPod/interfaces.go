@@ -0,0 +1,107 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigenPod
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IEigenPodReader is the read-only surface of the IEigenPod
+// binding, implemented by IEigenPodCaller, IEigenPod and FakeIEigenPod.
+type IEigenPodReader interface {
+	ActiveValidatorCount(opts *bind.CallOpts) (*big.Int, error)
+	CheckpointBalanceExitedGwei(opts *bind.CallOpts, arg0 uint64) (uint64, error)
+	CurrentCheckpoint(opts *bind.CallOpts) (IEigenPodTypesCheckpoint, error)
+	CurrentCheckpointTimestamp(opts *bind.CallOpts) (uint64, error)
+	EigenPodManager(opts *bind.CallOpts) (common.Address, error)
+	GetConsolidationRequestFee(opts *bind.CallOpts) (*big.Int, error)
+	GetParentBlockRoot(opts *bind.CallOpts, timestamp uint64) ([32]byte, error)
+	GetWithdrawalRequestFee(opts *bind.CallOpts) (*big.Int, error)
+	LastCheckpointTimestamp(opts *bind.CallOpts) (uint64, error)
+	PodOwner(opts *bind.CallOpts) (common.Address, error)
+	ProofSubmitter(opts *bind.CallOpts) (common.Address, error)
+	ValidatorPubkeyHashToInfo(opts *bind.CallOpts, validatorPubkeyHash [32]byte) (IEigenPodTypesValidatorInfo, error)
+	ValidatorPubkeyToInfo(opts *bind.CallOpts, validatorPubkey []byte) (IEigenPodTypesValidatorInfo, error)
+	ValidatorStatus(opts *bind.CallOpts, validatorPubkey []byte) (uint8, error)
+	ValidatorStatus0(opts *bind.CallOpts, pubkeyHash [32]byte) (uint8, error)
+	WithdrawableRestakedExecutionLayerGwei(opts *bind.CallOpts) (uint64, error)
+}
+
+// IEigenPodWriter is the transacting surface of the IEigenPod
+// binding, implemented by IEigenPodTransactor, IEigenPod and FakeIEigenPod.
+type IEigenPodWriter interface {
+	Initialize(opts *bind.TransactOpts, owner common.Address) (*types.Transaction, error)
+	RecoverTokens(opts *bind.TransactOpts, tokenList []common.Address, amountsToWithdraw []*big.Int, recipient common.Address) (*types.Transaction, error)
+	RequestConsolidation(opts *bind.TransactOpts, requests []IEigenPodTypesConsolidationRequest) (*types.Transaction, error)
+	RequestWithdrawal(opts *bind.TransactOpts, requests []IEigenPodTypesWithdrawalRequest) (*types.Transaction, error)
+	SetProofSubmitter(opts *bind.TransactOpts, newProofSubmitter common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	StartCheckpoint(opts *bind.TransactOpts, revertIfNoBalance bool) (*types.Transaction, error)
+	VerifyCheckpointProofs(opts *bind.TransactOpts, balanceContainerProof BeaconChainProofsBalanceContainerProof, proofs []BeaconChainProofsBalanceProof) (*types.Transaction, error)
+	VerifyStaleBalance(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, proof BeaconChainProofsValidatorProof) (*types.Transaction, error)
+	VerifyWithdrawalCredentials(opts *bind.TransactOpts, beaconTimestamp uint64, stateRootProof BeaconChainProofsStateRootProof, validatorIndices []*big.Int, validatorFieldsProofs [][]byte, validatorFields [][][32]byte) (*types.Transaction, error)
+	WithdrawRestakedBeaconChainETH(opts *bind.TransactOpts, recipient common.Address, amount *big.Int) (*types.Transaction, error)
+}
+
+// IEigenPodEvents is the event filtering surface of the IEigenPod
+// binding, implemented by IEigenPodFilterer, IEigenPod and FakeIEigenPod.
+type IEigenPodEvents interface {
+	FilterCheckpointCreated(opts *bind.FilterOpts, checkpointTimestamp []uint64, beaconBlockRoot [][32]byte) (*IEigenPodCheckpointCreatedIterator, error)
+	WatchCheckpointCreated(opts *bind.WatchOpts, sink chan<- *IEigenPodCheckpointCreated, checkpointTimestamp []uint64, beaconBlockRoot [][32]byte) (event.Subscription, error)
+	ParseCheckpointCreated(log types.Log) (*IEigenPodCheckpointCreated, error)
+	FilterCheckpointFinalized(opts *bind.FilterOpts, checkpointTimestamp []uint64) (*IEigenPodCheckpointFinalizedIterator, error)
+	WatchCheckpointFinalized(opts *bind.WatchOpts, sink chan<- *IEigenPodCheckpointFinalized, checkpointTimestamp []uint64) (event.Subscription, error)
+	ParseCheckpointFinalized(log types.Log) (*IEigenPodCheckpointFinalized, error)
+	FilterConsolidationRequested(opts *bind.FilterOpts, sourcePubkeyHash [][32]byte, targetPubkeyHash [][32]byte) (*IEigenPodConsolidationRequestedIterator, error)
+	WatchConsolidationRequested(opts *bind.WatchOpts, sink chan<- *IEigenPodConsolidationRequested, sourcePubkeyHash [][32]byte, targetPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseConsolidationRequested(log types.Log) (*IEigenPodConsolidationRequested, error)
+	FilterEigenPodStaked(opts *bind.FilterOpts) (*IEigenPodEigenPodStakedIterator, error)
+	WatchEigenPodStaked(opts *bind.WatchOpts, sink chan<- *IEigenPodEigenPodStaked) (event.Subscription, error)
+	ParseEigenPodStaked(log types.Log) (*IEigenPodEigenPodStaked, error)
+	FilterExitRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*IEigenPodExitRequestedIterator, error)
+	WatchExitRequested(opts *bind.WatchOpts, sink chan<- *IEigenPodExitRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseExitRequested(log types.Log) (*IEigenPodExitRequested, error)
+	FilterNonBeaconChainETHReceived(opts *bind.FilterOpts) (*IEigenPodNonBeaconChainETHReceivedIterator, error)
+	WatchNonBeaconChainETHReceived(opts *bind.WatchOpts, sink chan<- *IEigenPodNonBeaconChainETHReceived) (event.Subscription, error)
+	ParseNonBeaconChainETHReceived(log types.Log) (*IEigenPodNonBeaconChainETHReceived, error)
+	FilterProofSubmitterUpdated(opts *bind.FilterOpts) (*IEigenPodProofSubmitterUpdatedIterator, error)
+	WatchProofSubmitterUpdated(opts *bind.WatchOpts, sink chan<- *IEigenPodProofSubmitterUpdated) (event.Subscription, error)
+	ParseProofSubmitterUpdated(log types.Log) (*IEigenPodProofSubmitterUpdated, error)
+	FilterRestakedBeaconChainETHWithdrawn(opts *bind.FilterOpts, recipient []common.Address) (*IEigenPodRestakedBeaconChainETHWithdrawnIterator, error)
+	WatchRestakedBeaconChainETHWithdrawn(opts *bind.WatchOpts, sink chan<- *IEigenPodRestakedBeaconChainETHWithdrawn, recipient []common.Address) (event.Subscription, error)
+	ParseRestakedBeaconChainETHWithdrawn(log types.Log) (*IEigenPodRestakedBeaconChainETHWithdrawn, error)
+	FilterSwitchToCompoundingRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*IEigenPodSwitchToCompoundingRequestedIterator, error)
+	WatchSwitchToCompoundingRequested(opts *bind.WatchOpts, sink chan<- *IEigenPodSwitchToCompoundingRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseSwitchToCompoundingRequested(log types.Log) (*IEigenPodSwitchToCompoundingRequested, error)
+	FilterValidatorBalanceUpdated(opts *bind.FilterOpts) (*IEigenPodValidatorBalanceUpdatedIterator, error)
+	WatchValidatorBalanceUpdated(opts *bind.WatchOpts, sink chan<- *IEigenPodValidatorBalanceUpdated) (event.Subscription, error)
+	ParseValidatorBalanceUpdated(log types.Log) (*IEigenPodValidatorBalanceUpdated, error)
+	FilterValidatorCheckpointed(opts *bind.FilterOpts, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (*IEigenPodValidatorCheckpointedIterator, error)
+	WatchValidatorCheckpointed(opts *bind.WatchOpts, sink chan<- *IEigenPodValidatorCheckpointed, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (event.Subscription, error)
+	ParseValidatorCheckpointed(log types.Log) (*IEigenPodValidatorCheckpointed, error)
+	FilterValidatorRestaked(opts *bind.FilterOpts) (*IEigenPodValidatorRestakedIterator, error)
+	WatchValidatorRestaked(opts *bind.WatchOpts, sink chan<- *IEigenPodValidatorRestaked) (event.Subscription, error)
+	ParseValidatorRestaked(log types.Log) (*IEigenPodValidatorRestaked, error)
+	FilterValidatorWithdrawn(opts *bind.FilterOpts, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (*IEigenPodValidatorWithdrawnIterator, error)
+	WatchValidatorWithdrawn(opts *bind.WatchOpts, sink chan<- *IEigenPodValidatorWithdrawn, checkpointTimestamp []uint64, pubkeyHash [][32]byte) (event.Subscription, error)
+	ParseValidatorWithdrawn(log types.Log) (*IEigenPodValidatorWithdrawn, error)
+	FilterWithdrawalRequested(opts *bind.FilterOpts, validatorPubkeyHash [][32]byte) (*IEigenPodWithdrawalRequestedIterator, error)
+	WatchWithdrawalRequested(opts *bind.WatchOpts, sink chan<- *IEigenPodWithdrawalRequested, validatorPubkeyHash [][32]byte) (event.Subscription, error)
+	ParseWithdrawalRequested(log types.Log) (*IEigenPodWithdrawalRequested, error)
+}
+
+var (
+	_ IEigenPodReader = (*IEigenPodCaller)(nil)
+	_ IEigenPodReader = (*IEigenPod)(nil)
+	_ IEigenPodReader = (*FakeIEigenPod)(nil)
+	_ IEigenPodWriter = (*IEigenPodTransactor)(nil)
+	_ IEigenPodWriter = (*IEigenPod)(nil)
+	_ IEigenPodWriter = (*FakeIEigenPod)(nil)
+	_ IEigenPodEvents = (*IEigenPodFilterer)(nil)
+	_ IEigenPodEvents = (*IEigenPod)(nil)
+	_ IEigenPodEvents = (*FakeIEigenPod)(nil)
+)
@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigenPodManager
+
+// ErrCurrentlyPaused is the typed form of the IEigenPodManager custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "IEigenPodManager: CurrentlyPaused()"
+}
+
+// ErrEigenPodAlreadyExists is the typed form of the IEigenPodManager custom error EigenPodAlreadyExists().
+type ErrEigenPodAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrEigenPodAlreadyExists) Error() string {
+	return "IEigenPodManager: EigenPodAlreadyExists()"
+}
+
+// ErrInputAddressZero is the typed form of the IEigenPodManager custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "IEigenPodManager: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the IEigenPodManager custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "IEigenPodManager: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidStrategy is the typed form of the IEigenPodManager custom error InvalidStrategy().
+type ErrInvalidStrategy struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStrategy) Error() string {
+	return "IEigenPodManager: InvalidStrategy()"
+}
+
+// ErrLegacyWithdrawalsNotCompleted is the typed form of the IEigenPodManager custom error LegacyWithdrawalsNotCompleted().
+type ErrLegacyWithdrawalsNotCompleted struct{}
+
+// Error implements the error interface.
+func (ErrLegacyWithdrawalsNotCompleted) Error() string {
+	return "IEigenPodManager: LegacyWithdrawalsNotCompleted()"
+}
+
+// ErrOnlyDelegationManager is the typed form of the IEigenPodManager custom error OnlyDelegationManager().
+type ErrOnlyDelegationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyDelegationManager) Error() string {
+	return "IEigenPodManager: OnlyDelegationManager()"
+}
+
+// ErrOnlyEigenPod is the typed form of the IEigenPodManager custom error OnlyEigenPod().
+type ErrOnlyEigenPod struct{}
+
+// Error implements the error interface.
+func (ErrOnlyEigenPod) Error() string {
+	return "IEigenPodManager: OnlyEigenPod()"
+}
+
+// ErrOnlyPauser is the typed form of the IEigenPodManager custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "IEigenPodManager: OnlyPauser()"
+}
+
+// ErrOnlyProofTimestampSetter is the typed form of the IEigenPodManager custom error OnlyProofTimestampSetter().
+type ErrOnlyProofTimestampSetter struct{}
+
+// Error implements the error interface.
+func (ErrOnlyProofTimestampSetter) Error() string {
+	return "IEigenPodManager: OnlyProofTimestampSetter()"
+}
+
+// ErrOnlyUnpauser is the typed form of the IEigenPodManager custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "IEigenPodManager: OnlyUnpauser()"
+}
+
+// ErrSharesNegative is the typed form of the IEigenPodManager custom error SharesNegative().
+type ErrSharesNegative struct{}
+
+// Error implements the error interface.
+func (ErrSharesNegative) Error() string {
+	return "IEigenPodManager: SharesNegative()"
+}
+
+// ErrSharesNotMultipleOfGwei is the typed form of the IEigenPodManager custom error SharesNotMultipleOfGwei().
+type ErrSharesNotMultipleOfGwei struct{}
+
+// Error implements the error interface.
+func (ErrSharesNotMultipleOfGwei) Error() string {
+	return "IEigenPodManager: SharesNotMultipleOfGwei()"
+}
+
+// IEigenPodManagerErrors maps the name of every custom error in the IEigenPodManager ABI to its typed Go error.
+var IEigenPodManagerErrors = map[string]error{
+	"CurrentlyPaused":               ErrCurrentlyPaused{},
+	"EigenPodAlreadyExists":         ErrEigenPodAlreadyExists{},
+	"InputAddressZero":              ErrInputAddressZero{},
+	"InvalidNewPausedStatus":        ErrInvalidNewPausedStatus{},
+	"InvalidStrategy":               ErrInvalidStrategy{},
+	"LegacyWithdrawalsNotCompleted": ErrLegacyWithdrawalsNotCompleted{},
+	"OnlyDelegationManager":         ErrOnlyDelegationManager{},
+	"OnlyEigenPod":                  ErrOnlyEigenPod{},
+	"OnlyPauser":                    ErrOnlyPauser{},
+	"OnlyProofTimestampSetter":      ErrOnlyProofTimestampSetter{},
+	"OnlyUnpauser":                  ErrOnlyUnpauser{},
+	"SharesNegative":                ErrSharesNegative{},
+	"SharesNotMultipleOfGwei":       ErrSharesNotMultipleOfGwei{},
+}
@@ -0,0 +1,457 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigenPodManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIEigenPodManager is an in-memory IEigenPodManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IEigenPodManagerFilterer.
+type FakeIEigenPodManager struct {
+	*fake.Contract
+	*IEigenPodManagerFilterer
+}
+
+// NewFakeIEigenPodManager returns an empty fake of the contract at address.
+func NewFakeIEigenPodManager(address common.Address) *FakeIEigenPodManager {
+	contract := fake.NewContract(IEigenPodManagerMetaData, address)
+	filterer, err := NewIEigenPodManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIEigenPodManager{Contract: contract, IEigenPodManagerFilterer: filterer}
+}
+
+// BeaconChainETHStrategy returns the values stubbed with StubBeaconChainETHStrategy.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPodManager.Contract.Read("beaconChainETHStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainETHStrategy sets what BeaconChainETHStrategy returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubBeaconChainETHStrategy(out0 common.Address) {
+	_FakeIEigenPodManager.Contract.Stub("beaconChainETHStrategy", []interface{}{}, out0)
+}
+
+// BeaconChainSlashingFactor returns the values stubbed with StubBeaconChainSlashingFactor.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) BeaconChainSlashingFactor(opts *bind.CallOpts, staker common.Address) (uint64, error) {
+	var out0 uint64
+	err := _FakeIEigenPodManager.Contract.Read("beaconChainSlashingFactor", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainSlashingFactor sets what BeaconChainSlashingFactor returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubBeaconChainSlashingFactor(staker common.Address, out0 uint64) {
+	_FakeIEigenPodManager.Contract.Stub("beaconChainSlashingFactor", []interface{}{staker}, out0)
+}
+
+// BurnableETHShares returns the values stubbed with StubBurnableETHShares.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) BurnableETHShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigenPodManager.Contract.Read("burnableETHShares", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBurnableETHShares sets what BurnableETHShares returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubBurnableETHShares(out0 *big.Int) {
+	_FakeIEigenPodManager.Contract.Stub("burnableETHShares", []interface{}{}, out0)
+}
+
+// EigenPodBeacon returns the values stubbed with StubEigenPodBeacon.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EigenPodBeacon(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPodManager.Contract.Read("eigenPodBeacon", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEigenPodBeacon sets what EigenPodBeacon returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubEigenPodBeacon(out0 common.Address) {
+	_FakeIEigenPodManager.Contract.Stub("eigenPodBeacon", []interface{}{}, out0)
+}
+
+// EthPOS returns the values stubbed with StubEthPOS.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EthPOS(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPodManager.Contract.Read("ethPOS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEthPOS sets what EthPOS returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubEthPOS(out0 common.Address) {
+	_FakeIEigenPodManager.Contract.Stub("ethPOS", []interface{}{}, out0)
+}
+
+// GetPod returns the values stubbed with StubGetPod.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPodManager.Contract.Read("getPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubGetPod sets what GetPod returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubGetPod(podOwner common.Address, out0 common.Address) {
+	_FakeIEigenPodManager.Contract.Stub("getPod", []interface{}{podOwner}, out0)
+}
+
+// HasPod returns the values stubbed with StubHasPod.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIEigenPodManager.Contract.Read("hasPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubHasPod sets what HasPod returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubHasPod(podOwner common.Address, out0 bool) {
+	_FakeIEigenPodManager.Contract.Stub("hasPod", []interface{}{podOwner}, out0)
+}
+
+// NumPods returns the values stubbed with StubNumPods.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) NumPods(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigenPodManager.Contract.Read("numPods", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubNumPods sets what NumPods returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubNumPods(out0 *big.Int) {
+	_FakeIEigenPodManager.Contract.Stub("numPods", []interface{}{}, out0)
+}
+
+// OwnerToPod returns the values stubbed with StubOwnerToPod.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OwnerToPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPodManager.Contract.Read("ownerToPod", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubOwnerToPod sets what OwnerToPod returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubOwnerToPod(podOwner common.Address, out0 common.Address) {
+	_FakeIEigenPodManager.Contract.Stub("ownerToPod", []interface{}{podOwner}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeIEigenPodManager.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubPaused(index uint8, out0 bool) {
+	_FakeIEigenPodManager.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigenPodManager.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubPaused0(out0 *big.Int) {
+	_FakeIEigenPodManager.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIEigenPodManager.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubPauserRegistry(out0 common.Address) {
+	_FakeIEigenPodManager.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// PectraForkTimestamp returns the values stubbed with StubPectraForkTimestamp.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) PectraForkTimestamp(opts *bind.CallOpts) (uint64, error) {
+	var out0 uint64
+	err := _FakeIEigenPodManager.Contract.Read("pectraForkTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPectraForkTimestamp sets what PectraForkTimestamp returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubPectraForkTimestamp(out0 uint64) {
+	_FakeIEigenPodManager.Contract.Stub("pectraForkTimestamp", []interface{}{}, out0)
+}
+
+// PodOwnerDepositShares returns the values stubbed with StubPodOwnerDepositShares.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) PodOwnerDepositShares(opts *bind.CallOpts, podOwner common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigenPodManager.Contract.Read("podOwnerDepositShares", []interface{}{podOwner}, &out0)
+	return out0, err
+}
+
+// StubPodOwnerDepositShares sets what PodOwnerDepositShares returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubPodOwnerDepositShares(podOwner common.Address, out0 *big.Int) {
+	_FakeIEigenPodManager.Contract.Stub("podOwnerDepositShares", []interface{}{podOwner}, out0)
+}
+
+// StakerDepositShares returns the values stubbed with StubStakerDepositShares.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIEigenPodManager.Contract.Read("stakerDepositShares", []interface{}{user, strategy}, &out0)
+	return out0, err
+}
+
+// StubStakerDepositShares sets what StakerDepositShares returns for the given arguments.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) StubStakerDepositShares(user common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeIEigenPodManager.Contract.Stub("stakerDepositShares", []interface{}{user, strategy}, out0)
+}
+
+// AddShares records a addShares call after running the hook set with OnAddShares.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "addShares", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("addShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, shares)
+		}
+		return nil
+	}, staker, strategy, shares)
+}
+
+// OnAddShares sets a hook run by every AddShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnAddShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error) {
+	_FakeIEigenPodManager.Contract.SetHook("addShares", hook)
+}
+
+// CreatePod records a createPod call after running the hook set with OnCreatePod.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) CreatePod(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "createPod", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("createPod").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnCreatePod sets a hook run by every CreatePod call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnCreatePod(hook func(opts *bind.TransactOpts) error) {
+	_FakeIEigenPodManager.Contract.SetHook("createPod", hook)
+}
+
+// IncreaseBurnOrRedistributableShares records a increaseBurnOrRedistributableShares call after running the hook set with OnIncreaseBurnOrRedistributableShares.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "increaseBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("increaseBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId, strategy, addedSharesToBurn)
+		}
+		return nil
+	}, operatorSet, slashId, strategy, addedSharesToBurn)
+}
+
+// OnIncreaseBurnOrRedistributableShares sets a hook run by every IncreaseBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnIncreaseBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error) {
+	_FakeIEigenPodManager.Contract.SetHook("increaseBurnOrRedistributableShares", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeIEigenPodManager.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeIEigenPodManager.Contract.SetHook("pauseAll", hook)
+}
+
+// RecordBeaconChainETHBalanceUpdate records a recordBeaconChainETHBalanceUpdate call after running the hook set with OnRecordBeaconChainETHBalanceUpdate.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "recordBeaconChainETHBalanceUpdate", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("recordBeaconChainETHBalanceUpdate").(func(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) error); ok {
+			return hook(opts, podOwner, prevRestakedBalanceWei, balanceDeltaWei)
+		}
+		return nil
+	}, podOwner, prevRestakedBalanceWei, balanceDeltaWei)
+}
+
+// OnRecordBeaconChainETHBalanceUpdate sets a hook run by every RecordBeaconChainETHBalanceUpdate call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnRecordBeaconChainETHBalanceUpdate(hook func(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) error) {
+	_FakeIEigenPodManager.Contract.SetHook("recordBeaconChainETHBalanceUpdate", hook)
+}
+
+// RemoveDepositShares records a removeDepositShares call after running the hook set with OnRemoveDepositShares.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "removeDepositShares", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("removeDepositShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error); ok {
+			return hook(opts, staker, strategy, depositSharesToRemove)
+		}
+		return nil
+	}, staker, strategy, depositSharesToRemove)
+}
+
+// OnRemoveDepositShares sets a hook run by every RemoveDepositShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnRemoveDepositShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error) {
+	_FakeIEigenPodManager.Contract.SetHook("removeDepositShares", hook)
+}
+
+// SetPectraForkTimestamp records a setPectraForkTimestamp call after running the hook set with OnSetPectraForkTimestamp.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) SetPectraForkTimestamp(opts *bind.TransactOpts, timestamp uint64) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "setPectraForkTimestamp", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("setPectraForkTimestamp").(func(opts *bind.TransactOpts, timestamp uint64) error); ok {
+			return hook(opts, timestamp)
+		}
+		return nil
+	}, timestamp)
+}
+
+// OnSetPectraForkTimestamp sets a hook run by every SetPectraForkTimestamp call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnSetPectraForkTimestamp(hook func(opts *bind.TransactOpts, timestamp uint64) error) {
+	_FakeIEigenPodManager.Contract.SetHook("setPectraForkTimestamp", hook)
+}
+
+// SetProofTimestampSetter records a setProofTimestampSetter call after running the hook set with OnSetProofTimestampSetter.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) SetProofTimestampSetter(opts *bind.TransactOpts, newProofTimestampSetter common.Address) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "setProofTimestampSetter", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("setProofTimestampSetter").(func(opts *bind.TransactOpts, newProofTimestampSetter common.Address) error); ok {
+			return hook(opts, newProofTimestampSetter)
+		}
+		return nil
+	}, newProofTimestampSetter)
+}
+
+// OnSetProofTimestampSetter sets a hook run by every SetProofTimestampSetter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnSetProofTimestampSetter(hook func(opts *bind.TransactOpts, newProofTimestampSetter common.Address) error) {
+	_FakeIEigenPodManager.Contract.SetHook("setProofTimestampSetter", hook)
+}
+
+// Stake records a stake call after running the hook set with OnStake.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "stake", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("stake").(func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error); ok {
+			return hook(opts, pubkey, signature, depositDataRoot)
+		}
+		return nil
+	}, pubkey, signature, depositDataRoot)
+}
+
+// OnStake sets a hook run by every Stake call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnStake(hook func(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) error) {
+	_FakeIEigenPodManager.Contract.SetHook("stake", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeIEigenPodManager.Contract.SetHook("unpause", hook)
+}
+
+// WithdrawSharesAsTokens records a withdrawSharesAsTokens call after running the hook set with OnWithdrawSharesAsTokens.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeIEigenPodManager.Contract.Transact(opts, "withdrawSharesAsTokens", func() error {
+		if hook, ok := _FakeIEigenPodManager.Contract.Hook("withdrawSharesAsTokens").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, token, shares)
+		}
+		return nil
+	}, staker, strategy, token, shares)
+}
+
+// OnWithdrawSharesAsTokens sets a hook run by every WithdrawSharesAsTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) OnWithdrawSharesAsTokens(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error) {
+	_FakeIEigenPodManager.Contract.SetHook("withdrawSharesAsTokens", hook)
+}
+
+// EmitBeaconChainETHDeposited appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitBeaconChainETHDeposited(event *IEigenPodManagerBeaconChainETHDeposited) {
+	_FakeIEigenPodManager.Contract.Emit("BeaconChainETHDeposited", event)
+}
+
+// EmitBeaconChainETHWithdrawalCompleted appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitBeaconChainETHWithdrawalCompleted(event *IEigenPodManagerBeaconChainETHWithdrawalCompleted) {
+	_FakeIEigenPodManager.Contract.Emit("BeaconChainETHWithdrawalCompleted", event)
+}
+
+// EmitBeaconChainSlashingFactorDecreased appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitBeaconChainSlashingFactorDecreased(event *IEigenPodManagerBeaconChainSlashingFactorDecreased) {
+	_FakeIEigenPodManager.Contract.Emit("BeaconChainSlashingFactorDecreased", event)
+}
+
+// EmitBurnableETHSharesIncreased appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitBurnableETHSharesIncreased(event *IEigenPodManagerBurnableETHSharesIncreased) {
+	_FakeIEigenPodManager.Contract.Emit("BurnableETHSharesIncreased", event)
+}
+
+// EmitNewTotalShares appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitNewTotalShares(event *IEigenPodManagerNewTotalShares) {
+	_FakeIEigenPodManager.Contract.Emit("NewTotalShares", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitPaused(event *IEigenPodManagerPaused) {
+	_FakeIEigenPodManager.Contract.Emit("Paused", event)
+}
+
+// EmitPectraForkTimestampSet appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitPectraForkTimestampSet(event *IEigenPodManagerPectraForkTimestampSet) {
+	_FakeIEigenPodManager.Contract.Emit("PectraForkTimestampSet", event)
+}
+
+// EmitPodDeployed appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitPodDeployed(event *IEigenPodManagerPodDeployed) {
+	_FakeIEigenPodManager.Contract.Emit("PodDeployed", event)
+}
+
+// EmitPodSharesUpdated appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitPodSharesUpdated(event *IEigenPodManagerPodSharesUpdated) {
+	_FakeIEigenPodManager.Contract.Emit("PodSharesUpdated", event)
+}
+
+// EmitProofTimestampSetterSet appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitProofTimestampSetterSet(event *IEigenPodManagerProofTimestampSetterSet) {
+	_FakeIEigenPodManager.Contract.Emit("ProofTimestampSetterSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeIEigenPodManager *FakeIEigenPodManager) EmitUnpaused(event *IEigenPodManagerUnpaused) {
+	_FakeIEigenPodManager.Contract.Emit("Unpaused", event)
+}
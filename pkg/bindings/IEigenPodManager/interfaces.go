@@ -0,0 +1,98 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IEigenPodManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IEigenPodManagerReader is the read-only surface of the IEigenPodManager
+// binding, implemented by IEigenPodManagerCaller, IEigenPodManager and FakeIEigenPodManager.
+type IEigenPodManagerReader interface {
+	BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error)
+	BeaconChainSlashingFactor(opts *bind.CallOpts, staker common.Address) (uint64, error)
+	BurnableETHShares(opts *bind.CallOpts) (*big.Int, error)
+	EigenPodBeacon(opts *bind.CallOpts) (common.Address, error)
+	EthPOS(opts *bind.CallOpts) (common.Address, error)
+	GetPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	HasPod(opts *bind.CallOpts, podOwner common.Address) (bool, error)
+	NumPods(opts *bind.CallOpts) (*big.Int, error)
+	OwnerToPod(opts *bind.CallOpts, podOwner common.Address) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	PectraForkTimestamp(opts *bind.CallOpts) (uint64, error)
+	PodOwnerDepositShares(opts *bind.CallOpts, podOwner common.Address) (*big.Int, error)
+	StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error)
+}
+
+// IEigenPodManagerWriter is the transacting surface of the IEigenPodManager
+// binding, implemented by IEigenPodManagerTransactor, IEigenPodManager and FakeIEigenPodManager.
+type IEigenPodManagerWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	CreatePod(opts *bind.TransactOpts) (*types.Transaction, error)
+	IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RecordBeaconChainETHBalanceUpdate(opts *bind.TransactOpts, podOwner common.Address, prevRestakedBalanceWei *big.Int, balanceDeltaWei *big.Int) (*types.Transaction, error)
+	RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error)
+	SetPectraForkTimestamp(opts *bind.TransactOpts, timestamp uint64) (*types.Transaction, error)
+	SetProofTimestampSetter(opts *bind.TransactOpts, newProofTimestampSetter common.Address) (*types.Transaction, error)
+	Stake(opts *bind.TransactOpts, pubkey []byte, signature []byte, depositDataRoot [32]byte) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+// IEigenPodManagerEvents is the event filtering surface of the IEigenPodManager
+// binding, implemented by IEigenPodManagerFilterer, IEigenPodManager and FakeIEigenPodManager.
+type IEigenPodManagerEvents interface {
+	FilterBeaconChainETHDeposited(opts *bind.FilterOpts, podOwner []common.Address) (*IEigenPodManagerBeaconChainETHDepositedIterator, error)
+	WatchBeaconChainETHDeposited(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerBeaconChainETHDeposited, podOwner []common.Address) (event.Subscription, error)
+	ParseBeaconChainETHDeposited(log types.Log) (*IEigenPodManagerBeaconChainETHDeposited, error)
+	FilterBeaconChainETHWithdrawalCompleted(opts *bind.FilterOpts, podOwner []common.Address) (*IEigenPodManagerBeaconChainETHWithdrawalCompletedIterator, error)
+	WatchBeaconChainETHWithdrawalCompleted(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerBeaconChainETHWithdrawalCompleted, podOwner []common.Address) (event.Subscription, error)
+	ParseBeaconChainETHWithdrawalCompleted(log types.Log) (*IEigenPodManagerBeaconChainETHWithdrawalCompleted, error)
+	FilterBeaconChainSlashingFactorDecreased(opts *bind.FilterOpts) (*IEigenPodManagerBeaconChainSlashingFactorDecreasedIterator, error)
+	WatchBeaconChainSlashingFactorDecreased(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerBeaconChainSlashingFactorDecreased) (event.Subscription, error)
+	ParseBeaconChainSlashingFactorDecreased(log types.Log) (*IEigenPodManagerBeaconChainSlashingFactorDecreased, error)
+	FilterBurnableETHSharesIncreased(opts *bind.FilterOpts) (*IEigenPodManagerBurnableETHSharesIncreasedIterator, error)
+	WatchBurnableETHSharesIncreased(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerBurnableETHSharesIncreased) (event.Subscription, error)
+	ParseBurnableETHSharesIncreased(log types.Log) (*IEigenPodManagerBurnableETHSharesIncreased, error)
+	FilterNewTotalShares(opts *bind.FilterOpts, podOwner []common.Address) (*IEigenPodManagerNewTotalSharesIterator, error)
+	WatchNewTotalShares(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerNewTotalShares, podOwner []common.Address) (event.Subscription, error)
+	ParseNewTotalShares(log types.Log) (*IEigenPodManagerNewTotalShares, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*IEigenPodManagerPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*IEigenPodManagerPaused, error)
+	FilterPectraForkTimestampSet(opts *bind.FilterOpts) (*IEigenPodManagerPectraForkTimestampSetIterator, error)
+	WatchPectraForkTimestampSet(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerPectraForkTimestampSet) (event.Subscription, error)
+	ParsePectraForkTimestampSet(log types.Log) (*IEigenPodManagerPectraForkTimestampSet, error)
+	FilterPodDeployed(opts *bind.FilterOpts, eigenPod []common.Address, podOwner []common.Address) (*IEigenPodManagerPodDeployedIterator, error)
+	WatchPodDeployed(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerPodDeployed, eigenPod []common.Address, podOwner []common.Address) (event.Subscription, error)
+	ParsePodDeployed(log types.Log) (*IEigenPodManagerPodDeployed, error)
+	FilterPodSharesUpdated(opts *bind.FilterOpts, podOwner []common.Address) (*IEigenPodManagerPodSharesUpdatedIterator, error)
+	WatchPodSharesUpdated(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerPodSharesUpdated, podOwner []common.Address) (event.Subscription, error)
+	ParsePodSharesUpdated(log types.Log) (*IEigenPodManagerPodSharesUpdated, error)
+	FilterProofTimestampSetterSet(opts *bind.FilterOpts) (*IEigenPodManagerProofTimestampSetterSetIterator, error)
+	WatchProofTimestampSetterSet(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerProofTimestampSetterSet) (event.Subscription, error)
+	ParseProofTimestampSetterSet(log types.Log) (*IEigenPodManagerProofTimestampSetterSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*IEigenPodManagerUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *IEigenPodManagerUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*IEigenPodManagerUnpaused, error)
+}
+
+var (
+	_ IEigenPodManagerReader = (*IEigenPodManagerCaller)(nil)
+	_ IEigenPodManagerReader = (*IEigenPodManager)(nil)
+	_ IEigenPodManagerReader = (*FakeIEigenPodManager)(nil)
+	_ IEigenPodManagerWriter = (*IEigenPodManagerTransactor)(nil)
+	_ IEigenPodManagerWriter = (*IEigenPodManager)(nil)
+	_ IEigenPodManagerWriter = (*FakeIEigenPodManager)(nil)
+	_ IEigenPodManagerEvents = (*IEigenPodManagerFilterer)(nil)
+	_ IEigenPodManagerEvents = (*IEigenPodManager)(nil)
+	_ IEigenPodManagerEvents = (*FakeIEigenPodManager)(nil)
+)
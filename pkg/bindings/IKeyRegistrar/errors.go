@@ -0,0 +1,117 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IKeyRegistrar
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrConfigurationAlreadySet is the typed form of the IKeyRegistrar custom error ConfigurationAlreadySet().
+type ErrConfigurationAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrConfigurationAlreadySet) Error() string {
+	return "IKeyRegistrar: ConfigurationAlreadySet()"
+}
+
+// ErrInvalidCurveType is the typed form of the IKeyRegistrar custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "IKeyRegistrar: InvalidCurveType()"
+}
+
+// ErrInvalidKeyFormat is the typed form of the IKeyRegistrar custom error InvalidKeyFormat().
+type ErrInvalidKeyFormat struct{}
+
+// Error implements the error interface.
+func (ErrInvalidKeyFormat) Error() string {
+	return "IKeyRegistrar: InvalidKeyFormat()"
+}
+
+// ErrInvalidKeypair is the typed form of the IKeyRegistrar custom error InvalidKeypair().
+type ErrInvalidKeypair struct{}
+
+// Error implements the error interface.
+func (ErrInvalidKeypair) Error() string {
+	return "IKeyRegistrar: InvalidKeypair()"
+}
+
+// ErrKeyAlreadyRegistered is the typed form of the IKeyRegistrar custom error KeyAlreadyRegistered().
+type ErrKeyAlreadyRegistered struct{}
+
+// Error implements the error interface.
+func (ErrKeyAlreadyRegistered) Error() string {
+	return "IKeyRegistrar: KeyAlreadyRegistered()"
+}
+
+// ErrKeyNotFound is the typed form of the IKeyRegistrar custom error KeyNotFound((address,uint32),address).
+type ErrKeyNotFound struct {
+	OperatorSet OperatorSet
+	Operator    common.Address
+}
+
+// Error implements the error interface.
+func (e ErrKeyNotFound) Error() string {
+	return fmt.Sprintf("IKeyRegistrar: KeyNotFound(operatorSet: %v, operator: %v)", e.OperatorSet, e.Operator)
+}
+
+// ErrOperatorAlreadyRegistered is the typed form of the IKeyRegistrar custom error OperatorAlreadyRegistered().
+type ErrOperatorAlreadyRegistered struct{}
+
+// Error implements the error interface.
+func (ErrOperatorAlreadyRegistered) Error() string {
+	return "IKeyRegistrar: OperatorAlreadyRegistered()"
+}
+
+// ErrOperatorSetNotConfigured is the typed form of the IKeyRegistrar custom error OperatorSetNotConfigured().
+type ErrOperatorSetNotConfigured struct{}
+
+// Error implements the error interface.
+func (ErrOperatorSetNotConfigured) Error() string {
+	return "IKeyRegistrar: OperatorSetNotConfigured()"
+}
+
+// ErrOperatorStillSlashable is the typed form of the IKeyRegistrar custom error OperatorStillSlashable((address,uint32),address).
+type ErrOperatorStillSlashable struct {
+	OperatorSet OperatorSet
+	Operator    common.Address
+}
+
+// Error implements the error interface.
+func (e ErrOperatorStillSlashable) Error() string {
+	return fmt.Sprintf("IKeyRegistrar: OperatorStillSlashable(operatorSet: %v, operator: %v)", e.OperatorSet, e.Operator)
+}
+
+// ErrZeroAddress is the typed form of the IKeyRegistrar custom error ZeroAddress().
+type ErrZeroAddress struct{}
+
+// Error implements the error interface.
+func (ErrZeroAddress) Error() string {
+	return "IKeyRegistrar: ZeroAddress()"
+}
+
+// ErrZeroPubkey is the typed form of the IKeyRegistrar custom error ZeroPubkey().
+type ErrZeroPubkey struct{}
+
+// Error implements the error interface.
+func (ErrZeroPubkey) Error() string {
+	return "IKeyRegistrar: ZeroPubkey()"
+}
+
+// IKeyRegistrarErrors maps the name of every custom error in the IKeyRegistrar ABI to its typed Go error.
+var IKeyRegistrarErrors = map[string]error{
+	"ConfigurationAlreadySet":   ErrConfigurationAlreadySet{},
+	"InvalidCurveType":          ErrInvalidCurveType{},
+	"InvalidKeyFormat":          ErrInvalidKeyFormat{},
+	"InvalidKeypair":            ErrInvalidKeypair{},
+	"KeyAlreadyRegistered":      ErrKeyAlreadyRegistered{},
+	"KeyNotFound":               ErrKeyNotFound{},
+	"OperatorAlreadyRegistered": ErrOperatorAlreadyRegistered{},
+	"OperatorSetNotConfigured":  ErrOperatorSetNotConfigured{},
+	"OperatorStillSlashable":    ErrOperatorStillSlashable{},
+	"ZeroAddress":               ErrZeroAddress{},
+	"ZeroPubkey":                ErrZeroPubkey{},
+}
@@ -0,0 +1,239 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IKeyRegistrar
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeIKeyRegistrar is an in-memory IKeyRegistrar for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IKeyRegistrarFilterer.
+type FakeIKeyRegistrar struct {
+	*fake.Contract
+	*IKeyRegistrarFilterer
+}
+
+// NewFakeIKeyRegistrar returns an empty fake of the contract at address.
+func NewFakeIKeyRegistrar(address common.Address) *FakeIKeyRegistrar {
+	contract := fake.NewContract(IKeyRegistrarMetaData, address)
+	filterer, err := NewIKeyRegistrarFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIKeyRegistrar{Contract: contract, IKeyRegistrarFilterer: filterer}
+}
+
+// EncodeBN254KeyData returns the values stubbed with StubEncodeBN254KeyData.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) EncodeBN254KeyData(opts *bind.CallOpts, g1Point BN254G1Point, g2Point BN254G2Point) ([]byte, error) {
+	var out0 []byte
+	err := _FakeIKeyRegistrar.Contract.Read("encodeBN254KeyData", []interface{}{g1Point, g2Point}, &out0)
+	return out0, err
+}
+
+// StubEncodeBN254KeyData sets what EncodeBN254KeyData returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubEncodeBN254KeyData(g1Point BN254G1Point, g2Point BN254G2Point, out0 []byte) {
+	_FakeIKeyRegistrar.Contract.Stub("encodeBN254KeyData", []interface{}{g1Point, g2Point}, out0)
+}
+
+// GetBN254Key returns the values stubbed with StubGetBN254Key.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) GetBN254Key(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (struct {
+	G1Point BN254G1Point
+	G2Point BN254G2Point
+}, error) {
+	var out0 struct {
+		G1Point BN254G1Point
+		G2Point BN254G2Point
+	}
+	err := _FakeIKeyRegistrar.Contract.Read("getBN254Key", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetBN254Key sets what GetBN254Key returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubGetBN254Key(operatorSet OperatorSet, operator common.Address, out0 struct {
+	G1Point BN254G1Point
+	G2Point BN254G2Point
+}) {
+	_FakeIKeyRegistrar.Contract.Stub("getBN254Key", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetBN254KeyRegistrationMessageHash returns the values stubbed with StubGetBN254KeyRegistrationMessageHash.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) GetBN254KeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyData []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIKeyRegistrar.Contract.Read("getBN254KeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyData}, &out0)
+	return out0, err
+}
+
+// StubGetBN254KeyRegistrationMessageHash sets what GetBN254KeyRegistrationMessageHash returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubGetBN254KeyRegistrationMessageHash(operator common.Address, operatorSet OperatorSet, keyData []byte, out0 [32]byte) {
+	_FakeIKeyRegistrar.Contract.Stub("getBN254KeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyData}, out0)
+}
+
+// GetECDSAAddress returns the values stubbed with StubGetECDSAAddress.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) GetECDSAAddress(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIKeyRegistrar.Contract.Read("getECDSAAddress", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAAddress sets what GetECDSAAddress returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubGetECDSAAddress(operatorSet OperatorSet, operator common.Address, out0 common.Address) {
+	_FakeIKeyRegistrar.Contract.Stub("getECDSAAddress", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetECDSAKey returns the values stubbed with StubGetECDSAKey.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) GetECDSAKey(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([]byte, error) {
+	var out0 []byte
+	err := _FakeIKeyRegistrar.Contract.Read("getECDSAKey", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAKey sets what GetECDSAKey returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubGetECDSAKey(operatorSet OperatorSet, operator common.Address, out0 []byte) {
+	_FakeIKeyRegistrar.Contract.Stub("getECDSAKey", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetECDSAKeyRegistrationMessageHash returns the values stubbed with StubGetECDSAKeyRegistrationMessageHash.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) GetECDSAKeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyAddress common.Address) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIKeyRegistrar.Contract.Read("getECDSAKeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyAddress}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAKeyRegistrationMessageHash sets what GetECDSAKeyRegistrationMessageHash returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubGetECDSAKeyRegistrationMessageHash(operator common.Address, operatorSet OperatorSet, keyAddress common.Address, out0 [32]byte) {
+	_FakeIKeyRegistrar.Contract.Stub("getECDSAKeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyAddress}, out0)
+}
+
+// GetKeyHash returns the values stubbed with StubGetKeyHash.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) GetKeyHash(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIKeyRegistrar.Contract.Read("getKeyHash", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetKeyHash sets what GetKeyHash returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubGetKeyHash(operatorSet OperatorSet, operator common.Address, out0 [32]byte) {
+	_FakeIKeyRegistrar.Contract.Stub("getKeyHash", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetOperatorFromSigningKey returns the values stubbed with StubGetOperatorFromSigningKey.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) GetOperatorFromSigningKey(opts *bind.CallOpts, operatorSet OperatorSet, keyData []byte) (common.Address, bool, error) {
+	var out0 common.Address
+	var out1 bool
+	err := _FakeIKeyRegistrar.Contract.Read("getOperatorFromSigningKey", []interface{}{operatorSet, keyData}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetOperatorFromSigningKey sets what GetOperatorFromSigningKey returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubGetOperatorFromSigningKey(operatorSet OperatorSet, keyData []byte, out0 common.Address, out1 bool) {
+	_FakeIKeyRegistrar.Contract.Stub("getOperatorFromSigningKey", []interface{}{operatorSet, keyData}, out0, out1)
+}
+
+// GetOperatorSetCurveType returns the values stubbed with StubGetOperatorSetCurveType.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) GetOperatorSetCurveType(opts *bind.CallOpts, operatorSet OperatorSet) (uint8, error) {
+	var out0 uint8
+	err := _FakeIKeyRegistrar.Contract.Read("getOperatorSetCurveType", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetCurveType sets what GetOperatorSetCurveType returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubGetOperatorSetCurveType(operatorSet OperatorSet, out0 uint8) {
+	_FakeIKeyRegistrar.Contract.Stub("getOperatorSetCurveType", []interface{}{operatorSet}, out0)
+}
+
+// IsKeyGloballyRegistered returns the values stubbed with StubIsKeyGloballyRegistered.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) IsKeyGloballyRegistered(opts *bind.CallOpts, keyHash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeIKeyRegistrar.Contract.Read("isKeyGloballyRegistered", []interface{}{keyHash}, &out0)
+	return out0, err
+}
+
+// StubIsKeyGloballyRegistered sets what IsKeyGloballyRegistered returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubIsKeyGloballyRegistered(keyHash [32]byte, out0 bool) {
+	_FakeIKeyRegistrar.Contract.Stub("isKeyGloballyRegistered", []interface{}{keyHash}, out0)
+}
+
+// IsRegistered returns the values stubbed with StubIsRegistered.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) IsRegistered(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIKeyRegistrar.Contract.Read("isRegistered", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubIsRegistered sets what IsRegistered returns for the given arguments.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) StubIsRegistered(operatorSet OperatorSet, operator common.Address, out0 bool) {
+	_FakeIKeyRegistrar.Contract.Stub("isRegistered", []interface{}{operatorSet, operator}, out0)
+}
+
+// ConfigureOperatorSet records a configureOperatorSet call after running the hook set with OnConfigureOperatorSet.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) ConfigureOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) (*types.Transaction, error) {
+	return _FakeIKeyRegistrar.Contract.Transact(opts, "configureOperatorSet", func() error {
+		if hook, ok := _FakeIKeyRegistrar.Contract.Hook("configureOperatorSet").(func(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) error); ok {
+			return hook(opts, operatorSet, curveType)
+		}
+		return nil
+	}, operatorSet, curveType)
+}
+
+// OnConfigureOperatorSet sets a hook run by every ConfigureOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) OnConfigureOperatorSet(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) error) {
+	_FakeIKeyRegistrar.Contract.SetHook("configureOperatorSet", hook)
+}
+
+// DeregisterKey records a deregisterKey call after running the hook set with OnDeregisterKey.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) DeregisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) (*types.Transaction, error) {
+	return _FakeIKeyRegistrar.Contract.Transact(opts, "deregisterKey", func() error {
+		if hook, ok := _FakeIKeyRegistrar.Contract.Hook("deregisterKey").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) error); ok {
+			return hook(opts, operator, operatorSet)
+		}
+		return nil
+	}, operator, operatorSet)
+}
+
+// OnDeregisterKey sets a hook run by every DeregisterKey call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) OnDeregisterKey(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) error) {
+	_FakeIKeyRegistrar.Contract.SetHook("deregisterKey", hook)
+}
+
+// RegisterKey records a registerKey call after running the hook set with OnRegisterKey.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) RegisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, pubkey []byte, signature []byte) (*types.Transaction, error) {
+	return _FakeIKeyRegistrar.Contract.Transact(opts, "registerKey", func() error {
+		if hook, ok := _FakeIKeyRegistrar.Contract.Hook("registerKey").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, pubkey []byte, signature []byte) error); ok {
+			return hook(opts, operator, operatorSet, pubkey, signature)
+		}
+		return nil
+	}, operator, operatorSet, pubkey, signature)
+}
+
+// OnRegisterKey sets a hook run by every RegisterKey call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) OnRegisterKey(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, pubkey []byte, signature []byte) error) {
+	_FakeIKeyRegistrar.Contract.SetHook("registerKey", hook)
+}
+
+// EmitAggregateBN254KeyUpdated appends the event to the fake's logs.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) EmitAggregateBN254KeyUpdated(event *IKeyRegistrarAggregateBN254KeyUpdated) {
+	_FakeIKeyRegistrar.Contract.Emit("AggregateBN254KeyUpdated", event)
+}
+
+// EmitKeyDeregistered appends the event to the fake's logs.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) EmitKeyDeregistered(event *IKeyRegistrarKeyDeregistered) {
+	_FakeIKeyRegistrar.Contract.Emit("KeyDeregistered", event)
+}
+
+// EmitKeyRegistered appends the event to the fake's logs.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) EmitKeyRegistered(event *IKeyRegistrarKeyRegistered) {
+	_FakeIKeyRegistrar.Contract.Emit("KeyRegistered", event)
+}
+
+// EmitOperatorSetConfigured appends the event to the fake's logs.
+func (_FakeIKeyRegistrar *FakeIKeyRegistrar) EmitOperatorSetConfigured(event *IKeyRegistrarOperatorSetConfigured) {
+	_FakeIKeyRegistrar.Contract.Emit("OperatorSetConfigured", event)
+}
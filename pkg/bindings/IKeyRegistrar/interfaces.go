@@ -0,0 +1,66 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IKeyRegistrar
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IKeyRegistrarReader is the read-only surface of the IKeyRegistrar
+// binding, implemented by IKeyRegistrarCaller, IKeyRegistrar and FakeIKeyRegistrar.
+type IKeyRegistrarReader interface {
+	EncodeBN254KeyData(opts *bind.CallOpts, g1Point BN254G1Point, g2Point BN254G2Point) ([]byte, error)
+	GetBN254Key(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (struct {
+		G1Point BN254G1Point
+		G2Point BN254G2Point
+	}, error)
+	GetBN254KeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyData []byte) ([32]byte, error)
+	GetECDSAAddress(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (common.Address, error)
+	GetECDSAKey(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([]byte, error)
+	GetECDSAKeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyAddress common.Address) ([32]byte, error)
+	GetKeyHash(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([32]byte, error)
+	GetOperatorFromSigningKey(opts *bind.CallOpts, operatorSet OperatorSet, keyData []byte) (common.Address, bool, error)
+	GetOperatorSetCurveType(opts *bind.CallOpts, operatorSet OperatorSet) (uint8, error)
+	IsKeyGloballyRegistered(opts *bind.CallOpts, keyHash [32]byte) (bool, error)
+	IsRegistered(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (bool, error)
+}
+
+// IKeyRegistrarWriter is the transacting surface of the IKeyRegistrar
+// binding, implemented by IKeyRegistrarTransactor, IKeyRegistrar and FakeIKeyRegistrar.
+type IKeyRegistrarWriter interface {
+	ConfigureOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) (*types.Transaction, error)
+	DeregisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) (*types.Transaction, error)
+	RegisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, pubkey []byte, signature []byte) (*types.Transaction, error)
+}
+
+// IKeyRegistrarEvents is the event filtering surface of the IKeyRegistrar
+// binding, implemented by IKeyRegistrarFilterer, IKeyRegistrar and FakeIKeyRegistrar.
+type IKeyRegistrarEvents interface {
+	FilterAggregateBN254KeyUpdated(opts *bind.FilterOpts) (*IKeyRegistrarAggregateBN254KeyUpdatedIterator, error)
+	WatchAggregateBN254KeyUpdated(opts *bind.WatchOpts, sink chan<- *IKeyRegistrarAggregateBN254KeyUpdated) (event.Subscription, error)
+	ParseAggregateBN254KeyUpdated(log types.Log) (*IKeyRegistrarAggregateBN254KeyUpdated, error)
+	FilterKeyDeregistered(opts *bind.FilterOpts, operator []common.Address) (*IKeyRegistrarKeyDeregisteredIterator, error)
+	WatchKeyDeregistered(opts *bind.WatchOpts, sink chan<- *IKeyRegistrarKeyDeregistered, operator []common.Address) (event.Subscription, error)
+	ParseKeyDeregistered(log types.Log) (*IKeyRegistrarKeyDeregistered, error)
+	FilterKeyRegistered(opts *bind.FilterOpts, operator []common.Address) (*IKeyRegistrarKeyRegisteredIterator, error)
+	WatchKeyRegistered(opts *bind.WatchOpts, sink chan<- *IKeyRegistrarKeyRegistered, operator []common.Address) (event.Subscription, error)
+	ParseKeyRegistered(log types.Log) (*IKeyRegistrarKeyRegistered, error)
+	FilterOperatorSetConfigured(opts *bind.FilterOpts) (*IKeyRegistrarOperatorSetConfiguredIterator, error)
+	WatchOperatorSetConfigured(opts *bind.WatchOpts, sink chan<- *IKeyRegistrarOperatorSetConfigured) (event.Subscription, error)
+	ParseOperatorSetConfigured(log types.Log) (*IKeyRegistrarOperatorSetConfigured, error)
+}
+
+var (
+	_ IKeyRegistrarReader = (*IKeyRegistrarCaller)(nil)
+	_ IKeyRegistrarReader = (*IKeyRegistrar)(nil)
+	_ IKeyRegistrarReader = (*FakeIKeyRegistrar)(nil)
+	_ IKeyRegistrarWriter = (*IKeyRegistrarTransactor)(nil)
+	_ IKeyRegistrarWriter = (*IKeyRegistrar)(nil)
+	_ IKeyRegistrarWriter = (*FakeIKeyRegistrar)(nil)
+	_ IKeyRegistrarEvents = (*IKeyRegistrarFilterer)(nil)
+	_ IKeyRegistrarEvents = (*IKeyRegistrar)(nil)
+	_ IKeyRegistrarEvents = (*FakeIKeyRegistrar)(nil)
+)
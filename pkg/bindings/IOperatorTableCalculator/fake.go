@@ -0,0 +1,74 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IOperatorTableCalculator
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+// FakeIOperatorTableCalculator is an in-memory IOperatorTableCalculator for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IOperatorTableCalculatorFilterer.
+type FakeIOperatorTableCalculator struct {
+	*fake.Contract
+	*IOperatorTableCalculatorFilterer
+}
+
+// NewFakeIOperatorTableCalculator returns an empty fake of the contract at address.
+func NewFakeIOperatorTableCalculator(address common.Address) *FakeIOperatorTableCalculator {
+	contract := fake.NewContract(IOperatorTableCalculatorMetaData, address)
+	filterer, err := NewIOperatorTableCalculatorFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIOperatorTableCalculator{Contract: contract, IOperatorTableCalculatorFilterer: filterer}
+}
+
+// CalculateOperatorTableBytes returns the values stubbed with StubCalculateOperatorTableBytes.
+func (_FakeIOperatorTableCalculator *FakeIOperatorTableCalculator) CalculateOperatorTableBytes(opts *bind.CallOpts, operatorSet OperatorSet) ([]byte, error) {
+	var out0 []byte
+	err := _FakeIOperatorTableCalculator.Contract.Read("calculateOperatorTableBytes", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorTableBytes sets what CalculateOperatorTableBytes returns for the given arguments.
+func (_FakeIOperatorTableCalculator *FakeIOperatorTableCalculator) StubCalculateOperatorTableBytes(operatorSet OperatorSet, out0 []byte) {
+	_FakeIOperatorTableCalculator.Contract.Stub("calculateOperatorTableBytes", []interface{}{operatorSet}, out0)
+}
+
+// GetOperatorSetWeights returns the values stubbed with StubGetOperatorSetWeights.
+func (_FakeIOperatorTableCalculator *FakeIOperatorTableCalculator) GetOperatorSetWeights(opts *bind.CallOpts, operatorSet OperatorSet) (struct {
+	Operators []common.Address
+	Weights   [][]*big.Int
+}, error) {
+	var out0 struct {
+		Operators []common.Address
+		Weights   [][]*big.Int
+	}
+	err := _FakeIOperatorTableCalculator.Contract.Read("getOperatorSetWeights", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetWeights sets what GetOperatorSetWeights returns for the given arguments.
+func (_FakeIOperatorTableCalculator *FakeIOperatorTableCalculator) StubGetOperatorSetWeights(operatorSet OperatorSet, out0 struct {
+	Operators []common.Address
+	Weights   [][]*big.Int
+}) {
+	_FakeIOperatorTableCalculator.Contract.Stub("getOperatorSetWeights", []interface{}{operatorSet}, out0)
+}
+
+// GetOperatorWeights returns the values stubbed with StubGetOperatorWeights.
+func (_FakeIOperatorTableCalculator *FakeIOperatorTableCalculator) GetOperatorWeights(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeIOperatorTableCalculator.Contract.Read("getOperatorWeights", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorWeights sets what GetOperatorWeights returns for the given arguments.
+func (_FakeIOperatorTableCalculator *FakeIOperatorTableCalculator) StubGetOperatorWeights(operatorSet OperatorSet, operator common.Address, out0 []*big.Int) {
+	_FakeIOperatorTableCalculator.Contract.Stub("getOperatorWeights", []interface{}{operatorSet, operator}, out0)
+}
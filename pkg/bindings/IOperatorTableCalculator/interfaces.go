@@ -0,0 +1,26 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IOperatorTableCalculator
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"math/big"
+)
+
+// IOperatorTableCalculatorReader is the read-only surface of the IOperatorTableCalculator
+// binding, implemented by IOperatorTableCalculatorCaller, IOperatorTableCalculator and FakeIOperatorTableCalculator.
+type IOperatorTableCalculatorReader interface {
+	CalculateOperatorTableBytes(opts *bind.CallOpts, operatorSet OperatorSet) ([]byte, error)
+	GetOperatorSetWeights(opts *bind.CallOpts, operatorSet OperatorSet) (struct {
+		Operators []common.Address
+		Weights   [][]*big.Int
+	}, error)
+	GetOperatorWeights(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([]*big.Int, error)
+}
+
+var (
+	_ IOperatorTableCalculatorReader = (*IOperatorTableCalculatorCaller)(nil)
+	_ IOperatorTableCalculatorReader = (*IOperatorTableCalculator)(nil)
+	_ IOperatorTableCalculatorReader = (*FakeIOperatorTableCalculator)(nil)
+)
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,59 +30,29 @@ var (
 	_ = abi.ConvertType
 )
 
-// BN254G1Point is an auto generated low-level Go binding around an user-defined struct.
-type BN254G1Point struct {
-	X *big.Int
-	Y *big.Int
-}
+// BN254G1Point is an alias of the canonical eltypes.BN254G1Point shared by all bindings.
+type BN254G1Point = eltypes.BN254G1Point
 
-// BN254G2Point is an auto generated low-level Go binding around an user-defined struct.
-type BN254G2Point struct {
-	X [2]*big.Int
-	Y [2]*big.Int
-}
+// BN254G2Point is an alias of the canonical eltypes.BN254G2Point shared by all bindings.
+type BN254G2Point = eltypes.BN254G2Point
 
-// IBN254CertificateVerifierTypesBN254Certificate is an auto generated low-level Go binding around an user-defined struct.
-type IBN254CertificateVerifierTypesBN254Certificate struct {
-	ReferenceTimestamp uint32
-	MessageHash        [32]byte
-	Signature          BN254G1Point
-	Apk                BN254G2Point
-	NonSignerWitnesses []IBN254CertificateVerifierTypesBN254OperatorInfoWitness
-}
+// IBN254CertificateVerifierTypesBN254Certificate is an alias of the canonical eltypes.IBN254CertificateVerifierTypesBN254Certificate shared by all bindings.
+type IBN254CertificateVerifierTypesBN254Certificate = eltypes.IBN254CertificateVerifierTypesBN254Certificate
 
-// IBN254CertificateVerifierTypesBN254OperatorInfoWitness is an auto generated low-level Go binding around an user-defined struct.
-type IBN254CertificateVerifierTypesBN254OperatorInfoWitness struct {
-	OperatorIndex     uint32
-	OperatorInfoProof []byte
-	OperatorInfo      IOperatorTableCalculatorTypesBN254OperatorInfo
-}
+// IBN254CertificateVerifierTypesBN254OperatorInfoWitness is an alias of the canonical eltypes.IBN254CertificateVerifierTypesBN254OperatorInfoWitness shared by all bindings.
+type IBN254CertificateVerifierTypesBN254OperatorInfoWitness = eltypes.IBN254CertificateVerifierTypesBN254OperatorInfoWitness
 
-// ICrossChainRegistryTypesOperatorSetConfig is an auto generated low-level Go binding around an user-defined struct.
-type ICrossChainRegistryTypesOperatorSetConfig struct {
-	Owner              common.Address
-	MaxStalenessPeriod uint32
-}
+// ICrossChainRegistryTypesOperatorSetConfig is an alias of the canonical eltypes.ICrossChainRegistryTypesOperatorSetConfig shared by all bindings.
+type ICrossChainRegistryTypesOperatorSetConfig = eltypes.ICrossChainRegistryTypesOperatorSetConfig
 
-// IOperatorTableCalculatorTypesBN254OperatorInfo is an auto generated low-level Go binding around an user-defined struct.
-type IOperatorTableCalculatorTypesBN254OperatorInfo struct {
-	Pubkey  BN254G1Point
-	Weights []*big.Int
-}
+// IOperatorTableCalculatorTypesBN254OperatorInfo is an alias of the canonical eltypes.IOperatorTableCalculatorTypesBN254OperatorInfo shared by all bindings.
+type IOperatorTableCalculatorTypesBN254OperatorInfo = eltypes.IOperatorTableCalculatorTypesBN254OperatorInfo
 
-// IOperatorTableCalculatorTypesBN254OperatorSetInfo is an auto generated low-level Go binding around an user-defined struct.
-type IOperatorTableCalculatorTypesBN254OperatorSetInfo struct {
-	OperatorInfoTreeRoot [32]byte
-	NumOperators         *big.Int
-	AggregatePubkey      BN254G1Point
-	TotalWeights         []*big.Int
-}
+// IOperatorTableCalculatorTypesBN254OperatorSetInfo is an alias of the canonical eltypes.IOperatorTableCalculatorTypesBN254OperatorSetInfo shared by all bindings.
+type IOperatorTableCalculatorTypesBN254OperatorSetInfo = eltypes.IOperatorTableCalculatorTypesBN254OperatorSetInfo
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // IOperatorTableUpdaterMetaData contains all meta data concerning the IOperatorTableUpdater contract.
 var IOperatorTableUpdaterMetaData = &bind.MetaData{
@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IOperatorTableUpdater
+
+// ErrCannotDisableGeneratorRoot is the typed form of the IOperatorTableUpdater custom error CannotDisableGeneratorRoot().
+type ErrCannotDisableGeneratorRoot struct{}
+
+// Error implements the error interface.
+func (ErrCannotDisableGeneratorRoot) Error() string {
+	return "IOperatorTableUpdater: CannotDisableGeneratorRoot()"
+}
+
+// ErrCertificateInvalid is the typed form of the IOperatorTableUpdater custom error CertificateInvalid().
+type ErrCertificateInvalid struct{}
+
+// Error implements the error interface.
+func (ErrCertificateInvalid) Error() string {
+	return "IOperatorTableUpdater: CertificateInvalid()"
+}
+
+// ErrGlobalTableRootInFuture is the typed form of the IOperatorTableUpdater custom error GlobalTableRootInFuture().
+type ErrGlobalTableRootInFuture struct{}
+
+// Error implements the error interface.
+func (ErrGlobalTableRootInFuture) Error() string {
+	return "IOperatorTableUpdater: GlobalTableRootInFuture()"
+}
+
+// ErrGlobalTableRootStale is the typed form of the IOperatorTableUpdater custom error GlobalTableRootStale().
+type ErrGlobalTableRootStale struct{}
+
+// Error implements the error interface.
+func (ErrGlobalTableRootStale) Error() string {
+	return "IOperatorTableUpdater: GlobalTableRootStale()"
+}
+
+// ErrInvalidConfirmationThreshold is the typed form of the IOperatorTableUpdater custom error InvalidConfirmationThreshold().
+type ErrInvalidConfirmationThreshold struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConfirmationThreshold) Error() string {
+	return "IOperatorTableUpdater: InvalidConfirmationThreshold()"
+}
+
+// ErrInvalidCurveType is the typed form of the IOperatorTableUpdater custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "IOperatorTableUpdater: InvalidCurveType()"
+}
+
+// ErrInvalidGenerator is the typed form of the IOperatorTableUpdater custom error InvalidGenerator().
+type ErrInvalidGenerator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGenerator) Error() string {
+	return "IOperatorTableUpdater: InvalidGenerator()"
+}
+
+// ErrInvalidGlobalTableRoot is the typed form of the IOperatorTableUpdater custom error InvalidGlobalTableRoot().
+type ErrInvalidGlobalTableRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGlobalTableRoot) Error() string {
+	return "IOperatorTableUpdater: InvalidGlobalTableRoot()"
+}
+
+// ErrInvalidMessageHash is the typed form of the IOperatorTableUpdater custom error InvalidMessageHash().
+type ErrInvalidMessageHash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMessageHash) Error() string {
+	return "IOperatorTableUpdater: InvalidMessageHash()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the IOperatorTableUpdater custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "IOperatorTableUpdater: InvalidOperatorSet()"
+}
+
+// ErrInvalidOperatorSetProof is the typed form of the IOperatorTableUpdater custom error InvalidOperatorSetProof().
+type ErrInvalidOperatorSetProof struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSetProof) Error() string {
+	return "IOperatorTableUpdater: InvalidOperatorSetProof()"
+}
+
+// ErrInvalidRoot is the typed form of the IOperatorTableUpdater custom error InvalidRoot().
+type ErrInvalidRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRoot) Error() string {
+	return "IOperatorTableUpdater: InvalidRoot()"
+}
+
+// ErrTableUpdateForPastTimestamp is the typed form of the IOperatorTableUpdater custom error TableUpdateForPastTimestamp().
+type ErrTableUpdateForPastTimestamp struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateForPastTimestamp) Error() string {
+	return "IOperatorTableUpdater: TableUpdateForPastTimestamp()"
+}
+
+// IOperatorTableUpdaterErrors maps the name of every custom error in the IOperatorTableUpdater ABI to its typed Go error.
+var IOperatorTableUpdaterErrors = map[string]error{
+	"CannotDisableGeneratorRoot":   ErrCannotDisableGeneratorRoot{},
+	"CertificateInvalid":           ErrCertificateInvalid{},
+	"GlobalTableRootInFuture":      ErrGlobalTableRootInFuture{},
+	"GlobalTableRootStale":         ErrGlobalTableRootStale{},
+	"InvalidConfirmationThreshold": ErrInvalidConfirmationThreshold{},
+	"InvalidCurveType":             ErrInvalidCurveType{},
+	"InvalidGenerator":             ErrInvalidGenerator{},
+	"InvalidGlobalTableRoot":       ErrInvalidGlobalTableRoot{},
+	"InvalidMessageHash":           ErrInvalidMessageHash{},
+	"InvalidOperatorSet":           ErrInvalidOperatorSet{},
+	"InvalidOperatorSetProof":      ErrInvalidOperatorSetProof{},
+	"InvalidRoot":                  ErrInvalidRoot{},
+	"TableUpdateForPastTimestamp":  ErrTableUpdateForPastTimestamp{},
+}
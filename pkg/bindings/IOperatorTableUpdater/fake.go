@@ -0,0 +1,297 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IOperatorTableUpdater
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeIOperatorTableUpdater is an in-memory IOperatorTableUpdater for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IOperatorTableUpdaterFilterer.
+type FakeIOperatorTableUpdater struct {
+	*fake.Contract
+	*IOperatorTableUpdaterFilterer
+}
+
+// NewFakeIOperatorTableUpdater returns an empty fake of the contract at address.
+func NewFakeIOperatorTableUpdater(address common.Address) *FakeIOperatorTableUpdater {
+	contract := fake.NewContract(IOperatorTableUpdaterMetaData, address)
+	filterer, err := NewIOperatorTableUpdaterFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIOperatorTableUpdater{Contract: contract, IOperatorTableUpdaterFilterer: filterer}
+}
+
+// GetCertificateVerifier returns the values stubbed with StubGetCertificateVerifier.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetCertificateVerifier(opts *bind.CallOpts, curveType uint8) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIOperatorTableUpdater.Contract.Read("getCertificateVerifier", []interface{}{curveType}, &out0)
+	return out0, err
+}
+
+// StubGetCertificateVerifier sets what GetCertificateVerifier returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetCertificateVerifier(curveType uint8, out0 common.Address) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getCertificateVerifier", []interface{}{curveType}, out0)
+}
+
+// GetCurrentGlobalTableRoot returns the values stubbed with StubGetCurrentGlobalTableRoot.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetCurrentGlobalTableRoot(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIOperatorTableUpdater.Contract.Read("getCurrentGlobalTableRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentGlobalTableRoot sets what GetCurrentGlobalTableRoot returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetCurrentGlobalTableRoot(out0 [32]byte) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getCurrentGlobalTableRoot", []interface{}{}, out0)
+}
+
+// GetGenerator returns the values stubbed with StubGetGenerator.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetGenerator(opts *bind.CallOpts) (OperatorSet, error) {
+	var out0 OperatorSet
+	err := _FakeIOperatorTableUpdater.Contract.Read("getGenerator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGenerator sets what GetGenerator returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetGenerator(out0 OperatorSet) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getGenerator", []interface{}{}, out0)
+}
+
+// GetGeneratorConfig returns the values stubbed with StubGetGeneratorConfig.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetGeneratorConfig(opts *bind.CallOpts) (ICrossChainRegistryTypesOperatorSetConfig, error) {
+	var out0 ICrossChainRegistryTypesOperatorSetConfig
+	err := _FakeIOperatorTableUpdater.Contract.Read("getGeneratorConfig", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGeneratorConfig sets what GetGeneratorConfig returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetGeneratorConfig(out0 ICrossChainRegistryTypesOperatorSetConfig) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getGeneratorConfig", []interface{}{}, out0)
+}
+
+// GetGeneratorReferenceTimestamp returns the values stubbed with StubGetGeneratorReferenceTimestamp.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetGeneratorReferenceTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIOperatorTableUpdater.Contract.Read("getGeneratorReferenceTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGeneratorReferenceTimestamp sets what GetGeneratorReferenceTimestamp returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetGeneratorReferenceTimestamp(out0 uint32) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getGeneratorReferenceTimestamp", []interface{}{}, out0)
+}
+
+// GetGlobalTableRootByTimestamp returns the values stubbed with StubGetGlobalTableRootByTimestamp.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetGlobalTableRootByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIOperatorTableUpdater.Contract.Read("getGlobalTableRootByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableRootByTimestamp sets what GetGlobalTableRootByTimestamp returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetGlobalTableRootByTimestamp(referenceTimestamp uint32, out0 [32]byte) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getGlobalTableRootByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// GetGlobalTableUpdateMessageHash returns the values stubbed with StubGetGlobalTableUpdateMessageHash.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetGlobalTableUpdateMessageHash(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIOperatorTableUpdater.Contract.Read("getGlobalTableUpdateMessageHash", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableUpdateMessageHash sets what GetGlobalTableUpdateMessageHash returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetGlobalTableUpdateMessageHash(globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32, out0 [32]byte) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getGlobalTableUpdateMessageHash", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, out0)
+}
+
+// GetGlobalTableUpdateSignableDigest returns the values stubbed with StubGetGlobalTableUpdateSignableDigest.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetGlobalTableUpdateSignableDigest(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIOperatorTableUpdater.Contract.Read("getGlobalTableUpdateSignableDigest", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableUpdateSignableDigest sets what GetGlobalTableUpdateSignableDigest returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetGlobalTableUpdateSignableDigest(globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32, out0 [32]byte) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getGlobalTableUpdateSignableDigest", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, out0)
+}
+
+// GetLatestReferenceBlockNumber returns the values stubbed with StubGetLatestReferenceBlockNumber.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetLatestReferenceBlockNumber(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIOperatorTableUpdater.Contract.Read("getLatestReferenceBlockNumber", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetLatestReferenceBlockNumber sets what GetLatestReferenceBlockNumber returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetLatestReferenceBlockNumber(out0 uint32) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getLatestReferenceBlockNumber", []interface{}{}, out0)
+}
+
+// GetLatestReferenceTimestamp returns the values stubbed with StubGetLatestReferenceTimestamp.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetLatestReferenceTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIOperatorTableUpdater.Contract.Read("getLatestReferenceTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetLatestReferenceTimestamp sets what GetLatestReferenceTimestamp returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetLatestReferenceTimestamp(out0 uint32) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getLatestReferenceTimestamp", []interface{}{}, out0)
+}
+
+// GetReferenceBlockNumberByTimestamp returns the values stubbed with StubGetReferenceBlockNumberByTimestamp.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetReferenceBlockNumberByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (uint32, error) {
+	var out0 uint32
+	err := _FakeIOperatorTableUpdater.Contract.Read("getReferenceBlockNumberByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetReferenceBlockNumberByTimestamp sets what GetReferenceBlockNumberByTimestamp returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetReferenceBlockNumberByTimestamp(referenceTimestamp uint32, out0 uint32) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getReferenceBlockNumberByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// GetReferenceTimestampByBlockNumber returns the values stubbed with StubGetReferenceTimestampByBlockNumber.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) GetReferenceTimestampByBlockNumber(opts *bind.CallOpts, referenceBlockNumber uint32) (uint32, error) {
+	var out0 uint32
+	err := _FakeIOperatorTableUpdater.Contract.Read("getReferenceTimestampByBlockNumber", []interface{}{referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetReferenceTimestampByBlockNumber sets what GetReferenceTimestampByBlockNumber returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubGetReferenceTimestampByBlockNumber(referenceBlockNumber uint32, out0 uint32) {
+	_FakeIOperatorTableUpdater.Contract.Stub("getReferenceTimestampByBlockNumber", []interface{}{referenceBlockNumber}, out0)
+}
+
+// IsRootValid returns the values stubbed with StubIsRootValid.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) IsRootValid(opts *bind.CallOpts, globalTableRoot [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeIOperatorTableUpdater.Contract.Read("isRootValid", []interface{}{globalTableRoot}, &out0)
+	return out0, err
+}
+
+// StubIsRootValid sets what IsRootValid returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubIsRootValid(globalTableRoot [32]byte, out0 bool) {
+	_FakeIOperatorTableUpdater.Contract.Stub("isRootValid", []interface{}{globalTableRoot}, out0)
+}
+
+// IsRootValidByTimestamp returns the values stubbed with StubIsRootValidByTimestamp.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) IsRootValidByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeIOperatorTableUpdater.Contract.Read("isRootValidByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsRootValidByTimestamp sets what IsRootValidByTimestamp returns for the given arguments.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) StubIsRootValidByTimestamp(referenceTimestamp uint32, out0 bool) {
+	_FakeIOperatorTableUpdater.Contract.Stub("isRootValidByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// ConfirmGlobalTableRoot records a confirmGlobalTableRoot call after running the hook set with OnConfirmGlobalTableRoot.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) ConfirmGlobalTableRoot(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) (*types.Transaction, error) {
+	return _FakeIOperatorTableUpdater.Contract.Transact(opts, "confirmGlobalTableRoot", func() error {
+		if hook, ok := _FakeIOperatorTableUpdater.Contract.Hook("confirmGlobalTableRoot").(func(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) error); ok {
+			return hook(opts, globalTableRootCert, globalTableRoot, referenceTimestamp, referenceBlockNumber)
+		}
+		return nil
+	}, globalTableRootCert, globalTableRoot, referenceTimestamp, referenceBlockNumber)
+}
+
+// OnConfirmGlobalTableRoot sets a hook run by every ConfirmGlobalTableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) OnConfirmGlobalTableRoot(hook func(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) error) {
+	_FakeIOperatorTableUpdater.Contract.SetHook("confirmGlobalTableRoot", hook)
+}
+
+// DisableRoot records a disableRoot call after running the hook set with OnDisableRoot.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) DisableRoot(opts *bind.TransactOpts, globalTableRoot [32]byte) (*types.Transaction, error) {
+	return _FakeIOperatorTableUpdater.Contract.Transact(opts, "disableRoot", func() error {
+		if hook, ok := _FakeIOperatorTableUpdater.Contract.Hook("disableRoot").(func(opts *bind.TransactOpts, globalTableRoot [32]byte) error); ok {
+			return hook(opts, globalTableRoot)
+		}
+		return nil
+	}, globalTableRoot)
+}
+
+// OnDisableRoot sets a hook run by every DisableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) OnDisableRoot(hook func(opts *bind.TransactOpts, globalTableRoot [32]byte) error) {
+	_FakeIOperatorTableUpdater.Contract.SetHook("disableRoot", hook)
+}
+
+// SetGlobalRootConfirmationThreshold records a setGlobalRootConfirmationThreshold call after running the hook set with OnSetGlobalRootConfirmationThreshold.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) SetGlobalRootConfirmationThreshold(opts *bind.TransactOpts, bps uint16) (*types.Transaction, error) {
+	return _FakeIOperatorTableUpdater.Contract.Transact(opts, "setGlobalRootConfirmationThreshold", func() error {
+		if hook, ok := _FakeIOperatorTableUpdater.Contract.Hook("setGlobalRootConfirmationThreshold").(func(opts *bind.TransactOpts, bps uint16) error); ok {
+			return hook(opts, bps)
+		}
+		return nil
+	}, bps)
+}
+
+// OnSetGlobalRootConfirmationThreshold sets a hook run by every SetGlobalRootConfirmationThreshold call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) OnSetGlobalRootConfirmationThreshold(hook func(opts *bind.TransactOpts, bps uint16) error) {
+	_FakeIOperatorTableUpdater.Contract.SetHook("setGlobalRootConfirmationThreshold", hook)
+}
+
+// UpdateGenerator records a updateGenerator call after running the hook set with OnUpdateGenerator.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) UpdateGenerator(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) (*types.Transaction, error) {
+	return _FakeIOperatorTableUpdater.Contract.Transact(opts, "updateGenerator", func() error {
+		if hook, ok := _FakeIOperatorTableUpdater.Contract.Hook("updateGenerator").(func(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) error); ok {
+			return hook(opts, generator, generatorInfo)
+		}
+		return nil
+	}, generator, generatorInfo)
+}
+
+// OnUpdateGenerator sets a hook run by every UpdateGenerator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) OnUpdateGenerator(hook func(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) error) {
+	_FakeIOperatorTableUpdater.Contract.SetHook("updateGenerator", hook)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) UpdateOperatorTable(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) (*types.Transaction, error) {
+	return _FakeIOperatorTableUpdater.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeIOperatorTableUpdater.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) error); ok {
+			return hook(opts, referenceTimestamp, globalTableRoot, operatorSetIndex, proof, operatorTableBytes)
+		}
+		return nil
+	}, referenceTimestamp, globalTableRoot, operatorSetIndex, proof, operatorTableBytes)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) error) {
+	_FakeIOperatorTableUpdater.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// EmitGeneratorUpdated appends the event to the fake's logs.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) EmitGeneratorUpdated(event *IOperatorTableUpdaterGeneratorUpdated) {
+	_FakeIOperatorTableUpdater.Contract.Emit("GeneratorUpdated", event)
+}
+
+// EmitGlobalRootConfirmationThresholdUpdated appends the event to the fake's logs.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) EmitGlobalRootConfirmationThresholdUpdated(event *IOperatorTableUpdaterGlobalRootConfirmationThresholdUpdated) {
+	_FakeIOperatorTableUpdater.Contract.Emit("GlobalRootConfirmationThresholdUpdated", event)
+}
+
+// EmitGlobalRootDisabled appends the event to the fake's logs.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) EmitGlobalRootDisabled(event *IOperatorTableUpdaterGlobalRootDisabled) {
+	_FakeIOperatorTableUpdater.Contract.Emit("GlobalRootDisabled", event)
+}
+
+// EmitNewGlobalTableRoot appends the event to the fake's logs.
+func (_FakeIOperatorTableUpdater *FakeIOperatorTableUpdater) EmitNewGlobalTableRoot(event *IOperatorTableUpdaterNewGlobalTableRoot) {
+	_FakeIOperatorTableUpdater.Contract.Emit("NewGlobalTableRoot", event)
+}
@@ -0,0 +1,68 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IOperatorTableUpdater
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IOperatorTableUpdaterReader is the read-only surface of the IOperatorTableUpdater
+// binding, implemented by IOperatorTableUpdaterCaller, IOperatorTableUpdater and FakeIOperatorTableUpdater.
+type IOperatorTableUpdaterReader interface {
+	GetCertificateVerifier(opts *bind.CallOpts, curveType uint8) (common.Address, error)
+	GetCurrentGlobalTableRoot(opts *bind.CallOpts) ([32]byte, error)
+	GetGenerator(opts *bind.CallOpts) (OperatorSet, error)
+	GetGeneratorConfig(opts *bind.CallOpts) (ICrossChainRegistryTypesOperatorSetConfig, error)
+	GetGeneratorReferenceTimestamp(opts *bind.CallOpts) (uint32, error)
+	GetGlobalTableRootByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) ([32]byte, error)
+	GetGlobalTableUpdateMessageHash(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error)
+	GetGlobalTableUpdateSignableDigest(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error)
+	GetLatestReferenceBlockNumber(opts *bind.CallOpts) (uint32, error)
+	GetLatestReferenceTimestamp(opts *bind.CallOpts) (uint32, error)
+	GetReferenceBlockNumberByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (uint32, error)
+	GetReferenceTimestampByBlockNumber(opts *bind.CallOpts, referenceBlockNumber uint32) (uint32, error)
+	IsRootValid(opts *bind.CallOpts, globalTableRoot [32]byte) (bool, error)
+	IsRootValidByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (bool, error)
+}
+
+// IOperatorTableUpdaterWriter is the transacting surface of the IOperatorTableUpdater
+// binding, implemented by IOperatorTableUpdaterTransactor, IOperatorTableUpdater and FakeIOperatorTableUpdater.
+type IOperatorTableUpdaterWriter interface {
+	ConfirmGlobalTableRoot(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) (*types.Transaction, error)
+	DisableRoot(opts *bind.TransactOpts, globalTableRoot [32]byte) (*types.Transaction, error)
+	SetGlobalRootConfirmationThreshold(opts *bind.TransactOpts, bps uint16) (*types.Transaction, error)
+	UpdateGenerator(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) (*types.Transaction, error)
+	UpdateOperatorTable(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) (*types.Transaction, error)
+}
+
+// IOperatorTableUpdaterEvents is the event filtering surface of the IOperatorTableUpdater
+// binding, implemented by IOperatorTableUpdaterFilterer, IOperatorTableUpdater and FakeIOperatorTableUpdater.
+type IOperatorTableUpdaterEvents interface {
+	FilterGeneratorUpdated(opts *bind.FilterOpts) (*IOperatorTableUpdaterGeneratorUpdatedIterator, error)
+	WatchGeneratorUpdated(opts *bind.WatchOpts, sink chan<- *IOperatorTableUpdaterGeneratorUpdated) (event.Subscription, error)
+	ParseGeneratorUpdated(log types.Log) (*IOperatorTableUpdaterGeneratorUpdated, error)
+	FilterGlobalRootConfirmationThresholdUpdated(opts *bind.FilterOpts) (*IOperatorTableUpdaterGlobalRootConfirmationThresholdUpdatedIterator, error)
+	WatchGlobalRootConfirmationThresholdUpdated(opts *bind.WatchOpts, sink chan<- *IOperatorTableUpdaterGlobalRootConfirmationThresholdUpdated) (event.Subscription, error)
+	ParseGlobalRootConfirmationThresholdUpdated(log types.Log) (*IOperatorTableUpdaterGlobalRootConfirmationThresholdUpdated, error)
+	FilterGlobalRootDisabled(opts *bind.FilterOpts, globalTableRoot [][32]byte) (*IOperatorTableUpdaterGlobalRootDisabledIterator, error)
+	WatchGlobalRootDisabled(opts *bind.WatchOpts, sink chan<- *IOperatorTableUpdaterGlobalRootDisabled, globalTableRoot [][32]byte) (event.Subscription, error)
+	ParseGlobalRootDisabled(log types.Log) (*IOperatorTableUpdaterGlobalRootDisabled, error)
+	FilterNewGlobalTableRoot(opts *bind.FilterOpts, referenceTimestamp []uint32, globalTableRoot [][32]byte) (*IOperatorTableUpdaterNewGlobalTableRootIterator, error)
+	WatchNewGlobalTableRoot(opts *bind.WatchOpts, sink chan<- *IOperatorTableUpdaterNewGlobalTableRoot, referenceTimestamp []uint32, globalTableRoot [][32]byte) (event.Subscription, error)
+	ParseNewGlobalTableRoot(log types.Log) (*IOperatorTableUpdaterNewGlobalTableRoot, error)
+}
+
+var (
+	_ IOperatorTableUpdaterReader = (*IOperatorTableUpdaterCaller)(nil)
+	_ IOperatorTableUpdaterReader = (*IOperatorTableUpdater)(nil)
+	_ IOperatorTableUpdaterReader = (*FakeIOperatorTableUpdater)(nil)
+	_ IOperatorTableUpdaterWriter = (*IOperatorTableUpdaterTransactor)(nil)
+	_ IOperatorTableUpdaterWriter = (*IOperatorTableUpdater)(nil)
+	_ IOperatorTableUpdaterWriter = (*FakeIOperatorTableUpdater)(nil)
+	_ IOperatorTableUpdaterEvents = (*IOperatorTableUpdaterFilterer)(nil)
+	_ IOperatorTableUpdaterEvents = (*IOperatorTableUpdater)(nil)
+	_ IOperatorTableUpdaterEvents = (*FakeIOperatorTableUpdater)(nil)
+)
@@ -0,0 +1,52 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPausable
+
+// ErrCurrentlyPaused is the typed form of the IPausable custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "IPausable: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the IPausable custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "IPausable: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the IPausable custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "IPausable: InvalidNewPausedStatus()"
+}
+
+// ErrOnlyPauser is the typed form of the IPausable custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "IPausable: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the IPausable custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "IPausable: OnlyUnpauser()"
+}
+
+// IPausableErrors maps the name of every custom error in the IPausable ABI to its typed Go error.
+var IPausableErrors = map[string]error{
+	"CurrentlyPaused":        ErrCurrentlyPaused{},
+	"InputAddressZero":       ErrInputAddressZero{},
+	"InvalidNewPausedStatus": ErrInvalidNewPausedStatus{},
+	"OnlyPauser":             ErrOnlyPauser{},
+	"OnlyUnpauser":           ErrOnlyUnpauser{},
+}
@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPausable
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIPausable is an in-memory IPausable for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IPausableFilterer.
+type FakeIPausable struct {
+	*fake.Contract
+	*IPausableFilterer
+}
+
+// NewFakeIPausable returns an empty fake of the contract at address.
+func NewFakeIPausable(address common.Address) *FakeIPausable {
+	contract := fake.NewContract(IPausableMetaData, address)
+	filterer, err := NewIPausableFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIPausable{Contract: contract, IPausableFilterer: filterer}
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeIPausable *FakeIPausable) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeIPausable.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeIPausable *FakeIPausable) StubPaused(index uint8, out0 bool) {
+	_FakeIPausable.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeIPausable *FakeIPausable) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIPausable.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeIPausable *FakeIPausable) StubPaused0(out0 *big.Int) {
+	_FakeIPausable.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeIPausable *FakeIPausable) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIPausable.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeIPausable *FakeIPausable) StubPauserRegistry(out0 common.Address) {
+	_FakeIPausable.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeIPausable *FakeIPausable) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIPausable.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeIPausable.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPausable *FakeIPausable) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeIPausable.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeIPausable *FakeIPausable) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeIPausable.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeIPausable.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPausable *FakeIPausable) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeIPausable.Contract.SetHook("pauseAll", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeIPausable *FakeIPausable) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIPausable.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeIPausable.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPausable *FakeIPausable) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeIPausable.Contract.SetHook("unpause", hook)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeIPausable *FakeIPausable) EmitPaused(event *IPausablePaused) {
+	_FakeIPausable.Contract.Emit("Paused", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeIPausable *FakeIPausable) EmitUnpaused(event *IPausableUnpaused) {
+	_FakeIPausable.Contract.Emit("Unpaused", event)
+}
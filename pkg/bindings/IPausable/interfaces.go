@@ -0,0 +1,50 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPausable
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IPausableReader is the read-only surface of the IPausable
+// binding, implemented by IPausableCaller, IPausable and FakeIPausable.
+type IPausableReader interface {
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IPausableWriter is the transacting surface of the IPausable
+// binding, implemented by IPausableTransactor, IPausable and FakeIPausable.
+type IPausableWriter interface {
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+}
+
+// IPausableEvents is the event filtering surface of the IPausable
+// binding, implemented by IPausableFilterer, IPausable and FakeIPausable.
+type IPausableEvents interface {
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*IPausablePausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *IPausablePaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*IPausablePaused, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*IPausableUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *IPausableUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*IPausableUnpaused, error)
+}
+
+var (
+	_ IPausableReader = (*IPausableCaller)(nil)
+	_ IPausableReader = (*IPausable)(nil)
+	_ IPausableReader = (*FakeIPausable)(nil)
+	_ IPausableWriter = (*IPausableTransactor)(nil)
+	_ IPausableWriter = (*IPausable)(nil)
+	_ IPausableWriter = (*FakeIPausable)(nil)
+	_ IPausableEvents = (*IPausableFilterer)(nil)
+	_ IPausableEvents = (*IPausable)(nil)
+	_ IPausableEvents = (*FakeIPausable)(nil)
+)
@@ -0,0 +1,25 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPauserRegistry
+
+// ErrInputAddressZero is the typed form of the IPauserRegistry custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "IPauserRegistry: InputAddressZero()"
+}
+
+// ErrOnlyUnpauser is the typed form of the IPauserRegistry custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "IPauserRegistry: OnlyUnpauser()"
+}
+
+// IPauserRegistryErrors maps the name of every custom error in the IPauserRegistry ABI to its typed Go error.
+var IPauserRegistryErrors = map[string]error{
+	"InputAddressZero": ErrInputAddressZero{},
+	"OnlyUnpauser":     ErrOnlyUnpauser{},
+}
@@ -0,0 +1,62 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPauserRegistry
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeIPauserRegistry is an in-memory IPauserRegistry for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IPauserRegistryFilterer.
+type FakeIPauserRegistry struct {
+	*fake.Contract
+	*IPauserRegistryFilterer
+}
+
+// NewFakeIPauserRegistry returns an empty fake of the contract at address.
+func NewFakeIPauserRegistry(address common.Address) *FakeIPauserRegistry {
+	contract := fake.NewContract(IPauserRegistryMetaData, address)
+	filterer, err := NewIPauserRegistryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIPauserRegistry{Contract: contract, IPauserRegistryFilterer: filterer}
+}
+
+// IsPauser returns the values stubbed with StubIsPauser.
+func (_FakeIPauserRegistry *FakeIPauserRegistry) IsPauser(opts *bind.CallOpts, pauser common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIPauserRegistry.Contract.Read("isPauser", []interface{}{pauser}, &out0)
+	return out0, err
+}
+
+// StubIsPauser sets what IsPauser returns for the given arguments.
+func (_FakeIPauserRegistry *FakeIPauserRegistry) StubIsPauser(pauser common.Address, out0 bool) {
+	_FakeIPauserRegistry.Contract.Stub("isPauser", []interface{}{pauser}, out0)
+}
+
+// Unpauser returns the values stubbed with StubUnpauser.
+func (_FakeIPauserRegistry *FakeIPauserRegistry) Unpauser(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIPauserRegistry.Contract.Read("unpauser", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubUnpauser sets what Unpauser returns for the given arguments.
+func (_FakeIPauserRegistry *FakeIPauserRegistry) StubUnpauser(out0 common.Address) {
+	_FakeIPauserRegistry.Contract.Stub("unpauser", []interface{}{}, out0)
+}
+
+// EmitPauserStatusChanged appends the event to the fake's logs.
+func (_FakeIPauserRegistry *FakeIPauserRegistry) EmitPauserStatusChanged(event *IPauserRegistryPauserStatusChanged) {
+	_FakeIPauserRegistry.Contract.Emit("PauserStatusChanged", event)
+}
+
+// EmitUnpauserChanged appends the event to the fake's logs.
+func (_FakeIPauserRegistry *FakeIPauserRegistry) EmitUnpauserChanged(event *IPauserRegistryUnpauserChanged) {
+	_FakeIPauserRegistry.Contract.Emit("UnpauserChanged", event)
+}
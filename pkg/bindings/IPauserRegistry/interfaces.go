@@ -0,0 +1,37 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPauserRegistry
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IPauserRegistryReader is the read-only surface of the IPauserRegistry
+// binding, implemented by IPauserRegistryCaller, IPauserRegistry and FakeIPauserRegistry.
+type IPauserRegistryReader interface {
+	IsPauser(opts *bind.CallOpts, pauser common.Address) (bool, error)
+	Unpauser(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IPauserRegistryEvents is the event filtering surface of the IPauserRegistry
+// binding, implemented by IPauserRegistryFilterer, IPauserRegistry and FakeIPauserRegistry.
+type IPauserRegistryEvents interface {
+	FilterPauserStatusChanged(opts *bind.FilterOpts) (*IPauserRegistryPauserStatusChangedIterator, error)
+	WatchPauserStatusChanged(opts *bind.WatchOpts, sink chan<- *IPauserRegistryPauserStatusChanged) (event.Subscription, error)
+	ParsePauserStatusChanged(log types.Log) (*IPauserRegistryPauserStatusChanged, error)
+	FilterUnpauserChanged(opts *bind.FilterOpts) (*IPauserRegistryUnpauserChangedIterator, error)
+	WatchUnpauserChanged(opts *bind.WatchOpts, sink chan<- *IPauserRegistryUnpauserChanged) (event.Subscription, error)
+	ParseUnpauserChanged(log types.Log) (*IPauserRegistryUnpauserChanged, error)
+}
+
+var (
+	_ IPauserRegistryReader = (*IPauserRegistryCaller)(nil)
+	_ IPauserRegistryReader = (*IPauserRegistry)(nil)
+	_ IPauserRegistryReader = (*FakeIPauserRegistry)(nil)
+	_ IPauserRegistryEvents = (*IPauserRegistryFilterer)(nil)
+	_ IPauserRegistryEvents = (*IPauserRegistry)(nil)
+	_ IPauserRegistryEvents = (*FakeIPauserRegistry)(nil)
+)
@@ -0,0 +1,79 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPermissionController
+
+// ErrAdminAlreadyPending is the typed form of the IPermissionController custom error AdminAlreadyPending().
+type ErrAdminAlreadyPending struct{}
+
+// Error implements the error interface.
+func (ErrAdminAlreadyPending) Error() string {
+	return "IPermissionController: AdminAlreadyPending()"
+}
+
+// ErrAdminAlreadySet is the typed form of the IPermissionController custom error AdminAlreadySet().
+type ErrAdminAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrAdminAlreadySet) Error() string {
+	return "IPermissionController: AdminAlreadySet()"
+}
+
+// ErrAdminNotPending is the typed form of the IPermissionController custom error AdminNotPending().
+type ErrAdminNotPending struct{}
+
+// Error implements the error interface.
+func (ErrAdminNotPending) Error() string {
+	return "IPermissionController: AdminNotPending()"
+}
+
+// ErrAdminNotSet is the typed form of the IPermissionController custom error AdminNotSet().
+type ErrAdminNotSet struct{}
+
+// Error implements the error interface.
+func (ErrAdminNotSet) Error() string {
+	return "IPermissionController: AdminNotSet()"
+}
+
+// ErrAppointeeAlreadySet is the typed form of the IPermissionController custom error AppointeeAlreadySet().
+type ErrAppointeeAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrAppointeeAlreadySet) Error() string {
+	return "IPermissionController: AppointeeAlreadySet()"
+}
+
+// ErrAppointeeNotSet is the typed form of the IPermissionController custom error AppointeeNotSet().
+type ErrAppointeeNotSet struct{}
+
+// Error implements the error interface.
+func (ErrAppointeeNotSet) Error() string {
+	return "IPermissionController: AppointeeNotSet()"
+}
+
+// ErrCannotHaveZeroAdmins is the typed form of the IPermissionController custom error CannotHaveZeroAdmins().
+type ErrCannotHaveZeroAdmins struct{}
+
+// Error implements the error interface.
+func (ErrCannotHaveZeroAdmins) Error() string {
+	return "IPermissionController: CannotHaveZeroAdmins()"
+}
+
+// ErrNotAdmin is the typed form of the IPermissionController custom error NotAdmin().
+type ErrNotAdmin struct{}
+
+// Error implements the error interface.
+func (ErrNotAdmin) Error() string {
+	return "IPermissionController: NotAdmin()"
+}
+
+// IPermissionControllerErrors maps the name of every custom error in the IPermissionController ABI to its typed Go error.
+var IPermissionControllerErrors = map[string]error{
+	"AdminAlreadyPending":  ErrAdminAlreadyPending{},
+	"AdminAlreadySet":      ErrAdminAlreadySet{},
+	"AdminNotPending":      ErrAdminNotPending{},
+	"AdminNotSet":          ErrAdminNotSet{},
+	"AppointeeAlreadySet":  ErrAppointeeAlreadySet{},
+	"AppointeeNotSet":      ErrAppointeeNotSet{},
+	"CannotHaveZeroAdmins": ErrCannotHaveZeroAdmins{},
+	"NotAdmin":             ErrNotAdmin{},
+}
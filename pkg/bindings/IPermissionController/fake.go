@@ -0,0 +1,240 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPermissionController
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeIPermissionController is an in-memory IPermissionController for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IPermissionControllerFilterer.
+type FakeIPermissionController struct {
+	*fake.Contract
+	*IPermissionControllerFilterer
+}
+
+// NewFakeIPermissionController returns an empty fake of the contract at address.
+func NewFakeIPermissionController(address common.Address) *FakeIPermissionController {
+	contract := fake.NewContract(IPermissionControllerMetaData, address)
+	filterer, err := NewIPermissionControllerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIPermissionController{Contract: contract, IPermissionControllerFilterer: filterer}
+}
+
+// CanCall returns the values stubbed with StubCanCall.
+func (_FakeIPermissionController *FakeIPermissionController) CanCall(opts *bind.CallOpts, account common.Address, caller common.Address, target common.Address, selector [4]byte) (bool, error) {
+	var out0 bool
+	err := _FakeIPermissionController.Contract.Read("canCall", []interface{}{account, caller, target, selector}, &out0)
+	return out0, err
+}
+
+// StubCanCall sets what CanCall returns for the given arguments.
+func (_FakeIPermissionController *FakeIPermissionController) StubCanCall(account common.Address, caller common.Address, target common.Address, selector [4]byte, out0 bool) {
+	_FakeIPermissionController.Contract.Stub("canCall", []interface{}{account, caller, target, selector}, out0)
+}
+
+// GetAdmins returns the values stubbed with StubGetAdmins.
+func (_FakeIPermissionController *FakeIPermissionController) GetAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeIPermissionController.Contract.Read("getAdmins", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubGetAdmins sets what GetAdmins returns for the given arguments.
+func (_FakeIPermissionController *FakeIPermissionController) StubGetAdmins(account common.Address, out0 []common.Address) {
+	_FakeIPermissionController.Contract.Stub("getAdmins", []interface{}{account}, out0)
+}
+
+// GetAppointeePermissions returns the values stubbed with StubGetAppointeePermissions.
+func (_FakeIPermissionController *FakeIPermissionController) GetAppointeePermissions(opts *bind.CallOpts, account common.Address, appointee common.Address) ([]common.Address, [][4]byte, error) {
+	var out0 []common.Address
+	var out1 [][4]byte
+	err := _FakeIPermissionController.Contract.Read("getAppointeePermissions", []interface{}{account, appointee}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetAppointeePermissions sets what GetAppointeePermissions returns for the given arguments.
+func (_FakeIPermissionController *FakeIPermissionController) StubGetAppointeePermissions(account common.Address, appointee common.Address, out0 []common.Address, out1 [][4]byte) {
+	_FakeIPermissionController.Contract.Stub("getAppointeePermissions", []interface{}{account, appointee}, out0, out1)
+}
+
+// GetAppointees returns the values stubbed with StubGetAppointees.
+func (_FakeIPermissionController *FakeIPermissionController) GetAppointees(opts *bind.CallOpts, account common.Address, target common.Address, selector [4]byte) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeIPermissionController.Contract.Read("getAppointees", []interface{}{account, target, selector}, &out0)
+	return out0, err
+}
+
+// StubGetAppointees sets what GetAppointees returns for the given arguments.
+func (_FakeIPermissionController *FakeIPermissionController) StubGetAppointees(account common.Address, target common.Address, selector [4]byte, out0 []common.Address) {
+	_FakeIPermissionController.Contract.Stub("getAppointees", []interface{}{account, target, selector}, out0)
+}
+
+// GetPendingAdmins returns the values stubbed with StubGetPendingAdmins.
+func (_FakeIPermissionController *FakeIPermissionController) GetPendingAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeIPermissionController.Contract.Read("getPendingAdmins", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubGetPendingAdmins sets what GetPendingAdmins returns for the given arguments.
+func (_FakeIPermissionController *FakeIPermissionController) StubGetPendingAdmins(account common.Address, out0 []common.Address) {
+	_FakeIPermissionController.Contract.Stub("getPendingAdmins", []interface{}{account}, out0)
+}
+
+// IsAdmin returns the values stubbed with StubIsAdmin.
+func (_FakeIPermissionController *FakeIPermissionController) IsAdmin(opts *bind.CallOpts, account common.Address, caller common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIPermissionController.Contract.Read("isAdmin", []interface{}{account, caller}, &out0)
+	return out0, err
+}
+
+// StubIsAdmin sets what IsAdmin returns for the given arguments.
+func (_FakeIPermissionController *FakeIPermissionController) StubIsAdmin(account common.Address, caller common.Address, out0 bool) {
+	_FakeIPermissionController.Contract.Stub("isAdmin", []interface{}{account, caller}, out0)
+}
+
+// IsPendingAdmin returns the values stubbed with StubIsPendingAdmin.
+func (_FakeIPermissionController *FakeIPermissionController) IsPendingAdmin(opts *bind.CallOpts, account common.Address, pendingAdmin common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIPermissionController.Contract.Read("isPendingAdmin", []interface{}{account, pendingAdmin}, &out0)
+	return out0, err
+}
+
+// StubIsPendingAdmin sets what IsPendingAdmin returns for the given arguments.
+func (_FakeIPermissionController *FakeIPermissionController) StubIsPendingAdmin(account common.Address, pendingAdmin common.Address, out0 bool) {
+	_FakeIPermissionController.Contract.Stub("isPendingAdmin", []interface{}{account, pendingAdmin}, out0)
+}
+
+// AcceptAdmin records a acceptAdmin call after running the hook set with OnAcceptAdmin.
+func (_FakeIPermissionController *FakeIPermissionController) AcceptAdmin(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _FakeIPermissionController.Contract.Transact(opts, "acceptAdmin", func() error {
+		if hook, ok := _FakeIPermissionController.Contract.Hook("acceptAdmin").(func(opts *bind.TransactOpts, account common.Address) error); ok {
+			return hook(opts, account)
+		}
+		return nil
+	}, account)
+}
+
+// OnAcceptAdmin sets a hook run by every AcceptAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPermissionController *FakeIPermissionController) OnAcceptAdmin(hook func(opts *bind.TransactOpts, account common.Address) error) {
+	_FakeIPermissionController.Contract.SetHook("acceptAdmin", hook)
+}
+
+// AddPendingAdmin records a addPendingAdmin call after running the hook set with OnAddPendingAdmin.
+func (_FakeIPermissionController *FakeIPermissionController) AddPendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakeIPermissionController.Contract.Transact(opts, "addPendingAdmin", func() error {
+		if hook, ok := _FakeIPermissionController.Contract.Hook("addPendingAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnAddPendingAdmin sets a hook run by every AddPendingAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPermissionController *FakeIPermissionController) OnAddPendingAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakeIPermissionController.Contract.SetHook("addPendingAdmin", hook)
+}
+
+// RemoveAdmin records a removeAdmin call after running the hook set with OnRemoveAdmin.
+func (_FakeIPermissionController *FakeIPermissionController) RemoveAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakeIPermissionController.Contract.Transact(opts, "removeAdmin", func() error {
+		if hook, ok := _FakeIPermissionController.Contract.Hook("removeAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnRemoveAdmin sets a hook run by every RemoveAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPermissionController *FakeIPermissionController) OnRemoveAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakeIPermissionController.Contract.SetHook("removeAdmin", hook)
+}
+
+// RemoveAppointee records a removeAppointee call after running the hook set with OnRemoveAppointee.
+func (_FakeIPermissionController *FakeIPermissionController) RemoveAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error) {
+	return _FakeIPermissionController.Contract.Transact(opts, "removeAppointee", func() error {
+		if hook, ok := _FakeIPermissionController.Contract.Hook("removeAppointee").(func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error); ok {
+			return hook(opts, account, appointee, target, selector)
+		}
+		return nil
+	}, account, appointee, target, selector)
+}
+
+// OnRemoveAppointee sets a hook run by every RemoveAppointee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPermissionController *FakeIPermissionController) OnRemoveAppointee(hook func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error) {
+	_FakeIPermissionController.Contract.SetHook("removeAppointee", hook)
+}
+
+// RemovePendingAdmin records a removePendingAdmin call after running the hook set with OnRemovePendingAdmin.
+func (_FakeIPermissionController *FakeIPermissionController) RemovePendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakeIPermissionController.Contract.Transact(opts, "removePendingAdmin", func() error {
+		if hook, ok := _FakeIPermissionController.Contract.Hook("removePendingAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnRemovePendingAdmin sets a hook run by every RemovePendingAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPermissionController *FakeIPermissionController) OnRemovePendingAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakeIPermissionController.Contract.SetHook("removePendingAdmin", hook)
+}
+
+// SetAppointee records a setAppointee call after running the hook set with OnSetAppointee.
+func (_FakeIPermissionController *FakeIPermissionController) SetAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error) {
+	return _FakeIPermissionController.Contract.Transact(opts, "setAppointee", func() error {
+		if hook, ok := _FakeIPermissionController.Contract.Hook("setAppointee").(func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error); ok {
+			return hook(opts, account, appointee, target, selector)
+		}
+		return nil
+	}, account, appointee, target, selector)
+}
+
+// OnSetAppointee sets a hook run by every SetAppointee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIPermissionController *FakeIPermissionController) OnSetAppointee(hook func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error) {
+	_FakeIPermissionController.Contract.SetHook("setAppointee", hook)
+}
+
+// EmitAdminRemoved appends the event to the fake's logs.
+func (_FakeIPermissionController *FakeIPermissionController) EmitAdminRemoved(event *IPermissionControllerAdminRemoved) {
+	_FakeIPermissionController.Contract.Emit("AdminRemoved", event)
+}
+
+// EmitAdminSet appends the event to the fake's logs.
+func (_FakeIPermissionController *FakeIPermissionController) EmitAdminSet(event *IPermissionControllerAdminSet) {
+	_FakeIPermissionController.Contract.Emit("AdminSet", event)
+}
+
+// EmitAppointeeRemoved appends the event to the fake's logs.
+func (_FakeIPermissionController *FakeIPermissionController) EmitAppointeeRemoved(event *IPermissionControllerAppointeeRemoved) {
+	_FakeIPermissionController.Contract.Emit("AppointeeRemoved", event)
+}
+
+// EmitAppointeeSet appends the event to the fake's logs.
+func (_FakeIPermissionController *FakeIPermissionController) EmitAppointeeSet(event *IPermissionControllerAppointeeSet) {
+	_FakeIPermissionController.Contract.Emit("AppointeeSet", event)
+}
+
+// EmitPendingAdminAdded appends the event to the fake's logs.
+func (_FakeIPermissionController *FakeIPermissionController) EmitPendingAdminAdded(event *IPermissionControllerPendingAdminAdded) {
+	_FakeIPermissionController.Contract.Emit("PendingAdminAdded", event)
+}
+
+// EmitPendingAdminRemoved appends the event to the fake's logs.
+func (_FakeIPermissionController *FakeIPermissionController) EmitPendingAdminRemoved(event *IPermissionControllerPendingAdminRemoved) {
+	_FakeIPermissionController.Contract.Emit("PendingAdminRemoved", event)
+}
@@ -0,0 +1,68 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IPermissionController
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IPermissionControllerReader is the read-only surface of the IPermissionController
+// binding, implemented by IPermissionControllerCaller, IPermissionController and FakeIPermissionController.
+type IPermissionControllerReader interface {
+	CanCall(opts *bind.CallOpts, account common.Address, caller common.Address, target common.Address, selector [4]byte) (bool, error)
+	GetAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error)
+	GetAppointeePermissions(opts *bind.CallOpts, account common.Address, appointee common.Address) ([]common.Address, [][4]byte, error)
+	GetAppointees(opts *bind.CallOpts, account common.Address, target common.Address, selector [4]byte) ([]common.Address, error)
+	GetPendingAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error)
+	IsAdmin(opts *bind.CallOpts, account common.Address, caller common.Address) (bool, error)
+	IsPendingAdmin(opts *bind.CallOpts, account common.Address, pendingAdmin common.Address) (bool, error)
+}
+
+// IPermissionControllerWriter is the transacting surface of the IPermissionController
+// binding, implemented by IPermissionControllerTransactor, IPermissionController and FakeIPermissionController.
+type IPermissionControllerWriter interface {
+	AcceptAdmin(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error)
+	AddPendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	RemoveAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	RemoveAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error)
+	RemovePendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	SetAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error)
+}
+
+// IPermissionControllerEvents is the event filtering surface of the IPermissionController
+// binding, implemented by IPermissionControllerFilterer, IPermissionController and FakeIPermissionController.
+type IPermissionControllerEvents interface {
+	FilterAdminRemoved(opts *bind.FilterOpts, account []common.Address) (*IPermissionControllerAdminRemovedIterator, error)
+	WatchAdminRemoved(opts *bind.WatchOpts, sink chan<- *IPermissionControllerAdminRemoved, account []common.Address) (event.Subscription, error)
+	ParseAdminRemoved(log types.Log) (*IPermissionControllerAdminRemoved, error)
+	FilterAdminSet(opts *bind.FilterOpts, account []common.Address) (*IPermissionControllerAdminSetIterator, error)
+	WatchAdminSet(opts *bind.WatchOpts, sink chan<- *IPermissionControllerAdminSet, account []common.Address) (event.Subscription, error)
+	ParseAdminSet(log types.Log) (*IPermissionControllerAdminSet, error)
+	FilterAppointeeRemoved(opts *bind.FilterOpts, account []common.Address, appointee []common.Address) (*IPermissionControllerAppointeeRemovedIterator, error)
+	WatchAppointeeRemoved(opts *bind.WatchOpts, sink chan<- *IPermissionControllerAppointeeRemoved, account []common.Address, appointee []common.Address) (event.Subscription, error)
+	ParseAppointeeRemoved(log types.Log) (*IPermissionControllerAppointeeRemoved, error)
+	FilterAppointeeSet(opts *bind.FilterOpts, account []common.Address, appointee []common.Address) (*IPermissionControllerAppointeeSetIterator, error)
+	WatchAppointeeSet(opts *bind.WatchOpts, sink chan<- *IPermissionControllerAppointeeSet, account []common.Address, appointee []common.Address) (event.Subscription, error)
+	ParseAppointeeSet(log types.Log) (*IPermissionControllerAppointeeSet, error)
+	FilterPendingAdminAdded(opts *bind.FilterOpts, account []common.Address) (*IPermissionControllerPendingAdminAddedIterator, error)
+	WatchPendingAdminAdded(opts *bind.WatchOpts, sink chan<- *IPermissionControllerPendingAdminAdded, account []common.Address) (event.Subscription, error)
+	ParsePendingAdminAdded(log types.Log) (*IPermissionControllerPendingAdminAdded, error)
+	FilterPendingAdminRemoved(opts *bind.FilterOpts, account []common.Address) (*IPermissionControllerPendingAdminRemovedIterator, error)
+	WatchPendingAdminRemoved(opts *bind.WatchOpts, sink chan<- *IPermissionControllerPendingAdminRemoved, account []common.Address) (event.Subscription, error)
+	ParsePendingAdminRemoved(log types.Log) (*IPermissionControllerPendingAdminRemoved, error)
+}
+
+var (
+	_ IPermissionControllerReader = (*IPermissionControllerCaller)(nil)
+	_ IPermissionControllerReader = (*IPermissionController)(nil)
+	_ IPermissionControllerReader = (*FakeIPermissionController)(nil)
+	_ IPermissionControllerWriter = (*IPermissionControllerTransactor)(nil)
+	_ IPermissionControllerWriter = (*IPermissionController)(nil)
+	_ IPermissionControllerWriter = (*FakeIPermissionController)(nil)
+	_ IPermissionControllerEvents = (*IPermissionControllerFilterer)(nil)
+	_ IPermissionControllerEvents = (*IPermissionController)(nil)
+	_ IPermissionControllerEvents = (*FakeIPermissionController)(nil)
+)
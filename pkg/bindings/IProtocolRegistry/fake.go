@@ -0,0 +1,214 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IProtocolRegistry
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIProtocolRegistry is an in-memory IProtocolRegistry for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IProtocolRegistryFilterer.
+type FakeIProtocolRegistry struct {
+	*fake.Contract
+	*IProtocolRegistryFilterer
+}
+
+// NewFakeIProtocolRegistry returns an empty fake of the contract at address.
+func NewFakeIProtocolRegistry(address common.Address) *FakeIProtocolRegistry {
+	contract := fake.NewContract(IProtocolRegistryMetaData, address)
+	filterer, err := NewIProtocolRegistryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIProtocolRegistry{Contract: contract, IProtocolRegistryFilterer: filterer}
+}
+
+// PAUSERROLE returns the values stubbed with StubPAUSERROLE.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) PAUSERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIProtocolRegistry.Contract.Read("PAUSER_ROLE", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPAUSERROLE sets what PAUSERROLE returns for the given arguments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) StubPAUSERROLE(out0 [32]byte) {
+	_FakeIProtocolRegistry.Contract.Stub("PAUSER_ROLE", []interface{}{}, out0)
+}
+
+// GetAddress returns the values stubbed with StubGetAddress.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) GetAddress(opts *bind.CallOpts, name string) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIProtocolRegistry.Contract.Read("getAddress", []interface{}{name}, &out0)
+	return out0, err
+}
+
+// StubGetAddress sets what GetAddress returns for the given arguments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) StubGetAddress(name string, out0 common.Address) {
+	_FakeIProtocolRegistry.Contract.Stub("getAddress", []interface{}{name}, out0)
+}
+
+// GetAllDeployments returns the values stubbed with StubGetAllDeployments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) GetAllDeployments(opts *bind.CallOpts) (struct {
+	Names     []string
+	Addresses []common.Address
+	Configs   []IProtocolRegistryTypesDeploymentConfig
+}, error) {
+	var out0 struct {
+		Names     []string
+		Addresses []common.Address
+		Configs   []IProtocolRegistryTypesDeploymentConfig
+	}
+	err := _FakeIProtocolRegistry.Contract.Read("getAllDeployments", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetAllDeployments sets what GetAllDeployments returns for the given arguments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) StubGetAllDeployments(out0 struct {
+	Names     []string
+	Addresses []common.Address
+	Configs   []IProtocolRegistryTypesDeploymentConfig
+}) {
+	_FakeIProtocolRegistry.Contract.Stub("getAllDeployments", []interface{}{}, out0)
+}
+
+// GetDeployment returns the values stubbed with StubGetDeployment.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) GetDeployment(opts *bind.CallOpts, name string) (struct {
+	Addr   common.Address
+	Config IProtocolRegistryTypesDeploymentConfig
+}, error) {
+	var out0 struct {
+		Addr   common.Address
+		Config IProtocolRegistryTypesDeploymentConfig
+	}
+	err := _FakeIProtocolRegistry.Contract.Read("getDeployment", []interface{}{name}, &out0)
+	return out0, err
+}
+
+// StubGetDeployment sets what GetDeployment returns for the given arguments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) StubGetDeployment(name string, out0 struct {
+	Addr   common.Address
+	Config IProtocolRegistryTypesDeploymentConfig
+}) {
+	_FakeIProtocolRegistry.Contract.Stub("getDeployment", []interface{}{name}, out0)
+}
+
+// MajorVersion returns the values stubbed with StubMajorVersion.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) MajorVersion(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeIProtocolRegistry.Contract.Read("majorVersion", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMajorVersion sets what MajorVersion returns for the given arguments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) StubMajorVersion(out0 string) {
+	_FakeIProtocolRegistry.Contract.Stub("majorVersion", []interface{}{}, out0)
+}
+
+// TotalDeployments returns the values stubbed with StubTotalDeployments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) TotalDeployments(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIProtocolRegistry.Contract.Read("totalDeployments", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalDeployments sets what TotalDeployments returns for the given arguments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) StubTotalDeployments(out0 *big.Int) {
+	_FakeIProtocolRegistry.Contract.Stub("totalDeployments", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeIProtocolRegistry.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) StubVersion(out0 string) {
+	_FakeIProtocolRegistry.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// Configure records a configure call after running the hook set with OnConfigure.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) Configure(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) (*types.Transaction, error) {
+	return _FakeIProtocolRegistry.Contract.Transact(opts, "configure", func() error {
+		if hook, ok := _FakeIProtocolRegistry.Contract.Hook("configure").(func(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) error); ok {
+			return hook(opts, addr, config)
+		}
+		return nil
+	}, addr, config)
+}
+
+// OnConfigure sets a hook run by every Configure call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) OnConfigure(hook func(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) error) {
+	_FakeIProtocolRegistry.Contract.SetHook("configure", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) Initialize(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) (*types.Transaction, error) {
+	return _FakeIProtocolRegistry.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeIProtocolRegistry.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) error); ok {
+			return hook(opts, initialAdmin, pauserMultisig)
+		}
+		return nil
+	}, initialAdmin, pauserMultisig)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) OnInitialize(hook func(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) error) {
+	_FakeIProtocolRegistry.Contract.SetHook("initialize", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeIProtocolRegistry.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeIProtocolRegistry.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeIProtocolRegistry.Contract.SetHook("pauseAll", hook)
+}
+
+// Ship records a ship call after running the hook set with OnShip.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) Ship(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, contractNames []string, semanticVersion string) (*types.Transaction, error) {
+	return _FakeIProtocolRegistry.Contract.Transact(opts, "ship", func() error {
+		if hook, ok := _FakeIProtocolRegistry.Contract.Hook("ship").(func(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, contractNames []string, semanticVersion string) error); ok {
+			return hook(opts, addresses, configs, contractNames, semanticVersion)
+		}
+		return nil
+	}, addresses, configs, contractNames, semanticVersion)
+}
+
+// OnShip sets a hook run by every Ship call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) OnShip(hook func(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, contractNames []string, semanticVersion string) error) {
+	_FakeIProtocolRegistry.Contract.SetHook("ship", hook)
+}
+
+// EmitDeploymentConfigured appends the event to the fake's logs.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) EmitDeploymentConfigured(event *IProtocolRegistryDeploymentConfigured) {
+	_FakeIProtocolRegistry.Contract.Emit("DeploymentConfigured", event)
+}
+
+// EmitDeploymentShipped appends the event to the fake's logs.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) EmitDeploymentShipped(event *IProtocolRegistryDeploymentShipped) {
+	_FakeIProtocolRegistry.Contract.Emit("DeploymentShipped", event)
+}
+
+// EmitSemanticVersionUpdated appends the event to the fake's logs.
+func (_FakeIProtocolRegistry *FakeIProtocolRegistry) EmitSemanticVersionUpdated(event *IProtocolRegistrySemanticVersionUpdated) {
+	_FakeIProtocolRegistry.Contract.Emit("SemanticVersionUpdated", event)
+}
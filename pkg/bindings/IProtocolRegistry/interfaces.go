@@ -0,0 +1,65 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IProtocolRegistry
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IProtocolRegistryReader is the read-only surface of the IProtocolRegistry
+// binding, implemented by IProtocolRegistryCaller, IProtocolRegistry and FakeIProtocolRegistry.
+type IProtocolRegistryReader interface {
+	PAUSERROLE(opts *bind.CallOpts) ([32]byte, error)
+	GetAddress(opts *bind.CallOpts, name string) (common.Address, error)
+	GetAllDeployments(opts *bind.CallOpts) (struct {
+		Names     []string
+		Addresses []common.Address
+		Configs   []IProtocolRegistryTypesDeploymentConfig
+	}, error)
+	GetDeployment(opts *bind.CallOpts, name string) (struct {
+		Addr   common.Address
+		Config IProtocolRegistryTypesDeploymentConfig
+	}, error)
+	MajorVersion(opts *bind.CallOpts) (string, error)
+	TotalDeployments(opts *bind.CallOpts) (*big.Int, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+// IProtocolRegistryWriter is the transacting surface of the IProtocolRegistry
+// binding, implemented by IProtocolRegistryTransactor, IProtocolRegistry and FakeIProtocolRegistry.
+type IProtocolRegistryWriter interface {
+	Configure(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	Ship(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, contractNames []string, semanticVersion string) (*types.Transaction, error)
+}
+
+// IProtocolRegistryEvents is the event filtering surface of the IProtocolRegistry
+// binding, implemented by IProtocolRegistryFilterer, IProtocolRegistry and FakeIProtocolRegistry.
+type IProtocolRegistryEvents interface {
+	FilterDeploymentConfigured(opts *bind.FilterOpts, addr []common.Address) (*IProtocolRegistryDeploymentConfiguredIterator, error)
+	WatchDeploymentConfigured(opts *bind.WatchOpts, sink chan<- *IProtocolRegistryDeploymentConfigured, addr []common.Address) (event.Subscription, error)
+	ParseDeploymentConfigured(log types.Log) (*IProtocolRegistryDeploymentConfigured, error)
+	FilterDeploymentShipped(opts *bind.FilterOpts, addr []common.Address) (*IProtocolRegistryDeploymentShippedIterator, error)
+	WatchDeploymentShipped(opts *bind.WatchOpts, sink chan<- *IProtocolRegistryDeploymentShipped, addr []common.Address) (event.Subscription, error)
+	ParseDeploymentShipped(log types.Log) (*IProtocolRegistryDeploymentShipped, error)
+	FilterSemanticVersionUpdated(opts *bind.FilterOpts) (*IProtocolRegistrySemanticVersionUpdatedIterator, error)
+	WatchSemanticVersionUpdated(opts *bind.WatchOpts, sink chan<- *IProtocolRegistrySemanticVersionUpdated) (event.Subscription, error)
+	ParseSemanticVersionUpdated(log types.Log) (*IProtocolRegistrySemanticVersionUpdated, error)
+}
+
+var (
+	_ IProtocolRegistryReader = (*IProtocolRegistryCaller)(nil)
+	_ IProtocolRegistryReader = (*IProtocolRegistry)(nil)
+	_ IProtocolRegistryReader = (*FakeIProtocolRegistry)(nil)
+	_ IProtocolRegistryWriter = (*IProtocolRegistryTransactor)(nil)
+	_ IProtocolRegistryWriter = (*IProtocolRegistry)(nil)
+	_ IProtocolRegistryWriter = (*FakeIProtocolRegistry)(nil)
+	_ IProtocolRegistryEvents = (*IProtocolRegistryFilterer)(nil)
+	_ IProtocolRegistryEvents = (*IProtocolRegistry)(nil)
+	_ IProtocolRegistryEvents = (*FakeIProtocolRegistry)(nil)
+)
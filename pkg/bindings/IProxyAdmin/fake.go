@@ -0,0 +1,101 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IProxyAdmin
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeIProxyAdmin is an in-memory IProxyAdmin for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IProxyAdminFilterer.
+type FakeIProxyAdmin struct {
+	*fake.Contract
+	*IProxyAdminFilterer
+}
+
+// NewFakeIProxyAdmin returns an empty fake of the contract at address.
+func NewFakeIProxyAdmin(address common.Address) *FakeIProxyAdmin {
+	contract := fake.NewContract(IProxyAdminMetaData, address)
+	filterer, err := NewIProxyAdminFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIProxyAdmin{Contract: contract, IProxyAdminFilterer: filterer}
+}
+
+// GetProxyAdmin returns the values stubbed with StubGetProxyAdmin.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) GetProxyAdmin(opts *bind.CallOpts, proxy common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIProxyAdmin.Contract.Read("getProxyAdmin", []interface{}{proxy}, &out0)
+	return out0, err
+}
+
+// StubGetProxyAdmin sets what GetProxyAdmin returns for the given arguments.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) StubGetProxyAdmin(proxy common.Address, out0 common.Address) {
+	_FakeIProxyAdmin.Contract.Stub("getProxyAdmin", []interface{}{proxy}, out0)
+}
+
+// GetProxyImplementation returns the values stubbed with StubGetProxyImplementation.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) GetProxyImplementation(opts *bind.CallOpts, proxy common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIProxyAdmin.Contract.Read("getProxyImplementation", []interface{}{proxy}, &out0)
+	return out0, err
+}
+
+// StubGetProxyImplementation sets what GetProxyImplementation returns for the given arguments.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) StubGetProxyImplementation(proxy common.Address, out0 common.Address) {
+	_FakeIProxyAdmin.Contract.Stub("getProxyImplementation", []interface{}{proxy}, out0)
+}
+
+// ChangeProxyAdmin records a changeProxyAdmin call after running the hook set with OnChangeProxyAdmin.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) ChangeProxyAdmin(opts *bind.TransactOpts, proxy common.Address, newAdmin common.Address) (*types.Transaction, error) {
+	return _FakeIProxyAdmin.Contract.Transact(opts, "changeProxyAdmin", func() error {
+		if hook, ok := _FakeIProxyAdmin.Contract.Hook("changeProxyAdmin").(func(opts *bind.TransactOpts, proxy common.Address, newAdmin common.Address) error); ok {
+			return hook(opts, proxy, newAdmin)
+		}
+		return nil
+	}, proxy, newAdmin)
+}
+
+// OnChangeProxyAdmin sets a hook run by every ChangeProxyAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) OnChangeProxyAdmin(hook func(opts *bind.TransactOpts, proxy common.Address, newAdmin common.Address) error) {
+	_FakeIProxyAdmin.Contract.SetHook("changeProxyAdmin", hook)
+}
+
+// Upgrade records a upgrade call after running the hook set with OnUpgrade.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) Upgrade(opts *bind.TransactOpts, proxy common.Address, implementation common.Address) (*types.Transaction, error) {
+	return _FakeIProxyAdmin.Contract.Transact(opts, "upgrade", func() error {
+		if hook, ok := _FakeIProxyAdmin.Contract.Hook("upgrade").(func(opts *bind.TransactOpts, proxy common.Address, implementation common.Address) error); ok {
+			return hook(opts, proxy, implementation)
+		}
+		return nil
+	}, proxy, implementation)
+}
+
+// OnUpgrade sets a hook run by every Upgrade call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) OnUpgrade(hook func(opts *bind.TransactOpts, proxy common.Address, implementation common.Address) error) {
+	_FakeIProxyAdmin.Contract.SetHook("upgrade", hook)
+}
+
+// UpgradeAndCall records a upgradeAndCall call after running the hook set with OnUpgradeAndCall.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) UpgradeAndCall(opts *bind.TransactOpts, proxy common.Address, implementation common.Address, data []byte) (*types.Transaction, error) {
+	return _FakeIProxyAdmin.Contract.Transact(opts, "upgradeAndCall", func() error {
+		if hook, ok := _FakeIProxyAdmin.Contract.Hook("upgradeAndCall").(func(opts *bind.TransactOpts, proxy common.Address, implementation common.Address, data []byte) error); ok {
+			return hook(opts, proxy, implementation, data)
+		}
+		return nil
+	}, proxy, implementation, data)
+}
+
+// OnUpgradeAndCall sets a hook run by every UpgradeAndCall call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIProxyAdmin *FakeIProxyAdmin) OnUpgradeAndCall(hook func(opts *bind.TransactOpts, proxy common.Address, implementation common.Address, data []byte) error) {
+	_FakeIProxyAdmin.Contract.SetHook("upgradeAndCall", hook)
+}
@@ -0,0 +1,33 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IProxyAdmin
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// IProxyAdminReader is the read-only surface of the IProxyAdmin
+// binding, implemented by IProxyAdminCaller, IProxyAdmin and FakeIProxyAdmin.
+type IProxyAdminReader interface {
+	GetProxyAdmin(opts *bind.CallOpts, proxy common.Address) (common.Address, error)
+	GetProxyImplementation(opts *bind.CallOpts, proxy common.Address) (common.Address, error)
+}
+
+// IProxyAdminWriter is the transacting surface of the IProxyAdmin
+// binding, implemented by IProxyAdminTransactor, IProxyAdmin and FakeIProxyAdmin.
+type IProxyAdminWriter interface {
+	ChangeProxyAdmin(opts *bind.TransactOpts, proxy common.Address, newAdmin common.Address) (*types.Transaction, error)
+	Upgrade(opts *bind.TransactOpts, proxy common.Address, implementation common.Address) (*types.Transaction, error)
+	UpgradeAndCall(opts *bind.TransactOpts, proxy common.Address, implementation common.Address, data []byte) (*types.Transaction, error)
+}
+
+var (
+	_ IProxyAdminReader = (*IProxyAdminCaller)(nil)
+	_ IProxyAdminReader = (*IProxyAdmin)(nil)
+	_ IProxyAdminReader = (*FakeIProxyAdmin)(nil)
+	_ IProxyAdminWriter = (*IProxyAdminTransactor)(nil)
+	_ IProxyAdminWriter = (*IProxyAdmin)(nil)
+	_ IProxyAdminWriter = (*FakeIProxyAdmin)(nil)
+)
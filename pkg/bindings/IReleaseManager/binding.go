@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,23 +30,14 @@ var (
 	_ = abi.ConvertType
 )
 
-// IReleaseManagerTypesArtifact is an auto generated low-level Go binding around an user-defined struct.
-type IReleaseManagerTypesArtifact struct {
-	Digest   [32]byte
-	Registry string
-}
+// IReleaseManagerTypesArtifact is an alias of the canonical eltypes.IReleaseManagerTypesArtifact shared by all bindings.
+type IReleaseManagerTypesArtifact = eltypes.IReleaseManagerTypesArtifact
 
-// IReleaseManagerTypesRelease is an auto generated low-level Go binding around an user-defined struct.
-type IReleaseManagerTypesRelease struct {
-	Artifacts     []IReleaseManagerTypesArtifact
-	UpgradeByTime uint32
-}
+// IReleaseManagerTypesRelease is an alias of the canonical eltypes.IReleaseManagerTypesRelease shared by all bindings.
+type IReleaseManagerTypesRelease = eltypes.IReleaseManagerTypesRelease
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // IReleaseManagerMetaData contains all meta data concerning the IReleaseManager contract.
 var IReleaseManagerMetaData = &bind.MetaData{
@@ -0,0 +1,43 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IReleaseManager
+
+// ErrInvalidMetadataURI is the typed form of the IReleaseManager custom error InvalidMetadataURI().
+type ErrInvalidMetadataURI struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMetadataURI) Error() string {
+	return "IReleaseManager: InvalidMetadataURI()"
+}
+
+// ErrInvalidUpgradeByTime is the typed form of the IReleaseManager custom error InvalidUpgradeByTime().
+type ErrInvalidUpgradeByTime struct{}
+
+// Error implements the error interface.
+func (ErrInvalidUpgradeByTime) Error() string {
+	return "IReleaseManager: InvalidUpgradeByTime()"
+}
+
+// ErrMustPublishMetadataURI is the typed form of the IReleaseManager custom error MustPublishMetadataURI().
+type ErrMustPublishMetadataURI struct{}
+
+// Error implements the error interface.
+func (ErrMustPublishMetadataURI) Error() string {
+	return "IReleaseManager: MustPublishMetadataURI()"
+}
+
+// ErrNoReleases is the typed form of the IReleaseManager custom error NoReleases().
+type ErrNoReleases struct{}
+
+// Error implements the error interface.
+func (ErrNoReleases) Error() string {
+	return "IReleaseManager: NoReleases()"
+}
+
+// IReleaseManagerErrors maps the name of every custom error in the IReleaseManager ABI to its typed Go error.
+var IReleaseManagerErrors = map[string]error{
+	"InvalidMetadataURI":     ErrInvalidMetadataURI{},
+	"InvalidUpgradeByTime":   ErrInvalidUpgradeByTime{},
+	"MustPublishMetadataURI": ErrMustPublishMetadataURI{},
+	"NoReleases":             ErrNoReleases{},
+}
@@ -0,0 +1,145 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IReleaseManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIReleaseManager is an in-memory IReleaseManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IReleaseManagerFilterer.
+type FakeIReleaseManager struct {
+	*fake.Contract
+	*IReleaseManagerFilterer
+}
+
+// NewFakeIReleaseManager returns an empty fake of the contract at address.
+func NewFakeIReleaseManager(address common.Address) *FakeIReleaseManager {
+	contract := fake.NewContract(IReleaseManagerMetaData, address)
+	filterer, err := NewIReleaseManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIReleaseManager{Contract: contract, IReleaseManagerFilterer: filterer}
+}
+
+// GetLatestRelease returns the values stubbed with StubGetLatestRelease.
+func (_FakeIReleaseManager *FakeIReleaseManager) GetLatestRelease(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, IReleaseManagerTypesRelease, error) {
+	var out0 *big.Int
+	var out1 IReleaseManagerTypesRelease
+	err := _FakeIReleaseManager.Contract.Read("getLatestRelease", []interface{}{operatorSet}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetLatestRelease sets what GetLatestRelease returns for the given arguments.
+func (_FakeIReleaseManager *FakeIReleaseManager) StubGetLatestRelease(operatorSet OperatorSet, out0 *big.Int, out1 IReleaseManagerTypesRelease) {
+	_FakeIReleaseManager.Contract.Stub("getLatestRelease", []interface{}{operatorSet}, out0, out1)
+}
+
+// GetLatestUpgradeByTime returns the values stubbed with StubGetLatestUpgradeByTime.
+func (_FakeIReleaseManager *FakeIReleaseManager) GetLatestUpgradeByTime(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeIReleaseManager.Contract.Read("getLatestUpgradeByTime", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetLatestUpgradeByTime sets what GetLatestUpgradeByTime returns for the given arguments.
+func (_FakeIReleaseManager *FakeIReleaseManager) StubGetLatestUpgradeByTime(operatorSet OperatorSet, out0 uint32) {
+	_FakeIReleaseManager.Contract.Stub("getLatestUpgradeByTime", []interface{}{operatorSet}, out0)
+}
+
+// GetMetadataURI returns the values stubbed with StubGetMetadataURI.
+func (_FakeIReleaseManager *FakeIReleaseManager) GetMetadataURI(opts *bind.CallOpts, operatorSet OperatorSet) (string, error) {
+	var out0 string
+	err := _FakeIReleaseManager.Contract.Read("getMetadataURI", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetMetadataURI sets what GetMetadataURI returns for the given arguments.
+func (_FakeIReleaseManager *FakeIReleaseManager) StubGetMetadataURI(operatorSet OperatorSet, out0 string) {
+	_FakeIReleaseManager.Contract.Stub("getMetadataURI", []interface{}{operatorSet}, out0)
+}
+
+// GetRelease returns the values stubbed with StubGetRelease.
+func (_FakeIReleaseManager *FakeIReleaseManager) GetRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (IReleaseManagerTypesRelease, error) {
+	var out0 IReleaseManagerTypesRelease
+	err := _FakeIReleaseManager.Contract.Read("getRelease", []interface{}{operatorSet, releaseId}, &out0)
+	return out0, err
+}
+
+// StubGetRelease sets what GetRelease returns for the given arguments.
+func (_FakeIReleaseManager *FakeIReleaseManager) StubGetRelease(operatorSet OperatorSet, releaseId *big.Int, out0 IReleaseManagerTypesRelease) {
+	_FakeIReleaseManager.Contract.Stub("getRelease", []interface{}{operatorSet, releaseId}, out0)
+}
+
+// GetTotalReleases returns the values stubbed with StubGetTotalReleases.
+func (_FakeIReleaseManager *FakeIReleaseManager) GetTotalReleases(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIReleaseManager.Contract.Read("getTotalReleases", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetTotalReleases sets what GetTotalReleases returns for the given arguments.
+func (_FakeIReleaseManager *FakeIReleaseManager) StubGetTotalReleases(operatorSet OperatorSet, out0 *big.Int) {
+	_FakeIReleaseManager.Contract.Stub("getTotalReleases", []interface{}{operatorSet}, out0)
+}
+
+// IsValidRelease returns the values stubbed with StubIsValidRelease.
+func (_FakeIReleaseManager *FakeIReleaseManager) IsValidRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (bool, error) {
+	var out0 bool
+	err := _FakeIReleaseManager.Contract.Read("isValidRelease", []interface{}{operatorSet, releaseId}, &out0)
+	return out0, err
+}
+
+// StubIsValidRelease sets what IsValidRelease returns for the given arguments.
+func (_FakeIReleaseManager *FakeIReleaseManager) StubIsValidRelease(operatorSet OperatorSet, releaseId *big.Int, out0 bool) {
+	_FakeIReleaseManager.Contract.Stub("isValidRelease", []interface{}{operatorSet, releaseId}, out0)
+}
+
+// PublishMetadataURI records a publishMetadataURI call after running the hook set with OnPublishMetadataURI.
+func (_FakeIReleaseManager *FakeIReleaseManager) PublishMetadataURI(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) (*types.Transaction, error) {
+	return _FakeIReleaseManager.Contract.Transact(opts, "publishMetadataURI", func() error {
+		if hook, ok := _FakeIReleaseManager.Contract.Hook("publishMetadataURI").(func(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) error); ok {
+			return hook(opts, operatorSet, metadataURI)
+		}
+		return nil
+	}, operatorSet, metadataURI)
+}
+
+// OnPublishMetadataURI sets a hook run by every PublishMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIReleaseManager *FakeIReleaseManager) OnPublishMetadataURI(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) error) {
+	_FakeIReleaseManager.Contract.SetHook("publishMetadataURI", hook)
+}
+
+// PublishRelease records a publishRelease call after running the hook set with OnPublishRelease.
+func (_FakeIReleaseManager *FakeIReleaseManager) PublishRelease(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) (*types.Transaction, error) {
+	return _FakeIReleaseManager.Contract.Transact(opts, "publishRelease", func() error {
+		if hook, ok := _FakeIReleaseManager.Contract.Hook("publishRelease").(func(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) error); ok {
+			return hook(opts, operatorSet, release)
+		}
+		return nil
+	}, operatorSet, release)
+}
+
+// OnPublishRelease sets a hook run by every PublishRelease call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIReleaseManager *FakeIReleaseManager) OnPublishRelease(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) error) {
+	_FakeIReleaseManager.Contract.SetHook("publishRelease", hook)
+}
+
+// EmitMetadataURIPublished appends the event to the fake's logs.
+func (_FakeIReleaseManager *FakeIReleaseManager) EmitMetadataURIPublished(event *IReleaseManagerMetadataURIPublished) {
+	_FakeIReleaseManager.Contract.Emit("MetadataURIPublished", event)
+}
+
+// EmitReleasePublished appends the event to the fake's logs.
+func (_FakeIReleaseManager *FakeIReleaseManager) EmitReleasePublished(event *IReleaseManagerReleasePublished) {
+	_FakeIReleaseManager.Contract.Emit("ReleasePublished", event)
+}
@@ -0,0 +1,51 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IReleaseManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IReleaseManagerReader is the read-only surface of the IReleaseManager
+// binding, implemented by IReleaseManagerCaller, IReleaseManager and FakeIReleaseManager.
+type IReleaseManagerReader interface {
+	GetLatestRelease(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, IReleaseManagerTypesRelease, error)
+	GetLatestUpgradeByTime(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	GetMetadataURI(opts *bind.CallOpts, operatorSet OperatorSet) (string, error)
+	GetRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (IReleaseManagerTypesRelease, error)
+	GetTotalReleases(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error)
+	IsValidRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (bool, error)
+}
+
+// IReleaseManagerWriter is the transacting surface of the IReleaseManager
+// binding, implemented by IReleaseManagerTransactor, IReleaseManager and FakeIReleaseManager.
+type IReleaseManagerWriter interface {
+	PublishMetadataURI(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) (*types.Transaction, error)
+	PublishRelease(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) (*types.Transaction, error)
+}
+
+// IReleaseManagerEvents is the event filtering surface of the IReleaseManager
+// binding, implemented by IReleaseManagerFilterer, IReleaseManager and FakeIReleaseManager.
+type IReleaseManagerEvents interface {
+	FilterMetadataURIPublished(opts *bind.FilterOpts, operatorSet []OperatorSet) (*IReleaseManagerMetadataURIPublishedIterator, error)
+	WatchMetadataURIPublished(opts *bind.WatchOpts, sink chan<- *IReleaseManagerMetadataURIPublished, operatorSet []OperatorSet) (event.Subscription, error)
+	ParseMetadataURIPublished(log types.Log) (*IReleaseManagerMetadataURIPublished, error)
+	FilterReleasePublished(opts *bind.FilterOpts, operatorSet []OperatorSet, releaseId []*big.Int) (*IReleaseManagerReleasePublishedIterator, error)
+	WatchReleasePublished(opts *bind.WatchOpts, sink chan<- *IReleaseManagerReleasePublished, operatorSet []OperatorSet, releaseId []*big.Int) (event.Subscription, error)
+	ParseReleasePublished(log types.Log) (*IReleaseManagerReleasePublished, error)
+}
+
+var (
+	_ IReleaseManagerReader = (*IReleaseManagerCaller)(nil)
+	_ IReleaseManagerReader = (*IReleaseManager)(nil)
+	_ IReleaseManagerReader = (*FakeIReleaseManager)(nil)
+	_ IReleaseManagerWriter = (*IReleaseManagerTransactor)(nil)
+	_ IReleaseManagerWriter = (*IReleaseManager)(nil)
+	_ IReleaseManagerWriter = (*FakeIReleaseManager)(nil)
+	_ IReleaseManagerEvents = (*IReleaseManagerFilterer)(nil)
+	_ IReleaseManagerEvents = (*IReleaseManager)(nil)
+	_ IReleaseManagerEvents = (*FakeIReleaseManager)(nil)
+)
@@ -0,0 +1,304 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IRewardsCoordinator
+
+// ErrAmountExceedsMax is the typed form of the IRewardsCoordinator custom error AmountExceedsMax().
+type ErrAmountExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrAmountExceedsMax) Error() string {
+	return "IRewardsCoordinator: AmountExceedsMax()"
+}
+
+// ErrAmountIsZero is the typed form of the IRewardsCoordinator custom error AmountIsZero().
+type ErrAmountIsZero struct{}
+
+// Error implements the error interface.
+func (ErrAmountIsZero) Error() string {
+	return "IRewardsCoordinator: AmountIsZero()"
+}
+
+// ErrDurationExceedsMax is the typed form of the IRewardsCoordinator custom error DurationExceedsMax().
+type ErrDurationExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrDurationExceedsMax) Error() string {
+	return "IRewardsCoordinator: DurationExceedsMax()"
+}
+
+// ErrDurationIsZero is the typed form of the IRewardsCoordinator custom error DurationIsZero().
+type ErrDurationIsZero struct{}
+
+// Error implements the error interface.
+func (ErrDurationIsZero) Error() string {
+	return "IRewardsCoordinator: DurationIsZero()"
+}
+
+// ErrEarningsNotGreaterThanClaimed is the typed form of the IRewardsCoordinator custom error EarningsNotGreaterThanClaimed().
+type ErrEarningsNotGreaterThanClaimed struct{}
+
+// Error implements the error interface.
+func (ErrEarningsNotGreaterThanClaimed) Error() string {
+	return "IRewardsCoordinator: EarningsNotGreaterThanClaimed()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the IRewardsCoordinator custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "IRewardsCoordinator: InputArrayLengthMismatch()"
+}
+
+// ErrInputArrayLengthZero is the typed form of the IRewardsCoordinator custom error InputArrayLengthZero().
+type ErrInputArrayLengthZero struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthZero) Error() string {
+	return "IRewardsCoordinator: InputArrayLengthZero()"
+}
+
+// ErrInvalidAddressZero is the typed form of the IRewardsCoordinator custom error InvalidAddressZero().
+type ErrInvalidAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAddressZero) Error() string {
+	return "IRewardsCoordinator: InvalidAddressZero()"
+}
+
+// ErrInvalidCalculationIntervalSecondsRemainder is the typed form of the IRewardsCoordinator custom error InvalidCalculationIntervalSecondsRemainder().
+type ErrInvalidCalculationIntervalSecondsRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCalculationIntervalSecondsRemainder) Error() string {
+	return "IRewardsCoordinator: InvalidCalculationIntervalSecondsRemainder()"
+}
+
+// ErrInvalidClaimProof is the typed form of the IRewardsCoordinator custom error InvalidClaimProof().
+type ErrInvalidClaimProof struct{}
+
+// Error implements the error interface.
+func (ErrInvalidClaimProof) Error() string {
+	return "IRewardsCoordinator: InvalidClaimProof()"
+}
+
+// ErrInvalidDurationRemainder is the typed form of the IRewardsCoordinator custom error InvalidDurationRemainder().
+type ErrInvalidDurationRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidDurationRemainder) Error() string {
+	return "IRewardsCoordinator: InvalidDurationRemainder()"
+}
+
+// ErrInvalidEarner is the typed form of the IRewardsCoordinator custom error InvalidEarner().
+type ErrInvalidEarner struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEarner) Error() string {
+	return "IRewardsCoordinator: InvalidEarner()"
+}
+
+// ErrInvalidEarnerLeafIndex is the typed form of the IRewardsCoordinator custom error InvalidEarnerLeafIndex().
+type ErrInvalidEarnerLeafIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEarnerLeafIndex) Error() string {
+	return "IRewardsCoordinator: InvalidEarnerLeafIndex()"
+}
+
+// ErrInvalidGenesisRewardsTimestampRemainder is the typed form of the IRewardsCoordinator custom error InvalidGenesisRewardsTimestampRemainder().
+type ErrInvalidGenesisRewardsTimestampRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGenesisRewardsTimestampRemainder) Error() string {
+	return "IRewardsCoordinator: InvalidGenesisRewardsTimestampRemainder()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the IRewardsCoordinator custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "IRewardsCoordinator: InvalidOperatorSet()"
+}
+
+// ErrInvalidRoot is the typed form of the IRewardsCoordinator custom error InvalidRoot().
+type ErrInvalidRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRoot) Error() string {
+	return "IRewardsCoordinator: InvalidRoot()"
+}
+
+// ErrInvalidRootIndex is the typed form of the IRewardsCoordinator custom error InvalidRootIndex().
+type ErrInvalidRootIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRootIndex) Error() string {
+	return "IRewardsCoordinator: InvalidRootIndex()"
+}
+
+// ErrInvalidStartTimestampRemainder is the typed form of the IRewardsCoordinator custom error InvalidStartTimestampRemainder().
+type ErrInvalidStartTimestampRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStartTimestampRemainder) Error() string {
+	return "IRewardsCoordinator: InvalidStartTimestampRemainder()"
+}
+
+// ErrInvalidTokenLeafIndex is the typed form of the IRewardsCoordinator custom error InvalidTokenLeafIndex().
+type ErrInvalidTokenLeafIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTokenLeafIndex) Error() string {
+	return "IRewardsCoordinator: InvalidTokenLeafIndex()"
+}
+
+// ErrNewRootMustBeForNewCalculatedPeriod is the typed form of the IRewardsCoordinator custom error NewRootMustBeForNewCalculatedPeriod().
+type ErrNewRootMustBeForNewCalculatedPeriod struct{}
+
+// Error implements the error interface.
+func (ErrNewRootMustBeForNewCalculatedPeriod) Error() string {
+	return "IRewardsCoordinator: NewRootMustBeForNewCalculatedPeriod()"
+}
+
+// ErrOperatorsNotInAscendingOrder is the typed form of the IRewardsCoordinator custom error OperatorsNotInAscendingOrder().
+type ErrOperatorsNotInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrOperatorsNotInAscendingOrder) Error() string {
+	return "IRewardsCoordinator: OperatorsNotInAscendingOrder()"
+}
+
+// ErrPreviousSplitPending is the typed form of the IRewardsCoordinator custom error PreviousSplitPending().
+type ErrPreviousSplitPending struct{}
+
+// Error implements the error interface.
+func (ErrPreviousSplitPending) Error() string {
+	return "IRewardsCoordinator: PreviousSplitPending()"
+}
+
+// ErrRewardsEndTimestampNotElapsed is the typed form of the IRewardsCoordinator custom error RewardsEndTimestampNotElapsed().
+type ErrRewardsEndTimestampNotElapsed struct{}
+
+// Error implements the error interface.
+func (ErrRewardsEndTimestampNotElapsed) Error() string {
+	return "IRewardsCoordinator: RewardsEndTimestampNotElapsed()"
+}
+
+// ErrRootAlreadyActivated is the typed form of the IRewardsCoordinator custom error RootAlreadyActivated().
+type ErrRootAlreadyActivated struct{}
+
+// Error implements the error interface.
+func (ErrRootAlreadyActivated) Error() string {
+	return "IRewardsCoordinator: RootAlreadyActivated()"
+}
+
+// ErrRootDisabled is the typed form of the IRewardsCoordinator custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "IRewardsCoordinator: RootDisabled()"
+}
+
+// ErrRootNotActivated is the typed form of the IRewardsCoordinator custom error RootNotActivated().
+type ErrRootNotActivated struct{}
+
+// Error implements the error interface.
+func (ErrRootNotActivated) Error() string {
+	return "IRewardsCoordinator: RootNotActivated()"
+}
+
+// ErrSplitExceedsMax is the typed form of the IRewardsCoordinator custom error SplitExceedsMax().
+type ErrSplitExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrSplitExceedsMax) Error() string {
+	return "IRewardsCoordinator: SplitExceedsMax()"
+}
+
+// ErrStartTimestampTooFarInFuture is the typed form of the IRewardsCoordinator custom error StartTimestampTooFarInFuture().
+type ErrStartTimestampTooFarInFuture struct{}
+
+// Error implements the error interface.
+func (ErrStartTimestampTooFarInFuture) Error() string {
+	return "IRewardsCoordinator: StartTimestampTooFarInFuture()"
+}
+
+// ErrStartTimestampTooFarInPast is the typed form of the IRewardsCoordinator custom error StartTimestampTooFarInPast().
+type ErrStartTimestampTooFarInPast struct{}
+
+// Error implements the error interface.
+func (ErrStartTimestampTooFarInPast) Error() string {
+	return "IRewardsCoordinator: StartTimestampTooFarInPast()"
+}
+
+// ErrStrategiesNotInAscendingOrder is the typed form of the IRewardsCoordinator custom error StrategiesNotInAscendingOrder().
+type ErrStrategiesNotInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrStrategiesNotInAscendingOrder) Error() string {
+	return "IRewardsCoordinator: StrategiesNotInAscendingOrder()"
+}
+
+// ErrStrategyNotWhitelisted is the typed form of the IRewardsCoordinator custom error StrategyNotWhitelisted().
+type ErrStrategyNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotWhitelisted) Error() string {
+	return "IRewardsCoordinator: StrategyNotWhitelisted()"
+}
+
+// ErrSubmissionNotRetroactive is the typed form of the IRewardsCoordinator custom error SubmissionNotRetroactive().
+type ErrSubmissionNotRetroactive struct{}
+
+// Error implements the error interface.
+func (ErrSubmissionNotRetroactive) Error() string {
+	return "IRewardsCoordinator: SubmissionNotRetroactive()"
+}
+
+// ErrUnauthorizedCaller is the typed form of the IRewardsCoordinator custom error UnauthorizedCaller().
+type ErrUnauthorizedCaller struct{}
+
+// Error implements the error interface.
+func (ErrUnauthorizedCaller) Error() string {
+	return "IRewardsCoordinator: UnauthorizedCaller()"
+}
+
+// IRewardsCoordinatorErrors maps the name of every custom error in the IRewardsCoordinator ABI to its typed Go error.
+var IRewardsCoordinatorErrors = map[string]error{
+	"AmountExceedsMax":                           ErrAmountExceedsMax{},
+	"AmountIsZero":                               ErrAmountIsZero{},
+	"DurationExceedsMax":                         ErrDurationExceedsMax{},
+	"DurationIsZero":                             ErrDurationIsZero{},
+	"EarningsNotGreaterThanClaimed":              ErrEarningsNotGreaterThanClaimed{},
+	"InputArrayLengthMismatch":                   ErrInputArrayLengthMismatch{},
+	"InputArrayLengthZero":                       ErrInputArrayLengthZero{},
+	"InvalidAddressZero":                         ErrInvalidAddressZero{},
+	"InvalidCalculationIntervalSecondsRemainder": ErrInvalidCalculationIntervalSecondsRemainder{},
+	"InvalidClaimProof":                          ErrInvalidClaimProof{},
+	"InvalidDurationRemainder":                   ErrInvalidDurationRemainder{},
+	"InvalidEarner":                              ErrInvalidEarner{},
+	"InvalidEarnerLeafIndex":                     ErrInvalidEarnerLeafIndex{},
+	"InvalidGenesisRewardsTimestampRemainder":    ErrInvalidGenesisRewardsTimestampRemainder{},
+	"InvalidOperatorSet":                         ErrInvalidOperatorSet{},
+	"InvalidRoot":                                ErrInvalidRoot{},
+	"InvalidRootIndex":                           ErrInvalidRootIndex{},
+	"InvalidStartTimestampRemainder":             ErrInvalidStartTimestampRemainder{},
+	"InvalidTokenLeafIndex":                      ErrInvalidTokenLeafIndex{},
+	"NewRootMustBeForNewCalculatedPeriod":        ErrNewRootMustBeForNewCalculatedPeriod{},
+	"OperatorsNotInAscendingOrder":               ErrOperatorsNotInAscendingOrder{},
+	"PreviousSplitPending":                       ErrPreviousSplitPending{},
+	"RewardsEndTimestampNotElapsed":              ErrRewardsEndTimestampNotElapsed{},
+	"RootAlreadyActivated":                       ErrRootAlreadyActivated{},
+	"RootDisabled":                               ErrRootDisabled{},
+	"RootNotActivated":                           ErrRootNotActivated{},
+	"SplitExceedsMax":                            ErrSplitExceedsMax{},
+	"StartTimestampTooFarInFuture":               ErrStartTimestampTooFarInFuture{},
+	"StartTimestampTooFarInPast":                 ErrStartTimestampTooFarInPast{},
+	"StrategiesNotInAscendingOrder":              ErrStrategiesNotInAscendingOrder{},
+	"StrategyNotWhitelisted":                     ErrStrategyNotWhitelisted{},
+	"SubmissionNotRetroactive":                   ErrSubmissionNotRetroactive{},
+	"UnauthorizedCaller":                         ErrUnauthorizedCaller{},
+}
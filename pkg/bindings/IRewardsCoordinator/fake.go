@@ -0,0 +1,678 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IRewardsCoordinator
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIRewardsCoordinator is an in-memory IRewardsCoordinator for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IRewardsCoordinatorFilterer.
+type FakeIRewardsCoordinator struct {
+	*fake.Contract
+	*IRewardsCoordinatorFilterer
+}
+
+// NewFakeIRewardsCoordinator returns an empty fake of the contract at address.
+func NewFakeIRewardsCoordinator(address common.Address) *FakeIRewardsCoordinator {
+	contract := fake.NewContract(IRewardsCoordinatorMetaData, address)
+	filterer, err := NewIRewardsCoordinatorFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIRewardsCoordinator{Contract: contract, IRewardsCoordinatorFilterer: filterer}
+}
+
+// CALCULATIONINTERVALSECONDS returns the values stubbed with StubCALCULATIONINTERVALSECONDS.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIRewardsCoordinator.Contract.Read("CALCULATION_INTERVAL_SECONDS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCALCULATIONINTERVALSECONDS sets what CALCULATIONINTERVALSECONDS returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubCALCULATIONINTERVALSECONDS(out0 uint32) {
+	_FakeIRewardsCoordinator.Contract.Stub("CALCULATION_INTERVAL_SECONDS", []interface{}{}, out0)
+}
+
+// GENESISREWARDSTIMESTAMP returns the values stubbed with StubGENESISREWARDSTIMESTAMP.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GENESISREWARDSTIMESTAMP(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIRewardsCoordinator.Contract.Read("GENESIS_REWARDS_TIMESTAMP", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENESISREWARDSTIMESTAMP sets what GENESISREWARDSTIMESTAMP returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGENESISREWARDSTIMESTAMP(out0 uint32) {
+	_FakeIRewardsCoordinator.Contract.Stub("GENESIS_REWARDS_TIMESTAMP", []interface{}{}, out0)
+}
+
+// MAXFUTURELENGTH returns the values stubbed with StubMAXFUTURELENGTH.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) MAXFUTURELENGTH(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIRewardsCoordinator.Contract.Read("MAX_FUTURE_LENGTH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXFUTURELENGTH sets what MAXFUTURELENGTH returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubMAXFUTURELENGTH(out0 uint32) {
+	_FakeIRewardsCoordinator.Contract.Stub("MAX_FUTURE_LENGTH", []interface{}{}, out0)
+}
+
+// MAXRETROACTIVELENGTH returns the values stubbed with StubMAXRETROACTIVELENGTH.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) MAXRETROACTIVELENGTH(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIRewardsCoordinator.Contract.Read("MAX_RETROACTIVE_LENGTH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXRETROACTIVELENGTH sets what MAXRETROACTIVELENGTH returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubMAXRETROACTIVELENGTH(out0 uint32) {
+	_FakeIRewardsCoordinator.Contract.Stub("MAX_RETROACTIVE_LENGTH", []interface{}{}, out0)
+}
+
+// MAXREWARDSDURATION returns the values stubbed with StubMAXREWARDSDURATION.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIRewardsCoordinator.Contract.Read("MAX_REWARDS_DURATION", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXREWARDSDURATION sets what MAXREWARDSDURATION returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubMAXREWARDSDURATION(out0 uint32) {
+	_FakeIRewardsCoordinator.Contract.Stub("MAX_REWARDS_DURATION", []interface{}{}, out0)
+}
+
+// ActivationDelay returns the values stubbed with StubActivationDelay.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) ActivationDelay(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIRewardsCoordinator.Contract.Read("activationDelay", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubActivationDelay sets what ActivationDelay returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubActivationDelay(out0 uint32) {
+	_FakeIRewardsCoordinator.Contract.Stub("activationDelay", []interface{}{}, out0)
+}
+
+// CalculateEarnerLeafHash returns the values stubbed with StubCalculateEarnerLeafHash.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CalculateEarnerLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTypesEarnerTreeMerkleLeaf) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIRewardsCoordinator.Contract.Read("calculateEarnerLeafHash", []interface{}{leaf}, &out0)
+	return out0, err
+}
+
+// StubCalculateEarnerLeafHash sets what CalculateEarnerLeafHash returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubCalculateEarnerLeafHash(leaf IRewardsCoordinatorTypesEarnerTreeMerkleLeaf, out0 [32]byte) {
+	_FakeIRewardsCoordinator.Contract.Stub("calculateEarnerLeafHash", []interface{}{leaf}, out0)
+}
+
+// CalculateTokenLeafHash returns the values stubbed with StubCalculateTokenLeafHash.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CalculateTokenLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTypesTokenTreeMerkleLeaf) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIRewardsCoordinator.Contract.Read("calculateTokenLeafHash", []interface{}{leaf}, &out0)
+	return out0, err
+}
+
+// StubCalculateTokenLeafHash sets what CalculateTokenLeafHash returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubCalculateTokenLeafHash(leaf IRewardsCoordinatorTypesTokenTreeMerkleLeaf, out0 [32]byte) {
+	_FakeIRewardsCoordinator.Contract.Stub("calculateTokenLeafHash", []interface{}{leaf}, out0)
+}
+
+// CheckClaim returns the values stubbed with StubCheckClaim.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CheckClaim(opts *bind.CallOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim) (bool, error) {
+	var out0 bool
+	err := _FakeIRewardsCoordinator.Contract.Read("checkClaim", []interface{}{claim}, &out0)
+	return out0, err
+}
+
+// StubCheckClaim sets what CheckClaim returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubCheckClaim(claim IRewardsCoordinatorTypesRewardsMerkleClaim, out0 bool) {
+	_FakeIRewardsCoordinator.Contract.Stub("checkClaim", []interface{}{claim}, out0)
+}
+
+// ClaimerFor returns the values stubbed with StubClaimerFor.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) ClaimerFor(opts *bind.CallOpts, earner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIRewardsCoordinator.Contract.Read("claimerFor", []interface{}{earner}, &out0)
+	return out0, err
+}
+
+// StubClaimerFor sets what ClaimerFor returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubClaimerFor(earner common.Address, out0 common.Address) {
+	_FakeIRewardsCoordinator.Contract.Stub("claimerFor", []interface{}{earner}, out0)
+}
+
+// CumulativeClaimed returns the values stubbed with StubCumulativeClaimed.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CumulativeClaimed(opts *bind.CallOpts, claimer common.Address, token common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIRewardsCoordinator.Contract.Read("cumulativeClaimed", []interface{}{claimer, token}, &out0)
+	return out0, err
+}
+
+// StubCumulativeClaimed sets what CumulativeClaimed returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubCumulativeClaimed(claimer common.Address, token common.Address, out0 *big.Int) {
+	_FakeIRewardsCoordinator.Contract.Stub("cumulativeClaimed", []interface{}{claimer, token}, out0)
+}
+
+// CurrRewardsCalculationEndTimestamp returns the values stubbed with StubCurrRewardsCalculationEndTimestamp.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeIRewardsCoordinator.Contract.Read("currRewardsCalculationEndTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrRewardsCalculationEndTimestamp sets what CurrRewardsCalculationEndTimestamp returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubCurrRewardsCalculationEndTimestamp(out0 uint32) {
+	_FakeIRewardsCoordinator.Contract.Stub("currRewardsCalculationEndTimestamp", []interface{}{}, out0)
+}
+
+// DefaultOperatorSplitBips returns the values stubbed with StubDefaultOperatorSplitBips.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) DefaultOperatorSplitBips(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeIRewardsCoordinator.Contract.Read("defaultOperatorSplitBips", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDefaultOperatorSplitBips sets what DefaultOperatorSplitBips returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubDefaultOperatorSplitBips(out0 uint16) {
+	_FakeIRewardsCoordinator.Contract.Stub("defaultOperatorSplitBips", []interface{}{}, out0)
+}
+
+// GetCurrentClaimableDistributionRoot returns the values stubbed with StubGetCurrentClaimableDistributionRoot.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GetCurrentClaimableDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeIRewardsCoordinator.Contract.Read("getCurrentClaimableDistributionRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentClaimableDistributionRoot sets what GetCurrentClaimableDistributionRoot returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGetCurrentClaimableDistributionRoot(out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeIRewardsCoordinator.Contract.Stub("getCurrentClaimableDistributionRoot", []interface{}{}, out0)
+}
+
+// GetCurrentDistributionRoot returns the values stubbed with StubGetCurrentDistributionRoot.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GetCurrentDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeIRewardsCoordinator.Contract.Read("getCurrentDistributionRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentDistributionRoot sets what GetCurrentDistributionRoot returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGetCurrentDistributionRoot(out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeIRewardsCoordinator.Contract.Stub("getCurrentDistributionRoot", []interface{}{}, out0)
+}
+
+// GetDistributionRootAtIndex returns the values stubbed with StubGetDistributionRootAtIndex.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeIRewardsCoordinator.Contract.Read("getDistributionRootAtIndex", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubGetDistributionRootAtIndex sets what GetDistributionRootAtIndex returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGetDistributionRootAtIndex(index *big.Int, out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeIRewardsCoordinator.Contract.Stub("getDistributionRootAtIndex", []interface{}{index}, out0)
+}
+
+// GetDistributionRootsLength returns the values stubbed with StubGetDistributionRootsLength.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIRewardsCoordinator.Contract.Read("getDistributionRootsLength", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetDistributionRootsLength sets what GetDistributionRootsLength returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGetDistributionRootsLength(out0 *big.Int) {
+	_FakeIRewardsCoordinator.Contract.Stub("getDistributionRootsLength", []interface{}{}, out0)
+}
+
+// GetOperatorAVSSplit returns the values stubbed with StubGetOperatorAVSSplit.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GetOperatorAVSSplit(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error) {
+	var out0 uint16
+	err := _FakeIRewardsCoordinator.Contract.Read("getOperatorAVSSplit", []interface{}{operator, avs}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorAVSSplit sets what GetOperatorAVSSplit returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGetOperatorAVSSplit(operator common.Address, avs common.Address, out0 uint16) {
+	_FakeIRewardsCoordinator.Contract.Stub("getOperatorAVSSplit", []interface{}{operator, avs}, out0)
+}
+
+// GetOperatorPISplit returns the values stubbed with StubGetOperatorPISplit.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GetOperatorPISplit(opts *bind.CallOpts, operator common.Address) (uint16, error) {
+	var out0 uint16
+	err := _FakeIRewardsCoordinator.Contract.Read("getOperatorPISplit", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorPISplit sets what GetOperatorPISplit returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGetOperatorPISplit(operator common.Address, out0 uint16) {
+	_FakeIRewardsCoordinator.Contract.Stub("getOperatorPISplit", []interface{}{operator}, out0)
+}
+
+// GetOperatorSetSplit returns the values stubbed with StubGetOperatorSetSplit.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GetOperatorSetSplit(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (uint16, error) {
+	var out0 uint16
+	err := _FakeIRewardsCoordinator.Contract.Read("getOperatorSetSplit", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetSplit sets what GetOperatorSetSplit returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGetOperatorSetSplit(operator common.Address, operatorSet OperatorSet, out0 uint16) {
+	_FakeIRewardsCoordinator.Contract.Stub("getOperatorSetSplit", []interface{}{operator, operatorSet}, out0)
+}
+
+// GetRootIndexFromHash returns the values stubbed with StubGetRootIndexFromHash.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error) {
+	var out0 uint32
+	err := _FakeIRewardsCoordinator.Contract.Read("getRootIndexFromHash", []interface{}{rootHash}, &out0)
+	return out0, err
+}
+
+// StubGetRootIndexFromHash sets what GetRootIndexFromHash returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubGetRootIndexFromHash(rootHash [32]byte, out0 uint32) {
+	_FakeIRewardsCoordinator.Contract.Stub("getRootIndexFromHash", []interface{}{rootHash}, out0)
+}
+
+// RewardsUpdater returns the values stubbed with StubRewardsUpdater.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) RewardsUpdater(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIRewardsCoordinator.Contract.Read("rewardsUpdater", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubRewardsUpdater sets what RewardsUpdater returns for the given arguments.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) StubRewardsUpdater(out0 common.Address) {
+	_FakeIRewardsCoordinator.Contract.Stub("rewardsUpdater", []interface{}{}, out0)
+}
+
+// CreateAVSRewardsSubmission records a createAVSRewardsSubmission call after running the hook set with OnCreateAVSRewardsSubmission.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CreateAVSRewardsSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "createAVSRewardsSubmission", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("createAVSRewardsSubmission").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateAVSRewardsSubmission sets a hook run by every CreateAVSRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnCreateAVSRewardsSubmission(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("createAVSRewardsSubmission", hook)
+}
+
+// CreateOperatorDirectedAVSRewardsSubmission records a createOperatorDirectedAVSRewardsSubmission call after running the hook set with OnCreateOperatorDirectedAVSRewardsSubmission.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CreateOperatorDirectedAVSRewardsSubmission(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "createOperatorDirectedAVSRewardsSubmission", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("createOperatorDirectedAVSRewardsSubmission").(func(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error); ok {
+			return hook(opts, avs, operatorDirectedRewardsSubmissions)
+		}
+		return nil
+	}, avs, operatorDirectedRewardsSubmissions)
+}
+
+// OnCreateOperatorDirectedAVSRewardsSubmission sets a hook run by every CreateOperatorDirectedAVSRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnCreateOperatorDirectedAVSRewardsSubmission(hook func(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("createOperatorDirectedAVSRewardsSubmission", hook)
+}
+
+// CreateOperatorDirectedOperatorSetRewardsSubmission records a createOperatorDirectedOperatorSetRewardsSubmission call after running the hook set with OnCreateOperatorDirectedOperatorSetRewardsSubmission.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CreateOperatorDirectedOperatorSetRewardsSubmission(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "createOperatorDirectedOperatorSetRewardsSubmission", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("createOperatorDirectedOperatorSetRewardsSubmission").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error); ok {
+			return hook(opts, operatorSet, operatorDirectedRewardsSubmissions)
+		}
+		return nil
+	}, operatorSet, operatorDirectedRewardsSubmissions)
+}
+
+// OnCreateOperatorDirectedOperatorSetRewardsSubmission sets a hook run by every CreateOperatorDirectedOperatorSetRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnCreateOperatorDirectedOperatorSetRewardsSubmission(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("createOperatorDirectedOperatorSetRewardsSubmission", hook)
+}
+
+// CreateRewardsForAllEarners records a createRewardsForAllEarners call after running the hook set with OnCreateRewardsForAllEarners.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CreateRewardsForAllEarners(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "createRewardsForAllEarners", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("createRewardsForAllEarners").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateRewardsForAllEarners sets a hook run by every CreateRewardsForAllEarners call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnCreateRewardsForAllEarners(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("createRewardsForAllEarners", hook)
+}
+
+// CreateRewardsForAllSubmission records a createRewardsForAllSubmission call after running the hook set with OnCreateRewardsForAllSubmission.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) CreateRewardsForAllSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "createRewardsForAllSubmission", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("createRewardsForAllSubmission").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateRewardsForAllSubmission sets a hook run by every CreateRewardsForAllSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnCreateRewardsForAllSubmission(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("createRewardsForAllSubmission", hook)
+}
+
+// DisableRoot records a disableRoot call after running the hook set with OnDisableRoot.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) DisableRoot(opts *bind.TransactOpts, rootIndex uint32) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "disableRoot", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("disableRoot").(func(opts *bind.TransactOpts, rootIndex uint32) error); ok {
+			return hook(opts, rootIndex)
+		}
+		return nil
+	}, rootIndex)
+}
+
+// OnDisableRoot sets a hook run by every DisableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnDisableRoot(hook func(opts *bind.TransactOpts, rootIndex uint32) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("disableRoot", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) error); ok {
+			return hook(opts, initialOwner, initialPausedStatus, _rewardsUpdater, _activationDelay, _defaultSplitBips)
+		}
+		return nil
+	}, initialOwner, initialPausedStatus, _rewardsUpdater, _activationDelay, _defaultSplitBips)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("initialize", hook)
+}
+
+// ProcessClaim records a processClaim call after running the hook set with OnProcessClaim.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) ProcessClaim(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "processClaim", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("processClaim").(func(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error); ok {
+			return hook(opts, claim, recipient)
+		}
+		return nil
+	}, claim, recipient)
+}
+
+// OnProcessClaim sets a hook run by every ProcessClaim call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnProcessClaim(hook func(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("processClaim", hook)
+}
+
+// ProcessClaims records a processClaims call after running the hook set with OnProcessClaims.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) ProcessClaims(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "processClaims", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("processClaims").(func(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error); ok {
+			return hook(opts, claims, recipient)
+		}
+		return nil
+	}, claims, recipient)
+}
+
+// OnProcessClaims sets a hook run by every ProcessClaims call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnProcessClaims(hook func(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("processClaims", hook)
+}
+
+// SetActivationDelay records a setActivationDelay call after running the hook set with OnSetActivationDelay.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetActivationDelay(opts *bind.TransactOpts, _activationDelay uint32) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setActivationDelay", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setActivationDelay").(func(opts *bind.TransactOpts, _activationDelay uint32) error); ok {
+			return hook(opts, _activationDelay)
+		}
+		return nil
+	}, _activationDelay)
+}
+
+// OnSetActivationDelay sets a hook run by every SetActivationDelay call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetActivationDelay(hook func(opts *bind.TransactOpts, _activationDelay uint32) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setActivationDelay", hook)
+}
+
+// SetClaimerFor records a setClaimerFor call after running the hook set with OnSetClaimerFor.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetClaimerFor(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setClaimerFor", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setClaimerFor").(func(opts *bind.TransactOpts, claimer common.Address) error); ok {
+			return hook(opts, claimer)
+		}
+		return nil
+	}, claimer)
+}
+
+// OnSetClaimerFor sets a hook run by every SetClaimerFor call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetClaimerFor(hook func(opts *bind.TransactOpts, claimer common.Address) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setClaimerFor", hook)
+}
+
+// SetClaimerFor0 records a setClaimerFor0 call after running the hook set with OnSetClaimerFor0.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetClaimerFor0(opts *bind.TransactOpts, earner common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setClaimerFor0", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setClaimerFor0").(func(opts *bind.TransactOpts, earner common.Address, claimer common.Address) error); ok {
+			return hook(opts, earner, claimer)
+		}
+		return nil
+	}, earner, claimer)
+}
+
+// OnSetClaimerFor0 sets a hook run by every SetClaimerFor0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetClaimerFor0(hook func(opts *bind.TransactOpts, earner common.Address, claimer common.Address) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setClaimerFor0", hook)
+}
+
+// SetDefaultOperatorSplit records a setDefaultOperatorSplit call after running the hook set with OnSetDefaultOperatorSplit.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetDefaultOperatorSplit(opts *bind.TransactOpts, split uint16) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setDefaultOperatorSplit", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setDefaultOperatorSplit").(func(opts *bind.TransactOpts, split uint16) error); ok {
+			return hook(opts, split)
+		}
+		return nil
+	}, split)
+}
+
+// OnSetDefaultOperatorSplit sets a hook run by every SetDefaultOperatorSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetDefaultOperatorSplit(hook func(opts *bind.TransactOpts, split uint16) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setDefaultOperatorSplit", hook)
+}
+
+// SetOperatorAVSSplit records a setOperatorAVSSplit call after running the hook set with OnSetOperatorAVSSplit.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetOperatorAVSSplit(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setOperatorAVSSplit", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setOperatorAVSSplit").(func(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) error); ok {
+			return hook(opts, operator, avs, split)
+		}
+		return nil
+	}, operator, avs, split)
+}
+
+// OnSetOperatorAVSSplit sets a hook run by every SetOperatorAVSSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetOperatorAVSSplit(hook func(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setOperatorAVSSplit", hook)
+}
+
+// SetOperatorPISplit records a setOperatorPISplit call after running the hook set with OnSetOperatorPISplit.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetOperatorPISplit(opts *bind.TransactOpts, operator common.Address, split uint16) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setOperatorPISplit", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setOperatorPISplit").(func(opts *bind.TransactOpts, operator common.Address, split uint16) error); ok {
+			return hook(opts, operator, split)
+		}
+		return nil
+	}, operator, split)
+}
+
+// OnSetOperatorPISplit sets a hook run by every SetOperatorPISplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetOperatorPISplit(hook func(opts *bind.TransactOpts, operator common.Address, split uint16) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setOperatorPISplit", hook)
+}
+
+// SetOperatorSetSplit records a setOperatorSetSplit call after running the hook set with OnSetOperatorSetSplit.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetOperatorSetSplit(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setOperatorSetSplit", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setOperatorSetSplit").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) error); ok {
+			return hook(opts, operator, operatorSet, split)
+		}
+		return nil
+	}, operator, operatorSet, split)
+}
+
+// OnSetOperatorSetSplit sets a hook run by every SetOperatorSetSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetOperatorSetSplit(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setOperatorSetSplit", hook)
+}
+
+// SetRewardsForAllSubmitter records a setRewardsForAllSubmitter call after running the hook set with OnSetRewardsForAllSubmitter.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetRewardsForAllSubmitter(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setRewardsForAllSubmitter", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setRewardsForAllSubmitter").(func(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) error); ok {
+			return hook(opts, _submitter, _newValue)
+		}
+		return nil
+	}, _submitter, _newValue)
+}
+
+// OnSetRewardsForAllSubmitter sets a hook run by every SetRewardsForAllSubmitter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetRewardsForAllSubmitter(hook func(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setRewardsForAllSubmitter", hook)
+}
+
+// SetRewardsUpdater records a setRewardsUpdater call after running the hook set with OnSetRewardsUpdater.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SetRewardsUpdater(opts *bind.TransactOpts, _rewardsUpdater common.Address) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "setRewardsUpdater", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("setRewardsUpdater").(func(opts *bind.TransactOpts, _rewardsUpdater common.Address) error); ok {
+			return hook(opts, _rewardsUpdater)
+		}
+		return nil
+	}, _rewardsUpdater)
+}
+
+// OnSetRewardsUpdater sets a hook run by every SetRewardsUpdater call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSetRewardsUpdater(hook func(opts *bind.TransactOpts, _rewardsUpdater common.Address) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("setRewardsUpdater", hook)
+}
+
+// SubmitRoot records a submitRoot call after running the hook set with OnSubmitRoot.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error) {
+	return _FakeIRewardsCoordinator.Contract.Transact(opts, "submitRoot", func() error {
+		if hook, ok := _FakeIRewardsCoordinator.Contract.Hook("submitRoot").(func(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) error); ok {
+			return hook(opts, root, rewardsCalculationEndTimestamp)
+		}
+		return nil
+	}, root, rewardsCalculationEndTimestamp)
+}
+
+// OnSubmitRoot sets a hook run by every SubmitRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) OnSubmitRoot(hook func(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) error) {
+	_FakeIRewardsCoordinator.Contract.SetHook("submitRoot", hook)
+}
+
+// EmitAVSRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitAVSRewardsSubmissionCreated(event *IRewardsCoordinatorAVSRewardsSubmissionCreated) {
+	_FakeIRewardsCoordinator.Contract.Emit("AVSRewardsSubmissionCreated", event)
+}
+
+// EmitActivationDelaySet appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitActivationDelaySet(event *IRewardsCoordinatorActivationDelaySet) {
+	_FakeIRewardsCoordinator.Contract.Emit("ActivationDelaySet", event)
+}
+
+// EmitClaimerForSet appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitClaimerForSet(event *IRewardsCoordinatorClaimerForSet) {
+	_FakeIRewardsCoordinator.Contract.Emit("ClaimerForSet", event)
+}
+
+// EmitDefaultOperatorSplitBipsSet appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitDefaultOperatorSplitBipsSet(event *IRewardsCoordinatorDefaultOperatorSplitBipsSet) {
+	_FakeIRewardsCoordinator.Contract.Emit("DefaultOperatorSplitBipsSet", event)
+}
+
+// EmitDistributionRootDisabled appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitDistributionRootDisabled(event *IRewardsCoordinatorDistributionRootDisabled) {
+	_FakeIRewardsCoordinator.Contract.Emit("DistributionRootDisabled", event)
+}
+
+// EmitDistributionRootSubmitted appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitDistributionRootSubmitted(event *IRewardsCoordinatorDistributionRootSubmitted) {
+	_FakeIRewardsCoordinator.Contract.Emit("DistributionRootSubmitted", event)
+}
+
+// EmitOperatorAVSSplitBipsSet appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitOperatorAVSSplitBipsSet(event *IRewardsCoordinatorOperatorAVSSplitBipsSet) {
+	_FakeIRewardsCoordinator.Contract.Emit("OperatorAVSSplitBipsSet", event)
+}
+
+// EmitOperatorDirectedAVSRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitOperatorDirectedAVSRewardsSubmissionCreated(event *IRewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated) {
+	_FakeIRewardsCoordinator.Contract.Emit("OperatorDirectedAVSRewardsSubmissionCreated", event)
+}
+
+// EmitOperatorDirectedOperatorSetRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitOperatorDirectedOperatorSetRewardsSubmissionCreated(event *IRewardsCoordinatorOperatorDirectedOperatorSetRewardsSubmissionCreated) {
+	_FakeIRewardsCoordinator.Contract.Emit("OperatorDirectedOperatorSetRewardsSubmissionCreated", event)
+}
+
+// EmitOperatorPISplitBipsSet appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitOperatorPISplitBipsSet(event *IRewardsCoordinatorOperatorPISplitBipsSet) {
+	_FakeIRewardsCoordinator.Contract.Emit("OperatorPISplitBipsSet", event)
+}
+
+// EmitOperatorSetSplitBipsSet appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitOperatorSetSplitBipsSet(event *IRewardsCoordinatorOperatorSetSplitBipsSet) {
+	_FakeIRewardsCoordinator.Contract.Emit("OperatorSetSplitBipsSet", event)
+}
+
+// EmitRewardsClaimed appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitRewardsClaimed(event *IRewardsCoordinatorRewardsClaimed) {
+	_FakeIRewardsCoordinator.Contract.Emit("RewardsClaimed", event)
+}
+
+// EmitRewardsForAllSubmitterSet appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitRewardsForAllSubmitterSet(event *IRewardsCoordinatorRewardsForAllSubmitterSet) {
+	_FakeIRewardsCoordinator.Contract.Emit("RewardsForAllSubmitterSet", event)
+}
+
+// EmitRewardsSubmissionForAllCreated appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitRewardsSubmissionForAllCreated(event *IRewardsCoordinatorRewardsSubmissionForAllCreated) {
+	_FakeIRewardsCoordinator.Contract.Emit("RewardsSubmissionForAllCreated", event)
+}
+
+// EmitRewardsSubmissionForAllEarnersCreated appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitRewardsSubmissionForAllEarnersCreated(event *IRewardsCoordinatorRewardsSubmissionForAllEarnersCreated) {
+	_FakeIRewardsCoordinator.Contract.Emit("RewardsSubmissionForAllEarnersCreated", event)
+}
+
+// EmitRewardsUpdaterSet appends the event to the fake's logs.
+func (_FakeIRewardsCoordinator *FakeIRewardsCoordinator) EmitRewardsUpdaterSet(event *IRewardsCoordinatorRewardsUpdaterSet) {
+	_FakeIRewardsCoordinator.Contract.Emit("RewardsUpdaterSet", event)
+}
@@ -0,0 +1,40 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISemVerMixin
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeISemVerMixin is an in-memory ISemVerMixin for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ISemVerMixinFilterer.
+type FakeISemVerMixin struct {
+	*fake.Contract
+	*ISemVerMixinFilterer
+}
+
+// NewFakeISemVerMixin returns an empty fake of the contract at address.
+func NewFakeISemVerMixin(address common.Address) *FakeISemVerMixin {
+	contract := fake.NewContract(ISemVerMixinMetaData, address)
+	filterer, err := NewISemVerMixinFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeISemVerMixin{Contract: contract, ISemVerMixinFilterer: filterer}
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeISemVerMixin *FakeISemVerMixin) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeISemVerMixin.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeISemVerMixin *FakeISemVerMixin) StubVersion(out0 string) {
+	_FakeISemVerMixin.Contract.Stub("version", []interface{}{}, out0)
+}
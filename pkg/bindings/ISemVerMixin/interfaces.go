@@ -0,0 +1,19 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISemVerMixin
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// ISemVerMixinReader is the read-only surface of the ISemVerMixin
+// binding, implemented by ISemVerMixinCaller, ISemVerMixin and FakeISemVerMixin.
+type ISemVerMixinReader interface {
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+var (
+	_ ISemVerMixinReader = (*ISemVerMixinCaller)(nil)
+	_ ISemVerMixinReader = (*ISemVerMixin)(nil)
+	_ ISemVerMixinReader = (*FakeISemVerMixin)(nil)
+)
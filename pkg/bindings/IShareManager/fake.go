@@ -0,0 +1,106 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IShareManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIShareManager is an in-memory IShareManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IShareManagerFilterer.
+type FakeIShareManager struct {
+	*fake.Contract
+	*IShareManagerFilterer
+}
+
+// NewFakeIShareManager returns an empty fake of the contract at address.
+func NewFakeIShareManager(address common.Address) *FakeIShareManager {
+	contract := fake.NewContract(IShareManagerMetaData, address)
+	filterer, err := NewIShareManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIShareManager{Contract: contract, IShareManagerFilterer: filterer}
+}
+
+// StakerDepositShares returns the values stubbed with StubStakerDepositShares.
+func (_FakeIShareManager *FakeIShareManager) StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIShareManager.Contract.Read("stakerDepositShares", []interface{}{user, strategy}, &out0)
+	return out0, err
+}
+
+// StubStakerDepositShares sets what StakerDepositShares returns for the given arguments.
+func (_FakeIShareManager *FakeIShareManager) StubStakerDepositShares(user common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeIShareManager.Contract.Stub("stakerDepositShares", []interface{}{user, strategy}, out0)
+}
+
+// AddShares records a addShares call after running the hook set with OnAddShares.
+func (_FakeIShareManager *FakeIShareManager) AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeIShareManager.Contract.Transact(opts, "addShares", func() error {
+		if hook, ok := _FakeIShareManager.Contract.Hook("addShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, shares)
+		}
+		return nil
+	}, staker, strategy, shares)
+}
+
+// OnAddShares sets a hook run by every AddShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIShareManager *FakeIShareManager) OnAddShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error) {
+	_FakeIShareManager.Contract.SetHook("addShares", hook)
+}
+
+// IncreaseBurnOrRedistributableShares records a increaseBurnOrRedistributableShares call after running the hook set with OnIncreaseBurnOrRedistributableShares.
+func (_FakeIShareManager *FakeIShareManager) IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error) {
+	return _FakeIShareManager.Contract.Transact(opts, "increaseBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeIShareManager.Contract.Hook("increaseBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId, strategy, addedSharesToBurn)
+		}
+		return nil
+	}, operatorSet, slashId, strategy, addedSharesToBurn)
+}
+
+// OnIncreaseBurnOrRedistributableShares sets a hook run by every IncreaseBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIShareManager *FakeIShareManager) OnIncreaseBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error) {
+	_FakeIShareManager.Contract.SetHook("increaseBurnOrRedistributableShares", hook)
+}
+
+// RemoveDepositShares records a removeDepositShares call after running the hook set with OnRemoveDepositShares.
+func (_FakeIShareManager *FakeIShareManager) RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error) {
+	return _FakeIShareManager.Contract.Transact(opts, "removeDepositShares", func() error {
+		if hook, ok := _FakeIShareManager.Contract.Hook("removeDepositShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error); ok {
+			return hook(opts, staker, strategy, depositSharesToRemove)
+		}
+		return nil
+	}, staker, strategy, depositSharesToRemove)
+}
+
+// OnRemoveDepositShares sets a hook run by every RemoveDepositShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIShareManager *FakeIShareManager) OnRemoveDepositShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error) {
+	_FakeIShareManager.Contract.SetHook("removeDepositShares", hook)
+}
+
+// WithdrawSharesAsTokens records a withdrawSharesAsTokens call after running the hook set with OnWithdrawSharesAsTokens.
+func (_FakeIShareManager *FakeIShareManager) WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeIShareManager.Contract.Transact(opts, "withdrawSharesAsTokens", func() error {
+		if hook, ok := _FakeIShareManager.Contract.Hook("withdrawSharesAsTokens").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, token, shares)
+		}
+		return nil
+	}, staker, strategy, token, shares)
+}
+
+// OnWithdrawSharesAsTokens sets a hook run by every WithdrawSharesAsTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIShareManager *FakeIShareManager) OnWithdrawSharesAsTokens(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error) {
+	_FakeIShareManager.Contract.SetHook("withdrawSharesAsTokens", hook)
+}
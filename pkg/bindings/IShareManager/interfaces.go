@@ -0,0 +1,34 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IShareManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// IShareManagerReader is the read-only surface of the IShareManager
+// binding, implemented by IShareManagerCaller, IShareManager and FakeIShareManager.
+type IShareManagerReader interface {
+	StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error)
+}
+
+// IShareManagerWriter is the transacting surface of the IShareManager
+// binding, implemented by IShareManagerTransactor, IShareManager and FakeIShareManager.
+type IShareManagerWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error)
+	RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+var (
+	_ IShareManagerReader = (*IShareManagerCaller)(nil)
+	_ IShareManagerReader = (*IShareManager)(nil)
+	_ IShareManagerReader = (*FakeIShareManager)(nil)
+	_ IShareManagerWriter = (*IShareManagerTransactor)(nil)
+	_ IShareManagerWriter = (*IShareManager)(nil)
+	_ IShareManagerWriter = (*FakeIShareManager)(nil)
+)
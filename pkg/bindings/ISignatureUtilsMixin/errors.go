@@ -0,0 +1,25 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISignatureUtilsMixin
+
+// ErrInvalidSignature is the typed form of the ISignatureUtilsMixin custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "ISignatureUtilsMixin: InvalidSignature()"
+}
+
+// ErrSignatureExpired is the typed form of the ISignatureUtilsMixin custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "ISignatureUtilsMixin: SignatureExpired()"
+}
+
+// ISignatureUtilsMixinErrors maps the name of every custom error in the ISignatureUtilsMixin ABI to its typed Go error.
+var ISignatureUtilsMixinErrors = map[string]error{
+	"InvalidSignature": ErrInvalidSignature{},
+	"SignatureExpired": ErrSignatureExpired{},
+}
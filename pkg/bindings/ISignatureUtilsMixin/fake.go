@@ -0,0 +1,40 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISignatureUtilsMixin
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeISignatureUtilsMixin is an in-memory ISignatureUtilsMixin for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ISignatureUtilsMixinFilterer.
+type FakeISignatureUtilsMixin struct {
+	*fake.Contract
+	*ISignatureUtilsMixinFilterer
+}
+
+// NewFakeISignatureUtilsMixin returns an empty fake of the contract at address.
+func NewFakeISignatureUtilsMixin(address common.Address) *FakeISignatureUtilsMixin {
+	contract := fake.NewContract(ISignatureUtilsMixinMetaData, address)
+	filterer, err := NewISignatureUtilsMixinFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeISignatureUtilsMixin{Contract: contract, ISignatureUtilsMixinFilterer: filterer}
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeISignatureUtilsMixin *FakeISignatureUtilsMixin) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeISignatureUtilsMixin.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeISignatureUtilsMixin *FakeISignatureUtilsMixin) StubDomainSeparator(out0 [32]byte) {
+	_FakeISignatureUtilsMixin.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
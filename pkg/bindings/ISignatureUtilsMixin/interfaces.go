@@ -0,0 +1,19 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ISignatureUtilsMixin
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// ISignatureUtilsMixinReader is the read-only surface of the ISignatureUtilsMixin
+// binding, implemented by ISignatureUtilsMixinCaller, ISignatureUtilsMixin and FakeISignatureUtilsMixin.
+type ISignatureUtilsMixinReader interface {
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+}
+
+var (
+	_ ISignatureUtilsMixinReader = (*ISignatureUtilsMixinCaller)(nil)
+	_ ISignatureUtilsMixinReader = (*ISignatureUtilsMixin)(nil)
+	_ ISignatureUtilsMixinReader = (*FakeISignatureUtilsMixin)(nil)
+)
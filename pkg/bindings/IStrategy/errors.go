@@ -0,0 +1,70 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategy
+
+// ErrBalanceExceedsMaxTotalDeposits is the typed form of the IStrategy custom error BalanceExceedsMaxTotalDeposits().
+type ErrBalanceExceedsMaxTotalDeposits struct{}
+
+// Error implements the error interface.
+func (ErrBalanceExceedsMaxTotalDeposits) Error() string {
+	return "IStrategy: BalanceExceedsMaxTotalDeposits()"
+}
+
+// ErrMaxPerDepositExceedsMax is the typed form of the IStrategy custom error MaxPerDepositExceedsMax().
+type ErrMaxPerDepositExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrMaxPerDepositExceedsMax) Error() string {
+	return "IStrategy: MaxPerDepositExceedsMax()"
+}
+
+// ErrNewSharesZero is the typed form of the IStrategy custom error NewSharesZero().
+type ErrNewSharesZero struct{}
+
+// Error implements the error interface.
+func (ErrNewSharesZero) Error() string {
+	return "IStrategy: NewSharesZero()"
+}
+
+// ErrOnlyStrategyManager is the typed form of the IStrategy custom error OnlyStrategyManager().
+type ErrOnlyStrategyManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyManager) Error() string {
+	return "IStrategy: OnlyStrategyManager()"
+}
+
+// ErrOnlyUnderlyingToken is the typed form of the IStrategy custom error OnlyUnderlyingToken().
+type ErrOnlyUnderlyingToken struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnderlyingToken) Error() string {
+	return "IStrategy: OnlyUnderlyingToken()"
+}
+
+// ErrTotalSharesExceedsMax is the typed form of the IStrategy custom error TotalSharesExceedsMax().
+type ErrTotalSharesExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrTotalSharesExceedsMax) Error() string {
+	return "IStrategy: TotalSharesExceedsMax()"
+}
+
+// ErrWithdrawalAmountExceedsTotalDeposits is the typed form of the IStrategy custom error WithdrawalAmountExceedsTotalDeposits().
+type ErrWithdrawalAmountExceedsTotalDeposits struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalAmountExceedsTotalDeposits) Error() string {
+	return "IStrategy: WithdrawalAmountExceedsTotalDeposits()"
+}
+
+// IStrategyErrors maps the name of every custom error in the IStrategy ABI to its typed Go error.
+var IStrategyErrors = map[string]error{
+	"BalanceExceedsMaxTotalDeposits":       ErrBalanceExceedsMaxTotalDeposits{},
+	"MaxPerDepositExceedsMax":              ErrMaxPerDepositExceedsMax{},
+	"NewSharesZero":                        ErrNewSharesZero{},
+	"OnlyStrategyManager":                  ErrOnlyStrategyManager{},
+	"OnlyUnderlyingToken":                  ErrOnlyUnderlyingToken{},
+	"TotalSharesExceedsMax":                ErrTotalSharesExceedsMax{},
+	"WithdrawalAmountExceedsTotalDeposits": ErrWithdrawalAmountExceedsTotalDeposits{},
+}
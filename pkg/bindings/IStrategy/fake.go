@@ -0,0 +1,204 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategy
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIStrategy is an in-memory IStrategy for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IStrategyFilterer.
+type FakeIStrategy struct {
+	*fake.Contract
+	*IStrategyFilterer
+}
+
+// NewFakeIStrategy returns an empty fake of the contract at address.
+func NewFakeIStrategy(address common.Address) *FakeIStrategy {
+	contract := fake.NewContract(IStrategyMetaData, address)
+	filterer, err := NewIStrategyFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIStrategy{Contract: contract, IStrategyFilterer: filterer}
+}
+
+// Explanation returns the values stubbed with StubExplanation.
+func (_FakeIStrategy *FakeIStrategy) Explanation(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeIStrategy.Contract.Read("explanation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubExplanation sets what Explanation returns for the given arguments.
+func (_FakeIStrategy *FakeIStrategy) StubExplanation(out0 string) {
+	_FakeIStrategy.Contract.Stub("explanation", []interface{}{}, out0)
+}
+
+// Shares returns the values stubbed with StubShares.
+func (_FakeIStrategy *FakeIStrategy) Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategy.Contract.Read("shares", []interface{}{user}, &out0)
+	return out0, err
+}
+
+// StubShares sets what Shares returns for the given arguments.
+func (_FakeIStrategy *FakeIStrategy) StubShares(user common.Address, out0 *big.Int) {
+	_FakeIStrategy.Contract.Stub("shares", []interface{}{user}, out0)
+}
+
+// SharesToUnderlyingView returns the values stubbed with StubSharesToUnderlyingView.
+func (_FakeIStrategy *FakeIStrategy) SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategy.Contract.Read("sharesToUnderlyingView", []interface{}{amountShares}, &out0)
+	return out0, err
+}
+
+// StubSharesToUnderlyingView sets what SharesToUnderlyingView returns for the given arguments.
+func (_FakeIStrategy *FakeIStrategy) StubSharesToUnderlyingView(amountShares *big.Int, out0 *big.Int) {
+	_FakeIStrategy.Contract.Stub("sharesToUnderlyingView", []interface{}{amountShares}, out0)
+}
+
+// TotalShares returns the values stubbed with StubTotalShares.
+func (_FakeIStrategy *FakeIStrategy) TotalShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategy.Contract.Read("totalShares", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalShares sets what TotalShares returns for the given arguments.
+func (_FakeIStrategy *FakeIStrategy) StubTotalShares(out0 *big.Int) {
+	_FakeIStrategy.Contract.Stub("totalShares", []interface{}{}, out0)
+}
+
+// UnderlyingToSharesView returns the values stubbed with StubUnderlyingToSharesView.
+func (_FakeIStrategy *FakeIStrategy) UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategy.Contract.Read("underlyingToSharesView", []interface{}{amountUnderlying}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToSharesView sets what UnderlyingToSharesView returns for the given arguments.
+func (_FakeIStrategy *FakeIStrategy) StubUnderlyingToSharesView(amountUnderlying *big.Int, out0 *big.Int) {
+	_FakeIStrategy.Contract.Stub("underlyingToSharesView", []interface{}{amountUnderlying}, out0)
+}
+
+// UnderlyingToken returns the values stubbed with StubUnderlyingToken.
+func (_FakeIStrategy *FakeIStrategy) UnderlyingToken(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIStrategy.Contract.Read("underlyingToken", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToken sets what UnderlyingToken returns for the given arguments.
+func (_FakeIStrategy *FakeIStrategy) StubUnderlyingToken(out0 common.Address) {
+	_FakeIStrategy.Contract.Stub("underlyingToken", []interface{}{}, out0)
+}
+
+// UserUnderlyingView returns the values stubbed with StubUserUnderlyingView.
+func (_FakeIStrategy *FakeIStrategy) UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategy.Contract.Read("userUnderlyingView", []interface{}{user}, &out0)
+	return out0, err
+}
+
+// StubUserUnderlyingView sets what UserUnderlyingView returns for the given arguments.
+func (_FakeIStrategy *FakeIStrategy) StubUserUnderlyingView(user common.Address, out0 *big.Int) {
+	_FakeIStrategy.Contract.Stub("userUnderlyingView", []interface{}{user}, out0)
+}
+
+// Deposit records a deposit call after running the hook set with OnDeposit.
+func (_FakeIStrategy *FakeIStrategy) Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategy.Contract.Transact(opts, "deposit", func() error {
+		if hook, ok := _FakeIStrategy.Contract.Hook("deposit").(func(opts *bind.TransactOpts, token common.Address, amount *big.Int) error); ok {
+			return hook(opts, token, amount)
+		}
+		return nil
+	}, token, amount)
+}
+
+// OnDeposit sets a hook run by every Deposit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategy *FakeIStrategy) OnDeposit(hook func(opts *bind.TransactOpts, token common.Address, amount *big.Int) error) {
+	_FakeIStrategy.Contract.SetHook("deposit", hook)
+}
+
+// SharesToUnderlying records a sharesToUnderlying call after running the hook set with OnSharesToUnderlying.
+func (_FakeIStrategy *FakeIStrategy) SharesToUnderlying(opts *bind.TransactOpts, amountShares *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategy.Contract.Transact(opts, "sharesToUnderlying", func() error {
+		if hook, ok := _FakeIStrategy.Contract.Hook("sharesToUnderlying").(func(opts *bind.TransactOpts, amountShares *big.Int) error); ok {
+			return hook(opts, amountShares)
+		}
+		return nil
+	}, amountShares)
+}
+
+// OnSharesToUnderlying sets a hook run by every SharesToUnderlying call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategy *FakeIStrategy) OnSharesToUnderlying(hook func(opts *bind.TransactOpts, amountShares *big.Int) error) {
+	_FakeIStrategy.Contract.SetHook("sharesToUnderlying", hook)
+}
+
+// UnderlyingToShares records a underlyingToShares call after running the hook set with OnUnderlyingToShares.
+func (_FakeIStrategy *FakeIStrategy) UnderlyingToShares(opts *bind.TransactOpts, amountUnderlying *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategy.Contract.Transact(opts, "underlyingToShares", func() error {
+		if hook, ok := _FakeIStrategy.Contract.Hook("underlyingToShares").(func(opts *bind.TransactOpts, amountUnderlying *big.Int) error); ok {
+			return hook(opts, amountUnderlying)
+		}
+		return nil
+	}, amountUnderlying)
+}
+
+// OnUnderlyingToShares sets a hook run by every UnderlyingToShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategy *FakeIStrategy) OnUnderlyingToShares(hook func(opts *bind.TransactOpts, amountUnderlying *big.Int) error) {
+	_FakeIStrategy.Contract.SetHook("underlyingToShares", hook)
+}
+
+// UserUnderlying records a userUnderlying call after running the hook set with OnUserUnderlying.
+func (_FakeIStrategy *FakeIStrategy) UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error) {
+	return _FakeIStrategy.Contract.Transact(opts, "userUnderlying", func() error {
+		if hook, ok := _FakeIStrategy.Contract.Hook("userUnderlying").(func(opts *bind.TransactOpts, user common.Address) error); ok {
+			return hook(opts, user)
+		}
+		return nil
+	}, user)
+}
+
+// OnUserUnderlying sets a hook run by every UserUnderlying call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategy *FakeIStrategy) OnUserUnderlying(hook func(opts *bind.TransactOpts, user common.Address) error) {
+	_FakeIStrategy.Contract.SetHook("userUnderlying", hook)
+}
+
+// Withdraw records a withdraw call after running the hook set with OnWithdraw.
+func (_FakeIStrategy *FakeIStrategy) Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategy.Contract.Transact(opts, "withdraw", func() error {
+		if hook, ok := _FakeIStrategy.Contract.Hook("withdraw").(func(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) error); ok {
+			return hook(opts, recipient, token, amountShares)
+		}
+		return nil
+	}, recipient, token, amountShares)
+}
+
+// OnWithdraw sets a hook run by every Withdraw call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategy *FakeIStrategy) OnWithdraw(hook func(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) error) {
+	_FakeIStrategy.Contract.SetHook("withdraw", hook)
+}
+
+// EmitExchangeRateEmitted appends the event to the fake's logs.
+func (_FakeIStrategy *FakeIStrategy) EmitExchangeRateEmitted(event *IStrategyExchangeRateEmitted) {
+	_FakeIStrategy.Contract.Emit("ExchangeRateEmitted", event)
+}
+
+// EmitStrategyTokenSet appends the event to the fake's logs.
+func (_FakeIStrategy *FakeIStrategy) EmitStrategyTokenSet(event *IStrategyStrategyTokenSet) {
+	_FakeIStrategy.Contract.Emit("StrategyTokenSet", event)
+}
@@ -0,0 +1,56 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategy
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IStrategyReader is the read-only surface of the IStrategy
+// binding, implemented by IStrategyCaller, IStrategy and FakeIStrategy.
+type IStrategyReader interface {
+	Explanation(opts *bind.CallOpts) (string, error)
+	Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	TotalShares(opts *bind.CallOpts) (*big.Int, error)
+	UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToken(opts *bind.CallOpts) (common.Address, error)
+	UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+}
+
+// IStrategyWriter is the transacting surface of the IStrategy
+// binding, implemented by IStrategyTransactor, IStrategy and FakeIStrategy.
+type IStrategyWriter interface {
+	Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+	SharesToUnderlying(opts *bind.TransactOpts, amountShares *big.Int) (*types.Transaction, error)
+	UnderlyingToShares(opts *bind.TransactOpts, amountUnderlying *big.Int) (*types.Transaction, error)
+	UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error)
+}
+
+// IStrategyEvents is the event filtering surface of the IStrategy
+// binding, implemented by IStrategyFilterer, IStrategy and FakeIStrategy.
+type IStrategyEvents interface {
+	FilterExchangeRateEmitted(opts *bind.FilterOpts) (*IStrategyExchangeRateEmittedIterator, error)
+	WatchExchangeRateEmitted(opts *bind.WatchOpts, sink chan<- *IStrategyExchangeRateEmitted) (event.Subscription, error)
+	ParseExchangeRateEmitted(log types.Log) (*IStrategyExchangeRateEmitted, error)
+	FilterStrategyTokenSet(opts *bind.FilterOpts) (*IStrategyStrategyTokenSetIterator, error)
+	WatchStrategyTokenSet(opts *bind.WatchOpts, sink chan<- *IStrategyStrategyTokenSet) (event.Subscription, error)
+	ParseStrategyTokenSet(log types.Log) (*IStrategyStrategyTokenSet, error)
+}
+
+var (
+	_ IStrategyReader = (*IStrategyCaller)(nil)
+	_ IStrategyReader = (*IStrategy)(nil)
+	_ IStrategyReader = (*FakeIStrategy)(nil)
+	_ IStrategyWriter = (*IStrategyTransactor)(nil)
+	_ IStrategyWriter = (*IStrategy)(nil)
+	_ IStrategyWriter = (*FakeIStrategy)(nil)
+	_ IStrategyEvents = (*IStrategyFilterer)(nil)
+	_ IStrategyEvents = (*IStrategy)(nil)
+	_ IStrategyEvents = (*FakeIStrategy)(nil)
+)
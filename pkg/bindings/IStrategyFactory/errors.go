@@ -0,0 +1,34 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategyFactory
+
+// ErrAlreadyBlacklisted is the typed form of the IStrategyFactory custom error AlreadyBlacklisted().
+type ErrAlreadyBlacklisted struct{}
+
+// Error implements the error interface.
+func (ErrAlreadyBlacklisted) Error() string {
+	return "IStrategyFactory: AlreadyBlacklisted()"
+}
+
+// ErrBlacklistedToken is the typed form of the IStrategyFactory custom error BlacklistedToken().
+type ErrBlacklistedToken struct{}
+
+// Error implements the error interface.
+func (ErrBlacklistedToken) Error() string {
+	return "IStrategyFactory: BlacklistedToken()"
+}
+
+// ErrStrategyAlreadyExists is the typed form of the IStrategyFactory custom error StrategyAlreadyExists().
+type ErrStrategyAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyExists) Error() string {
+	return "IStrategyFactory: StrategyAlreadyExists()"
+}
+
+// IStrategyFactoryErrors maps the name of every custom error in the IStrategyFactory ABI to its typed Go error.
+var IStrategyFactoryErrors = map[string]error{
+	"AlreadyBlacklisted":    ErrAlreadyBlacklisted{},
+	"BlacklistedToken":      ErrBlacklistedToken{},
+	"StrategyAlreadyExists": ErrStrategyAlreadyExists{},
+}
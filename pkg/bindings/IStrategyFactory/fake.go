@@ -0,0 +1,116 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategyFactory
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeIStrategyFactory is an in-memory IStrategyFactory for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IStrategyFactoryFilterer.
+type FakeIStrategyFactory struct {
+	*fake.Contract
+	*IStrategyFactoryFilterer
+}
+
+// NewFakeIStrategyFactory returns an empty fake of the contract at address.
+func NewFakeIStrategyFactory(address common.Address) *FakeIStrategyFactory {
+	contract := fake.NewContract(IStrategyFactoryMetaData, address)
+	filterer, err := NewIStrategyFactoryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIStrategyFactory{Contract: contract, IStrategyFactoryFilterer: filterer}
+}
+
+// DeployedStrategies returns the values stubbed with StubDeployedStrategies.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) DeployedStrategies(opts *bind.CallOpts, token common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIStrategyFactory.Contract.Read("deployedStrategies", []interface{}{token}, &out0)
+	return out0, err
+}
+
+// StubDeployedStrategies sets what DeployedStrategies returns for the given arguments.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) StubDeployedStrategies(token common.Address, out0 common.Address) {
+	_FakeIStrategyFactory.Contract.Stub("deployedStrategies", []interface{}{token}, out0)
+}
+
+// StrategyBeacon returns the values stubbed with StubStrategyBeacon.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) StrategyBeacon(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIStrategyFactory.Contract.Read("strategyBeacon", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyBeacon sets what StrategyBeacon returns for the given arguments.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) StubStrategyBeacon(out0 common.Address) {
+	_FakeIStrategyFactory.Contract.Stub("strategyBeacon", []interface{}{}, out0)
+}
+
+// DeployNewStrategy records a deployNewStrategy call after running the hook set with OnDeployNewStrategy.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) DeployNewStrategy(opts *bind.TransactOpts, token common.Address) (*types.Transaction, error) {
+	return _FakeIStrategyFactory.Contract.Transact(opts, "deployNewStrategy", func() error {
+		if hook, ok := _FakeIStrategyFactory.Contract.Hook("deployNewStrategy").(func(opts *bind.TransactOpts, token common.Address) error); ok {
+			return hook(opts, token)
+		}
+		return nil
+	}, token)
+}
+
+// OnDeployNewStrategy sets a hook run by every DeployNewStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) OnDeployNewStrategy(hook func(opts *bind.TransactOpts, token common.Address) error) {
+	_FakeIStrategyFactory.Contract.SetHook("deployNewStrategy", hook)
+}
+
+// RemoveStrategiesFromWhitelist records a removeStrategiesFromWhitelist call after running the hook set with OnRemoveStrategiesFromWhitelist.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) RemoveStrategiesFromWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeIStrategyFactory.Contract.Transact(opts, "removeStrategiesFromWhitelist", func() error {
+		if hook, ok := _FakeIStrategyFactory.Contract.Hook("removeStrategiesFromWhitelist").(func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToRemoveFromWhitelist)
+		}
+		return nil
+	}, strategiesToRemoveFromWhitelist)
+}
+
+// OnRemoveStrategiesFromWhitelist sets a hook run by every RemoveStrategiesFromWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) OnRemoveStrategiesFromWhitelist(hook func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error) {
+	_FakeIStrategyFactory.Contract.SetHook("removeStrategiesFromWhitelist", hook)
+}
+
+// WhitelistStrategies records a whitelistStrategies call after running the hook set with OnWhitelistStrategies.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) WhitelistStrategies(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeIStrategyFactory.Contract.Transact(opts, "whitelistStrategies", func() error {
+		if hook, ok := _FakeIStrategyFactory.Contract.Hook("whitelistStrategies").(func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToWhitelist)
+		}
+		return nil
+	}, strategiesToWhitelist)
+}
+
+// OnWhitelistStrategies sets a hook run by every WhitelistStrategies call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) OnWhitelistStrategies(hook func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error) {
+	_FakeIStrategyFactory.Contract.SetHook("whitelistStrategies", hook)
+}
+
+// EmitStrategyBeaconModified appends the event to the fake's logs.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) EmitStrategyBeaconModified(event *IStrategyFactoryStrategyBeaconModified) {
+	_FakeIStrategyFactory.Contract.Emit("StrategyBeaconModified", event)
+}
+
+// EmitStrategySetForToken appends the event to the fake's logs.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) EmitStrategySetForToken(event *IStrategyFactoryStrategySetForToken) {
+	_FakeIStrategyFactory.Contract.Emit("StrategySetForToken", event)
+}
+
+// EmitTokenBlacklisted appends the event to the fake's logs.
+func (_FakeIStrategyFactory *FakeIStrategyFactory) EmitTokenBlacklisted(event *IStrategyFactoryTokenBlacklisted) {
+	_FakeIStrategyFactory.Contract.Emit("TokenBlacklisted", event)
+}
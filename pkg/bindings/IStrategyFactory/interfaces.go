@@ -0,0 +1,51 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategyFactory
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// IStrategyFactoryReader is the read-only surface of the IStrategyFactory
+// binding, implemented by IStrategyFactoryCaller, IStrategyFactory and FakeIStrategyFactory.
+type IStrategyFactoryReader interface {
+	DeployedStrategies(opts *bind.CallOpts, token common.Address) (common.Address, error)
+	StrategyBeacon(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IStrategyFactoryWriter is the transacting surface of the IStrategyFactory
+// binding, implemented by IStrategyFactoryTransactor, IStrategyFactory and FakeIStrategyFactory.
+type IStrategyFactoryWriter interface {
+	DeployNewStrategy(opts *bind.TransactOpts, token common.Address) (*types.Transaction, error)
+	RemoveStrategiesFromWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error)
+	WhitelistStrategies(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error)
+}
+
+// IStrategyFactoryEvents is the event filtering surface of the IStrategyFactory
+// binding, implemented by IStrategyFactoryFilterer, IStrategyFactory and FakeIStrategyFactory.
+type IStrategyFactoryEvents interface {
+	FilterStrategyBeaconModified(opts *bind.FilterOpts) (*IStrategyFactoryStrategyBeaconModifiedIterator, error)
+	WatchStrategyBeaconModified(opts *bind.WatchOpts, sink chan<- *IStrategyFactoryStrategyBeaconModified) (event.Subscription, error)
+	ParseStrategyBeaconModified(log types.Log) (*IStrategyFactoryStrategyBeaconModified, error)
+	FilterStrategySetForToken(opts *bind.FilterOpts) (*IStrategyFactoryStrategySetForTokenIterator, error)
+	WatchStrategySetForToken(opts *bind.WatchOpts, sink chan<- *IStrategyFactoryStrategySetForToken) (event.Subscription, error)
+	ParseStrategySetForToken(log types.Log) (*IStrategyFactoryStrategySetForToken, error)
+	FilterTokenBlacklisted(opts *bind.FilterOpts) (*IStrategyFactoryTokenBlacklistedIterator, error)
+	WatchTokenBlacklisted(opts *bind.WatchOpts, sink chan<- *IStrategyFactoryTokenBlacklisted) (event.Subscription, error)
+	ParseTokenBlacklisted(log types.Log) (*IStrategyFactoryTokenBlacklisted, error)
+}
+
+var (
+	_ IStrategyFactoryReader = (*IStrategyFactoryCaller)(nil)
+	_ IStrategyFactoryReader = (*IStrategyFactory)(nil)
+	_ IStrategyFactoryReader = (*FakeIStrategyFactory)(nil)
+	_ IStrategyFactoryWriter = (*IStrategyFactoryTransactor)(nil)
+	_ IStrategyFactoryWriter = (*IStrategyFactory)(nil)
+	_ IStrategyFactoryWriter = (*FakeIStrategyFactory)(nil)
+	_ IStrategyFactoryEvents = (*IStrategyFactoryFilterer)(nil)
+	_ IStrategyFactoryEvents = (*IStrategyFactory)(nil)
+	_ IStrategyFactoryEvents = (*FakeIStrategyFactory)(nil)
+)
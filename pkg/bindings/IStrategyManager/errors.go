@@ -0,0 +1,88 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategyManager
+
+// ErrMaxStrategiesExceeded is the typed form of the IStrategyManager custom error MaxStrategiesExceeded().
+type ErrMaxStrategiesExceeded struct{}
+
+// Error implements the error interface.
+func (ErrMaxStrategiesExceeded) Error() string {
+	return "IStrategyManager: MaxStrategiesExceeded()"
+}
+
+// ErrOnlyDelegationManager is the typed form of the IStrategyManager custom error OnlyDelegationManager().
+type ErrOnlyDelegationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyDelegationManager) Error() string {
+	return "IStrategyManager: OnlyDelegationManager()"
+}
+
+// ErrOnlyStrategyWhitelister is the typed form of the IStrategyManager custom error OnlyStrategyWhitelister().
+type ErrOnlyStrategyWhitelister struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyWhitelister) Error() string {
+	return "IStrategyManager: OnlyStrategyWhitelister()"
+}
+
+// ErrSharesAmountTooHigh is the typed form of the IStrategyManager custom error SharesAmountTooHigh().
+type ErrSharesAmountTooHigh struct{}
+
+// Error implements the error interface.
+func (ErrSharesAmountTooHigh) Error() string {
+	return "IStrategyManager: SharesAmountTooHigh()"
+}
+
+// ErrSharesAmountZero is the typed form of the IStrategyManager custom error SharesAmountZero().
+type ErrSharesAmountZero struct{}
+
+// Error implements the error interface.
+func (ErrSharesAmountZero) Error() string {
+	return "IStrategyManager: SharesAmountZero()"
+}
+
+// ErrStakerAddressZero is the typed form of the IStrategyManager custom error StakerAddressZero().
+type ErrStakerAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrStakerAddressZero) Error() string {
+	return "IStrategyManager: StakerAddressZero()"
+}
+
+// ErrStrategyAlreadyInSlash is the typed form of the IStrategyManager custom error StrategyAlreadyInSlash().
+type ErrStrategyAlreadyInSlash struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyInSlash) Error() string {
+	return "IStrategyManager: StrategyAlreadyInSlash()"
+}
+
+// ErrStrategyNotFound is the typed form of the IStrategyManager custom error StrategyNotFound().
+type ErrStrategyNotFound struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotFound) Error() string {
+	return "IStrategyManager: StrategyNotFound()"
+}
+
+// ErrStrategyNotWhitelisted is the typed form of the IStrategyManager custom error StrategyNotWhitelisted().
+type ErrStrategyNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotWhitelisted) Error() string {
+	return "IStrategyManager: StrategyNotWhitelisted()"
+}
+
+// IStrategyManagerErrors maps the name of every custom error in the IStrategyManager ABI to its typed Go error.
+var IStrategyManagerErrors = map[string]error{
+	"MaxStrategiesExceeded":   ErrMaxStrategiesExceeded{},
+	"OnlyDelegationManager":   ErrOnlyDelegationManager{},
+	"OnlyStrategyWhitelister": ErrOnlyStrategyWhitelister{},
+	"SharesAmountTooHigh":     ErrSharesAmountTooHigh{},
+	"SharesAmountZero":        ErrSharesAmountZero{},
+	"StakerAddressZero":       ErrStakerAddressZero{},
+	"StrategyAlreadyInSlash":  ErrStrategyAlreadyInSlash{},
+	"StrategyNotFound":        ErrStrategyNotFound{},
+	"StrategyNotWhitelisted":  ErrStrategyNotWhitelisted{},
+}
@@ -0,0 +1,456 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategyManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeIStrategyManager is an in-memory IStrategyManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded IStrategyManagerFilterer.
+type FakeIStrategyManager struct {
+	*fake.Contract
+	*IStrategyManagerFilterer
+}
+
+// NewFakeIStrategyManager returns an empty fake of the contract at address.
+func NewFakeIStrategyManager(address common.Address) *FakeIStrategyManager {
+	contract := fake.NewContract(IStrategyManagerMetaData, address)
+	filterer, err := NewIStrategyManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeIStrategyManager{Contract: contract, IStrategyManagerFilterer: filterer}
+}
+
+// CalculateStrategyDepositDigestHash returns the values stubbed with StubCalculateStrategyDepositDigestHash.
+func (_FakeIStrategyManager *FakeIStrategyManager) CalculateStrategyDepositDigestHash(opts *bind.CallOpts, staker common.Address, strategy common.Address, token common.Address, amount *big.Int, nonce *big.Int, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeIStrategyManager.Contract.Read("calculateStrategyDepositDigestHash", []interface{}{staker, strategy, token, amount, nonce, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateStrategyDepositDigestHash sets what CalculateStrategyDepositDigestHash returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubCalculateStrategyDepositDigestHash(staker common.Address, strategy common.Address, token common.Address, amount *big.Int, nonce *big.Int, expiry *big.Int, out0 [32]byte) {
+	_FakeIStrategyManager.Contract.Stub("calculateStrategyDepositDigestHash", []interface{}{staker, strategy, token, amount, nonce, expiry}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeIStrategyManager *FakeIStrategyManager) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIStrategyManager.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubDelegation(out0 common.Address) {
+	_FakeIStrategyManager.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// GetBurnOrRedistributableCount returns the values stubbed with StubGetBurnOrRedistributableCount.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetBurnOrRedistributableCount(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategyManager.Contract.Read("getBurnOrRedistributableCount", []interface{}{operatorSet, slashId}, &out0)
+	return out0, err
+}
+
+// StubGetBurnOrRedistributableCount sets what GetBurnOrRedistributableCount returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetBurnOrRedistributableCount(operatorSet OperatorSet, slashId *big.Int, out0 *big.Int) {
+	_FakeIStrategyManager.Contract.Stub("getBurnOrRedistributableCount", []interface{}{operatorSet, slashId}, out0)
+}
+
+// GetBurnOrRedistributableShares returns the values stubbed with StubGetBurnOrRedistributableShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetBurnOrRedistributableShares(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeIStrategyManager.Contract.Read("getBurnOrRedistributableShares", []interface{}{operatorSet, slashId}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetBurnOrRedistributableShares sets what GetBurnOrRedistributableShares returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetBurnOrRedistributableShares(operatorSet OperatorSet, slashId *big.Int, out0 []common.Address, out1 []*big.Int) {
+	_FakeIStrategyManager.Contract.Stub("getBurnOrRedistributableShares", []interface{}{operatorSet, slashId}, out0, out1)
+}
+
+// GetBurnOrRedistributableShares0 returns the values stubbed with StubGetBurnOrRedistributableShares0.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetBurnOrRedistributableShares0(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategyManager.Contract.Read("getBurnOrRedistributableShares0", []interface{}{operatorSet, slashId, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetBurnOrRedistributableShares0 sets what GetBurnOrRedistributableShares0 returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetBurnOrRedistributableShares0(operatorSet OperatorSet, slashId *big.Int, strategy common.Address, out0 *big.Int) {
+	_FakeIStrategyManager.Contract.Stub("getBurnOrRedistributableShares0", []interface{}{operatorSet, slashId, strategy}, out0)
+}
+
+// GetBurnableShares returns the values stubbed with StubGetBurnableShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetBurnableShares(opts *bind.CallOpts, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategyManager.Contract.Read("getBurnableShares", []interface{}{strategy}, &out0)
+	return out0, err
+}
+
+// StubGetBurnableShares sets what GetBurnableShares returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetBurnableShares(strategy common.Address, out0 *big.Int) {
+	_FakeIStrategyManager.Contract.Stub("getBurnableShares", []interface{}{strategy}, out0)
+}
+
+// GetDeposits returns the values stubbed with StubGetDeposits.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeIStrategyManager.Contract.Read("getDeposits", []interface{}{staker}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetDeposits sets what GetDeposits returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetDeposits(staker common.Address, out0 []common.Address, out1 []*big.Int) {
+	_FakeIStrategyManager.Contract.Stub("getDeposits", []interface{}{staker}, out0, out1)
+}
+
+// GetPendingOperatorSets returns the values stubbed with StubGetPendingOperatorSets.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetPendingOperatorSets(opts *bind.CallOpts) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeIStrategyManager.Contract.Read("getPendingOperatorSets", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetPendingOperatorSets sets what GetPendingOperatorSets returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetPendingOperatorSets(out0 []OperatorSet) {
+	_FakeIStrategyManager.Contract.Stub("getPendingOperatorSets", []interface{}{}, out0)
+}
+
+// GetPendingSlashIds returns the values stubbed with StubGetPendingSlashIds.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetPendingSlashIds(opts *bind.CallOpts, operatorSet OperatorSet) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeIStrategyManager.Contract.Read("getPendingSlashIds", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetPendingSlashIds sets what GetPendingSlashIds returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetPendingSlashIds(operatorSet OperatorSet, out0 []*big.Int) {
+	_FakeIStrategyManager.Contract.Stub("getPendingSlashIds", []interface{}{operatorSet}, out0)
+}
+
+// GetStakerStrategyList returns the values stubbed with StubGetStakerStrategyList.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetStakerStrategyList(opts *bind.CallOpts, staker common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeIStrategyManager.Contract.Read("getStakerStrategyList", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetStakerStrategyList sets what GetStakerStrategyList returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetStakerStrategyList(staker common.Address, out0 []common.Address) {
+	_FakeIStrategyManager.Contract.Stub("getStakerStrategyList", []interface{}{staker}, out0)
+}
+
+// GetStrategiesWithBurnableShares returns the values stubbed with StubGetStrategiesWithBurnableShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) GetStrategiesWithBurnableShares(opts *bind.CallOpts) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeIStrategyManager.Contract.Read("getStrategiesWithBurnableShares", []interface{}{}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetStrategiesWithBurnableShares sets what GetStrategiesWithBurnableShares returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubGetStrategiesWithBurnableShares(out0 []common.Address, out1 []*big.Int) {
+	_FakeIStrategyManager.Contract.Stub("getStrategiesWithBurnableShares", []interface{}{}, out0, out1)
+}
+
+// StakerDepositShares returns the values stubbed with StubStakerDepositShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategyManager.Contract.Read("stakerDepositShares", []interface{}{user, strategy}, &out0)
+	return out0, err
+}
+
+// StubStakerDepositShares sets what StakerDepositShares returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubStakerDepositShares(user common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeIStrategyManager.Contract.Stub("stakerDepositShares", []interface{}{user, strategy}, out0)
+}
+
+// StakerStrategyListLength returns the values stubbed with StubStakerStrategyListLength.
+func (_FakeIStrategyManager *FakeIStrategyManager) StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeIStrategyManager.Contract.Read("stakerStrategyListLength", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubStakerStrategyListLength sets what StakerStrategyListLength returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubStakerStrategyListLength(staker common.Address, out0 *big.Int) {
+	_FakeIStrategyManager.Contract.Stub("stakerStrategyListLength", []interface{}{staker}, out0)
+}
+
+// StrategyIsWhitelistedForDeposit returns the values stubbed with StubStrategyIsWhitelistedForDeposit.
+func (_FakeIStrategyManager *FakeIStrategyManager) StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, strategy common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeIStrategyManager.Contract.Read("strategyIsWhitelistedForDeposit", []interface{}{strategy}, &out0)
+	return out0, err
+}
+
+// StubStrategyIsWhitelistedForDeposit sets what StrategyIsWhitelistedForDeposit returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubStrategyIsWhitelistedForDeposit(strategy common.Address, out0 bool) {
+	_FakeIStrategyManager.Contract.Stub("strategyIsWhitelistedForDeposit", []interface{}{strategy}, out0)
+}
+
+// StrategyWhitelister returns the values stubbed with StubStrategyWhitelister.
+func (_FakeIStrategyManager *FakeIStrategyManager) StrategyWhitelister(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeIStrategyManager.Contract.Read("strategyWhitelister", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyWhitelister sets what StrategyWhitelister returns for the given arguments.
+func (_FakeIStrategyManager *FakeIStrategyManager) StubStrategyWhitelister(out0 common.Address) {
+	_FakeIStrategyManager.Contract.Stub("strategyWhitelister", []interface{}{}, out0)
+}
+
+// AddShares records a addShares call after running the hook set with OnAddShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "addShares", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("addShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, shares)
+		}
+		return nil
+	}, staker, strategy, shares)
+}
+
+// OnAddShares sets a hook run by every AddShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnAddShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error) {
+	_FakeIStrategyManager.Contract.SetHook("addShares", hook)
+}
+
+// AddStrategiesToDepositWhitelist records a addStrategiesToDepositWhitelist call after running the hook set with OnAddStrategiesToDepositWhitelist.
+func (_FakeIStrategyManager *FakeIStrategyManager) AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "addStrategiesToDepositWhitelist", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("addStrategiesToDepositWhitelist").(func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToWhitelist)
+		}
+		return nil
+	}, strategiesToWhitelist)
+}
+
+// OnAddStrategiesToDepositWhitelist sets a hook run by every AddStrategiesToDepositWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnAddStrategiesToDepositWhitelist(hook func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error) {
+	_FakeIStrategyManager.Contract.SetHook("addStrategiesToDepositWhitelist", hook)
+}
+
+// BurnShares records a burnShares call after running the hook set with OnBurnShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) BurnShares(opts *bind.TransactOpts, strategy common.Address) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "burnShares", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("burnShares").(func(opts *bind.TransactOpts, strategy common.Address) error); ok {
+			return hook(opts, strategy)
+		}
+		return nil
+	}, strategy)
+}
+
+// OnBurnShares sets a hook run by every BurnShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnBurnShares(hook func(opts *bind.TransactOpts, strategy common.Address) error) {
+	_FakeIStrategyManager.Contract.SetHook("burnShares", hook)
+}
+
+// ClearBurnOrRedistributableShares records a clearBurnOrRedistributableShares call after running the hook set with OnClearBurnOrRedistributableShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) ClearBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "clearBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("clearBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId)
+		}
+		return nil
+	}, operatorSet, slashId)
+}
+
+// OnClearBurnOrRedistributableShares sets a hook run by every ClearBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnClearBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) error) {
+	_FakeIStrategyManager.Contract.SetHook("clearBurnOrRedistributableShares", hook)
+}
+
+// ClearBurnOrRedistributableSharesByStrategy records a clearBurnOrRedistributableSharesByStrategy call after running the hook set with OnClearBurnOrRedistributableSharesByStrategy.
+func (_FakeIStrategyManager *FakeIStrategyManager) ClearBurnOrRedistributableSharesByStrategy(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "clearBurnOrRedistributableSharesByStrategy", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("clearBurnOrRedistributableSharesByStrategy").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) error); ok {
+			return hook(opts, operatorSet, slashId, strategy)
+		}
+		return nil
+	}, operatorSet, slashId, strategy)
+}
+
+// OnClearBurnOrRedistributableSharesByStrategy sets a hook run by every ClearBurnOrRedistributableSharesByStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnClearBurnOrRedistributableSharesByStrategy(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) error) {
+	_FakeIStrategyManager.Contract.SetHook("clearBurnOrRedistributableSharesByStrategy", hook)
+}
+
+// DepositIntoStrategy records a depositIntoStrategy call after running the hook set with OnDepositIntoStrategy.
+func (_FakeIStrategyManager *FakeIStrategyManager) DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "depositIntoStrategy", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("depositIntoStrategy").(func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) error); ok {
+			return hook(opts, strategy, token, amount)
+		}
+		return nil
+	}, strategy, token, amount)
+}
+
+// OnDepositIntoStrategy sets a hook run by every DepositIntoStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnDepositIntoStrategy(hook func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) error) {
+	_FakeIStrategyManager.Contract.SetHook("depositIntoStrategy", hook)
+}
+
+// DepositIntoStrategyWithSignature records a depositIntoStrategyWithSignature call after running the hook set with OnDepositIntoStrategyWithSignature.
+func (_FakeIStrategyManager *FakeIStrategyManager) DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "depositIntoStrategyWithSignature", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("depositIntoStrategyWithSignature").(func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) error); ok {
+			return hook(opts, strategy, token, amount, staker, expiry, signature)
+		}
+		return nil
+	}, strategy, token, amount, staker, expiry, signature)
+}
+
+// OnDepositIntoStrategyWithSignature sets a hook run by every DepositIntoStrategyWithSignature call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnDepositIntoStrategyWithSignature(hook func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) error) {
+	_FakeIStrategyManager.Contract.SetHook("depositIntoStrategyWithSignature", hook)
+}
+
+// IncreaseBurnOrRedistributableShares records a increaseBurnOrRedistributableShares call after running the hook set with OnIncreaseBurnOrRedistributableShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "increaseBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("increaseBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId, strategy, addedSharesToBurn)
+		}
+		return nil
+	}, operatorSet, slashId, strategy, addedSharesToBurn)
+}
+
+// OnIncreaseBurnOrRedistributableShares sets a hook run by every IncreaseBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnIncreaseBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error) {
+	_FakeIStrategyManager.Contract.SetHook("increaseBurnOrRedistributableShares", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeIStrategyManager *FakeIStrategyManager) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialOwner, initialStrategyWhitelister, initialPausedStatus)
+		}
+		return nil
+	}, initialOwner, initialStrategyWhitelister, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) error) {
+	_FakeIStrategyManager.Contract.SetHook("initialize", hook)
+}
+
+// RemoveDepositShares records a removeDepositShares call after running the hook set with OnRemoveDepositShares.
+func (_FakeIStrategyManager *FakeIStrategyManager) RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "removeDepositShares", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("removeDepositShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error); ok {
+			return hook(opts, staker, strategy, depositSharesToRemove)
+		}
+		return nil
+	}, staker, strategy, depositSharesToRemove)
+}
+
+// OnRemoveDepositShares sets a hook run by every RemoveDepositShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnRemoveDepositShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error) {
+	_FakeIStrategyManager.Contract.SetHook("removeDepositShares", hook)
+}
+
+// RemoveStrategiesFromDepositWhitelist records a removeStrategiesFromDepositWhitelist call after running the hook set with OnRemoveStrategiesFromDepositWhitelist.
+func (_FakeIStrategyManager *FakeIStrategyManager) RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "removeStrategiesFromDepositWhitelist", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("removeStrategiesFromDepositWhitelist").(func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToRemoveFromWhitelist)
+		}
+		return nil
+	}, strategiesToRemoveFromWhitelist)
+}
+
+// OnRemoveStrategiesFromDepositWhitelist sets a hook run by every RemoveStrategiesFromDepositWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnRemoveStrategiesFromDepositWhitelist(hook func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error) {
+	_FakeIStrategyManager.Contract.SetHook("removeStrategiesFromDepositWhitelist", hook)
+}
+
+// SetStrategyWhitelister records a setStrategyWhitelister call after running the hook set with OnSetStrategyWhitelister.
+func (_FakeIStrategyManager *FakeIStrategyManager) SetStrategyWhitelister(opts *bind.TransactOpts, newStrategyWhitelister common.Address) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "setStrategyWhitelister", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("setStrategyWhitelister").(func(opts *bind.TransactOpts, newStrategyWhitelister common.Address) error); ok {
+			return hook(opts, newStrategyWhitelister)
+		}
+		return nil
+	}, newStrategyWhitelister)
+}
+
+// OnSetStrategyWhitelister sets a hook run by every SetStrategyWhitelister call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnSetStrategyWhitelister(hook func(opts *bind.TransactOpts, newStrategyWhitelister common.Address) error) {
+	_FakeIStrategyManager.Contract.SetHook("setStrategyWhitelister", hook)
+}
+
+// WithdrawSharesAsTokens records a withdrawSharesAsTokens call after running the hook set with OnWithdrawSharesAsTokens.
+func (_FakeIStrategyManager *FakeIStrategyManager) WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeIStrategyManager.Contract.Transact(opts, "withdrawSharesAsTokens", func() error {
+		if hook, ok := _FakeIStrategyManager.Contract.Hook("withdrawSharesAsTokens").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, token, shares)
+		}
+		return nil
+	}, staker, strategy, token, shares)
+}
+
+// OnWithdrawSharesAsTokens sets a hook run by every WithdrawSharesAsTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeIStrategyManager *FakeIStrategyManager) OnWithdrawSharesAsTokens(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error) {
+	_FakeIStrategyManager.Contract.SetHook("withdrawSharesAsTokens", hook)
+}
+
+// EmitBurnOrRedistributableSharesDecreased appends the event to the fake's logs.
+func (_FakeIStrategyManager *FakeIStrategyManager) EmitBurnOrRedistributableSharesDecreased(event *IStrategyManagerBurnOrRedistributableSharesDecreased) {
+	_FakeIStrategyManager.Contract.Emit("BurnOrRedistributableSharesDecreased", event)
+}
+
+// EmitBurnOrRedistributableSharesIncreased appends the event to the fake's logs.
+func (_FakeIStrategyManager *FakeIStrategyManager) EmitBurnOrRedistributableSharesIncreased(event *IStrategyManagerBurnOrRedistributableSharesIncreased) {
+	_FakeIStrategyManager.Contract.Emit("BurnOrRedistributableSharesIncreased", event)
+}
+
+// EmitBurnableSharesDecreased appends the event to the fake's logs.
+func (_FakeIStrategyManager *FakeIStrategyManager) EmitBurnableSharesDecreased(event *IStrategyManagerBurnableSharesDecreased) {
+	_FakeIStrategyManager.Contract.Emit("BurnableSharesDecreased", event)
+}
+
+// EmitDeposit appends the event to the fake's logs.
+func (_FakeIStrategyManager *FakeIStrategyManager) EmitDeposit(event *IStrategyManagerDeposit) {
+	_FakeIStrategyManager.Contract.Emit("Deposit", event)
+}
+
+// EmitStrategyAddedToDepositWhitelist appends the event to the fake's logs.
+func (_FakeIStrategyManager *FakeIStrategyManager) EmitStrategyAddedToDepositWhitelist(event *IStrategyManagerStrategyAddedToDepositWhitelist) {
+	_FakeIStrategyManager.Contract.Emit("StrategyAddedToDepositWhitelist", event)
+}
+
+// EmitStrategyRemovedFromDepositWhitelist appends the event to the fake's logs.
+func (_FakeIStrategyManager *FakeIStrategyManager) EmitStrategyRemovedFromDepositWhitelist(event *IStrategyManagerStrategyRemovedFromDepositWhitelist) {
+	_FakeIStrategyManager.Contract.Emit("StrategyRemovedFromDepositWhitelist", event)
+}
+
+// EmitStrategyWhitelisterChanged appends the event to the fake's logs.
+func (_FakeIStrategyManager *FakeIStrategyManager) EmitStrategyWhitelisterChanged(event *IStrategyManagerStrategyWhitelisterChanged) {
+	_FakeIStrategyManager.Contract.Emit("StrategyWhitelisterChanged", event)
+}
@@ -0,0 +1,87 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package IStrategyManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// IStrategyManagerReader is the read-only surface of the IStrategyManager
+// binding, implemented by IStrategyManagerCaller, IStrategyManager and FakeIStrategyManager.
+type IStrategyManagerReader interface {
+	CalculateStrategyDepositDigestHash(opts *bind.CallOpts, staker common.Address, strategy common.Address, token common.Address, amount *big.Int, nonce *big.Int, expiry *big.Int) ([32]byte, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	GetBurnOrRedistributableCount(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) (*big.Int, error)
+	GetBurnOrRedistributableShares(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) ([]common.Address, []*big.Int, error)
+	GetBurnOrRedistributableShares0(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*big.Int, error)
+	GetBurnableShares(opts *bind.CallOpts, strategy common.Address) (*big.Int, error)
+	GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	GetPendingOperatorSets(opts *bind.CallOpts) ([]OperatorSet, error)
+	GetPendingSlashIds(opts *bind.CallOpts, operatorSet OperatorSet) ([]*big.Int, error)
+	GetStakerStrategyList(opts *bind.CallOpts, staker common.Address) ([]common.Address, error)
+	GetStrategiesWithBurnableShares(opts *bind.CallOpts) ([]common.Address, []*big.Int, error)
+	StakerDepositShares(opts *bind.CallOpts, user common.Address, strategy common.Address) (*big.Int, error)
+	StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, strategy common.Address) (bool, error)
+	StrategyWhitelister(opts *bind.CallOpts) (common.Address, error)
+}
+
+// IStrategyManagerWriter is the transacting surface of the IStrategyManager
+// binding, implemented by IStrategyManagerTransactor, IStrategyManager and FakeIStrategyManager.
+type IStrategyManagerWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error)
+	BurnShares(opts *bind.TransactOpts, strategy common.Address) (*types.Transaction, error)
+	ClearBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) (*types.Transaction, error)
+	ClearBurnOrRedistributableSharesByStrategy(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*types.Transaction, error)
+	DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error)
+	DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error)
+	IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) (*types.Transaction, error)
+	RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error)
+	RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error)
+	SetStrategyWhitelister(opts *bind.TransactOpts, newStrategyWhitelister common.Address) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+// IStrategyManagerEvents is the event filtering surface of the IStrategyManager
+// binding, implemented by IStrategyManagerFilterer, IStrategyManager and FakeIStrategyManager.
+type IStrategyManagerEvents interface {
+	FilterBurnOrRedistributableSharesDecreased(opts *bind.FilterOpts) (*IStrategyManagerBurnOrRedistributableSharesDecreasedIterator, error)
+	WatchBurnOrRedistributableSharesDecreased(opts *bind.WatchOpts, sink chan<- *IStrategyManagerBurnOrRedistributableSharesDecreased) (event.Subscription, error)
+	ParseBurnOrRedistributableSharesDecreased(log types.Log) (*IStrategyManagerBurnOrRedistributableSharesDecreased, error)
+	FilterBurnOrRedistributableSharesIncreased(opts *bind.FilterOpts) (*IStrategyManagerBurnOrRedistributableSharesIncreasedIterator, error)
+	WatchBurnOrRedistributableSharesIncreased(opts *bind.WatchOpts, sink chan<- *IStrategyManagerBurnOrRedistributableSharesIncreased) (event.Subscription, error)
+	ParseBurnOrRedistributableSharesIncreased(log types.Log) (*IStrategyManagerBurnOrRedistributableSharesIncreased, error)
+	FilterBurnableSharesDecreased(opts *bind.FilterOpts) (*IStrategyManagerBurnableSharesDecreasedIterator, error)
+	WatchBurnableSharesDecreased(opts *bind.WatchOpts, sink chan<- *IStrategyManagerBurnableSharesDecreased) (event.Subscription, error)
+	ParseBurnableSharesDecreased(log types.Log) (*IStrategyManagerBurnableSharesDecreased, error)
+	FilterDeposit(opts *bind.FilterOpts) (*IStrategyManagerDepositIterator, error)
+	WatchDeposit(opts *bind.WatchOpts, sink chan<- *IStrategyManagerDeposit) (event.Subscription, error)
+	ParseDeposit(log types.Log) (*IStrategyManagerDeposit, error)
+	FilterStrategyAddedToDepositWhitelist(opts *bind.FilterOpts) (*IStrategyManagerStrategyAddedToDepositWhitelistIterator, error)
+	WatchStrategyAddedToDepositWhitelist(opts *bind.WatchOpts, sink chan<- *IStrategyManagerStrategyAddedToDepositWhitelist) (event.Subscription, error)
+	ParseStrategyAddedToDepositWhitelist(log types.Log) (*IStrategyManagerStrategyAddedToDepositWhitelist, error)
+	FilterStrategyRemovedFromDepositWhitelist(opts *bind.FilterOpts) (*IStrategyManagerStrategyRemovedFromDepositWhitelistIterator, error)
+	WatchStrategyRemovedFromDepositWhitelist(opts *bind.WatchOpts, sink chan<- *IStrategyManagerStrategyRemovedFromDepositWhitelist) (event.Subscription, error)
+	ParseStrategyRemovedFromDepositWhitelist(log types.Log) (*IStrategyManagerStrategyRemovedFromDepositWhitelist, error)
+	FilterStrategyWhitelisterChanged(opts *bind.FilterOpts) (*IStrategyManagerStrategyWhitelisterChangedIterator, error)
+	WatchStrategyWhitelisterChanged(opts *bind.WatchOpts, sink chan<- *IStrategyManagerStrategyWhitelisterChanged) (event.Subscription, error)
+	ParseStrategyWhitelisterChanged(log types.Log) (*IStrategyManagerStrategyWhitelisterChanged, error)
+}
+
+var (
+	_ IStrategyManagerReader = (*IStrategyManagerCaller)(nil)
+	_ IStrategyManagerReader = (*IStrategyManager)(nil)
+	_ IStrategyManagerReader = (*FakeIStrategyManager)(nil)
+	_ IStrategyManagerWriter = (*IStrategyManagerTransactor)(nil)
+	_ IStrategyManagerWriter = (*IStrategyManager)(nil)
+	_ IStrategyManagerWriter = (*FakeIStrategyManager)(nil)
+	_ IStrategyManagerEvents = (*IStrategyManagerFilterer)(nil)
+	_ IStrategyManagerEvents = (*IStrategyManager)(nil)
+	_ IStrategyManagerEvents = (*FakeIStrategyManager)(nil)
+)
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,94 +30,38 @@ var (
 	_ = abi.ConvertType
 )
 
-// BN254G1Point is an auto generated low-level Go binding around an user-defined struct.
-type BN254G1Point struct {
-	X *big.Int
-	Y *big.Int
-}
+// BN254G1Point is an alias of the canonical eltypes.BN254G1Point shared by all bindings.
+type BN254G1Point = eltypes.BN254G1Point
 
-// BN254G2Point is an auto generated low-level Go binding around an user-defined struct.
-type BN254G2Point struct {
-	X [2]*big.Int
-	Y [2]*big.Int
-}
+// BN254G2Point is an alias of the canonical eltypes.BN254G2Point shared by all bindings.
+type BN254G2Point = eltypes.BN254G2Point
 
-// IBN254CertificateVerifierTypesBN254Certificate is an auto generated low-level Go binding around an user-defined struct.
-type IBN254CertificateVerifierTypesBN254Certificate struct {
-	ReferenceTimestamp uint32
-	MessageHash        [32]byte
-	Signature          BN254G1Point
-	Apk                BN254G2Point
-	NonSignerWitnesses []IBN254CertificateVerifierTypesBN254OperatorInfoWitness
-}
+// IBN254CertificateVerifierTypesBN254Certificate is an alias of the canonical eltypes.IBN254CertificateVerifierTypesBN254Certificate shared by all bindings.
+type IBN254CertificateVerifierTypesBN254Certificate = eltypes.IBN254CertificateVerifierTypesBN254Certificate
 
-// IBN254CertificateVerifierTypesBN254OperatorInfoWitness is an auto generated low-level Go binding around an user-defined struct.
-type IBN254CertificateVerifierTypesBN254OperatorInfoWitness struct {
-	OperatorIndex     uint32
-	OperatorInfoProof []byte
-	OperatorInfo      IOperatorTableCalculatorTypesBN254OperatorInfo
-}
+// IBN254CertificateVerifierTypesBN254OperatorInfoWitness is an alias of the canonical eltypes.IBN254CertificateVerifierTypesBN254OperatorInfoWitness shared by all bindings.
+type IBN254CertificateVerifierTypesBN254OperatorInfoWitness = eltypes.IBN254CertificateVerifierTypesBN254OperatorInfoWitness
 
-// IECDSACertificateVerifierTypesECDSACertificate is an auto generated low-level Go binding around an user-defined struct.
-type IECDSACertificateVerifierTypesECDSACertificate struct {
-	ReferenceTimestamp uint32
-	MessageHash        [32]byte
-	Sig                []byte
-}
+// IECDSACertificateVerifierTypesECDSACertificate is an alias of the canonical eltypes.IECDSACertificateVerifierTypesECDSACertificate shared by all bindings.
+type IECDSACertificateVerifierTypesECDSACertificate = eltypes.IECDSACertificateVerifierTypesECDSACertificate
 
-// IOperatorTableCalculatorTypesBN254OperatorInfo is an auto generated low-level Go binding around an user-defined struct.
-type IOperatorTableCalculatorTypesBN254OperatorInfo struct {
-	Pubkey  BN254G1Point
-	Weights []*big.Int
-}
+// IOperatorTableCalculatorTypesBN254OperatorInfo is an alias of the canonical eltypes.IOperatorTableCalculatorTypesBN254OperatorInfo shared by all bindings.
+type IOperatorTableCalculatorTypesBN254OperatorInfo = eltypes.IOperatorTableCalculatorTypesBN254OperatorInfo
 
-// ITaskMailboxTypesConsensus is an auto generated low-level Go binding around an user-defined struct.
-type ITaskMailboxTypesConsensus struct {
-	ConsensusType uint8
-	Value         []byte
-}
+// ITaskMailboxTypesConsensus is an alias of the canonical eltypes.ITaskMailboxTypesConsensus shared by all bindings.
+type ITaskMailboxTypesConsensus = eltypes.ITaskMailboxTypesConsensus
 
-// ITaskMailboxTypesExecutorOperatorSetTaskConfig is an auto generated low-level Go binding around an user-defined struct.
-type ITaskMailboxTypesExecutorOperatorSetTaskConfig struct {
-	TaskHook     common.Address
-	TaskSLA      *big.Int
-	FeeToken     common.Address
-	CurveType    uint8
-	FeeCollector common.Address
-	Consensus    ITaskMailboxTypesConsensus
-	TaskMetadata []byte
-}
+// ITaskMailboxTypesExecutorOperatorSetTaskConfig is an alias of the canonical eltypes.ITaskMailboxTypesExecutorOperatorSetTaskConfig shared by all bindings.
+type ITaskMailboxTypesExecutorOperatorSetTaskConfig = eltypes.ITaskMailboxTypesExecutorOperatorSetTaskConfig
 
-// ITaskMailboxTypesTask is an auto generated low-level Go binding around an user-defined struct.
-type ITaskMailboxTypesTask struct {
-	Creator                         common.Address
-	CreationTime                    *big.Int
-	Avs                             common.Address
-	AvsFee                          *big.Int
-	RefundCollector                 common.Address
-	ExecutorOperatorSetId           uint32
-	FeeSplit                        uint16
-	Status                          uint8
-	IsFeeRefunded                   bool
-	OperatorTableReferenceTimestamp uint32
-	ExecutorOperatorSetTaskConfig   ITaskMailboxTypesExecutorOperatorSetTaskConfig
-	Payload                         []byte
-	ExecutorCert                    []byte
-	Result                          []byte
-}
+// ITaskMailboxTypesTask is an alias of the canonical eltypes.ITaskMailboxTypesTask shared by all bindings.
+type ITaskMailboxTypesTask = eltypes.ITaskMailboxTypesTask
 
-// ITaskMailboxTypesTaskParams is an auto generated low-level Go binding around an user-defined struct.
-type ITaskMailboxTypesTaskParams struct {
-	RefundCollector     common.Address
-	ExecutorOperatorSet OperatorSet
-	Payload             []byte
-}
+// ITaskMailboxTypesTaskParams is an alias of the canonical eltypes.ITaskMailboxTypesTaskParams shared by all bindings.
+type ITaskMailboxTypesTaskParams = eltypes.ITaskMailboxTypesTaskParams
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // ITaskMailboxMetaData contains all meta data concerning the ITaskMailbox contract.
 var ITaskMailboxMetaData = &bind.MetaData{
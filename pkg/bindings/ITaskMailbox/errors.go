@@ -0,0 +1,203 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ITaskMailbox
+
+import (
+	"fmt"
+)
+
+// ErrCertificateStale is the typed form of the ITaskMailbox custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "ITaskMailbox: CertificateStale()"
+}
+
+// ErrEmptyCertificateSignature is the typed form of the ITaskMailbox custom error EmptyCertificateSignature().
+type ErrEmptyCertificateSignature struct{}
+
+// Error implements the error interface.
+func (ErrEmptyCertificateSignature) Error() string {
+	return "ITaskMailbox: EmptyCertificateSignature()"
+}
+
+// ErrExecutorOperatorSetNotRegistered is the typed form of the ITaskMailbox custom error ExecutorOperatorSetNotRegistered().
+type ErrExecutorOperatorSetNotRegistered struct{}
+
+// Error implements the error interface.
+func (ErrExecutorOperatorSetNotRegistered) Error() string {
+	return "ITaskMailbox: ExecutorOperatorSetNotRegistered()"
+}
+
+// ErrExecutorOperatorSetTaskConfigNotSet is the typed form of the ITaskMailbox custom error ExecutorOperatorSetTaskConfigNotSet().
+type ErrExecutorOperatorSetTaskConfigNotSet struct{}
+
+// Error implements the error interface.
+func (ErrExecutorOperatorSetTaskConfigNotSet) Error() string {
+	return "ITaskMailbox: ExecutorOperatorSetTaskConfigNotSet()"
+}
+
+// ErrFeeAlreadyRefunded is the typed form of the ITaskMailbox custom error FeeAlreadyRefunded().
+type ErrFeeAlreadyRefunded struct{}
+
+// Error implements the error interface.
+func (ErrFeeAlreadyRefunded) Error() string {
+	return "ITaskMailbox: FeeAlreadyRefunded()"
+}
+
+// ErrInvalidAddressZero is the typed form of the ITaskMailbox custom error InvalidAddressZero().
+type ErrInvalidAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAddressZero) Error() string {
+	return "ITaskMailbox: InvalidAddressZero()"
+}
+
+// ErrInvalidConsensusType is the typed form of the ITaskMailbox custom error InvalidConsensusType().
+type ErrInvalidConsensusType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConsensusType) Error() string {
+	return "ITaskMailbox: InvalidConsensusType()"
+}
+
+// ErrInvalidConsensusValue is the typed form of the ITaskMailbox custom error InvalidConsensusValue().
+type ErrInvalidConsensusValue struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConsensusValue) Error() string {
+	return "ITaskMailbox: InvalidConsensusValue()"
+}
+
+// ErrInvalidCurveType is the typed form of the ITaskMailbox custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "ITaskMailbox: InvalidCurveType()"
+}
+
+// ErrInvalidFeeReceiver is the typed form of the ITaskMailbox custom error InvalidFeeReceiver().
+type ErrInvalidFeeReceiver struct{}
+
+// Error implements the error interface.
+func (ErrInvalidFeeReceiver) Error() string {
+	return "ITaskMailbox: InvalidFeeReceiver()"
+}
+
+// ErrInvalidFeeSplit is the typed form of the ITaskMailbox custom error InvalidFeeSplit().
+type ErrInvalidFeeSplit struct{}
+
+// Error implements the error interface.
+func (ErrInvalidFeeSplit) Error() string {
+	return "ITaskMailbox: InvalidFeeSplit()"
+}
+
+// ErrInvalidMessageHash is the typed form of the ITaskMailbox custom error InvalidMessageHash().
+type ErrInvalidMessageHash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMessageHash) Error() string {
+	return "ITaskMailbox: InvalidMessageHash()"
+}
+
+// ErrInvalidOperatorSetOwner is the typed form of the ITaskMailbox custom error InvalidOperatorSetOwner().
+type ErrInvalidOperatorSetOwner struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSetOwner) Error() string {
+	return "ITaskMailbox: InvalidOperatorSetOwner()"
+}
+
+// ErrInvalidReferenceTimestamp is the typed form of the ITaskMailbox custom error InvalidReferenceTimestamp().
+type ErrInvalidReferenceTimestamp struct{}
+
+// Error implements the error interface.
+func (ErrInvalidReferenceTimestamp) Error() string {
+	return "ITaskMailbox: InvalidReferenceTimestamp()"
+}
+
+// ErrInvalidTaskCreator is the typed form of the ITaskMailbox custom error InvalidTaskCreator().
+type ErrInvalidTaskCreator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTaskCreator) Error() string {
+	return "ITaskMailbox: InvalidTaskCreator()"
+}
+
+// ErrInvalidTaskStatus is the typed form of the ITaskMailbox custom error InvalidTaskStatus(uint8,uint8).
+type ErrInvalidTaskStatus struct {
+	Expected uint8
+	Actual   uint8
+}
+
+// Error implements the error interface.
+func (e ErrInvalidTaskStatus) Error() string {
+	return fmt.Sprintf("ITaskMailbox: InvalidTaskStatus(expected: %v, actual: %v)", e.Expected, e.Actual)
+}
+
+// ErrOnlyRefundCollector is the typed form of the ITaskMailbox custom error OnlyRefundCollector().
+type ErrOnlyRefundCollector struct{}
+
+// Error implements the error interface.
+func (ErrOnlyRefundCollector) Error() string {
+	return "ITaskMailbox: OnlyRefundCollector()"
+}
+
+// ErrPayloadIsEmpty is the typed form of the ITaskMailbox custom error PayloadIsEmpty().
+type ErrPayloadIsEmpty struct{}
+
+// Error implements the error interface.
+func (ErrPayloadIsEmpty) Error() string {
+	return "ITaskMailbox: PayloadIsEmpty()"
+}
+
+// ErrTaskSLAExceedsMaximum is the typed form of the ITaskMailbox custom error TaskSLAExceedsMaximum().
+type ErrTaskSLAExceedsMaximum struct{}
+
+// Error implements the error interface.
+func (ErrTaskSLAExceedsMaximum) Error() string {
+	return "ITaskMailbox: TaskSLAExceedsMaximum()"
+}
+
+// ErrThresholdNotMet is the typed form of the ITaskMailbox custom error ThresholdNotMet().
+type ErrThresholdNotMet struct{}
+
+// Error implements the error interface.
+func (ErrThresholdNotMet) Error() string {
+	return "ITaskMailbox: ThresholdNotMet()"
+}
+
+// ErrTimestampAtCreation is the typed form of the ITaskMailbox custom error TimestampAtCreation().
+type ErrTimestampAtCreation struct{}
+
+// Error implements the error interface.
+func (ErrTimestampAtCreation) Error() string {
+	return "ITaskMailbox: TimestampAtCreation()"
+}
+
+// ITaskMailboxErrors maps the name of every custom error in the ITaskMailbox ABI to its typed Go error.
+var ITaskMailboxErrors = map[string]error{
+	"CertificateStale":                    ErrCertificateStale{},
+	"EmptyCertificateSignature":           ErrEmptyCertificateSignature{},
+	"ExecutorOperatorSetNotRegistered":    ErrExecutorOperatorSetNotRegistered{},
+	"ExecutorOperatorSetTaskConfigNotSet": ErrExecutorOperatorSetTaskConfigNotSet{},
+	"FeeAlreadyRefunded":                  ErrFeeAlreadyRefunded{},
+	"InvalidAddressZero":                  ErrInvalidAddressZero{},
+	"InvalidConsensusType":                ErrInvalidConsensusType{},
+	"InvalidConsensusValue":               ErrInvalidConsensusValue{},
+	"InvalidCurveType":                    ErrInvalidCurveType{},
+	"InvalidFeeReceiver":                  ErrInvalidFeeReceiver{},
+	"InvalidFeeSplit":                     ErrInvalidFeeSplit{},
+	"InvalidMessageHash":                  ErrInvalidMessageHash{},
+	"InvalidOperatorSetOwner":             ErrInvalidOperatorSetOwner{},
+	"InvalidReferenceTimestamp":           ErrInvalidReferenceTimestamp{},
+	"InvalidTaskCreator":                  ErrInvalidTaskCreator{},
+	"InvalidTaskStatus":                   ErrInvalidTaskStatus{},
+	"OnlyRefundCollector":                 ErrOnlyRefundCollector{},
+	"PayloadIsEmpty":                      ErrPayloadIsEmpty{},
+	"TaskSLAExceedsMaximum":               ErrTaskSLAExceedsMaximum{},
+	"ThresholdNotMet":                     ErrThresholdNotMet{},
+	"TimestampAtCreation":                 ErrTimestampAtCreation{},
+}
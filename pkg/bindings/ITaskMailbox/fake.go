@@ -0,0 +1,349 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ITaskMailbox
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeITaskMailbox is an in-memory ITaskMailbox for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ITaskMailboxFilterer.
+type FakeITaskMailbox struct {
+	*fake.Contract
+	*ITaskMailboxFilterer
+}
+
+// NewFakeITaskMailbox returns an empty fake of the contract at address.
+func NewFakeITaskMailbox(address common.Address) *FakeITaskMailbox {
+	contract := fake.NewContract(ITaskMailboxMetaData, address)
+	filterer, err := NewITaskMailboxFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeITaskMailbox{Contract: contract, ITaskMailboxFilterer: filterer}
+}
+
+// BN254CERTIFICATEVERIFIER returns the values stubbed with StubBN254CERTIFICATEVERIFIER.
+func (_FakeITaskMailbox *FakeITaskMailbox) BN254CERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeITaskMailbox.Contract.Read("BN254_CERTIFICATE_VERIFIER", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBN254CERTIFICATEVERIFIER sets what BN254CERTIFICATEVERIFIER returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubBN254CERTIFICATEVERIFIER(out0 common.Address) {
+	_FakeITaskMailbox.Contract.Stub("BN254_CERTIFICATE_VERIFIER", []interface{}{}, out0)
+}
+
+// ECDSACERTIFICATEVERIFIER returns the values stubbed with StubECDSACERTIFICATEVERIFIER.
+func (_FakeITaskMailbox *FakeITaskMailbox) ECDSACERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeITaskMailbox.Contract.Read("ECDSA_CERTIFICATE_VERIFIER", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubECDSACERTIFICATEVERIFIER sets what ECDSACERTIFICATEVERIFIER returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubECDSACERTIFICATEVERIFIER(out0 common.Address) {
+	_FakeITaskMailbox.Contract.Stub("ECDSA_CERTIFICATE_VERIFIER", []interface{}{}, out0)
+}
+
+// MAXTASKSLA returns the values stubbed with StubMAXTASKSLA.
+func (_FakeITaskMailbox *FakeITaskMailbox) MAXTASKSLA(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeITaskMailbox.Contract.Read("MAX_TASK_SLA", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXTASKSLA sets what MAXTASKSLA returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubMAXTASKSLA(out0 *big.Int) {
+	_FakeITaskMailbox.Contract.Stub("MAX_TASK_SLA", []interface{}{}, out0)
+}
+
+// FeeSplit returns the values stubbed with StubFeeSplit.
+func (_FakeITaskMailbox *FakeITaskMailbox) FeeSplit(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeITaskMailbox.Contract.Read("feeSplit", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubFeeSplit sets what FeeSplit returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubFeeSplit(out0 uint16) {
+	_FakeITaskMailbox.Contract.Stub("feeSplit", []interface{}{}, out0)
+}
+
+// FeeSplitCollector returns the values stubbed with StubFeeSplitCollector.
+func (_FakeITaskMailbox *FakeITaskMailbox) FeeSplitCollector(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeITaskMailbox.Contract.Read("feeSplitCollector", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubFeeSplitCollector sets what FeeSplitCollector returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubFeeSplitCollector(out0 common.Address) {
+	_FakeITaskMailbox.Contract.Stub("feeSplitCollector", []interface{}{}, out0)
+}
+
+// GetBN254CertificateBytes returns the values stubbed with StubGetBN254CertificateBytes.
+func (_FakeITaskMailbox *FakeITaskMailbox) GetBN254CertificateBytes(opts *bind.CallOpts, cert IBN254CertificateVerifierTypesBN254Certificate) ([]byte, error) {
+	var out0 []byte
+	err := _FakeITaskMailbox.Contract.Read("getBN254CertificateBytes", []interface{}{cert}, &out0)
+	return out0, err
+}
+
+// StubGetBN254CertificateBytes sets what GetBN254CertificateBytes returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubGetBN254CertificateBytes(cert IBN254CertificateVerifierTypesBN254Certificate, out0 []byte) {
+	_FakeITaskMailbox.Contract.Stub("getBN254CertificateBytes", []interface{}{cert}, out0)
+}
+
+// GetECDSACertificateBytes returns the values stubbed with StubGetECDSACertificateBytes.
+func (_FakeITaskMailbox *FakeITaskMailbox) GetECDSACertificateBytes(opts *bind.CallOpts, cert IECDSACertificateVerifierTypesECDSACertificate) ([]byte, error) {
+	var out0 []byte
+	err := _FakeITaskMailbox.Contract.Read("getECDSACertificateBytes", []interface{}{cert}, &out0)
+	return out0, err
+}
+
+// StubGetECDSACertificateBytes sets what GetECDSACertificateBytes returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubGetECDSACertificateBytes(cert IECDSACertificateVerifierTypesECDSACertificate, out0 []byte) {
+	_FakeITaskMailbox.Contract.Stub("getECDSACertificateBytes", []interface{}{cert}, out0)
+}
+
+// GetExecutorOperatorSetTaskConfig returns the values stubbed with StubGetExecutorOperatorSetTaskConfig.
+func (_FakeITaskMailbox *FakeITaskMailbox) GetExecutorOperatorSetTaskConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ITaskMailboxTypesExecutorOperatorSetTaskConfig, error) {
+	var out0 ITaskMailboxTypesExecutorOperatorSetTaskConfig
+	err := _FakeITaskMailbox.Contract.Read("getExecutorOperatorSetTaskConfig", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetExecutorOperatorSetTaskConfig sets what GetExecutorOperatorSetTaskConfig returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubGetExecutorOperatorSetTaskConfig(operatorSet OperatorSet, out0 ITaskMailboxTypesExecutorOperatorSetTaskConfig) {
+	_FakeITaskMailbox.Contract.Stub("getExecutorOperatorSetTaskConfig", []interface{}{operatorSet}, out0)
+}
+
+// GetMessageHash returns the values stubbed with StubGetMessageHash.
+func (_FakeITaskMailbox *FakeITaskMailbox) GetMessageHash(opts *bind.CallOpts, taskHash [32]byte, result []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeITaskMailbox.Contract.Read("getMessageHash", []interface{}{taskHash, result}, &out0)
+	return out0, err
+}
+
+// StubGetMessageHash sets what GetMessageHash returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubGetMessageHash(taskHash [32]byte, result []byte, out0 [32]byte) {
+	_FakeITaskMailbox.Contract.Stub("getMessageHash", []interface{}{taskHash, result}, out0)
+}
+
+// GetTaskInfo returns the values stubbed with StubGetTaskInfo.
+func (_FakeITaskMailbox *FakeITaskMailbox) GetTaskInfo(opts *bind.CallOpts, taskHash [32]byte) (ITaskMailboxTypesTask, error) {
+	var out0 ITaskMailboxTypesTask
+	err := _FakeITaskMailbox.Contract.Read("getTaskInfo", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskInfo sets what GetTaskInfo returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubGetTaskInfo(taskHash [32]byte, out0 ITaskMailboxTypesTask) {
+	_FakeITaskMailbox.Contract.Stub("getTaskInfo", []interface{}{taskHash}, out0)
+}
+
+// GetTaskResult returns the values stubbed with StubGetTaskResult.
+func (_FakeITaskMailbox *FakeITaskMailbox) GetTaskResult(opts *bind.CallOpts, taskHash [32]byte) ([]byte, error) {
+	var out0 []byte
+	err := _FakeITaskMailbox.Contract.Read("getTaskResult", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskResult sets what GetTaskResult returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubGetTaskResult(taskHash [32]byte, out0 []byte) {
+	_FakeITaskMailbox.Contract.Stub("getTaskResult", []interface{}{taskHash}, out0)
+}
+
+// GetTaskStatus returns the values stubbed with StubGetTaskStatus.
+func (_FakeITaskMailbox *FakeITaskMailbox) GetTaskStatus(opts *bind.CallOpts, taskHash [32]byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeITaskMailbox.Contract.Read("getTaskStatus", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskStatus sets what GetTaskStatus returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubGetTaskStatus(taskHash [32]byte, out0 uint8) {
+	_FakeITaskMailbox.Contract.Stub("getTaskStatus", []interface{}{taskHash}, out0)
+}
+
+// IsExecutorOperatorSetRegistered returns the values stubbed with StubIsExecutorOperatorSetRegistered.
+func (_FakeITaskMailbox *FakeITaskMailbox) IsExecutorOperatorSetRegistered(opts *bind.CallOpts, operatorSetKey [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeITaskMailbox.Contract.Read("isExecutorOperatorSetRegistered", []interface{}{operatorSetKey}, &out0)
+	return out0, err
+}
+
+// StubIsExecutorOperatorSetRegistered sets what IsExecutorOperatorSetRegistered returns for the given arguments.
+func (_FakeITaskMailbox *FakeITaskMailbox) StubIsExecutorOperatorSetRegistered(operatorSetKey [32]byte, out0 bool) {
+	_FakeITaskMailbox.Contract.Stub("isExecutorOperatorSetRegistered", []interface{}{operatorSetKey}, out0)
+}
+
+// CreateTask records a createTask call after running the hook set with OnCreateTask.
+func (_FakeITaskMailbox *FakeITaskMailbox) CreateTask(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) (*types.Transaction, error) {
+	return _FakeITaskMailbox.Contract.Transact(opts, "createTask", func() error {
+		if hook, ok := _FakeITaskMailbox.Contract.Hook("createTask").(func(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) error); ok {
+			return hook(opts, taskParams)
+		}
+		return nil
+	}, taskParams)
+}
+
+// OnCreateTask sets a hook run by every CreateTask call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeITaskMailbox *FakeITaskMailbox) OnCreateTask(hook func(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) error) {
+	_FakeITaskMailbox.Contract.SetHook("createTask", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeITaskMailbox *FakeITaskMailbox) Initialize(opts *bind.TransactOpts, owner common.Address, feeSplit uint16, feeSplitCollector common.Address) (*types.Transaction, error) {
+	return _FakeITaskMailbox.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeITaskMailbox.Contract.Hook("initialize").(func(opts *bind.TransactOpts, owner common.Address, feeSplit uint16, feeSplitCollector common.Address) error); ok {
+			return hook(opts, owner, feeSplit, feeSplitCollector)
+		}
+		return nil
+	}, owner, feeSplit, feeSplitCollector)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeITaskMailbox *FakeITaskMailbox) OnInitialize(hook func(opts *bind.TransactOpts, owner common.Address, feeSplit uint16, feeSplitCollector common.Address) error) {
+	_FakeITaskMailbox.Contract.SetHook("initialize", hook)
+}
+
+// RefundFee records a refundFee call after running the hook set with OnRefundFee.
+func (_FakeITaskMailbox *FakeITaskMailbox) RefundFee(opts *bind.TransactOpts, taskHash [32]byte) (*types.Transaction, error) {
+	return _FakeITaskMailbox.Contract.Transact(opts, "refundFee", func() error {
+		if hook, ok := _FakeITaskMailbox.Contract.Hook("refundFee").(func(opts *bind.TransactOpts, taskHash [32]byte) error); ok {
+			return hook(opts, taskHash)
+		}
+		return nil
+	}, taskHash)
+}
+
+// OnRefundFee sets a hook run by every RefundFee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeITaskMailbox *FakeITaskMailbox) OnRefundFee(hook func(opts *bind.TransactOpts, taskHash [32]byte) error) {
+	_FakeITaskMailbox.Contract.SetHook("refundFee", hook)
+}
+
+// RegisterExecutorOperatorSet records a registerExecutorOperatorSet call after running the hook set with OnRegisterExecutorOperatorSet.
+func (_FakeITaskMailbox *FakeITaskMailbox) RegisterExecutorOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) (*types.Transaction, error) {
+	return _FakeITaskMailbox.Contract.Transact(opts, "registerExecutorOperatorSet", func() error {
+		if hook, ok := _FakeITaskMailbox.Contract.Hook("registerExecutorOperatorSet").(func(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) error); ok {
+			return hook(opts, operatorSet, isRegistered)
+		}
+		return nil
+	}, operatorSet, isRegistered)
+}
+
+// OnRegisterExecutorOperatorSet sets a hook run by every RegisterExecutorOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeITaskMailbox *FakeITaskMailbox) OnRegisterExecutorOperatorSet(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) error) {
+	_FakeITaskMailbox.Contract.SetHook("registerExecutorOperatorSet", hook)
+}
+
+// SetExecutorOperatorSetTaskConfig records a setExecutorOperatorSetTaskConfig call after running the hook set with OnSetExecutorOperatorSetTaskConfig.
+func (_FakeITaskMailbox *FakeITaskMailbox) SetExecutorOperatorSetTaskConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) (*types.Transaction, error) {
+	return _FakeITaskMailbox.Contract.Transact(opts, "setExecutorOperatorSetTaskConfig", func() error {
+		if hook, ok := _FakeITaskMailbox.Contract.Hook("setExecutorOperatorSetTaskConfig").(func(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) error); ok {
+			return hook(opts, operatorSet, config)
+		}
+		return nil
+	}, operatorSet, config)
+}
+
+// OnSetExecutorOperatorSetTaskConfig sets a hook run by every SetExecutorOperatorSetTaskConfig call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeITaskMailbox *FakeITaskMailbox) OnSetExecutorOperatorSetTaskConfig(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) error) {
+	_FakeITaskMailbox.Contract.SetHook("setExecutorOperatorSetTaskConfig", hook)
+}
+
+// SetFeeSplit records a setFeeSplit call after running the hook set with OnSetFeeSplit.
+func (_FakeITaskMailbox *FakeITaskMailbox) SetFeeSplit(opts *bind.TransactOpts, feeSplit uint16) (*types.Transaction, error) {
+	return _FakeITaskMailbox.Contract.Transact(opts, "setFeeSplit", func() error {
+		if hook, ok := _FakeITaskMailbox.Contract.Hook("setFeeSplit").(func(opts *bind.TransactOpts, feeSplit uint16) error); ok {
+			return hook(opts, feeSplit)
+		}
+		return nil
+	}, feeSplit)
+}
+
+// OnSetFeeSplit sets a hook run by every SetFeeSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeITaskMailbox *FakeITaskMailbox) OnSetFeeSplit(hook func(opts *bind.TransactOpts, feeSplit uint16) error) {
+	_FakeITaskMailbox.Contract.SetHook("setFeeSplit", hook)
+}
+
+// SetFeeSplitCollector records a setFeeSplitCollector call after running the hook set with OnSetFeeSplitCollector.
+func (_FakeITaskMailbox *FakeITaskMailbox) SetFeeSplitCollector(opts *bind.TransactOpts, feeSplitCollector common.Address) (*types.Transaction, error) {
+	return _FakeITaskMailbox.Contract.Transact(opts, "setFeeSplitCollector", func() error {
+		if hook, ok := _FakeITaskMailbox.Contract.Hook("setFeeSplitCollector").(func(opts *bind.TransactOpts, feeSplitCollector common.Address) error); ok {
+			return hook(opts, feeSplitCollector)
+		}
+		return nil
+	}, feeSplitCollector)
+}
+
+// OnSetFeeSplitCollector sets a hook run by every SetFeeSplitCollector call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeITaskMailbox *FakeITaskMailbox) OnSetFeeSplitCollector(hook func(opts *bind.TransactOpts, feeSplitCollector common.Address) error) {
+	_FakeITaskMailbox.Contract.SetHook("setFeeSplitCollector", hook)
+}
+
+// SubmitResult records a submitResult call after running the hook set with OnSubmitResult.
+func (_FakeITaskMailbox *FakeITaskMailbox) SubmitResult(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) (*types.Transaction, error) {
+	return _FakeITaskMailbox.Contract.Transact(opts, "submitResult", func() error {
+		if hook, ok := _FakeITaskMailbox.Contract.Hook("submitResult").(func(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) error); ok {
+			return hook(opts, taskHash, executorCert, result)
+		}
+		return nil
+	}, taskHash, executorCert, result)
+}
+
+// OnSubmitResult sets a hook run by every SubmitResult call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeITaskMailbox *FakeITaskMailbox) OnSubmitResult(hook func(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) error) {
+	_FakeITaskMailbox.Contract.SetHook("submitResult", hook)
+}
+
+// EmitExecutorOperatorSetRegistered appends the event to the fake's logs.
+func (_FakeITaskMailbox *FakeITaskMailbox) EmitExecutorOperatorSetRegistered(event *ITaskMailboxExecutorOperatorSetRegistered) {
+	_FakeITaskMailbox.Contract.Emit("ExecutorOperatorSetRegistered", event)
+}
+
+// EmitExecutorOperatorSetTaskConfigSet appends the event to the fake's logs.
+func (_FakeITaskMailbox *FakeITaskMailbox) EmitExecutorOperatorSetTaskConfigSet(event *ITaskMailboxExecutorOperatorSetTaskConfigSet) {
+	_FakeITaskMailbox.Contract.Emit("ExecutorOperatorSetTaskConfigSet", event)
+}
+
+// EmitFeeRefunded appends the event to the fake's logs.
+func (_FakeITaskMailbox *FakeITaskMailbox) EmitFeeRefunded(event *ITaskMailboxFeeRefunded) {
+	_FakeITaskMailbox.Contract.Emit("FeeRefunded", event)
+}
+
+// EmitFeeSplitCollectorSet appends the event to the fake's logs.
+func (_FakeITaskMailbox *FakeITaskMailbox) EmitFeeSplitCollectorSet(event *ITaskMailboxFeeSplitCollectorSet) {
+	_FakeITaskMailbox.Contract.Emit("FeeSplitCollectorSet", event)
+}
+
+// EmitFeeSplitSet appends the event to the fake's logs.
+func (_FakeITaskMailbox *FakeITaskMailbox) EmitFeeSplitSet(event *ITaskMailboxFeeSplitSet) {
+	_FakeITaskMailbox.Contract.Emit("FeeSplitSet", event)
+}
+
+// EmitTaskCreated appends the event to the fake's logs.
+func (_FakeITaskMailbox *FakeITaskMailbox) EmitTaskCreated(event *ITaskMailboxTaskCreated) {
+	_FakeITaskMailbox.Contract.Emit("TaskCreated", event)
+}
+
+// EmitTaskVerified appends the event to the fake's logs.
+func (_FakeITaskMailbox *FakeITaskMailbox) EmitTaskVerified(event *ITaskMailboxTaskVerified) {
+	_FakeITaskMailbox.Contract.Emit("TaskVerified", event)
+}
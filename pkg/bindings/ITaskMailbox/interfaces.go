@@ -0,0 +1,80 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ITaskMailbox
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// ITaskMailboxReader is the read-only surface of the ITaskMailbox
+// binding, implemented by ITaskMailboxCaller, ITaskMailbox and FakeITaskMailbox.
+type ITaskMailboxReader interface {
+	BN254CERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error)
+	ECDSACERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error)
+	MAXTASKSLA(opts *bind.CallOpts) (*big.Int, error)
+	FeeSplit(opts *bind.CallOpts) (uint16, error)
+	FeeSplitCollector(opts *bind.CallOpts) (common.Address, error)
+	GetBN254CertificateBytes(opts *bind.CallOpts, cert IBN254CertificateVerifierTypesBN254Certificate) ([]byte, error)
+	GetECDSACertificateBytes(opts *bind.CallOpts, cert IECDSACertificateVerifierTypesECDSACertificate) ([]byte, error)
+	GetExecutorOperatorSetTaskConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ITaskMailboxTypesExecutorOperatorSetTaskConfig, error)
+	GetMessageHash(opts *bind.CallOpts, taskHash [32]byte, result []byte) ([32]byte, error)
+	GetTaskInfo(opts *bind.CallOpts, taskHash [32]byte) (ITaskMailboxTypesTask, error)
+	GetTaskResult(opts *bind.CallOpts, taskHash [32]byte) ([]byte, error)
+	GetTaskStatus(opts *bind.CallOpts, taskHash [32]byte) (uint8, error)
+	IsExecutorOperatorSetRegistered(opts *bind.CallOpts, operatorSetKey [32]byte) (bool, error)
+}
+
+// ITaskMailboxWriter is the transacting surface of the ITaskMailbox
+// binding, implemented by ITaskMailboxTransactor, ITaskMailbox and FakeITaskMailbox.
+type ITaskMailboxWriter interface {
+	CreateTask(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, owner common.Address, feeSplit uint16, feeSplitCollector common.Address) (*types.Transaction, error)
+	RefundFee(opts *bind.TransactOpts, taskHash [32]byte) (*types.Transaction, error)
+	RegisterExecutorOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) (*types.Transaction, error)
+	SetExecutorOperatorSetTaskConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) (*types.Transaction, error)
+	SetFeeSplit(opts *bind.TransactOpts, feeSplit uint16) (*types.Transaction, error)
+	SetFeeSplitCollector(opts *bind.TransactOpts, feeSplitCollector common.Address) (*types.Transaction, error)
+	SubmitResult(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) (*types.Transaction, error)
+}
+
+// ITaskMailboxEvents is the event filtering surface of the ITaskMailbox
+// binding, implemented by ITaskMailboxFilterer, ITaskMailbox and FakeITaskMailbox.
+type ITaskMailboxEvents interface {
+	FilterExecutorOperatorSetRegistered(opts *bind.FilterOpts, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (*ITaskMailboxExecutorOperatorSetRegisteredIterator, error)
+	WatchExecutorOperatorSetRegistered(opts *bind.WatchOpts, sink chan<- *ITaskMailboxExecutorOperatorSetRegistered, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (event.Subscription, error)
+	ParseExecutorOperatorSetRegistered(log types.Log) (*ITaskMailboxExecutorOperatorSetRegistered, error)
+	FilterExecutorOperatorSetTaskConfigSet(opts *bind.FilterOpts, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (*ITaskMailboxExecutorOperatorSetTaskConfigSetIterator, error)
+	WatchExecutorOperatorSetTaskConfigSet(opts *bind.WatchOpts, sink chan<- *ITaskMailboxExecutorOperatorSetTaskConfigSet, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (event.Subscription, error)
+	ParseExecutorOperatorSetTaskConfigSet(log types.Log) (*ITaskMailboxExecutorOperatorSetTaskConfigSet, error)
+	FilterFeeRefunded(opts *bind.FilterOpts, refundCollector []common.Address, taskHash [][32]byte) (*ITaskMailboxFeeRefundedIterator, error)
+	WatchFeeRefunded(opts *bind.WatchOpts, sink chan<- *ITaskMailboxFeeRefunded, refundCollector []common.Address, taskHash [][32]byte) (event.Subscription, error)
+	ParseFeeRefunded(log types.Log) (*ITaskMailboxFeeRefunded, error)
+	FilterFeeSplitCollectorSet(opts *bind.FilterOpts, feeSplitCollector []common.Address) (*ITaskMailboxFeeSplitCollectorSetIterator, error)
+	WatchFeeSplitCollectorSet(opts *bind.WatchOpts, sink chan<- *ITaskMailboxFeeSplitCollectorSet, feeSplitCollector []common.Address) (event.Subscription, error)
+	ParseFeeSplitCollectorSet(log types.Log) (*ITaskMailboxFeeSplitCollectorSet, error)
+	FilterFeeSplitSet(opts *bind.FilterOpts) (*ITaskMailboxFeeSplitSetIterator, error)
+	WatchFeeSplitSet(opts *bind.WatchOpts, sink chan<- *ITaskMailboxFeeSplitSet) (event.Subscription, error)
+	ParseFeeSplitSet(log types.Log) (*ITaskMailboxFeeSplitSet, error)
+	FilterTaskCreated(opts *bind.FilterOpts, creator []common.Address, taskHash [][32]byte, avs []common.Address) (*ITaskMailboxTaskCreatedIterator, error)
+	WatchTaskCreated(opts *bind.WatchOpts, sink chan<- *ITaskMailboxTaskCreated, creator []common.Address, taskHash [][32]byte, avs []common.Address) (event.Subscription, error)
+	ParseTaskCreated(log types.Log) (*ITaskMailboxTaskCreated, error)
+	FilterTaskVerified(opts *bind.FilterOpts, aggregator []common.Address, taskHash [][32]byte, avs []common.Address) (*ITaskMailboxTaskVerifiedIterator, error)
+	WatchTaskVerified(opts *bind.WatchOpts, sink chan<- *ITaskMailboxTaskVerified, aggregator []common.Address, taskHash [][32]byte, avs []common.Address) (event.Subscription, error)
+	ParseTaskVerified(log types.Log) (*ITaskMailboxTaskVerified, error)
+}
+
+var (
+	_ ITaskMailboxReader = (*ITaskMailboxCaller)(nil)
+	_ ITaskMailboxReader = (*ITaskMailbox)(nil)
+	_ ITaskMailboxReader = (*FakeITaskMailbox)(nil)
+	_ ITaskMailboxWriter = (*ITaskMailboxTransactor)(nil)
+	_ ITaskMailboxWriter = (*ITaskMailbox)(nil)
+	_ ITaskMailboxWriter = (*FakeITaskMailbox)(nil)
+	_ ITaskMailboxEvents = (*ITaskMailboxFilterer)(nil)
+	_ ITaskMailboxEvents = (*ITaskMailbox)(nil)
+	_ ITaskMailboxEvents = (*FakeITaskMailbox)(nil)
+)
@@ -0,0 +1,200 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package KeyRegistrar
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrConfigurationAlreadySet is the typed form of the KeyRegistrar custom error ConfigurationAlreadySet().
+type ErrConfigurationAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrConfigurationAlreadySet) Error() string {
+	return "KeyRegistrar: ConfigurationAlreadySet()"
+}
+
+// ErrECAddFailed is the typed form of the KeyRegistrar custom error ECAddFailed().
+type ErrECAddFailed struct{}
+
+// Error implements the error interface.
+func (ErrECAddFailed) Error() string {
+	return "KeyRegistrar: ECAddFailed()"
+}
+
+// ErrECMulFailed is the typed form of the KeyRegistrar custom error ECMulFailed().
+type ErrECMulFailed struct{}
+
+// Error implements the error interface.
+func (ErrECMulFailed) Error() string {
+	return "KeyRegistrar: ECMulFailed()"
+}
+
+// ErrECPairingFailed is the typed form of the KeyRegistrar custom error ECPairingFailed().
+type ErrECPairingFailed struct{}
+
+// Error implements the error interface.
+func (ErrECPairingFailed) Error() string {
+	return "KeyRegistrar: ECPairingFailed()"
+}
+
+// ErrExpModFailed is the typed form of the KeyRegistrar custom error ExpModFailed().
+type ErrExpModFailed struct{}
+
+// Error implements the error interface.
+func (ErrExpModFailed) Error() string {
+	return "KeyRegistrar: ExpModFailed()"
+}
+
+// ErrInvalidCurveType is the typed form of the KeyRegistrar custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "KeyRegistrar: InvalidCurveType()"
+}
+
+// ErrInvalidKeyFormat is the typed form of the KeyRegistrar custom error InvalidKeyFormat().
+type ErrInvalidKeyFormat struct{}
+
+// Error implements the error interface.
+func (ErrInvalidKeyFormat) Error() string {
+	return "KeyRegistrar: InvalidKeyFormat()"
+}
+
+// ErrInvalidKeypair is the typed form of the KeyRegistrar custom error InvalidKeypair().
+type ErrInvalidKeypair struct{}
+
+// Error implements the error interface.
+func (ErrInvalidKeypair) Error() string {
+	return "KeyRegistrar: InvalidKeypair()"
+}
+
+// ErrInvalidPermissions is the typed form of the KeyRegistrar custom error InvalidPermissions().
+type ErrInvalidPermissions struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPermissions) Error() string {
+	return "KeyRegistrar: InvalidPermissions()"
+}
+
+// ErrInvalidShortString is the typed form of the KeyRegistrar custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "KeyRegistrar: InvalidShortString()"
+}
+
+// ErrInvalidSignature is the typed form of the KeyRegistrar custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "KeyRegistrar: InvalidSignature()"
+}
+
+// ErrKeyAlreadyRegistered is the typed form of the KeyRegistrar custom error KeyAlreadyRegistered().
+type ErrKeyAlreadyRegistered struct{}
+
+// Error implements the error interface.
+func (ErrKeyAlreadyRegistered) Error() string {
+	return "KeyRegistrar: KeyAlreadyRegistered()"
+}
+
+// ErrKeyNotFound is the typed form of the KeyRegistrar custom error KeyNotFound((address,uint32),address).
+type ErrKeyNotFound struct {
+	OperatorSet OperatorSet
+	Operator    common.Address
+}
+
+// Error implements the error interface.
+func (e ErrKeyNotFound) Error() string {
+	return fmt.Sprintf("KeyRegistrar: KeyNotFound(operatorSet: %v, operator: %v)", e.OperatorSet, e.Operator)
+}
+
+// ErrOperatorAlreadyRegistered is the typed form of the KeyRegistrar custom error OperatorAlreadyRegistered().
+type ErrOperatorAlreadyRegistered struct{}
+
+// Error implements the error interface.
+func (ErrOperatorAlreadyRegistered) Error() string {
+	return "KeyRegistrar: OperatorAlreadyRegistered()"
+}
+
+// ErrOperatorSetNotConfigured is the typed form of the KeyRegistrar custom error OperatorSetNotConfigured().
+type ErrOperatorSetNotConfigured struct{}
+
+// Error implements the error interface.
+func (ErrOperatorSetNotConfigured) Error() string {
+	return "KeyRegistrar: OperatorSetNotConfigured()"
+}
+
+// ErrOperatorStillSlashable is the typed form of the KeyRegistrar custom error OperatorStillSlashable((address,uint32),address).
+type ErrOperatorStillSlashable struct {
+	OperatorSet OperatorSet
+	Operator    common.Address
+}
+
+// Error implements the error interface.
+func (e ErrOperatorStillSlashable) Error() string {
+	return fmt.Sprintf("KeyRegistrar: OperatorStillSlashable(operatorSet: %v, operator: %v)", e.OperatorSet, e.Operator)
+}
+
+// ErrSignatureExpired is the typed form of the KeyRegistrar custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "KeyRegistrar: SignatureExpired()"
+}
+
+// ErrStringTooLong is the typed form of the KeyRegistrar custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("KeyRegistrar: StringTooLong(str: %v)", e.Str)
+}
+
+// ErrZeroAddress is the typed form of the KeyRegistrar custom error ZeroAddress().
+type ErrZeroAddress struct{}
+
+// Error implements the error interface.
+func (ErrZeroAddress) Error() string {
+	return "KeyRegistrar: ZeroAddress()"
+}
+
+// ErrZeroPubkey is the typed form of the KeyRegistrar custom error ZeroPubkey().
+type ErrZeroPubkey struct{}
+
+// Error implements the error interface.
+func (ErrZeroPubkey) Error() string {
+	return "KeyRegistrar: ZeroPubkey()"
+}
+
+// KeyRegistrarErrors maps the name of every custom error in the KeyRegistrar ABI to its typed Go error.
+var KeyRegistrarErrors = map[string]error{
+	"ConfigurationAlreadySet":   ErrConfigurationAlreadySet{},
+	"ECAddFailed":               ErrECAddFailed{},
+	"ECMulFailed":               ErrECMulFailed{},
+	"ECPairingFailed":           ErrECPairingFailed{},
+	"ExpModFailed":              ErrExpModFailed{},
+	"InvalidCurveType":          ErrInvalidCurveType{},
+	"InvalidKeyFormat":          ErrInvalidKeyFormat{},
+	"InvalidKeypair":            ErrInvalidKeypair{},
+	"InvalidPermissions":        ErrInvalidPermissions{},
+	"InvalidShortString":        ErrInvalidShortString{},
+	"InvalidSignature":          ErrInvalidSignature{},
+	"KeyAlreadyRegistered":      ErrKeyAlreadyRegistered{},
+	"KeyNotFound":               ErrKeyNotFound{},
+	"OperatorAlreadyRegistered": ErrOperatorAlreadyRegistered{},
+	"OperatorSetNotConfigured":  ErrOperatorSetNotConfigured{},
+	"OperatorStillSlashable":    ErrOperatorStillSlashable{},
+	"SignatureExpired":          ErrSignatureExpired{},
+	"StringTooLong":             ErrStringTooLong{},
+	"ZeroAddress":               ErrZeroAddress{},
+	"ZeroPubkey":                ErrZeroPubkey{},
+}
@@ -0,0 +1,311 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package KeyRegistrar
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeKeyRegistrar is an in-memory KeyRegistrar for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded KeyRegistrarFilterer.
+type FakeKeyRegistrar struct {
+	*fake.Contract
+	*KeyRegistrarFilterer
+}
+
+// NewFakeKeyRegistrar returns an empty fake of the contract at address.
+func NewFakeKeyRegistrar(address common.Address) *FakeKeyRegistrar {
+	contract := fake.NewContract(KeyRegistrarMetaData, address)
+	filterer, err := NewKeyRegistrarFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeKeyRegistrar{Contract: contract, KeyRegistrarFilterer: filterer}
+}
+
+// BN254KEYREGISTRATIONTYPEHASH returns the values stubbed with StubBN254KEYREGISTRATIONTYPEHASH.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) BN254KEYREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrar.Contract.Read("BN254_KEY_REGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBN254KEYREGISTRATIONTYPEHASH sets what BN254KEYREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubBN254KEYREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeKeyRegistrar.Contract.Stub("BN254_KEY_REGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// ECDSAKEYREGISTRATIONTYPEHASH returns the values stubbed with StubECDSAKEYREGISTRATIONTYPEHASH.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) ECDSAKEYREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrar.Contract.Read("ECDSA_KEY_REGISTRATION_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubECDSAKEYREGISTRATIONTYPEHASH sets what ECDSAKEYREGISTRATIONTYPEHASH returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubECDSAKEYREGISTRATIONTYPEHASH(out0 [32]byte) {
+	_FakeKeyRegistrar.Contract.Stub("ECDSA_KEY_REGISTRATION_TYPEHASH", []interface{}{}, out0)
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeKeyRegistrar.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubAllocationManager(out0 common.Address) {
+	_FakeKeyRegistrar.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrar.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubDomainSeparator(out0 [32]byte) {
+	_FakeKeyRegistrar.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// EncodeBN254KeyData returns the values stubbed with StubEncodeBN254KeyData.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) EncodeBN254KeyData(opts *bind.CallOpts, g1Point BN254G1Point, g2Point BN254G2Point) ([]byte, error) {
+	var out0 []byte
+	err := _FakeKeyRegistrar.Contract.Read("encodeBN254KeyData", []interface{}{g1Point, g2Point}, &out0)
+	return out0, err
+}
+
+// StubEncodeBN254KeyData sets what EncodeBN254KeyData returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubEncodeBN254KeyData(g1Point BN254G1Point, g2Point BN254G2Point, out0 []byte) {
+	_FakeKeyRegistrar.Contract.Stub("encodeBN254KeyData", []interface{}{g1Point, g2Point}, out0)
+}
+
+// GetBN254Key returns the values stubbed with StubGetBN254Key.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) GetBN254Key(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (struct {
+	G1Point BN254G1Point
+	G2Point BN254G2Point
+}, error) {
+	var out0 struct {
+		G1Point BN254G1Point
+		G2Point BN254G2Point
+	}
+	err := _FakeKeyRegistrar.Contract.Read("getBN254Key", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetBN254Key sets what GetBN254Key returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubGetBN254Key(operatorSet OperatorSet, operator common.Address, out0 struct {
+	G1Point BN254G1Point
+	G2Point BN254G2Point
+}) {
+	_FakeKeyRegistrar.Contract.Stub("getBN254Key", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetBN254KeyRegistrationMessageHash returns the values stubbed with StubGetBN254KeyRegistrationMessageHash.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) GetBN254KeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyData []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrar.Contract.Read("getBN254KeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyData}, &out0)
+	return out0, err
+}
+
+// StubGetBN254KeyRegistrationMessageHash sets what GetBN254KeyRegistrationMessageHash returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubGetBN254KeyRegistrationMessageHash(operator common.Address, operatorSet OperatorSet, keyData []byte, out0 [32]byte) {
+	_FakeKeyRegistrar.Contract.Stub("getBN254KeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyData}, out0)
+}
+
+// GetECDSAAddress returns the values stubbed with StubGetECDSAAddress.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) GetECDSAAddress(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeKeyRegistrar.Contract.Read("getECDSAAddress", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAAddress sets what GetECDSAAddress returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubGetECDSAAddress(operatorSet OperatorSet, operator common.Address, out0 common.Address) {
+	_FakeKeyRegistrar.Contract.Stub("getECDSAAddress", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetECDSAKey returns the values stubbed with StubGetECDSAKey.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) GetECDSAKey(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([]byte, error) {
+	var out0 []byte
+	err := _FakeKeyRegistrar.Contract.Read("getECDSAKey", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAKey sets what GetECDSAKey returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubGetECDSAKey(operatorSet OperatorSet, operator common.Address, out0 []byte) {
+	_FakeKeyRegistrar.Contract.Stub("getECDSAKey", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetECDSAKeyRegistrationMessageHash returns the values stubbed with StubGetECDSAKeyRegistrationMessageHash.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) GetECDSAKeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyAddress common.Address) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrar.Contract.Read("getECDSAKeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyAddress}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAKeyRegistrationMessageHash sets what GetECDSAKeyRegistrationMessageHash returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubGetECDSAKeyRegistrationMessageHash(operator common.Address, operatorSet OperatorSet, keyAddress common.Address, out0 [32]byte) {
+	_FakeKeyRegistrar.Contract.Stub("getECDSAKeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyAddress}, out0)
+}
+
+// GetKeyHash returns the values stubbed with StubGetKeyHash.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) GetKeyHash(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrar.Contract.Read("getKeyHash", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetKeyHash sets what GetKeyHash returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubGetKeyHash(operatorSet OperatorSet, operator common.Address, out0 [32]byte) {
+	_FakeKeyRegistrar.Contract.Stub("getKeyHash", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetOperatorFromSigningKey returns the values stubbed with StubGetOperatorFromSigningKey.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) GetOperatorFromSigningKey(opts *bind.CallOpts, operatorSet OperatorSet, keyData []byte) (common.Address, bool, error) {
+	var out0 common.Address
+	var out1 bool
+	err := _FakeKeyRegistrar.Contract.Read("getOperatorFromSigningKey", []interface{}{operatorSet, keyData}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetOperatorFromSigningKey sets what GetOperatorFromSigningKey returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubGetOperatorFromSigningKey(operatorSet OperatorSet, keyData []byte, out0 common.Address, out1 bool) {
+	_FakeKeyRegistrar.Contract.Stub("getOperatorFromSigningKey", []interface{}{operatorSet, keyData}, out0, out1)
+}
+
+// GetOperatorSetCurveType returns the values stubbed with StubGetOperatorSetCurveType.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) GetOperatorSetCurveType(opts *bind.CallOpts, operatorSet OperatorSet) (uint8, error) {
+	var out0 uint8
+	err := _FakeKeyRegistrar.Contract.Read("getOperatorSetCurveType", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetCurveType sets what GetOperatorSetCurveType returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubGetOperatorSetCurveType(operatorSet OperatorSet, out0 uint8) {
+	_FakeKeyRegistrar.Contract.Stub("getOperatorSetCurveType", []interface{}{operatorSet}, out0)
+}
+
+// IsKeyGloballyRegistered returns the values stubbed with StubIsKeyGloballyRegistered.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) IsKeyGloballyRegistered(opts *bind.CallOpts, keyHash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeKeyRegistrar.Contract.Read("isKeyGloballyRegistered", []interface{}{keyHash}, &out0)
+	return out0, err
+}
+
+// StubIsKeyGloballyRegistered sets what IsKeyGloballyRegistered returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubIsKeyGloballyRegistered(keyHash [32]byte, out0 bool) {
+	_FakeKeyRegistrar.Contract.Stub("isKeyGloballyRegistered", []interface{}{keyHash}, out0)
+}
+
+// IsRegistered returns the values stubbed with StubIsRegistered.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) IsRegistered(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeKeyRegistrar.Contract.Read("isRegistered", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubIsRegistered sets what IsRegistered returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubIsRegistered(operatorSet OperatorSet, operator common.Address, out0 bool) {
+	_FakeKeyRegistrar.Contract.Stub("isRegistered", []interface{}{operatorSet, operator}, out0)
+}
+
+// PermissionController returns the values stubbed with StubPermissionController.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) PermissionController(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeKeyRegistrar.Contract.Read("permissionController", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPermissionController sets what PermissionController returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubPermissionController(out0 common.Address) {
+	_FakeKeyRegistrar.Contract.Stub("permissionController", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeKeyRegistrar.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) StubVersion(out0 string) {
+	_FakeKeyRegistrar.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// ConfigureOperatorSet records a configureOperatorSet call after running the hook set with OnConfigureOperatorSet.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) ConfigureOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) (*types.Transaction, error) {
+	return _FakeKeyRegistrar.Contract.Transact(opts, "configureOperatorSet", func() error {
+		if hook, ok := _FakeKeyRegistrar.Contract.Hook("configureOperatorSet").(func(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) error); ok {
+			return hook(opts, operatorSet, curveType)
+		}
+		return nil
+	}, operatorSet, curveType)
+}
+
+// OnConfigureOperatorSet sets a hook run by every ConfigureOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) OnConfigureOperatorSet(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) error) {
+	_FakeKeyRegistrar.Contract.SetHook("configureOperatorSet", hook)
+}
+
+// DeregisterKey records a deregisterKey call after running the hook set with OnDeregisterKey.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) DeregisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) (*types.Transaction, error) {
+	return _FakeKeyRegistrar.Contract.Transact(opts, "deregisterKey", func() error {
+		if hook, ok := _FakeKeyRegistrar.Contract.Hook("deregisterKey").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) error); ok {
+			return hook(opts, operator, operatorSet)
+		}
+		return nil
+	}, operator, operatorSet)
+}
+
+// OnDeregisterKey sets a hook run by every DeregisterKey call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) OnDeregisterKey(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) error) {
+	_FakeKeyRegistrar.Contract.SetHook("deregisterKey", hook)
+}
+
+// RegisterKey records a registerKey call after running the hook set with OnRegisterKey.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) RegisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, keyData []byte, signature []byte) (*types.Transaction, error) {
+	return _FakeKeyRegistrar.Contract.Transact(opts, "registerKey", func() error {
+		if hook, ok := _FakeKeyRegistrar.Contract.Hook("registerKey").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, keyData []byte, signature []byte) error); ok {
+			return hook(opts, operator, operatorSet, keyData, signature)
+		}
+		return nil
+	}, operator, operatorSet, keyData, signature)
+}
+
+// OnRegisterKey sets a hook run by every RegisterKey call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) OnRegisterKey(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, keyData []byte, signature []byte) error) {
+	_FakeKeyRegistrar.Contract.SetHook("registerKey", hook)
+}
+
+// EmitAggregateBN254KeyUpdated appends the event to the fake's logs.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) EmitAggregateBN254KeyUpdated(event *KeyRegistrarAggregateBN254KeyUpdated) {
+	_FakeKeyRegistrar.Contract.Emit("AggregateBN254KeyUpdated", event)
+}
+
+// EmitKeyDeregistered appends the event to the fake's logs.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) EmitKeyDeregistered(event *KeyRegistrarKeyDeregistered) {
+	_FakeKeyRegistrar.Contract.Emit("KeyDeregistered", event)
+}
+
+// EmitKeyRegistered appends the event to the fake's logs.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) EmitKeyRegistered(event *KeyRegistrarKeyRegistered) {
+	_FakeKeyRegistrar.Contract.Emit("KeyRegistered", event)
+}
+
+// EmitOperatorSetConfigured appends the event to the fake's logs.
+func (_FakeKeyRegistrar *FakeKeyRegistrar) EmitOperatorSetConfigured(event *KeyRegistrarOperatorSetConfigured) {
+	_FakeKeyRegistrar.Contract.Emit("OperatorSetConfigured", event)
+}
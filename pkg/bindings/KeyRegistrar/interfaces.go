@@ -0,0 +1,72 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package KeyRegistrar
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// KeyRegistrarReader is the read-only surface of the KeyRegistrar
+// binding, implemented by KeyRegistrarCaller, KeyRegistrar and FakeKeyRegistrar.
+type KeyRegistrarReader interface {
+	BN254KEYREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	ECDSAKEYREGISTRATIONTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	AllocationManager(opts *bind.CallOpts) (common.Address, error)
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	EncodeBN254KeyData(opts *bind.CallOpts, g1Point BN254G1Point, g2Point BN254G2Point) ([]byte, error)
+	GetBN254Key(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (struct {
+		G1Point BN254G1Point
+		G2Point BN254G2Point
+	}, error)
+	GetBN254KeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyData []byte) ([32]byte, error)
+	GetECDSAAddress(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (common.Address, error)
+	GetECDSAKey(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([]byte, error)
+	GetECDSAKeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyAddress common.Address) ([32]byte, error)
+	GetKeyHash(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([32]byte, error)
+	GetOperatorFromSigningKey(opts *bind.CallOpts, operatorSet OperatorSet, keyData []byte) (common.Address, bool, error)
+	GetOperatorSetCurveType(opts *bind.CallOpts, operatorSet OperatorSet) (uint8, error)
+	IsKeyGloballyRegistered(opts *bind.CallOpts, keyHash [32]byte) (bool, error)
+	IsRegistered(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (bool, error)
+	PermissionController(opts *bind.CallOpts) (common.Address, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+// KeyRegistrarWriter is the transacting surface of the KeyRegistrar
+// binding, implemented by KeyRegistrarTransactor, KeyRegistrar and FakeKeyRegistrar.
+type KeyRegistrarWriter interface {
+	ConfigureOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) (*types.Transaction, error)
+	DeregisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) (*types.Transaction, error)
+	RegisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, keyData []byte, signature []byte) (*types.Transaction, error)
+}
+
+// KeyRegistrarEvents is the event filtering surface of the KeyRegistrar
+// binding, implemented by KeyRegistrarFilterer, KeyRegistrar and FakeKeyRegistrar.
+type KeyRegistrarEvents interface {
+	FilterAggregateBN254KeyUpdated(opts *bind.FilterOpts) (*KeyRegistrarAggregateBN254KeyUpdatedIterator, error)
+	WatchAggregateBN254KeyUpdated(opts *bind.WatchOpts, sink chan<- *KeyRegistrarAggregateBN254KeyUpdated) (event.Subscription, error)
+	ParseAggregateBN254KeyUpdated(log types.Log) (*KeyRegistrarAggregateBN254KeyUpdated, error)
+	FilterKeyDeregistered(opts *bind.FilterOpts, operator []common.Address) (*KeyRegistrarKeyDeregisteredIterator, error)
+	WatchKeyDeregistered(opts *bind.WatchOpts, sink chan<- *KeyRegistrarKeyDeregistered, operator []common.Address) (event.Subscription, error)
+	ParseKeyDeregistered(log types.Log) (*KeyRegistrarKeyDeregistered, error)
+	FilterKeyRegistered(opts *bind.FilterOpts, operator []common.Address) (*KeyRegistrarKeyRegisteredIterator, error)
+	WatchKeyRegistered(opts *bind.WatchOpts, sink chan<- *KeyRegistrarKeyRegistered, operator []common.Address) (event.Subscription, error)
+	ParseKeyRegistered(log types.Log) (*KeyRegistrarKeyRegistered, error)
+	FilterOperatorSetConfigured(opts *bind.FilterOpts) (*KeyRegistrarOperatorSetConfiguredIterator, error)
+	WatchOperatorSetConfigured(opts *bind.WatchOpts, sink chan<- *KeyRegistrarOperatorSetConfigured) (event.Subscription, error)
+	ParseOperatorSetConfigured(log types.Log) (*KeyRegistrarOperatorSetConfigured, error)
+}
+
+var (
+	_ KeyRegistrarReader = (*KeyRegistrarCaller)(nil)
+	_ KeyRegistrarReader = (*KeyRegistrar)(nil)
+	_ KeyRegistrarReader = (*FakeKeyRegistrar)(nil)
+	_ KeyRegistrarWriter = (*KeyRegistrarTransactor)(nil)
+	_ KeyRegistrarWriter = (*KeyRegistrar)(nil)
+	_ KeyRegistrarWriter = (*FakeKeyRegistrar)(nil)
+	_ KeyRegistrarEvents = (*KeyRegistrarFilterer)(nil)
+	_ KeyRegistrarEvents = (*KeyRegistrar)(nil)
+	_ KeyRegistrarEvents = (*FakeKeyRegistrar)(nil)
+)
@@ -0,0 +1,117 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package KeyRegistrarStorage
+
+import (
+	"fmt"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrConfigurationAlreadySet is the typed form of the KeyRegistrarStorage custom error ConfigurationAlreadySet().
+type ErrConfigurationAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrConfigurationAlreadySet) Error() string {
+	return "KeyRegistrarStorage: ConfigurationAlreadySet()"
+}
+
+// ErrInvalidCurveType is the typed form of the KeyRegistrarStorage custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "KeyRegistrarStorage: InvalidCurveType()"
+}
+
+// ErrInvalidKeyFormat is the typed form of the KeyRegistrarStorage custom error InvalidKeyFormat().
+type ErrInvalidKeyFormat struct{}
+
+// Error implements the error interface.
+func (ErrInvalidKeyFormat) Error() string {
+	return "KeyRegistrarStorage: InvalidKeyFormat()"
+}
+
+// ErrInvalidKeypair is the typed form of the KeyRegistrarStorage custom error InvalidKeypair().
+type ErrInvalidKeypair struct{}
+
+// Error implements the error interface.
+func (ErrInvalidKeypair) Error() string {
+	return "KeyRegistrarStorage: InvalidKeypair()"
+}
+
+// ErrKeyAlreadyRegistered is the typed form of the KeyRegistrarStorage custom error KeyAlreadyRegistered().
+type ErrKeyAlreadyRegistered struct{}
+
+// Error implements the error interface.
+func (ErrKeyAlreadyRegistered) Error() string {
+	return "KeyRegistrarStorage: KeyAlreadyRegistered()"
+}
+
+// ErrKeyNotFound is the typed form of the KeyRegistrarStorage custom error KeyNotFound((address,uint32),address).
+type ErrKeyNotFound struct {
+	OperatorSet OperatorSet
+	Operator    common.Address
+}
+
+// Error implements the error interface.
+func (e ErrKeyNotFound) Error() string {
+	return fmt.Sprintf("KeyRegistrarStorage: KeyNotFound(operatorSet: %v, operator: %v)", e.OperatorSet, e.Operator)
+}
+
+// ErrOperatorAlreadyRegistered is the typed form of the KeyRegistrarStorage custom error OperatorAlreadyRegistered().
+type ErrOperatorAlreadyRegistered struct{}
+
+// Error implements the error interface.
+func (ErrOperatorAlreadyRegistered) Error() string {
+	return "KeyRegistrarStorage: OperatorAlreadyRegistered()"
+}
+
+// ErrOperatorSetNotConfigured is the typed form of the KeyRegistrarStorage custom error OperatorSetNotConfigured().
+type ErrOperatorSetNotConfigured struct{}
+
+// Error implements the error interface.
+func (ErrOperatorSetNotConfigured) Error() string {
+	return "KeyRegistrarStorage: OperatorSetNotConfigured()"
+}
+
+// ErrOperatorStillSlashable is the typed form of the KeyRegistrarStorage custom error OperatorStillSlashable((address,uint32),address).
+type ErrOperatorStillSlashable struct {
+	OperatorSet OperatorSet
+	Operator    common.Address
+}
+
+// Error implements the error interface.
+func (e ErrOperatorStillSlashable) Error() string {
+	return fmt.Sprintf("KeyRegistrarStorage: OperatorStillSlashable(operatorSet: %v, operator: %v)", e.OperatorSet, e.Operator)
+}
+
+// ErrZeroAddress is the typed form of the KeyRegistrarStorage custom error ZeroAddress().
+type ErrZeroAddress struct{}
+
+// Error implements the error interface.
+func (ErrZeroAddress) Error() string {
+	return "KeyRegistrarStorage: ZeroAddress()"
+}
+
+// ErrZeroPubkey is the typed form of the KeyRegistrarStorage custom error ZeroPubkey().
+type ErrZeroPubkey struct{}
+
+// Error implements the error interface.
+func (ErrZeroPubkey) Error() string {
+	return "KeyRegistrarStorage: ZeroPubkey()"
+}
+
+// KeyRegistrarStorageErrors maps the name of every custom error in the KeyRegistrarStorage ABI to its typed Go error.
+var KeyRegistrarStorageErrors = map[string]error{
+	"ConfigurationAlreadySet":   ErrConfigurationAlreadySet{},
+	"InvalidCurveType":          ErrInvalidCurveType{},
+	"InvalidKeyFormat":          ErrInvalidKeyFormat{},
+	"InvalidKeypair":            ErrInvalidKeypair{},
+	"KeyAlreadyRegistered":      ErrKeyAlreadyRegistered{},
+	"KeyNotFound":               ErrKeyNotFound{},
+	"OperatorAlreadyRegistered": ErrOperatorAlreadyRegistered{},
+	"OperatorSetNotConfigured":  ErrOperatorSetNotConfigured{},
+	"OperatorStillSlashable":    ErrOperatorStillSlashable{},
+	"ZeroAddress":               ErrZeroAddress{},
+	"ZeroPubkey":                ErrZeroPubkey{},
+}
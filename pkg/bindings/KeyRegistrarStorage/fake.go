@@ -0,0 +1,251 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package KeyRegistrarStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeKeyRegistrarStorage is an in-memory KeyRegistrarStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded KeyRegistrarStorageFilterer.
+type FakeKeyRegistrarStorage struct {
+	*fake.Contract
+	*KeyRegistrarStorageFilterer
+}
+
+// NewFakeKeyRegistrarStorage returns an empty fake of the contract at address.
+func NewFakeKeyRegistrarStorage(address common.Address) *FakeKeyRegistrarStorage {
+	contract := fake.NewContract(KeyRegistrarStorageMetaData, address)
+	filterer, err := NewKeyRegistrarStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeKeyRegistrarStorage{Contract: contract, KeyRegistrarStorageFilterer: filterer}
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeKeyRegistrarStorage.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubAllocationManager(out0 common.Address) {
+	_FakeKeyRegistrarStorage.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// EncodeBN254KeyData returns the values stubbed with StubEncodeBN254KeyData.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) EncodeBN254KeyData(opts *bind.CallOpts, g1Point BN254G1Point, g2Point BN254G2Point) ([]byte, error) {
+	var out0 []byte
+	err := _FakeKeyRegistrarStorage.Contract.Read("encodeBN254KeyData", []interface{}{g1Point, g2Point}, &out0)
+	return out0, err
+}
+
+// StubEncodeBN254KeyData sets what EncodeBN254KeyData returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubEncodeBN254KeyData(g1Point BN254G1Point, g2Point BN254G2Point, out0 []byte) {
+	_FakeKeyRegistrarStorage.Contract.Stub("encodeBN254KeyData", []interface{}{g1Point, g2Point}, out0)
+}
+
+// GetBN254Key returns the values stubbed with StubGetBN254Key.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) GetBN254Key(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (struct {
+	G1Point BN254G1Point
+	G2Point BN254G2Point
+}, error) {
+	var out0 struct {
+		G1Point BN254G1Point
+		G2Point BN254G2Point
+	}
+	err := _FakeKeyRegistrarStorage.Contract.Read("getBN254Key", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetBN254Key sets what GetBN254Key returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubGetBN254Key(operatorSet OperatorSet, operator common.Address, out0 struct {
+	G1Point BN254G1Point
+	G2Point BN254G2Point
+}) {
+	_FakeKeyRegistrarStorage.Contract.Stub("getBN254Key", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetBN254KeyRegistrationMessageHash returns the values stubbed with StubGetBN254KeyRegistrationMessageHash.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) GetBN254KeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyData []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrarStorage.Contract.Read("getBN254KeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyData}, &out0)
+	return out0, err
+}
+
+// StubGetBN254KeyRegistrationMessageHash sets what GetBN254KeyRegistrationMessageHash returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubGetBN254KeyRegistrationMessageHash(operator common.Address, operatorSet OperatorSet, keyData []byte, out0 [32]byte) {
+	_FakeKeyRegistrarStorage.Contract.Stub("getBN254KeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyData}, out0)
+}
+
+// GetECDSAAddress returns the values stubbed with StubGetECDSAAddress.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) GetECDSAAddress(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeKeyRegistrarStorage.Contract.Read("getECDSAAddress", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAAddress sets what GetECDSAAddress returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubGetECDSAAddress(operatorSet OperatorSet, operator common.Address, out0 common.Address) {
+	_FakeKeyRegistrarStorage.Contract.Stub("getECDSAAddress", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetECDSAKey returns the values stubbed with StubGetECDSAKey.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) GetECDSAKey(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([]byte, error) {
+	var out0 []byte
+	err := _FakeKeyRegistrarStorage.Contract.Read("getECDSAKey", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAKey sets what GetECDSAKey returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubGetECDSAKey(operatorSet OperatorSet, operator common.Address, out0 []byte) {
+	_FakeKeyRegistrarStorage.Contract.Stub("getECDSAKey", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetECDSAKeyRegistrationMessageHash returns the values stubbed with StubGetECDSAKeyRegistrationMessageHash.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) GetECDSAKeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyAddress common.Address) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrarStorage.Contract.Read("getECDSAKeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyAddress}, &out0)
+	return out0, err
+}
+
+// StubGetECDSAKeyRegistrationMessageHash sets what GetECDSAKeyRegistrationMessageHash returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubGetECDSAKeyRegistrationMessageHash(operator common.Address, operatorSet OperatorSet, keyAddress common.Address, out0 [32]byte) {
+	_FakeKeyRegistrarStorage.Contract.Stub("getECDSAKeyRegistrationMessageHash", []interface{}{operator, operatorSet, keyAddress}, out0)
+}
+
+// GetKeyHash returns the values stubbed with StubGetKeyHash.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) GetKeyHash(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeKeyRegistrarStorage.Contract.Read("getKeyHash", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubGetKeyHash sets what GetKeyHash returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubGetKeyHash(operatorSet OperatorSet, operator common.Address, out0 [32]byte) {
+	_FakeKeyRegistrarStorage.Contract.Stub("getKeyHash", []interface{}{operatorSet, operator}, out0)
+}
+
+// GetOperatorFromSigningKey returns the values stubbed with StubGetOperatorFromSigningKey.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) GetOperatorFromSigningKey(opts *bind.CallOpts, operatorSet OperatorSet, keyData []byte) (common.Address, bool, error) {
+	var out0 common.Address
+	var out1 bool
+	err := _FakeKeyRegistrarStorage.Contract.Read("getOperatorFromSigningKey", []interface{}{operatorSet, keyData}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetOperatorFromSigningKey sets what GetOperatorFromSigningKey returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubGetOperatorFromSigningKey(operatorSet OperatorSet, keyData []byte, out0 common.Address, out1 bool) {
+	_FakeKeyRegistrarStorage.Contract.Stub("getOperatorFromSigningKey", []interface{}{operatorSet, keyData}, out0, out1)
+}
+
+// GetOperatorSetCurveType returns the values stubbed with StubGetOperatorSetCurveType.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) GetOperatorSetCurveType(opts *bind.CallOpts, operatorSet OperatorSet) (uint8, error) {
+	var out0 uint8
+	err := _FakeKeyRegistrarStorage.Contract.Read("getOperatorSetCurveType", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetCurveType sets what GetOperatorSetCurveType returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubGetOperatorSetCurveType(operatorSet OperatorSet, out0 uint8) {
+	_FakeKeyRegistrarStorage.Contract.Stub("getOperatorSetCurveType", []interface{}{operatorSet}, out0)
+}
+
+// IsKeyGloballyRegistered returns the values stubbed with StubIsKeyGloballyRegistered.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) IsKeyGloballyRegistered(opts *bind.CallOpts, keyHash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeKeyRegistrarStorage.Contract.Read("isKeyGloballyRegistered", []interface{}{keyHash}, &out0)
+	return out0, err
+}
+
+// StubIsKeyGloballyRegistered sets what IsKeyGloballyRegistered returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubIsKeyGloballyRegistered(keyHash [32]byte, out0 bool) {
+	_FakeKeyRegistrarStorage.Contract.Stub("isKeyGloballyRegistered", []interface{}{keyHash}, out0)
+}
+
+// IsRegistered returns the values stubbed with StubIsRegistered.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) IsRegistered(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeKeyRegistrarStorage.Contract.Read("isRegistered", []interface{}{operatorSet, operator}, &out0)
+	return out0, err
+}
+
+// StubIsRegistered sets what IsRegistered returns for the given arguments.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) StubIsRegistered(operatorSet OperatorSet, operator common.Address, out0 bool) {
+	_FakeKeyRegistrarStorage.Contract.Stub("isRegistered", []interface{}{operatorSet, operator}, out0)
+}
+
+// ConfigureOperatorSet records a configureOperatorSet call after running the hook set with OnConfigureOperatorSet.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) ConfigureOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) (*types.Transaction, error) {
+	return _FakeKeyRegistrarStorage.Contract.Transact(opts, "configureOperatorSet", func() error {
+		if hook, ok := _FakeKeyRegistrarStorage.Contract.Hook("configureOperatorSet").(func(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) error); ok {
+			return hook(opts, operatorSet, curveType)
+		}
+		return nil
+	}, operatorSet, curveType)
+}
+
+// OnConfigureOperatorSet sets a hook run by every ConfigureOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) OnConfigureOperatorSet(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) error) {
+	_FakeKeyRegistrarStorage.Contract.SetHook("configureOperatorSet", hook)
+}
+
+// DeregisterKey records a deregisterKey call after running the hook set with OnDeregisterKey.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) DeregisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) (*types.Transaction, error) {
+	return _FakeKeyRegistrarStorage.Contract.Transact(opts, "deregisterKey", func() error {
+		if hook, ok := _FakeKeyRegistrarStorage.Contract.Hook("deregisterKey").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) error); ok {
+			return hook(opts, operator, operatorSet)
+		}
+		return nil
+	}, operator, operatorSet)
+}
+
+// OnDeregisterKey sets a hook run by every DeregisterKey call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) OnDeregisterKey(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) error) {
+	_FakeKeyRegistrarStorage.Contract.SetHook("deregisterKey", hook)
+}
+
+// RegisterKey records a registerKey call after running the hook set with OnRegisterKey.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) RegisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, pubkey []byte, signature []byte) (*types.Transaction, error) {
+	return _FakeKeyRegistrarStorage.Contract.Transact(opts, "registerKey", func() error {
+		if hook, ok := _FakeKeyRegistrarStorage.Contract.Hook("registerKey").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, pubkey []byte, signature []byte) error); ok {
+			return hook(opts, operator, operatorSet, pubkey, signature)
+		}
+		return nil
+	}, operator, operatorSet, pubkey, signature)
+}
+
+// OnRegisterKey sets a hook run by every RegisterKey call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) OnRegisterKey(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, pubkey []byte, signature []byte) error) {
+	_FakeKeyRegistrarStorage.Contract.SetHook("registerKey", hook)
+}
+
+// EmitAggregateBN254KeyUpdated appends the event to the fake's logs.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) EmitAggregateBN254KeyUpdated(event *KeyRegistrarStorageAggregateBN254KeyUpdated) {
+	_FakeKeyRegistrarStorage.Contract.Emit("AggregateBN254KeyUpdated", event)
+}
+
+// EmitKeyDeregistered appends the event to the fake's logs.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) EmitKeyDeregistered(event *KeyRegistrarStorageKeyDeregistered) {
+	_FakeKeyRegistrarStorage.Contract.Emit("KeyDeregistered", event)
+}
+
+// EmitKeyRegistered appends the event to the fake's logs.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) EmitKeyRegistered(event *KeyRegistrarStorageKeyRegistered) {
+	_FakeKeyRegistrarStorage.Contract.Emit("KeyRegistered", event)
+}
+
+// EmitOperatorSetConfigured appends the event to the fake's logs.
+func (_FakeKeyRegistrarStorage *FakeKeyRegistrarStorage) EmitOperatorSetConfigured(event *KeyRegistrarStorageOperatorSetConfigured) {
+	_FakeKeyRegistrarStorage.Contract.Emit("OperatorSetConfigured", event)
+}
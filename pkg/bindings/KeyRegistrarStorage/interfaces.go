@@ -0,0 +1,67 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package KeyRegistrarStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// KeyRegistrarStorageReader is the read-only surface of the KeyRegistrarStorage
+// binding, implemented by KeyRegistrarStorageCaller, KeyRegistrarStorage and FakeKeyRegistrarStorage.
+type KeyRegistrarStorageReader interface {
+	AllocationManager(opts *bind.CallOpts) (common.Address, error)
+	EncodeBN254KeyData(opts *bind.CallOpts, g1Point BN254G1Point, g2Point BN254G2Point) ([]byte, error)
+	GetBN254Key(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (struct {
+		G1Point BN254G1Point
+		G2Point BN254G2Point
+	}, error)
+	GetBN254KeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyData []byte) ([32]byte, error)
+	GetECDSAAddress(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (common.Address, error)
+	GetECDSAKey(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([]byte, error)
+	GetECDSAKeyRegistrationMessageHash(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet, keyAddress common.Address) ([32]byte, error)
+	GetKeyHash(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) ([32]byte, error)
+	GetOperatorFromSigningKey(opts *bind.CallOpts, operatorSet OperatorSet, keyData []byte) (common.Address, bool, error)
+	GetOperatorSetCurveType(opts *bind.CallOpts, operatorSet OperatorSet) (uint8, error)
+	IsKeyGloballyRegistered(opts *bind.CallOpts, keyHash [32]byte) (bool, error)
+	IsRegistered(opts *bind.CallOpts, operatorSet OperatorSet, operator common.Address) (bool, error)
+}
+
+// KeyRegistrarStorageWriter is the transacting surface of the KeyRegistrarStorage
+// binding, implemented by KeyRegistrarStorageTransactor, KeyRegistrarStorage and FakeKeyRegistrarStorage.
+type KeyRegistrarStorageWriter interface {
+	ConfigureOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, curveType uint8) (*types.Transaction, error)
+	DeregisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet) (*types.Transaction, error)
+	RegisterKey(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, pubkey []byte, signature []byte) (*types.Transaction, error)
+}
+
+// KeyRegistrarStorageEvents is the event filtering surface of the KeyRegistrarStorage
+// binding, implemented by KeyRegistrarStorageFilterer, KeyRegistrarStorage and FakeKeyRegistrarStorage.
+type KeyRegistrarStorageEvents interface {
+	FilterAggregateBN254KeyUpdated(opts *bind.FilterOpts) (*KeyRegistrarStorageAggregateBN254KeyUpdatedIterator, error)
+	WatchAggregateBN254KeyUpdated(opts *bind.WatchOpts, sink chan<- *KeyRegistrarStorageAggregateBN254KeyUpdated) (event.Subscription, error)
+	ParseAggregateBN254KeyUpdated(log types.Log) (*KeyRegistrarStorageAggregateBN254KeyUpdated, error)
+	FilterKeyDeregistered(opts *bind.FilterOpts, operator []common.Address) (*KeyRegistrarStorageKeyDeregisteredIterator, error)
+	WatchKeyDeregistered(opts *bind.WatchOpts, sink chan<- *KeyRegistrarStorageKeyDeregistered, operator []common.Address) (event.Subscription, error)
+	ParseKeyDeregistered(log types.Log) (*KeyRegistrarStorageKeyDeregistered, error)
+	FilterKeyRegistered(opts *bind.FilterOpts, operator []common.Address) (*KeyRegistrarStorageKeyRegisteredIterator, error)
+	WatchKeyRegistered(opts *bind.WatchOpts, sink chan<- *KeyRegistrarStorageKeyRegistered, operator []common.Address) (event.Subscription, error)
+	ParseKeyRegistered(log types.Log) (*KeyRegistrarStorageKeyRegistered, error)
+	FilterOperatorSetConfigured(opts *bind.FilterOpts) (*KeyRegistrarStorageOperatorSetConfiguredIterator, error)
+	WatchOperatorSetConfigured(opts *bind.WatchOpts, sink chan<- *KeyRegistrarStorageOperatorSetConfigured) (event.Subscription, error)
+	ParseOperatorSetConfigured(log types.Log) (*KeyRegistrarStorageOperatorSetConfigured, error)
+}
+
+var (
+	_ KeyRegistrarStorageReader = (*KeyRegistrarStorageCaller)(nil)
+	_ KeyRegistrarStorageReader = (*KeyRegistrarStorage)(nil)
+	_ KeyRegistrarStorageReader = (*FakeKeyRegistrarStorage)(nil)
+	_ KeyRegistrarStorageWriter = (*KeyRegistrarStorageTransactor)(nil)
+	_ KeyRegistrarStorageWriter = (*KeyRegistrarStorage)(nil)
+	_ KeyRegistrarStorageWriter = (*FakeKeyRegistrarStorage)(nil)
+	_ KeyRegistrarStorageEvents = (*KeyRegistrarStorageFilterer)(nil)
+	_ KeyRegistrarStorageEvents = (*KeyRegistrarStorage)(nil)
+	_ KeyRegistrarStorageEvents = (*FakeKeyRegistrarStorage)(nil)
+)
@@ -0,0 +1,76 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package LeafCalculatorMixin
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeLeafCalculatorMixin is an in-memory LeafCalculatorMixin for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded LeafCalculatorMixinFilterer.
+type FakeLeafCalculatorMixin struct {
+	*fake.Contract
+	*LeafCalculatorMixinFilterer
+}
+
+// NewFakeLeafCalculatorMixin returns an empty fake of the contract at address.
+func NewFakeLeafCalculatorMixin(address common.Address) *FakeLeafCalculatorMixin {
+	contract := fake.NewContract(LeafCalculatorMixinMetaData, address)
+	filterer, err := NewLeafCalculatorMixinFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeLeafCalculatorMixin{Contract: contract, LeafCalculatorMixinFilterer: filterer}
+}
+
+// OPERATORINFOLEAFSALT returns the values stubbed with StubOPERATORINFOLEAFSALT.
+func (_FakeLeafCalculatorMixin *FakeLeafCalculatorMixin) OPERATORINFOLEAFSALT(opts *bind.CallOpts) (uint8, error) {
+	var out0 uint8
+	err := _FakeLeafCalculatorMixin.Contract.Read("OPERATOR_INFO_LEAF_SALT", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORINFOLEAFSALT sets what OPERATORINFOLEAFSALT returns for the given arguments.
+func (_FakeLeafCalculatorMixin *FakeLeafCalculatorMixin) StubOPERATORINFOLEAFSALT(out0 uint8) {
+	_FakeLeafCalculatorMixin.Contract.Stub("OPERATOR_INFO_LEAF_SALT", []interface{}{}, out0)
+}
+
+// OPERATORTABLELEAFSALT returns the values stubbed with StubOPERATORTABLELEAFSALT.
+func (_FakeLeafCalculatorMixin *FakeLeafCalculatorMixin) OPERATORTABLELEAFSALT(opts *bind.CallOpts) (uint8, error) {
+	var out0 uint8
+	err := _FakeLeafCalculatorMixin.Contract.Read("OPERATOR_TABLE_LEAF_SALT", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORTABLELEAFSALT sets what OPERATORTABLELEAFSALT returns for the given arguments.
+func (_FakeLeafCalculatorMixin *FakeLeafCalculatorMixin) StubOPERATORTABLELEAFSALT(out0 uint8) {
+	_FakeLeafCalculatorMixin.Contract.Stub("OPERATOR_TABLE_LEAF_SALT", []interface{}{}, out0)
+}
+
+// CalculateOperatorInfoLeaf returns the values stubbed with StubCalculateOperatorInfoLeaf.
+func (_FakeLeafCalculatorMixin *FakeLeafCalculatorMixin) CalculateOperatorInfoLeaf(opts *bind.CallOpts, operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeLeafCalculatorMixin.Contract.Read("calculateOperatorInfoLeaf", []interface{}{operatorInfo}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorInfoLeaf sets what CalculateOperatorInfoLeaf returns for the given arguments.
+func (_FakeLeafCalculatorMixin *FakeLeafCalculatorMixin) StubCalculateOperatorInfoLeaf(operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo, out0 [32]byte) {
+	_FakeLeafCalculatorMixin.Contract.Stub("calculateOperatorInfoLeaf", []interface{}{operatorInfo}, out0)
+}
+
+// CalculateOperatorTableLeaf returns the values stubbed with StubCalculateOperatorTableLeaf.
+func (_FakeLeafCalculatorMixin *FakeLeafCalculatorMixin) CalculateOperatorTableLeaf(opts *bind.CallOpts, operatorTableBytes []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeLeafCalculatorMixin.Contract.Read("calculateOperatorTableLeaf", []interface{}{operatorTableBytes}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorTableLeaf sets what CalculateOperatorTableLeaf returns for the given arguments.
+func (_FakeLeafCalculatorMixin *FakeLeafCalculatorMixin) StubCalculateOperatorTableLeaf(operatorTableBytes []byte, out0 [32]byte) {
+	_FakeLeafCalculatorMixin.Contract.Stub("calculateOperatorTableLeaf", []interface{}{operatorTableBytes}, out0)
+}
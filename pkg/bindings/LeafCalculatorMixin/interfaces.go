@@ -0,0 +1,22 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package LeafCalculatorMixin
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// LeafCalculatorMixinReader is the read-only surface of the LeafCalculatorMixin
+// binding, implemented by LeafCalculatorMixinCaller, LeafCalculatorMixin and FakeLeafCalculatorMixin.
+type LeafCalculatorMixinReader interface {
+	OPERATORINFOLEAFSALT(opts *bind.CallOpts) (uint8, error)
+	OPERATORTABLELEAFSALT(opts *bind.CallOpts) (uint8, error)
+	CalculateOperatorInfoLeaf(opts *bind.CallOpts, operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo) ([32]byte, error)
+	CalculateOperatorTableLeaf(opts *bind.CallOpts, operatorTableBytes []byte) ([32]byte, error)
+}
+
+var (
+	_ LeafCalculatorMixinReader = (*LeafCalculatorMixinCaller)(nil)
+	_ LeafCalculatorMixinReader = (*LeafCalculatorMixin)(nil)
+	_ LeafCalculatorMixinReader = (*FakeLeafCalculatorMixin)(nil)
+)
@@ -0,0 +1,61 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Merkle
+
+// ErrEmptyRoot is the typed form of the Merkle custom error EmptyRoot().
+type ErrEmptyRoot struct{}
+
+// Error implements the error interface.
+func (ErrEmptyRoot) Error() string {
+	return "Merkle: EmptyRoot()"
+}
+
+// ErrInvalidIndex is the typed form of the Merkle custom error InvalidIndex().
+type ErrInvalidIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidIndex) Error() string {
+	return "Merkle: InvalidIndex()"
+}
+
+// ErrInvalidProofLength is the typed form of the Merkle custom error InvalidProofLength().
+type ErrInvalidProofLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidProofLength) Error() string {
+	return "Merkle: InvalidProofLength()"
+}
+
+// ErrLeavesNotPowerOfTwo is the typed form of the Merkle custom error LeavesNotPowerOfTwo().
+type ErrLeavesNotPowerOfTwo struct{}
+
+// Error implements the error interface.
+func (ErrLeavesNotPowerOfTwo) Error() string {
+	return "Merkle: LeavesNotPowerOfTwo()"
+}
+
+// ErrNoLeaves is the typed form of the Merkle custom error NoLeaves().
+type ErrNoLeaves struct{}
+
+// Error implements the error interface.
+func (ErrNoLeaves) Error() string {
+	return "Merkle: NoLeaves()"
+}
+
+// ErrNotEnoughLeaves is the typed form of the Merkle custom error NotEnoughLeaves().
+type ErrNotEnoughLeaves struct{}
+
+// Error implements the error interface.
+func (ErrNotEnoughLeaves) Error() string {
+	return "Merkle: NotEnoughLeaves()"
+}
+
+// MerkleErrors maps the name of every custom error in the Merkle ABI to its typed Go error.
+var MerkleErrors = map[string]error{
+	"EmptyRoot":           ErrEmptyRoot{},
+	"InvalidIndex":        ErrInvalidIndex{},
+	"InvalidProofLength":  ErrInvalidProofLength{},
+	"LeavesNotPowerOfTwo": ErrLeavesNotPowerOfTwo{},
+	"NoLeaves":            ErrNoLeaves{},
+	"NotEnoughLeaves":     ErrNotEnoughLeaves{},
+}
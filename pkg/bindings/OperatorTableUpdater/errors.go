@@ -0,0 +1,196 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package OperatorTableUpdater
+
+// ErrCannotDisableGeneratorRoot is the typed form of the OperatorTableUpdater custom error CannotDisableGeneratorRoot().
+type ErrCannotDisableGeneratorRoot struct{}
+
+// Error implements the error interface.
+func (ErrCannotDisableGeneratorRoot) Error() string {
+	return "OperatorTableUpdater: CannotDisableGeneratorRoot()"
+}
+
+// ErrCertificateInvalid is the typed form of the OperatorTableUpdater custom error CertificateInvalid().
+type ErrCertificateInvalid struct{}
+
+// Error implements the error interface.
+func (ErrCertificateInvalid) Error() string {
+	return "OperatorTableUpdater: CertificateInvalid()"
+}
+
+// ErrCurrentlyPaused is the typed form of the OperatorTableUpdater custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "OperatorTableUpdater: CurrentlyPaused()"
+}
+
+// ErrEmptyRoot is the typed form of the OperatorTableUpdater custom error EmptyRoot().
+type ErrEmptyRoot struct{}
+
+// Error implements the error interface.
+func (ErrEmptyRoot) Error() string {
+	return "OperatorTableUpdater: EmptyRoot()"
+}
+
+// ErrGlobalTableRootInFuture is the typed form of the OperatorTableUpdater custom error GlobalTableRootInFuture().
+type ErrGlobalTableRootInFuture struct{}
+
+// Error implements the error interface.
+func (ErrGlobalTableRootInFuture) Error() string {
+	return "OperatorTableUpdater: GlobalTableRootInFuture()"
+}
+
+// ErrGlobalTableRootStale is the typed form of the OperatorTableUpdater custom error GlobalTableRootStale().
+type ErrGlobalTableRootStale struct{}
+
+// Error implements the error interface.
+func (ErrGlobalTableRootStale) Error() string {
+	return "OperatorTableUpdater: GlobalTableRootStale()"
+}
+
+// ErrInputAddressZero is the typed form of the OperatorTableUpdater custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "OperatorTableUpdater: InputAddressZero()"
+}
+
+// ErrInvalidConfirmationThreshold is the typed form of the OperatorTableUpdater custom error InvalidConfirmationThreshold().
+type ErrInvalidConfirmationThreshold struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConfirmationThreshold) Error() string {
+	return "OperatorTableUpdater: InvalidConfirmationThreshold()"
+}
+
+// ErrInvalidCurveType is the typed form of the OperatorTableUpdater custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "OperatorTableUpdater: InvalidCurveType()"
+}
+
+// ErrInvalidGenerator is the typed form of the OperatorTableUpdater custom error InvalidGenerator().
+type ErrInvalidGenerator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGenerator) Error() string {
+	return "OperatorTableUpdater: InvalidGenerator()"
+}
+
+// ErrInvalidGlobalTableRoot is the typed form of the OperatorTableUpdater custom error InvalidGlobalTableRoot().
+type ErrInvalidGlobalTableRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGlobalTableRoot) Error() string {
+	return "OperatorTableUpdater: InvalidGlobalTableRoot()"
+}
+
+// ErrInvalidIndex is the typed form of the OperatorTableUpdater custom error InvalidIndex().
+type ErrInvalidIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidIndex) Error() string {
+	return "OperatorTableUpdater: InvalidIndex()"
+}
+
+// ErrInvalidMessageHash is the typed form of the OperatorTableUpdater custom error InvalidMessageHash().
+type ErrInvalidMessageHash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMessageHash) Error() string {
+	return "OperatorTableUpdater: InvalidMessageHash()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the OperatorTableUpdater custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "OperatorTableUpdater: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the OperatorTableUpdater custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "OperatorTableUpdater: InvalidOperatorSet()"
+}
+
+// ErrInvalidOperatorSetProof is the typed form of the OperatorTableUpdater custom error InvalidOperatorSetProof().
+type ErrInvalidOperatorSetProof struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSetProof) Error() string {
+	return "OperatorTableUpdater: InvalidOperatorSetProof()"
+}
+
+// ErrInvalidProofLength is the typed form of the OperatorTableUpdater custom error InvalidProofLength().
+type ErrInvalidProofLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidProofLength) Error() string {
+	return "OperatorTableUpdater: InvalidProofLength()"
+}
+
+// ErrInvalidRoot is the typed form of the OperatorTableUpdater custom error InvalidRoot().
+type ErrInvalidRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRoot) Error() string {
+	return "OperatorTableUpdater: InvalidRoot()"
+}
+
+// ErrOnlyPauser is the typed form of the OperatorTableUpdater custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "OperatorTableUpdater: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the OperatorTableUpdater custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "OperatorTableUpdater: OnlyUnpauser()"
+}
+
+// ErrTableUpdateForPastTimestamp is the typed form of the OperatorTableUpdater custom error TableUpdateForPastTimestamp().
+type ErrTableUpdateForPastTimestamp struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateForPastTimestamp) Error() string {
+	return "OperatorTableUpdater: TableUpdateForPastTimestamp()"
+}
+
+// OperatorTableUpdaterErrors maps the name of every custom error in the OperatorTableUpdater ABI to its typed Go error.
+var OperatorTableUpdaterErrors = map[string]error{
+	"CannotDisableGeneratorRoot":   ErrCannotDisableGeneratorRoot{},
+	"CertificateInvalid":           ErrCertificateInvalid{},
+	"CurrentlyPaused":              ErrCurrentlyPaused{},
+	"EmptyRoot":                    ErrEmptyRoot{},
+	"GlobalTableRootInFuture":      ErrGlobalTableRootInFuture{},
+	"GlobalTableRootStale":         ErrGlobalTableRootStale{},
+	"InputAddressZero":             ErrInputAddressZero{},
+	"InvalidConfirmationThreshold": ErrInvalidConfirmationThreshold{},
+	"InvalidCurveType":             ErrInvalidCurveType{},
+	"InvalidGenerator":             ErrInvalidGenerator{},
+	"InvalidGlobalTableRoot":       ErrInvalidGlobalTableRoot{},
+	"InvalidIndex":                 ErrInvalidIndex{},
+	"InvalidMessageHash":           ErrInvalidMessageHash{},
+	"InvalidNewPausedStatus":       ErrInvalidNewPausedStatus{},
+	"InvalidOperatorSet":           ErrInvalidOperatorSet{},
+	"InvalidOperatorSetProof":      ErrInvalidOperatorSetProof{},
+	"InvalidProofLength":           ErrInvalidProofLength{},
+	"InvalidRoot":                  ErrInvalidRoot{},
+	"OnlyPauser":                   ErrOnlyPauser{},
+	"OnlyUnpauser":                 ErrOnlyUnpauser{},
+	"TableUpdateForPastTimestamp":  ErrTableUpdateForPastTimestamp{},
+}
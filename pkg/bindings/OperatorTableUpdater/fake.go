@@ -0,0 +1,606 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package OperatorTableUpdater
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeOperatorTableUpdater is an in-memory OperatorTableUpdater for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded OperatorTableUpdaterFilterer.
+type FakeOperatorTableUpdater struct {
+	*fake.Contract
+	*OperatorTableUpdaterFilterer
+}
+
+// NewFakeOperatorTableUpdater returns an empty fake of the contract at address.
+func NewFakeOperatorTableUpdater(address common.Address) *FakeOperatorTableUpdater {
+	contract := fake.NewContract(OperatorTableUpdaterMetaData, address)
+	filterer, err := NewOperatorTableUpdaterFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeOperatorTableUpdater{Contract: contract, OperatorTableUpdaterFilterer: filterer}
+}
+
+// GENERATORGLOBALTABLEROOT returns the values stubbed with StubGENERATORGLOBALTABLEROOT.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GENERATORGLOBALTABLEROOT(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdater.Contract.Read("GENERATOR_GLOBAL_TABLE_ROOT", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENERATORGLOBALTABLEROOT sets what GENERATORGLOBALTABLEROOT returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGENERATORGLOBALTABLEROOT(out0 [32]byte) {
+	_FakeOperatorTableUpdater.Contract.Stub("GENERATOR_GLOBAL_TABLE_ROOT", []interface{}{}, out0)
+}
+
+// GENERATORMAXSTALENESSPERIOD returns the values stubbed with StubGENERATORMAXSTALENESSPERIOD.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GENERATORMAXSTALENESSPERIOD(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdater.Contract.Read("GENERATOR_MAX_STALENESS_PERIOD", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENERATORMAXSTALENESSPERIOD sets what GENERATORMAXSTALENESSPERIOD returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGENERATORMAXSTALENESSPERIOD(out0 uint32) {
+	_FakeOperatorTableUpdater.Contract.Stub("GENERATOR_MAX_STALENESS_PERIOD", []interface{}{}, out0)
+}
+
+// GENERATORREFERENCETIMESTAMP returns the values stubbed with StubGENERATORREFERENCETIMESTAMP.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GENERATORREFERENCETIMESTAMP(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdater.Contract.Read("GENERATOR_REFERENCE_TIMESTAMP", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENERATORREFERENCETIMESTAMP sets what GENERATORREFERENCETIMESTAMP returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGENERATORREFERENCETIMESTAMP(out0 uint32) {
+	_FakeOperatorTableUpdater.Contract.Stub("GENERATOR_REFERENCE_TIMESTAMP", []interface{}{}, out0)
+}
+
+// GLOBALTABLEROOTCERTTYPEHASH returns the values stubbed with StubGLOBALTABLEROOTCERTTYPEHASH.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GLOBALTABLEROOTCERTTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdater.Contract.Read("GLOBAL_TABLE_ROOT_CERT_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGLOBALTABLEROOTCERTTYPEHASH sets what GLOBALTABLEROOTCERTTYPEHASH returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGLOBALTABLEROOTCERTTYPEHASH(out0 [32]byte) {
+	_FakeOperatorTableUpdater.Contract.Stub("GLOBAL_TABLE_ROOT_CERT_TYPEHASH", []interface{}{}, out0)
+}
+
+// MAXBPS returns the values stubbed with StubMAXBPS.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) MAXBPS(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeOperatorTableUpdater.Contract.Read("MAX_BPS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXBPS sets what MAXBPS returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubMAXBPS(out0 uint16) {
+	_FakeOperatorTableUpdater.Contract.Stub("MAX_BPS", []interface{}{}, out0)
+}
+
+// OPERATORINFOLEAFSALT returns the values stubbed with StubOPERATORINFOLEAFSALT.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OPERATORINFOLEAFSALT(opts *bind.CallOpts) (uint8, error) {
+	var out0 uint8
+	err := _FakeOperatorTableUpdater.Contract.Read("OPERATOR_INFO_LEAF_SALT", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORINFOLEAFSALT sets what OPERATORINFOLEAFSALT returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubOPERATORINFOLEAFSALT(out0 uint8) {
+	_FakeOperatorTableUpdater.Contract.Stub("OPERATOR_INFO_LEAF_SALT", []interface{}{}, out0)
+}
+
+// OPERATORTABLELEAFSALT returns the values stubbed with StubOPERATORTABLELEAFSALT.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OPERATORTABLELEAFSALT(opts *bind.CallOpts) (uint8, error) {
+	var out0 uint8
+	err := _FakeOperatorTableUpdater.Contract.Read("OPERATOR_TABLE_LEAF_SALT", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOPERATORTABLELEAFSALT sets what OPERATORTABLELEAFSALT returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubOPERATORTABLELEAFSALT(out0 uint8) {
+	_FakeOperatorTableUpdater.Contract.Stub("OPERATOR_TABLE_LEAF_SALT", []interface{}{}, out0)
+}
+
+// Bn254CertificateVerifier returns the values stubbed with StubBn254CertificateVerifier.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) Bn254CertificateVerifier(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeOperatorTableUpdater.Contract.Read("bn254CertificateVerifier", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBn254CertificateVerifier sets what Bn254CertificateVerifier returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubBn254CertificateVerifier(out0 common.Address) {
+	_FakeOperatorTableUpdater.Contract.Stub("bn254CertificateVerifier", []interface{}{}, out0)
+}
+
+// CalculateOperatorInfoLeaf returns the values stubbed with StubCalculateOperatorInfoLeaf.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) CalculateOperatorInfoLeaf(opts *bind.CallOpts, operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdater.Contract.Read("calculateOperatorInfoLeaf", []interface{}{operatorInfo}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorInfoLeaf sets what CalculateOperatorInfoLeaf returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubCalculateOperatorInfoLeaf(operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo, out0 [32]byte) {
+	_FakeOperatorTableUpdater.Contract.Stub("calculateOperatorInfoLeaf", []interface{}{operatorInfo}, out0)
+}
+
+// CalculateOperatorTableLeaf returns the values stubbed with StubCalculateOperatorTableLeaf.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) CalculateOperatorTableLeaf(opts *bind.CallOpts, operatorTableBytes []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdater.Contract.Read("calculateOperatorTableLeaf", []interface{}{operatorTableBytes}, &out0)
+	return out0, err
+}
+
+// StubCalculateOperatorTableLeaf sets what CalculateOperatorTableLeaf returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubCalculateOperatorTableLeaf(operatorTableBytes []byte, out0 [32]byte) {
+	_FakeOperatorTableUpdater.Contract.Stub("calculateOperatorTableLeaf", []interface{}{operatorTableBytes}, out0)
+}
+
+// EcdsaCertificateVerifier returns the values stubbed with StubEcdsaCertificateVerifier.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EcdsaCertificateVerifier(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeOperatorTableUpdater.Contract.Read("ecdsaCertificateVerifier", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEcdsaCertificateVerifier sets what EcdsaCertificateVerifier returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubEcdsaCertificateVerifier(out0 common.Address) {
+	_FakeOperatorTableUpdater.Contract.Stub("ecdsaCertificateVerifier", []interface{}{}, out0)
+}
+
+// GetCertificateVerifier returns the values stubbed with StubGetCertificateVerifier.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetCertificateVerifier(opts *bind.CallOpts, curveType uint8) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeOperatorTableUpdater.Contract.Read("getCertificateVerifier", []interface{}{curveType}, &out0)
+	return out0, err
+}
+
+// StubGetCertificateVerifier sets what GetCertificateVerifier returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetCertificateVerifier(curveType uint8, out0 common.Address) {
+	_FakeOperatorTableUpdater.Contract.Stub("getCertificateVerifier", []interface{}{curveType}, out0)
+}
+
+// GetCurrentGlobalTableRoot returns the values stubbed with StubGetCurrentGlobalTableRoot.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetCurrentGlobalTableRoot(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdater.Contract.Read("getCurrentGlobalTableRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentGlobalTableRoot sets what GetCurrentGlobalTableRoot returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetCurrentGlobalTableRoot(out0 [32]byte) {
+	_FakeOperatorTableUpdater.Contract.Stub("getCurrentGlobalTableRoot", []interface{}{}, out0)
+}
+
+// GetGenerator returns the values stubbed with StubGetGenerator.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetGenerator(opts *bind.CallOpts) (OperatorSet, error) {
+	var out0 OperatorSet
+	err := _FakeOperatorTableUpdater.Contract.Read("getGenerator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGenerator sets what GetGenerator returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetGenerator(out0 OperatorSet) {
+	_FakeOperatorTableUpdater.Contract.Stub("getGenerator", []interface{}{}, out0)
+}
+
+// GetGeneratorConfig returns the values stubbed with StubGetGeneratorConfig.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetGeneratorConfig(opts *bind.CallOpts) (ICrossChainRegistryTypesOperatorSetConfig, error) {
+	var out0 ICrossChainRegistryTypesOperatorSetConfig
+	err := _FakeOperatorTableUpdater.Contract.Read("getGeneratorConfig", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGeneratorConfig sets what GetGeneratorConfig returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetGeneratorConfig(out0 ICrossChainRegistryTypesOperatorSetConfig) {
+	_FakeOperatorTableUpdater.Contract.Stub("getGeneratorConfig", []interface{}{}, out0)
+}
+
+// GetGeneratorReferenceTimestamp returns the values stubbed with StubGetGeneratorReferenceTimestamp.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetGeneratorReferenceTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdater.Contract.Read("getGeneratorReferenceTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGeneratorReferenceTimestamp sets what GetGeneratorReferenceTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetGeneratorReferenceTimestamp(out0 uint32) {
+	_FakeOperatorTableUpdater.Contract.Stub("getGeneratorReferenceTimestamp", []interface{}{}, out0)
+}
+
+// GetGlobalTableRootByTimestamp returns the values stubbed with StubGetGlobalTableRootByTimestamp.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetGlobalTableRootByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdater.Contract.Read("getGlobalTableRootByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableRootByTimestamp sets what GetGlobalTableRootByTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetGlobalTableRootByTimestamp(referenceTimestamp uint32, out0 [32]byte) {
+	_FakeOperatorTableUpdater.Contract.Stub("getGlobalTableRootByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// GetGlobalTableUpdateMessageHash returns the values stubbed with StubGetGlobalTableUpdateMessageHash.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetGlobalTableUpdateMessageHash(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdater.Contract.Read("getGlobalTableUpdateMessageHash", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableUpdateMessageHash sets what GetGlobalTableUpdateMessageHash returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetGlobalTableUpdateMessageHash(globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32, out0 [32]byte) {
+	_FakeOperatorTableUpdater.Contract.Stub("getGlobalTableUpdateMessageHash", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, out0)
+}
+
+// GetGlobalTableUpdateSignableDigest returns the values stubbed with StubGetGlobalTableUpdateSignableDigest.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetGlobalTableUpdateSignableDigest(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdater.Contract.Read("getGlobalTableUpdateSignableDigest", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableUpdateSignableDigest sets what GetGlobalTableUpdateSignableDigest returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetGlobalTableUpdateSignableDigest(globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32, out0 [32]byte) {
+	_FakeOperatorTableUpdater.Contract.Stub("getGlobalTableUpdateSignableDigest", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, out0)
+}
+
+// GetLatestReferenceBlockNumber returns the values stubbed with StubGetLatestReferenceBlockNumber.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetLatestReferenceBlockNumber(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdater.Contract.Read("getLatestReferenceBlockNumber", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetLatestReferenceBlockNumber sets what GetLatestReferenceBlockNumber returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetLatestReferenceBlockNumber(out0 uint32) {
+	_FakeOperatorTableUpdater.Contract.Stub("getLatestReferenceBlockNumber", []interface{}{}, out0)
+}
+
+// GetLatestReferenceTimestamp returns the values stubbed with StubGetLatestReferenceTimestamp.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetLatestReferenceTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdater.Contract.Read("getLatestReferenceTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetLatestReferenceTimestamp sets what GetLatestReferenceTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetLatestReferenceTimestamp(out0 uint32) {
+	_FakeOperatorTableUpdater.Contract.Stub("getLatestReferenceTimestamp", []interface{}{}, out0)
+}
+
+// GetReferenceBlockNumberByTimestamp returns the values stubbed with StubGetReferenceBlockNumberByTimestamp.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetReferenceBlockNumberByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdater.Contract.Read("getReferenceBlockNumberByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetReferenceBlockNumberByTimestamp sets what GetReferenceBlockNumberByTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetReferenceBlockNumberByTimestamp(referenceTimestamp uint32, out0 uint32) {
+	_FakeOperatorTableUpdater.Contract.Stub("getReferenceBlockNumberByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// GetReferenceTimestampByBlockNumber returns the values stubbed with StubGetReferenceTimestampByBlockNumber.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GetReferenceTimestampByBlockNumber(opts *bind.CallOpts, referenceBlockNumber uint32) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdater.Contract.Read("getReferenceTimestampByBlockNumber", []interface{}{referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetReferenceTimestampByBlockNumber sets what GetReferenceTimestampByBlockNumber returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGetReferenceTimestampByBlockNumber(referenceBlockNumber uint32, out0 uint32) {
+	_FakeOperatorTableUpdater.Contract.Stub("getReferenceTimestampByBlockNumber", []interface{}{referenceBlockNumber}, out0)
+}
+
+// GlobalRootConfirmationThreshold returns the values stubbed with StubGlobalRootConfirmationThreshold.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) GlobalRootConfirmationThreshold(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeOperatorTableUpdater.Contract.Read("globalRootConfirmationThreshold", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGlobalRootConfirmationThreshold sets what GlobalRootConfirmationThreshold returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubGlobalRootConfirmationThreshold(out0 uint16) {
+	_FakeOperatorTableUpdater.Contract.Stub("globalRootConfirmationThreshold", []interface{}{}, out0)
+}
+
+// IsRootValid returns the values stubbed with StubIsRootValid.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) IsRootValid(opts *bind.CallOpts, globalTableRoot [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeOperatorTableUpdater.Contract.Read("isRootValid", []interface{}{globalTableRoot}, &out0)
+	return out0, err
+}
+
+// StubIsRootValid sets what IsRootValid returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubIsRootValid(globalTableRoot [32]byte, out0 bool) {
+	_FakeOperatorTableUpdater.Contract.Stub("isRootValid", []interface{}{globalTableRoot}, out0)
+}
+
+// IsRootValidByTimestamp returns the values stubbed with StubIsRootValidByTimestamp.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) IsRootValidByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeOperatorTableUpdater.Contract.Read("isRootValidByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsRootValidByTimestamp sets what IsRootValidByTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubIsRootValidByTimestamp(referenceTimestamp uint32, out0 bool) {
+	_FakeOperatorTableUpdater.Contract.Stub("isRootValidByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeOperatorTableUpdater.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubOwner(out0 common.Address) {
+	_FakeOperatorTableUpdater.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeOperatorTableUpdater.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubPaused(index uint8, out0 bool) {
+	_FakeOperatorTableUpdater.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeOperatorTableUpdater.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubPaused0(out0 *big.Int) {
+	_FakeOperatorTableUpdater.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeOperatorTableUpdater.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) StubPauserRegistry(out0 common.Address) {
+	_FakeOperatorTableUpdater.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// ConfirmGlobalTableRoot records a confirmGlobalTableRoot call after running the hook set with OnConfirmGlobalTableRoot.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) ConfirmGlobalTableRoot(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "confirmGlobalTableRoot", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("confirmGlobalTableRoot").(func(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) error); ok {
+			return hook(opts, globalTableRootCert, globalTableRoot, referenceTimestamp, referenceBlockNumber)
+		}
+		return nil
+	}, globalTableRootCert, globalTableRoot, referenceTimestamp, referenceBlockNumber)
+}
+
+// OnConfirmGlobalTableRoot sets a hook run by every ConfirmGlobalTableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnConfirmGlobalTableRoot(hook func(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("confirmGlobalTableRoot", hook)
+}
+
+// DisableRoot records a disableRoot call after running the hook set with OnDisableRoot.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) DisableRoot(opts *bind.TransactOpts, globalTableRoot [32]byte) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "disableRoot", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("disableRoot").(func(opts *bind.TransactOpts, globalTableRoot [32]byte) error); ok {
+			return hook(opts, globalTableRoot)
+		}
+		return nil
+	}, globalTableRoot)
+}
+
+// OnDisableRoot sets a hook run by every DisableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnDisableRoot(hook func(opts *bind.TransactOpts, globalTableRoot [32]byte) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("disableRoot", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) Initialize(opts *bind.TransactOpts, _owner common.Address, initialPausedStatus *big.Int, _initialGenerator OperatorSet, _globalRootConfirmationThreshold uint16, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("initialize").(func(opts *bind.TransactOpts, _owner common.Address, initialPausedStatus *big.Int, _initialGenerator OperatorSet, _globalRootConfirmationThreshold uint16, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) error); ok {
+			return hook(opts, _owner, initialPausedStatus, _initialGenerator, _globalRootConfirmationThreshold, generatorInfo)
+		}
+		return nil
+	}, _owner, initialPausedStatus, _initialGenerator, _globalRootConfirmationThreshold, generatorInfo)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnInitialize(hook func(opts *bind.TransactOpts, _owner common.Address, initialPausedStatus *big.Int, _initialGenerator OperatorSet, _globalRootConfirmationThreshold uint16, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("initialize", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("pauseAll", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("renounceOwnership", hook)
+}
+
+// SetGlobalRootConfirmationThreshold records a setGlobalRootConfirmationThreshold call after running the hook set with OnSetGlobalRootConfirmationThreshold.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) SetGlobalRootConfirmationThreshold(opts *bind.TransactOpts, bps uint16) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "setGlobalRootConfirmationThreshold", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("setGlobalRootConfirmationThreshold").(func(opts *bind.TransactOpts, bps uint16) error); ok {
+			return hook(opts, bps)
+		}
+		return nil
+	}, bps)
+}
+
+// OnSetGlobalRootConfirmationThreshold sets a hook run by every SetGlobalRootConfirmationThreshold call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnSetGlobalRootConfirmationThreshold(hook func(opts *bind.TransactOpts, bps uint16) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("setGlobalRootConfirmationThreshold", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("transferOwnership", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("unpause", hook)
+}
+
+// UpdateGenerator records a updateGenerator call after running the hook set with OnUpdateGenerator.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) UpdateGenerator(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "updateGenerator", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("updateGenerator").(func(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) error); ok {
+			return hook(opts, generator, generatorInfo)
+		}
+		return nil
+	}, generator, generatorInfo)
+}
+
+// OnUpdateGenerator sets a hook run by every UpdateGenerator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnUpdateGenerator(hook func(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("updateGenerator", hook)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) UpdateOperatorTable(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdater.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeOperatorTableUpdater.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) error); ok {
+			return hook(opts, referenceTimestamp, globalTableRoot, operatorSetIndex, proof, operatorTableBytes)
+		}
+		return nil
+	}, referenceTimestamp, globalTableRoot, operatorSetIndex, proof, operatorTableBytes)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) error) {
+	_FakeOperatorTableUpdater.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// EmitGeneratorUpdated appends the event to the fake's logs.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EmitGeneratorUpdated(event *OperatorTableUpdaterGeneratorUpdated) {
+	_FakeOperatorTableUpdater.Contract.Emit("GeneratorUpdated", event)
+}
+
+// EmitGlobalRootConfirmationThresholdUpdated appends the event to the fake's logs.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EmitGlobalRootConfirmationThresholdUpdated(event *OperatorTableUpdaterGlobalRootConfirmationThresholdUpdated) {
+	_FakeOperatorTableUpdater.Contract.Emit("GlobalRootConfirmationThresholdUpdated", event)
+}
+
+// EmitGlobalRootDisabled appends the event to the fake's logs.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EmitGlobalRootDisabled(event *OperatorTableUpdaterGlobalRootDisabled) {
+	_FakeOperatorTableUpdater.Contract.Emit("GlobalRootDisabled", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EmitInitialized(event *OperatorTableUpdaterInitialized) {
+	_FakeOperatorTableUpdater.Contract.Emit("Initialized", event)
+}
+
+// EmitNewGlobalTableRoot appends the event to the fake's logs.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EmitNewGlobalTableRoot(event *OperatorTableUpdaterNewGlobalTableRoot) {
+	_FakeOperatorTableUpdater.Contract.Emit("NewGlobalTableRoot", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EmitOwnershipTransferred(event *OperatorTableUpdaterOwnershipTransferred) {
+	_FakeOperatorTableUpdater.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EmitPaused(event *OperatorTableUpdaterPaused) {
+	_FakeOperatorTableUpdater.Contract.Emit("Paused", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeOperatorTableUpdater *FakeOperatorTableUpdater) EmitUnpaused(event *OperatorTableUpdaterUnpaused) {
+	_FakeOperatorTableUpdater.Contract.Emit("Unpaused", event)
+}
@@ -0,0 +1,103 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package OperatorTableUpdater
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// OperatorTableUpdaterReader is the read-only surface of the OperatorTableUpdater
+// binding, implemented by OperatorTableUpdaterCaller, OperatorTableUpdater and FakeOperatorTableUpdater.
+type OperatorTableUpdaterReader interface {
+	GENERATORGLOBALTABLEROOT(opts *bind.CallOpts) ([32]byte, error)
+	GENERATORMAXSTALENESSPERIOD(opts *bind.CallOpts) (uint32, error)
+	GENERATORREFERENCETIMESTAMP(opts *bind.CallOpts) (uint32, error)
+	GLOBALTABLEROOTCERTTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	MAXBPS(opts *bind.CallOpts) (uint16, error)
+	OPERATORINFOLEAFSALT(opts *bind.CallOpts) (uint8, error)
+	OPERATORTABLELEAFSALT(opts *bind.CallOpts) (uint8, error)
+	Bn254CertificateVerifier(opts *bind.CallOpts) (common.Address, error)
+	CalculateOperatorInfoLeaf(opts *bind.CallOpts, operatorInfo IOperatorTableCalculatorTypesBN254OperatorInfo) ([32]byte, error)
+	CalculateOperatorTableLeaf(opts *bind.CallOpts, operatorTableBytes []byte) ([32]byte, error)
+	EcdsaCertificateVerifier(opts *bind.CallOpts) (common.Address, error)
+	GetCertificateVerifier(opts *bind.CallOpts, curveType uint8) (common.Address, error)
+	GetCurrentGlobalTableRoot(opts *bind.CallOpts) ([32]byte, error)
+	GetGenerator(opts *bind.CallOpts) (OperatorSet, error)
+	GetGeneratorConfig(opts *bind.CallOpts) (ICrossChainRegistryTypesOperatorSetConfig, error)
+	GetGeneratorReferenceTimestamp(opts *bind.CallOpts) (uint32, error)
+	GetGlobalTableRootByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) ([32]byte, error)
+	GetGlobalTableUpdateMessageHash(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error)
+	GetGlobalTableUpdateSignableDigest(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error)
+	GetLatestReferenceBlockNumber(opts *bind.CallOpts) (uint32, error)
+	GetLatestReferenceTimestamp(opts *bind.CallOpts) (uint32, error)
+	GetReferenceBlockNumberByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (uint32, error)
+	GetReferenceTimestampByBlockNumber(opts *bind.CallOpts, referenceBlockNumber uint32) (uint32, error)
+	GlobalRootConfirmationThreshold(opts *bind.CallOpts) (uint16, error)
+	IsRootValid(opts *bind.CallOpts, globalTableRoot [32]byte) (bool, error)
+	IsRootValidByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (bool, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+// OperatorTableUpdaterWriter is the transacting surface of the OperatorTableUpdater
+// binding, implemented by OperatorTableUpdaterTransactor, OperatorTableUpdater and FakeOperatorTableUpdater.
+type OperatorTableUpdaterWriter interface {
+	ConfirmGlobalTableRoot(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) (*types.Transaction, error)
+	DisableRoot(opts *bind.TransactOpts, globalTableRoot [32]byte) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _owner common.Address, initialPausedStatus *big.Int, _initialGenerator OperatorSet, _globalRootConfirmationThreshold uint16, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetGlobalRootConfirmationThreshold(opts *bind.TransactOpts, bps uint16) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UpdateGenerator(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) (*types.Transaction, error)
+	UpdateOperatorTable(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) (*types.Transaction, error)
+}
+
+// OperatorTableUpdaterEvents is the event filtering surface of the OperatorTableUpdater
+// binding, implemented by OperatorTableUpdaterFilterer, OperatorTableUpdater and FakeOperatorTableUpdater.
+type OperatorTableUpdaterEvents interface {
+	FilterGeneratorUpdated(opts *bind.FilterOpts) (*OperatorTableUpdaterGeneratorUpdatedIterator, error)
+	WatchGeneratorUpdated(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterGeneratorUpdated) (event.Subscription, error)
+	ParseGeneratorUpdated(log types.Log) (*OperatorTableUpdaterGeneratorUpdated, error)
+	FilterGlobalRootConfirmationThresholdUpdated(opts *bind.FilterOpts) (*OperatorTableUpdaterGlobalRootConfirmationThresholdUpdatedIterator, error)
+	WatchGlobalRootConfirmationThresholdUpdated(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterGlobalRootConfirmationThresholdUpdated) (event.Subscription, error)
+	ParseGlobalRootConfirmationThresholdUpdated(log types.Log) (*OperatorTableUpdaterGlobalRootConfirmationThresholdUpdated, error)
+	FilterGlobalRootDisabled(opts *bind.FilterOpts, globalTableRoot [][32]byte) (*OperatorTableUpdaterGlobalRootDisabledIterator, error)
+	WatchGlobalRootDisabled(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterGlobalRootDisabled, globalTableRoot [][32]byte) (event.Subscription, error)
+	ParseGlobalRootDisabled(log types.Log) (*OperatorTableUpdaterGlobalRootDisabled, error)
+	FilterInitialized(opts *bind.FilterOpts) (*OperatorTableUpdaterInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*OperatorTableUpdaterInitialized, error)
+	FilterNewGlobalTableRoot(opts *bind.FilterOpts, referenceTimestamp []uint32, globalTableRoot [][32]byte) (*OperatorTableUpdaterNewGlobalTableRootIterator, error)
+	WatchNewGlobalTableRoot(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterNewGlobalTableRoot, referenceTimestamp []uint32, globalTableRoot [][32]byte) (event.Subscription, error)
+	ParseNewGlobalTableRoot(log types.Log) (*OperatorTableUpdaterNewGlobalTableRoot, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*OperatorTableUpdaterOwnershipTransferredIterator, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	ParseOwnershipTransferred(log types.Log) (*OperatorTableUpdaterOwnershipTransferred, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*OperatorTableUpdaterPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*OperatorTableUpdaterPaused, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*OperatorTableUpdaterUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*OperatorTableUpdaterUnpaused, error)
+}
+
+var (
+	_ OperatorTableUpdaterReader = (*OperatorTableUpdaterCaller)(nil)
+	_ OperatorTableUpdaterReader = (*OperatorTableUpdater)(nil)
+	_ OperatorTableUpdaterReader = (*FakeOperatorTableUpdater)(nil)
+	_ OperatorTableUpdaterWriter = (*OperatorTableUpdaterTransactor)(nil)
+	_ OperatorTableUpdaterWriter = (*OperatorTableUpdater)(nil)
+	_ OperatorTableUpdaterWriter = (*FakeOperatorTableUpdater)(nil)
+	_ OperatorTableUpdaterEvents = (*OperatorTableUpdaterFilterer)(nil)
+	_ OperatorTableUpdaterEvents = (*OperatorTableUpdater)(nil)
+	_ OperatorTableUpdaterEvents = (*FakeOperatorTableUpdater)(nil)
+)
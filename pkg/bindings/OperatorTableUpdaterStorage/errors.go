@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package OperatorTableUpdaterStorage
+
+// ErrCannotDisableGeneratorRoot is the typed form of the OperatorTableUpdaterStorage custom error CannotDisableGeneratorRoot().
+type ErrCannotDisableGeneratorRoot struct{}
+
+// Error implements the error interface.
+func (ErrCannotDisableGeneratorRoot) Error() string {
+	return "OperatorTableUpdaterStorage: CannotDisableGeneratorRoot()"
+}
+
+// ErrCertificateInvalid is the typed form of the OperatorTableUpdaterStorage custom error CertificateInvalid().
+type ErrCertificateInvalid struct{}
+
+// Error implements the error interface.
+func (ErrCertificateInvalid) Error() string {
+	return "OperatorTableUpdaterStorage: CertificateInvalid()"
+}
+
+// ErrGlobalTableRootInFuture is the typed form of the OperatorTableUpdaterStorage custom error GlobalTableRootInFuture().
+type ErrGlobalTableRootInFuture struct{}
+
+// Error implements the error interface.
+func (ErrGlobalTableRootInFuture) Error() string {
+	return "OperatorTableUpdaterStorage: GlobalTableRootInFuture()"
+}
+
+// ErrGlobalTableRootStale is the typed form of the OperatorTableUpdaterStorage custom error GlobalTableRootStale().
+type ErrGlobalTableRootStale struct{}
+
+// Error implements the error interface.
+func (ErrGlobalTableRootStale) Error() string {
+	return "OperatorTableUpdaterStorage: GlobalTableRootStale()"
+}
+
+// ErrInvalidConfirmationThreshold is the typed form of the OperatorTableUpdaterStorage custom error InvalidConfirmationThreshold().
+type ErrInvalidConfirmationThreshold struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConfirmationThreshold) Error() string {
+	return "OperatorTableUpdaterStorage: InvalidConfirmationThreshold()"
+}
+
+// ErrInvalidCurveType is the typed form of the OperatorTableUpdaterStorage custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "OperatorTableUpdaterStorage: InvalidCurveType()"
+}
+
+// ErrInvalidGenerator is the typed form of the OperatorTableUpdaterStorage custom error InvalidGenerator().
+type ErrInvalidGenerator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGenerator) Error() string {
+	return "OperatorTableUpdaterStorage: InvalidGenerator()"
+}
+
+// ErrInvalidGlobalTableRoot is the typed form of the OperatorTableUpdaterStorage custom error InvalidGlobalTableRoot().
+type ErrInvalidGlobalTableRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGlobalTableRoot) Error() string {
+	return "OperatorTableUpdaterStorage: InvalidGlobalTableRoot()"
+}
+
+// ErrInvalidMessageHash is the typed form of the OperatorTableUpdaterStorage custom error InvalidMessageHash().
+type ErrInvalidMessageHash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMessageHash) Error() string {
+	return "OperatorTableUpdaterStorage: InvalidMessageHash()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the OperatorTableUpdaterStorage custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "OperatorTableUpdaterStorage: InvalidOperatorSet()"
+}
+
+// ErrInvalidOperatorSetProof is the typed form of the OperatorTableUpdaterStorage custom error InvalidOperatorSetProof().
+type ErrInvalidOperatorSetProof struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSetProof) Error() string {
+	return "OperatorTableUpdaterStorage: InvalidOperatorSetProof()"
+}
+
+// ErrInvalidRoot is the typed form of the OperatorTableUpdaterStorage custom error InvalidRoot().
+type ErrInvalidRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRoot) Error() string {
+	return "OperatorTableUpdaterStorage: InvalidRoot()"
+}
+
+// ErrTableUpdateForPastTimestamp is the typed form of the OperatorTableUpdaterStorage custom error TableUpdateForPastTimestamp().
+type ErrTableUpdateForPastTimestamp struct{}
+
+// Error implements the error interface.
+func (ErrTableUpdateForPastTimestamp) Error() string {
+	return "OperatorTableUpdaterStorage: TableUpdateForPastTimestamp()"
+}
+
+// OperatorTableUpdaterStorageErrors maps the name of every custom error in the OperatorTableUpdaterStorage ABI to its typed Go error.
+var OperatorTableUpdaterStorageErrors = map[string]error{
+	"CannotDisableGeneratorRoot":   ErrCannotDisableGeneratorRoot{},
+	"CertificateInvalid":           ErrCertificateInvalid{},
+	"GlobalTableRootInFuture":      ErrGlobalTableRootInFuture{},
+	"GlobalTableRootStale":         ErrGlobalTableRootStale{},
+	"InvalidConfirmationThreshold": ErrInvalidConfirmationThreshold{},
+	"InvalidCurveType":             ErrInvalidCurveType{},
+	"InvalidGenerator":             ErrInvalidGenerator{},
+	"InvalidGlobalTableRoot":       ErrInvalidGlobalTableRoot{},
+	"InvalidMessageHash":           ErrInvalidMessageHash{},
+	"InvalidOperatorSet":           ErrInvalidOperatorSet{},
+	"InvalidOperatorSetProof":      ErrInvalidOperatorSetProof{},
+	"InvalidRoot":                  ErrInvalidRoot{},
+	"TableUpdateForPastTimestamp":  ErrTableUpdateForPastTimestamp{},
+}
@@ -0,0 +1,393 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package OperatorTableUpdaterStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeOperatorTableUpdaterStorage is an in-memory OperatorTableUpdaterStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded OperatorTableUpdaterStorageFilterer.
+type FakeOperatorTableUpdaterStorage struct {
+	*fake.Contract
+	*OperatorTableUpdaterStorageFilterer
+}
+
+// NewFakeOperatorTableUpdaterStorage returns an empty fake of the contract at address.
+func NewFakeOperatorTableUpdaterStorage(address common.Address) *FakeOperatorTableUpdaterStorage {
+	contract := fake.NewContract(OperatorTableUpdaterStorageMetaData, address)
+	filterer, err := NewOperatorTableUpdaterStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeOperatorTableUpdaterStorage{Contract: contract, OperatorTableUpdaterStorageFilterer: filterer}
+}
+
+// GENERATORGLOBALTABLEROOT returns the values stubbed with StubGENERATORGLOBALTABLEROOT.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GENERATORGLOBALTABLEROOT(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("GENERATOR_GLOBAL_TABLE_ROOT", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENERATORGLOBALTABLEROOT sets what GENERATORGLOBALTABLEROOT returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGENERATORGLOBALTABLEROOT(out0 [32]byte) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("GENERATOR_GLOBAL_TABLE_ROOT", []interface{}{}, out0)
+}
+
+// GENERATORMAXSTALENESSPERIOD returns the values stubbed with StubGENERATORMAXSTALENESSPERIOD.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GENERATORMAXSTALENESSPERIOD(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("GENERATOR_MAX_STALENESS_PERIOD", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENERATORMAXSTALENESSPERIOD sets what GENERATORMAXSTALENESSPERIOD returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGENERATORMAXSTALENESSPERIOD(out0 uint32) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("GENERATOR_MAX_STALENESS_PERIOD", []interface{}{}, out0)
+}
+
+// GENERATORREFERENCETIMESTAMP returns the values stubbed with StubGENERATORREFERENCETIMESTAMP.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GENERATORREFERENCETIMESTAMP(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("GENERATOR_REFERENCE_TIMESTAMP", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENERATORREFERENCETIMESTAMP sets what GENERATORREFERENCETIMESTAMP returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGENERATORREFERENCETIMESTAMP(out0 uint32) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("GENERATOR_REFERENCE_TIMESTAMP", []interface{}{}, out0)
+}
+
+// GLOBALTABLEROOTCERTTYPEHASH returns the values stubbed with StubGLOBALTABLEROOTCERTTYPEHASH.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GLOBALTABLEROOTCERTTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("GLOBAL_TABLE_ROOT_CERT_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGLOBALTABLEROOTCERTTYPEHASH sets what GLOBALTABLEROOTCERTTYPEHASH returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGLOBALTABLEROOTCERTTYPEHASH(out0 [32]byte) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("GLOBAL_TABLE_ROOT_CERT_TYPEHASH", []interface{}{}, out0)
+}
+
+// MAXBPS returns the values stubbed with StubMAXBPS.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) MAXBPS(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("MAX_BPS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXBPS sets what MAXBPS returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubMAXBPS(out0 uint16) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("MAX_BPS", []interface{}{}, out0)
+}
+
+// Bn254CertificateVerifier returns the values stubbed with StubBn254CertificateVerifier.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) Bn254CertificateVerifier(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("bn254CertificateVerifier", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBn254CertificateVerifier sets what Bn254CertificateVerifier returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubBn254CertificateVerifier(out0 common.Address) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("bn254CertificateVerifier", []interface{}{}, out0)
+}
+
+// EcdsaCertificateVerifier returns the values stubbed with StubEcdsaCertificateVerifier.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) EcdsaCertificateVerifier(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("ecdsaCertificateVerifier", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubEcdsaCertificateVerifier sets what EcdsaCertificateVerifier returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubEcdsaCertificateVerifier(out0 common.Address) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("ecdsaCertificateVerifier", []interface{}{}, out0)
+}
+
+// GetCertificateVerifier returns the values stubbed with StubGetCertificateVerifier.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetCertificateVerifier(opts *bind.CallOpts, curveType uint8) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getCertificateVerifier", []interface{}{curveType}, &out0)
+	return out0, err
+}
+
+// StubGetCertificateVerifier sets what GetCertificateVerifier returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetCertificateVerifier(curveType uint8, out0 common.Address) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getCertificateVerifier", []interface{}{curveType}, out0)
+}
+
+// GetCurrentGlobalTableRoot returns the values stubbed with StubGetCurrentGlobalTableRoot.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetCurrentGlobalTableRoot(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getCurrentGlobalTableRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentGlobalTableRoot sets what GetCurrentGlobalTableRoot returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetCurrentGlobalTableRoot(out0 [32]byte) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getCurrentGlobalTableRoot", []interface{}{}, out0)
+}
+
+// GetGenerator returns the values stubbed with StubGetGenerator.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetGenerator(opts *bind.CallOpts) (OperatorSet, error) {
+	var out0 OperatorSet
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getGenerator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGenerator sets what GetGenerator returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetGenerator(out0 OperatorSet) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getGenerator", []interface{}{}, out0)
+}
+
+// GetGeneratorConfig returns the values stubbed with StubGetGeneratorConfig.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetGeneratorConfig(opts *bind.CallOpts) (ICrossChainRegistryTypesOperatorSetConfig, error) {
+	var out0 ICrossChainRegistryTypesOperatorSetConfig
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getGeneratorConfig", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGeneratorConfig sets what GetGeneratorConfig returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetGeneratorConfig(out0 ICrossChainRegistryTypesOperatorSetConfig) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getGeneratorConfig", []interface{}{}, out0)
+}
+
+// GetGeneratorReferenceTimestamp returns the values stubbed with StubGetGeneratorReferenceTimestamp.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetGeneratorReferenceTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getGeneratorReferenceTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetGeneratorReferenceTimestamp sets what GetGeneratorReferenceTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetGeneratorReferenceTimestamp(out0 uint32) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getGeneratorReferenceTimestamp", []interface{}{}, out0)
+}
+
+// GetGlobalTableRootByTimestamp returns the values stubbed with StubGetGlobalTableRootByTimestamp.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetGlobalTableRootByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getGlobalTableRootByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableRootByTimestamp sets what GetGlobalTableRootByTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetGlobalTableRootByTimestamp(referenceTimestamp uint32, out0 [32]byte) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getGlobalTableRootByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// GetGlobalTableUpdateMessageHash returns the values stubbed with StubGetGlobalTableUpdateMessageHash.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetGlobalTableUpdateMessageHash(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getGlobalTableUpdateMessageHash", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableUpdateMessageHash sets what GetGlobalTableUpdateMessageHash returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetGlobalTableUpdateMessageHash(globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32, out0 [32]byte) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getGlobalTableUpdateMessageHash", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, out0)
+}
+
+// GetGlobalTableUpdateSignableDigest returns the values stubbed with StubGetGlobalTableUpdateSignableDigest.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetGlobalTableUpdateSignableDigest(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getGlobalTableUpdateSignableDigest", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetGlobalTableUpdateSignableDigest sets what GetGlobalTableUpdateSignableDigest returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetGlobalTableUpdateSignableDigest(globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32, out0 [32]byte) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getGlobalTableUpdateSignableDigest", []interface{}{globalTableRoot, referenceTimestamp, referenceBlockNumber}, out0)
+}
+
+// GetLatestReferenceBlockNumber returns the values stubbed with StubGetLatestReferenceBlockNumber.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetLatestReferenceBlockNumber(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getLatestReferenceBlockNumber", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetLatestReferenceBlockNumber sets what GetLatestReferenceBlockNumber returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetLatestReferenceBlockNumber(out0 uint32) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getLatestReferenceBlockNumber", []interface{}{}, out0)
+}
+
+// GetLatestReferenceTimestamp returns the values stubbed with StubGetLatestReferenceTimestamp.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetLatestReferenceTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getLatestReferenceTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetLatestReferenceTimestamp sets what GetLatestReferenceTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetLatestReferenceTimestamp(out0 uint32) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getLatestReferenceTimestamp", []interface{}{}, out0)
+}
+
+// GetReferenceBlockNumberByTimestamp returns the values stubbed with StubGetReferenceBlockNumberByTimestamp.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetReferenceBlockNumberByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getReferenceBlockNumberByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubGetReferenceBlockNumberByTimestamp sets what GetReferenceBlockNumberByTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetReferenceBlockNumberByTimestamp(referenceTimestamp uint32, out0 uint32) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getReferenceBlockNumberByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// GetReferenceTimestampByBlockNumber returns the values stubbed with StubGetReferenceTimestampByBlockNumber.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GetReferenceTimestampByBlockNumber(opts *bind.CallOpts, referenceBlockNumber uint32) (uint32, error) {
+	var out0 uint32
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("getReferenceTimestampByBlockNumber", []interface{}{referenceBlockNumber}, &out0)
+	return out0, err
+}
+
+// StubGetReferenceTimestampByBlockNumber sets what GetReferenceTimestampByBlockNumber returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGetReferenceTimestampByBlockNumber(referenceBlockNumber uint32, out0 uint32) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("getReferenceTimestampByBlockNumber", []interface{}{referenceBlockNumber}, out0)
+}
+
+// GlobalRootConfirmationThreshold returns the values stubbed with StubGlobalRootConfirmationThreshold.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) GlobalRootConfirmationThreshold(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("globalRootConfirmationThreshold", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGlobalRootConfirmationThreshold sets what GlobalRootConfirmationThreshold returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubGlobalRootConfirmationThreshold(out0 uint16) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("globalRootConfirmationThreshold", []interface{}{}, out0)
+}
+
+// IsRootValid returns the values stubbed with StubIsRootValid.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) IsRootValid(opts *bind.CallOpts, globalTableRoot [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("isRootValid", []interface{}{globalTableRoot}, &out0)
+	return out0, err
+}
+
+// StubIsRootValid sets what IsRootValid returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubIsRootValid(globalTableRoot [32]byte, out0 bool) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("isRootValid", []interface{}{globalTableRoot}, out0)
+}
+
+// IsRootValidByTimestamp returns the values stubbed with StubIsRootValidByTimestamp.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) IsRootValidByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (bool, error) {
+	var out0 bool
+	err := _FakeOperatorTableUpdaterStorage.Contract.Read("isRootValidByTimestamp", []interface{}{referenceTimestamp}, &out0)
+	return out0, err
+}
+
+// StubIsRootValidByTimestamp sets what IsRootValidByTimestamp returns for the given arguments.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) StubIsRootValidByTimestamp(referenceTimestamp uint32, out0 bool) {
+	_FakeOperatorTableUpdaterStorage.Contract.Stub("isRootValidByTimestamp", []interface{}{referenceTimestamp}, out0)
+}
+
+// ConfirmGlobalTableRoot records a confirmGlobalTableRoot call after running the hook set with OnConfirmGlobalTableRoot.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) ConfirmGlobalTableRoot(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdaterStorage.Contract.Transact(opts, "confirmGlobalTableRoot", func() error {
+		if hook, ok := _FakeOperatorTableUpdaterStorage.Contract.Hook("confirmGlobalTableRoot").(func(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) error); ok {
+			return hook(opts, globalTableRootCert, globalTableRoot, referenceTimestamp, referenceBlockNumber)
+		}
+		return nil
+	}, globalTableRootCert, globalTableRoot, referenceTimestamp, referenceBlockNumber)
+}
+
+// OnConfirmGlobalTableRoot sets a hook run by every ConfirmGlobalTableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) OnConfirmGlobalTableRoot(hook func(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) error) {
+	_FakeOperatorTableUpdaterStorage.Contract.SetHook("confirmGlobalTableRoot", hook)
+}
+
+// DisableRoot records a disableRoot call after running the hook set with OnDisableRoot.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) DisableRoot(opts *bind.TransactOpts, globalTableRoot [32]byte) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdaterStorage.Contract.Transact(opts, "disableRoot", func() error {
+		if hook, ok := _FakeOperatorTableUpdaterStorage.Contract.Hook("disableRoot").(func(opts *bind.TransactOpts, globalTableRoot [32]byte) error); ok {
+			return hook(opts, globalTableRoot)
+		}
+		return nil
+	}, globalTableRoot)
+}
+
+// OnDisableRoot sets a hook run by every DisableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) OnDisableRoot(hook func(opts *bind.TransactOpts, globalTableRoot [32]byte) error) {
+	_FakeOperatorTableUpdaterStorage.Contract.SetHook("disableRoot", hook)
+}
+
+// SetGlobalRootConfirmationThreshold records a setGlobalRootConfirmationThreshold call after running the hook set with OnSetGlobalRootConfirmationThreshold.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) SetGlobalRootConfirmationThreshold(opts *bind.TransactOpts, bps uint16) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdaterStorage.Contract.Transact(opts, "setGlobalRootConfirmationThreshold", func() error {
+		if hook, ok := _FakeOperatorTableUpdaterStorage.Contract.Hook("setGlobalRootConfirmationThreshold").(func(opts *bind.TransactOpts, bps uint16) error); ok {
+			return hook(opts, bps)
+		}
+		return nil
+	}, bps)
+}
+
+// OnSetGlobalRootConfirmationThreshold sets a hook run by every SetGlobalRootConfirmationThreshold call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) OnSetGlobalRootConfirmationThreshold(hook func(opts *bind.TransactOpts, bps uint16) error) {
+	_FakeOperatorTableUpdaterStorage.Contract.SetHook("setGlobalRootConfirmationThreshold", hook)
+}
+
+// UpdateGenerator records a updateGenerator call after running the hook set with OnUpdateGenerator.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) UpdateGenerator(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdaterStorage.Contract.Transact(opts, "updateGenerator", func() error {
+		if hook, ok := _FakeOperatorTableUpdaterStorage.Contract.Hook("updateGenerator").(func(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) error); ok {
+			return hook(opts, generator, generatorInfo)
+		}
+		return nil
+	}, generator, generatorInfo)
+}
+
+// OnUpdateGenerator sets a hook run by every UpdateGenerator call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) OnUpdateGenerator(hook func(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) error) {
+	_FakeOperatorTableUpdaterStorage.Contract.SetHook("updateGenerator", hook)
+}
+
+// UpdateOperatorTable records a updateOperatorTable call after running the hook set with OnUpdateOperatorTable.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) UpdateOperatorTable(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) (*types.Transaction, error) {
+	return _FakeOperatorTableUpdaterStorage.Contract.Transact(opts, "updateOperatorTable", func() error {
+		if hook, ok := _FakeOperatorTableUpdaterStorage.Contract.Hook("updateOperatorTable").(func(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) error); ok {
+			return hook(opts, referenceTimestamp, globalTableRoot, operatorSetIndex, proof, operatorTableBytes)
+		}
+		return nil
+	}, referenceTimestamp, globalTableRoot, operatorSetIndex, proof, operatorTableBytes)
+}
+
+// OnUpdateOperatorTable sets a hook run by every UpdateOperatorTable call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) OnUpdateOperatorTable(hook func(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) error) {
+	_FakeOperatorTableUpdaterStorage.Contract.SetHook("updateOperatorTable", hook)
+}
+
+// EmitGeneratorUpdated appends the event to the fake's logs.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) EmitGeneratorUpdated(event *OperatorTableUpdaterStorageGeneratorUpdated) {
+	_FakeOperatorTableUpdaterStorage.Contract.Emit("GeneratorUpdated", event)
+}
+
+// EmitGlobalRootConfirmationThresholdUpdated appends the event to the fake's logs.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) EmitGlobalRootConfirmationThresholdUpdated(event *OperatorTableUpdaterStorageGlobalRootConfirmationThresholdUpdated) {
+	_FakeOperatorTableUpdaterStorage.Contract.Emit("GlobalRootConfirmationThresholdUpdated", event)
+}
+
+// EmitGlobalRootDisabled appends the event to the fake's logs.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) EmitGlobalRootDisabled(event *OperatorTableUpdaterStorageGlobalRootDisabled) {
+	_FakeOperatorTableUpdaterStorage.Contract.Emit("GlobalRootDisabled", event)
+}
+
+// EmitNewGlobalTableRoot appends the event to the fake's logs.
+func (_FakeOperatorTableUpdaterStorage *FakeOperatorTableUpdaterStorage) EmitNewGlobalTableRoot(event *OperatorTableUpdaterStorageNewGlobalTableRoot) {
+	_FakeOperatorTableUpdaterStorage.Contract.Emit("NewGlobalTableRoot", event)
+}
@@ -0,0 +1,76 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package OperatorTableUpdaterStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// OperatorTableUpdaterStorageReader is the read-only surface of the OperatorTableUpdaterStorage
+// binding, implemented by OperatorTableUpdaterStorageCaller, OperatorTableUpdaterStorage and FakeOperatorTableUpdaterStorage.
+type OperatorTableUpdaterStorageReader interface {
+	GENERATORGLOBALTABLEROOT(opts *bind.CallOpts) ([32]byte, error)
+	GENERATORMAXSTALENESSPERIOD(opts *bind.CallOpts) (uint32, error)
+	GENERATORREFERENCETIMESTAMP(opts *bind.CallOpts) (uint32, error)
+	GLOBALTABLEROOTCERTTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	MAXBPS(opts *bind.CallOpts) (uint16, error)
+	Bn254CertificateVerifier(opts *bind.CallOpts) (common.Address, error)
+	EcdsaCertificateVerifier(opts *bind.CallOpts) (common.Address, error)
+	GetCertificateVerifier(opts *bind.CallOpts, curveType uint8) (common.Address, error)
+	GetCurrentGlobalTableRoot(opts *bind.CallOpts) ([32]byte, error)
+	GetGenerator(opts *bind.CallOpts) (OperatorSet, error)
+	GetGeneratorConfig(opts *bind.CallOpts) (ICrossChainRegistryTypesOperatorSetConfig, error)
+	GetGeneratorReferenceTimestamp(opts *bind.CallOpts) (uint32, error)
+	GetGlobalTableRootByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) ([32]byte, error)
+	GetGlobalTableUpdateMessageHash(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error)
+	GetGlobalTableUpdateSignableDigest(opts *bind.CallOpts, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) ([32]byte, error)
+	GetLatestReferenceBlockNumber(opts *bind.CallOpts) (uint32, error)
+	GetLatestReferenceTimestamp(opts *bind.CallOpts) (uint32, error)
+	GetReferenceBlockNumberByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (uint32, error)
+	GetReferenceTimestampByBlockNumber(opts *bind.CallOpts, referenceBlockNumber uint32) (uint32, error)
+	GlobalRootConfirmationThreshold(opts *bind.CallOpts) (uint16, error)
+	IsRootValid(opts *bind.CallOpts, globalTableRoot [32]byte) (bool, error)
+	IsRootValidByTimestamp(opts *bind.CallOpts, referenceTimestamp uint32) (bool, error)
+}
+
+// OperatorTableUpdaterStorageWriter is the transacting surface of the OperatorTableUpdaterStorage
+// binding, implemented by OperatorTableUpdaterStorageTransactor, OperatorTableUpdaterStorage and FakeOperatorTableUpdaterStorage.
+type OperatorTableUpdaterStorageWriter interface {
+	ConfirmGlobalTableRoot(opts *bind.TransactOpts, globalTableRootCert IBN254CertificateVerifierTypesBN254Certificate, globalTableRoot [32]byte, referenceTimestamp uint32, referenceBlockNumber uint32) (*types.Transaction, error)
+	DisableRoot(opts *bind.TransactOpts, globalTableRoot [32]byte) (*types.Transaction, error)
+	SetGlobalRootConfirmationThreshold(opts *bind.TransactOpts, bps uint16) (*types.Transaction, error)
+	UpdateGenerator(opts *bind.TransactOpts, generator OperatorSet, generatorInfo IOperatorTableCalculatorTypesBN254OperatorSetInfo) (*types.Transaction, error)
+	UpdateOperatorTable(opts *bind.TransactOpts, referenceTimestamp uint32, globalTableRoot [32]byte, operatorSetIndex uint32, proof []byte, operatorTableBytes []byte) (*types.Transaction, error)
+}
+
+// OperatorTableUpdaterStorageEvents is the event filtering surface of the OperatorTableUpdaterStorage
+// binding, implemented by OperatorTableUpdaterStorageFilterer, OperatorTableUpdaterStorage and FakeOperatorTableUpdaterStorage.
+type OperatorTableUpdaterStorageEvents interface {
+	FilterGeneratorUpdated(opts *bind.FilterOpts) (*OperatorTableUpdaterStorageGeneratorUpdatedIterator, error)
+	WatchGeneratorUpdated(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterStorageGeneratorUpdated) (event.Subscription, error)
+	ParseGeneratorUpdated(log types.Log) (*OperatorTableUpdaterStorageGeneratorUpdated, error)
+	FilterGlobalRootConfirmationThresholdUpdated(opts *bind.FilterOpts) (*OperatorTableUpdaterStorageGlobalRootConfirmationThresholdUpdatedIterator, error)
+	WatchGlobalRootConfirmationThresholdUpdated(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterStorageGlobalRootConfirmationThresholdUpdated) (event.Subscription, error)
+	ParseGlobalRootConfirmationThresholdUpdated(log types.Log) (*OperatorTableUpdaterStorageGlobalRootConfirmationThresholdUpdated, error)
+	FilterGlobalRootDisabled(opts *bind.FilterOpts, globalTableRoot [][32]byte) (*OperatorTableUpdaterStorageGlobalRootDisabledIterator, error)
+	WatchGlobalRootDisabled(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterStorageGlobalRootDisabled, globalTableRoot [][32]byte) (event.Subscription, error)
+	ParseGlobalRootDisabled(log types.Log) (*OperatorTableUpdaterStorageGlobalRootDisabled, error)
+	FilterNewGlobalTableRoot(opts *bind.FilterOpts, referenceTimestamp []uint32, globalTableRoot [][32]byte) (*OperatorTableUpdaterStorageNewGlobalTableRootIterator, error)
+	WatchNewGlobalTableRoot(opts *bind.WatchOpts, sink chan<- *OperatorTableUpdaterStorageNewGlobalTableRoot, referenceTimestamp []uint32, globalTableRoot [][32]byte) (event.Subscription, error)
+	ParseNewGlobalTableRoot(log types.Log) (*OperatorTableUpdaterStorageNewGlobalTableRoot, error)
+}
+
+var (
+	_ OperatorTableUpdaterStorageReader = (*OperatorTableUpdaterStorageCaller)(nil)
+	_ OperatorTableUpdaterStorageReader = (*OperatorTableUpdaterStorage)(nil)
+	_ OperatorTableUpdaterStorageReader = (*FakeOperatorTableUpdaterStorage)(nil)
+	_ OperatorTableUpdaterStorageWriter = (*OperatorTableUpdaterStorageTransactor)(nil)
+	_ OperatorTableUpdaterStorageWriter = (*OperatorTableUpdaterStorage)(nil)
+	_ OperatorTableUpdaterStorageWriter = (*FakeOperatorTableUpdaterStorage)(nil)
+	_ OperatorTableUpdaterStorageEvents = (*OperatorTableUpdaterStorageFilterer)(nil)
+	_ OperatorTableUpdaterStorageEvents = (*OperatorTableUpdaterStorage)(nil)
+	_ OperatorTableUpdaterStorageEvents = (*FakeOperatorTableUpdaterStorage)(nil)
+)
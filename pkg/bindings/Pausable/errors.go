@@ -0,0 +1,52 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Pausable
+
+// ErrCurrentlyPaused is the typed form of the Pausable custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "Pausable: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the Pausable custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "Pausable: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the Pausable custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "Pausable: InvalidNewPausedStatus()"
+}
+
+// ErrOnlyPauser is the typed form of the Pausable custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "Pausable: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the Pausable custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "Pausable: OnlyUnpauser()"
+}
+
+// PausableErrors maps the name of every custom error in the Pausable ABI to its typed Go error.
+var PausableErrors = map[string]error{
+	"CurrentlyPaused":        ErrCurrentlyPaused{},
+	"InputAddressZero":       ErrInputAddressZero{},
+	"InvalidNewPausedStatus": ErrInvalidNewPausedStatus{},
+	"OnlyPauser":             ErrOnlyPauser{},
+	"OnlyUnpauser":           ErrOnlyUnpauser{},
+}
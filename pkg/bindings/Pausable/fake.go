@@ -0,0 +1,124 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Pausable
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakePausable is an in-memory Pausable for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded PausableFilterer.
+type FakePausable struct {
+	*fake.Contract
+	*PausableFilterer
+}
+
+// NewFakePausable returns an empty fake of the contract at address.
+func NewFakePausable(address common.Address) *FakePausable {
+	contract := fake.NewContract(PausableMetaData, address)
+	filterer, err := NewPausableFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakePausable{Contract: contract, PausableFilterer: filterer}
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakePausable *FakePausable) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakePausable.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakePausable *FakePausable) StubPaused(index uint8, out0 bool) {
+	_FakePausable.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakePausable *FakePausable) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakePausable.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakePausable *FakePausable) StubPaused0(out0 *big.Int) {
+	_FakePausable.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakePausable *FakePausable) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakePausable.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakePausable *FakePausable) StubPauserRegistry(out0 common.Address) {
+	_FakePausable.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakePausable *FakePausable) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakePausable.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakePausable.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePausable *FakePausable) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakePausable.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakePausable *FakePausable) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakePausable.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakePausable.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePausable *FakePausable) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakePausable.Contract.SetHook("pauseAll", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakePausable *FakePausable) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakePausable.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakePausable.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePausable *FakePausable) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakePausable.Contract.SetHook("unpause", hook)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakePausable *FakePausable) EmitPaused(event *PausablePaused) {
+	_FakePausable.Contract.Emit("Paused", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakePausable *FakePausable) EmitUnpaused(event *PausableUnpaused) {
+	_FakePausable.Contract.Emit("Unpaused", event)
+}
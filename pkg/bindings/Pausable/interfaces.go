@@ -0,0 +1,50 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Pausable
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// PausableReader is the read-only surface of the Pausable
+// binding, implemented by PausableCaller, Pausable and FakePausable.
+type PausableReader interface {
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+}
+
+// PausableWriter is the transacting surface of the Pausable
+// binding, implemented by PausableTransactor, Pausable and FakePausable.
+type PausableWriter interface {
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+}
+
+// PausableEvents is the event filtering surface of the Pausable
+// binding, implemented by PausableFilterer, Pausable and FakePausable.
+type PausableEvents interface {
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*PausablePausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *PausablePaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*PausablePaused, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*PausableUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *PausableUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*PausableUnpaused, error)
+}
+
+var (
+	_ PausableReader = (*PausableCaller)(nil)
+	_ PausableReader = (*Pausable)(nil)
+	_ PausableReader = (*FakePausable)(nil)
+	_ PausableWriter = (*PausableTransactor)(nil)
+	_ PausableWriter = (*Pausable)(nil)
+	_ PausableWriter = (*FakePausable)(nil)
+	_ PausableEvents = (*PausableFilterer)(nil)
+	_ PausableEvents = (*Pausable)(nil)
+	_ PausableEvents = (*FakePausable)(nil)
+)
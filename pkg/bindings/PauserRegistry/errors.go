@@ -0,0 +1,25 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PauserRegistry
+
+// ErrInputAddressZero is the typed form of the PauserRegistry custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "PauserRegistry: InputAddressZero()"
+}
+
+// ErrOnlyUnpauser is the typed form of the PauserRegistry custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "PauserRegistry: OnlyUnpauser()"
+}
+
+// PauserRegistryErrors maps the name of every custom error in the PauserRegistry ABI to its typed Go error.
+var PauserRegistryErrors = map[string]error{
+	"InputAddressZero": ErrInputAddressZero{},
+	"OnlyUnpauser":     ErrOnlyUnpauser{},
+}
@@ -0,0 +1,95 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PauserRegistry
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakePauserRegistry is an in-memory PauserRegistry for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded PauserRegistryFilterer.
+type FakePauserRegistry struct {
+	*fake.Contract
+	*PauserRegistryFilterer
+}
+
+// NewFakePauserRegistry returns an empty fake of the contract at address.
+func NewFakePauserRegistry(address common.Address) *FakePauserRegistry {
+	contract := fake.NewContract(PauserRegistryMetaData, address)
+	filterer, err := NewPauserRegistryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakePauserRegistry{Contract: contract, PauserRegistryFilterer: filterer}
+}
+
+// IsPauser returns the values stubbed with StubIsPauser.
+func (_FakePauserRegistry *FakePauserRegistry) IsPauser(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakePauserRegistry.Contract.Read("isPauser", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubIsPauser sets what IsPauser returns for the given arguments.
+func (_FakePauserRegistry *FakePauserRegistry) StubIsPauser(arg0 common.Address, out0 bool) {
+	_FakePauserRegistry.Contract.Stub("isPauser", []interface{}{arg0}, out0)
+}
+
+// Unpauser returns the values stubbed with StubUnpauser.
+func (_FakePauserRegistry *FakePauserRegistry) Unpauser(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakePauserRegistry.Contract.Read("unpauser", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubUnpauser sets what Unpauser returns for the given arguments.
+func (_FakePauserRegistry *FakePauserRegistry) StubUnpauser(out0 common.Address) {
+	_FakePauserRegistry.Contract.Stub("unpauser", []interface{}{}, out0)
+}
+
+// SetIsPauser records a setIsPauser call after running the hook set with OnSetIsPauser.
+func (_FakePauserRegistry *FakePauserRegistry) SetIsPauser(opts *bind.TransactOpts, newPauser common.Address, canPause bool) (*types.Transaction, error) {
+	return _FakePauserRegistry.Contract.Transact(opts, "setIsPauser", func() error {
+		if hook, ok := _FakePauserRegistry.Contract.Hook("setIsPauser").(func(opts *bind.TransactOpts, newPauser common.Address, canPause bool) error); ok {
+			return hook(opts, newPauser, canPause)
+		}
+		return nil
+	}, newPauser, canPause)
+}
+
+// OnSetIsPauser sets a hook run by every SetIsPauser call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePauserRegistry *FakePauserRegistry) OnSetIsPauser(hook func(opts *bind.TransactOpts, newPauser common.Address, canPause bool) error) {
+	_FakePauserRegistry.Contract.SetHook("setIsPauser", hook)
+}
+
+// SetUnpauser records a setUnpauser call after running the hook set with OnSetUnpauser.
+func (_FakePauserRegistry *FakePauserRegistry) SetUnpauser(opts *bind.TransactOpts, newUnpauser common.Address) (*types.Transaction, error) {
+	return _FakePauserRegistry.Contract.Transact(opts, "setUnpauser", func() error {
+		if hook, ok := _FakePauserRegistry.Contract.Hook("setUnpauser").(func(opts *bind.TransactOpts, newUnpauser common.Address) error); ok {
+			return hook(opts, newUnpauser)
+		}
+		return nil
+	}, newUnpauser)
+}
+
+// OnSetUnpauser sets a hook run by every SetUnpauser call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePauserRegistry *FakePauserRegistry) OnSetUnpauser(hook func(opts *bind.TransactOpts, newUnpauser common.Address) error) {
+	_FakePauserRegistry.Contract.SetHook("setUnpauser", hook)
+}
+
+// EmitPauserStatusChanged appends the event to the fake's logs.
+func (_FakePauserRegistry *FakePauserRegistry) EmitPauserStatusChanged(event *PauserRegistryPauserStatusChanged) {
+	_FakePauserRegistry.Contract.Emit("PauserStatusChanged", event)
+}
+
+// EmitUnpauserChanged appends the event to the fake's logs.
+func (_FakePauserRegistry *FakePauserRegistry) EmitUnpauserChanged(event *PauserRegistryUnpauserChanged) {
+	_FakePauserRegistry.Contract.Emit("UnpauserChanged", event)
+}
@@ -0,0 +1,47 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PauserRegistry
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// PauserRegistryReader is the read-only surface of the PauserRegistry
+// binding, implemented by PauserRegistryCaller, PauserRegistry and FakePauserRegistry.
+type PauserRegistryReader interface {
+	IsPauser(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	Unpauser(opts *bind.CallOpts) (common.Address, error)
+}
+
+// PauserRegistryWriter is the transacting surface of the PauserRegistry
+// binding, implemented by PauserRegistryTransactor, PauserRegistry and FakePauserRegistry.
+type PauserRegistryWriter interface {
+	SetIsPauser(opts *bind.TransactOpts, newPauser common.Address, canPause bool) (*types.Transaction, error)
+	SetUnpauser(opts *bind.TransactOpts, newUnpauser common.Address) (*types.Transaction, error)
+}
+
+// PauserRegistryEvents is the event filtering surface of the PauserRegistry
+// binding, implemented by PauserRegistryFilterer, PauserRegistry and FakePauserRegistry.
+type PauserRegistryEvents interface {
+	FilterPauserStatusChanged(opts *bind.FilterOpts) (*PauserRegistryPauserStatusChangedIterator, error)
+	WatchPauserStatusChanged(opts *bind.WatchOpts, sink chan<- *PauserRegistryPauserStatusChanged) (event.Subscription, error)
+	ParsePauserStatusChanged(log types.Log) (*PauserRegistryPauserStatusChanged, error)
+	FilterUnpauserChanged(opts *bind.FilterOpts) (*PauserRegistryUnpauserChangedIterator, error)
+	WatchUnpauserChanged(opts *bind.WatchOpts, sink chan<- *PauserRegistryUnpauserChanged) (event.Subscription, error)
+	ParseUnpauserChanged(log types.Log) (*PauserRegistryUnpauserChanged, error)
+}
+
+var (
+	_ PauserRegistryReader = (*PauserRegistryCaller)(nil)
+	_ PauserRegistryReader = (*PauserRegistry)(nil)
+	_ PauserRegistryReader = (*FakePauserRegistry)(nil)
+	_ PauserRegistryWriter = (*PauserRegistryTransactor)(nil)
+	_ PauserRegistryWriter = (*PauserRegistry)(nil)
+	_ PauserRegistryWriter = (*FakePauserRegistry)(nil)
+	_ PauserRegistryEvents = (*PauserRegistryFilterer)(nil)
+	_ PauserRegistryEvents = (*PauserRegistry)(nil)
+	_ PauserRegistryEvents = (*FakePauserRegistry)(nil)
+)
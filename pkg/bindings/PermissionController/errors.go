@@ -0,0 +1,79 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionController
+
+// ErrAdminAlreadyPending is the typed form of the PermissionController custom error AdminAlreadyPending().
+type ErrAdminAlreadyPending struct{}
+
+// Error implements the error interface.
+func (ErrAdminAlreadyPending) Error() string {
+	return "PermissionController: AdminAlreadyPending()"
+}
+
+// ErrAdminAlreadySet is the typed form of the PermissionController custom error AdminAlreadySet().
+type ErrAdminAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrAdminAlreadySet) Error() string {
+	return "PermissionController: AdminAlreadySet()"
+}
+
+// ErrAdminNotPending is the typed form of the PermissionController custom error AdminNotPending().
+type ErrAdminNotPending struct{}
+
+// Error implements the error interface.
+func (ErrAdminNotPending) Error() string {
+	return "PermissionController: AdminNotPending()"
+}
+
+// ErrAdminNotSet is the typed form of the PermissionController custom error AdminNotSet().
+type ErrAdminNotSet struct{}
+
+// Error implements the error interface.
+func (ErrAdminNotSet) Error() string {
+	return "PermissionController: AdminNotSet()"
+}
+
+// ErrAppointeeAlreadySet is the typed form of the PermissionController custom error AppointeeAlreadySet().
+type ErrAppointeeAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrAppointeeAlreadySet) Error() string {
+	return "PermissionController: AppointeeAlreadySet()"
+}
+
+// ErrAppointeeNotSet is the typed form of the PermissionController custom error AppointeeNotSet().
+type ErrAppointeeNotSet struct{}
+
+// Error implements the error interface.
+func (ErrAppointeeNotSet) Error() string {
+	return "PermissionController: AppointeeNotSet()"
+}
+
+// ErrCannotHaveZeroAdmins is the typed form of the PermissionController custom error CannotHaveZeroAdmins().
+type ErrCannotHaveZeroAdmins struct{}
+
+// Error implements the error interface.
+func (ErrCannotHaveZeroAdmins) Error() string {
+	return "PermissionController: CannotHaveZeroAdmins()"
+}
+
+// ErrNotAdmin is the typed form of the PermissionController custom error NotAdmin().
+type ErrNotAdmin struct{}
+
+// Error implements the error interface.
+func (ErrNotAdmin) Error() string {
+	return "PermissionController: NotAdmin()"
+}
+
+// PermissionControllerErrors maps the name of every custom error in the PermissionController ABI to its typed Go error.
+var PermissionControllerErrors = map[string]error{
+	"AdminAlreadyPending":  ErrAdminAlreadyPending{},
+	"AdminAlreadySet":      ErrAdminAlreadySet{},
+	"AdminNotPending":      ErrAdminNotPending{},
+	"AdminNotSet":          ErrAdminNotSet{},
+	"AppointeeAlreadySet":  ErrAppointeeAlreadySet{},
+	"AppointeeNotSet":      ErrAppointeeNotSet{},
+	"CannotHaveZeroAdmins": ErrCannotHaveZeroAdmins{},
+	"NotAdmin":             ErrNotAdmin{},
+}
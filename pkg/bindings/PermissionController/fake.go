@@ -0,0 +1,245 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionController
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakePermissionController is an in-memory PermissionController for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded PermissionControllerFilterer.
+type FakePermissionController struct {
+	*fake.Contract
+	*PermissionControllerFilterer
+}
+
+// NewFakePermissionController returns an empty fake of the contract at address.
+func NewFakePermissionController(address common.Address) *FakePermissionController {
+	contract := fake.NewContract(PermissionControllerMetaData, address)
+	filterer, err := NewPermissionControllerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakePermissionController{Contract: contract, PermissionControllerFilterer: filterer}
+}
+
+// CanCall returns the values stubbed with StubCanCall.
+func (_FakePermissionController *FakePermissionController) CanCall(opts *bind.CallOpts, account common.Address, caller common.Address, target common.Address, selector [4]byte) (bool, error) {
+	var out0 bool
+	err := _FakePermissionController.Contract.Read("canCall", []interface{}{account, caller, target, selector}, &out0)
+	return out0, err
+}
+
+// StubCanCall sets what CanCall returns for the given arguments.
+func (_FakePermissionController *FakePermissionController) StubCanCall(account common.Address, caller common.Address, target common.Address, selector [4]byte, out0 bool) {
+	_FakePermissionController.Contract.Stub("canCall", []interface{}{account, caller, target, selector}, out0)
+}
+
+// GetAdmins returns the values stubbed with StubGetAdmins.
+func (_FakePermissionController *FakePermissionController) GetAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakePermissionController.Contract.Read("getAdmins", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubGetAdmins sets what GetAdmins returns for the given arguments.
+func (_FakePermissionController *FakePermissionController) StubGetAdmins(account common.Address, out0 []common.Address) {
+	_FakePermissionController.Contract.Stub("getAdmins", []interface{}{account}, out0)
+}
+
+// GetAppointeePermissions returns the values stubbed with StubGetAppointeePermissions.
+func (_FakePermissionController *FakePermissionController) GetAppointeePermissions(opts *bind.CallOpts, account common.Address, appointee common.Address) ([]common.Address, [][4]byte, error) {
+	var out0 []common.Address
+	var out1 [][4]byte
+	err := _FakePermissionController.Contract.Read("getAppointeePermissions", []interface{}{account, appointee}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetAppointeePermissions sets what GetAppointeePermissions returns for the given arguments.
+func (_FakePermissionController *FakePermissionController) StubGetAppointeePermissions(account common.Address, appointee common.Address, out0 []common.Address, out1 [][4]byte) {
+	_FakePermissionController.Contract.Stub("getAppointeePermissions", []interface{}{account, appointee}, out0, out1)
+}
+
+// GetAppointees returns the values stubbed with StubGetAppointees.
+func (_FakePermissionController *FakePermissionController) GetAppointees(opts *bind.CallOpts, account common.Address, target common.Address, selector [4]byte) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakePermissionController.Contract.Read("getAppointees", []interface{}{account, target, selector}, &out0)
+	return out0, err
+}
+
+// StubGetAppointees sets what GetAppointees returns for the given arguments.
+func (_FakePermissionController *FakePermissionController) StubGetAppointees(account common.Address, target common.Address, selector [4]byte, out0 []common.Address) {
+	_FakePermissionController.Contract.Stub("getAppointees", []interface{}{account, target, selector}, out0)
+}
+
+// GetPendingAdmins returns the values stubbed with StubGetPendingAdmins.
+func (_FakePermissionController *FakePermissionController) GetPendingAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakePermissionController.Contract.Read("getPendingAdmins", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubGetPendingAdmins sets what GetPendingAdmins returns for the given arguments.
+func (_FakePermissionController *FakePermissionController) StubGetPendingAdmins(account common.Address, out0 []common.Address) {
+	_FakePermissionController.Contract.Stub("getPendingAdmins", []interface{}{account}, out0)
+}
+
+// IsAdmin returns the values stubbed with StubIsAdmin.
+func (_FakePermissionController *FakePermissionController) IsAdmin(opts *bind.CallOpts, account common.Address, caller common.Address) (bool, error) {
+	var out0 bool
+	err := _FakePermissionController.Contract.Read("isAdmin", []interface{}{account, caller}, &out0)
+	return out0, err
+}
+
+// StubIsAdmin sets what IsAdmin returns for the given arguments.
+func (_FakePermissionController *FakePermissionController) StubIsAdmin(account common.Address, caller common.Address, out0 bool) {
+	_FakePermissionController.Contract.Stub("isAdmin", []interface{}{account, caller}, out0)
+}
+
+// IsPendingAdmin returns the values stubbed with StubIsPendingAdmin.
+func (_FakePermissionController *FakePermissionController) IsPendingAdmin(opts *bind.CallOpts, account common.Address, pendingAdmin common.Address) (bool, error) {
+	var out0 bool
+	err := _FakePermissionController.Contract.Read("isPendingAdmin", []interface{}{account, pendingAdmin}, &out0)
+	return out0, err
+}
+
+// StubIsPendingAdmin sets what IsPendingAdmin returns for the given arguments.
+func (_FakePermissionController *FakePermissionController) StubIsPendingAdmin(account common.Address, pendingAdmin common.Address, out0 bool) {
+	_FakePermissionController.Contract.Stub("isPendingAdmin", []interface{}{account, pendingAdmin}, out0)
+}
+
+// AcceptAdmin records a acceptAdmin call after running the hook set with OnAcceptAdmin.
+func (_FakePermissionController *FakePermissionController) AcceptAdmin(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _FakePermissionController.Contract.Transact(opts, "acceptAdmin", func() error {
+		if hook, ok := _FakePermissionController.Contract.Hook("acceptAdmin").(func(opts *bind.TransactOpts, account common.Address) error); ok {
+			return hook(opts, account)
+		}
+		return nil
+	}, account)
+}
+
+// OnAcceptAdmin sets a hook run by every AcceptAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionController *FakePermissionController) OnAcceptAdmin(hook func(opts *bind.TransactOpts, account common.Address) error) {
+	_FakePermissionController.Contract.SetHook("acceptAdmin", hook)
+}
+
+// AddPendingAdmin records a addPendingAdmin call after running the hook set with OnAddPendingAdmin.
+func (_FakePermissionController *FakePermissionController) AddPendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakePermissionController.Contract.Transact(opts, "addPendingAdmin", func() error {
+		if hook, ok := _FakePermissionController.Contract.Hook("addPendingAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnAddPendingAdmin sets a hook run by every AddPendingAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionController *FakePermissionController) OnAddPendingAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakePermissionController.Contract.SetHook("addPendingAdmin", hook)
+}
+
+// RemoveAdmin records a removeAdmin call after running the hook set with OnRemoveAdmin.
+func (_FakePermissionController *FakePermissionController) RemoveAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakePermissionController.Contract.Transact(opts, "removeAdmin", func() error {
+		if hook, ok := _FakePermissionController.Contract.Hook("removeAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnRemoveAdmin sets a hook run by every RemoveAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionController *FakePermissionController) OnRemoveAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakePermissionController.Contract.SetHook("removeAdmin", hook)
+}
+
+// RemoveAppointee records a removeAppointee call after running the hook set with OnRemoveAppointee.
+func (_FakePermissionController *FakePermissionController) RemoveAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error) {
+	return _FakePermissionController.Contract.Transact(opts, "removeAppointee", func() error {
+		if hook, ok := _FakePermissionController.Contract.Hook("removeAppointee").(func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error); ok {
+			return hook(opts, account, appointee, target, selector)
+		}
+		return nil
+	}, account, appointee, target, selector)
+}
+
+// OnRemoveAppointee sets a hook run by every RemoveAppointee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionController *FakePermissionController) OnRemoveAppointee(hook func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error) {
+	_FakePermissionController.Contract.SetHook("removeAppointee", hook)
+}
+
+// RemovePendingAdmin records a removePendingAdmin call after running the hook set with OnRemovePendingAdmin.
+func (_FakePermissionController *FakePermissionController) RemovePendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakePermissionController.Contract.Transact(opts, "removePendingAdmin", func() error {
+		if hook, ok := _FakePermissionController.Contract.Hook("removePendingAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnRemovePendingAdmin sets a hook run by every RemovePendingAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionController *FakePermissionController) OnRemovePendingAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakePermissionController.Contract.SetHook("removePendingAdmin", hook)
+}
+
+// SetAppointee records a setAppointee call after running the hook set with OnSetAppointee.
+func (_FakePermissionController *FakePermissionController) SetAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error) {
+	return _FakePermissionController.Contract.Transact(opts, "setAppointee", func() error {
+		if hook, ok := _FakePermissionController.Contract.Hook("setAppointee").(func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error); ok {
+			return hook(opts, account, appointee, target, selector)
+		}
+		return nil
+	}, account, appointee, target, selector)
+}
+
+// OnSetAppointee sets a hook run by every SetAppointee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionController *FakePermissionController) OnSetAppointee(hook func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error) {
+	_FakePermissionController.Contract.SetHook("setAppointee", hook)
+}
+
+// EmitAdminRemoved appends the event to the fake's logs.
+func (_FakePermissionController *FakePermissionController) EmitAdminRemoved(event *PermissionControllerAdminRemoved) {
+	_FakePermissionController.Contract.Emit("AdminRemoved", event)
+}
+
+// EmitAdminSet appends the event to the fake's logs.
+func (_FakePermissionController *FakePermissionController) EmitAdminSet(event *PermissionControllerAdminSet) {
+	_FakePermissionController.Contract.Emit("AdminSet", event)
+}
+
+// EmitAppointeeRemoved appends the event to the fake's logs.
+func (_FakePermissionController *FakePermissionController) EmitAppointeeRemoved(event *PermissionControllerAppointeeRemoved) {
+	_FakePermissionController.Contract.Emit("AppointeeRemoved", event)
+}
+
+// EmitAppointeeSet appends the event to the fake's logs.
+func (_FakePermissionController *FakePermissionController) EmitAppointeeSet(event *PermissionControllerAppointeeSet) {
+	_FakePermissionController.Contract.Emit("AppointeeSet", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakePermissionController *FakePermissionController) EmitInitialized(event *PermissionControllerInitialized) {
+	_FakePermissionController.Contract.Emit("Initialized", event)
+}
+
+// EmitPendingAdminAdded appends the event to the fake's logs.
+func (_FakePermissionController *FakePermissionController) EmitPendingAdminAdded(event *PermissionControllerPendingAdminAdded) {
+	_FakePermissionController.Contract.Emit("PendingAdminAdded", event)
+}
+
+// EmitPendingAdminRemoved appends the event to the fake's logs.
+func (_FakePermissionController *FakePermissionController) EmitPendingAdminRemoved(event *PermissionControllerPendingAdminRemoved) {
+	_FakePermissionController.Contract.Emit("PendingAdminRemoved", event)
+}
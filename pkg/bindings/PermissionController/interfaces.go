@@ -0,0 +1,71 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionController
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// PermissionControllerReader is the read-only surface of the PermissionController
+// binding, implemented by PermissionControllerCaller, PermissionController and FakePermissionController.
+type PermissionControllerReader interface {
+	CanCall(opts *bind.CallOpts, account common.Address, caller common.Address, target common.Address, selector [4]byte) (bool, error)
+	GetAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error)
+	GetAppointeePermissions(opts *bind.CallOpts, account common.Address, appointee common.Address) ([]common.Address, [][4]byte, error)
+	GetAppointees(opts *bind.CallOpts, account common.Address, target common.Address, selector [4]byte) ([]common.Address, error)
+	GetPendingAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error)
+	IsAdmin(opts *bind.CallOpts, account common.Address, caller common.Address) (bool, error)
+	IsPendingAdmin(opts *bind.CallOpts, account common.Address, pendingAdmin common.Address) (bool, error)
+}
+
+// PermissionControllerWriter is the transacting surface of the PermissionController
+// binding, implemented by PermissionControllerTransactor, PermissionController and FakePermissionController.
+type PermissionControllerWriter interface {
+	AcceptAdmin(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error)
+	AddPendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	RemoveAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	RemoveAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error)
+	RemovePendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	SetAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error)
+}
+
+// PermissionControllerEvents is the event filtering surface of the PermissionController
+// binding, implemented by PermissionControllerFilterer, PermissionController and FakePermissionController.
+type PermissionControllerEvents interface {
+	FilterAdminRemoved(opts *bind.FilterOpts, account []common.Address) (*PermissionControllerAdminRemovedIterator, error)
+	WatchAdminRemoved(opts *bind.WatchOpts, sink chan<- *PermissionControllerAdminRemoved, account []common.Address) (event.Subscription, error)
+	ParseAdminRemoved(log types.Log) (*PermissionControllerAdminRemoved, error)
+	FilterAdminSet(opts *bind.FilterOpts, account []common.Address) (*PermissionControllerAdminSetIterator, error)
+	WatchAdminSet(opts *bind.WatchOpts, sink chan<- *PermissionControllerAdminSet, account []common.Address) (event.Subscription, error)
+	ParseAdminSet(log types.Log) (*PermissionControllerAdminSet, error)
+	FilterAppointeeRemoved(opts *bind.FilterOpts, account []common.Address, appointee []common.Address) (*PermissionControllerAppointeeRemovedIterator, error)
+	WatchAppointeeRemoved(opts *bind.WatchOpts, sink chan<- *PermissionControllerAppointeeRemoved, account []common.Address, appointee []common.Address) (event.Subscription, error)
+	ParseAppointeeRemoved(log types.Log) (*PermissionControllerAppointeeRemoved, error)
+	FilterAppointeeSet(opts *bind.FilterOpts, account []common.Address, appointee []common.Address) (*PermissionControllerAppointeeSetIterator, error)
+	WatchAppointeeSet(opts *bind.WatchOpts, sink chan<- *PermissionControllerAppointeeSet, account []common.Address, appointee []common.Address) (event.Subscription, error)
+	ParseAppointeeSet(log types.Log) (*PermissionControllerAppointeeSet, error)
+	FilterInitialized(opts *bind.FilterOpts) (*PermissionControllerInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *PermissionControllerInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*PermissionControllerInitialized, error)
+	FilterPendingAdminAdded(opts *bind.FilterOpts, account []common.Address) (*PermissionControllerPendingAdminAddedIterator, error)
+	WatchPendingAdminAdded(opts *bind.WatchOpts, sink chan<- *PermissionControllerPendingAdminAdded, account []common.Address) (event.Subscription, error)
+	ParsePendingAdminAdded(log types.Log) (*PermissionControllerPendingAdminAdded, error)
+	FilterPendingAdminRemoved(opts *bind.FilterOpts, account []common.Address) (*PermissionControllerPendingAdminRemovedIterator, error)
+	WatchPendingAdminRemoved(opts *bind.WatchOpts, sink chan<- *PermissionControllerPendingAdminRemoved, account []common.Address) (event.Subscription, error)
+	ParsePendingAdminRemoved(log types.Log) (*PermissionControllerPendingAdminRemoved, error)
+}
+
+var (
+	_ PermissionControllerReader = (*PermissionControllerCaller)(nil)
+	_ PermissionControllerReader = (*PermissionController)(nil)
+	_ PermissionControllerReader = (*FakePermissionController)(nil)
+	_ PermissionControllerWriter = (*PermissionControllerTransactor)(nil)
+	_ PermissionControllerWriter = (*PermissionController)(nil)
+	_ PermissionControllerWriter = (*FakePermissionController)(nil)
+	_ PermissionControllerEvents = (*PermissionControllerFilterer)(nil)
+	_ PermissionControllerEvents = (*PermissionController)(nil)
+	_ PermissionControllerEvents = (*FakePermissionController)(nil)
+)
@@ -0,0 +1,16 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionControllerMixin
+
+// ErrInvalidPermissions is the typed form of the PermissionControllerMixin custom error InvalidPermissions().
+type ErrInvalidPermissions struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPermissions) Error() string {
+	return "PermissionControllerMixin: InvalidPermissions()"
+}
+
+// PermissionControllerMixinErrors maps the name of every custom error in the PermissionControllerMixin ABI to its typed Go error.
+var PermissionControllerMixinErrors = map[string]error{
+	"InvalidPermissions": ErrInvalidPermissions{},
+}
@@ -0,0 +1,40 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionControllerMixin
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakePermissionControllerMixin is an in-memory PermissionControllerMixin for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded PermissionControllerMixinFilterer.
+type FakePermissionControllerMixin struct {
+	*fake.Contract
+	*PermissionControllerMixinFilterer
+}
+
+// NewFakePermissionControllerMixin returns an empty fake of the contract at address.
+func NewFakePermissionControllerMixin(address common.Address) *FakePermissionControllerMixin {
+	contract := fake.NewContract(PermissionControllerMixinMetaData, address)
+	filterer, err := NewPermissionControllerMixinFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakePermissionControllerMixin{Contract: contract, PermissionControllerMixinFilterer: filterer}
+}
+
+// PermissionController returns the values stubbed with StubPermissionController.
+func (_FakePermissionControllerMixin *FakePermissionControllerMixin) PermissionController(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakePermissionControllerMixin.Contract.Read("permissionController", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPermissionController sets what PermissionController returns for the given arguments.
+func (_FakePermissionControllerMixin *FakePermissionControllerMixin) StubPermissionController(out0 common.Address) {
+	_FakePermissionControllerMixin.Contract.Stub("permissionController", []interface{}{}, out0)
+}
@@ -0,0 +1,20 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionControllerMixin
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PermissionControllerMixinReader is the read-only surface of the PermissionControllerMixin
+// binding, implemented by PermissionControllerMixinCaller, PermissionControllerMixin and FakePermissionControllerMixin.
+type PermissionControllerMixinReader interface {
+	PermissionController(opts *bind.CallOpts) (common.Address, error)
+}
+
+var (
+	_ PermissionControllerMixinReader = (*PermissionControllerMixinCaller)(nil)
+	_ PermissionControllerMixinReader = (*PermissionControllerMixin)(nil)
+	_ PermissionControllerMixinReader = (*FakePermissionControllerMixin)(nil)
+)
@@ -0,0 +1,79 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionControllerStorage
+
+// ErrAdminAlreadyPending is the typed form of the PermissionControllerStorage custom error AdminAlreadyPending().
+type ErrAdminAlreadyPending struct{}
+
+// Error implements the error interface.
+func (ErrAdminAlreadyPending) Error() string {
+	return "PermissionControllerStorage: AdminAlreadyPending()"
+}
+
+// ErrAdminAlreadySet is the typed form of the PermissionControllerStorage custom error AdminAlreadySet().
+type ErrAdminAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrAdminAlreadySet) Error() string {
+	return "PermissionControllerStorage: AdminAlreadySet()"
+}
+
+// ErrAdminNotPending is the typed form of the PermissionControllerStorage custom error AdminNotPending().
+type ErrAdminNotPending struct{}
+
+// Error implements the error interface.
+func (ErrAdminNotPending) Error() string {
+	return "PermissionControllerStorage: AdminNotPending()"
+}
+
+// ErrAdminNotSet is the typed form of the PermissionControllerStorage custom error AdminNotSet().
+type ErrAdminNotSet struct{}
+
+// Error implements the error interface.
+func (ErrAdminNotSet) Error() string {
+	return "PermissionControllerStorage: AdminNotSet()"
+}
+
+// ErrAppointeeAlreadySet is the typed form of the PermissionControllerStorage custom error AppointeeAlreadySet().
+type ErrAppointeeAlreadySet struct{}
+
+// Error implements the error interface.
+func (ErrAppointeeAlreadySet) Error() string {
+	return "PermissionControllerStorage: AppointeeAlreadySet()"
+}
+
+// ErrAppointeeNotSet is the typed form of the PermissionControllerStorage custom error AppointeeNotSet().
+type ErrAppointeeNotSet struct{}
+
+// Error implements the error interface.
+func (ErrAppointeeNotSet) Error() string {
+	return "PermissionControllerStorage: AppointeeNotSet()"
+}
+
+// ErrCannotHaveZeroAdmins is the typed form of the PermissionControllerStorage custom error CannotHaveZeroAdmins().
+type ErrCannotHaveZeroAdmins struct{}
+
+// Error implements the error interface.
+func (ErrCannotHaveZeroAdmins) Error() string {
+	return "PermissionControllerStorage: CannotHaveZeroAdmins()"
+}
+
+// ErrNotAdmin is the typed form of the PermissionControllerStorage custom error NotAdmin().
+type ErrNotAdmin struct{}
+
+// Error implements the error interface.
+func (ErrNotAdmin) Error() string {
+	return "PermissionControllerStorage: NotAdmin()"
+}
+
+// PermissionControllerStorageErrors maps the name of every custom error in the PermissionControllerStorage ABI to its typed Go error.
+var PermissionControllerStorageErrors = map[string]error{
+	"AdminAlreadyPending":  ErrAdminAlreadyPending{},
+	"AdminAlreadySet":      ErrAdminAlreadySet{},
+	"AdminNotPending":      ErrAdminNotPending{},
+	"AdminNotSet":          ErrAdminNotSet{},
+	"AppointeeAlreadySet":  ErrAppointeeAlreadySet{},
+	"AppointeeNotSet":      ErrAppointeeNotSet{},
+	"CannotHaveZeroAdmins": ErrCannotHaveZeroAdmins{},
+	"NotAdmin":             ErrNotAdmin{},
+}
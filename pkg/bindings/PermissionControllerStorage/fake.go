@@ -0,0 +1,240 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionControllerStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakePermissionControllerStorage is an in-memory PermissionControllerStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded PermissionControllerStorageFilterer.
+type FakePermissionControllerStorage struct {
+	*fake.Contract
+	*PermissionControllerStorageFilterer
+}
+
+// NewFakePermissionControllerStorage returns an empty fake of the contract at address.
+func NewFakePermissionControllerStorage(address common.Address) *FakePermissionControllerStorage {
+	contract := fake.NewContract(PermissionControllerStorageMetaData, address)
+	filterer, err := NewPermissionControllerStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakePermissionControllerStorage{Contract: contract, PermissionControllerStorageFilterer: filterer}
+}
+
+// CanCall returns the values stubbed with StubCanCall.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) CanCall(opts *bind.CallOpts, account common.Address, caller common.Address, target common.Address, selector [4]byte) (bool, error) {
+	var out0 bool
+	err := _FakePermissionControllerStorage.Contract.Read("canCall", []interface{}{account, caller, target, selector}, &out0)
+	return out0, err
+}
+
+// StubCanCall sets what CanCall returns for the given arguments.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) StubCanCall(account common.Address, caller common.Address, target common.Address, selector [4]byte, out0 bool) {
+	_FakePermissionControllerStorage.Contract.Stub("canCall", []interface{}{account, caller, target, selector}, out0)
+}
+
+// GetAdmins returns the values stubbed with StubGetAdmins.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) GetAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakePermissionControllerStorage.Contract.Read("getAdmins", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubGetAdmins sets what GetAdmins returns for the given arguments.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) StubGetAdmins(account common.Address, out0 []common.Address) {
+	_FakePermissionControllerStorage.Contract.Stub("getAdmins", []interface{}{account}, out0)
+}
+
+// GetAppointeePermissions returns the values stubbed with StubGetAppointeePermissions.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) GetAppointeePermissions(opts *bind.CallOpts, account common.Address, appointee common.Address) ([]common.Address, [][4]byte, error) {
+	var out0 []common.Address
+	var out1 [][4]byte
+	err := _FakePermissionControllerStorage.Contract.Read("getAppointeePermissions", []interface{}{account, appointee}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetAppointeePermissions sets what GetAppointeePermissions returns for the given arguments.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) StubGetAppointeePermissions(account common.Address, appointee common.Address, out0 []common.Address, out1 [][4]byte) {
+	_FakePermissionControllerStorage.Contract.Stub("getAppointeePermissions", []interface{}{account, appointee}, out0, out1)
+}
+
+// GetAppointees returns the values stubbed with StubGetAppointees.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) GetAppointees(opts *bind.CallOpts, account common.Address, target common.Address, selector [4]byte) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakePermissionControllerStorage.Contract.Read("getAppointees", []interface{}{account, target, selector}, &out0)
+	return out0, err
+}
+
+// StubGetAppointees sets what GetAppointees returns for the given arguments.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) StubGetAppointees(account common.Address, target common.Address, selector [4]byte, out0 []common.Address) {
+	_FakePermissionControllerStorage.Contract.Stub("getAppointees", []interface{}{account, target, selector}, out0)
+}
+
+// GetPendingAdmins returns the values stubbed with StubGetPendingAdmins.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) GetPendingAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakePermissionControllerStorage.Contract.Read("getPendingAdmins", []interface{}{account}, &out0)
+	return out0, err
+}
+
+// StubGetPendingAdmins sets what GetPendingAdmins returns for the given arguments.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) StubGetPendingAdmins(account common.Address, out0 []common.Address) {
+	_FakePermissionControllerStorage.Contract.Stub("getPendingAdmins", []interface{}{account}, out0)
+}
+
+// IsAdmin returns the values stubbed with StubIsAdmin.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) IsAdmin(opts *bind.CallOpts, account common.Address, caller common.Address) (bool, error) {
+	var out0 bool
+	err := _FakePermissionControllerStorage.Contract.Read("isAdmin", []interface{}{account, caller}, &out0)
+	return out0, err
+}
+
+// StubIsAdmin sets what IsAdmin returns for the given arguments.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) StubIsAdmin(account common.Address, caller common.Address, out0 bool) {
+	_FakePermissionControllerStorage.Contract.Stub("isAdmin", []interface{}{account, caller}, out0)
+}
+
+// IsPendingAdmin returns the values stubbed with StubIsPendingAdmin.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) IsPendingAdmin(opts *bind.CallOpts, account common.Address, pendingAdmin common.Address) (bool, error) {
+	var out0 bool
+	err := _FakePermissionControllerStorage.Contract.Read("isPendingAdmin", []interface{}{account, pendingAdmin}, &out0)
+	return out0, err
+}
+
+// StubIsPendingAdmin sets what IsPendingAdmin returns for the given arguments.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) StubIsPendingAdmin(account common.Address, pendingAdmin common.Address, out0 bool) {
+	_FakePermissionControllerStorage.Contract.Stub("isPendingAdmin", []interface{}{account, pendingAdmin}, out0)
+}
+
+// AcceptAdmin records a acceptAdmin call after running the hook set with OnAcceptAdmin.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) AcceptAdmin(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error) {
+	return _FakePermissionControllerStorage.Contract.Transact(opts, "acceptAdmin", func() error {
+		if hook, ok := _FakePermissionControllerStorage.Contract.Hook("acceptAdmin").(func(opts *bind.TransactOpts, account common.Address) error); ok {
+			return hook(opts, account)
+		}
+		return nil
+	}, account)
+}
+
+// OnAcceptAdmin sets a hook run by every AcceptAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) OnAcceptAdmin(hook func(opts *bind.TransactOpts, account common.Address) error) {
+	_FakePermissionControllerStorage.Contract.SetHook("acceptAdmin", hook)
+}
+
+// AddPendingAdmin records a addPendingAdmin call after running the hook set with OnAddPendingAdmin.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) AddPendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakePermissionControllerStorage.Contract.Transact(opts, "addPendingAdmin", func() error {
+		if hook, ok := _FakePermissionControllerStorage.Contract.Hook("addPendingAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnAddPendingAdmin sets a hook run by every AddPendingAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) OnAddPendingAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakePermissionControllerStorage.Contract.SetHook("addPendingAdmin", hook)
+}
+
+// RemoveAdmin records a removeAdmin call after running the hook set with OnRemoveAdmin.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) RemoveAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakePermissionControllerStorage.Contract.Transact(opts, "removeAdmin", func() error {
+		if hook, ok := _FakePermissionControllerStorage.Contract.Hook("removeAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnRemoveAdmin sets a hook run by every RemoveAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) OnRemoveAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakePermissionControllerStorage.Contract.SetHook("removeAdmin", hook)
+}
+
+// RemoveAppointee records a removeAppointee call after running the hook set with OnRemoveAppointee.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) RemoveAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error) {
+	return _FakePermissionControllerStorage.Contract.Transact(opts, "removeAppointee", func() error {
+		if hook, ok := _FakePermissionControllerStorage.Contract.Hook("removeAppointee").(func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error); ok {
+			return hook(opts, account, appointee, target, selector)
+		}
+		return nil
+	}, account, appointee, target, selector)
+}
+
+// OnRemoveAppointee sets a hook run by every RemoveAppointee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) OnRemoveAppointee(hook func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error) {
+	_FakePermissionControllerStorage.Contract.SetHook("removeAppointee", hook)
+}
+
+// RemovePendingAdmin records a removePendingAdmin call after running the hook set with OnRemovePendingAdmin.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) RemovePendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error) {
+	return _FakePermissionControllerStorage.Contract.Transact(opts, "removePendingAdmin", func() error {
+		if hook, ok := _FakePermissionControllerStorage.Contract.Hook("removePendingAdmin").(func(opts *bind.TransactOpts, account common.Address, admin common.Address) error); ok {
+			return hook(opts, account, admin)
+		}
+		return nil
+	}, account, admin)
+}
+
+// OnRemovePendingAdmin sets a hook run by every RemovePendingAdmin call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) OnRemovePendingAdmin(hook func(opts *bind.TransactOpts, account common.Address, admin common.Address) error) {
+	_FakePermissionControllerStorage.Contract.SetHook("removePendingAdmin", hook)
+}
+
+// SetAppointee records a setAppointee call after running the hook set with OnSetAppointee.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) SetAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error) {
+	return _FakePermissionControllerStorage.Contract.Transact(opts, "setAppointee", func() error {
+		if hook, ok := _FakePermissionControllerStorage.Contract.Hook("setAppointee").(func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error); ok {
+			return hook(opts, account, appointee, target, selector)
+		}
+		return nil
+	}, account, appointee, target, selector)
+}
+
+// OnSetAppointee sets a hook run by every SetAppointee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) OnSetAppointee(hook func(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) error) {
+	_FakePermissionControllerStorage.Contract.SetHook("setAppointee", hook)
+}
+
+// EmitAdminRemoved appends the event to the fake's logs.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) EmitAdminRemoved(event *PermissionControllerStorageAdminRemoved) {
+	_FakePermissionControllerStorage.Contract.Emit("AdminRemoved", event)
+}
+
+// EmitAdminSet appends the event to the fake's logs.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) EmitAdminSet(event *PermissionControllerStorageAdminSet) {
+	_FakePermissionControllerStorage.Contract.Emit("AdminSet", event)
+}
+
+// EmitAppointeeRemoved appends the event to the fake's logs.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) EmitAppointeeRemoved(event *PermissionControllerStorageAppointeeRemoved) {
+	_FakePermissionControllerStorage.Contract.Emit("AppointeeRemoved", event)
+}
+
+// EmitAppointeeSet appends the event to the fake's logs.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) EmitAppointeeSet(event *PermissionControllerStorageAppointeeSet) {
+	_FakePermissionControllerStorage.Contract.Emit("AppointeeSet", event)
+}
+
+// EmitPendingAdminAdded appends the event to the fake's logs.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) EmitPendingAdminAdded(event *PermissionControllerStoragePendingAdminAdded) {
+	_FakePermissionControllerStorage.Contract.Emit("PendingAdminAdded", event)
+}
+
+// EmitPendingAdminRemoved appends the event to the fake's logs.
+func (_FakePermissionControllerStorage *FakePermissionControllerStorage) EmitPendingAdminRemoved(event *PermissionControllerStoragePendingAdminRemoved) {
+	_FakePermissionControllerStorage.Contract.Emit("PendingAdminRemoved", event)
+}
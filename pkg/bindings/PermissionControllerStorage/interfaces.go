@@ -0,0 +1,68 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package PermissionControllerStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// PermissionControllerStorageReader is the read-only surface of the PermissionControllerStorage
+// binding, implemented by PermissionControllerStorageCaller, PermissionControllerStorage and FakePermissionControllerStorage.
+type PermissionControllerStorageReader interface {
+	CanCall(opts *bind.CallOpts, account common.Address, caller common.Address, target common.Address, selector [4]byte) (bool, error)
+	GetAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error)
+	GetAppointeePermissions(opts *bind.CallOpts, account common.Address, appointee common.Address) ([]common.Address, [][4]byte, error)
+	GetAppointees(opts *bind.CallOpts, account common.Address, target common.Address, selector [4]byte) ([]common.Address, error)
+	GetPendingAdmins(opts *bind.CallOpts, account common.Address) ([]common.Address, error)
+	IsAdmin(opts *bind.CallOpts, account common.Address, caller common.Address) (bool, error)
+	IsPendingAdmin(opts *bind.CallOpts, account common.Address, pendingAdmin common.Address) (bool, error)
+}
+
+// PermissionControllerStorageWriter is the transacting surface of the PermissionControllerStorage
+// binding, implemented by PermissionControllerStorageTransactor, PermissionControllerStorage and FakePermissionControllerStorage.
+type PermissionControllerStorageWriter interface {
+	AcceptAdmin(opts *bind.TransactOpts, account common.Address) (*types.Transaction, error)
+	AddPendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	RemoveAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	RemoveAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error)
+	RemovePendingAdmin(opts *bind.TransactOpts, account common.Address, admin common.Address) (*types.Transaction, error)
+	SetAppointee(opts *bind.TransactOpts, account common.Address, appointee common.Address, target common.Address, selector [4]byte) (*types.Transaction, error)
+}
+
+// PermissionControllerStorageEvents is the event filtering surface of the PermissionControllerStorage
+// binding, implemented by PermissionControllerStorageFilterer, PermissionControllerStorage and FakePermissionControllerStorage.
+type PermissionControllerStorageEvents interface {
+	FilterAdminRemoved(opts *bind.FilterOpts, account []common.Address) (*PermissionControllerStorageAdminRemovedIterator, error)
+	WatchAdminRemoved(opts *bind.WatchOpts, sink chan<- *PermissionControllerStorageAdminRemoved, account []common.Address) (event.Subscription, error)
+	ParseAdminRemoved(log types.Log) (*PermissionControllerStorageAdminRemoved, error)
+	FilterAdminSet(opts *bind.FilterOpts, account []common.Address) (*PermissionControllerStorageAdminSetIterator, error)
+	WatchAdminSet(opts *bind.WatchOpts, sink chan<- *PermissionControllerStorageAdminSet, account []common.Address) (event.Subscription, error)
+	ParseAdminSet(log types.Log) (*PermissionControllerStorageAdminSet, error)
+	FilterAppointeeRemoved(opts *bind.FilterOpts, account []common.Address, appointee []common.Address) (*PermissionControllerStorageAppointeeRemovedIterator, error)
+	WatchAppointeeRemoved(opts *bind.WatchOpts, sink chan<- *PermissionControllerStorageAppointeeRemoved, account []common.Address, appointee []common.Address) (event.Subscription, error)
+	ParseAppointeeRemoved(log types.Log) (*PermissionControllerStorageAppointeeRemoved, error)
+	FilterAppointeeSet(opts *bind.FilterOpts, account []common.Address, appointee []common.Address) (*PermissionControllerStorageAppointeeSetIterator, error)
+	WatchAppointeeSet(opts *bind.WatchOpts, sink chan<- *PermissionControllerStorageAppointeeSet, account []common.Address, appointee []common.Address) (event.Subscription, error)
+	ParseAppointeeSet(log types.Log) (*PermissionControllerStorageAppointeeSet, error)
+	FilterPendingAdminAdded(opts *bind.FilterOpts, account []common.Address) (*PermissionControllerStoragePendingAdminAddedIterator, error)
+	WatchPendingAdminAdded(opts *bind.WatchOpts, sink chan<- *PermissionControllerStoragePendingAdminAdded, account []common.Address) (event.Subscription, error)
+	ParsePendingAdminAdded(log types.Log) (*PermissionControllerStoragePendingAdminAdded, error)
+	FilterPendingAdminRemoved(opts *bind.FilterOpts, account []common.Address) (*PermissionControllerStoragePendingAdminRemovedIterator, error)
+	WatchPendingAdminRemoved(opts *bind.WatchOpts, sink chan<- *PermissionControllerStoragePendingAdminRemoved, account []common.Address) (event.Subscription, error)
+	ParsePendingAdminRemoved(log types.Log) (*PermissionControllerStoragePendingAdminRemoved, error)
+}
+
+var (
+	_ PermissionControllerStorageReader = (*PermissionControllerStorageCaller)(nil)
+	_ PermissionControllerStorageReader = (*PermissionControllerStorage)(nil)
+	_ PermissionControllerStorageReader = (*FakePermissionControllerStorage)(nil)
+	_ PermissionControllerStorageWriter = (*PermissionControllerStorageTransactor)(nil)
+	_ PermissionControllerStorageWriter = (*PermissionControllerStorage)(nil)
+	_ PermissionControllerStorageWriter = (*FakePermissionControllerStorage)(nil)
+	_ PermissionControllerStorageEvents = (*PermissionControllerStorageFilterer)(nil)
+	_ PermissionControllerStorageEvents = (*PermissionControllerStorage)(nil)
+	_ PermissionControllerStorageEvents = (*FakePermissionControllerStorage)(nil)
+)
@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,11 +30,8 @@ var (
 	_ = abi.ConvertType
 )
 
-// IProtocolRegistryTypesDeploymentConfig is an auto generated low-level Go binding around an user-defined struct.
-type IProtocolRegistryTypesDeploymentConfig struct {
-	Pausable   bool
-	Deprecated bool
-}
+// IProtocolRegistryTypesDeploymentConfig is an alias of the canonical eltypes.IProtocolRegistryTypesDeploymentConfig shared by all bindings.
+type IProtocolRegistryTypesDeploymentConfig = eltypes.IProtocolRegistryTypesDeploymentConfig
 
 // ProtocolRegistryMetaData contains all meta data concerning the ProtocolRegistry contract.
 var ProtocolRegistryMetaData = &bind.MetaData{
@@ -0,0 +1,31 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ProtocolRegistry
+
+import (
+	"fmt"
+)
+
+// ErrInvalidShortString is the typed form of the ProtocolRegistry custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "ProtocolRegistry: InvalidShortString()"
+}
+
+// ErrStringTooLong is the typed form of the ProtocolRegistry custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("ProtocolRegistry: StringTooLong(str: %v)", e.Str)
+}
+
+// ProtocolRegistryErrors maps the name of every custom error in the ProtocolRegistry ABI to its typed Go error.
+var ProtocolRegistryErrors = map[string]error{
+	"InvalidShortString": ErrInvalidShortString{},
+	"StringTooLong":      ErrStringTooLong{},
+}
@@ -0,0 +1,354 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ProtocolRegistry
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeProtocolRegistry is an in-memory ProtocolRegistry for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ProtocolRegistryFilterer.
+type FakeProtocolRegistry struct {
+	*fake.Contract
+	*ProtocolRegistryFilterer
+}
+
+// NewFakeProtocolRegistry returns an empty fake of the contract at address.
+func NewFakeProtocolRegistry(address common.Address) *FakeProtocolRegistry {
+	contract := fake.NewContract(ProtocolRegistryMetaData, address)
+	filterer, err := NewProtocolRegistryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeProtocolRegistry{Contract: contract, ProtocolRegistryFilterer: filterer}
+}
+
+// DEFAULTADMINROLE returns the values stubbed with StubDEFAULTADMINROLE.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeProtocolRegistry.Contract.Read("DEFAULT_ADMIN_ROLE", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEFAULTADMINROLE sets what DEFAULTADMINROLE returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubDEFAULTADMINROLE(out0 [32]byte) {
+	_FakeProtocolRegistry.Contract.Stub("DEFAULT_ADMIN_ROLE", []interface{}{}, out0)
+}
+
+// PAUSERROLE returns the values stubbed with StubPAUSERROLE.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) PAUSERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeProtocolRegistry.Contract.Read("PAUSER_ROLE", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPAUSERROLE sets what PAUSERROLE returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubPAUSERROLE(out0 [32]byte) {
+	_FakeProtocolRegistry.Contract.Stub("PAUSER_ROLE", []interface{}{}, out0)
+}
+
+// GetAddress returns the values stubbed with StubGetAddress.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) GetAddress(opts *bind.CallOpts, name string) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeProtocolRegistry.Contract.Read("getAddress", []interface{}{name}, &out0)
+	return out0, err
+}
+
+// StubGetAddress sets what GetAddress returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubGetAddress(name string, out0 common.Address) {
+	_FakeProtocolRegistry.Contract.Stub("getAddress", []interface{}{name}, out0)
+}
+
+// GetAllDeployments returns the values stubbed with StubGetAllDeployments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) GetAllDeployments(opts *bind.CallOpts) (struct {
+	Names     []string
+	Addresses []common.Address
+	Configs   []IProtocolRegistryTypesDeploymentConfig
+}, error) {
+	var out0 struct {
+		Names     []string
+		Addresses []common.Address
+		Configs   []IProtocolRegistryTypesDeploymentConfig
+	}
+	err := _FakeProtocolRegistry.Contract.Read("getAllDeployments", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetAllDeployments sets what GetAllDeployments returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubGetAllDeployments(out0 struct {
+	Names     []string
+	Addresses []common.Address
+	Configs   []IProtocolRegistryTypesDeploymentConfig
+}) {
+	_FakeProtocolRegistry.Contract.Stub("getAllDeployments", []interface{}{}, out0)
+}
+
+// GetDeployment returns the values stubbed with StubGetDeployment.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) GetDeployment(opts *bind.CallOpts, name string) (struct {
+	Addr   common.Address
+	Config IProtocolRegistryTypesDeploymentConfig
+}, error) {
+	var out0 struct {
+		Addr   common.Address
+		Config IProtocolRegistryTypesDeploymentConfig
+	}
+	err := _FakeProtocolRegistry.Contract.Read("getDeployment", []interface{}{name}, &out0)
+	return out0, err
+}
+
+// StubGetDeployment sets what GetDeployment returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubGetDeployment(name string, out0 struct {
+	Addr   common.Address
+	Config IProtocolRegistryTypesDeploymentConfig
+}) {
+	_FakeProtocolRegistry.Contract.Stub("getDeployment", []interface{}{name}, out0)
+}
+
+// GetRoleAdmin returns the values stubbed with StubGetRoleAdmin.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeProtocolRegistry.Contract.Read("getRoleAdmin", []interface{}{role}, &out0)
+	return out0, err
+}
+
+// StubGetRoleAdmin sets what GetRoleAdmin returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubGetRoleAdmin(role [32]byte, out0 [32]byte) {
+	_FakeProtocolRegistry.Contract.Stub("getRoleAdmin", []interface{}{role}, out0)
+}
+
+// GetRoleMember returns the values stubbed with StubGetRoleMember.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) GetRoleMember(opts *bind.CallOpts, role [32]byte, index *big.Int) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeProtocolRegistry.Contract.Read("getRoleMember", []interface{}{role, index}, &out0)
+	return out0, err
+}
+
+// StubGetRoleMember sets what GetRoleMember returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubGetRoleMember(role [32]byte, index *big.Int, out0 common.Address) {
+	_FakeProtocolRegistry.Contract.Stub("getRoleMember", []interface{}{role, index}, out0)
+}
+
+// GetRoleMemberCount returns the values stubbed with StubGetRoleMemberCount.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) GetRoleMemberCount(opts *bind.CallOpts, role [32]byte) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeProtocolRegistry.Contract.Read("getRoleMemberCount", []interface{}{role}, &out0)
+	return out0, err
+}
+
+// StubGetRoleMemberCount sets what GetRoleMemberCount returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubGetRoleMemberCount(role [32]byte, out0 *big.Int) {
+	_FakeProtocolRegistry.Contract.Stub("getRoleMemberCount", []interface{}{role}, out0)
+}
+
+// HasRole returns the values stubbed with StubHasRole.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeProtocolRegistry.Contract.Read("hasRole", []interface{}{role, account}, &out0)
+	return out0, err
+}
+
+// StubHasRole sets what HasRole returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubHasRole(role [32]byte, account common.Address, out0 bool) {
+	_FakeProtocolRegistry.Contract.Stub("hasRole", []interface{}{role, account}, out0)
+}
+
+// MajorVersion returns the values stubbed with StubMajorVersion.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) MajorVersion(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeProtocolRegistry.Contract.Read("majorVersion", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMajorVersion sets what MajorVersion returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubMajorVersion(out0 string) {
+	_FakeProtocolRegistry.Contract.Stub("majorVersion", []interface{}{}, out0)
+}
+
+// SupportsInterface returns the values stubbed with StubSupportsInterface.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error) {
+	var out0 bool
+	err := _FakeProtocolRegistry.Contract.Read("supportsInterface", []interface{}{interfaceId}, &out0)
+	return out0, err
+}
+
+// StubSupportsInterface sets what SupportsInterface returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubSupportsInterface(interfaceId [4]byte, out0 bool) {
+	_FakeProtocolRegistry.Contract.Stub("supportsInterface", []interface{}{interfaceId}, out0)
+}
+
+// TotalDeployments returns the values stubbed with StubTotalDeployments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) TotalDeployments(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeProtocolRegistry.Contract.Read("totalDeployments", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalDeployments sets what TotalDeployments returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubTotalDeployments(out0 *big.Int) {
+	_FakeProtocolRegistry.Contract.Stub("totalDeployments", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeProtocolRegistry.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) StubVersion(out0 string) {
+	_FakeProtocolRegistry.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// Configure records a configure call after running the hook set with OnConfigure.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) Configure(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) (*types.Transaction, error) {
+	return _FakeProtocolRegistry.Contract.Transact(opts, "configure", func() error {
+		if hook, ok := _FakeProtocolRegistry.Contract.Hook("configure").(func(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) error); ok {
+			return hook(opts, addr, config)
+		}
+		return nil
+	}, addr, config)
+}
+
+// OnConfigure sets a hook run by every Configure call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) OnConfigure(hook func(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) error) {
+	_FakeProtocolRegistry.Contract.SetHook("configure", hook)
+}
+
+// GrantRole records a grantRole call after running the hook set with OnGrantRole.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _FakeProtocolRegistry.Contract.Transact(opts, "grantRole", func() error {
+		if hook, ok := _FakeProtocolRegistry.Contract.Hook("grantRole").(func(opts *bind.TransactOpts, role [32]byte, account common.Address) error); ok {
+			return hook(opts, role, account)
+		}
+		return nil
+	}, role, account)
+}
+
+// OnGrantRole sets a hook run by every GrantRole call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) OnGrantRole(hook func(opts *bind.TransactOpts, role [32]byte, account common.Address) error) {
+	_FakeProtocolRegistry.Contract.SetHook("grantRole", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) Initialize(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) (*types.Transaction, error) {
+	return _FakeProtocolRegistry.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeProtocolRegistry.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) error); ok {
+			return hook(opts, initialAdmin, pauserMultisig)
+		}
+		return nil
+	}, initialAdmin, pauserMultisig)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) OnInitialize(hook func(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) error) {
+	_FakeProtocolRegistry.Contract.SetHook("initialize", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeProtocolRegistry.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeProtocolRegistry.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeProtocolRegistry.Contract.SetHook("pauseAll", hook)
+}
+
+// RenounceRole records a renounceRole call after running the hook set with OnRenounceRole.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _FakeProtocolRegistry.Contract.Transact(opts, "renounceRole", func() error {
+		if hook, ok := _FakeProtocolRegistry.Contract.Hook("renounceRole").(func(opts *bind.TransactOpts, role [32]byte, account common.Address) error); ok {
+			return hook(opts, role, account)
+		}
+		return nil
+	}, role, account)
+}
+
+// OnRenounceRole sets a hook run by every RenounceRole call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) OnRenounceRole(hook func(opts *bind.TransactOpts, role [32]byte, account common.Address) error) {
+	_FakeProtocolRegistry.Contract.SetHook("renounceRole", hook)
+}
+
+// RevokeRole records a revokeRole call after running the hook set with OnRevokeRole.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error) {
+	return _FakeProtocolRegistry.Contract.Transact(opts, "revokeRole", func() error {
+		if hook, ok := _FakeProtocolRegistry.Contract.Hook("revokeRole").(func(opts *bind.TransactOpts, role [32]byte, account common.Address) error); ok {
+			return hook(opts, role, account)
+		}
+		return nil
+	}, role, account)
+}
+
+// OnRevokeRole sets a hook run by every RevokeRole call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) OnRevokeRole(hook func(opts *bind.TransactOpts, role [32]byte, account common.Address) error) {
+	_FakeProtocolRegistry.Contract.SetHook("revokeRole", hook)
+}
+
+// Ship records a ship call after running the hook set with OnShip.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) Ship(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, names []string, semanticVersion string) (*types.Transaction, error) {
+	return _FakeProtocolRegistry.Contract.Transact(opts, "ship", func() error {
+		if hook, ok := _FakeProtocolRegistry.Contract.Hook("ship").(func(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, names []string, semanticVersion string) error); ok {
+			return hook(opts, addresses, configs, names, semanticVersion)
+		}
+		return nil
+	}, addresses, configs, names, semanticVersion)
+}
+
+// OnShip sets a hook run by every Ship call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) OnShip(hook func(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, names []string, semanticVersion string) error) {
+	_FakeProtocolRegistry.Contract.SetHook("ship", hook)
+}
+
+// EmitDeploymentConfigured appends the event to the fake's logs.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) EmitDeploymentConfigured(event *ProtocolRegistryDeploymentConfigured) {
+	_FakeProtocolRegistry.Contract.Emit("DeploymentConfigured", event)
+}
+
+// EmitDeploymentShipped appends the event to the fake's logs.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) EmitDeploymentShipped(event *ProtocolRegistryDeploymentShipped) {
+	_FakeProtocolRegistry.Contract.Emit("DeploymentShipped", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) EmitInitialized(event *ProtocolRegistryInitialized) {
+	_FakeProtocolRegistry.Contract.Emit("Initialized", event)
+}
+
+// EmitRoleAdminChanged appends the event to the fake's logs.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) EmitRoleAdminChanged(event *ProtocolRegistryRoleAdminChanged) {
+	_FakeProtocolRegistry.Contract.Emit("RoleAdminChanged", event)
+}
+
+// EmitRoleGranted appends the event to the fake's logs.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) EmitRoleGranted(event *ProtocolRegistryRoleGranted) {
+	_FakeProtocolRegistry.Contract.Emit("RoleGranted", event)
+}
+
+// EmitRoleRevoked appends the event to the fake's logs.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) EmitRoleRevoked(event *ProtocolRegistryRoleRevoked) {
+	_FakeProtocolRegistry.Contract.Emit("RoleRevoked", event)
+}
+
+// EmitSemanticVersionUpdated appends the event to the fake's logs.
+func (_FakeProtocolRegistry *FakeProtocolRegistry) EmitSemanticVersionUpdated(event *ProtocolRegistrySemanticVersionUpdated) {
+	_FakeProtocolRegistry.Contract.Emit("SemanticVersionUpdated", event)
+}
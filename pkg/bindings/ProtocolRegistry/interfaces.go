@@ -0,0 +1,86 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ProtocolRegistry
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// ProtocolRegistryReader is the read-only surface of the ProtocolRegistry
+// binding, implemented by ProtocolRegistryCaller, ProtocolRegistry and FakeProtocolRegistry.
+type ProtocolRegistryReader interface {
+	DEFAULTADMINROLE(opts *bind.CallOpts) ([32]byte, error)
+	PAUSERROLE(opts *bind.CallOpts) ([32]byte, error)
+	GetAddress(opts *bind.CallOpts, name string) (common.Address, error)
+	GetAllDeployments(opts *bind.CallOpts) (struct {
+		Names     []string
+		Addresses []common.Address
+		Configs   []IProtocolRegistryTypesDeploymentConfig
+	}, error)
+	GetDeployment(opts *bind.CallOpts, name string) (struct {
+		Addr   common.Address
+		Config IProtocolRegistryTypesDeploymentConfig
+	}, error)
+	GetRoleAdmin(opts *bind.CallOpts, role [32]byte) ([32]byte, error)
+	GetRoleMember(opts *bind.CallOpts, role [32]byte, index *big.Int) (common.Address, error)
+	GetRoleMemberCount(opts *bind.CallOpts, role [32]byte) (*big.Int, error)
+	HasRole(opts *bind.CallOpts, role [32]byte, account common.Address) (bool, error)
+	MajorVersion(opts *bind.CallOpts) (string, error)
+	SupportsInterface(opts *bind.CallOpts, interfaceId [4]byte) (bool, error)
+	TotalDeployments(opts *bind.CallOpts) (*big.Int, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+// ProtocolRegistryWriter is the transacting surface of the ProtocolRegistry
+// binding, implemented by ProtocolRegistryTransactor, ProtocolRegistry and FakeProtocolRegistry.
+type ProtocolRegistryWriter interface {
+	Configure(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) (*types.Transaction, error)
+	GrantRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RenounceRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error)
+	RevokeRole(opts *bind.TransactOpts, role [32]byte, account common.Address) (*types.Transaction, error)
+	Ship(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, names []string, semanticVersion string) (*types.Transaction, error)
+}
+
+// ProtocolRegistryEvents is the event filtering surface of the ProtocolRegistry
+// binding, implemented by ProtocolRegistryFilterer, ProtocolRegistry and FakeProtocolRegistry.
+type ProtocolRegistryEvents interface {
+	FilterDeploymentConfigured(opts *bind.FilterOpts, addr []common.Address) (*ProtocolRegistryDeploymentConfiguredIterator, error)
+	WatchDeploymentConfigured(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryDeploymentConfigured, addr []common.Address) (event.Subscription, error)
+	ParseDeploymentConfigured(log types.Log) (*ProtocolRegistryDeploymentConfigured, error)
+	FilterDeploymentShipped(opts *bind.FilterOpts, addr []common.Address) (*ProtocolRegistryDeploymentShippedIterator, error)
+	WatchDeploymentShipped(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryDeploymentShipped, addr []common.Address) (event.Subscription, error)
+	ParseDeploymentShipped(log types.Log) (*ProtocolRegistryDeploymentShipped, error)
+	FilterInitialized(opts *bind.FilterOpts) (*ProtocolRegistryInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*ProtocolRegistryInitialized, error)
+	FilterRoleAdminChanged(opts *bind.FilterOpts, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (*ProtocolRegistryRoleAdminChangedIterator, error)
+	WatchRoleAdminChanged(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryRoleAdminChanged, role [][32]byte, previousAdminRole [][32]byte, newAdminRole [][32]byte) (event.Subscription, error)
+	ParseRoleAdminChanged(log types.Log) (*ProtocolRegistryRoleAdminChanged, error)
+	FilterRoleGranted(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ProtocolRegistryRoleGrantedIterator, error)
+	WatchRoleGranted(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryRoleGranted, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error)
+	ParseRoleGranted(log types.Log) (*ProtocolRegistryRoleGranted, error)
+	FilterRoleRevoked(opts *bind.FilterOpts, role [][32]byte, account []common.Address, sender []common.Address) (*ProtocolRegistryRoleRevokedIterator, error)
+	WatchRoleRevoked(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryRoleRevoked, role [][32]byte, account []common.Address, sender []common.Address) (event.Subscription, error)
+	ParseRoleRevoked(log types.Log) (*ProtocolRegistryRoleRevoked, error)
+	FilterSemanticVersionUpdated(opts *bind.FilterOpts) (*ProtocolRegistrySemanticVersionUpdatedIterator, error)
+	WatchSemanticVersionUpdated(opts *bind.WatchOpts, sink chan<- *ProtocolRegistrySemanticVersionUpdated) (event.Subscription, error)
+	ParseSemanticVersionUpdated(log types.Log) (*ProtocolRegistrySemanticVersionUpdated, error)
+}
+
+var (
+	_ ProtocolRegistryReader = (*ProtocolRegistryCaller)(nil)
+	_ ProtocolRegistryReader = (*ProtocolRegistry)(nil)
+	_ ProtocolRegistryReader = (*FakeProtocolRegistry)(nil)
+	_ ProtocolRegistryWriter = (*ProtocolRegistryTransactor)(nil)
+	_ ProtocolRegistryWriter = (*ProtocolRegistry)(nil)
+	_ ProtocolRegistryWriter = (*FakeProtocolRegistry)(nil)
+	_ ProtocolRegistryEvents = (*ProtocolRegistryFilterer)(nil)
+	_ ProtocolRegistryEvents = (*ProtocolRegistry)(nil)
+	_ ProtocolRegistryEvents = (*FakeProtocolRegistry)(nil)
+)
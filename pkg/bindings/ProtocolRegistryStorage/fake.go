@@ -0,0 +1,214 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ProtocolRegistryStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeProtocolRegistryStorage is an in-memory ProtocolRegistryStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ProtocolRegistryStorageFilterer.
+type FakeProtocolRegistryStorage struct {
+	*fake.Contract
+	*ProtocolRegistryStorageFilterer
+}
+
+// NewFakeProtocolRegistryStorage returns an empty fake of the contract at address.
+func NewFakeProtocolRegistryStorage(address common.Address) *FakeProtocolRegistryStorage {
+	contract := fake.NewContract(ProtocolRegistryStorageMetaData, address)
+	filterer, err := NewProtocolRegistryStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeProtocolRegistryStorage{Contract: contract, ProtocolRegistryStorageFilterer: filterer}
+}
+
+// PAUSERROLE returns the values stubbed with StubPAUSERROLE.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) PAUSERROLE(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeProtocolRegistryStorage.Contract.Read("PAUSER_ROLE", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPAUSERROLE sets what PAUSERROLE returns for the given arguments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) StubPAUSERROLE(out0 [32]byte) {
+	_FakeProtocolRegistryStorage.Contract.Stub("PAUSER_ROLE", []interface{}{}, out0)
+}
+
+// GetAddress returns the values stubbed with StubGetAddress.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) GetAddress(opts *bind.CallOpts, name string) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeProtocolRegistryStorage.Contract.Read("getAddress", []interface{}{name}, &out0)
+	return out0, err
+}
+
+// StubGetAddress sets what GetAddress returns for the given arguments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) StubGetAddress(name string, out0 common.Address) {
+	_FakeProtocolRegistryStorage.Contract.Stub("getAddress", []interface{}{name}, out0)
+}
+
+// GetAllDeployments returns the values stubbed with StubGetAllDeployments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) GetAllDeployments(opts *bind.CallOpts) (struct {
+	Names     []string
+	Addresses []common.Address
+	Configs   []IProtocolRegistryTypesDeploymentConfig
+}, error) {
+	var out0 struct {
+		Names     []string
+		Addresses []common.Address
+		Configs   []IProtocolRegistryTypesDeploymentConfig
+	}
+	err := _FakeProtocolRegistryStorage.Contract.Read("getAllDeployments", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetAllDeployments sets what GetAllDeployments returns for the given arguments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) StubGetAllDeployments(out0 struct {
+	Names     []string
+	Addresses []common.Address
+	Configs   []IProtocolRegistryTypesDeploymentConfig
+}) {
+	_FakeProtocolRegistryStorage.Contract.Stub("getAllDeployments", []interface{}{}, out0)
+}
+
+// GetDeployment returns the values stubbed with StubGetDeployment.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) GetDeployment(opts *bind.CallOpts, name string) (struct {
+	Addr   common.Address
+	Config IProtocolRegistryTypesDeploymentConfig
+}, error) {
+	var out0 struct {
+		Addr   common.Address
+		Config IProtocolRegistryTypesDeploymentConfig
+	}
+	err := _FakeProtocolRegistryStorage.Contract.Read("getDeployment", []interface{}{name}, &out0)
+	return out0, err
+}
+
+// StubGetDeployment sets what GetDeployment returns for the given arguments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) StubGetDeployment(name string, out0 struct {
+	Addr   common.Address
+	Config IProtocolRegistryTypesDeploymentConfig
+}) {
+	_FakeProtocolRegistryStorage.Contract.Stub("getDeployment", []interface{}{name}, out0)
+}
+
+// MajorVersion returns the values stubbed with StubMajorVersion.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) MajorVersion(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeProtocolRegistryStorage.Contract.Read("majorVersion", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMajorVersion sets what MajorVersion returns for the given arguments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) StubMajorVersion(out0 string) {
+	_FakeProtocolRegistryStorage.Contract.Stub("majorVersion", []interface{}{}, out0)
+}
+
+// TotalDeployments returns the values stubbed with StubTotalDeployments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) TotalDeployments(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeProtocolRegistryStorage.Contract.Read("totalDeployments", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalDeployments sets what TotalDeployments returns for the given arguments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) StubTotalDeployments(out0 *big.Int) {
+	_FakeProtocolRegistryStorage.Contract.Stub("totalDeployments", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeProtocolRegistryStorage.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) StubVersion(out0 string) {
+	_FakeProtocolRegistryStorage.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// Configure records a configure call after running the hook set with OnConfigure.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) Configure(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) (*types.Transaction, error) {
+	return _FakeProtocolRegistryStorage.Contract.Transact(opts, "configure", func() error {
+		if hook, ok := _FakeProtocolRegistryStorage.Contract.Hook("configure").(func(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) error); ok {
+			return hook(opts, addr, config)
+		}
+		return nil
+	}, addr, config)
+}
+
+// OnConfigure sets a hook run by every Configure call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) OnConfigure(hook func(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) error) {
+	_FakeProtocolRegistryStorage.Contract.SetHook("configure", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) Initialize(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) (*types.Transaction, error) {
+	return _FakeProtocolRegistryStorage.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeProtocolRegistryStorage.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) error); ok {
+			return hook(opts, initialAdmin, pauserMultisig)
+		}
+		return nil
+	}, initialAdmin, pauserMultisig)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) OnInitialize(hook func(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) error) {
+	_FakeProtocolRegistryStorage.Contract.SetHook("initialize", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeProtocolRegistryStorage.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeProtocolRegistryStorage.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeProtocolRegistryStorage.Contract.SetHook("pauseAll", hook)
+}
+
+// Ship records a ship call after running the hook set with OnShip.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) Ship(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, contractNames []string, semanticVersion string) (*types.Transaction, error) {
+	return _FakeProtocolRegistryStorage.Contract.Transact(opts, "ship", func() error {
+		if hook, ok := _FakeProtocolRegistryStorage.Contract.Hook("ship").(func(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, contractNames []string, semanticVersion string) error); ok {
+			return hook(opts, addresses, configs, contractNames, semanticVersion)
+		}
+		return nil
+	}, addresses, configs, contractNames, semanticVersion)
+}
+
+// OnShip sets a hook run by every Ship call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) OnShip(hook func(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, contractNames []string, semanticVersion string) error) {
+	_FakeProtocolRegistryStorage.Contract.SetHook("ship", hook)
+}
+
+// EmitDeploymentConfigured appends the event to the fake's logs.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) EmitDeploymentConfigured(event *ProtocolRegistryStorageDeploymentConfigured) {
+	_FakeProtocolRegistryStorage.Contract.Emit("DeploymentConfigured", event)
+}
+
+// EmitDeploymentShipped appends the event to the fake's logs.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) EmitDeploymentShipped(event *ProtocolRegistryStorageDeploymentShipped) {
+	_FakeProtocolRegistryStorage.Contract.Emit("DeploymentShipped", event)
+}
+
+// EmitSemanticVersionUpdated appends the event to the fake's logs.
+func (_FakeProtocolRegistryStorage *FakeProtocolRegistryStorage) EmitSemanticVersionUpdated(event *ProtocolRegistryStorageSemanticVersionUpdated) {
+	_FakeProtocolRegistryStorage.Contract.Emit("SemanticVersionUpdated", event)
+}
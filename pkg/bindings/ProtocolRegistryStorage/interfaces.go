@@ -0,0 +1,65 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ProtocolRegistryStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// ProtocolRegistryStorageReader is the read-only surface of the ProtocolRegistryStorage
+// binding, implemented by ProtocolRegistryStorageCaller, ProtocolRegistryStorage and FakeProtocolRegistryStorage.
+type ProtocolRegistryStorageReader interface {
+	PAUSERROLE(opts *bind.CallOpts) ([32]byte, error)
+	GetAddress(opts *bind.CallOpts, name string) (common.Address, error)
+	GetAllDeployments(opts *bind.CallOpts) (struct {
+		Names     []string
+		Addresses []common.Address
+		Configs   []IProtocolRegistryTypesDeploymentConfig
+	}, error)
+	GetDeployment(opts *bind.CallOpts, name string) (struct {
+		Addr   common.Address
+		Config IProtocolRegistryTypesDeploymentConfig
+	}, error)
+	MajorVersion(opts *bind.CallOpts) (string, error)
+	TotalDeployments(opts *bind.CallOpts) (*big.Int, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+// ProtocolRegistryStorageWriter is the transacting surface of the ProtocolRegistryStorage
+// binding, implemented by ProtocolRegistryStorageTransactor, ProtocolRegistryStorage and FakeProtocolRegistryStorage.
+type ProtocolRegistryStorageWriter interface {
+	Configure(opts *bind.TransactOpts, addr common.Address, config IProtocolRegistryTypesDeploymentConfig) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialAdmin common.Address, pauserMultisig common.Address) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	Ship(opts *bind.TransactOpts, addresses []common.Address, configs []IProtocolRegistryTypesDeploymentConfig, contractNames []string, semanticVersion string) (*types.Transaction, error)
+}
+
+// ProtocolRegistryStorageEvents is the event filtering surface of the ProtocolRegistryStorage
+// binding, implemented by ProtocolRegistryStorageFilterer, ProtocolRegistryStorage and FakeProtocolRegistryStorage.
+type ProtocolRegistryStorageEvents interface {
+	FilterDeploymentConfigured(opts *bind.FilterOpts, addr []common.Address) (*ProtocolRegistryStorageDeploymentConfiguredIterator, error)
+	WatchDeploymentConfigured(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryStorageDeploymentConfigured, addr []common.Address) (event.Subscription, error)
+	ParseDeploymentConfigured(log types.Log) (*ProtocolRegistryStorageDeploymentConfigured, error)
+	FilterDeploymentShipped(opts *bind.FilterOpts, addr []common.Address) (*ProtocolRegistryStorageDeploymentShippedIterator, error)
+	WatchDeploymentShipped(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryStorageDeploymentShipped, addr []common.Address) (event.Subscription, error)
+	ParseDeploymentShipped(log types.Log) (*ProtocolRegistryStorageDeploymentShipped, error)
+	FilterSemanticVersionUpdated(opts *bind.FilterOpts) (*ProtocolRegistryStorageSemanticVersionUpdatedIterator, error)
+	WatchSemanticVersionUpdated(opts *bind.WatchOpts, sink chan<- *ProtocolRegistryStorageSemanticVersionUpdated) (event.Subscription, error)
+	ParseSemanticVersionUpdated(log types.Log) (*ProtocolRegistryStorageSemanticVersionUpdated, error)
+}
+
+var (
+	_ ProtocolRegistryStorageReader = (*ProtocolRegistryStorageCaller)(nil)
+	_ ProtocolRegistryStorageReader = (*ProtocolRegistryStorage)(nil)
+	_ ProtocolRegistryStorageReader = (*FakeProtocolRegistryStorage)(nil)
+	_ ProtocolRegistryStorageWriter = (*ProtocolRegistryStorageTransactor)(nil)
+	_ ProtocolRegistryStorageWriter = (*ProtocolRegistryStorage)(nil)
+	_ ProtocolRegistryStorageWriter = (*FakeProtocolRegistryStorage)(nil)
+	_ ProtocolRegistryStorageEvents = (*ProtocolRegistryStorageFilterer)(nil)
+	_ ProtocolRegistryStorageEvents = (*ProtocolRegistryStorage)(nil)
+	_ ProtocolRegistryStorageEvents = (*FakeProtocolRegistryStorage)(nil)
+)
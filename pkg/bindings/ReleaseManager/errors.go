@@ -0,0 +1,52 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ReleaseManager
+
+// ErrInvalidMetadataURI is the typed form of the ReleaseManager custom error InvalidMetadataURI().
+type ErrInvalidMetadataURI struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMetadataURI) Error() string {
+	return "ReleaseManager: InvalidMetadataURI()"
+}
+
+// ErrInvalidPermissions is the typed form of the ReleaseManager custom error InvalidPermissions().
+type ErrInvalidPermissions struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPermissions) Error() string {
+	return "ReleaseManager: InvalidPermissions()"
+}
+
+// ErrInvalidUpgradeByTime is the typed form of the ReleaseManager custom error InvalidUpgradeByTime().
+type ErrInvalidUpgradeByTime struct{}
+
+// Error implements the error interface.
+func (ErrInvalidUpgradeByTime) Error() string {
+	return "ReleaseManager: InvalidUpgradeByTime()"
+}
+
+// ErrMustPublishMetadataURI is the typed form of the ReleaseManager custom error MustPublishMetadataURI().
+type ErrMustPublishMetadataURI struct{}
+
+// Error implements the error interface.
+func (ErrMustPublishMetadataURI) Error() string {
+	return "ReleaseManager: MustPublishMetadataURI()"
+}
+
+// ErrNoReleases is the typed form of the ReleaseManager custom error NoReleases().
+type ErrNoReleases struct{}
+
+// Error implements the error interface.
+func (ErrNoReleases) Error() string {
+	return "ReleaseManager: NoReleases()"
+}
+
+// ReleaseManagerErrors maps the name of every custom error in the ReleaseManager ABI to its typed Go error.
+var ReleaseManagerErrors = map[string]error{
+	"InvalidMetadataURI":     ErrInvalidMetadataURI{},
+	"InvalidPermissions":     ErrInvalidPermissions{},
+	"InvalidUpgradeByTime":   ErrInvalidUpgradeByTime{},
+	"MustPublishMetadataURI": ErrMustPublishMetadataURI{},
+	"NoReleases":             ErrNoReleases{},
+}
@@ -0,0 +1,162 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ReleaseManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeReleaseManager is an in-memory ReleaseManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ReleaseManagerFilterer.
+type FakeReleaseManager struct {
+	*fake.Contract
+	*ReleaseManagerFilterer
+}
+
+// NewFakeReleaseManager returns an empty fake of the contract at address.
+func NewFakeReleaseManager(address common.Address) *FakeReleaseManager {
+	contract := fake.NewContract(ReleaseManagerMetaData, address)
+	filterer, err := NewReleaseManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeReleaseManager{Contract: contract, ReleaseManagerFilterer: filterer}
+}
+
+// GetLatestRelease returns the values stubbed with StubGetLatestRelease.
+func (_FakeReleaseManager *FakeReleaseManager) GetLatestRelease(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, IReleaseManagerTypesRelease, error) {
+	var out0 *big.Int
+	var out1 IReleaseManagerTypesRelease
+	err := _FakeReleaseManager.Contract.Read("getLatestRelease", []interface{}{operatorSet}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetLatestRelease sets what GetLatestRelease returns for the given arguments.
+func (_FakeReleaseManager *FakeReleaseManager) StubGetLatestRelease(operatorSet OperatorSet, out0 *big.Int, out1 IReleaseManagerTypesRelease) {
+	_FakeReleaseManager.Contract.Stub("getLatestRelease", []interface{}{operatorSet}, out0, out1)
+}
+
+// GetLatestUpgradeByTime returns the values stubbed with StubGetLatestUpgradeByTime.
+func (_FakeReleaseManager *FakeReleaseManager) GetLatestUpgradeByTime(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeReleaseManager.Contract.Read("getLatestUpgradeByTime", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetLatestUpgradeByTime sets what GetLatestUpgradeByTime returns for the given arguments.
+func (_FakeReleaseManager *FakeReleaseManager) StubGetLatestUpgradeByTime(operatorSet OperatorSet, out0 uint32) {
+	_FakeReleaseManager.Contract.Stub("getLatestUpgradeByTime", []interface{}{operatorSet}, out0)
+}
+
+// GetMetadataURI returns the values stubbed with StubGetMetadataURI.
+func (_FakeReleaseManager *FakeReleaseManager) GetMetadataURI(opts *bind.CallOpts, operatorSet OperatorSet) (string, error) {
+	var out0 string
+	err := _FakeReleaseManager.Contract.Read("getMetadataURI", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetMetadataURI sets what GetMetadataURI returns for the given arguments.
+func (_FakeReleaseManager *FakeReleaseManager) StubGetMetadataURI(operatorSet OperatorSet, out0 string) {
+	_FakeReleaseManager.Contract.Stub("getMetadataURI", []interface{}{operatorSet}, out0)
+}
+
+// GetRelease returns the values stubbed with StubGetRelease.
+func (_FakeReleaseManager *FakeReleaseManager) GetRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (IReleaseManagerTypesRelease, error) {
+	var out0 IReleaseManagerTypesRelease
+	err := _FakeReleaseManager.Contract.Read("getRelease", []interface{}{operatorSet, releaseId}, &out0)
+	return out0, err
+}
+
+// StubGetRelease sets what GetRelease returns for the given arguments.
+func (_FakeReleaseManager *FakeReleaseManager) StubGetRelease(operatorSet OperatorSet, releaseId *big.Int, out0 IReleaseManagerTypesRelease) {
+	_FakeReleaseManager.Contract.Stub("getRelease", []interface{}{operatorSet, releaseId}, out0)
+}
+
+// GetTotalReleases returns the values stubbed with StubGetTotalReleases.
+func (_FakeReleaseManager *FakeReleaseManager) GetTotalReleases(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeReleaseManager.Contract.Read("getTotalReleases", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetTotalReleases sets what GetTotalReleases returns for the given arguments.
+func (_FakeReleaseManager *FakeReleaseManager) StubGetTotalReleases(operatorSet OperatorSet, out0 *big.Int) {
+	_FakeReleaseManager.Contract.Stub("getTotalReleases", []interface{}{operatorSet}, out0)
+}
+
+// IsValidRelease returns the values stubbed with StubIsValidRelease.
+func (_FakeReleaseManager *FakeReleaseManager) IsValidRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (bool, error) {
+	var out0 bool
+	err := _FakeReleaseManager.Contract.Read("isValidRelease", []interface{}{operatorSet, releaseId}, &out0)
+	return out0, err
+}
+
+// StubIsValidRelease sets what IsValidRelease returns for the given arguments.
+func (_FakeReleaseManager *FakeReleaseManager) StubIsValidRelease(operatorSet OperatorSet, releaseId *big.Int, out0 bool) {
+	_FakeReleaseManager.Contract.Stub("isValidRelease", []interface{}{operatorSet, releaseId}, out0)
+}
+
+// PermissionController returns the values stubbed with StubPermissionController.
+func (_FakeReleaseManager *FakeReleaseManager) PermissionController(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeReleaseManager.Contract.Read("permissionController", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPermissionController sets what PermissionController returns for the given arguments.
+func (_FakeReleaseManager *FakeReleaseManager) StubPermissionController(out0 common.Address) {
+	_FakeReleaseManager.Contract.Stub("permissionController", []interface{}{}, out0)
+}
+
+// PublishMetadataURI records a publishMetadataURI call after running the hook set with OnPublishMetadataURI.
+func (_FakeReleaseManager *FakeReleaseManager) PublishMetadataURI(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) (*types.Transaction, error) {
+	return _FakeReleaseManager.Contract.Transact(opts, "publishMetadataURI", func() error {
+		if hook, ok := _FakeReleaseManager.Contract.Hook("publishMetadataURI").(func(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) error); ok {
+			return hook(opts, operatorSet, metadataURI)
+		}
+		return nil
+	}, operatorSet, metadataURI)
+}
+
+// OnPublishMetadataURI sets a hook run by every PublishMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeReleaseManager *FakeReleaseManager) OnPublishMetadataURI(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) error) {
+	_FakeReleaseManager.Contract.SetHook("publishMetadataURI", hook)
+}
+
+// PublishRelease records a publishRelease call after running the hook set with OnPublishRelease.
+func (_FakeReleaseManager *FakeReleaseManager) PublishRelease(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) (*types.Transaction, error) {
+	return _FakeReleaseManager.Contract.Transact(opts, "publishRelease", func() error {
+		if hook, ok := _FakeReleaseManager.Contract.Hook("publishRelease").(func(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) error); ok {
+			return hook(opts, operatorSet, release)
+		}
+		return nil
+	}, operatorSet, release)
+}
+
+// OnPublishRelease sets a hook run by every PublishRelease call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeReleaseManager *FakeReleaseManager) OnPublishRelease(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) error) {
+	_FakeReleaseManager.Contract.SetHook("publishRelease", hook)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeReleaseManager *FakeReleaseManager) EmitInitialized(event *ReleaseManagerInitialized) {
+	_FakeReleaseManager.Contract.Emit("Initialized", event)
+}
+
+// EmitMetadataURIPublished appends the event to the fake's logs.
+func (_FakeReleaseManager *FakeReleaseManager) EmitMetadataURIPublished(event *ReleaseManagerMetadataURIPublished) {
+	_FakeReleaseManager.Contract.Emit("MetadataURIPublished", event)
+}
+
+// EmitReleasePublished appends the event to the fake's logs.
+func (_FakeReleaseManager *FakeReleaseManager) EmitReleasePublished(event *ReleaseManagerReleasePublished) {
+	_FakeReleaseManager.Contract.Emit("ReleasePublished", event)
+}
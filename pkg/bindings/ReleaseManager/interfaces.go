@@ -0,0 +1,56 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ReleaseManager
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// ReleaseManagerReader is the read-only surface of the ReleaseManager
+// binding, implemented by ReleaseManagerCaller, ReleaseManager and FakeReleaseManager.
+type ReleaseManagerReader interface {
+	GetLatestRelease(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, IReleaseManagerTypesRelease, error)
+	GetLatestUpgradeByTime(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	GetMetadataURI(opts *bind.CallOpts, operatorSet OperatorSet) (string, error)
+	GetRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (IReleaseManagerTypesRelease, error)
+	GetTotalReleases(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error)
+	IsValidRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (bool, error)
+	PermissionController(opts *bind.CallOpts) (common.Address, error)
+}
+
+// ReleaseManagerWriter is the transacting surface of the ReleaseManager
+// binding, implemented by ReleaseManagerTransactor, ReleaseManager and FakeReleaseManager.
+type ReleaseManagerWriter interface {
+	PublishMetadataURI(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) (*types.Transaction, error)
+	PublishRelease(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) (*types.Transaction, error)
+}
+
+// ReleaseManagerEvents is the event filtering surface of the ReleaseManager
+// binding, implemented by ReleaseManagerFilterer, ReleaseManager and FakeReleaseManager.
+type ReleaseManagerEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*ReleaseManagerInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *ReleaseManagerInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*ReleaseManagerInitialized, error)
+	FilterMetadataURIPublished(opts *bind.FilterOpts, operatorSet []OperatorSet) (*ReleaseManagerMetadataURIPublishedIterator, error)
+	WatchMetadataURIPublished(opts *bind.WatchOpts, sink chan<- *ReleaseManagerMetadataURIPublished, operatorSet []OperatorSet) (event.Subscription, error)
+	ParseMetadataURIPublished(log types.Log) (*ReleaseManagerMetadataURIPublished, error)
+	FilterReleasePublished(opts *bind.FilterOpts, operatorSet []OperatorSet, releaseId []*big.Int) (*ReleaseManagerReleasePublishedIterator, error)
+	WatchReleasePublished(opts *bind.WatchOpts, sink chan<- *ReleaseManagerReleasePublished, operatorSet []OperatorSet, releaseId []*big.Int) (event.Subscription, error)
+	ParseReleasePublished(log types.Log) (*ReleaseManagerReleasePublished, error)
+}
+
+var (
+	_ ReleaseManagerReader = (*ReleaseManagerCaller)(nil)
+	_ ReleaseManagerReader = (*ReleaseManager)(nil)
+	_ ReleaseManagerReader = (*FakeReleaseManager)(nil)
+	_ ReleaseManagerWriter = (*ReleaseManagerTransactor)(nil)
+	_ ReleaseManagerWriter = (*ReleaseManager)(nil)
+	_ ReleaseManagerWriter = (*FakeReleaseManager)(nil)
+	_ ReleaseManagerEvents = (*ReleaseManagerFilterer)(nil)
+	_ ReleaseManagerEvents = (*ReleaseManager)(nil)
+	_ ReleaseManagerEvents = (*FakeReleaseManager)(nil)
+)
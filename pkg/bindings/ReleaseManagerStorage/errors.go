@@ -0,0 +1,43 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ReleaseManagerStorage
+
+// ErrInvalidMetadataURI is the typed form of the ReleaseManagerStorage custom error InvalidMetadataURI().
+type ErrInvalidMetadataURI struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMetadataURI) Error() string {
+	return "ReleaseManagerStorage: InvalidMetadataURI()"
+}
+
+// ErrInvalidUpgradeByTime is the typed form of the ReleaseManagerStorage custom error InvalidUpgradeByTime().
+type ErrInvalidUpgradeByTime struct{}
+
+// Error implements the error interface.
+func (ErrInvalidUpgradeByTime) Error() string {
+	return "ReleaseManagerStorage: InvalidUpgradeByTime()"
+}
+
+// ErrMustPublishMetadataURI is the typed form of the ReleaseManagerStorage custom error MustPublishMetadataURI().
+type ErrMustPublishMetadataURI struct{}
+
+// Error implements the error interface.
+func (ErrMustPublishMetadataURI) Error() string {
+	return "ReleaseManagerStorage: MustPublishMetadataURI()"
+}
+
+// ErrNoReleases is the typed form of the ReleaseManagerStorage custom error NoReleases().
+type ErrNoReleases struct{}
+
+// Error implements the error interface.
+func (ErrNoReleases) Error() string {
+	return "ReleaseManagerStorage: NoReleases()"
+}
+
+// ReleaseManagerStorageErrors maps the name of every custom error in the ReleaseManagerStorage ABI to its typed Go error.
+var ReleaseManagerStorageErrors = map[string]error{
+	"InvalidMetadataURI":     ErrInvalidMetadataURI{},
+	"InvalidUpgradeByTime":   ErrInvalidUpgradeByTime{},
+	"MustPublishMetadataURI": ErrMustPublishMetadataURI{},
+	"NoReleases":             ErrNoReleases{},
+}
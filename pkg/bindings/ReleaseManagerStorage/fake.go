@@ -0,0 +1,145 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ReleaseManagerStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeReleaseManagerStorage is an in-memory ReleaseManagerStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded ReleaseManagerStorageFilterer.
+type FakeReleaseManagerStorage struct {
+	*fake.Contract
+	*ReleaseManagerStorageFilterer
+}
+
+// NewFakeReleaseManagerStorage returns an empty fake of the contract at address.
+func NewFakeReleaseManagerStorage(address common.Address) *FakeReleaseManagerStorage {
+	contract := fake.NewContract(ReleaseManagerStorageMetaData, address)
+	filterer, err := NewReleaseManagerStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeReleaseManagerStorage{Contract: contract, ReleaseManagerStorageFilterer: filterer}
+}
+
+// GetLatestRelease returns the values stubbed with StubGetLatestRelease.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) GetLatestRelease(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, IReleaseManagerTypesRelease, error) {
+	var out0 *big.Int
+	var out1 IReleaseManagerTypesRelease
+	err := _FakeReleaseManagerStorage.Contract.Read("getLatestRelease", []interface{}{operatorSet}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetLatestRelease sets what GetLatestRelease returns for the given arguments.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) StubGetLatestRelease(operatorSet OperatorSet, out0 *big.Int, out1 IReleaseManagerTypesRelease) {
+	_FakeReleaseManagerStorage.Contract.Stub("getLatestRelease", []interface{}{operatorSet}, out0, out1)
+}
+
+// GetLatestUpgradeByTime returns the values stubbed with StubGetLatestUpgradeByTime.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) GetLatestUpgradeByTime(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error) {
+	var out0 uint32
+	err := _FakeReleaseManagerStorage.Contract.Read("getLatestUpgradeByTime", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetLatestUpgradeByTime sets what GetLatestUpgradeByTime returns for the given arguments.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) StubGetLatestUpgradeByTime(operatorSet OperatorSet, out0 uint32) {
+	_FakeReleaseManagerStorage.Contract.Stub("getLatestUpgradeByTime", []interface{}{operatorSet}, out0)
+}
+
+// GetMetadataURI returns the values stubbed with StubGetMetadataURI.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) GetMetadataURI(opts *bind.CallOpts, operatorSet OperatorSet) (string, error) {
+	var out0 string
+	err := _FakeReleaseManagerStorage.Contract.Read("getMetadataURI", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetMetadataURI sets what GetMetadataURI returns for the given arguments.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) StubGetMetadataURI(operatorSet OperatorSet, out0 string) {
+	_FakeReleaseManagerStorage.Contract.Stub("getMetadataURI", []interface{}{operatorSet}, out0)
+}
+
+// GetRelease returns the values stubbed with StubGetRelease.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) GetRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (IReleaseManagerTypesRelease, error) {
+	var out0 IReleaseManagerTypesRelease
+	err := _FakeReleaseManagerStorage.Contract.Read("getRelease", []interface{}{operatorSet, releaseId}, &out0)
+	return out0, err
+}
+
+// StubGetRelease sets what GetRelease returns for the given arguments.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) StubGetRelease(operatorSet OperatorSet, releaseId *big.Int, out0 IReleaseManagerTypesRelease) {
+	_FakeReleaseManagerStorage.Contract.Stub("getRelease", []interface{}{operatorSet, releaseId}, out0)
+}
+
+// GetTotalReleases returns the values stubbed with StubGetTotalReleases.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) GetTotalReleases(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeReleaseManagerStorage.Contract.Read("getTotalReleases", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetTotalReleases sets what GetTotalReleases returns for the given arguments.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) StubGetTotalReleases(operatorSet OperatorSet, out0 *big.Int) {
+	_FakeReleaseManagerStorage.Contract.Stub("getTotalReleases", []interface{}{operatorSet}, out0)
+}
+
+// IsValidRelease returns the values stubbed with StubIsValidRelease.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) IsValidRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (bool, error) {
+	var out0 bool
+	err := _FakeReleaseManagerStorage.Contract.Read("isValidRelease", []interface{}{operatorSet, releaseId}, &out0)
+	return out0, err
+}
+
+// StubIsValidRelease sets what IsValidRelease returns for the given arguments.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) StubIsValidRelease(operatorSet OperatorSet, releaseId *big.Int, out0 bool) {
+	_FakeReleaseManagerStorage.Contract.Stub("isValidRelease", []interface{}{operatorSet, releaseId}, out0)
+}
+
+// PublishMetadataURI records a publishMetadataURI call after running the hook set with OnPublishMetadataURI.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) PublishMetadataURI(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) (*types.Transaction, error) {
+	return _FakeReleaseManagerStorage.Contract.Transact(opts, "publishMetadataURI", func() error {
+		if hook, ok := _FakeReleaseManagerStorage.Contract.Hook("publishMetadataURI").(func(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) error); ok {
+			return hook(opts, operatorSet, metadataURI)
+		}
+		return nil
+	}, operatorSet, metadataURI)
+}
+
+// OnPublishMetadataURI sets a hook run by every PublishMetadataURI call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) OnPublishMetadataURI(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) error) {
+	_FakeReleaseManagerStorage.Contract.SetHook("publishMetadataURI", hook)
+}
+
+// PublishRelease records a publishRelease call after running the hook set with OnPublishRelease.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) PublishRelease(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) (*types.Transaction, error) {
+	return _FakeReleaseManagerStorage.Contract.Transact(opts, "publishRelease", func() error {
+		if hook, ok := _FakeReleaseManagerStorage.Contract.Hook("publishRelease").(func(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) error); ok {
+			return hook(opts, operatorSet, release)
+		}
+		return nil
+	}, operatorSet, release)
+}
+
+// OnPublishRelease sets a hook run by every PublishRelease call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) OnPublishRelease(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) error) {
+	_FakeReleaseManagerStorage.Contract.SetHook("publishRelease", hook)
+}
+
+// EmitMetadataURIPublished appends the event to the fake's logs.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) EmitMetadataURIPublished(event *ReleaseManagerStorageMetadataURIPublished) {
+	_FakeReleaseManagerStorage.Contract.Emit("MetadataURIPublished", event)
+}
+
+// EmitReleasePublished appends the event to the fake's logs.
+func (_FakeReleaseManagerStorage *FakeReleaseManagerStorage) EmitReleasePublished(event *ReleaseManagerStorageReleasePublished) {
+	_FakeReleaseManagerStorage.Contract.Emit("ReleasePublished", event)
+}
@@ -0,0 +1,51 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package ReleaseManagerStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// ReleaseManagerStorageReader is the read-only surface of the ReleaseManagerStorage
+// binding, implemented by ReleaseManagerStorageCaller, ReleaseManagerStorage and FakeReleaseManagerStorage.
+type ReleaseManagerStorageReader interface {
+	GetLatestRelease(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, IReleaseManagerTypesRelease, error)
+	GetLatestUpgradeByTime(opts *bind.CallOpts, operatorSet OperatorSet) (uint32, error)
+	GetMetadataURI(opts *bind.CallOpts, operatorSet OperatorSet) (string, error)
+	GetRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (IReleaseManagerTypesRelease, error)
+	GetTotalReleases(opts *bind.CallOpts, operatorSet OperatorSet) (*big.Int, error)
+	IsValidRelease(opts *bind.CallOpts, operatorSet OperatorSet, releaseId *big.Int) (bool, error)
+}
+
+// ReleaseManagerStorageWriter is the transacting surface of the ReleaseManagerStorage
+// binding, implemented by ReleaseManagerStorageTransactor, ReleaseManagerStorage and FakeReleaseManagerStorage.
+type ReleaseManagerStorageWriter interface {
+	PublishMetadataURI(opts *bind.TransactOpts, operatorSet OperatorSet, metadataURI string) (*types.Transaction, error)
+	PublishRelease(opts *bind.TransactOpts, operatorSet OperatorSet, release IReleaseManagerTypesRelease) (*types.Transaction, error)
+}
+
+// ReleaseManagerStorageEvents is the event filtering surface of the ReleaseManagerStorage
+// binding, implemented by ReleaseManagerStorageFilterer, ReleaseManagerStorage and FakeReleaseManagerStorage.
+type ReleaseManagerStorageEvents interface {
+	FilterMetadataURIPublished(opts *bind.FilterOpts, operatorSet []OperatorSet) (*ReleaseManagerStorageMetadataURIPublishedIterator, error)
+	WatchMetadataURIPublished(opts *bind.WatchOpts, sink chan<- *ReleaseManagerStorageMetadataURIPublished, operatorSet []OperatorSet) (event.Subscription, error)
+	ParseMetadataURIPublished(log types.Log) (*ReleaseManagerStorageMetadataURIPublished, error)
+	FilterReleasePublished(opts *bind.FilterOpts, operatorSet []OperatorSet, releaseId []*big.Int) (*ReleaseManagerStorageReleasePublishedIterator, error)
+	WatchReleasePublished(opts *bind.WatchOpts, sink chan<- *ReleaseManagerStorageReleasePublished, operatorSet []OperatorSet, releaseId []*big.Int) (event.Subscription, error)
+	ParseReleasePublished(log types.Log) (*ReleaseManagerStorageReleasePublished, error)
+}
+
+var (
+	_ ReleaseManagerStorageReader = (*ReleaseManagerStorageCaller)(nil)
+	_ ReleaseManagerStorageReader = (*ReleaseManagerStorage)(nil)
+	_ ReleaseManagerStorageReader = (*FakeReleaseManagerStorage)(nil)
+	_ ReleaseManagerStorageWriter = (*ReleaseManagerStorageTransactor)(nil)
+	_ ReleaseManagerStorageWriter = (*ReleaseManagerStorage)(nil)
+	_ ReleaseManagerStorageWriter = (*FakeReleaseManagerStorage)(nil)
+	_ ReleaseManagerStorageEvents = (*ReleaseManagerStorageFilterer)(nil)
+	_ ReleaseManagerStorageEvents = (*ReleaseManagerStorage)(nil)
+	_ ReleaseManagerStorageEvents = (*FakeReleaseManagerStorage)(nil)
+)
@@ -0,0 +1,385 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package RewardsCoordinator
+
+// ErrAmountExceedsMax is the typed form of the RewardsCoordinator custom error AmountExceedsMax().
+type ErrAmountExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrAmountExceedsMax) Error() string {
+	return "RewardsCoordinator: AmountExceedsMax()"
+}
+
+// ErrAmountIsZero is the typed form of the RewardsCoordinator custom error AmountIsZero().
+type ErrAmountIsZero struct{}
+
+// Error implements the error interface.
+func (ErrAmountIsZero) Error() string {
+	return "RewardsCoordinator: AmountIsZero()"
+}
+
+// ErrCurrentlyPaused is the typed form of the RewardsCoordinator custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "RewardsCoordinator: CurrentlyPaused()"
+}
+
+// ErrDurationExceedsMax is the typed form of the RewardsCoordinator custom error DurationExceedsMax().
+type ErrDurationExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrDurationExceedsMax) Error() string {
+	return "RewardsCoordinator: DurationExceedsMax()"
+}
+
+// ErrDurationIsZero is the typed form of the RewardsCoordinator custom error DurationIsZero().
+type ErrDurationIsZero struct{}
+
+// Error implements the error interface.
+func (ErrDurationIsZero) Error() string {
+	return "RewardsCoordinator: DurationIsZero()"
+}
+
+// ErrEarningsNotGreaterThanClaimed is the typed form of the RewardsCoordinator custom error EarningsNotGreaterThanClaimed().
+type ErrEarningsNotGreaterThanClaimed struct{}
+
+// Error implements the error interface.
+func (ErrEarningsNotGreaterThanClaimed) Error() string {
+	return "RewardsCoordinator: EarningsNotGreaterThanClaimed()"
+}
+
+// ErrEmptyRoot is the typed form of the RewardsCoordinator custom error EmptyRoot().
+type ErrEmptyRoot struct{}
+
+// Error implements the error interface.
+func (ErrEmptyRoot) Error() string {
+	return "RewardsCoordinator: EmptyRoot()"
+}
+
+// ErrInputAddressZero is the typed form of the RewardsCoordinator custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "RewardsCoordinator: InputAddressZero()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the RewardsCoordinator custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "RewardsCoordinator: InputArrayLengthMismatch()"
+}
+
+// ErrInputArrayLengthZero is the typed form of the RewardsCoordinator custom error InputArrayLengthZero().
+type ErrInputArrayLengthZero struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthZero) Error() string {
+	return "RewardsCoordinator: InputArrayLengthZero()"
+}
+
+// ErrInvalidAddressZero is the typed form of the RewardsCoordinator custom error InvalidAddressZero().
+type ErrInvalidAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAddressZero) Error() string {
+	return "RewardsCoordinator: InvalidAddressZero()"
+}
+
+// ErrInvalidCalculationIntervalSecondsRemainder is the typed form of the RewardsCoordinator custom error InvalidCalculationIntervalSecondsRemainder().
+type ErrInvalidCalculationIntervalSecondsRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCalculationIntervalSecondsRemainder) Error() string {
+	return "RewardsCoordinator: InvalidCalculationIntervalSecondsRemainder()"
+}
+
+// ErrInvalidClaimProof is the typed form of the RewardsCoordinator custom error InvalidClaimProof().
+type ErrInvalidClaimProof struct{}
+
+// Error implements the error interface.
+func (ErrInvalidClaimProof) Error() string {
+	return "RewardsCoordinator: InvalidClaimProof()"
+}
+
+// ErrInvalidDurationRemainder is the typed form of the RewardsCoordinator custom error InvalidDurationRemainder().
+type ErrInvalidDurationRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidDurationRemainder) Error() string {
+	return "RewardsCoordinator: InvalidDurationRemainder()"
+}
+
+// ErrInvalidEarner is the typed form of the RewardsCoordinator custom error InvalidEarner().
+type ErrInvalidEarner struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEarner) Error() string {
+	return "RewardsCoordinator: InvalidEarner()"
+}
+
+// ErrInvalidEarnerLeafIndex is the typed form of the RewardsCoordinator custom error InvalidEarnerLeafIndex().
+type ErrInvalidEarnerLeafIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEarnerLeafIndex) Error() string {
+	return "RewardsCoordinator: InvalidEarnerLeafIndex()"
+}
+
+// ErrInvalidGenesisRewardsTimestampRemainder is the typed form of the RewardsCoordinator custom error InvalidGenesisRewardsTimestampRemainder().
+type ErrInvalidGenesisRewardsTimestampRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGenesisRewardsTimestampRemainder) Error() string {
+	return "RewardsCoordinator: InvalidGenesisRewardsTimestampRemainder()"
+}
+
+// ErrInvalidIndex is the typed form of the RewardsCoordinator custom error InvalidIndex().
+type ErrInvalidIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidIndex) Error() string {
+	return "RewardsCoordinator: InvalidIndex()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the RewardsCoordinator custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "RewardsCoordinator: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the RewardsCoordinator custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "RewardsCoordinator: InvalidOperatorSet()"
+}
+
+// ErrInvalidPermissions is the typed form of the RewardsCoordinator custom error InvalidPermissions().
+type ErrInvalidPermissions struct{}
+
+// Error implements the error interface.
+func (ErrInvalidPermissions) Error() string {
+	return "RewardsCoordinator: InvalidPermissions()"
+}
+
+// ErrInvalidProofLength is the typed form of the RewardsCoordinator custom error InvalidProofLength().
+type ErrInvalidProofLength struct{}
+
+// Error implements the error interface.
+func (ErrInvalidProofLength) Error() string {
+	return "RewardsCoordinator: InvalidProofLength()"
+}
+
+// ErrInvalidRoot is the typed form of the RewardsCoordinator custom error InvalidRoot().
+type ErrInvalidRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRoot) Error() string {
+	return "RewardsCoordinator: InvalidRoot()"
+}
+
+// ErrInvalidRootIndex is the typed form of the RewardsCoordinator custom error InvalidRootIndex().
+type ErrInvalidRootIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRootIndex) Error() string {
+	return "RewardsCoordinator: InvalidRootIndex()"
+}
+
+// ErrInvalidStartTimestampRemainder is the typed form of the RewardsCoordinator custom error InvalidStartTimestampRemainder().
+type ErrInvalidStartTimestampRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStartTimestampRemainder) Error() string {
+	return "RewardsCoordinator: InvalidStartTimestampRemainder()"
+}
+
+// ErrInvalidTokenLeafIndex is the typed form of the RewardsCoordinator custom error InvalidTokenLeafIndex().
+type ErrInvalidTokenLeafIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTokenLeafIndex) Error() string {
+	return "RewardsCoordinator: InvalidTokenLeafIndex()"
+}
+
+// ErrNewRootMustBeForNewCalculatedPeriod is the typed form of the RewardsCoordinator custom error NewRootMustBeForNewCalculatedPeriod().
+type ErrNewRootMustBeForNewCalculatedPeriod struct{}
+
+// Error implements the error interface.
+func (ErrNewRootMustBeForNewCalculatedPeriod) Error() string {
+	return "RewardsCoordinator: NewRootMustBeForNewCalculatedPeriod()"
+}
+
+// ErrOnlyPauser is the typed form of the RewardsCoordinator custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "RewardsCoordinator: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the RewardsCoordinator custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "RewardsCoordinator: OnlyUnpauser()"
+}
+
+// ErrOperatorsNotInAscendingOrder is the typed form of the RewardsCoordinator custom error OperatorsNotInAscendingOrder().
+type ErrOperatorsNotInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrOperatorsNotInAscendingOrder) Error() string {
+	return "RewardsCoordinator: OperatorsNotInAscendingOrder()"
+}
+
+// ErrPreviousSplitPending is the typed form of the RewardsCoordinator custom error PreviousSplitPending().
+type ErrPreviousSplitPending struct{}
+
+// Error implements the error interface.
+func (ErrPreviousSplitPending) Error() string {
+	return "RewardsCoordinator: PreviousSplitPending()"
+}
+
+// ErrRewardsEndTimestampNotElapsed is the typed form of the RewardsCoordinator custom error RewardsEndTimestampNotElapsed().
+type ErrRewardsEndTimestampNotElapsed struct{}
+
+// Error implements the error interface.
+func (ErrRewardsEndTimestampNotElapsed) Error() string {
+	return "RewardsCoordinator: RewardsEndTimestampNotElapsed()"
+}
+
+// ErrRootAlreadyActivated is the typed form of the RewardsCoordinator custom error RootAlreadyActivated().
+type ErrRootAlreadyActivated struct{}
+
+// Error implements the error interface.
+func (ErrRootAlreadyActivated) Error() string {
+	return "RewardsCoordinator: RootAlreadyActivated()"
+}
+
+// ErrRootDisabled is the typed form of the RewardsCoordinator custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "RewardsCoordinator: RootDisabled()"
+}
+
+// ErrRootNotActivated is the typed form of the RewardsCoordinator custom error RootNotActivated().
+type ErrRootNotActivated struct{}
+
+// Error implements the error interface.
+func (ErrRootNotActivated) Error() string {
+	return "RewardsCoordinator: RootNotActivated()"
+}
+
+// ErrSplitExceedsMax is the typed form of the RewardsCoordinator custom error SplitExceedsMax().
+type ErrSplitExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrSplitExceedsMax) Error() string {
+	return "RewardsCoordinator: SplitExceedsMax()"
+}
+
+// ErrStartTimestampTooFarInFuture is the typed form of the RewardsCoordinator custom error StartTimestampTooFarInFuture().
+type ErrStartTimestampTooFarInFuture struct{}
+
+// Error implements the error interface.
+func (ErrStartTimestampTooFarInFuture) Error() string {
+	return "RewardsCoordinator: StartTimestampTooFarInFuture()"
+}
+
+// ErrStartTimestampTooFarInPast is the typed form of the RewardsCoordinator custom error StartTimestampTooFarInPast().
+type ErrStartTimestampTooFarInPast struct{}
+
+// Error implements the error interface.
+func (ErrStartTimestampTooFarInPast) Error() string {
+	return "RewardsCoordinator: StartTimestampTooFarInPast()"
+}
+
+// ErrStrategiesNotInAscendingOrder is the typed form of the RewardsCoordinator custom error StrategiesNotInAscendingOrder().
+type ErrStrategiesNotInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrStrategiesNotInAscendingOrder) Error() string {
+	return "RewardsCoordinator: StrategiesNotInAscendingOrder()"
+}
+
+// ErrStrategyNotWhitelisted is the typed form of the RewardsCoordinator custom error StrategyNotWhitelisted().
+type ErrStrategyNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotWhitelisted) Error() string {
+	return "RewardsCoordinator: StrategyNotWhitelisted()"
+}
+
+// ErrSubmissionNotRetroactive is the typed form of the RewardsCoordinator custom error SubmissionNotRetroactive().
+type ErrSubmissionNotRetroactive struct{}
+
+// Error implements the error interface.
+func (ErrSubmissionNotRetroactive) Error() string {
+	return "RewardsCoordinator: SubmissionNotRetroactive()"
+}
+
+// ErrUnauthorizedCaller is the typed form of the RewardsCoordinator custom error UnauthorizedCaller().
+type ErrUnauthorizedCaller struct{}
+
+// Error implements the error interface.
+func (ErrUnauthorizedCaller) Error() string {
+	return "RewardsCoordinator: UnauthorizedCaller()"
+}
+
+// RewardsCoordinatorErrors maps the name of every custom error in the RewardsCoordinator ABI to its typed Go error.
+var RewardsCoordinatorErrors = map[string]error{
+	"AmountExceedsMax":                           ErrAmountExceedsMax{},
+	"AmountIsZero":                               ErrAmountIsZero{},
+	"CurrentlyPaused":                            ErrCurrentlyPaused{},
+	"DurationExceedsMax":                         ErrDurationExceedsMax{},
+	"DurationIsZero":                             ErrDurationIsZero{},
+	"EarningsNotGreaterThanClaimed":              ErrEarningsNotGreaterThanClaimed{},
+	"EmptyRoot":                                  ErrEmptyRoot{},
+	"InputAddressZero":                           ErrInputAddressZero{},
+	"InputArrayLengthMismatch":                   ErrInputArrayLengthMismatch{},
+	"InputArrayLengthZero":                       ErrInputArrayLengthZero{},
+	"InvalidAddressZero":                         ErrInvalidAddressZero{},
+	"InvalidCalculationIntervalSecondsRemainder": ErrInvalidCalculationIntervalSecondsRemainder{},
+	"InvalidClaimProof":                          ErrInvalidClaimProof{},
+	"InvalidDurationRemainder":                   ErrInvalidDurationRemainder{},
+	"InvalidEarner":                              ErrInvalidEarner{},
+	"InvalidEarnerLeafIndex":                     ErrInvalidEarnerLeafIndex{},
+	"InvalidGenesisRewardsTimestampRemainder":    ErrInvalidGenesisRewardsTimestampRemainder{},
+	"InvalidIndex":                               ErrInvalidIndex{},
+	"InvalidNewPausedStatus":                     ErrInvalidNewPausedStatus{},
+	"InvalidOperatorSet":                         ErrInvalidOperatorSet{},
+	"InvalidPermissions":                         ErrInvalidPermissions{},
+	"InvalidProofLength":                         ErrInvalidProofLength{},
+	"InvalidRoot":                                ErrInvalidRoot{},
+	"InvalidRootIndex":                           ErrInvalidRootIndex{},
+	"InvalidStartTimestampRemainder":             ErrInvalidStartTimestampRemainder{},
+	"InvalidTokenLeafIndex":                      ErrInvalidTokenLeafIndex{},
+	"NewRootMustBeForNewCalculatedPeriod":        ErrNewRootMustBeForNewCalculatedPeriod{},
+	"OnlyPauser":                                 ErrOnlyPauser{},
+	"OnlyUnpauser":                               ErrOnlyUnpauser{},
+	"OperatorsNotInAscendingOrder":               ErrOperatorsNotInAscendingOrder{},
+	"PreviousSplitPending":                       ErrPreviousSplitPending{},
+	"RewardsEndTimestampNotElapsed":              ErrRewardsEndTimestampNotElapsed{},
+	"RootAlreadyActivated":                       ErrRootAlreadyActivated{},
+	"RootDisabled":                               ErrRootDisabled{},
+	"RootNotActivated":                           ErrRootNotActivated{},
+	"SplitExceedsMax":                            ErrSplitExceedsMax{},
+	"StartTimestampTooFarInFuture":               ErrStartTimestampTooFarInFuture{},
+	"StartTimestampTooFarInPast":                 ErrStartTimestampTooFarInPast{},
+	"StrategiesNotInAscendingOrder":              ErrStrategiesNotInAscendingOrder{},
+	"StrategyNotWhitelisted":                     ErrStrategyNotWhitelisted{},
+	"SubmissionNotRetroactive":                   ErrSubmissionNotRetroactive{},
+	"UnauthorizedCaller":                         ErrUnauthorizedCaller{},
+}
@@ -0,0 +1,970 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package RewardsCoordinator
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeRewardsCoordinator is an in-memory RewardsCoordinator for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded RewardsCoordinatorFilterer.
+type FakeRewardsCoordinator struct {
+	*fake.Contract
+	*RewardsCoordinatorFilterer
+}
+
+// NewFakeRewardsCoordinator returns an empty fake of the contract at address.
+func NewFakeRewardsCoordinator(address common.Address) *FakeRewardsCoordinator {
+	contract := fake.NewContract(RewardsCoordinatorMetaData, address)
+	filterer, err := NewRewardsCoordinatorFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeRewardsCoordinator{Contract: contract, RewardsCoordinatorFilterer: filterer}
+}
+
+// CALCULATIONINTERVALSECONDS returns the values stubbed with StubCALCULATIONINTERVALSECONDS.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinator.Contract.Read("CALCULATION_INTERVAL_SECONDS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCALCULATIONINTERVALSECONDS sets what CALCULATIONINTERVALSECONDS returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubCALCULATIONINTERVALSECONDS(out0 uint32) {
+	_FakeRewardsCoordinator.Contract.Stub("CALCULATION_INTERVAL_SECONDS", []interface{}{}, out0)
+}
+
+// GENESISREWARDSTIMESTAMP returns the values stubbed with StubGENESISREWARDSTIMESTAMP.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GENESISREWARDSTIMESTAMP(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinator.Contract.Read("GENESIS_REWARDS_TIMESTAMP", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENESISREWARDSTIMESTAMP sets what GENESISREWARDSTIMESTAMP returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGENESISREWARDSTIMESTAMP(out0 uint32) {
+	_FakeRewardsCoordinator.Contract.Stub("GENESIS_REWARDS_TIMESTAMP", []interface{}{}, out0)
+}
+
+// MAXFUTURELENGTH returns the values stubbed with StubMAXFUTURELENGTH.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) MAXFUTURELENGTH(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinator.Contract.Read("MAX_FUTURE_LENGTH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXFUTURELENGTH sets what MAXFUTURELENGTH returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubMAXFUTURELENGTH(out0 uint32) {
+	_FakeRewardsCoordinator.Contract.Stub("MAX_FUTURE_LENGTH", []interface{}{}, out0)
+}
+
+// MAXRETROACTIVELENGTH returns the values stubbed with StubMAXRETROACTIVELENGTH.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) MAXRETROACTIVELENGTH(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinator.Contract.Read("MAX_RETROACTIVE_LENGTH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXRETROACTIVELENGTH sets what MAXRETROACTIVELENGTH returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubMAXRETROACTIVELENGTH(out0 uint32) {
+	_FakeRewardsCoordinator.Contract.Stub("MAX_RETROACTIVE_LENGTH", []interface{}{}, out0)
+}
+
+// MAXREWARDSDURATION returns the values stubbed with StubMAXREWARDSDURATION.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinator.Contract.Read("MAX_REWARDS_DURATION", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXREWARDSDURATION sets what MAXREWARDSDURATION returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubMAXREWARDSDURATION(out0 uint32) {
+	_FakeRewardsCoordinator.Contract.Stub("MAX_REWARDS_DURATION", []interface{}{}, out0)
+}
+
+// ActivationDelay returns the values stubbed with StubActivationDelay.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) ActivationDelay(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinator.Contract.Read("activationDelay", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubActivationDelay sets what ActivationDelay returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubActivationDelay(out0 uint32) {
+	_FakeRewardsCoordinator.Contract.Stub("activationDelay", []interface{}{}, out0)
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubAllocationManager(out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// BeaconChainETHStrategy returns the values stubbed with StubBeaconChainETHStrategy.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("beaconChainETHStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainETHStrategy sets what BeaconChainETHStrategy returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubBeaconChainETHStrategy(out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("beaconChainETHStrategy", []interface{}{}, out0)
+}
+
+// CalculateEarnerLeafHash returns the values stubbed with StubCalculateEarnerLeafHash.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CalculateEarnerLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTypesEarnerTreeMerkleLeaf) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeRewardsCoordinator.Contract.Read("calculateEarnerLeafHash", []interface{}{leaf}, &out0)
+	return out0, err
+}
+
+// StubCalculateEarnerLeafHash sets what CalculateEarnerLeafHash returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubCalculateEarnerLeafHash(leaf IRewardsCoordinatorTypesEarnerTreeMerkleLeaf, out0 [32]byte) {
+	_FakeRewardsCoordinator.Contract.Stub("calculateEarnerLeafHash", []interface{}{leaf}, out0)
+}
+
+// CalculateTokenLeafHash returns the values stubbed with StubCalculateTokenLeafHash.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CalculateTokenLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTypesTokenTreeMerkleLeaf) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeRewardsCoordinator.Contract.Read("calculateTokenLeafHash", []interface{}{leaf}, &out0)
+	return out0, err
+}
+
+// StubCalculateTokenLeafHash sets what CalculateTokenLeafHash returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubCalculateTokenLeafHash(leaf IRewardsCoordinatorTypesTokenTreeMerkleLeaf, out0 [32]byte) {
+	_FakeRewardsCoordinator.Contract.Stub("calculateTokenLeafHash", []interface{}{leaf}, out0)
+}
+
+// CheckClaim returns the values stubbed with StubCheckClaim.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CheckClaim(opts *bind.CallOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinator.Contract.Read("checkClaim", []interface{}{claim}, &out0)
+	return out0, err
+}
+
+// StubCheckClaim sets what CheckClaim returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubCheckClaim(claim IRewardsCoordinatorTypesRewardsMerkleClaim, out0 bool) {
+	_FakeRewardsCoordinator.Contract.Stub("checkClaim", []interface{}{claim}, out0)
+}
+
+// ClaimerFor returns the values stubbed with StubClaimerFor.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) ClaimerFor(opts *bind.CallOpts, earner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("claimerFor", []interface{}{earner}, &out0)
+	return out0, err
+}
+
+// StubClaimerFor sets what ClaimerFor returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubClaimerFor(earner common.Address, out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("claimerFor", []interface{}{earner}, out0)
+}
+
+// CumulativeClaimed returns the values stubbed with StubCumulativeClaimed.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CumulativeClaimed(opts *bind.CallOpts, earner common.Address, token common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeRewardsCoordinator.Contract.Read("cumulativeClaimed", []interface{}{earner, token}, &out0)
+	return out0, err
+}
+
+// StubCumulativeClaimed sets what CumulativeClaimed returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubCumulativeClaimed(earner common.Address, token common.Address, out0 *big.Int) {
+	_FakeRewardsCoordinator.Contract.Stub("cumulativeClaimed", []interface{}{earner, token}, out0)
+}
+
+// CurrRewardsCalculationEndTimestamp returns the values stubbed with StubCurrRewardsCalculationEndTimestamp.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinator.Contract.Read("currRewardsCalculationEndTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrRewardsCalculationEndTimestamp sets what CurrRewardsCalculationEndTimestamp returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubCurrRewardsCalculationEndTimestamp(out0 uint32) {
+	_FakeRewardsCoordinator.Contract.Stub("currRewardsCalculationEndTimestamp", []interface{}{}, out0)
+}
+
+// DefaultOperatorSplitBips returns the values stubbed with StubDefaultOperatorSplitBips.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) DefaultOperatorSplitBips(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeRewardsCoordinator.Contract.Read("defaultOperatorSplitBips", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDefaultOperatorSplitBips sets what DefaultOperatorSplitBips returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubDefaultOperatorSplitBips(out0 uint16) {
+	_FakeRewardsCoordinator.Contract.Stub("defaultOperatorSplitBips", []interface{}{}, out0)
+}
+
+// DelegationManager returns the values stubbed with StubDelegationManager.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) DelegationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("delegationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegationManager sets what DelegationManager returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubDelegationManager(out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("delegationManager", []interface{}{}, out0)
+}
+
+// GetCurrentClaimableDistributionRoot returns the values stubbed with StubGetCurrentClaimableDistributionRoot.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GetCurrentClaimableDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeRewardsCoordinator.Contract.Read("getCurrentClaimableDistributionRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentClaimableDistributionRoot sets what GetCurrentClaimableDistributionRoot returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGetCurrentClaimableDistributionRoot(out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeRewardsCoordinator.Contract.Stub("getCurrentClaimableDistributionRoot", []interface{}{}, out0)
+}
+
+// GetCurrentDistributionRoot returns the values stubbed with StubGetCurrentDistributionRoot.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GetCurrentDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeRewardsCoordinator.Contract.Read("getCurrentDistributionRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentDistributionRoot sets what GetCurrentDistributionRoot returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGetCurrentDistributionRoot(out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeRewardsCoordinator.Contract.Stub("getCurrentDistributionRoot", []interface{}{}, out0)
+}
+
+// GetDistributionRootAtIndex returns the values stubbed with StubGetDistributionRootAtIndex.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeRewardsCoordinator.Contract.Read("getDistributionRootAtIndex", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubGetDistributionRootAtIndex sets what GetDistributionRootAtIndex returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGetDistributionRootAtIndex(index *big.Int, out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeRewardsCoordinator.Contract.Stub("getDistributionRootAtIndex", []interface{}{index}, out0)
+}
+
+// GetDistributionRootsLength returns the values stubbed with StubGetDistributionRootsLength.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeRewardsCoordinator.Contract.Read("getDistributionRootsLength", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetDistributionRootsLength sets what GetDistributionRootsLength returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGetDistributionRootsLength(out0 *big.Int) {
+	_FakeRewardsCoordinator.Contract.Stub("getDistributionRootsLength", []interface{}{}, out0)
+}
+
+// GetOperatorAVSSplit returns the values stubbed with StubGetOperatorAVSSplit.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GetOperatorAVSSplit(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error) {
+	var out0 uint16
+	err := _FakeRewardsCoordinator.Contract.Read("getOperatorAVSSplit", []interface{}{operator, avs}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorAVSSplit sets what GetOperatorAVSSplit returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGetOperatorAVSSplit(operator common.Address, avs common.Address, out0 uint16) {
+	_FakeRewardsCoordinator.Contract.Stub("getOperatorAVSSplit", []interface{}{operator, avs}, out0)
+}
+
+// GetOperatorPISplit returns the values stubbed with StubGetOperatorPISplit.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GetOperatorPISplit(opts *bind.CallOpts, operator common.Address) (uint16, error) {
+	var out0 uint16
+	err := _FakeRewardsCoordinator.Contract.Read("getOperatorPISplit", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorPISplit sets what GetOperatorPISplit returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGetOperatorPISplit(operator common.Address, out0 uint16) {
+	_FakeRewardsCoordinator.Contract.Stub("getOperatorPISplit", []interface{}{operator}, out0)
+}
+
+// GetOperatorSetSplit returns the values stubbed with StubGetOperatorSetSplit.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GetOperatorSetSplit(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (uint16, error) {
+	var out0 uint16
+	err := _FakeRewardsCoordinator.Contract.Read("getOperatorSetSplit", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetSplit sets what GetOperatorSetSplit returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGetOperatorSetSplit(operator common.Address, operatorSet OperatorSet, out0 uint16) {
+	_FakeRewardsCoordinator.Contract.Stub("getOperatorSetSplit", []interface{}{operator, operatorSet}, out0)
+}
+
+// GetRootIndexFromHash returns the values stubbed with StubGetRootIndexFromHash.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinator.Contract.Read("getRootIndexFromHash", []interface{}{rootHash}, &out0)
+	return out0, err
+}
+
+// StubGetRootIndexFromHash sets what GetRootIndexFromHash returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubGetRootIndexFromHash(rootHash [32]byte, out0 uint32) {
+	_FakeRewardsCoordinator.Contract.Stub("getRootIndexFromHash", []interface{}{rootHash}, out0)
+}
+
+// IsAVSRewardsSubmissionHash returns the values stubbed with StubIsAVSRewardsSubmissionHash.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) IsAVSRewardsSubmissionHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinator.Contract.Read("isAVSRewardsSubmissionHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsAVSRewardsSubmissionHash sets what IsAVSRewardsSubmissionHash returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubIsAVSRewardsSubmissionHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinator.Contract.Stub("isAVSRewardsSubmissionHash", []interface{}{avs, hash}, out0)
+}
+
+// IsOperatorDirectedAVSRewardsSubmissionHash returns the values stubbed with StubIsOperatorDirectedAVSRewardsSubmissionHash.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) IsOperatorDirectedAVSRewardsSubmissionHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinator.Contract.Read("isOperatorDirectedAVSRewardsSubmissionHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorDirectedAVSRewardsSubmissionHash sets what IsOperatorDirectedAVSRewardsSubmissionHash returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubIsOperatorDirectedAVSRewardsSubmissionHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinator.Contract.Stub("isOperatorDirectedAVSRewardsSubmissionHash", []interface{}{avs, hash}, out0)
+}
+
+// IsOperatorDirectedOperatorSetRewardsSubmissionHash returns the values stubbed with StubIsOperatorDirectedOperatorSetRewardsSubmissionHash.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) IsOperatorDirectedOperatorSetRewardsSubmissionHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinator.Contract.Read("isOperatorDirectedOperatorSetRewardsSubmissionHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorDirectedOperatorSetRewardsSubmissionHash sets what IsOperatorDirectedOperatorSetRewardsSubmissionHash returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubIsOperatorDirectedOperatorSetRewardsSubmissionHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinator.Contract.Stub("isOperatorDirectedOperatorSetRewardsSubmissionHash", []interface{}{avs, hash}, out0)
+}
+
+// IsRewardsForAllSubmitter returns the values stubbed with StubIsRewardsForAllSubmitter.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) IsRewardsForAllSubmitter(opts *bind.CallOpts, submitter common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinator.Contract.Read("isRewardsForAllSubmitter", []interface{}{submitter}, &out0)
+	return out0, err
+}
+
+// StubIsRewardsForAllSubmitter sets what IsRewardsForAllSubmitter returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubIsRewardsForAllSubmitter(submitter common.Address, out0 bool) {
+	_FakeRewardsCoordinator.Contract.Stub("isRewardsForAllSubmitter", []interface{}{submitter}, out0)
+}
+
+// IsRewardsSubmissionForAllEarnersHash returns the values stubbed with StubIsRewardsSubmissionForAllEarnersHash.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) IsRewardsSubmissionForAllEarnersHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinator.Contract.Read("isRewardsSubmissionForAllEarnersHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsRewardsSubmissionForAllEarnersHash sets what IsRewardsSubmissionForAllEarnersHash returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubIsRewardsSubmissionForAllEarnersHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinator.Contract.Stub("isRewardsSubmissionForAllEarnersHash", []interface{}{avs, hash}, out0)
+}
+
+// IsRewardsSubmissionForAllHash returns the values stubbed with StubIsRewardsSubmissionForAllHash.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) IsRewardsSubmissionForAllHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinator.Contract.Read("isRewardsSubmissionForAllHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsRewardsSubmissionForAllHash sets what IsRewardsSubmissionForAllHash returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubIsRewardsSubmissionForAllHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinator.Contract.Stub("isRewardsSubmissionForAllHash", []interface{}{avs, hash}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubOwner(out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinator.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubPaused(index uint8, out0 bool) {
+	_FakeRewardsCoordinator.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeRewardsCoordinator.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubPaused0(out0 *big.Int) {
+	_FakeRewardsCoordinator.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubPauserRegistry(out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// PermissionController returns the values stubbed with StubPermissionController.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) PermissionController(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("permissionController", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPermissionController sets what PermissionController returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubPermissionController(out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("permissionController", []interface{}{}, out0)
+}
+
+// RewardsUpdater returns the values stubbed with StubRewardsUpdater.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) RewardsUpdater(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("rewardsUpdater", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubRewardsUpdater sets what RewardsUpdater returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubRewardsUpdater(out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("rewardsUpdater", []interface{}{}, out0)
+}
+
+// StrategyManager returns the values stubbed with StubStrategyManager.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinator.Contract.Read("strategyManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyManager sets what StrategyManager returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubStrategyManager(out0 common.Address) {
+	_FakeRewardsCoordinator.Contract.Stub("strategyManager", []interface{}{}, out0)
+}
+
+// SubmissionNonce returns the values stubbed with StubSubmissionNonce.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SubmissionNonce(opts *bind.CallOpts, avs common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeRewardsCoordinator.Contract.Read("submissionNonce", []interface{}{avs}, &out0)
+	return out0, err
+}
+
+// StubSubmissionNonce sets what SubmissionNonce returns for the given arguments.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) StubSubmissionNonce(avs common.Address, out0 *big.Int) {
+	_FakeRewardsCoordinator.Contract.Stub("submissionNonce", []interface{}{avs}, out0)
+}
+
+// CreateAVSRewardsSubmission records a createAVSRewardsSubmission call after running the hook set with OnCreateAVSRewardsSubmission.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CreateAVSRewardsSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "createAVSRewardsSubmission", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("createAVSRewardsSubmission").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateAVSRewardsSubmission sets a hook run by every CreateAVSRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnCreateAVSRewardsSubmission(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("createAVSRewardsSubmission", hook)
+}
+
+// CreateOperatorDirectedAVSRewardsSubmission records a createOperatorDirectedAVSRewardsSubmission call after running the hook set with OnCreateOperatorDirectedAVSRewardsSubmission.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CreateOperatorDirectedAVSRewardsSubmission(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "createOperatorDirectedAVSRewardsSubmission", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("createOperatorDirectedAVSRewardsSubmission").(func(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error); ok {
+			return hook(opts, avs, operatorDirectedRewardsSubmissions)
+		}
+		return nil
+	}, avs, operatorDirectedRewardsSubmissions)
+}
+
+// OnCreateOperatorDirectedAVSRewardsSubmission sets a hook run by every CreateOperatorDirectedAVSRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnCreateOperatorDirectedAVSRewardsSubmission(hook func(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("createOperatorDirectedAVSRewardsSubmission", hook)
+}
+
+// CreateOperatorDirectedOperatorSetRewardsSubmission records a createOperatorDirectedOperatorSetRewardsSubmission call after running the hook set with OnCreateOperatorDirectedOperatorSetRewardsSubmission.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CreateOperatorDirectedOperatorSetRewardsSubmission(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "createOperatorDirectedOperatorSetRewardsSubmission", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("createOperatorDirectedOperatorSetRewardsSubmission").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error); ok {
+			return hook(opts, operatorSet, operatorDirectedRewardsSubmissions)
+		}
+		return nil
+	}, operatorSet, operatorDirectedRewardsSubmissions)
+}
+
+// OnCreateOperatorDirectedOperatorSetRewardsSubmission sets a hook run by every CreateOperatorDirectedOperatorSetRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnCreateOperatorDirectedOperatorSetRewardsSubmission(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("createOperatorDirectedOperatorSetRewardsSubmission", hook)
+}
+
+// CreateRewardsForAllEarners records a createRewardsForAllEarners call after running the hook set with OnCreateRewardsForAllEarners.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CreateRewardsForAllEarners(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "createRewardsForAllEarners", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("createRewardsForAllEarners").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateRewardsForAllEarners sets a hook run by every CreateRewardsForAllEarners call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnCreateRewardsForAllEarners(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("createRewardsForAllEarners", hook)
+}
+
+// CreateRewardsForAllSubmission records a createRewardsForAllSubmission call after running the hook set with OnCreateRewardsForAllSubmission.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) CreateRewardsForAllSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "createRewardsForAllSubmission", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("createRewardsForAllSubmission").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateRewardsForAllSubmission sets a hook run by every CreateRewardsForAllSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnCreateRewardsForAllSubmission(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("createRewardsForAllSubmission", hook)
+}
+
+// DisableRoot records a disableRoot call after running the hook set with OnDisableRoot.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) DisableRoot(opts *bind.TransactOpts, rootIndex uint32) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "disableRoot", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("disableRoot").(func(opts *bind.TransactOpts, rootIndex uint32) error); ok {
+			return hook(opts, rootIndex)
+		}
+		return nil
+	}, rootIndex)
+}
+
+// OnDisableRoot sets a hook run by every DisableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnDisableRoot(hook func(opts *bind.TransactOpts, rootIndex uint32) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("disableRoot", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) error); ok {
+			return hook(opts, initialOwner, initialPausedStatus, _rewardsUpdater, _activationDelay, _defaultSplitBips)
+		}
+		return nil
+	}, initialOwner, initialPausedStatus, _rewardsUpdater, _activationDelay, _defaultSplitBips)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("initialize", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("pauseAll", hook)
+}
+
+// ProcessClaim records a processClaim call after running the hook set with OnProcessClaim.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) ProcessClaim(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "processClaim", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("processClaim").(func(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error); ok {
+			return hook(opts, claim, recipient)
+		}
+		return nil
+	}, claim, recipient)
+}
+
+// OnProcessClaim sets a hook run by every ProcessClaim call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnProcessClaim(hook func(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("processClaim", hook)
+}
+
+// ProcessClaims records a processClaims call after running the hook set with OnProcessClaims.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) ProcessClaims(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "processClaims", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("processClaims").(func(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error); ok {
+			return hook(opts, claims, recipient)
+		}
+		return nil
+	}, claims, recipient)
+}
+
+// OnProcessClaims sets a hook run by every ProcessClaims call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnProcessClaims(hook func(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("processClaims", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("renounceOwnership", hook)
+}
+
+// SetActivationDelay records a setActivationDelay call after running the hook set with OnSetActivationDelay.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetActivationDelay(opts *bind.TransactOpts, _activationDelay uint32) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setActivationDelay", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setActivationDelay").(func(opts *bind.TransactOpts, _activationDelay uint32) error); ok {
+			return hook(opts, _activationDelay)
+		}
+		return nil
+	}, _activationDelay)
+}
+
+// OnSetActivationDelay sets a hook run by every SetActivationDelay call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetActivationDelay(hook func(opts *bind.TransactOpts, _activationDelay uint32) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setActivationDelay", hook)
+}
+
+// SetClaimerFor records a setClaimerFor call after running the hook set with OnSetClaimerFor.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetClaimerFor(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setClaimerFor", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setClaimerFor").(func(opts *bind.TransactOpts, claimer common.Address) error); ok {
+			return hook(opts, claimer)
+		}
+		return nil
+	}, claimer)
+}
+
+// OnSetClaimerFor sets a hook run by every SetClaimerFor call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetClaimerFor(hook func(opts *bind.TransactOpts, claimer common.Address) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setClaimerFor", hook)
+}
+
+// SetClaimerFor0 records a setClaimerFor0 call after running the hook set with OnSetClaimerFor0.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetClaimerFor0(opts *bind.TransactOpts, earner common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setClaimerFor0", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setClaimerFor0").(func(opts *bind.TransactOpts, earner common.Address, claimer common.Address) error); ok {
+			return hook(opts, earner, claimer)
+		}
+		return nil
+	}, earner, claimer)
+}
+
+// OnSetClaimerFor0 sets a hook run by every SetClaimerFor0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetClaimerFor0(hook func(opts *bind.TransactOpts, earner common.Address, claimer common.Address) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setClaimerFor0", hook)
+}
+
+// SetDefaultOperatorSplit records a setDefaultOperatorSplit call after running the hook set with OnSetDefaultOperatorSplit.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetDefaultOperatorSplit(opts *bind.TransactOpts, split uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setDefaultOperatorSplit", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setDefaultOperatorSplit").(func(opts *bind.TransactOpts, split uint16) error); ok {
+			return hook(opts, split)
+		}
+		return nil
+	}, split)
+}
+
+// OnSetDefaultOperatorSplit sets a hook run by every SetDefaultOperatorSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetDefaultOperatorSplit(hook func(opts *bind.TransactOpts, split uint16) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setDefaultOperatorSplit", hook)
+}
+
+// SetOperatorAVSSplit records a setOperatorAVSSplit call after running the hook set with OnSetOperatorAVSSplit.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetOperatorAVSSplit(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setOperatorAVSSplit", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setOperatorAVSSplit").(func(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) error); ok {
+			return hook(opts, operator, avs, split)
+		}
+		return nil
+	}, operator, avs, split)
+}
+
+// OnSetOperatorAVSSplit sets a hook run by every SetOperatorAVSSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetOperatorAVSSplit(hook func(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setOperatorAVSSplit", hook)
+}
+
+// SetOperatorPISplit records a setOperatorPISplit call after running the hook set with OnSetOperatorPISplit.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetOperatorPISplit(opts *bind.TransactOpts, operator common.Address, split uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setOperatorPISplit", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setOperatorPISplit").(func(opts *bind.TransactOpts, operator common.Address, split uint16) error); ok {
+			return hook(opts, operator, split)
+		}
+		return nil
+	}, operator, split)
+}
+
+// OnSetOperatorPISplit sets a hook run by every SetOperatorPISplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetOperatorPISplit(hook func(opts *bind.TransactOpts, operator common.Address, split uint16) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setOperatorPISplit", hook)
+}
+
+// SetOperatorSetSplit records a setOperatorSetSplit call after running the hook set with OnSetOperatorSetSplit.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetOperatorSetSplit(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setOperatorSetSplit", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setOperatorSetSplit").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) error); ok {
+			return hook(opts, operator, operatorSet, split)
+		}
+		return nil
+	}, operator, operatorSet, split)
+}
+
+// OnSetOperatorSetSplit sets a hook run by every SetOperatorSetSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetOperatorSetSplit(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setOperatorSetSplit", hook)
+}
+
+// SetRewardsForAllSubmitter records a setRewardsForAllSubmitter call after running the hook set with OnSetRewardsForAllSubmitter.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetRewardsForAllSubmitter(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setRewardsForAllSubmitter", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setRewardsForAllSubmitter").(func(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) error); ok {
+			return hook(opts, _submitter, _newValue)
+		}
+		return nil
+	}, _submitter, _newValue)
+}
+
+// OnSetRewardsForAllSubmitter sets a hook run by every SetRewardsForAllSubmitter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetRewardsForAllSubmitter(hook func(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setRewardsForAllSubmitter", hook)
+}
+
+// SetRewardsUpdater records a setRewardsUpdater call after running the hook set with OnSetRewardsUpdater.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SetRewardsUpdater(opts *bind.TransactOpts, _rewardsUpdater common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "setRewardsUpdater", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("setRewardsUpdater").(func(opts *bind.TransactOpts, _rewardsUpdater common.Address) error); ok {
+			return hook(opts, _rewardsUpdater)
+		}
+		return nil
+	}, _rewardsUpdater)
+}
+
+// OnSetRewardsUpdater sets a hook run by every SetRewardsUpdater call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSetRewardsUpdater(hook func(opts *bind.TransactOpts, _rewardsUpdater common.Address) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("setRewardsUpdater", hook)
+}
+
+// SubmitRoot records a submitRoot call after running the hook set with OnSubmitRoot.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "submitRoot", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("submitRoot").(func(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) error); ok {
+			return hook(opts, root, rewardsCalculationEndTimestamp)
+		}
+		return nil
+	}, root, rewardsCalculationEndTimestamp)
+}
+
+// OnSubmitRoot sets a hook run by every SubmitRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnSubmitRoot(hook func(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("submitRoot", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("transferOwnership", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeRewardsCoordinator.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeRewardsCoordinator.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeRewardsCoordinator.Contract.SetHook("unpause", hook)
+}
+
+// EmitAVSRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitAVSRewardsSubmissionCreated(event *RewardsCoordinatorAVSRewardsSubmissionCreated) {
+	_FakeRewardsCoordinator.Contract.Emit("AVSRewardsSubmissionCreated", event)
+}
+
+// EmitActivationDelaySet appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitActivationDelaySet(event *RewardsCoordinatorActivationDelaySet) {
+	_FakeRewardsCoordinator.Contract.Emit("ActivationDelaySet", event)
+}
+
+// EmitClaimerForSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitClaimerForSet(event *RewardsCoordinatorClaimerForSet) {
+	_FakeRewardsCoordinator.Contract.Emit("ClaimerForSet", event)
+}
+
+// EmitDefaultOperatorSplitBipsSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitDefaultOperatorSplitBipsSet(event *RewardsCoordinatorDefaultOperatorSplitBipsSet) {
+	_FakeRewardsCoordinator.Contract.Emit("DefaultOperatorSplitBipsSet", event)
+}
+
+// EmitDistributionRootDisabled appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitDistributionRootDisabled(event *RewardsCoordinatorDistributionRootDisabled) {
+	_FakeRewardsCoordinator.Contract.Emit("DistributionRootDisabled", event)
+}
+
+// EmitDistributionRootSubmitted appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitDistributionRootSubmitted(event *RewardsCoordinatorDistributionRootSubmitted) {
+	_FakeRewardsCoordinator.Contract.Emit("DistributionRootSubmitted", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitInitialized(event *RewardsCoordinatorInitialized) {
+	_FakeRewardsCoordinator.Contract.Emit("Initialized", event)
+}
+
+// EmitOperatorAVSSplitBipsSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitOperatorAVSSplitBipsSet(event *RewardsCoordinatorOperatorAVSSplitBipsSet) {
+	_FakeRewardsCoordinator.Contract.Emit("OperatorAVSSplitBipsSet", event)
+}
+
+// EmitOperatorDirectedAVSRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitOperatorDirectedAVSRewardsSubmissionCreated(event *RewardsCoordinatorOperatorDirectedAVSRewardsSubmissionCreated) {
+	_FakeRewardsCoordinator.Contract.Emit("OperatorDirectedAVSRewardsSubmissionCreated", event)
+}
+
+// EmitOperatorDirectedOperatorSetRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitOperatorDirectedOperatorSetRewardsSubmissionCreated(event *RewardsCoordinatorOperatorDirectedOperatorSetRewardsSubmissionCreated) {
+	_FakeRewardsCoordinator.Contract.Emit("OperatorDirectedOperatorSetRewardsSubmissionCreated", event)
+}
+
+// EmitOperatorPISplitBipsSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitOperatorPISplitBipsSet(event *RewardsCoordinatorOperatorPISplitBipsSet) {
+	_FakeRewardsCoordinator.Contract.Emit("OperatorPISplitBipsSet", event)
+}
+
+// EmitOperatorSetSplitBipsSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitOperatorSetSplitBipsSet(event *RewardsCoordinatorOperatorSetSplitBipsSet) {
+	_FakeRewardsCoordinator.Contract.Emit("OperatorSetSplitBipsSet", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitOwnershipTransferred(event *RewardsCoordinatorOwnershipTransferred) {
+	_FakeRewardsCoordinator.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitPaused(event *RewardsCoordinatorPaused) {
+	_FakeRewardsCoordinator.Contract.Emit("Paused", event)
+}
+
+// EmitRewardsClaimed appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitRewardsClaimed(event *RewardsCoordinatorRewardsClaimed) {
+	_FakeRewardsCoordinator.Contract.Emit("RewardsClaimed", event)
+}
+
+// EmitRewardsForAllSubmitterSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitRewardsForAllSubmitterSet(event *RewardsCoordinatorRewardsForAllSubmitterSet) {
+	_FakeRewardsCoordinator.Contract.Emit("RewardsForAllSubmitterSet", event)
+}
+
+// EmitRewardsSubmissionForAllCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitRewardsSubmissionForAllCreated(event *RewardsCoordinatorRewardsSubmissionForAllCreated) {
+	_FakeRewardsCoordinator.Contract.Emit("RewardsSubmissionForAllCreated", event)
+}
+
+// EmitRewardsSubmissionForAllEarnersCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitRewardsSubmissionForAllEarnersCreated(event *RewardsCoordinatorRewardsSubmissionForAllEarnersCreated) {
+	_FakeRewardsCoordinator.Contract.Emit("RewardsSubmissionForAllEarnersCreated", event)
+}
+
+// EmitRewardsUpdaterSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitRewardsUpdaterSet(event *RewardsCoordinatorRewardsUpdaterSet) {
+	_FakeRewardsCoordinator.Contract.Emit("RewardsUpdaterSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeRewardsCoordinator *FakeRewardsCoordinator) EmitUnpaused(event *RewardsCoordinatorUnpaused) {
+	_FakeRewardsCoordinator.Contract.Emit("Unpaused", event)
+}
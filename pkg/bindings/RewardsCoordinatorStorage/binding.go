@@ -8,6 +8,7 @@ import (
 	"math/big"
 	"strings"
 
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
@@ -29,73 +30,32 @@ var (
 	_ = abi.ConvertType
 )
 
-// IRewardsCoordinatorTypesDistributionRoot is an auto generated low-level Go binding around an user-defined struct.
-type IRewardsCoordinatorTypesDistributionRoot struct {
-	Root                           [32]byte
-	RewardsCalculationEndTimestamp uint32
-	ActivatedAt                    uint32
-	Disabled                       bool
-}
+// IRewardsCoordinatorTypesDistributionRoot is an alias of the canonical eltypes.IRewardsCoordinatorTypesDistributionRoot shared by all bindings.
+type IRewardsCoordinatorTypesDistributionRoot = eltypes.IRewardsCoordinatorTypesDistributionRoot
 
-// IRewardsCoordinatorTypesEarnerTreeMerkleLeaf is an auto generated low-level Go binding around an user-defined struct.
-type IRewardsCoordinatorTypesEarnerTreeMerkleLeaf struct {
-	Earner          common.Address
-	EarnerTokenRoot [32]byte
-}
+// IRewardsCoordinatorTypesEarnerTreeMerkleLeaf is an alias of the canonical eltypes.IRewardsCoordinatorTypesEarnerTreeMerkleLeaf shared by all bindings.
+type IRewardsCoordinatorTypesEarnerTreeMerkleLeaf = eltypes.IRewardsCoordinatorTypesEarnerTreeMerkleLeaf
 
-// IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission is an auto generated low-level Go binding around an user-defined struct.
-type IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission struct {
-	StrategiesAndMultipliers []IRewardsCoordinatorTypesStrategyAndMultiplier
-	Token                    common.Address
-	OperatorRewards          []IRewardsCoordinatorTypesOperatorReward
-	StartTimestamp           uint32
-	Duration                 uint32
-	Description              string
-}
+// IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission is an alias of the canonical eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission shared by all bindings.
+type IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission = eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission
 
-// IRewardsCoordinatorTypesOperatorReward is an auto generated low-level Go binding around an user-defined struct.
-type IRewardsCoordinatorTypesOperatorReward struct {
-	Operator common.Address
-	Amount   *big.Int
-}
+// IRewardsCoordinatorTypesOperatorReward is an alias of the canonical eltypes.IRewardsCoordinatorTypesOperatorReward shared by all bindings.
+type IRewardsCoordinatorTypesOperatorReward = eltypes.IRewardsCoordinatorTypesOperatorReward
 
-// IRewardsCoordinatorTypesRewardsMerkleClaim is an auto generated low-level Go binding around an user-defined struct.
-type IRewardsCoordinatorTypesRewardsMerkleClaim struct {
-	RootIndex       uint32
-	EarnerIndex     uint32
-	EarnerTreeProof []byte
-	EarnerLeaf      IRewardsCoordinatorTypesEarnerTreeMerkleLeaf
-	TokenIndices    []uint32
-	TokenTreeProofs [][]byte
-	TokenLeaves     []IRewardsCoordinatorTypesTokenTreeMerkleLeaf
-}
+// IRewardsCoordinatorTypesRewardsMerkleClaim is an alias of the canonical eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim shared by all bindings.
+type IRewardsCoordinatorTypesRewardsMerkleClaim = eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim
 
-// IRewardsCoordinatorTypesRewardsSubmission is an auto generated low-level Go binding around an user-defined struct.
-type IRewardsCoordinatorTypesRewardsSubmission struct {
-	StrategiesAndMultipliers []IRewardsCoordinatorTypesStrategyAndMultiplier
-	Token                    common.Address
-	Amount                   *big.Int
-	StartTimestamp           uint32
-	Duration                 uint32
-}
+// IRewardsCoordinatorTypesRewardsSubmission is an alias of the canonical eltypes.IRewardsCoordinatorTypesRewardsSubmission shared by all bindings.
+type IRewardsCoordinatorTypesRewardsSubmission = eltypes.IRewardsCoordinatorTypesRewardsSubmission
 
-// IRewardsCoordinatorTypesStrategyAndMultiplier is an auto generated low-level Go binding around an user-defined struct.
-type IRewardsCoordinatorTypesStrategyAndMultiplier struct {
-	Strategy   common.Address
-	Multiplier *big.Int
-}
+// IRewardsCoordinatorTypesStrategyAndMultiplier is an alias of the canonical eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier shared by all bindings.
+type IRewardsCoordinatorTypesStrategyAndMultiplier = eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier
 
-// IRewardsCoordinatorTypesTokenTreeMerkleLeaf is an auto generated low-level Go binding around an user-defined struct.
-type IRewardsCoordinatorTypesTokenTreeMerkleLeaf struct {
-	Token              common.Address
-	CumulativeEarnings *big.Int
-}
+// IRewardsCoordinatorTypesTokenTreeMerkleLeaf is an alias of the canonical eltypes.IRewardsCoordinatorTypesTokenTreeMerkleLeaf shared by all bindings.
+type IRewardsCoordinatorTypesTokenTreeMerkleLeaf = eltypes.IRewardsCoordinatorTypesTokenTreeMerkleLeaf
 
-// OperatorSet is an auto generated low-level Go binding around an user-defined struct.
-type OperatorSet struct {
-	Avs common.Address
-	Id  uint32
-}
+// OperatorSet is an alias of the canonical eltypes.OperatorSet shared by all bindings.
+type OperatorSet = eltypes.OperatorSet
 
 // RewardsCoordinatorStorageMetaData contains all meta data concerning the RewardsCoordinatorStorage contract.
 var RewardsCoordinatorStorageMetaData = &bind.MetaData{
@@ -0,0 +1,304 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package RewardsCoordinatorStorage
+
+// ErrAmountExceedsMax is the typed form of the RewardsCoordinatorStorage custom error AmountExceedsMax().
+type ErrAmountExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrAmountExceedsMax) Error() string {
+	return "RewardsCoordinatorStorage: AmountExceedsMax()"
+}
+
+// ErrAmountIsZero is the typed form of the RewardsCoordinatorStorage custom error AmountIsZero().
+type ErrAmountIsZero struct{}
+
+// Error implements the error interface.
+func (ErrAmountIsZero) Error() string {
+	return "RewardsCoordinatorStorage: AmountIsZero()"
+}
+
+// ErrDurationExceedsMax is the typed form of the RewardsCoordinatorStorage custom error DurationExceedsMax().
+type ErrDurationExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrDurationExceedsMax) Error() string {
+	return "RewardsCoordinatorStorage: DurationExceedsMax()"
+}
+
+// ErrDurationIsZero is the typed form of the RewardsCoordinatorStorage custom error DurationIsZero().
+type ErrDurationIsZero struct{}
+
+// Error implements the error interface.
+func (ErrDurationIsZero) Error() string {
+	return "RewardsCoordinatorStorage: DurationIsZero()"
+}
+
+// ErrEarningsNotGreaterThanClaimed is the typed form of the RewardsCoordinatorStorage custom error EarningsNotGreaterThanClaimed().
+type ErrEarningsNotGreaterThanClaimed struct{}
+
+// Error implements the error interface.
+func (ErrEarningsNotGreaterThanClaimed) Error() string {
+	return "RewardsCoordinatorStorage: EarningsNotGreaterThanClaimed()"
+}
+
+// ErrInputArrayLengthMismatch is the typed form of the RewardsCoordinatorStorage custom error InputArrayLengthMismatch().
+type ErrInputArrayLengthMismatch struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthMismatch) Error() string {
+	return "RewardsCoordinatorStorage: InputArrayLengthMismatch()"
+}
+
+// ErrInputArrayLengthZero is the typed form of the RewardsCoordinatorStorage custom error InputArrayLengthZero().
+type ErrInputArrayLengthZero struct{}
+
+// Error implements the error interface.
+func (ErrInputArrayLengthZero) Error() string {
+	return "RewardsCoordinatorStorage: InputArrayLengthZero()"
+}
+
+// ErrInvalidAddressZero is the typed form of the RewardsCoordinatorStorage custom error InvalidAddressZero().
+type ErrInvalidAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAddressZero) Error() string {
+	return "RewardsCoordinatorStorage: InvalidAddressZero()"
+}
+
+// ErrInvalidCalculationIntervalSecondsRemainder is the typed form of the RewardsCoordinatorStorage custom error InvalidCalculationIntervalSecondsRemainder().
+type ErrInvalidCalculationIntervalSecondsRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCalculationIntervalSecondsRemainder) Error() string {
+	return "RewardsCoordinatorStorage: InvalidCalculationIntervalSecondsRemainder()"
+}
+
+// ErrInvalidClaimProof is the typed form of the RewardsCoordinatorStorage custom error InvalidClaimProof().
+type ErrInvalidClaimProof struct{}
+
+// Error implements the error interface.
+func (ErrInvalidClaimProof) Error() string {
+	return "RewardsCoordinatorStorage: InvalidClaimProof()"
+}
+
+// ErrInvalidDurationRemainder is the typed form of the RewardsCoordinatorStorage custom error InvalidDurationRemainder().
+type ErrInvalidDurationRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidDurationRemainder) Error() string {
+	return "RewardsCoordinatorStorage: InvalidDurationRemainder()"
+}
+
+// ErrInvalidEarner is the typed form of the RewardsCoordinatorStorage custom error InvalidEarner().
+type ErrInvalidEarner struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEarner) Error() string {
+	return "RewardsCoordinatorStorage: InvalidEarner()"
+}
+
+// ErrInvalidEarnerLeafIndex is the typed form of the RewardsCoordinatorStorage custom error InvalidEarnerLeafIndex().
+type ErrInvalidEarnerLeafIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidEarnerLeafIndex) Error() string {
+	return "RewardsCoordinatorStorage: InvalidEarnerLeafIndex()"
+}
+
+// ErrInvalidGenesisRewardsTimestampRemainder is the typed form of the RewardsCoordinatorStorage custom error InvalidGenesisRewardsTimestampRemainder().
+type ErrInvalidGenesisRewardsTimestampRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidGenesisRewardsTimestampRemainder) Error() string {
+	return "RewardsCoordinatorStorage: InvalidGenesisRewardsTimestampRemainder()"
+}
+
+// ErrInvalidOperatorSet is the typed form of the RewardsCoordinatorStorage custom error InvalidOperatorSet().
+type ErrInvalidOperatorSet struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSet) Error() string {
+	return "RewardsCoordinatorStorage: InvalidOperatorSet()"
+}
+
+// ErrInvalidRoot is the typed form of the RewardsCoordinatorStorage custom error InvalidRoot().
+type ErrInvalidRoot struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRoot) Error() string {
+	return "RewardsCoordinatorStorage: InvalidRoot()"
+}
+
+// ErrInvalidRootIndex is the typed form of the RewardsCoordinatorStorage custom error InvalidRootIndex().
+type ErrInvalidRootIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidRootIndex) Error() string {
+	return "RewardsCoordinatorStorage: InvalidRootIndex()"
+}
+
+// ErrInvalidStartTimestampRemainder is the typed form of the RewardsCoordinatorStorage custom error InvalidStartTimestampRemainder().
+type ErrInvalidStartTimestampRemainder struct{}
+
+// Error implements the error interface.
+func (ErrInvalidStartTimestampRemainder) Error() string {
+	return "RewardsCoordinatorStorage: InvalidStartTimestampRemainder()"
+}
+
+// ErrInvalidTokenLeafIndex is the typed form of the RewardsCoordinatorStorage custom error InvalidTokenLeafIndex().
+type ErrInvalidTokenLeafIndex struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTokenLeafIndex) Error() string {
+	return "RewardsCoordinatorStorage: InvalidTokenLeafIndex()"
+}
+
+// ErrNewRootMustBeForNewCalculatedPeriod is the typed form of the RewardsCoordinatorStorage custom error NewRootMustBeForNewCalculatedPeriod().
+type ErrNewRootMustBeForNewCalculatedPeriod struct{}
+
+// Error implements the error interface.
+func (ErrNewRootMustBeForNewCalculatedPeriod) Error() string {
+	return "RewardsCoordinatorStorage: NewRootMustBeForNewCalculatedPeriod()"
+}
+
+// ErrOperatorsNotInAscendingOrder is the typed form of the RewardsCoordinatorStorage custom error OperatorsNotInAscendingOrder().
+type ErrOperatorsNotInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrOperatorsNotInAscendingOrder) Error() string {
+	return "RewardsCoordinatorStorage: OperatorsNotInAscendingOrder()"
+}
+
+// ErrPreviousSplitPending is the typed form of the RewardsCoordinatorStorage custom error PreviousSplitPending().
+type ErrPreviousSplitPending struct{}
+
+// Error implements the error interface.
+func (ErrPreviousSplitPending) Error() string {
+	return "RewardsCoordinatorStorage: PreviousSplitPending()"
+}
+
+// ErrRewardsEndTimestampNotElapsed is the typed form of the RewardsCoordinatorStorage custom error RewardsEndTimestampNotElapsed().
+type ErrRewardsEndTimestampNotElapsed struct{}
+
+// Error implements the error interface.
+func (ErrRewardsEndTimestampNotElapsed) Error() string {
+	return "RewardsCoordinatorStorage: RewardsEndTimestampNotElapsed()"
+}
+
+// ErrRootAlreadyActivated is the typed form of the RewardsCoordinatorStorage custom error RootAlreadyActivated().
+type ErrRootAlreadyActivated struct{}
+
+// Error implements the error interface.
+func (ErrRootAlreadyActivated) Error() string {
+	return "RewardsCoordinatorStorage: RootAlreadyActivated()"
+}
+
+// ErrRootDisabled is the typed form of the RewardsCoordinatorStorage custom error RootDisabled().
+type ErrRootDisabled struct{}
+
+// Error implements the error interface.
+func (ErrRootDisabled) Error() string {
+	return "RewardsCoordinatorStorage: RootDisabled()"
+}
+
+// ErrRootNotActivated is the typed form of the RewardsCoordinatorStorage custom error RootNotActivated().
+type ErrRootNotActivated struct{}
+
+// Error implements the error interface.
+func (ErrRootNotActivated) Error() string {
+	return "RewardsCoordinatorStorage: RootNotActivated()"
+}
+
+// ErrSplitExceedsMax is the typed form of the RewardsCoordinatorStorage custom error SplitExceedsMax().
+type ErrSplitExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrSplitExceedsMax) Error() string {
+	return "RewardsCoordinatorStorage: SplitExceedsMax()"
+}
+
+// ErrStartTimestampTooFarInFuture is the typed form of the RewardsCoordinatorStorage custom error StartTimestampTooFarInFuture().
+type ErrStartTimestampTooFarInFuture struct{}
+
+// Error implements the error interface.
+func (ErrStartTimestampTooFarInFuture) Error() string {
+	return "RewardsCoordinatorStorage: StartTimestampTooFarInFuture()"
+}
+
+// ErrStartTimestampTooFarInPast is the typed form of the RewardsCoordinatorStorage custom error StartTimestampTooFarInPast().
+type ErrStartTimestampTooFarInPast struct{}
+
+// Error implements the error interface.
+func (ErrStartTimestampTooFarInPast) Error() string {
+	return "RewardsCoordinatorStorage: StartTimestampTooFarInPast()"
+}
+
+// ErrStrategiesNotInAscendingOrder is the typed form of the RewardsCoordinatorStorage custom error StrategiesNotInAscendingOrder().
+type ErrStrategiesNotInAscendingOrder struct{}
+
+// Error implements the error interface.
+func (ErrStrategiesNotInAscendingOrder) Error() string {
+	return "RewardsCoordinatorStorage: StrategiesNotInAscendingOrder()"
+}
+
+// ErrStrategyNotWhitelisted is the typed form of the RewardsCoordinatorStorage custom error StrategyNotWhitelisted().
+type ErrStrategyNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotWhitelisted) Error() string {
+	return "RewardsCoordinatorStorage: StrategyNotWhitelisted()"
+}
+
+// ErrSubmissionNotRetroactive is the typed form of the RewardsCoordinatorStorage custom error SubmissionNotRetroactive().
+type ErrSubmissionNotRetroactive struct{}
+
+// Error implements the error interface.
+func (ErrSubmissionNotRetroactive) Error() string {
+	return "RewardsCoordinatorStorage: SubmissionNotRetroactive()"
+}
+
+// ErrUnauthorizedCaller is the typed form of the RewardsCoordinatorStorage custom error UnauthorizedCaller().
+type ErrUnauthorizedCaller struct{}
+
+// Error implements the error interface.
+func (ErrUnauthorizedCaller) Error() string {
+	return "RewardsCoordinatorStorage: UnauthorizedCaller()"
+}
+
+// RewardsCoordinatorStorageErrors maps the name of every custom error in the RewardsCoordinatorStorage ABI to its typed Go error.
+var RewardsCoordinatorStorageErrors = map[string]error{
+	"AmountExceedsMax":                           ErrAmountExceedsMax{},
+	"AmountIsZero":                               ErrAmountIsZero{},
+	"DurationExceedsMax":                         ErrDurationExceedsMax{},
+	"DurationIsZero":                             ErrDurationIsZero{},
+	"EarningsNotGreaterThanClaimed":              ErrEarningsNotGreaterThanClaimed{},
+	"InputArrayLengthMismatch":                   ErrInputArrayLengthMismatch{},
+	"InputArrayLengthZero":                       ErrInputArrayLengthZero{},
+	"InvalidAddressZero":                         ErrInvalidAddressZero{},
+	"InvalidCalculationIntervalSecondsRemainder": ErrInvalidCalculationIntervalSecondsRemainder{},
+	"InvalidClaimProof":                          ErrInvalidClaimProof{},
+	"InvalidDurationRemainder":                   ErrInvalidDurationRemainder{},
+	"InvalidEarner":                              ErrInvalidEarner{},
+	"InvalidEarnerLeafIndex":                     ErrInvalidEarnerLeafIndex{},
+	"InvalidGenesisRewardsTimestampRemainder":    ErrInvalidGenesisRewardsTimestampRemainder{},
+	"InvalidOperatorSet":                         ErrInvalidOperatorSet{},
+	"InvalidRoot":                                ErrInvalidRoot{},
+	"InvalidRootIndex":                           ErrInvalidRootIndex{},
+	"InvalidStartTimestampRemainder":             ErrInvalidStartTimestampRemainder{},
+	"InvalidTokenLeafIndex":                      ErrInvalidTokenLeafIndex{},
+	"NewRootMustBeForNewCalculatedPeriod":        ErrNewRootMustBeForNewCalculatedPeriod{},
+	"OperatorsNotInAscendingOrder":               ErrOperatorsNotInAscendingOrder{},
+	"PreviousSplitPending":                       ErrPreviousSplitPending{},
+	"RewardsEndTimestampNotElapsed":              ErrRewardsEndTimestampNotElapsed{},
+	"RootAlreadyActivated":                       ErrRootAlreadyActivated{},
+	"RootDisabled":                               ErrRootDisabled{},
+	"RootNotActivated":                           ErrRootNotActivated{},
+	"SplitExceedsMax":                            ErrSplitExceedsMax{},
+	"StartTimestampTooFarInFuture":               ErrStartTimestampTooFarInFuture{},
+	"StartTimestampTooFarInPast":                 ErrStartTimestampTooFarInPast{},
+	"StrategiesNotInAscendingOrder":              ErrStrategiesNotInAscendingOrder{},
+	"StrategyNotWhitelisted":                     ErrStrategyNotWhitelisted{},
+	"SubmissionNotRetroactive":                   ErrSubmissionNotRetroactive{},
+	"UnauthorizedCaller":                         ErrUnauthorizedCaller{},
+}
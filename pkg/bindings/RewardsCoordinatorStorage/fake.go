@@ -0,0 +1,810 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package RewardsCoordinatorStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeRewardsCoordinatorStorage is an in-memory RewardsCoordinatorStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded RewardsCoordinatorStorageFilterer.
+type FakeRewardsCoordinatorStorage struct {
+	*fake.Contract
+	*RewardsCoordinatorStorageFilterer
+}
+
+// NewFakeRewardsCoordinatorStorage returns an empty fake of the contract at address.
+func NewFakeRewardsCoordinatorStorage(address common.Address) *FakeRewardsCoordinatorStorage {
+	contract := fake.NewContract(RewardsCoordinatorStorageMetaData, address)
+	filterer, err := NewRewardsCoordinatorStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeRewardsCoordinatorStorage{Contract: contract, RewardsCoordinatorStorageFilterer: filterer}
+}
+
+// CALCULATIONINTERVALSECONDS returns the values stubbed with StubCALCULATIONINTERVALSECONDS.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CALCULATIONINTERVALSECONDS(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("CALCULATION_INTERVAL_SECONDS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCALCULATIONINTERVALSECONDS sets what CALCULATIONINTERVALSECONDS returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubCALCULATIONINTERVALSECONDS(out0 uint32) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("CALCULATION_INTERVAL_SECONDS", []interface{}{}, out0)
+}
+
+// GENESISREWARDSTIMESTAMP returns the values stubbed with StubGENESISREWARDSTIMESTAMP.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GENESISREWARDSTIMESTAMP(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("GENESIS_REWARDS_TIMESTAMP", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGENESISREWARDSTIMESTAMP sets what GENESISREWARDSTIMESTAMP returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGENESISREWARDSTIMESTAMP(out0 uint32) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("GENESIS_REWARDS_TIMESTAMP", []interface{}{}, out0)
+}
+
+// MAXFUTURELENGTH returns the values stubbed with StubMAXFUTURELENGTH.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) MAXFUTURELENGTH(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("MAX_FUTURE_LENGTH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXFUTURELENGTH sets what MAXFUTURELENGTH returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubMAXFUTURELENGTH(out0 uint32) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("MAX_FUTURE_LENGTH", []interface{}{}, out0)
+}
+
+// MAXRETROACTIVELENGTH returns the values stubbed with StubMAXRETROACTIVELENGTH.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) MAXRETROACTIVELENGTH(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("MAX_RETROACTIVE_LENGTH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXRETROACTIVELENGTH sets what MAXRETROACTIVELENGTH returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubMAXRETROACTIVELENGTH(out0 uint32) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("MAX_RETROACTIVE_LENGTH", []interface{}{}, out0)
+}
+
+// MAXREWARDSDURATION returns the values stubbed with StubMAXREWARDSDURATION.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) MAXREWARDSDURATION(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("MAX_REWARDS_DURATION", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXREWARDSDURATION sets what MAXREWARDSDURATION returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubMAXREWARDSDURATION(out0 uint32) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("MAX_REWARDS_DURATION", []interface{}{}, out0)
+}
+
+// ActivationDelay returns the values stubbed with StubActivationDelay.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) ActivationDelay(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("activationDelay", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubActivationDelay sets what ActivationDelay returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubActivationDelay(out0 uint32) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("activationDelay", []interface{}{}, out0)
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubAllocationManager(out0 common.Address) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// BeaconChainETHStrategy returns the values stubbed with StubBeaconChainETHStrategy.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) BeaconChainETHStrategy(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("beaconChainETHStrategy", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBeaconChainETHStrategy sets what BeaconChainETHStrategy returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubBeaconChainETHStrategy(out0 common.Address) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("beaconChainETHStrategy", []interface{}{}, out0)
+}
+
+// CalculateEarnerLeafHash returns the values stubbed with StubCalculateEarnerLeafHash.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CalculateEarnerLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTypesEarnerTreeMerkleLeaf) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("calculateEarnerLeafHash", []interface{}{leaf}, &out0)
+	return out0, err
+}
+
+// StubCalculateEarnerLeafHash sets what CalculateEarnerLeafHash returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubCalculateEarnerLeafHash(leaf IRewardsCoordinatorTypesEarnerTreeMerkleLeaf, out0 [32]byte) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("calculateEarnerLeafHash", []interface{}{leaf}, out0)
+}
+
+// CalculateTokenLeafHash returns the values stubbed with StubCalculateTokenLeafHash.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CalculateTokenLeafHash(opts *bind.CallOpts, leaf IRewardsCoordinatorTypesTokenTreeMerkleLeaf) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("calculateTokenLeafHash", []interface{}{leaf}, &out0)
+	return out0, err
+}
+
+// StubCalculateTokenLeafHash sets what CalculateTokenLeafHash returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubCalculateTokenLeafHash(leaf IRewardsCoordinatorTypesTokenTreeMerkleLeaf, out0 [32]byte) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("calculateTokenLeafHash", []interface{}{leaf}, out0)
+}
+
+// CheckClaim returns the values stubbed with StubCheckClaim.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CheckClaim(opts *bind.CallOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("checkClaim", []interface{}{claim}, &out0)
+	return out0, err
+}
+
+// StubCheckClaim sets what CheckClaim returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubCheckClaim(claim IRewardsCoordinatorTypesRewardsMerkleClaim, out0 bool) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("checkClaim", []interface{}{claim}, out0)
+}
+
+// ClaimerFor returns the values stubbed with StubClaimerFor.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) ClaimerFor(opts *bind.CallOpts, earner common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("claimerFor", []interface{}{earner}, &out0)
+	return out0, err
+}
+
+// StubClaimerFor sets what ClaimerFor returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubClaimerFor(earner common.Address, out0 common.Address) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("claimerFor", []interface{}{earner}, out0)
+}
+
+// CumulativeClaimed returns the values stubbed with StubCumulativeClaimed.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CumulativeClaimed(opts *bind.CallOpts, earner common.Address, token common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("cumulativeClaimed", []interface{}{earner, token}, &out0)
+	return out0, err
+}
+
+// StubCumulativeClaimed sets what CumulativeClaimed returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubCumulativeClaimed(earner common.Address, token common.Address, out0 *big.Int) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("cumulativeClaimed", []interface{}{earner, token}, out0)
+}
+
+// CurrRewardsCalculationEndTimestamp returns the values stubbed with StubCurrRewardsCalculationEndTimestamp.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CurrRewardsCalculationEndTimestamp(opts *bind.CallOpts) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("currRewardsCalculationEndTimestamp", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubCurrRewardsCalculationEndTimestamp sets what CurrRewardsCalculationEndTimestamp returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubCurrRewardsCalculationEndTimestamp(out0 uint32) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("currRewardsCalculationEndTimestamp", []interface{}{}, out0)
+}
+
+// DefaultOperatorSplitBips returns the values stubbed with StubDefaultOperatorSplitBips.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) DefaultOperatorSplitBips(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("defaultOperatorSplitBips", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDefaultOperatorSplitBips sets what DefaultOperatorSplitBips returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubDefaultOperatorSplitBips(out0 uint16) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("defaultOperatorSplitBips", []interface{}{}, out0)
+}
+
+// DelegationManager returns the values stubbed with StubDelegationManager.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) DelegationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("delegationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegationManager sets what DelegationManager returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubDelegationManager(out0 common.Address) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("delegationManager", []interface{}{}, out0)
+}
+
+// GetCurrentClaimableDistributionRoot returns the values stubbed with StubGetCurrentClaimableDistributionRoot.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GetCurrentClaimableDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("getCurrentClaimableDistributionRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentClaimableDistributionRoot sets what GetCurrentClaimableDistributionRoot returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGetCurrentClaimableDistributionRoot(out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("getCurrentClaimableDistributionRoot", []interface{}{}, out0)
+}
+
+// GetCurrentDistributionRoot returns the values stubbed with StubGetCurrentDistributionRoot.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GetCurrentDistributionRoot(opts *bind.CallOpts) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("getCurrentDistributionRoot", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetCurrentDistributionRoot sets what GetCurrentDistributionRoot returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGetCurrentDistributionRoot(out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("getCurrentDistributionRoot", []interface{}{}, out0)
+}
+
+// GetDistributionRootAtIndex returns the values stubbed with StubGetDistributionRootAtIndex.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GetDistributionRootAtIndex(opts *bind.CallOpts, index *big.Int) (IRewardsCoordinatorTypesDistributionRoot, error) {
+	var out0 IRewardsCoordinatorTypesDistributionRoot
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("getDistributionRootAtIndex", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubGetDistributionRootAtIndex sets what GetDistributionRootAtIndex returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGetDistributionRootAtIndex(index *big.Int, out0 IRewardsCoordinatorTypesDistributionRoot) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("getDistributionRootAtIndex", []interface{}{index}, out0)
+}
+
+// GetDistributionRootsLength returns the values stubbed with StubGetDistributionRootsLength.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GetDistributionRootsLength(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("getDistributionRootsLength", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetDistributionRootsLength sets what GetDistributionRootsLength returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGetDistributionRootsLength(out0 *big.Int) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("getDistributionRootsLength", []interface{}{}, out0)
+}
+
+// GetOperatorAVSSplit returns the values stubbed with StubGetOperatorAVSSplit.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GetOperatorAVSSplit(opts *bind.CallOpts, operator common.Address, avs common.Address) (uint16, error) {
+	var out0 uint16
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("getOperatorAVSSplit", []interface{}{operator, avs}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorAVSSplit sets what GetOperatorAVSSplit returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGetOperatorAVSSplit(operator common.Address, avs common.Address, out0 uint16) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("getOperatorAVSSplit", []interface{}{operator, avs}, out0)
+}
+
+// GetOperatorPISplit returns the values stubbed with StubGetOperatorPISplit.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GetOperatorPISplit(opts *bind.CallOpts, operator common.Address) (uint16, error) {
+	var out0 uint16
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("getOperatorPISplit", []interface{}{operator}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorPISplit sets what GetOperatorPISplit returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGetOperatorPISplit(operator common.Address, out0 uint16) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("getOperatorPISplit", []interface{}{operator}, out0)
+}
+
+// GetOperatorSetSplit returns the values stubbed with StubGetOperatorSetSplit.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GetOperatorSetSplit(opts *bind.CallOpts, operator common.Address, operatorSet OperatorSet) (uint16, error) {
+	var out0 uint16
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("getOperatorSetSplit", []interface{}{operator, operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetOperatorSetSplit sets what GetOperatorSetSplit returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGetOperatorSetSplit(operator common.Address, operatorSet OperatorSet, out0 uint16) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("getOperatorSetSplit", []interface{}{operator, operatorSet}, out0)
+}
+
+// GetRootIndexFromHash returns the values stubbed with StubGetRootIndexFromHash.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) GetRootIndexFromHash(opts *bind.CallOpts, rootHash [32]byte) (uint32, error) {
+	var out0 uint32
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("getRootIndexFromHash", []interface{}{rootHash}, &out0)
+	return out0, err
+}
+
+// StubGetRootIndexFromHash sets what GetRootIndexFromHash returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubGetRootIndexFromHash(rootHash [32]byte, out0 uint32) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("getRootIndexFromHash", []interface{}{rootHash}, out0)
+}
+
+// IsAVSRewardsSubmissionHash returns the values stubbed with StubIsAVSRewardsSubmissionHash.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) IsAVSRewardsSubmissionHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("isAVSRewardsSubmissionHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsAVSRewardsSubmissionHash sets what IsAVSRewardsSubmissionHash returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubIsAVSRewardsSubmissionHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("isAVSRewardsSubmissionHash", []interface{}{avs, hash}, out0)
+}
+
+// IsOperatorDirectedAVSRewardsSubmissionHash returns the values stubbed with StubIsOperatorDirectedAVSRewardsSubmissionHash.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) IsOperatorDirectedAVSRewardsSubmissionHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("isOperatorDirectedAVSRewardsSubmissionHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorDirectedAVSRewardsSubmissionHash sets what IsOperatorDirectedAVSRewardsSubmissionHash returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubIsOperatorDirectedAVSRewardsSubmissionHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("isOperatorDirectedAVSRewardsSubmissionHash", []interface{}{avs, hash}, out0)
+}
+
+// IsOperatorDirectedOperatorSetRewardsSubmissionHash returns the values stubbed with StubIsOperatorDirectedOperatorSetRewardsSubmissionHash.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) IsOperatorDirectedOperatorSetRewardsSubmissionHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("isOperatorDirectedOperatorSetRewardsSubmissionHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsOperatorDirectedOperatorSetRewardsSubmissionHash sets what IsOperatorDirectedOperatorSetRewardsSubmissionHash returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubIsOperatorDirectedOperatorSetRewardsSubmissionHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("isOperatorDirectedOperatorSetRewardsSubmissionHash", []interface{}{avs, hash}, out0)
+}
+
+// IsRewardsForAllSubmitter returns the values stubbed with StubIsRewardsForAllSubmitter.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) IsRewardsForAllSubmitter(opts *bind.CallOpts, submitter common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("isRewardsForAllSubmitter", []interface{}{submitter}, &out0)
+	return out0, err
+}
+
+// StubIsRewardsForAllSubmitter sets what IsRewardsForAllSubmitter returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubIsRewardsForAllSubmitter(submitter common.Address, out0 bool) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("isRewardsForAllSubmitter", []interface{}{submitter}, out0)
+}
+
+// IsRewardsSubmissionForAllEarnersHash returns the values stubbed with StubIsRewardsSubmissionForAllEarnersHash.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) IsRewardsSubmissionForAllEarnersHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("isRewardsSubmissionForAllEarnersHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsRewardsSubmissionForAllEarnersHash sets what IsRewardsSubmissionForAllEarnersHash returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubIsRewardsSubmissionForAllEarnersHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("isRewardsSubmissionForAllEarnersHash", []interface{}{avs, hash}, out0)
+}
+
+// IsRewardsSubmissionForAllHash returns the values stubbed with StubIsRewardsSubmissionForAllHash.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) IsRewardsSubmissionForAllHash(opts *bind.CallOpts, avs common.Address, hash [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("isRewardsSubmissionForAllHash", []interface{}{avs, hash}, &out0)
+	return out0, err
+}
+
+// StubIsRewardsSubmissionForAllHash sets what IsRewardsSubmissionForAllHash returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubIsRewardsSubmissionForAllHash(avs common.Address, hash [32]byte, out0 bool) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("isRewardsSubmissionForAllHash", []interface{}{avs, hash}, out0)
+}
+
+// RewardsUpdater returns the values stubbed with StubRewardsUpdater.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) RewardsUpdater(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("rewardsUpdater", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubRewardsUpdater sets what RewardsUpdater returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubRewardsUpdater(out0 common.Address) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("rewardsUpdater", []interface{}{}, out0)
+}
+
+// StrategyManager returns the values stubbed with StubStrategyManager.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("strategyManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyManager sets what StrategyManager returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubStrategyManager(out0 common.Address) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("strategyManager", []interface{}{}, out0)
+}
+
+// SubmissionNonce returns the values stubbed with StubSubmissionNonce.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SubmissionNonce(opts *bind.CallOpts, avs common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeRewardsCoordinatorStorage.Contract.Read("submissionNonce", []interface{}{avs}, &out0)
+	return out0, err
+}
+
+// StubSubmissionNonce sets what SubmissionNonce returns for the given arguments.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) StubSubmissionNonce(avs common.Address, out0 *big.Int) {
+	_FakeRewardsCoordinatorStorage.Contract.Stub("submissionNonce", []interface{}{avs}, out0)
+}
+
+// CreateAVSRewardsSubmission records a createAVSRewardsSubmission call after running the hook set with OnCreateAVSRewardsSubmission.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CreateAVSRewardsSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "createAVSRewardsSubmission", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("createAVSRewardsSubmission").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateAVSRewardsSubmission sets a hook run by every CreateAVSRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnCreateAVSRewardsSubmission(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("createAVSRewardsSubmission", hook)
+}
+
+// CreateOperatorDirectedAVSRewardsSubmission records a createOperatorDirectedAVSRewardsSubmission call after running the hook set with OnCreateOperatorDirectedAVSRewardsSubmission.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CreateOperatorDirectedAVSRewardsSubmission(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "createOperatorDirectedAVSRewardsSubmission", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("createOperatorDirectedAVSRewardsSubmission").(func(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error); ok {
+			return hook(opts, avs, operatorDirectedRewardsSubmissions)
+		}
+		return nil
+	}, avs, operatorDirectedRewardsSubmissions)
+}
+
+// OnCreateOperatorDirectedAVSRewardsSubmission sets a hook run by every CreateOperatorDirectedAVSRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnCreateOperatorDirectedAVSRewardsSubmission(hook func(opts *bind.TransactOpts, avs common.Address, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("createOperatorDirectedAVSRewardsSubmission", hook)
+}
+
+// CreateOperatorDirectedOperatorSetRewardsSubmission records a createOperatorDirectedOperatorSetRewardsSubmission call after running the hook set with OnCreateOperatorDirectedOperatorSetRewardsSubmission.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CreateOperatorDirectedOperatorSetRewardsSubmission(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "createOperatorDirectedOperatorSetRewardsSubmission", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("createOperatorDirectedOperatorSetRewardsSubmission").(func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error); ok {
+			return hook(opts, operatorSet, operatorDirectedRewardsSubmissions)
+		}
+		return nil
+	}, operatorSet, operatorDirectedRewardsSubmissions)
+}
+
+// OnCreateOperatorDirectedOperatorSetRewardsSubmission sets a hook run by every CreateOperatorDirectedOperatorSetRewardsSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnCreateOperatorDirectedOperatorSetRewardsSubmission(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, operatorDirectedRewardsSubmissions []IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("createOperatorDirectedOperatorSetRewardsSubmission", hook)
+}
+
+// CreateRewardsForAllEarners records a createRewardsForAllEarners call after running the hook set with OnCreateRewardsForAllEarners.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CreateRewardsForAllEarners(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "createRewardsForAllEarners", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("createRewardsForAllEarners").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateRewardsForAllEarners sets a hook run by every CreateRewardsForAllEarners call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnCreateRewardsForAllEarners(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("createRewardsForAllEarners", hook)
+}
+
+// CreateRewardsForAllSubmission records a createRewardsForAllSubmission call after running the hook set with OnCreateRewardsForAllSubmission.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) CreateRewardsForAllSubmission(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "createRewardsForAllSubmission", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("createRewardsForAllSubmission").(func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error); ok {
+			return hook(opts, rewardsSubmissions)
+		}
+		return nil
+	}, rewardsSubmissions)
+}
+
+// OnCreateRewardsForAllSubmission sets a hook run by every CreateRewardsForAllSubmission call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnCreateRewardsForAllSubmission(hook func(opts *bind.TransactOpts, rewardsSubmissions []IRewardsCoordinatorTypesRewardsSubmission) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("createRewardsForAllSubmission", hook)
+}
+
+// DisableRoot records a disableRoot call after running the hook set with OnDisableRoot.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) DisableRoot(opts *bind.TransactOpts, rootIndex uint32) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "disableRoot", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("disableRoot").(func(opts *bind.TransactOpts, rootIndex uint32) error); ok {
+			return hook(opts, rootIndex)
+		}
+		return nil
+	}, rootIndex)
+}
+
+// OnDisableRoot sets a hook run by every DisableRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnDisableRoot(hook func(opts *bind.TransactOpts, rootIndex uint32) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("disableRoot", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) error); ok {
+			return hook(opts, initialOwner, initialPausedStatus, _rewardsUpdater, _activationDelay, _defaultSplitBips)
+		}
+		return nil
+	}, initialOwner, initialPausedStatus, _rewardsUpdater, _activationDelay, _defaultSplitBips)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialPausedStatus *big.Int, _rewardsUpdater common.Address, _activationDelay uint32, _defaultSplitBips uint16) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("initialize", hook)
+}
+
+// ProcessClaim records a processClaim call after running the hook set with OnProcessClaim.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) ProcessClaim(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "processClaim", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("processClaim").(func(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error); ok {
+			return hook(opts, claim, recipient)
+		}
+		return nil
+	}, claim, recipient)
+}
+
+// OnProcessClaim sets a hook run by every ProcessClaim call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnProcessClaim(hook func(opts *bind.TransactOpts, claim IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("processClaim", hook)
+}
+
+// ProcessClaims records a processClaims call after running the hook set with OnProcessClaims.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) ProcessClaims(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "processClaims", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("processClaims").(func(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error); ok {
+			return hook(opts, claims, recipient)
+		}
+		return nil
+	}, claims, recipient)
+}
+
+// OnProcessClaims sets a hook run by every ProcessClaims call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnProcessClaims(hook func(opts *bind.TransactOpts, claims []IRewardsCoordinatorTypesRewardsMerkleClaim, recipient common.Address) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("processClaims", hook)
+}
+
+// SetActivationDelay records a setActivationDelay call after running the hook set with OnSetActivationDelay.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetActivationDelay(opts *bind.TransactOpts, _activationDelay uint32) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setActivationDelay", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setActivationDelay").(func(opts *bind.TransactOpts, _activationDelay uint32) error); ok {
+			return hook(opts, _activationDelay)
+		}
+		return nil
+	}, _activationDelay)
+}
+
+// OnSetActivationDelay sets a hook run by every SetActivationDelay call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetActivationDelay(hook func(opts *bind.TransactOpts, _activationDelay uint32) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setActivationDelay", hook)
+}
+
+// SetClaimerFor records a setClaimerFor call after running the hook set with OnSetClaimerFor.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetClaimerFor(opts *bind.TransactOpts, claimer common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setClaimerFor", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setClaimerFor").(func(opts *bind.TransactOpts, claimer common.Address) error); ok {
+			return hook(opts, claimer)
+		}
+		return nil
+	}, claimer)
+}
+
+// OnSetClaimerFor sets a hook run by every SetClaimerFor call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetClaimerFor(hook func(opts *bind.TransactOpts, claimer common.Address) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setClaimerFor", hook)
+}
+
+// SetClaimerFor0 records a setClaimerFor0 call after running the hook set with OnSetClaimerFor0.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetClaimerFor0(opts *bind.TransactOpts, earner common.Address, claimer common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setClaimerFor0", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setClaimerFor0").(func(opts *bind.TransactOpts, earner common.Address, claimer common.Address) error); ok {
+			return hook(opts, earner, claimer)
+		}
+		return nil
+	}, earner, claimer)
+}
+
+// OnSetClaimerFor0 sets a hook run by every SetClaimerFor0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetClaimerFor0(hook func(opts *bind.TransactOpts, earner common.Address, claimer common.Address) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setClaimerFor0", hook)
+}
+
+// SetDefaultOperatorSplit records a setDefaultOperatorSplit call after running the hook set with OnSetDefaultOperatorSplit.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetDefaultOperatorSplit(opts *bind.TransactOpts, split uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setDefaultOperatorSplit", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setDefaultOperatorSplit").(func(opts *bind.TransactOpts, split uint16) error); ok {
+			return hook(opts, split)
+		}
+		return nil
+	}, split)
+}
+
+// OnSetDefaultOperatorSplit sets a hook run by every SetDefaultOperatorSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetDefaultOperatorSplit(hook func(opts *bind.TransactOpts, split uint16) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setDefaultOperatorSplit", hook)
+}
+
+// SetOperatorAVSSplit records a setOperatorAVSSplit call after running the hook set with OnSetOperatorAVSSplit.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetOperatorAVSSplit(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setOperatorAVSSplit", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setOperatorAVSSplit").(func(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) error); ok {
+			return hook(opts, operator, avs, split)
+		}
+		return nil
+	}, operator, avs, split)
+}
+
+// OnSetOperatorAVSSplit sets a hook run by every SetOperatorAVSSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetOperatorAVSSplit(hook func(opts *bind.TransactOpts, operator common.Address, avs common.Address, split uint16) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setOperatorAVSSplit", hook)
+}
+
+// SetOperatorPISplit records a setOperatorPISplit call after running the hook set with OnSetOperatorPISplit.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetOperatorPISplit(opts *bind.TransactOpts, operator common.Address, split uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setOperatorPISplit", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setOperatorPISplit").(func(opts *bind.TransactOpts, operator common.Address, split uint16) error); ok {
+			return hook(opts, operator, split)
+		}
+		return nil
+	}, operator, split)
+}
+
+// OnSetOperatorPISplit sets a hook run by every SetOperatorPISplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetOperatorPISplit(hook func(opts *bind.TransactOpts, operator common.Address, split uint16) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setOperatorPISplit", hook)
+}
+
+// SetOperatorSetSplit records a setOperatorSetSplit call after running the hook set with OnSetOperatorSetSplit.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetOperatorSetSplit(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setOperatorSetSplit", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setOperatorSetSplit").(func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) error); ok {
+			return hook(opts, operator, operatorSet, split)
+		}
+		return nil
+	}, operator, operatorSet, split)
+}
+
+// OnSetOperatorSetSplit sets a hook run by every SetOperatorSetSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetOperatorSetSplit(hook func(opts *bind.TransactOpts, operator common.Address, operatorSet OperatorSet, split uint16) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setOperatorSetSplit", hook)
+}
+
+// SetRewardsForAllSubmitter records a setRewardsForAllSubmitter call after running the hook set with OnSetRewardsForAllSubmitter.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetRewardsForAllSubmitter(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setRewardsForAllSubmitter", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setRewardsForAllSubmitter").(func(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) error); ok {
+			return hook(opts, _submitter, _newValue)
+		}
+		return nil
+	}, _submitter, _newValue)
+}
+
+// OnSetRewardsForAllSubmitter sets a hook run by every SetRewardsForAllSubmitter call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetRewardsForAllSubmitter(hook func(opts *bind.TransactOpts, _submitter common.Address, _newValue bool) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setRewardsForAllSubmitter", hook)
+}
+
+// SetRewardsUpdater records a setRewardsUpdater call after running the hook set with OnSetRewardsUpdater.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SetRewardsUpdater(opts *bind.TransactOpts, _rewardsUpdater common.Address) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "setRewardsUpdater", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("setRewardsUpdater").(func(opts *bind.TransactOpts, _rewardsUpdater common.Address) error); ok {
+			return hook(opts, _rewardsUpdater)
+		}
+		return nil
+	}, _rewardsUpdater)
+}
+
+// OnSetRewardsUpdater sets a hook run by every SetRewardsUpdater call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSetRewardsUpdater(hook func(opts *bind.TransactOpts, _rewardsUpdater common.Address) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("setRewardsUpdater", hook)
+}
+
+// SubmitRoot records a submitRoot call after running the hook set with OnSubmitRoot.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) SubmitRoot(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) (*types.Transaction, error) {
+	return _FakeRewardsCoordinatorStorage.Contract.Transact(opts, "submitRoot", func() error {
+		if hook, ok := _FakeRewardsCoordinatorStorage.Contract.Hook("submitRoot").(func(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) error); ok {
+			return hook(opts, root, rewardsCalculationEndTimestamp)
+		}
+		return nil
+	}, root, rewardsCalculationEndTimestamp)
+}
+
+// OnSubmitRoot sets a hook run by every SubmitRoot call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) OnSubmitRoot(hook func(opts *bind.TransactOpts, root [32]byte, rewardsCalculationEndTimestamp uint32) error) {
+	_FakeRewardsCoordinatorStorage.Contract.SetHook("submitRoot", hook)
+}
+
+// EmitAVSRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitAVSRewardsSubmissionCreated(event *RewardsCoordinatorStorageAVSRewardsSubmissionCreated) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("AVSRewardsSubmissionCreated", event)
+}
+
+// EmitActivationDelaySet appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitActivationDelaySet(event *RewardsCoordinatorStorageActivationDelaySet) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("ActivationDelaySet", event)
+}
+
+// EmitClaimerForSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitClaimerForSet(event *RewardsCoordinatorStorageClaimerForSet) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("ClaimerForSet", event)
+}
+
+// EmitDefaultOperatorSplitBipsSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitDefaultOperatorSplitBipsSet(event *RewardsCoordinatorStorageDefaultOperatorSplitBipsSet) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("DefaultOperatorSplitBipsSet", event)
+}
+
+// EmitDistributionRootDisabled appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitDistributionRootDisabled(event *RewardsCoordinatorStorageDistributionRootDisabled) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("DistributionRootDisabled", event)
+}
+
+// EmitDistributionRootSubmitted appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitDistributionRootSubmitted(event *RewardsCoordinatorStorageDistributionRootSubmitted) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("DistributionRootSubmitted", event)
+}
+
+// EmitOperatorAVSSplitBipsSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitOperatorAVSSplitBipsSet(event *RewardsCoordinatorStorageOperatorAVSSplitBipsSet) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("OperatorAVSSplitBipsSet", event)
+}
+
+// EmitOperatorDirectedAVSRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitOperatorDirectedAVSRewardsSubmissionCreated(event *RewardsCoordinatorStorageOperatorDirectedAVSRewardsSubmissionCreated) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("OperatorDirectedAVSRewardsSubmissionCreated", event)
+}
+
+// EmitOperatorDirectedOperatorSetRewardsSubmissionCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitOperatorDirectedOperatorSetRewardsSubmissionCreated(event *RewardsCoordinatorStorageOperatorDirectedOperatorSetRewardsSubmissionCreated) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("OperatorDirectedOperatorSetRewardsSubmissionCreated", event)
+}
+
+// EmitOperatorPISplitBipsSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitOperatorPISplitBipsSet(event *RewardsCoordinatorStorageOperatorPISplitBipsSet) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("OperatorPISplitBipsSet", event)
+}
+
+// EmitOperatorSetSplitBipsSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitOperatorSetSplitBipsSet(event *RewardsCoordinatorStorageOperatorSetSplitBipsSet) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("OperatorSetSplitBipsSet", event)
+}
+
+// EmitRewardsClaimed appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitRewardsClaimed(event *RewardsCoordinatorStorageRewardsClaimed) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("RewardsClaimed", event)
+}
+
+// EmitRewardsForAllSubmitterSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitRewardsForAllSubmitterSet(event *RewardsCoordinatorStorageRewardsForAllSubmitterSet) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("RewardsForAllSubmitterSet", event)
+}
+
+// EmitRewardsSubmissionForAllCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitRewardsSubmissionForAllCreated(event *RewardsCoordinatorStorageRewardsSubmissionForAllCreated) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("RewardsSubmissionForAllCreated", event)
+}
+
+// EmitRewardsSubmissionForAllEarnersCreated appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitRewardsSubmissionForAllEarnersCreated(event *RewardsCoordinatorStorageRewardsSubmissionForAllEarnersCreated) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("RewardsSubmissionForAllEarnersCreated", event)
+}
+
+// EmitRewardsUpdaterSet appends the event to the fake's logs.
+func (_FakeRewardsCoordinatorStorage *FakeRewardsCoordinatorStorage) EmitRewardsUpdaterSet(event *RewardsCoordinatorStorageRewardsUpdaterSet) {
+	_FakeRewardsCoordinatorStorage.Contract.Emit("RewardsUpdaterSet", event)
+}
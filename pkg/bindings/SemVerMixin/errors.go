@@ -0,0 +1,31 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SemVerMixin
+
+import (
+	"fmt"
+)
+
+// ErrInvalidShortString is the typed form of the SemVerMixin custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "SemVerMixin: InvalidShortString()"
+}
+
+// ErrStringTooLong is the typed form of the SemVerMixin custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("SemVerMixin: StringTooLong(str: %v)", e.Str)
+}
+
+// SemVerMixinErrors maps the name of every custom error in the SemVerMixin ABI to its typed Go error.
+var SemVerMixinErrors = map[string]error{
+	"InvalidShortString": ErrInvalidShortString{},
+	"StringTooLong":      ErrStringTooLong{},
+}
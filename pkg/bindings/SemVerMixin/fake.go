@@ -0,0 +1,40 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SemVerMixin
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeSemVerMixin is an in-memory SemVerMixin for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded SemVerMixinFilterer.
+type FakeSemVerMixin struct {
+	*fake.Contract
+	*SemVerMixinFilterer
+}
+
+// NewFakeSemVerMixin returns an empty fake of the contract at address.
+func NewFakeSemVerMixin(address common.Address) *FakeSemVerMixin {
+	contract := fake.NewContract(SemVerMixinMetaData, address)
+	filterer, err := NewSemVerMixinFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeSemVerMixin{Contract: contract, SemVerMixinFilterer: filterer}
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeSemVerMixin *FakeSemVerMixin) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeSemVerMixin.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeSemVerMixin *FakeSemVerMixin) StubVersion(out0 string) {
+	_FakeSemVerMixin.Contract.Stub("version", []interface{}{}, out0)
+}
@@ -0,0 +1,19 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SemVerMixin
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// SemVerMixinReader is the read-only surface of the SemVerMixin
+// binding, implemented by SemVerMixinCaller, SemVerMixin and FakeSemVerMixin.
+type SemVerMixinReader interface {
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+var (
+	_ SemVerMixinReader = (*SemVerMixinCaller)(nil)
+	_ SemVerMixinReader = (*SemVerMixin)(nil)
+	_ SemVerMixinReader = (*FakeSemVerMixin)(nil)
+)
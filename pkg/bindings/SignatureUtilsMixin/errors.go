@@ -0,0 +1,49 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SignatureUtilsMixin
+
+import (
+	"fmt"
+)
+
+// ErrInvalidShortString is the typed form of the SignatureUtilsMixin custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "SignatureUtilsMixin: InvalidShortString()"
+}
+
+// ErrInvalidSignature is the typed form of the SignatureUtilsMixin custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "SignatureUtilsMixin: InvalidSignature()"
+}
+
+// ErrSignatureExpired is the typed form of the SignatureUtilsMixin custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "SignatureUtilsMixin: SignatureExpired()"
+}
+
+// ErrStringTooLong is the typed form of the SignatureUtilsMixin custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("SignatureUtilsMixin: StringTooLong(str: %v)", e.Str)
+}
+
+// SignatureUtilsMixinErrors maps the name of every custom error in the SignatureUtilsMixin ABI to its typed Go error.
+var SignatureUtilsMixinErrors = map[string]error{
+	"InvalidShortString": ErrInvalidShortString{},
+	"InvalidSignature":   ErrInvalidSignature{},
+	"SignatureExpired":   ErrSignatureExpired{},
+	"StringTooLong":      ErrStringTooLong{},
+}
@@ -0,0 +1,52 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SignatureUtilsMixin
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeSignatureUtilsMixin is an in-memory SignatureUtilsMixin for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded SignatureUtilsMixinFilterer.
+type FakeSignatureUtilsMixin struct {
+	*fake.Contract
+	*SignatureUtilsMixinFilterer
+}
+
+// NewFakeSignatureUtilsMixin returns an empty fake of the contract at address.
+func NewFakeSignatureUtilsMixin(address common.Address) *FakeSignatureUtilsMixin {
+	contract := fake.NewContract(SignatureUtilsMixinMetaData, address)
+	filterer, err := NewSignatureUtilsMixinFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeSignatureUtilsMixin{Contract: contract, SignatureUtilsMixinFilterer: filterer}
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeSignatureUtilsMixin *FakeSignatureUtilsMixin) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeSignatureUtilsMixin.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeSignatureUtilsMixin *FakeSignatureUtilsMixin) StubDomainSeparator(out0 [32]byte) {
+	_FakeSignatureUtilsMixin.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeSignatureUtilsMixin *FakeSignatureUtilsMixin) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeSignatureUtilsMixin.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeSignatureUtilsMixin *FakeSignatureUtilsMixin) StubVersion(out0 string) {
+	_FakeSignatureUtilsMixin.Contract.Stub("version", []interface{}{}, out0)
+}
@@ -0,0 +1,20 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SignatureUtilsMixin
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// SignatureUtilsMixinReader is the read-only surface of the SignatureUtilsMixin
+// binding, implemented by SignatureUtilsMixinCaller, SignatureUtilsMixin and FakeSignatureUtilsMixin.
+type SignatureUtilsMixinReader interface {
+	DomainSeparator(opts *bind.CallOpts) ([32]byte, error)
+	Version(opts *bind.CallOpts) (string, error)
+}
+
+var (
+	_ SignatureUtilsMixinReader = (*SignatureUtilsMixinCaller)(nil)
+	_ SignatureUtilsMixinReader = (*SignatureUtilsMixin)(nil)
+	_ SignatureUtilsMixinReader = (*FakeSignatureUtilsMixin)(nil)
+)
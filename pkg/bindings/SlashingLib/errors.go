@@ -0,0 +1,16 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SlashingLib
+
+// ErrInvalidDepositScalingFactor is the typed form of the SlashingLib custom error InvalidDepositScalingFactor().
+type ErrInvalidDepositScalingFactor struct{}
+
+// Error implements the error interface.
+func (ErrInvalidDepositScalingFactor) Error() string {
+	return "SlashingLib: InvalidDepositScalingFactor()"
+}
+
+// SlashingLibErrors maps the name of every custom error in the SlashingLib ABI to its typed Go error.
+var SlashingLibErrors = map[string]error{
+	"InvalidDepositScalingFactor": ErrInvalidDepositScalingFactor{},
+}
@@ -0,0 +1,16 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package Snapshots
+
+// ErrInvalidSnapshotOrdering is the typed form of the Snapshots custom error InvalidSnapshotOrdering().
+type ErrInvalidSnapshotOrdering struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSnapshotOrdering) Error() string {
+	return "Snapshots: InvalidSnapshotOrdering()"
+}
+
+// SnapshotsErrors maps the name of every custom error in the Snapshots ABI to its typed Go error.
+var SnapshotsErrors = map[string]error{
+	"InvalidSnapshotOrdering": ErrInvalidSnapshotOrdering{},
+}
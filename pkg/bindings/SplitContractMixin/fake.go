@@ -0,0 +1,40 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SplitContractMixin
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FakeSplitContractMixin is an in-memory SplitContractMixin for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded SplitContractMixinFilterer.
+type FakeSplitContractMixin struct {
+	*fake.Contract
+	*SplitContractMixinFilterer
+}
+
+// NewFakeSplitContractMixin returns an empty fake of the contract at address.
+func NewFakeSplitContractMixin(address common.Address) *FakeSplitContractMixin {
+	contract := fake.NewContract(SplitContractMixinMetaData, address)
+	filterer, err := NewSplitContractMixinFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeSplitContractMixin{Contract: contract, SplitContractMixinFilterer: filterer}
+}
+
+// ViewImplementation returns the values stubbed with StubViewImplementation.
+func (_FakeSplitContractMixin *FakeSplitContractMixin) ViewImplementation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeSplitContractMixin.Contract.Read("viewImplementation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubViewImplementation sets what ViewImplementation returns for the given arguments.
+func (_FakeSplitContractMixin *FakeSplitContractMixin) StubViewImplementation(out0 common.Address) {
+	_FakeSplitContractMixin.Contract.Stub("viewImplementation", []interface{}{}, out0)
+}
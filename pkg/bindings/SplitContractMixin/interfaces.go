@@ -0,0 +1,20 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package SplitContractMixin
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SplitContractMixinReader is the read-only surface of the SplitContractMixin
+// binding, implemented by SplitContractMixinCaller, SplitContractMixin and FakeSplitContractMixin.
+type SplitContractMixinReader interface {
+	ViewImplementation(opts *bind.CallOpts) (common.Address, error)
+}
+
+var (
+	_ SplitContractMixinReader = (*SplitContractMixinCaller)(nil)
+	_ SplitContractMixinReader = (*SplitContractMixin)(nil)
+	_ SplitContractMixinReader = (*FakeSplitContractMixin)(nil)
+)
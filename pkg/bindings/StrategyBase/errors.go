@@ -0,0 +1,115 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyBase
+
+// ErrBalanceExceedsMaxTotalDeposits is the typed form of the StrategyBase custom error BalanceExceedsMaxTotalDeposits().
+type ErrBalanceExceedsMaxTotalDeposits struct{}
+
+// Error implements the error interface.
+func (ErrBalanceExceedsMaxTotalDeposits) Error() string {
+	return "StrategyBase: BalanceExceedsMaxTotalDeposits()"
+}
+
+// ErrCurrentlyPaused is the typed form of the StrategyBase custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "StrategyBase: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the StrategyBase custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "StrategyBase: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the StrategyBase custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "StrategyBase: InvalidNewPausedStatus()"
+}
+
+// ErrMaxPerDepositExceedsMax is the typed form of the StrategyBase custom error MaxPerDepositExceedsMax().
+type ErrMaxPerDepositExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrMaxPerDepositExceedsMax) Error() string {
+	return "StrategyBase: MaxPerDepositExceedsMax()"
+}
+
+// ErrNewSharesZero is the typed form of the StrategyBase custom error NewSharesZero().
+type ErrNewSharesZero struct{}
+
+// Error implements the error interface.
+func (ErrNewSharesZero) Error() string {
+	return "StrategyBase: NewSharesZero()"
+}
+
+// ErrOnlyPauser is the typed form of the StrategyBase custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "StrategyBase: OnlyPauser()"
+}
+
+// ErrOnlyStrategyManager is the typed form of the StrategyBase custom error OnlyStrategyManager().
+type ErrOnlyStrategyManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyManager) Error() string {
+	return "StrategyBase: OnlyStrategyManager()"
+}
+
+// ErrOnlyUnderlyingToken is the typed form of the StrategyBase custom error OnlyUnderlyingToken().
+type ErrOnlyUnderlyingToken struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnderlyingToken) Error() string {
+	return "StrategyBase: OnlyUnderlyingToken()"
+}
+
+// ErrOnlyUnpauser is the typed form of the StrategyBase custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "StrategyBase: OnlyUnpauser()"
+}
+
+// ErrTotalSharesExceedsMax is the typed form of the StrategyBase custom error TotalSharesExceedsMax().
+type ErrTotalSharesExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrTotalSharesExceedsMax) Error() string {
+	return "StrategyBase: TotalSharesExceedsMax()"
+}
+
+// ErrWithdrawalAmountExceedsTotalDeposits is the typed form of the StrategyBase custom error WithdrawalAmountExceedsTotalDeposits().
+type ErrWithdrawalAmountExceedsTotalDeposits struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalAmountExceedsTotalDeposits) Error() string {
+	return "StrategyBase: WithdrawalAmountExceedsTotalDeposits()"
+}
+
+// StrategyBaseErrors maps the name of every custom error in the StrategyBase ABI to its typed Go error.
+var StrategyBaseErrors = map[string]error{
+	"BalanceExceedsMaxTotalDeposits":       ErrBalanceExceedsMaxTotalDeposits{},
+	"CurrentlyPaused":                      ErrCurrentlyPaused{},
+	"InputAddressZero":                     ErrInputAddressZero{},
+	"InvalidNewPausedStatus":               ErrInvalidNewPausedStatus{},
+	"MaxPerDepositExceedsMax":              ErrMaxPerDepositExceedsMax{},
+	"NewSharesZero":                        ErrNewSharesZero{},
+	"OnlyPauser":                           ErrOnlyPauser{},
+	"OnlyStrategyManager":                  ErrOnlyStrategyManager{},
+	"OnlyUnderlyingToken":                  ErrOnlyUnderlyingToken{},
+	"OnlyUnpauser":                         ErrOnlyUnpauser{},
+	"TotalSharesExceedsMax":                ErrTotalSharesExceedsMax{},
+	"WithdrawalAmountExceedsTotalDeposits": ErrWithdrawalAmountExceedsTotalDeposits{},
+}
@@ -0,0 +1,323 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyBase
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeStrategyBase is an in-memory StrategyBase for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded StrategyBaseFilterer.
+type FakeStrategyBase struct {
+	*fake.Contract
+	*StrategyBaseFilterer
+}
+
+// NewFakeStrategyBase returns an empty fake of the contract at address.
+func NewFakeStrategyBase(address common.Address) *FakeStrategyBase {
+	contract := fake.NewContract(StrategyBaseMetaData, address)
+	filterer, err := NewStrategyBaseFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeStrategyBase{Contract: contract, StrategyBaseFilterer: filterer}
+}
+
+// Explanation returns the values stubbed with StubExplanation.
+func (_FakeStrategyBase *FakeStrategyBase) Explanation(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeStrategyBase.Contract.Read("explanation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubExplanation sets what Explanation returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubExplanation(out0 string) {
+	_FakeStrategyBase.Contract.Stub("explanation", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeStrategyBase *FakeStrategyBase) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeStrategyBase.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubPaused(index uint8, out0 bool) {
+	_FakeStrategyBase.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeStrategyBase *FakeStrategyBase) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBase.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubPaused0(out0 *big.Int) {
+	_FakeStrategyBase.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeStrategyBase *FakeStrategyBase) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyBase.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubPauserRegistry(out0 common.Address) {
+	_FakeStrategyBase.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// Shares returns the values stubbed with StubShares.
+func (_FakeStrategyBase *FakeStrategyBase) Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBase.Contract.Read("shares", []interface{}{user}, &out0)
+	return out0, err
+}
+
+// StubShares sets what Shares returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubShares(user common.Address, out0 *big.Int) {
+	_FakeStrategyBase.Contract.Stub("shares", []interface{}{user}, out0)
+}
+
+// SharesToUnderlying returns the values stubbed with StubSharesToUnderlying.
+func (_FakeStrategyBase *FakeStrategyBase) SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBase.Contract.Read("sharesToUnderlying", []interface{}{amountShares}, &out0)
+	return out0, err
+}
+
+// StubSharesToUnderlying sets what SharesToUnderlying returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubSharesToUnderlying(amountShares *big.Int, out0 *big.Int) {
+	_FakeStrategyBase.Contract.Stub("sharesToUnderlying", []interface{}{amountShares}, out0)
+}
+
+// SharesToUnderlyingView returns the values stubbed with StubSharesToUnderlyingView.
+func (_FakeStrategyBase *FakeStrategyBase) SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBase.Contract.Read("sharesToUnderlyingView", []interface{}{amountShares}, &out0)
+	return out0, err
+}
+
+// StubSharesToUnderlyingView sets what SharesToUnderlyingView returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubSharesToUnderlyingView(amountShares *big.Int, out0 *big.Int) {
+	_FakeStrategyBase.Contract.Stub("sharesToUnderlyingView", []interface{}{amountShares}, out0)
+}
+
+// StrategyManager returns the values stubbed with StubStrategyManager.
+func (_FakeStrategyBase *FakeStrategyBase) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyBase.Contract.Read("strategyManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyManager sets what StrategyManager returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubStrategyManager(out0 common.Address) {
+	_FakeStrategyBase.Contract.Stub("strategyManager", []interface{}{}, out0)
+}
+
+// TotalShares returns the values stubbed with StubTotalShares.
+func (_FakeStrategyBase *FakeStrategyBase) TotalShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBase.Contract.Read("totalShares", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalShares sets what TotalShares returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubTotalShares(out0 *big.Int) {
+	_FakeStrategyBase.Contract.Stub("totalShares", []interface{}{}, out0)
+}
+
+// UnderlyingToShares returns the values stubbed with StubUnderlyingToShares.
+func (_FakeStrategyBase *FakeStrategyBase) UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBase.Contract.Read("underlyingToShares", []interface{}{amountUnderlying}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToShares sets what UnderlyingToShares returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubUnderlyingToShares(amountUnderlying *big.Int, out0 *big.Int) {
+	_FakeStrategyBase.Contract.Stub("underlyingToShares", []interface{}{amountUnderlying}, out0)
+}
+
+// UnderlyingToSharesView returns the values stubbed with StubUnderlyingToSharesView.
+func (_FakeStrategyBase *FakeStrategyBase) UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBase.Contract.Read("underlyingToSharesView", []interface{}{amountUnderlying}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToSharesView sets what UnderlyingToSharesView returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubUnderlyingToSharesView(amountUnderlying *big.Int, out0 *big.Int) {
+	_FakeStrategyBase.Contract.Stub("underlyingToSharesView", []interface{}{amountUnderlying}, out0)
+}
+
+// UnderlyingToken returns the values stubbed with StubUnderlyingToken.
+func (_FakeStrategyBase *FakeStrategyBase) UnderlyingToken(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyBase.Contract.Read("underlyingToken", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToken sets what UnderlyingToken returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubUnderlyingToken(out0 common.Address) {
+	_FakeStrategyBase.Contract.Stub("underlyingToken", []interface{}{}, out0)
+}
+
+// UserUnderlyingView returns the values stubbed with StubUserUnderlyingView.
+func (_FakeStrategyBase *FakeStrategyBase) UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBase.Contract.Read("userUnderlyingView", []interface{}{user}, &out0)
+	return out0, err
+}
+
+// StubUserUnderlyingView sets what UserUnderlyingView returns for the given arguments.
+func (_FakeStrategyBase *FakeStrategyBase) StubUserUnderlyingView(user common.Address, out0 *big.Int) {
+	_FakeStrategyBase.Contract.Stub("userUnderlyingView", []interface{}{user}, out0)
+}
+
+// Deposit records a deposit call after running the hook set with OnDeposit.
+func (_FakeStrategyBase *FakeStrategyBase) Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBase.Contract.Transact(opts, "deposit", func() error {
+		if hook, ok := _FakeStrategyBase.Contract.Hook("deposit").(func(opts *bind.TransactOpts, token common.Address, amount *big.Int) error); ok {
+			return hook(opts, token, amount)
+		}
+		return nil
+	}, token, amount)
+}
+
+// OnDeposit sets a hook run by every Deposit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBase *FakeStrategyBase) OnDeposit(hook func(opts *bind.TransactOpts, token common.Address, amount *big.Int) error) {
+	_FakeStrategyBase.Contract.SetHook("deposit", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeStrategyBase *FakeStrategyBase) Initialize(opts *bind.TransactOpts, _underlyingToken common.Address) (*types.Transaction, error) {
+	return _FakeStrategyBase.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeStrategyBase.Contract.Hook("initialize").(func(opts *bind.TransactOpts, _underlyingToken common.Address) error); ok {
+			return hook(opts, _underlyingToken)
+		}
+		return nil
+	}, _underlyingToken)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBase *FakeStrategyBase) OnInitialize(hook func(opts *bind.TransactOpts, _underlyingToken common.Address) error) {
+	_FakeStrategyBase.Contract.SetHook("initialize", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeStrategyBase *FakeStrategyBase) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBase.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeStrategyBase.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBase *FakeStrategyBase) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeStrategyBase.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeStrategyBase *FakeStrategyBase) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeStrategyBase.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeStrategyBase.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBase *FakeStrategyBase) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeStrategyBase.Contract.SetHook("pauseAll", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeStrategyBase *FakeStrategyBase) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBase.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeStrategyBase.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBase *FakeStrategyBase) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeStrategyBase.Contract.SetHook("unpause", hook)
+}
+
+// UserUnderlying records a userUnderlying call after running the hook set with OnUserUnderlying.
+func (_FakeStrategyBase *FakeStrategyBase) UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error) {
+	return _FakeStrategyBase.Contract.Transact(opts, "userUnderlying", func() error {
+		if hook, ok := _FakeStrategyBase.Contract.Hook("userUnderlying").(func(opts *bind.TransactOpts, user common.Address) error); ok {
+			return hook(opts, user)
+		}
+		return nil
+	}, user)
+}
+
+// OnUserUnderlying sets a hook run by every UserUnderlying call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBase *FakeStrategyBase) OnUserUnderlying(hook func(opts *bind.TransactOpts, user common.Address) error) {
+	_FakeStrategyBase.Contract.SetHook("userUnderlying", hook)
+}
+
+// Withdraw records a withdraw call after running the hook set with OnWithdraw.
+func (_FakeStrategyBase *FakeStrategyBase) Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBase.Contract.Transact(opts, "withdraw", func() error {
+		if hook, ok := _FakeStrategyBase.Contract.Hook("withdraw").(func(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) error); ok {
+			return hook(opts, recipient, token, amountShares)
+		}
+		return nil
+	}, recipient, token, amountShares)
+}
+
+// OnWithdraw sets a hook run by every Withdraw call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBase *FakeStrategyBase) OnWithdraw(hook func(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) error) {
+	_FakeStrategyBase.Contract.SetHook("withdraw", hook)
+}
+
+// EmitExchangeRateEmitted appends the event to the fake's logs.
+func (_FakeStrategyBase *FakeStrategyBase) EmitExchangeRateEmitted(event *StrategyBaseExchangeRateEmitted) {
+	_FakeStrategyBase.Contract.Emit("ExchangeRateEmitted", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeStrategyBase *FakeStrategyBase) EmitInitialized(event *StrategyBaseInitialized) {
+	_FakeStrategyBase.Contract.Emit("Initialized", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeStrategyBase *FakeStrategyBase) EmitPaused(event *StrategyBasePaused) {
+	_FakeStrategyBase.Contract.Emit("Paused", event)
+}
+
+// EmitStrategyTokenSet appends the event to the fake's logs.
+func (_FakeStrategyBase *FakeStrategyBase) EmitStrategyTokenSet(event *StrategyBaseStrategyTokenSet) {
+	_FakeStrategyBase.Contract.Emit("StrategyTokenSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeStrategyBase *FakeStrategyBase) EmitUnpaused(event *StrategyBaseUnpaused) {
+	_FakeStrategyBase.Contract.Emit("Unpaused", event)
+}
@@ -0,0 +1,73 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyBase
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// StrategyBaseReader is the read-only surface of the StrategyBase
+// binding, implemented by StrategyBaseCaller, StrategyBase and FakeStrategyBase.
+type StrategyBaseReader interface {
+	Explanation(opts *bind.CallOpts) (string, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	TotalShares(opts *bind.CallOpts) (*big.Int, error)
+	UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToken(opts *bind.CallOpts) (common.Address, error)
+	UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+}
+
+// StrategyBaseWriter is the transacting surface of the StrategyBase
+// binding, implemented by StrategyBaseTransactor, StrategyBase and FakeStrategyBase.
+type StrategyBaseWriter interface {
+	Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _underlyingToken common.Address) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error)
+}
+
+// StrategyBaseEvents is the event filtering surface of the StrategyBase
+// binding, implemented by StrategyBaseFilterer, StrategyBase and FakeStrategyBase.
+type StrategyBaseEvents interface {
+	FilterExchangeRateEmitted(opts *bind.FilterOpts) (*StrategyBaseExchangeRateEmittedIterator, error)
+	WatchExchangeRateEmitted(opts *bind.WatchOpts, sink chan<- *StrategyBaseExchangeRateEmitted) (event.Subscription, error)
+	ParseExchangeRateEmitted(log types.Log) (*StrategyBaseExchangeRateEmitted, error)
+	FilterInitialized(opts *bind.FilterOpts) (*StrategyBaseInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *StrategyBaseInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*StrategyBaseInitialized, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*StrategyBasePausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *StrategyBasePaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*StrategyBasePaused, error)
+	FilterStrategyTokenSet(opts *bind.FilterOpts) (*StrategyBaseStrategyTokenSetIterator, error)
+	WatchStrategyTokenSet(opts *bind.WatchOpts, sink chan<- *StrategyBaseStrategyTokenSet) (event.Subscription, error)
+	ParseStrategyTokenSet(log types.Log) (*StrategyBaseStrategyTokenSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*StrategyBaseUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *StrategyBaseUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*StrategyBaseUnpaused, error)
+}
+
+var (
+	_ StrategyBaseReader = (*StrategyBaseCaller)(nil)
+	_ StrategyBaseReader = (*StrategyBase)(nil)
+	_ StrategyBaseReader = (*FakeStrategyBase)(nil)
+	_ StrategyBaseWriter = (*StrategyBaseTransactor)(nil)
+	_ StrategyBaseWriter = (*StrategyBase)(nil)
+	_ StrategyBaseWriter = (*FakeStrategyBase)(nil)
+	_ StrategyBaseEvents = (*StrategyBaseFilterer)(nil)
+	_ StrategyBaseEvents = (*StrategyBase)(nil)
+	_ StrategyBaseEvents = (*FakeStrategyBase)(nil)
+)
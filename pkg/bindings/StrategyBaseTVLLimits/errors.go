@@ -0,0 +1,115 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyBaseTVLLimits
+
+// ErrBalanceExceedsMaxTotalDeposits is the typed form of the StrategyBaseTVLLimits custom error BalanceExceedsMaxTotalDeposits().
+type ErrBalanceExceedsMaxTotalDeposits struct{}
+
+// Error implements the error interface.
+func (ErrBalanceExceedsMaxTotalDeposits) Error() string {
+	return "StrategyBaseTVLLimits: BalanceExceedsMaxTotalDeposits()"
+}
+
+// ErrCurrentlyPaused is the typed form of the StrategyBaseTVLLimits custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "StrategyBaseTVLLimits: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the StrategyBaseTVLLimits custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "StrategyBaseTVLLimits: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the StrategyBaseTVLLimits custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "StrategyBaseTVLLimits: InvalidNewPausedStatus()"
+}
+
+// ErrMaxPerDepositExceedsMax is the typed form of the StrategyBaseTVLLimits custom error MaxPerDepositExceedsMax().
+type ErrMaxPerDepositExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrMaxPerDepositExceedsMax) Error() string {
+	return "StrategyBaseTVLLimits: MaxPerDepositExceedsMax()"
+}
+
+// ErrNewSharesZero is the typed form of the StrategyBaseTVLLimits custom error NewSharesZero().
+type ErrNewSharesZero struct{}
+
+// Error implements the error interface.
+func (ErrNewSharesZero) Error() string {
+	return "StrategyBaseTVLLimits: NewSharesZero()"
+}
+
+// ErrOnlyPauser is the typed form of the StrategyBaseTVLLimits custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "StrategyBaseTVLLimits: OnlyPauser()"
+}
+
+// ErrOnlyStrategyManager is the typed form of the StrategyBaseTVLLimits custom error OnlyStrategyManager().
+type ErrOnlyStrategyManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyManager) Error() string {
+	return "StrategyBaseTVLLimits: OnlyStrategyManager()"
+}
+
+// ErrOnlyUnderlyingToken is the typed form of the StrategyBaseTVLLimits custom error OnlyUnderlyingToken().
+type ErrOnlyUnderlyingToken struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnderlyingToken) Error() string {
+	return "StrategyBaseTVLLimits: OnlyUnderlyingToken()"
+}
+
+// ErrOnlyUnpauser is the typed form of the StrategyBaseTVLLimits custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "StrategyBaseTVLLimits: OnlyUnpauser()"
+}
+
+// ErrTotalSharesExceedsMax is the typed form of the StrategyBaseTVLLimits custom error TotalSharesExceedsMax().
+type ErrTotalSharesExceedsMax struct{}
+
+// Error implements the error interface.
+func (ErrTotalSharesExceedsMax) Error() string {
+	return "StrategyBaseTVLLimits: TotalSharesExceedsMax()"
+}
+
+// ErrWithdrawalAmountExceedsTotalDeposits is the typed form of the StrategyBaseTVLLimits custom error WithdrawalAmountExceedsTotalDeposits().
+type ErrWithdrawalAmountExceedsTotalDeposits struct{}
+
+// Error implements the error interface.
+func (ErrWithdrawalAmountExceedsTotalDeposits) Error() string {
+	return "StrategyBaseTVLLimits: WithdrawalAmountExceedsTotalDeposits()"
+}
+
+// StrategyBaseTVLLimitsErrors maps the name of every custom error in the StrategyBaseTVLLimits ABI to its typed Go error.
+var StrategyBaseTVLLimitsErrors = map[string]error{
+	"BalanceExceedsMaxTotalDeposits":       ErrBalanceExceedsMaxTotalDeposits{},
+	"CurrentlyPaused":                      ErrCurrentlyPaused{},
+	"InputAddressZero":                     ErrInputAddressZero{},
+	"InvalidNewPausedStatus":               ErrInvalidNewPausedStatus{},
+	"MaxPerDepositExceedsMax":              ErrMaxPerDepositExceedsMax{},
+	"NewSharesZero":                        ErrNewSharesZero{},
+	"OnlyPauser":                           ErrOnlyPauser{},
+	"OnlyStrategyManager":                  ErrOnlyStrategyManager{},
+	"OnlyUnderlyingToken":                  ErrOnlyUnderlyingToken{},
+	"OnlyUnpauser":                         ErrOnlyUnpauser{},
+	"TotalSharesExceedsMax":                ErrTotalSharesExceedsMax{},
+	"WithdrawalAmountExceedsTotalDeposits": ErrWithdrawalAmountExceedsTotalDeposits{},
+}
@@ -0,0 +1,402 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyBaseTVLLimits
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeStrategyBaseTVLLimits is an in-memory StrategyBaseTVLLimits for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded StrategyBaseTVLLimitsFilterer.
+type FakeStrategyBaseTVLLimits struct {
+	*fake.Contract
+	*StrategyBaseTVLLimitsFilterer
+}
+
+// NewFakeStrategyBaseTVLLimits returns an empty fake of the contract at address.
+func NewFakeStrategyBaseTVLLimits(address common.Address) *FakeStrategyBaseTVLLimits {
+	contract := fake.NewContract(StrategyBaseTVLLimitsMetaData, address)
+	filterer, err := NewStrategyBaseTVLLimitsFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeStrategyBaseTVLLimits{Contract: contract, StrategyBaseTVLLimitsFilterer: filterer}
+}
+
+// Explanation returns the values stubbed with StubExplanation.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Explanation(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("explanation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubExplanation sets what Explanation returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubExplanation(out0 string) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("explanation", []interface{}{}, out0)
+}
+
+// GetTVLLimits returns the values stubbed with StubGetTVLLimits.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) GetTVLLimits(opts *bind.CallOpts) (*big.Int, *big.Int, error) {
+	var out0 *big.Int
+	var out1 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("getTVLLimits", []interface{}{}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetTVLLimits sets what GetTVLLimits returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubGetTVLLimits(out0 *big.Int, out1 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("getTVLLimits", []interface{}{}, out0, out1)
+}
+
+// MaxPerDeposit returns the values stubbed with StubMaxPerDeposit.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) MaxPerDeposit(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("maxPerDeposit", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMaxPerDeposit sets what MaxPerDeposit returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubMaxPerDeposit(out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("maxPerDeposit", []interface{}{}, out0)
+}
+
+// MaxTotalDeposits returns the values stubbed with StubMaxTotalDeposits.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) MaxTotalDeposits(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("maxTotalDeposits", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMaxTotalDeposits sets what MaxTotalDeposits returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubMaxTotalDeposits(out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("maxTotalDeposits", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubPaused(index uint8, out0 bool) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubPaused0(out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubPauserRegistry(out0 common.Address) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// Shares returns the values stubbed with StubShares.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("shares", []interface{}{user}, &out0)
+	return out0, err
+}
+
+// StubShares sets what Shares returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubShares(user common.Address, out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("shares", []interface{}{user}, out0)
+}
+
+// SharesToUnderlying returns the values stubbed with StubSharesToUnderlying.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("sharesToUnderlying", []interface{}{amountShares}, &out0)
+	return out0, err
+}
+
+// StubSharesToUnderlying sets what SharesToUnderlying returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubSharesToUnderlying(amountShares *big.Int, out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("sharesToUnderlying", []interface{}{amountShares}, out0)
+}
+
+// SharesToUnderlyingView returns the values stubbed with StubSharesToUnderlyingView.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("sharesToUnderlyingView", []interface{}{amountShares}, &out0)
+	return out0, err
+}
+
+// StubSharesToUnderlyingView sets what SharesToUnderlyingView returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubSharesToUnderlyingView(amountShares *big.Int, out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("sharesToUnderlyingView", []interface{}{amountShares}, out0)
+}
+
+// StrategyManager returns the values stubbed with StubStrategyManager.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("strategyManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyManager sets what StrategyManager returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubStrategyManager(out0 common.Address) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("strategyManager", []interface{}{}, out0)
+}
+
+// TotalShares returns the values stubbed with StubTotalShares.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) TotalShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("totalShares", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubTotalShares sets what TotalShares returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubTotalShares(out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("totalShares", []interface{}{}, out0)
+}
+
+// UnderlyingToShares returns the values stubbed with StubUnderlyingToShares.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("underlyingToShares", []interface{}{amountUnderlying}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToShares sets what UnderlyingToShares returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubUnderlyingToShares(amountUnderlying *big.Int, out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("underlyingToShares", []interface{}{amountUnderlying}, out0)
+}
+
+// UnderlyingToSharesView returns the values stubbed with StubUnderlyingToSharesView.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("underlyingToSharesView", []interface{}{amountUnderlying}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToSharesView sets what UnderlyingToSharesView returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubUnderlyingToSharesView(amountUnderlying *big.Int, out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("underlyingToSharesView", []interface{}{amountUnderlying}, out0)
+}
+
+// UnderlyingToken returns the values stubbed with StubUnderlyingToken.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) UnderlyingToken(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("underlyingToken", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubUnderlyingToken sets what UnderlyingToken returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubUnderlyingToken(out0 common.Address) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("underlyingToken", []interface{}{}, out0)
+}
+
+// UserUnderlyingView returns the values stubbed with StubUserUnderlyingView.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyBaseTVLLimits.Contract.Read("userUnderlyingView", []interface{}{user}, &out0)
+	return out0, err
+}
+
+// StubUserUnderlyingView sets what UserUnderlyingView returns for the given arguments.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) StubUserUnderlyingView(user common.Address, out0 *big.Int) {
+	_FakeStrategyBaseTVLLimits.Contract.Stub("userUnderlyingView", []interface{}{user}, out0)
+}
+
+// Deposit records a deposit call after running the hook set with OnDeposit.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "deposit", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("deposit").(func(opts *bind.TransactOpts, token common.Address, amount *big.Int) error); ok {
+			return hook(opts, token, amount)
+		}
+		return nil
+	}, token, amount)
+}
+
+// OnDeposit sets a hook run by every Deposit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnDeposit(hook func(opts *bind.TransactOpts, token common.Address, amount *big.Int) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("deposit", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Initialize(opts *bind.TransactOpts, _maxPerDeposit *big.Int, _maxTotalDeposits *big.Int, _underlyingToken common.Address) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("initialize").(func(opts *bind.TransactOpts, _maxPerDeposit *big.Int, _maxTotalDeposits *big.Int, _underlyingToken common.Address) error); ok {
+			return hook(opts, _maxPerDeposit, _maxTotalDeposits, _underlyingToken)
+		}
+		return nil
+	}, _maxPerDeposit, _maxTotalDeposits, _underlyingToken)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnInitialize(hook func(opts *bind.TransactOpts, _maxPerDeposit *big.Int, _maxTotalDeposits *big.Int, _underlyingToken common.Address) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("initialize", hook)
+}
+
+// Initialize0 records a initialize0 call after running the hook set with OnInitialize0.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Initialize0(opts *bind.TransactOpts, _underlyingToken common.Address) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "initialize0", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("initialize0").(func(opts *bind.TransactOpts, _underlyingToken common.Address) error); ok {
+			return hook(opts, _underlyingToken)
+		}
+		return nil
+	}, _underlyingToken)
+}
+
+// OnInitialize0 sets a hook run by every Initialize0 call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnInitialize0(hook func(opts *bind.TransactOpts, _underlyingToken common.Address) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("initialize0", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("pauseAll", hook)
+}
+
+// SetTVLLimits records a setTVLLimits call after running the hook set with OnSetTVLLimits.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) SetTVLLimits(opts *bind.TransactOpts, newMaxPerDeposit *big.Int, newMaxTotalDeposits *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "setTVLLimits", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("setTVLLimits").(func(opts *bind.TransactOpts, newMaxPerDeposit *big.Int, newMaxTotalDeposits *big.Int) error); ok {
+			return hook(opts, newMaxPerDeposit, newMaxTotalDeposits)
+		}
+		return nil
+	}, newMaxPerDeposit, newMaxTotalDeposits)
+}
+
+// OnSetTVLLimits sets a hook run by every SetTVLLimits call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnSetTVLLimits(hook func(opts *bind.TransactOpts, newMaxPerDeposit *big.Int, newMaxTotalDeposits *big.Int) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("setTVLLimits", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("unpause", hook)
+}
+
+// UserUnderlying records a userUnderlying call after running the hook set with OnUserUnderlying.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "userUnderlying", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("userUnderlying").(func(opts *bind.TransactOpts, user common.Address) error); ok {
+			return hook(opts, user)
+		}
+		return nil
+	}, user)
+}
+
+// OnUserUnderlying sets a hook run by every UserUnderlying call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnUserUnderlying(hook func(opts *bind.TransactOpts, user common.Address) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("userUnderlying", hook)
+}
+
+// Withdraw records a withdraw call after running the hook set with OnWithdraw.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyBaseTVLLimits.Contract.Transact(opts, "withdraw", func() error {
+		if hook, ok := _FakeStrategyBaseTVLLimits.Contract.Hook("withdraw").(func(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) error); ok {
+			return hook(opts, recipient, token, amountShares)
+		}
+		return nil
+	}, recipient, token, amountShares)
+}
+
+// OnWithdraw sets a hook run by every Withdraw call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) OnWithdraw(hook func(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) error) {
+	_FakeStrategyBaseTVLLimits.Contract.SetHook("withdraw", hook)
+}
+
+// EmitExchangeRateEmitted appends the event to the fake's logs.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) EmitExchangeRateEmitted(event *StrategyBaseTVLLimitsExchangeRateEmitted) {
+	_FakeStrategyBaseTVLLimits.Contract.Emit("ExchangeRateEmitted", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) EmitInitialized(event *StrategyBaseTVLLimitsInitialized) {
+	_FakeStrategyBaseTVLLimits.Contract.Emit("Initialized", event)
+}
+
+// EmitMaxPerDepositUpdated appends the event to the fake's logs.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) EmitMaxPerDepositUpdated(event *StrategyBaseTVLLimitsMaxPerDepositUpdated) {
+	_FakeStrategyBaseTVLLimits.Contract.Emit("MaxPerDepositUpdated", event)
+}
+
+// EmitMaxTotalDepositsUpdated appends the event to the fake's logs.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) EmitMaxTotalDepositsUpdated(event *StrategyBaseTVLLimitsMaxTotalDepositsUpdated) {
+	_FakeStrategyBaseTVLLimits.Contract.Emit("MaxTotalDepositsUpdated", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) EmitPaused(event *StrategyBaseTVLLimitsPaused) {
+	_FakeStrategyBaseTVLLimits.Contract.Emit("Paused", event)
+}
+
+// EmitStrategyTokenSet appends the event to the fake's logs.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) EmitStrategyTokenSet(event *StrategyBaseTVLLimitsStrategyTokenSet) {
+	_FakeStrategyBaseTVLLimits.Contract.Emit("StrategyTokenSet", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeStrategyBaseTVLLimits *FakeStrategyBaseTVLLimits) EmitUnpaused(event *StrategyBaseTVLLimitsUnpaused) {
+	_FakeStrategyBaseTVLLimits.Contract.Emit("Unpaused", event)
+}
@@ -0,0 +1,84 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyBaseTVLLimits
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// StrategyBaseTVLLimitsReader is the read-only surface of the StrategyBaseTVLLimits
+// binding, implemented by StrategyBaseTVLLimitsCaller, StrategyBaseTVLLimits and FakeStrategyBaseTVLLimits.
+type StrategyBaseTVLLimitsReader interface {
+	Explanation(opts *bind.CallOpts) (string, error)
+	GetTVLLimits(opts *bind.CallOpts) (*big.Int, *big.Int, error)
+	MaxPerDeposit(opts *bind.CallOpts) (*big.Int, error)
+	MaxTotalDeposits(opts *bind.CallOpts) (*big.Int, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	Shares(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+	SharesToUnderlying(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	SharesToUnderlyingView(opts *bind.CallOpts, amountShares *big.Int) (*big.Int, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+	TotalShares(opts *bind.CallOpts) (*big.Int, error)
+	UnderlyingToShares(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToSharesView(opts *bind.CallOpts, amountUnderlying *big.Int) (*big.Int, error)
+	UnderlyingToken(opts *bind.CallOpts) (common.Address, error)
+	UserUnderlyingView(opts *bind.CallOpts, user common.Address) (*big.Int, error)
+}
+
+// StrategyBaseTVLLimitsWriter is the transacting surface of the StrategyBaseTVLLimits
+// binding, implemented by StrategyBaseTVLLimitsTransactor, StrategyBaseTVLLimits and FakeStrategyBaseTVLLimits.
+type StrategyBaseTVLLimitsWriter interface {
+	Deposit(opts *bind.TransactOpts, token common.Address, amount *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _maxPerDeposit *big.Int, _maxTotalDeposits *big.Int, _underlyingToken common.Address) (*types.Transaction, error)
+	Initialize0(opts *bind.TransactOpts, _underlyingToken common.Address) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetTVLLimits(opts *bind.TransactOpts, newMaxPerDeposit *big.Int, newMaxTotalDeposits *big.Int) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	UserUnderlying(opts *bind.TransactOpts, user common.Address) (*types.Transaction, error)
+	Withdraw(opts *bind.TransactOpts, recipient common.Address, token common.Address, amountShares *big.Int) (*types.Transaction, error)
+}
+
+// StrategyBaseTVLLimitsEvents is the event filtering surface of the StrategyBaseTVLLimits
+// binding, implemented by StrategyBaseTVLLimitsFilterer, StrategyBaseTVLLimits and FakeStrategyBaseTVLLimits.
+type StrategyBaseTVLLimitsEvents interface {
+	FilterExchangeRateEmitted(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsExchangeRateEmittedIterator, error)
+	WatchExchangeRateEmitted(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsExchangeRateEmitted) (event.Subscription, error)
+	ParseExchangeRateEmitted(log types.Log) (*StrategyBaseTVLLimitsExchangeRateEmitted, error)
+	FilterInitialized(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*StrategyBaseTVLLimitsInitialized, error)
+	FilterMaxPerDepositUpdated(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsMaxPerDepositUpdatedIterator, error)
+	WatchMaxPerDepositUpdated(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsMaxPerDepositUpdated) (event.Subscription, error)
+	ParseMaxPerDepositUpdated(log types.Log) (*StrategyBaseTVLLimitsMaxPerDepositUpdated, error)
+	FilterMaxTotalDepositsUpdated(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsMaxTotalDepositsUpdatedIterator, error)
+	WatchMaxTotalDepositsUpdated(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsMaxTotalDepositsUpdated) (event.Subscription, error)
+	ParseMaxTotalDepositsUpdated(log types.Log) (*StrategyBaseTVLLimitsMaxTotalDepositsUpdated, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*StrategyBaseTVLLimitsPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*StrategyBaseTVLLimitsPaused, error)
+	FilterStrategyTokenSet(opts *bind.FilterOpts) (*StrategyBaseTVLLimitsStrategyTokenSetIterator, error)
+	WatchStrategyTokenSet(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsStrategyTokenSet) (event.Subscription, error)
+	ParseStrategyTokenSet(log types.Log) (*StrategyBaseTVLLimitsStrategyTokenSet, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*StrategyBaseTVLLimitsUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *StrategyBaseTVLLimitsUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*StrategyBaseTVLLimitsUnpaused, error)
+}
+
+var (
+	_ StrategyBaseTVLLimitsReader = (*StrategyBaseTVLLimitsCaller)(nil)
+	_ StrategyBaseTVLLimitsReader = (*StrategyBaseTVLLimits)(nil)
+	_ StrategyBaseTVLLimitsReader = (*FakeStrategyBaseTVLLimits)(nil)
+	_ StrategyBaseTVLLimitsWriter = (*StrategyBaseTVLLimitsTransactor)(nil)
+	_ StrategyBaseTVLLimitsWriter = (*StrategyBaseTVLLimits)(nil)
+	_ StrategyBaseTVLLimitsWriter = (*FakeStrategyBaseTVLLimits)(nil)
+	_ StrategyBaseTVLLimitsEvents = (*StrategyBaseTVLLimitsFilterer)(nil)
+	_ StrategyBaseTVLLimitsEvents = (*StrategyBaseTVLLimits)(nil)
+	_ StrategyBaseTVLLimitsEvents = (*FakeStrategyBaseTVLLimits)(nil)
+)
@@ -0,0 +1,79 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyFactory
+
+// ErrAlreadyBlacklisted is the typed form of the StrategyFactory custom error AlreadyBlacklisted().
+type ErrAlreadyBlacklisted struct{}
+
+// Error implements the error interface.
+func (ErrAlreadyBlacklisted) Error() string {
+	return "StrategyFactory: AlreadyBlacklisted()"
+}
+
+// ErrBlacklistedToken is the typed form of the StrategyFactory custom error BlacklistedToken().
+type ErrBlacklistedToken struct{}
+
+// Error implements the error interface.
+func (ErrBlacklistedToken) Error() string {
+	return "StrategyFactory: BlacklistedToken()"
+}
+
+// ErrCurrentlyPaused is the typed form of the StrategyFactory custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "StrategyFactory: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the StrategyFactory custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "StrategyFactory: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the StrategyFactory custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "StrategyFactory: InvalidNewPausedStatus()"
+}
+
+// ErrOnlyPauser is the typed form of the StrategyFactory custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "StrategyFactory: OnlyPauser()"
+}
+
+// ErrOnlyUnpauser is the typed form of the StrategyFactory custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "StrategyFactory: OnlyUnpauser()"
+}
+
+// ErrStrategyAlreadyExists is the typed form of the StrategyFactory custom error StrategyAlreadyExists().
+type ErrStrategyAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyExists) Error() string {
+	return "StrategyFactory: StrategyAlreadyExists()"
+}
+
+// StrategyFactoryErrors maps the name of every custom error in the StrategyFactory ABI to its typed Go error.
+var StrategyFactoryErrors = map[string]error{
+	"AlreadyBlacklisted":     ErrAlreadyBlacklisted{},
+	"BlacklistedToken":       ErrBlacklistedToken{},
+	"CurrentlyPaused":        ErrCurrentlyPaused{},
+	"InputAddressZero":       ErrInputAddressZero{},
+	"InvalidNewPausedStatus": ErrInvalidNewPausedStatus{},
+	"OnlyPauser":             ErrOnlyPauser{},
+	"OnlyUnpauser":           ErrOnlyUnpauser{},
+	"StrategyAlreadyExists":  ErrStrategyAlreadyExists{},
+}
@@ -0,0 +1,321 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyFactory
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeStrategyFactory is an in-memory StrategyFactory for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded StrategyFactoryFilterer.
+type FakeStrategyFactory struct {
+	*fake.Contract
+	*StrategyFactoryFilterer
+}
+
+// NewFakeStrategyFactory returns an empty fake of the contract at address.
+func NewFakeStrategyFactory(address common.Address) *FakeStrategyFactory {
+	contract := fake.NewContract(StrategyFactoryMetaData, address)
+	filterer, err := NewStrategyFactoryFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeStrategyFactory{Contract: contract, StrategyFactoryFilterer: filterer}
+}
+
+// DeployedStrategies returns the values stubbed with StubDeployedStrategies.
+func (_FakeStrategyFactory *FakeStrategyFactory) DeployedStrategies(opts *bind.CallOpts, arg0 common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyFactory.Contract.Read("deployedStrategies", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubDeployedStrategies sets what DeployedStrategies returns for the given arguments.
+func (_FakeStrategyFactory *FakeStrategyFactory) StubDeployedStrategies(arg0 common.Address, out0 common.Address) {
+	_FakeStrategyFactory.Contract.Stub("deployedStrategies", []interface{}{arg0}, out0)
+}
+
+// IsBlacklisted returns the values stubbed with StubIsBlacklisted.
+func (_FakeStrategyFactory *FakeStrategyFactory) IsBlacklisted(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeStrategyFactory.Contract.Read("isBlacklisted", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubIsBlacklisted sets what IsBlacklisted returns for the given arguments.
+func (_FakeStrategyFactory *FakeStrategyFactory) StubIsBlacklisted(arg0 common.Address, out0 bool) {
+	_FakeStrategyFactory.Contract.Stub("isBlacklisted", []interface{}{arg0}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeStrategyFactory *FakeStrategyFactory) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyFactory.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeStrategyFactory *FakeStrategyFactory) StubOwner(out0 common.Address) {
+	_FakeStrategyFactory.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeStrategyFactory *FakeStrategyFactory) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeStrategyFactory.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeStrategyFactory *FakeStrategyFactory) StubPaused(index uint8, out0 bool) {
+	_FakeStrategyFactory.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeStrategyFactory *FakeStrategyFactory) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyFactory.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeStrategyFactory *FakeStrategyFactory) StubPaused0(out0 *big.Int) {
+	_FakeStrategyFactory.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeStrategyFactory *FakeStrategyFactory) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyFactory.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeStrategyFactory *FakeStrategyFactory) StubPauserRegistry(out0 common.Address) {
+	_FakeStrategyFactory.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// StrategyBeacon returns the values stubbed with StubStrategyBeacon.
+func (_FakeStrategyFactory *FakeStrategyFactory) StrategyBeacon(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyFactory.Contract.Read("strategyBeacon", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyBeacon sets what StrategyBeacon returns for the given arguments.
+func (_FakeStrategyFactory *FakeStrategyFactory) StubStrategyBeacon(out0 common.Address) {
+	_FakeStrategyFactory.Contract.Stub("strategyBeacon", []interface{}{}, out0)
+}
+
+// StrategyManager returns the values stubbed with StubStrategyManager.
+func (_FakeStrategyFactory *FakeStrategyFactory) StrategyManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyFactory.Contract.Read("strategyManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyManager sets what StrategyManager returns for the given arguments.
+func (_FakeStrategyFactory *FakeStrategyFactory) StubStrategyManager(out0 common.Address) {
+	_FakeStrategyFactory.Contract.Stub("strategyManager", []interface{}{}, out0)
+}
+
+// BlacklistTokens records a blacklistTokens call after running the hook set with OnBlacklistTokens.
+func (_FakeStrategyFactory *FakeStrategyFactory) BlacklistTokens(opts *bind.TransactOpts, tokens []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "blacklistTokens", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("blacklistTokens").(func(opts *bind.TransactOpts, tokens []common.Address) error); ok {
+			return hook(opts, tokens)
+		}
+		return nil
+	}, tokens)
+}
+
+// OnBlacklistTokens sets a hook run by every BlacklistTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnBlacklistTokens(hook func(opts *bind.TransactOpts, tokens []common.Address) error) {
+	_FakeStrategyFactory.Contract.SetHook("blacklistTokens", hook)
+}
+
+// DeployNewStrategy records a deployNewStrategy call after running the hook set with OnDeployNewStrategy.
+func (_FakeStrategyFactory *FakeStrategyFactory) DeployNewStrategy(opts *bind.TransactOpts, token common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "deployNewStrategy", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("deployNewStrategy").(func(opts *bind.TransactOpts, token common.Address) error); ok {
+			return hook(opts, token)
+		}
+		return nil
+	}, token)
+}
+
+// OnDeployNewStrategy sets a hook run by every DeployNewStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnDeployNewStrategy(hook func(opts *bind.TransactOpts, token common.Address) error) {
+	_FakeStrategyFactory.Contract.SetHook("deployNewStrategy", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeStrategyFactory *FakeStrategyFactory) Initialize(opts *bind.TransactOpts, _initialOwner common.Address, _initialPausedStatus *big.Int, _strategyBeacon common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("initialize").(func(opts *bind.TransactOpts, _initialOwner common.Address, _initialPausedStatus *big.Int, _strategyBeacon common.Address) error); ok {
+			return hook(opts, _initialOwner, _initialPausedStatus, _strategyBeacon)
+		}
+		return nil
+	}, _initialOwner, _initialPausedStatus, _strategyBeacon)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnInitialize(hook func(opts *bind.TransactOpts, _initialOwner common.Address, _initialPausedStatus *big.Int, _strategyBeacon common.Address) error) {
+	_FakeStrategyFactory.Contract.SetHook("initialize", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeStrategyFactory *FakeStrategyFactory) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeStrategyFactory.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeStrategyFactory *FakeStrategyFactory) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeStrategyFactory.Contract.SetHook("pauseAll", hook)
+}
+
+// RemoveStrategiesFromWhitelist records a removeStrategiesFromWhitelist call after running the hook set with OnRemoveStrategiesFromWhitelist.
+func (_FakeStrategyFactory *FakeStrategyFactory) RemoveStrategiesFromWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "removeStrategiesFromWhitelist", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("removeStrategiesFromWhitelist").(func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToRemoveFromWhitelist)
+		}
+		return nil
+	}, strategiesToRemoveFromWhitelist)
+}
+
+// OnRemoveStrategiesFromWhitelist sets a hook run by every RemoveStrategiesFromWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnRemoveStrategiesFromWhitelist(hook func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error) {
+	_FakeStrategyFactory.Contract.SetHook("removeStrategiesFromWhitelist", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeStrategyFactory *FakeStrategyFactory) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeStrategyFactory.Contract.SetHook("renounceOwnership", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeStrategyFactory *FakeStrategyFactory) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeStrategyFactory.Contract.SetHook("transferOwnership", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeStrategyFactory *FakeStrategyFactory) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeStrategyFactory.Contract.SetHook("unpause", hook)
+}
+
+// WhitelistStrategies records a whitelistStrategies call after running the hook set with OnWhitelistStrategies.
+func (_FakeStrategyFactory *FakeStrategyFactory) WhitelistStrategies(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactory.Contract.Transact(opts, "whitelistStrategies", func() error {
+		if hook, ok := _FakeStrategyFactory.Contract.Hook("whitelistStrategies").(func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToWhitelist)
+		}
+		return nil
+	}, strategiesToWhitelist)
+}
+
+// OnWhitelistStrategies sets a hook run by every WhitelistStrategies call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactory *FakeStrategyFactory) OnWhitelistStrategies(hook func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error) {
+	_FakeStrategyFactory.Contract.SetHook("whitelistStrategies", hook)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeStrategyFactory *FakeStrategyFactory) EmitInitialized(event *StrategyFactoryInitialized) {
+	_FakeStrategyFactory.Contract.Emit("Initialized", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeStrategyFactory *FakeStrategyFactory) EmitOwnershipTransferred(event *StrategyFactoryOwnershipTransferred) {
+	_FakeStrategyFactory.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeStrategyFactory *FakeStrategyFactory) EmitPaused(event *StrategyFactoryPaused) {
+	_FakeStrategyFactory.Contract.Emit("Paused", event)
+}
+
+// EmitStrategyBeaconModified appends the event to the fake's logs.
+func (_FakeStrategyFactory *FakeStrategyFactory) EmitStrategyBeaconModified(event *StrategyFactoryStrategyBeaconModified) {
+	_FakeStrategyFactory.Contract.Emit("StrategyBeaconModified", event)
+}
+
+// EmitStrategySetForToken appends the event to the fake's logs.
+func (_FakeStrategyFactory *FakeStrategyFactory) EmitStrategySetForToken(event *StrategyFactoryStrategySetForToken) {
+	_FakeStrategyFactory.Contract.Emit("StrategySetForToken", event)
+}
+
+// EmitTokenBlacklisted appends the event to the fake's logs.
+func (_FakeStrategyFactory *FakeStrategyFactory) EmitTokenBlacklisted(event *StrategyFactoryTokenBlacklisted) {
+	_FakeStrategyFactory.Contract.Emit("TokenBlacklisted", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeStrategyFactory *FakeStrategyFactory) EmitUnpaused(event *StrategyFactoryUnpaused) {
+	_FakeStrategyFactory.Contract.Emit("Unpaused", event)
+}
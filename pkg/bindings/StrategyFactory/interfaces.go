@@ -0,0 +1,77 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyFactory
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// StrategyFactoryReader is the read-only surface of the StrategyFactory
+// binding, implemented by StrategyFactoryCaller, StrategyFactory and FakeStrategyFactory.
+type StrategyFactoryReader interface {
+	DeployedStrategies(opts *bind.CallOpts, arg0 common.Address) (common.Address, error)
+	IsBlacklisted(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+	Paused(opts *bind.CallOpts, index uint8) (bool, error)
+	Paused0(opts *bind.CallOpts) (*big.Int, error)
+	PauserRegistry(opts *bind.CallOpts) (common.Address, error)
+	StrategyBeacon(opts *bind.CallOpts) (common.Address, error)
+	StrategyManager(opts *bind.CallOpts) (common.Address, error)
+}
+
+// StrategyFactoryWriter is the transacting surface of the StrategyFactory
+// binding, implemented by StrategyFactoryTransactor, StrategyFactory and FakeStrategyFactory.
+type StrategyFactoryWriter interface {
+	BlacklistTokens(opts *bind.TransactOpts, tokens []common.Address) (*types.Transaction, error)
+	DeployNewStrategy(opts *bind.TransactOpts, token common.Address) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _initialOwner common.Address, _initialPausedStatus *big.Int, _strategyBeacon common.Address) (*types.Transaction, error)
+	Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	PauseAll(opts *bind.TransactOpts) (*types.Transaction, error)
+	RemoveStrategiesFromWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+	Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error)
+	WhitelistStrategies(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error)
+}
+
+// StrategyFactoryEvents is the event filtering surface of the StrategyFactory
+// binding, implemented by StrategyFactoryFilterer, StrategyFactory and FakeStrategyFactory.
+type StrategyFactoryEvents interface {
+	FilterInitialized(opts *bind.FilterOpts) (*StrategyFactoryInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *StrategyFactoryInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*StrategyFactoryInitialized, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*StrategyFactoryOwnershipTransferredIterator, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *StrategyFactoryOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	ParseOwnershipTransferred(log types.Log) (*StrategyFactoryOwnershipTransferred, error)
+	FilterPaused(opts *bind.FilterOpts, account []common.Address) (*StrategyFactoryPausedIterator, error)
+	WatchPaused(opts *bind.WatchOpts, sink chan<- *StrategyFactoryPaused, account []common.Address) (event.Subscription, error)
+	ParsePaused(log types.Log) (*StrategyFactoryPaused, error)
+	FilterStrategyBeaconModified(opts *bind.FilterOpts) (*StrategyFactoryStrategyBeaconModifiedIterator, error)
+	WatchStrategyBeaconModified(opts *bind.WatchOpts, sink chan<- *StrategyFactoryStrategyBeaconModified) (event.Subscription, error)
+	ParseStrategyBeaconModified(log types.Log) (*StrategyFactoryStrategyBeaconModified, error)
+	FilterStrategySetForToken(opts *bind.FilterOpts) (*StrategyFactoryStrategySetForTokenIterator, error)
+	WatchStrategySetForToken(opts *bind.WatchOpts, sink chan<- *StrategyFactoryStrategySetForToken) (event.Subscription, error)
+	ParseStrategySetForToken(log types.Log) (*StrategyFactoryStrategySetForToken, error)
+	FilterTokenBlacklisted(opts *bind.FilterOpts) (*StrategyFactoryTokenBlacklistedIterator, error)
+	WatchTokenBlacklisted(opts *bind.WatchOpts, sink chan<- *StrategyFactoryTokenBlacklisted) (event.Subscription, error)
+	ParseTokenBlacklisted(log types.Log) (*StrategyFactoryTokenBlacklisted, error)
+	FilterUnpaused(opts *bind.FilterOpts, account []common.Address) (*StrategyFactoryUnpausedIterator, error)
+	WatchUnpaused(opts *bind.WatchOpts, sink chan<- *StrategyFactoryUnpaused, account []common.Address) (event.Subscription, error)
+	ParseUnpaused(log types.Log) (*StrategyFactoryUnpaused, error)
+}
+
+var (
+	_ StrategyFactoryReader = (*StrategyFactoryCaller)(nil)
+	_ StrategyFactoryReader = (*StrategyFactory)(nil)
+	_ StrategyFactoryReader = (*FakeStrategyFactory)(nil)
+	_ StrategyFactoryWriter = (*StrategyFactoryTransactor)(nil)
+	_ StrategyFactoryWriter = (*StrategyFactory)(nil)
+	_ StrategyFactoryWriter = (*FakeStrategyFactory)(nil)
+	_ StrategyFactoryEvents = (*StrategyFactoryFilterer)(nil)
+	_ StrategyFactoryEvents = (*StrategyFactory)(nil)
+	_ StrategyFactoryEvents = (*FakeStrategyFactory)(nil)
+)
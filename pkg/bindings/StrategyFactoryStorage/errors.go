@@ -0,0 +1,34 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyFactoryStorage
+
+// ErrAlreadyBlacklisted is the typed form of the StrategyFactoryStorage custom error AlreadyBlacklisted().
+type ErrAlreadyBlacklisted struct{}
+
+// Error implements the error interface.
+func (ErrAlreadyBlacklisted) Error() string {
+	return "StrategyFactoryStorage: AlreadyBlacklisted()"
+}
+
+// ErrBlacklistedToken is the typed form of the StrategyFactoryStorage custom error BlacklistedToken().
+type ErrBlacklistedToken struct{}
+
+// Error implements the error interface.
+func (ErrBlacklistedToken) Error() string {
+	return "StrategyFactoryStorage: BlacklistedToken()"
+}
+
+// ErrStrategyAlreadyExists is the typed form of the StrategyFactoryStorage custom error StrategyAlreadyExists().
+type ErrStrategyAlreadyExists struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyExists) Error() string {
+	return "StrategyFactoryStorage: StrategyAlreadyExists()"
+}
+
+// StrategyFactoryStorageErrors maps the name of every custom error in the StrategyFactoryStorage ABI to its typed Go error.
+var StrategyFactoryStorageErrors = map[string]error{
+	"AlreadyBlacklisted":    ErrAlreadyBlacklisted{},
+	"BlacklistedToken":      ErrBlacklistedToken{},
+	"StrategyAlreadyExists": ErrStrategyAlreadyExists{},
+}
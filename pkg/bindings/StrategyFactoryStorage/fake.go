@@ -0,0 +1,128 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyFactoryStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FakeStrategyFactoryStorage is an in-memory StrategyFactoryStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded StrategyFactoryStorageFilterer.
+type FakeStrategyFactoryStorage struct {
+	*fake.Contract
+	*StrategyFactoryStorageFilterer
+}
+
+// NewFakeStrategyFactoryStorage returns an empty fake of the contract at address.
+func NewFakeStrategyFactoryStorage(address common.Address) *FakeStrategyFactoryStorage {
+	contract := fake.NewContract(StrategyFactoryStorageMetaData, address)
+	filterer, err := NewStrategyFactoryStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeStrategyFactoryStorage{Contract: contract, StrategyFactoryStorageFilterer: filterer}
+}
+
+// DeployedStrategies returns the values stubbed with StubDeployedStrategies.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) DeployedStrategies(opts *bind.CallOpts, arg0 common.Address) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyFactoryStorage.Contract.Read("deployedStrategies", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubDeployedStrategies sets what DeployedStrategies returns for the given arguments.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) StubDeployedStrategies(arg0 common.Address, out0 common.Address) {
+	_FakeStrategyFactoryStorage.Contract.Stub("deployedStrategies", []interface{}{arg0}, out0)
+}
+
+// IsBlacklisted returns the values stubbed with StubIsBlacklisted.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) IsBlacklisted(opts *bind.CallOpts, arg0 common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeStrategyFactoryStorage.Contract.Read("isBlacklisted", []interface{}{arg0}, &out0)
+	return out0, err
+}
+
+// StubIsBlacklisted sets what IsBlacklisted returns for the given arguments.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) StubIsBlacklisted(arg0 common.Address, out0 bool) {
+	_FakeStrategyFactoryStorage.Contract.Stub("isBlacklisted", []interface{}{arg0}, out0)
+}
+
+// StrategyBeacon returns the values stubbed with StubStrategyBeacon.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) StrategyBeacon(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyFactoryStorage.Contract.Read("strategyBeacon", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyBeacon sets what StrategyBeacon returns for the given arguments.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) StubStrategyBeacon(out0 common.Address) {
+	_FakeStrategyFactoryStorage.Contract.Stub("strategyBeacon", []interface{}{}, out0)
+}
+
+// DeployNewStrategy records a deployNewStrategy call after running the hook set with OnDeployNewStrategy.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) DeployNewStrategy(opts *bind.TransactOpts, token common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactoryStorage.Contract.Transact(opts, "deployNewStrategy", func() error {
+		if hook, ok := _FakeStrategyFactoryStorage.Contract.Hook("deployNewStrategy").(func(opts *bind.TransactOpts, token common.Address) error); ok {
+			return hook(opts, token)
+		}
+		return nil
+	}, token)
+}
+
+// OnDeployNewStrategy sets a hook run by every DeployNewStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) OnDeployNewStrategy(hook func(opts *bind.TransactOpts, token common.Address) error) {
+	_FakeStrategyFactoryStorage.Contract.SetHook("deployNewStrategy", hook)
+}
+
+// RemoveStrategiesFromWhitelist records a removeStrategiesFromWhitelist call after running the hook set with OnRemoveStrategiesFromWhitelist.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) RemoveStrategiesFromWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactoryStorage.Contract.Transact(opts, "removeStrategiesFromWhitelist", func() error {
+		if hook, ok := _FakeStrategyFactoryStorage.Contract.Hook("removeStrategiesFromWhitelist").(func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToRemoveFromWhitelist)
+		}
+		return nil
+	}, strategiesToRemoveFromWhitelist)
+}
+
+// OnRemoveStrategiesFromWhitelist sets a hook run by every RemoveStrategiesFromWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) OnRemoveStrategiesFromWhitelist(hook func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error) {
+	_FakeStrategyFactoryStorage.Contract.SetHook("removeStrategiesFromWhitelist", hook)
+}
+
+// WhitelistStrategies records a whitelistStrategies call after running the hook set with OnWhitelistStrategies.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) WhitelistStrategies(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyFactoryStorage.Contract.Transact(opts, "whitelistStrategies", func() error {
+		if hook, ok := _FakeStrategyFactoryStorage.Contract.Hook("whitelistStrategies").(func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToWhitelist)
+		}
+		return nil
+	}, strategiesToWhitelist)
+}
+
+// OnWhitelistStrategies sets a hook run by every WhitelistStrategies call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) OnWhitelistStrategies(hook func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error) {
+	_FakeStrategyFactoryStorage.Contract.SetHook("whitelistStrategies", hook)
+}
+
+// EmitStrategyBeaconModified appends the event to the fake's logs.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) EmitStrategyBeaconModified(event *StrategyFactoryStorageStrategyBeaconModified) {
+	_FakeStrategyFactoryStorage.Contract.Emit("StrategyBeaconModified", event)
+}
+
+// EmitStrategySetForToken appends the event to the fake's logs.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) EmitStrategySetForToken(event *StrategyFactoryStorageStrategySetForToken) {
+	_FakeStrategyFactoryStorage.Contract.Emit("StrategySetForToken", event)
+}
+
+// EmitTokenBlacklisted appends the event to the fake's logs.
+func (_FakeStrategyFactoryStorage *FakeStrategyFactoryStorage) EmitTokenBlacklisted(event *StrategyFactoryStorageTokenBlacklisted) {
+	_FakeStrategyFactoryStorage.Contract.Emit("TokenBlacklisted", event)
+}
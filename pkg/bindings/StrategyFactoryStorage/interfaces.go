@@ -0,0 +1,52 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyFactoryStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// StrategyFactoryStorageReader is the read-only surface of the StrategyFactoryStorage
+// binding, implemented by StrategyFactoryStorageCaller, StrategyFactoryStorage and FakeStrategyFactoryStorage.
+type StrategyFactoryStorageReader interface {
+	DeployedStrategies(opts *bind.CallOpts, arg0 common.Address) (common.Address, error)
+	IsBlacklisted(opts *bind.CallOpts, arg0 common.Address) (bool, error)
+	StrategyBeacon(opts *bind.CallOpts) (common.Address, error)
+}
+
+// StrategyFactoryStorageWriter is the transacting surface of the StrategyFactoryStorage
+// binding, implemented by StrategyFactoryStorageTransactor, StrategyFactoryStorage and FakeStrategyFactoryStorage.
+type StrategyFactoryStorageWriter interface {
+	DeployNewStrategy(opts *bind.TransactOpts, token common.Address) (*types.Transaction, error)
+	RemoveStrategiesFromWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error)
+	WhitelistStrategies(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error)
+}
+
+// StrategyFactoryStorageEvents is the event filtering surface of the StrategyFactoryStorage
+// binding, implemented by StrategyFactoryStorageFilterer, StrategyFactoryStorage and FakeStrategyFactoryStorage.
+type StrategyFactoryStorageEvents interface {
+	FilterStrategyBeaconModified(opts *bind.FilterOpts) (*StrategyFactoryStorageStrategyBeaconModifiedIterator, error)
+	WatchStrategyBeaconModified(opts *bind.WatchOpts, sink chan<- *StrategyFactoryStorageStrategyBeaconModified) (event.Subscription, error)
+	ParseStrategyBeaconModified(log types.Log) (*StrategyFactoryStorageStrategyBeaconModified, error)
+	FilterStrategySetForToken(opts *bind.FilterOpts) (*StrategyFactoryStorageStrategySetForTokenIterator, error)
+	WatchStrategySetForToken(opts *bind.WatchOpts, sink chan<- *StrategyFactoryStorageStrategySetForToken) (event.Subscription, error)
+	ParseStrategySetForToken(log types.Log) (*StrategyFactoryStorageStrategySetForToken, error)
+	FilterTokenBlacklisted(opts *bind.FilterOpts) (*StrategyFactoryStorageTokenBlacklistedIterator, error)
+	WatchTokenBlacklisted(opts *bind.WatchOpts, sink chan<- *StrategyFactoryStorageTokenBlacklisted) (event.Subscription, error)
+	ParseTokenBlacklisted(log types.Log) (*StrategyFactoryStorageTokenBlacklisted, error)
+}
+
+var (
+	_ StrategyFactoryStorageReader = (*StrategyFactoryStorageCaller)(nil)
+	_ StrategyFactoryStorageReader = (*StrategyFactoryStorage)(nil)
+	_ StrategyFactoryStorageReader = (*FakeStrategyFactoryStorage)(nil)
+	_ StrategyFactoryStorageWriter = (*StrategyFactoryStorageTransactor)(nil)
+	_ StrategyFactoryStorageWriter = (*StrategyFactoryStorage)(nil)
+	_ StrategyFactoryStorageWriter = (*FakeStrategyFactoryStorage)(nil)
+	_ StrategyFactoryStorageEvents = (*StrategyFactoryStorageFilterer)(nil)
+	_ StrategyFactoryStorageEvents = (*StrategyFactoryStorage)(nil)
+	_ StrategyFactoryStorageEvents = (*FakeStrategyFactoryStorage)(nil)
+)
@@ -0,0 +1,175 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyManager
+
+import (
+	"fmt"
+)
+
+// ErrCurrentlyPaused is the typed form of the StrategyManager custom error CurrentlyPaused().
+type ErrCurrentlyPaused struct{}
+
+// Error implements the error interface.
+func (ErrCurrentlyPaused) Error() string {
+	return "StrategyManager: CurrentlyPaused()"
+}
+
+// ErrInputAddressZero is the typed form of the StrategyManager custom error InputAddressZero().
+type ErrInputAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInputAddressZero) Error() string {
+	return "StrategyManager: InputAddressZero()"
+}
+
+// ErrInvalidNewPausedStatus is the typed form of the StrategyManager custom error InvalidNewPausedStatus().
+type ErrInvalidNewPausedStatus struct{}
+
+// Error implements the error interface.
+func (ErrInvalidNewPausedStatus) Error() string {
+	return "StrategyManager: InvalidNewPausedStatus()"
+}
+
+// ErrInvalidShortString is the typed form of the StrategyManager custom error InvalidShortString().
+type ErrInvalidShortString struct{}
+
+// Error implements the error interface.
+func (ErrInvalidShortString) Error() string {
+	return "StrategyManager: InvalidShortString()"
+}
+
+// ErrInvalidSignature is the typed form of the StrategyManager custom error InvalidSignature().
+type ErrInvalidSignature struct{}
+
+// Error implements the error interface.
+func (ErrInvalidSignature) Error() string {
+	return "StrategyManager: InvalidSignature()"
+}
+
+// ErrMaxStrategiesExceeded is the typed form of the StrategyManager custom error MaxStrategiesExceeded().
+type ErrMaxStrategiesExceeded struct{}
+
+// Error implements the error interface.
+func (ErrMaxStrategiesExceeded) Error() string {
+	return "StrategyManager: MaxStrategiesExceeded()"
+}
+
+// ErrOnlyDelegationManager is the typed form of the StrategyManager custom error OnlyDelegationManager().
+type ErrOnlyDelegationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyDelegationManager) Error() string {
+	return "StrategyManager: OnlyDelegationManager()"
+}
+
+// ErrOnlyPauser is the typed form of the StrategyManager custom error OnlyPauser().
+type ErrOnlyPauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyPauser) Error() string {
+	return "StrategyManager: OnlyPauser()"
+}
+
+// ErrOnlyStrategyWhitelister is the typed form of the StrategyManager custom error OnlyStrategyWhitelister().
+type ErrOnlyStrategyWhitelister struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyWhitelister) Error() string {
+	return "StrategyManager: OnlyStrategyWhitelister()"
+}
+
+// ErrOnlyUnpauser is the typed form of the StrategyManager custom error OnlyUnpauser().
+type ErrOnlyUnpauser struct{}
+
+// Error implements the error interface.
+func (ErrOnlyUnpauser) Error() string {
+	return "StrategyManager: OnlyUnpauser()"
+}
+
+// ErrSharesAmountTooHigh is the typed form of the StrategyManager custom error SharesAmountTooHigh().
+type ErrSharesAmountTooHigh struct{}
+
+// Error implements the error interface.
+func (ErrSharesAmountTooHigh) Error() string {
+	return "StrategyManager: SharesAmountTooHigh()"
+}
+
+// ErrSharesAmountZero is the typed form of the StrategyManager custom error SharesAmountZero().
+type ErrSharesAmountZero struct{}
+
+// Error implements the error interface.
+func (ErrSharesAmountZero) Error() string {
+	return "StrategyManager: SharesAmountZero()"
+}
+
+// ErrSignatureExpired is the typed form of the StrategyManager custom error SignatureExpired().
+type ErrSignatureExpired struct{}
+
+// Error implements the error interface.
+func (ErrSignatureExpired) Error() string {
+	return "StrategyManager: SignatureExpired()"
+}
+
+// ErrStakerAddressZero is the typed form of the StrategyManager custom error StakerAddressZero().
+type ErrStakerAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrStakerAddressZero) Error() string {
+	return "StrategyManager: StakerAddressZero()"
+}
+
+// ErrStrategyAlreadyInSlash is the typed form of the StrategyManager custom error StrategyAlreadyInSlash().
+type ErrStrategyAlreadyInSlash struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyInSlash) Error() string {
+	return "StrategyManager: StrategyAlreadyInSlash()"
+}
+
+// ErrStrategyNotFound is the typed form of the StrategyManager custom error StrategyNotFound().
+type ErrStrategyNotFound struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotFound) Error() string {
+	return "StrategyManager: StrategyNotFound()"
+}
+
+// ErrStrategyNotWhitelisted is the typed form of the StrategyManager custom error StrategyNotWhitelisted().
+type ErrStrategyNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotWhitelisted) Error() string {
+	return "StrategyManager: StrategyNotWhitelisted()"
+}
+
+// ErrStringTooLong is the typed form of the StrategyManager custom error StringTooLong(string).
+type ErrStringTooLong struct {
+	Str string
+}
+
+// Error implements the error interface.
+func (e ErrStringTooLong) Error() string {
+	return fmt.Sprintf("StrategyManager: StringTooLong(str: %v)", e.Str)
+}
+
+// StrategyManagerErrors maps the name of every custom error in the StrategyManager ABI to its typed Go error.
+var StrategyManagerErrors = map[string]error{
+	"CurrentlyPaused":         ErrCurrentlyPaused{},
+	"InputAddressZero":        ErrInputAddressZero{},
+	"InvalidNewPausedStatus":  ErrInvalidNewPausedStatus{},
+	"InvalidShortString":      ErrInvalidShortString{},
+	"InvalidSignature":        ErrInvalidSignature{},
+	"MaxStrategiesExceeded":   ErrMaxStrategiesExceeded{},
+	"OnlyDelegationManager":   ErrOnlyDelegationManager{},
+	"OnlyPauser":              ErrOnlyPauser{},
+	"OnlyStrategyWhitelister": ErrOnlyStrategyWhitelister{},
+	"OnlyUnpauser":            ErrOnlyUnpauser{},
+	"SharesAmountTooHigh":     ErrSharesAmountTooHigh{},
+	"SharesAmountZero":        ErrSharesAmountZero{},
+	"SignatureExpired":        ErrSignatureExpired{},
+	"StakerAddressZero":       ErrStakerAddressZero{},
+	"StrategyAlreadyInSlash":  ErrStrategyAlreadyInSlash{},
+	"StrategyNotFound":        ErrStrategyNotFound{},
+	"StrategyNotWhitelisted":  ErrStrategyNotWhitelisted{},
+	"StringTooLong":           ErrStringTooLong{},
+}
@@ -0,0 +1,688 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyManager
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeStrategyManager is an in-memory StrategyManager for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded StrategyManagerFilterer.
+type FakeStrategyManager struct {
+	*fake.Contract
+	*StrategyManagerFilterer
+}
+
+// NewFakeStrategyManager returns an empty fake of the contract at address.
+func NewFakeStrategyManager(address common.Address) *FakeStrategyManager {
+	contract := fake.NewContract(StrategyManagerMetaData, address)
+	filterer, err := NewStrategyManagerFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeStrategyManager{Contract: contract, StrategyManagerFilterer: filterer}
+}
+
+// DEFAULTBURNADDRESS returns the values stubbed with StubDEFAULTBURNADDRESS.
+func (_FakeStrategyManager *FakeStrategyManager) DEFAULTBURNADDRESS(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManager.Contract.Read("DEFAULT_BURN_ADDRESS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEFAULTBURNADDRESS sets what DEFAULTBURNADDRESS returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubDEFAULTBURNADDRESS(out0 common.Address) {
+	_FakeStrategyManager.Contract.Stub("DEFAULT_BURN_ADDRESS", []interface{}{}, out0)
+}
+
+// DEPOSITTYPEHASH returns the values stubbed with StubDEPOSITTYPEHASH.
+func (_FakeStrategyManager *FakeStrategyManager) DEPOSITTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeStrategyManager.Contract.Read("DEPOSIT_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEPOSITTYPEHASH sets what DEPOSITTYPEHASH returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubDEPOSITTYPEHASH(out0 [32]byte) {
+	_FakeStrategyManager.Contract.Stub("DEPOSIT_TYPEHASH", []interface{}{}, out0)
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeStrategyManager *FakeStrategyManager) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManager.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubAllocationManager(out0 common.Address) {
+	_FakeStrategyManager.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// CalculateStrategyDepositDigestHash returns the values stubbed with StubCalculateStrategyDepositDigestHash.
+func (_FakeStrategyManager *FakeStrategyManager) CalculateStrategyDepositDigestHash(opts *bind.CallOpts, staker common.Address, strategy common.Address, token common.Address, amount *big.Int, nonce *big.Int, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeStrategyManager.Contract.Read("calculateStrategyDepositDigestHash", []interface{}{staker, strategy, token, amount, nonce, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateStrategyDepositDigestHash sets what CalculateStrategyDepositDigestHash returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubCalculateStrategyDepositDigestHash(staker common.Address, strategy common.Address, token common.Address, amount *big.Int, nonce *big.Int, expiry *big.Int, out0 [32]byte) {
+	_FakeStrategyManager.Contract.Stub("calculateStrategyDepositDigestHash", []interface{}{staker, strategy, token, amount, nonce, expiry}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeStrategyManager *FakeStrategyManager) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManager.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubDelegation(out0 common.Address) {
+	_FakeStrategyManager.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// DomainSeparator returns the values stubbed with StubDomainSeparator.
+func (_FakeStrategyManager *FakeStrategyManager) DomainSeparator(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeStrategyManager.Contract.Read("domainSeparator", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDomainSeparator sets what DomainSeparator returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubDomainSeparator(out0 [32]byte) {
+	_FakeStrategyManager.Contract.Stub("domainSeparator", []interface{}{}, out0)
+}
+
+// GetBurnOrRedistributableCount returns the values stubbed with StubGetBurnOrRedistributableCount.
+func (_FakeStrategyManager *FakeStrategyManager) GetBurnOrRedistributableCount(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManager.Contract.Read("getBurnOrRedistributableCount", []interface{}{operatorSet, slashId}, &out0)
+	return out0, err
+}
+
+// StubGetBurnOrRedistributableCount sets what GetBurnOrRedistributableCount returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetBurnOrRedistributableCount(operatorSet OperatorSet, slashId *big.Int, out0 *big.Int) {
+	_FakeStrategyManager.Contract.Stub("getBurnOrRedistributableCount", []interface{}{operatorSet, slashId}, out0)
+}
+
+// GetBurnOrRedistributableShares returns the values stubbed with StubGetBurnOrRedistributableShares.
+func (_FakeStrategyManager *FakeStrategyManager) GetBurnOrRedistributableShares(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeStrategyManager.Contract.Read("getBurnOrRedistributableShares", []interface{}{operatorSet, slashId}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetBurnOrRedistributableShares sets what GetBurnOrRedistributableShares returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetBurnOrRedistributableShares(operatorSet OperatorSet, slashId *big.Int, out0 []common.Address, out1 []*big.Int) {
+	_FakeStrategyManager.Contract.Stub("getBurnOrRedistributableShares", []interface{}{operatorSet, slashId}, out0, out1)
+}
+
+// GetBurnOrRedistributableShares0 returns the values stubbed with StubGetBurnOrRedistributableShares0.
+func (_FakeStrategyManager *FakeStrategyManager) GetBurnOrRedistributableShares0(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManager.Contract.Read("getBurnOrRedistributableShares0", []interface{}{operatorSet, slashId, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetBurnOrRedistributableShares0 sets what GetBurnOrRedistributableShares0 returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetBurnOrRedistributableShares0(operatorSet OperatorSet, slashId *big.Int, strategy common.Address, out0 *big.Int) {
+	_FakeStrategyManager.Contract.Stub("getBurnOrRedistributableShares0", []interface{}{operatorSet, slashId, strategy}, out0)
+}
+
+// GetBurnableShares returns the values stubbed with StubGetBurnableShares.
+func (_FakeStrategyManager *FakeStrategyManager) GetBurnableShares(opts *bind.CallOpts, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManager.Contract.Read("getBurnableShares", []interface{}{strategy}, &out0)
+	return out0, err
+}
+
+// StubGetBurnableShares sets what GetBurnableShares returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetBurnableShares(strategy common.Address, out0 *big.Int) {
+	_FakeStrategyManager.Contract.Stub("getBurnableShares", []interface{}{strategy}, out0)
+}
+
+// GetDeposits returns the values stubbed with StubGetDeposits.
+func (_FakeStrategyManager *FakeStrategyManager) GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeStrategyManager.Contract.Read("getDeposits", []interface{}{staker}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetDeposits sets what GetDeposits returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetDeposits(staker common.Address, out0 []common.Address, out1 []*big.Int) {
+	_FakeStrategyManager.Contract.Stub("getDeposits", []interface{}{staker}, out0, out1)
+}
+
+// GetPendingOperatorSets returns the values stubbed with StubGetPendingOperatorSets.
+func (_FakeStrategyManager *FakeStrategyManager) GetPendingOperatorSets(opts *bind.CallOpts) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeStrategyManager.Contract.Read("getPendingOperatorSets", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetPendingOperatorSets sets what GetPendingOperatorSets returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetPendingOperatorSets(out0 []OperatorSet) {
+	_FakeStrategyManager.Contract.Stub("getPendingOperatorSets", []interface{}{}, out0)
+}
+
+// GetPendingSlashIds returns the values stubbed with StubGetPendingSlashIds.
+func (_FakeStrategyManager *FakeStrategyManager) GetPendingSlashIds(opts *bind.CallOpts, operatorSet OperatorSet) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeStrategyManager.Contract.Read("getPendingSlashIds", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetPendingSlashIds sets what GetPendingSlashIds returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetPendingSlashIds(operatorSet OperatorSet, out0 []*big.Int) {
+	_FakeStrategyManager.Contract.Stub("getPendingSlashIds", []interface{}{operatorSet}, out0)
+}
+
+// GetStakerStrategyList returns the values stubbed with StubGetStakerStrategyList.
+func (_FakeStrategyManager *FakeStrategyManager) GetStakerStrategyList(opts *bind.CallOpts, staker common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeStrategyManager.Contract.Read("getStakerStrategyList", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetStakerStrategyList sets what GetStakerStrategyList returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetStakerStrategyList(staker common.Address, out0 []common.Address) {
+	_FakeStrategyManager.Contract.Stub("getStakerStrategyList", []interface{}{staker}, out0)
+}
+
+// GetStrategiesWithBurnableShares returns the values stubbed with StubGetStrategiesWithBurnableShares.
+func (_FakeStrategyManager *FakeStrategyManager) GetStrategiesWithBurnableShares(opts *bind.CallOpts) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeStrategyManager.Contract.Read("getStrategiesWithBurnableShares", []interface{}{}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetStrategiesWithBurnableShares sets what GetStrategiesWithBurnableShares returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubGetStrategiesWithBurnableShares(out0 []common.Address, out1 []*big.Int) {
+	_FakeStrategyManager.Contract.Stub("getStrategiesWithBurnableShares", []interface{}{}, out0, out1)
+}
+
+// Nonces returns the values stubbed with StubNonces.
+func (_FakeStrategyManager *FakeStrategyManager) Nonces(opts *bind.CallOpts, signer common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManager.Contract.Read("nonces", []interface{}{signer}, &out0)
+	return out0, err
+}
+
+// StubNonces sets what Nonces returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubNonces(signer common.Address, out0 *big.Int) {
+	_FakeStrategyManager.Contract.Stub("nonces", []interface{}{signer}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeStrategyManager *FakeStrategyManager) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManager.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubOwner(out0 common.Address) {
+	_FakeStrategyManager.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// Paused returns the values stubbed with StubPaused.
+func (_FakeStrategyManager *FakeStrategyManager) Paused(opts *bind.CallOpts, index uint8) (bool, error) {
+	var out0 bool
+	err := _FakeStrategyManager.Contract.Read("paused", []interface{}{index}, &out0)
+	return out0, err
+}
+
+// StubPaused sets what Paused returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubPaused(index uint8, out0 bool) {
+	_FakeStrategyManager.Contract.Stub("paused", []interface{}{index}, out0)
+}
+
+// Paused0 returns the values stubbed with StubPaused0.
+func (_FakeStrategyManager *FakeStrategyManager) Paused0(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManager.Contract.Read("paused0", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPaused0 sets what Paused0 returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubPaused0(out0 *big.Int) {
+	_FakeStrategyManager.Contract.Stub("paused0", []interface{}{}, out0)
+}
+
+// PauserRegistry returns the values stubbed with StubPauserRegistry.
+func (_FakeStrategyManager *FakeStrategyManager) PauserRegistry(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManager.Contract.Read("pauserRegistry", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubPauserRegistry sets what PauserRegistry returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubPauserRegistry(out0 common.Address) {
+	_FakeStrategyManager.Contract.Stub("pauserRegistry", []interface{}{}, out0)
+}
+
+// StakerDepositShares returns the values stubbed with StubStakerDepositShares.
+func (_FakeStrategyManager *FakeStrategyManager) StakerDepositShares(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManager.Contract.Read("stakerDepositShares", []interface{}{staker, strategy}, &out0)
+	return out0, err
+}
+
+// StubStakerDepositShares sets what StakerDepositShares returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubStakerDepositShares(staker common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeStrategyManager.Contract.Stub("stakerDepositShares", []interface{}{staker, strategy}, out0)
+}
+
+// StakerStrategyList returns the values stubbed with StubStakerStrategyList.
+func (_FakeStrategyManager *FakeStrategyManager) StakerStrategyList(opts *bind.CallOpts, staker common.Address, arg1 *big.Int) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManager.Contract.Read("stakerStrategyList", []interface{}{staker, arg1}, &out0)
+	return out0, err
+}
+
+// StubStakerStrategyList sets what StakerStrategyList returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubStakerStrategyList(staker common.Address, arg1 *big.Int, out0 common.Address) {
+	_FakeStrategyManager.Contract.Stub("stakerStrategyList", []interface{}{staker, arg1}, out0)
+}
+
+// StakerStrategyListLength returns the values stubbed with StubStakerStrategyListLength.
+func (_FakeStrategyManager *FakeStrategyManager) StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManager.Contract.Read("stakerStrategyListLength", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubStakerStrategyListLength sets what StakerStrategyListLength returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubStakerStrategyListLength(staker common.Address, out0 *big.Int) {
+	_FakeStrategyManager.Contract.Stub("stakerStrategyListLength", []interface{}{staker}, out0)
+}
+
+// StrategyIsWhitelistedForDeposit returns the values stubbed with StubStrategyIsWhitelistedForDeposit.
+func (_FakeStrategyManager *FakeStrategyManager) StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, strategy common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeStrategyManager.Contract.Read("strategyIsWhitelistedForDeposit", []interface{}{strategy}, &out0)
+	return out0, err
+}
+
+// StubStrategyIsWhitelistedForDeposit sets what StrategyIsWhitelistedForDeposit returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubStrategyIsWhitelistedForDeposit(strategy common.Address, out0 bool) {
+	_FakeStrategyManager.Contract.Stub("strategyIsWhitelistedForDeposit", []interface{}{strategy}, out0)
+}
+
+// StrategyWhitelister returns the values stubbed with StubStrategyWhitelister.
+func (_FakeStrategyManager *FakeStrategyManager) StrategyWhitelister(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManager.Contract.Read("strategyWhitelister", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyWhitelister sets what StrategyWhitelister returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubStrategyWhitelister(out0 common.Address) {
+	_FakeStrategyManager.Contract.Stub("strategyWhitelister", []interface{}{}, out0)
+}
+
+// Version returns the values stubbed with StubVersion.
+func (_FakeStrategyManager *FakeStrategyManager) Version(opts *bind.CallOpts) (string, error) {
+	var out0 string
+	err := _FakeStrategyManager.Contract.Read("version", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubVersion sets what Version returns for the given arguments.
+func (_FakeStrategyManager *FakeStrategyManager) StubVersion(out0 string) {
+	_FakeStrategyManager.Contract.Stub("version", []interface{}{}, out0)
+}
+
+// AddShares records a addShares call after running the hook set with OnAddShares.
+func (_FakeStrategyManager *FakeStrategyManager) AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "addShares", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("addShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, shares)
+		}
+		return nil
+	}, staker, strategy, shares)
+}
+
+// OnAddShares sets a hook run by every AddShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnAddShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("addShares", hook)
+}
+
+// AddStrategiesToDepositWhitelist records a addStrategiesToDepositWhitelist call after running the hook set with OnAddStrategiesToDepositWhitelist.
+func (_FakeStrategyManager *FakeStrategyManager) AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "addStrategiesToDepositWhitelist", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("addStrategiesToDepositWhitelist").(func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToWhitelist)
+		}
+		return nil
+	}, strategiesToWhitelist)
+}
+
+// OnAddStrategiesToDepositWhitelist sets a hook run by every AddStrategiesToDepositWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnAddStrategiesToDepositWhitelist(hook func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error) {
+	_FakeStrategyManager.Contract.SetHook("addStrategiesToDepositWhitelist", hook)
+}
+
+// BurnShares records a burnShares call after running the hook set with OnBurnShares.
+func (_FakeStrategyManager *FakeStrategyManager) BurnShares(opts *bind.TransactOpts, strategy common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "burnShares", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("burnShares").(func(opts *bind.TransactOpts, strategy common.Address) error); ok {
+			return hook(opts, strategy)
+		}
+		return nil
+	}, strategy)
+}
+
+// OnBurnShares sets a hook run by every BurnShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnBurnShares(hook func(opts *bind.TransactOpts, strategy common.Address) error) {
+	_FakeStrategyManager.Contract.SetHook("burnShares", hook)
+}
+
+// ClearBurnOrRedistributableShares records a clearBurnOrRedistributableShares call after running the hook set with OnClearBurnOrRedistributableShares.
+func (_FakeStrategyManager *FakeStrategyManager) ClearBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "clearBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("clearBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId)
+		}
+		return nil
+	}, operatorSet, slashId)
+}
+
+// OnClearBurnOrRedistributableShares sets a hook run by every ClearBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnClearBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("clearBurnOrRedistributableShares", hook)
+}
+
+// ClearBurnOrRedistributableSharesByStrategy records a clearBurnOrRedistributableSharesByStrategy call after running the hook set with OnClearBurnOrRedistributableSharesByStrategy.
+func (_FakeStrategyManager *FakeStrategyManager) ClearBurnOrRedistributableSharesByStrategy(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "clearBurnOrRedistributableSharesByStrategy", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("clearBurnOrRedistributableSharesByStrategy").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) error); ok {
+			return hook(opts, operatorSet, slashId, strategy)
+		}
+		return nil
+	}, operatorSet, slashId, strategy)
+}
+
+// OnClearBurnOrRedistributableSharesByStrategy sets a hook run by every ClearBurnOrRedistributableSharesByStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnClearBurnOrRedistributableSharesByStrategy(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) error) {
+	_FakeStrategyManager.Contract.SetHook("clearBurnOrRedistributableSharesByStrategy", hook)
+}
+
+// DepositIntoStrategy records a depositIntoStrategy call after running the hook set with OnDepositIntoStrategy.
+func (_FakeStrategyManager *FakeStrategyManager) DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "depositIntoStrategy", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("depositIntoStrategy").(func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) error); ok {
+			return hook(opts, strategy, token, amount)
+		}
+		return nil
+	}, strategy, token, amount)
+}
+
+// OnDepositIntoStrategy sets a hook run by every DepositIntoStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnDepositIntoStrategy(hook func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("depositIntoStrategy", hook)
+}
+
+// DepositIntoStrategyWithSignature records a depositIntoStrategyWithSignature call after running the hook set with OnDepositIntoStrategyWithSignature.
+func (_FakeStrategyManager *FakeStrategyManager) DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "depositIntoStrategyWithSignature", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("depositIntoStrategyWithSignature").(func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) error); ok {
+			return hook(opts, strategy, token, amount, staker, expiry, signature)
+		}
+		return nil
+	}, strategy, token, amount, staker, expiry, signature)
+}
+
+// OnDepositIntoStrategyWithSignature sets a hook run by every DepositIntoStrategyWithSignature call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnDepositIntoStrategyWithSignature(hook func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) error) {
+	_FakeStrategyManager.Contract.SetHook("depositIntoStrategyWithSignature", hook)
+}
+
+// IncreaseBurnOrRedistributableShares records a increaseBurnOrRedistributableShares call after running the hook set with OnIncreaseBurnOrRedistributableShares.
+func (_FakeStrategyManager *FakeStrategyManager) IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, sharesToBurn *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "increaseBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("increaseBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, sharesToBurn *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId, strategy, sharesToBurn)
+		}
+		return nil
+	}, operatorSet, slashId, strategy, sharesToBurn)
+}
+
+// OnIncreaseBurnOrRedistributableShares sets a hook run by every IncreaseBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnIncreaseBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, sharesToBurn *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("increaseBurnOrRedistributableShares", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeStrategyManager *FakeStrategyManager) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialOwner, initialStrategyWhitelister, initialPausedStatus)
+		}
+		return nil
+	}, initialOwner, initialStrategyWhitelister, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("initialize", hook)
+}
+
+// Pause records a pause call after running the hook set with OnPause.
+func (_FakeStrategyManager *FakeStrategyManager) Pause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "pause", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("pause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnPause sets a hook run by every Pause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnPause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("pause", hook)
+}
+
+// PauseAll records a pauseAll call after running the hook set with OnPauseAll.
+func (_FakeStrategyManager *FakeStrategyManager) PauseAll(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "pauseAll", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("pauseAll").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnPauseAll sets a hook run by every PauseAll call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnPauseAll(hook func(opts *bind.TransactOpts) error) {
+	_FakeStrategyManager.Contract.SetHook("pauseAll", hook)
+}
+
+// RemoveDepositShares records a removeDepositShares call after running the hook set with OnRemoveDepositShares.
+func (_FakeStrategyManager *FakeStrategyManager) RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "removeDepositShares", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("removeDepositShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error); ok {
+			return hook(opts, staker, strategy, depositSharesToRemove)
+		}
+		return nil
+	}, staker, strategy, depositSharesToRemove)
+}
+
+// OnRemoveDepositShares sets a hook run by every RemoveDepositShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnRemoveDepositShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("removeDepositShares", hook)
+}
+
+// RemoveStrategiesFromDepositWhitelist records a removeStrategiesFromDepositWhitelist call after running the hook set with OnRemoveStrategiesFromDepositWhitelist.
+func (_FakeStrategyManager *FakeStrategyManager) RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "removeStrategiesFromDepositWhitelist", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("removeStrategiesFromDepositWhitelist").(func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToRemoveFromWhitelist)
+		}
+		return nil
+	}, strategiesToRemoveFromWhitelist)
+}
+
+// OnRemoveStrategiesFromDepositWhitelist sets a hook run by every RemoveStrategiesFromDepositWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnRemoveStrategiesFromDepositWhitelist(hook func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error) {
+	_FakeStrategyManager.Contract.SetHook("removeStrategiesFromDepositWhitelist", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeStrategyManager *FakeStrategyManager) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeStrategyManager.Contract.SetHook("renounceOwnership", hook)
+}
+
+// SetStrategyWhitelister records a setStrategyWhitelister call after running the hook set with OnSetStrategyWhitelister.
+func (_FakeStrategyManager *FakeStrategyManager) SetStrategyWhitelister(opts *bind.TransactOpts, newStrategyWhitelister common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "setStrategyWhitelister", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("setStrategyWhitelister").(func(opts *bind.TransactOpts, newStrategyWhitelister common.Address) error); ok {
+			return hook(opts, newStrategyWhitelister)
+		}
+		return nil
+	}, newStrategyWhitelister)
+}
+
+// OnSetStrategyWhitelister sets a hook run by every SetStrategyWhitelister call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnSetStrategyWhitelister(hook func(opts *bind.TransactOpts, newStrategyWhitelister common.Address) error) {
+	_FakeStrategyManager.Contract.SetHook("setStrategyWhitelister", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeStrategyManager *FakeStrategyManager) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeStrategyManager.Contract.SetHook("transferOwnership", hook)
+}
+
+// Unpause records a unpause call after running the hook set with OnUnpause.
+func (_FakeStrategyManager *FakeStrategyManager) Unpause(opts *bind.TransactOpts, newPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "unpause", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("unpause").(func(opts *bind.TransactOpts, newPausedStatus *big.Int) error); ok {
+			return hook(opts, newPausedStatus)
+		}
+		return nil
+	}, newPausedStatus)
+}
+
+// OnUnpause sets a hook run by every Unpause call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnUnpause(hook func(opts *bind.TransactOpts, newPausedStatus *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("unpause", hook)
+}
+
+// WithdrawSharesAsTokens records a withdrawSharesAsTokens call after running the hook set with OnWithdrawSharesAsTokens.
+func (_FakeStrategyManager *FakeStrategyManager) WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManager.Contract.Transact(opts, "withdrawSharesAsTokens", func() error {
+		if hook, ok := _FakeStrategyManager.Contract.Hook("withdrawSharesAsTokens").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, token, shares)
+		}
+		return nil
+	}, staker, strategy, token, shares)
+}
+
+// OnWithdrawSharesAsTokens sets a hook run by every WithdrawSharesAsTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManager *FakeStrategyManager) OnWithdrawSharesAsTokens(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error) {
+	_FakeStrategyManager.Contract.SetHook("withdrawSharesAsTokens", hook)
+}
+
+// EmitBurnOrRedistributableSharesDecreased appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitBurnOrRedistributableSharesDecreased(event *StrategyManagerBurnOrRedistributableSharesDecreased) {
+	_FakeStrategyManager.Contract.Emit("BurnOrRedistributableSharesDecreased", event)
+}
+
+// EmitBurnOrRedistributableSharesIncreased appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitBurnOrRedistributableSharesIncreased(event *StrategyManagerBurnOrRedistributableSharesIncreased) {
+	_FakeStrategyManager.Contract.Emit("BurnOrRedistributableSharesIncreased", event)
+}
+
+// EmitBurnableSharesDecreased appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitBurnableSharesDecreased(event *StrategyManagerBurnableSharesDecreased) {
+	_FakeStrategyManager.Contract.Emit("BurnableSharesDecreased", event)
+}
+
+// EmitDeposit appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitDeposit(event *StrategyManagerDeposit) {
+	_FakeStrategyManager.Contract.Emit("Deposit", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitInitialized(event *StrategyManagerInitialized) {
+	_FakeStrategyManager.Contract.Emit("Initialized", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitOwnershipTransferred(event *StrategyManagerOwnershipTransferred) {
+	_FakeStrategyManager.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitPaused appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitPaused(event *StrategyManagerPaused) {
+	_FakeStrategyManager.Contract.Emit("Paused", event)
+}
+
+// EmitStrategyAddedToDepositWhitelist appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitStrategyAddedToDepositWhitelist(event *StrategyManagerStrategyAddedToDepositWhitelist) {
+	_FakeStrategyManager.Contract.Emit("StrategyAddedToDepositWhitelist", event)
+}
+
+// EmitStrategyRemovedFromDepositWhitelist appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitStrategyRemovedFromDepositWhitelist(event *StrategyManagerStrategyRemovedFromDepositWhitelist) {
+	_FakeStrategyManager.Contract.Emit("StrategyRemovedFromDepositWhitelist", event)
+}
+
+// EmitStrategyWhitelisterChanged appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitStrategyWhitelisterChanged(event *StrategyManagerStrategyWhitelisterChanged) {
+	_FakeStrategyManager.Contract.Emit("StrategyWhitelisterChanged", event)
+}
+
+// EmitUnpaused appends the event to the fake's logs.
+func (_FakeStrategyManager *FakeStrategyManager) EmitUnpaused(event *StrategyManagerUnpaused) {
+	_FakeStrategyManager.Contract.Emit("Unpaused", event)
+}
@@ -0,0 +1,88 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyManagerStorage
+
+// ErrMaxStrategiesExceeded is the typed form of the StrategyManagerStorage custom error MaxStrategiesExceeded().
+type ErrMaxStrategiesExceeded struct{}
+
+// Error implements the error interface.
+func (ErrMaxStrategiesExceeded) Error() string {
+	return "StrategyManagerStorage: MaxStrategiesExceeded()"
+}
+
+// ErrOnlyDelegationManager is the typed form of the StrategyManagerStorage custom error OnlyDelegationManager().
+type ErrOnlyDelegationManager struct{}
+
+// Error implements the error interface.
+func (ErrOnlyDelegationManager) Error() string {
+	return "StrategyManagerStorage: OnlyDelegationManager()"
+}
+
+// ErrOnlyStrategyWhitelister is the typed form of the StrategyManagerStorage custom error OnlyStrategyWhitelister().
+type ErrOnlyStrategyWhitelister struct{}
+
+// Error implements the error interface.
+func (ErrOnlyStrategyWhitelister) Error() string {
+	return "StrategyManagerStorage: OnlyStrategyWhitelister()"
+}
+
+// ErrSharesAmountTooHigh is the typed form of the StrategyManagerStorage custom error SharesAmountTooHigh().
+type ErrSharesAmountTooHigh struct{}
+
+// Error implements the error interface.
+func (ErrSharesAmountTooHigh) Error() string {
+	return "StrategyManagerStorage: SharesAmountTooHigh()"
+}
+
+// ErrSharesAmountZero is the typed form of the StrategyManagerStorage custom error SharesAmountZero().
+type ErrSharesAmountZero struct{}
+
+// Error implements the error interface.
+func (ErrSharesAmountZero) Error() string {
+	return "StrategyManagerStorage: SharesAmountZero()"
+}
+
+// ErrStakerAddressZero is the typed form of the StrategyManagerStorage custom error StakerAddressZero().
+type ErrStakerAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrStakerAddressZero) Error() string {
+	return "StrategyManagerStorage: StakerAddressZero()"
+}
+
+// ErrStrategyAlreadyInSlash is the typed form of the StrategyManagerStorage custom error StrategyAlreadyInSlash().
+type ErrStrategyAlreadyInSlash struct{}
+
+// Error implements the error interface.
+func (ErrStrategyAlreadyInSlash) Error() string {
+	return "StrategyManagerStorage: StrategyAlreadyInSlash()"
+}
+
+// ErrStrategyNotFound is the typed form of the StrategyManagerStorage custom error StrategyNotFound().
+type ErrStrategyNotFound struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotFound) Error() string {
+	return "StrategyManagerStorage: StrategyNotFound()"
+}
+
+// ErrStrategyNotWhitelisted is the typed form of the StrategyManagerStorage custom error StrategyNotWhitelisted().
+type ErrStrategyNotWhitelisted struct{}
+
+// Error implements the error interface.
+func (ErrStrategyNotWhitelisted) Error() string {
+	return "StrategyManagerStorage: StrategyNotWhitelisted()"
+}
+
+// StrategyManagerStorageErrors maps the name of every custom error in the StrategyManagerStorage ABI to its typed Go error.
+var StrategyManagerStorageErrors = map[string]error{
+	"MaxStrategiesExceeded":   ErrMaxStrategiesExceeded{},
+	"OnlyDelegationManager":   ErrOnlyDelegationManager{},
+	"OnlyStrategyWhitelister": ErrOnlyStrategyWhitelister{},
+	"SharesAmountTooHigh":     ErrSharesAmountTooHigh{},
+	"SharesAmountZero":        ErrSharesAmountZero{},
+	"StakerAddressZero":       ErrStakerAddressZero{},
+	"StrategyAlreadyInSlash":  ErrStrategyAlreadyInSlash{},
+	"StrategyNotFound":        ErrStrategyNotFound{},
+	"StrategyNotWhitelisted":  ErrStrategyNotWhitelisted{},
+}
@@ -0,0 +1,516 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyManagerStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeStrategyManagerStorage is an in-memory StrategyManagerStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded StrategyManagerStorageFilterer.
+type FakeStrategyManagerStorage struct {
+	*fake.Contract
+	*StrategyManagerStorageFilterer
+}
+
+// NewFakeStrategyManagerStorage returns an empty fake of the contract at address.
+func NewFakeStrategyManagerStorage(address common.Address) *FakeStrategyManagerStorage {
+	contract := fake.NewContract(StrategyManagerStorageMetaData, address)
+	filterer, err := NewStrategyManagerStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeStrategyManagerStorage{Contract: contract, StrategyManagerStorageFilterer: filterer}
+}
+
+// DEFAULTBURNADDRESS returns the values stubbed with StubDEFAULTBURNADDRESS.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) DEFAULTBURNADDRESS(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManagerStorage.Contract.Read("DEFAULT_BURN_ADDRESS", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEFAULTBURNADDRESS sets what DEFAULTBURNADDRESS returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubDEFAULTBURNADDRESS(out0 common.Address) {
+	_FakeStrategyManagerStorage.Contract.Stub("DEFAULT_BURN_ADDRESS", []interface{}{}, out0)
+}
+
+// DEPOSITTYPEHASH returns the values stubbed with StubDEPOSITTYPEHASH.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) DEPOSITTYPEHASH(opts *bind.CallOpts) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeStrategyManagerStorage.Contract.Read("DEPOSIT_TYPEHASH", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDEPOSITTYPEHASH sets what DEPOSITTYPEHASH returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubDEPOSITTYPEHASH(out0 [32]byte) {
+	_FakeStrategyManagerStorage.Contract.Stub("DEPOSIT_TYPEHASH", []interface{}{}, out0)
+}
+
+// AllocationManager returns the values stubbed with StubAllocationManager.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) AllocationManager(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManagerStorage.Contract.Read("allocationManager", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubAllocationManager sets what AllocationManager returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubAllocationManager(out0 common.Address) {
+	_FakeStrategyManagerStorage.Contract.Stub("allocationManager", []interface{}{}, out0)
+}
+
+// CalculateStrategyDepositDigestHash returns the values stubbed with StubCalculateStrategyDepositDigestHash.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) CalculateStrategyDepositDigestHash(opts *bind.CallOpts, staker common.Address, strategy common.Address, token common.Address, amount *big.Int, nonce *big.Int, expiry *big.Int) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeStrategyManagerStorage.Contract.Read("calculateStrategyDepositDigestHash", []interface{}{staker, strategy, token, amount, nonce, expiry}, &out0)
+	return out0, err
+}
+
+// StubCalculateStrategyDepositDigestHash sets what CalculateStrategyDepositDigestHash returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubCalculateStrategyDepositDigestHash(staker common.Address, strategy common.Address, token common.Address, amount *big.Int, nonce *big.Int, expiry *big.Int, out0 [32]byte) {
+	_FakeStrategyManagerStorage.Contract.Stub("calculateStrategyDepositDigestHash", []interface{}{staker, strategy, token, amount, nonce, expiry}, out0)
+}
+
+// Delegation returns the values stubbed with StubDelegation.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) Delegation(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManagerStorage.Contract.Read("delegation", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubDelegation sets what Delegation returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubDelegation(out0 common.Address) {
+	_FakeStrategyManagerStorage.Contract.Stub("delegation", []interface{}{}, out0)
+}
+
+// GetBurnOrRedistributableCount returns the values stubbed with StubGetBurnOrRedistributableCount.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetBurnOrRedistributableCount(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("getBurnOrRedistributableCount", []interface{}{operatorSet, slashId}, &out0)
+	return out0, err
+}
+
+// StubGetBurnOrRedistributableCount sets what GetBurnOrRedistributableCount returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetBurnOrRedistributableCount(operatorSet OperatorSet, slashId *big.Int, out0 *big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("getBurnOrRedistributableCount", []interface{}{operatorSet, slashId}, out0)
+}
+
+// GetBurnOrRedistributableShares returns the values stubbed with StubGetBurnOrRedistributableShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetBurnOrRedistributableShares(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("getBurnOrRedistributableShares", []interface{}{operatorSet, slashId}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetBurnOrRedistributableShares sets what GetBurnOrRedistributableShares returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetBurnOrRedistributableShares(operatorSet OperatorSet, slashId *big.Int, out0 []common.Address, out1 []*big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("getBurnOrRedistributableShares", []interface{}{operatorSet, slashId}, out0, out1)
+}
+
+// GetBurnOrRedistributableShares0 returns the values stubbed with StubGetBurnOrRedistributableShares0.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetBurnOrRedistributableShares0(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("getBurnOrRedistributableShares0", []interface{}{operatorSet, slashId, strategy}, &out0)
+	return out0, err
+}
+
+// StubGetBurnOrRedistributableShares0 sets what GetBurnOrRedistributableShares0 returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetBurnOrRedistributableShares0(operatorSet OperatorSet, slashId *big.Int, strategy common.Address, out0 *big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("getBurnOrRedistributableShares0", []interface{}{operatorSet, slashId, strategy}, out0)
+}
+
+// GetBurnableShares returns the values stubbed with StubGetBurnableShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetBurnableShares(opts *bind.CallOpts, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("getBurnableShares", []interface{}{strategy}, &out0)
+	return out0, err
+}
+
+// StubGetBurnableShares sets what GetBurnableShares returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetBurnableShares(strategy common.Address, out0 *big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("getBurnableShares", []interface{}{strategy}, out0)
+}
+
+// GetDeposits returns the values stubbed with StubGetDeposits.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("getDeposits", []interface{}{staker}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetDeposits sets what GetDeposits returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetDeposits(staker common.Address, out0 []common.Address, out1 []*big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("getDeposits", []interface{}{staker}, out0, out1)
+}
+
+// GetPendingOperatorSets returns the values stubbed with StubGetPendingOperatorSets.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetPendingOperatorSets(opts *bind.CallOpts) ([]OperatorSet, error) {
+	var out0 []OperatorSet
+	err := _FakeStrategyManagerStorage.Contract.Read("getPendingOperatorSets", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubGetPendingOperatorSets sets what GetPendingOperatorSets returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetPendingOperatorSets(out0 []OperatorSet) {
+	_FakeStrategyManagerStorage.Contract.Stub("getPendingOperatorSets", []interface{}{}, out0)
+}
+
+// GetPendingSlashIds returns the values stubbed with StubGetPendingSlashIds.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetPendingSlashIds(opts *bind.CallOpts, operatorSet OperatorSet) ([]*big.Int, error) {
+	var out0 []*big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("getPendingSlashIds", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetPendingSlashIds sets what GetPendingSlashIds returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetPendingSlashIds(operatorSet OperatorSet, out0 []*big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("getPendingSlashIds", []interface{}{operatorSet}, out0)
+}
+
+// GetStakerStrategyList returns the values stubbed with StubGetStakerStrategyList.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetStakerStrategyList(opts *bind.CallOpts, staker common.Address) ([]common.Address, error) {
+	var out0 []common.Address
+	err := _FakeStrategyManagerStorage.Contract.Read("getStakerStrategyList", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubGetStakerStrategyList sets what GetStakerStrategyList returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetStakerStrategyList(staker common.Address, out0 []common.Address) {
+	_FakeStrategyManagerStorage.Contract.Stub("getStakerStrategyList", []interface{}{staker}, out0)
+}
+
+// GetStrategiesWithBurnableShares returns the values stubbed with StubGetStrategiesWithBurnableShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) GetStrategiesWithBurnableShares(opts *bind.CallOpts) ([]common.Address, []*big.Int, error) {
+	var out0 []common.Address
+	var out1 []*big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("getStrategiesWithBurnableShares", []interface{}{}, &out0, &out1)
+	return out0, out1, err
+}
+
+// StubGetStrategiesWithBurnableShares sets what GetStrategiesWithBurnableShares returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubGetStrategiesWithBurnableShares(out0 []common.Address, out1 []*big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("getStrategiesWithBurnableShares", []interface{}{}, out0, out1)
+}
+
+// Nonces returns the values stubbed with StubNonces.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) Nonces(opts *bind.CallOpts, signer common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("nonces", []interface{}{signer}, &out0)
+	return out0, err
+}
+
+// StubNonces sets what Nonces returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubNonces(signer common.Address, out0 *big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("nonces", []interface{}{signer}, out0)
+}
+
+// StakerDepositShares returns the values stubbed with StubStakerDepositShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StakerDepositShares(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("stakerDepositShares", []interface{}{staker, strategy}, &out0)
+	return out0, err
+}
+
+// StubStakerDepositShares sets what StakerDepositShares returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubStakerDepositShares(staker common.Address, strategy common.Address, out0 *big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("stakerDepositShares", []interface{}{staker, strategy}, out0)
+}
+
+// StakerStrategyList returns the values stubbed with StubStakerStrategyList.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StakerStrategyList(opts *bind.CallOpts, staker common.Address, arg1 *big.Int) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManagerStorage.Contract.Read("stakerStrategyList", []interface{}{staker, arg1}, &out0)
+	return out0, err
+}
+
+// StubStakerStrategyList sets what StakerStrategyList returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubStakerStrategyList(staker common.Address, arg1 *big.Int, out0 common.Address) {
+	_FakeStrategyManagerStorage.Contract.Stub("stakerStrategyList", []interface{}{staker, arg1}, out0)
+}
+
+// StakerStrategyListLength returns the values stubbed with StubStakerStrategyListLength.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeStrategyManagerStorage.Contract.Read("stakerStrategyListLength", []interface{}{staker}, &out0)
+	return out0, err
+}
+
+// StubStakerStrategyListLength sets what StakerStrategyListLength returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubStakerStrategyListLength(staker common.Address, out0 *big.Int) {
+	_FakeStrategyManagerStorage.Contract.Stub("stakerStrategyListLength", []interface{}{staker}, out0)
+}
+
+// StrategyIsWhitelistedForDeposit returns the values stubbed with StubStrategyIsWhitelistedForDeposit.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, strategy common.Address) (bool, error) {
+	var out0 bool
+	err := _FakeStrategyManagerStorage.Contract.Read("strategyIsWhitelistedForDeposit", []interface{}{strategy}, &out0)
+	return out0, err
+}
+
+// StubStrategyIsWhitelistedForDeposit sets what StrategyIsWhitelistedForDeposit returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubStrategyIsWhitelistedForDeposit(strategy common.Address, out0 bool) {
+	_FakeStrategyManagerStorage.Contract.Stub("strategyIsWhitelistedForDeposit", []interface{}{strategy}, out0)
+}
+
+// StrategyWhitelister returns the values stubbed with StubStrategyWhitelister.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StrategyWhitelister(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeStrategyManagerStorage.Contract.Read("strategyWhitelister", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubStrategyWhitelister sets what StrategyWhitelister returns for the given arguments.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) StubStrategyWhitelister(out0 common.Address) {
+	_FakeStrategyManagerStorage.Contract.Stub("strategyWhitelister", []interface{}{}, out0)
+}
+
+// AddShares records a addShares call after running the hook set with OnAddShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "addShares", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("addShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, shares)
+		}
+		return nil
+	}, staker, strategy, shares)
+}
+
+// OnAddShares sets a hook run by every AddShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnAddShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("addShares", hook)
+}
+
+// AddStrategiesToDepositWhitelist records a addStrategiesToDepositWhitelist call after running the hook set with OnAddStrategiesToDepositWhitelist.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "addStrategiesToDepositWhitelist", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("addStrategiesToDepositWhitelist").(func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToWhitelist)
+		}
+		return nil
+	}, strategiesToWhitelist)
+}
+
+// OnAddStrategiesToDepositWhitelist sets a hook run by every AddStrategiesToDepositWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnAddStrategiesToDepositWhitelist(hook func(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("addStrategiesToDepositWhitelist", hook)
+}
+
+// BurnShares records a burnShares call after running the hook set with OnBurnShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) BurnShares(opts *bind.TransactOpts, strategy common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "burnShares", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("burnShares").(func(opts *bind.TransactOpts, strategy common.Address) error); ok {
+			return hook(opts, strategy)
+		}
+		return nil
+	}, strategy)
+}
+
+// OnBurnShares sets a hook run by every BurnShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnBurnShares(hook func(opts *bind.TransactOpts, strategy common.Address) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("burnShares", hook)
+}
+
+// ClearBurnOrRedistributableShares records a clearBurnOrRedistributableShares call after running the hook set with OnClearBurnOrRedistributableShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) ClearBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "clearBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("clearBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId)
+		}
+		return nil
+	}, operatorSet, slashId)
+}
+
+// OnClearBurnOrRedistributableShares sets a hook run by every ClearBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnClearBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("clearBurnOrRedistributableShares", hook)
+}
+
+// ClearBurnOrRedistributableSharesByStrategy records a clearBurnOrRedistributableSharesByStrategy call after running the hook set with OnClearBurnOrRedistributableSharesByStrategy.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) ClearBurnOrRedistributableSharesByStrategy(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "clearBurnOrRedistributableSharesByStrategy", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("clearBurnOrRedistributableSharesByStrategy").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) error); ok {
+			return hook(opts, operatorSet, slashId, strategy)
+		}
+		return nil
+	}, operatorSet, slashId, strategy)
+}
+
+// OnClearBurnOrRedistributableSharesByStrategy sets a hook run by every ClearBurnOrRedistributableSharesByStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnClearBurnOrRedistributableSharesByStrategy(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("clearBurnOrRedistributableSharesByStrategy", hook)
+}
+
+// DepositIntoStrategy records a depositIntoStrategy call after running the hook set with OnDepositIntoStrategy.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "depositIntoStrategy", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("depositIntoStrategy").(func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) error); ok {
+			return hook(opts, strategy, token, amount)
+		}
+		return nil
+	}, strategy, token, amount)
+}
+
+// OnDepositIntoStrategy sets a hook run by every DepositIntoStrategy call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnDepositIntoStrategy(hook func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("depositIntoStrategy", hook)
+}
+
+// DepositIntoStrategyWithSignature records a depositIntoStrategyWithSignature call after running the hook set with OnDepositIntoStrategyWithSignature.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "depositIntoStrategyWithSignature", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("depositIntoStrategyWithSignature").(func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) error); ok {
+			return hook(opts, strategy, token, amount, staker, expiry, signature)
+		}
+		return nil
+	}, strategy, token, amount, staker, expiry, signature)
+}
+
+// OnDepositIntoStrategyWithSignature sets a hook run by every DepositIntoStrategyWithSignature call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnDepositIntoStrategyWithSignature(hook func(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("depositIntoStrategyWithSignature", hook)
+}
+
+// IncreaseBurnOrRedistributableShares records a increaseBurnOrRedistributableShares call after running the hook set with OnIncreaseBurnOrRedistributableShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "increaseBurnOrRedistributableShares", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("increaseBurnOrRedistributableShares").(func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error); ok {
+			return hook(opts, operatorSet, slashId, strategy, addedSharesToBurn)
+		}
+		return nil
+	}, operatorSet, slashId, strategy, addedSharesToBurn)
+}
+
+// OnIncreaseBurnOrRedistributableShares sets a hook run by every IncreaseBurnOrRedistributableShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnIncreaseBurnOrRedistributableShares(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("increaseBurnOrRedistributableShares", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("initialize").(func(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) error); ok {
+			return hook(opts, initialOwner, initialStrategyWhitelister, initialPausedStatus)
+		}
+		return nil
+	}, initialOwner, initialStrategyWhitelister, initialPausedStatus)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnInitialize(hook func(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("initialize", hook)
+}
+
+// RemoveDepositShares records a removeDepositShares call after running the hook set with OnRemoveDepositShares.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "removeDepositShares", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("removeDepositShares").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error); ok {
+			return hook(opts, staker, strategy, depositSharesToRemove)
+		}
+		return nil
+	}, staker, strategy, depositSharesToRemove)
+}
+
+// OnRemoveDepositShares sets a hook run by every RemoveDepositShares call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnRemoveDepositShares(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("removeDepositShares", hook)
+}
+
+// RemoveStrategiesFromDepositWhitelist records a removeStrategiesFromDepositWhitelist call after running the hook set with OnRemoveStrategiesFromDepositWhitelist.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "removeStrategiesFromDepositWhitelist", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("removeStrategiesFromDepositWhitelist").(func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error); ok {
+			return hook(opts, strategiesToRemoveFromWhitelist)
+		}
+		return nil
+	}, strategiesToRemoveFromWhitelist)
+}
+
+// OnRemoveStrategiesFromDepositWhitelist sets a hook run by every RemoveStrategiesFromDepositWhitelist call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnRemoveStrategiesFromDepositWhitelist(hook func(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("removeStrategiesFromDepositWhitelist", hook)
+}
+
+// SetStrategyWhitelister records a setStrategyWhitelister call after running the hook set with OnSetStrategyWhitelister.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) SetStrategyWhitelister(opts *bind.TransactOpts, newStrategyWhitelister common.Address) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "setStrategyWhitelister", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("setStrategyWhitelister").(func(opts *bind.TransactOpts, newStrategyWhitelister common.Address) error); ok {
+			return hook(opts, newStrategyWhitelister)
+		}
+		return nil
+	}, newStrategyWhitelister)
+}
+
+// OnSetStrategyWhitelister sets a hook run by every SetStrategyWhitelister call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnSetStrategyWhitelister(hook func(opts *bind.TransactOpts, newStrategyWhitelister common.Address) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("setStrategyWhitelister", hook)
+}
+
+// WithdrawSharesAsTokens records a withdrawSharesAsTokens call after running the hook set with OnWithdrawSharesAsTokens.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error) {
+	return _FakeStrategyManagerStorage.Contract.Transact(opts, "withdrawSharesAsTokens", func() error {
+		if hook, ok := _FakeStrategyManagerStorage.Contract.Hook("withdrawSharesAsTokens").(func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error); ok {
+			return hook(opts, staker, strategy, token, shares)
+		}
+		return nil
+	}, staker, strategy, token, shares)
+}
+
+// OnWithdrawSharesAsTokens sets a hook run by every WithdrawSharesAsTokens call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) OnWithdrawSharesAsTokens(hook func(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) error) {
+	_FakeStrategyManagerStorage.Contract.SetHook("withdrawSharesAsTokens", hook)
+}
+
+// EmitBurnOrRedistributableSharesDecreased appends the event to the fake's logs.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) EmitBurnOrRedistributableSharesDecreased(event *StrategyManagerStorageBurnOrRedistributableSharesDecreased) {
+	_FakeStrategyManagerStorage.Contract.Emit("BurnOrRedistributableSharesDecreased", event)
+}
+
+// EmitBurnOrRedistributableSharesIncreased appends the event to the fake's logs.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) EmitBurnOrRedistributableSharesIncreased(event *StrategyManagerStorageBurnOrRedistributableSharesIncreased) {
+	_FakeStrategyManagerStorage.Contract.Emit("BurnOrRedistributableSharesIncreased", event)
+}
+
+// EmitBurnableSharesDecreased appends the event to the fake's logs.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) EmitBurnableSharesDecreased(event *StrategyManagerStorageBurnableSharesDecreased) {
+	_FakeStrategyManagerStorage.Contract.Emit("BurnableSharesDecreased", event)
+}
+
+// EmitDeposit appends the event to the fake's logs.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) EmitDeposit(event *StrategyManagerStorageDeposit) {
+	_FakeStrategyManagerStorage.Contract.Emit("Deposit", event)
+}
+
+// EmitStrategyAddedToDepositWhitelist appends the event to the fake's logs.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) EmitStrategyAddedToDepositWhitelist(event *StrategyManagerStorageStrategyAddedToDepositWhitelist) {
+	_FakeStrategyManagerStorage.Contract.Emit("StrategyAddedToDepositWhitelist", event)
+}
+
+// EmitStrategyRemovedFromDepositWhitelist appends the event to the fake's logs.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) EmitStrategyRemovedFromDepositWhitelist(event *StrategyManagerStorageStrategyRemovedFromDepositWhitelist) {
+	_FakeStrategyManagerStorage.Contract.Emit("StrategyRemovedFromDepositWhitelist", event)
+}
+
+// EmitStrategyWhitelisterChanged appends the event to the fake's logs.
+func (_FakeStrategyManagerStorage *FakeStrategyManagerStorage) EmitStrategyWhitelisterChanged(event *StrategyManagerStorageStrategyWhitelisterChanged) {
+	_FakeStrategyManagerStorage.Contract.Emit("StrategyWhitelisterChanged", event)
+}
@@ -0,0 +1,92 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package StrategyManagerStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// StrategyManagerStorageReader is the read-only surface of the StrategyManagerStorage
+// binding, implemented by StrategyManagerStorageCaller, StrategyManagerStorage and FakeStrategyManagerStorage.
+type StrategyManagerStorageReader interface {
+	DEFAULTBURNADDRESS(opts *bind.CallOpts) (common.Address, error)
+	DEPOSITTYPEHASH(opts *bind.CallOpts) ([32]byte, error)
+	AllocationManager(opts *bind.CallOpts) (common.Address, error)
+	CalculateStrategyDepositDigestHash(opts *bind.CallOpts, staker common.Address, strategy common.Address, token common.Address, amount *big.Int, nonce *big.Int, expiry *big.Int) ([32]byte, error)
+	Delegation(opts *bind.CallOpts) (common.Address, error)
+	GetBurnOrRedistributableCount(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) (*big.Int, error)
+	GetBurnOrRedistributableShares(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int) ([]common.Address, []*big.Int, error)
+	GetBurnOrRedistributableShares0(opts *bind.CallOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*big.Int, error)
+	GetBurnableShares(opts *bind.CallOpts, strategy common.Address) (*big.Int, error)
+	GetDeposits(opts *bind.CallOpts, staker common.Address) ([]common.Address, []*big.Int, error)
+	GetPendingOperatorSets(opts *bind.CallOpts) ([]OperatorSet, error)
+	GetPendingSlashIds(opts *bind.CallOpts, operatorSet OperatorSet) ([]*big.Int, error)
+	GetStakerStrategyList(opts *bind.CallOpts, staker common.Address) ([]common.Address, error)
+	GetStrategiesWithBurnableShares(opts *bind.CallOpts) ([]common.Address, []*big.Int, error)
+	Nonces(opts *bind.CallOpts, signer common.Address) (*big.Int, error)
+	StakerDepositShares(opts *bind.CallOpts, staker common.Address, strategy common.Address) (*big.Int, error)
+	StakerStrategyList(opts *bind.CallOpts, staker common.Address, arg1 *big.Int) (common.Address, error)
+	StakerStrategyListLength(opts *bind.CallOpts, staker common.Address) (*big.Int, error)
+	StrategyIsWhitelistedForDeposit(opts *bind.CallOpts, strategy common.Address) (bool, error)
+	StrategyWhitelister(opts *bind.CallOpts) (common.Address, error)
+}
+
+// StrategyManagerStorageWriter is the transacting surface of the StrategyManagerStorage
+// binding, implemented by StrategyManagerStorageTransactor, StrategyManagerStorage and FakeStrategyManagerStorage.
+type StrategyManagerStorageWriter interface {
+	AddShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, shares *big.Int) (*types.Transaction, error)
+	AddStrategiesToDepositWhitelist(opts *bind.TransactOpts, strategiesToWhitelist []common.Address) (*types.Transaction, error)
+	BurnShares(opts *bind.TransactOpts, strategy common.Address) (*types.Transaction, error)
+	ClearBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int) (*types.Transaction, error)
+	ClearBurnOrRedistributableSharesByStrategy(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address) (*types.Transaction, error)
+	DepositIntoStrategy(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int) (*types.Transaction, error)
+	DepositIntoStrategyWithSignature(opts *bind.TransactOpts, strategy common.Address, token common.Address, amount *big.Int, staker common.Address, expiry *big.Int, signature []byte) (*types.Transaction, error)
+	IncreaseBurnOrRedistributableShares(opts *bind.TransactOpts, operatorSet OperatorSet, slashId *big.Int, strategy common.Address, addedSharesToBurn *big.Int) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, initialOwner common.Address, initialStrategyWhitelister common.Address, initialPausedStatus *big.Int) (*types.Transaction, error)
+	RemoveDepositShares(opts *bind.TransactOpts, staker common.Address, strategy common.Address, depositSharesToRemove *big.Int) (*types.Transaction, error)
+	RemoveStrategiesFromDepositWhitelist(opts *bind.TransactOpts, strategiesToRemoveFromWhitelist []common.Address) (*types.Transaction, error)
+	SetStrategyWhitelister(opts *bind.TransactOpts, newStrategyWhitelister common.Address) (*types.Transaction, error)
+	WithdrawSharesAsTokens(opts *bind.TransactOpts, staker common.Address, strategy common.Address, token common.Address, shares *big.Int) (*types.Transaction, error)
+}
+
+// StrategyManagerStorageEvents is the event filtering surface of the StrategyManagerStorage
+// binding, implemented by StrategyManagerStorageFilterer, StrategyManagerStorage and FakeStrategyManagerStorage.
+type StrategyManagerStorageEvents interface {
+	FilterBurnOrRedistributableSharesDecreased(opts *bind.FilterOpts) (*StrategyManagerStorageBurnOrRedistributableSharesDecreasedIterator, error)
+	WatchBurnOrRedistributableSharesDecreased(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageBurnOrRedistributableSharesDecreased) (event.Subscription, error)
+	ParseBurnOrRedistributableSharesDecreased(log types.Log) (*StrategyManagerStorageBurnOrRedistributableSharesDecreased, error)
+	FilterBurnOrRedistributableSharesIncreased(opts *bind.FilterOpts) (*StrategyManagerStorageBurnOrRedistributableSharesIncreasedIterator, error)
+	WatchBurnOrRedistributableSharesIncreased(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageBurnOrRedistributableSharesIncreased) (event.Subscription, error)
+	ParseBurnOrRedistributableSharesIncreased(log types.Log) (*StrategyManagerStorageBurnOrRedistributableSharesIncreased, error)
+	FilterBurnableSharesDecreased(opts *bind.FilterOpts) (*StrategyManagerStorageBurnableSharesDecreasedIterator, error)
+	WatchBurnableSharesDecreased(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageBurnableSharesDecreased) (event.Subscription, error)
+	ParseBurnableSharesDecreased(log types.Log) (*StrategyManagerStorageBurnableSharesDecreased, error)
+	FilterDeposit(opts *bind.FilterOpts) (*StrategyManagerStorageDepositIterator, error)
+	WatchDeposit(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageDeposit) (event.Subscription, error)
+	ParseDeposit(log types.Log) (*StrategyManagerStorageDeposit, error)
+	FilterStrategyAddedToDepositWhitelist(opts *bind.FilterOpts) (*StrategyManagerStorageStrategyAddedToDepositWhitelistIterator, error)
+	WatchStrategyAddedToDepositWhitelist(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageStrategyAddedToDepositWhitelist) (event.Subscription, error)
+	ParseStrategyAddedToDepositWhitelist(log types.Log) (*StrategyManagerStorageStrategyAddedToDepositWhitelist, error)
+	FilterStrategyRemovedFromDepositWhitelist(opts *bind.FilterOpts) (*StrategyManagerStorageStrategyRemovedFromDepositWhitelistIterator, error)
+	WatchStrategyRemovedFromDepositWhitelist(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageStrategyRemovedFromDepositWhitelist) (event.Subscription, error)
+	ParseStrategyRemovedFromDepositWhitelist(log types.Log) (*StrategyManagerStorageStrategyRemovedFromDepositWhitelist, error)
+	FilterStrategyWhitelisterChanged(opts *bind.FilterOpts) (*StrategyManagerStorageStrategyWhitelisterChangedIterator, error)
+	WatchStrategyWhitelisterChanged(opts *bind.WatchOpts, sink chan<- *StrategyManagerStorageStrategyWhitelisterChanged) (event.Subscription, error)
+	ParseStrategyWhitelisterChanged(log types.Log) (*StrategyManagerStorageStrategyWhitelisterChanged, error)
+}
+
+var (
+	_ StrategyManagerStorageReader = (*StrategyManagerStorageCaller)(nil)
+	_ StrategyManagerStorageReader = (*StrategyManagerStorage)(nil)
+	_ StrategyManagerStorageReader = (*FakeStrategyManagerStorage)(nil)
+	_ StrategyManagerStorageWriter = (*StrategyManagerStorageTransactor)(nil)
+	_ StrategyManagerStorageWriter = (*StrategyManagerStorage)(nil)
+	_ StrategyManagerStorageWriter = (*FakeStrategyManagerStorage)(nil)
+	_ StrategyManagerStorageEvents = (*StrategyManagerStorageFilterer)(nil)
+	_ StrategyManagerStorageEvents = (*StrategyManagerStorage)(nil)
+	_ StrategyManagerStorageEvents = (*FakeStrategyManagerStorage)(nil)
+)
@@ -0,0 +1,203 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package TaskMailbox
+
+import (
+	"fmt"
+)
+
+// ErrCertificateStale is the typed form of the TaskMailbox custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "TaskMailbox: CertificateStale()"
+}
+
+// ErrEmptyCertificateSignature is the typed form of the TaskMailbox custom error EmptyCertificateSignature().
+type ErrEmptyCertificateSignature struct{}
+
+// Error implements the error interface.
+func (ErrEmptyCertificateSignature) Error() string {
+	return "TaskMailbox: EmptyCertificateSignature()"
+}
+
+// ErrExecutorOperatorSetNotRegistered is the typed form of the TaskMailbox custom error ExecutorOperatorSetNotRegistered().
+type ErrExecutorOperatorSetNotRegistered struct{}
+
+// Error implements the error interface.
+func (ErrExecutorOperatorSetNotRegistered) Error() string {
+	return "TaskMailbox: ExecutorOperatorSetNotRegistered()"
+}
+
+// ErrExecutorOperatorSetTaskConfigNotSet is the typed form of the TaskMailbox custom error ExecutorOperatorSetTaskConfigNotSet().
+type ErrExecutorOperatorSetTaskConfigNotSet struct{}
+
+// Error implements the error interface.
+func (ErrExecutorOperatorSetTaskConfigNotSet) Error() string {
+	return "TaskMailbox: ExecutorOperatorSetTaskConfigNotSet()"
+}
+
+// ErrFeeAlreadyRefunded is the typed form of the TaskMailbox custom error FeeAlreadyRefunded().
+type ErrFeeAlreadyRefunded struct{}
+
+// Error implements the error interface.
+func (ErrFeeAlreadyRefunded) Error() string {
+	return "TaskMailbox: FeeAlreadyRefunded()"
+}
+
+// ErrInvalidAddressZero is the typed form of the TaskMailbox custom error InvalidAddressZero().
+type ErrInvalidAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAddressZero) Error() string {
+	return "TaskMailbox: InvalidAddressZero()"
+}
+
+// ErrInvalidConsensusType is the typed form of the TaskMailbox custom error InvalidConsensusType().
+type ErrInvalidConsensusType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConsensusType) Error() string {
+	return "TaskMailbox: InvalidConsensusType()"
+}
+
+// ErrInvalidConsensusValue is the typed form of the TaskMailbox custom error InvalidConsensusValue().
+type ErrInvalidConsensusValue struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConsensusValue) Error() string {
+	return "TaskMailbox: InvalidConsensusValue()"
+}
+
+// ErrInvalidCurveType is the typed form of the TaskMailbox custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "TaskMailbox: InvalidCurveType()"
+}
+
+// ErrInvalidFeeReceiver is the typed form of the TaskMailbox custom error InvalidFeeReceiver().
+type ErrInvalidFeeReceiver struct{}
+
+// Error implements the error interface.
+func (ErrInvalidFeeReceiver) Error() string {
+	return "TaskMailbox: InvalidFeeReceiver()"
+}
+
+// ErrInvalidFeeSplit is the typed form of the TaskMailbox custom error InvalidFeeSplit().
+type ErrInvalidFeeSplit struct{}
+
+// Error implements the error interface.
+func (ErrInvalidFeeSplit) Error() string {
+	return "TaskMailbox: InvalidFeeSplit()"
+}
+
+// ErrInvalidMessageHash is the typed form of the TaskMailbox custom error InvalidMessageHash().
+type ErrInvalidMessageHash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMessageHash) Error() string {
+	return "TaskMailbox: InvalidMessageHash()"
+}
+
+// ErrInvalidOperatorSetOwner is the typed form of the TaskMailbox custom error InvalidOperatorSetOwner().
+type ErrInvalidOperatorSetOwner struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSetOwner) Error() string {
+	return "TaskMailbox: InvalidOperatorSetOwner()"
+}
+
+// ErrInvalidReferenceTimestamp is the typed form of the TaskMailbox custom error InvalidReferenceTimestamp().
+type ErrInvalidReferenceTimestamp struct{}
+
+// Error implements the error interface.
+func (ErrInvalidReferenceTimestamp) Error() string {
+	return "TaskMailbox: InvalidReferenceTimestamp()"
+}
+
+// ErrInvalidTaskCreator is the typed form of the TaskMailbox custom error InvalidTaskCreator().
+type ErrInvalidTaskCreator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTaskCreator) Error() string {
+	return "TaskMailbox: InvalidTaskCreator()"
+}
+
+// ErrInvalidTaskStatus is the typed form of the TaskMailbox custom error InvalidTaskStatus(uint8,uint8).
+type ErrInvalidTaskStatus struct {
+	Expected uint8
+	Actual   uint8
+}
+
+// Error implements the error interface.
+func (e ErrInvalidTaskStatus) Error() string {
+	return fmt.Sprintf("TaskMailbox: InvalidTaskStatus(expected: %v, actual: %v)", e.Expected, e.Actual)
+}
+
+// ErrOnlyRefundCollector is the typed form of the TaskMailbox custom error OnlyRefundCollector().
+type ErrOnlyRefundCollector struct{}
+
+// Error implements the error interface.
+func (ErrOnlyRefundCollector) Error() string {
+	return "TaskMailbox: OnlyRefundCollector()"
+}
+
+// ErrPayloadIsEmpty is the typed form of the TaskMailbox custom error PayloadIsEmpty().
+type ErrPayloadIsEmpty struct{}
+
+// Error implements the error interface.
+func (ErrPayloadIsEmpty) Error() string {
+	return "TaskMailbox: PayloadIsEmpty()"
+}
+
+// ErrTaskSLAExceedsMaximum is the typed form of the TaskMailbox custom error TaskSLAExceedsMaximum().
+type ErrTaskSLAExceedsMaximum struct{}
+
+// Error implements the error interface.
+func (ErrTaskSLAExceedsMaximum) Error() string {
+	return "TaskMailbox: TaskSLAExceedsMaximum()"
+}
+
+// ErrThresholdNotMet is the typed form of the TaskMailbox custom error ThresholdNotMet().
+type ErrThresholdNotMet struct{}
+
+// Error implements the error interface.
+func (ErrThresholdNotMet) Error() string {
+	return "TaskMailbox: ThresholdNotMet()"
+}
+
+// ErrTimestampAtCreation is the typed form of the TaskMailbox custom error TimestampAtCreation().
+type ErrTimestampAtCreation struct{}
+
+// Error implements the error interface.
+func (ErrTimestampAtCreation) Error() string {
+	return "TaskMailbox: TimestampAtCreation()"
+}
+
+// TaskMailboxErrors maps the name of every custom error in the TaskMailbox ABI to its typed Go error.
+var TaskMailboxErrors = map[string]error{
+	"CertificateStale":                    ErrCertificateStale{},
+	"EmptyCertificateSignature":           ErrEmptyCertificateSignature{},
+	"ExecutorOperatorSetNotRegistered":    ErrExecutorOperatorSetNotRegistered{},
+	"ExecutorOperatorSetTaskConfigNotSet": ErrExecutorOperatorSetTaskConfigNotSet{},
+	"FeeAlreadyRefunded":                  ErrFeeAlreadyRefunded{},
+	"InvalidAddressZero":                  ErrInvalidAddressZero{},
+	"InvalidConsensusType":                ErrInvalidConsensusType{},
+	"InvalidConsensusValue":               ErrInvalidConsensusValue{},
+	"InvalidCurveType":                    ErrInvalidCurveType{},
+	"InvalidFeeReceiver":                  ErrInvalidFeeReceiver{},
+	"InvalidFeeSplit":                     ErrInvalidFeeSplit{},
+	"InvalidMessageHash":                  ErrInvalidMessageHash{},
+	"InvalidOperatorSetOwner":             ErrInvalidOperatorSetOwner{},
+	"InvalidReferenceTimestamp":           ErrInvalidReferenceTimestamp{},
+	"InvalidTaskCreator":                  ErrInvalidTaskCreator{},
+	"InvalidTaskStatus":                   ErrInvalidTaskStatus{},
+	"OnlyRefundCollector":                 ErrOnlyRefundCollector{},
+	"PayloadIsEmpty":                      ErrPayloadIsEmpty{},
+	"TaskSLAExceedsMaximum":               ErrTaskSLAExceedsMaximum{},
+	"ThresholdNotMet":                     ErrThresholdNotMet{},
+	"TimestampAtCreation":                 ErrTimestampAtCreation{},
+}
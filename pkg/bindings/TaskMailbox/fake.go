@@ -0,0 +1,403 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package TaskMailbox
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeTaskMailbox is an in-memory TaskMailbox for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded TaskMailboxFilterer.
+type FakeTaskMailbox struct {
+	*fake.Contract
+	*TaskMailboxFilterer
+}
+
+// NewFakeTaskMailbox returns an empty fake of the contract at address.
+func NewFakeTaskMailbox(address common.Address) *FakeTaskMailbox {
+	contract := fake.NewContract(TaskMailboxMetaData, address)
+	filterer, err := NewTaskMailboxFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeTaskMailbox{Contract: contract, TaskMailboxFilterer: filterer}
+}
+
+// BN254CERTIFICATEVERIFIER returns the values stubbed with StubBN254CERTIFICATEVERIFIER.
+func (_FakeTaskMailbox *FakeTaskMailbox) BN254CERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeTaskMailbox.Contract.Read("BN254_CERTIFICATE_VERIFIER", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBN254CERTIFICATEVERIFIER sets what BN254CERTIFICATEVERIFIER returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubBN254CERTIFICATEVERIFIER(out0 common.Address) {
+	_FakeTaskMailbox.Contract.Stub("BN254_CERTIFICATE_VERIFIER", []interface{}{}, out0)
+}
+
+// ECDSACERTIFICATEVERIFIER returns the values stubbed with StubECDSACERTIFICATEVERIFIER.
+func (_FakeTaskMailbox *FakeTaskMailbox) ECDSACERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeTaskMailbox.Contract.Read("ECDSA_CERTIFICATE_VERIFIER", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubECDSACERTIFICATEVERIFIER sets what ECDSACERTIFICATEVERIFIER returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubECDSACERTIFICATEVERIFIER(out0 common.Address) {
+	_FakeTaskMailbox.Contract.Stub("ECDSA_CERTIFICATE_VERIFIER", []interface{}{}, out0)
+}
+
+// MAXTASKSLA returns the values stubbed with StubMAXTASKSLA.
+func (_FakeTaskMailbox *FakeTaskMailbox) MAXTASKSLA(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeTaskMailbox.Contract.Read("MAX_TASK_SLA", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXTASKSLA sets what MAXTASKSLA returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubMAXTASKSLA(out0 *big.Int) {
+	_FakeTaskMailbox.Contract.Stub("MAX_TASK_SLA", []interface{}{}, out0)
+}
+
+// FeeSplit returns the values stubbed with StubFeeSplit.
+func (_FakeTaskMailbox *FakeTaskMailbox) FeeSplit(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeTaskMailbox.Contract.Read("feeSplit", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubFeeSplit sets what FeeSplit returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubFeeSplit(out0 uint16) {
+	_FakeTaskMailbox.Contract.Stub("feeSplit", []interface{}{}, out0)
+}
+
+// FeeSplitCollector returns the values stubbed with StubFeeSplitCollector.
+func (_FakeTaskMailbox *FakeTaskMailbox) FeeSplitCollector(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeTaskMailbox.Contract.Read("feeSplitCollector", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubFeeSplitCollector sets what FeeSplitCollector returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubFeeSplitCollector(out0 common.Address) {
+	_FakeTaskMailbox.Contract.Stub("feeSplitCollector", []interface{}{}, out0)
+}
+
+// GetBN254CertificateBytes returns the values stubbed with StubGetBN254CertificateBytes.
+func (_FakeTaskMailbox *FakeTaskMailbox) GetBN254CertificateBytes(opts *bind.CallOpts, cert IBN254CertificateVerifierTypesBN254Certificate) ([]byte, error) {
+	var out0 []byte
+	err := _FakeTaskMailbox.Contract.Read("getBN254CertificateBytes", []interface{}{cert}, &out0)
+	return out0, err
+}
+
+// StubGetBN254CertificateBytes sets what GetBN254CertificateBytes returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubGetBN254CertificateBytes(cert IBN254CertificateVerifierTypesBN254Certificate, out0 []byte) {
+	_FakeTaskMailbox.Contract.Stub("getBN254CertificateBytes", []interface{}{cert}, out0)
+}
+
+// GetECDSACertificateBytes returns the values stubbed with StubGetECDSACertificateBytes.
+func (_FakeTaskMailbox *FakeTaskMailbox) GetECDSACertificateBytes(opts *bind.CallOpts, cert IECDSACertificateVerifierTypesECDSACertificate) ([]byte, error) {
+	var out0 []byte
+	err := _FakeTaskMailbox.Contract.Read("getECDSACertificateBytes", []interface{}{cert}, &out0)
+	return out0, err
+}
+
+// StubGetECDSACertificateBytes sets what GetECDSACertificateBytes returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubGetECDSACertificateBytes(cert IECDSACertificateVerifierTypesECDSACertificate, out0 []byte) {
+	_FakeTaskMailbox.Contract.Stub("getECDSACertificateBytes", []interface{}{cert}, out0)
+}
+
+// GetExecutorOperatorSetTaskConfig returns the values stubbed with StubGetExecutorOperatorSetTaskConfig.
+func (_FakeTaskMailbox *FakeTaskMailbox) GetExecutorOperatorSetTaskConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ITaskMailboxTypesExecutorOperatorSetTaskConfig, error) {
+	var out0 ITaskMailboxTypesExecutorOperatorSetTaskConfig
+	err := _FakeTaskMailbox.Contract.Read("getExecutorOperatorSetTaskConfig", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetExecutorOperatorSetTaskConfig sets what GetExecutorOperatorSetTaskConfig returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubGetExecutorOperatorSetTaskConfig(operatorSet OperatorSet, out0 ITaskMailboxTypesExecutorOperatorSetTaskConfig) {
+	_FakeTaskMailbox.Contract.Stub("getExecutorOperatorSetTaskConfig", []interface{}{operatorSet}, out0)
+}
+
+// GetMessageHash returns the values stubbed with StubGetMessageHash.
+func (_FakeTaskMailbox *FakeTaskMailbox) GetMessageHash(opts *bind.CallOpts, taskHash [32]byte, result []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeTaskMailbox.Contract.Read("getMessageHash", []interface{}{taskHash, result}, &out0)
+	return out0, err
+}
+
+// StubGetMessageHash sets what GetMessageHash returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubGetMessageHash(taskHash [32]byte, result []byte, out0 [32]byte) {
+	_FakeTaskMailbox.Contract.Stub("getMessageHash", []interface{}{taskHash, result}, out0)
+}
+
+// GetTaskInfo returns the values stubbed with StubGetTaskInfo.
+func (_FakeTaskMailbox *FakeTaskMailbox) GetTaskInfo(opts *bind.CallOpts, taskHash [32]byte) (ITaskMailboxTypesTask, error) {
+	var out0 ITaskMailboxTypesTask
+	err := _FakeTaskMailbox.Contract.Read("getTaskInfo", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskInfo sets what GetTaskInfo returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubGetTaskInfo(taskHash [32]byte, out0 ITaskMailboxTypesTask) {
+	_FakeTaskMailbox.Contract.Stub("getTaskInfo", []interface{}{taskHash}, out0)
+}
+
+// GetTaskResult returns the values stubbed with StubGetTaskResult.
+func (_FakeTaskMailbox *FakeTaskMailbox) GetTaskResult(opts *bind.CallOpts, taskHash [32]byte) ([]byte, error) {
+	var out0 []byte
+	err := _FakeTaskMailbox.Contract.Read("getTaskResult", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskResult sets what GetTaskResult returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubGetTaskResult(taskHash [32]byte, out0 []byte) {
+	_FakeTaskMailbox.Contract.Stub("getTaskResult", []interface{}{taskHash}, out0)
+}
+
+// GetTaskStatus returns the values stubbed with StubGetTaskStatus.
+func (_FakeTaskMailbox *FakeTaskMailbox) GetTaskStatus(opts *bind.CallOpts, taskHash [32]byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeTaskMailbox.Contract.Read("getTaskStatus", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskStatus sets what GetTaskStatus returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubGetTaskStatus(taskHash [32]byte, out0 uint8) {
+	_FakeTaskMailbox.Contract.Stub("getTaskStatus", []interface{}{taskHash}, out0)
+}
+
+// IsExecutorOperatorSetRegistered returns the values stubbed with StubIsExecutorOperatorSetRegistered.
+func (_FakeTaskMailbox *FakeTaskMailbox) IsExecutorOperatorSetRegistered(opts *bind.CallOpts, operatorSetKey [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeTaskMailbox.Contract.Read("isExecutorOperatorSetRegistered", []interface{}{operatorSetKey}, &out0)
+	return out0, err
+}
+
+// StubIsExecutorOperatorSetRegistered sets what IsExecutorOperatorSetRegistered returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubIsExecutorOperatorSetRegistered(operatorSetKey [32]byte, out0 bool) {
+	_FakeTaskMailbox.Contract.Stub("isExecutorOperatorSetRegistered", []interface{}{operatorSetKey}, out0)
+}
+
+// Owner returns the values stubbed with StubOwner.
+func (_FakeTaskMailbox *FakeTaskMailbox) Owner(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeTaskMailbox.Contract.Read("owner", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubOwner sets what Owner returns for the given arguments.
+func (_FakeTaskMailbox *FakeTaskMailbox) StubOwner(out0 common.Address) {
+	_FakeTaskMailbox.Contract.Stub("owner", []interface{}{}, out0)
+}
+
+// CreateTask records a createTask call after running the hook set with OnCreateTask.
+func (_FakeTaskMailbox *FakeTaskMailbox) CreateTask(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "createTask", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("createTask").(func(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) error); ok {
+			return hook(opts, taskParams)
+		}
+		return nil
+	}, taskParams)
+}
+
+// OnCreateTask sets a hook run by every CreateTask call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnCreateTask(hook func(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) error) {
+	_FakeTaskMailbox.Contract.SetHook("createTask", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeTaskMailbox *FakeTaskMailbox) Initialize(opts *bind.TransactOpts, _owner common.Address, _feeSplit uint16, _feeSplitCollector common.Address) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("initialize").(func(opts *bind.TransactOpts, _owner common.Address, _feeSplit uint16, _feeSplitCollector common.Address) error); ok {
+			return hook(opts, _owner, _feeSplit, _feeSplitCollector)
+		}
+		return nil
+	}, _owner, _feeSplit, _feeSplitCollector)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnInitialize(hook func(opts *bind.TransactOpts, _owner common.Address, _feeSplit uint16, _feeSplitCollector common.Address) error) {
+	_FakeTaskMailbox.Contract.SetHook("initialize", hook)
+}
+
+// RefundFee records a refundFee call after running the hook set with OnRefundFee.
+func (_FakeTaskMailbox *FakeTaskMailbox) RefundFee(opts *bind.TransactOpts, taskHash [32]byte) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "refundFee", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("refundFee").(func(opts *bind.TransactOpts, taskHash [32]byte) error); ok {
+			return hook(opts, taskHash)
+		}
+		return nil
+	}, taskHash)
+}
+
+// OnRefundFee sets a hook run by every RefundFee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnRefundFee(hook func(opts *bind.TransactOpts, taskHash [32]byte) error) {
+	_FakeTaskMailbox.Contract.SetHook("refundFee", hook)
+}
+
+// RegisterExecutorOperatorSet records a registerExecutorOperatorSet call after running the hook set with OnRegisterExecutorOperatorSet.
+func (_FakeTaskMailbox *FakeTaskMailbox) RegisterExecutorOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "registerExecutorOperatorSet", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("registerExecutorOperatorSet").(func(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) error); ok {
+			return hook(opts, operatorSet, isRegistered)
+		}
+		return nil
+	}, operatorSet, isRegistered)
+}
+
+// OnRegisterExecutorOperatorSet sets a hook run by every RegisterExecutorOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnRegisterExecutorOperatorSet(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) error) {
+	_FakeTaskMailbox.Contract.SetHook("registerExecutorOperatorSet", hook)
+}
+
+// RenounceOwnership records a renounceOwnership call after running the hook set with OnRenounceOwnership.
+func (_FakeTaskMailbox *FakeTaskMailbox) RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "renounceOwnership", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("renounceOwnership").(func(opts *bind.TransactOpts) error); ok {
+			return hook(opts)
+		}
+		return nil
+	})
+}
+
+// OnRenounceOwnership sets a hook run by every RenounceOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnRenounceOwnership(hook func(opts *bind.TransactOpts) error) {
+	_FakeTaskMailbox.Contract.SetHook("renounceOwnership", hook)
+}
+
+// SetExecutorOperatorSetTaskConfig records a setExecutorOperatorSetTaskConfig call after running the hook set with OnSetExecutorOperatorSetTaskConfig.
+func (_FakeTaskMailbox *FakeTaskMailbox) SetExecutorOperatorSetTaskConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "setExecutorOperatorSetTaskConfig", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("setExecutorOperatorSetTaskConfig").(func(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) error); ok {
+			return hook(opts, operatorSet, config)
+		}
+		return nil
+	}, operatorSet, config)
+}
+
+// OnSetExecutorOperatorSetTaskConfig sets a hook run by every SetExecutorOperatorSetTaskConfig call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnSetExecutorOperatorSetTaskConfig(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) error) {
+	_FakeTaskMailbox.Contract.SetHook("setExecutorOperatorSetTaskConfig", hook)
+}
+
+// SetFeeSplit records a setFeeSplit call after running the hook set with OnSetFeeSplit.
+func (_FakeTaskMailbox *FakeTaskMailbox) SetFeeSplit(opts *bind.TransactOpts, _feeSplit uint16) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "setFeeSplit", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("setFeeSplit").(func(opts *bind.TransactOpts, _feeSplit uint16) error); ok {
+			return hook(opts, _feeSplit)
+		}
+		return nil
+	}, _feeSplit)
+}
+
+// OnSetFeeSplit sets a hook run by every SetFeeSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnSetFeeSplit(hook func(opts *bind.TransactOpts, _feeSplit uint16) error) {
+	_FakeTaskMailbox.Contract.SetHook("setFeeSplit", hook)
+}
+
+// SetFeeSplitCollector records a setFeeSplitCollector call after running the hook set with OnSetFeeSplitCollector.
+func (_FakeTaskMailbox *FakeTaskMailbox) SetFeeSplitCollector(opts *bind.TransactOpts, _feeSplitCollector common.Address) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "setFeeSplitCollector", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("setFeeSplitCollector").(func(opts *bind.TransactOpts, _feeSplitCollector common.Address) error); ok {
+			return hook(opts, _feeSplitCollector)
+		}
+		return nil
+	}, _feeSplitCollector)
+}
+
+// OnSetFeeSplitCollector sets a hook run by every SetFeeSplitCollector call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnSetFeeSplitCollector(hook func(opts *bind.TransactOpts, _feeSplitCollector common.Address) error) {
+	_FakeTaskMailbox.Contract.SetHook("setFeeSplitCollector", hook)
+}
+
+// SubmitResult records a submitResult call after running the hook set with OnSubmitResult.
+func (_FakeTaskMailbox *FakeTaskMailbox) SubmitResult(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "submitResult", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("submitResult").(func(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) error); ok {
+			return hook(opts, taskHash, executorCert, result)
+		}
+		return nil
+	}, taskHash, executorCert, result)
+}
+
+// OnSubmitResult sets a hook run by every SubmitResult call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnSubmitResult(hook func(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) error) {
+	_FakeTaskMailbox.Contract.SetHook("submitResult", hook)
+}
+
+// TransferOwnership records a transferOwnership call after running the hook set with OnTransferOwnership.
+func (_FakeTaskMailbox *FakeTaskMailbox) TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error) {
+	return _FakeTaskMailbox.Contract.Transact(opts, "transferOwnership", func() error {
+		if hook, ok := _FakeTaskMailbox.Contract.Hook("transferOwnership").(func(opts *bind.TransactOpts, newOwner common.Address) error); ok {
+			return hook(opts, newOwner)
+		}
+		return nil
+	}, newOwner)
+}
+
+// OnTransferOwnership sets a hook run by every TransferOwnership call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailbox *FakeTaskMailbox) OnTransferOwnership(hook func(opts *bind.TransactOpts, newOwner common.Address) error) {
+	_FakeTaskMailbox.Contract.SetHook("transferOwnership", hook)
+}
+
+// EmitExecutorOperatorSetRegistered appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitExecutorOperatorSetRegistered(event *TaskMailboxExecutorOperatorSetRegistered) {
+	_FakeTaskMailbox.Contract.Emit("ExecutorOperatorSetRegistered", event)
+}
+
+// EmitExecutorOperatorSetTaskConfigSet appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitExecutorOperatorSetTaskConfigSet(event *TaskMailboxExecutorOperatorSetTaskConfigSet) {
+	_FakeTaskMailbox.Contract.Emit("ExecutorOperatorSetTaskConfigSet", event)
+}
+
+// EmitFeeRefunded appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitFeeRefunded(event *TaskMailboxFeeRefunded) {
+	_FakeTaskMailbox.Contract.Emit("FeeRefunded", event)
+}
+
+// EmitFeeSplitCollectorSet appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitFeeSplitCollectorSet(event *TaskMailboxFeeSplitCollectorSet) {
+	_FakeTaskMailbox.Contract.Emit("FeeSplitCollectorSet", event)
+}
+
+// EmitFeeSplitSet appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitFeeSplitSet(event *TaskMailboxFeeSplitSet) {
+	_FakeTaskMailbox.Contract.Emit("FeeSplitSet", event)
+}
+
+// EmitInitialized appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitInitialized(event *TaskMailboxInitialized) {
+	_FakeTaskMailbox.Contract.Emit("Initialized", event)
+}
+
+// EmitOwnershipTransferred appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitOwnershipTransferred(event *TaskMailboxOwnershipTransferred) {
+	_FakeTaskMailbox.Contract.Emit("OwnershipTransferred", event)
+}
+
+// EmitTaskCreated appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitTaskCreated(event *TaskMailboxTaskCreated) {
+	_FakeTaskMailbox.Contract.Emit("TaskCreated", event)
+}
+
+// EmitTaskVerified appends the event to the fake's logs.
+func (_FakeTaskMailbox *FakeTaskMailbox) EmitTaskVerified(event *TaskMailboxTaskVerified) {
+	_FakeTaskMailbox.Contract.Emit("TaskVerified", event)
+}
@@ -0,0 +1,89 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package TaskMailbox
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// TaskMailboxReader is the read-only surface of the TaskMailbox
+// binding, implemented by TaskMailboxCaller, TaskMailbox and FakeTaskMailbox.
+type TaskMailboxReader interface {
+	BN254CERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error)
+	ECDSACERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error)
+	MAXTASKSLA(opts *bind.CallOpts) (*big.Int, error)
+	FeeSplit(opts *bind.CallOpts) (uint16, error)
+	FeeSplitCollector(opts *bind.CallOpts) (common.Address, error)
+	GetBN254CertificateBytes(opts *bind.CallOpts, cert IBN254CertificateVerifierTypesBN254Certificate) ([]byte, error)
+	GetECDSACertificateBytes(opts *bind.CallOpts, cert IECDSACertificateVerifierTypesECDSACertificate) ([]byte, error)
+	GetExecutorOperatorSetTaskConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ITaskMailboxTypesExecutorOperatorSetTaskConfig, error)
+	GetMessageHash(opts *bind.CallOpts, taskHash [32]byte, result []byte) ([32]byte, error)
+	GetTaskInfo(opts *bind.CallOpts, taskHash [32]byte) (ITaskMailboxTypesTask, error)
+	GetTaskResult(opts *bind.CallOpts, taskHash [32]byte) ([]byte, error)
+	GetTaskStatus(opts *bind.CallOpts, taskHash [32]byte) (uint8, error)
+	IsExecutorOperatorSetRegistered(opts *bind.CallOpts, operatorSetKey [32]byte) (bool, error)
+	Owner(opts *bind.CallOpts) (common.Address, error)
+}
+
+// TaskMailboxWriter is the transacting surface of the TaskMailbox
+// binding, implemented by TaskMailboxTransactor, TaskMailbox and FakeTaskMailbox.
+type TaskMailboxWriter interface {
+	CreateTask(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, _owner common.Address, _feeSplit uint16, _feeSplitCollector common.Address) (*types.Transaction, error)
+	RefundFee(opts *bind.TransactOpts, taskHash [32]byte) (*types.Transaction, error)
+	RegisterExecutorOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) (*types.Transaction, error)
+	RenounceOwnership(opts *bind.TransactOpts) (*types.Transaction, error)
+	SetExecutorOperatorSetTaskConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) (*types.Transaction, error)
+	SetFeeSplit(opts *bind.TransactOpts, _feeSplit uint16) (*types.Transaction, error)
+	SetFeeSplitCollector(opts *bind.TransactOpts, _feeSplitCollector common.Address) (*types.Transaction, error)
+	SubmitResult(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) (*types.Transaction, error)
+	TransferOwnership(opts *bind.TransactOpts, newOwner common.Address) (*types.Transaction, error)
+}
+
+// TaskMailboxEvents is the event filtering surface of the TaskMailbox
+// binding, implemented by TaskMailboxFilterer, TaskMailbox and FakeTaskMailbox.
+type TaskMailboxEvents interface {
+	FilterExecutorOperatorSetRegistered(opts *bind.FilterOpts, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (*TaskMailboxExecutorOperatorSetRegisteredIterator, error)
+	WatchExecutorOperatorSetRegistered(opts *bind.WatchOpts, sink chan<- *TaskMailboxExecutorOperatorSetRegistered, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (event.Subscription, error)
+	ParseExecutorOperatorSetRegistered(log types.Log) (*TaskMailboxExecutorOperatorSetRegistered, error)
+	FilterExecutorOperatorSetTaskConfigSet(opts *bind.FilterOpts, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (*TaskMailboxExecutorOperatorSetTaskConfigSetIterator, error)
+	WatchExecutorOperatorSetTaskConfigSet(opts *bind.WatchOpts, sink chan<- *TaskMailboxExecutorOperatorSetTaskConfigSet, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (event.Subscription, error)
+	ParseExecutorOperatorSetTaskConfigSet(log types.Log) (*TaskMailboxExecutorOperatorSetTaskConfigSet, error)
+	FilterFeeRefunded(opts *bind.FilterOpts, refundCollector []common.Address, taskHash [][32]byte) (*TaskMailboxFeeRefundedIterator, error)
+	WatchFeeRefunded(opts *bind.WatchOpts, sink chan<- *TaskMailboxFeeRefunded, refundCollector []common.Address, taskHash [][32]byte) (event.Subscription, error)
+	ParseFeeRefunded(log types.Log) (*TaskMailboxFeeRefunded, error)
+	FilterFeeSplitCollectorSet(opts *bind.FilterOpts, feeSplitCollector []common.Address) (*TaskMailboxFeeSplitCollectorSetIterator, error)
+	WatchFeeSplitCollectorSet(opts *bind.WatchOpts, sink chan<- *TaskMailboxFeeSplitCollectorSet, feeSplitCollector []common.Address) (event.Subscription, error)
+	ParseFeeSplitCollectorSet(log types.Log) (*TaskMailboxFeeSplitCollectorSet, error)
+	FilterFeeSplitSet(opts *bind.FilterOpts) (*TaskMailboxFeeSplitSetIterator, error)
+	WatchFeeSplitSet(opts *bind.WatchOpts, sink chan<- *TaskMailboxFeeSplitSet) (event.Subscription, error)
+	ParseFeeSplitSet(log types.Log) (*TaskMailboxFeeSplitSet, error)
+	FilterInitialized(opts *bind.FilterOpts) (*TaskMailboxInitializedIterator, error)
+	WatchInitialized(opts *bind.WatchOpts, sink chan<- *TaskMailboxInitialized) (event.Subscription, error)
+	ParseInitialized(log types.Log) (*TaskMailboxInitialized, error)
+	FilterOwnershipTransferred(opts *bind.FilterOpts, previousOwner []common.Address, newOwner []common.Address) (*TaskMailboxOwnershipTransferredIterator, error)
+	WatchOwnershipTransferred(opts *bind.WatchOpts, sink chan<- *TaskMailboxOwnershipTransferred, previousOwner []common.Address, newOwner []common.Address) (event.Subscription, error)
+	ParseOwnershipTransferred(log types.Log) (*TaskMailboxOwnershipTransferred, error)
+	FilterTaskCreated(opts *bind.FilterOpts, creator []common.Address, taskHash [][32]byte, avs []common.Address) (*TaskMailboxTaskCreatedIterator, error)
+	WatchTaskCreated(opts *bind.WatchOpts, sink chan<- *TaskMailboxTaskCreated, creator []common.Address, taskHash [][32]byte, avs []common.Address) (event.Subscription, error)
+	ParseTaskCreated(log types.Log) (*TaskMailboxTaskCreated, error)
+	FilterTaskVerified(opts *bind.FilterOpts, aggregator []common.Address, taskHash [][32]byte, avs []common.Address) (*TaskMailboxTaskVerifiedIterator, error)
+	WatchTaskVerified(opts *bind.WatchOpts, sink chan<- *TaskMailboxTaskVerified, aggregator []common.Address, taskHash [][32]byte, avs []common.Address) (event.Subscription, error)
+	ParseTaskVerified(log types.Log) (*TaskMailboxTaskVerified, error)
+}
+
+var (
+	_ TaskMailboxReader = (*TaskMailboxCaller)(nil)
+	_ TaskMailboxReader = (*TaskMailbox)(nil)
+	_ TaskMailboxReader = (*FakeTaskMailbox)(nil)
+	_ TaskMailboxWriter = (*TaskMailboxTransactor)(nil)
+	_ TaskMailboxWriter = (*TaskMailbox)(nil)
+	_ TaskMailboxWriter = (*FakeTaskMailbox)(nil)
+	_ TaskMailboxEvents = (*TaskMailboxFilterer)(nil)
+	_ TaskMailboxEvents = (*TaskMailbox)(nil)
+	_ TaskMailboxEvents = (*FakeTaskMailbox)(nil)
+)
@@ -0,0 +1,203 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package TaskMailboxStorage
+
+import (
+	"fmt"
+)
+
+// ErrCertificateStale is the typed form of the TaskMailboxStorage custom error CertificateStale().
+type ErrCertificateStale struct{}
+
+// Error implements the error interface.
+func (ErrCertificateStale) Error() string {
+	return "TaskMailboxStorage: CertificateStale()"
+}
+
+// ErrEmptyCertificateSignature is the typed form of the TaskMailboxStorage custom error EmptyCertificateSignature().
+type ErrEmptyCertificateSignature struct{}
+
+// Error implements the error interface.
+func (ErrEmptyCertificateSignature) Error() string {
+	return "TaskMailboxStorage: EmptyCertificateSignature()"
+}
+
+// ErrExecutorOperatorSetNotRegistered is the typed form of the TaskMailboxStorage custom error ExecutorOperatorSetNotRegistered().
+type ErrExecutorOperatorSetNotRegistered struct{}
+
+// Error implements the error interface.
+func (ErrExecutorOperatorSetNotRegistered) Error() string {
+	return "TaskMailboxStorage: ExecutorOperatorSetNotRegistered()"
+}
+
+// ErrExecutorOperatorSetTaskConfigNotSet is the typed form of the TaskMailboxStorage custom error ExecutorOperatorSetTaskConfigNotSet().
+type ErrExecutorOperatorSetTaskConfigNotSet struct{}
+
+// Error implements the error interface.
+func (ErrExecutorOperatorSetTaskConfigNotSet) Error() string {
+	return "TaskMailboxStorage: ExecutorOperatorSetTaskConfigNotSet()"
+}
+
+// ErrFeeAlreadyRefunded is the typed form of the TaskMailboxStorage custom error FeeAlreadyRefunded().
+type ErrFeeAlreadyRefunded struct{}
+
+// Error implements the error interface.
+func (ErrFeeAlreadyRefunded) Error() string {
+	return "TaskMailboxStorage: FeeAlreadyRefunded()"
+}
+
+// ErrInvalidAddressZero is the typed form of the TaskMailboxStorage custom error InvalidAddressZero().
+type ErrInvalidAddressZero struct{}
+
+// Error implements the error interface.
+func (ErrInvalidAddressZero) Error() string {
+	return "TaskMailboxStorage: InvalidAddressZero()"
+}
+
+// ErrInvalidConsensusType is the typed form of the TaskMailboxStorage custom error InvalidConsensusType().
+type ErrInvalidConsensusType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConsensusType) Error() string {
+	return "TaskMailboxStorage: InvalidConsensusType()"
+}
+
+// ErrInvalidConsensusValue is the typed form of the TaskMailboxStorage custom error InvalidConsensusValue().
+type ErrInvalidConsensusValue struct{}
+
+// Error implements the error interface.
+func (ErrInvalidConsensusValue) Error() string {
+	return "TaskMailboxStorage: InvalidConsensusValue()"
+}
+
+// ErrInvalidCurveType is the typed form of the TaskMailboxStorage custom error InvalidCurveType().
+type ErrInvalidCurveType struct{}
+
+// Error implements the error interface.
+func (ErrInvalidCurveType) Error() string {
+	return "TaskMailboxStorage: InvalidCurveType()"
+}
+
+// ErrInvalidFeeReceiver is the typed form of the TaskMailboxStorage custom error InvalidFeeReceiver().
+type ErrInvalidFeeReceiver struct{}
+
+// Error implements the error interface.
+func (ErrInvalidFeeReceiver) Error() string {
+	return "TaskMailboxStorage: InvalidFeeReceiver()"
+}
+
+// ErrInvalidFeeSplit is the typed form of the TaskMailboxStorage custom error InvalidFeeSplit().
+type ErrInvalidFeeSplit struct{}
+
+// Error implements the error interface.
+func (ErrInvalidFeeSplit) Error() string {
+	return "TaskMailboxStorage: InvalidFeeSplit()"
+}
+
+// ErrInvalidMessageHash is the typed form of the TaskMailboxStorage custom error InvalidMessageHash().
+type ErrInvalidMessageHash struct{}
+
+// Error implements the error interface.
+func (ErrInvalidMessageHash) Error() string {
+	return "TaskMailboxStorage: InvalidMessageHash()"
+}
+
+// ErrInvalidOperatorSetOwner is the typed form of the TaskMailboxStorage custom error InvalidOperatorSetOwner().
+type ErrInvalidOperatorSetOwner struct{}
+
+// Error implements the error interface.
+func (ErrInvalidOperatorSetOwner) Error() string {
+	return "TaskMailboxStorage: InvalidOperatorSetOwner()"
+}
+
+// ErrInvalidReferenceTimestamp is the typed form of the TaskMailboxStorage custom error InvalidReferenceTimestamp().
+type ErrInvalidReferenceTimestamp struct{}
+
+// Error implements the error interface.
+func (ErrInvalidReferenceTimestamp) Error() string {
+	return "TaskMailboxStorage: InvalidReferenceTimestamp()"
+}
+
+// ErrInvalidTaskCreator is the typed form of the TaskMailboxStorage custom error InvalidTaskCreator().
+type ErrInvalidTaskCreator struct{}
+
+// Error implements the error interface.
+func (ErrInvalidTaskCreator) Error() string {
+	return "TaskMailboxStorage: InvalidTaskCreator()"
+}
+
+// ErrInvalidTaskStatus is the typed form of the TaskMailboxStorage custom error InvalidTaskStatus(uint8,uint8).
+type ErrInvalidTaskStatus struct {
+	Expected uint8
+	Actual   uint8
+}
+
+// Error implements the error interface.
+func (e ErrInvalidTaskStatus) Error() string {
+	return fmt.Sprintf("TaskMailboxStorage: InvalidTaskStatus(expected: %v, actual: %v)", e.Expected, e.Actual)
+}
+
+// ErrOnlyRefundCollector is the typed form of the TaskMailboxStorage custom error OnlyRefundCollector().
+type ErrOnlyRefundCollector struct{}
+
+// Error implements the error interface.
+func (ErrOnlyRefundCollector) Error() string {
+	return "TaskMailboxStorage: OnlyRefundCollector()"
+}
+
+// ErrPayloadIsEmpty is the typed form of the TaskMailboxStorage custom error PayloadIsEmpty().
+type ErrPayloadIsEmpty struct{}
+
+// Error implements the error interface.
+func (ErrPayloadIsEmpty) Error() string {
+	return "TaskMailboxStorage: PayloadIsEmpty()"
+}
+
+// ErrTaskSLAExceedsMaximum is the typed form of the TaskMailboxStorage custom error TaskSLAExceedsMaximum().
+type ErrTaskSLAExceedsMaximum struct{}
+
+// Error implements the error interface.
+func (ErrTaskSLAExceedsMaximum) Error() string {
+	return "TaskMailboxStorage: TaskSLAExceedsMaximum()"
+}
+
+// ErrThresholdNotMet is the typed form of the TaskMailboxStorage custom error ThresholdNotMet().
+type ErrThresholdNotMet struct{}
+
+// Error implements the error interface.
+func (ErrThresholdNotMet) Error() string {
+	return "TaskMailboxStorage: ThresholdNotMet()"
+}
+
+// ErrTimestampAtCreation is the typed form of the TaskMailboxStorage custom error TimestampAtCreation().
+type ErrTimestampAtCreation struct{}
+
+// Error implements the error interface.
+func (ErrTimestampAtCreation) Error() string {
+	return "TaskMailboxStorage: TimestampAtCreation()"
+}
+
+// TaskMailboxStorageErrors maps the name of every custom error in the TaskMailboxStorage ABI to its typed Go error.
+var TaskMailboxStorageErrors = map[string]error{
+	"CertificateStale":                    ErrCertificateStale{},
+	"EmptyCertificateSignature":           ErrEmptyCertificateSignature{},
+	"ExecutorOperatorSetNotRegistered":    ErrExecutorOperatorSetNotRegistered{},
+	"ExecutorOperatorSetTaskConfigNotSet": ErrExecutorOperatorSetTaskConfigNotSet{},
+	"FeeAlreadyRefunded":                  ErrFeeAlreadyRefunded{},
+	"InvalidAddressZero":                  ErrInvalidAddressZero{},
+	"InvalidConsensusType":                ErrInvalidConsensusType{},
+	"InvalidConsensusValue":               ErrInvalidConsensusValue{},
+	"InvalidCurveType":                    ErrInvalidCurveType{},
+	"InvalidFeeReceiver":                  ErrInvalidFeeReceiver{},
+	"InvalidFeeSplit":                     ErrInvalidFeeSplit{},
+	"InvalidMessageHash":                  ErrInvalidMessageHash{},
+	"InvalidOperatorSetOwner":             ErrInvalidOperatorSetOwner{},
+	"InvalidReferenceTimestamp":           ErrInvalidReferenceTimestamp{},
+	"InvalidTaskCreator":                  ErrInvalidTaskCreator{},
+	"InvalidTaskStatus":                   ErrInvalidTaskStatus{},
+	"OnlyRefundCollector":                 ErrOnlyRefundCollector{},
+	"PayloadIsEmpty":                      ErrPayloadIsEmpty{},
+	"TaskSLAExceedsMaximum":               ErrTaskSLAExceedsMaximum{},
+	"ThresholdNotMet":                     ErrThresholdNotMet{},
+	"TimestampAtCreation":                 ErrTimestampAtCreation{},
+}
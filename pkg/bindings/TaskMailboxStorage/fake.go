@@ -0,0 +1,349 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package TaskMailboxStorage
+
+import (
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/fake"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"math/big"
+)
+
+// FakeTaskMailboxStorage is an in-memory TaskMailboxStorage for unit tests.
+// Reads return the values set with the Stub methods, or zero values; writes
+// are recorded and run the hooks set with the On methods; events appended
+// with the Emit methods are served by the embedded TaskMailboxStorageFilterer.
+type FakeTaskMailboxStorage struct {
+	*fake.Contract
+	*TaskMailboxStorageFilterer
+}
+
+// NewFakeTaskMailboxStorage returns an empty fake of the contract at address.
+func NewFakeTaskMailboxStorage(address common.Address) *FakeTaskMailboxStorage {
+	contract := fake.NewContract(TaskMailboxStorageMetaData, address)
+	filterer, err := NewTaskMailboxStorageFilterer(address, contract.Logs())
+	if err != nil {
+		panic(err)
+	}
+	return &FakeTaskMailboxStorage{Contract: contract, TaskMailboxStorageFilterer: filterer}
+}
+
+// BN254CERTIFICATEVERIFIER returns the values stubbed with StubBN254CERTIFICATEVERIFIER.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) BN254CERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeTaskMailboxStorage.Contract.Read("BN254_CERTIFICATE_VERIFIER", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubBN254CERTIFICATEVERIFIER sets what BN254CERTIFICATEVERIFIER returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubBN254CERTIFICATEVERIFIER(out0 common.Address) {
+	_FakeTaskMailboxStorage.Contract.Stub("BN254_CERTIFICATE_VERIFIER", []interface{}{}, out0)
+}
+
+// ECDSACERTIFICATEVERIFIER returns the values stubbed with StubECDSACERTIFICATEVERIFIER.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) ECDSACERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeTaskMailboxStorage.Contract.Read("ECDSA_CERTIFICATE_VERIFIER", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubECDSACERTIFICATEVERIFIER sets what ECDSACERTIFICATEVERIFIER returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubECDSACERTIFICATEVERIFIER(out0 common.Address) {
+	_FakeTaskMailboxStorage.Contract.Stub("ECDSA_CERTIFICATE_VERIFIER", []interface{}{}, out0)
+}
+
+// MAXTASKSLA returns the values stubbed with StubMAXTASKSLA.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) MAXTASKSLA(opts *bind.CallOpts) (*big.Int, error) {
+	var out0 *big.Int
+	err := _FakeTaskMailboxStorage.Contract.Read("MAX_TASK_SLA", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubMAXTASKSLA sets what MAXTASKSLA returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubMAXTASKSLA(out0 *big.Int) {
+	_FakeTaskMailboxStorage.Contract.Stub("MAX_TASK_SLA", []interface{}{}, out0)
+}
+
+// FeeSplit returns the values stubbed with StubFeeSplit.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) FeeSplit(opts *bind.CallOpts) (uint16, error) {
+	var out0 uint16
+	err := _FakeTaskMailboxStorage.Contract.Read("feeSplit", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubFeeSplit sets what FeeSplit returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubFeeSplit(out0 uint16) {
+	_FakeTaskMailboxStorage.Contract.Stub("feeSplit", []interface{}{}, out0)
+}
+
+// FeeSplitCollector returns the values stubbed with StubFeeSplitCollector.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) FeeSplitCollector(opts *bind.CallOpts) (common.Address, error) {
+	var out0 common.Address
+	err := _FakeTaskMailboxStorage.Contract.Read("feeSplitCollector", []interface{}{}, &out0)
+	return out0, err
+}
+
+// StubFeeSplitCollector sets what FeeSplitCollector returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubFeeSplitCollector(out0 common.Address) {
+	_FakeTaskMailboxStorage.Contract.Stub("feeSplitCollector", []interface{}{}, out0)
+}
+
+// GetBN254CertificateBytes returns the values stubbed with StubGetBN254CertificateBytes.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) GetBN254CertificateBytes(opts *bind.CallOpts, cert IBN254CertificateVerifierTypesBN254Certificate) ([]byte, error) {
+	var out0 []byte
+	err := _FakeTaskMailboxStorage.Contract.Read("getBN254CertificateBytes", []interface{}{cert}, &out0)
+	return out0, err
+}
+
+// StubGetBN254CertificateBytes sets what GetBN254CertificateBytes returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubGetBN254CertificateBytes(cert IBN254CertificateVerifierTypesBN254Certificate, out0 []byte) {
+	_FakeTaskMailboxStorage.Contract.Stub("getBN254CertificateBytes", []interface{}{cert}, out0)
+}
+
+// GetECDSACertificateBytes returns the values stubbed with StubGetECDSACertificateBytes.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) GetECDSACertificateBytes(opts *bind.CallOpts, cert IECDSACertificateVerifierTypesECDSACertificate) ([]byte, error) {
+	var out0 []byte
+	err := _FakeTaskMailboxStorage.Contract.Read("getECDSACertificateBytes", []interface{}{cert}, &out0)
+	return out0, err
+}
+
+// StubGetECDSACertificateBytes sets what GetECDSACertificateBytes returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubGetECDSACertificateBytes(cert IECDSACertificateVerifierTypesECDSACertificate, out0 []byte) {
+	_FakeTaskMailboxStorage.Contract.Stub("getECDSACertificateBytes", []interface{}{cert}, out0)
+}
+
+// GetExecutorOperatorSetTaskConfig returns the values stubbed with StubGetExecutorOperatorSetTaskConfig.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) GetExecutorOperatorSetTaskConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ITaskMailboxTypesExecutorOperatorSetTaskConfig, error) {
+	var out0 ITaskMailboxTypesExecutorOperatorSetTaskConfig
+	err := _FakeTaskMailboxStorage.Contract.Read("getExecutorOperatorSetTaskConfig", []interface{}{operatorSet}, &out0)
+	return out0, err
+}
+
+// StubGetExecutorOperatorSetTaskConfig sets what GetExecutorOperatorSetTaskConfig returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubGetExecutorOperatorSetTaskConfig(operatorSet OperatorSet, out0 ITaskMailboxTypesExecutorOperatorSetTaskConfig) {
+	_FakeTaskMailboxStorage.Contract.Stub("getExecutorOperatorSetTaskConfig", []interface{}{operatorSet}, out0)
+}
+
+// GetMessageHash returns the values stubbed with StubGetMessageHash.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) GetMessageHash(opts *bind.CallOpts, taskHash [32]byte, result []byte) ([32]byte, error) {
+	var out0 [32]byte
+	err := _FakeTaskMailboxStorage.Contract.Read("getMessageHash", []interface{}{taskHash, result}, &out0)
+	return out0, err
+}
+
+// StubGetMessageHash sets what GetMessageHash returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubGetMessageHash(taskHash [32]byte, result []byte, out0 [32]byte) {
+	_FakeTaskMailboxStorage.Contract.Stub("getMessageHash", []interface{}{taskHash, result}, out0)
+}
+
+// GetTaskInfo returns the values stubbed with StubGetTaskInfo.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) GetTaskInfo(opts *bind.CallOpts, taskHash [32]byte) (ITaskMailboxTypesTask, error) {
+	var out0 ITaskMailboxTypesTask
+	err := _FakeTaskMailboxStorage.Contract.Read("getTaskInfo", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskInfo sets what GetTaskInfo returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubGetTaskInfo(taskHash [32]byte, out0 ITaskMailboxTypesTask) {
+	_FakeTaskMailboxStorage.Contract.Stub("getTaskInfo", []interface{}{taskHash}, out0)
+}
+
+// GetTaskResult returns the values stubbed with StubGetTaskResult.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) GetTaskResult(opts *bind.CallOpts, taskHash [32]byte) ([]byte, error) {
+	var out0 []byte
+	err := _FakeTaskMailboxStorage.Contract.Read("getTaskResult", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskResult sets what GetTaskResult returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubGetTaskResult(taskHash [32]byte, out0 []byte) {
+	_FakeTaskMailboxStorage.Contract.Stub("getTaskResult", []interface{}{taskHash}, out0)
+}
+
+// GetTaskStatus returns the values stubbed with StubGetTaskStatus.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) GetTaskStatus(opts *bind.CallOpts, taskHash [32]byte) (uint8, error) {
+	var out0 uint8
+	err := _FakeTaskMailboxStorage.Contract.Read("getTaskStatus", []interface{}{taskHash}, &out0)
+	return out0, err
+}
+
+// StubGetTaskStatus sets what GetTaskStatus returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubGetTaskStatus(taskHash [32]byte, out0 uint8) {
+	_FakeTaskMailboxStorage.Contract.Stub("getTaskStatus", []interface{}{taskHash}, out0)
+}
+
+// IsExecutorOperatorSetRegistered returns the values stubbed with StubIsExecutorOperatorSetRegistered.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) IsExecutorOperatorSetRegistered(opts *bind.CallOpts, operatorSetKey [32]byte) (bool, error) {
+	var out0 bool
+	err := _FakeTaskMailboxStorage.Contract.Read("isExecutorOperatorSetRegistered", []interface{}{operatorSetKey}, &out0)
+	return out0, err
+}
+
+// StubIsExecutorOperatorSetRegistered sets what IsExecutorOperatorSetRegistered returns for the given arguments.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) StubIsExecutorOperatorSetRegistered(operatorSetKey [32]byte, out0 bool) {
+	_FakeTaskMailboxStorage.Contract.Stub("isExecutorOperatorSetRegistered", []interface{}{operatorSetKey}, out0)
+}
+
+// CreateTask records a createTask call after running the hook set with OnCreateTask.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) CreateTask(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) (*types.Transaction, error) {
+	return _FakeTaskMailboxStorage.Contract.Transact(opts, "createTask", func() error {
+		if hook, ok := _FakeTaskMailboxStorage.Contract.Hook("createTask").(func(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) error); ok {
+			return hook(opts, taskParams)
+		}
+		return nil
+	}, taskParams)
+}
+
+// OnCreateTask sets a hook run by every CreateTask call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) OnCreateTask(hook func(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) error) {
+	_FakeTaskMailboxStorage.Contract.SetHook("createTask", hook)
+}
+
+// Initialize records a initialize call after running the hook set with OnInitialize.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) Initialize(opts *bind.TransactOpts, owner common.Address, feeSplit uint16, feeSplitCollector common.Address) (*types.Transaction, error) {
+	return _FakeTaskMailboxStorage.Contract.Transact(opts, "initialize", func() error {
+		if hook, ok := _FakeTaskMailboxStorage.Contract.Hook("initialize").(func(opts *bind.TransactOpts, owner common.Address, feeSplit uint16, feeSplitCollector common.Address) error); ok {
+			return hook(opts, owner, feeSplit, feeSplitCollector)
+		}
+		return nil
+	}, owner, feeSplit, feeSplitCollector)
+}
+
+// OnInitialize sets a hook run by every Initialize call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) OnInitialize(hook func(opts *bind.TransactOpts, owner common.Address, feeSplit uint16, feeSplitCollector common.Address) error) {
+	_FakeTaskMailboxStorage.Contract.SetHook("initialize", hook)
+}
+
+// RefundFee records a refundFee call after running the hook set with OnRefundFee.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) RefundFee(opts *bind.TransactOpts, taskHash [32]byte) (*types.Transaction, error) {
+	return _FakeTaskMailboxStorage.Contract.Transact(opts, "refundFee", func() error {
+		if hook, ok := _FakeTaskMailboxStorage.Contract.Hook("refundFee").(func(opts *bind.TransactOpts, taskHash [32]byte) error); ok {
+			return hook(opts, taskHash)
+		}
+		return nil
+	}, taskHash)
+}
+
+// OnRefundFee sets a hook run by every RefundFee call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) OnRefundFee(hook func(opts *bind.TransactOpts, taskHash [32]byte) error) {
+	_FakeTaskMailboxStorage.Contract.SetHook("refundFee", hook)
+}
+
+// RegisterExecutorOperatorSet records a registerExecutorOperatorSet call after running the hook set with OnRegisterExecutorOperatorSet.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) RegisterExecutorOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) (*types.Transaction, error) {
+	return _FakeTaskMailboxStorage.Contract.Transact(opts, "registerExecutorOperatorSet", func() error {
+		if hook, ok := _FakeTaskMailboxStorage.Contract.Hook("registerExecutorOperatorSet").(func(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) error); ok {
+			return hook(opts, operatorSet, isRegistered)
+		}
+		return nil
+	}, operatorSet, isRegistered)
+}
+
+// OnRegisterExecutorOperatorSet sets a hook run by every RegisterExecutorOperatorSet call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) OnRegisterExecutorOperatorSet(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) error) {
+	_FakeTaskMailboxStorage.Contract.SetHook("registerExecutorOperatorSet", hook)
+}
+
+// SetExecutorOperatorSetTaskConfig records a setExecutorOperatorSetTaskConfig call after running the hook set with OnSetExecutorOperatorSetTaskConfig.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) SetExecutorOperatorSetTaskConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) (*types.Transaction, error) {
+	return _FakeTaskMailboxStorage.Contract.Transact(opts, "setExecutorOperatorSetTaskConfig", func() error {
+		if hook, ok := _FakeTaskMailboxStorage.Contract.Hook("setExecutorOperatorSetTaskConfig").(func(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) error); ok {
+			return hook(opts, operatorSet, config)
+		}
+		return nil
+	}, operatorSet, config)
+}
+
+// OnSetExecutorOperatorSetTaskConfig sets a hook run by every SetExecutorOperatorSetTaskConfig call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) OnSetExecutorOperatorSetTaskConfig(hook func(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) error) {
+	_FakeTaskMailboxStorage.Contract.SetHook("setExecutorOperatorSetTaskConfig", hook)
+}
+
+// SetFeeSplit records a setFeeSplit call after running the hook set with OnSetFeeSplit.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) SetFeeSplit(opts *bind.TransactOpts, feeSplit uint16) (*types.Transaction, error) {
+	return _FakeTaskMailboxStorage.Contract.Transact(opts, "setFeeSplit", func() error {
+		if hook, ok := _FakeTaskMailboxStorage.Contract.Hook("setFeeSplit").(func(opts *bind.TransactOpts, feeSplit uint16) error); ok {
+			return hook(opts, feeSplit)
+		}
+		return nil
+	}, feeSplit)
+}
+
+// OnSetFeeSplit sets a hook run by every SetFeeSplit call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) OnSetFeeSplit(hook func(opts *bind.TransactOpts, feeSplit uint16) error) {
+	_FakeTaskMailboxStorage.Contract.SetHook("setFeeSplit", hook)
+}
+
+// SetFeeSplitCollector records a setFeeSplitCollector call after running the hook set with OnSetFeeSplitCollector.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) SetFeeSplitCollector(opts *bind.TransactOpts, feeSplitCollector common.Address) (*types.Transaction, error) {
+	return _FakeTaskMailboxStorage.Contract.Transact(opts, "setFeeSplitCollector", func() error {
+		if hook, ok := _FakeTaskMailboxStorage.Contract.Hook("setFeeSplitCollector").(func(opts *bind.TransactOpts, feeSplitCollector common.Address) error); ok {
+			return hook(opts, feeSplitCollector)
+		}
+		return nil
+	}, feeSplitCollector)
+}
+
+// OnSetFeeSplitCollector sets a hook run by every SetFeeSplitCollector call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) OnSetFeeSplitCollector(hook func(opts *bind.TransactOpts, feeSplitCollector common.Address) error) {
+	_FakeTaskMailboxStorage.Contract.SetHook("setFeeSplitCollector", hook)
+}
+
+// SubmitResult records a submitResult call after running the hook set with OnSubmitResult.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) SubmitResult(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) (*types.Transaction, error) {
+	return _FakeTaskMailboxStorage.Contract.Transact(opts, "submitResult", func() error {
+		if hook, ok := _FakeTaskMailboxStorage.Contract.Hook("submitResult").(func(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) error); ok {
+			return hook(opts, taskHash, executorCert, result)
+		}
+		return nil
+	}, taskHash, executorCert, result)
+}
+
+// OnSubmitResult sets a hook run by every SubmitResult call, e.g. to update stubbed
+// reads. A non-nil error fails the call.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) OnSubmitResult(hook func(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) error) {
+	_FakeTaskMailboxStorage.Contract.SetHook("submitResult", hook)
+}
+
+// EmitExecutorOperatorSetRegistered appends the event to the fake's logs.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) EmitExecutorOperatorSetRegistered(event *TaskMailboxStorageExecutorOperatorSetRegistered) {
+	_FakeTaskMailboxStorage.Contract.Emit("ExecutorOperatorSetRegistered", event)
+}
+
+// EmitExecutorOperatorSetTaskConfigSet appends the event to the fake's logs.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) EmitExecutorOperatorSetTaskConfigSet(event *TaskMailboxStorageExecutorOperatorSetTaskConfigSet) {
+	_FakeTaskMailboxStorage.Contract.Emit("ExecutorOperatorSetTaskConfigSet", event)
+}
+
+// EmitFeeRefunded appends the event to the fake's logs.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) EmitFeeRefunded(event *TaskMailboxStorageFeeRefunded) {
+	_FakeTaskMailboxStorage.Contract.Emit("FeeRefunded", event)
+}
+
+// EmitFeeSplitCollectorSet appends the event to the fake's logs.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) EmitFeeSplitCollectorSet(event *TaskMailboxStorageFeeSplitCollectorSet) {
+	_FakeTaskMailboxStorage.Contract.Emit("FeeSplitCollectorSet", event)
+}
+
+// EmitFeeSplitSet appends the event to the fake's logs.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) EmitFeeSplitSet(event *TaskMailboxStorageFeeSplitSet) {
+	_FakeTaskMailboxStorage.Contract.Emit("FeeSplitSet", event)
+}
+
+// EmitTaskCreated appends the event to the fake's logs.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) EmitTaskCreated(event *TaskMailboxStorageTaskCreated) {
+	_FakeTaskMailboxStorage.Contract.Emit("TaskCreated", event)
+}
+
+// EmitTaskVerified appends the event to the fake's logs.
+func (_FakeTaskMailboxStorage *FakeTaskMailboxStorage) EmitTaskVerified(event *TaskMailboxStorageTaskVerified) {
+	_FakeTaskMailboxStorage.Contract.Emit("TaskVerified", event)
+}
@@ -0,0 +1,80 @@
+// Code generated by bindgen - DO NOT EDIT.
+
+package TaskMailboxStorage
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"math/big"
+)
+
+// TaskMailboxStorageReader is the read-only surface of the TaskMailboxStorage
+// binding, implemented by TaskMailboxStorageCaller, TaskMailboxStorage and FakeTaskMailboxStorage.
+type TaskMailboxStorageReader interface {
+	BN254CERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error)
+	ECDSACERTIFICATEVERIFIER(opts *bind.CallOpts) (common.Address, error)
+	MAXTASKSLA(opts *bind.CallOpts) (*big.Int, error)
+	FeeSplit(opts *bind.CallOpts) (uint16, error)
+	FeeSplitCollector(opts *bind.CallOpts) (common.Address, error)
+	GetBN254CertificateBytes(opts *bind.CallOpts, cert IBN254CertificateVerifierTypesBN254Certificate) ([]byte, error)
+	GetECDSACertificateBytes(opts *bind.CallOpts, cert IECDSACertificateVerifierTypesECDSACertificate) ([]byte, error)
+	GetExecutorOperatorSetTaskConfig(opts *bind.CallOpts, operatorSet OperatorSet) (ITaskMailboxTypesExecutorOperatorSetTaskConfig, error)
+	GetMessageHash(opts *bind.CallOpts, taskHash [32]byte, result []byte) ([32]byte, error)
+	GetTaskInfo(opts *bind.CallOpts, taskHash [32]byte) (ITaskMailboxTypesTask, error)
+	GetTaskResult(opts *bind.CallOpts, taskHash [32]byte) ([]byte, error)
+	GetTaskStatus(opts *bind.CallOpts, taskHash [32]byte) (uint8, error)
+	IsExecutorOperatorSetRegistered(opts *bind.CallOpts, operatorSetKey [32]byte) (bool, error)
+}
+
+// TaskMailboxStorageWriter is the transacting surface of the TaskMailboxStorage
+// binding, implemented by TaskMailboxStorageTransactor, TaskMailboxStorage and FakeTaskMailboxStorage.
+type TaskMailboxStorageWriter interface {
+	CreateTask(opts *bind.TransactOpts, taskParams ITaskMailboxTypesTaskParams) (*types.Transaction, error)
+	Initialize(opts *bind.TransactOpts, owner common.Address, feeSplit uint16, feeSplitCollector common.Address) (*types.Transaction, error)
+	RefundFee(opts *bind.TransactOpts, taskHash [32]byte) (*types.Transaction, error)
+	RegisterExecutorOperatorSet(opts *bind.TransactOpts, operatorSet OperatorSet, isRegistered bool) (*types.Transaction, error)
+	SetExecutorOperatorSetTaskConfig(opts *bind.TransactOpts, operatorSet OperatorSet, config ITaskMailboxTypesExecutorOperatorSetTaskConfig) (*types.Transaction, error)
+	SetFeeSplit(opts *bind.TransactOpts, feeSplit uint16) (*types.Transaction, error)
+	SetFeeSplitCollector(opts *bind.TransactOpts, feeSplitCollector common.Address) (*types.Transaction, error)
+	SubmitResult(opts *bind.TransactOpts, taskHash [32]byte, executorCert []byte, result []byte) (*types.Transaction, error)
+}
+
+// TaskMailboxStorageEvents is the event filtering surface of the TaskMailboxStorage
+// binding, implemented by TaskMailboxStorageFilterer, TaskMailboxStorage and FakeTaskMailboxStorage.
+type TaskMailboxStorageEvents interface {
+	FilterExecutorOperatorSetRegistered(opts *bind.FilterOpts, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (*TaskMailboxStorageExecutorOperatorSetRegisteredIterator, error)
+	WatchExecutorOperatorSetRegistered(opts *bind.WatchOpts, sink chan<- *TaskMailboxStorageExecutorOperatorSetRegistered, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (event.Subscription, error)
+	ParseExecutorOperatorSetRegistered(log types.Log) (*TaskMailboxStorageExecutorOperatorSetRegistered, error)
+	FilterExecutorOperatorSetTaskConfigSet(opts *bind.FilterOpts, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (*TaskMailboxStorageExecutorOperatorSetTaskConfigSetIterator, error)
+	WatchExecutorOperatorSetTaskConfigSet(opts *bind.WatchOpts, sink chan<- *TaskMailboxStorageExecutorOperatorSetTaskConfigSet, caller []common.Address, avs []common.Address, executorOperatorSetId []uint32) (event.Subscription, error)
+	ParseExecutorOperatorSetTaskConfigSet(log types.Log) (*TaskMailboxStorageExecutorOperatorSetTaskConfigSet, error)
+	FilterFeeRefunded(opts *bind.FilterOpts, refundCollector []common.Address, taskHash [][32]byte) (*TaskMailboxStorageFeeRefundedIterator, error)
+	WatchFeeRefunded(opts *bind.WatchOpts, sink chan<- *TaskMailboxStorageFeeRefunded, refundCollector []common.Address, taskHash [][32]byte) (event.Subscription, error)
+	ParseFeeRefunded(log types.Log) (*TaskMailboxStorageFeeRefunded, error)
+	FilterFeeSplitCollectorSet(opts *bind.FilterOpts, feeSplitCollector []common.Address) (*TaskMailboxStorageFeeSplitCollectorSetIterator, error)
+	WatchFeeSplitCollectorSet(opts *bind.WatchOpts, sink chan<- *TaskMailboxStorageFeeSplitCollectorSet, feeSplitCollector []common.Address) (event.Subscription, error)
+	ParseFeeSplitCollectorSet(log types.Log) (*TaskMailboxStorageFeeSplitCollectorSet, error)
+	FilterFeeSplitSet(opts *bind.FilterOpts) (*TaskMailboxStorageFeeSplitSetIterator, error)
+	WatchFeeSplitSet(opts *bind.WatchOpts, sink chan<- *TaskMailboxStorageFeeSplitSet) (event.Subscription, error)
+	ParseFeeSplitSet(log types.Log) (*TaskMailboxStorageFeeSplitSet, error)
+	FilterTaskCreated(opts *bind.FilterOpts, creator []common.Address, taskHash [][32]byte, avs []common.Address) (*TaskMailboxStorageTaskCreatedIterator, error)
+	WatchTaskCreated(opts *bind.WatchOpts, sink chan<- *TaskMailboxStorageTaskCreated, creator []common.Address, taskHash [][32]byte, avs []common.Address) (event.Subscription, error)
+	ParseTaskCreated(log types.Log) (*TaskMailboxStorageTaskCreated, error)
+	FilterTaskVerified(opts *bind.FilterOpts, aggregator []common.Address, taskHash [][32]byte, avs []common.Address) (*TaskMailboxStorageTaskVerifiedIterator, error)
+	WatchTaskVerified(opts *bind.WatchOpts, sink chan<- *TaskMailboxStorageTaskVerified, aggregator []common.Address, taskHash [][32]byte, avs []common.Address) (event.Subscription, error)
+	ParseTaskVerified(log types.Log) (*TaskMailboxStorageTaskVerified, error)
+}
+
+var (
+	_ TaskMailboxStorageReader = (*TaskMailboxStorageCaller)(nil)
+	_ TaskMailboxStorageReader = (*TaskMailboxStorage)(nil)
+	_ TaskMailboxStorageReader = (*FakeTaskMailboxStorage)(nil)
+	_ TaskMailboxStorageWriter = (*TaskMailboxStorageTransactor)(nil)
+	_ TaskMailboxStorageWriter = (*TaskMailboxStorage)(nil)
+	_ TaskMailboxStorageWriter = (*FakeTaskMailboxStorage)(nil)
+	_ TaskMailboxStorageEvents = (*TaskMailboxStorageFilterer)(nil)
+	_ TaskMailboxStorageEvents = (*TaskMailboxStorage)(nil)
+	_ TaskMailboxStorageEvents = (*FakeTaskMailboxStorage)(nil)
+)
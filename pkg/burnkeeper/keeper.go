@@ -0,0 +1,308 @@
+// Package burnkeeper clears the shares slashes leave with the
+// StrategyManager and records where they went.
+//
+// Every slash adds the slashed shares of each strategy to the
+// StrategyManager under the operator set and slash id, and they stay there
+// until someone calls clearBurnOrRedistributableShares, which withdraws them
+// to the operator set's redistribution recipient, or to the default burn
+// address for operator sets that do not redistribute. A Keeper lists the
+// pending slashes from getPendingOperatorSets and getPendingSlashIds on a
+// schedule, clears them and keeps a Ledger of the tokens each clear sent:
+//
+//	m, err := txmgr.New(ctx, client, auth, txmgr.Config{})
+//	k, err := burnkeeper.New(contracts.StrategyManager, contracts.AllocationManager, m, burnkeeper.Config{})
+//	go k.Run(ctx)
+//	...
+//	err = k.Ledger().WriteCSV(os.Stdout)
+//
+// Beacon chain ETH is slashed through the EigenPodManager instead and is
+// not covered.
+package burnkeeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sync"
+	"time"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/operatorset"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Defaults used for zero Config fields.
+const (
+	DefaultInterval           = time.Hour
+	DefaultMaxStrategiesPerTx = 20
+)
+
+// StrategyManager is the part of the StrategyManager binding a Keeper uses.
+type StrategyManager interface {
+	strategymanager.StrategyManagerReader
+	strategymanager.StrategyManagerWriter
+	strategymanager.StrategyManagerEvents
+}
+
+// Config tunes a Keeper. Zero fields take the Default* values.
+type Config struct {
+	// OperatorSets limits the keeper to these operator sets. Empty clears
+	// every operator set's slashes.
+	OperatorSets []eltypes.OperatorSet
+	// Interval is how often Run clears the pending slashes.
+	Interval time.Duration
+	// MaxStrategiesPerTx bounds the strategies one transaction clears, and
+	// so its gas. Slashes of more strategies are cleared one strategy at a
+	// time with clearBurnOrRedistributableSharesByStrategy.
+	MaxStrategiesPerTx int
+	// Logger receives the errors Run recovers from. It defaults to
+	// slog.Default().
+	Logger *slog.Logger
+}
+
+func (c Config) withDefaults() Config {
+	if c.Interval == 0 {
+		c.Interval = DefaultInterval
+	}
+	if c.MaxStrategiesPerTx == 0 {
+		c.MaxStrategiesPerTx = DefaultMaxStrategiesPerTx
+	}
+	if c.Logger == nil {
+		c.Logger = slog.Default()
+	}
+	return c
+}
+
+// Slash is the shares of one slash still held by the StrategyManager.
+type Slash struct {
+	OperatorSet eltypes.OperatorSet
+	SlashID     *big.Int
+	// Recipient is AllocationManager.getRedistributionRecipient of the
+	// operator set, the default burn address unless it redistributes.
+	Recipient      common.Address
+	Redistributing bool
+	Strategies     []common.Address
+	Shares         []*big.Int
+}
+
+// recipient is an operator set's resolved redistribution recipient.
+type recipient struct {
+	address        common.Address
+	redistributing bool
+}
+
+// Keeper clears pending slashes. It is safe for concurrent use.
+type Keeper struct {
+	sm     StrategyManager
+	am     allocationmanager.AllocationManagerReader
+	sender txmgr.Sender
+	cfg    Config
+	sets   map[operatorset.Set]bool
+
+	// clearing serializes Refresh and Clear, which release mu while they
+	// wait on the chain.
+	clearing   sync.Mutex
+	recipients map[operatorset.Set]recipient
+
+	mu      sync.Mutex
+	pending []Slash
+	ledger  Ledger
+}
+
+// New returns a Keeper clearing the slashes held by sm through sender,
+// resolving recipients from am.
+func New(sm StrategyManager, am allocationmanager.AllocationManagerReader, sender txmgr.Sender, cfg Config) (*Keeper, error) {
+	if sm == nil || am == nil || sender == nil {
+		return nil, errors.New("burnkeeper: nil StrategyManager, AllocationManager or Sender")
+	}
+	cfg = cfg.withDefaults()
+	k := &Keeper{
+		sm:         sm,
+		am:         am,
+		sender:     sender,
+		cfg:        cfg,
+		recipients: make(map[operatorset.Set]recipient),
+	}
+	if len(cfg.OperatorSets) > 0 {
+		k.sets = make(map[operatorset.Set]bool, len(cfg.OperatorSets))
+		for _, set := range cfg.OperatorSets {
+			k.sets[operatorset.From(set)] = true
+		}
+	}
+	return k, nil
+}
+
+// Run clears the pending slashes every Config.Interval until ctx is done.
+// Failures are logged and retried on the next tick.
+func (k *Keeper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(k.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		if err := k.Clear(ctx); err != nil && ctx.Err() == nil {
+			k.cfg.Logger.Warn("Burn keeper clear failed", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Refresh reads the pending slashes and returns them, ordered as the
+// StrategyManager lists operator sets and slash ids.
+func (k *Keeper) Refresh(ctx context.Context) ([]Slash, error) {
+	k.clearing.Lock()
+	defer k.clearing.Unlock()
+	pending, err := k.refresh(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append([]Slash(nil), pending...), nil
+}
+
+// refresh reads the pending slashes and stores them.
+func (k *Keeper) refresh(ctx context.Context) ([]Slash, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	sets, err := k.sm.GetPendingOperatorSets(opts)
+	if err != nil {
+		return nil, fmt.Errorf("burnkeeper: failed to read pending operator sets: %w", err)
+	}
+	var pending []Slash
+	for _, set := range sets {
+		id := operatorset.From(set)
+		if k.sets != nil && !k.sets[id] {
+			continue
+		}
+		r, err := k.recipient(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		slashIDs, err := k.sm.GetPendingSlashIds(opts, set)
+		if err != nil {
+			return nil, fmt.Errorf("burnkeeper: failed to read pending slashes of %s: %w", id, err)
+		}
+		for _, slashID := range slashIDs {
+			strategies, shares, err := k.sm.GetBurnOrRedistributableShares(opts, set, slashID)
+			if err != nil {
+				return nil, fmt.Errorf("burnkeeper: failed to read shares of slash %s of %s: %w", slashID, id, err)
+			}
+			pending = append(pending, Slash{
+				OperatorSet:    set,
+				SlashID:        slashID,
+				Recipient:      r.address,
+				Redistributing: r.redistributing,
+				Strategies:     strategies,
+				Shares:         shares,
+			})
+		}
+	}
+	k.mu.Lock()
+	k.pending = pending
+	k.mu.Unlock()
+	return pending, nil
+}
+
+// recipient resolves an operator set's recipient once; it is fixed when
+// the operator set is created.
+func (k *Keeper) recipient(ctx context.Context, set operatorset.Set) (recipient, error) {
+	if r, ok := k.recipients[set]; ok {
+		return r, nil
+	}
+	opts := &bind.CallOpts{Context: ctx}
+	address, err := k.am.GetRedistributionRecipient(opts, set.Binding())
+	if err != nil {
+		return recipient{}, fmt.Errorf("burnkeeper: failed to read redistribution recipient of %s: %w", set, err)
+	}
+	redistributing, err := k.am.IsRedistributingOperatorSet(opts, set.Binding())
+	if err != nil {
+		return recipient{}, fmt.Errorf("burnkeeper: failed to read whether %s redistributes: %w", set, err)
+	}
+	r := recipient{address: address, redistributing: redistributing}
+	k.recipients[set] = r
+	return r, nil
+}
+
+// Clear reads the pending slashes and clears each of them, recording what
+// was sent in the ledger. A slash that fails to clear stays pending and is
+// retried by the next call.
+func (k *Keeper) Clear(ctx context.Context) error {
+	k.clearing.Lock()
+	defer k.clearing.Unlock()
+	pending, err := k.refresh(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	var remaining []Slash
+	for _, s := range pending {
+		id := operatorset.From(s.OperatorSet)
+		var failed []int
+		if len(s.Strategies) <= k.cfg.MaxStrategiesPerTx {
+			err := k.send(ctx, s, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return k.sm.ClearBurnOrRedistributableShares(opts, s.OperatorSet, s.SlashID)
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("burnkeeper: failed to clear slash %s of %s: %w", s.SlashID, id, err))
+				remaining = append(remaining, s)
+			}
+			continue
+		}
+		for i, strategy := range s.Strategies {
+			err := k.send(ctx, s, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return k.sm.ClearBurnOrRedistributableSharesByStrategy(opts, s.OperatorSet, s.SlashID, strategy)
+			})
+			if err != nil {
+				errs = append(errs, fmt.Errorf("burnkeeper: failed to clear %s of slash %s of %s: %w", strategy.Hex(), s.SlashID, id, err))
+				failed = append(failed, i)
+			}
+		}
+		if len(failed) > 0 {
+			rest := s
+			rest.Strategies, rest.Shares = nil, nil
+			for _, i := range failed {
+				rest.Strategies = append(rest.Strategies, s.Strategies[i])
+				rest.Shares = append(rest.Shares, s.Shares[i])
+			}
+			remaining = append(remaining, rest)
+		}
+	}
+	k.mu.Lock()
+	k.pending = remaining
+	k.mu.Unlock()
+	return errors.Join(errs...)
+}
+
+// send sends a clear of s and records its receipt in the ledger.
+func (k *Keeper) send(ctx context.Context, s Slash, build txmgr.BuildFunc) error {
+	receipt, err := k.sender.SendAndWait(ctx, build)
+	if err != nil {
+		return err
+	}
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.ledger = append(k.ledger, entries(k.sm, s, receipt)...)
+	return nil
+}
+
+// Pending returns the slashes left pending by the last Refresh or Clear.
+func (k *Keeper) Pending() []Slash {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return append([]Slash(nil), k.pending...)
+}
+
+// Ledger returns what the keeper's clears sent, in order.
+func (k *Keeper) Ledger() Ledger {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return append(Ledger(nil), k.ledger...)
+}
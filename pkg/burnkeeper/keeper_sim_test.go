@@ -0,0 +1,128 @@
+//go:build !go1.23 || simulated
+
+package burnkeeper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	backingeigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+type harnessSender struct{ h *harness.Harness }
+
+func (s harnessSender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	return s.h.Send(ctx, build)
+}
+
+// TestKeeperClearsContractSlashes slashes an operator in a burning and a
+// redistributing operator set and checks the keeper clears both, with a
+// ledger matching the token balances of the burn address and the
+// redistribution recipient.
+func TestKeeperClearsContractSlashes(t *testing.T) {
+	h, err := harness.New(harness.Config{MinWithdrawalDelayBlocks: 1})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+	call := &bind.CallOpts{Context: ctx}
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	account := func() *bind.TransactOpts {
+		auth, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+		check(err)
+		return auth
+	}
+
+	strategy, err := h.DeployStrategy(ctx)
+	check(err)
+	strategies := []common.Address{strategy.Address}
+	operator, avs, staker := account(), account(), account()
+	receiver := common.HexToAddress("0x7ec1")
+	check(h.RegisterOperator(ctx, operator))
+	burning, err := h.CreateOperatorSet(ctx, avs, 1, strategies)
+	check(err)
+	redistributing := eltypes.OperatorSet{Avs: avs.From, Id: 2}
+	_, err = h.SendAs(ctx, avs, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.AllocationManager.CreateRedistributingOperatorSets(opts, avs.From, []eltypes.IAllocationManagerTypesCreateSetParams{
+			{OperatorSetId: redistributing.Id, Strategies: strategies},
+		}, []common.Address{receiver})
+	})
+	check(err)
+	check(h.Deposit(ctx, staker, strategy, big.NewInt(4e18)))
+	check(h.Delegate(ctx, staker, operator.From))
+	for _, set := range []eltypes.OperatorSet{burning, redistributing} {
+		check(h.RegisterForOperatorSet(ctx, operator, set))
+		_, err = h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.AllocationManager.ModifyAllocations(opts, operator.From, []eltypes.IAllocationManagerTypesAllocateParams{
+				{OperatorSet: set, Strategies: strategies, NewMagnitudes: []uint64{5e17}},
+			})
+		})
+		check(err)
+	}
+	h.Backend.Commit()
+	for _, set := range []eltypes.OperatorSet{burning, redistributing} {
+		_, err = h.SendAs(ctx, avs, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			// The gas estimate falls short for the redistributing slash.
+			opts.GasLimit = 5_000_000
+			return h.AllocationManager.SlashOperator(opts, avs.From, eltypes.IAllocationManagerTypesSlashingParams{
+				Operator:      operator.From,
+				OperatorSetId: set.Id,
+				Strategies:    strategies,
+				WadsToSlash:   []*big.Int{big.NewInt(5e17)},
+				Description:   "test",
+			})
+		})
+		check(err)
+	}
+
+	k, err := New(h.StrategyManager, h.AllocationManager, harnessSender{h}, Config{})
+	check(err)
+	pending, err := k.Refresh(ctx)
+	check(err)
+	if len(pending) != 2 || pending[0].Redistributing || !pending[1].Redistributing || pending[1].Recipient != receiver {
+		t.Fatalf("Expected a burning and a redistributing slash, got %+v", pending)
+	}
+	check(k.Clear(ctx))
+
+	sets, err := h.StrategyManager.GetPendingOperatorSets(call)
+	check(err)
+	if len(sets) != 0 || len(k.Pending()) != 0 {
+		t.Errorf("Expected nothing pending, got %v", sets)
+	}
+	token, err := backingeigen.NewBackingEigen(strategy.Token, h.Client)
+	check(err)
+	ledger := k.Ledger()
+	if len(ledger) != 2 {
+		t.Fatalf("Expected 2 entries, got %+v", ledger)
+	}
+	for i, e := range ledger {
+		if e.Strategy != strategy.Address || e.Token != strategy.Token || e.Shares.Cmp(pending[i].Shares[0]) != 0 || e.Amount == nil || e.Amount.Sign() == 0 {
+			t.Errorf("Unexpected entry %+v for %+v", e, pending[i])
+		}
+		balance, err := token.BalanceOf(call, e.Recipient)
+		check(err)
+		if balance.Cmp(e.Amount) != 0 {
+			t.Errorf("Expected %s to hold %s, got %s", e.Recipient.Hex(), e.Amount, balance)
+		}
+	}
+	burn, err := h.StrategyManager.DEFAULTBURNADDRESS(call)
+	check(err)
+	if ledger[0].Recipient != burn || ledger[0].Redistributed || ledger[1].Recipient != receiver || !ledger[1].Redistributed {
+		t.Errorf("Expected a burn then a redistribution, got %+v", ledger)
+	}
+}
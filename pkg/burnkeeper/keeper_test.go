@@ -0,0 +1,258 @@
+package burnkeeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	s1            = common.HexToAddress("0x1")
+	s2            = common.HexToAddress("0x2")
+	s3            = common.HexToAddress("0x3")
+	token         = common.HexToAddress("0x70")
+	burn          = common.HexToAddress("0x00000000000000000000000000000000000E16E4")
+	redistributor = common.HexToAddress("0xbeef")
+	setA          = eltypes.OperatorSet{Avs: common.HexToAddress("0xa5"), Id: 1}
+	setB          = eltypes.OperatorSet{Avs: common.HexToAddress("0xa5"), Id: 2}
+)
+
+// sender returns receipts holding the logs the fake emitted while building
+// the transaction.
+type sender struct {
+	sm   *strategymanager.FakeStrategyManager
+	seen int
+}
+
+func (s *sender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	tx, err := build(&bind.TransactOpts{})
+	if err != nil {
+		return nil, err
+	}
+	all := s.sm.Logs().All()
+	receipt := &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful, BlockNumber: big.NewInt(int64(len(all)))}
+	for i := s.seen; i < len(all); i++ {
+		receipt.Logs = append(receipt.Logs, &all[i])
+	}
+	s.seen = len(all)
+	return receipt, nil
+}
+
+// withdraw emits what the StrategyManager does when it clears strategy: the
+// strategy's token transfer to the recipient and the decrease event.
+func withdraw(sm *strategymanager.FakeStrategyManager, set eltypes.OperatorSet, slashID *big.Int, strategy, to common.Address, shares int64) {
+	sm.Logs().Append(types.Log{
+		Address: token,
+		Topics:  []common.Hash{transferTopic, common.BytesToHash(strategy.Bytes()), common.BytesToHash(to.Bytes())},
+		Data:    common.BigToHash(big.NewInt(shares / 2)).Bytes(),
+	})
+	sm.EmitBurnOrRedistributableSharesDecreased(&strategymanager.StrategyManagerBurnOrRedistributableSharesDecreased{
+		OperatorSet: set, SlashId: slashID, Strategy: strategy, Shares: big.NewInt(shares),
+	})
+}
+
+func newKeeper(t *testing.T, cfg Config) (*Keeper, *strategymanager.FakeStrategyManager, *allocationmanager.FakeAllocationManager) {
+	sm := strategymanager.NewFakeStrategyManager(common.HexToAddress("0x5a"))
+	am := allocationmanager.NewFakeAllocationManager(common.HexToAddress("0xa11"))
+	am.StubGetRedistributionRecipient(setA, redistributor)
+	am.StubIsRedistributingOperatorSet(setA, true)
+	am.StubGetRedistributionRecipient(setB, burn)
+	k, err := New(sm, am, &sender{sm: sm}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k, sm, am
+}
+
+// stubPending stubs slash 1 of setA over s1 and s2 and slash 4 of setB over
+// s3, and clears them when asked.
+func stubPending(sm *strategymanager.FakeStrategyManager) {
+	sm.StubGetPendingOperatorSets([]eltypes.OperatorSet{setA, setB})
+	sm.StubGetPendingSlashIds(setA, []*big.Int{big.NewInt(1)})
+	sm.StubGetPendingSlashIds(setB, []*big.Int{big.NewInt(4)})
+	sm.StubGetBurnOrRedistributableShares(setA, big.NewInt(1), []common.Address{s1, s2}, []*big.Int{big.NewInt(100), big.NewInt(200)})
+	sm.StubGetBurnOrRedistributableShares(setB, big.NewInt(4), []common.Address{s3}, []*big.Int{big.NewInt(50)})
+	sm.OnClearBurnOrRedistributableShares(func(opts *bind.TransactOpts, set eltypes.OperatorSet, slashID *big.Int) error {
+		strategies, shares, _ := sm.GetBurnOrRedistributableShares(nil, set, slashID)
+		to := burn
+		if set == setA {
+			to = redistributor
+		}
+		for i, strategy := range strategies {
+			withdraw(sm, set, slashID, strategy, to, shares[i].Int64())
+		}
+		return nil
+	})
+	sm.OnClearBurnOrRedistributableSharesByStrategy(func(opts *bind.TransactOpts, set eltypes.OperatorSet, slashID *big.Int, strategy common.Address) error {
+		withdraw(sm, set, slashID, strategy, redistributor, 100)
+		return nil
+	})
+}
+
+func TestKeeperRefresh(t *testing.T) {
+	k, sm, am := newKeeper(t, Config{})
+	stubPending(sm)
+
+	pending, err := k.Refresh(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Slash{
+		{OperatorSet: setA, SlashID: big.NewInt(1), Recipient: redistributor, Redistributing: true, Strategies: []common.Address{s1, s2}, Shares: []*big.Int{big.NewInt(100), big.NewInt(200)}},
+		{OperatorSet: setB, SlashID: big.NewInt(4), Recipient: burn, Strategies: []common.Address{s3}, Shares: []*big.Int{big.NewInt(50)}},
+	}
+	if !reflect.DeepEqual(pending, want) {
+		t.Errorf("Expected %+v, got %+v", want, pending)
+	}
+
+	// Recipients are read once per operator set.
+	am.Fail("getRedistributionRecipient", errors.New("unavailable"))
+	if _, err := k.Refresh(context.Background()); err != nil {
+		t.Errorf("Expected cached recipients, got %v", err)
+	}
+}
+
+func TestKeeperClear(t *testing.T) {
+	k, sm, _ := newKeeper(t, Config{})
+	stubPending(sm)
+
+	if err := k.Clear(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(sm.Calls("clearBurnOrRedistributableShares")); n != 2 {
+		t.Errorf("Expected 2 clears, got %d", n)
+	}
+	if n := len(k.Pending()); n != 0 {
+		t.Errorf("Expected nothing pending, got %d", n)
+	}
+
+	ledger := k.Ledger()
+	if len(ledger) != 3 {
+		t.Fatalf("Expected 3 entries, got %+v", ledger)
+	}
+	e := ledger[1]
+	if e.OperatorSet != setA || e.SlashID.Int64() != 1 || e.Strategy != s2 || e.Shares.Int64() != 200 ||
+		e.Recipient != redistributor || !e.Redistributed || e.Token != token || e.Amount.Int64() != 100 {
+		t.Errorf("Unexpected entry %+v", e)
+	}
+	if e := ledger[2]; e.Recipient != burn || e.Redistributed || e.Amount.Int64() != 25 {
+		t.Errorf("Expected a burn of 25, got %+v", e)
+	}
+
+	totals := ledger.Totals()
+	if got := totals[redistributor][token]; got == nil || got.Int64() != 150 {
+		t.Errorf("Expected 150 redistributed, got %v", got)
+	}
+	if got := totals[burn][token]; got == nil || got.Int64() != 25 {
+		t.Errorf("Expected 25 burnt, got %v", got)
+	}
+
+	var buf bytes.Buffer
+	if err := ledger.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "block,tx_hash,operator_set,slash_id,") {
+		t.Fatalf("Unexpected CSV:\n%s", buf.String())
+	}
+	if !strings.Contains(lines[3], ",0x00000000000000000000000000000000000000A5:2,4,") || !strings.HasSuffix(lines[3], ",false,"+token.Hex()+",25") {
+		t.Errorf("Unexpected row %q", lines[3])
+	}
+}
+
+func TestKeeperFiltersOperatorSets(t *testing.T) {
+	k, sm, _ := newKeeper(t, Config{OperatorSets: []eltypes.OperatorSet{setB}})
+	stubPending(sm)
+
+	if err := k.Clear(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	calls := sm.Calls("clearBurnOrRedistributableShares")
+	if len(calls) != 1 || calls[0].Args[0] != setB {
+		t.Errorf("Expected only setB cleared, got %+v", calls)
+	}
+}
+
+func TestKeeperClearsByStrategy(t *testing.T) {
+	k, sm, _ := newKeeper(t, Config{OperatorSets: []eltypes.OperatorSet{setA}, MaxStrategiesPerTx: 1})
+	stubPending(sm)
+	sm.OnClearBurnOrRedistributableSharesByStrategy(func(opts *bind.TransactOpts, set eltypes.OperatorSet, slashID *big.Int, strategy common.Address) error {
+		if strategy == s1 {
+			return errors.New("reverted")
+		}
+		withdraw(sm, set, slashID, strategy, redistributor, 200)
+		return nil
+	})
+
+	if err := k.Clear(context.Background()); err == nil {
+		t.Fatal("Expected the failed strategy reported")
+	}
+	if n := len(sm.Calls("clearBurnOrRedistributableShares")); n != 0 {
+		t.Errorf("Expected no whole-slash clears, got %d", n)
+	}
+	calls := sm.Calls("clearBurnOrRedistributableSharesByStrategy")
+	if len(calls) != 1 || calls[0].Args[2] != s2 {
+		t.Errorf("Expected s2 cleared, got %+v", calls)
+	}
+	want := []Slash{{OperatorSet: setA, SlashID: big.NewInt(1), Recipient: redistributor, Redistributing: true, Strategies: []common.Address{s1}, Shares: []*big.Int{big.NewInt(100)}}}
+	if got := k.Pending(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected s1 left pending, got %+v", got)
+	}
+	if ledger := k.Ledger(); len(ledger) != 1 || ledger[0].Strategy != s2 || ledger[0].Amount.Int64() != 100 {
+		t.Errorf("Unexpected ledger %+v", ledger)
+	}
+}
+
+// blockingSender holds every transaction until release is closed.
+type blockingSender struct {
+	*sender
+	sending chan struct{}
+	release chan struct{}
+}
+
+func (s blockingSender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	s.sending <- struct{}{}
+	<-s.release
+	return s.sender.SendAndWait(ctx, build)
+}
+
+func TestKeeperReadableWhileClearing(t *testing.T) {
+	sm := strategymanager.NewFakeStrategyManager(common.HexToAddress("0x5a"))
+	am := allocationmanager.NewFakeAllocationManager(common.HexToAddress("0xa11"))
+	am.StubGetRedistributionRecipient(setB, burn)
+	s := blockingSender{&sender{sm: sm}, make(chan struct{}), make(chan struct{})}
+	k, err := New(sm, am, s, Config{OperatorSets: []eltypes.OperatorSet{setB}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stubPending(sm)
+
+	done := make(chan error)
+	go func() { done <- k.Clear(context.Background()) }()
+	<-s.sending
+	if n := len(k.Pending()); n != 1 {
+		t.Errorf("Expected the slash pending while it is cleared, got %d", n)
+	}
+	if n := len(k.Ledger()); n != 0 {
+		t.Errorf("Expected an empty ledger while clearing, got %d entries", n)
+	}
+	close(s.release)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+	if len(k.Pending()) != 0 || len(k.Ledger()) != 1 {
+		t.Errorf("Expected the slash cleared, got %+v and %+v", k.Pending(), k.Ledger())
+	}
+}
@@ -0,0 +1,127 @@
+package burnkeeper
+
+import (
+	"encoding/csv"
+	"io"
+	"math/big"
+	"strconv"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/operatorset"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// transferTopic is the topic of the ERC20 Transfer event.
+var transferTopic = crypto.Keccak256Hash([]byte("Transfer(address,address,uint256)"))
+
+// Entry records the slashed shares of one strategy leaving the
+// StrategyManager.
+type Entry struct {
+	Block       uint64              `json:"block"`
+	TxHash      common.Hash         `json:"txHash"`
+	OperatorSet eltypes.OperatorSet `json:"operatorSet"`
+	SlashID     *big.Int            `json:"slashId"`
+	Strategy    common.Address      `json:"strategy"`
+	// Shares is the amount in BurnOrRedistributableSharesDecreased.
+	Shares *big.Int `json:"shares"`
+	// Recipient received the tokens; Redistributed is false when it is the
+	// default burn address.
+	Recipient     common.Address `json:"recipient"`
+	Redistributed bool           `json:"redistributed"`
+	// Token and Amount are taken from the underlying token's Transfer event
+	// to Recipient. Amount is nil if the token emitted none.
+	Token  common.Address `json:"token"`
+	Amount *big.Int       `json:"amount"`
+}
+
+// Ledger is a list of entries in the order they were cleared.
+type Ledger []Entry
+
+// entries reads the entries of a clear of s from its receipt: one per
+// BurnOrRedistributableSharesDecreased event, with the Transfer the
+// strategy made just before it.
+func entries(sm StrategyManager, s Slash, receipt *types.Receipt) []Entry {
+	var out []Entry
+	transfers := make(map[common.Address]*types.Log)
+	for _, log := range receipt.Logs {
+		if len(log.Topics) == 3 && log.Topics[0] == transferTopic && common.BytesToAddress(log.Topics[2].Bytes()) == s.Recipient {
+			transfers[common.BytesToAddress(log.Topics[1].Bytes())] = log
+			continue
+		}
+		e, err := sm.ParseBurnOrRedistributableSharesDecreased(*log)
+		if err != nil || e.OperatorSet != s.OperatorSet || e.SlashId.Cmp(s.SlashID) != 0 {
+			continue
+		}
+		entry := Entry{
+			Block:         receipt.BlockNumber.Uint64(),
+			TxHash:        receipt.TxHash,
+			OperatorSet:   s.OperatorSet,
+			SlashID:       s.SlashID,
+			Strategy:      e.Strategy,
+			Shares:        e.Shares,
+			Recipient:     s.Recipient,
+			Redistributed: s.Redistributing,
+		}
+		if t, ok := transfers[e.Strategy]; ok {
+			entry.Token = t.Address
+			entry.Amount = new(big.Int).SetBytes(t.Data)
+			delete(transfers, e.Strategy)
+		}
+		out = append(out, entry)
+	}
+	return out
+}
+
+// Totals sums the amounts sent per recipient and token.
+func (l Ledger) Totals() map[common.Address]map[common.Address]*big.Int {
+	totals := make(map[common.Address]map[common.Address]*big.Int)
+	for _, e := range l {
+		if e.Amount == nil {
+			continue
+		}
+		byToken, ok := totals[e.Recipient]
+		if !ok {
+			byToken = make(map[common.Address]*big.Int)
+			totals[e.Recipient] = byToken
+		}
+		if byToken[e.Token] == nil {
+			byToken[e.Token] = new(big.Int)
+		}
+		byToken[e.Token].Add(byToken[e.Token], e.Amount)
+	}
+	return totals
+}
+
+// WriteCSV writes the ledger to w as CSV with a header row. Missing amounts
+// are left empty.
+func (l Ledger) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"block", "tx_hash", "operator_set", "slash_id", "strategy", "shares", "recipient", "redistributed", "token", "amount"}); err != nil {
+		return err
+	}
+	for _, e := range l {
+		amount := ""
+		if e.Amount != nil {
+			amount = e.Amount.String()
+		}
+		err := cw.Write([]string{
+			strconv.FormatUint(e.Block, 10),
+			e.TxHash.Hex(),
+			operatorset.From(e.OperatorSet).String(),
+			e.SlashID.String(),
+			e.Strategy.Hex(),
+			e.Shares.String(),
+			e.Recipient.Hex(),
+			strconv.FormatBool(e.Redistributed),
+			e.Token.Hex(),
+			amount,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
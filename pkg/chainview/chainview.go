@@ -0,0 +1,117 @@
+// Package chainview holds what the packages loading AllocationManager and
+// DelegationManager state at one block share: the contracts they read, the
+// resolution of that block, and the withdrawals queued before it.
+//
+//	src := chainview.NewSource(contracts, client)
+//	state, err := slashsim.Load(ctx, src, nil, operatorSet, operator, strategies)
+package chainview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deployment"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BlockNumberReader returns the latest block number, like ethclient.Client.
+type BlockNumberReader interface {
+	BlockNumber(ctx context.Context) (uint64, error)
+}
+
+// DelegationManager is the part of the DelegationManager binding loaders
+// read: its views and its SlashingWithdrawalQueued events.
+type DelegationManager interface {
+	delegationmanager.DelegationManagerReader
+	delegationmanager.DelegationManagerEvents
+}
+
+// Source is the contracts a state is loaded from.
+type Source struct {
+	AllocationManager allocationmanager.AllocationManagerReader
+	DelegationManager DelegationManager
+	// Chain resolves the latest block when CallOpts.BlockNumber is nil.
+	Chain BlockNumberReader
+}
+
+// NewSource returns the Source of a deployment bound to client.
+func NewSource(c *deployment.Contracts, client BlockNumberReader) Source {
+	return Source{
+		AllocationManager: c.AllocationManager,
+		DelegationManager: c.DelegationManager,
+		Chain:             client,
+	}
+}
+
+// CallOpts returns a copy of opts, or empty options if nil, with ctx as
+// context unless opts has one and pinned to the latest block unless opts
+// names one, so every read of a load sees the same block.
+func (s Source) CallOpts(ctx context.Context, opts *bind.CallOpts) (bind.CallOpts, error) {
+	o := bind.CallOpts{Context: ctx}
+	if opts != nil {
+		o = *opts
+		if o.Context == nil {
+			o.Context = ctx
+		}
+	}
+	if o.BlockNumber != nil {
+		return o, nil
+	}
+	if s.Chain == nil {
+		return o, errors.New("chainview: no block number and no chain to read it from")
+	}
+	latest, err := s.Chain.BlockNumber(ctx)
+	if err != nil {
+		return o, fmt.Errorf("chainview: failed to read block number: %w", err)
+	}
+	o.BlockNumber = new(big.Int).SetUint64(latest)
+	return o, nil
+}
+
+// QueuedWithdrawal is a slashable withdrawal and the root it is queued
+// under.
+type QueuedWithdrawal struct {
+	Root       [32]byte
+	Withdrawal eltypes.IDelegationManagerTypesWithdrawal
+}
+
+// QueuedWithdrawals returns the withdrawals delegated to operators that were
+// queued in the MIN_WITHDRAWAL_DELAY_BLOCKS before block, up to and
+// including read, keyed by operator. Withdrawals queued in that window
+// cannot have completed by block, so all of them are still pending.
+func (s Source) QueuedWithdrawals(ctx context.Context, read uint64, block, delay uint32, operators []common.Address) (map[common.Address][]QueuedWithdrawal, error) {
+	var start uint64
+	if block > delay {
+		start = uint64(block - delay)
+	}
+	wanted := make(map[common.Address]bool, len(operators))
+	for _, operator := range operators {
+		wanted[operator] = true
+	}
+	it, err := s.DelegationManager.FilterSlashingWithdrawalQueued(&bind.FilterOpts{Start: start, End: &read, Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("chainview: failed to read queued withdrawals: %w", err)
+	}
+	defer it.Close()
+	queued := make(map[common.Address][]QueuedWithdrawal)
+	for it.Next() {
+		operator := it.Event.Withdrawal.DelegatedTo
+		if !wanted[operator] {
+			continue
+		}
+		queued[operator] = append(queued[operator], QueuedWithdrawal{
+			Root:       it.Event.WithdrawalRoot,
+			Withdrawal: it.Event.Withdrawal,
+		})
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("chainview: failed to read queued withdrawals: %w", err)
+	}
+	return queued, nil
+}
@@ -0,0 +1,468 @@
+// Package deallockeeper clears matured deallocations from the
+// AllocationManager's deallocation queues.
+//
+// A slashable deallocation stays queued after DEALLOCATION_DELAY until
+// clearDeallocationQueue, or the operator's next modifyAllocations in the
+// strategy, pops it, and until then its magnitude stays encumbered. A Keeper
+// mirrors the queues from AllocationUpdated events and the stored encumbered
+// magnitude from EncumberedMagnitudeUpdated events, and once deallocations
+// mature it batches clearDeallocationQueue calls per operator:
+//
+//	m, err := txmgr.New(ctx, client, auth, txmgr.Config{})
+//	k, err := deallockeeper.New(contracts.AllocationManager, m, client, deallockeeper.Config{
+//		FromBlock:  deploymentBlock,
+//		Registerer: prometheus.DefaultRegisterer,
+//	})
+//	err = k.Run(ctx)
+//
+// Before clearing, the keeper compares the stored encumbered magnitude with
+// AllocationManager.getEncumberedMagnitude, which simulates clearing, so
+// queues someone else already cleared cost no transaction. The queue is only
+// known from FromBlock on; deallocations queued earlier are not cleared.
+package deallockeeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Defaults used for zero Config fields.
+const (
+	DefaultPollInterval   = 12 * time.Second
+	DefaultMaxBlockRange  = 5000
+	DefaultMaxClearsPerTx = 100
+)
+
+// AllocationManager is the part of the AllocationManager binding a Keeper
+// uses.
+type AllocationManager interface {
+	allocationmanager.AllocationManagerReader
+	allocationmanager.AllocationManagerWriter
+	allocationmanager.AllocationManagerEvents
+}
+
+// Config tunes a Keeper. Zero fields take the Default* values.
+type Config struct {
+	// Operators limits the keeper to these operators. Empty keeps every
+	// operator's queues.
+	Operators []common.Address
+	// FromBlock is the first block scanned for events, typically the
+	// AllocationManager's deployment block.
+	FromBlock uint64
+	// PollInterval is how often Run polls the chain.
+	PollInterval time.Duration
+	// MaxBlockRange bounds the block range of one log query.
+	MaxBlockRange uint64
+	// MaxClearsPerTx bounds the sum of numToClear in one transaction, and so
+	// its gas.
+	MaxClearsPerTx int
+	// Registerer, if set, registers the keeper's metrics.
+	Registerer prometheus.Registerer
+	// Logger receives the errors Run recovers from. It defaults to
+	// slog.Default().
+	Logger *slog.Logger
+}
+
+func (c Config) withDefaults() Config {
+	if c.PollInterval == 0 {
+		c.PollInterval = DefaultPollInterval
+	}
+	if c.MaxBlockRange == 0 {
+		c.MaxBlockRange = DefaultMaxBlockRange
+	}
+	if c.MaxClearsPerTx == 0 {
+		c.MaxClearsPerTx = DefaultMaxClearsPerTx
+	}
+	if c.Logger == nil {
+		c.Logger = slog.Default()
+	}
+	return c
+}
+
+// Deallocation is a queued deallocation.
+type Deallocation struct {
+	Operator    common.Address
+	OperatorSet eltypes.OperatorSet
+	Strategy    common.Address
+	// Magnitude is the magnitude allocated once it completes.
+	Magnitude uint64
+	// EffectBlock is the first block it can be cleared in.
+	EffectBlock uint32
+}
+
+// Stats is the keeper's backlog as of its last poll.
+type Stats struct {
+	// Head is the last block scanned.
+	Head uint64
+	// Queued counts the known deallocations still in a queue, Matured those
+	// of them past their EffectBlock.
+	Queued, Matured int
+	// ClearableMagnitude is the magnitude clearing the matured deallocations
+	// would free, summed over operators and strategies.
+	ClearableMagnitude uint64
+}
+
+type pair struct {
+	operator, strategy common.Address
+}
+
+type allocation struct {
+	operator common.Address
+	set      eltypes.OperatorSet
+	strategy common.Address
+}
+
+// Keeper clears matured deallocations. It is safe for concurrent use.
+type Keeper struct {
+	am        AllocationManager
+	sender    txmgr.Sender
+	chain     chainview.BlockNumberReader
+	cfg       Config
+	operators map[common.Address]bool
+	metrics   *metrics
+
+	// polling serializes polls. The fields under mu are only written with
+	// both held, so a poll reads them without mu, which it releases while
+	// it waits on the chain.
+	polling sync.Mutex
+	next    uint64
+	delay   *uint32
+
+	mu      sync.Mutex
+	targets map[allocation]uint64
+	stored  map[pair]uint64
+	queues  map[pair][]Deallocation
+	stats   Stats
+}
+
+// New returns a Keeper reading am from cfg.FromBlock on and sending clears
+// through sender.
+func New(am AllocationManager, sender txmgr.Sender, chain chainview.BlockNumberReader, cfg Config) (*Keeper, error) {
+	cfg = cfg.withDefaults()
+	k := &Keeper{
+		am:      am,
+		sender:  sender,
+		chain:   chain,
+		cfg:     cfg,
+		metrics: newMetrics(),
+		next:    cfg.FromBlock,
+		targets: make(map[allocation]uint64),
+		stored:  make(map[pair]uint64),
+		queues:  make(map[pair][]Deallocation),
+	}
+	if len(cfg.Operators) > 0 {
+		k.operators = make(map[common.Address]bool, len(cfg.Operators))
+		for _, op := range cfg.Operators {
+			k.operators[op] = true
+		}
+	}
+	if cfg.Registerer != nil {
+		if err := k.metrics.register(cfg.Registerer); err != nil {
+			return nil, fmt.Errorf("deallockeeper: failed to register metrics: %w", err)
+		}
+	}
+	return k, nil
+}
+
+// Run polls every Config.PollInterval until ctx is done. Failed polls are
+// logged and retried on the next tick.
+func (k *Keeper) Run(ctx context.Context) error {
+	ticker := time.NewTicker(k.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		if err := k.Poll(ctx); err != nil && ctx.Err() == nil {
+			k.cfg.Logger.Warn("Deallocation keeper poll failed", "err", err)
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll scans the events up to the latest block and clears every matured
+// deallocation.
+func (k *Keeper) Poll(ctx context.Context) error {
+	k.polling.Lock()
+	defer k.polling.Unlock()
+
+	err := k.poll(ctx)
+	if err != nil {
+		k.metrics.pollFailures.Inc()
+	}
+	k.metrics.set(k.stats)
+	return err
+}
+
+func (k *Keeper) poll(ctx context.Context) error {
+	head, err := k.chain.BlockNumber(ctx)
+	if err != nil {
+		return fmt.Errorf("deallockeeper: failed to read block number: %w", err)
+	}
+	if k.delay == nil {
+		delay, err := k.am.DEALLOCATIONDELAY(&bind.CallOpts{Context: ctx})
+		if err != nil {
+			return fmt.Errorf("deallockeeper: failed to read deallocation delay: %w", err)
+		}
+		k.delay = &delay
+	}
+	for k.next <= head {
+		end := k.next + k.cfg.MaxBlockRange - 1
+		if end > head {
+			end = head
+		}
+		if err := k.scan(ctx, k.next, end); err != nil {
+			return err
+		}
+		k.next = end + 1
+	}
+	return k.clear(ctx, head)
+}
+
+// event is a decoded AllocationUpdated or EncumberedMagnitudeUpdated log.
+type event struct {
+	log        types.Log
+	allocation *allocationmanager.AllocationManagerAllocationUpdated
+	encumbered *allocationmanager.AllocationManagerEncumberedMagnitudeUpdated
+}
+
+// scan applies the events of blocks start to end in order.
+func (k *Keeper) scan(ctx context.Context, start, end uint64) error {
+	opts := &bind.FilterOpts{Start: start, End: &end, Context: ctx}
+	var events []event
+	allocations, err := k.am.FilterAllocationUpdated(opts)
+	if err != nil {
+		return fmt.Errorf("deallockeeper: failed to read AllocationUpdated events: %w", err)
+	}
+	for allocations.Next() {
+		events = append(events, event{log: allocations.Event.Raw, allocation: allocations.Event})
+	}
+	allocations.Close()
+	if err := allocations.Error(); err != nil {
+		return fmt.Errorf("deallockeeper: failed to read AllocationUpdated events: %w", err)
+	}
+	encumbered, err := k.am.FilterEncumberedMagnitudeUpdated(opts)
+	if err != nil {
+		return fmt.Errorf("deallockeeper: failed to read EncumberedMagnitudeUpdated events: %w", err)
+	}
+	for encumbered.Next() {
+		events = append(events, event{log: encumbered.Event.Raw, encumbered: encumbered.Event})
+	}
+	encumbered.Close()
+	if err := encumbered.Error(); err != nil {
+		return fmt.Errorf("deallockeeper: failed to read EncumberedMagnitudeUpdated events: %w", err)
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		a, b := events[i].log, events[j].log
+		if a.BlockNumber != b.BlockNumber {
+			return a.BlockNumber < b.BlockNumber
+		}
+		return a.Index < b.Index
+	})
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.stats.Head = end
+	for _, e := range events {
+		switch {
+		case e.allocation != nil && k.watched(e.allocation.Operator):
+			k.applyAllocation(e.allocation)
+		case e.encumbered != nil && k.watched(e.encumbered.Operator):
+			k.stored[pair{e.encumbered.Operator, e.encumbered.Strategy}] = e.encumbered.EncumberedMagnitude
+		}
+	}
+	return nil
+}
+
+func (k *Keeper) watched(operator common.Address) bool {
+	return k.operators == nil || k.operators[operator]
+}
+
+// applyAllocation queues the deallocation an AllocationUpdated event
+// announces, if any.
+func (k *Keeper) applyAllocation(e *allocationmanager.AllocationManagerAllocationUpdated) {
+	a := allocation{e.Operator, e.OperatorSet, e.Strategy}
+	prev, known := k.targets[a]
+	k.targets[a] = e.Magnitude
+
+	// Completed changes, including the record slashOperator emits, take
+	// effect in the block they are emitted in.
+	block := e.Raw.BlockNumber
+	if uint64(e.EffectBlock) <= block {
+		return
+	}
+	p := pair{e.Operator, e.Strategy}
+	queue := k.queues[p]
+	for i, d := range queue {
+		// A slash shrinks a pending deallocation without requeueing it.
+		if d.OperatorSet == e.OperatorSet && d.EffectBlock == e.EffectBlock {
+			queue[i].Magnitude = e.Magnitude
+			return
+		}
+	}
+	// Allocations raise the magnitude. Without an earlier event only the
+	// deallocation delay tells them apart.
+	if known && e.Magnitude >= prev || !known && uint64(e.EffectBlock) != block+uint64(*k.delay)+1 {
+		return
+	}
+	k.queues[p] = append(queue, Deallocation{
+		Operator:    e.Operator,
+		OperatorSet: e.OperatorSet,
+		Strategy:    e.Strategy,
+		Magnitude:   e.Magnitude,
+		EffectBlock: e.EffectBlock,
+	})
+}
+
+// clear sends clearDeallocationQueue for every queue with deallocations
+// matured by head.
+func (k *Keeper) clear(ctx context.Context, head uint64) error {
+	stats := Stats{Head: k.stats.Head}
+
+	type batch struct {
+		strategies []common.Address
+		numToClear []uint16
+	}
+	batches := make(map[common.Address]*batch)
+	var operators []common.Address
+	cleared := make(map[pair]int)
+	for _, p := range k.pairs() {
+		queue := k.queues[p]
+		matured := 0
+		for matured < len(queue) && uint64(queue[matured].EffectBlock) <= head {
+			matured++
+		}
+		stats.Queued += len(queue)
+		if matured == 0 {
+			continue
+		}
+
+		// Gas is estimated at head, so check the queue as of head.
+		if stored, ok := k.stored[p]; ok {
+			current, err := k.am.GetEncumberedMagnitude(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(head)}, p.operator, p.strategy)
+			if err != nil {
+				return fmt.Errorf("deallockeeper: failed to read encumbered magnitude of %s in %s: %w", p.operator.Hex(), p.strategy.Hex(), err)
+			}
+			if current >= stored {
+				// Already cleared, or clearing would free nothing.
+				cleared[p] = matured
+				stats.Queued -= matured
+				continue
+			}
+			stats.ClearableMagnitude += stored - current
+		}
+		stats.Matured += matured
+
+		b, ok := batches[p.operator]
+		if !ok {
+			b = &batch{}
+			batches[p.operator] = b
+			operators = append(operators, p.operator)
+		}
+		b.strategies = append(b.strategies, p.strategy)
+		b.numToClear = append(b.numToClear, uint16(min(matured, math.MaxUint16)))
+	}
+	k.mu.Lock()
+	for p, n := range cleared {
+		k.drop(p, n)
+	}
+	k.stats = stats
+	k.mu.Unlock()
+
+	var errs []error
+	for _, operator := range operators {
+		b := batches[operator]
+		for start := 0; start < len(b.strategies); {
+			// Split the operator's strategies so no call clears more than
+			// MaxClearsPerTx deallocations, but always make progress.
+			end, total := start, 0
+			for end < len(b.strategies) && (end == start || total+int(b.numToClear[end]) <= k.cfg.MaxClearsPerTx) {
+				total += int(b.numToClear[end])
+				end++
+			}
+			strategies, numToClear := b.strategies[start:end], b.numToClear[start:end]
+			start = end
+
+			_, err := k.sender.SendAndWait(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return k.am.ClearDeallocationQueue(opts, operator, strategies, numToClear)
+			})
+			if err != nil {
+				k.metrics.clearFailures.Inc()
+				errs = append(errs, fmt.Errorf("deallockeeper: failed to clear queues of %s: %w", operator.Hex(), err))
+				continue
+			}
+			k.metrics.clears.Inc()
+			k.mu.Lock()
+			for i, strategy := range strategies {
+				n := int(numToClear[i])
+				k.metrics.cleared.Add(float64(n))
+				k.drop(pair{operator, strategy}, n)
+				k.stats.Queued -= n
+				k.stats.Matured -= n
+			}
+			k.mu.Unlock()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// drop forgets the first n deallocations of a queue. It must be called with
+// k.polling and k.mu held.
+func (k *Keeper) drop(p pair, n int) {
+	if rest := k.queues[p][n:]; len(rest) > 0 {
+		k.queues[p] = rest
+	} else {
+		delete(k.queues, p)
+	}
+}
+
+// pairs returns the queued operators and strategies in a stable order.
+func (k *Keeper) pairs() []pair {
+	pairs := make([]pair, 0, len(k.queues))
+	for p := range k.queues {
+		pairs = append(pairs, p)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if c := pairs[i].operator.Cmp(pairs[j].operator); c != 0 {
+			return c < 0
+		}
+		return pairs[i].strategy.Cmp(pairs[j].strategy) < 0
+	})
+	return pairs
+}
+
+// Queued returns the known deallocations still queued, ordered by operator,
+// strategy and queue position.
+func (k *Keeper) Queued() []Deallocation {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	var out []Deallocation
+	for _, p := range k.pairs() {
+		out = append(out, k.queues[p]...)
+	}
+	return out
+}
+
+// Stats returns the backlog as of the last poll.
+func (k *Keeper) Stats() Stats {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	return k.stats
+}
@@ -0,0 +1,107 @@
+//go:build !go1.23 || simulated
+
+package deallockeeper
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+type harnessSender struct{ h *harness.Harness }
+
+func (s harnessSender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	return s.h.Send(ctx, build)
+}
+
+// TestKeeperClearsContractQueue deallocates from a slashable operator set
+// and checks the keeper leaves the queue alone until the deallocation
+// matures and then clears it, freeing the encumbered magnitude.
+func TestKeeperClearsContractQueue(t *testing.T) {
+	h, err := harness.New(harness.Config{DeallocationDelay: 5})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+	call := &bind.CallOpts{Context: ctx}
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	account := func() *bind.TransactOpts {
+		auth, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+		check(err)
+		return auth
+	}
+	allocate := func(operator *bind.TransactOpts, set eltypes.OperatorSet, strategy common.Address, magnitude uint64) {
+		t.Helper()
+		_, err := h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.AllocationManager.ModifyAllocations(opts, operator.From, []eltypes.IAllocationManagerTypesAllocateParams{
+				{OperatorSet: set, Strategies: []common.Address{strategy}, NewMagnitudes: []uint64{magnitude}},
+			})
+		})
+		check(err)
+	}
+
+	strategy, err := h.DeployStrategy(ctx)
+	check(err)
+	operator, avs, staker := account(), account(), account()
+	check(h.RegisterOperator(ctx, operator))
+	set, err := h.CreateOperatorSet(ctx, avs, 1, []common.Address{strategy.Address})
+	check(err)
+	check(h.RegisterForOperatorSet(ctx, operator, set))
+	check(h.Deposit(ctx, staker, strategy, big.NewInt(1e18)))
+	check(h.Delegate(ctx, staker, operator.From))
+	allocate(operator, set, strategy.Address, 5e17)
+	allocate(operator, set, strategy.Address, 2e17)
+
+	k, err := New(h.AllocationManager, harnessSender{h}, h.Client, Config{})
+	check(err)
+	check(k.Poll(ctx))
+	if s := k.Stats(); s.Queued != 1 || s.Matured != 0 {
+		t.Fatalf("Expected one pending deallocation, got %+v", s)
+	}
+
+	queued := k.Queued()[0]
+	for {
+		head, err := h.Client.BlockNumber(ctx)
+		check(err)
+		if head >= uint64(queued.EffectBlock) {
+			break
+		}
+		h.Backend.Commit()
+	}
+	check(k.Poll(ctx))
+	if s := k.Stats(); s.Queued != 0 || s.ClearableMagnitude != 3e17 {
+		t.Errorf("Expected the deallocation cleared, freeing 3e17, got %+v", s)
+	}
+
+	it, err := h.AllocationManager.FilterEncumberedMagnitudeUpdated(&bind.FilterOpts{Context: ctx})
+	check(err)
+	defer it.Close()
+	var stored uint64
+	for it.Next() {
+		stored = it.Event.EncumberedMagnitude
+	}
+	check(it.Error())
+	if stored != 2e17 {
+		t.Errorf("Expected stored encumbered magnitude 2e17, got %d", stored)
+	}
+	allocation, err := h.AllocationManager.GetAllocation(call, operator.From, set, strategy.Address)
+	check(err)
+	if allocation.CurrentMagnitude != 2e17 || allocation.PendingDiff.Sign() != 0 {
+		t.Errorf("Expected a completed allocation of 2e17, got %+v", allocation)
+	}
+}
@@ -0,0 +1,243 @@
+package deallockeeper
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	operator  = common.HexToAddress("0x09")
+	operator2 = common.HexToAddress("0x0a")
+	s1        = common.HexToAddress("0x1")
+	s2        = common.HexToAddress("0x2")
+	s3        = common.HexToAddress("0x3")
+	setA      = eltypes.OperatorSet{Avs: common.HexToAddress("0xa5"), Id: 1}
+	setB      = eltypes.OperatorSet{Avs: common.HexToAddress("0xa5"), Id: 2}
+)
+
+type chain uint64
+
+func (c *chain) BlockNumber(ctx context.Context) (uint64, error) {
+	return uint64(*c), nil
+}
+
+type sender struct{}
+
+func (sender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	tx, err := build(&bind.TransactOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful}, nil
+}
+
+// Every fake log is mined in its own block, numbered in emission order.
+func allocationUpdated(am *allocationmanager.FakeAllocationManager, operator common.Address, set eltypes.OperatorSet, strategy common.Address, magnitude uint64, effectBlock uint32) {
+	am.EmitAllocationUpdated(&allocationmanager.AllocationManagerAllocationUpdated{
+		Operator: operator, OperatorSet: set, Strategy: strategy, Magnitude: magnitude, EffectBlock: effectBlock,
+	})
+}
+
+func encumberedUpdated(am *allocationmanager.FakeAllocationManager, operator, strategy common.Address, magnitude uint64) {
+	am.EmitEncumberedMagnitudeUpdated(&allocationmanager.AllocationManagerEncumberedMagnitudeUpdated{
+		Operator: operator, Strategy: strategy, EncumberedMagnitude: magnitude,
+	})
+}
+
+func newKeeper(t *testing.T, cfg Config) (*Keeper, *allocationmanager.FakeAllocationManager, *chain) {
+	am := allocationmanager.NewFakeAllocationManager(common.HexToAddress("0xa11"))
+	am.StubDEALLOCATIONDELAY(5)
+	head := new(chain)
+	k, err := New(am, sender{}, head, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return k, am, head
+}
+
+func TestKeeperClearsMaturedQueues(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	k, am, head := newKeeper(t, Config{Registerer: registry})
+	ctx := context.Background()
+
+	allocationUpdated(am, operator, setA, s1, 5e17, 1) // block 1
+	encumberedUpdated(am, operator, s1, 5e17)          // block 2
+	allocationUpdated(am, operator, setA, s1, 2e17, 9) // block 3: deallocation
+	// Without an earlier event, a deallocation is told apart by its delay.
+	allocationUpdated(am, operator2, setA, s1, 0, 10)  // block 4: deallocation
+	allocationUpdated(am, operator, setB, s1, 1e17, 7) // block 5: allocation
+	// A slash shrinks the pending deallocation and records the slashed
+	// allocation.
+	allocationUpdated(am, operator, setA, s1, 1e17, 9) // block 6
+	allocationUpdated(am, operator, setA, s1, 2e17, 7) // block 7
+	encumberedUpdated(am, operator, s1, 4e17)          // block 8
+
+	*head = 8
+	if err := k.Poll(ctx); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	want := []Deallocation{
+		{Operator: operator, OperatorSet: setA, Strategy: s1, Magnitude: 1e17, EffectBlock: 9},
+		{Operator: operator2, OperatorSet: setA, Strategy: s1, Magnitude: 0, EffectBlock: 10},
+	}
+	if got := k.Queued(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("Expected queue %+v, got %+v", want, got)
+	}
+	if s := k.Stats(); s.Head != 8 || s.Queued != 2 || s.Matured != 0 {
+		t.Errorf("Expected 2 queued deallocations at block 8, got %+v", s)
+	}
+	if calls := am.Calls("clearDeallocationQueue"); len(calls) != 0 {
+		t.Fatalf("Expected no clears before the effect blocks, got %d", len(calls))
+	}
+
+	// The operator's clear frees 1e17, the other operator's is not checked
+	// without a stored encumbered magnitude.
+	am.StubGetEncumberedMagnitude(operator, s1, 3e17)
+	*head = 10
+	if err := k.Poll(ctx); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	calls := am.Calls("clearDeallocationQueue")
+	if len(calls) != 2 {
+		t.Fatalf("Expected 2 clears, got %d", len(calls))
+	}
+	for i, op := range []common.Address{operator, operator2} {
+		args := calls[i].Args
+		if args[0] != op || !reflect.DeepEqual(args[1], []common.Address{s1}) || !reflect.DeepEqual(args[2], []uint16{1}) {
+			t.Errorf("Expected one deallocation of %s cleared, got %v", op.Hex(), args)
+		}
+	}
+	if s := k.Stats(); s.Queued != 0 || s.Matured != 0 || s.ClearableMagnitude != 1e17 {
+		t.Errorf("Expected an empty backlog after clearing 1e17, got %+v", s)
+	}
+
+	values := make(map[string]float64)
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, f := range families {
+		m := f.GetMetric()[0]
+		values[f.GetName()] = m.GetGauge().GetValue() + m.GetCounter().GetValue()
+	}
+	if values["eigenlayer_deallocation_keeper_head_block"] != 10 || values["eigenlayer_deallocation_keeper_cleared_deallocations_total"] != 2 {
+		t.Errorf("Unexpected metrics %v", values)
+	}
+}
+
+func TestKeeperSkipsClearedQueues(t *testing.T) {
+	k, am, head := newKeeper(t, Config{})
+	allocationUpdated(am, operator, setA, s1, 0, 7) // block 1
+	encumberedUpdated(am, operator, s1, 5e17)       // block 2
+
+	// Someone else cleared the queue: the view matches storage.
+	am.StubGetEncumberedMagnitude(operator, s1, 5e17)
+	*head = 7
+	if err := k.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if calls := am.Calls("clearDeallocationQueue"); len(calls) != 0 || len(k.Queued()) != 0 {
+		t.Errorf("Expected the queue to be dropped without a clear, got %d clears and %+v", len(calls), k.Queued())
+	}
+}
+
+func TestKeeperBatches(t *testing.T) {
+	k, am, head := newKeeper(t, Config{MaxClearsPerTx: 2, Operators: []common.Address{operator}})
+	for i, s := range []common.Address{s1, s2, s3} {
+		allocationUpdated(am, operator, setA, s, 0, uint32(i+7))
+	}
+	allocationUpdated(am, operator, setB, s1, 0, 10)
+	allocationUpdated(am, operator2, setA, s1, 0, 11)
+
+	*head = 20
+	if err := k.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	calls := am.Calls("clearDeallocationQueue")
+	if len(calls) != 2 {
+		t.Fatalf("Expected 2 clears, got %d", len(calls))
+	}
+	if got := calls[0].Args[2]; !reflect.DeepEqual(got, []uint16{2}) {
+		t.Errorf("Expected s1's two deallocations cleared first, got %v", got)
+	}
+	if got := calls[1].Args[1]; !reflect.DeepEqual(got, []common.Address{s2, s3}) {
+		t.Errorf("Expected s2 and s3 cleared together, got %v", got)
+	}
+	for _, c := range calls {
+		if c.Args[0] != operator {
+			t.Errorf("Expected only the watched operator cleared, got %v", c.Args[0])
+		}
+	}
+}
+
+func TestKeeperKeepsFailedClears(t *testing.T) {
+	k, am, head := newKeeper(t, Config{})
+	allocationUpdated(am, operator, setA, s1, 0, 7)
+	failure := allocationmanager.ErrCurrentlyPaused{}
+	am.Fail("clearDeallocationQueue", failure)
+
+	*head = 7
+	if err := k.Poll(context.Background()); !errors.Is(err, failure) {
+		t.Fatalf("Expected %v, got %v", failure, err)
+	}
+	if s := k.Stats(); s.Matured != 1 {
+		t.Errorf("Expected the deallocation to stay matured, got %+v", s)
+	}
+
+	am.Fail("clearDeallocationQueue", nil)
+	if err := k.Poll(context.Background()); err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if s := k.Stats(); s.Matured != 0 || s.Queued != 0 {
+		t.Errorf("Expected the retry to clear the deallocation, got %+v", s)
+	}
+}
+
+// blockingSender holds every transaction until release is closed.
+type blockingSender struct {
+	sending chan struct{}
+	release chan struct{}
+}
+
+func (s blockingSender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	s.sending <- struct{}{}
+	<-s.release
+	return sender{}.SendAndWait(ctx, build)
+}
+
+func TestKeeperReadableWhileSending(t *testing.T) {
+	am := allocationmanager.NewFakeAllocationManager(common.HexToAddress("0xa11"))
+	am.StubDEALLOCATIONDELAY(5)
+	head := new(chain)
+	s := blockingSender{sending: make(chan struct{}), release: make(chan struct{})}
+	k, err := New(am, s, head, Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	allocationUpdated(am, operator, setA, s1, 0, 7)
+
+	*head = 7
+	done := make(chan error)
+	go func() { done <- k.Poll(context.Background()) }()
+	<-s.sending
+	if st := k.Stats(); st.Matured != 1 || len(k.Queued()) != 1 {
+		t.Errorf("Expected the matured deallocation while it is cleared, got %+v", st)
+	}
+	close(s.release)
+	if err := <-done; err != nil {
+		t.Fatalf("Poll failed: %v", err)
+	}
+	if st := k.Stats(); st.Matured != 0 || len(k.Queued()) != 0 {
+		t.Errorf("Expected the deallocation cleared, got %+v", st)
+	}
+}
@@ -0,0 +1,57 @@
+package deallockeeper
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	namespace = "eigenlayer"
+	subsystem = "deallocation_keeper"
+)
+
+// metrics exports a Keeper's Stats and activity.
+type metrics struct {
+	head          prometheus.Gauge
+	queued        prometheus.Gauge
+	matured       prometheus.Gauge
+	clearable     prometheus.Gauge
+	clears        prometheus.Counter
+	cleared       prometheus.Counter
+	clearFailures prometheus.Counter
+	pollFailures  prometheus.Counter
+}
+
+func newMetrics() *metrics {
+	gauge := func(name, help string) prometheus.Gauge {
+		return prometheus.NewGauge(prometheus.GaugeOpts{Namespace: namespace, Subsystem: subsystem, Name: name, Help: help})
+	}
+	counter := func(name, help string) prometheus.Counter {
+		return prometheus.NewCounter(prometheus.CounterOpts{Namespace: namespace, Subsystem: subsystem, Name: name, Help: help})
+	}
+	return &metrics{
+		head:          gauge("head_block", "Last block scanned for events."),
+		queued:        gauge("queued_deallocations", "Known deallocations still in a deallocation queue."),
+		matured:       gauge("matured_deallocations", "Queued deallocations past their effect block and not yet cleared."),
+		clearable:     gauge("clearable_magnitude", "Encumbered magnitude clearing the matured deallocations would free."),
+		clears:        counter("clears_total", "clearDeallocationQueue transactions sent."),
+		cleared:       counter("cleared_deallocations_total", "Deallocations cleared by the keeper."),
+		clearFailures: counter("clear_failures_total", "clearDeallocationQueue transactions that failed."),
+		pollFailures:  counter("poll_failures_total", "Polls that failed."),
+	}
+}
+
+func (m *metrics) register(r prometheus.Registerer) error {
+	for _, c := range []prometheus.Collector{m.head, m.queued, m.matured, m.clearable, m.clears, m.cleared, m.clearFailures, m.pollFailures} {
+		if err := r.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m *metrics) set(s Stats) {
+	m.head.Set(float64(s.Head))
+	m.queued.Set(float64(s.Queued))
+	m.matured.Set(float64(s.Matured))
+	m.clearable.Set(float64(s.ClearableMagnitude))
+}
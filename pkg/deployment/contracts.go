@@ -0,0 +1,164 @@
+package deployment
+
+import (
+	"fmt"
+
+	avsdirectory "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AVSDirectory"
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	allocationmanagerview "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManagerView"
+	bn254certificateverifier "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BN254CertificateVerifier"
+	backingeigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	crosschainregistry "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/CrossChainRegistry"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	ecdsacertificateverifier "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ECDSACertificateVerifier"
+	eigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/Eigen"
+	eigenpodmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	eigenstrategy "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenStrategy"
+	keyregistrar "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/KeyRegistrar"
+	operatortableupdater "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/OperatorTableUpdater"
+	pauserregistry "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	permissioncontroller "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PermissionController"
+	protocolregistry "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ProtocolRegistry"
+	releasemanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ReleaseManager"
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	strategyfactory "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyFactory"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	taskmailbox "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/TaskMailbox"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/events"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Contracts bundles the bindings of a deployment, all bound to the same
+// backend. Contracts the deployment has no address for are left nil; older
+// configs predate e.g. the AllocationManager.
+type Contracts struct {
+	Deployment *Deployment
+
+	PauserRegistry *pauserregistry.PauserRegistry
+
+	AllocationManager     *allocationmanager.AllocationManager
+	AllocationManagerView *allocationmanagerview.AllocationManagerView
+	AVSDirectory          *avsdirectory.AVSDirectory
+	DelegationManager     *delegationmanager.DelegationManager
+	KeyRegistrar          *keyregistrar.KeyRegistrar
+	PermissionController  *permissioncontroller.PermissionController
+	ProtocolRegistry      *protocolregistry.ProtocolRegistry
+	ReleaseManager        *releasemanager.ReleaseManager
+	RewardsCoordinator    *rewardscoordinator.RewardsCoordinator
+	StrategyManager       *strategymanager.StrategyManager
+
+	EigenPodManager *eigenpodmanager.EigenPodManager
+	StrategyFactory *strategyfactory.StrategyFactory
+
+	BN254CertificateVerifier *bn254certificateverifier.BN254CertificateVerifier
+	CrossChainRegistry       *crosschainregistry.CrossChainRegistry
+	ECDSACertificateVerifier *ecdsacertificateverifier.ECDSACertificateVerifier
+	OperatorTableUpdater     *operatortableupdater.OperatorTableUpdater
+
+	TaskMailbox *taskmailbox.TaskMailbox
+
+	EIGEN         *eigen.Eigen
+	BackingEigen  *backingeigen.BackingEigen
+	EigenStrategy *eigenstrategy.EigenStrategy
+}
+
+// Contracts binds every contract of the deployment to backend.
+func (d *Deployment) Contracts(backend bind.ContractBackend) (*Contracts, error) {
+	c := &Contracts{Deployment: d}
+	b := binder{backend: backend}
+
+	bindAt(&b, &c.PauserRegistry, "PauserRegistry", d.Admin.PauserRegistry, pauserregistry.NewPauserRegistry)
+
+	bindAt(&b, &c.AllocationManager, "AllocationManager", d.Core.AllocationManager.Proxy, allocationmanager.NewAllocationManager)
+	bindAt(&b, &c.AllocationManagerView, "AllocationManagerView", d.Core.AllocationManagerView, allocationmanagerview.NewAllocationManagerView)
+	bindAt(&b, &c.AVSDirectory, "AVSDirectory", d.Core.AVSDirectory.Proxy, avsdirectory.NewAVSDirectory)
+	bindAt(&b, &c.DelegationManager, "DelegationManager", d.Core.DelegationManager.Proxy, delegationmanager.NewDelegationManager)
+	bindAt(&b, &c.KeyRegistrar, "KeyRegistrar", d.Core.KeyRegistrar.Proxy, keyregistrar.NewKeyRegistrar)
+	bindAt(&b, &c.PermissionController, "PermissionController", d.Core.PermissionController.Proxy, permissioncontroller.NewPermissionController)
+	bindAt(&b, &c.ProtocolRegistry, "ProtocolRegistry", d.Core.ProtocolRegistry.Proxy, protocolregistry.NewProtocolRegistry)
+	bindAt(&b, &c.ReleaseManager, "ReleaseManager", d.Core.ReleaseManager.Proxy, releasemanager.NewReleaseManager)
+	bindAt(&b, &c.RewardsCoordinator, "RewardsCoordinator", d.Core.RewardsCoordinator.Proxy, rewardscoordinator.NewRewardsCoordinator)
+	bindAt(&b, &c.StrategyManager, "StrategyManager", d.Core.StrategyManager.Proxy, strategymanager.NewStrategyManager)
+
+	bindAt(&b, &c.EigenPodManager, "EigenPodManager", d.Pods.EigenPodManager.Proxy, eigenpodmanager.NewEigenPodManager)
+	bindAt(&b, &c.StrategyFactory, "StrategyFactory", d.Strategies.StrategyFactory.Proxy, strategyfactory.NewStrategyFactory)
+
+	bindAt(&b, &c.BN254CertificateVerifier, "BN254CertificateVerifier", d.Multichain.BN254CertificateVerifier.Proxy, bn254certificateverifier.NewBN254CertificateVerifier)
+	bindAt(&b, &c.CrossChainRegistry, "CrossChainRegistry", d.Multichain.CrossChainRegistry.Proxy, crosschainregistry.NewCrossChainRegistry)
+	bindAt(&b, &c.ECDSACertificateVerifier, "ECDSACertificateVerifier", d.Multichain.ECDSACertificateVerifier.Proxy, ecdsacertificateverifier.NewECDSACertificateVerifier)
+	bindAt(&b, &c.OperatorTableUpdater, "OperatorTableUpdater", d.Multichain.OperatorTableUpdater.Proxy, operatortableupdater.NewOperatorTableUpdater)
+
+	bindAt(&b, &c.TaskMailbox, "TaskMailbox", d.AVS.TaskMailbox.Proxy, taskmailbox.NewTaskMailbox)
+
+	bindAt(&b, &c.EIGEN, "Eigen", d.Tokens.EIGEN.Proxy.Proxy, eigen.NewEigen)
+	bindAt(&b, &c.BackingEigen, "BackingEigen", d.Tokens.BackingEigen.Proxy.Proxy, backingeigen.NewBackingEigen)
+	bindAt(&b, &c.EigenStrategy, "EigenStrategy", d.Tokens.EigenStrategy.Proxy, eigenstrategy.NewEigenStrategy)
+
+	if b.err != nil {
+		return nil, b.err
+	}
+	return c, nil
+}
+
+// binder binds contracts to one backend, keeping the first error.
+type binder struct {
+	backend bind.ContractBackend
+	err     error
+}
+
+// bindAt sets *dst to a binding of the contract at addr, unless addr is zero.
+func bindAt[T any](b *binder, dst **T, name string, addr common.Address, newBinding func(common.Address, bind.ContractBackend) (*T, error)) {
+	if b.err != nil || addr == (common.Address{}) {
+		return
+	}
+	v, err := newBinding(addr, b.backend)
+	if err != nil {
+		b.err = fmt.Errorf("failed to bind %s at %s: %w", name, addr.Hex(), err)
+		return
+	}
+	*dst = v
+}
+
+// AddressBook maps the deployment's proxy and implementation addresses to
+// their binding names, for decoding logs with events.Registry.WithAddressBook.
+func (d *Deployment) AddressBook() events.AddressBook {
+	book := events.AddressBook{}
+	add := func(name string, addrs ...common.Address) {
+		for _, a := range addrs {
+			if a != (common.Address{}) {
+				book[a] = name
+			}
+		}
+	}
+	proxy := func(name string, p Proxy) { add(name, p.Proxy, p.Impl, p.PendingImpl) }
+
+	add("PauserRegistry", d.Admin.PauserRegistry)
+	proxy("AllocationManager", d.Core.AllocationManager)
+	add("AllocationManagerView", d.Core.AllocationManagerView)
+	proxy("AVSDirectory", d.Core.AVSDirectory)
+	proxy("DelegationManager", d.Core.DelegationManager)
+	proxy("KeyRegistrar", d.Core.KeyRegistrar)
+	proxy("PermissionController", d.Core.PermissionController)
+	proxy("ProtocolRegistry", d.Core.ProtocolRegistry)
+	proxy("ReleaseManager", d.Core.ReleaseManager)
+	proxy("RewardsCoordinator", d.Core.RewardsCoordinator)
+	proxy("StrategyManager", d.Core.StrategyManager)
+	proxy("EigenPodManager", d.Pods.EigenPodManager)
+	add("EigenPod", d.Pods.EigenPod.Impl, d.Pods.EigenPod.PendingImpl)
+	proxy("StrategyFactory", d.Strategies.StrategyFactory)
+	add("StrategyBase", d.Strategies.BaseStrategyImpl, d.Strategies.StrategyBeacon.Impl)
+	add("StrategyBase", d.Strategies.StrategyAddresses...)
+	for _, s := range d.Strategies.BySymbol {
+		add("StrategyBase", s)
+	}
+	proxy("BN254CertificateVerifier", d.Multichain.BN254CertificateVerifier)
+	proxy("CrossChainRegistry", d.Multichain.CrossChainRegistry)
+	proxy("ECDSACertificateVerifier", d.Multichain.ECDSACertificateVerifier)
+	proxy("OperatorTableUpdater", d.Multichain.OperatorTableUpdater)
+	proxy("TaskMailbox", d.AVS.TaskMailbox)
+	proxy("Eigen", d.Tokens.EIGEN.Proxy)
+	proxy("BackingEigen", d.Tokens.BackingEigen.Proxy)
+	proxy("EigenStrategy", d.Tokens.EigenStrategy)
+	return book
+}
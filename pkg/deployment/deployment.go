@@ -0,0 +1,428 @@
+// Package deployment loads EigenLayer deployment address books from the JSON
+// files under script/configs.
+//
+// Two layouts are understood:
+//
+//   - the zeus layout of script/configs/mainnet.json, with addresses under
+//     deployment.{admin,core,pods,strategies,token}
+//   - the flat layout of script/configs/mainnet/mainnet-addresses.config.json
+//     and of the deploy script outputs under script/output, with addresses
+//     under addresses.* and multisigs under parameters.*
+//
+// The deploy_from_scratch configs only hold deployment parameters and are
+// rejected.
+//
+// FromRegistry and Dial instead discover a deployment from an on-chain
+// ProtocolRegistry, so tools follow upgrades without stale config files.
+package deployment
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Proxy is a transparent upgradeable proxy and its implementation.
+type Proxy struct {
+	Proxy       common.Address
+	Impl        common.Address
+	PendingImpl common.Address
+}
+
+// Beacon is an upgradeable beacon and its implementation.
+type Beacon struct {
+	Beacon      common.Address
+	Impl        common.Address
+	PendingImpl common.Address
+}
+
+// Token is an upgradeable token with its own proxy admin.
+type Token struct {
+	Proxy
+	ProxyAdmin common.Address
+}
+
+// Admin holds the protocol's administrative addresses.
+type Admin struct {
+	CommunityMultisig  common.Address
+	ExecutorMultisig   common.Address
+	OperationsMultisig common.Address
+	PauserMultisig     common.Address
+	PauserRegistry     common.Address
+	ProxyAdmin         common.Address
+	Timelock           common.Address
+}
+
+// Core holds the core protocol contracts.
+type Core struct {
+	AllocationManager Proxy
+	// AllocationManagerView is not upgradeable; AllocationManager
+	// delegates its view functions to it.
+	AllocationManagerView common.Address
+	AVSDirectory          Proxy
+	DelegationManager     Proxy
+	KeyRegistrar          Proxy
+	PermissionController  Proxy
+	ProtocolRegistry      Proxy
+	ReleaseManager        Proxy
+	RewardsCoordinator    Proxy
+	Slasher               Proxy
+	StrategyManager       Proxy
+}
+
+// Pods holds the native restaking contracts.
+type Pods struct {
+	DelayedWithdrawalRouter Proxy
+	EigenPod                Beacon
+	EigenPodManager         Proxy
+}
+
+// Strategies holds the strategy contracts.
+type Strategies struct {
+	StrategyFactory   Proxy
+	StrategyBeacon    Beacon
+	BaseStrategyImpl  common.Address
+	BySymbol          map[string]common.Address
+	StrategyAddresses []common.Address
+}
+
+// Multichain holds the contracts used to verify operator tables on other
+// chains.
+type Multichain struct {
+	BN254CertificateVerifier Proxy
+	CrossChainRegistry       Proxy
+	ECDSACertificateVerifier Proxy
+	OperatorTableUpdater     Proxy
+}
+
+// AVS holds contracts deployed for AVSs to use directly.
+type AVS struct {
+	TaskMailbox Proxy
+}
+
+// Tokens holds the EIGEN tokens and the EIGEN strategy.
+type Tokens struct {
+	EIGEN         Token
+	BackingEigen  Token
+	EigenStrategy Proxy
+}
+
+// Deployment is a typed EigenLayer address book.
+type Deployment struct {
+	// Name is the environment name, e.g. "mainnet".
+	Name string
+	// ChainID is the chain the contracts are deployed on.
+	ChainID uint64
+	// LastUpdated is the release the file was last updated for.
+	LastUpdated string
+	// Version is the protocol's semantic version, e.g. "v1.9.0".
+	Version string
+
+	Admin      Admin
+	Core       Core
+	Pods       Pods
+	Strategies Strategies
+	Multichain Multichain
+	AVS        AVS
+	Tokens     Tokens
+
+	// Registry is set for deployments discovered with FromRegistry.
+	Registry *Registry
+}
+
+// Load reads a deployment config file.
+func Load(path string) (*Deployment, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	d, err := Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return d, nil
+}
+
+// Parse decodes a deployment config and validates every address in it.
+func Parse(raw []byte) (*Deployment, error) {
+	var root map[string]interface{}
+	if err := json.Unmarshal(raw, &root); err != nil {
+		return nil, err
+	}
+	r := &reader{root: root}
+
+	var d *Deployment
+	switch {
+	case r.has("deployment"):
+		d = r.zeus()
+	case r.has("addresses") && r.has("chainInfo"):
+		d = r.flat()
+	default:
+		return nil, errors.New("no deployment addresses found; deploy_from_scratch configs only hold parameters")
+	}
+	if len(r.errs) > 0 {
+		return nil, errors.Join(r.errs...)
+	}
+	return d, nil
+}
+
+// zeus reads the layout of script/configs/mainnet.json.
+func (r *reader) zeus() *Deployment {
+	d := &Deployment{
+		Name:        r.str("config.environment.name"),
+		ChainID:     r.uint("config.environment.chainid"),
+		LastUpdated: r.str("config.environment.lastUpdated"),
+	}
+	d.Admin = Admin{
+		CommunityMultisig:  r.address("deployment.admin.communityMultisig"),
+		ExecutorMultisig:   r.address("deployment.admin.executorMultisig"),
+		OperationsMultisig: r.address("deployment.admin.operationsMultisig"),
+		PauserMultisig:     r.address("deployment.admin.pauserMultisig"),
+		PauserRegistry:     r.address("deployment.admin.pauserRegistry"),
+		ProxyAdmin:         r.address("deployment.admin.proxyAdmin"),
+		Timelock:           r.address("deployment.admin.timelock"),
+	}
+	d.Core = Core{
+		AllocationManager:    r.proxy("deployment.core.allocationManager"),
+		AVSDirectory:         r.proxy("deployment.core.avsDirectory"),
+		DelegationManager:    r.proxy("deployment.core.delegationManager"),
+		PermissionController: r.proxy("deployment.core.permissionController"),
+		RewardsCoordinator:   r.proxy("deployment.core.rewardsCoordinator"),
+		Slasher:              r.proxy("deployment.core.slasher"),
+		StrategyManager:      r.proxy("deployment.core.strategyManager"),
+	}
+	d.Pods = Pods{
+		DelayedWithdrawalRouter: r.proxy("deployment.pods.delayedWithdrawalRouter"),
+		EigenPod:                r.beacon("deployment.pods.eigenPod"),
+		EigenPodManager:         r.proxy("deployment.pods.eigenPodManager"),
+	}
+	d.Strategies = Strategies{
+		StrategyFactory:   r.proxy("deployment.strategies.strategyFactory"),
+		StrategyBeacon:    r.beacon("deployment.strategies.strategyBeacon"),
+		BaseStrategyImpl:  r.address("deployment.strategies.preLongtailStrats.impl"),
+		StrategyAddresses: r.addresses("deployment.strategies.preLongtailStrats.addrs"),
+	}
+	d.Tokens = Tokens{
+		EIGEN:         r.token("deployment.token.EIGEN"),
+		BackingEigen:  r.token("deployment.token.bEIGEN"),
+		EigenStrategy: r.proxy("deployment.token.eigenStrategy"),
+	}
+	return d
+}
+
+// flat reads the layout of script/configs/mainnet/mainnet-addresses.config.json.
+func (r *reader) flat() *Deployment {
+	pair := func(name string) Proxy {
+		return Proxy{
+			Proxy: r.address("addresses." + name),
+			Impl:  r.address("addresses." + name + "Implementation"),
+		}
+	}
+	d := &Deployment{
+		ChainID:     r.uint("chainInfo.chainId"),
+		LastUpdated: r.str("lastUpdated"),
+		Version:     r.str("parameters.semver"),
+	}
+	d.Admin = Admin{
+		CommunityMultisig:  r.address("parameters.communityMultisig"),
+		ExecutorMultisig:   r.address("parameters.executorMultisig"),
+		OperationsMultisig: r.address("parameters.operationsMultisig"),
+		PauserMultisig:     r.address("parameters.pauserMultisig"),
+		PauserRegistry:     r.address("addresses.eigenLayerPauserReg"),
+		ProxyAdmin:         r.address("addresses.eigenLayerProxyAdmin"),
+		Timelock:           r.address("parameters.timelock"),
+	}
+	d.Core = Core{
+		AllocationManager:    pair("allocationManager"),
+		AVSDirectory:         pair("avsDirectory"),
+		DelegationManager:    pair("delegationManager"),
+		PermissionController: pair("permissionController"),
+		RewardsCoordinator:   pair("rewardsCoordinator"),
+		Slasher:              pair("slasher"),
+		StrategyManager:      pair("strategyManager"),
+	}
+	d.Pods = Pods{
+		DelayedWithdrawalRouter: pair("delayedWithdrawalRouter"),
+		EigenPod: Beacon{
+			Beacon: r.address("addresses.eigenPodBeacon"),
+			Impl:   r.address("addresses.eigenPodImplementation"),
+		},
+		EigenPodManager: pair("eigenPodManager"),
+	}
+	d.Strategies = Strategies{
+		StrategyFactory: pair("strategyFactory"),
+		StrategyBeacon: Beacon{
+			Beacon: r.address("addresses.strategyFactoryBeacon"),
+			Impl:   r.address("addresses.strategyFactoryBeaconImplementation"),
+		},
+		BaseStrategyImpl:  r.address("addresses.baseStrategyImplementation"),
+		BySymbol:          r.addressMap("addresses.strategies"),
+		StrategyAddresses: r.addresses("addresses.strategyAddresses"),
+	}
+	d.Tokens = Tokens{
+		EIGEN: Token{
+			Proxy:      Proxy{Proxy: r.address("addresses.token.EIGEN"), Impl: r.address("addresses.token.EIGENImpl")},
+			ProxyAdmin: r.address("addresses.token.tokenProxyAdmin"),
+		},
+		BackingEigen: Token{
+			Proxy:      Proxy{Proxy: r.address("addresses.token.bEIGEN"), Impl: r.address("addresses.token.bEIGENImpl")},
+			ProxyAdmin: r.address("addresses.token.tokenProxyAdmin"),
+		},
+		EigenStrategy: Proxy{
+			Proxy: r.address("addresses.token.eigenStrategy"),
+			Impl:  r.address("addresses.token.eigenStrategyImpl"),
+		},
+	}
+	return d
+}
+
+// reader extracts typed values from a decoded JSON document by dotted path,
+// collecting every validation error instead of stopping at the first.
+type reader struct {
+	root map[string]interface{}
+	errs []error
+}
+
+func (r *reader) lookup(path string) (interface{}, bool) {
+	var cur interface{} = r.root
+	for _, key := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if cur, ok = m[key]; !ok {
+			return nil, false
+		}
+	}
+	return cur, true
+}
+
+func (r *reader) has(path string) bool {
+	_, ok := r.lookup(path)
+	return ok
+}
+
+func (r *reader) fail(path string, format string, args ...interface{}) {
+	r.errs = append(r.errs, fmt.Errorf("%s: %s", path, fmt.Sprintf(format, args...)))
+}
+
+func (r *reader) str(path string) string {
+	v, ok := r.lookup(path)
+	if !ok {
+		return ""
+	}
+	s, ok := v.(string)
+	if !ok {
+		r.fail(path, "expected a string, got %v", v)
+	}
+	return s
+}
+
+func (r *reader) uint(path string) uint64 {
+	v, ok := r.lookup(path)
+	if !ok {
+		return 0
+	}
+	f, ok := v.(float64)
+	if !ok || f < 0 || f != float64(uint64(f)) {
+		r.fail(path, "expected an unsigned integer, got %v", v)
+		return 0
+	}
+	return uint64(f)
+}
+
+// address reads an optional address. Missing entries yield the zero address.
+func (r *reader) address(path string) common.Address {
+	v, ok := r.lookup(path)
+	if !ok {
+		return common.Address{}
+	}
+	return r.parseAddress(path, v)
+}
+
+func (r *reader) parseAddress(path string, v interface{}) common.Address {
+	s, ok := v.(string)
+	if !ok || !common.IsHexAddress(s) {
+		r.fail(path, "invalid address %v", v)
+		return common.Address{}
+	}
+	addr := common.HexToAddress(s)
+	// Mixed-case addresses carry an EIP-55 checksum; all-lower or all-upper
+	// case addresses do not.
+	hex := strings.TrimPrefix(strings.TrimPrefix(s, "0x"), "0X")
+	if hex != strings.ToLower(hex) && hex != strings.ToUpper(hex) && addr.Hex()[2:] != hex {
+		r.fail(path, "address %s fails its EIP-55 checksum (expected %s)", s, addr.Hex())
+	}
+	return addr
+}
+
+func (r *reader) addresses(path string) []common.Address {
+	v, ok := r.lookup(path)
+	if !ok {
+		return nil
+	}
+	list, ok := v.([]interface{})
+	if !ok {
+		r.fail(path, "expected a list of addresses")
+		return nil
+	}
+	out := make([]common.Address, len(list))
+	for i, item := range list {
+		out[i] = r.parseAddress(fmt.Sprintf("%s[%d]", path, i), item)
+	}
+	return out
+}
+
+func (r *reader) addressMap(path string) map[string]common.Address {
+	v, ok := r.lookup(path)
+	if !ok {
+		return nil
+	}
+	m, ok := v.(map[string]interface{})
+	if !ok {
+		r.fail(path, "expected an object of addresses")
+		return nil
+	}
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	out := make(map[string]common.Address, len(m))
+	for _, k := range keys {
+		out[k] = r.parseAddress(path+"."+k, m[k])
+	}
+	return out
+}
+
+func (r *reader) proxy(path string) Proxy {
+	p := Proxy{
+		Proxy:       r.address(path + ".proxy"),
+		Impl:        r.address(path + ".impl"),
+		PendingImpl: r.address(path + ".pendingImpl"),
+	}
+	if p.Proxy == (common.Address{}) && p.Impl != (common.Address{}) {
+		r.fail(path, "implementation set without a proxy")
+	}
+	return p
+}
+
+func (r *reader) beacon(path string) Beacon {
+	return Beacon{
+		Beacon:      r.address(path + ".beacon"),
+		Impl:        r.address(path + ".impl"),
+		PendingImpl: r.address(path + ".pendingImpl"),
+	}
+}
+
+func (r *reader) token(path string) Token {
+	return Token{
+		Proxy:      r.proxy(path),
+		ProxyAdmin: r.address(path + ".proxyAdmin"),
+	}
+}
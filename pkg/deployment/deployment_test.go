@@ -0,0 +1,85 @@
+package deployment
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestLoadZeusConfig(t *testing.T) {
+	d, err := Load("../../script/configs/mainnet.json")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if d.ChainID != 1 || d.Name != "mainnet" {
+		t.Errorf("Unexpected environment %q on chain %d", d.Name, d.ChainID)
+	}
+	if d.Core.DelegationManager.Proxy != common.HexToAddress("0x39053D51B77DC0d36036Fc1fCc8Cb819df8Ef37A") {
+		t.Errorf("Unexpected DelegationManager proxy %s", d.Core.DelegationManager.Proxy.Hex())
+	}
+	if len(d.Strategies.StrategyAddresses) == 0 {
+		t.Errorf("Expected pre-longtail strategies")
+	}
+
+	c, err := d.Contracts(nil)
+	if err != nil {
+		t.Fatalf("Contracts failed: %v", err)
+	}
+	if c.DelegationManager == nil || c.EigenPodManager == nil || c.RewardsCoordinator == nil {
+		t.Errorf("Expected core bindings to be bound")
+	}
+	if name, _ := d.AddressBook().Lookup(d.Core.DelegationManager.Proxy); name != "DelegationManager" {
+		t.Errorf("Expected address book to resolve DelegationManager, got %q", name)
+	}
+}
+
+func TestLoadFlatConfig(t *testing.T) {
+	d, err := Load("../../script/configs/mainnet/mainnet-addresses.config.json")
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if d.ChainID != 1 {
+		t.Errorf("Expected chain 1, got %d", d.ChainID)
+	}
+	if d.Core.AllocationManager.Proxy == (common.Address{}) || d.Core.AllocationManager.Impl == (common.Address{}) {
+		t.Errorf("Expected AllocationManager proxy and implementation")
+	}
+	if d.Strategies.BySymbol["stETH"] == (common.Address{}) {
+		t.Errorf("Expected strategies by symbol, got %v", d.Strategies.BySymbol)
+	}
+	c, err := d.Contracts(nil)
+	if err != nil {
+		t.Fatalf("Contracts failed: %v", err)
+	}
+	if c.AllocationManager == nil || c.PermissionController == nil {
+		t.Errorf("Expected slashing bindings to be bound")
+	}
+}
+
+func TestParseRejectsBadAddresses(t *testing.T) {
+	_, err := Parse([]byte(`{"deployment": {"core": {
+		"delegationManager": {"proxy": "0x1234"},
+		"strategyManager": {"proxy": "0x858646372cc42e1a627fce94aa7a7033e7cf075A"},
+		"avsDirectory": {"impl": "0x135dda560e946695d6f155dacafc6f1f25c1f5af"}
+	}}}`))
+	if err == nil {
+		t.Fatal("Expected validation errors")
+	}
+	for _, want := range []string{
+		"deployment.core.delegationManager.proxy: invalid address",
+		"deployment.core.strategyManager.proxy: address 0x858646372cc42e1a627fce94aa7a7033e7cf075A fails its EIP-55 checksum",
+		"deployment.core.avsDirectory: implementation set without a proxy",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("Expected %q in %v", want, err)
+		}
+	}
+}
+
+func TestParseRejectsDeployParams(t *testing.T) {
+	_, err := Load("../../script/configs/local/deploy_from_scratch.slashing.anvil.config.json")
+	if err == nil || !strings.Contains(err.Error(), "no deployment addresses") {
+		t.Fatalf("Expected no deployment addresses error, got %v", err)
+	}
+}
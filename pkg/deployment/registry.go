@@ -0,0 +1,180 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	protocolregistry "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ProtocolRegistry"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrDeprecated is returned when looking up a registry entry flagged as
+// deprecated.
+var ErrDeprecated = errors.New("deployment: contract is deprecated")
+
+// RegistryEntry is a contract shipped to the ProtocolRegistry.
+type RegistryEntry struct {
+	// Name is the contract name, which is also its binding package name.
+	Name       string
+	Address    common.Address
+	Pausable   bool
+	Deprecated bool
+}
+
+// Registry is the ProtocolRegistry a deployment was discovered from.
+type Registry struct {
+	Address common.Address
+	Entries []RegistryEntry
+}
+
+// Lookup returns the address shipped under name, refusing deprecated entries.
+func (r *Registry) Lookup(name string) (common.Address, error) {
+	for _, e := range r.Entries {
+		if e.Name != name {
+			continue
+		}
+		if e.Deprecated {
+			return common.Address{}, fmt.Errorf("%w: %s at %s", ErrDeprecated, name, e.Address.Hex())
+		}
+		return e.Address, nil
+	}
+	return common.Address{}, fmt.Errorf("deployment: %s is not in the protocol registry", name)
+}
+
+// FromRegistry discovers a deployment from the ProtocolRegistry at addr.
+//
+// Every entry returned by getAllDeployments is recorded in Deployment.Registry.
+// Entries that are not deprecated and name a known contract are also placed in
+// the typed fields, so Contracts binds them; deprecated entries never are.
+// The registry only records proxies and beacons, so implementation addresses
+// and multisigs are left zero.
+//
+// Call FromRegistry again to pick up contracts shipped by later upgrades.
+func FromRegistry(ctx context.Context, caller bind.ContractCaller, addr common.Address) (*Deployment, error) {
+	registry, err := protocolregistry.NewProtocolRegistryCaller(addr, caller)
+	if err != nil {
+		return nil, err
+	}
+	opts := &bind.CallOpts{Context: ctx}
+
+	// Pin both reads to one block so the version matches the deployments.
+	if br, ok := caller.(interface {
+		BlockNumber(context.Context) (uint64, error)
+	}); ok {
+		n, err := br.BlockNumber(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read block number: %w", err)
+		}
+		opts.BlockNumber = new(big.Int).SetUint64(n)
+	}
+
+	all, err := registry.GetAllDeployments(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read deployments from protocol registry %s: %w", addr.Hex(), err)
+	}
+	if len(all.Names) != len(all.Addresses) || len(all.Names) != len(all.Configs) {
+		return nil, fmt.Errorf("protocol registry %s returned %d names, %d addresses and %d configs",
+			addr.Hex(), len(all.Names), len(all.Addresses), len(all.Configs))
+	}
+	version, err := registry.Version(opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read version from protocol registry %s: %w", addr.Hex(), err)
+	}
+
+	d := &Deployment{Version: version, Registry: &Registry{Address: addr}}
+	d.Core.ProtocolRegistry.Proxy = addr
+	if cr, ok := caller.(interface {
+		ChainID(context.Context) (*big.Int, error)
+	}); ok {
+		id, err := cr.ChainID(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read chain id: %w", err)
+		}
+		d.ChainID = id.Uint64()
+	}
+
+	for i, name := range all.Names {
+		entry := RegistryEntry{
+			Name:       name,
+			Address:    all.Addresses[i],
+			Pausable:   all.Configs[i].Pausable,
+			Deprecated: all.Configs[i].Deprecated,
+		}
+		d.Registry.Entries = append(d.Registry.Entries, entry)
+		if entry.Deprecated {
+			continue
+		}
+		if slot := d.registrySlot(name); slot != nil {
+			*slot = entry.Address
+		}
+	}
+	return d, nil
+}
+
+// Dial discovers a deployment from the ProtocolRegistry at addr and binds its
+// contracts to backend.
+func Dial(ctx context.Context, backend bind.ContractBackend, addr common.Address) (*Contracts, error) {
+	d, err := FromRegistry(ctx, backend, addr)
+	if err != nil {
+		return nil, err
+	}
+	return d.Contracts(backend)
+}
+
+// registrySlot returns the field a ProtocolRegistry entry is stored in. The
+// registry records proxies, except for PauserRegistry and AllocationManagerView
+// which are not upgradeable and the EigenPod and StrategyBase beacons.
+func (d *Deployment) registrySlot(name string) *common.Address {
+	switch name {
+	case "PauserRegistry":
+		return &d.Admin.PauserRegistry
+	case "AllocationManager":
+		return &d.Core.AllocationManager.Proxy
+	case "AllocationManagerView":
+		return &d.Core.AllocationManagerView
+	case "AVSDirectory":
+		return &d.Core.AVSDirectory.Proxy
+	case "DelegationManager":
+		return &d.Core.DelegationManager.Proxy
+	case "KeyRegistrar":
+		return &d.Core.KeyRegistrar.Proxy
+	case "PermissionController":
+		return &d.Core.PermissionController.Proxy
+	case "ProtocolRegistry":
+		return &d.Core.ProtocolRegistry.Proxy
+	case "ReleaseManager":
+		return &d.Core.ReleaseManager.Proxy
+	case "RewardsCoordinator":
+		return &d.Core.RewardsCoordinator.Proxy
+	case "StrategyManager":
+		return &d.Core.StrategyManager.Proxy
+	case "EigenPodManager":
+		return &d.Pods.EigenPodManager.Proxy
+	case "EigenPod":
+		return &d.Pods.EigenPod.Beacon
+	case "StrategyFactory":
+		return &d.Strategies.StrategyFactory.Proxy
+	case "StrategyBase":
+		return &d.Strategies.StrategyBeacon.Beacon
+	case "BN254CertificateVerifier":
+		return &d.Multichain.BN254CertificateVerifier.Proxy
+	case "CrossChainRegistry":
+		return &d.Multichain.CrossChainRegistry.Proxy
+	case "ECDSACertificateVerifier":
+		return &d.Multichain.ECDSACertificateVerifier.Proxy
+	case "OperatorTableUpdater":
+		return &d.Multichain.OperatorTableUpdater.Proxy
+	case "TaskMailbox":
+		return &d.AVS.TaskMailbox.Proxy
+	case "EigenStrategy":
+		return &d.Tokens.EigenStrategy.Proxy
+	case "Eigen":
+		return &d.Tokens.EIGEN.Proxy.Proxy
+	case "BackingEigen":
+		return &d.Tokens.BackingEigen.Proxy.Proxy
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+package deployment
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	protocolregistry "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/ProtocolRegistry"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// fakeRegistry answers ProtocolRegistry calls from canned per-selector outputs.
+type fakeRegistry struct {
+	bind.ContractBackend
+	outputs map[[4]byte][]byte
+}
+
+func (f *fakeRegistry) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (f *fakeRegistry) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	var selector [4]byte
+	copy(selector[:], call.Data[:4])
+	return f.outputs[selector], nil
+}
+
+func newFakeRegistry(t *testing.T, version string, names []string, addrs []common.Address, configs []protocolregistry.IProtocolRegistryTypesDeploymentConfig) *fakeRegistry {
+	t.Helper()
+	parsed, err := protocolregistry.ProtocolRegistryMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeRegistry{outputs: make(map[[4]byte][]byte)}
+	pack := func(method string, values ...interface{}) {
+		m := parsed.Methods[method]
+		out, err := m.Outputs.Pack(values...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var selector [4]byte
+		copy(selector[:], m.ID)
+		f.outputs[selector] = out
+	}
+	pack("getAllDeployments", names, addrs, configs)
+	pack("version", version)
+	return f
+}
+
+func TestFromRegistry(t *testing.T) {
+	registryAddr := common.HexToAddress("0xf0")
+	dm := common.HexToAddress("0xd1")
+	avsDirectory := common.HexToAddress("0xa1")
+	eigenPodBeacon := common.HexToAddress("0xbe")
+	backend := newFakeRegistry(t, "v1.9.0",
+		[]string{"DelegationManager", "AVSDirectory", "EigenPod", "FutureContract"},
+		[]common.Address{dm, avsDirectory, eigenPodBeacon, common.HexToAddress("0xff")},
+		[]protocolregistry.IProtocolRegistryTypesDeploymentConfig{
+			{Pausable: true}, {Pausable: true, Deprecated: true}, {}, {},
+		})
+
+	c, err := Dial(context.Background(), backend, registryAddr)
+	if err != nil {
+		t.Fatalf("Dial failed: %v", err)
+	}
+	d := c.Deployment
+	if d.Version != "v1.9.0" {
+		t.Errorf("Expected version v1.9.0, got %q", d.Version)
+	}
+	if d.Core.DelegationManager.Proxy != dm || c.DelegationManager == nil {
+		t.Errorf("Expected DelegationManager to be bound at %s", dm.Hex())
+	}
+	if d.Pods.EigenPod.Beacon != eigenPodBeacon {
+		t.Errorf("Expected EigenPod beacon %s, got %s", eigenPodBeacon.Hex(), d.Pods.EigenPod.Beacon.Hex())
+	}
+	if c.ProtocolRegistry == nil {
+		t.Errorf("Expected the registry itself to be bound")
+	}
+	if len(d.Registry.Entries) != 4 {
+		t.Errorf("Expected every entry to be recorded, got %d", len(d.Registry.Entries))
+	}
+
+	// Deprecated entries are never bound.
+	if d.Core.AVSDirectory.Proxy != (common.Address{}) || c.AVSDirectory != nil {
+		t.Errorf("Expected deprecated AVSDirectory to be skipped")
+	}
+	if _, err := d.Registry.Lookup("AVSDirectory"); !errors.Is(err, ErrDeprecated) {
+		t.Errorf("Expected ErrDeprecated, got %v", err)
+	}
+	if addr, err := d.Registry.Lookup("FutureContract"); err != nil || addr != common.HexToAddress("0xff") {
+		t.Errorf("Expected unknown entries to be looked up, got %s, %v", addr.Hex(), err)
+	}
+}
+
+func TestFromRegistryMismatchedLengths(t *testing.T) {
+	backend := newFakeRegistry(t, "v1.9.0",
+		[]string{"DelegationManager"}, nil, []protocolregistry.IProtocolRegistryTypesDeploymentConfig{{}})
+	if _, err := FromRegistry(context.Background(), backend, common.HexToAddress("0xf0")); err == nil {
+		t.Fatal("Expected an error for mismatched registry arrays")
+	}
+}
@@ -0,0 +1,328 @@
+// Package errors decodes EigenLayer contract reverts into typed Go errors.
+//
+// Every custom error declared in the ABI of a binding under pkg/bindings has a
+// typed counterpart generated next to it (for example
+// AllocationManager.ErrAlreadyMemberOfSet). Wrap the error returned by a
+// binding call with Wrap or Registry.Wrap and inspect it with the standard
+// library:
+//
+//	_, err := allocationManager.RegisterForOperatorSets(opts, operator, params)
+//	err = errors.Wrap(err)
+//	if stderrors.As(err, &allocationmanager.ErrAlreadyMemberOfSet{}) {
+//		// ...
+//	}
+package errors
+
+import (
+	"encoding/hex"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Selectors of the revert reasons built into Solidity.
+var (
+	errorStringSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0} // Error(string)
+	panicSelector       = [4]byte{0x4e, 0x48, 0x7b, 0x71} // Panic(uint256)
+)
+
+// Contract describes the custom errors of a single binding.
+type Contract struct {
+	// Name is the contract (and binding package) name.
+	Name string
+	// MetaData is the binding's <Contract>MetaData.
+	MetaData *bind.MetaData
+	// Errors maps each custom error name to its typed Go error.
+	Errors map[string]error
+}
+
+// entry is a custom error declared by one contract.
+type entry struct {
+	contract string
+	abiError abi.Error
+	typed    reflect.Type
+}
+
+// Registry maps 4-byte error selectors to the custom errors declaring them.
+type Registry struct {
+	bySelector map[[4]byte][]entry
+}
+
+// NewRegistry builds a registry from the given contracts.
+func NewRegistry(contracts ...Contract) (*Registry, error) {
+	r := &Registry{bySelector: make(map[[4]byte][]entry)}
+	for _, c := range contracts {
+		parsed, err := c.MetaData.GetAbi()
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to parse ABI: %w", c.Name, err)
+		}
+		for name, abiError := range parsed.Errors {
+			var selector [4]byte
+			copy(selector[:], abiError.ID[:4])
+
+			e := entry{contract: c.Name, abiError: abiError}
+			if typed, ok := c.Errors[name]; ok {
+				e.typed = reflect.TypeOf(typed)
+			}
+			r.bySelector[selector] = append(r.bySelector[selector], e)
+		}
+	}
+	for _, entries := range r.bySelector {
+		sort.Slice(entries, func(i, j int) bool { return entries[i].contract < entries[j].contract })
+	}
+	return r, nil
+}
+
+var (
+	defaultOnce     sync.Once
+	defaultRegistry *Registry
+)
+
+// Default returns the registry built from every binding in pkg/bindings.
+func Default() *Registry {
+	defaultOnce.Do(func() {
+		r, err := NewRegistry(contracts...)
+		if err != nil {
+			panic(fmt.Sprintf("errors: failed to build default registry: %v", err))
+		}
+		defaultRegistry = r
+	})
+	return defaultRegistry
+}
+
+// Wrap decodes the revert data carried by err with the default registry. See
+// Registry.Wrap.
+func Wrap(err error) error {
+	return Default().Wrap(err)
+}
+
+// Decode decodes raw revert data with the default registry. See
+// Registry.Decode.
+func Decode(data []byte) (*RevertError, bool) {
+	return Default().Decode(data)
+}
+
+// RevertError is a decoded contract revert.
+type RevertError struct {
+	// Name is the custom error name, or "Error"/"Panic" for the builtin reasons.
+	Name string
+	// Signature is the canonical error signature, e.g. "CurrentlyPaused(uint8)".
+	Signature string
+	// Contract is the contract the error came from. It is empty if the
+	// selector is declared by several contracts and no hint was given.
+	Contract string
+	// Candidates lists every contract declaring this error.
+	Candidates []string
+	// Args holds the decoded error arguments, keyed by parameter name
+	// (or "arg<i>" for unnamed parameters).
+	Args map[string]interface{}
+	// Data is the raw revert data.
+	Data []byte
+
+	typed []error
+	cause error
+}
+
+// Error implements the error interface.
+func (e *RevertError) Error() string {
+	var b strings.Builder
+	b.WriteString("execution reverted: ")
+	if e.Contract != "" {
+		b.WriteString(e.Contract)
+		b.WriteString(".")
+	}
+	b.WriteString(e.Name)
+	b.WriteString("(")
+	keys := make([]string, 0, len(e.Args))
+	for k := range e.Args {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%s: %v", k, e.Args[k])
+	}
+	b.WriteString(")")
+	return b.String()
+}
+
+// Unwrap returns the typed errors of every candidate contract, followed by the
+// original error, so errors.As matches the typed error of any contract that
+// declares it.
+func (e *RevertError) Unwrap() []error {
+	errs := append([]error(nil), e.typed...)
+	if e.cause != nil {
+		errs = append(errs, e.cause)
+	}
+	return errs
+}
+
+// Wrap inspects err for revert data (as returned by eth_call and
+// eth_estimateGas) and, if it decodes to a known error, returns a *RevertError
+// wrapping err. Otherwise err is returned unchanged.
+func (r *Registry) Wrap(err error) error {
+	return r.WrapFor("", err)
+}
+
+// WrapFor is like Wrap, but attributes errors declared by several contracts to
+// the named contract when it is one of them.
+func (r *Registry) WrapFor(contract string, err error) error {
+	if err == nil {
+		return nil
+	}
+	var revert *RevertError
+	if stderrors.As(err, &revert) {
+		return err
+	}
+	data, ok := RevertData(err)
+	if !ok {
+		return err
+	}
+	revert, ok = r.DecodeFor(contract, data)
+	if !ok {
+		return err
+	}
+	revert.cause = err
+	return revert
+}
+
+// Decode decodes raw revert data.
+func (r *Registry) Decode(data []byte) (*RevertError, bool) {
+	return r.DecodeFor("", data)
+}
+
+// DecodeFor decodes raw revert data, preferring the named contract when the
+// selector is declared by several contracts. An empty contract name means no
+// preference.
+func (r *Registry) DecodeFor(contract string, data []byte) (*RevertError, bool) {
+	if len(data) < 4 {
+		return nil, false
+	}
+	var selector [4]byte
+	copy(selector[:], data[:4])
+
+	switch selector {
+	case errorStringSelector:
+		return decodeBuiltin("Error", "reason", "string", data)
+	case panicSelector:
+		return decodeBuiltin("Panic", "code", "uint256", data)
+	}
+
+	entries := r.bySelector[selector]
+	if len(entries) == 0 {
+		return nil, false
+	}
+	if contract != "" {
+		for _, e := range entries {
+			if e.contract == contract {
+				entries = []entry{e}
+				break
+			}
+		}
+	}
+
+	first := entries[0].abiError
+	values, err := first.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil, false
+	}
+
+	revert := &RevertError{
+		Name:      first.Name,
+		Signature: first.Sig,
+		Args:      make(map[string]interface{}, len(values)),
+		Data:      data,
+	}
+	for i, input := range first.Inputs {
+		name := input.Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		revert.Args[name] = values[i]
+	}
+	for _, e := range r.bySelector[selector] {
+		revert.Candidates = append(revert.Candidates, e.contract)
+	}
+	if len(entries) == 1 {
+		revert.Contract = entries[0].contract
+	}
+	for _, e := range entries {
+		if typed := newTyped(e.typed, values); typed != nil {
+			revert.typed = append(revert.typed, typed)
+		}
+	}
+	return revert, true
+}
+
+// newTyped builds a typed error value from the decoded arguments. The fields
+// of generated error structs follow the ABI parameter order.
+func newTyped(typ reflect.Type, values []interface{}) (typed error) {
+	if typ == nil || typ.Kind() != reflect.Struct || typ.NumField() != len(values) {
+		return nil
+	}
+	defer func() {
+		// abi.ConvertType panics on mismatched types.
+		if recover() != nil {
+			typed = nil
+		}
+	}()
+	v := reflect.New(typ).Elem()
+	for i, value := range values {
+		field := v.Field(i)
+		converted := abi.ConvertType(value, reflect.New(field.Type()).Interface())
+		field.Set(reflect.ValueOf(converted).Elem())
+	}
+	typed, _ = v.Interface().(error)
+	return typed
+}
+
+// decodeBuiltin decodes the single-argument Error(string) and Panic(uint256)
+// reasons.
+func decodeBuiltin(name, arg, argType string, data []byte) (*RevertError, bool) {
+	typ, err := abi.NewType(argType, "", nil)
+	if err != nil {
+		return nil, false
+	}
+	values, err := abi.Arguments{{Name: arg, Type: typ}}.Unpack(data[4:])
+	if err != nil {
+		return nil, false
+	}
+	return &RevertError{
+		Name:      name,
+		Signature: name + "(" + argType + ")",
+		Args:      map[string]interface{}{arg: values[0]},
+		Data:      data,
+	}, true
+}
+
+// RevertData extracts the revert data attached to an RPC error, if any.
+func RevertData(err error) ([]byte, bool) {
+	var dataErr rpc.DataError
+	if !stderrors.As(err, &dataErr) {
+		return nil, false
+	}
+	switch data := dataErr.ErrorData().(type) {
+	case string:
+		b, err := hexutil.Decode(data)
+		if err != nil {
+			b, err = hex.DecodeString(strings.TrimPrefix(data, "0x"))
+			if err != nil {
+				return nil, false
+			}
+		}
+		return b, len(b) > 0
+	case []byte:
+		return data, len(data) > 0
+	}
+	return nil, false
+}
@@ -0,0 +1,116 @@
+package errors
+
+import (
+	stderrors "errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	keyregistrar "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/KeyRegistrar"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// rpcError mimics the JSON-RPC error returned for a reverted call.
+type rpcError struct {
+	data string
+}
+
+func (e rpcError) Error() string          { return "execution reverted" }
+func (e rpcError) ErrorData() interface{} { return e.data }
+
+func revertData(t *testing.T, metaABI, name string, args ...interface{}) []byte {
+	t.Helper()
+	parsed, err := abi.JSON(strings.NewReader(metaABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := parsed.Errors[name]
+	packed, err := e.Inputs.Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return append(append([]byte(nil), e.ID[:4]...), packed...)
+}
+
+func TestWrapTypedError(t *testing.T) {
+	data := revertData(t, allocationmanager.AllocationManagerMetaData.ABI, "AlreadyMemberOfSet")
+	err := Wrap(rpcError{data: hexutil.Encode(data)})
+
+	if !stderrors.As(err, &allocationmanager.ErrAlreadyMemberOfSet{}) {
+		t.Fatalf("Expected errors.As to match ErrAlreadyMemberOfSet, got %v", err)
+	}
+
+	var revert *RevertError
+	if !stderrors.As(err, &revert) {
+		t.Fatalf("Expected a *RevertError, got %T", err)
+	}
+	if revert.Name != "AlreadyMemberOfSet" {
+		t.Errorf("Expected name AlreadyMemberOfSet, got %s", revert.Name)
+	}
+	if len(revert.Candidates) == 0 {
+		t.Errorf("Expected at least one candidate contract")
+	}
+	if !stderrors.As(err, &rpcError{}) {
+		t.Errorf("Expected the original RPC error to stay in the chain")
+	}
+}
+
+func TestDecodeForArguments(t *testing.T) {
+	operatorSet := keyregistrar.OperatorSet{Avs: common.HexToAddress("0x1234"), Id: 7}
+	operator := common.HexToAddress("0xabcd")
+	data := revertData(t, keyregistrar.KeyRegistrarMetaData.ABI, "KeyNotFound", operatorSet, operator)
+
+	revert, ok := Default().DecodeFor("KeyRegistrar", data)
+	if !ok {
+		t.Fatalf("Expected KeyNotFound to decode")
+	}
+	if revert.Contract != "KeyRegistrar" {
+		t.Errorf("Expected contract KeyRegistrar, got %s", revert.Contract)
+	}
+
+	var typed keyregistrar.ErrKeyNotFound
+	if !stderrors.As(revert, &typed) {
+		t.Fatalf("Expected errors.As to match ErrKeyNotFound")
+	}
+	if typed.OperatorSet != operatorSet || typed.Operator != operator {
+		t.Errorf("Expected decoded arguments %v/%s, got %v/%s", operatorSet, operator.Hex(), typed.OperatorSet, typed.Operator.Hex())
+	}
+}
+
+func TestDecodeBuiltin(t *testing.T) {
+	args := abi.Arguments{{Type: mustType(t, "uint256")}}
+	packed, err := args.Pack(big.NewInt(0x11))
+	if err != nil {
+		t.Fatal(err)
+	}
+	revert, ok := Decode(append(panicSelector[:], packed...))
+	if !ok {
+		t.Fatalf("Expected Panic(uint256) to decode")
+	}
+	if revert.Name != "Panic" || revert.Args["code"].(*big.Int).Int64() != 0x11 {
+		t.Errorf("Expected Panic(17), got %s", revert.Error())
+	}
+}
+
+func TestWrapPassThrough(t *testing.T) {
+	plain := stderrors.New("connection refused")
+	if got := Wrap(plain); got != plain {
+		t.Errorf("Expected errors without revert data to pass through, got %v", got)
+	}
+	unknown := rpcError{data: "0xdeadbeef"}
+	if got := Wrap(unknown); got != error(unknown) {
+		t.Errorf("Expected unknown selectors to pass through, got %v", got)
+	}
+}
+
+func mustType(t *testing.T, name string) abi.Type {
+	t.Helper()
+	typ, err := abi.NewType(name, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return typ
+}
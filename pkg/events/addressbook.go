@@ -0,0 +1,27 @@
+package events
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AddressBook maps deployed contract addresses to binding names, e.g. the
+// AllocationManager proxy address to "AllocationManager".
+type AddressBook map[common.Address]string
+
+// NewAddressBook builds an address book from a contract name to address map,
+// the shape deployment configs are usually read into.
+func NewAddressBook(byName map[string]common.Address) AddressBook {
+	book := make(AddressBook, len(byName))
+	for name, addr := range byName {
+		if addr != (common.Address{}) {
+			book[addr] = name
+		}
+	}
+	return book
+}
+
+// Lookup returns the contract name deployed at addr.
+func (b AddressBook) Lookup(addr common.Address) (string, bool) {
+	name, ok := b[addr]
+	return name, ok
+}
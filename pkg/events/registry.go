@@ -0,0 +1,226 @@
+// Package events decodes arbitrary logs emitted by EigenLayer contracts into
+// the event structs of the generated bindings.
+//
+// The registry is keyed by topic0 and built from the ABI of every binding under
+// pkg/bindings. Many events (Paused, Initialized, OwnershipTransferred, ...)
+// are declared by several contracts; supply an AddressBook so logs are decoded
+// with the binding of the contract that actually emitted them:
+//
+//	registry := events.Default().WithAddressBook(book)
+//	for _, l := range receipt.Logs {
+//		ev, err := registry.Decode(*l)
+//		if err != nil {
+//			continue
+//		}
+//		switch e := ev.Value.(type) {
+//		case *allocationmanager.AllocationManagerOperatorSlashed:
+//			// ...
+//		}
+//	}
+package events
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ErrUnknownEvent is returned for logs whose topic0 is not declared by any
+// registered contract.
+var ErrUnknownEvent = errors.New("events: unknown event")
+
+// Contract describes the events of a single binding.
+type Contract struct {
+	// Name is the contract (and binding package) name.
+	Name string
+	// MetaData is the binding's <Contract>MetaData.
+	MetaData *bind.MetaData
+	// Filterer returns the binding's *<Contract>Filterer, whose Parse<Event>
+	// methods decode the logs.
+	Filterer func() (interface{}, error)
+}
+
+// filterer adapts a generated New<Contract>Filterer constructor. The filterer
+// is only used to parse logs, so it is bound to no address and no backend.
+func filterer[T any](newFilterer func(common.Address, bind.ContractFilterer) (*T, error)) func() (interface{}, error) {
+	return func() (interface{}, error) {
+		return newFilterer(common.Address{}, nil)
+	}
+}
+
+// Event is a decoded log.
+type Event struct {
+	// Name is the Solidity event name.
+	Name string
+	// Contract is the binding used to decode the log.
+	Contract string
+	// Value is the binding's event struct, e.g.
+	// *allocationmanager.AllocationManagerOperatorSlashed.
+	Value interface{}
+	// Log is the raw log.
+	Log types.Log
+}
+
+// decoder decodes one event of one contract.
+type decoder struct {
+	contract string
+	event    abi.Event
+	parse    reflect.Value // func(types.Log) (*T, error)
+}
+
+func (d decoder) decode(log types.Log) (*Event, error) {
+	out := d.parse.Call([]reflect.Value{reflect.ValueOf(log)})
+	if err, _ := out[1].Interface().(error); err != nil {
+		return nil, err
+	}
+	return &Event{Name: d.event.RawName, Contract: d.contract, Value: out[0].Interface(), Log: log}, nil
+}
+
+// Registry maps event topics to the bindings that decode them.
+type Registry struct {
+	byTopic map[common.Hash][]decoder
+	book    AddressBook
+}
+
+// NewRegistry builds a registry from the given contracts.
+func NewRegistry(contracts ...Contract) (*Registry, error) {
+	r := &Registry{byTopic: make(map[common.Hash][]decoder)}
+	for _, c := range contracts {
+		parsed, err := c.MetaData.GetAbi()
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to parse ABI: %w", c.Name, err)
+		}
+		f, err := c.Filterer()
+		if err != nil {
+			return nil, fmt.Errorf("%s: failed to create filterer: %w", c.Name, err)
+		}
+		fv := reflect.ValueOf(f)
+		for name, event := range parsed.Events {
+			if event.Anonymous {
+				continue
+			}
+			// abigen names the parser after the (de-overloaded) event name.
+			parse := fv.MethodByName("Parse" + abi.ToCamelCase(name))
+			if !parse.IsValid() {
+				return nil, fmt.Errorf("%s: no Parse%s method for event %s", c.Name, abi.ToCamelCase(name), event.Sig)
+			}
+			r.byTopic[event.ID] = append(r.byTopic[event.ID], decoder{contract: c.Name, event: event, parse: parse})
+		}
+	}
+	for _, decoders := range r.byTopic {
+		sort.Slice(decoders, func(i, j int) bool {
+			return preferred(decoders[i].contract, decoders[j].contract)
+		})
+	}
+	return r, nil
+}
+
+// preferred orders implementation contracts before their storage layouts and
+// interfaces, so logs decoded without an address book resolve to the binding a
+// user most likely holds.
+func preferred(a, b string) bool {
+	ra, rb := rank(a), rank(b)
+	if ra != rb {
+		return ra < rb
+	}
+	return a < b
+}
+
+func rank(contract string) int {
+	switch {
+	case len(contract) > 1 && contract[0] == 'I' && contract[1] >= 'A' && contract[1] <= 'Z':
+		return 2
+	case strings.HasSuffix(contract, "Storage"):
+		return 1
+	}
+	return 0
+}
+
+var (
+	defaultOnce     sync.Once
+	defaultRegistry *Registry
+)
+
+// Default returns the registry built from every binding in pkg/bindings.
+func Default() *Registry {
+	defaultOnce.Do(func() {
+		r, err := NewRegistry(contracts...)
+		if err != nil {
+			panic(fmt.Sprintf("events: failed to build default registry: %v", err))
+		}
+		defaultRegistry = r
+	})
+	return defaultRegistry
+}
+
+// WithAddressBook returns a copy of the registry that uses book to pick the
+// binding of the emitting contract.
+func (r *Registry) WithAddressBook(book AddressBook) *Registry {
+	return &Registry{byTopic: r.byTopic, book: book}
+}
+
+// Contracts returns the names of every contract declaring the event with the
+// given topic0.
+func (r *Registry) Contracts(topic common.Hash) []string {
+	var names []string
+	for _, d := range r.byTopic[topic] {
+		names = append(names, d.contract)
+	}
+	return names
+}
+
+// Decode decodes a log into its binding event struct. If the registry has an
+// address book that knows the log address, that contract's binding is tried
+// first.
+func (r *Registry) Decode(log types.Log) (*Event, error) {
+	if len(log.Topics) == 0 {
+		return nil, ErrUnknownEvent
+	}
+	decoders := r.byTopic[log.Topics[0]]
+	if len(decoders) == 0 {
+		return nil, fmt.Errorf("%w: topic %s", ErrUnknownEvent, log.Topics[0].Hex())
+	}
+
+	if name, ok := r.book.Lookup(log.Address); ok {
+		for _, d := range decoders {
+			if d.contract == name {
+				if ev, err := d.decode(log); err == nil {
+					return ev, nil
+				}
+			}
+		}
+	}
+
+	// Events with the same signature may differ in which fields are indexed,
+	// so fall through the candidates until one unpacks.
+	var firstErr error
+	for _, d := range decoders {
+		ev, err := d.decode(log)
+		if err == nil {
+			return ev, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, fmt.Errorf("events: failed to decode %s: %w", decoders[0].event.Sig, firstErr)
+}
+
+// DecodeAll decodes every log it recognizes and skips the rest.
+func (r *Registry) DecodeAll(logs []*types.Log) []*Event {
+	var out []*Event
+	for _, l := range logs {
+		if ev, err := r.Decode(*l); err == nil {
+			out = append(out, ev)
+		}
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+package events
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// makeLog encodes an event the way the EVM would emit it.
+func makeLog(t *testing.T, meta *bind.MetaData, name string, addr common.Address, topics []common.Hash, data ...interface{}) types.Log {
+	t.Helper()
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	event := parsed.Events[name]
+	packed, err := event.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return types.Log{Address: addr, Topics: append([]common.Hash{event.ID}, topics...), Data: packed}
+}
+
+func TestDecodeConcreteEvent(t *testing.T) {
+	operatorSet := allocationmanager.OperatorSet{Avs: common.HexToAddress("0xa1"), Id: 4}
+	operator := common.HexToAddress("0xbeef")
+	log := makeLog(t, allocationmanager.AllocationManagerMetaData, "OperatorSlashed", common.HexToAddress("0x1"), nil,
+		operator, operatorSet, []common.Address{{0x5}}, []*big.Int{big.NewInt(5e17)}, "misbehaved")
+
+	ev, err := Default().Decode(log)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	slashed, ok := ev.Value.(*allocationmanager.AllocationManagerOperatorSlashed)
+	if !ok {
+		t.Fatalf("Expected *AllocationManagerOperatorSlashed, got %T from %s", ev.Value, ev.Contract)
+	}
+	if ev.Name != "OperatorSlashed" || ev.Contract != "AllocationManager" {
+		t.Errorf("Expected AllocationManager.OperatorSlashed, got %s.%s", ev.Contract, ev.Name)
+	}
+	if slashed.Operator != operator || slashed.OperatorSet != operatorSet || slashed.Description != "misbehaved" {
+		t.Errorf("Unexpected decoded event %+v", slashed)
+	}
+}
+
+func TestDecodeWithAddressBook(t *testing.T) {
+	dmAddr := common.HexToAddress("0xd1")
+	account := common.HexToAddress("0xabc")
+	log := makeLog(t, delegationmanager.DelegationManagerMetaData, "Paused", dmAddr,
+		[]common.Hash{common.BytesToHash(account.Bytes())}, big.NewInt(1))
+
+	registry := Default()
+	if len(registry.Contracts(log.Topics[0])) < 2 {
+		t.Fatalf("Expected Paused to be declared by several contracts")
+	}
+
+	book := NewAddressBook(map[string]common.Address{"DelegationManager": dmAddr})
+	ev, err := registry.WithAddressBook(book).Decode(log)
+	if err != nil {
+		t.Fatalf("Decode failed: %v", err)
+	}
+	paused, ok := ev.Value.(*delegationmanager.DelegationManagerPaused)
+	if !ok {
+		t.Fatalf("Expected *DelegationManagerPaused, got %T", ev.Value)
+	}
+	if paused.Account != account || paused.NewPausedStatus.Int64() != 1 {
+		t.Errorf("Unexpected decoded event %+v", paused)
+	}
+	if name, _ := book.Lookup(dmAddr); name != "DelegationManager" {
+		t.Errorf("Expected address book to resolve DelegationManager, got %q", name)
+	}
+}
+
+func TestDecodeUnknown(t *testing.T) {
+	_, err := Default().Decode(types.Log{Topics: []common.Hash{{0x1}}})
+	if !errors.Is(err, ErrUnknownEvent) {
+		t.Errorf("Expected ErrUnknownEvent, got %v", err)
+	}
+}
@@ -0,0 +1,279 @@
+// Package fake keeps the state of the in-memory contract fakes generated next
+// to every binding, so business logic written against the binding interfaces
+// can be unit tested without a chain:
+//
+//	am := allocationmanager.NewFakeAllocationManager(addr)
+//	am.StubGetAllocationDelay(operator, true, 17)
+//	am.OnModifyAllocations(func(opts *bind.TransactOpts, operator common.Address, params []allocationmanager.IAllocationManagerTypesAllocateParams) error {
+//		am.EmitAllocationUpdated(&allocationmanager.AllocationManagerAllocationUpdated{Operator: operator})
+//		return nil
+//	})
+//	keeper := NewKeeper(am) // takes an allocationmanager.AllocationManagerReader etc.
+//
+// Reads return the values stubbed for their exact arguments, or zero values
+// like unset contract storage. Writes are recorded and run the hook set for
+// their method. Emitted events are served to the binding's own Filterer, so
+// Filter*, Watch* and Parse* behave as against a node.
+package fake
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Call is a write recorded by a fake.
+type Call struct {
+	Method string
+	Args   []interface{}
+	From   common.Address
+	Value  *big.Int
+	Tx     *types.Transaction
+}
+
+// Contract is the state of one fake contract. It is safe for concurrent use.
+type Contract struct {
+	address common.Address
+	abi     abi.ABI
+	logs    *Logs
+
+	mu       sync.Mutex
+	reads    map[string][]interface{}
+	failures map[string]error
+	hooks    map[string]interface{}
+	calls    []Call
+}
+
+// NewContract returns an empty fake of the contract described by meta. It
+// panics if the ABI does not parse, which generated metadata always does.
+func NewContract(meta *bind.MetaData, address common.Address) *Contract {
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		panic(fmt.Sprintf("fake: %v", err))
+	}
+	return &Contract{
+		address:  address,
+		abi:      *parsed,
+		logs:     NewLogs(),
+		reads:    make(map[string][]interface{}),
+		failures: make(map[string]error),
+		hooks:    make(map[string]interface{}),
+	}
+}
+
+// Address returns the address the fake is deployed at.
+func (c *Contract) Address() common.Address {
+	return c.address
+}
+
+// Logs returns the log store the fake's Filterer reads from.
+func (c *Contract) Logs() *Logs {
+	return c.logs
+}
+
+// Stub sets the values a read of method returns for args. It panics if args do
+// not encode for method.
+func (c *Contract) Stub(method string, args []interface{}, outs ...interface{}) {
+	key, err := c.key(method, args)
+	if err != nil {
+		panic(err)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.reads[key] = outs
+}
+
+// Read copies the values stubbed for method and args into outs, which must be
+// pointers to the types passed to Stub. Without a stub outs are left at their
+// zero values.
+func (c *Contract) Read(method string, args []interface{}, outs ...interface{}) error {
+	key, err := c.key(method, args)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err := c.failures[method]; err != nil {
+		return err
+	}
+	stubbed, ok := c.reads[key]
+	if !ok {
+		return nil
+	}
+	for i, out := range outs {
+		if i < len(stubbed) && stubbed[i] != nil {
+			reflect.ValueOf(out).Elem().Set(reflect.ValueOf(stubbed[i]))
+		}
+	}
+	return nil
+}
+
+// Fail makes every read and write of method return err, e.g. a typed custom
+// error from the binding package. A nil err clears the failure.
+func (c *Contract) Fail(method string, err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err == nil {
+		delete(c.failures, method)
+	} else {
+		c.failures[method] = err
+	}
+}
+
+// SetHook registers the typed hook the generated On<Method> setters store.
+func (c *Contract) SetHook(method string, hook interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.hooks[method] = hook
+}
+
+// Hook returns the hook registered for method, or nil.
+func (c *Contract) Hook(method string) interface{} {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hooks[method]
+}
+
+// Transact records a write of method. run executes the method's hook; if it
+// fails the write is not recorded and its error is returned. The returned
+// transaction carries the call data and a nonce counting successful writes,
+// but is never signed or sent.
+func (c *Contract) Transact(opts *bind.TransactOpts, method string, run func() error, args ...interface{}) (*types.Transaction, error) {
+	data, err := c.calldata(method, args)
+	if err != nil {
+		return nil, err
+	}
+	c.mu.Lock()
+	failure := c.failures[method]
+	c.mu.Unlock()
+	if failure != nil {
+		return nil, failure
+	}
+	// The hook runs unlocked so it can stub reads and emit events.
+	if err := run(); err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	call := Call{Method: method, Args: args}
+	inner := &types.LegacyTx{Nonce: uint64(len(c.calls)), To: &c.address, Data: data}
+	if opts != nil {
+		call.From, call.Value = opts.From, opts.Value
+		inner.Value, inner.Gas, inner.GasPrice = opts.Value, opts.GasLimit, opts.GasPrice
+	}
+	call.Tx = types.NewTx(inner)
+	c.calls = append(c.calls, call)
+	return call.Tx, nil
+}
+
+// Calls returns the recorded writes of method in order, or of every method if
+// method is empty.
+func (c *Contract) Calls(method string) []Call {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var calls []Call
+	for _, call := range c.calls {
+		if method == "" || call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Emit stores event, a pointer to the binding's event struct, as a log of the
+// named ABI event and delivers it to watchers. It panics if the fields do not
+// encode for the event.
+func (c *Contract) Emit(name string, event interface{}) {
+	log, err := c.log(name, event)
+	if err != nil {
+		panic(err)
+	}
+	c.logs.Append(log)
+}
+
+// key identifies a read by its method and encoded arguments.
+func (c *Contract) key(method string, args []interface{}) (string, error) {
+	data, err := c.calldata(method, args)
+	if err != nil {
+		return "", err
+	}
+	return method + string(data), nil
+}
+
+// calldata encodes a call of method. Fallback and receive calls, which are not
+// ABI methods, carry their raw call data.
+func (c *Contract) calldata(method string, args []interface{}) ([]byte, error) {
+	if m, ok := c.abi.Methods[method]; ok {
+		packed, err := m.Inputs.Pack(args...)
+		if err != nil {
+			return nil, fmt.Errorf("fake: %s: %w", method, err)
+		}
+		return append(append([]byte{}, m.ID...), packed...), nil
+	}
+	switch method {
+	case "fallback":
+		if len(args) == 1 {
+			if data, ok := args[0].([]byte); ok {
+				return bytes.Clone(data), nil
+			}
+		}
+	case "receive":
+		return nil, nil
+	}
+	return nil, fmt.Errorf("fake: no method %q in the ABI", method)
+}
+
+// log encodes event as a log of the named ABI event.
+func (c *Contract) log(name string, event interface{}) (types.Log, error) {
+	e, ok := c.abi.Events[name]
+	if !ok {
+		return types.Log{}, fmt.Errorf("fake: no event %q in the ABI", name)
+	}
+	v := reflect.Indirect(reflect.ValueOf(event))
+	if v.Kind() != reflect.Struct {
+		return types.Log{}, fmt.Errorf("fake: event %s must be a struct, got %T", name, event)
+	}
+
+	log := types.Log{Address: c.address}
+	if !e.Anonymous {
+		log.Topics = append(log.Topics, e.ID)
+	}
+	var data []interface{}
+	for i, input := range e.Inputs {
+		field := v.FieldByName(eventField(input.Name, i))
+		if !field.IsValid() {
+			return types.Log{}, fmt.Errorf("fake: event %s has no field for %q", name, input.Name)
+		}
+		if !input.Indexed {
+			data = append(data, field.Interface())
+			continue
+		}
+		topics, err := abi.MakeTopics([]interface{}{field.Interface()})
+		if err != nil {
+			return types.Log{}, fmt.Errorf("fake: event %s: %w", name, err)
+		}
+		log.Topics = append(log.Topics, topics[0][0])
+	}
+	packed, err := e.Inputs.NonIndexed().Pack(data...)
+	if err != nil {
+		return types.Log{}, fmt.Errorf("fake: event %s: %w", name, err)
+	}
+	log.Data = packed
+	return log, nil
+}
+
+// eventField is the name abigen gives the struct field of an event input.
+func eventField(name string, i int) string {
+	if name == "" {
+		return fmt.Sprintf("Arg%d", i)
+	}
+	return abi.ToCamelCase(name)
+}
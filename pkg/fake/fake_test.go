@@ -0,0 +1,137 @@
+package fake
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var testMetaData = &bind.MetaData{
+	ABI: `[
+		{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"owner","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+		{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+		{"type":"event","name":"Transfer","anonymous":false,"inputs":[{"name":"from","type":"address","indexed":true},{"name":"to","type":"address","indexed":true},{"name":"value","type":"uint256","indexed":false}]}
+	]`,
+}
+
+type testTransfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   types.Log
+}
+
+var (
+	alice = common.HexToAddress("0xa11ce")
+	bob   = common.HexToAddress("0xb0b")
+)
+
+func TestReadStubs(t *testing.T) {
+	c := NewContract(testMetaData, common.HexToAddress("0x1"))
+
+	var balance *big.Int
+	if err := c.Read("balanceOf", []interface{}{alice}, &balance); err != nil || balance != nil {
+		t.Fatalf("Expected an unstubbed read to return the zero value, got %v, %v", balance, err)
+	}
+
+	c.Stub("balanceOf", []interface{}{alice}, big.NewInt(7))
+	if err := c.Read("balanceOf", []interface{}{alice}, &balance); err != nil || balance.Int64() != 7 {
+		t.Errorf("Expected 7, got %v, %v", balance, err)
+	}
+	balance = nil
+	if err := c.Read("balanceOf", []interface{}{bob}, &balance); err != nil || balance != nil {
+		t.Errorf("Expected stubs to be keyed by arguments, got %v, %v", balance, err)
+	}
+
+	failure := errors.New("paused")
+	c.Fail("balanceOf", failure)
+	if err := c.Read("balanceOf", []interface{}{alice}, &balance); !errors.Is(err, failure) {
+		t.Errorf("Expected the configured failure, got %v", err)
+	}
+}
+
+func TestTransactRecordsCalls(t *testing.T) {
+	c := NewContract(testMetaData, common.HexToAddress("0x1"))
+	opts := &bind.TransactOpts{From: alice}
+
+	tx, err := c.Transact(opts, "transfer", func() error {
+		c.Stub("balanceOf", []interface{}{bob}, big.NewInt(5))
+		return nil
+	}, bob, big.NewInt(5))
+	if err != nil {
+		t.Fatalf("Transact failed: %v", err)
+	}
+	if *tx.To() != c.Address() || len(tx.Data()) != 4+64 {
+		t.Errorf("Expected the transaction to carry the call, got to %s and %d bytes", tx.To().Hex(), len(tx.Data()))
+	}
+	var balance *big.Int
+	if err := c.Read("balanceOf", []interface{}{bob}, &balance); err != nil || balance.Int64() != 5 {
+		t.Errorf("Expected the hook to update the stub, got %v, %v", balance, err)
+	}
+
+	reverted := errors.New("insufficient balance")
+	if _, err := c.Transact(opts, "transfer", func() error { return reverted }, bob, big.NewInt(1)); !errors.Is(err, reverted) {
+		t.Errorf("Expected the hook error, got %v", err)
+	}
+
+	calls := c.Calls("transfer")
+	if len(calls) != 1 {
+		t.Fatalf("Expected one recorded call, got %d", len(calls))
+	}
+	if calls[0].From != alice || calls[0].Args[0] != bob || calls[0].Tx != tx {
+		t.Errorf("Unexpected call %+v", calls[0])
+	}
+}
+
+func TestEmitServesFilterer(t *testing.T) {
+	c := NewContract(testMetaData, common.HexToAddress("0x1"))
+	parsed, err := testMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	bound := bind.NewBoundContract(c.Address(), *parsed, nil, nil, c.Logs())
+
+	watched := make(chan types.Log, 1)
+	sub, err := c.Logs().SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{Addresses: []common.Address{c.Address()}}, watched)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Unsubscribe()
+
+	c.Emit("Transfer", &testTransfer{From: alice, To: bob, Value: big.NewInt(3)})
+	c.Emit("Transfer", &testTransfer{From: bob, To: alice, Value: big.NewInt(4)})
+
+	logs, filtered, err := bound.FilterLogs(&bind.FilterOpts{Start: 2}, "Transfer", nil, []interface{}{alice})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The error channel closes once every matching log is queued.
+	<-filtered.Err()
+	var got []testTransfer
+	for len(logs) > 0 {
+		var ev testTransfer
+		if err := bound.UnpackLog(&ev, "Transfer", <-logs); err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, ev)
+	}
+	if len(got) != 1 || got[0].From != bob || got[0].Value.Int64() != 4 {
+		t.Errorf("Expected the second transfer only, got %+v", got)
+	}
+
+	select {
+	case log := <-watched:
+		if log.BlockNumber != 1 {
+			t.Errorf("Expected the first log in block 1, got %d", log.BlockNumber)
+		}
+	case <-time.After(time.Second):
+		t.Error("Expected the subscription to receive the emitted log")
+	}
+}
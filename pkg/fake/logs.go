@@ -0,0 +1,143 @@
+package fake
+
+import (
+	"context"
+	"sync"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Logs is an in-memory bind.ContractFilterer. Every appended log is placed in
+// its own block, numbered from 1, so FilterOpts block ranges select by
+// emission order.
+type Logs struct {
+	mu   sync.Mutex
+	logs []types.Log
+	subs map[*subscriber]struct{}
+}
+
+// NewLogs returns an empty log store.
+func NewLogs() *Logs {
+	return &Logs{subs: make(map[*subscriber]struct{})}
+}
+
+type subscriber struct {
+	query   ethereum.FilterQuery
+	pending []types.Log
+	wake    chan struct{}
+}
+
+// Append stores log and delivers it to matching subscriptions.
+func (l *Logs) Append(log types.Log) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	log.BlockNumber = uint64(len(l.logs) + 1)
+	log.Index = uint(len(l.logs))
+	l.logs = append(l.logs, log)
+	for s := range l.subs {
+		if matches(s.query, log) {
+			s.pending = append(s.pending, log)
+			select {
+			case s.wake <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// All returns every stored log in order.
+func (l *Logs) All() []types.Log {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]types.Log(nil), l.logs...)
+}
+
+// FilterLogs implements bind.ContractFilterer.
+func (l *Logs) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]types.Log, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	var out []types.Log
+	for _, log := range l.logs {
+		if matches(query, log) && inRange(query, log) {
+			out = append(out, log)
+		}
+	}
+	return out, nil
+}
+
+// SubscribeFilterLogs implements bind.ContractFilterer. Only logs appended
+// after the call are delivered.
+func (l *Logs) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	s := &subscriber{query: query, wake: make(chan struct{}, 1)}
+	l.mu.Lock()
+	l.subs[s] = struct{}{}
+	l.mu.Unlock()
+
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer func() {
+			l.mu.Lock()
+			delete(l.subs, s)
+			l.mu.Unlock()
+		}()
+		for {
+			select {
+			case <-quit:
+				return nil
+			case <-s.wake:
+			}
+			for {
+				l.mu.Lock()
+				if len(s.pending) == 0 {
+					l.mu.Unlock()
+					break
+				}
+				log := s.pending[0]
+				s.pending = s.pending[1:]
+				l.mu.Unlock()
+				select {
+				case ch <- log:
+				case <-quit:
+					return nil
+				}
+			}
+		}
+	}), nil
+}
+
+// matches reports whether log satisfies the address and topic filters.
+func matches(query ethereum.FilterQuery, log types.Log) bool {
+	if len(query.Addresses) > 0 && !contains(query.Addresses, log.Address) {
+		return false
+	}
+	for i, want := range query.Topics {
+		if len(want) == 0 {
+			continue
+		}
+		if i >= len(log.Topics) || !contains(want, log.Topics[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func inRange(query ethereum.FilterQuery, log types.Log) bool {
+	if query.FromBlock != nil && query.FromBlock.Sign() > 0 && log.BlockNumber < query.FromBlock.Uint64() {
+		return false
+	}
+	if query.ToBlock != nil && query.ToBlock.Sign() >= 0 && log.BlockNumber > query.ToBlock.Uint64() {
+		return false
+	}
+	return true
+}
+
+func contains[T common.Address | common.Hash](list []T, v T) bool {
+	for _, x := range list {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
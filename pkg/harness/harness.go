@@ -0,0 +1,463 @@
+// Package harness deploys the EigenLayer core protocol on go-ethereum's
+// in-process simulated backend, for integration tests that should not need
+// Foundry or anvil.
+//
+//	h, err := harness.New(harness.Config{})
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//	defer h.Close()
+//	receipt, err := h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return h.AllocationManager.ModifyAllocations(opts, operator, params)
+//	})
+//
+// The stack mirrors script/deploy/local/deploy_from_scratch.slashing.s.sol:
+// every core contract sits behind an EIP-1967 transparent proxy that was
+// upgraded and initialized in one call, owned by Harness.Owner and
+// administered by Harness.ProxyAdmin.
+package harness
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	allocationmanagerview "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManagerView"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	eigenpod "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPod"
+	eigenpodmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	eigenstrategy "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenStrategy"
+	keyregistrar "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/KeyRegistrar"
+	pauserregistry "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	permissioncontroller "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PermissionController"
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deployment"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ChainID is the chain id of the simulated backend.
+const ChainID = 1337
+
+// Defaults used for zero Config fields, taken from
+// script/configs/local/deploy_from_scratch.slashing.anvil.config.json.
+const (
+	DefaultVersion                      = "9.9.9"
+	DefaultMinWithdrawalDelayBlocks     = 900
+	DefaultDeallocationDelay            = 900
+	DefaultAllocationConfigurationDelay = 1200
+	DefaultCalculationIntervalSeconds   = 604800
+	DefaultMaxRewardsDuration           = 6048000
+	DefaultMaxRetroactiveLength         = 7776000
+	DefaultMaxFutureLength              = 2592000
+	DefaultGenesisRewardsTimestamp      = 1710979200
+	DefaultActivationDelay              = 7200
+	DefaultOperatorSplitBips            = 1000
+)
+
+// DefaultEthPOS is the mainnet beacon deposit contract. Nothing is deployed
+// there on the simulated chain.
+var DefaultEthPOS = common.HexToAddress("0x00000000219ab540356cBB839Cbe05303d7705Fa")
+
+// Config tunes the deployed protocol. Zero fields take the Default* values.
+type Config struct {
+	// Alloc funds additional accounts in the genesis block.
+	Alloc types.GenesisAlloc
+
+	Version                      string
+	MinWithdrawalDelayBlocks     uint32
+	DeallocationDelay            uint32
+	AllocationConfigurationDelay uint32
+	CalculationIntervalSeconds   uint32
+	MaxRewardsDuration           uint32
+	MaxRetroactiveLength         uint32
+	MaxFutureLength              uint32
+	GenesisRewardsTimestamp      uint32
+	ActivationDelay              uint32
+	OperatorSplitBips            uint16
+	EthPOS                       common.Address
+}
+
+func (c Config) withDefaults() Config {
+	if c.Version == "" {
+		c.Version = DefaultVersion
+	}
+	if c.MinWithdrawalDelayBlocks == 0 {
+		c.MinWithdrawalDelayBlocks = DefaultMinWithdrawalDelayBlocks
+	}
+	if c.DeallocationDelay == 0 {
+		c.DeallocationDelay = DefaultDeallocationDelay
+	}
+	if c.AllocationConfigurationDelay == 0 {
+		c.AllocationConfigurationDelay = DefaultAllocationConfigurationDelay
+	}
+	if c.CalculationIntervalSeconds == 0 {
+		c.CalculationIntervalSeconds = DefaultCalculationIntervalSeconds
+	}
+	if c.MaxRewardsDuration == 0 {
+		c.MaxRewardsDuration = DefaultMaxRewardsDuration
+	}
+	if c.MaxRetroactiveLength == 0 {
+		c.MaxRetroactiveLength = DefaultMaxRetroactiveLength
+	}
+	if c.MaxFutureLength == 0 {
+		c.MaxFutureLength = DefaultMaxFutureLength
+	}
+	if c.GenesisRewardsTimestamp == 0 {
+		c.GenesisRewardsTimestamp = DefaultGenesisRewardsTimestamp
+	}
+	if c.ActivationDelay == 0 {
+		c.ActivationDelay = DefaultActivationDelay
+	}
+	if c.OperatorSplitBips == 0 {
+		c.OperatorSplitBips = DefaultOperatorSplitBips
+	}
+	if c.EthPOS == (common.Address{}) {
+		c.EthPOS = DefaultEthPOS
+	}
+	return c
+}
+
+// Harness is a simulated chain with the core protocol deployed.
+type Harness struct {
+	Backend *simulated.Backend
+	Client  simulated.Client
+
+	// Owner owns every contract, is the sole pauser and unpauser and the
+	// rewards updater.
+	Owner    *bind.TransactOpts
+	OwnerKey *ecdsa.PrivateKey
+	// ProxyAdmin administers every proxy. Like any transparent proxy admin,
+	// its calls are never forwarded to the implementation.
+	ProxyAdmin *bind.TransactOpts
+
+	*deployment.Contracts
+
+	mu       sync.Mutex
+	managers map[common.Address]*txmgr.Manager
+}
+
+// New starts a simulated chain and deploys the core protocol on it.
+func New(cfg Config) (*Harness, error) {
+	cfg = cfg.withDefaults()
+
+	owner, ownerKey, err := newAccount()
+	if err != nil {
+		return nil, err
+	}
+	admin, _, err := newAccount()
+	if err != nil {
+		return nil, err
+	}
+	alloc := types.GenesisAlloc{
+		owner.From: {Balance: new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(params.Ether))},
+		admin.From: {Balance: big.NewInt(params.Ether)},
+	}
+	for addr, account := range cfg.Alloc {
+		alloc[addr] = account
+	}
+
+	sim := simulated.NewBackend(alloc)
+	// The genesis block predates the merge, which contracts using PUSH0 need.
+	sim.Commit()
+
+	h := &Harness{
+		Backend:    sim,
+		Client:     sim.Client(),
+		Owner:      owner,
+		OwnerKey:   ownerKey,
+		ProxyAdmin: admin,
+		managers:   make(map[common.Address]*txmgr.Manager),
+	}
+	if err := h.deploy(context.Background(), cfg); err != nil {
+		sim.Close()
+		return nil, err
+	}
+	return h, nil
+}
+
+// Close shuts the simulated chain down.
+func (h *Harness) Close() error {
+	return h.Backend.Close()
+}
+
+// Send sends a transaction as Owner, mines it and returns its receipt.
+func (h *Harness) Send(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	return h.SendAs(ctx, h.Owner, build)
+}
+
+// SendAs sends a transaction from auth, mines it and returns its receipt. A
+// reverted transaction returns a *txmgr.RevertedError.
+func (h *Harness) SendAs(ctx context.Context, auth *bind.TransactOpts, build txmgr.BuildFunc) (*types.Receipt, error) {
+	m, err := h.manager(ctx, auth)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := m.Send(ctx, build)
+	if err != nil {
+		return nil, err
+	}
+	h.Backend.Commit()
+	return tx.Wait(ctx)
+}
+
+func (h *Harness) manager(ctx context.Context, auth *bind.TransactOpts) (*txmgr.Manager, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if m, ok := h.managers[auth.From]; ok {
+		return m, nil
+	}
+	m, err := txmgr.New(ctx, h.Client, auth, txmgr.Config{PollInterval: time.Millisecond})
+	if err != nil {
+		return nil, err
+	}
+	h.managers[auth.From] = m
+	return m, nil
+}
+
+// NewAccount creates an account funded by Owner with balance wei.
+func (h *Harness) NewAccount(ctx context.Context, balance *big.Int) (*bind.TransactOpts, *ecdsa.PrivateKey, error) {
+	auth, key, err := newAccount()
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		// Bound contracts refuse to transact with an address without code.
+		return opts.Signer(opts.From, types.NewTx(&types.DynamicFeeTx{
+			ChainID:   big.NewInt(ChainID),
+			Nonce:     opts.Nonce.Uint64(),
+			GasTipCap: opts.GasTipCap,
+			GasFeeCap: opts.GasFeeCap,
+			Gas:       params.TxGas,
+			To:        &auth.From,
+			Value:     balance,
+		}))
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fund %s: %w", auth.From.Hex(), err)
+	}
+	return auth, key, nil
+}
+
+// Upgrade points proxy at impl and, if data is non-empty, delegatecalls data
+// to initialize it.
+func (h *Harness) Upgrade(ctx context.Context, proxy, impl common.Address, data []byte) error {
+	_, err := h.SendAs(ctx, h.ProxyAdmin, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return bind.NewBoundContract(proxy, proxyABI, nil, h.Client, nil).Transact(opts, "upgradeToAndCall", impl, data)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upgrade proxy %s to %s: %w", proxy.Hex(), impl.Hex(), err)
+	}
+	return nil
+}
+
+func newAccount() (*bind.TransactOpts, *ecdsa.PrivateKey, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(ChainID))
+	if err != nil {
+		return nil, nil, err
+	}
+	return auth, key, nil
+}
+
+var proxyABI = mustParseABI(`[{"type":"function","name":"upgradeToAndCall","stateMutability":"payable","inputs":[{"name":"newImplementation","type":"address"},{"name":"data","type":"bytes"}],"outputs":[]}]`)
+
+func mustParseABI(raw string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(raw))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}
+
+// deployer records the first error of a deployment sequence.
+type deployer struct {
+	h   *Harness
+	ctx context.Context
+	err error
+}
+
+// deploy sends a contract creation built by a Deploy<Contract> binding
+// function and returns the new contract's address.
+func (d *deployer) deploy(name string, create func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error)) common.Address {
+	if d.err != nil {
+		return common.Address{}
+	}
+	var addr common.Address
+	_, err := d.h.Send(d.ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		a, tx, err := create(opts)
+		addr = a
+		return tx, err
+	})
+	if err != nil {
+		d.err = fmt.Errorf("failed to deploy %s: %w", name, err)
+	}
+	return addr
+}
+
+// deployCode deploys raw init code with 32-byte word arguments appended.
+func (d *deployer) deployCode(name string, code []byte, args ...common.Address) common.Address {
+	return d.deploy(name, func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		initCode := append([]byte{}, code...)
+		for _, a := range args {
+			initCode = append(initCode, common.LeftPadBytes(a.Bytes(), 32)...)
+		}
+		addr, tx, _, err := bind.DeployContract(opts, abi.ABI{}, initCode, d.h.Client)
+		return addr, tx, err
+	})
+}
+
+// proxy deploys a proxy with no implementation yet.
+func (d *deployer) proxy(name string) common.Address {
+	return d.deployCode(name+" proxy", proxyCode, common.Address{}, d.h.ProxyAdmin.From)
+}
+
+// upgrade points a proxy at its implementation and runs its initializer.
+func (d *deployer) upgrade(name string, p deployment.Proxy, meta *bind.MetaData, args ...interface{}) {
+	if d.err != nil {
+		return
+	}
+	var data []byte
+	if meta != nil {
+		parsed, err := meta.GetAbi()
+		if err != nil {
+			d.err = err
+			return
+		}
+		if data, err = parsed.Pack("initialize", args...); err != nil {
+			d.err = fmt.Errorf("failed to encode %s initializer: %w", name, err)
+			return
+		}
+	}
+	if err := d.h.Upgrade(d.ctx, p.Proxy, p.Impl, data); err != nil {
+		d.err = fmt.Errorf("%s: %w", name, err)
+	}
+}
+
+func (h *Harness) deploy(ctx context.Context, cfg Config) error {
+	d := &deployer{h: h, ctx: ctx}
+	owner := h.Owner.From
+	backend := h.Client
+
+	dep := &deployment.Deployment{Name: "simulated", ChainID: ChainID, Version: cfg.Version}
+	dep.Admin = deployment.Admin{
+		CommunityMultisig:  owner,
+		ExecutorMultisig:   owner,
+		OperationsMultisig: owner,
+		PauserMultisig:     owner,
+		ProxyAdmin:         h.ProxyAdmin.From,
+	}
+	dep.Admin.PauserRegistry = d.deploy("PauserRegistry", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := pauserregistry.DeployPauserRegistry(opts, backend, []common.Address{owner}, owner)
+		return addr, tx, err
+	})
+	pauserRegistry := dep.Admin.PauserRegistry
+
+	// First deploy the proxies, so implementations can reference each other.
+	core, pods := &dep.Core, &dep.Pods
+	core.DelegationManager.Proxy = d.proxy("DelegationManager")
+	core.StrategyManager.Proxy = d.proxy("StrategyManager")
+	core.AllocationManager.Proxy = d.proxy("AllocationManager")
+	core.RewardsCoordinator.Proxy = d.proxy("RewardsCoordinator")
+	core.PermissionController.Proxy = d.proxy("PermissionController")
+	core.KeyRegistrar.Proxy = d.proxy("KeyRegistrar")
+	pods.EigenPodManager.Proxy = d.proxy("EigenPodManager")
+
+	dm, sm, am := core.DelegationManager.Proxy, core.StrategyManager.Proxy, core.AllocationManager.Proxy
+	pc, epm := core.PermissionController.Proxy, pods.EigenPodManager.Proxy
+
+	// The EIGEN strategy is deployed without a proxy, as in the local deploy
+	// script; the AllocationManager only needs its address.
+	dep.Tokens.EigenStrategy.Proxy = d.deploy("EigenStrategy", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := eigenstrategy.DeployEigenStrategy(opts, backend, sm, pauserRegistry)
+		return addr, tx, err
+	})
+	eigenStrategy := dep.Tokens.EigenStrategy.Proxy
+
+	pods.EigenPod.Impl = d.deploy("EigenPod", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := eigenpod.DeployEigenPod(opts, backend, cfg.EthPOS, epm)
+		return addr, tx, err
+	})
+	pods.EigenPod.Beacon = d.deployCode("EigenPod beacon", beaconCode, pods.EigenPod.Impl)
+
+	// Then the implementations, wired to the proxies.
+	core.AllocationManagerView = d.deploy("AllocationManagerView", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := allocationmanagerview.DeployAllocationManagerView(opts, backend, dm, eigenStrategy, cfg.DeallocationDelay, cfg.AllocationConfigurationDelay)
+		return addr, tx, err
+	})
+	core.DelegationManager.Impl = d.deploy("DelegationManager", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := delegationmanager.DeployDelegationManager(opts, backend, sm, epm, am, pauserRegistry, pc, cfg.MinWithdrawalDelayBlocks, cfg.Version)
+		return addr, tx, err
+	})
+	core.StrategyManager.Impl = d.deploy("StrategyManager", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := strategymanager.DeployStrategyManager(opts, backend, am, dm, pauserRegistry, cfg.Version)
+		return addr, tx, err
+	})
+	core.AllocationManager.Impl = d.deploy("AllocationManager", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := allocationmanager.DeployAllocationManager(opts, backend, core.AllocationManagerView, dm, eigenStrategy, pauserRegistry, pc, cfg.DeallocationDelay, cfg.AllocationConfigurationDelay)
+		return addr, tx, err
+	})
+	core.RewardsCoordinator.Impl = d.deploy("RewardsCoordinator", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := rewardscoordinator.DeployRewardsCoordinator(opts, backend, rewardscoordinator.IRewardsCoordinatorTypesRewardsCoordinatorConstructorParams{
+			DelegationManager:          dm,
+			StrategyManager:            sm,
+			AllocationManager:          am,
+			PauserRegistry:             pauserRegistry,
+			PermissionController:       pc,
+			CALCULATIONINTERVALSECONDS: cfg.CalculationIntervalSeconds,
+			MAXREWARDSDURATION:         cfg.MaxRewardsDuration,
+			MAXRETROACTIVELENGTH:       cfg.MaxRetroactiveLength,
+			MAXFUTURELENGTH:            cfg.MaxFutureLength,
+			GENESISREWARDSTIMESTAMP:    cfg.GenesisRewardsTimestamp,
+		})
+		return addr, tx, err
+	})
+	core.PermissionController.Impl = d.deploy("PermissionController", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := permissioncontroller.DeployPermissionController(opts, backend)
+		return addr, tx, err
+	})
+	core.KeyRegistrar.Impl = d.deploy("KeyRegistrar", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := keyregistrar.DeployKeyRegistrar(opts, backend, pc, am, cfg.Version)
+		return addr, tx, err
+	})
+	pods.EigenPodManager.Impl = d.deploy("EigenPodManager", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := eigenpodmanager.DeployEigenPodManager(opts, backend, cfg.EthPOS, pods.EigenPod.Beacon, dm, pauserRegistry)
+		return addr, tx, err
+	})
+
+	// Finally upgrade each proxy and initialize it in the same call.
+	unpaused := big.NewInt(0)
+	d.upgrade("DelegationManager", core.DelegationManager, delegationmanager.DelegationManagerMetaData, unpaused)
+	d.upgrade("StrategyManager", core.StrategyManager, strategymanager.StrategyManagerMetaData, owner, owner, unpaused)
+	d.upgrade("AllocationManager", core.AllocationManager, allocationmanager.AllocationManagerMetaData, unpaused)
+	d.upgrade("RewardsCoordinator", core.RewardsCoordinator, rewardscoordinator.RewardsCoordinatorMetaData,
+		owner, unpaused, owner, cfg.ActivationDelay, cfg.OperatorSplitBips)
+	d.upgrade("EigenPodManager", pods.EigenPodManager, eigenpodmanager.EigenPodManagerMetaData, owner, unpaused)
+	d.upgrade("PermissionController", core.PermissionController, nil)
+	d.upgrade("KeyRegistrar", core.KeyRegistrar, nil)
+	if d.err != nil {
+		return d.err
+	}
+
+	contracts, err := dep.Contracts(backend)
+	if err != nil {
+		return err
+	}
+	h.Contracts = contracts
+	return nil
+}
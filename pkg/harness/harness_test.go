@@ -0,0 +1,191 @@
+//go:build !go1.23 || simulated
+
+package harness
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+func newTestHarness(t *testing.T) *Harness {
+	t.Helper()
+	h, err := New(Config{})
+	if err != nil {
+		t.Fatalf("New failed: %v", err)
+	}
+	t.Cleanup(func() { h.Close() })
+	return h
+}
+
+func TestDeploy(t *testing.T) {
+	h := newTestHarness(t)
+	ctx := context.Background()
+	opts := &bind.CallOpts{Context: ctx}
+
+	for name, p := range map[string]common.Address{
+		"DelegationManager":    h.Deployment.Core.DelegationManager.Proxy,
+		"StrategyManager":      h.Deployment.Core.StrategyManager.Proxy,
+		"AllocationManager":    h.Deployment.Core.AllocationManager.Proxy,
+		"RewardsCoordinator":   h.Deployment.Core.RewardsCoordinator.Proxy,
+		"PermissionController": h.Deployment.Core.PermissionController.Proxy,
+		"KeyRegistrar":         h.Deployment.Core.KeyRegistrar.Proxy,
+		"EigenPodManager":      h.Deployment.Pods.EigenPodManager.Proxy,
+	} {
+		slot, err := h.Client.StorageAt(ctx, p, ImplementationSlot, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if common.BytesToAddress(slot) == (common.Address{}) {
+			t.Errorf("Expected %s proxy to have an implementation", name)
+		}
+	}
+
+	version, err := h.DelegationManager.Version(opts)
+	if err != nil || version != DefaultVersion {
+		t.Errorf("Expected version %s, got %q, %v", DefaultVersion, version, err)
+	}
+	if owner, err := h.StrategyManager.Owner(opts); err != nil || owner != h.Owner.From {
+		t.Errorf("Expected StrategyManager owner %s, got %s, %v", h.Owner.From.Hex(), owner.Hex(), err)
+	}
+	if updater, err := h.RewardsCoordinator.RewardsUpdater(opts); err != nil || updater != h.Owner.From {
+		t.Errorf("Expected rewards updater %s, got %s, %v", h.Owner.From.Hex(), updater.Hex(), err)
+	}
+	if beacon, err := h.EigenPodManager.EigenPodBeacon(opts); err != nil || beacon != h.Deployment.Pods.EigenPod.Beacon {
+		t.Errorf("Expected EigenPod beacon %s, got %s, %v", h.Deployment.Pods.EigenPod.Beacon.Hex(), beacon.Hex(), err)
+	}
+	if delay, err := h.AllocationManager.DEALLOCATIONDELAY(opts); err != nil || delay != DefaultDeallocationDelay {
+		t.Errorf("Expected deallocation delay %d, got %d, %v", DefaultDeallocationDelay, delay, err)
+	}
+	if paused, err := h.PauserRegistry.IsPauser(opts, h.Owner.From); err != nil || !paused {
+		t.Errorf("Expected owner to be a pauser, got %v, %v", paused, err)
+	}
+}
+
+func TestRegisterOperator(t *testing.T) {
+	h := newTestHarness(t)
+	ctx := context.Background()
+
+	operator, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.DelegationManager.RegisterAsOperator(opts, common.Address{}, 0, "")
+	})
+	if err != nil {
+		t.Fatalf("RegisterAsOperator failed: %v", err)
+	}
+	if ok, err := h.DelegationManager.IsOperator(&bind.CallOpts{Context: ctx}, operator.From); err != nil || !ok {
+		t.Fatalf("Expected %s to be an operator, got %v, %v", operator.From.Hex(), ok, err)
+	}
+	// The allocation delay is read through the AllocationManagerView.
+	if isSet, delay, err := h.AllocationManager.GetAllocationDelay(&bind.CallOpts{Context: ctx}, operator.From); err != nil || !isSet || delay != 0 {
+		t.Errorf("Expected an allocation delay of 0, got %v, %d, %v", isSet, delay, err)
+	}
+
+	_, err = h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.DelegationManager.RegisterAsOperator(opts, common.Address{}, 0, "")
+	})
+	if !errors.As(err, new(delegationmanager.ErrActivelyDelegated)) {
+		t.Errorf("Expected ErrActivelyDelegated, got %v", err)
+	}
+}
+
+func TestProxyAdmin(t *testing.T) {
+	h := newTestHarness(t)
+	ctx := context.Background()
+	proxy := h.Deployment.Core.DelegationManager.Proxy
+
+	// The admin's calls are never forwarded to the implementation.
+	_, err := h.SendAs(ctx, h.ProxyAdmin, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		parsed, err := delegationmanager.DelegationManagerMetaData.GetAbi()
+		if err != nil {
+			return nil, err
+		}
+		opts.GasLimit = 100_000
+		return bind.NewBoundContract(proxy, *parsed, nil, h.Client, nil).Transact(opts, "version")
+	})
+	if err == nil {
+		t.Fatal("Expected a non-upgrade call from the admin to revert")
+	}
+
+	// Point the proxy at another implementation.
+	impl := h.Deployment.Core.StrategyManager.Impl
+	if err := h.Upgrade(ctx, proxy, impl, nil); err != nil {
+		t.Fatalf("Upgrade failed: %v", err)
+	}
+	slot, err := h.Client.StorageAt(ctx, proxy, ImplementationSlot, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := common.BytesToAddress(slot); got != impl {
+		t.Errorf("Expected implementation %s, got %s", impl.Hex(), got.Hex())
+	}
+
+	// Someone else's upgrade call is forwarded and fails in the implementation.
+	other, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.SendAs(ctx, other, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return bind.NewBoundContract(proxy, proxyABI, nil, h.Client, nil).Transact(opts, "upgradeToAndCall", common.Address{}, []byte{})
+	}); err == nil {
+		t.Error("Expected upgradeToAndCall from a non-admin to fail")
+	}
+}
+
+func TestStrategyAndOperatorSet(t *testing.T) {
+	h := newTestHarness(t)
+	ctx := context.Background()
+	opts := &bind.CallOpts{Context: ctx}
+
+	strategy, err := h.DeployStrategy(ctx)
+	if err != nil {
+		t.Fatalf("DeployStrategy failed: %v", err)
+	}
+	staker, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Deposit(ctx, staker, strategy, big.NewInt(params.Ether)); err != nil {
+		t.Fatalf("Deposit failed: %v", err)
+	}
+	if shares, err := h.StrategyManager.StakerDepositShares(opts, staker.From, strategy.Address); err != nil || shares.Cmp(big.NewInt(params.Ether)) != 0 {
+		t.Errorf("Expected 1 ether of deposit shares, got %v, %v", shares, err)
+	}
+
+	operator, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+	if err != nil {
+		t.Fatal(err)
+	}
+	avs, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.RegisterOperator(ctx, operator); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Delegate(ctx, staker, operator.From); err != nil {
+		t.Fatal(err)
+	}
+	for id := uint32(1); id <= 2; id++ {
+		set, err := h.CreateOperatorSet(ctx, avs, id, []common.Address{strategy.Address})
+		if err != nil {
+			t.Fatalf("CreateOperatorSet failed: %v", err)
+		}
+		if err := h.RegisterForOperatorSet(ctx, operator, set); err != nil {
+			t.Fatalf("RegisterForOperatorSet failed: %v", err)
+		}
+		if ok, err := h.AllocationManager.IsMemberOfOperatorSet(opts, operator.From, set); err != nil || !ok {
+			t.Errorf("Expected %s to be a member of set %d, got %v, %v", operator.From.Hex(), id, ok, err)
+		}
+	}
+}
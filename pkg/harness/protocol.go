@@ -0,0 +1,173 @@
+package harness
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	backingeigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	strategybase "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyBase"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deployment"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Strategy is a StrategyBase deployed by DeployStrategy and its mintable
+// underlying token.
+type Strategy struct {
+	Address common.Address
+	Token   common.Address
+}
+
+// DeployStrategy deploys a token and a StrategyBase for it, both behind
+// proxies, and whitelists the strategy for deposits. The token is a
+// bEIGEN instance without transfer restrictions that Owner can mint; its
+// initial supply, meant for EIGEN, goes to Owner.
+func (h *Harness) DeployStrategy(ctx context.Context) (Strategy, error) {
+	d := &deployer{h: h, ctx: ctx}
+	backend := h.Client
+	owner := h.Owner.From
+
+	token := deployment.Proxy{Proxy: d.proxy("token")}
+	token.Impl = d.deploy("token", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := backingeigen.DeployBackingEigen(opts, backend, owner)
+		return addr, tx, err
+	})
+	d.upgrade("token", token, backingeigen.BackingEigenMetaData, owner)
+
+	strategy := deployment.Proxy{Proxy: d.proxy("strategy")}
+	strategy.Impl = d.deploy("strategy", func(opts *bind.TransactOpts) (common.Address, *types.Transaction, error) {
+		addr, tx, _, err := strategybase.DeployStrategyBase(opts, backend, h.Deployment.Core.StrategyManager.Proxy, h.Deployment.Admin.PauserRegistry)
+		return addr, tx, err
+	})
+	d.upgrade("strategy", strategy, strategybase.StrategyBaseMetaData, token.Proxy)
+	if d.err != nil {
+		return Strategy{}, d.err
+	}
+
+	bEIGEN, err := backingeigen.NewBackingEigen(token.Proxy, backend)
+	if err != nil {
+		return Strategy{}, err
+	}
+	for _, build := range []func(opts *bind.TransactOpts) (*types.Transaction, error){
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return bEIGEN.DisableTransferRestrictions(opts)
+		},
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return bEIGEN.SetIsMinter(opts, owner, true)
+		},
+		func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.StrategyManager.AddStrategiesToDepositWhitelist(opts, []common.Address{strategy.Proxy})
+		},
+	} {
+		if _, err := h.Send(ctx, build); err != nil {
+			return Strategy{}, fmt.Errorf("failed to set up strategy %s: %w", strategy.Proxy.Hex(), err)
+		}
+	}
+	return Strategy{Address: strategy.Proxy, Token: token.Proxy}, nil
+}
+
+// Deposit mints amount of strategy's token to staker and deposits it through
+// the StrategyManager.
+func (h *Harness) Deposit(ctx context.Context, staker *bind.TransactOpts, strategy Strategy, amount *big.Int) error {
+	token, err := backingeigen.NewBackingEigen(strategy.Token, h.Client)
+	if err != nil {
+		return err
+	}
+	if _, err := h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Mint(opts, staker.From, amount)
+	}); err != nil {
+		return fmt.Errorf("failed to mint for %s: %w", staker.From.Hex(), err)
+	}
+	if _, err := h.SendAs(ctx, staker, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Approve(opts, h.Deployment.Core.StrategyManager.Proxy, amount)
+	}); err != nil {
+		return fmt.Errorf("failed to approve deposit for %s: %w", staker.From.Hex(), err)
+	}
+	if _, err := h.SendAs(ctx, staker, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.StrategyManager.DepositIntoStrategy(opts, strategy.Address, strategy.Token, amount)
+	}); err != nil {
+		return fmt.Errorf("failed to deposit for %s: %w", staker.From.Hex(), err)
+	}
+	return nil
+}
+
+// CreateOperatorSet creates operator set id of avs over strategies, with avs
+// as its slasher. The first call for an avs registers its metadata and an
+// AVS registrar that accepts every operator.
+func (h *Harness) CreateOperatorSet(ctx context.Context, avs *bind.TransactOpts, id uint32, strategies []common.Address) (eltypes.OperatorSet, error) {
+	set := eltypes.OperatorSet{Avs: avs.From, Id: id}
+	registrar, err := h.AllocationManager.GetAVSRegistrar(&bind.CallOpts{Context: ctx}, avs.From)
+	if err != nil {
+		return set, err
+	}
+	if registrar == avs.From {
+		d := &deployer{h: h, ctx: ctx}
+		registrar = d.deployCode("AVS registrar", registrarCode)
+		if d.err != nil {
+			return set, d.err
+		}
+		for _, build := range []func(opts *bind.TransactOpts) (*types.Transaction, error){
+			func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return h.AllocationManager.UpdateAVSMetadataURI(opts, avs.From, "")
+			},
+			func(opts *bind.TransactOpts) (*types.Transaction, error) {
+				return h.AllocationManager.SetAVSRegistrar(opts, avs.From, registrar)
+			},
+		} {
+			if _, err := h.SendAs(ctx, avs, build); err != nil {
+				return set, fmt.Errorf("failed to set up AVS %s: %w", avs.From.Hex(), err)
+			}
+		}
+	}
+	_, err = h.SendAs(ctx, avs, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.AllocationManager.CreateOperatorSets(opts, avs.From, []allocationmanager.IAllocationManagerTypesCreateSetParams{
+			{OperatorSetId: id, Strategies: strategies},
+		})
+	})
+	if err != nil {
+		return set, fmt.Errorf("failed to create operator set %d of %s: %w", id, avs.From.Hex(), err)
+	}
+	return set, nil
+}
+
+// RegisterOperator registers operator with an allocation delay of 0, so its
+// allocations take effect in the block they are made.
+func (h *Harness) RegisterOperator(ctx context.Context, operator *bind.TransactOpts) error {
+	_, err := h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.DelegationManager.RegisterAsOperator(opts, common.Address{}, 0, "")
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register operator %s: %w", operator.From.Hex(), err)
+	}
+	return nil
+}
+
+// Delegate delegates staker to an operator without a delegation approver.
+func (h *Harness) Delegate(ctx context.Context, staker *bind.TransactOpts, operator common.Address) error {
+	_, err := h.SendAs(ctx, staker, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.DelegationManager.DelegateTo(opts, operator, eltypes.ISignatureUtilsMixinTypesSignatureWithExpiry{Expiry: new(big.Int)}, [32]byte{})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delegate %s to %s: %w", staker.From.Hex(), operator.Hex(), err)
+	}
+	return nil
+}
+
+// RegisterForOperatorSet registers operator for an operator set created by
+// CreateOperatorSet.
+func (h *Harness) RegisterForOperatorSet(ctx context.Context, operator *bind.TransactOpts, set eltypes.OperatorSet) error {
+	_, err := h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.AllocationManager.RegisterForOperatorSets(opts, operator.From, eltypes.IAllocationManagerTypesRegisterParams{
+			Avs:            set.Avs,
+			OperatorSetIds: []uint32{set.Id},
+		})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register %s for operator set %d of %s: %w", operator.From.Hex(), set.Id, set.Avs.Hex(), err)
+	}
+	return nil
+}
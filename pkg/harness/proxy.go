@@ -0,0 +1,163 @@
+package harness
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// EIP-1967 storage slots.
+var (
+	ImplementationSlot = common.HexToHash("0x360894a13ba1a3210667c828492db98dca3e2076cc3735a920a3ca505d382bbc")
+	AdminSlot          = common.HexToHash("0xb53127684a568b3173ae13b9f8a6016e243e63b6e8ee1178d6a717850b5d6103")
+)
+
+// upgradeToAndCallSelector is the selector of upgradeToAndCall(address,bytes).
+var upgradeToAndCallSelector = []byte{0x4f, 0x1e, 0xf2, 0x86}
+
+// The bindings carry no proxy bytecode, so the harness assembles its own.
+//
+// proxyCode is a minimal transparent proxy. Its constructor takes the
+// implementation and admin addresses as two words appended to the code and
+// stores them in the EIP-1967 slots. At runtime every call from a non-admin is
+// delegated to the implementation. The admin may only call
+// upgradeToAndCall(address,bytes), which sets the implementation and, if the
+// bytes are non-empty, delegatecalls them to initialize it.
+var proxyCode = deployCode(2, func(a *assembler) {
+	a.push(0x00).op(vm.MLOAD).push(ImplementationSlot.Bytes()...).op(vm.SSTORE)
+	a.push(0x20).op(vm.MLOAD).push(AdminSlot.Bytes()...).op(vm.SSTORE)
+}, func(a *assembler) {
+	a.push(AdminSlot.Bytes()...).op(vm.SLOAD, vm.CALLER, vm.EQ).jumpi("admin")
+
+	// delegatecall(gas, impl, 0, calldatasize, 0, 0)
+	a.op(vm.CALLDATASIZE).push(0x00).push(0x00).op(vm.CALLDATACOPY)
+	a.push(0x00).push(0x00).op(vm.CALLDATASIZE).push(0x00)
+	a.push(ImplementationSlot.Bytes()...).op(vm.SLOAD, vm.GAS, vm.DELEGATECALL)
+	a.forward("delegated")
+
+	a.label("admin")
+	a.push(0x00).op(vm.CALLDATALOAD).push(0xe0).op(vm.SHR)
+	a.push(upgradeToAndCallSelector...).op(vm.EQ).jumpi("upgrade")
+	a.push(0x00).push(0x00).op(vm.REVERT)
+
+	a.label("upgrade")
+	// impl = calldata[4:36]
+	a.push(0x04).op(vm.CALLDATALOAD)
+	a.op(vm.DUP1).push(ImplementationSlot.Bytes()...).op(vm.SSTORE)
+	// The bytes start with their length at 4 + calldata[36:68].
+	a.push(0x24).op(vm.CALLDATALOAD).push(0x04).op(vm.ADD)
+	a.op(vm.DUP1, vm.CALLDATALOAD) // impl, lenPos, len
+	a.op(vm.DUP1).jumpi("initialize")
+	a.op(vm.STOP)
+
+	a.label("initialize")
+	a.op(vm.SWAP1).push(0x20).op(vm.ADD) // impl, len, dataPos
+	a.op(vm.DUP2, vm.SWAP1).push(0x00).op(vm.CALLDATACOPY)
+	// delegatecall(gas, impl, 0, len, 0, 0)
+	a.push(0x00).push(0x00).op(vm.DUP3).push(0x00).op(vm.DUP6, vm.GAS, vm.DELEGATECALL)
+	a.forward("initialized")
+})
+
+// beaconCode is an immutable beacon. Its constructor takes the implementation
+// address as a word appended to the code; at runtime any call, including
+// implementation(), returns that address.
+var beaconCode = deployCode(1, func(a *assembler) {
+	a.push(0x00).op(vm.MLOAD).push(0x00).op(vm.SSTORE)
+}, func(a *assembler) {
+	a.push(0x00).op(vm.SLOAD).push(0x00).op(vm.MSTORE)
+	a.push(0x20).push(0x00).op(vm.RETURN)
+})
+
+// registrarCode is an AVS registrar that accepts everything: any call,
+// including supportsAVS(address) and registerOperator, returns the word 1.
+var registrarCode = deployCode(0, func(*assembler) {}, func(a *assembler) {
+	a.push(0x01).push(0x00).op(vm.MSTORE)
+	a.push(0x20).push(0x00).op(vm.RETURN)
+})
+
+// deployCode assembles init code that copies its trailing args words to
+// memory, runs constructor and returns the runtime code. Constructor args are
+// appended to the returned code as 32-byte words.
+func deployCode(args int, constructor, runtime func(*assembler)) []byte {
+	body := assemble(runtime)
+
+	ctor := func(initLen int) []byte {
+		return assemble(func(a *assembler) {
+			// codecopy(0, codesize - 32*args, 32*args)
+			a.push(byte(32 * args))
+			a.push(byte(32*args)).op(vm.CODESIZE, vm.SUB)
+			a.push(0x00).op(vm.CODECOPY)
+			constructor(a)
+			// codecopy(0, initLen, len(body)); return(0, len(body))
+			a.push(byte(len(body)>>8), byte(len(body))).op(vm.DUP1)
+			a.push(byte(initLen>>8), byte(initLen))
+			a.push(0x00).op(vm.CODECOPY)
+			a.push(0x00).op(vm.RETURN)
+		})
+	}
+	// The init code's length does not depend on the offset it embeds.
+	init := ctor(len(ctor(0)))
+	return append(init, body...)
+}
+
+// assembler builds EVM bytecode with two-byte jump labels.
+type assembler struct {
+	code   []byte
+	labels map[string]int
+	fixups map[int]string
+}
+
+func assemble(build func(*assembler)) []byte {
+	a := &assembler{labels: make(map[string]int), fixups: make(map[int]string)}
+	build(a)
+	for pos, name := range a.fixups {
+		target, ok := a.labels[name]
+		if !ok {
+			panic(fmt.Sprintf("harness: undefined label %q", name))
+		}
+		a.code[pos], a.code[pos+1] = byte(target>>8), byte(target)
+	}
+	return a.code
+}
+
+func (a *assembler) op(ops ...vm.OpCode) *assembler {
+	for _, op := range ops {
+		a.code = append(a.code, byte(op))
+	}
+	return a
+}
+
+// push emits PUSHn for n bytes of data.
+func (a *assembler) push(data ...byte) *assembler {
+	if len(data) == 0 || len(data) > 32 {
+		panic("harness: invalid push width")
+	}
+	a.code = append(a.code, byte(vm.PUSH1)+byte(len(data)-1))
+	a.code = append(a.code, data...)
+	return a
+}
+
+// label marks a jump destination.
+func (a *assembler) label(name string) {
+	a.labels[name] = len(a.code)
+	a.op(vm.JUMPDEST)
+}
+
+// jumpi jumps to the label if the top of the stack is non-zero.
+func (a *assembler) jumpi(name string) {
+	a.code = append(a.code, byte(vm.PUSH2))
+	a.fixups[len(a.code)] = name
+	a.code = append(a.code, 0, 0)
+	a.op(vm.JUMPI)
+}
+
+// forward returns or reverts with the return data of the preceding call,
+// whose success flag is on top of the stack.
+func (a *assembler) forward(name string) {
+	a.op(vm.RETURNDATASIZE).push(0x00).push(0x00).op(vm.RETURNDATACOPY)
+	a.jumpi(name)
+	a.op(vm.RETURNDATASIZE).push(0x00).op(vm.REVERT)
+	a.label(name)
+	a.op(vm.RETURNDATASIZE).push(0x00).op(vm.RETURN)
+}
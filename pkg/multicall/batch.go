@@ -0,0 +1,288 @@
+// Package multicall batches read-only binding calls into Multicall3
+// aggregate3 calls.
+//
+// A Batch implements bind.ContractCaller, so any generated *Caller can be bound
+// to it. Calls made through such a binding inside Add are recorded instead of
+// sent; Execute sends them all in one eth_call (per ChunkSize calls) and fills
+// in the typed results:
+//
+//	batch := multicall.NewBatch(client, &bind.CallOpts{BlockNumber: block})
+//	am, _ := allocationmanager.NewAllocationManagerCaller(amAddr, batch)
+//	dm, _ := delegationmanager.NewDelegationManagerCaller(dmAddr, batch)
+//
+//	sets := multicall.Add(batch, func(opts *bind.CallOpts) ([]allocationmanager.OperatorSet, error) {
+//		return am.GetAllocatedSets(opts, operator)
+//	})
+//	shares := multicall.Add(batch, func(opts *bind.CallOpts) ([]*big.Int, error) {
+//		return dm.GetOperatorShares(opts, operator, strategies)
+//	})
+//	if err := batch.Execute(); err != nil {
+//		return err
+//	}
+//	allocated, err := sets.Get()
+package multicall
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Multicall3Address is the address Multicall3 is deployed at on every major
+// chain.
+var Multicall3Address = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// DefaultChunkSize is the number of calls sent per aggregate3 call.
+const DefaultChunkSize = 500
+
+const aggregate3ABI = `[{"type":"function","name":"aggregate3","stateMutability":"payable",
+"inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],
+"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`
+
+var multicallABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(aggregate3ABI))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// call3 mirrors Multicall3.Call3.
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// result3 mirrors Multicall3.Result.
+type result3 struct {
+	Success    bool
+	ReturnData []byte
+}
+
+var (
+	// ErrNotExecuted is returned by Result.Get before the batch ran.
+	ErrNotExecuted = errors.New("multicall: batch not executed")
+
+	// errRecorded aborts a binding call once its calldata has been captured.
+	errRecorded = errors.New("multicall: call recorded")
+)
+
+// queued is a single recorded call and the closure that decodes its result.
+type queued struct {
+	target   common.Address
+	data     []byte
+	complete func(returned []byte, err error)
+}
+
+// Batch collects binding calls and executes them through Multicall3.
+type Batch struct {
+	// Multicall is the Multicall3 contract address.
+	Multicall common.Address
+	// ChunkSize caps the number of calls per aggregate3 call.
+	ChunkSize int
+
+	backend bind.ContractCaller
+	opts    bind.CallOpts
+
+	mu        sync.Mutex
+	calls     []*queued
+	recording *ethereum.CallMsg
+	replaying *replay
+}
+
+// replay is the response handed to a binding while its result is decoded.
+type replay struct {
+	data []byte
+	err  error
+}
+
+// NewBatch creates a batch reading through backend. opts may pin the block
+// number and set the context and sender used for every call; nil means the
+// latest block.
+func NewBatch(backend bind.ContractCaller, opts *bind.CallOpts) *Batch {
+	b := &Batch{
+		Multicall: Multicall3Address,
+		ChunkSize: DefaultChunkSize,
+		backend:   backend,
+	}
+	if opts != nil {
+		b.opts = *opts
+	}
+	return b
+}
+
+// Result is the typed outcome of a batched call.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Get returns the decoded value, or the call's error. Calls that reverted
+// return an error decoded by pkg/errors where possible.
+func (r *Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Add queues a read. call must make exactly one contract call through a
+// binding constructed with b as its caller, passing the given opts through.
+func Add[T any](b *Batch, call func(opts *bind.CallOpts) (T, error)) *Result[T] {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	res := &Result[T]{err: ErrNotExecuted}
+	opts := b.opts
+
+	var msg ethereum.CallMsg
+	b.recording = &msg
+	_, err := call(&opts)
+	b.recording = nil
+
+	if !errors.Is(err, errRecorded) {
+		// The binding failed before reaching the backend (e.g. packing).
+		if err == nil {
+			err = fmt.Errorf("multicall: call did not go through the batch caller")
+		}
+		res.err = err
+		return res
+	}
+	if msg.To == nil {
+		res.err = fmt.Errorf("multicall: contract creation cannot be batched")
+		return res
+	}
+
+	b.calls = append(b.calls, &queued{
+		target: *msg.To,
+		data:   msg.Data,
+		complete: func(returned []byte, callErr error) {
+			b.replaying = &replay{data: returned, err: callErr}
+			res.value, res.err = call(&opts)
+			b.replaying = nil
+		},
+	})
+	return res
+}
+
+// Len returns the number of queued calls.
+func (b *Batch) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.calls)
+}
+
+// Execute runs all queued calls and fills in their results. If the block is
+// not pinned and the calls span several chunks, the current block number is
+// read first so every chunk sees the same state.
+func (b *Batch) Execute() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	calls := b.calls
+	b.calls = nil
+	if len(calls) == 0 {
+		return nil
+	}
+
+	ctx := b.opts.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	chunkSize := b.ChunkSize
+	if chunkSize <= 0 {
+		chunkSize = DefaultChunkSize
+	}
+	block := b.opts.BlockNumber
+	if block == nil && len(calls) > chunkSize {
+		reader, ok := b.backend.(interface {
+			BlockNumber(ctx context.Context) (uint64, error)
+		})
+		if ok {
+			n, err := reader.BlockNumber(ctx)
+			if err != nil {
+				return fmt.Errorf("multicall: failed to pin block number: %w", err)
+			}
+			block = new(big.Int).SetUint64(n)
+		}
+	}
+
+	for start := 0; start < len(calls); start += chunkSize {
+		end := start + chunkSize
+		if end > len(calls) {
+			end = len(calls)
+		}
+		if err := b.execute(ctx, block, calls[start:end]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Batch) execute(ctx context.Context, block *big.Int, calls []*queued) error {
+	args := make([]call3, len(calls))
+	for i, c := range calls {
+		args[i] = call3{Target: c.target, AllowFailure: true, CallData: c.data}
+	}
+	input, err := multicallABI.Pack("aggregate3", args)
+	if err != nil {
+		return fmt.Errorf("multicall: failed to pack aggregate3: %w", err)
+	}
+	output, err := b.backend.CallContract(ctx, ethereum.CallMsg{From: b.opts.From, To: &b.Multicall, Data: input}, block)
+	if err != nil {
+		return fmt.Errorf("multicall: aggregate3 failed: %w", elerrors.Wrap(err))
+	}
+	if len(output) == 0 {
+		return fmt.Errorf("multicall: no Multicall3 contract at %s", b.Multicall.Hex())
+	}
+	out, err := multicallABI.Unpack("aggregate3", output)
+	if err != nil {
+		return fmt.Errorf("multicall: failed to unpack aggregate3: %w", err)
+	}
+	results := *abi.ConvertType(out[0], new([]result3)).(*[]result3)
+	if len(results) != len(calls) {
+		return fmt.Errorf("multicall: expected %d results, got %d", len(calls), len(results))
+	}
+
+	for i, c := range calls {
+		if results[i].Success {
+			c.complete(results[i].ReturnData, nil)
+			continue
+		}
+		c.complete(nil, revertError(c.target, results[i].ReturnData))
+	}
+	return nil
+}
+
+// revertError turns the return data of a failed call into an error.
+func revertError(target common.Address, data []byte) error {
+	if revert, ok := elerrors.Decode(data); ok {
+		return revert
+	}
+	return fmt.Errorf("multicall: call to %s reverted (data 0x%x)", target.Hex(), data)
+}
+
+// CodeAt implements bind.ContractCaller by forwarding to the backend.
+func (b *Batch) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return b.backend.CodeAt(ctx, contract, blockNumber)
+}
+
+// CallContract implements bind.ContractCaller. Inside Add it records the call;
+// while results are decoded it returns the aggregated response.
+func (b *Batch) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	switch {
+	case b.recording != nil:
+		*b.recording = call
+		return nil, errRecorded
+	case b.replaying != nil:
+		return b.replaying.data, b.replaying.err
+	}
+	return nil, fmt.Errorf("multicall: binding calls must be made inside Add")
+}
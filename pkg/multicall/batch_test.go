@@ -0,0 +1,156 @@
+package multicall
+
+import (
+	"context"
+	stderrors "errors"
+	"math/big"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// fakeMulticall answers aggregate3 calls from canned per-selector responses.
+type fakeMulticall struct {
+	responses map[[4]byte]result3
+	rpcCalls  int
+	blocks    []*big.Int
+}
+
+func (f *fakeMulticall) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return []byte{0x1}, nil
+}
+
+func (f *fakeMulticall) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	f.rpcCalls++
+	f.blocks = append(f.blocks, blockNumber)
+
+	method := multicallABI.Methods["aggregate3"]
+	in, err := method.Inputs.Unpack(call.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	calls := *abi.ConvertType(in[0], new([]call3)).(*[]call3)
+	results := make([]result3, len(calls))
+	for i, c := range calls {
+		var selector [4]byte
+		copy(selector[:], c.CallData[:4])
+		results[i] = f.responses[selector]
+	}
+	return method.Outputs.Pack(results)
+}
+
+func packOutput(t *testing.T, metaABI *bind.MetaData, method string, values ...interface{}) ([4]byte, []byte) {
+	t.Helper()
+	parsed, err := metaABI.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := parsed.Methods[method]
+	out, err := m.Outputs.Pack(values...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var selector [4]byte
+	copy(selector[:], m.ID)
+	return selector, out
+}
+
+func TestBatchTypedResults(t *testing.T) {
+	sets := []allocationmanager.OperatorSet{{Avs: common.HexToAddress("0xa1"), Id: 3}}
+	shares := []*big.Int{big.NewInt(100), big.NewInt(200)}
+
+	setsSel, setsOut := packOutput(t, allocationmanager.AllocationManagerMetaData, "getAllocatedSets", sets)
+	sharesSel, sharesOut := packOutput(t, delegationmanager.DelegationManagerMetaData, "getOperatorShares", shares)
+
+	parsed, _ := allocationmanager.AllocationManagerMetaData.GetAbi()
+	pausedID := parsed.Errors["CurrentlyPaused"].ID
+	paused := pausedID[:4]
+	magSel, _ := packOutput(t, allocationmanager.AllocationManagerMetaData, "getMaxMagnitudes", []uint64{})
+
+	backend := &fakeMulticall{responses: map[[4]byte]result3{
+		setsSel:   {Success: true, ReturnData: setsOut},
+		sharesSel: {Success: true, ReturnData: sharesOut},
+		magSel:    {Success: false, ReturnData: paused},
+	}}
+
+	block := big.NewInt(1234)
+	batch := NewBatch(backend, &bind.CallOpts{BlockNumber: block})
+	am, err := allocationmanager.NewAllocationManagerCaller(common.HexToAddress("0x1"), batch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dm, err := delegationmanager.NewDelegationManagerCaller(common.HexToAddress("0x2"), batch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operator := common.HexToAddress("0xbeef")
+	setsRes := Add(batch, func(opts *bind.CallOpts) ([]allocationmanager.OperatorSet, error) {
+		return am.GetAllocatedSets(opts, operator)
+	})
+	sharesRes := Add(batch, func(opts *bind.CallOpts) ([]*big.Int, error) {
+		return dm.GetOperatorShares(opts, operator, []common.Address{{0x1}, {0x2}})
+	})
+	magRes := Add(batch, func(opts *bind.CallOpts) ([]uint64, error) {
+		return am.GetMaxMagnitudes(opts, []common.Address{operator}, common.Address{0x1})
+	})
+
+	if _, err := setsRes.Get(); !stderrors.Is(err, ErrNotExecuted) {
+		t.Errorf("Expected ErrNotExecuted before Execute, got %v", err)
+	}
+	if batch.Len() != 3 {
+		t.Fatalf("Expected 3 queued calls, got %d", batch.Len())
+	}
+	if err := batch.Execute(); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+
+	if backend.rpcCalls != 1 {
+		t.Errorf("Expected a single RPC call, got %d", backend.rpcCalls)
+	}
+	if backend.blocks[0] == nil || backend.blocks[0].Cmp(block) != 0 {
+		t.Errorf("Expected the call to be pinned to block %s, got %v", block, backend.blocks[0])
+	}
+
+	gotSets, err := setsRes.Get()
+	if err != nil || len(gotSets) != 1 || gotSets[0] != sets[0] {
+		t.Errorf("Expected %v, got %v (err %v)", sets, gotSets, err)
+	}
+	gotShares, err := sharesRes.Get()
+	if err != nil || len(gotShares) != 2 || gotShares[1].Cmp(shares[1]) != 0 {
+		t.Errorf("Expected %v, got %v (err %v)", shares, gotShares, err)
+	}
+	if _, err := magRes.Get(); !stderrors.As(err, &allocationmanager.ErrCurrentlyPaused{}) {
+		t.Errorf("Expected a typed CurrentlyPaused error, got %v", err)
+	}
+}
+
+func TestBatchChunks(t *testing.T) {
+	sel, out := packOutput(t, allocationmanager.AllocationManagerMetaData, "DEALLOCATION_DELAY", uint32(17))
+	backend := &fakeMulticall{responses: map[[4]byte]result3{sel: {Success: true, ReturnData: out}}}
+
+	batch := NewBatch(backend, nil)
+	batch.ChunkSize = 2
+	am, _ := allocationmanager.NewAllocationManagerCaller(common.HexToAddress("0x1"), batch)
+
+	var results []*Result[uint32]
+	for i := 0; i < 5; i++ {
+		results = append(results, Add(batch, am.DEALLOCATIONDELAY))
+	}
+	if err := batch.Execute(); err != nil {
+		t.Fatalf("Execute failed: %v", err)
+	}
+	if backend.rpcCalls != 3 {
+		t.Errorf("Expected 3 aggregate3 calls, got %d", backend.rpcCalls)
+	}
+	for i, r := range results {
+		if v, err := r.Get(); err != nil || v != 17 {
+			t.Errorf("Result %d: expected 17, got %d (err %v)", i, v, err)
+		}
+	}
+}
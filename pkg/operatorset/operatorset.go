@@ -0,0 +1,112 @@
+// Package operatorset identifies operator sets the way OperatorSetLib does.
+//
+// A Set is an (AVS, id) pair. Key packs it into the bytes32 that contracts
+// store and emit, the AVS address in the high 20 bytes and the id as a
+// uint96 in the low 12, and FromKey unpacks it again. String gives the
+// canonical "<avs>:<id>" form used in CLIs and logs, which Parse and the
+// text and JSON encodings read back:
+//
+//	set, err := operatorset.Parse("0x5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c:7")
+//	if err != nil {
+//		return err
+//	}
+//	members, err := contracts.AllocationManager.GetMembers(opts, set.Binding())
+//
+// Every binding under pkg/bindings declares its OperatorSet struct as an
+// alias of eltypes.OperatorSet, so From and Binding convert to and from all
+// of them.
+package operatorset
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidFormat is returned by Parse for strings not of the form
+// "<avs>:<id>".
+var ErrInvalidFormat = errors.New("operatorset: invalid operator set")
+
+// Set identifies an operator set.
+type Set struct {
+	AVS common.Address
+	ID  uint32
+}
+
+// New returns the operator set of avs with the given id.
+func New(avs common.Address, id uint32) Set {
+	return Set{AVS: avs, ID: id}
+}
+
+// From converts the OperatorSet struct of any binding.
+func From(set eltypes.OperatorSet) Set {
+	return Set{AVS: set.Avs, ID: set.Id}
+}
+
+// Binding returns the OperatorSet struct the bindings take.
+func (s Set) Binding() eltypes.OperatorSet {
+	return eltypes.OperatorSet{Avs: s.AVS, Id: s.ID}
+}
+
+// Key returns OperatorSetLib.key: the AVS address followed by the id as a
+// uint96.
+func (s Set) Key() [32]byte {
+	var key [32]byte
+	copy(key[:common.AddressLength], s.AVS[:])
+	key[28], key[29], key[30], key[31] = byte(s.ID>>24), byte(s.ID>>16), byte(s.ID>>8), byte(s.ID)
+	return key
+}
+
+// FromKey returns OperatorSetLib.decode of key. Like the contract, it
+// ignores the high 64 bits of the uint96 id.
+func FromKey(key [32]byte) Set {
+	var s Set
+	copy(s.AVS[:], key[:common.AddressLength])
+	s.ID = uint32(key[28])<<24 | uint32(key[29])<<16 | uint32(key[30])<<8 | uint32(key[31])
+	return s
+}
+
+// Compare orders operator sets by key, returning -1, 0 or 1.
+func (s Set) Compare(other Set) int {
+	a, b := s.Key(), other.Key()
+	return bytes.Compare(a[:], b[:])
+}
+
+// String returns the canonical form: the checksummed AVS address, a colon
+// and the decimal id.
+func (s Set) String() string {
+	return s.AVS.Hex() + ":" + strconv.FormatUint(uint64(s.ID), 10)
+}
+
+// Parse reads the form String returns. The address may be in any case.
+func Parse(str string) (Set, error) {
+	avs, id, ok := strings.Cut(str, ":")
+	if !ok || !strings.HasPrefix(avs, "0x") || !common.IsHexAddress(avs) {
+		return Set{}, fmt.Errorf("%w %q: expected <avs>:<id>", ErrInvalidFormat, str)
+	}
+	n, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return Set{}, fmt.Errorf("%w %q: bad id: %v", ErrInvalidFormat, str, err)
+	}
+	return Set{AVS: common.HexToAddress(avs), ID: uint32(n)}, nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s Set) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Set) UnmarshalText(text []byte) error {
+	parsed, err := Parse(string(text))
+	if err != nil {
+		return err
+	}
+	*s = parsed
+	return nil
+}
@@ -0,0 +1,92 @@
+package operatorset
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	keyregistrar "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/KeyRegistrar"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var avs = common.HexToAddress("0x5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c")
+
+func TestKey(t *testing.T) {
+	set := New(avs, 0x01020304)
+	want := common.HexToHash("0x5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c000000000000000001020304")
+	if got := set.Key(); got != want {
+		t.Errorf("Expected key %s, got %x", want.Hex(), got)
+	}
+	if got := FromKey(want); got != set {
+		t.Errorf("Expected %v, got %v", set, got)
+	}
+
+	// The high bits of the uint96 id are dropped, as in OperatorSetLib.decode.
+	key := want
+	key[20] = 0xff
+	if got := FromKey(key); got != set {
+		t.Errorf("Expected the high id bits ignored, got %v", got)
+	}
+
+	if New(avs, 1).Compare(New(avs, 2)) >= 0 || New(common.Address{}, 9).Compare(New(avs, 1)) >= 0 || set.Compare(set) != 0 {
+		t.Errorf("Expected operator sets ordered by key")
+	}
+}
+
+func TestString(t *testing.T) {
+	set := New(avs, 7)
+	want := "0x5a8C9E1F0e6e1D4b3c2A1F0E9D8C7b6A5F4e3d2C:7"
+	if got := set.String(); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+	for _, s := range []string{want, "0x5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c:7"} {
+		got, err := Parse(s)
+		if err != nil || got != set {
+			t.Errorf("Parse(%q): expected %v, got %v, %v", s, set, got, err)
+		}
+	}
+	for _, s := range []string{
+		"",
+		"0x5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c",
+		"5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c:7",
+		"0x5a8c:7",
+		"0x5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c:-1",
+		"0x5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c:4294967296",
+		"0x5a8c9e1f0e6e1d4b3c2a1f0e9d8c7b6a5f4e3d2c:7:8",
+	} {
+		if _, err := Parse(s); !errors.Is(err, ErrInvalidFormat) {
+			t.Errorf("Parse(%q): expected ErrInvalidFormat, got %v", s, err)
+		}
+	}
+}
+
+func TestJSON(t *testing.T) {
+	in := map[Set]uint64{New(avs, 1): 10, New(common.Address{}, 2): 20}
+	raw, err := json.Marshal(in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out map[Set]uint64
+	if err := json.Unmarshal(raw, &out); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	if len(out) != 2 || out[New(avs, 1)] != 10 || out[New(common.Address{}, 2)] != 20 {
+		t.Errorf("Expected %v, got %v from %s", in, out, raw)
+	}
+
+	var s struct{ Set Set }
+	if err := json.Unmarshal([]byte(`{"Set":"0x5a8c:1"}`), &s); !errors.Is(err, ErrInvalidFormat) {
+		t.Errorf("Expected ErrInvalidFormat, got %v", err)
+	}
+}
+
+func TestBinding(t *testing.T) {
+	set := New(avs, 3)
+	// Every binding's OperatorSet is the same canonical type.
+	var am allocationmanager.OperatorSet = set.Binding()
+	var kr keyregistrar.OperatorSet = am
+	if From(kr) != set || am.Avs != avs || am.Id != 3 {
+		t.Errorf("Expected %v to round-trip, got %+v", set, kr)
+	}
+}
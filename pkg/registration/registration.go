@@ -0,0 +1,239 @@
+// Package registration registers operators for operator sets, checking with
+// the AVS registrar before anything is sent.
+//
+// AllocationManager.registerForOperatorSets hands RegisterParams.data to the
+// AVS's registrar, whose registerOperator can revert for reasons of its own,
+// and a reverted registration still costs gas. A Client resolves the
+// registrar with getAVSRegistrar, checks it supportsAVS, builds the data
+// with the configured Encoder and runs the registration with eth_call first,
+// so a registrar revert comes back decoded instead of as a failed
+// transaction:
+//
+//	m, err := txmgr.New(ctx, client, auth, txmgr.Config{})
+//	c, err := registration.New(registration.NewContracts(contracts, client), m, registration.Config{
+//		Encoder: registration.StaticData(pubkeyRegistration),
+//	})
+//	receipt, err := c.Register(ctx, auth.From, avs, []uint32{1})
+//
+// Deregister goes through the same checks for deregisterFromOperatorSets.
+package registration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	iavsregistrar "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IAVSRegistrar"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deployment"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrNoRegistrar is returned when the AVS's registrar has no code, as
+	// when the AVS never set one and is not a contract itself.
+	ErrNoRegistrar = errors.New("registration: AVS registrar has no code")
+	// ErrUnsupportedAVS is returned when the registrar's supportsAVS is false.
+	ErrUnsupportedAVS = errors.New("registration: AVS registrar does not support the AVS")
+)
+
+// AllocationManager is the part of the AllocationManager binding a Client
+// uses.
+type AllocationManager interface {
+	allocationmanager.AllocationManagerReader
+	allocationmanager.AllocationManagerWriter
+}
+
+// Contracts is what a Client calls.
+type Contracts struct {
+	// AllocationManager is the binding of the AllocationManager at Address.
+	AllocationManager AllocationManager
+	Address           common.Address
+	// Caller runs the simulations and reads the AVS registrars.
+	Caller bind.ContractCaller
+}
+
+// NewContracts returns the Contracts of a deployment bound to caller.
+func NewContracts(c *deployment.Contracts, caller bind.ContractCaller) Contracts {
+	return Contracts{
+		AllocationManager: c.AllocationManager,
+		Address:           c.Deployment.Core.AllocationManager.Proxy,
+		Caller:            caller,
+	}
+}
+
+// Encoder builds the data registerForOperatorSets passes to the AVS
+// registrar's registerOperator, e.g. a signed key registration.
+type Encoder func(ctx context.Context, operator, avs common.Address, operatorSetIds []uint32) ([]byte, error)
+
+// StaticData returns an Encoder that always returns data.
+func StaticData(data []byte) Encoder {
+	return func(context.Context, common.Address, common.Address, []uint32) ([]byte, error) {
+		return data, nil
+	}
+}
+
+// Config tunes a Client.
+type Config struct {
+	// Encoder builds the registrar data. It defaults to empty data.
+	Encoder Encoder
+	// Errors decodes the custom errors of the AVS registrar, e.g. a registry
+	// built with errors.NewRegistry from the registrar's binding. Reverts it
+	// does not know are decoded with errors.Default.
+	Errors *elerrors.Registry
+}
+
+func (c Config) withDefaults() Config {
+	if c.Encoder == nil {
+		c.Encoder = StaticData(nil)
+	}
+	return c
+}
+
+// Client registers and deregisters operators for operator sets.
+type Client struct {
+	contracts Contracts
+	sender    txmgr.AccountSender
+	cfg       Config
+	abi       *abi.ABI
+}
+
+// New returns a Client sending through sender and simulating as its account.
+func New(contracts Contracts, sender txmgr.AccountSender, cfg Config) (*Client, error) {
+	if contracts.AllocationManager == nil || contracts.Caller == nil || sender == nil {
+		return nil, errors.New("registration: nil AllocationManager, Caller or Sender")
+	}
+	parsed, err := allocationmanager.AllocationManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("registration: failed to parse AllocationManager ABI: %w", err)
+	}
+	return &Client{contracts: contracts, sender: sender, cfg: cfg.withDefaults(), abi: parsed}, nil
+}
+
+// Registration is a registration that passed its checks and simulation.
+type Registration struct {
+	Registrar common.Address
+	Operator  common.Address
+	Params    eltypes.IAllocationManagerTypesRegisterParams
+}
+
+// Prepare checks the AVS's registrar, encodes the registrar data and
+// simulates the registration of operator for the operator sets of avs. A
+// simulated revert is returned decoded, wrapping an *errors.RevertError
+// when the error is known.
+func (c *Client) Prepare(ctx context.Context, operator, avs common.Address, operatorSetIds []uint32) (Registration, error) {
+	registrar, err := c.registrar(ctx, avs)
+	if err != nil {
+		return Registration{}, err
+	}
+	data, err := c.cfg.Encoder(ctx, operator, avs, operatorSetIds)
+	if err != nil {
+		return Registration{}, fmt.Errorf("registration: failed to encode registrar data: %w", err)
+	}
+	r := Registration{
+		Registrar: registrar,
+		Operator:  operator,
+		Params:    eltypes.IAllocationManagerTypesRegisterParams{Avs: avs, OperatorSetIds: operatorSetIds, Data: data},
+	}
+	if err := c.simulate(ctx, "registerForOperatorSets", operator, r.Params); err != nil {
+		return Registration{}, fmt.Errorf("registration: registration of %s for %v of %s reverted: %w", operator.Hex(), operatorSetIds, avs.Hex(), err)
+	}
+	return r, nil
+}
+
+// Register prepares the registration of operator for the operator sets of
+// avs and sends it.
+func (c *Client) Register(ctx context.Context, operator, avs common.Address, operatorSetIds []uint32) (*types.Receipt, error) {
+	r, err := c.Prepare(ctx, operator, avs, operatorSetIds)
+	if err != nil {
+		return nil, err
+	}
+	return c.sender.SendAndWait(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contracts.AllocationManager.RegisterForOperatorSets(opts, r.Operator, r.Params)
+	})
+}
+
+// PrepareDeregistration checks the AVS's registrar and simulates the
+// deregistration of operator from the operator sets of avs, like Prepare.
+func (c *Client) PrepareDeregistration(ctx context.Context, operator, avs common.Address, operatorSetIds []uint32) (eltypes.IAllocationManagerTypesDeregisterParams, error) {
+	params := eltypes.IAllocationManagerTypesDeregisterParams{Operator: operator, Avs: avs, OperatorSetIds: operatorSetIds}
+	if _, err := c.registrar(ctx, avs); err != nil {
+		return params, err
+	}
+	if err := c.simulate(ctx, "deregisterFromOperatorSets", params); err != nil {
+		return params, fmt.Errorf("registration: deregistration of %s from %v of %s reverted: %w", operator.Hex(), operatorSetIds, avs.Hex(), err)
+	}
+	return params, nil
+}
+
+// Deregister prepares the deregistration of operator from the operator sets
+// of avs and sends it.
+func (c *Client) Deregister(ctx context.Context, operator, avs common.Address, operatorSetIds []uint32) (*types.Receipt, error) {
+	params, err := c.PrepareDeregistration(ctx, operator, avs, operatorSetIds)
+	if err != nil {
+		return nil, err
+	}
+	return c.sender.SendAndWait(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return c.contracts.AllocationManager.DeregisterFromOperatorSets(opts, params)
+	})
+}
+
+// registrar returns the AVS's registrar after checking it supports avs.
+func (c *Client) registrar(ctx context.Context, avs common.Address) (common.Address, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	registrar, err := c.contracts.AllocationManager.GetAVSRegistrar(opts, avs)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("registration: failed to read AVS registrar of %s: %w", avs.Hex(), err)
+	}
+	code, err := c.contracts.Caller.CodeAt(ctx, registrar, nil)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("registration: failed to read code of AVS registrar %s: %w", registrar.Hex(), err)
+	}
+	if len(code) == 0 {
+		return common.Address{}, fmt.Errorf("%w: %s of %s", ErrNoRegistrar, registrar.Hex(), avs.Hex())
+	}
+	caller, err := iavsregistrar.NewIAVSRegistrarCaller(registrar, c.contracts.Caller)
+	if err != nil {
+		return common.Address{}, err
+	}
+	supported, err := caller.SupportsAVS(opts, avs)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("registration: failed to read whether %s supports %s: %w", registrar.Hex(), avs.Hex(), c.wrap(err))
+	}
+	if !supported {
+		return common.Address{}, fmt.Errorf("%w: %s of %s", ErrUnsupportedAVS, registrar.Hex(), avs.Hex())
+	}
+	return registrar, nil
+}
+
+// simulate runs an AllocationManager method with eth_call from the sender
+// against the latest block.
+func (c *Client) simulate(ctx context.Context, method string, args ...interface{}) error {
+	data, err := c.abi.Pack(method, args...)
+	if err != nil {
+		return err
+	}
+	msg := ethereum.CallMsg{From: c.sender.From(), To: &c.contracts.Address, Data: data}
+	if _, err := c.contracts.Caller.CallContract(ctx, msg, nil); err != nil {
+		return c.wrap(err)
+	}
+	return nil
+}
+
+// wrap decodes a revert, trying the registrar's errors first.
+func (c *Client) wrap(err error) error {
+	if c.cfg.Errors != nil {
+		var revert *elerrors.RevertError
+		if wrapped := c.cfg.Errors.Wrap(err); errors.As(wrapped, &revert) {
+			return wrapped
+		}
+	}
+	return elerrors.Wrap(err)
+}
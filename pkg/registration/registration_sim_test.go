@@ -0,0 +1,95 @@
+//go:build !go1.23 || simulated
+
+package registration
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+type harnessSender struct {
+	h    *harness.Harness
+	auth *bind.TransactOpts
+}
+
+func (s harnessSender) From() common.Address { return s.auth.From }
+
+func (s harnessSender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	return s.h.SendAs(ctx, s.auth, build)
+}
+
+// TestClientRegistersWithContract registers an operator through the
+// harness's accepting registrar, checks a repeated registration is stopped
+// by its simulation, and deregisters it again.
+func TestClientRegistersWithContract(t *testing.T) {
+	h, err := harness.New(harness.Config{})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+	call := &bind.CallOpts{Context: ctx}
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	account := func() *bind.TransactOpts {
+		auth, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+		check(err)
+		return auth
+	}
+
+	operator, avs := account(), account()
+	check(h.RegisterOperator(ctx, operator))
+	set, err := h.CreateOperatorSet(ctx, avs, 1, nil)
+	check(err)
+
+	client, err := New(NewContracts(h.Contracts, h.Client), harnessSender{h, operator}, Config{Encoder: StaticData([]byte{0x01})})
+	check(err)
+	if _, err := client.Register(ctx, operator.From, avs.From, []uint32{set.Id}); err != nil {
+		t.Fatal(err)
+	}
+	registered, err := h.AllocationManager.IsMemberOfOperatorSet(call, operator.From, set)
+	check(err)
+	if !registered {
+		t.Fatal("Expected the operator registered")
+	}
+
+	head, err := h.Client.BlockNumber(ctx)
+	check(err)
+	_, err = client.Register(ctx, operator.From, avs.From, []uint32{set.Id})
+	var revert *elerrors.RevertError
+	if !errors.As(err, &revert) || revert.Name != "AlreadyMemberOfSet" {
+		t.Errorf("Expected AlreadyMemberOfSet, got %v", err)
+	}
+	if after, err := h.Client.BlockNumber(ctx); err != nil || after != head {
+		t.Errorf("Expected no transaction mined, head moved from %d to %d", head, after)
+	}
+
+	// An AVS without a registrar contract is refused before simulation.
+	if _, err := client.Register(ctx, operator.From, account().From, []uint32{1}); !errors.Is(err, ErrNoRegistrar) {
+		t.Errorf("Expected ErrNoRegistrar, got %v", err)
+	}
+
+	if _, err := client.Deregister(ctx, operator.From, avs.From, []uint32{set.Id}); err != nil {
+		t.Fatal(err)
+	}
+	members, err := h.AllocationManager.GetMembers(call, set)
+	check(err)
+	if len(members) != 0 {
+		t.Errorf("Expected no members after deregistration, got %v", members)
+	}
+}
@@ -0,0 +1,197 @@
+package registration
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	iavsregistrar "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/IAVSRegistrar"
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	operator  = common.HexToAddress("0x09")
+	appointee = common.HexToAddress("0x0a")
+	avs       = common.HexToAddress("0xa5")
+	registrar = common.HexToAddress("0x7e")
+)
+
+// registrarABI declares the custom error of the test AVS registrar.
+const registrarABI = `[{"type":"error","name":"OperatorNotAllowed","inputs":[{"name":"operator","type":"address"}]}]`
+
+// rpcError mimics the JSON-RPC error returned for a reverted call.
+type rpcError struct {
+	data string
+}
+
+func (e rpcError) Error() string          { return "execution reverted" }
+func (e rpcError) ErrorData() interface{} { return e.data }
+
+// chain answers supportsAVS from the registrar and the simulations of the
+// AllocationManager.
+type chain struct {
+	code      map[common.Address][]byte
+	supported bool
+	revert    []byte
+	calls     []ethereum.CallMsg
+}
+
+func (c *chain) CodeAt(ctx context.Context, account common.Address, block *big.Int) ([]byte, error) {
+	return c.code[account], nil
+}
+
+func (c *chain) CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int) ([]byte, error) {
+	if *msg.To == registrar {
+		parsed, _ := iavsregistrar.IAVSRegistrarMetaData.GetAbi()
+		return parsed.Methods["supportsAVS"].Outputs.Pack(c.supported)
+	}
+	c.calls = append(c.calls, msg)
+	if c.revert != nil {
+		return nil, rpcError{data: hexutil.Encode(c.revert)}
+	}
+	return nil, nil
+}
+
+type sender struct{}
+
+func (sender) From() common.Address { return appointee }
+
+func (sender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	tx, err := build(&bind.TransactOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func newClient(t *testing.T, cfg Config) (*Client, *allocationmanager.FakeAllocationManager, *chain) {
+	am := allocationmanager.NewFakeAllocationManager(common.HexToAddress("0xa11"))
+	am.StubGetAVSRegistrar(avs, registrar)
+	c := &chain{code: map[common.Address][]byte{registrar: {0x00}}, supported: true}
+	client, err := New(Contracts{AllocationManager: am, Address: am.Address(), Caller: c}, sender{}, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, am, c
+}
+
+func TestRegister(t *testing.T) {
+	encoder := func(ctx context.Context, op, a common.Address, ids []uint32) ([]byte, error) {
+		return append(op.Bytes(), byte(len(ids))), nil
+	}
+	client, am, c := newClient(t, Config{Encoder: encoder})
+
+	if _, err := client.Register(context.Background(), operator, avs, []uint32{1, 2}); err != nil {
+		t.Fatal(err)
+	}
+	want := eltypes.IAllocationManagerTypesRegisterParams{Avs: avs, OperatorSetIds: []uint32{1, 2}, Data: append(operator.Bytes(), 2)}
+	calls := am.Calls("registerForOperatorSets")
+	if len(calls) != 1 || calls[0].Args[0] != operator {
+		t.Fatalf("Expected one registration of %s, got %+v", operator.Hex(), calls)
+	}
+	if got := calls[0].Args[1].(eltypes.IAllocationManagerTypesRegisterParams); !bytes.Equal(got.Data, want.Data) || got.Avs != avs {
+		t.Errorf("Expected params %+v, got %+v", want, got)
+	}
+
+	// The simulation sends the same call data from the sender.
+	if len(c.calls) != 1 || c.calls[0].From != appointee || *c.calls[0].To != am.Address() || !bytes.Equal(c.calls[0].Data, calls[0].Tx.Data()) {
+		t.Errorf("Expected the registration simulated from %s, got %+v", appointee.Hex(), c.calls)
+	}
+}
+
+func TestPrepareChecksRegistrar(t *testing.T) {
+	ctx := context.Background()
+
+	client, am, c := newClient(t, Config{})
+	c.supported = false
+	if _, err := client.Register(ctx, operator, avs, []uint32{1}); !errors.Is(err, ErrUnsupportedAVS) {
+		t.Errorf("Expected ErrUnsupportedAVS, got %v", err)
+	}
+	delete(c.code, registrar)
+	if _, err := client.Register(ctx, operator, avs, []uint32{1}); !errors.Is(err, ErrNoRegistrar) {
+		t.Errorf("Expected ErrNoRegistrar, got %v", err)
+	}
+	if _, err := client.Deregister(ctx, operator, avs, []uint32{1}); !errors.Is(err, ErrNoRegistrar) {
+		t.Errorf("Expected ErrNoRegistrar on deregistration, got %v", err)
+	}
+
+	failing := errors.New("no key")
+	client, _, _ = newClient(t, Config{Encoder: func(context.Context, common.Address, common.Address, []uint32) ([]byte, error) {
+		return nil, failing
+	}})
+	if _, err := client.Register(ctx, operator, avs, []uint32{1}); !errors.Is(err, failing) {
+		t.Errorf("Expected the encoder error, got %v", err)
+	}
+	if n := len(am.Calls("")); n != 0 {
+		t.Errorf("Expected nothing sent, got %d calls", n)
+	}
+}
+
+func TestPrepareDecodesRevert(t *testing.T) {
+	parsed, err := abi.JSON(strings.NewReader(registrarABI))
+	if err != nil {
+		t.Fatal(err)
+	}
+	custom := parsed.Errors["OperatorNotAllowed"]
+	args, err := custom.Inputs.Pack(operator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	registry, err := elerrors.NewRegistry(elerrors.Contract{Name: "Registrar", MetaData: &bind.MetaData{ABI: registrarABI}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	client, am, c := newClient(t, Config{Errors: registry})
+	c.revert = append(append([]byte(nil), custom.ID[:4]...), args...)
+
+	_, err = client.Register(context.Background(), operator, avs, []uint32{1})
+	var revert *elerrors.RevertError
+	if !errors.As(err, &revert) || revert.Contract != "Registrar" || revert.Name != "OperatorNotAllowed" || revert.Args["operator"] != operator {
+		t.Fatalf("Expected the registrar's OperatorNotAllowed, got %v", err)
+	}
+	if n := len(am.Calls("registerForOperatorSets")); n != 0 {
+		t.Errorf("Expected nothing sent, got %d registrations", n)
+	}
+
+	// Errors the registrar does not declare fall back to the default registry.
+	amABI, err := allocationmanager.AllocationManagerMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	notMember := amABI.Errors["NotMemberOfSet"].ID
+	c.revert = notMember[:4]
+	_, err = client.Deregister(context.Background(), operator, avs, []uint32{1})
+	if !errors.As(err, &revert) || revert.Name != "NotMemberOfSet" {
+		t.Errorf("Expected NotMemberOfSet, got %v", err)
+	}
+	if n := len(am.Calls("deregisterFromOperatorSets")); n != 0 {
+		t.Errorf("Expected nothing sent, got %d deregistrations", n)
+	}
+}
+
+func TestDeregister(t *testing.T) {
+	client, am, c := newClient(t, Config{})
+	if _, err := client.Deregister(context.Background(), operator, avs, []uint32{3}); err != nil {
+		t.Fatal(err)
+	}
+	calls := am.Calls("deregisterFromOperatorSets")
+	want := eltypes.IAllocationManagerTypesDeregisterParams{Operator: operator, Avs: avs, OperatorSetIds: []uint32{3}}
+	if len(calls) != 1 || len(c.calls) != 1 || !bytes.Equal(c.calls[0].Data, calls[0].Tx.Data()) {
+		t.Fatalf("Expected one simulated deregistration, got %+v", calls)
+	}
+	if got := calls[0].Args[0].(eltypes.IAllocationManagerTypesDeregisterParams); got.Operator != want.Operator || got.OperatorSetIds[0] != 3 {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+}
@@ -0,0 +1,206 @@
+// Package rewardsclaim builds the RewardsMerkleClaim an earner submits to
+// RewardsCoordinator.processClaim.
+//
+// A claim proves the earner's leaf in a distribution root and the leaves of
+// the tokens claimed in the earner's token tree. Build rebuilds the tree of a
+// distribution file with pkg/rewardstree, finds its root with
+// getRootIndexFromHash and leaves out the tokens whose cumulative amount has
+// already been claimed, so a claim always pays something:
+//
+//	d, err := rewardsclaim.LoadDistribution("distribution.json")
+//	tree, err := rewardstree.Build(d)
+//	claim, err := rewardsclaim.Build(ctx, contracts.RewardsCoordinator, tree, earner, nil)
+//	tx, err := contracts.RewardsCoordinator.ProcessClaim(opts, claim.Claim, recipient)
+//
+// Verify runs the checks of processClaim offline, so a claim can be checked
+// before it is signed and a failure named instead of reverting:
+//
+//	err := rewardsclaim.Verify(claim.Claim, rewardsclaim.State{
+//		Root:      root, // getDistributionRootAtIndex(claim.RootIndex)
+//		Timestamp: head.Time,
+//		Claimed:   claimed,
+//	})
+//	if errors.Is(err, rewardsclaim.ErrRootNotActivated) { ... }
+//
+// cmd/rewardsclaim builds claims from the command line.
+package rewardsclaim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewardstree"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+var (
+	// ErrUnknownEarner is returned when the earner has no leaf in the tree.
+	ErrUnknownEarner = errors.New("rewardsclaim: earner not in distribution")
+	// ErrUnknownToken is returned when a requested token has no leaf in the
+	// earner's token tree.
+	ErrUnknownToken = errors.New("rewardsclaim: token not in earner's distribution")
+	// ErrNothingToClaim is returned when every requested token has already
+	// been claimed up to its cumulative amount.
+	ErrNothingToClaim = errors.New("rewardsclaim: nothing to claim")
+)
+
+// Claim is a claim ready to submit, with what it pays.
+type Claim struct {
+	Claim eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim
+	// Amounts is what each token leaf pays: its cumulative amount less
+	// cumulativeClaimed.
+	Amounts []*big.Int
+}
+
+// Build builds the claim of earner in tree for tokens, or for all of the
+// earner's tokens if tokens is empty. Tokens with nothing left to claim are
+// left out.
+func Build(ctx context.Context, rc rewardscoordinator.RewardsCoordinatorReader, tree *rewardstree.Tree, earner common.Address, tokens []common.Address) (Claim, error) {
+	e, earnerIndex, ok := tree.Earner(earner)
+	if !ok {
+		return Claim{}, fmt.Errorf("%w: %s", ErrUnknownEarner, earner.Hex())
+	}
+	var indices []uint32
+	if len(tokens) == 0 {
+		for i := range e.Tokens {
+			indices = append(indices, uint32(i))
+		}
+	}
+	seen := make(map[common.Address]bool, len(tokens))
+	for _, token := range tokens {
+		i, ok := e.Token(token)
+		if !ok {
+			return Claim{}, fmt.Errorf("%w: %s for %s", ErrUnknownToken, token.Hex(), earner.Hex())
+		}
+		if !seen[token] {
+			seen[token] = true
+			indices = append(indices, i)
+		}
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	rootIndex, err := rc.GetRootIndexFromHash(opts, tree.Root)
+	if err != nil {
+		return Claim{}, fmt.Errorf("rewardsclaim: failed to find root %s: %w", tree.Root.Hex(), err)
+	}
+	c := Claim{Claim: eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim{
+		RootIndex:       rootIndex,
+		EarnerIndex:     earnerIndex,
+		EarnerTreeProof: tree.EarnerProof(earnerIndex),
+		EarnerLeaf:      e.Leaf(),
+	}}
+	for _, i := range indices {
+		leaf := e.Tokens[i]
+		claimed, err := rc.CumulativeClaimed(opts, earner, leaf.Token)
+		if err != nil {
+			return Claim{}, fmt.Errorf("rewardsclaim: failed to read claimed %s of %s: %w", leaf.Token.Hex(), earner.Hex(), err)
+		}
+		if leaf.CumulativeEarnings.Cmp(claimed) <= 0 {
+			continue
+		}
+		c.Claim.TokenIndices = append(c.Claim.TokenIndices, i)
+		c.Claim.TokenTreeProofs = append(c.Claim.TokenTreeProofs, e.TokenProof(i))
+		c.Claim.TokenLeaves = append(c.Claim.TokenLeaves, leaf)
+		c.Amounts = append(c.Amounts, new(big.Int).Sub(leaf.CumulativeEarnings, claimed))
+	}
+	if len(c.Claim.TokenLeaves) == 0 {
+		return Claim{}, fmt.Errorf("%w: %s", ErrNothingToClaim, earner.Hex())
+	}
+	return c, nil
+}
+
+// decimal encodes a big.Int as a decimal string, as distribution files do.
+type decimal big.Int
+
+// MarshalText implements encoding.TextMarshaler.
+func (d *decimal) MarshalText() ([]byte, error) {
+	return []byte((*big.Int)(d).String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (d *decimal) UnmarshalText(text []byte) error {
+	if _, ok := (*big.Int)(d).SetString(string(text), 10); !ok {
+		return fmt.Errorf("rewardsclaim: bad amount %q", text)
+	}
+	return nil
+}
+
+type jsonEarnerLeaf struct {
+	Earner          common.Address `json:"earner"`
+	EarnerTokenRoot common.Hash    `json:"earnerTokenRoot"`
+}
+
+type jsonTokenLeaf struct {
+	Token              common.Address `json:"token"`
+	CumulativeEarnings *decimal       `json:"cumulativeEarnings"`
+}
+
+type jsonClaim struct {
+	RootIndex       uint32          `json:"rootIndex"`
+	EarnerIndex     uint32          `json:"earnerIndex"`
+	EarnerTreeProof hexutil.Bytes   `json:"earnerTreeProof"`
+	EarnerLeaf      jsonEarnerLeaf  `json:"earnerLeaf"`
+	TokenIndices    []uint32        `json:"tokenIndices"`
+	TokenTreeProofs []hexutil.Bytes `json:"tokenTreeProofs"`
+	TokenLeaves     []jsonTokenLeaf `json:"tokenLeaves"`
+	Amounts         []*decimal      `json:"amounts"`
+}
+
+// MarshalJSON encodes the claim as RewardsMerkleClaim, with hex proofs and
+// decimal amounts, and what each token leaf pays in a separate amounts
+// array.
+func (c Claim) MarshalJSON() ([]byte, error) {
+	out := jsonClaim{
+		RootIndex:       c.Claim.RootIndex,
+		EarnerIndex:     c.Claim.EarnerIndex,
+		EarnerTreeProof: c.Claim.EarnerTreeProof,
+		EarnerLeaf:      jsonEarnerLeaf{Earner: c.Claim.EarnerLeaf.Earner, EarnerTokenRoot: c.Claim.EarnerLeaf.EarnerTokenRoot},
+		TokenIndices:    c.Claim.TokenIndices,
+	}
+	for _, proof := range c.Claim.TokenTreeProofs {
+		out.TokenTreeProofs = append(out.TokenTreeProofs, proof)
+	}
+	for _, leaf := range c.Claim.TokenLeaves {
+		out.TokenLeaves = append(out.TokenLeaves, jsonTokenLeaf{Token: leaf.Token, CumulativeEarnings: (*decimal)(leaf.CumulativeEarnings)})
+	}
+	for _, amount := range c.Amounts {
+		out.Amounts = append(out.Amounts, (*decimal)(amount))
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalJSON decodes a claim encoded by MarshalJSON.
+func (c *Claim) UnmarshalJSON(data []byte) error {
+	var in jsonClaim
+	if err := json.Unmarshal(data, &in); err != nil {
+		return err
+	}
+	n := len(in.TokenLeaves)
+	if len(in.TokenIndices) != n || len(in.TokenTreeProofs) != n || len(in.Amounts) != n {
+		return fmt.Errorf("rewardsclaim: claim of %d token leaves with %d indices, %d proofs and %d amounts", n, len(in.TokenIndices), len(in.TokenTreeProofs), len(in.Amounts))
+	}
+	out := Claim{Claim: eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim{
+		RootIndex:       in.RootIndex,
+		EarnerIndex:     in.EarnerIndex,
+		EarnerTreeProof: in.EarnerTreeProof,
+		EarnerLeaf:      eltypes.IRewardsCoordinatorTypesEarnerTreeMerkleLeaf{Earner: in.EarnerLeaf.Earner, EarnerTokenRoot: in.EarnerLeaf.EarnerTokenRoot},
+		TokenIndices:    in.TokenIndices,
+	}}
+	for i, leaf := range in.TokenLeaves {
+		if leaf.CumulativeEarnings == nil || in.Amounts[i] == nil {
+			return fmt.Errorf("rewardsclaim: token leaf %d without an amount", i)
+		}
+		out.Claim.TokenTreeProofs = append(out.Claim.TokenTreeProofs, in.TokenTreeProofs[i])
+		out.Claim.TokenLeaves = append(out.Claim.TokenLeaves, eltypes.IRewardsCoordinatorTypesTokenTreeMerkleLeaf{Token: leaf.Token, CumulativeEarnings: (*big.Int)(leaf.CumulativeEarnings)})
+		out.Amounts = append(out.Amounts, (*big.Int)(in.Amounts[i]))
+	}
+	*c = out
+	return nil
+}
@@ -0,0 +1,91 @@
+//go:build !go1.23 || simulated
+
+package rewardsclaim
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	backingeigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewardstree"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// TestClaimsProcessed submits two roots with growing cumulative amounts and
+// checks the claims built for each are accepted by processClaim and pay
+// only what was not claimed before.
+func TestClaimsProcessed(t *testing.T) {
+	h, err := harness.New(harness.Config{ActivationDelay: 60})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+	call := &bind.CallOpts{Context: ctx}
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	strategy, err := h.DeployStrategy(ctx)
+	check(err)
+	token, err := backingeigen.NewBackingEigen(strategy.Token, h.Client)
+	check(err)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Mint(opts, h.Deployment.Core.RewardsCoordinator.Proxy, big.NewInt(9e18))
+	})
+	check(err)
+	claimer, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+	check(err)
+
+	submit := func(amount int64) *rewardstree.Tree {
+		t.Helper()
+		tree, err := rewardstree.Build(rewardstree.Distribution{
+			claimer.From:                {strategy.Token: big.NewInt(amount)},
+			common.HexToAddress("0xe4"): {strategy.Token: big.NewInt(1)},
+		})
+		check(err)
+		head, err := h.Client.HeaderByNumber(ctx, nil)
+		check(err)
+		_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.RewardsCoordinator.SubmitRoot(opts, tree.Root, uint32(head.Time-1))
+		})
+		check(err)
+		// The simulated backend adds the adjustment as seconds.
+		check(h.Backend.AdjustTime(60))
+		h.Backend.Commit()
+		return tree
+	}
+	claim := func(tree *rewardstree.Tree, rootIndex uint32, paid, balance int64) {
+		t.Helper()
+		c, err := Build(ctx, h.RewardsCoordinator, tree, claimer.From, nil)
+		check(err)
+		if c.Claim.RootIndex != rootIndex || c.Amounts[0].Cmp(big.NewInt(paid)) != 0 {
+			t.Fatalf("Expected root %d paying %d, got root %d paying %s", rootIndex, paid, c.Claim.RootIndex, c.Amounts[0])
+		}
+		_, err = h.SendAs(ctx, claimer, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.RewardsCoordinator.ProcessClaim(opts, c.Claim, claimer.From)
+		})
+		check(err)
+		got, err := token.BalanceOf(call, claimer.From)
+		check(err)
+		if got.Cmp(big.NewInt(balance)) != 0 {
+			t.Errorf("Expected a balance of %d, got %s", balance, got)
+		}
+		if _, err := Build(ctx, h.RewardsCoordinator, tree, claimer.From, nil); !errors.Is(err, ErrNothingToClaim) {
+			t.Errorf("Expected ErrNothingToClaim after claiming, got %v", err)
+		}
+	}
+
+	claim(submit(3e18), 0, 3e18, 3e18)
+	claim(submit(5e18), 1, 2e18, 5e18)
+}
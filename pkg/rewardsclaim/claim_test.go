@@ -0,0 +1,154 @@
+package rewardsclaim
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"reflect"
+	"strings"
+	"testing"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewardstree"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	earner = common.HexToAddress("0x01")
+	other  = common.HexToAddress("0x02")
+	tokenA = common.HexToAddress("0xa0")
+	tokenB = common.HexToAddress("0xb0")
+	tokenC = common.HexToAddress("0xc0")
+)
+
+const distributionJSON = `[
+	{"earner": "0x0000000000000000000000000000000000000001", "token": "0x00000000000000000000000000000000000000a0", "cumulativeAmount": "100"},
+	{"earner": "0x0000000000000000000000000000000000000001", "token": "0x00000000000000000000000000000000000000b0", "cumulativeAmount": "200"},
+	{"earner": "0x0000000000000000000000000000000000000001", "token": "0x00000000000000000000000000000000000000c0", "cumulativeAmount": "300000000000000000000000000000"},
+	{"earner": "0x0000000000000000000000000000000000000002", "token": "0x00000000000000000000000000000000000000a0", "cumulativeAmount": "5"}
+]`
+
+func newTree(t *testing.T) *rewardstree.Tree {
+	t.Helper()
+	d, err := ReadDistribution(strings.NewReader(distributionJSON))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tree, err := rewardstree.Build(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tree
+}
+
+func newCoordinator(tree *rewardstree.Tree, claimed map[common.Address]int64) *rewardscoordinator.FakeRewardsCoordinator {
+	rc := rewardscoordinator.NewFakeRewardsCoordinator(common.HexToAddress("0x4c"))
+	rc.StubGetRootIndexFromHash(tree.Root, 7)
+	for _, token := range []common.Address{tokenA, tokenB, tokenC} {
+		rc.StubCumulativeClaimed(earner, token, big.NewInt(claimed[token]))
+	}
+	return rc
+}
+
+func TestBuild(t *testing.T) {
+	tree := newTree(t)
+	rc := newCoordinator(tree, map[common.Address]int64{tokenA: 100, tokenB: 50})
+
+	c, err := Build(context.Background(), rc, tree, earner, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// tokenA is fully claimed and left out.
+	if c.Claim.RootIndex != 7 || c.Claim.EarnerIndex != 0 || len(c.Claim.TokenLeaves) != 2 ||
+		c.Claim.TokenLeaves[0].Token != tokenB || c.Claim.TokenIndices[0] != 1 || c.Claim.TokenIndices[1] != 2 {
+		t.Fatalf("Unexpected claim %+v", c.Claim)
+	}
+	if c.Amounts[0].Int64() != 150 || c.Amounts[1].String() != "300000000000000000000000000000" {
+		t.Errorf("Expected 150 and the full third amount, got %v", c.Amounts)
+	}
+
+	root, err := rewardstree.ProcessProof(c.Claim.EarnerTreeProof, rewardstree.EarnerLeafHash(c.Claim.EarnerLeaf), c.Claim.EarnerIndex)
+	if err != nil || root != tree.Root {
+		t.Errorf("Expected the earner proof to give the root, got %s, %v", root.Hex(), err)
+	}
+	for i, leaf := range c.Claim.TokenLeaves {
+		root, err := rewardstree.ProcessProof(c.Claim.TokenTreeProofs[i], rewardstree.TokenLeafHash(leaf), c.Claim.TokenIndices[i])
+		if err != nil || root != c.Claim.EarnerLeaf.EarnerTokenRoot {
+			t.Errorf("Token %d: expected the proof to give the earner token root, got %s, %v", i, root.Hex(), err)
+		}
+	}
+
+	raw, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded Claim
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("Failed to decode %s: %v", raw, err)
+	}
+	if !reflect.DeepEqual(decoded, c) {
+		t.Errorf("Expected %+v back, got %+v", c, decoded)
+	}
+	// The claim has the shape of RewardsMerkleClaim, with decimal amounts.
+	var fields struct {
+		EarnerLeaf  map[string]string   `json:"earnerLeaf"`
+		TokenLeaves []map[string]string `json:"tokenLeaves"`
+		Amounts     []string            `json:"amounts"`
+	}
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		t.Fatal(err)
+	}
+	if len(fields.EarnerLeaf) != 2 || fields.EarnerLeaf["earner"] != strings.ToLower(earner.Hex()) {
+		t.Errorf("Expected an earnerLeaf of earner and earnerTokenRoot, got %v", fields.EarnerLeaf)
+	}
+	if len(fields.TokenLeaves[0]) != 2 || fields.TokenLeaves[0]["cumulativeEarnings"] != "200" {
+		t.Errorf("Expected token leaves of token and cumulativeEarnings, got %v", fields.TokenLeaves)
+	}
+	if !reflect.DeepEqual(fields.Amounts, []string{"150", "300000000000000000000000000000"}) {
+		t.Errorf("Expected decimal amounts, got %v", fields.Amounts)
+	}
+}
+
+func TestBuildTokenSubset(t *testing.T) {
+	tree := newTree(t)
+	rc := newCoordinator(tree, map[common.Address]int64{tokenA: 100})
+	ctx := context.Background()
+
+	c, err := Build(ctx, rc, tree, earner, []common.Address{tokenC, tokenB, tokenC})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Claim.TokenLeaves) != 2 || c.Claim.TokenLeaves[0].Token != tokenC || c.Claim.TokenLeaves[1].Token != tokenB {
+		t.Errorf("Expected tokens C and B once each, got %+v", c.Claim.TokenLeaves)
+	}
+
+	if _, err := Build(ctx, rc, tree, earner, []common.Address{tokenA}); !errors.Is(err, ErrNothingToClaim) {
+		t.Errorf("Expected ErrNothingToClaim, got %v", err)
+	}
+	if _, err := Build(ctx, rc, tree, other, []common.Address{tokenB}); !errors.Is(err, ErrUnknownToken) {
+		t.Errorf("Expected ErrUnknownToken, got %v", err)
+	}
+	if _, err := Build(ctx, rc, tree, common.HexToAddress("0x03"), nil); !errors.Is(err, ErrUnknownEarner) {
+		t.Errorf("Expected ErrUnknownEarner, got %v", err)
+	}
+
+	rc.Fail("getRootIndexFromHash", rewardscoordinator.ErrInvalidRoot{})
+	var invalidRoot rewardscoordinator.ErrInvalidRoot
+	if _, err := Build(ctx, rc, tree, earner, nil); !errors.As(err, &invalidRoot) {
+		t.Errorf("Expected InvalidRoot for an unsubmitted root, got %v", err)
+	}
+}
+
+func TestReadDistribution(t *testing.T) {
+	for name, raw := range map[string]string{
+		"not an array": `{}`,
+		"bad amount":   `[{"earner": "0x0000000000000000000000000000000000000001", "token": "0x0000000000000000000000000000000000000002", "cumulativeAmount": "1e18"}]`,
+		"duplicate": `[{"earner": "0x0000000000000000000000000000000000000001", "token": "0x0000000000000000000000000000000000000002", "cumulativeAmount": "1"},
+			{"earner": "0x0000000000000000000000000000000000000001", "token": "0x0000000000000000000000000000000000000002", "cumulativeAmount": "2"}]`,
+	} {
+		if _, err := ReadDistribution(strings.NewReader(raw)); !errors.Is(err, ErrInvalidDistribution) {
+			t.Errorf("%s: expected ErrInvalidDistribution, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package rewardsclaim
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewardstree"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrInvalidDistribution is returned for distribution files with malformed
+// or duplicate rows.
+var ErrInvalidDistribution = errors.New("rewardsclaim: invalid distribution")
+
+// Row is one row of a distribution file.
+type Row struct {
+	Earner common.Address `json:"earner"`
+	Token  common.Address `json:"token"`
+	// CumulativeAmount is a decimal string, as amounts exceed JSON numbers.
+	CumulativeAmount string `json:"cumulativeAmount"`
+}
+
+// ReadDistribution reads a distribution file: a JSON array of rows, one per
+// earner and token.
+func ReadDistribution(r io.Reader) (rewardstree.Distribution, error) {
+	var rows []Row
+	if err := json.NewDecoder(r).Decode(&rows); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidDistribution, err)
+	}
+	d := make(rewardstree.Distribution)
+	for i, row := range rows {
+		amount, ok := new(big.Int).SetString(row.CumulativeAmount, 10)
+		if !ok {
+			return nil, fmt.Errorf("%w: row %d: bad amount %q", ErrInvalidDistribution, i, row.CumulativeAmount)
+		}
+		tokens, ok := d[row.Earner]
+		if !ok {
+			tokens = make(map[common.Address]*big.Int)
+			d[row.Earner] = tokens
+		}
+		if _, dup := tokens[row.Token]; dup {
+			return nil, fmt.Errorf("%w: row %d: duplicate token %s for earner %s", ErrInvalidDistribution, i, row.Token.Hex(), row.Earner.Hex())
+		}
+		tokens[row.Token] = amount
+	}
+	return d, nil
+}
+
+// LoadDistribution reads the distribution file at path.
+func LoadDistribution(path string) (rewardstree.Distribution, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	d, err := ReadDistribution(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return d, nil
+}
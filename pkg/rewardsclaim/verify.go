@@ -0,0 +1,124 @@
+package rewardsclaim
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewardstree"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrRootDisabled is returned by Verify for a disabled root
+	// (RootDisabled).
+	ErrRootDisabled = errors.New("rewardsclaim: root disabled")
+	// ErrRootNotActivated is returned by Verify for a root whose activatedAt
+	// is still ahead (RootNotActivated).
+	ErrRootNotActivated = errors.New("rewardsclaim: root not activated")
+	// ErrLengthMismatch is returned by Verify for a claim whose token
+	// indices, proofs and leaves differ in length (InputArrayLengthMismatch).
+	ErrLengthMismatch = errors.New("rewardsclaim: token indices, proofs and leaves differ in length")
+	// ErrInvalidLeafIndex is returned by Verify for an earner or token index
+	// beyond the tree its proof climbs (InvalidEarnerLeafIndex,
+	// InvalidTokenLeafIndex).
+	ErrInvalidLeafIndex = errors.New("rewardsclaim: leaf index beyond proof")
+	// ErrInvalidClaimProof is returned by Verify for a proof that does not
+	// rebuild its root from the leaf (InvalidClaimProof, EmptyRoot,
+	// InvalidProofLength).
+	ErrInvalidClaimProof = errors.New("rewardsclaim: invalid claim proof")
+	// ErrNotAboveClaimed is returned by Verify for a token leaf whose
+	// cumulative earnings are not above what the earner has claimed
+	// (EarningsNotGreaterThanClaimed).
+	ErrNotAboveClaimed = errors.New("rewardsclaim: earnings not greater than claimed")
+	// ErrUnauthorizedClaimer is returned by Verify when the sender is not
+	// the earner's claimer (UnauthorizedCaller).
+	ErrUnauthorizedClaimer = errors.New("rewardsclaim: sender is not the earner's claimer")
+)
+
+// State is what Verify checks a claim against, as read from the
+// RewardsCoordinator.
+type State struct {
+	// Root is getDistributionRootAtIndex of the claim's root index.
+	Root eltypes.IRewardsCoordinatorTypesDistributionRoot
+	// Timestamp is the timestamp of the block processing the claim.
+	Timestamp uint64
+	// Claimed is cumulativeClaimed of the earner for each token. Tokens
+	// missing from the map have claimed nothing; a nil map skips the check,
+	// as checkClaim does.
+	Claimed map[common.Address]*big.Int
+	// Claimer is claimerFor of the earner, zero if unset.
+	Claimer common.Address
+	// Sender is the account processing the claim; zero skips the check, as
+	// checkClaim does.
+	Sender common.Address
+}
+
+// Verify checks claim the way processClaim does, in the same order, and
+// returns the first check that fails, or nil if the claim would be paid.
+func Verify(claim eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim, s State) error {
+	if s.Root.Disabled {
+		return fmt.Errorf("%w: root %d", ErrRootDisabled, claim.RootIndex)
+	}
+	if s.Timestamp < uint64(s.Root.ActivatedAt) {
+		return fmt.Errorf("%w: root %d activates at %d, now %d", ErrRootNotActivated, claim.RootIndex, s.Root.ActivatedAt, s.Timestamp)
+	}
+	if len(claim.TokenIndices) != len(claim.TokenTreeProofs) || len(claim.TokenTreeProofs) != len(claim.TokenLeaves) {
+		return fmt.Errorf("%w: %d indices, %d proofs, %d leaves", ErrLengthMismatch,
+			len(claim.TokenIndices), len(claim.TokenTreeProofs), len(claim.TokenLeaves))
+	}
+
+	earnerLeaf := rewardstree.EarnerLeafHash(claim.EarnerLeaf)
+	if err := verifyProof(s.Root.Root, claim.EarnerTreeProof, earnerLeaf, claim.EarnerIndex); err != nil {
+		return fmt.Errorf("%w of earner %s at index %d", err, claim.EarnerLeaf.Earner.Hex(), claim.EarnerIndex)
+	}
+	for i, leaf := range claim.TokenLeaves {
+		if leaf.CumulativeEarnings == nil {
+			return fmt.Errorf("%w: token %d has no cumulative earnings", ErrInvalidClaimProof, i)
+		}
+		err := verifyProof(claim.EarnerLeaf.EarnerTokenRoot, claim.TokenTreeProofs[i], rewardstree.TokenLeafHash(leaf), claim.TokenIndices[i])
+		if err != nil {
+			return fmt.Errorf("%w of token %d (%s) at index %d", err, i, leaf.Token.Hex(), claim.TokenIndices[i])
+		}
+	}
+
+	claimer := s.Claimer
+	if claimer == (common.Address{}) {
+		claimer = claim.EarnerLeaf.Earner
+	}
+	if s.Sender != (common.Address{}) && s.Sender != claimer {
+		return fmt.Errorf("%w: %s claims for %s", ErrUnauthorizedClaimer, claimer.Hex(), claim.EarnerLeaf.Earner.Hex())
+	}
+	if s.Claimed == nil {
+		return nil
+	}
+	for i, leaf := range claim.TokenLeaves {
+		claimed := s.Claimed[leaf.Token]
+		if claimed == nil {
+			claimed = new(big.Int)
+		}
+		if leaf.CumulativeEarnings.Cmp(claimed) <= 0 {
+			return fmt.Errorf("%w: token %d (%s) earned %s, claimed %s", ErrNotAboveClaimed, i, leaf.Token.Hex(), leaf.CumulativeEarnings, claimed)
+		}
+	}
+	return nil
+}
+
+// verifyProof mirrors _verifyEarnerClaimProof and _verifyTokenClaimProof.
+func verifyProof(root common.Hash, proof []byte, leaf common.Hash, index uint32) error {
+	if levels := len(proof) / 32; levels < 32 && uint64(index) >= 1<<levels {
+		return fmt.Errorf("%w: %d-level proof", ErrInvalidLeafIndex, levels)
+	}
+	if root == (common.Hash{}) {
+		return fmt.Errorf("%w: empty root", ErrInvalidClaimProof)
+	}
+	got, err := rewardstree.ProcessProof(proof, leaf, index)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidClaimProof, err)
+	}
+	if got != root {
+		return fmt.Errorf("%w: proof gives %s, not %s", ErrInvalidClaimProof, got.Hex(), root.Hex())
+	}
+	return nil
+}
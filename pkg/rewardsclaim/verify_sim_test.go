@@ -0,0 +1,94 @@
+//go:build !go1.23 || simulated
+
+package rewardsclaim
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/rewardstree"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TestVerifyMatchesCheckClaim checks Verify fails where checkClaim reverts,
+// with the matching reason, and passes where checkClaim does.
+func TestVerifyMatchesCheckClaim(t *testing.T) {
+	h, err := harness.New(harness.Config{ActivationDelay: 60})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+	call := &bind.CallOpts{Context: ctx}
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tree, err := rewardstree.Build(rewardstree.Distribution{
+		earner: {tokenA: big.NewInt(100), tokenB: big.NewInt(200)},
+		other:  {tokenA: big.NewInt(5)},
+	})
+	check(err)
+	head, err := h.Client.HeaderByNumber(ctx, nil)
+	check(err)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.RewardsCoordinator.SubmitRoot(opts, tree.Root, uint32(head.Time-1))
+	})
+	check(err)
+	c, err := Build(ctx, h.RewardsCoordinator, tree, earner, nil)
+	check(err)
+
+	compare := func(want error, revert string) {
+		t.Helper()
+		root, err := h.RewardsCoordinator.GetDistributionRootAtIndex(call, big.NewInt(int64(c.Claim.RootIndex)))
+		check(err)
+		head, err := h.Client.HeaderByNumber(ctx, nil)
+		check(err)
+		if err := Verify(c.Claim, State{Root: root, Timestamp: head.Time}); !errors.Is(err, want) || (want == nil) != (err == nil) {
+			t.Errorf("Expected Verify to give %v, got %v", want, err)
+		}
+		_, err = h.RewardsCoordinator.CheckClaim(call, c.Claim)
+		var r *elerrors.RevertError
+		if revert == "" && err != nil || revert != "" && (!errors.As(elerrors.Wrap(err), &r) || r.Name != revert) {
+			t.Errorf("Expected checkClaim to revert with %q, got %v", revert, err)
+		}
+	}
+
+	compare(ErrRootNotActivated, "RootNotActivated")
+	// The simulated backend adds the adjustment as seconds.
+	check(h.Backend.AdjustTime(60))
+	h.Backend.Commit()
+	compare(nil, "")
+
+	c.Claim.TokenIndices[0] = 1
+	compare(ErrInvalidClaimProof, "InvalidClaimProof")
+	c.Claim.TokenIndices[0] = 2
+	compare(ErrInvalidLeafIndex, "InvalidTokenLeafIndex")
+	c.Claim.TokenIndices[0] = 0
+
+	// Roots can only be disabled before they activate, so disable a new one.
+	tree, err = rewardstree.Build(rewardstree.Distribution{earner: {tokenA: big.NewInt(150)}})
+	check(err)
+	head, err = h.Client.HeaderByNumber(ctx, nil)
+	check(err)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.RewardsCoordinator.SubmitRoot(opts, tree.Root, uint32(head.Time-1))
+	})
+	check(err)
+	c, err = Build(ctx, h.RewardsCoordinator, tree, earner, nil)
+	check(err)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.RewardsCoordinator.DisableRoot(opts, c.Claim.RootIndex)
+	})
+	check(err)
+	compare(ErrRootDisabled, "RootDisabled")
+}
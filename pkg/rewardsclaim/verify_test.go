@@ -0,0 +1,85 @@
+package rewardsclaim
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestVerify(t *testing.T) {
+	tree := newTree(t)
+	rc := newCoordinator(tree, nil)
+	c, err := Build(context.Background(), rc, tree, earner, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state := func() State {
+		return State{
+			Root:      eltypes.IRewardsCoordinatorTypesDistributionRoot{Root: tree.Root, ActivatedAt: 100},
+			Timestamp: 100,
+			Claimed:   map[common.Address]*big.Int{tokenA: big.NewInt(99)},
+			Sender:    earner,
+		}
+	}
+	if err := Verify(c.Claim, state()); err != nil {
+		t.Fatalf("Expected the built claim to verify, got %v", err)
+	}
+
+	for name, tc := range map[string]struct {
+		claim func(*eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim)
+		state func(*State)
+		want  error
+	}{
+		"disabled":       {state: func(s *State) { s.Root.Disabled = true }, want: ErrRootDisabled},
+		"not activated":  {state: func(s *State) { s.Timestamp = 99 }, want: ErrRootNotActivated},
+		"other root":     {state: func(s *State) { s.Root.Root = common.HexToHash("0x01") }, want: ErrInvalidClaimProof},
+		"empty root":     {state: func(s *State) { s.Root.Root = common.Hash{} }, want: ErrInvalidClaimProof},
+		"claimed":        {state: func(s *State) { s.Claimed[tokenA] = big.NewInt(100) }, want: ErrNotAboveClaimed},
+		"other sender":   {state: func(s *State) { s.Sender = other }, want: ErrUnauthorizedClaimer},
+		"claimer sender": {state: func(s *State) { s.Claimer = other }, want: ErrUnauthorizedClaimer},
+		"claimer":        {state: func(s *State) { s.Claimer, s.Sender = other, other }},
+		"unchecked":      {state: func(s *State) { s.Claimed, s.Sender = nil, common.Address{} }},
+		"missing proof": {
+			claim: func(c *eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim) { c.TokenTreeProofs = c.TokenTreeProofs[1:] },
+			want:  ErrLengthMismatch,
+		},
+		"earner index beyond proof": {
+			claim: func(c *eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim) { c.EarnerIndex = 2 },
+			want:  ErrInvalidLeafIndex,
+		},
+		"swapped token indices": {
+			claim: func(c *eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim) {
+				c.TokenIndices[0], c.TokenIndices[1] = c.TokenIndices[1], c.TokenIndices[0]
+			},
+			want: ErrInvalidClaimProof,
+		},
+		"token index beyond proof": {
+			claim: func(c *eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim) { c.TokenIndices[2] = 4 },
+			want:  ErrInvalidLeafIndex,
+		},
+		"inflated earnings": {
+			claim: func(c *eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim) {
+				c.TokenLeaves[1].CumulativeEarnings = big.NewInt(201)
+			},
+			want: ErrInvalidClaimProof,
+		},
+	} {
+		claim := c.Claim
+		claim.TokenIndices = append([]uint32(nil), c.Claim.TokenIndices...)
+		claim.TokenLeaves = append([]eltypes.IRewardsCoordinatorTypesTokenTreeMerkleLeaf(nil), c.Claim.TokenLeaves...)
+		s := state()
+		if tc.claim != nil {
+			tc.claim(&claim)
+		}
+		if tc.state != nil {
+			tc.state(&s)
+		}
+		if err := Verify(claim, s); !errors.Is(err, tc.want) || (tc.want == nil) != (err == nil) {
+			t.Errorf("%s: expected %v, got %v", name, tc.want, err)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package rewardssubmission
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var hashArgs = sync.OnceValues(func() (map[string]abi.Arguments, error) {
+	parsed, err := rewardscoordinator.RewardsCoordinatorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	address, err := abi.NewType("address", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	uint256, err := abi.NewType("uint256", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	// The submission tuples are the elements of the create methods' arrays.
+	args := make(map[string]abi.Arguments)
+	for name, method := range map[string]string{
+		"RewardsSubmission":                 "createAVSRewardsSubmission",
+		"OperatorDirectedRewardsSubmission": "createOperatorDirectedAVSRewardsSubmission",
+	} {
+		m, ok := parsed.Methods[method]
+		if !ok {
+			return nil, fmt.Errorf("no method %s", method)
+		}
+		inputs := m.Inputs
+		args[name] = abi.Arguments{{Type: address}, {Type: uint256}, {Type: *inputs[len(inputs)-1].Type.Elem}}
+	}
+	return args, nil
+})
+
+// RewardsSubmissionHash returns the hash RewardsCoordinator keys a
+// RewardsSubmission by, keccak256(abi.encode(avs, nonce, s)), as in
+// isAVSRewardsSubmissionHash, isRewardsSubmissionForAllHash and
+// isRewardsSubmissionForAllEarnersHash. avs is the submitter and nonce its
+// submissionNonce when s is submitted.
+func RewardsSubmissionHash(avs common.Address, nonce *big.Int, s eltypes.IRewardsCoordinatorTypesRewardsSubmission) (common.Hash, error) {
+	return submissionHash("RewardsSubmission", avs, nonce, s)
+}
+
+// OperatorDirectedSubmissionHash returns the hash RewardsCoordinator keys an
+// OperatorDirectedRewardsSubmission by, keccak256(abi.encode(avs, nonce, s)),
+// as in isOperatorDirectedAVSRewardsSubmissionHash and
+// isOperatorDirectedOperatorSetRewardsSubmissionHash. avs is the AVS
+// submitted for, or the operator set's AVS, and nonce its submissionNonce
+// when s is submitted.
+func OperatorDirectedSubmissionHash(avs common.Address, nonce *big.Int, s eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) (common.Hash, error) {
+	return submissionHash("OperatorDirectedRewardsSubmission", avs, nonce, s)
+}
+
+func submissionHash(name string, avs common.Address, nonce *big.Int, s interface{}) (common.Hash, error) {
+	args, err := hashArgs()
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("rewardssubmission: failed to load RewardsCoordinator ABI: %w", err)
+	}
+	if nonce == nil {
+		nonce = new(big.Int)
+	}
+	encoded, err := args[name].Pack(avs, nonce, s)
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("rewardssubmission: failed to encode %s: %w", name, err)
+	}
+	return crypto.Keccak256Hash(encoded), nil
+}
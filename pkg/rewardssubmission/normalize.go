@@ -0,0 +1,176 @@
+package rewardssubmission
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ErrWindowOverflow is returned when an aligned window would end past the
+// largest uint32 timestamp.
+var ErrWindowOverflow = errors.New("rewardssubmission: aligned window ends past the uint32 range")
+
+// Window is the time range a submission rewards.
+type Window struct {
+	StartTimestamp uint32
+	Duration       uint32
+}
+
+// Align widens start and duration to whole calculation intervals: the start
+// is rounded down and the end up. Note that rounding the end up can make an
+// operator-directed submission end after the current block.
+func (r Rules) Align(start, duration uint32) (Window, error) {
+	interval := uint64(r.CalculationInterval)
+	from := uint64(start) / interval * interval
+	to := (uint64(start) + uint64(duration) + interval - 1) / interval * interval
+	if to > math.MaxUint32 {
+		return Window{}, fmt.Errorf("%w: start %d, duration %d", ErrWindowOverflow, start, duration)
+	}
+	return Window{StartTimestamp: uint32(from), Duration: uint32(to - from)}, nil
+}
+
+// Split splits a window into consecutive windows of at most
+// MaxRewardsDuration, rounded down to whole calculation intervals. The last
+// window takes what remains.
+func (r Rules) Split(w Window) []Window {
+	step := r.MaxRewardsDuration - r.MaxRewardsDuration%r.CalculationInterval
+	if step == 0 || w.Duration <= step {
+		return []Window{w}
+	}
+	var out []Window
+	for start, left := w.StartTimestamp, w.Duration; left > 0; {
+		d := min(step, left)
+		out = append(out, Window{StartTimestamp: start, Duration: d})
+		start += d
+		left -= d
+	}
+	return out
+}
+
+// SortStrategies returns a copy of strategies in ascending address order.
+func SortStrategies(strategies []eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier) []eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier {
+	out := append([]eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier(nil), strategies...)
+	sort.SliceStable(out, func(i, j int) bool { return bytes.Compare(out[i].Strategy[:], out[j].Strategy[:]) < 0 })
+	return out
+}
+
+// SortOperatorRewards returns rewards in ascending operator order, with the
+// rewards of an operator listed twice added up and zero rewards left out.
+func SortOperatorRewards(rewards []eltypes.IRewardsCoordinatorTypesOperatorReward) []eltypes.IRewardsCoordinatorTypesOperatorReward {
+	amounts := make(map[common.Address]*big.Int, len(rewards))
+	for _, reward := range rewards {
+		if reward.Amount == nil || reward.Amount.Sign() == 0 {
+			continue
+		}
+		if a, ok := amounts[reward.Operator]; ok {
+			a.Add(a, reward.Amount)
+		} else {
+			amounts[reward.Operator] = new(big.Int).Set(reward.Amount)
+		}
+	}
+	out := make([]eltypes.IRewardsCoordinatorTypesOperatorReward, 0, len(amounts))
+	for operator, amount := range amounts {
+		out = append(out, eltypes.IRewardsCoordinatorTypesOperatorReward{Operator: operator, Amount: amount})
+	}
+	sort.Slice(out, func(i, j int) bool { return bytes.Compare(out[i].Operator[:], out[j].Operator[:]) < 0 })
+	return out
+}
+
+// NormalizeRewardsSubmission sorts the strategies of s, aligns its window
+// and splits it into submissions no longer than MaxRewardsDuration. The
+// amount is shared between them by duration, the last one taking the
+// rounding remainder, so they pay s.Amount in total; shares rounding to zero
+// are left out, as the contract rejects them.
+func (r Rules) NormalizeRewardsSubmission(s eltypes.IRewardsCoordinatorTypesRewardsSubmission) ([]eltypes.IRewardsCoordinatorTypesRewardsSubmission, error) {
+	strategies := SortStrategies(s.StrategiesAndMultipliers)
+	w, err := r.Align(s.StartTimestamp, s.Duration)
+	if err != nil {
+		return nil, err
+	}
+	windows := r.Split(w)
+	amount := s.Amount
+	if amount == nil {
+		amount = new(big.Int)
+	}
+	amounts := share(amount, windows)
+	var out []eltypes.IRewardsCoordinatorTypesRewardsSubmission
+	for i, w := range windows {
+		if amounts[i].Sign() == 0 && amount.Sign() != 0 {
+			continue
+		}
+		out = append(out, eltypes.IRewardsCoordinatorTypesRewardsSubmission{
+			StrategiesAndMultipliers: strategies,
+			Token:                    s.Token,
+			Amount:                   amounts[i],
+			StartTimestamp:           w.StartTimestamp,
+			Duration:                 w.Duration,
+		})
+	}
+	return out, nil
+}
+
+// NormalizeOperatorDirectedSubmission sorts the strategies and operator
+// rewards of s, aligns its window and splits it into submissions no longer
+// than MaxRewardsDuration. Each operator's reward is shared between them by
+// duration, the last one taking the rounding remainder; shares rounding to
+// zero are left out, and so are submissions left without rewards.
+func (r Rules) NormalizeOperatorDirectedSubmission(s eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) ([]eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission, error) {
+	strategies := SortStrategies(s.StrategiesAndMultipliers)
+	rewards := SortOperatorRewards(s.OperatorRewards)
+	w, err := r.Align(s.StartTimestamp, s.Duration)
+	if err != nil {
+		return nil, err
+	}
+	windows := r.Split(w)
+	perWindow := make([][]eltypes.IRewardsCoordinatorTypesOperatorReward, len(windows))
+	for _, reward := range rewards {
+		for i, amount := range share(reward.Amount, windows) {
+			if amount.Sign() > 0 {
+				perWindow[i] = append(perWindow[i], eltypes.IRewardsCoordinatorTypesOperatorReward{Operator: reward.Operator, Amount: amount})
+			}
+		}
+	}
+	var out []eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission
+	for i, w := range windows {
+		if len(perWindow[i]) == 0 && len(rewards) > 0 {
+			continue
+		}
+		out = append(out, eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission{
+			StrategiesAndMultipliers: strategies,
+			Token:                    s.Token,
+			OperatorRewards:          perWindow[i],
+			StartTimestamp:           w.StartTimestamp,
+			Duration:                 w.Duration,
+			Description:              s.Description,
+		})
+	}
+	return out, nil
+}
+
+// share splits amount between windows by duration, rounding down, with the
+// remainder going to the last window.
+func share(amount *big.Int, windows []Window) []*big.Int {
+	var total uint64
+	for _, w := range windows {
+		total += uint64(w.Duration)
+	}
+	out := make([]*big.Int, len(windows))
+	left := new(big.Int).Set(amount)
+	for i, w := range windows {
+		if i == len(windows)-1 || total == 0 {
+			out[i] = left
+			break
+		}
+		part := new(big.Int).Mul(amount, new(big.Int).SetUint64(uint64(w.Duration)))
+		part.Quo(part, new(big.Int).SetUint64(total))
+		out[i] = part
+		left = new(big.Int).Sub(left, part)
+	}
+	return out
+}
@@ -0,0 +1,111 @@
+package rewardssubmission
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"reflect"
+	"testing"
+
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+)
+
+func TestAlignAndSplit(t *testing.T) {
+	if got, err := rules.Align(genesis+day, week); err != nil || got != (Window{genesis, 2 * week}) {
+		t.Errorf("Expected %+v, got %+v, %v", Window{genesis, 2 * week}, got, err)
+	}
+	if got, err := rules.Align(genesis, 2*week); err != nil || got != (Window{genesis, 2 * week}) {
+		t.Errorf("Expected an aligned window unchanged, got %+v, %v", got, err)
+	}
+	// Rounding the end up would pass the last uint32 timestamp.
+	if _, err := rules.Align(math.MaxUint32-day, day); !errors.Is(err, ErrWindowOverflow) {
+		t.Errorf("Expected ErrWindowOverflow, got %v", err)
+	}
+
+	got := rules.Split(Window{genesis, 25 * week})
+	want := []Window{{genesis, 10 * week}, {genesis + 10*week, 10 * week}, {genesis + 20*week, 5 * week}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %+v, got %+v", want, got)
+	}
+	// A maximum that is not a whole number of intervals is rounded down.
+	odd := rules
+	odd.MaxRewardsDuration = 2*week + day
+	if got := odd.Split(Window{genesis, 3 * week}); len(got) != 2 || got[0].Duration != 2*week || got[1].Duration != week {
+		t.Errorf("Expected windows of two and one weeks, got %+v", got)
+	}
+}
+
+func TestNormalizeRewardsSubmission(t *testing.T) {
+	subs, err := rules.NormalizeRewardsSubmission(eltypes.IRewardsCoordinatorTypesRewardsSubmission{
+		StrategiesAndMultipliers: strategies(strategyB, strategyA),
+		Amount:                   big.NewInt(1000),
+		StartTimestamp:           genesis + 3*day,
+		Duration:                 12 * week,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 2 || subs[0].Duration != 10*week || subs[1].Duration != 3*week || subs[1].StartTimestamp != genesis+10*week {
+		t.Fatalf("Expected 10 and 3 week submissions, got %+v", subs)
+	}
+	if subs[0].Amount.Int64() != 769 || subs[1].Amount.Int64() != 231 {
+		t.Errorf("Expected 769 and 231, got %s and %s", subs[0].Amount, subs[1].Amount)
+	}
+	if subs[0].StrategiesAndMultipliers[0].Strategy != strategyA {
+		t.Errorf("Expected strategies sorted, got %+v", subs[0].StrategiesAndMultipliers)
+	}
+	for i, s := range subs {
+		if err := rules.CheckRewardsSubmission(s, genesis+10*week); err != nil {
+			t.Errorf("Submission %d: expected to pass, got %v", i, err)
+		}
+	}
+
+	// A single unit rounds to zero in the first submission, which the
+	// contract would reject, so only the second is kept.
+	subs, err = rules.NormalizeRewardsSubmission(eltypes.IRewardsCoordinatorTypesRewardsSubmission{
+		StrategiesAndMultipliers: strategies(strategyA),
+		Amount:                   big.NewInt(1),
+		StartTimestamp:           genesis,
+		Duration:                 11 * week,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 1 || subs[0].StartTimestamp != genesis+10*week || subs[0].Amount.Int64() != 1 {
+		t.Errorf("Expected one submission of 1 from week 10, got %+v", subs)
+	}
+}
+
+func TestNormalizeOperatorDirectedSubmission(t *testing.T) {
+	subs, err := rules.NormalizeOperatorDirectedSubmission(eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission{
+		StrategiesAndMultipliers: strategies(strategyA),
+		OperatorRewards: []eltypes.IRewardsCoordinatorTypesOperatorReward{
+			{Operator: operator2, Amount: big.NewInt(100)},
+			{Operator: operator1, Amount: big.NewInt(1)},
+			{Operator: operator2, Amount: big.NewInt(10)},
+			{Operator: operator1, Amount: nil},
+		},
+		StartTimestamp: genesis,
+		Duration:       11 * week,
+		Description:    "epoch 1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 2 || subs[1].Description != "epoch 1" {
+		t.Fatalf("Expected two submissions, got %+v", subs)
+	}
+	// operator1's single unit rounds to zero in the first submission.
+	first, second := subs[0].OperatorRewards, subs[1].OperatorRewards
+	if len(first) != 1 || first[0].Operator != operator2 || first[0].Amount.Int64() != 100 {
+		t.Errorf("Expected only operator2 with 100 first, got %+v", first)
+	}
+	if len(second) != 2 || second[0].Operator != operator1 || second[0].Amount.Int64() != 1 || second[1].Amount.Int64() != 10 {
+		t.Errorf("Expected operator1 with 1 and operator2 with 10 second, got %+v", second)
+	}
+	for i, s := range subs {
+		if err := rules.CheckOperatorDirectedSubmission(s, genesis+12*week); err != nil {
+			t.Errorf("Submission %d: expected to pass, got %v", i, err)
+		}
+	}
+}
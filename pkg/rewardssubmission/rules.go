@@ -0,0 +1,287 @@
+// Package rewardssubmission checks rewards submissions against the rules
+// RewardsCoordinator enforces, before they are sent.
+//
+// createAVSRewardsSubmission, createOperatorDirectedAVSRewardsSubmission and
+// createOperatorDirectedOperatorSetRewardsSubmission share their timing
+// rules: start and duration are multiples of CALCULATION_INTERVAL_SECONDS,
+// the duration is at most MAX_REWARDS_DURATION and the start is no earlier
+// than MAX_RETROACTIVE_LENGTH ago nor GENESIS_REWARDS_TIMESTAMP. Strategies
+// are in strictly ascending order and whitelisted for deposit. Rewards
+// submissions start at most MAX_FUTURE_LENGTH ahead; operator-directed ones
+// have ended, with non-zero operator rewards in ascending operator order.
+//
+// A Validator reads the constants from the contract and reports every rule a
+// submission breaks, and Rules normalizes submissions into ones that pass:
+//
+//	v, err := rewardssubmission.NewValidator(ctx, contracts.RewardsCoordinator, contracts.StrategyManager)
+//	subs, err := v.Rules.NormalizeRewardsSubmission(sub)
+//	for _, s := range subs {
+//		if err := v.ValidateRewardsSubmission(ctx, s, head.Time); err != nil {
+//			return err
+//		}
+//	}
+//	tx, err := contracts.RewardsCoordinator.CreateAVSRewardsSubmission(opts, subs)
+//
+// The contract records each submission under keccak256(abi.encode(avs,
+// nonce, submission)), which RewardsSubmissionHash and
+// OperatorDirectedSubmissionHash compute. A Submitter checks those records
+// before sending, so a batch retried after a timeout is sent only once:
+//
+//	b := &rewardssubmission.Batch{Kind: rewardssubmission.AVSRewards, AVS: avs, Rewards: subs}
+//	receipt, err := submitter.Submit(ctx, b)
+//	// On timeout, retry with the same b.
+//	receipt, err = submitter.Submit(ctx, b)
+//	if errors.Is(err, rewardssubmission.ErrAlreadySubmitted) { ... }
+package rewardssubmission
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Errors for the rules of RewardsCoordinator, named after the contract error
+// each mirrors.
+var (
+	// ErrNoStrategies mirrors InputArrayLengthZero for strategies.
+	ErrNoStrategies = errors.New("rewardssubmission: no strategies")
+	// ErrDurationExceedsMax mirrors DurationExceedsMax.
+	ErrDurationExceedsMax = errors.New("rewardssubmission: duration exceeds MAX_REWARDS_DURATION")
+	// ErrInvalidDurationRemainder mirrors InvalidDurationRemainder.
+	ErrInvalidDurationRemainder = errors.New("rewardssubmission: duration not a multiple of CALCULATION_INTERVAL_SECONDS")
+	// ErrDurationIsZero mirrors DurationIsZero.
+	ErrDurationIsZero = errors.New("rewardssubmission: duration is zero")
+	// ErrInvalidStartTimestampRemainder mirrors
+	// InvalidStartTimestampRemainder.
+	ErrInvalidStartTimestampRemainder = errors.New("rewardssubmission: start not a multiple of CALCULATION_INTERVAL_SECONDS")
+	// ErrStartTimestampTooFarInPast mirrors StartTimestampTooFarInPast.
+	ErrStartTimestampTooFarInPast = errors.New("rewardssubmission: start before MAX_RETROACTIVE_LENGTH ago or GENESIS_REWARDS_TIMESTAMP")
+	// ErrBeforeMaxRetroactiveLength mirrors the underflow of
+	// block.timestamp - MAX_RETROACTIVE_LENGTH, which reverts.
+	ErrBeforeMaxRetroactiveLength = errors.New("rewardssubmission: timestamp before MAX_RETROACTIVE_LENGTH")
+	// ErrStartTimestampTooFarInFuture mirrors StartTimestampTooFarInFuture.
+	ErrStartTimestampTooFarInFuture = errors.New("rewardssubmission: start after MAX_FUTURE_LENGTH ahead")
+	// ErrStrategiesNotInAscendingOrder mirrors StrategiesNotInAscendingOrder.
+	ErrStrategiesNotInAscendingOrder = errors.New("rewardssubmission: strategies not in ascending order")
+	// ErrStrategyNotWhitelisted mirrors StrategyNotWhitelisted.
+	ErrStrategyNotWhitelisted = errors.New("rewardssubmission: strategy not whitelisted for deposit")
+	// ErrAmountIsZero mirrors AmountIsZero.
+	ErrAmountIsZero = errors.New("rewardssubmission: amount is zero")
+	// ErrAmountExceedsMax mirrors AmountExceedsMax.
+	ErrAmountExceedsMax = errors.New("rewardssubmission: amount exceeds MAX_REWARDS_AMOUNT")
+	// ErrNoOperatorRewards mirrors InputArrayLengthZero for operator
+	// rewards.
+	ErrNoOperatorRewards = errors.New("rewardssubmission: no operator rewards")
+	// ErrSubmissionNotRetroactive mirrors SubmissionNotRetroactive.
+	ErrSubmissionNotRetroactive = errors.New("rewardssubmission: operator-directed submission has not ended")
+	// ErrOperatorIsZero mirrors InvalidAddressZero.
+	ErrOperatorIsZero = errors.New("rewardssubmission: operator is the zero address")
+	// ErrOperatorsNotInAscendingOrder mirrors OperatorsNotInAscendingOrder.
+	ErrOperatorsNotInAscendingOrder = errors.New("rewardssubmission: operators not in ascending order")
+)
+
+// MaxRewardsAmount is RewardsCoordinatorStorage.MAX_REWARDS_AMOUNT, the most
+// a submission pays in total.
+var MaxRewardsAmount = new(big.Int).Sub(new(big.Int).Exp(big.NewInt(10), big.NewInt(38), nil), big.NewInt(1))
+
+// Rules are the RewardsCoordinator constants submissions are checked
+// against, in seconds.
+type Rules struct {
+	CalculationInterval     uint32
+	MaxRewardsDuration      uint32
+	MaxRetroactiveLength    uint32
+	MaxFutureLength         uint32
+	GenesisRewardsTimestamp uint32
+}
+
+// LoadRules reads the Rules of rc.
+func LoadRules(ctx context.Context, rc rewardscoordinator.RewardsCoordinatorReader) (Rules, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	var (
+		r   Rules
+		err error
+	)
+	if r.CalculationInterval, err = rc.CALCULATIONINTERVALSECONDS(opts); err != nil {
+		return Rules{}, fmt.Errorf("rewardssubmission: failed to read CALCULATION_INTERVAL_SECONDS: %w", err)
+	}
+	if r.MaxRewardsDuration, err = rc.MAXREWARDSDURATION(opts); err != nil {
+		return Rules{}, fmt.Errorf("rewardssubmission: failed to read MAX_REWARDS_DURATION: %w", err)
+	}
+	if r.MaxRetroactiveLength, err = rc.MAXRETROACTIVELENGTH(opts); err != nil {
+		return Rules{}, fmt.Errorf("rewardssubmission: failed to read MAX_RETROACTIVE_LENGTH: %w", err)
+	}
+	if r.MaxFutureLength, err = rc.MAXFUTURELENGTH(opts); err != nil {
+		return Rules{}, fmt.Errorf("rewardssubmission: failed to read MAX_FUTURE_LENGTH: %w", err)
+	}
+	if r.GenesisRewardsTimestamp, err = rc.GENESISREWARDSTIMESTAMP(opts); err != nil {
+		return Rules{}, fmt.Errorf("rewardssubmission: failed to read GENESIS_REWARDS_TIMESTAMP: %w", err)
+	}
+	if r.CalculationInterval == 0 {
+		return Rules{}, errors.New("rewardssubmission: CALCULATION_INTERVAL_SECONDS is zero")
+	}
+	return r, nil
+}
+
+// CheckRewardsSubmission checks s as _validateRewardsSubmission would in a
+// block at timestamp now, except for the strategy whitelist. It returns every
+// rule s breaks, joined.
+func (r Rules) CheckRewardsSubmission(s eltypes.IRewardsCoordinatorTypesRewardsSubmission, now uint64) error {
+	errs := r.checkCommon(s.StrategiesAndMultipliers, s.StartTimestamp, s.Duration, now)
+	if s.Amount == nil || s.Amount.Sign() <= 0 {
+		errs = append(errs, ErrAmountIsZero)
+	} else if s.Amount.Cmp(MaxRewardsAmount) > 0 {
+		errs = append(errs, fmt.Errorf("%w: %s", ErrAmountExceedsMax, s.Amount))
+	}
+	if uint64(s.StartTimestamp) > now+uint64(r.MaxFutureLength) {
+		errs = append(errs, fmt.Errorf("%w: start %d, latest %d", ErrStartTimestampTooFarInFuture, s.StartTimestamp, now+uint64(r.MaxFutureLength)))
+	}
+	return errors.Join(errs...)
+}
+
+// CheckOperatorDirectedSubmission checks s as
+// _validateOperatorDirectedRewardsSubmission would in a block at timestamp
+// now, except for the strategy whitelist. It returns every rule s breaks,
+// joined.
+func (r Rules) CheckOperatorDirectedSubmission(s eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission, now uint64) error {
+	errs := r.checkCommon(s.StrategiesAndMultipliers, s.StartTimestamp, s.Duration, now)
+	if len(s.OperatorRewards) == 0 {
+		errs = append(errs, ErrNoOperatorRewards)
+	}
+	if end := uint64(s.StartTimestamp) + uint64(s.Duration); end >= now {
+		errs = append(errs, fmt.Errorf("%w: ends at %d, now %d", ErrSubmissionNotRetroactive, end, now))
+	}
+	total := new(big.Int)
+	var prev common.Address
+	for i, reward := range s.OperatorRewards {
+		switch {
+		case reward.Operator == (common.Address{}):
+			errs = append(errs, fmt.Errorf("%w: reward %d", ErrOperatorIsZero, i))
+		case bytes.Compare(prev[:], reward.Operator[:]) >= 0:
+			errs = append(errs, fmt.Errorf("%w: %s after %s", ErrOperatorsNotInAscendingOrder, reward.Operator.Hex(), prev.Hex()))
+		}
+		if reward.Amount == nil || reward.Amount.Sign() <= 0 {
+			errs = append(errs, fmt.Errorf("%w: reward %d to %s", ErrAmountIsZero, i, reward.Operator.Hex()))
+		} else {
+			total.Add(total, reward.Amount)
+		}
+		prev = reward.Operator
+	}
+	if total.Cmp(MaxRewardsAmount) > 0 {
+		errs = append(errs, fmt.Errorf("%w: total %s", ErrAmountExceedsMax, total))
+	}
+	return errors.Join(errs...)
+}
+
+// checkCommon mirrors _validateCommonRewardsSubmission but for the
+// whitelist.
+func (r Rules) checkCommon(strategies []eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier, start, duration uint32, now uint64) []error {
+	var errs []error
+	if len(strategies) == 0 {
+		errs = append(errs, ErrNoStrategies)
+	}
+	if duration > r.MaxRewardsDuration {
+		errs = append(errs, fmt.Errorf("%w: %d > %d", ErrDurationExceedsMax, duration, r.MaxRewardsDuration))
+	}
+	if duration%r.CalculationInterval != 0 {
+		errs = append(errs, fmt.Errorf("%w: %d", ErrInvalidDurationRemainder, duration))
+	}
+	if duration == 0 {
+		errs = append(errs, ErrDurationIsZero)
+	}
+	if start%r.CalculationInterval != 0 {
+		errs = append(errs, fmt.Errorf("%w: %d", ErrInvalidStartTimestampRemainder, start))
+	}
+	if earliest, err := r.earliestStart(now); err != nil {
+		errs = append(errs, err)
+	} else if uint64(start) < earliest {
+		errs = append(errs, fmt.Errorf("%w: start %d, earliest %d", ErrStartTimestampTooFarInPast, start, earliest))
+	}
+	var prev common.Address
+	for _, s := range strategies {
+		if bytes.Compare(prev[:], s.Strategy[:]) >= 0 {
+			errs = append(errs, fmt.Errorf("%w: %s after %s", ErrStrategiesNotInAscendingOrder, s.Strategy.Hex(), prev.Hex()))
+		}
+		prev = s.Strategy
+	}
+	return errs
+}
+
+// earliestStart is the earliest start accepted at timestamp now. Before
+// MAX_RETROACTIVE_LENGTH has passed the contract reverts on the underflow,
+// so no start is accepted.
+func (r Rules) earliestStart(now uint64) (uint64, error) {
+	if now < uint64(r.MaxRetroactiveLength) {
+		return 0, fmt.Errorf("%w: now %d, MAX_RETROACTIVE_LENGTH %d", ErrBeforeMaxRetroactiveLength, now, r.MaxRetroactiveLength)
+	}
+	return max(uint64(r.GenesisRewardsTimestamp), now-uint64(r.MaxRetroactiveLength)), nil
+}
+
+// Validator checks submissions against the Rules of a RewardsCoordinator
+// and the deposit whitelist of its StrategyManager.
+type Validator struct {
+	Rules Rules
+
+	strategyManager        strategymanager.StrategyManagerReader
+	beaconChainETHStrategy common.Address
+}
+
+// NewValidator reads the Rules of rc. If sm is nil, strategies are not
+// checked against the whitelist.
+func NewValidator(ctx context.Context, rc rewardscoordinator.RewardsCoordinatorReader, sm strategymanager.StrategyManagerReader) (*Validator, error) {
+	rules, err := LoadRules(ctx, rc)
+	if err != nil {
+		return nil, err
+	}
+	v := &Validator{Rules: rules, strategyManager: sm}
+	if sm != nil {
+		if v.beaconChainETHStrategy, err = rc.BeaconChainETHStrategy(&bind.CallOpts{Context: ctx}); err != nil {
+			return nil, fmt.Errorf("rewardssubmission: failed to read beaconChainETHStrategy: %w", err)
+		}
+	}
+	return v, nil
+}
+
+// ValidateRewardsSubmission checks s, for createAVSRewardsSubmission, as in
+// a block at timestamp now. It returns every rule s breaks, joined.
+func (v *Validator) ValidateRewardsSubmission(ctx context.Context, s eltypes.IRewardsCoordinatorTypesRewardsSubmission, now uint64) error {
+	err := v.Rules.CheckRewardsSubmission(s, now)
+	return v.withWhitelist(ctx, err, s.StrategiesAndMultipliers)
+}
+
+// ValidateOperatorDirectedSubmission checks s, for
+// createOperatorDirectedAVSRewardsSubmission and
+// createOperatorDirectedOperatorSetRewardsSubmission, as in a block at
+// timestamp now. It returns every rule s breaks, joined.
+func (v *Validator) ValidateOperatorDirectedSubmission(ctx context.Context, s eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission, now uint64) error {
+	err := v.Rules.CheckOperatorDirectedSubmission(s, now)
+	return v.withWhitelist(ctx, err, s.StrategiesAndMultipliers)
+}
+
+func (v *Validator) withWhitelist(ctx context.Context, err error, strategies []eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier) error {
+	if v.strategyManager == nil {
+		return err
+	}
+	errs := []error{err}
+	opts := &bind.CallOpts{Context: ctx}
+	for _, s := range strategies {
+		if s.Strategy == v.beaconChainETHStrategy {
+			continue
+		}
+		ok, err := v.strategyManager.StrategyIsWhitelistedForDeposit(opts, s.Strategy)
+		if err != nil {
+			return fmt.Errorf("rewardssubmission: failed to read whitelist of %s: %w", s.Strategy.Hex(), err)
+		}
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrStrategyNotWhitelisted, s.Strategy.Hex()))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,92 @@
+//go:build !go1.23 || simulated
+
+package rewardssubmission
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	backingeigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TestNormalizedSubmissionAccepted checks a submission that is too long and
+// misaligned fails validation, and that its normalized form passes and is
+// accepted by createAVSRewardsSubmission.
+func TestNormalizedSubmissionAccepted(t *testing.T) {
+	h, err := harness.New(harness.Config{})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	v, err := NewValidator(ctx, h.RewardsCoordinator, h.StrategyManager)
+	check(err)
+	if v.Rules.CalculationInterval != harness.DefaultCalculationIntervalSeconds || v.Rules.MaxRewardsDuration != harness.DefaultMaxRewardsDuration {
+		t.Fatalf("Unexpected rules %+v", v.Rules)
+	}
+
+	strategy, err := h.DeployStrategy(ctx)
+	check(err)
+	token, err := backingeigen.NewBackingEigen(strategy.Token, h.Client)
+	check(err)
+	amount := big.NewInt(1e18)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Mint(opts, h.Owner.From, amount)
+	})
+	check(err)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Approve(opts, h.Deployment.Core.RewardsCoordinator.Proxy, amount)
+	})
+	check(err)
+
+	head, err := h.Client.HeaderByNumber(ctx, nil)
+	check(err)
+	sub := eltypes.IRewardsCoordinatorTypesRewardsSubmission{
+		StrategiesAndMultipliers: []eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier{
+			{Strategy: strategy.Address, Multiplier: big.NewInt(1e18)},
+		},
+		Token:          strategy.Token,
+		Amount:         amount,
+		StartTimestamp: uint32(head.Time) - 17*harness.DefaultCalculationIntervalSeconds/2,
+		Duration:       harness.DefaultMaxRewardsDuration,
+	}
+	if err := v.ValidateRewardsSubmission(ctx, sub, head.Time); !errors.Is(err, ErrInvalidStartTimestampRemainder) {
+		t.Errorf("Expected ErrInvalidStartTimestampRemainder, got %v", err)
+	}
+
+	subs, err := v.Rules.NormalizeRewardsSubmission(sub)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 2 {
+		t.Fatalf("Expected the aligned submission split in two, got %+v", subs)
+	}
+	for i, s := range subs {
+		if err := v.ValidateRewardsSubmission(ctx, s, head.Time); err != nil {
+			t.Errorf("Submission %d: expected to pass, got %v", i, err)
+		}
+	}
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.RewardsCoordinator.CreateAVSRewardsSubmission(opts, subs)
+	})
+	check(err)
+	nonce, err := h.RewardsCoordinator.SubmissionNonce(&bind.CallOpts{Context: ctx}, h.Owner.From)
+	check(err)
+	if nonce.Int64() != 2 {
+		t.Errorf("Expected two submissions, got nonce %s", nonce)
+	}
+}
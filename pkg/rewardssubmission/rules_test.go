@@ -0,0 +1,192 @@
+package rewardssubmission
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	day  = 86400
+	week = 7 * day
+	// now is twenty weeks and three days after genesis.
+	now = genesis + 20*week + 3*day
+	// genesis is a multiple of week, as on mainnet.
+	genesis = 2818 * week
+)
+
+var (
+	strategyA = common.HexToAddress("0x5a")
+	strategyB = common.HexToAddress("0x5b")
+	beacon    = common.HexToAddress("0xbeac0eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeebeac0")
+	operator1 = common.HexToAddress("0x01")
+	operator2 = common.HexToAddress("0x02")
+)
+
+var rules = Rules{
+	CalculationInterval:     week,
+	MaxRewardsDuration:      10 * week,
+	MaxRetroactiveLength:    90 * day,
+	MaxFutureLength:         30 * day,
+	GenesisRewardsTimestamp: genesis,
+}
+
+func strategies(addrs ...common.Address) []eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier {
+	var out []eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier
+	for _, a := range addrs {
+		out = append(out, eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier{Strategy: a, Multiplier: big.NewInt(1e18)})
+	}
+	return out
+}
+
+func rewards(amounts ...int64) []eltypes.IRewardsCoordinatorTypesOperatorReward {
+	var out []eltypes.IRewardsCoordinatorTypesOperatorReward
+	for i, a := range amounts {
+		out = append(out, eltypes.IRewardsCoordinatorTypesOperatorReward{Operator: common.BigToAddress(big.NewInt(int64(i + 1))), Amount: big.NewInt(a)})
+	}
+	return out
+}
+
+func TestCheckRewardsSubmission(t *testing.T) {
+	valid := func() eltypes.IRewardsCoordinatorTypesRewardsSubmission {
+		return eltypes.IRewardsCoordinatorTypesRewardsSubmission{
+			StrategiesAndMultipliers: strategies(strategyA, strategyB),
+			Amount:                   big.NewInt(1e18),
+			StartTimestamp:           genesis + 19*week,
+			Duration:                 2 * week,
+		}
+	}
+	if err := rules.CheckRewardsSubmission(valid(), now); err != nil {
+		t.Fatalf("Expected a valid submission, got %v", err)
+	}
+
+	for name, tc := range map[string]struct {
+		edit func(*eltypes.IRewardsCoordinatorTypesRewardsSubmission)
+		want []error
+	}{
+		"no strategies": {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.StrategiesAndMultipliers = nil }, []error{ErrNoStrategies}},
+		"unsorted": {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) {
+			s.StrategiesAndMultipliers = strategies(strategyB, strategyA)
+		}, []error{ErrStrategiesNotInAscendingOrder}},
+		"duplicate strategy": {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) {
+			s.StrategiesAndMultipliers = strategies(strategyA, strategyA)
+		}, []error{ErrStrategiesNotInAscendingOrder}},
+		"zero duration":   {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.Duration = 0 }, []error{ErrDurationIsZero}},
+		"too long":        {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.Duration = 11 * week }, []error{ErrDurationExceedsMax}},
+		"odd duration":    {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.Duration = week + day }, []error{ErrInvalidDurationRemainder}},
+		"odd start":       {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.StartTimestamp += day }, []error{ErrInvalidStartTimestampRemainder}},
+		"before genesis":  {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.StartTimestamp = genesis - week }, []error{ErrStartTimestampTooFarInPast}},
+		"too retroactive": {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.StartTimestamp = genesis + 7*week }, []error{ErrStartTimestampTooFarInPast}},
+		"too far ahead":   {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.StartTimestamp = genesis + 25*week }, []error{ErrStartTimestampTooFarInFuture}},
+		"zero amount":     {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) { s.Amount = nil }, []error{ErrAmountIsZero}},
+		"huge amount": {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) {
+			s.Amount = new(big.Int).Add(MaxRewardsAmount, big.NewInt(1))
+		}, []error{ErrAmountExceedsMax}},
+		"everything": {func(s *eltypes.IRewardsCoordinatorTypesRewardsSubmission) {
+			s.StrategiesAndMultipliers, s.Duration, s.Amount = nil, 0, nil
+		}, []error{ErrNoStrategies, ErrDurationIsZero, ErrAmountIsZero}},
+	} {
+		s := valid()
+		tc.edit(&s)
+		err := rules.CheckRewardsSubmission(s, now)
+		if err == nil {
+			t.Errorf("%s: expected %v, got nil", name, tc.want)
+		}
+		for _, want := range tc.want {
+			if !errors.Is(err, want) {
+				t.Errorf("%s: expected %v, got %v", name, want, err)
+			}
+		}
+	}
+
+	// The contract underflows before MAX_RETROACTIVE_LENGTH has passed.
+	if err := rules.CheckRewardsSubmission(valid(), 90*day-1); !errors.Is(err, ErrBeforeMaxRetroactiveLength) {
+		t.Errorf("Expected ErrBeforeMaxRetroactiveLength, got %v", err)
+	}
+}
+
+func TestCheckOperatorDirectedSubmission(t *testing.T) {
+	valid := func() eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission {
+		return eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission{
+			StrategiesAndMultipliers: strategies(strategyA),
+			OperatorRewards:          rewards(5, 7),
+			StartTimestamp:           genesis + 17*week,
+			Duration:                 2 * week,
+		}
+	}
+	if err := rules.CheckOperatorDirectedSubmission(valid(), now); err != nil {
+		t.Fatalf("Expected a valid submission, got %v", err)
+	}
+
+	for name, tc := range map[string]struct {
+		edit func(*eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission)
+		want error
+	}{
+		"no rewards": {func(s *eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) { s.OperatorRewards = nil }, ErrNoOperatorRewards},
+		"not ended":  {func(s *eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) { s.Duration = 4 * week }, ErrSubmissionNotRetroactive},
+		"zero operator": {func(s *eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) {
+			s.OperatorRewards[0].Operator = common.Address{}
+		}, ErrOperatorIsZero},
+		"zero reward": {func(s *eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) {
+			s.OperatorRewards[1].Amount = new(big.Int)
+		}, ErrAmountIsZero},
+		"unsorted": {func(s *eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) {
+			s.OperatorRewards[1].Operator = operator1
+		}, ErrOperatorsNotInAscendingOrder},
+		"common": {func(s *eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) { s.StartTimestamp++ }, ErrInvalidStartTimestampRemainder},
+		"total over max": {func(s *eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission) {
+			s.OperatorRewards[0].Amount = MaxRewardsAmount
+		}, ErrAmountExceedsMax},
+	} {
+		s := valid()
+		tc.edit(&s)
+		if err := rules.CheckOperatorDirectedSubmission(s, now); !errors.Is(err, tc.want) {
+			t.Errorf("%s: expected %v, got %v", name, tc.want, err)
+		}
+	}
+}
+
+func TestValidator(t *testing.T) {
+	ctx := context.Background()
+	rc := rewardscoordinator.NewFakeRewardsCoordinator(common.HexToAddress("0x4c"))
+	rc.StubCALCULATIONINTERVALSECONDS(rules.CalculationInterval)
+	rc.StubMAXREWARDSDURATION(rules.MaxRewardsDuration)
+	rc.StubMAXRETROACTIVELENGTH(rules.MaxRetroactiveLength)
+	rc.StubMAXFUTURELENGTH(rules.MaxFutureLength)
+	rc.StubGENESISREWARDSTIMESTAMP(rules.GenesisRewardsTimestamp)
+	rc.StubBeaconChainETHStrategy(beacon)
+	sm := strategymanager.NewFakeStrategyManager(common.HexToAddress("0x5e"))
+	sm.StubStrategyIsWhitelistedForDeposit(strategyA, true)
+
+	v, err := NewValidator(ctx, rc, sm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Rules != rules {
+		t.Fatalf("Expected rules %+v, got %+v", rules, v.Rules)
+	}
+	s := eltypes.IRewardsCoordinatorTypesRewardsSubmission{
+		StrategiesAndMultipliers: strategies(strategyA, beacon),
+		Amount:                   big.NewInt(1),
+		StartTimestamp:           genesis + 20*week,
+		Duration:                 week,
+	}
+	if err := v.ValidateRewardsSubmission(ctx, s, now); err != nil {
+		t.Errorf("Expected whitelisted and beacon chain strategies to pass, got %v", err)
+	}
+	s.StrategiesAndMultipliers = strategies(strategyA, strategyB)
+	if err := v.ValidateRewardsSubmission(ctx, s, now); !errors.Is(err, ErrStrategyNotWhitelisted) {
+		t.Errorf("Expected ErrStrategyNotWhitelisted, got %v", err)
+	}
+
+	rc.StubCALCULATIONINTERVALSECONDS(0)
+	if _, err := NewValidator(ctx, rc, nil); err == nil {
+		t.Error("Expected an error for a zero calculation interval")
+	}
+}
@@ -0,0 +1,260 @@
+package rewardssubmission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrAlreadySubmitted is returned by Submit when the batch is already
+	// recorded on chain.
+	ErrAlreadySubmitted = errors.New("rewardssubmission: already submitted")
+	// ErrPartiallySubmitted is returned by Submit when only some of the
+	// batch's hashes are recorded, which a single transaction cannot do: the
+	// batch was changed after it was first sent.
+	ErrPartiallySubmitted = errors.New("rewardssubmission: batch partially recorded")
+	// ErrOperatorSetAVS is returned when the operator set of an
+	// OperatorDirectedOperatorSetRewards batch belongs to another AVS than
+	// the batch, whose hashes would then be looked up under the wrong AVS.
+	ErrOperatorSetAVS = errors.New("rewardssubmission: operator set of another AVS")
+)
+
+// Kind is the create method a batch is sent with.
+type Kind int
+
+const (
+	// AVSRewards is createAVSRewardsSubmission.
+	AVSRewards Kind = iota
+	// RewardsForAll is createRewardsForAllSubmission.
+	RewardsForAll
+	// RewardsForAllEarners is createRewardsForAllEarners.
+	RewardsForAllEarners
+	// OperatorDirectedAVSRewards is
+	// createOperatorDirectedAVSRewardsSubmission.
+	OperatorDirectedAVSRewards
+	// OperatorDirectedOperatorSetRewards is
+	// createOperatorDirectedOperatorSetRewardsSubmission.
+	OperatorDirectedOperatorSetRewards
+)
+
+func (k Kind) String() string {
+	switch k {
+	case AVSRewards:
+		return "createAVSRewardsSubmission"
+	case RewardsForAll:
+		return "createRewardsForAllSubmission"
+	case RewardsForAllEarners:
+		return "createRewardsForAllEarners"
+	case OperatorDirectedAVSRewards:
+		return "createOperatorDirectedAVSRewardsSubmission"
+	case OperatorDirectedOperatorSetRewards:
+		return "createOperatorDirectedOperatorSetRewardsSubmission"
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+func (k Kind) operatorDirected() bool {
+	return k == OperatorDirectedAVSRewards || k == OperatorDirectedOperatorSetRewards
+}
+
+// Batch is one create call and the nonce it was first sent with. Keep it
+// across retries: its hashes tell whether an earlier attempt landed.
+type Batch struct {
+	Kind Kind
+	// AVS keys the submissions: the sender for the rewards kinds, the AVS
+	// submitted for by the operator-directed ones.
+	AVS common.Address
+	// OperatorSet is the set of OperatorDirectedOperatorSetRewards; its AVS
+	// must be AVS.
+	OperatorSet eltypes.OperatorSet
+	// Nonce is submissionNonce of AVS before the batch; the i-th submission
+	// takes Nonce+i.
+	Nonce *big.Int
+	// Rewards are the submissions of the rewards kinds.
+	Rewards []eltypes.IRewardsCoordinatorTypesRewardsSubmission
+	// OperatorDirected are the submissions of the operator-directed kinds.
+	OperatorDirected []eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission
+}
+
+// Hashes returns the hash each submission of b is recorded under.
+func (b *Batch) Hashes() ([]common.Hash, error) {
+	return b.hashesAt(b.Nonce)
+}
+
+// hashesAt returns the hashes of b had it been sent at first.
+func (b *Batch) hashesAt(first *big.Int) ([]common.Hash, error) {
+	var hashes []common.Hash
+	nonce := func(i int) *big.Int {
+		n := new(big.Int).SetInt64(int64(i))
+		if first != nil {
+			n.Add(n, first)
+		}
+		return n
+	}
+	if b.Kind.operatorDirected() {
+		for i, s := range b.OperatorDirected {
+			h, err := OperatorDirectedSubmissionHash(b.AVS, nonce(i), s)
+			if err != nil {
+				return nil, err
+			}
+			hashes = append(hashes, h)
+		}
+		return hashes, nil
+	}
+	for i, s := range b.Rewards {
+		h, err := RewardsSubmissionHash(b.AVS, nonce(i), s)
+		if err != nil {
+			return nil, err
+		}
+		hashes = append(hashes, h)
+	}
+	return hashes, nil
+}
+
+// RewardsCoordinator is the part of the RewardsCoordinator binding a
+// Submitter uses.
+type RewardsCoordinator interface {
+	rewardscoordinator.RewardsCoordinatorReader
+	rewardscoordinator.RewardsCoordinatorWriter
+}
+
+// Submitter sends batches at most once.
+type Submitter struct {
+	rc     RewardsCoordinator
+	sender txmgr.Sender
+}
+
+// NewSubmitter returns a Submitter sending through sender.
+func NewSubmitter(rc RewardsCoordinator, sender txmgr.Sender) *Submitter {
+	return &Submitter{rc: rc, sender: sender}
+}
+
+// check reports whether the hashes of b are looked up where the contract
+// records them.
+func (b *Batch) check() error {
+	if b.Kind == OperatorDirectedOperatorSetRewards && b.OperatorSet.Avs != b.AVS {
+		return fmt.Errorf("%w: operator set of %s, batch of %s", ErrOperatorSetAVS, b.OperatorSet.Avs.Hex(), b.AVS.Hex())
+	}
+	return nil
+}
+
+// Prepare sets b.Nonce to the current submissionNonce of b.AVS, ahead of
+// the first attempt.
+func (s *Submitter) Prepare(ctx context.Context, b *Batch) error {
+	nonce, err := s.nonce(ctx, b)
+	if err != nil {
+		return err
+	}
+	b.Nonce = nonce
+	return nil
+}
+
+func (s *Submitter) nonce(ctx context.Context, b *Batch) (*big.Int, error) {
+	nonce, err := s.rc.SubmissionNonce(&bind.CallOpts{Context: ctx}, b.AVS)
+	if err != nil {
+		return nil, fmt.Errorf("rewardssubmission: failed to read submission nonce of %s: %w", b.AVS.Hex(), err)
+	}
+	return nonce, nil
+}
+
+// Submitted reports whether every submission of b is recorded on chain at
+// b.Nonce.
+func (s *Submitter) Submitted(ctx context.Context, b *Batch) (bool, error) {
+	if err := b.check(); err != nil {
+		return false, err
+	}
+	return s.submittedAt(&bind.CallOpts{Context: ctx}, b, b.Nonce)
+}
+
+func (s *Submitter) submittedAt(opts *bind.CallOpts, b *Batch, nonce *big.Int) (bool, error) {
+	hashes, err := b.hashesAt(nonce)
+	if err != nil {
+		return false, err
+	}
+	recorded := 0
+	for _, h := range hashes {
+		ok, err := s.isHash(opts, b, h)
+		if err != nil {
+			return false, fmt.Errorf("rewardssubmission: failed to read %s hash %s: %w", b.Kind, h.Hex(), err)
+		}
+		if ok {
+			recorded++
+		}
+	}
+	if recorded > 0 && recorded < len(hashes) {
+		return false, fmt.Errorf("%w: %d of %d", ErrPartiallySubmitted, recorded, len(hashes))
+	}
+	return len(hashes) > 0 && recorded == len(hashes), nil
+}
+
+// Submit sends b unless an earlier attempt landed, in which case it returns
+// ErrAlreadySubmitted. The contract hashes with the nonce current when a
+// transaction executes, so an attempt sent at b.Nonce may have landed at any
+// later nonce once others submitted first: Submit checks every nonce from
+// b.Nonce up to the current one, updating b.Nonce to the one it landed at,
+// or to the current one before sending again. Submit cannot see a
+// transaction that is still pending; retry once the previous one is mined
+// or dropped.
+func (s *Submitter) Submit(ctx context.Context, b *Batch) (*types.Receipt, error) {
+	if err := b.check(); err != nil {
+		return nil, err
+	}
+	current, err := s.nonce(ctx, b)
+	if err != nil {
+		return nil, err
+	}
+	if b.Nonce != nil {
+		opts := &bind.CallOpts{Context: ctx}
+		for n := new(big.Int).Set(b.Nonce); n.Cmp(current) < 0; n.Add(n, big.NewInt(1)) {
+			done, err := s.submittedAt(opts, b, n)
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				b.Nonce = n
+				return nil, fmt.Errorf("%w: %s nonce %s", ErrAlreadySubmitted, b.AVS.Hex(), n)
+			}
+		}
+	}
+	b.Nonce = current
+	return s.sender.SendAndWait(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		switch b.Kind {
+		case AVSRewards:
+			return s.rc.CreateAVSRewardsSubmission(opts, b.Rewards)
+		case RewardsForAll:
+			return s.rc.CreateRewardsForAllSubmission(opts, b.Rewards)
+		case RewardsForAllEarners:
+			return s.rc.CreateRewardsForAllEarners(opts, b.Rewards)
+		case OperatorDirectedAVSRewards:
+			return s.rc.CreateOperatorDirectedAVSRewardsSubmission(opts, b.AVS, b.OperatorDirected)
+		case OperatorDirectedOperatorSetRewards:
+			return s.rc.CreateOperatorDirectedOperatorSetRewardsSubmission(opts, b.OperatorSet, b.OperatorDirected)
+		}
+		return nil, fmt.Errorf("rewardssubmission: unknown kind %d", int(b.Kind))
+	})
+}
+
+func (s *Submitter) isHash(opts *bind.CallOpts, b *Batch, h common.Hash) (bool, error) {
+	switch b.Kind {
+	case AVSRewards:
+		return s.rc.IsAVSRewardsSubmissionHash(opts, b.AVS, h)
+	case RewardsForAll:
+		return s.rc.IsRewardsSubmissionForAllHash(opts, b.AVS, h)
+	case RewardsForAllEarners:
+		return s.rc.IsRewardsSubmissionForAllEarnersHash(opts, b.AVS, h)
+	case OperatorDirectedAVSRewards:
+		return s.rc.IsOperatorDirectedAVSRewardsSubmissionHash(opts, b.AVS, h)
+	case OperatorDirectedOperatorSetRewards:
+		return s.rc.IsOperatorDirectedOperatorSetRewardsSubmissionHash(opts, b.AVS, h)
+	}
+	return false, fmt.Errorf("rewardssubmission: unknown kind %d", int(b.Kind))
+}
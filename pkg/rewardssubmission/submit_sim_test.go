@@ -0,0 +1,100 @@
+//go:build !go1.23 || simulated
+
+package rewardssubmission
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	backingeigen "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/BackingEigen"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+type harnessSender struct {
+	h    *harness.Harness
+	auth *bind.TransactOpts
+}
+
+func (s harnessSender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	return s.h.SendAs(ctx, s.auth, build)
+}
+
+// TestSubmitterMatchesContractHashes submits a rewards and an
+// operator-directed batch, checks the hashes the contract emits are the ones
+// computed, and that a retry is not resent.
+func TestSubmitterMatchesContractHashes(t *testing.T) {
+	h, err := harness.New(harness.Config{})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	strategy, err := h.DeployStrategy(ctx)
+	check(err)
+	token, err := backingeigen.NewBackingEigen(strategy.Token, h.Client)
+	check(err)
+	amount := big.NewInt(1e18)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Mint(opts, h.Owner.From, amount)
+	})
+	check(err)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return token.Approve(opts, h.Deployment.Core.RewardsCoordinator.Proxy, amount)
+	})
+	check(err)
+
+	head, err := h.Client.HeaderByNumber(ctx, nil)
+	check(err)
+	interval := uint32(harness.DefaultCalculationIntervalSeconds)
+	start := (uint32(head.Time)/interval - 3) * interval
+	strategies := []eltypes.IRewardsCoordinatorTypesStrategyAndMultiplier{{Strategy: strategy.Address, Multiplier: big.NewInt(1e18)}}
+	s := NewSubmitter(h.RewardsCoordinator, harnessSender{h, h.Owner})
+
+	for _, b := range []*Batch{
+		{Kind: AVSRewards, AVS: h.Owner.From, Rewards: []eltypes.IRewardsCoordinatorTypesRewardsSubmission{
+			{StrategiesAndMultipliers: strategies, Token: strategy.Token, Amount: big.NewInt(1e17), StartTimestamp: start, Duration: interval},
+			{StrategiesAndMultipliers: strategies, Token: strategy.Token, Amount: big.NewInt(2e17), StartTimestamp: start, Duration: interval},
+		}},
+		{Kind: OperatorDirectedAVSRewards, AVS: h.Owner.From, OperatorDirected: []eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission{
+			{StrategiesAndMultipliers: strategies, Token: strategy.Token, OperatorRewards: rewards(3e17), StartTimestamp: start, Duration: interval, Description: "retro"},
+		}},
+	} {
+		receipt, err := s.Submit(ctx, b)
+		check(err)
+		hashes, err := b.Hashes()
+		check(err)
+		var emitted [][32]byte
+		for _, l := range receipt.Logs {
+			if ev, err := h.RewardsCoordinator.ParseAVSRewardsSubmissionCreated(*l); err == nil {
+				emitted = append(emitted, ev.RewardsSubmissionHash)
+			} else if ev, err := h.RewardsCoordinator.ParseOperatorDirectedAVSRewardsSubmissionCreated(*l); err == nil {
+				emitted = append(emitted, ev.OperatorDirectedRewardsSubmissionHash)
+			}
+		}
+		if len(emitted) != len(hashes) {
+			t.Fatalf("%s: expected %d events, got %d", b.Kind, len(hashes), len(emitted))
+		}
+		for i := range hashes {
+			if hashes[i] != emitted[i] {
+				t.Errorf("%s: submission %d: computed %s, contract %x", b.Kind, i, hashes[i].Hex(), emitted[i])
+			}
+		}
+		if _, err := s.Submit(ctx, b); !errors.Is(err, ErrAlreadySubmitted) {
+			t.Errorf("%s: expected ErrAlreadySubmitted on retry, got %v", b.Kind, err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package rewardssubmission
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	rewardscoordinator "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/RewardsCoordinator"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/txmgr"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var avs = common.HexToAddress("0xa5")
+
+type sender struct{}
+
+func (sender) SendAndWait(ctx context.Context, build txmgr.BuildFunc) (*types.Receipt, error) {
+	tx, err := build(&bind.TransactOpts{})
+	if err != nil {
+		return nil, err
+	}
+	return &types.Receipt{TxHash: tx.Hash(), Status: types.ReceiptStatusSuccessful}, nil
+}
+
+func TestSubmissionHashes(t *testing.T) {
+	s := eltypes.IRewardsCoordinatorTypesRewardsSubmission{
+		StrategiesAndMultipliers: strategies(strategyA),
+		Token:                    common.HexToAddress("0x70"),
+		Amount:                   big.NewInt(1),
+		StartTimestamp:           genesis,
+		Duration:                 week,
+	}
+	h0, err := RewardsSubmissionHash(avs, big.NewInt(0), s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again, _ := RewardsSubmissionHash(avs, nil, s); again != h0 {
+		t.Errorf("Expected a nil nonce to hash as zero, got %s and %s", again.Hex(), h0.Hex())
+	}
+	if h1, _ := RewardsSubmissionHash(avs, big.NewInt(1), s); h1 == h0 {
+		t.Error("Expected the nonce to change the hash")
+	}
+	if other, _ := RewardsSubmissionHash(operator1, big.NewInt(0), s); other == h0 {
+		t.Error("Expected the AVS to change the hash")
+	}
+
+	od := eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission{
+		StrategiesAndMultipliers: s.StrategiesAndMultipliers,
+		Token:                    s.Token,
+		OperatorRewards:          rewards(1),
+		StartTimestamp:           s.StartTimestamp,
+		Duration:                 s.Duration,
+	}
+	h, err := OperatorDirectedSubmissionHash(avs, big.NewInt(0), od)
+	if err != nil {
+		t.Fatal(err)
+	}
+	od.Description = "epoch 1"
+	if described, _ := OperatorDirectedSubmissionHash(avs, big.NewInt(0), od); described == h {
+		t.Error("Expected the description to change the hash")
+	}
+}
+
+func TestSubmitOnce(t *testing.T) {
+	ctx := context.Background()
+	rc := rewardscoordinator.NewFakeRewardsCoordinator(common.HexToAddress("0x4c"))
+	rc.StubSubmissionNonce(avs, big.NewInt(4))
+	s := NewSubmitter(rc, sender{})
+	b := &Batch{
+		Kind: OperatorDirectedAVSRewards,
+		AVS:  avs,
+		OperatorDirected: []eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission{
+			{StrategiesAndMultipliers: strategies(strategyA), OperatorRewards: rewards(1), StartTimestamp: genesis, Duration: week},
+			{StrategiesAndMultipliers: strategies(strategyA), OperatorRewards: rewards(2), StartTimestamp: genesis, Duration: week},
+		},
+	}
+
+	if _, err := s.Submit(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	if b.Nonce.Int64() != 4 || len(rc.Calls("createOperatorDirectedAVSRewardsSubmission")) != 1 {
+		t.Fatalf("Expected one submission at nonce 4, got nonce %s and %d calls", b.Nonce, len(rc.Calls("createOperatorDirectedAVSRewardsSubmission")))
+	}
+	hashes, err := b.Hashes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want, _ := OperatorDirectedSubmissionHash(avs, big.NewInt(5), b.OperatorDirected[1]); hashes[1] != want {
+		t.Errorf("Expected the second submission hashed with nonce 5")
+	}
+
+	// The first attempt landed: nothing is resent.
+	for _, h := range hashes {
+		rc.StubIsOperatorDirectedAVSRewardsSubmissionHash(avs, h, true)
+	}
+	rc.StubSubmissionNonce(avs, big.NewInt(6))
+	if _, err := s.Submit(ctx, b); !errors.Is(err, ErrAlreadySubmitted) {
+		t.Errorf("Expected ErrAlreadySubmitted, got %v", err)
+	}
+	if n := len(rc.Calls("createOperatorDirectedAVSRewardsSubmission")); n != 1 {
+		t.Errorf("Expected no new submission, got %d calls", n)
+	}
+	rc.StubIsOperatorDirectedAVSRewardsSubmissionHash(avs, hashes[1], false)
+	if _, err := s.Submit(ctx, b); !errors.Is(err, ErrPartiallySubmitted) {
+		t.Errorf("Expected ErrPartiallySubmitted, got %v", err)
+	}
+
+	// Others took nonce 4 before the first attempt executed, so it landed
+	// at 5: nothing is resent.
+	b.Nonce = big.NewInt(4)
+	rc.StubIsOperatorDirectedAVSRewardsSubmissionHash(avs, hashes[0], false)
+	later, err := b.hashesAt(big.NewInt(5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range later {
+		rc.StubIsOperatorDirectedAVSRewardsSubmissionHash(avs, h, true)
+	}
+	rc.StubSubmissionNonce(avs, big.NewInt(7))
+	if _, err := s.Submit(ctx, b); !errors.Is(err, ErrAlreadySubmitted) {
+		t.Errorf("Expected ErrAlreadySubmitted at nonce 5, got %v", err)
+	}
+	if b.Nonce.Int64() != 5 || len(rc.Calls("createOperatorDirectedAVSRewardsSubmission")) != 1 {
+		t.Errorf("Expected nonce 5 and no new submission, got nonce %s", b.Nonce)
+	}
+
+	// The first attempt was dropped and others took nonces 4 to 6: resend
+	// at 7.
+	b.Nonce = big.NewInt(4)
+	for _, h := range later {
+		rc.StubIsOperatorDirectedAVSRewardsSubmissionHash(avs, h, false)
+	}
+	if _, err := s.Submit(ctx, b); err != nil {
+		t.Fatal(err)
+	}
+	if b.Nonce.Int64() != 7 || len(rc.Calls("createOperatorDirectedAVSRewardsSubmission")) != 2 {
+		t.Errorf("Expected a resend at nonce 7, got nonce %s", b.Nonce)
+	}
+}
+
+func TestSubmitOperatorSetOfAnotherAVS(t *testing.T) {
+	rc := rewardscoordinator.NewFakeRewardsCoordinator(common.HexToAddress("0x4c"))
+	s := NewSubmitter(rc, sender{})
+	b := &Batch{
+		Kind:        OperatorDirectedOperatorSetRewards,
+		AVS:         avs,
+		OperatorSet: eltypes.OperatorSet{Avs: operator1, Id: 1},
+		OperatorDirected: []eltypes.IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission{
+			{StrategiesAndMultipliers: strategies(strategyA), OperatorRewards: rewards(1), StartTimestamp: genesis, Duration: week},
+		},
+	}
+	if _, err := s.Submit(context.Background(), b); !errors.Is(err, ErrOperatorSetAVS) {
+		t.Errorf("Expected ErrOperatorSetAVS, got %v", err)
+	}
+	if n := len(rc.Calls("createOperatorDirectedOperatorSetRewardsSubmission")); n != 0 {
+		t.Errorf("Expected nothing sent, got %d calls", n)
+	}
+}
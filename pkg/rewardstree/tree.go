@@ -0,0 +1,234 @@
+// Package rewardstree builds the rewards distribution trees RewardsCoordinator
+// checks claims against.
+//
+// A distribution root commits to a two-level tree. Each earner has a token
+// tree whose leaves are (token, cumulativeEarnings) hashed with
+// TOKEN_LEAF_SALT; the earner tree's leaves are (earner, earnerTokenRoot)
+// hashed with EARNER_LEAF_SALT. Both levels are Merkle.merkleizeKeccak trees:
+// leaves are padded with zero hashes to a power of two and pairs are hashed
+// with keccak256. Build sorts earners and tokens by address, so the same
+// table always gives the same root:
+//
+//	tree, err := rewardstree.Build(rewardstree.Distribution{
+//		earner: {token: big.NewInt(1e18)},
+//	})
+//	tx, err := contracts.RewardsCoordinator.SubmitRoot(opts, tree.Root, endTimestamp)
+//
+// Earner and EarnerTree.Token find the leaves of a claim, and EarnerProof and
+// EarnerTree.TokenProof give the proofs RewardsMerkleClaim carries.
+package rewardstree
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Leaf salts of RewardsCoordinatorStorage.
+const (
+	EarnerLeafSalt = 0
+	TokenLeafSalt  = 1
+)
+
+var (
+	// ErrEmpty is returned by Build for a distribution without earners, or
+	// with an earner without tokens; Merkle.merkleizeKeccak has no root for
+	// no leaves.
+	ErrEmpty = errors.New("rewardstree: no leaves")
+	// ErrInvalidAmount is returned by Build for an amount that is nil or
+	// does not fit a uint256.
+	ErrInvalidAmount = errors.New("rewardstree: invalid cumulative amount")
+	// ErrInvalidProof is returned by ProcessProof for a proof whose length is
+	// not a multiple of 32 or too short for the index.
+	ErrInvalidProof = errors.New("rewardstree: invalid proof")
+)
+
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// Distribution is the cumulative amount of each token earned by each earner.
+type Distribution map[common.Address]map[common.Address]*big.Int
+
+// EarnerLeafHash returns RewardsCoordinator.calculateEarnerLeafHash of leaf.
+func EarnerLeafHash(leaf eltypes.IRewardsCoordinatorTypesEarnerTreeMerkleLeaf) common.Hash {
+	return crypto.Keccak256Hash([]byte{EarnerLeafSalt}, leaf.Earner.Bytes(), leaf.EarnerTokenRoot[:])
+}
+
+// TokenLeafHash returns RewardsCoordinator.calculateTokenLeafHash of leaf.
+func TokenLeafHash(leaf eltypes.IRewardsCoordinatorTypesTokenTreeMerkleLeaf) common.Hash {
+	return crypto.Keccak256Hash([]byte{TokenLeafSalt}, leaf.Token.Bytes(), common.BigToHash(leaf.CumulativeEarnings).Bytes())
+}
+
+// Tree is a distribution's earner tree.
+type Tree struct {
+	// Root is the distribution root to submit.
+	Root common.Hash
+	// Earners holds the token tree of each earner, in leaf order.
+	Earners []*EarnerTree
+
+	layers [][]common.Hash
+	index  map[common.Address]uint32
+}
+
+// EarnerTree is the token tree of one earner.
+type EarnerTree struct {
+	Earner common.Address
+	// Root is the earnerTokenRoot of the earner's leaf.
+	Root common.Hash
+	// Tokens holds the token leaves, in leaf order.
+	Tokens []eltypes.IRewardsCoordinatorTypesTokenTreeMerkleLeaf
+
+	layers [][]common.Hash
+	index  map[common.Address]uint32
+}
+
+// Build builds the tree of d. The amounts are copied.
+func Build(d Distribution) (*Tree, error) {
+	if len(d) == 0 {
+		return nil, ErrEmpty
+	}
+	t := &Tree{index: make(map[common.Address]uint32, len(d))}
+	for _, earner := range sortedKeys(d) {
+		e, err := buildEarner(earner, d[earner])
+		if err != nil {
+			return nil, err
+		}
+		t.index[earner] = uint32(len(t.Earners))
+		t.Earners = append(t.Earners, e)
+	}
+	leaves := make([]common.Hash, len(t.Earners))
+	for i, e := range t.Earners {
+		leaves[i] = EarnerLeafHash(e.Leaf())
+	}
+	t.layers = merkleize(leaves)
+	t.Root = t.layers[len(t.layers)-1][0]
+	return t, nil
+}
+
+func buildEarner(earner common.Address, amounts map[common.Address]*big.Int) (*EarnerTree, error) {
+	if len(amounts) == 0 {
+		return nil, fmt.Errorf("%w: earner %s has no tokens", ErrEmpty, earner.Hex())
+	}
+	e := &EarnerTree{Earner: earner, index: make(map[common.Address]uint32, len(amounts))}
+	leaves := make([]common.Hash, 0, len(amounts))
+	for _, token := range sortedKeys(amounts) {
+		amount := amounts[token]
+		if amount == nil || amount.Sign() < 0 || amount.Cmp(maxUint256) > 0 {
+			return nil, fmt.Errorf("%w: %v of token %s for earner %s", ErrInvalidAmount, amount, token.Hex(), earner.Hex())
+		}
+		leaf := eltypes.IRewardsCoordinatorTypesTokenTreeMerkleLeaf{Token: token, CumulativeEarnings: new(big.Int).Set(amount)}
+		e.index[token] = uint32(len(e.Tokens))
+		e.Tokens = append(e.Tokens, leaf)
+		leaves = append(leaves, TokenLeafHash(leaf))
+	}
+	e.layers = merkleize(leaves)
+	e.Root = e.layers[len(e.layers)-1][0]
+	return e, nil
+}
+
+// Earner returns the token tree of earner and the index of its leaf.
+func (t *Tree) Earner(earner common.Address) (*EarnerTree, uint32, bool) {
+	i, ok := t.index[earner]
+	if !ok {
+		return nil, 0, false
+	}
+	return t.Earners[i], i, true
+}
+
+// EarnerProof returns the proof of the earner leaf at index, as
+// RewardsMerkleClaim.earnerTreeProof.
+func (t *Tree) EarnerProof(index uint32) []byte {
+	return proof(t.layers, len(t.Earners), index)
+}
+
+// Leaf returns the earner's leaf in the earner tree.
+func (e *EarnerTree) Leaf() eltypes.IRewardsCoordinatorTypesEarnerTreeMerkleLeaf {
+	return eltypes.IRewardsCoordinatorTypesEarnerTreeMerkleLeaf{Earner: e.Earner, EarnerTokenRoot: e.Root}
+}
+
+// Token returns the index of token's leaf.
+func (e *EarnerTree) Token(token common.Address) (uint32, bool) {
+	i, ok := e.index[token]
+	return i, ok
+}
+
+// TokenProof returns the proof of the token leaf at index, as an entry of
+// RewardsMerkleClaim.tokenTreeProofs.
+func (e *EarnerTree) TokenProof(index uint32) []byte {
+	return proof(e.layers, len(e.Tokens), index)
+}
+
+// merkleize returns every layer of the tree over leaves, from the leaves
+// padded to a power of two up to the root, as Merkle.merkleizeKeccak.
+func merkleize(leaves []common.Hash) [][]common.Hash {
+	width := 1
+	for width < len(leaves) {
+		width *= 2
+	}
+	layer := make([]common.Hash, width)
+	copy(layer, leaves)
+	layers := [][]common.Hash{layer}
+	for len(layer) > 1 {
+		next := make([]common.Hash, len(layer)/2)
+		for i := range next {
+			next[i] = crypto.Keccak256Hash(layer[2*i][:], layer[2*i+1][:])
+		}
+		layers = append(layers, next)
+		layer = next
+	}
+	return layers
+}
+
+// proof concatenates the siblings of the leaf at index from the bottom up,
+// as Merkle.processInclusionProofKeccak reads them. It panics if index is
+// not one of the n leaves.
+func proof(layers [][]common.Hash, n int, index uint32) []byte {
+	if int(index) >= n {
+		panic(fmt.Sprintf("rewardstree: leaf index %d out of range", index))
+	}
+	out := make([]byte, 0, 32*(len(layers)-1))
+	for _, layer := range layers[:len(layers)-1] {
+		out = append(out, layer[index^1][:]...)
+		index /= 2
+	}
+	return out
+}
+
+// ProcessProof returns the root rebuilt from leaf at index and its proof, as
+// Merkle.processInclusionProofKeccak. Like the library, it returns leaf for
+// an empty proof whatever the index.
+func ProcessProof(proof []byte, leaf common.Hash, index uint32) (common.Hash, error) {
+	if len(proof) == 0 {
+		return leaf, nil
+	}
+	if len(proof)%32 != 0 {
+		return common.Hash{}, fmt.Errorf("%w: length %d is not a multiple of 32", ErrInvalidProof, len(proof))
+	}
+	node := leaf
+	for i := 0; i < len(proof); i += 32 {
+		if index%2 == 0 {
+			node = crypto.Keccak256Hash(node[:], proof[i:i+32])
+		} else {
+			node = crypto.Keccak256Hash(proof[i:i+32], node[:])
+		}
+		index /= 2
+	}
+	if index != 0 {
+		return common.Hash{}, fmt.Errorf("%w: index beyond the %d-level tree", ErrInvalidProof, len(proof)/32)
+	}
+	return node, nil
+}
+
+func sortedKeys[V any](m map[common.Address]V) []common.Address {
+	keys := make([]common.Address, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+	return keys
+}
@@ -0,0 +1,88 @@
+//go:build !go1.23 || simulated
+
+package rewardstree
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// TestTreeMatchesContract checks every leaf hash against
+// calculateEarnerLeafHash and calculateTokenLeafHash, then submits the root
+// and checks a claim of every leaf with checkClaim.
+func TestTreeMatchesContract(t *testing.T) {
+	h, err := harness.New(harness.Config{ActivationDelay: 60})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+	call := &bind.CallOpts{Context: ctx}
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	d := testDistribution()
+	// Large amounts use the full width of the uint256 encoding.
+	d[earner2][tokenB] = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	for i := 0; i < 5; i++ {
+		d[common.BigToAddress(big.NewInt(int64(0x100+i)))] = map[common.Address]*big.Int{tokenA: big.NewInt(int64(i + 1))}
+	}
+	tree, err := Build(d)
+	check(err)
+
+	for _, e := range tree.Earners {
+		want, err := h.RewardsCoordinator.CalculateEarnerLeafHash(call, e.Leaf())
+		check(err)
+		if got := EarnerLeafHash(e.Leaf()); got != want {
+			t.Errorf("Earner %s: expected leaf hash %x, got %s", e.Earner.Hex(), want, got.Hex())
+		}
+		for _, leaf := range e.Tokens {
+			want, err := h.RewardsCoordinator.CalculateTokenLeafHash(call, leaf)
+			check(err)
+			if got := TokenLeafHash(leaf); got != want {
+				t.Errorf("Earner %s token %s: expected leaf hash %x, got %s", e.Earner.Hex(), leaf.Token.Hex(), want, got.Hex())
+			}
+		}
+	}
+
+	head, err := h.Client.HeaderByNumber(ctx, nil)
+	check(err)
+	_, err = h.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.RewardsCoordinator.SubmitRoot(opts, tree.Root, uint32(head.Time-1))
+	})
+	check(err)
+	// The simulated backend adds the adjustment as seconds.
+	check(h.Backend.AdjustTime(60))
+	h.Backend.Commit()
+	index, err := h.RewardsCoordinator.GetRootIndexFromHash(call, tree.Root)
+	check(err)
+
+	for i, e := range tree.Earners {
+		claim := eltypes.IRewardsCoordinatorTypesRewardsMerkleClaim{
+			RootIndex:       index,
+			EarnerIndex:     uint32(i),
+			EarnerTreeProof: tree.EarnerProof(uint32(i)),
+			EarnerLeaf:      e.Leaf(),
+		}
+		for j, leaf := range e.Tokens {
+			claim.TokenIndices = append(claim.TokenIndices, uint32(j))
+			claim.TokenTreeProofs = append(claim.TokenTreeProofs, e.TokenProof(uint32(j)))
+			claim.TokenLeaves = append(claim.TokenLeaves, leaf)
+		}
+		if ok, err := h.RewardsCoordinator.CheckClaim(call, claim); err != nil || !ok {
+			t.Errorf("Earner %s: expected a valid claim, got %v, %v", e.Earner.Hex(), ok, err)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package rewardstree
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	earner1 = common.HexToAddress("0x01")
+	earner2 = common.HexToAddress("0x02")
+	earner3 = common.HexToAddress("0x03")
+	tokenA  = common.HexToAddress("0xa0")
+	tokenB  = common.HexToAddress("0xb0")
+)
+
+func testDistribution() Distribution {
+	return Distribution{
+		earner3: {tokenA: big.NewInt(3)},
+		earner1: {tokenB: big.NewInt(2), tokenA: big.NewInt(1)},
+		earner2: {tokenA: big.NewInt(0)},
+	}
+}
+
+func TestBuild(t *testing.T) {
+	tree, err := Build(testDistribution())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Earners and tokens are in address order.
+	e1, i, ok := tree.Earner(earner1)
+	if !ok || i != 0 || tree.Earners[2].Earner != earner3 {
+		t.Fatalf("Expected earners in address order, got %d, %v", i, ok)
+	}
+	if j, ok := e1.Token(tokenB); !ok || j != 1 || e1.Tokens[0].Token != tokenA {
+		t.Errorf("Expected tokens in address order, got %d, %v", j, ok)
+	}
+
+	// Two tokens hash to a single pair; three earners are padded to four.
+	leafA, leafB := TokenLeafHash(e1.Tokens[0]), TokenLeafHash(e1.Tokens[1])
+	if want := crypto.Keccak256Hash(leafA[:], leafB[:]); e1.Root != want {
+		t.Errorf("Expected token root %s, got %s", want.Hex(), e1.Root.Hex())
+	}
+	e2, _, _ := tree.Earner(earner2)
+	if want := TokenLeafHash(e2.Tokens[0]); e2.Root != want {
+		t.Errorf("Expected a single leaf to be the root")
+	}
+	var leaves [4]common.Hash
+	for i, e := range tree.Earners {
+		leaves[i] = EarnerLeafHash(e.Leaf())
+	}
+	left := crypto.Keccak256Hash(leaves[0][:], leaves[1][:])
+	right := crypto.Keccak256Hash(leaves[2][:], common.Hash{}.Bytes())
+	if want := crypto.Keccak256Hash(left[:], right[:]); tree.Root != want {
+		t.Errorf("Expected root %s, got %s", want.Hex(), tree.Root.Hex())
+	}
+
+	again, err := Build(testDistribution())
+	if err != nil || again.Root != tree.Root {
+		t.Errorf("Expected the same root on rebuild, got %v", err)
+	}
+}
+
+func TestProofs(t *testing.T) {
+	tree, err := Build(testDistribution())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, e := range tree.Earners {
+		root, err := ProcessProof(tree.EarnerProof(uint32(i)), EarnerLeafHash(e.Leaf()), uint32(i))
+		if err != nil || root != tree.Root {
+			t.Errorf("Earner %d: expected root %s, got %s, %v", i, tree.Root.Hex(), root.Hex(), err)
+		}
+		for j, leaf := range e.Tokens {
+			root, err := ProcessProof(e.TokenProof(uint32(j)), TokenLeafHash(leaf), uint32(j))
+			if err != nil || root != e.Root {
+				t.Errorf("Earner %d token %d: expected root %s, got %s, %v", i, j, e.Root.Hex(), root.Hex(), err)
+			}
+		}
+	}
+
+	proof := tree.EarnerProof(1)
+	if _, err := ProcessProof(proof[:40], common.Hash{}, 1); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("Expected ErrInvalidProof for a bad length, got %v", err)
+	}
+	if _, err := ProcessProof(proof, common.Hash{}, 4); !errors.Is(err, ErrInvalidProof) {
+		t.Errorf("Expected ErrInvalidProof for an index beyond the tree, got %v", err)
+	}
+}
+
+func TestBuildRejects(t *testing.T) {
+	tooLarge := new(big.Int).Lsh(big.NewInt(1), 256)
+	for name, d := range map[string]Distribution{
+		"empty":      {},
+		"no tokens":  {earner1: {}},
+		"nil amount": {earner1: {tokenA: nil}},
+		"negative":   {earner1: {tokenA: big.NewInt(-1)}},
+		"overflow":   {earner1: {tokenA: tooLarge}},
+	} {
+		_, err := Build(d)
+		if !errors.Is(err, ErrEmpty) && !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("%s: expected an error, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package shares
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	eigenpodmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/deployment"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Source is the contracts a Snapshot is loaded from.
+type Source struct {
+	DelegationManager delegationmanager.DelegationManagerReader
+	StrategyManager   strategymanager.StrategyManagerReader
+	EigenPodManager   eigenpodmanager.EigenPodManagerReader
+	AllocationManager allocationmanager.AllocationManagerReader
+}
+
+// NewSource returns the Source of a bound deployment.
+func NewSource(c *deployment.Contracts) Source {
+	return Source{
+		DelegationManager: c.DelegationManager,
+		StrategyManager:   c.StrategyManager,
+		EigenPodManager:   c.EigenPodManager,
+		AllocationManager: c.AllocationManager,
+	}
+}
+
+// Load snapshots the state of stakers in strategies. Pin opts.BlockNumber so
+// every read sees the same block.
+func Load(ctx context.Context, src Source, opts *bind.CallOpts, stakers, strategies []common.Address) (*Snapshot, error) {
+	if opts == nil {
+		opts = &bind.CallOpts{}
+	}
+	if opts.Context == nil {
+		o := *opts
+		o.Context = ctx
+		opts = &o
+	}
+
+	snap := NewSnapshot()
+	operators := make(map[common.Address]bool)
+	for _, staker := range stakers {
+		operator, err := src.DelegationManager.DelegatedTo(opts, staker)
+		if err != nil {
+			return nil, fmt.Errorf("shares: failed to read delegation of %s: %w", staker.Hex(), err)
+		}
+		if operator != (common.Address{}) {
+			snap.DelegatedTo[staker] = operator
+		}
+		operators[operator] = true
+
+		for _, strategy := range strategies {
+			var deposit *big.Int
+			if strategy == BeaconChainETHStrategy {
+				deposit, err = src.EigenPodManager.StakerDepositShares(opts, staker, strategy)
+			} else {
+				deposit, err = src.StrategyManager.StakerDepositShares(opts, staker, strategy)
+			}
+			if err != nil {
+				return nil, fmt.Errorf("shares: failed to read deposit shares of %s in %s: %w", staker.Hex(), strategy.Hex(), err)
+			}
+			if deposit == nil || deposit.Sign() == 0 {
+				continue
+			}
+			dsf, err := src.DelegationManager.DepositScalingFactor(opts, staker, strategy)
+			if err != nil {
+				return nil, fmt.Errorf("shares: failed to read deposit scaling factor of %s in %s: %w", staker.Hex(), strategy.Hex(), err)
+			}
+			snap.SetDeposit(staker, strategy, deposit, dsf)
+		}
+
+		if _, ok := snap.DepositShares[staker][BeaconChainETHStrategy]; ok {
+			factor, err := src.EigenPodManager.BeaconChainSlashingFactor(opts, staker)
+			if err != nil {
+				return nil, fmt.Errorf("shares: failed to read beacon chain slashing factor of %s: %w", staker.Hex(), err)
+			}
+			snap.BeaconChainSlashingFactors[staker] = factor
+		}
+	}
+
+	for operator := range operators {
+		magnitudes, err := src.AllocationManager.GetMaxMagnitudes0(opts, operator, strategies)
+		if err != nil {
+			return nil, fmt.Errorf("shares: failed to read max magnitudes of %s: %w", operator.Hex(), err)
+		}
+		if len(magnitudes) != len(strategies) {
+			return nil, fmt.Errorf("shares: expected %d max magnitudes of %s, got %d", len(strategies), operator.Hex(), len(magnitudes))
+		}
+		for i, strategy := range strategies {
+			snap.SetMaxMagnitude(operator, strategy, magnitudes[i])
+		}
+	}
+	return snap, nil
+}
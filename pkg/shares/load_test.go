@@ -0,0 +1,127 @@
+//go:build !go1.23 || simulated
+
+package shares
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// TestLoadMatchesContract slashes an operator twice around deposits of
+// several stakers and checks the snapshot's figures against
+// DelegationManager.getWithdrawableShares.
+func TestLoadMatchesContract(t *testing.T) {
+	// Slashing looks up queued withdrawals MinWithdrawalDelayBlocks back, which
+	// underflows on a chain younger than the delay.
+	h, err := harness.New(harness.Config{MinWithdrawalDelayBlocks: 1})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+
+	account := func() *bind.TransactOpts {
+		auth, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+	send := func(auth *bind.TransactOpts, build func(opts *bind.TransactOpts) (*types.Transaction, error)) {
+		t.Helper()
+		if _, err := h.SendAs(ctx, auth, build); err != nil {
+			t.Fatal(err)
+		}
+	}
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var deployed []harness.Strategy
+	for i := 0; i < 2; i++ {
+		s, err := h.DeployStrategy(ctx)
+		check(err)
+		deployed = append(deployed, s)
+	}
+	// Slashing requires strategies in ascending order.
+	sort.Slice(deployed, func(i, j int) bool { return deployed[i].Address.Cmp(deployed[j].Address) < 0 })
+	strategies := []common.Address{deployed[0].Address, deployed[1].Address}
+
+	operator, avs := account(), account()
+	check(h.RegisterOperator(ctx, operator))
+	set, err := h.CreateOperatorSet(ctx, avs, 1, strategies)
+	check(err)
+	check(h.RegisterForOperatorSet(ctx, operator, set))
+	send(operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.AllocationManager.ModifyAllocations(opts, operator.From, []eltypes.IAllocationManagerTypesAllocateParams{
+			{OperatorSet: set, Strategies: strategies, NewMagnitudes: []uint64{5e17, 1e18}},
+		})
+	})
+	slash := func(wads ...int64) {
+		t.Helper()
+		send(avs, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.AllocationManager.SlashOperator(opts, avs.From, eltypes.IAllocationManagerTypesSlashingParams{
+				Operator:      operator.From,
+				OperatorSetId: set.Id,
+				Strategies:    strategies,
+				WadsToSlash:   []*big.Int{big.NewInt(wads[0]), big.NewInt(wads[1])},
+			})
+		})
+	}
+
+	early, late, undelegated := account(), account(), account()
+	for _, s := range deployed {
+		check(h.Deposit(ctx, early, s, big.NewInt(1e18)))
+		check(h.Deposit(ctx, undelegated, s, big.NewInt(333333333333333333)))
+	}
+	check(h.Delegate(ctx, early, operator.From))
+	slash(3e17, 333333333333333333)
+
+	// A delegated deposit after a slash gets a scaling factor above WAD, and a
+	// second deposit into the same strategy blends it.
+	check(h.Delegate(ctx, late, operator.From))
+	check(h.Deposit(ctx, late, deployed[0], big.NewInt(7e17+3)))
+	check(h.Deposit(ctx, late, deployed[0], big.NewInt(11)))
+	check(h.Deposit(ctx, early, deployed[1], big.NewInt(5e17)))
+	slash(123456789012345678, 9e17)
+
+	block, err := h.Client.BlockNumber(ctx)
+	check(err)
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}
+	stakers := []common.Address{early.From, late.From, undelegated.From}
+	queried := append(append([]common.Address{}, strategies...), BeaconChainETHStrategy)
+
+	snap, err := Load(ctx, NewSource(h.Contracts), opts, stakers, queried)
+	check(err)
+	if snap.MaxMagnitude(operator.From, strategies[0]) >= 1e18 {
+		t.Fatalf("Expected the operator to be slashed, got max magnitude %d", snap.MaxMagnitude(operator.From, strategies[0]))
+	}
+	if dsf := snap.DepositScalingFactor(late.From, strategies[0]).ScalingFactor(); dsf.Cmp(big.NewInt(1e18)) <= 0 {
+		t.Errorf("Expected a scaling factor above WAD for a deposit after slashing, got %s", dsf)
+	}
+
+	for _, staker := range stakers {
+		want, err := h.DelegationManager.GetWithdrawableShares(opts, staker, queried)
+		check(err)
+		withdrawable, deposit, err := snap.WithdrawableShares(staker, queried)
+		check(err)
+		for i, strategy := range queried {
+			if withdrawable[i].Cmp(want.WithdrawableShares[i]) != 0 || deposit[i].Cmp(want.DepositShares[i]) != 0 {
+				t.Errorf("%s in %s: expected %s withdrawable of %s, got %s of %s", staker.Hex(), strategy.Hex(),
+					want.WithdrawableShares[i], want.DepositShares[i], withdrawable[i], deposit[i])
+			}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+// Package shares computes staker shares offline from a snapshot of protocol
+// state, for reports over many stakers that should not need one
+// DelegationManager.getWithdrawableShares call per staker.
+//
+// A Snapshot holds the storage getWithdrawableShares reads: delegations,
+// deposit shares and deposit scaling factors per staker and strategy,
+// operator max magnitudes and beacon chain slashing factors. Load reads one
+// from a live deployment, or it can be built from an indexer or a JSON file:
+//
+//	snap, err := shares.Load(ctx, shares.NewSource(contracts), opts, stakers, strategies)
+//	if err != nil {
+//		return err
+//	}
+//	withdrawable, err := snap.WithdrawableSharesByStaker()
+//
+// Results match the contract exactly, including its rounding; see
+// pkg/slashing for the underlying math.
+package shares
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/slashing"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// BeaconChainETHStrategy is the placeholder strategy for native restaked ETH,
+// whose shares the EigenPodManager tracks.
+var BeaconChainETHStrategy = common.HexToAddress("0xbeaC0eeEeeeeEEeEeEEEEeeEEeEeeeEeeEEBEaC0")
+
+// Snapshot is the state DelegationManager.getWithdrawableShares depends on.
+// Missing entries read like unset storage: stakers are undelegated, deposit
+// shares are 0 and scaling factors, max magnitudes and beacon chain slashing
+// factors are WAD.
+type Snapshot struct {
+	// DelegatedTo maps stakers to their operator, as
+	// DelegationManager.delegatedTo.
+	DelegatedTo map[common.Address]common.Address `json:"delegatedTo"`
+	// DepositShares maps staker and strategy to stakerDepositShares of the
+	// StrategyManager, or of the EigenPodManager for BeaconChainETHStrategy.
+	DepositShares map[common.Address]map[common.Address]*big.Int `json:"depositShares"`
+	// DepositScalingFactors maps staker and strategy to
+	// DelegationManager.depositScalingFactor.
+	DepositScalingFactors map[common.Address]map[common.Address]*big.Int `json:"depositScalingFactors"`
+	// MaxMagnitudes maps operator and strategy to
+	// AllocationManager.getMaxMagnitudes. Undelegated stakers read the zero
+	// address's, which is always WAD on chain.
+	MaxMagnitudes map[common.Address]map[common.Address]uint64 `json:"maxMagnitudes"`
+	// BeaconChainSlashingFactors maps stakers to
+	// EigenPodManager.beaconChainSlashingFactor.
+	BeaconChainSlashingFactors map[common.Address]uint64 `json:"beaconChainSlashingFactors"`
+}
+
+// NewSnapshot returns an empty snapshot.
+func NewSnapshot() *Snapshot {
+	return &Snapshot{
+		DelegatedTo:                make(map[common.Address]common.Address),
+		DepositShares:              make(map[common.Address]map[common.Address]*big.Int),
+		DepositScalingFactors:      make(map[common.Address]map[common.Address]*big.Int),
+		MaxMagnitudes:              make(map[common.Address]map[common.Address]uint64),
+		BeaconChainSlashingFactors: make(map[common.Address]uint64),
+	}
+}
+
+// SetDeposit records staker's deposit shares and scaling factor in strategy.
+func (s *Snapshot) SetDeposit(staker, strategy common.Address, depositShares, depositScalingFactor *big.Int) {
+	if s.DepositShares == nil {
+		s.DepositShares = make(map[common.Address]map[common.Address]*big.Int)
+	}
+	if s.DepositScalingFactors == nil {
+		s.DepositScalingFactors = make(map[common.Address]map[common.Address]*big.Int)
+	}
+	set(s.DepositShares, staker, strategy, depositShares)
+	set(s.DepositScalingFactors, staker, strategy, depositScalingFactor)
+}
+
+// SetMaxMagnitude records operator's max magnitude in strategy.
+func (s *Snapshot) SetMaxMagnitude(operator, strategy common.Address, maxMagnitude uint64) {
+	if s.MaxMagnitudes == nil {
+		s.MaxMagnitudes = make(map[common.Address]map[common.Address]uint64)
+	}
+	set(s.MaxMagnitudes, operator, strategy, maxMagnitude)
+}
+
+// Operator returns the operator staker is delegated to, or the zero address.
+func (s *Snapshot) Operator(staker common.Address) common.Address {
+	return s.DelegatedTo[staker]
+}
+
+// MaxMagnitude returns operator's max magnitude in strategy.
+func (s *Snapshot) MaxMagnitude(operator, strategy common.Address) uint64 {
+	if m, ok := s.MaxMagnitudes[operator][strategy]; ok {
+		return m
+	}
+	return slashing.WAD
+}
+
+// BeaconChainSlashingFactor returns staker's beacon chain slashing factor.
+func (s *Snapshot) BeaconChainSlashingFactor(staker common.Address) uint64 {
+	if f, ok := s.BeaconChainSlashingFactors[staker]; ok {
+		return f
+	}
+	return slashing.WAD
+}
+
+// DepositScalingFactor returns staker's deposit scaling factor in strategy.
+func (s *Snapshot) DepositScalingFactor(staker, strategy common.Address) slashing.DepositScalingFactor {
+	return slashing.NewDepositScalingFactor(s.DepositScalingFactors[staker][strategy])
+}
+
+// StakerDepositShares returns staker's deposit shares in strategy.
+func (s *Snapshot) StakerDepositShares(staker, strategy common.Address) *big.Int {
+	if shares := s.DepositShares[staker][strategy]; shares != nil {
+		return new(big.Int).Set(shares)
+	}
+	return new(big.Int)
+}
+
+// SlashingFactor returns the slashing factor applied to staker's shares in
+// strategy: their operator's max magnitude, further scaled by the beacon
+// chain slashing factor for BeaconChainETHStrategy.
+func (s *Snapshot) SlashingFactor(staker, strategy common.Address) (*big.Int, error) {
+	maxMagnitude := new(big.Int).SetUint64(s.MaxMagnitude(s.Operator(staker), strategy))
+	if strategy != BeaconChainETHStrategy {
+		return maxMagnitude, nil
+	}
+	return slashing.MulWad(maxMagnitude, new(big.Int).SetUint64(s.BeaconChainSlashingFactor(staker)))
+}
+
+// WithdrawableShares returns staker's withdrawable and deposit shares in each
+// of strategies, as DelegationManager.getWithdrawableShares.
+func (s *Snapshot) WithdrawableShares(staker common.Address, strategies []common.Address) (withdrawable, deposit []*big.Int, err error) {
+	withdrawable = make([]*big.Int, len(strategies))
+	deposit = make([]*big.Int, len(strategies))
+	for i, strategy := range strategies {
+		slashingFactor, err := s.SlashingFactor(staker, strategy)
+		if err != nil {
+			return nil, nil, fmt.Errorf("shares: slashing factor of %s in %s: %w", staker.Hex(), strategy.Hex(), err)
+		}
+		deposit[i] = s.StakerDepositShares(staker, strategy)
+		withdrawable[i], err = s.DepositScalingFactor(staker, strategy).CalcWithdrawable(deposit[i], slashingFactor)
+		if err != nil {
+			return nil, nil, fmt.Errorf("shares: withdrawable shares of %s in %s: %w", staker.Hex(), strategy.Hex(), err)
+		}
+	}
+	return withdrawable, deposit, nil
+}
+
+// WithdrawableSharesByStaker returns the withdrawable shares of every staker
+// and strategy with deposit shares in the snapshot.
+func (s *Snapshot) WithdrawableSharesByStaker() (map[common.Address]map[common.Address]*big.Int, error) {
+	out := make(map[common.Address]map[common.Address]*big.Int, len(s.DepositShares))
+	for staker, deposits := range s.DepositShares {
+		strategies := make([]common.Address, 0, len(deposits))
+		for strategy := range deposits {
+			strategies = append(strategies, strategy)
+		}
+		sort.Slice(strategies, func(i, j int) bool { return strategies[i].Cmp(strategies[j]) < 0 })
+
+		withdrawable, _, err := s.WithdrawableShares(staker, strategies)
+		if err != nil {
+			return nil, err
+		}
+		out[staker] = make(map[common.Address]*big.Int, len(strategies))
+		for i, strategy := range strategies {
+			out[staker][strategy] = withdrawable[i]
+		}
+	}
+	return out, nil
+}
+
+func set[V any](m map[common.Address]map[common.Address]V, outer, inner common.Address, v V) {
+	if m[outer] == nil {
+		m[outer] = make(map[common.Address]V)
+	}
+	m[outer][inner] = v
+}
@@ -0,0 +1,136 @@
+package shares
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	delegationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/DelegationManager"
+	eigenpodmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/EigenPodManager"
+	strategymanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/StrategyManager"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	operator    = common.HexToAddress("0x09")
+	delegated   = common.HexToAddress("0xa11ce")
+	undelegated = common.HexToAddress("0xb0b")
+	rounding    = common.HexToAddress("0xca7")
+	strategy    = common.HexToAddress("0x5")
+)
+
+func e18(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func testSnapshot() *Snapshot {
+	s := NewSnapshot()
+	s.DelegatedTo[delegated] = operator
+	s.DelegatedTo[rounding] = operator
+	s.SetMaxMagnitude(operator, strategy, 5e17)
+	s.SetMaxMagnitude(operator, BeaconChainETHStrategy, 5e17)
+	s.BeaconChainSlashingFactors[delegated] = 5e17
+
+	s.SetDeposit(delegated, strategy, e18(100), e18(2))
+	s.SetDeposit(delegated, BeaconChainETHStrategy, e18(8), nil)
+	s.SetDeposit(undelegated, strategy, big.NewInt(3), big.NewInt(15e17))
+	s.SetDeposit(rounding, strategy, big.NewInt(3), big.NewInt(15e17))
+	s.SetMaxMagnitude(operator, common.HexToAddress("0x6"), 333333333333333333)
+	s.SetDeposit(rounding, common.HexToAddress("0x6"), big.NewInt(3), big.NewInt(15e17))
+	return s
+}
+
+func TestWithdrawableShares(t *testing.T) {
+	s := testSnapshot()
+	for _, v := range []struct {
+		name         string
+		staker       common.Address
+		strategy     common.Address
+		withdrawable *big.Int
+		deposit      *big.Int
+	}{
+		// 100 * 2 * 0.5
+		{"slashed operator", delegated, strategy, e18(100), e18(100)},
+		// 8 * 0.5 * 0.5
+		{"beacon chain slashing", delegated, BeaconChainETHStrategy, e18(2), e18(8)},
+		// 3 * 1.5 rounds down to 4 and undelegated stakers are unslashed.
+		{"undelegated", undelegated, strategy, big.NewInt(4), big.NewInt(3)},
+		// 4 / 3 rounds down to 1.
+		{"rounds twice", rounding, common.HexToAddress("0x6"), big.NewInt(1), big.NewInt(3)},
+		{"no deposit", undelegated, BeaconChainETHStrategy, big.NewInt(0), big.NewInt(0)},
+	} {
+		withdrawable, deposit, err := s.WithdrawableShares(v.staker, []common.Address{v.strategy})
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", v.name, err)
+			continue
+		}
+		if withdrawable[0].Cmp(v.withdrawable) != 0 || deposit[0].Cmp(v.deposit) != 0 {
+			t.Errorf("%s: expected %s withdrawable of %s, got %s of %s", v.name, v.withdrawable, v.deposit, withdrawable[0], deposit[0])
+		}
+	}
+}
+
+func TestWithdrawableSharesByStaker(t *testing.T) {
+	all, err := testSnapshot().WithdrawableSharesByStaker()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 || len(all[delegated]) != 2 || len(all[rounding]) != 2 {
+		t.Fatalf("Expected every deposit to be reported, got %v", all)
+	}
+	if got := all[delegated][BeaconChainETHStrategy]; got.Cmp(e18(2)) != 0 {
+		t.Errorf("Expected 2e18 beacon chain shares, got %s", got)
+	}
+}
+
+func TestSnapshotJSON(t *testing.T) {
+	data, err := json.Marshal(testSnapshot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var s Snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		t.Fatal(err)
+	}
+	withdrawable, _, err := s.WithdrawableShares(delegated, []common.Address{strategy, BeaconChainETHStrategy})
+	if err != nil || withdrawable[0].Cmp(e18(100)) != 0 || withdrawable[1].Cmp(e18(2)) != 0 {
+		t.Errorf("Expected the decoded snapshot to give the same shares, got %v, %v", withdrawable, err)
+	}
+}
+
+func TestLoad(t *testing.T) {
+	dm := delegationmanager.NewFakeDelegationManager(common.HexToAddress("0xd"))
+	sm := strategymanager.NewFakeStrategyManager(common.HexToAddress("0x5a"))
+	epm := eigenpodmanager.NewFakeEigenPodManager(common.HexToAddress("0xe"))
+	am := allocationmanager.NewFakeAllocationManager(common.HexToAddress("0xa"))
+
+	strategies := []common.Address{strategy, BeaconChainETHStrategy}
+	dm.StubDelegatedTo(delegated, operator)
+	dm.StubDepositScalingFactor(delegated, strategy, e18(2))
+	dm.StubDepositScalingFactor(delegated, BeaconChainETHStrategy, e18(1))
+	sm.StubStakerDepositShares(delegated, strategy, e18(100))
+	epm.StubStakerDepositShares(delegated, BeaconChainETHStrategy, e18(8))
+	epm.StubBeaconChainSlashingFactor(delegated, 5e17)
+	am.StubGetMaxMagnitudes0(operator, strategies, []uint64{5e17, 5e17})
+	am.StubGetMaxMagnitudes0(common.Address{}, strategies, []uint64{1e18, 1e18})
+
+	src := Source{DelegationManager: dm, StrategyManager: sm, EigenPodManager: epm, AllocationManager: am}
+	s, err := Load(context.Background(), src, nil, []common.Address{delegated, undelegated}, strategies)
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if _, ok := s.DepositShares[undelegated]; ok {
+		t.Errorf("Expected stakers without deposits to be left out, got %v", s.DepositShares[undelegated])
+	}
+	withdrawable, _, err := s.WithdrawableShares(delegated, strategies)
+	if err != nil || withdrawable[0].Cmp(e18(100)) != 0 || withdrawable[1].Cmp(e18(2)) != 0 {
+		t.Errorf("Expected 100e18 and 2e18 withdrawable shares, got %v, %v", withdrawable, err)
+	}
+
+	am.StubGetMaxMagnitudes0(operator, strategies, nil)
+	if _, err := Load(context.Background(), src, nil, []common.Address{delegated}, strategies); err == nil {
+		t.Error("Expected a short max magnitude result to fail")
+	}
+}
@@ -0,0 +1,269 @@
+// Package slashing is a bit-exact Go port of the share accounting math in
+// src/contracts/libraries/SlashingLib.sol, for computing withdrawable shares,
+// deposit shares and slashed amounts off-chain.
+//
+// All values are uint256 in the contracts and *big.Int here. Every function
+// reverts where the Solidity version would, returning an error instead:
+// ErrDivisionByZero for Panic(0x12), ErrOverflow and ErrUnderflow for
+// Panic(0x11), ErrMulDivOverflow for OpenZeppelin's "Math: mulDiv overflow"
+// and slashinglib.ErrInvalidDepositScalingFactor for the library's own error.
+// Rounding follows the contracts: down everywhere except MulWadRoundUp and
+// the subtracted term of CalcSlashedAmount.
+package slashing
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	slashinglib "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/SlashingLib"
+)
+
+// WAD is the fixed-point 1 that scaling factors and magnitudes are expressed
+// in.
+const WAD = 1e18
+
+var (
+	// ErrDivisionByZero mirrors Solidity's Panic(0x12).
+	ErrDivisionByZero = errors.New("slashing: division by zero")
+	// ErrOverflow mirrors Solidity's Panic(0x11) on addition.
+	ErrOverflow = errors.New("slashing: arithmetic overflow")
+	// ErrUnderflow mirrors Solidity's Panic(0x11) on subtraction.
+	ErrUnderflow = errors.New("slashing: arithmetic underflow")
+	// ErrMulDivOverflow mirrors the "Math: mulDiv overflow" revert of
+	// OpenZeppelin's Math.mulDiv, raised when the result does not fit in 256
+	// bits or when dividing a product of 256 bits or more by zero.
+	ErrMulDivOverflow = errors.New("slashing: mulDiv overflow")
+	// ErrNotUint256 is returned for negative inputs or inputs of more than
+	// 256 bits, which cannot be passed to the contracts.
+	ErrNotUint256 = errors.New("slashing: value is not a uint256")
+)
+
+var (
+	wad        = big.NewInt(WAD)
+	maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+)
+
+// Rounding selects the rounding direction of MulDiv, like OpenZeppelin's
+// Math.Rounding.
+type Rounding int
+
+const (
+	RoundDown Rounding = iota
+	RoundUp
+)
+
+// MulDiv returns x * y / denominator with full 512-bit intermediate
+// precision, as OpenZeppelin's Math.mulDiv.
+func MulDiv(x, y, denominator *big.Int, rounding Rounding) (*big.Int, error) {
+	if err := checkUint256(x, y, denominator); err != nil {
+		return nil, err
+	}
+	product := new(big.Int).Mul(x, y)
+	if denominator.Sign() == 0 {
+		// Math.mulDiv divides directly when the product fits in 256 bits, and
+		// otherwise requires denominator > the product's high word.
+		if product.Cmp(maxUint256) > 0 {
+			return nil, ErrMulDivOverflow
+		}
+		return nil, ErrDivisionByZero
+	}
+	result, rem := new(big.Int).QuoRem(product, denominator, new(big.Int))
+	if result.Cmp(maxUint256) > 0 {
+		return nil, ErrMulDivOverflow
+	}
+	if rounding == RoundUp && rem.Sign() > 0 {
+		result.Add(result, big.NewInt(1))
+		if result.Cmp(maxUint256) > 0 {
+			return nil, ErrOverflow
+		}
+	}
+	return result, nil
+}
+
+// MulWad returns x * y / WAD, rounded down.
+func MulWad(x, y *big.Int) (*big.Int, error) {
+	return MulDiv(x, y, wad, RoundDown)
+}
+
+// DivWad returns x * WAD / y, rounded down.
+func DivWad(x, y *big.Int) (*big.Int, error) {
+	return MulDiv(x, wad, y, RoundDown)
+}
+
+// MulWadRoundUp returns x * y / WAD, rounded up. The contracts use it for
+// slashed magnitudes so repeated slashes cannot round down to nothing.
+func MulWadRoundUp(x, y *big.Int) (*big.Int, error) {
+	return MulDiv(x, y, wad, RoundUp)
+}
+
+// ScaleForCompleteWithdrawal returns the shares a queued withdrawal of
+// scaledShares pays out under slashingFactor.
+func ScaleForCompleteWithdrawal(scaledShares, slashingFactor *big.Int) (*big.Int, error) {
+	return MulWad(scaledShares, slashingFactor)
+}
+
+// CalcSlashedAmount returns how many of operatorShares are slashed when an
+// operator's max magnitude drops from prevMaxMagnitude to newMaxMagnitude.
+// The retained shares are rounded up so the slashed amount is never
+// overstated.
+func CalcSlashedAmount(operatorShares, prevMaxMagnitude, newMaxMagnitude *big.Int) (*big.Int, error) {
+	retained, err := MulDiv(operatorShares, newMaxMagnitude, prevMaxMagnitude, RoundUp)
+	if err != nil {
+		return nil, err
+	}
+	return sub(operatorShares, retained)
+}
+
+// DepositScalingFactor is a staker's scaling factor for one strategy. The zero
+// value, like an unset storage slot, reads as WAD.
+type DepositScalingFactor struct {
+	factor *big.Int
+}
+
+// NewDepositScalingFactor wraps a raw stored scaling factor, where 0 means
+// WAD. Note that DelegationManager.depositScalingFactor already returns WAD
+// for unset factors.
+func NewDepositScalingFactor(raw *big.Int) DepositScalingFactor {
+	if raw == nil {
+		return DepositScalingFactor{}
+	}
+	return DepositScalingFactor{factor: new(big.Int).Set(raw)}
+}
+
+// ScalingFactor returns the effective scaling factor.
+func (d DepositScalingFactor) ScalingFactor() *big.Int {
+	if d.factor == nil || d.factor.Sign() == 0 {
+		return new(big.Int).Set(wad)
+	}
+	return new(big.Int).Set(d.factor)
+}
+
+// Raw returns the value the contract would store, 0 for a reset factor.
+func (d DepositScalingFactor) Raw() *big.Int {
+	if d.factor == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(d.factor)
+}
+
+// String implements fmt.Stringer.
+func (d DepositScalingFactor) String() string {
+	return d.ScalingFactor().String()
+}
+
+// ScaleForQueueWithdrawal returns the scaled shares recorded in a queued
+// withdrawal of depositSharesToWithdraw.
+func (d DepositScalingFactor) ScaleForQueueWithdrawal(depositSharesToWithdraw *big.Int) (*big.Int, error) {
+	return MulWad(depositSharesToWithdraw, d.ScalingFactor())
+}
+
+// CalcWithdrawable converts deposit shares into withdrawable shares.
+func (d DepositScalingFactor) CalcWithdrawable(depositShares, slashingFactor *big.Int) (*big.Int, error) {
+	scaled, err := MulWad(depositShares, d.ScalingFactor())
+	if err != nil {
+		return nil, err
+	}
+	return MulWad(scaled, slashingFactor)
+}
+
+// CalcDepositShares converts withdrawable shares into deposit shares.
+func (d DepositScalingFactor) CalcDepositShares(withdrawableShares, slashingFactor *big.Int) (*big.Int, error) {
+	scaled, err := DivWad(withdrawableShares, d.ScalingFactor())
+	if err != nil {
+		return nil, err
+	}
+	return DivWad(scaled, slashingFactor)
+}
+
+// Update returns the scaling factor after addedShares are deposited on top of
+// prevDepositShares under slashingFactor, as SlashingLib.update stores it.
+func (d DepositScalingFactor) Update(prevDepositShares, addedShares, slashingFactor *big.Int) (DepositScalingFactor, error) {
+	if err := checkUint256(prevDepositShares, addedShares, slashingFactor); err != nil {
+		return DepositScalingFactor{}, err
+	}
+	if prevDepositShares.Sign() == 0 {
+		// A first deposit forgives prior slashing by inverting the slashing
+		// factor into the scaling factor.
+		factor, err := DivWad(d.ScalingFactor(), slashingFactor)
+		if err != nil {
+			return DepositScalingFactor{}, err
+		}
+		return DepositScalingFactor{factor: factor}, nil
+	}
+
+	currentShares, err := d.CalcWithdrawable(prevDepositShares, slashingFactor)
+	if err != nil {
+		return DepositScalingFactor{}, err
+	}
+	newShares, err := add(currentShares, addedShares)
+	if err != nil {
+		return DepositScalingFactor{}, err
+	}
+	newDepositShares, err := add(prevDepositShares, addedShares)
+	if err != nil {
+		return DepositScalingFactor{}, err
+	}
+	factor, err := DivWad(newShares, newDepositShares)
+	if err != nil {
+		return DepositScalingFactor{}, err
+	}
+	if factor, err = DivWad(factor, slashingFactor); err != nil {
+		return DepositScalingFactor{}, err
+	}
+	if factor.Sign() == 0 {
+		return DepositScalingFactor{}, slashinglib.ErrInvalidDepositScalingFactor{}
+	}
+	return DepositScalingFactor{factor: factor}, nil
+}
+
+// Reset returns a reset scaling factor, which reads as WAD.
+func (d DepositScalingFactor) Reset() DepositScalingFactor {
+	return DepositScalingFactor{factor: new(big.Int)}
+}
+
+// AddInt128 returns a + b as AllocationManager._addInt128 applies a pending
+// diff to a magnitude: ErrUnderflow below zero and ErrOverflow past uint64.
+func AddInt128(a uint64, b *big.Int) (uint64, error) {
+	sum := new(big.Int).Add(new(big.Int).SetUint64(a), OrZero(b))
+	if sum.Sign() < 0 {
+		return 0, ErrUnderflow
+	}
+	if !sum.IsUint64() {
+		return 0, ErrOverflow
+	}
+	return sum.Uint64(), nil
+}
+
+// OrZero returns x, or zero if x is nil, the way an unset binding field
+// reads on chain.
+func OrZero(x *big.Int) *big.Int {
+	if x == nil {
+		return new(big.Int)
+	}
+	return x
+}
+
+func add(x, y *big.Int) (*big.Int, error) {
+	sum := new(big.Int).Add(x, y)
+	if sum.Cmp(maxUint256) > 0 {
+		return nil, ErrOverflow
+	}
+	return sum, nil
+}
+
+func sub(x, y *big.Int) (*big.Int, error) {
+	if x.Cmp(y) < 0 {
+		return nil, ErrUnderflow
+	}
+	return new(big.Int).Sub(x, y), nil
+}
+
+func checkUint256(values ...*big.Int) error {
+	for _, v := range values {
+		if v == nil || v.Sign() < 0 || v.Cmp(maxUint256) > 0 {
+			return fmt.Errorf("%w: %v", ErrNotUint256, v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package slashing
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	slashinglib "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/SlashingLib"
+)
+
+const (
+	maxUint = "115792089237316195423570985008687907853269984665640564039457584007913129639935"
+	wadStr  = "1000000000000000000"
+	halfWad = "500000000000000000"
+)
+
+func n(t *testing.T, s string) *big.Int {
+	t.Helper()
+	v, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("Bad test value %q", s)
+	}
+	return v
+}
+
+// golden is a vector computed with uint256 semantics; want is ignored when err
+// is set.
+type golden struct {
+	name string
+	args []string
+	want string
+	err  error
+}
+
+func check(t *testing.T, v golden, got *big.Int, err error) {
+	t.Helper()
+	if v.err != nil {
+		if !errors.Is(err, v.err) {
+			t.Errorf("%s: expected %v, got %v, %v", v.name, v.err, got, err)
+		}
+		return
+	}
+	if err != nil {
+		t.Errorf("%s: unexpected error %v", v.name, err)
+		return
+	}
+	if got.String() != v.want {
+		t.Errorf("%s: expected %s, got %s", v.name, v.want, got)
+	}
+}
+
+func TestWadMath(t *testing.T) {
+	ops := map[string]func(x, y *big.Int) (*big.Int, error){
+		"mulWad":        MulWad,
+		"divWad":        DivWad,
+		"mulWadRoundUp": MulWadRoundUp,
+	}
+	for op, vectors := range map[string][]golden{
+		"mulWad": {
+			{"zero", []string{"0", "0"}, "0", nil},
+			{"rounds down to zero", []string{"1", "1"}, "0", nil},
+			{"one times one", []string{wadStr, wadStr}, wadStr, nil},
+			{"just below one", []string{"3", "333333333333333333"}, "0", nil},
+			{"max times one", []string{maxUint, wadStr}, maxUint, nil},
+			{"result overflows", []string{maxUint, "1000000000000000001"}, "", ErrMulDivOverflow},
+			{"intermediate beyond 256 bits", []string{maxUint, "2"}, "231584178474632390847141970017375815706539969331281128078915", nil},
+		},
+		"mulWadRoundUp": {
+			{"zero stays zero", []string{"0", maxUint}, "0", nil},
+			{"rounds up from zero", []string{"1", "1"}, "1", nil},
+			{"exact", []string{wadStr, "5"}, "5", nil},
+			{"rounds up", []string{"3", "333333333333333334"}, "2", nil},
+			{"result overflows", []string{maxUint, maxUint}, "", ErrMulDivOverflow},
+		},
+		"divWad": {
+			{"divide by zero", []string{"1", "0"}, "", ErrDivisionByZero},
+			{"zero by zero", []string{"0", "0"}, "", ErrDivisionByZero},
+			// Math.mulDiv checks the high word before dividing.
+			{"wide product by zero", []string{maxUint, "0"}, "", ErrMulDivOverflow},
+			{"rounds down", []string{"1", "3"}, "333333333333333333", nil},
+			{"two thirds", []string{"2000000000000000000", "3000000000000000000"}, "666666666666666666", nil},
+			{"result overflows", []string{maxUint, "1"}, "", ErrMulDivOverflow},
+		},
+	} {
+		for _, v := range vectors {
+			got, err := ops[op](n(t, v.args[0]), n(t, v.args[1]))
+			v.name = op + ": " + v.name
+			check(t, v, got, err)
+		}
+	}
+}
+
+func TestMulDivRoundUpOverflow(t *testing.T) {
+	// 618298780995040089266257453058982480847 * 374550598501810936581776630096313181393
+	// is 2^257 - 1, so dividing by 2 gives 2^256 - 1 remainder 1.
+	x := n(t, "618298780995040089266257453058982480847")
+	y := n(t, "374550598501810936581776630096313181393")
+	got, err := MulDiv(x, y, big.NewInt(2), RoundDown)
+	if err != nil || got.String() != maxUint {
+		t.Fatalf("Expected max uint256, got %v, %v", got, err)
+	}
+	if _, err := MulDiv(x, y, big.NewInt(2), RoundUp); !errors.Is(err, ErrOverflow) {
+		t.Errorf("Expected rounding up past max uint256 to overflow, got %v", err)
+	}
+}
+
+func TestNotUint256(t *testing.T) {
+	if _, err := MulWad(big.NewInt(-1), big.NewInt(1)); !errors.Is(err, ErrNotUint256) {
+		t.Errorf("Expected ErrNotUint256 for a negative input, got %v", err)
+	}
+	tooBig := new(big.Int).Lsh(big.NewInt(1), 256)
+	if _, err := DivWad(tooBig, big.NewInt(1)); !errors.Is(err, ErrNotUint256) {
+		t.Errorf("Expected ErrNotUint256 for 2^256, got %v", err)
+	}
+}
+
+func TestCalcSlashedAmount(t *testing.T) {
+	for _, v := range []golden{
+		{"half", []string{"100", wadStr, halfWad}, "50", nil},
+		// 50.5 shares are retained and rounded up to 51.
+		{"retained rounds up", []string{"101", wadStr, halfWad}, "50", nil},
+		{"tiny slash rounds to zero", []string{"1", "3", "1"}, "0", nil},
+		{"fully slashed", []string{"100", wadStr, "0"}, "100", nil},
+		{"zero previous magnitude", []string{"10", "0", "0"}, "", ErrDivisionByZero},
+		{"magnitude increase", []string{"10", "5", "6"}, "", ErrUnderflow},
+	} {
+		got, err := CalcSlashedAmount(n(t, v.args[0]), n(t, v.args[1]), n(t, v.args[2]))
+		check(t, v, got, err)
+	}
+}
+
+func TestScaleForCompleteWithdrawal(t *testing.T) {
+	got, err := ScaleForCompleteWithdrawal(n(t, "3000000000000000000"), n(t, halfWad))
+	check(t, golden{name: "half slashed", want: "1500000000000000000"}, got, err)
+	got, err = ScaleForCompleteWithdrawal(big.NewInt(1), big.NewInt(1))
+	check(t, golden{name: "rounds down", want: "0"}, got, err)
+}
+
+func TestDepositScalingFactor(t *testing.T) {
+	var unset DepositScalingFactor
+	if unset.ScalingFactor().String() != wadStr || unset.Raw().Sign() != 0 {
+		t.Errorf("Expected an unset factor to read as WAD and store 0, got %s and %s", unset.ScalingFactor(), unset.Raw())
+	}
+	if reset := NewDepositScalingFactor(big.NewInt(7)).Reset(); reset.ScalingFactor().String() != wadStr {
+		t.Errorf("Expected a reset factor to read as WAD, got %s", reset.ScalingFactor())
+	}
+
+	dsf := NewDepositScalingFactor(n(t, "1500000000000000000"))
+	got, err := dsf.ScaleForQueueWithdrawal(big.NewInt(3))
+	check(t, golden{name: "scaleForQueueWithdrawal", want: "4"}, got, err)
+
+	// 3 * 1.5 = 4.5 rounds to 4, and 4 / 3 rounds to 1.
+	got, err = dsf.CalcWithdrawable(big.NewInt(3), n(t, "333333333333333333"))
+	check(t, golden{name: "calcWithdrawable rounds twice", want: "1"}, got, err)
+	got, err = unset.CalcWithdrawable(n(t, wadStr), n(t, halfWad))
+	check(t, golden{name: "calcWithdrawable", want: halfWad}, got, err)
+
+	got, err = unset.CalcDepositShares(n(t, halfWad), n(t, halfWad))
+	check(t, golden{name: "calcDepositShares", want: wadStr}, got, err)
+	got, err = unset.CalcDepositShares(big.NewInt(1), big.NewInt(0))
+	check(t, golden{name: "calcDepositShares fully slashed", err: ErrDivisionByZero}, got, err)
+}
+
+func TestDepositScalingFactorUpdate(t *testing.T) {
+	var unset DepositScalingFactor
+	for _, v := range []struct {
+		golden
+		dsf DepositScalingFactor
+	}{
+		{golden{"first deposit inverts slashing", []string{"0", "100", halfWad}, "2000000000000000000", nil}, unset},
+		{golden{"first deposit fully slashed", []string{"0", "100", "0"}, "", ErrDivisionByZero}, unset},
+		{golden{"unslashed", []string{"100", "100", wadStr}, wadStr, nil}, unset},
+		// currentShares = 50, so (50 + 50) / 150 / 0.5 = 1.333...
+		{golden{"half slashed", []string{"100", "50", halfWad}, "1333333333333333332", nil}, unset},
+		{golden{"rounds to zero", []string{"100000000000000000", "0", "1"}, "", slashinglib.ErrInvalidDepositScalingFactor{}}, unset},
+		{golden{"deposit overflows", []string{"1", maxUint, wadStr}, "", ErrOverflow}, unset},
+	} {
+		updated, err := v.dsf.Update(n(t, v.args[0]), n(t, v.args[1]), n(t, v.args[2]))
+		var got *big.Int
+		if err == nil {
+			got = updated.Raw()
+		}
+		check(t, v.golden, got, err)
+	}
+}
+
+func TestAddInt128(t *testing.T) {
+	for _, v := range []struct {
+		a    uint64
+		b    *big.Int
+		want uint64
+		err  error
+	}{
+		{5, big.NewInt(-3), 2, nil},
+		{5, nil, 5, nil},
+		{5, big.NewInt(-6), 0, ErrUnderflow},
+		{^uint64(0), big.NewInt(1), 0, ErrOverflow},
+	} {
+		got, err := AddInt128(v.a, v.b)
+		if got != v.want || !errors.Is(err, v.err) {
+			t.Errorf("AddInt128(%d, %v): expected %d, %v, got %d, %v", v.a, v.b, v.want, v.err, got, err)
+		}
+	}
+}
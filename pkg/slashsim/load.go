@@ -0,0 +1,83 @@
+package slashsim
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Load reads the state a slash of operator in operatorSet over strategies
+// depends on, as of opts.BlockNumber or the latest block, for a slash mined
+// in the next block.
+func Load(ctx context.Context, src chainview.Source, opts *bind.CallOpts, operatorSet eltypes.OperatorSet, operator common.Address, strategies []common.Address) (*State, error) {
+	o, err := src.CallOpts(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	read := o.BlockNumber.Uint64()
+	am, dm := src.AllocationManager, src.DelegationManager
+
+	state := &State{
+		OperatorSet: operatorSet,
+		Operator:    operator,
+		Block:       uint32(read + 1),
+		Strategies:  make(map[common.Address]*StrategyState, len(strategies)),
+	}
+	if state.IsOperatorSet, err = am.IsOperatorSet(&o, operatorSet); err != nil {
+		return nil, fmt.Errorf("slashsim: failed to read operator set: %w", err)
+	}
+	if state.Slashable, err = am.IsOperatorSlashable(&o, operator, operatorSet); err != nil {
+		return nil, fmt.Errorf("slashsim: failed to read slashability of %s: %w", operator.Hex(), err)
+	}
+	if state.Slasher, err = am.GetSlasher(&o, operatorSet); err != nil {
+		return nil, fmt.Errorf("slashsim: failed to read slasher: %w", err)
+	}
+	if state.SlashCount, err = am.GetSlashCount(&o, operatorSet); err != nil {
+		return nil, fmt.Errorf("slashsim: failed to read slash count: %w", err)
+	}
+	if state.RedistributionRecipient, err = am.GetRedistributionRecipient(&o, operatorSet); err != nil {
+		return nil, fmt.Errorf("slashsim: failed to read redistribution recipient: %w", err)
+	}
+	inSet, err := am.GetStrategiesInOperatorSet(&o, operatorSet)
+	if err != nil {
+		return nil, fmt.Errorf("slashsim: failed to read operator set strategies: %w", err)
+	}
+	if state.MinWithdrawalDelayBlocks, err = dm.MinWithdrawalDelayBlocks(&o); err != nil {
+		return nil, fmt.Errorf("slashsim: failed to read withdrawal delay: %w", err)
+	}
+
+	operatorShares, err := dm.GetOperatorShares(&o, operator, strategies)
+	if err != nil {
+		return nil, fmt.Errorf("slashsim: failed to read operator shares of %s: %w", operator.Hex(), err)
+	}
+	if len(operatorShares) != len(strategies) {
+		return nil, fmt.Errorf("slashsim: expected %d operator shares, got %d", len(strategies), len(operatorShares))
+	}
+	for i, strategy := range strategies {
+		s := &StrategyState{OperatorShares: operatorShares[i]}
+		for _, member := range inSet {
+			s.InOperatorSet = s.InOperatorSet || member == strategy
+		}
+		if s.MaxMagnitude, err = am.GetMaxMagnitude(&o, operator, strategy); err != nil {
+			return nil, fmt.Errorf("slashsim: failed to read max magnitude in %s: %w", strategy.Hex(), err)
+		}
+		if s.EncumberedMagnitude, err = am.GetEncumberedMagnitude(&o, operator, strategy); err != nil {
+			return nil, fmt.Errorf("slashsim: failed to read encumbered magnitude in %s: %w", strategy.Hex(), err)
+		}
+		if s.Allocation, err = am.GetAllocation(&o, operator, operatorSet, strategy); err != nil {
+			return nil, fmt.Errorf("slashsim: failed to read allocation in %s: %w", strategy.Hex(), err)
+		}
+		state.Strategies[strategy] = s
+	}
+
+	queued, err := src.QueuedWithdrawals(ctx, read, state.Block, state.MinWithdrawalDelayBlocks, []common.Address{operator})
+	if err != nil {
+		return nil, err
+	}
+	state.QueuedWithdrawals = queued[operator]
+	return state, nil
+}
@@ -0,0 +1,190 @@
+//go:build !go1.23 || simulated
+
+package slashsim
+
+import (
+	"context"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// TestPreviewMatchesContract previews a slash over a pending deallocation and
+// withdrawals queued in and before the slashable window, then slashes and
+// checks the preview against the emitted events and the resulting state.
+func TestPreviewMatchesContract(t *testing.T) {
+	const delay = 5
+	h, err := harness.New(harness.Config{MinWithdrawalDelayBlocks: delay})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+
+	account := func() *bind.TransactOpts {
+		auth, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return auth
+	}
+	send := func(auth *bind.TransactOpts, build func(opts *bind.TransactOpts) (*types.Transaction, error)) *types.Receipt {
+		t.Helper()
+		receipt, err := h.SendAs(ctx, auth, build)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return receipt
+	}
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var deployed []harness.Strategy
+	for i := 0; i < 2; i++ {
+		s, err := h.DeployStrategy(ctx)
+		check(err)
+		deployed = append(deployed, s)
+	}
+	sort.Slice(deployed, func(i, j int) bool { return deployed[i].Address.Cmp(deployed[j].Address) < 0 })
+	strategies := []common.Address{deployed[0].Address, deployed[1].Address}
+
+	operator, avs := account(), account()
+	check(h.RegisterOperator(ctx, operator))
+	set, err := h.CreateOperatorSet(ctx, avs, 1, strategies)
+	check(err)
+	check(h.RegisterForOperatorSet(ctx, operator, set))
+	allocate := func(magnitudes ...uint64) {
+		send(operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.AllocationManager.ModifyAllocations(opts, operator.From, []eltypes.IAllocationManagerTypesAllocateParams{
+				{OperatorSet: set, Strategies: strategies, NewMagnitudes: magnitudes},
+			})
+		})
+	}
+	allocate(5e17, 1e18)
+
+	stakers := []*bind.TransactOpts{account(), account()}
+	for _, staker := range stakers {
+		for _, s := range deployed {
+			check(h.Deposit(ctx, staker, s, big.NewInt(1e18)))
+		}
+		check(h.Delegate(ctx, staker, operator.From))
+	}
+	queue := func(staker *bind.TransactOpts, shares ...int64) {
+		send(staker, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.DelegationManager.QueueWithdrawals(opts, []eltypes.IDelegationManagerTypesQueuedWithdrawalParams{{
+				Strategies:           strategies,
+				DepositShares:        []*big.Int{big.NewInt(shares[0]), big.NewInt(shares[1])},
+				DeprecatedWithdrawer: staker.From,
+			}})
+		})
+	}
+	queue(stakers[0], 4e17, 1e17)
+	// Push the first withdrawal out of the slashable window.
+	for i := 0; i < delay; i++ {
+		check(h.Deposit(ctx, stakers[0], deployed[0], big.NewInt(1)))
+	}
+	queue(stakers[1], 3e17, 2e17)
+	// Both stay pending for the deallocation delay, but are slashed with the rest.
+	allocate(4e17, 6e17)
+
+	state, err := Load(ctx, chainview.NewSource(h.Contracts, h.Client), nil, set, operator.From, strategies)
+	check(err)
+	if state.Slasher != avs.From || !state.Slashable || len(state.QueuedWithdrawals) != 1 {
+		t.Fatalf("Unexpected state: slasher %s, slashable %t, %d withdrawals", state.Slasher.Hex(), state.Slashable, len(state.QueuedWithdrawals))
+	}
+	slash := eltypes.IAllocationManagerTypesSlashingParams{
+		Operator:      operator.From,
+		OperatorSetId: set.Id,
+		Strategies:    strategies,
+		WadsToSlash:   []*big.Int{big.NewInt(3e17), big.NewInt(5e17)},
+		Description:   "preview",
+	}
+	preview, err := Preview(state, slash)
+	check(err)
+	for _, r := range preview.Strategies {
+		if len(r.Withdrawals) != 1 || r.Withdrawals[0].Staker != stakers[1].From {
+			t.Errorf("%s: expected only the second withdrawal to be hit, got %+v", r.Strategy.Hex(), r.Withdrawals)
+		}
+	}
+
+	receipt := send(avs, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.AllocationManager.SlashOperator(opts, avs.From, slash)
+	})
+	if block := receipt.BlockNumber.Uint64(); block != uint64(state.Block) {
+		t.Fatalf("Expected the slash in block %d, got %d", state.Block, block)
+	}
+
+	slashed := make(map[common.Address]*big.Int)
+	burned := make(map[common.Address]*big.Int)
+	var wadSlashed []*big.Int
+	for _, log := range receipt.Logs {
+		if e, err := h.DelegationManager.ParseOperatorSharesSlashed(*log); err == nil && log.Address == h.Deployment.Core.DelegationManager.Proxy {
+			slashed[e.Strategy] = e.TotalSlashedShares
+		}
+		if e, err := h.StrategyManager.ParseBurnOrRedistributableSharesIncreased(*log); err == nil && log.Address == h.Deployment.Core.StrategyManager.Proxy {
+			if e.SlashId.Cmp(preview.SlashID) != 0 {
+				t.Errorf("Expected slash id %s, got %s", preview.SlashID, e.SlashId)
+			}
+			burned[e.Strategy] = e.Shares
+		}
+		if e, err := h.AllocationManager.ParseOperatorSlashed(*log); err == nil && log.Address == h.Deployment.Core.AllocationManager.Proxy {
+			wadSlashed = e.WadSlashed
+		}
+	}
+	if len(wadSlashed) != len(strategies) {
+		t.Fatalf("Expected an OperatorSlashed event over %d strategies, got %d", len(strategies), len(wadSlashed))
+	}
+
+	opts := &bind.CallOpts{Context: ctx}
+	count, err := h.AllocationManager.GetSlashCount(opts, set)
+	check(err)
+	if count.Cmp(preview.SlashID) != 0 {
+		t.Errorf("Expected slash count %s, got %s", preview.SlashID, count)
+	}
+	shares, err := h.DelegationManager.GetOperatorShares(opts, operator.From, strategies)
+	check(err)
+	for i, r := range preview.Strategies {
+		s := r.Strategy
+		if r.WadSlashed.Cmp(wadSlashed[i]) != 0 {
+			t.Errorf("%s: expected wad slashed %s, got %s", s.Hex(), wadSlashed[i], r.WadSlashed)
+		}
+		if slashed[s] == nil || r.TotalSharesSlashed.Cmp(slashed[s]) != 0 || burned[s] == nil || r.TotalSharesSlashed.Cmp(burned[s]) != 0 {
+			t.Errorf("%s: expected %s shares slashed and %s burned, got %s", s.Hex(), slashed[s], burned[s], r.TotalSharesSlashed)
+		}
+		if r.QueuedSharesSlashed.Sign() == 0 {
+			t.Errorf("%s: expected queued shares to be slashed", s.Hex())
+		}
+		if r.OperatorSharesAfter.Cmp(shares[i]) != 0 {
+			t.Errorf("%s: expected %s operator shares after, got %s", s.Hex(), shares[i], r.OperatorSharesAfter)
+		}
+
+		max, err := h.AllocationManager.GetMaxMagnitude(opts, operator.From, s)
+		check(err)
+		encumbered, err := h.AllocationManager.GetEncumberedMagnitude(opts, operator.From, s)
+		check(err)
+		alloc, err := h.AllocationManager.GetAllocation(opts, operator.From, set, s)
+		check(err)
+		if r.MaxMagnitudeAfter != max || r.EncumberedMagnitudeAfter != encumbered {
+			t.Errorf("%s: expected max %d and encumbered %d, got %d and %d", s.Hex(), max, encumbered, r.MaxMagnitudeAfter, r.EncumberedMagnitudeAfter)
+		}
+		after := r.AllocationAfter
+		if after.CurrentMagnitude != alloc.CurrentMagnitude || after.PendingDiff.Cmp(alloc.PendingDiff) != 0 || after.EffectBlock != alloc.EffectBlock {
+			t.Errorf("%s: expected allocation %+v, got %+v", s.Hex(), alloc, after)
+		}
+	}
+	if p := preview.Strategies[1].AllocationBefore.PendingDiff; p.Sign() >= 0 {
+		t.Errorf("Expected a pending deallocation, got %s", p)
+	}
+}
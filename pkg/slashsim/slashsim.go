@@ -0,0 +1,333 @@
+// Package slashsim previews AllocationManager.slashOperator offline, so a
+// slasher can check WadsToSlash before submitting.
+//
+// Preview replays _slashOperator and DelegationManager.slashOperatorShares
+// over a State, reverting where the contracts would with the typed
+// AllocationManager errors, and reports per strategy the operator's
+// magnitudes before and after, the OperatorSharesSlashed amount, the queued
+// withdrawals it reaches and the shares handed to the StrategyManager (or
+// the EigenPodManager for beacon chain ETH) to burn or redistribute:
+//
+//	src := chainview.NewSource(contracts, client)
+//	state, err := slashsim.Load(ctx, src, nil, operatorSet, params.Operator, params.Strategies)
+//	if err != nil {
+//		return err
+//	}
+//	preview, err := slashsim.Preview(state, params)
+//
+// The caller is not checked against the operator set's slasher; State.Slasher
+// holds it for the caller to compare.
+package slashsim
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/slashing"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// DefaultBurnAddress receives the slashed funds of operator sets that do not
+// redistribute.
+var DefaultBurnAddress = common.HexToAddress("0x00000000000000000000000000000000000E16E4")
+
+var (
+	// ErrStateMismatch is returned when the params name another operator or
+	// operator set than the State was loaded for.
+	ErrStateMismatch = errors.New("slashsim: params do not match state")
+	// ErrMissingStrategy is returned when a slashed strategy has no
+	// StrategyState.
+	ErrMissingStrategy = errors.New("slashsim: no state for strategy")
+)
+
+// State is the chain state a slash of one operator in one operator set
+// depends on.
+type State struct {
+	OperatorSet eltypes.OperatorSet
+	Operator    common.Address
+	// Block is the block.number the slash executes in.
+	Block                    uint32
+	MinWithdrawalDelayBlocks uint32
+
+	IsOperatorSet bool
+	// Slashable is AllocationManager.isOperatorSlashable.
+	Slashable bool
+	Slasher   common.Address
+	// SlashCount is the number of slashes of the operator set so far.
+	SlashCount *big.Int
+	// RedistributionRecipient is DefaultBurnAddress unless the operator set
+	// redistributes.
+	RedistributionRecipient common.Address
+
+	Strategies map[common.Address]*StrategyState
+	// QueuedWithdrawals are the withdrawals delegated to Operator queued at
+	// or after Block - MinWithdrawalDelayBlocks, which are still slashable.
+	// Others are ignored.
+	QueuedWithdrawals []QueuedWithdrawal
+}
+
+// StrategyState is the operator's state in one strategy.
+type StrategyState struct {
+	InOperatorSet bool
+	MaxMagnitude  uint64
+	// EncumberedMagnitude is as AllocationManager.getEncumberedMagnitude
+	// reports it, with completable deallocations applied.
+	EncumberedMagnitude uint64
+	// Allocation is the operator set's allocation, as
+	// AllocationManager.getAllocation reports it. A pending change that takes
+	// effect by Block is applied by Preview.
+	Allocation     eltypes.IAllocationManagerTypesAllocation
+	OperatorShares *big.Int
+}
+
+// QueuedWithdrawal is a queued withdrawal and its root.
+type QueuedWithdrawal = chainview.QueuedWithdrawal
+
+// Result is the preview of a slash.
+type Result struct {
+	// SlashID is the id the slash is recorded under.
+	SlashID *big.Int
+	// Recipient receives the slashed funds once they are cleared.
+	Recipient      common.Address
+	Redistributing bool
+	// Strategies follows the order of SlashingParams.Strategies.
+	Strategies []StrategyResult
+}
+
+// StrategyResult is the effect of a slash on one strategy.
+type StrategyResult struct {
+	Strategy   common.Address
+	WadToSlash *big.Int
+	// Skipped is set when the operator had no magnitude allocated, in which
+	// case the strategy is left untouched.
+	Skipped bool
+
+	// SlashedMagnitude is taken from the allocation, the max magnitude and
+	// the encumbered magnitude alike. WadSlashed is the proportion of the max
+	// magnitude it represents, as reported in OperatorSlashed.
+	SlashedMagnitude uint64
+	WadSlashed       *big.Int
+
+	MaxMagnitudeBefore, MaxMagnitudeAfter               uint64
+	EncumberedMagnitudeBefore, EncumberedMagnitudeAfter uint64
+	AllocationBefore, AllocationAfter                   eltypes.IAllocationManagerTypesAllocation
+
+	OperatorSharesBefore, OperatorSharesAfter *big.Int
+	// OperatorSharesSlashed is taken from the operator's delegated shares,
+	// QueuedSharesSlashed from withdrawals still in the slashable window.
+	OperatorSharesSlashed *big.Int
+	QueuedSharesSlashed   *big.Int
+	// TotalSharesSlashed is the amount emitted in OperatorSharesSlashed and
+	// added to the burn or redistributable shares of the StrategyManager, or
+	// to burnableETHShares of the EigenPodManager for beacon chain ETH.
+	TotalSharesSlashed *big.Int
+
+	// Withdrawals are the queued withdrawals in the slashable window holding
+	// the strategy. Their Slashed amounts are computed one by one and may sum
+	// to slightly less than QueuedSharesSlashed, which the contract computes
+	// over their total.
+	Withdrawals []WithdrawalHit
+}
+
+// WithdrawalHit is the effect of a slash on one queued withdrawal.
+type WithdrawalHit struct {
+	Root         [32]byte
+	Staker       common.Address
+	StartBlock   uint32
+	ScaledShares *big.Int
+	Slashed      *big.Int
+}
+
+// Preview computes the effect of the operator set's AVS calling
+// slashOperator with params on state. It returns the error the contract
+// would revert with, if any.
+func Preview(state *State, params eltypes.IAllocationManagerTypesSlashingParams) (*Result, error) {
+	if len(params.Strategies) != len(params.WadsToSlash) {
+		return nil, allocationmanager.ErrInputArrayLengthMismatch{}
+	}
+	if params.Operator != state.Operator || params.OperatorSetId != state.OperatorSet.Id {
+		return nil, fmt.Errorf("%w: state is of %s in operator set %d", ErrStateMismatch, state.Operator.Hex(), state.OperatorSet.Id)
+	}
+	if !state.IsOperatorSet {
+		return nil, allocationmanager.ErrInvalidOperatorSet{}
+	}
+	if !state.Slashable {
+		return nil, allocationmanager.ErrOperatorNotSlashable{}
+	}
+
+	slashID := new(big.Int).Add(slashing.OrZero(state.SlashCount), big.NewInt(1))
+	recipient := state.RedistributionRecipient
+	if recipient == (common.Address{}) {
+		recipient = DefaultBurnAddress
+	}
+	result := &Result{
+		SlashID:        slashID,
+		Recipient:      recipient,
+		Redistributing: recipient != DefaultBurnAddress,
+		Strategies:     make([]StrategyResult, len(params.Strategies)),
+	}
+
+	wad := big.NewInt(slashing.WAD)
+	for i, strategy := range params.Strategies {
+		if i > 0 && strategy.Cmp(params.Strategies[i-1]) <= 0 {
+			return nil, allocationmanager.ErrStrategiesMustBeInAscendingOrder{}
+		}
+		wadToSlash := params.WadsToSlash[i]
+		if wadToSlash == nil || wadToSlash.Sign() <= 0 || wadToSlash.Cmp(wad) > 0 {
+			return nil, allocationmanager.ErrInvalidWadToSlash{}
+		}
+		s, ok := state.Strategies[strategy]
+		if !ok {
+			return nil, fmt.Errorf("%w %s", ErrMissingStrategy, strategy.Hex())
+		}
+		if !s.InOperatorSet {
+			return nil, allocationmanager.ErrStrategyNotInOperatorSet{}
+		}
+
+		r, err := previewStrategy(state, s, strategy, wadToSlash)
+		if err != nil {
+			return nil, fmt.Errorf("slashsim: %s: %w", strategy.Hex(), err)
+		}
+		result.Strategies[i] = *r
+	}
+	return result, nil
+}
+
+func previewStrategy(state *State, s *StrategyState, strategy common.Address, wadToSlash *big.Int) (*StrategyResult, error) {
+	allocation := copyAllocation(s.Allocation)
+	encumbered := s.EncumberedMagnitude
+	// Complete a pending change that takes effect by the slash's block, as
+	// _getUpdatedAllocation does.
+	if pending := slashing.OrZero(allocation.PendingDiff); pending.Sign() != 0 && state.Block >= allocation.EffectBlock {
+		current, err := slashing.AddInt128(allocation.CurrentMagnitude, pending)
+		if err != nil {
+			return nil, err
+		}
+		if pending.Sign() < 0 {
+			if encumbered, err = slashing.AddInt128(encumbered, pending); err != nil {
+				return nil, err
+			}
+		}
+		allocation = eltypes.IAllocationManagerTypesAllocation{CurrentMagnitude: current, PendingDiff: new(big.Int)}
+	}
+
+	operatorShares := slashing.OrZero(s.OperatorShares)
+	r := &StrategyResult{
+		Strategy:                  strategy,
+		WadToSlash:                new(big.Int).Set(wadToSlash),
+		WadSlashed:                new(big.Int),
+		MaxMagnitudeBefore:        s.MaxMagnitude,
+		MaxMagnitudeAfter:         s.MaxMagnitude,
+		EncumberedMagnitudeBefore: encumbered,
+		EncumberedMagnitudeAfter:  encumbered,
+		AllocationBefore:          allocation,
+		AllocationAfter:           copyAllocation(allocation),
+		OperatorSharesBefore:      operatorShares,
+		OperatorSharesAfter:       new(big.Int).Set(operatorShares),
+		OperatorSharesSlashed:     new(big.Int),
+		QueuedSharesSlashed:       new(big.Int),
+		TotalSharesSlashed:        new(big.Int),
+	}
+	if allocation.CurrentMagnitude == 0 {
+		r.Skipped = true
+		return r, nil
+	}
+
+	slashed, err := slashing.MulWadRoundUp(new(big.Int).SetUint64(allocation.CurrentMagnitude), wadToSlash)
+	if err != nil {
+		return nil, err
+	}
+	prevMax := new(big.Int).SetUint64(s.MaxMagnitude)
+	if r.WadSlashed, err = slashing.DivWad(slashed, prevMax); err != nil {
+		return nil, err
+	}
+	r.SlashedMagnitude = slashed.Uint64()
+	if r.SlashedMagnitude > s.MaxMagnitude || r.SlashedMagnitude > encumbered {
+		return nil, slashing.ErrUnderflow
+	}
+	r.MaxMagnitudeAfter = s.MaxMagnitude - r.SlashedMagnitude
+	r.EncumberedMagnitudeAfter = encumbered - r.SlashedMagnitude
+	r.AllocationAfter.CurrentMagnitude -= r.SlashedMagnitude
+
+	// A pending deallocation shrinks by the same proportion.
+	if pending := r.AllocationAfter.PendingDiff; pending.Sign() < 0 {
+		slashedPending, err := slashing.MulWadRoundUp(new(big.Int).Neg(pending), wadToSlash)
+		if err != nil {
+			return nil, err
+		}
+		r.AllocationAfter.PendingDiff = new(big.Int).Add(pending, slashedPending)
+	}
+
+	newMax := new(big.Int).SetUint64(r.MaxMagnitudeAfter)
+	if r.OperatorSharesSlashed, err = slashing.CalcSlashedAmount(operatorShares, prevMax, newMax); err != nil {
+		return nil, err
+	}
+	r.OperatorSharesAfter = new(big.Int).Sub(operatorShares, r.OperatorSharesSlashed)
+
+	if r.QueuedSharesSlashed, r.Withdrawals, err = slashQueue(state, strategy, prevMax, newMax); err != nil {
+		return nil, err
+	}
+	r.TotalSharesSlashed = new(big.Int).Add(r.OperatorSharesSlashed, r.QueuedSharesSlashed)
+	return r, nil
+}
+
+// slashQueue mirrors DelegationManager._getSlashableSharesInQueue and
+// attributes the slashed shares to individual withdrawals.
+func slashQueue(state *State, strategy common.Address, prevMax, newMax *big.Int) (*big.Int, []WithdrawalHit, error) {
+	if prevMax.Sign() == 0 {
+		return new(big.Int), nil, nil
+	}
+	// The contract looks up the queue as of Block - delay - 1.
+	if uint64(state.Block) < uint64(state.MinWithdrawalDelayBlocks)+1 {
+		return nil, nil, slashing.ErrUnderflow
+	}
+	windowStart := state.Block - state.MinWithdrawalDelayBlocks
+
+	total := new(big.Int)
+	var hits []WithdrawalHit
+	for _, q := range state.QueuedWithdrawals {
+		w := q.Withdrawal
+		if w.DelegatedTo != state.Operator || w.StartBlock < windowStart {
+			continue
+		}
+		for j, s := range w.Strategies {
+			if s != strategy || j >= len(w.ScaledShares) {
+				continue
+			}
+			total.Add(total, w.ScaledShares[j])
+			slashed, err := slashQueued(w.ScaledShares[j], prevMax, newMax)
+			if err != nil {
+				return nil, nil, err
+			}
+			hits = append(hits, WithdrawalHit{
+				Root:         q.Root,
+				Staker:       w.Staker,
+				StartBlock:   w.StartBlock,
+				ScaledShares: new(big.Int).Set(w.ScaledShares[j]),
+				Slashed:      slashed,
+			})
+		}
+	}
+	slashed, err := slashQueued(total, prevMax, newMax)
+	if err != nil {
+		return nil, nil, err
+	}
+	return slashed, hits, nil
+}
+
+func slashQueued(scaledShares, prevMax, newMax *big.Int) (*big.Int, error) {
+	withdrawable, err := slashing.MulWad(scaledShares, prevMax)
+	if err != nil {
+		return nil, err
+	}
+	return slashing.CalcSlashedAmount(withdrawable, prevMax, newMax)
+}
+
+func copyAllocation(a eltypes.IAllocationManagerTypesAllocation) eltypes.IAllocationManagerTypesAllocation {
+	a.PendingDiff = new(big.Int).Set(slashing.OrZero(a.PendingDiff))
+	return a
+}
@@ -0,0 +1,161 @@
+package slashsim
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/slashing"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	operator = common.HexToAddress("0x09")
+	avs      = common.HexToAddress("0xa5")
+	s1       = common.HexToAddress("0x1")
+	s2       = common.HexToAddress("0x2")
+	s3       = common.HexToAddress("0x3")
+)
+
+func e18(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func allocation(current uint64, pending int64, effect uint32) eltypes.IAllocationManagerTypesAllocation {
+	return eltypes.IAllocationManagerTypesAllocation{CurrentMagnitude: current, PendingDiff: big.NewInt(pending), EffectBlock: effect}
+}
+
+func withdrawal(delegatedTo common.Address, start uint32, scaled *big.Int) QueuedWithdrawal {
+	return QueuedWithdrawal{
+		Root: [32]byte{byte(start)},
+		Withdrawal: eltypes.IDelegationManagerTypesWithdrawal{
+			DelegatedTo:  delegatedTo,
+			StartBlock:   start,
+			Strategies:   []common.Address{s1},
+			ScaledShares: []*big.Int{scaled},
+		},
+	}
+}
+
+func testState() *State {
+	return &State{
+		OperatorSet:              eltypes.OperatorSet{Avs: avs, Id: 1},
+		Operator:                 operator,
+		Block:                    1000,
+		MinWithdrawalDelayBlocks: 100,
+		IsOperatorSet:            true,
+		Slashable:                true,
+		Slasher:                  avs,
+		SlashCount:               big.NewInt(2),
+		Strategies: map[common.Address]*StrategyState{
+			s1: {InOperatorSet: true, MaxMagnitude: 1e18, EncumberedMagnitude: 6e17, Allocation: allocation(5e17, 0, 0), OperatorShares: e18(1000)},
+			s2: {InOperatorSet: true, MaxMagnitude: 5e17, EncumberedMagnitude: 5e17, Allocation: allocation(4e17, -2e17, 2000), OperatorShares: e18(10)},
+			s3: {InOperatorSet: true, MaxMagnitude: 1e18, OperatorShares: e18(1)},
+		},
+		QueuedWithdrawals: []QueuedWithdrawal{
+			withdrawal(operator, 950, e18(100)),
+			// Queued before the window, so no longer slashable.
+			withdrawal(operator, 899, e18(7)),
+			withdrawal(common.HexToAddress("0x0a"), 990, e18(7)),
+		},
+	}
+}
+
+func slashParams(strategies []common.Address, wads ...int64) eltypes.IAllocationManagerTypesSlashingParams {
+	p := eltypes.IAllocationManagerTypesSlashingParams{Operator: operator, OperatorSetId: 1, Strategies: strategies}
+	for _, w := range wads {
+		p.WadsToSlash = append(p.WadsToSlash, big.NewInt(w))
+	}
+	return p
+}
+
+func TestPreview(t *testing.T) {
+	result, err := Preview(testState(), slashParams([]common.Address{s1, s2, s3}, 5e17, 1e18, 1e18))
+	if err != nil {
+		t.Fatalf("Preview failed: %v", err)
+	}
+	if result.SlashID.Int64() != 3 || result.Recipient != DefaultBurnAddress || result.Redistributing {
+		t.Errorf("Expected slash 3 burned, got %s to %s", result.SlashID, result.Recipient.Hex())
+	}
+
+	r := result.Strategies[0]
+	if r.SlashedMagnitude != 25e16 || r.WadSlashed.Int64() != 25e16 {
+		t.Errorf("Expected a quarter of the magnitude slashed, got %d (%s)", r.SlashedMagnitude, r.WadSlashed)
+	}
+	if r.MaxMagnitudeAfter != 75e16 || r.EncumberedMagnitudeAfter != 35e16 || r.AllocationAfter.CurrentMagnitude != 25e16 {
+		t.Errorf("Unexpected magnitudes after: max %d, encumbered %d, allocated %d", r.MaxMagnitudeAfter, r.EncumberedMagnitudeAfter, r.AllocationAfter.CurrentMagnitude)
+	}
+	if r.OperatorSharesSlashed.Cmp(e18(250)) != 0 || r.OperatorSharesAfter.Cmp(e18(750)) != 0 {
+		t.Errorf("Expected 250e18 of 1000e18 operator shares slashed, got %s leaving %s", r.OperatorSharesSlashed, r.OperatorSharesAfter)
+	}
+	if r.QueuedSharesSlashed.Cmp(e18(25)) != 0 || r.TotalSharesSlashed.Cmp(e18(275)) != 0 {
+		t.Errorf("Expected 25e18 queued and 275e18 total shares slashed, got %s and %s", r.QueuedSharesSlashed, r.TotalSharesSlashed)
+	}
+	if len(r.Withdrawals) != 1 || r.Withdrawals[0].StartBlock != 950 || r.Withdrawals[0].Slashed.Cmp(e18(25)) != 0 {
+		t.Errorf("Expected only the withdrawal in the window to be hit, got %+v", r.Withdrawals)
+	}
+
+	// The whole allocation goes, and the pending deallocation with it.
+	r = result.Strategies[1]
+	if r.SlashedMagnitude != 4e17 || r.WadSlashed.Int64() != 8e17 || r.MaxMagnitudeAfter != 1e17 || r.EncumberedMagnitudeAfter != 1e17 {
+		t.Errorf("Unexpected magnitudes: slashed %d (%s), max %d, encumbered %d", r.SlashedMagnitude, r.WadSlashed, r.MaxMagnitudeAfter, r.EncumberedMagnitudeAfter)
+	}
+	if r.AllocationAfter.CurrentMagnitude != 0 || r.AllocationAfter.PendingDiff.Sign() != 0 || r.AllocationAfter.EffectBlock != 2000 {
+		t.Errorf("Expected the allocation and pending deallocation to be slashed away, got %+v", r.AllocationAfter)
+	}
+	if r.OperatorSharesSlashed.Cmp(e18(8)) != 0 || r.QueuedSharesSlashed.Sign() != 0 {
+		t.Errorf("Expected 8e18 operator shares slashed, got %s and %s queued", r.OperatorSharesSlashed, r.QueuedSharesSlashed)
+	}
+
+	if r = result.Strategies[2]; !r.Skipped || r.TotalSharesSlashed.Sign() != 0 || r.MaxMagnitudeAfter != 1e18 {
+		t.Errorf("Expected an unallocated strategy to be skipped, got %+v", r)
+	}
+}
+
+func TestPreviewCompletesPendingChange(t *testing.T) {
+	state := testState()
+	state.Strategies[s2].Allocation = allocation(3e17, -1e17, 1000)
+	result, err := Preview(state, slashParams([]common.Address{s2}, 5e17))
+	if err != nil {
+		t.Fatalf("Preview failed: %v", err)
+	}
+	r := result.Strategies[0]
+	if r.AllocationBefore.CurrentMagnitude != 2e17 || r.EncumberedMagnitudeBefore != 4e17 {
+		t.Errorf("Expected the deallocation to complete first, got %+v and encumbered %d", r.AllocationBefore, r.EncumberedMagnitudeBefore)
+	}
+	if r.SlashedMagnitude != 1e17 || r.EncumberedMagnitudeAfter != 3e17 {
+		t.Errorf("Expected 1e17 slashed from the completed allocation, got %d leaving %d encumbered", r.SlashedMagnitude, r.EncumberedMagnitudeAfter)
+	}
+}
+
+func TestPreviewReverts(t *testing.T) {
+	for _, v := range []struct {
+		name   string
+		modify func(*State)
+		params eltypes.IAllocationManagerTypesSlashingParams
+		err    error
+	}{
+		{"length mismatch", nil, slashParams([]common.Address{s1, s2}, 1), allocationmanager.ErrInputArrayLengthMismatch{}},
+		{"no operator set", func(s *State) { s.IsOperatorSet = false }, slashParams([]common.Address{s1}, 1), allocationmanager.ErrInvalidOperatorSet{}},
+		{"not slashable", func(s *State) { s.Slashable = false }, slashParams([]common.Address{s1}, 1), allocationmanager.ErrOperatorNotSlashable{}},
+		{"descending", nil, slashParams([]common.Address{s2, s1}, 1, 1), allocationmanager.ErrStrategiesMustBeInAscendingOrder{}},
+		{"duplicate", nil, slashParams([]common.Address{s1, s1}, 1, 1), allocationmanager.ErrStrategiesMustBeInAscendingOrder{}},
+		{"zero wad", nil, slashParams([]common.Address{s1}, 0), allocationmanager.ErrInvalidWadToSlash{}},
+		{"wad above one", nil, slashParams([]common.Address{s1}, 1e18+1), allocationmanager.ErrInvalidWadToSlash{}},
+		{"not in set", func(s *State) { s.Strategies[s1].InOperatorSet = false }, slashParams([]common.Address{s1}, 1), allocationmanager.ErrStrategyNotInOperatorSet{}},
+		{"unknown strategy", nil, slashParams([]common.Address{common.HexToAddress("0x4")}, 1), ErrMissingStrategy},
+		{"other operator", func(s *State) { s.Operator = avs }, slashParams([]common.Address{s1}, 1), ErrStateMismatch},
+		// The queue lookup underflows on a chain younger than the delay.
+		{"young chain", func(s *State) { s.Block = 100 }, slashParams([]common.Address{s1}, 1), slashing.ErrUnderflow},
+	} {
+		state := testState()
+		if v.modify != nil {
+			v.modify(state)
+		}
+		if _, err := Preview(state, v.params); !errors.Is(err, v.err) {
+			t.Errorf("%s: expected %v, got %v", v.name, v.err, err)
+		}
+	}
+}
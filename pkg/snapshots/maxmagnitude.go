@@ -0,0 +1,108 @@
+package snapshots
+
+import (
+	"context"
+	"fmt"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxMagnitudes holds the max magnitude history of every operator and
+// strategy, like AllocationManager._maxMagnitudeHistory. Pairs without
+// events read as WAD. The zero value is empty and ready to use.
+//
+// The histories are only complete if every MaxMagnitudeUpdated event since
+// the AllocationManager's deployment is applied; lookups before the first
+// applied event otherwise return WAD rather than the value then.
+type MaxMagnitudes struct {
+	histories map[common.Address]map[common.Address]*DefaultWadHistory
+}
+
+// LoadMaxMagnitudes builds the histories from the MaxMagnitudeUpdated
+// events am emitted within opts.
+func LoadMaxMagnitudes(ctx context.Context, am allocationmanager.AllocationManagerEvents, opts *bind.FilterOpts) (*MaxMagnitudes, error) {
+	o := bind.FilterOpts{Context: ctx}
+	if opts != nil {
+		o = *opts
+		if o.Context == nil {
+			o.Context = ctx
+		}
+	}
+	it, err := am.FilterMaxMagnitudeUpdated(&o)
+	if err != nil {
+		return nil, fmt.Errorf("snapshots: failed to read max magnitude updates: %w", err)
+	}
+	defer it.Close()
+	m := new(MaxMagnitudes)
+	for it.Next() {
+		if err := m.Apply(it.Event); err != nil {
+			return nil, err
+		}
+	}
+	if err := it.Error(); err != nil {
+		return nil, fmt.Errorf("snapshots: failed to read max magnitude updates: %w", err)
+	}
+	return m, nil
+}
+
+// Apply pushes a MaxMagnitudeUpdated event, keyed by the block it was
+// emitted in. Events must be applied in log order.
+func (m *MaxMagnitudes) Apply(e *allocationmanager.AllocationManagerMaxMagnitudeUpdated) error {
+	block := e.Raw.BlockNumber
+	if block > uint64(^uint32(0)) {
+		return fmt.Errorf("snapshots: block %d does not fit a snapshot key", block)
+	}
+	if err := m.Push(e.Operator, e.Strategy, uint32(block), e.MaxMagnitude); err != nil {
+		return fmt.Errorf("snapshots: %s in %s at block %d: %w", e.Operator.Hex(), e.Strategy.Hex(), block, err)
+	}
+	return nil
+}
+
+// Push records operator's max magnitude in strategy as of block.
+func (m *MaxMagnitudes) Push(operator, strategy common.Address, block uint32, maxMagnitude uint64) error {
+	if m.histories == nil {
+		m.histories = make(map[common.Address]map[common.Address]*DefaultWadHistory)
+	}
+	byStrategy, ok := m.histories[operator]
+	if !ok {
+		byStrategy = make(map[common.Address]*DefaultWadHistory)
+		m.histories[operator] = byStrategy
+	}
+	h, ok := byStrategy[strategy]
+	if !ok {
+		h = new(DefaultWadHistory)
+		byStrategy[strategy] = h
+	}
+	return h.Push(block, maxMagnitude)
+}
+
+// History returns operator's history in strategy, empty if it has none.
+func (m *MaxMagnitudes) History(operator, strategy common.Address) *DefaultWadHistory {
+	if h, ok := m.histories[operator][strategy]; ok {
+		return h
+	}
+	return new(DefaultWadHistory)
+}
+
+// UpperLookup returns operator's max magnitude in strategy as of block.
+func (m *MaxMagnitudes) UpperLookup(operator, strategy common.Address, block uint32) uint64 {
+	return m.History(operator, strategy).UpperLookup(block)
+}
+
+// Latest returns operator's current max magnitude in strategy, like
+// AllocationManager.getMaxMagnitude.
+func (m *MaxMagnitudes) Latest(operator, strategy common.Address) uint64 {
+	return m.History(operator, strategy).Latest()
+}
+
+// AtBlock returns operator's max magnitudes in strategies as of block, like
+// AllocationManager.getMaxMagnitudesAtBlock.
+func (m *MaxMagnitudes) AtBlock(operator common.Address, strategies []common.Address, block uint32) []uint64 {
+	out := make([]uint64, len(strategies))
+	for i, strategy := range strategies {
+		out[i] = m.UpperLookup(operator, strategy, block)
+	}
+	return out
+}
@@ -0,0 +1,91 @@
+//go:build !go1.23 || simulated
+
+package snapshots
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// TestMaxMagnitudesMatchContract slashes an operator twice and checks the
+// rebuilt history against getMaxMagnitudesAtBlock at every block.
+func TestMaxMagnitudesMatchContract(t *testing.T) {
+	h, err := harness.New(harness.Config{MinWithdrawalDelayBlocks: 1})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	account := func() *bind.TransactOpts {
+		auth, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+		check(err)
+		return auth
+	}
+
+	strategy, err := h.DeployStrategy(ctx)
+	check(err)
+	strategies := []common.Address{strategy.Address}
+	operator, avs := account(), account()
+	check(h.RegisterOperator(ctx, operator))
+	set, err := h.CreateOperatorSet(ctx, avs, 1, strategies)
+	check(err)
+	check(h.RegisterForOperatorSet(ctx, operator, set))
+	_, err = h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.AllocationManager.ModifyAllocations(opts, operator.From, []eltypes.IAllocationManagerTypesAllocateParams{
+			{OperatorSet: set, Strategies: strategies, NewMagnitudes: []uint64{5e17}},
+		})
+	})
+	check(err)
+
+	start, err := h.Client.BlockNumber(ctx)
+	check(err)
+	for _, wad := range []int64{5e17, 2e17} {
+		h.Backend.Commit()
+		_, err := h.SendAs(ctx, avs, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.AllocationManager.SlashOperator(opts, avs.From, eltypes.IAllocationManagerTypesSlashingParams{
+				Operator:      operator.From,
+				OperatorSetId: set.Id,
+				Strategies:    strategies,
+				WadsToSlash:   []*big.Int{big.NewInt(wad)},
+				Description:   "test",
+			})
+		})
+		check(err)
+	}
+	h.Backend.Commit()
+	head, err := h.Client.BlockNumber(ctx)
+	check(err)
+
+	m, err := LoadMaxMagnitudes(ctx, h.AllocationManager, nil)
+	check(err)
+	if n := m.History(operator.From, strategy.Address).Length(); n != 2 {
+		t.Fatalf("Expected 2 snapshots, got %d", n)
+	}
+	for block := uint32(start); block <= uint32(head); block++ {
+		want, err := h.AllocationManager.GetMaxMagnitudesAtBlock(&bind.CallOpts{Context: ctx}, operator.From, strategies, block)
+		check(err)
+		if got := m.AtBlock(operator.From, strategies, block); got[0] != want[0] {
+			t.Errorf("Block %d: expected max magnitude %d, got %d", block, want[0], got[0])
+		}
+	}
+	want, err := h.AllocationManager.GetMaxMagnitude(&bind.CallOpts{Context: ctx}, operator.From, strategy.Address)
+	check(err)
+	if got := m.Latest(operator.From, strategy.Address); got != want || got == 1e18 {
+		t.Errorf("Expected a slashed latest max magnitude %d, got %d", want, got)
+	}
+}
@@ -0,0 +1,153 @@
+// Package snapshots is a Go port of the Snapshots library the
+// AllocationManager keeps max magnitude histories in and the
+// DelegationManager cumulative scaled shares histories.
+//
+// A history is a list of (block, value) snapshots with non-decreasing keys,
+// where pushing a key equal to the last one overwrites it. UpperLookup
+// returns the value of the last snapshot at or before a block, or the
+// history's default when there is none: WAD for a DefaultWadHistory and 0
+// for a DefaultZeroHistory.
+//
+// MaxMagnitudes rebuilds the AllocationManager's max magnitude histories
+// from MaxMagnitudeUpdated events, which the contract emits on every push,
+// so getMaxMagnitudesAtBlock can be answered for any block without an
+// archive node:
+//
+//	history, err := snapshots.LoadMaxMagnitudes(ctx, contracts.AllocationManager, &bind.FilterOpts{Start: deploymentBlock})
+//	if err != nil {
+//		return err
+//	}
+//	magnitude := history.UpperLookup(operator, strategy, block)
+package snapshots
+
+import (
+	"errors"
+	"math/big"
+	"sort"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/slashing"
+)
+
+// ErrInvalidSnapshotOrdering mirrors the InvalidSnapshotOrdering revert of
+// a push with a key lower than the last snapshot's.
+var ErrInvalidSnapshotOrdering = errors.New("snapshots: invalid snapshot ordering")
+
+// maxUint224 bounds the values a DefaultZeroHistory stores.
+var maxUint224 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 224), big.NewInt(1))
+
+// Snapshot is a value as of a block.
+type Snapshot[V any] struct {
+	Key   uint32
+	Value V
+}
+
+// DefaultWadHistory is a history of uint64 values that reads as WAD when
+// empty, like the AllocationManager's max magnitudes. The zero value is an
+// empty history.
+type DefaultWadHistory struct {
+	snapshots []Snapshot[uint64]
+}
+
+// Push records value as of key.
+func (h *DefaultWadHistory) Push(key uint32, value uint64) error {
+	return insert(&h.snapshots, key, value)
+}
+
+// UpperLookup returns the value of the last snapshot with a key lower than
+// or equal to key, or WAD if there is none.
+func (h *DefaultWadHistory) UpperLookup(key uint32) uint64 {
+	if pos := upperBinaryLookup(h.snapshots, key); pos > 0 {
+		return h.snapshots[pos-1].Value
+	}
+	return slashing.WAD
+}
+
+// Latest returns the value of the last snapshot, or WAD if there is none.
+func (h *DefaultWadHistory) Latest() uint64 {
+	if n := len(h.snapshots); n > 0 {
+		return h.snapshots[n-1].Value
+	}
+	return slashing.WAD
+}
+
+// Length returns the number of snapshots.
+func (h *DefaultWadHistory) Length() int {
+	return len(h.snapshots)
+}
+
+// Snapshots returns a copy of the snapshots in key order.
+func (h *DefaultWadHistory) Snapshots() []Snapshot[uint64] {
+	return append([]Snapshot[uint64](nil), h.snapshots...)
+}
+
+// DefaultZeroHistory is a history of uint224 values that reads as 0 when
+// empty, like the DelegationManager's cumulative scaled shares. The zero
+// value is an empty history.
+type DefaultZeroHistory struct {
+	snapshots []Snapshot[*big.Int]
+}
+
+// Push records value as of key. Like the contract, it truncates value to
+// 224 bits.
+func (h *DefaultZeroHistory) Push(key uint32, value *big.Int) error {
+	v := new(big.Int)
+	if value != nil {
+		v.And(value, maxUint224)
+	}
+	return insert(&h.snapshots, key, v)
+}
+
+// UpperLookup returns the value of the last snapshot with a key lower than
+// or equal to key, or 0 if there is none.
+func (h *DefaultZeroHistory) UpperLookup(key uint32) *big.Int {
+	if pos := upperBinaryLookup(h.snapshots, key); pos > 0 {
+		return new(big.Int).Set(h.snapshots[pos-1].Value)
+	}
+	return new(big.Int)
+}
+
+// Latest returns the value of the last snapshot, or 0 if there is none.
+func (h *DefaultZeroHistory) Latest() *big.Int {
+	if n := len(h.snapshots); n > 0 {
+		return new(big.Int).Set(h.snapshots[n-1].Value)
+	}
+	return new(big.Int)
+}
+
+// Length returns the number of snapshots.
+func (h *DefaultZeroHistory) Length() int {
+	return len(h.snapshots)
+}
+
+// Snapshots returns a copy of the snapshots in key order.
+func (h *DefaultZeroHistory) Snapshots() []Snapshot[*big.Int] {
+	out := make([]Snapshot[*big.Int], len(h.snapshots))
+	for i, s := range h.snapshots {
+		out[i] = Snapshot[*big.Int]{Key: s.Key, Value: new(big.Int).Set(s.Value)}
+	}
+	return out
+}
+
+// insert mirrors Snapshots._insert: it appends a snapshot, or overwrites
+// the last one if it has the same key.
+func insert[V any](snapshots *[]Snapshot[V], key uint32, value V) error {
+	if n := len(*snapshots); n > 0 {
+		last := &(*snapshots)[n-1]
+		if last.Key > key {
+			return ErrInvalidSnapshotOrdering
+		}
+		if last.Key == key {
+			last.Value = value
+			return nil
+		}
+	}
+	*snapshots = append(*snapshots, Snapshot[V]{Key: key, Value: value})
+	return nil
+}
+
+// upperBinaryLookup mirrors Snapshots._upperBinaryLookup over the whole
+// list: it returns the number of snapshots with a key lower than or equal
+// to key.
+func upperBinaryLookup[V any](snapshots []Snapshot[V], key uint32) int {
+	return sort.Search(len(snapshots), func(i int) bool { return snapshots[i].Key > key })
+}
@@ -0,0 +1,107 @@
+package snapshots
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	allocationmanager "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/AllocationManager"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestDefaultWadHistory(t *testing.T) {
+	var h DefaultWadHistory
+	if h.UpperLookup(10) != 1e18 || h.Latest() != 1e18 || h.Length() != 0 {
+		t.Fatalf("Expected an empty history to read WAD")
+	}
+	for _, s := range []Snapshot[uint64]{{10, 9e17}, {20, 8e17}, {20, 7e17}, {35, 5e17}} {
+		if err := h.Push(s.Key, s.Value); err != nil {
+			t.Fatalf("Push(%d) failed: %v", s.Key, err)
+		}
+	}
+	want := []Snapshot[uint64]{{10, 9e17}, {20, 7e17}, {35, 5e17}}
+	if got := h.Snapshots(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected snapshots %v, got %v", want, got)
+	}
+	for key, want := range map[uint32]uint64{0: 1e18, 9: 1e18, 10: 9e17, 19: 9e17, 20: 7e17, 34: 7e17, 35: 5e17, 1 << 31: 5e17} {
+		if got := h.UpperLookup(key); got != want {
+			t.Errorf("UpperLookup(%d): expected %d, got %d", key, want, got)
+		}
+	}
+	if h.Latest() != 5e17 {
+		t.Errorf("Expected latest 5e17, got %d", h.Latest())
+	}
+	if err := h.Push(34, 1); !errors.Is(err, ErrInvalidSnapshotOrdering) {
+		t.Errorf("Expected ErrInvalidSnapshotOrdering, got %v", err)
+	}
+}
+
+func TestDefaultZeroHistory(t *testing.T) {
+	var h DefaultZeroHistory
+	if h.UpperLookup(10).Sign() != 0 || h.Latest().Sign() != 0 {
+		t.Fatalf("Expected an empty history to read 0")
+	}
+	// Values are truncated to uint224, as the contract casts them.
+	big224 := new(big.Int).Lsh(big.NewInt(1), 224)
+	if err := h.Push(5, new(big.Int).Add(big224, big.NewInt(3))); err != nil {
+		t.Fatal(err)
+	}
+	if err := h.Push(8, big.NewInt(10)); err != nil {
+		t.Fatal(err)
+	}
+	for key, want := range map[uint32]int64{4: 0, 5: 3, 7: 3, 8: 10} {
+		if got := h.UpperLookup(key); got.Cmp(big.NewInt(want)) != 0 {
+			t.Errorf("UpperLookup(%d): expected %d, got %s", key, want, got)
+		}
+	}
+	h.UpperLookup(8).SetInt64(0)
+	if h.Latest().Int64() != 10 {
+		t.Errorf("Expected lookups to return copies")
+	}
+	if err := h.Push(7, big.NewInt(1)); !errors.Is(err, ErrInvalidSnapshotOrdering) {
+		t.Errorf("Expected ErrInvalidSnapshotOrdering, got %v", err)
+	}
+}
+
+func TestLoadMaxMagnitudes(t *testing.T) {
+	am := allocationmanager.NewFakeAllocationManager(common.HexToAddress("0xa11"))
+	operator, other := common.HexToAddress("0x09"), common.HexToAddress("0x0a")
+	s1, s2 := common.HexToAddress("0x1"), common.HexToAddress("0x2")
+	// Every fake log is mined in its own block, numbered in emission order.
+	for _, e := range []allocationmanager.AllocationManagerMaxMagnitudeUpdated{
+		{Operator: operator, Strategy: s1, MaxMagnitude: 9e17}, // block 1
+		{Operator: other, Strategy: s1, MaxMagnitude: 5e17},    // block 2
+		{Operator: operator, Strategy: s1, MaxMagnitude: 6e17}, // block 3
+		{Operator: operator, Strategy: s2, MaxMagnitude: 1e17}, // block 4
+	} {
+		am.EmitMaxMagnitudeUpdated(&e)
+	}
+
+	m, err := LoadMaxMagnitudes(context.Background(), am, nil)
+	if err != nil {
+		t.Fatalf("LoadMaxMagnitudes failed: %v", err)
+	}
+	strategies := []common.Address{s1, s2, common.HexToAddress("0x3")}
+	for block, want := range map[uint32][]uint64{
+		0: {1e18, 1e18, 1e18},
+		1: {9e17, 1e18, 1e18},
+		2: {9e17, 1e18, 1e18},
+		3: {6e17, 1e18, 1e18},
+		9: {6e17, 1e17, 1e18},
+	} {
+		if got := m.AtBlock(operator, strategies, block); !reflect.DeepEqual(got, want) {
+			t.Errorf("AtBlock(%d): expected %v, got %v", block, want, got)
+		}
+	}
+	if got := m.Latest(other, s1); got != 5e17 {
+		t.Errorf("Expected the other operator's latest 5e17, got %d", got)
+	}
+
+	e := &allocationmanager.AllocationManagerMaxMagnitudeUpdated{Operator: operator, Strategy: s1, MaxMagnitude: 1}
+	e.Raw.BlockNumber = 2
+	if err := m.Apply(e); !errors.Is(err, ErrInvalidSnapshotOrdering) {
+		t.Errorf("Expected ErrInvalidSnapshotOrdering for an out of order event, got %v", err)
+	}
+}
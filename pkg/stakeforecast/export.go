@@ -0,0 +1,75 @@
+package stakeforecast
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strconv"
+)
+
+// csvHeader names the columns WriteCSV writes.
+var csvHeader = []string{"block", "operator", "strategy", "cause", "withdrawal", "magnitude", "delegated_stake", "queued_stake", "stake", "delta"}
+
+// WriteCSV writes the timeline to w as CSV with a header row. Each series
+// starts with a row of cause "start" for its state at From, followed by one
+// row per step. The totals come last, with an empty operator and magnitude.
+func (t *Timeline) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, s := range t.Series {
+		if err := writeSeries(cw, &s, s.Operator.Hex(), true); err != nil {
+			return err
+		}
+	}
+	for _, s := range t.Totals {
+		if err := writeSeries(cw, &s, "", false); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func writeSeries(cw *csv.Writer, s *Series, operator string, magnitudes bool) error {
+	row := func(p Point, cause, withdrawal, delta string) error {
+		magnitude := ""
+		if magnitudes {
+			magnitude = strconv.FormatUint(p.Magnitude, 10)
+		}
+		return cw.Write([]string{
+			strconv.FormatUint(uint64(p.Block), 10),
+			operator,
+			s.Strategy.Hex(),
+			cause,
+			withdrawal,
+			magnitude,
+			p.DelegatedStake.String(),
+			p.QueuedStake.String(),
+			p.Stake.String(),
+			delta,
+		})
+	}
+	if err := row(s.Start, "start", "", "0"); err != nil {
+		return err
+	}
+	for _, step := range s.Steps {
+		withdrawal := ""
+		if step.Withdrawal != nil {
+			withdrawal = step.Withdrawal.Hex()
+		}
+		if err := row(step.Point, step.Cause.String(), withdrawal, step.Delta.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the timeline to w as indented JSON. Stakes are JSON
+// numbers and causes their names.
+func (t *Timeline) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(t)
+}
@@ -0,0 +1,64 @@
+package stakeforecast
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestWriteCSV(t *testing.T) {
+	state := testState()
+	state.Operators = state.Operators[:1]
+	state.Strategies = state.Strategies[:1]
+	timeline, err := Forecast(state, 130)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := timeline.WriteCSV(&buf); err != nil {
+		t.Fatalf("WriteCSV failed: %v", err)
+	}
+	a, s := operatorA.Hex(), s1.Hex()
+	root := common.Hash{1}.Hex()
+	want := strings.Join([]string{
+		"block,operator,strategy,cause,withdrawal,magnitude,delegated_stake,queued_stake,stake,delta",
+		"100," + a + "," + s + ",start,,500000000000000000,5000000000000000000,1000000000000000000,6000000000000000000,0",
+		"106," + a + "," + s + ",withdrawal," + root + ",500000000000000000,5000000000000000000,0,5000000000000000000,-1000000000000000000",
+		"120," + a + "," + s + ",deallocation,,300000000000000000,3000000000000000000,0,3000000000000000000,-2000000000000000000",
+		"100,," + s + ",start,,,5000000000000000000,1000000000000000000,6000000000000000000,0",
+		"106,," + s + ",withdrawal," + root + ",,5000000000000000000,0,5000000000000000000,-1000000000000000000",
+		"120,," + s + ",deallocation,,,3000000000000000000,0,3000000000000000000,-2000000000000000000",
+	}, "\n") + "\n"
+	if buf.String() != want {
+		t.Errorf("Expected\n%s\ngot\n%s", want, buf.String())
+	}
+}
+
+func TestWriteJSON(t *testing.T) {
+	timeline, err := Forecast(testState(), 130)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := timeline.WriteJSON(&buf); err != nil {
+		t.Fatalf("WriteJSON failed: %v", err)
+	}
+	if !strings.Contains(buf.String(), `"cause": "deallocation"`) {
+		t.Errorf("Expected causes by name, got %s", buf.String())
+	}
+
+	var decoded Timeline
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil {
+		t.Fatalf("Unmarshal failed: %v", err)
+	}
+	var again bytes.Buffer
+	if err := decoded.WriteJSON(&again); err != nil {
+		t.Fatal(err)
+	}
+	if again.String() != buf.String() {
+		t.Errorf("Expected the JSON to round-trip, got\n%s", again.String())
+	}
+}
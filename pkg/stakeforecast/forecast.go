@@ -0,0 +1,385 @@
+// Package stakeforecast forecasts how an operator set's slashable stake
+// changes over the coming blocks.
+//
+// AllocationManager.getMinimumSlashableStake answers for a single future
+// block and ignores pending allocations. Forecast instead returns, per
+// operator and strategy, the stake now and every step change up to a block,
+// with its cause: a pending allocation or deallocation taking effect, or a
+// queued withdrawal leaving the slashable window. Totals sums the operators
+// per strategy:
+//
+//	state, err := stakeforecast.Load(ctx, chainview.NewSource(contracts, client), nil, operatorSet, nil, nil)
+//	if err != nil {
+//		return err
+//	}
+//	timeline, err := stakeforecast.Forecast(state, state.Block+50400)
+//	if err != nil {
+//		return err
+//	}
+//	err = timeline.WriteCSV(os.Stdout)
+//
+// The forecast assumes nothing else happens: no new allocations, deposits,
+// withdrawals, slashes or deregistrations.
+package stakeforecast
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/slashing"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrInvalidHorizon is returned when the forecast would end before the
+	// State's block.
+	ErrInvalidHorizon = errors.New("stakeforecast: horizon before state block")
+	// ErrMissingState is returned when an operator has no StrategyState for
+	// one of the State's strategies.
+	ErrMissingState = errors.New("stakeforecast: no state for strategy")
+)
+
+// State is the chain state the slashable stake of an operator set depends
+// on, as of Block.
+type State struct {
+	OperatorSet              eltypes.OperatorSet
+	Block                    uint32
+	MinWithdrawalDelayBlocks uint32
+	Strategies               []common.Address
+	Operators                []OperatorState
+}
+
+// OperatorState is one operator's state.
+type OperatorState struct {
+	Operator common.Address
+	// Slashable is AllocationManager.isOperatorSlashable. Operators that are
+	// not slashable have no slashable stake.
+	Slashable  bool
+	Strategies map[common.Address]*StrategyState
+	// QueuedWithdrawals are the withdrawals delegated to Operator that are
+	// still slashable at Block, those queued at or after
+	// Block - MinWithdrawalDelayBlocks. Others are ignored.
+	QueuedWithdrawals []QueuedWithdrawal
+}
+
+// StrategyState is an operator's state in one strategy.
+type StrategyState struct {
+	MaxMagnitude   uint64
+	OperatorShares *big.Int
+	// Allocation is the operator set's allocation, as
+	// AllocationManager.getAllocation reports it.
+	Allocation eltypes.IAllocationManagerTypesAllocation
+}
+
+// QueuedWithdrawal is a queued withdrawal and its root.
+type QueuedWithdrawal = chainview.QueuedWithdrawal
+
+// Cause is the reason for a step change in slashable stake.
+type Cause uint8
+
+const (
+	// CauseAllocation is a pending allocation taking effect.
+	CauseAllocation Cause = iota + 1
+	// CauseDeallocation is a pending deallocation completing.
+	CauseDeallocation
+	// CauseWithdrawal is a queued withdrawal leaving the slashable window,
+	// the block it becomes completable.
+	CauseWithdrawal
+)
+
+var causeNames = map[Cause]string{
+	CauseAllocation:   "allocation",
+	CauseDeallocation: "deallocation",
+	CauseWithdrawal:   "withdrawal",
+}
+
+// String implements fmt.Stringer.
+func (c Cause) String() string {
+	if name, ok := causeNames[c]; ok {
+		return name
+	}
+	return fmt.Sprintf("Cause(%d)", uint8(c))
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (c Cause) MarshalText() ([]byte, error) {
+	name, ok := causeNames[c]
+	if !ok {
+		return nil, fmt.Errorf("stakeforecast: unknown cause %d", uint8(c))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (c *Cause) UnmarshalText(text []byte) error {
+	for cause, name := range causeNames {
+		if name == string(text) {
+			*c = cause
+			return nil
+		}
+	}
+	return fmt.Errorf("stakeforecast: unknown cause %q", text)
+}
+
+// Timeline is the forecast of an operator set's slashable stake from one
+// block through another.
+type Timeline struct {
+	OperatorSet eltypes.OperatorSet `json:"operatorSet"`
+	From        uint32              `json:"from"`
+	Until       uint32              `json:"until"`
+	// Series holds one entry per operator and strategy, in the State's
+	// order.
+	Series []Series `json:"series"`
+	// Totals holds one entry per strategy, summing the operators. Its
+	// Operator and magnitudes are zero.
+	Totals []Series `json:"totals"`
+}
+
+// Series is the slashable stake of one operator, or of all of them, in one
+// strategy.
+type Series struct {
+	Operator common.Address `json:"operator"`
+	Strategy common.Address `json:"strategy"`
+	Start    Point          `json:"start"`
+	// Steps are ordered by block. Changes in the same block are separate
+	// steps, allocations first.
+	Steps []Step `json:"steps"`
+}
+
+// Point is slashable stake at a block.
+type Point struct {
+	Block uint32 `json:"block"`
+	// Magnitude is the operator's allocated magnitude.
+	Magnitude uint64 `json:"magnitude"`
+	// DelegatedStake is the part of the operator's delegated shares
+	// allocated to the operator set, what
+	// AllocationManager.getMinimumSlashableStake reports.
+	DelegatedStake *big.Int `json:"delegatedStake"`
+	// QueuedStake is the same part of the shares in the withdrawal queue
+	// that are still slashable.
+	QueuedStake *big.Int `json:"queuedStake"`
+	// Stake is DelegatedStake plus QueuedStake.
+	Stake *big.Int `json:"stake"`
+}
+
+// Step is a change in slashable stake.
+type Step struct {
+	Point
+	Cause    Cause          `json:"cause"`
+	Operator common.Address `json:"operator"`
+	// Withdrawal is the root of the withdrawal for CauseWithdrawal.
+	Withdrawal *common.Hash `json:"withdrawal,omitempty"`
+	// Delta is the change in Stake.
+	Delta *big.Int `json:"delta"`
+}
+
+// At returns the series' point at block, the start point for blocks before
+// the first step.
+func (s *Series) At(block uint32) Point {
+	p := s.Start
+	for _, step := range s.Steps {
+		if step.Block > block {
+			break
+		}
+		p = step.Point
+	}
+	return p
+}
+
+// Forecast returns the slashable stake of the State's operators from
+// state.Block through until.
+func Forecast(state *State, until uint32) (*Timeline, error) {
+	if until < state.Block {
+		return nil, fmt.Errorf("%w: %d < %d", ErrInvalidHorizon, until, state.Block)
+	}
+	t := &Timeline{OperatorSet: state.OperatorSet, From: state.Block, Until: until}
+	for _, o := range state.Operators {
+		for _, strategy := range state.Strategies {
+			series, err := forecastStrategy(state, &o, strategy, until)
+			if err != nil {
+				return nil, fmt.Errorf("stakeforecast: %s in %s: %w", o.Operator.Hex(), strategy.Hex(), err)
+			}
+			t.Series = append(t.Series, *series)
+		}
+	}
+	for i, strategy := range state.Strategies {
+		var series []Series
+		for j := i; j < len(t.Series); j += len(state.Strategies) {
+			series = append(series, t.Series[j])
+		}
+		t.Totals = append(t.Totals, total(strategy, state.Block, series))
+	}
+	return t, nil
+}
+
+// change is a pending change to one series.
+type change struct {
+	block      uint32
+	cause      Cause
+	magnitude  uint64
+	withdrawal *common.Hash
+	queued     *big.Int
+}
+
+func forecastStrategy(state *State, o *OperatorState, strategy common.Address, until uint32) (*Series, error) {
+	s, ok := o.Strategies[strategy]
+	if !ok {
+		return nil, ErrMissingState
+	}
+	series := &Series{Operator: o.Operator, Strategy: strategy}
+	magnitude := s.Allocation.CurrentMagnitude
+	queued := new(big.Int)
+	var changes []change
+
+	// The contract reports no slashable stake for these, whatever happens.
+	if !o.Slashable || s.MaxMagnitude == 0 {
+		p, err := point(s, state.Block, magnitude, queued, false)
+		if err != nil {
+			return nil, err
+		}
+		series.Start = p
+		return series, nil
+	}
+
+	if diff := s.Allocation.PendingDiff; diff != nil && diff.Sign() != 0 {
+		next, err := slashing.AddInt128(magnitude, diff)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case s.Allocation.EffectBlock <= state.Block:
+			magnitude = next
+		case s.Allocation.EffectBlock <= until:
+			cause := CauseAllocation
+			if diff.Sign() < 0 {
+				cause = CauseDeallocation
+			}
+			changes = append(changes, change{block: s.Allocation.EffectBlock, cause: cause, magnitude: next})
+		}
+	}
+
+	maxMagnitude := new(big.Int).SetUint64(s.MaxMagnitude)
+	for _, w := range o.QueuedWithdrawals {
+		slashableUntil := uint64(w.Withdrawal.StartBlock) + uint64(state.MinWithdrawalDelayBlocks)
+		if w.Withdrawal.DelegatedTo != o.Operator || slashableUntil < uint64(state.Block) {
+			continue
+		}
+		for i, ws := range w.Withdrawal.Strategies {
+			if ws != strategy || i >= len(w.Withdrawal.ScaledShares) {
+				continue
+			}
+			shares, err := slashing.MulWad(slashing.OrZero(w.Withdrawal.ScaledShares[i]), maxMagnitude)
+			if err != nil {
+				return nil, err
+			}
+			queued.Add(queued, shares)
+			if slashableUntil+1 <= uint64(until) {
+				root := common.Hash(w.Root)
+				changes = append(changes, change{
+					block:      uint32(slashableUntil + 1),
+					cause:      CauseWithdrawal,
+					withdrawal: &root,
+					queued:     shares,
+				})
+			}
+		}
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].block != changes[j].block {
+			return changes[i].block < changes[j].block
+		}
+		return changes[i].cause != CauseWithdrawal && changes[j].cause == CauseWithdrawal
+	})
+
+	var err error
+	if series.Start, err = point(s, state.Block, magnitude, queued, true); err != nil {
+		return nil, err
+	}
+	prev := series.Start
+	for _, c := range changes {
+		if c.cause == CauseWithdrawal {
+			queued = new(big.Int).Sub(queued, c.queued)
+		} else {
+			magnitude = c.magnitude
+		}
+		p, err := point(s, c.block, magnitude, queued, true)
+		if err != nil {
+			return nil, err
+		}
+		series.Steps = append(series.Steps, Step{
+			Point:      p,
+			Cause:      c.cause,
+			Operator:   o.Operator,
+			Withdrawal: c.withdrawal,
+			Delta:      new(big.Int).Sub(p.Stake, prev.Stake),
+		})
+		prev = p
+	}
+	return series, nil
+}
+
+// point computes slashable stake the way
+// AllocationManager._getMinimumAllocatedStake does, applying the same
+// proportion to the queued shares.
+func point(s *StrategyState, block uint32, magnitude uint64, queued *big.Int, slashable bool) (Point, error) {
+	p := Point{Block: block, Magnitude: magnitude, DelegatedStake: new(big.Int), QueuedStake: new(big.Int), Stake: new(big.Int)}
+	if !slashable {
+		return p, nil
+	}
+	proportion, err := slashing.DivWad(new(big.Int).SetUint64(magnitude), new(big.Int).SetUint64(s.MaxMagnitude))
+	if err != nil {
+		return Point{}, err
+	}
+	if p.DelegatedStake, err = slashing.MulWad(slashing.OrZero(s.OperatorShares), proportion); err != nil {
+		return Point{}, err
+	}
+	if p.QueuedStake, err = slashing.MulWad(queued, proportion); err != nil {
+		return Point{}, err
+	}
+	p.Stake.Add(p.DelegatedStake, p.QueuedStake)
+	return p, nil
+}
+
+// total sums the series of one strategy.
+func total(strategy common.Address, block uint32, series []Series) Series {
+	t := Series{Strategy: strategy, Start: Point{Block: block, DelegatedStake: new(big.Int), QueuedStake: new(big.Int), Stake: new(big.Int)}}
+	var steps []Step
+	for _, s := range series {
+		t.Start.DelegatedStake.Add(t.Start.DelegatedStake, s.Start.DelegatedStake)
+		t.Start.QueuedStake.Add(t.Start.QueuedStake, s.Start.QueuedStake)
+		t.Start.Stake.Add(t.Start.Stake, s.Start.Stake)
+		steps = append(steps, s.Steps...)
+	}
+	// Series steps are sorted already; a stable sort keeps operators in order
+	// within a block.
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].Block < steps[j].Block })
+
+	// Each step of an operator changes its stake from its previous step.
+	last := make(map[common.Address]Point, len(series))
+	for _, s := range series {
+		last[s.Operator] = s.Start
+	}
+	current := t.Start
+	for _, step := range steps {
+		prev := last[step.Operator]
+		last[step.Operator] = step.Point
+		current = Point{
+			Block:          step.Block,
+			DelegatedStake: new(big.Int).Add(current.DelegatedStake, new(big.Int).Sub(step.DelegatedStake, prev.DelegatedStake)),
+			QueuedStake:    new(big.Int).Add(current.QueuedStake, new(big.Int).Sub(step.QueuedStake, prev.QueuedStake)),
+			Stake:          new(big.Int).Add(current.Stake, step.Delta),
+		}
+		t.Steps = append(t.Steps, Step{
+			Point:      current,
+			Cause:      step.Cause,
+			Operator:   step.Operator,
+			Withdrawal: step.Withdrawal,
+			Delta:      step.Delta,
+		})
+	}
+	return t
+}
@@ -0,0 +1,173 @@
+package stakeforecast
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	operatorA = common.HexToAddress("0x0a")
+	operatorB = common.HexToAddress("0x0b")
+	operatorC = common.HexToAddress("0x0c")
+	s1        = common.HexToAddress("0x1")
+	s2        = common.HexToAddress("0x2")
+	set       = eltypes.OperatorSet{Avs: common.HexToAddress("0xa5"), Id: 1}
+)
+
+func e18(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(1e18))
+}
+
+func allocation(current uint64, diff int64, effectBlock uint32) eltypes.IAllocationManagerTypesAllocation {
+	return eltypes.IAllocationManagerTypesAllocation{CurrentMagnitude: current, PendingDiff: big.NewInt(diff), EffectBlock: effectBlock}
+}
+
+func withdrawal(root byte, operator common.Address, startBlock uint32, strategy common.Address, scaledShares *big.Int) QueuedWithdrawal {
+	return QueuedWithdrawal{
+		Root: [32]byte{root},
+		Withdrawal: eltypes.IDelegationManagerTypesWithdrawal{
+			DelegatedTo:  operator,
+			StartBlock:   startBlock,
+			Strategies:   []common.Address{strategy},
+			ScaledShares: []*big.Int{scaledShares},
+		},
+	}
+}
+
+// testState has operator A deallocate s1 at block 120 and allocate s2 at
+// block 105 with a withdrawal maturing at block 106, operator B not
+// slashable and operator C allocated without changes.
+func testState() *State {
+	return &State{
+		OperatorSet:              set,
+		Block:                    100,
+		MinWithdrawalDelayBlocks: 10,
+		Strategies:               []common.Address{s1, s2},
+		Operators: []OperatorState{
+			{
+				Operator:  operatorA,
+				Slashable: true,
+				Strategies: map[common.Address]*StrategyState{
+					s1: {MaxMagnitude: 1e18, OperatorShares: e18(10), Allocation: allocation(5e17, -2e17, 120)},
+					s2: {MaxMagnitude: 1e18, OperatorShares: e18(4), Allocation: allocation(0, 5e17, 105)},
+				},
+				QueuedWithdrawals: []QueuedWithdrawal{
+					withdrawal(1, operatorA, 95, s1, e18(2)),
+					// Completable already.
+					withdrawal(2, operatorA, 85, s1, e18(2)),
+				},
+			},
+			{
+				Operator: operatorB,
+				Strategies: map[common.Address]*StrategyState{
+					s1: {MaxMagnitude: 1e18, OperatorShares: e18(10), Allocation: allocation(1e18, 0, 0)},
+					s2: {MaxMagnitude: 1e18, OperatorShares: e18(10), Allocation: allocation(1e18, -1e18, 110)},
+				},
+			},
+			{
+				Operator:  operatorC,
+				Slashable: true,
+				Strategies: map[common.Address]*StrategyState{
+					s1: {MaxMagnitude: 1e18, OperatorShares: e18(1), Allocation: allocation(1e18, 0, 0)},
+					s2: {MaxMagnitude: 1e18, Allocation: allocation(0, 0, 0)},
+				},
+			},
+		},
+	}
+}
+
+type wantStep struct {
+	block uint32
+	cause Cause
+	stake *big.Int
+}
+
+func checkSeries(t *testing.T, name string, s Series, start *big.Int, steps []wantStep) {
+	t.Helper()
+	if s.Start.Stake.Cmp(start) != 0 {
+		t.Errorf("%s: expected start stake %s, got %s", name, start, s.Start.Stake)
+	}
+	if len(s.Steps) != len(steps) {
+		t.Fatalf("%s: expected %d steps, got %+v", name, len(steps), s.Steps)
+	}
+	for i, want := range steps {
+		got := s.Steps[i]
+		if got.Block != want.block || got.Cause != want.cause || got.Stake.Cmp(want.stake) != 0 {
+			t.Errorf("%s: step %d: expected %s to %s at %d, got %s to %s at %d", name, i, want.cause, want.stake, want.block, got.Cause, got.Stake, got.Block)
+		}
+	}
+}
+
+func TestForecast(t *testing.T) {
+	timeline, err := Forecast(testState(), 130)
+	if err != nil {
+		t.Fatalf("Forecast failed: %v", err)
+	}
+	if len(timeline.Series) != 6 || len(timeline.Totals) != 2 {
+		t.Fatalf("Expected 6 series and 2 totals, got %d and %d", len(timeline.Series), len(timeline.Totals))
+	}
+
+	a1 := timeline.Series[0]
+	checkSeries(t, "A/s1", a1, e18(6), []wantStep{
+		{106, CauseWithdrawal, e18(5)},
+		{120, CauseDeallocation, e18(3)},
+	})
+	if a1.Start.QueuedStake.Cmp(e18(1)) != 0 || a1.Steps[0].Withdrawal == nil || a1.Steps[0].Withdrawal[0] != 1 {
+		t.Errorf("Expected 1e18 queued stake leaving with withdrawal 1, got %+v", a1)
+	}
+	if a1.Steps[1].Magnitude != 3e17 || a1.Steps[1].Delta.Cmp(e18(-2)) != 0 {
+		t.Errorf("Expected the deallocation to 3e17 to drop 2e18, got %+v", a1.Steps[1])
+	}
+	checkSeries(t, "A/s2", timeline.Series[1], new(big.Int), []wantStep{{105, CauseAllocation, e18(2)}})
+	checkSeries(t, "B/s1", timeline.Series[2], new(big.Int), nil)
+	checkSeries(t, "B/s2", timeline.Series[3], new(big.Int), nil)
+	checkSeries(t, "C/s1", timeline.Series[4], e18(1), nil)
+
+	checkSeries(t, "total/s1", timeline.Totals[0], e18(7), []wantStep{
+		{106, CauseWithdrawal, e18(6)},
+		{120, CauseDeallocation, e18(4)},
+	})
+	if got := timeline.Totals[0].Steps[1]; got.Operator != operatorA || got.DelegatedStake.Cmp(e18(4)) != 0 || got.QueuedStake.Sign() != 0 {
+		t.Errorf("Expected operator A's deallocation in the totals, got %+v", got)
+	}
+	checkSeries(t, "total/s2", timeline.Totals[1], new(big.Int), []wantStep{{105, CauseAllocation, e18(2)}})
+
+	for block, want := range map[uint32]*big.Int{100: e18(6), 105: e18(6), 106: e18(5), 119: e18(5), 130: e18(3)} {
+		if got := a1.At(block).Stake; got.Cmp(want) != 0 {
+			t.Errorf("At(%d): expected %s, got %s", block, want, got)
+		}
+	}
+}
+
+func TestForecastHorizon(t *testing.T) {
+	timeline, err := Forecast(testState(), 110)
+	if err != nil {
+		t.Fatalf("Forecast failed: %v", err)
+	}
+	checkSeries(t, "A/s1", timeline.Series[0], e18(6), []wantStep{{106, CauseWithdrawal, e18(5)}})
+
+	// Changes that took effect by the state's block are applied to the
+	// start.
+	state := testState()
+	state.Block = 120
+	timeline, err = Forecast(state, 130)
+	if err != nil {
+		t.Fatalf("Forecast failed: %v", err)
+	}
+	checkSeries(t, "A/s1", timeline.Series[0], e18(3), nil)
+}
+
+func TestForecastErrors(t *testing.T) {
+	if _, err := Forecast(testState(), 99); !errors.Is(err, ErrInvalidHorizon) {
+		t.Errorf("Expected ErrInvalidHorizon, got %v", err)
+	}
+	state := testState()
+	delete(state.Operators[2].Strategies, s2)
+	if _, err := Forecast(state, 130); !errors.Is(err, ErrMissingState) {
+		t.Errorf("Expected ErrMissingState, got %v", err)
+	}
+}
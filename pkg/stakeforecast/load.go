@@ -0,0 +1,93 @@
+package stakeforecast
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Load reads the state the slashable stake of operators in operatorSet over
+// strategies depends on, as of opts.BlockNumber or the latest block. Nil
+// operators or strategies default to the operator set's members and
+// strategies.
+func Load(ctx context.Context, src chainview.Source, opts *bind.CallOpts, operatorSet eltypes.OperatorSet, operators, strategies []common.Address) (*State, error) {
+	o, err := src.CallOpts(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	read := o.BlockNumber.Uint64()
+	am, dm := src.AllocationManager, src.DelegationManager
+
+	if operators == nil {
+		if operators, err = am.GetMembers(&o, operatorSet); err != nil {
+			return nil, fmt.Errorf("stakeforecast: failed to read operator set members: %w", err)
+		}
+	}
+	if strategies == nil {
+		if strategies, err = am.GetStrategiesInOperatorSet(&o, operatorSet); err != nil {
+			return nil, fmt.Errorf("stakeforecast: failed to read operator set strategies: %w", err)
+		}
+	}
+	state := &State{
+		OperatorSet: operatorSet,
+		Block:       uint32(read),
+		Strategies:  strategies,
+		Operators:   make([]OperatorState, len(operators)),
+	}
+	if state.MinWithdrawalDelayBlocks, err = dm.MinWithdrawalDelayBlocks(&o); err != nil {
+		return nil, fmt.Errorf("stakeforecast: failed to read withdrawal delay: %w", err)
+	}
+	shares, err := dm.GetOperatorsShares(&o, operators, strategies)
+	if err != nil {
+		return nil, fmt.Errorf("stakeforecast: failed to read operator shares: %w", err)
+	}
+	if len(shares) != len(operators) {
+		return nil, fmt.Errorf("stakeforecast: expected operator shares of %d operators, got %d", len(operators), len(shares))
+	}
+
+	for i, operator := range operators {
+		op := &state.Operators[i]
+		op.Operator = operator
+		op.Strategies = make(map[common.Address]*StrategyState, len(strategies))
+		if op.Slashable, err = am.IsOperatorSlashable(&o, operator, operatorSet); err != nil {
+			return nil, fmt.Errorf("stakeforecast: failed to read slashability of %s: %w", operator.Hex(), err)
+		}
+		if len(shares[i]) != len(strategies) {
+			return nil, fmt.Errorf("stakeforecast: expected %d operator shares of %s, got %d", len(strategies), operator.Hex(), len(shares[i]))
+		}
+		for j := range strategies {
+			op.Strategies[strategies[j]] = &StrategyState{OperatorShares: shares[i][j]}
+		}
+	}
+	for _, strategy := range strategies {
+		maxMagnitudes, err := am.GetMaxMagnitudes(&o, operators, strategy)
+		if err != nil {
+			return nil, fmt.Errorf("stakeforecast: failed to read max magnitudes in %s: %w", strategy.Hex(), err)
+		}
+		allocations, err := am.GetAllocations(&o, operators, operatorSet, strategy)
+		if err != nil {
+			return nil, fmt.Errorf("stakeforecast: failed to read allocations in %s: %w", strategy.Hex(), err)
+		}
+		if len(maxMagnitudes) != len(operators) || len(allocations) != len(operators) {
+			return nil, fmt.Errorf("stakeforecast: expected %d max magnitudes and allocations in %s, got %d and %d", len(operators), strategy.Hex(), len(maxMagnitudes), len(allocations))
+		}
+		for i := range operators {
+			s := state.Operators[i].Strategies[strategy]
+			s.MaxMagnitude = maxMagnitudes[i]
+			s.Allocation = allocations[i]
+		}
+	}
+
+	queued, err := src.QueuedWithdrawals(ctx, read, state.Block, state.MinWithdrawalDelayBlocks, operators)
+	if err != nil {
+		return nil, err
+	}
+	for i, operator := range operators {
+		state.Operators[i].QueuedWithdrawals = queued[operator]
+	}
+	return state, nil
+}
@@ -0,0 +1,111 @@
+//go:build !go1.23 || simulated
+
+package stakeforecast
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/chainview"
+	"github.com/Layr-Labs/eigenlayer-contracts/pkg/harness"
+	eltypes "github.com/Layr-Labs/eigenlayer-contracts/pkg/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// TestForecastMatchesContract leaves a deallocation pending and a withdrawal
+// in the slashable window, then mines through the forecast and checks every
+// block's delegated stake against getAllocatedStake and its queued stake
+// against getSlashableSharesInQueue, and each step against
+// getMinimumSlashableStake.
+func TestForecastMatchesContract(t *testing.T) {
+	h, err := harness.New(harness.Config{MinWithdrawalDelayBlocks: 4, DeallocationDelay: 6})
+	if err != nil {
+		t.Fatalf("harness.New failed: %v", err)
+	}
+	defer h.Close()
+	ctx := context.Background()
+	call := &bind.CallOpts{Context: ctx}
+
+	check := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	account := func() *bind.TransactOpts {
+		auth, _, err := h.NewAccount(ctx, big.NewInt(params.Ether))
+		check(err)
+		return auth
+	}
+	allocate := func(operator *bind.TransactOpts, set eltypes.OperatorSet, strategy common.Address, magnitude uint64) {
+		t.Helper()
+		_, err := h.SendAs(ctx, operator, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			return h.AllocationManager.ModifyAllocations(opts, operator.From, []eltypes.IAllocationManagerTypesAllocateParams{
+				{OperatorSet: set, Strategies: []common.Address{strategy}, NewMagnitudes: []uint64{magnitude}},
+			})
+		})
+		check(err)
+	}
+
+	strategy, err := h.DeployStrategy(ctx)
+	check(err)
+	operator, avs, staker := account(), account(), account()
+	check(h.RegisterOperator(ctx, operator))
+	set, err := h.CreateOperatorSet(ctx, avs, 1, []common.Address{strategy.Address})
+	check(err)
+	check(h.RegisterForOperatorSet(ctx, operator, set))
+	check(h.Deposit(ctx, staker, strategy, big.NewInt(4e18)))
+	check(h.Delegate(ctx, staker, operator.From))
+	allocate(operator, set, strategy.Address, 5e17)
+	allocate(operator, set, strategy.Address, 2e17)
+	_, err = h.SendAs(ctx, staker, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return h.DelegationManager.QueueWithdrawals(opts, []eltypes.IDelegationManagerTypesQueuedWithdrawalParams{{
+			Strategies:           []common.Address{strategy.Address},
+			DepositShares:        []*big.Int{big.NewInt(1e18)},
+			DeprecatedWithdrawer: staker.From,
+		}})
+	})
+	check(err)
+
+	state, err := Load(ctx, chainview.NewSource(h.Contracts, h.Client), nil, set, nil, nil)
+	check(err)
+	if len(state.Operators) != 1 || len(state.Operators[0].QueuedWithdrawals) != 1 {
+		t.Fatalf("Expected one operator with one queued withdrawal, got %+v", state.Operators)
+	}
+	timeline, err := Forecast(state, state.Block+10)
+	check(err)
+	series := timeline.Series[0]
+	if len(series.Steps) != 2 || series.Steps[0].Cause != CauseWithdrawal || series.Steps[1].Cause != CauseDeallocation {
+		t.Fatalf("Expected a withdrawal then a deallocation step, got %+v", series.Steps)
+	}
+	if series.Start.QueuedStake.Cmp(big.NewInt(5e17)) != 0 || series.Steps[1].Stake.Cmp(big.NewInt(6e17)) != 0 {
+		t.Errorf("Expected 5e17 queued stake and 6e17 after the deallocation, got %+v", series)
+	}
+
+	operators, strategies := []common.Address{operator.From}, []common.Address{strategy.Address}
+	for _, step := range series.Steps {
+		minimum, err := h.AllocationManager.GetMinimumSlashableStake(call, set, operators, strategies, step.Block)
+		check(err)
+		if minimum[0][0].Cmp(step.DelegatedStake) != 0 {
+			t.Errorf("Block %d: expected minimum slashable stake %s, got %s", step.Block, step.DelegatedStake, minimum[0][0])
+		}
+	}
+	for block := state.Block; block < timeline.Until; block++ {
+		stake, err := h.AllocationManager.GetAllocatedStake(call, set, operators, strategies)
+		check(err)
+		if want := series.At(block).DelegatedStake; stake[0][0].Cmp(want) != 0 {
+			t.Errorf("Block %d: expected allocated stake %s, got %s", block, want, stake[0][0])
+		}
+		slashable, err := h.DelegationManager.GetSlashableSharesInQueue(call, operator.From, strategy.Address)
+		check(err)
+		// The queued stake is a proportion of the slashable queued shares.
+		if queued := series.At(block).QueuedStake; (queued.Sign() == 0) != (slashable.Sign() == 0) {
+			t.Errorf("Block %d: expected queued stake %s, got %s slashable shares in the queue", block, queued, slashable)
+		}
+		h.Backend.Commit()
+	}
+}
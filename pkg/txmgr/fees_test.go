@@ -0,0 +1,37 @@
+package txmgr
+
+import (
+	"math/big"
+	"testing"
+)
+
+func TestBump(t *testing.T) {
+	m := &Manager{cfg: Config{FeeBumpPercent: 12}.withDefaults()}
+	for _, tc := range []struct {
+		old, suggested, want int64
+	}{
+		{old: 100, want: 112},
+		{old: 101, want: 114}, // rounds up so the node sees at least the minimum bump
+		{old: 1, want: 2},
+		{old: 100, suggested: 500, want: 500},
+		{old: 100, suggested: 50, want: 112},
+	} {
+		var suggested *big.Int
+		if tc.suggested != 0 {
+			suggested = big.NewInt(tc.suggested)
+		}
+		if got := m.bump(big.NewInt(tc.old), suggested); got.Int64() != tc.want {
+			t.Errorf("bump(%d, %d) = %s, want %d", tc.old, tc.suggested, got, tc.want)
+		}
+	}
+}
+
+func TestCapFee(t *testing.T) {
+	m := &Manager{cfg: Config{MaxFeeCap: big.NewInt(1000)}.withDefaults()}
+	if got := m.capFee(big.NewInt(5000)); got.Int64() != 1000 {
+		t.Errorf("Expected fee to be capped at 1000, got %s", got)
+	}
+	if got := m.capFee(big.NewInt(10)); got.Int64() != 10 {
+		t.Errorf("Expected fee below the cap to be unchanged, got %s", got)
+	}
+}
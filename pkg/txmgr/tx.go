@@ -0,0 +1,225 @@
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// RevertedError is returned for a transaction that was mined but reverted.
+// Err holds the decoded revert, usually a *errors.RevertError from pkg/errors.
+type RevertedError struct {
+	Receipt *types.Receipt
+	Err     error
+}
+
+// Error implements the error interface.
+func (e *RevertedError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("txmgr: transaction %s reverted in block %s", e.Receipt.TxHash.Hex(), e.Receipt.BlockNumber)
+	}
+	return fmt.Sprintf("txmgr: transaction %s reverted in block %s: %v", e.Receipt.TxHash.Hex(), e.Receipt.BlockNumber, e.Err)
+}
+
+// Unwrap returns the decoded revert.
+func (e *RevertedError) Unwrap() error {
+	return e.Err
+}
+
+// Tx is a transaction in flight. It may be replaced several times; every
+// version shares the same nonce and at most one of them is mined.
+type Tx struct {
+	m      *Manager
+	sent   []*types.Transaction
+	sentAt time.Time
+	// lowNonce counts replacements refused as "nonce too low" while no
+	// version's receipt could be looked up yet.
+	lowNonce int
+	// Reorgs counts how often a receipt was dropped from the canonical chain
+	// while waiting for confirmations.
+	Reorgs int
+}
+
+// Nonce returns the transaction's nonce.
+func (t *Tx) Nonce() uint64 {
+	return t.sent[0].Nonce()
+}
+
+// Latest returns the most recently broadcast version of the transaction.
+func (t *Tx) Latest() *types.Transaction {
+	return t.sent[len(t.sent)-1]
+}
+
+// Hashes returns the hashes of every broadcast version, oldest first.
+func (t *Tx) Hashes() []common.Hash {
+	hashes := make([]common.Hash, len(t.sent))
+	for i, tx := range t.sent {
+		hashes[i] = tx.Hash()
+	}
+	return hashes
+}
+
+// Wait blocks until one version of the transaction is mined and buried under
+// Config.Confirmations blocks, replacing it with bumped fees whenever it stays
+// unmined for Config.ResubmitAfter. If the inclusion block is reorged out,
+// Wait goes back to waiting for the transaction to be mined again. A mined
+// but reverted transaction yields a *RevertedError, and a nonce taken by
+// another transaction ErrNonceConsumed.
+func (t *Tx) Wait(ctx context.Context) (*types.Receipt, error) {
+	m := t.m
+	ticker := time.NewTicker(m.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		receipt, err := t.receipt(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			done, err := t.confirmed(ctx, receipt)
+			if err != nil {
+				return nil, err
+			}
+			if done {
+				if receipt.Status != types.ReceiptStatusSuccessful {
+					return receipt, &RevertedError{Receipt: receipt, Err: t.revertReason(ctx, receipt)}
+				}
+				return receipt, nil
+			}
+		} else if time.Since(t.sentAt) >= m.cfg.ResubmitAfter {
+			if err := t.replace(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// receipt returns the receipt of whichever version was mined, if any.
+func (t *Tx) receipt(ctx context.Context) (*types.Receipt, error) {
+	for i := len(t.sent) - 1; i >= 0; i-- {
+		receipt, err := t.m.receipts.TransactionReceipt(ctx, t.sent[i].Hash())
+		if errors.Is(err, ethereum.NotFound) || isIndexing(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("txmgr: failed to fetch receipt for %s: %w", t.sent[i].Hash().Hex(), err)
+		}
+		return receipt, nil
+	}
+	return nil, nil
+}
+
+// confirmed reports whether receipt is deep enough and still canonical. A
+// receipt whose block is no longer canonical counts as a reorg.
+func (t *Tx) confirmed(ctx context.Context, receipt *types.Receipt) (bool, error) {
+	head, err := t.m.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return false, fmt.Errorf("txmgr: failed to fetch head: %w", err)
+	}
+	target := new(big.Int).Add(receipt.BlockNumber, new(big.Int).SetUint64(t.m.cfg.Confirmations-1))
+	if head.Number.Cmp(target) < 0 {
+		return false, nil
+	}
+	header, err := t.m.backend.HeaderByNumber(ctx, receipt.BlockNumber)
+	if err != nil && !errors.Is(err, ethereum.NotFound) {
+		return false, fmt.Errorf("txmgr: failed to fetch block %s: %w", receipt.BlockNumber, err)
+	}
+	if header == nil || header.Hash() != receipt.BlockHash {
+		t.Reorgs++
+		// Measure the resubmission timeout from the reorg, not the first send.
+		t.sentAt = time.Now()
+		return false, nil
+	}
+	return true, nil
+}
+
+// replace re-signs and broadcasts the latest version with bumped fees.
+func (t *Tx) replace(ctx context.Context) error {
+	if len(t.sent)-1+t.lowNonce >= t.m.cfg.MaxReplacements {
+		return fmt.Errorf("%w: nonce %d, hashes %v", ErrTooManyReplacements, t.Nonce(), t.Hashes())
+	}
+	next, err := t.m.replacement(ctx, t.Latest())
+	if err != nil {
+		return err
+	}
+	if err := t.m.backend.SendTransaction(ctx, next); err != nil {
+		if strings.Contains(err.Error(), "nonce too low") {
+			return t.nonceUsed(ctx)
+		}
+		return fmt.Errorf("txmgr: failed to send replacement for nonce %d: %w", next.Nonce(), err)
+	}
+	t.sent = append(t.sent, next)
+	t.sentAt = time.Now()
+	return nil
+}
+
+// nonceUsed handles a replacement refused because the nonce is used. If a
+// version was mined the next poll finds its receipt; if none was, another
+// transaction took the nonce and waiting cannot succeed.
+func (t *Tx) nonceUsed(ctx context.Context) error {
+	indexing := false
+	for _, tx := range t.sent {
+		_, err := t.m.receipts.TransactionReceipt(ctx, tx.Hash())
+		switch {
+		case err == nil:
+			t.sentAt = time.Now()
+			return nil
+		case isIndexing(err):
+			indexing = true
+		case !errors.Is(err, ethereum.NotFound):
+			return fmt.Errorf("txmgr: failed to fetch receipt for %s: %w", tx.Hash().Hex(), err)
+		}
+	}
+	if !indexing {
+		return fmt.Errorf("%w: nonce %d, hashes %v", ErrNonceConsumed, t.Nonce(), t.Hashes())
+	}
+	// The receipts may not be indexed yet; retry, within MaxReplacements.
+	t.lowNonce++
+	t.sentAt = time.Now()
+	return nil
+}
+
+// isIndexing reports whether a receipt lookup failed only because the node is
+// still indexing transactions.
+func isIndexing(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "transaction indexing is in progress")
+}
+
+// revertReason replays a reverted transaction on the state it executed
+// against and decodes the revert through pkg/errors.
+func (t *Tx) revertReason(ctx context.Context, receipt *types.Receipt) error {
+	var tx *types.Transaction
+	for _, s := range t.sent {
+		if s.Hash() == receipt.TxHash {
+			tx = s
+		}
+	}
+	msg := ethereum.CallMsg{
+		From:  t.m.auth.From,
+		To:    tx.To(),
+		Gas:   tx.Gas(),
+		Value: tx.Value(),
+		Data:  tx.Data(),
+	}
+	// Replaying against the parent block ignores transactions earlier in the
+	// same block, which is the best a plain eth_call can do.
+	parent := new(big.Int).Sub(receipt.BlockNumber, big.NewInt(1))
+	_, err := t.m.backend.CallContract(ctx, msg, parent)
+	if err == nil {
+		return nil
+	}
+	return t.m.errors.Wrap(err)
+}
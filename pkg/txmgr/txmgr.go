@@ -0,0 +1,307 @@
+// Package txmgr sends transactions built by the generated bindings and sees
+// them through to confirmation.
+//
+// Binding methods sign a transaction and hand it to the backend, leaving
+// nonces, fees, stuck transactions and receipts to the caller. A Manager runs
+// the binding call with NoSend set, then owns everything after signing:
+//
+//	m, err := txmgr.New(ctx, client, auth, txmgr.Config{Confirmations: 3})
+//	receipt, err := m.SendAndWait(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+//		return allocationManager.MigrateSlashers(opts, sets)
+//	})
+//
+// Nonces are tracked locally so many transactions can be in flight at once.
+// A transaction that is not mined within Config.ResubmitAfter is re-signed with
+// bumped EIP-1559 fees and the same nonce. Receipts are only returned once they
+// are Config.Confirmations blocks deep and still canonical, and a reverted
+// transaction is replayed to decode its revert through pkg/errors.
+package txmgr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	elerrors "github.com/Layr-Labs/eigenlayer-contracts/pkg/errors"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Defaults used for zero Config fields.
+const (
+	DefaultConfirmations   = 1
+	DefaultPollInterval    = time.Second
+	DefaultResubmitAfter   = time.Minute
+	DefaultFeeBumpPercent  = 12
+	DefaultMaxReplacements = 5
+)
+
+// ErrTooManyReplacements is returned when a transaction is still not mined
+// after Config.MaxReplacements fee bumps.
+var ErrTooManyReplacements = errors.New("txmgr: transaction not mined after maximum replacements")
+
+// ErrNonceConsumed is returned when the transaction's nonce was used by a
+// transaction that is none of its versions, as when another signer shares
+// the account.
+var ErrNonceConsumed = errors.New("txmgr: nonce consumed by another transaction")
+
+// Config tunes a Manager. Zero fields take the Default* values.
+type Config struct {
+	// Confirmations is the number of blocks, including the inclusion block, a
+	// transaction must be buried under before its receipt is returned.
+	Confirmations uint64
+	// PollInterval is how often receipts and the chain head are polled.
+	PollInterval time.Duration
+	// ResubmitAfter is how long a transaction may stay unmined before it is
+	// replaced with higher fees.
+	ResubmitAfter time.Duration
+	// FeeBumpPercent is the minimum increase of both fee caps on replacement.
+	// Nodes reject replacements bumping less than 10%.
+	FeeBumpPercent uint64
+	// MaxReplacements bounds how often one transaction is replaced.
+	MaxReplacements int
+	// MaxFeeCap, if set, is never exceeded by estimated or bumped fees.
+	MaxFeeCap *big.Int
+}
+
+func (c Config) withDefaults() Config {
+	if c.Confirmations == 0 {
+		c.Confirmations = DefaultConfirmations
+	}
+	if c.PollInterval == 0 {
+		c.PollInterval = DefaultPollInterval
+	}
+	if c.ResubmitAfter == 0 {
+		c.ResubmitAfter = DefaultResubmitAfter
+	}
+	if c.FeeBumpPercent == 0 {
+		c.FeeBumpPercent = DefaultFeeBumpPercent
+	}
+	if c.MaxReplacements == 0 {
+		c.MaxReplacements = DefaultMaxReplacements
+	}
+	return c
+}
+
+// receiptReader is implemented by ethclient.Client and the simulated backend.
+type receiptReader interface {
+	TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error)
+}
+
+// Manager sends transactions from a single account.
+type Manager struct {
+	backend  bind.ContractBackend
+	receipts receiptReader
+	auth     *bind.TransactOpts
+	cfg      Config
+	errors   *elerrors.Registry
+
+	mu    sync.Mutex
+	nonce *uint64 // next nonce to use; nil until synced with the node
+}
+
+// New returns a Manager sending from auth.From. Fee and gas fields set on auth
+// are used for the first submission of every transaction; replacements are
+// always priced from the network. The backend must also be able to fetch
+// receipts, as ethclient.Client and the simulated backend can.
+func New(ctx context.Context, backend bind.ContractBackend, auth *bind.TransactOpts, cfg Config) (*Manager, error) {
+	receipts, ok := backend.(receiptReader)
+	if !ok {
+		return nil, fmt.Errorf("txmgr: backend %T cannot fetch transaction receipts", backend)
+	}
+	if auth == nil || auth.Signer == nil {
+		return nil, errors.New("txmgr: transact opts must have a signer")
+	}
+	m := &Manager{
+		backend:  backend,
+		receipts: receipts,
+		auth:     auth,
+		cfg:      cfg.withDefaults(),
+		errors:   elerrors.Default(),
+	}
+	if auth.Nonce != nil {
+		n := auth.Nonce.Uint64()
+		m.nonce = &n
+	} else if err := m.syncNonce(ctx); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// From returns the sending account.
+func (m *Manager) From() common.Address {
+	return m.auth.From
+}
+
+// ResetNonce drops the local nonce so the next transaction resyncs it from
+// the node's pending nonce, e.g. after the account was used elsewhere.
+func (m *Manager) ResetNonce() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.nonce = nil
+}
+
+// syncNonce must be called with m.mu held or before m is shared.
+func (m *Manager) syncNonce(ctx context.Context) error {
+	n, err := m.backend.PendingNonceAt(ctx, m.auth.From)
+	if err != nil {
+		return fmt.Errorf("txmgr: failed to fetch nonce for %s: %w", m.auth.From.Hex(), err)
+	}
+	m.nonce = &n
+	return nil
+}
+
+// BuildFunc calls a binding method with the given opts and returns the signed
+// transaction. Opts have NoSend set, so the binding does not broadcast it.
+type BuildFunc func(opts *bind.TransactOpts) (*types.Transaction, error)
+
+// Sender sends a transaction and waits for its receipt, as Manager does.
+// Packages sending through a Manager take a Sender, so tests can stand in
+// for it.
+type Sender interface {
+	SendAndWait(ctx context.Context, build BuildFunc) (*types.Receipt, error)
+}
+
+// AccountSender is a Sender that also reports the account it sends from.
+type AccountSender interface {
+	Sender
+	From() common.Address
+}
+
+// Send builds, signs and broadcasts a transaction with the next local nonce.
+// Gas estimation reverts are decoded through pkg/errors.
+func (m *Manager) Send(ctx context.Context, build BuildFunc) (*Tx, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.nonce == nil {
+		if err := m.syncNonce(ctx); err != nil {
+			return nil, err
+		}
+	}
+	opts := *m.auth
+	opts.Context = ctx
+	opts.NoSend = true
+	opts.Nonce = new(big.Int).SetUint64(*m.nonce)
+	if opts.GasPrice == nil && opts.GasFeeCap == nil && opts.GasTipCap == nil {
+		tip, feeCap, err := m.suggestFees(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if feeCap == nil {
+			opts.GasPrice = tip
+		} else {
+			opts.GasTipCap, opts.GasFeeCap = tip, feeCap
+		}
+	}
+
+	tx, err := build(&opts)
+	if err != nil {
+		return nil, m.errors.Wrap(err)
+	}
+	if err := m.backend.SendTransaction(ctx, tx); err != nil {
+		if isNonceError(err) {
+			m.nonce = nil
+		}
+		return nil, fmt.Errorf("txmgr: failed to send transaction with nonce %d: %w", tx.Nonce(), m.errors.Wrap(err))
+	}
+	*m.nonce++
+	return &Tx{m: m, sent: []*types.Transaction{tx}, sentAt: time.Now()}, nil
+}
+
+// SendAndWait sends a transaction and waits for its confirmed receipt.
+func (m *Manager) SendAndWait(ctx context.Context, build BuildFunc) (*types.Receipt, error) {
+	tx, err := m.Send(ctx, build)
+	if err != nil {
+		return nil, err
+	}
+	return tx.Wait(ctx)
+}
+
+// suggestFees returns the tip and fee cap for a new transaction, or only a gas
+// price (and a nil fee cap) on chains without a base fee.
+func (m *Manager) suggestFees(ctx context.Context) (tip, feeCap *big.Int, err error) {
+	head, err := m.backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, nil, fmt.Errorf("txmgr: failed to fetch head: %w", err)
+	}
+	if head.BaseFee == nil {
+		price, err := m.backend.SuggestGasPrice(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("txmgr: failed to suggest gas price: %w", err)
+		}
+		return m.capFee(price), nil, nil
+	}
+	tip, err = m.backend.SuggestGasTipCap(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("txmgr: failed to suggest gas tip: %w", err)
+	}
+	// Leave room for the base fee to double, as abigen does.
+	feeCap = new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	feeCap = m.capFee(feeCap)
+	if tip.Cmp(feeCap) > 0 {
+		tip = new(big.Int).Set(feeCap)
+	}
+	return tip, feeCap, nil
+}
+
+func (m *Manager) capFee(fee *big.Int) *big.Int {
+	if m.cfg.MaxFeeCap != nil && fee.Cmp(m.cfg.MaxFeeCap) > 0 {
+		return new(big.Int).Set(m.cfg.MaxFeeCap)
+	}
+	return fee
+}
+
+// bump returns the larger of old raised by FeeBumpPercent and suggested.
+func (m *Manager) bump(old, suggested *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(old, new(big.Int).SetUint64(100+m.cfg.FeeBumpPercent))
+	bumped.Add(bumped, big.NewInt(99))
+	bumped.Div(bumped, big.NewInt(100))
+	if suggested != nil && suggested.Cmp(bumped) > 0 {
+		return new(big.Int).Set(suggested)
+	}
+	return bumped
+}
+
+// replacement re-signs tx with the same nonce and bumped fees.
+func (m *Manager) replacement(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	tip, feeCap, err := m.suggestFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var inner types.TxData
+	if tx.Type() == types.LegacyTxType || feeCap == nil {
+		price := m.bump(tx.GasPrice(), tip)
+		if m.cfg.MaxFeeCap != nil && price.Cmp(m.cfg.MaxFeeCap) > 0 {
+			return nil, fmt.Errorf("txmgr: replacement gas price %s exceeds the fee cap", price)
+		}
+		inner = &types.LegacyTx{
+			Nonce: tx.Nonce(), GasPrice: price, Gas: tx.Gas(), To: tx.To(), Value: tx.Value(), Data: tx.Data(),
+		}
+	} else {
+		newTip, newFeeCap := m.bump(tx.GasTipCap(), tip), m.bump(tx.GasFeeCap(), feeCap)
+		if m.cfg.MaxFeeCap != nil && newFeeCap.Cmp(m.cfg.MaxFeeCap) > 0 {
+			return nil, fmt.Errorf("txmgr: replacement fee cap %s exceeds the configured maximum", newFeeCap)
+		}
+		if newTip.Cmp(newFeeCap) > 0 {
+			newTip = new(big.Int).Set(newFeeCap)
+		}
+		inner = &types.DynamicFeeTx{
+			ChainID: tx.ChainId(), Nonce: tx.Nonce(), GasTipCap: newTip, GasFeeCap: newFeeCap,
+			Gas: tx.Gas(), To: tx.To(), Value: tx.Value(), Data: tx.Data(), AccessList: tx.AccessList(),
+		}
+	}
+	return m.auth.Signer(m.auth.From, types.NewTx(inner))
+}
+
+// isNonceError reports whether a send failed because the local nonce is out of
+// sync with the node.
+func isNonceError(err error) bool {
+	msg := err.Error()
+	return strings.Contains(msg, "nonce too low") || strings.Contains(msg, "nonce too high")
+}
@@ -0,0 +1,289 @@
+//go:build !go1.23 || simulated
+
+package txmgr
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	pauserregistry "github.com/Layr-Labs/eigenlayer-contracts/pkg/bindings/PauserRegistry"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+)
+
+type testChain struct {
+	sim     *simulated.Backend
+	client  simulated.Client
+	key     *ecdsa.PrivateKey
+	auth    *bind.TransactOpts
+	manager *Manager
+}
+
+func newTestChain(t *testing.T, cfg Config) *testChain {
+	t.Helper()
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	sim := simulated.NewBackend(types.GenesisAlloc{from: {Balance: big.NewInt(1e18)}})
+	t.Cleanup(func() { sim.Close() })
+	// The genesis block predates the merge; PUSH0 needs a post-merge head.
+	sim.Commit()
+
+	auth, err := bind.NewKeyedTransactorWithChainID(key, big.NewInt(1337))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.PollInterval == 0 {
+		cfg.PollInterval = time.Millisecond
+	}
+	m, err := New(context.Background(), sim.Client(), auth, cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testChain{sim: sim, client: sim.Client(), key: key, auth: auth, manager: m}
+}
+
+// deployPauserRegistry deploys a PauserRegistry whose unpauser is not the
+// manager's account.
+func (c *testChain) deployPauserRegistry(t *testing.T) *pauserregistry.PauserRegistry {
+	t.Helper()
+	var registry *pauserregistry.PauserRegistry
+	tx, err := c.manager.Send(context.Background(), func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, r, err := pauserregistry.DeployPauserRegistry(opts, c.client, nil, common.HexToAddress("0xdead"))
+		registry = r
+		return tx, err
+	})
+	if err != nil {
+		t.Fatalf("Deploy failed: %v", err)
+	}
+	c.sim.Commit()
+	if _, err := tx.Wait(context.Background()); err != nil {
+		t.Fatalf("Deploy not mined: %v", err)
+	}
+	return registry
+}
+
+func TestLocalNonces(t *testing.T) {
+	c := newTestChain(t, Config{})
+	ctx := context.Background()
+
+	var txs []*Tx
+	for i := 0; i < 3; i++ {
+		tx, err := c.manager.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+			_, tx, _, err := pauserregistry.DeployPauserRegistry(opts, c.client, nil, common.HexToAddress("0xdead"))
+			return tx, err
+		})
+		if err != nil {
+			t.Fatalf("Send %d failed: %v", i, err)
+		}
+		if tx.Nonce() != uint64(i) {
+			t.Errorf("Expected nonce %d, got %d", i, tx.Nonce())
+		}
+		txs = append(txs, tx)
+	}
+
+	block := c.sim.Commit()
+	for _, tx := range txs {
+		receipt, err := tx.Wait(ctx)
+		if err != nil {
+			t.Fatalf("Wait failed: %v", err)
+		}
+		if receipt.BlockHash != block {
+			t.Errorf("Expected all transactions in block %s, got %s", block.Hex(), receipt.BlockHash.Hex())
+		}
+	}
+}
+
+func TestConfirmations(t *testing.T) {
+	c := newTestChain(t, Config{Confirmations: 3})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tx, err := c.manager.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := pauserregistry.DeployPauserRegistry(opts, c.client, nil, common.HexToAddress("0xdead"))
+		return tx, err
+	})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	c.sim.Commit()
+	receipt, err := tx.receipt(ctx)
+	if err != nil || receipt == nil {
+		t.Fatalf("Expected a receipt, got %v", err)
+	}
+	for depth := uint64(1); depth <= 3; depth++ {
+		done, err := tx.confirmed(ctx, receipt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if done != (depth == 3) {
+			t.Errorf("At depth %d expected confirmed=%v, got %v", depth, depth == 3, done)
+		}
+		c.sim.Commit()
+	}
+}
+
+func TestRevertDecoded(t *testing.T) {
+	c := newTestChain(t, Config{})
+	registry := c.deployPauserRegistry(t)
+	ctx := context.Background()
+
+	// Gas estimation already surfaces the typed error.
+	_, err := c.manager.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		return registry.SetIsPauser(opts, common.HexToAddress("0x1"), true)
+	})
+	if !errors.As(err, new(pauserregistry.ErrOnlyUnpauser)) {
+		t.Fatalf("Expected ErrOnlyUnpauser from estimation, got %v", err)
+	}
+
+	// Skipping estimation gets the reverting transaction mined.
+	tx, err := c.manager.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = 100_000
+		return registry.SetIsPauser(opts, common.HexToAddress("0x1"), true)
+	})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	c.sim.Commit()
+	receipt, err := tx.Wait(ctx)
+	var reverted *RevertedError
+	if !errors.As(err, &reverted) || receipt.Status != types.ReceiptStatusFailed {
+		t.Fatalf("Expected RevertedError, got %v", err)
+	}
+	if !errors.As(err, new(pauserregistry.ErrOnlyUnpauser)) {
+		t.Errorf("Expected the receipt revert to decode to ErrOnlyUnpauser, got %v", err)
+	}
+}
+
+func TestStuckTransactionReplaced(t *testing.T) {
+	c := newTestChain(t, Config{ResubmitAfter: time.Millisecond})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// A fee cap below the base fee keeps the first version out of every block.
+	c.manager.auth.GasFeeCap = big.NewInt(1)
+	c.manager.auth.GasTipCap = big.NewInt(1)
+	tx, err := c.manager.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = 1_000_000
+		_, tx, _, err := pauserregistry.DeployPauserRegistry(opts, c.client, nil, common.HexToAddress("0xdead"))
+		return tx, err
+	})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-done:
+				return
+			case <-time.After(5 * time.Millisecond):
+				c.sim.Commit()
+			}
+		}
+	}()
+	receipt, err := tx.Wait(ctx)
+	close(done)
+	if err != nil {
+		t.Fatalf("Wait failed: %v", err)
+	}
+	hashes := tx.Hashes()
+	if len(hashes) < 2 {
+		t.Fatalf("Expected the stuck transaction to be replaced, got %d versions", len(hashes))
+	}
+	if receipt.TxHash == hashes[0] {
+		t.Errorf("Expected a replacement to be mined, not the original")
+	}
+	if tx.Latest().Nonce() != 0 {
+		t.Errorf("Expected replacements to reuse nonce 0, got %d", tx.Latest().Nonce())
+	}
+}
+
+func TestReorgDetected(t *testing.T) {
+	c := newTestChain(t, Config{Confirmations: 2})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	parent, err := c.client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := c.manager.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		_, tx, _, err := pauserregistry.DeployPauserRegistry(opts, c.client, nil, common.HexToAddress("0xdead"))
+		return tx, err
+	})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+	c.sim.Commit()
+	receipt, err := c.client.TransactionReceipt(ctx, tx.Latest().Hash())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Replace the inclusion block with a longer side chain that has been
+	// mined past the confirmation depth.
+	if err := c.sim.Fork(parent.Hash()); err != nil {
+		t.Fatal(err)
+	}
+	c.sim.Commit()
+	c.sim.Commit()
+	c.sim.Commit()
+
+	if done, err := tx.confirmed(ctx, receipt); err != nil || done {
+		t.Fatalf("Expected the reorged receipt not to confirm, got %v, %v", done, err)
+	}
+	if tx.Reorgs != 1 {
+		t.Errorf("Expected one reorg, got %d", tx.Reorgs)
+	}
+}
+
+func TestNonceConsumed(t *testing.T) {
+	c := newTestChain(t, Config{ResubmitAfter: time.Millisecond})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	c.manager.auth.GasFeeCap = big.NewInt(1)
+	c.manager.auth.GasTipCap = big.NewInt(1)
+	tx, err := c.manager.Send(ctx, func(opts *bind.TransactOpts) (*types.Transaction, error) {
+		opts.GasLimit = 1_000_000
+		_, tx, _, err := pauserregistry.DeployPauserRegistry(opts, c.client, nil, common.HexToAddress("0xdead"))
+		return tx, err
+	})
+	if err != nil {
+		t.Fatalf("Send failed: %v", err)
+	}
+
+	// Another signer of the same account takes nonce 0.
+	to := common.HexToAddress("0xdead")
+	other, err := types.SignTx(types.NewTx(&types.DynamicFeeTx{
+		ChainID:   big.NewInt(1337),
+		Nonce:     0,
+		GasTipCap: big.NewInt(1e9),
+		GasFeeCap: big.NewInt(1e11),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(1),
+	}), types.LatestSignerForChainID(big.NewInt(1337)), c.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.client.SendTransaction(ctx, other); err != nil {
+		t.Fatal(err)
+	}
+	c.sim.Commit()
+
+	if _, err := tx.Wait(ctx); !errors.Is(err, ErrNonceConsumed) {
+		t.Fatalf("Expected ErrNonceConsumed, got %v", err)
+	}
+}
@@ -0,0 +1,11 @@
+// Package types holds one canonical Go type per Solidity struct used by the
+// EigenLayer contracts.
+//
+// The packages under pkg/bindings declare these structs as type aliases, so a
+// value returned by one binding (for example an OperatorSet read from the
+// AllocationManager) can be passed to any other binding (such as KeyRegistrar
+// or CrossChainRegistry) without conversion.
+//
+// types.go is generated by bin/compile-bindings.sh and must not be edited by
+// hand.
+package types
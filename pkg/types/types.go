@@ -0,0 +1,361 @@
+// Code generated by bindgen - DO NOT EDIT.
+// This file holds the canonical definition of every Solidity struct used by
+// the contract bindings under pkg/bindings.
+
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = big.NewInt
+	_ = common.Big1
+)
+
+// BN254G1Point is a Go binding around the user-defined Solidity struct BN254.G1Point.
+type BN254G1Point struct {
+	X *big.Int
+	Y *big.Int
+}
+
+// BN254G2Point is a Go binding around the user-defined Solidity struct BN254.G2Point.
+type BN254G2Point struct {
+	X [2]*big.Int
+	Y [2]*big.Int
+}
+
+// BeaconChainProofsBalanceContainerProof is a Go binding around the user-defined Solidity struct BeaconChainProofs.BalanceContainerProof.
+type BeaconChainProofsBalanceContainerProof struct {
+	BalanceContainerRoot [32]byte
+	Proof                []byte
+}
+
+// BeaconChainProofsBalanceProof is a Go binding around the user-defined Solidity struct BeaconChainProofs.BalanceProof.
+type BeaconChainProofsBalanceProof struct {
+	PubkeyHash  [32]byte
+	BalanceRoot [32]byte
+	Proof       []byte
+}
+
+// BeaconChainProofsStateRootProof is a Go binding around the user-defined Solidity struct BeaconChainProofs.StateRootProof.
+type BeaconChainProofsStateRootProof struct {
+	BeaconStateRoot [32]byte
+	Proof           []byte
+}
+
+// BeaconChainProofsValidatorProof is a Go binding around the user-defined Solidity struct BeaconChainProofs.ValidatorProof.
+type BeaconChainProofsValidatorProof struct {
+	ValidatorFields [][32]byte
+	Proof           []byte
+}
+
+// ERC20VotesUpgradeableCheckpoint is a Go binding around the user-defined Solidity struct ERC20VotesUpgradeable.Checkpoint.
+type ERC20VotesUpgradeableCheckpoint struct {
+	FromBlock uint32
+	Votes     *big.Int
+}
+
+// IAllocationManagerTypesAllocateParams is a Go binding around the user-defined Solidity struct IAllocationManagerTypes.AllocateParams.
+type IAllocationManagerTypesAllocateParams struct {
+	OperatorSet   OperatorSet
+	Strategies    []common.Address
+	NewMagnitudes []uint64
+}
+
+// IAllocationManagerTypesAllocation is a Go binding around the user-defined Solidity struct IAllocationManagerTypes.Allocation.
+type IAllocationManagerTypesAllocation struct {
+	CurrentMagnitude uint64
+	PendingDiff      *big.Int
+	EffectBlock      uint32
+}
+
+// IAllocationManagerTypesCreateSetParams is a Go binding around the user-defined Solidity struct IAllocationManagerTypes.CreateSetParams.
+type IAllocationManagerTypesCreateSetParams struct {
+	OperatorSetId uint32
+	Strategies    []common.Address
+}
+
+// IAllocationManagerTypesCreateSetParamsV2 is a Go binding around the user-defined Solidity struct IAllocationManagerTypes.CreateSetParamsV2.
+type IAllocationManagerTypesCreateSetParamsV2 struct {
+	OperatorSetId uint32
+	Strategies    []common.Address
+	Slasher       common.Address
+}
+
+// IAllocationManagerTypesDeregisterParams is a Go binding around the user-defined Solidity struct IAllocationManagerTypes.DeregisterParams.
+type IAllocationManagerTypesDeregisterParams struct {
+	Operator       common.Address
+	Avs            common.Address
+	OperatorSetIds []uint32
+}
+
+// IAllocationManagerTypesRegisterParams is a Go binding around the user-defined Solidity struct IAllocationManagerTypes.RegisterParams.
+type IAllocationManagerTypesRegisterParams struct {
+	Avs            common.Address
+	OperatorSetIds []uint32
+	Data           []byte
+}
+
+// IAllocationManagerTypesSlashingParams is a Go binding around the user-defined Solidity struct IAllocationManagerTypes.SlashingParams.
+type IAllocationManagerTypesSlashingParams struct {
+	Operator      common.Address
+	OperatorSetId uint32
+	Strategies    []common.Address
+	WadsToSlash   []*big.Int
+	Description   string
+}
+
+// IBN254CertificateVerifierTypesBN254Certificate is a Go binding around the user-defined Solidity struct IBN254CertificateVerifierTypes.BN254Certificate.
+type IBN254CertificateVerifierTypesBN254Certificate struct {
+	ReferenceTimestamp uint32
+	MessageHash        [32]byte
+	Signature          BN254G1Point
+	Apk                BN254G2Point
+	NonSignerWitnesses []IBN254CertificateVerifierTypesBN254OperatorInfoWitness
+}
+
+// IBN254CertificateVerifierTypesBN254OperatorInfoWitness is a Go binding around the user-defined Solidity struct IBN254CertificateVerifierTypes.BN254OperatorInfoWitness.
+type IBN254CertificateVerifierTypesBN254OperatorInfoWitness struct {
+	OperatorIndex     uint32
+	OperatorInfoProof []byte
+	OperatorInfo      IOperatorTableCalculatorTypesBN254OperatorInfo
+}
+
+// ICrossChainRegistryTypesOperatorSetConfig is a Go binding around the user-defined Solidity struct ICrossChainRegistryTypes.OperatorSetConfig.
+type ICrossChainRegistryTypesOperatorSetConfig struct {
+	Owner              common.Address
+	MaxStalenessPeriod uint32
+}
+
+// IDelegationManagerTypesQueuedWithdrawalParams is a Go binding around the user-defined Solidity struct IDelegationManagerTypes.QueuedWithdrawalParams.
+type IDelegationManagerTypesQueuedWithdrawalParams struct {
+	Strategies           []common.Address
+	DepositShares        []*big.Int
+	DeprecatedWithdrawer common.Address
+}
+
+// IDelegationManagerTypesWithdrawal is a Go binding around the user-defined Solidity struct IDelegationManagerTypes.Withdrawal.
+type IDelegationManagerTypesWithdrawal struct {
+	Staker       common.Address
+	DelegatedTo  common.Address
+	Withdrawer   common.Address
+	Nonce        *big.Int
+	StartBlock   uint32
+	Strategies   []common.Address
+	ScaledShares []*big.Int
+}
+
+// IECDSACertificateVerifierTypesECDSACertificate is a Go binding around the user-defined Solidity struct IECDSACertificateVerifierTypes.ECDSACertificate.
+type IECDSACertificateVerifierTypesECDSACertificate struct {
+	ReferenceTimestamp uint32
+	MessageHash        [32]byte
+	Sig                []byte
+}
+
+// IEigenPodTypesCheckpoint is a Go binding around the user-defined Solidity struct IEigenPodTypes.Checkpoint.
+type IEigenPodTypesCheckpoint struct {
+	BeaconBlockRoot       [32]byte
+	ProofsRemaining       *big.Int
+	PodBalanceGwei        uint64
+	BalanceDeltasGwei     int64
+	PrevBeaconBalanceGwei uint64
+}
+
+// IEigenPodTypesConsolidationRequest is a Go binding around the user-defined Solidity struct IEigenPodTypes.ConsolidationRequest.
+type IEigenPodTypesConsolidationRequest struct {
+	SrcPubkey    []byte
+	TargetPubkey []byte
+}
+
+// IEigenPodTypesValidatorInfo is a Go binding around the user-defined Solidity struct IEigenPodTypes.ValidatorInfo.
+type IEigenPodTypesValidatorInfo struct {
+	ValidatorIndex      uint64
+	RestakedBalanceGwei uint64
+	LastCheckpointedAt  uint64
+	Status              uint8
+}
+
+// IEigenPodTypesWithdrawalRequest is a Go binding around the user-defined Solidity struct IEigenPodTypes.WithdrawalRequest.
+type IEigenPodTypesWithdrawalRequest struct {
+	Pubkey     []byte
+	AmountGwei uint64
+}
+
+// IOperatorTableCalculatorTypesBN254OperatorInfo is a Go binding around the user-defined Solidity struct IOperatorTableCalculatorTypes.BN254OperatorInfo.
+type IOperatorTableCalculatorTypesBN254OperatorInfo struct {
+	Pubkey  BN254G1Point
+	Weights []*big.Int
+}
+
+// IOperatorTableCalculatorTypesBN254OperatorSetInfo is a Go binding around the user-defined Solidity struct IOperatorTableCalculatorTypes.BN254OperatorSetInfo.
+type IOperatorTableCalculatorTypesBN254OperatorSetInfo struct {
+	OperatorInfoTreeRoot [32]byte
+	NumOperators         *big.Int
+	AggregatePubkey      BN254G1Point
+	TotalWeights         []*big.Int
+}
+
+// IOperatorTableCalculatorTypesECDSAOperatorInfo is a Go binding around the user-defined Solidity struct IOperatorTableCalculatorTypes.ECDSAOperatorInfo.
+type IOperatorTableCalculatorTypesECDSAOperatorInfo struct {
+	Pubkey  common.Address
+	Weights []*big.Int
+}
+
+// IProtocolRegistryTypesDeploymentConfig is a Go binding around the user-defined Solidity struct IProtocolRegistryTypes.DeploymentConfig.
+type IProtocolRegistryTypesDeploymentConfig struct {
+	Pausable   bool
+	Deprecated bool
+}
+
+// IReleaseManagerTypesArtifact is a Go binding around the user-defined Solidity struct IReleaseManagerTypes.Artifact.
+type IReleaseManagerTypesArtifact struct {
+	Digest   [32]byte
+	Registry string
+}
+
+// IReleaseManagerTypesRelease is a Go binding around the user-defined Solidity struct IReleaseManagerTypes.Release.
+type IReleaseManagerTypesRelease struct {
+	Artifacts     []IReleaseManagerTypesArtifact
+	UpgradeByTime uint32
+}
+
+// IRewardsCoordinatorTypesDistributionRoot is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.DistributionRoot.
+type IRewardsCoordinatorTypesDistributionRoot struct {
+	Root                           [32]byte
+	RewardsCalculationEndTimestamp uint32
+	ActivatedAt                    uint32
+	Disabled                       bool
+}
+
+// IRewardsCoordinatorTypesEarnerTreeMerkleLeaf is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.EarnerTreeMerkleLeaf.
+type IRewardsCoordinatorTypesEarnerTreeMerkleLeaf struct {
+	Earner          common.Address
+	EarnerTokenRoot [32]byte
+}
+
+// IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.OperatorDirectedRewardsSubmission.
+type IRewardsCoordinatorTypesOperatorDirectedRewardsSubmission struct {
+	StrategiesAndMultipliers []IRewardsCoordinatorTypesStrategyAndMultiplier
+	Token                    common.Address
+	OperatorRewards          []IRewardsCoordinatorTypesOperatorReward
+	StartTimestamp           uint32
+	Duration                 uint32
+	Description              string
+}
+
+// IRewardsCoordinatorTypesOperatorReward is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.OperatorReward.
+type IRewardsCoordinatorTypesOperatorReward struct {
+	Operator common.Address
+	Amount   *big.Int
+}
+
+// IRewardsCoordinatorTypesRewardsCoordinatorConstructorParams is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.RewardsCoordinatorConstructorParams.
+type IRewardsCoordinatorTypesRewardsCoordinatorConstructorParams struct {
+	DelegationManager          common.Address
+	StrategyManager            common.Address
+	AllocationManager          common.Address
+	PauserRegistry             common.Address
+	PermissionController       common.Address
+	CALCULATIONINTERVALSECONDS uint32
+	MAXREWARDSDURATION         uint32
+	MAXRETROACTIVELENGTH       uint32
+	MAXFUTURELENGTH            uint32
+	GENESISREWARDSTIMESTAMP    uint32
+}
+
+// IRewardsCoordinatorTypesRewardsMerkleClaim is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.RewardsMerkleClaim.
+type IRewardsCoordinatorTypesRewardsMerkleClaim struct {
+	RootIndex       uint32
+	EarnerIndex     uint32
+	EarnerTreeProof []byte
+	EarnerLeaf      IRewardsCoordinatorTypesEarnerTreeMerkleLeaf
+	TokenIndices    []uint32
+	TokenTreeProofs [][]byte
+	TokenLeaves     []IRewardsCoordinatorTypesTokenTreeMerkleLeaf
+}
+
+// IRewardsCoordinatorTypesRewardsSubmission is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.RewardsSubmission.
+type IRewardsCoordinatorTypesRewardsSubmission struct {
+	StrategiesAndMultipliers []IRewardsCoordinatorTypesStrategyAndMultiplier
+	Token                    common.Address
+	Amount                   *big.Int
+	StartTimestamp           uint32
+	Duration                 uint32
+}
+
+// IRewardsCoordinatorTypesStrategyAndMultiplier is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.StrategyAndMultiplier.
+type IRewardsCoordinatorTypesStrategyAndMultiplier struct {
+	Strategy   common.Address
+	Multiplier *big.Int
+}
+
+// IRewardsCoordinatorTypesTokenTreeMerkleLeaf is a Go binding around the user-defined Solidity struct IRewardsCoordinatorTypes.TokenTreeMerkleLeaf.
+type IRewardsCoordinatorTypesTokenTreeMerkleLeaf struct {
+	Token              common.Address
+	CumulativeEarnings *big.Int
+}
+
+// ISignatureUtilsMixinTypesSignatureWithExpiry is a Go binding around the user-defined Solidity struct ISignatureUtilsMixinTypes.SignatureWithExpiry.
+type ISignatureUtilsMixinTypesSignatureWithExpiry struct {
+	Signature []byte
+	Expiry    *big.Int
+}
+
+// ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry is a Go binding around the user-defined Solidity struct ISignatureUtilsMixinTypes.SignatureWithSaltAndExpiry.
+type ISignatureUtilsMixinTypesSignatureWithSaltAndExpiry struct {
+	Signature []byte
+	Salt      [32]byte
+	Expiry    *big.Int
+}
+
+// ITaskMailboxTypesConsensus is a Go binding around the user-defined Solidity struct ITaskMailboxTypes.Consensus.
+type ITaskMailboxTypesConsensus struct {
+	ConsensusType uint8
+	Value         []byte
+}
+
+// ITaskMailboxTypesExecutorOperatorSetTaskConfig is a Go binding around the user-defined Solidity struct ITaskMailboxTypes.ExecutorOperatorSetTaskConfig.
+type ITaskMailboxTypesExecutorOperatorSetTaskConfig struct {
+	TaskHook     common.Address
+	TaskSLA      *big.Int
+	FeeToken     common.Address
+	CurveType    uint8
+	FeeCollector common.Address
+	Consensus    ITaskMailboxTypesConsensus
+	TaskMetadata []byte
+}
+
+// ITaskMailboxTypesTask is a Go binding around the user-defined Solidity struct ITaskMailboxTypes.Task.
+type ITaskMailboxTypesTask struct {
+	Creator                         common.Address
+	CreationTime                    *big.Int
+	Avs                             common.Address
+	AvsFee                          *big.Int
+	RefundCollector                 common.Address
+	ExecutorOperatorSetId           uint32
+	FeeSplit                        uint16
+	Status                          uint8
+	IsFeeRefunded                   bool
+	OperatorTableReferenceTimestamp uint32
+	ExecutorOperatorSetTaskConfig   ITaskMailboxTypesExecutorOperatorSetTaskConfig
+	Payload                         []byte
+	ExecutorCert                    []byte
+	Result                          []byte
+}
+
+// ITaskMailboxTypesTaskParams is a Go binding around the user-defined Solidity struct ITaskMailboxTypes.TaskParams.
+type ITaskMailboxTypesTaskParams struct {
+	RefundCollector     common.Address
+	ExecutorOperatorSet OperatorSet
+	Payload             []byte
+}
+
+// OperatorSet is a Go binding around the user-defined Solidity struct OperatorSet.
+type OperatorSet struct {
+	Avs common.Address
+	Id  uint32
+}